@@ -2911,6 +2911,57 @@ func (x *fastReflection_MsgExecuteBundle) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_BundledTxResponse_1_list)(nil)
+
+type _BundledTxResponse_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_BundledTxResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BundledTxResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BundledTxResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BundledTxResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BundledTxResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BundledTxResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BundledTxResponse_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BundledTxResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_BundledTxResponse                protoreflect.MessageDescriptor
 	fd_BundledTxResponse_exec_responses protoreflect.FieldDescriptor
@@ -2989,8 +3040,8 @@ func (x *fastReflection_BundledTxResponse) Interface() protoreflect.ProtoMessage
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_BundledTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExecResponses != nil {
-		value := protoreflect.ValueOfMessage(x.ExecResponses.ProtoReflect())
+	if len(x.ExecResponses) != 0 {
+		value := protoreflect.ValueOfList(&_BundledTxResponse_1_list{list: &x.ExecResponses})
 		if !f(fd_BundledTxResponse_exec_responses, value) {
 			return
 		}
@@ -3017,7 +3068,7 @@ func (x *fastReflection_BundledTxResponse) Range(f func(protoreflect.FieldDescri
 func (x *fastReflection_BundledTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.accounts.v1.BundledTxResponse.exec_responses":
-		return x.ExecResponses != nil
+		return len(x.ExecResponses) != 0
 	case "cosmos.accounts.v1.BundledTxResponse.error":
 		return x.Error != ""
 	default:
@@ -3057,8 +3108,11 @@ func (x *fastReflection_BundledTxResponse) Clear(fd protoreflect.FieldDescriptor
 func (x *fastReflection_BundledTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	case "cosmos.accounts.v1.BundledTxResponse.exec_responses":
-		value := x.ExecResponses
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+		if len(x.ExecResponses) == 0 {
+			return protoreflect.ValueOfList(&_BundledTxResponse_1_list{})
+		}
+		listValue := &_BundledTxResponse_1_list{list: &x.ExecResponses}
+		return protoreflect.ValueOfList(listValue)
 	case "cosmos.accounts.v1.BundledTxResponse.error":
 		value := x.Error
 		return protoreflect.ValueOfString(value)
@@ -3083,7 +3137,9 @@ func (x *fastReflection_BundledTxResponse) Get(descriptor protoreflect.FieldDesc
 func (x *fastReflection_BundledTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	case "cosmos.accounts.v1.BundledTxResponse.exec_responses":
-		x.ExecResponses = value.Message().Interface().(*anypb.Any)
+		lv := value.List()
+		clv := lv.(*_BundledTxResponse_1_list)
+		x.ExecResponses = *clv.list
 	case "cosmos.accounts.v1.BundledTxResponse.error":
 		x.Error = value.Interface().(string)
 	default:
@@ -3108,9 +3164,10 @@ func (x *fastReflection_BundledTxResponse) Mutable(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "cosmos.accounts.v1.BundledTxResponse.exec_responses":
 		if x.ExecResponses == nil {
-			x.ExecResponses = new(anypb.Any)
+			x.ExecResponses = []*anypb.Any{}
 		}
-		return protoreflect.ValueOfMessage(x.ExecResponses.ProtoReflect())
+		value := &_BundledTxResponse_1_list{list: &x.ExecResponses}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.accounts.v1.BundledTxResponse.error":
 		panic(fmt.Errorf("field error of message cosmos.accounts.v1.BundledTxResponse is not mutable"))
 	default:
@@ -3127,8 +3184,8 @@ func (x *fastReflection_BundledTxResponse) Mutable(fd protoreflect.FieldDescript
 func (x *fastReflection_BundledTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	case "cosmos.accounts.v1.BundledTxResponse.exec_responses":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_BundledTxResponse_1_list{list: &list})
 	case "cosmos.accounts.v1.BundledTxResponse.error":
 		return protoreflect.ValueOfString("")
 	default:
@@ -3200,9 +3257,11 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ExecResponses != nil {
-			l = options.Size(x.ExecResponses)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ExecResponses) > 0 {
+			for _, e := range x.ExecResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		l = len(x.Error)
 		if l > 0 {
@@ -3244,19 +3303,21 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ExecResponses != nil {
-			encoded, err := options.Marshal(x.ExecResponses)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.ExecResponses) > 0 {
+			for iNdEx := len(x.ExecResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExecResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3336,10 +3397,8 @@ func (x *fastReflection_BundledTxResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExecResponses == nil {
-					x.ExecResponses = &anypb.Any{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecResponses); err != nil {
+				x.ExecResponses = append(x.ExecResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecResponses[len(x.ExecResponses)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4183,8 +4242,12 @@ type BundledTxResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExecResponses *anypb.Any `protobuf:"bytes,1,opt,name=exec_responses,json=execResponses,proto3" json:"exec_responses,omitempty"`
-	Error         string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// exec_responses are the responses of the messages of the bundled tx, in order.
+	// They are empty if the bundled tx failed.
+	ExecResponses []*anypb.Any `protobuf:"bytes,1,rep,name=exec_responses,json=execResponses,proto3" json:"exec_responses,omitempty"`
+	// error is the error of the bundled tx, empty if it succeeded. The state changes
+	// of a failed bundled tx are reverted, without affecting the other bundled txs.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (x *BundledTxResponse) Reset() {
@@ -4207,7 +4270,7 @@ func (*BundledTxResponse) Descriptor() ([]byte, []int) {
 	return file_cosmos_accounts_v1_tx_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *BundledTxResponse) GetExecResponses() *anypb.Any {
+func (x *BundledTxResponse) GetExecResponses() []*anypb.Any {
 	if x != nil {
 		return x.ExecResponses
 	}
@@ -4321,7 +4384,7 @@ var file_cosmos_accounts_v1_tx_proto_rawDesc = []byte{
 	0xe7, 0xb0, 0x2a, 0x07, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x11, 0x42,
 	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x3b, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0d,
 	0x65, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a,
 	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
@@ -29,6 +29,8 @@ const (
 	Query_DenomMetadata_FullMethodName              = "/cosmos.bank.v1beta1.Query/DenomMetadata"
 	Query_DenomMetadataByQueryString_FullMethodName = "/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString"
 	Query_DenomsMetadata_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
+	Query_DenomsMetadataBySymbol_FullMethodName     = "/cosmos.bank.v1beta1.Query/DenomsMetadataBySymbol"
+	Query_DenomsByPrefix_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomsByPrefix"
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
@@ -75,6 +77,12 @@ type QueryClient interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of all the coin
+	// denominations registered with a given display symbol.
+	DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error)
+	// DenomsByPrefix queries all the coin denominations with client metadata whose
+	// base denom starts with a given prefix.
+	DenomsByPrefix(ctx context.Context, in *QueryDenomsByPrefixRequest, opts ...grpc.CallOption) (*QueryDenomsByPrefixResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -190,6 +198,24 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error) {
+	out := new(QueryDenomsMetadataBySymbolResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsMetadataBySymbol_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsByPrefix(ctx context.Context, in *QueryDenomsByPrefixRequest, opts ...grpc.CallOption) (*QueryDenomsByPrefixResponse, error) {
+	out := new(QueryDenomsByPrefixResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsByPrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error) {
 	out := new(QueryDenomOwnersResponse)
 	err := c.cc.Invoke(ctx, Query_DenomOwners_FullMethodName, in, out, opts...)
@@ -258,6 +284,12 @@ type QueryServer interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of all the coin
+	// denominations registered with a given display symbol.
+	DenomsMetadataBySymbol(context.Context, *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error)
+	// DenomsByPrefix queries all the coin denominations with client metadata whose
+	// base denom starts with a given prefix.
+	DenomsByPrefix(context.Context, *QueryDenomsByPrefixRequest) (*QueryDenomsByPrefixResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -310,6 +342,12 @@ func (UnimplementedQueryServer) DenomMetadataByQueryString(context.Context, *Que
 func (UnimplementedQueryServer) DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (UnimplementedQueryServer) DenomsMetadataBySymbol(context.Context, *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataBySymbol not implemented")
+}
+func (UnimplementedQueryServer) DenomsByPrefix(context.Context, *QueryDenomsByPrefixRequest) (*QueryDenomsByPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsByPrefix not implemented")
+}
 func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
@@ -512,6 +550,42 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomsMetadataBySymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataBySymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsMetadataBySymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, req.(*QueryDenomsMetadataBySymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsByPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsByPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsByPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsByPrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsByPrefix(ctx, req.(*QueryDenomsByPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDenomOwnersRequest)
 	if err := dec(in); err != nil {
@@ -613,6 +687,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "DenomsMetadataBySymbol",
+			Handler:    _Query_DenomsMetadataBySymbol_Handler,
+		},
+		{
+			MethodName: "DenomsByPrefix",
+			Handler:    _Query_DenomsByPrefix_Handler,
+		},
 		{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
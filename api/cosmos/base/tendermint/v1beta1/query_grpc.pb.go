@@ -26,6 +26,9 @@ const (
 	Service_GetLatestValidatorSet_FullMethodName   = "/cosmos.base.tendermint.v1beta1.Service/GetLatestValidatorSet"
 	Service_GetValidatorSetByHeight_FullMethodName = "/cosmos.base.tendermint.v1beta1.Service/GetValidatorSetByHeight"
 	Service_ABCIQuery_FullMethodName               = "/cosmos.base.tendermint.v1beta1.Service/ABCIQuery"
+	Service_StoreProof_FullMethodName              = "/cosmos.base.tendermint.v1beta1.Service/StoreProof"
+	Service_SearchTxs_FullMethodName               = "/cosmos.base.tendermint.v1beta1.Service/SearchTxs"
+	Service_SearchBlocks_FullMethodName            = "/cosmos.base.tendermint.v1beta1.Service/SearchBlocks"
 )
 
 // ServiceClient is the client API for Service service.
@@ -48,6 +51,15 @@ type ServiceClient interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// StoreProof queries the value of a key in a module store, along with its
+	// ICS-23 proof of existence or non-existence, at a given height.
+	StoreProof(ctx context.Context, in *StoreProofRequest, opts ...grpc.CallOption) (*StoreProofResponse, error)
+	// SearchTxs searches for the transactions matching an events query with the
+	// CometBFT tx_search RPC method, and returns them decoded.
+	SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error)
+	// SearchBlocks searches for the blocks matching an events query with the
+	// CometBFT block_search RPC method.
+	SearchBlocks(ctx context.Context, in *SearchBlocksRequest, opts ...grpc.CallOption) (*SearchBlocksResponse, error)
 }
 
 type serviceClient struct {
@@ -121,6 +133,33 @@ func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) StoreProof(ctx context.Context, in *StoreProofRequest, opts ...grpc.CallOption) (*StoreProofResponse, error) {
+	out := new(StoreProofResponse)
+	err := c.cc.Invoke(ctx, Service_StoreProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error) {
+	out := new(SearchTxsResponse)
+	err := c.cc.Invoke(ctx, Service_SearchTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SearchBlocks(ctx context.Context, in *SearchBlocksRequest, opts ...grpc.CallOption) (*SearchBlocksResponse, error) {
+	out := new(SearchBlocksResponse)
+	err := c.cc.Invoke(ctx, Service_SearchBlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -141,6 +180,15 @@ type ServiceServer interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// StoreProof queries the value of a key in a module store, along with its
+	// ICS-23 proof of existence or non-existence, at a given height.
+	StoreProof(context.Context, *StoreProofRequest) (*StoreProofResponse, error)
+	// SearchTxs searches for the transactions matching an events query with the
+	// CometBFT tx_search RPC method, and returns them decoded.
+	SearchTxs(context.Context, *SearchTxsRequest) (*SearchTxsResponse, error)
+	// SearchBlocks searches for the blocks matching an events query with the
+	// CometBFT block_search RPC method.
+	SearchBlocks(context.Context, *SearchBlocksRequest) (*SearchBlocksResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -169,6 +217,15 @@ func (UnimplementedServiceServer) GetValidatorSetByHeight(context.Context, *GetV
 func (UnimplementedServiceServer) ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
 }
+func (UnimplementedServiceServer) StoreProof(context.Context, *StoreProofRequest) (*StoreProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreProof not implemented")
+}
+func (UnimplementedServiceServer) SearchTxs(context.Context, *SearchTxsRequest) (*SearchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTxs not implemented")
+}
+func (UnimplementedServiceServer) SearchBlocks(context.Context, *SearchBlocksRequest) (*SearchBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlocks not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -308,6 +365,60 @@ func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_StoreProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_StoreProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreProof(ctx, req.(*StoreProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SearchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SearchTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchTxs(ctx, req.(*SearchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SearchBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SearchBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchBlocks(ctx, req.(*SearchBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +454,18 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ABCIQuery",
 			Handler:    _Service_ABCIQuery_Handler,
 		},
+		{
+			MethodName: "StoreProof",
+			Handler:    _Service_StoreProof_Handler,
+		},
+		{
+			MethodName: "SearchTxs",
+			Handler:    _Service_SearchTxs_Handler,
+		},
+		{
+			MethodName: "SearchBlocks",
+			Handler:    _Service_SearchBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/cosmos.consensus.v1.Query/Params"
+	Query_Params_FullMethodName             = "/cosmos.consensus.v1.Query/Params"
+	Query_GasLimitController_FullMethodName = "/cosmos.consensus.v1.Query/GasLimitController"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,6 +31,9 @@ const (
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GasLimitController queries the parameters and the current epoch state of
+	// the block gas limit controller.
+	GasLimitController(ctx context.Context, in *QueryGasLimitControllerRequest, opts ...grpc.CallOption) (*QueryGasLimitControllerResponse, error)
 }
 
 type queryClient struct {
@@ -49,12 +53,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GasLimitController(ctx context.Context, in *QueryGasLimitControllerRequest, opts ...grpc.CallOption) (*QueryGasLimitControllerResponse, error) {
+	out := new(QueryGasLimitControllerResponse)
+	err := c.cc.Invoke(ctx, Query_GasLimitController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GasLimitController queries the parameters and the current epoch state of
+	// the block gas limit controller.
+	GasLimitController(context.Context, *QueryGasLimitControllerRequest) (*QueryGasLimitControllerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -65,6 +81,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) GasLimitController(context.Context, *QueryGasLimitControllerRequest) (*QueryGasLimitControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasLimitController not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +115,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasLimitController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasLimitControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasLimitController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GasLimitController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasLimitController(ctx, req.(*QueryGasLimitControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +144,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "GasLimitController",
+			Handler:    _Query_GasLimitController_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
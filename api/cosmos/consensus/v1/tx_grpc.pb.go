@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName                   = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_UpdateGasLimitControllerParams_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateGasLimitControllerParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateGasLimitControllerParams defines a governance operation for updating
+	// the parameters of the block gas limit controller.
+	// The authority is defined in the keeper.
+	UpdateGasLimitControllerParams(ctx context.Context, in *MsgUpdateGasLimitControllerParams, opts ...grpc.CallOption) (*MsgUpdateGasLimitControllerParamsResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +55,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateGasLimitControllerParams(ctx context.Context, in *MsgUpdateGasLimitControllerParams, opts ...grpc.CallOption) (*MsgUpdateGasLimitControllerParamsResponse, error) {
+	out := new(MsgUpdateGasLimitControllerParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGasLimitControllerParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -57,6 +71,10 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateGasLimitControllerParams defines a governance operation for updating
+	// the parameters of the block gas limit controller.
+	// The authority is defined in the keeper.
+	UpdateGasLimitControllerParams(context.Context, *MsgUpdateGasLimitControllerParams) (*MsgUpdateGasLimitControllerParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -67,6 +85,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateGasLimitControllerParams(context.Context, *MsgUpdateGasLimitControllerParams) (*MsgUpdateGasLimitControllerParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasLimitControllerParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +119,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGasLimitControllerParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasLimitControllerParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasLimitControllerParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGasLimitControllerParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasLimitControllerParams(ctx, req.(*MsgUpdateGasLimitControllerParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +148,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateGasLimitControllerParams",
+			Handler:    _Msg_UpdateGasLimitControllerParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -21,9 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
-	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
-	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_Allowance_FullMethodName                = "/cosmos.feegrant.v1beta1.Query/Allowance"
+	Query_Allowances_FullMethodName               = "/cosmos.feegrant.v1beta1.Query/Allowances"
+	Query_AllowancesByGranter_FullMethodName      = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_AllowanceUsage_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/AllowanceUsage"
+	Query_AllowanceUsagesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowanceUsagesByGranter"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +38,10 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+	AllowanceUsage(ctx context.Context, in *QueryAllowanceUsageRequest, opts ...grpc.CallOption) (*QueryAllowanceUsageResponse, error)
+	// AllowanceUsagesByGranter returns the usage of all the used allowances given by an address.
+	AllowanceUsagesByGranter(ctx context.Context, in *QueryAllowanceUsagesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowanceUsagesByGranterResponse, error)
 }
 
 type queryClient struct {
@@ -73,6 +79,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) AllowanceUsage(ctx context.Context, in *QueryAllowanceUsageRequest, opts ...grpc.CallOption) (*QueryAllowanceUsageResponse, error) {
+	out := new(QueryAllowanceUsageResponse)
+	err := c.cc.Invoke(ctx, Query_AllowanceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowanceUsagesByGranter(ctx context.Context, in *QueryAllowanceUsagesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowanceUsagesByGranterResponse, error) {
+	out := new(QueryAllowanceUsagesByGranterResponse)
+	err := c.cc.Invoke(ctx, Query_AllowanceUsagesByGranter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -83,6 +107,10 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+	AllowanceUsage(context.Context, *QueryAllowanceUsageRequest) (*QueryAllowanceUsageResponse, error)
+	// AllowanceUsagesByGranter returns the usage of all the used allowances given by an address.
+	AllowanceUsagesByGranter(context.Context, *QueryAllowanceUsagesByGranterRequest) (*QueryAllowanceUsagesByGranterResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -99,6 +127,12 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) AllowanceUsage(context.Context, *QueryAllowanceUsageRequest) (*QueryAllowanceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowanceUsage not implemented")
+}
+func (UnimplementedQueryServer) AllowanceUsagesByGranter(context.Context, *QueryAllowanceUsagesByGranterRequest) (*QueryAllowanceUsagesByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowanceUsagesByGranter not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +200,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowanceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowanceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowanceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowanceUsage(ctx, req.(*QueryAllowanceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowanceUsagesByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceUsagesByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowanceUsagesByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowanceUsagesByGranter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowanceUsagesByGranter(ctx, req.(*QueryAllowanceUsagesByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "AllowanceUsage",
+			Handler:    _Query_AllowanceUsage_Handler,
+		},
+		{
+			MethodName: "AllowanceUsagesByGranter",
+			Handler:    _Query_AllowanceUsagesByGranter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -22,6 +22,7 @@ const (
 	Msg_CreateValidator_FullMethodName           = "/cosmos.staking.v1beta1.Msg/CreateValidator"
 	Msg_EditValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/EditValidator"
 	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
+	Msg_MultiDelegate_FullMethodName             = "/cosmos.staking.v1beta1.Msg/MultiDelegate"
 	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
 	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
 	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
@@ -40,6 +41,9 @@ type MsgClient interface {
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
+	// MultiDelegate defines a method for splitting a delegation of coins
+	// from a delegator across multiple validators according to weights.
+	MultiDelegate(ctx context.Context, in *MsgMultiDelegate, opts ...grpc.CallOption) (*MsgMultiDelegateResponse, error)
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error)
@@ -92,6 +96,15 @@ func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) MultiDelegate(ctx context.Context, in *MsgMultiDelegate, opts ...grpc.CallOption) (*MsgMultiDelegateResponse, error) {
+	out := new(MsgMultiDelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_MultiDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error) {
 	out := new(MsgBeginRedelegateResponse)
 	err := c.cc.Invoke(ctx, Msg_BeginRedelegate_FullMethodName, in, out, opts...)
@@ -148,6 +161,9 @@ type MsgServer interface {
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
+	// MultiDelegate defines a method for splitting a delegation of coins
+	// from a delegator across multiple validators according to weights.
+	MultiDelegate(context.Context, *MsgMultiDelegate) (*MsgMultiDelegateResponse, error)
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error)
@@ -179,6 +195,9 @@ func (UnimplementedMsgServer) EditValidator(context.Context, *MsgEditValidator)
 func (UnimplementedMsgServer) Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
 }
+func (UnimplementedMsgServer) MultiDelegate(context.Context, *MsgMultiDelegate) (*MsgMultiDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiDelegate not implemented")
+}
 func (UnimplementedMsgServer) BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegate not implemented")
 }
@@ -261,6 +280,24 @@ func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MultiDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MultiDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiDelegate(ctx, req.(*MsgMultiDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_BeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBeginRedelegate)
 	if err := dec(in); err != nil {
@@ -370,6 +407,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delegate",
 			Handler:    _Msg_Delegate_Handler,
 		},
+		{
+			MethodName: "MultiDelegate",
+			Handler:    _Msg_MultiDelegate_Handler,
+		},
 		{
 			MethodName: "BeginRedelegate",
 			Handler:    _Msg_BeginRedelegate_Handler,
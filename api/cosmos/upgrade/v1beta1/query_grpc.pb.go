@@ -24,6 +24,7 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_DryRunParamChanges_FullMethodName     = "/cosmos.upgrade.v1beta1.Query/DryRunParamChanges"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +47,9 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// DryRunParamChanges executes the param changes of the current upgrade plan
+	// without persisting them and reports the outcome of each change.
+	DryRunParamChanges(ctx context.Context, in *QueryDryRunParamChangesRequest, opts ...grpc.CallOption) (*QueryDryRunParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -102,6 +106,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) DryRunParamChanges(ctx context.Context, in *QueryDryRunParamChangesRequest, opts ...grpc.CallOption) (*QueryDryRunParamChangesResponse, error) {
+	out := new(QueryDryRunParamChangesResponse)
+	err := c.cc.Invoke(ctx, Query_DryRunParamChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -122,6 +135,9 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// DryRunParamChanges executes the param changes of the current upgrade plan
+	// without persisting them and reports the outcome of each change.
+	DryRunParamChanges(context.Context, *QueryDryRunParamChangesRequest) (*QueryDryRunParamChangesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -144,6 +160,9 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) DryRunParamChanges(context.Context, *QueryDryRunParamChangesRequest) (*QueryDryRunParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunParamChanges not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -247,6 +266,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DryRunParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDryRunParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DryRunParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DryRunParamChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DryRunParamChanges(ctx, req.(*QueryDryRunParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -274,6 +311,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "DryRunParamChanges",
+			Handler:    _Query_DryRunParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -166,7 +166,7 @@ func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/8039
 	defer func() {
 		if r := recover(); r != nil {
-			resp = queryResult(errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r), app.trace)
+			resp = queryResult(errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r), app.errorEncoder())
 		}
 	}()
 
@@ -180,7 +180,7 @@ func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci
 	defer telemetry.MeasureSince(telemetry.Now(), req.Path)
 
 	if req.Path == QueryPathBroadcastTx {
-		return queryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "can't route a broadcast tx message"), app.trace), nil
+		return queryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "can't route a broadcast tx message"), app.errorEncoder()), nil
 	}
 
 	// handle gRPC routes first rather than calling splitPath because '/' characters
@@ -191,7 +191,7 @@ func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci
 
 	path := SplitABCIQueryPath(req.Path)
 	if len(path) == 0 {
-		return queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"), app.trace), nil
+		return queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"), app.errorEncoder()), nil
 	}
 
 	switch path[0] {
@@ -206,7 +206,7 @@ func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci
 		resp = handleQueryP2P(app, path)
 
 	default:
-		resp = queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "unknown query path"), app.trace)
+		resp = queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "unknown query path"), app.errorEncoder())
 	}
 
 	return resp, nil
@@ -363,9 +363,16 @@ func (app *BaseApp) CheckTx(req *abci.CheckTxRequest) (*abci.CheckTxResponse, er
 		return nil, fmt.Errorf("unknown RequestCheckTx type: %s", req.Type)
 	}
 
-	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx)
+	tx, err := app.TxDecode(req.Tx)
 	if err != nil {
-		return responseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace), nil
+		return responseCheckTxWithEvents(sdkerrors.ErrTxDecode.Wrap(err.Error()), 0, 0, nil, app.errorEncoder()), nil
+	}
+
+	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx, tx)
+	if err != nil {
+		// the rejected txs are not added to the mempool
+		app.txDecodeCache.remove([][]byte{req.Tx})
+		return responseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.errorEncoder()), nil
 	}
 
 	return &abci.CheckTxResponse{
@@ -529,6 +536,13 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.Context())))
 
+	// the txs of a rejected proposal will not be finalized
+	defer func() {
+		if resp == nil || resp.Status != abci.PROCESS_PROPOSAL_STATUS_ACCEPT {
+			app.txDecodeCache.remove(req.Txs)
+		}
+	}()
+
 	defer func() {
 		if err := recover(); err != nil {
 			app.logger.Error(
@@ -831,6 +845,9 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 		txResults = append(txResults, response)
 	}
 
+	// the finalized txs are not executed again
+	app.txDecodeCache.remove(req.Txs)
+
 	if app.finalizeBlockState.ms.TracingEnabled() {
 		app.finalizeBlockState.ms = app.finalizeBlockState.ms.SetTracingContext(nil).(storetypes.CacheMultiStore)
 	}
@@ -1009,7 +1026,7 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 
 			gInfo, res, err := app.Simulate(txBytes)
 			if err != nil {
-				return queryResult(errorsmod.Wrap(err, "failed to simulate tx"), app.trace)
+				return queryResult(errorsmod.Wrap(err, "failed to simulate tx"), app.errorEncoder())
 			}
 
 			simRes := &sdk.SimulationResponse{
@@ -1019,7 +1036,7 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 
 			bz, err := codec.ProtoMarshalJSON(simRes, app.interfaceRegistry)
 			if err != nil {
-				return queryResult(errorsmod.Wrap(err, "failed to JSON encode simulation response"), app.trace)
+				return queryResult(errorsmod.Wrap(err, "failed to JSON encode simulation response"), app.errorEncoder())
 			}
 
 			return &abci.QueryResponse{
@@ -1036,7 +1053,7 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 			}
 
 		default:
-			return queryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
+			return queryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.errorEncoder())
 		}
 	}
 
@@ -1044,14 +1061,14 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 		errorsmod.Wrap(
 			sdkerrors.ErrUnknownRequest,
 			"expected second parameter to be either 'simulate' or 'version', neither was present",
-		), app.trace)
+		), app.errorEncoder())
 }
 
 func handleQueryStore(app *BaseApp, path []string, req abci.QueryRequest) *abci.QueryResponse {
 	// "/store" prefix for store queries
 	queryable, ok := app.cms.(storetypes.Queryable)
 	if !ok {
-		return queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "multi-store does not support queries"), app.trace)
+		return queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "multi-store does not support queries"), app.errorEncoder())
 	}
 
 	req.Path = "/" + strings.Join(path[1:], "/")
@@ -1061,13 +1078,13 @@ func handleQueryStore(app *BaseApp, path []string, req abci.QueryRequest) *abci.
 			errorsmod.Wrap(
 				sdkerrors.ErrInvalidRequest,
 				"cannot query with proof when height <= 1; please provide a valid height",
-			), app.trace)
+			), app.errorEncoder())
 	}
 
 	sdkReq := storetypes.RequestQuery(req)
 	resp, err := queryable.Query(&sdkReq)
 	if err != nil {
-		return queryResult(err, app.trace)
+		return queryResult(err, app.errorEncoder())
 	}
 	resp.Height = req.Height
 
@@ -1079,7 +1096,7 @@ func handleQueryStore(app *BaseApp, path []string, req abci.QueryRequest) *abci.
 func handleQueryP2P(app *BaseApp, path []string) *abci.QueryResponse {
 	// "/p2p" prefix for p2p queries
 	if len(path) < 4 {
-		return queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "path should be p2p filter <addr|id> <parameter>"), app.trace)
+		return queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "path should be p2p filter <addr|id> <parameter>"), app.errorEncoder())
 	}
 
 	var resp *abci.QueryResponse
@@ -1096,7 +1113,7 @@ func handleQueryP2P(app *BaseApp, path []string) *abci.QueryResponse {
 		}
 
 	default:
-		resp = queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "expected second parameter to be 'filter'"), app.trace)
+		resp = queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "expected second parameter to be 'filter'"), app.errorEncoder())
 	}
 
 	return resp
@@ -1152,12 +1169,12 @@ func (app *BaseApp) getContextForProposal(ctx sdk.Context, height int64) sdk.Con
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.QueryRequest) *abci.QueryResponse {
 	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
 	if err != nil {
-		return queryResult(err, app.trace)
+		return queryResult(err, app.errorEncoder())
 	}
 
 	resp, err := handler(ctx, req)
 	if err != nil {
-		resp = queryResult(gRPCErrorToSDKError(err), app.trace)
+		resp = queryResult(gRPCErrorToSDKError(err), app.errorEncoder())
 		resp.Height = req.Height
 		return resp
 	}
@@ -2757,3 +2757,74 @@ func TestABCI_Proposal_FailReCheckTx(t *testing.T) {
 	require.NotEmpty(t, res.TxResults[0].Events)
 	require.True(t, res.TxResults[0].IsOK(), fmt.Sprintf("%v", res))
 }
+
+func TestABCI_TxDecodeCache(t *testing.T) {
+	reject := false
+	suite := NewBaseAppSuite(t, baseapp.SetTxDecodeCacheSize(100), func(bapp *baseapp.BaseApp) {
+		bapp.SetProcessProposal(func(_ sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+			for _, txBytes := range req.Txs {
+				if _, err := bapp.ProcessProposalVerifyTx(txBytes); err != nil {
+					return nil, err
+				}
+			}
+			if reject {
+				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+			}
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+		})
+	})
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	decodes := 0
+	suite.baseApp.SetTxDecoder(func(txBytes []byte) (sdk.Tx, error) {
+		decodes++
+		return suite.txConfig.TxDecoder()(txBytes)
+	})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	txs := make([][]byte, 2)
+	for i := range txs {
+		txs[i], err = suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, int64(i), 0))
+		require.NoError(t, err)
+	}
+
+	checkTx := func() {
+		res, err := suite.baseApp.CheckTx(&abci.CheckTxRequest{Tx: txs[0], Type: abci.CHECK_TX_TYPE_CHECK})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	// the txs decoded in CheckTx are cached
+	checkTx()
+	checkTx()
+	require.Equal(t, 1, decodes)
+
+	// the txs of a rejected proposal are evicted
+	reject = true
+	res, err := suite.baseApp.ProcessProposal(&abci.ProcessProposalRequest{Height: 1, Txs: txs[1:]})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_REJECT, res.Status)
+	require.Equal(t, 2, decodes)
+
+	reject = false
+	res, err = suite.baseApp.ProcessProposal(&abci.ProcessProposalRequest{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, res.Status)
+	require.Equal(t, 3, decodes)
+
+	// FinalizeBlock reuses the txs decoded by the previous phases
+	finalizeRes, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	for _, txRes := range finalizeRes.TxResults {
+		require.True(t, txRes.IsOK(), txRes.Log)
+	}
+	require.Equal(t, 3, decodes)
+
+	// and evicts them once finalized
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	checkTx()
+	require.Equal(t, 4, decodes)
+}
@@ -79,6 +79,9 @@ type BaseApp struct {
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.PostHandler // post handler, optional
 
+	anteDecorators []string // names of the decorators of the ante handler, if set with SetAnteDecorators
+	postDecorators []string // names of the decorators of the post handler, if set with SetPostDecorators
+
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
 	preBlocker         sdk.PreBlocker                 // logic to run before BeginBlocker
 	beginBlocker       sdk.BeginBlocker               // (legacy ABCI) BeginBlock handler
@@ -170,6 +173,12 @@ type BaseApp struct {
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
 
+	// errorRedaction defines which information of the errors is returned in
+	// the ABCI responses, the errors of the codespaces of
+	// errorRedactionAllowlist are not redacted.
+	errorRedaction          ErrorRedaction
+	errorRedactionAllowlist map[string]struct{}
+
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
@@ -188,6 +197,10 @@ type BaseApp struct {
 
 	// includeNestedMsgsGas holds a set of message types for which gas costs for its nested messages are calculated.
 	includeNestedMsgsGas map[string]struct{}
+
+	// txDecodeCache caches the decoded txs across the ABCI phases, it is nil
+	// when disabled.
+	txDecodeCache *txDecodeCache
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -377,6 +390,11 @@ func (app *BaseApp) CommitMultiStore() storetypes.CommitMultiStore {
 	return app.cms
 }
 
+// StreamingManager returns the streaming manager of the BaseApp.
+func (app *BaseApp) StreamingManager() storetypes.StreamingManager {
+	return app.streamingManager
+}
+
 // SnapshotManager returns the snapshot manager.
 // application use this to register extra extension snapshotters.
 func (app *BaseApp) SnapshotManager() *snapshots.Manager {
@@ -661,8 +679,8 @@ func (app *BaseApp) getBlockGasMeter(ctx sdk.Context) storetypes.GasMeter {
 	return storetypes.NewInfiniteGasMeter()
 }
 
-// retrieve the context for the tx w/ txBytes and other memoized values.
-func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
+// retrieve the context for the tx w/ txBytes, gas meter and other memoized values.
+func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte, gasMeter storetypes.GasMeter) sdk.Context {
 	app.mu.Lock()
 	defer app.mu.Unlock()
 
@@ -672,7 +690,7 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 	}
 	ctx := modeState.Context().
 		WithTxBytes(txBytes).
-		WithGasMeter(storetypes.NewInfiniteGasMeter())
+		WithGasMeter(gasMeter)
 
 	ctx = ctx.WithIsSigverifyTx(app.sigverifyTx)
 
@@ -765,7 +783,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx, app.txDecodeCache.get(tx))
 	if err != nil {
 		resultStr = "failed"
 		resp = responseExecTxResultWithEvents(
@@ -773,7 +791,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 			gInfo.GasWanted,
 			gInfo.GasUsed,
 			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
-			app.trace,
+			app.errorEncoder(),
 		)
 		return resp
 	}
@@ -826,13 +844,18 @@ type HasNestedMsgs interface {
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	// The initial gas meter of the tx is pooled, as it is no longer referenced
+	// once the tx has run. Its release is deferred first so that it happens last.
+	gasMeter := acquireGasMeter()
+	defer releaseGasMeter(gasMeter)
+
+	ctx := app.getContextForTx(mode, txBytes, gasMeter)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -874,9 +897,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		defer consumeBlockGas()
 	}
 
-	tx, err := app.txDecoder(txBytes)
-	if err != nil {
-		return sdk.GasInfo{GasUsed: 0, GasWanted: 0}, nil, nil, sdkerrors.ErrTxDecode.Wrap(err.Error())
+	if tx == nil {
+		tx, err = app.txDecoder(txBytes)
+		if err != nil {
+			return sdk.GasInfo{GasUsed: 0, GasWanted: 0}, nil, nil, sdkerrors.ErrTxDecode.Wrap(err.Error())
+		}
 	}
 
 	msgs := tx.GetMsgs()
@@ -902,7 +927,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// writes do not happen if aborted/failed.  This may have some
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
-		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+		anteEventManager := sdk.AcquireEventManager()
+		defer sdk.ReleaseEventManager(anteEventManager)
+		anteCtx = anteCtx.WithEventManager(anteEventManager)
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
@@ -979,7 +1006,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// The runMsgCtx context currently contains events emitted by the ante handler.
 		// We clear this to correctly order events without duplicates.
 		// Note that the state is still preserved.
-		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		postEventManager := sdk.AcquireEventManager()
+		defer sdk.ReleaseEventManager(postEventManager)
+		postCtx := runMsgCtx.WithEventManager(postEventManager)
 
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
 		if errPostHandler != nil {
@@ -1164,7 +1193,9 @@ func (app *BaseApp) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
 		return nil, err
 	}
 
-	_, _, _, err = app.runTx(execModePrepareProposal, bz)
+	app.txDecodeCache.add(bz, tx)
+
+	_, _, _, err = app.runTx(execModePrepareProposal, bz, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1178,12 +1209,12 @@ func (app *BaseApp) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
 // returned if the transaction cannot be decoded. <Tx, nil> will be returned if
 // the transaction is valid, otherwise <Tx, err> will be returned.
 func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
-	tx, err := app.txDecoder(txBz)
+	tx, err := app.TxDecode(txBz)
 	if err != nil {
 		return nil, err
 	}
 
-	_, _, _, err = app.runTx(execModeProcessProposal, txBz)
+	_, _, _, err = app.runTx(execModeProcessProposal, txBz, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1191,8 +1222,14 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 	return tx, nil
 }
 
+// TxDecode decodes a tx, reusing the decoded tx cached by the previous ABCI
+// phases if any.
 func (app *BaseApp) TxDecode(txBytes []byte) (sdk.Tx, error) {
-	return app.txDecoder(txBytes)
+	if app.txDecodeCache == nil {
+		return app.txDecoder(txBytes)
+	}
+
+	return app.txDecodeCache.decode(app.txDecoder, txBytes)
 }
 
 func (app *BaseApp) TxEncode(tx sdk.Tx) ([]byte, error) {
@@ -0,0 +1,85 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupBenchmarkApp returns an app running txs with a single counter message
+// through a minimal ante handler, along with the bytes of such a tx.
+func setupBenchmarkApp(b *testing.B) (*baseapp.BaseApp, []byte) {
+	b.Helper()
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	app := baseapp.NewBaseApp(b.Name(), log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder())
+	app.SetInterfaceRegistry(cdc.InterfaceRegistry())
+	app.MsgServiceRouter().SetInterfaceRegistry(cdc.InterfaceRegistry())
+	app.MountStores(capKey1)
+	app.SetParamStore(paramStore{db: dbm.NewMemDB()})
+	app.SetAnteHandler(func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent("ante"))
+		return ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000)), nil
+	})
+	baseapptestutil.RegisterCounterServer(app.MsgServiceRouter(), NoopCounterServerImpl{})
+	require.NoError(b, app.LoadLatestVersion())
+
+	_, err := app.InitChain(&abci.InitChainRequest{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(b, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	builder := txConfig.NewTxBuilder()
+	require.NoError(b, builder.SetMsgs(&baseapptestutil.MsgCounter{Signer: addr.String()}))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(b, err)
+
+	return app, txBytes
+}
+
+func BenchmarkCheckTx(b *testing.B) {
+	app, txBytes := setupBenchmarkApp(b)
+	req := &abci.CheckTxRequest{Tx: txBytes, Type: abci.CHECK_TX_TYPE_CHECK}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		res, err := app.CheckTx(req)
+		if err != nil || !res.IsOK() {
+			b.Fatal(err, res.Log)
+		}
+	}
+}
+
+func BenchmarkFinalizeBlockTx(b *testing.B) {
+	app, txBytes := setupBenchmarkApp(b)
+	txs := make([][]byte, b.N)
+	for i := range txs {
+		txs[i] = txBytes
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	res, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: txs})
+	require.NoError(b, err)
+	b.StopTimer()
+
+	for _, txRes := range res.TxResults {
+		require.True(b, txRes.IsOK(), txRes.Log)
+	}
+}
@@ -0,0 +1,191 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAnteDecorators sets the AnteHandler of the app to the chain of the given
+// decorators, see sdk.ChainAnteDecorators. Unlike SetAnteHandler, the decorators
+// can be enumerated with AnteDecorators and each of them emits its own
+// execution time and gas consumption, excluding the ones of the decorators it
+// wraps, labeled by decorator.
+func (app *BaseApp) SetAnteDecorators(decorators ...sdk.AnteDecorator) {
+	if app.sealed {
+		panic("SetAnteDecorators() on sealed BaseApp")
+	}
+
+	names := make([]string, len(decorators))
+	chain := make([]sdk.AnteDecorator, len(decorators))
+	for i, decorator := range decorators {
+		names[i] = decoratorName(decorator)
+		chain[i] = measuredAnteDecorator{name: names[i], decorator: decorator}
+	}
+
+	app.anteHandler = sdk.ChainAnteDecorators(chain...)
+	app.anteDecorators = names
+}
+
+// SetPostDecorators sets the PostHandler of the app to the chain of the given
+// decorators, see sdk.ChainPostDecorators. Like SetAnteDecorators, the
+// decorators can be enumerated with PostDecorators and emit their own metrics.
+func (app *BaseApp) SetPostDecorators(decorators ...sdk.PostDecorator) {
+	if app.sealed {
+		panic("SetPostDecorators() on sealed BaseApp")
+	}
+
+	names := make([]string, len(decorators))
+	chain := make([]sdk.PostDecorator, len(decorators))
+	for i, decorator := range decorators {
+		names[i] = decoratorName(decorator)
+		chain[i] = measuredPostDecorator{name: names[i], decorator: decorator}
+	}
+
+	app.postHandler = sdk.ChainPostDecorators(chain...)
+	app.postDecorators = names
+}
+
+// AnteDecorators returns the names of the decorators composing the AnteHandler
+// of the app, from the outermost to the innermost one. It returns nil if the
+// AnteHandler was not set with SetAnteDecorators.
+func (app *BaseApp) AnteDecorators() []string {
+	return append([]string(nil), app.anteDecorators...)
+}
+
+// PostDecorators returns the names of the decorators composing the PostHandler
+// of the app, from the outermost to the innermost one. It returns nil if the
+// PostHandler was not set with SetPostDecorators.
+func (app *BaseApp) PostDecorators() []string {
+	return append([]string(nil), app.postDecorators...)
+}
+
+// decoratorName returns the name of the type of the decorator, e.g.
+// ante.SetUpContextDecorator.
+func decoratorName(decorator interface{}) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", decorator), "*")
+}
+
+// measuredAnteDecorator wraps an AnteDecorator to emit its execution time and
+// gas consumption. Simulated transactions are not measured.
+type measuredAnteDecorator struct {
+	name      string
+	decorator sdk.AnteDecorator
+}
+
+func (d measuredAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !telemetry.IsTelemetryEnabled() || ctx.ExecMode() == sdk.ExecModeSimulate {
+		return d.decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	var m decoratorMeasure
+	m.start(ctx)
+	newCtx, err := d.decorator.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		m.startNext(ctx)
+		newCtx, err := next(ctx, tx, simulate)
+		m.endNext(newCtx)
+		return newCtx, err
+	})
+	m.end(newCtx, "ante", d.name, err)
+
+	return newCtx, err
+}
+
+// measuredPostDecorator wraps a PostDecorator to emit its execution time and
+// gas consumption. Simulated transactions are not measured.
+type measuredPostDecorator struct {
+	name      string
+	decorator sdk.PostDecorator
+}
+
+func (d measuredPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !telemetry.IsTelemetryEnabled() || ctx.ExecMode() == sdk.ExecModeSimulate {
+		return d.decorator.PostHandle(ctx, tx, simulate, success, next)
+	}
+
+	var m decoratorMeasure
+	m.start(ctx)
+	newCtx, err := d.decorator.PostHandle(ctx, tx, simulate, success, func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+		m.startNext(ctx)
+		newCtx, err := next(ctx, tx, simulate, success)
+		m.endNext(newCtx)
+		return newCtx, err
+	})
+	m.end(newCtx, "post", d.name, err)
+
+	return newCtx, err
+}
+
+// decoratorMeasure measures the execution time and gas consumption of a
+// decorator, excluding the ones of the next handler of the chain.
+type decoratorMeasure struct {
+	startTime     time.Time
+	startGasMeter storetypes.GasMeter
+	startGas      uint64
+
+	nextStartTime time.Time
+	nextGasMeter  storetypes.GasMeter
+	nextStartGas  uint64
+	nextTime      time.Duration
+	nextGas       uint64
+}
+
+func (m *decoratorMeasure) start(ctx sdk.Context) {
+	m.startTime = time.Now()
+	m.startGasMeter, m.startGas = gasConsumed(ctx)
+}
+
+func (m *decoratorMeasure) startNext(ctx sdk.Context) {
+	m.nextStartTime = time.Now()
+	m.nextGasMeter, m.nextStartGas = gasConsumed(ctx)
+}
+
+func (m *decoratorMeasure) endNext(ctx sdk.Context) {
+	m.nextTime = time.Since(m.nextStartTime)
+	m.nextGas = gasConsumedSince(ctx, m.nextGasMeter, m.nextStartGas)
+}
+
+func (m *decoratorMeasure) end(ctx sdk.Context, handler, name string, err error) {
+	var gas uint64
+	if total := gasConsumedSince(ctx, m.startGasMeter, m.startGas); total > m.nextGas {
+		gas = total - m.nextGas
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel("decorator", name),
+		telemetry.NewLabel("success", strconv.FormatBool(err == nil)),
+	}
+	telemetry.MeasureSinceWithLabels([]string{handler, "decorator", "time"}, m.startTime.Add(m.nextTime), labels)
+	telemetry.IncrCounterWithLabels([]string{handler, "decorator", "gas"}, float32(gas), labels)
+}
+
+// gasConsumed returns the gas meter of the context and the gas it consumed.
+func gasConsumed(ctx sdk.Context) (storetypes.GasMeter, uint64) {
+	if ctx.GasMeter() == nil {
+		return nil, 0
+	}
+	return ctx.GasMeter(), ctx.GasMeter().GasConsumed()
+}
+
+// gasConsumedSince returns the gas consumed by the gas meter of the context
+// since it consumed gas with the given gas meter. All the gas consumed by the
+// gas meter of the context is returned if it replaced the given gas meter.
+func gasConsumedSince(ctx sdk.Context, gasMeter storetypes.GasMeter, gas uint64) uint64 {
+	meter, consumed := gasConsumed(ctx)
+	switch {
+	case meter != gasMeter:
+		return consumed
+	case consumed < gas:
+		return 0
+	default:
+		return consumed - gas
+	}
+}
@@ -0,0 +1,127 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setUpGasDecorator sets a new gas meter before consuming gas, like the
+// SetUpContextDecorator of x/auth.
+type setUpGasDecorator struct{}
+
+func (setUpGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1000))
+	ctx.GasMeter().ConsumeGas(10, "set up")
+	return next(ctx, tx, simulate)
+}
+
+// consumeGasDecorator consumes gas before and after calling the next handler.
+type consumeGasDecorator struct{}
+
+func (consumeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(20, "before")
+	newCtx, err := next(ctx, tx, simulate)
+	newCtx.GasMeter().ConsumeGas(5, "after")
+	return newCtx, err
+}
+
+type failingDecorator struct{}
+
+func (*failingDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(30, "fail")
+	return ctx, errors.New("failed")
+}
+
+type noopPostDecorator struct{}
+
+func (noopPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate, success)
+}
+
+func TestSetAnteDecorators(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{
+		MetricsSink: telemetry.MetricSinkInMem,
+		Enabled:     true,
+		ServiceName: "test",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { telemetry.SetTelemetryEnabled(false) })
+
+	app := &BaseApp{}
+	app.SetAnteDecorators(setUpGasDecorator{}, consumeGasDecorator{}, &failingDecorator{})
+	app.SetPostDecorators(noopPostDecorator{})
+	require.Equal(t, []string{"baseapp.setUpGasDecorator", "baseapp.consumeGasDecorator", "baseapp.failingDecorator"}, app.AnteDecorators())
+	require.Equal(t, []string{"baseapp.noopPostDecorator"}, app.PostDecorators())
+
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger()).WithExecMode(sdk.ExecModeFinalize)
+	newCtx, err := app.anteHandler(ctx, nil, false)
+	require.Error(t, err)
+	require.Equal(t, uint64(65), newCtx.GasMeter().GasConsumed())
+
+	// simulated transactions are not measured
+	_, err = app.anteHandler(ctx.WithExecMode(sdk.ExecModeSimulate), nil, true)
+	require.Error(t, err)
+
+	_, err = app.postHandler(ctx, nil, false, true)
+	require.NoError(t, err)
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+
+	var summary struct {
+		Counters []struct {
+			Name   string
+			Count  int
+			Sum    float64
+			Labels map[string]string
+		}
+		Samples []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+
+	gas := map[string]float64{}
+	for _, c := range summary.Counters {
+		if c.Name == "test.ante.decorator.gas" {
+			require.Equal(t, 1, c.Count)
+			require.Equal(t, "false", c.Labels["success"])
+			gas[c.Labels["decorator"]] = c.Sum
+		}
+	}
+	require.Equal(t, map[string]float64{
+		"baseapp.setUpGasDecorator":   10,
+		"baseapp.consumeGasDecorator": 25,
+		"baseapp.failingDecorator":    30,
+	}, gas)
+
+	samples := map[string]int{}
+	for _, s := range summary.Samples {
+		if s.Name == "test.ante.decorator.time" || s.Name == "test.post.decorator.time" {
+			samples[s.Labels["decorator"]] += s.Count
+		}
+	}
+	require.Equal(t, map[string]int{
+		"baseapp.setUpGasDecorator":   1,
+		"baseapp.consumeGasDecorator": 1,
+		"baseapp.failingDecorator":    1,
+		"baseapp.noopPostDecorator":   1,
+	}, samples)
+
+	// the decorators can no longer be enumerated once the handlers are replaced
+	app.SetAnteHandler(nil)
+	app.SetPostHandler(nil)
+	require.Nil(t, app.AnteDecorators())
+	require.Nil(t, app.PostDecorators())
+}
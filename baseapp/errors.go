@@ -1,15 +1,82 @@
 package baseapp
 
 import (
+	"fmt"
+
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 
 	errorsmod "cosmossdk.io/errors"
 )
 
+// ErrorRedaction defines which information of the errors is returned in the
+// ABCI responses of CheckTx, FinalizeBlock and Query.
+//
+// The code of an error is never redacted, as it is part of the results of the
+// txs agreed on by consensus. The errors of the allowlisted codespaces set with
+// SetErrorRedaction are never redacted, so that private networks can expose
+// the errors of their own modules while redacting the others.
+type ErrorRedaction string
+
+const (
+	// ErrorRedactionNone returns the codespace, code and message of the errors.
+	ErrorRedactionNone ErrorRedaction = "none"
+	// ErrorRedactionCode returns the codespace and code of the errors, but not
+	// their message.
+	ErrorRedactionCode ErrorRedaction = "code"
+	// ErrorRedactionFull returns only the code of the errors.
+	ErrorRedactionFull ErrorRedaction = "full"
+)
+
+// Validate returns an error if the ErrorRedaction is unknown, the empty
+// ErrorRedaction is ErrorRedactionNone.
+func (r ErrorRedaction) Validate() error {
+	switch r {
+	case "", ErrorRedactionNone, ErrorRedactionCode, ErrorRedactionFull:
+		return nil
+	default:
+		return fmt.Errorf("unknown error redaction %q, expected one of %q, %q or %q", string(r), ErrorRedactionNone, ErrorRedactionCode, ErrorRedactionFull)
+	}
+}
+
+// abciErrorEncoder encodes the errors of the ABCI responses.
+type abciErrorEncoder struct {
+	// debug encodes the messages with a stack trace
+	debug     bool
+	redaction ErrorRedaction
+	// allowlist are the codespaces whose errors are not redacted
+	allowlist map[string]struct{}
+}
+
+// errorEncoder returns the encoder of the errors of the ABCI responses of app.
+func (app *BaseApp) errorEncoder() abciErrorEncoder {
+	return abciErrorEncoder{debug: app.trace, redaction: app.errorRedaction, allowlist: app.errorRedactionAllowlist}
+}
+
+// abciInfo returns the codespace, code and log of err, redacted unless its
+// codespace is allowlisted.
+func (e abciErrorEncoder) abciInfo(err error) (space string, code uint32, log string) {
+	space, code, log = errorsmod.ABCIInfo(err, e.debug)
+	if code == errorsmod.SuccessABCICode {
+		return space, code, log
+	}
+	if _, ok := e.allowlist[space]; ok {
+		return space, code, log
+	}
+
+	switch e.redaction {
+	case ErrorRedactionCode:
+		return space, code, fmt.Sprintf("error redacted: codespace %s, code %d", space, code)
+	case ErrorRedactionFull:
+		return "", code, "error redacted"
+	default:
+		return space, code, log
+	}
+}
+
 // responseCheckTxWithEvents returns an ABCI ResponseCheckTx object with fields filled in
 // from the given error, gas values and events.
-func responseCheckTxWithEvents(err error, gw, gu uint64, events []abci.Event, debug bool) *abci.CheckTxResponse {
-	space, code, log := errorsmod.ABCIInfo(err, debug)
+func responseCheckTxWithEvents(err error, gw, gu uint64, events []abci.Event, enc abciErrorEncoder) *abci.CheckTxResponse {
+	space, code, log := enc.abciInfo(err)
 	return &abci.CheckTxResponse{
 		Codespace: space,
 		Code:      code,
@@ -22,8 +89,8 @@ func responseCheckTxWithEvents(err error, gw, gu uint64, events []abci.Event, de
 
 // responseExecTxResultWithEvents returns an ABCI ExecTxResult object with fields
 // filled in from the given error, gas values and events.
-func responseExecTxResultWithEvents(err error, gw, gu uint64, events []abci.Event, debug bool) *abci.ExecTxResult {
-	space, code, log := errorsmod.ABCIInfo(err, debug)
+func responseExecTxResultWithEvents(err error, gw, gu uint64, events []abci.Event, enc abciErrorEncoder) *abci.ExecTxResult {
+	space, code, log := enc.abciInfo(err)
 	return &abci.ExecTxResult{
 		Codespace: space,
 		Code:      code,
@@ -36,8 +103,8 @@ func responseExecTxResultWithEvents(err error, gw, gu uint64, events []abci.Even
 
 // queryResult returns a ResponseQuery from an error. It will try to parse ABCI
 // info from the error.
-func queryResult(err error, debug bool) *abci.QueryResponse {
-	space, code, log := errorsmod.ABCIInfo(err, debug)
+func queryResult(err error, enc abciErrorEncoder) *abci.QueryResponse {
+	space, code, log := enc.abciInfo(err)
 	return &abci.QueryResponse{
 		Codespace: space,
 		Code:      code,
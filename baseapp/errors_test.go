@@ -0,0 +1,54 @@
+package baseapp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestABCIErrorEncoder(t *testing.T) {
+	errFunds := sdkerrors.ErrInsufficientFunds.Wrap("1stake is smaller than 2stake")
+	errCustom := errorsmod.Register("mymodule", 2, "custom error").Wrap("details")
+	errInternal := errors.New("internal details")
+
+	type info struct {
+		space string
+		code  uint32
+		log   string
+	}
+	tests := []struct {
+		name      string
+		redaction ErrorRedaction
+		allowlist []string
+		err       error
+		exp       info
+	}{
+		{"none", ErrorRedactionNone, nil, errFunds, info{"sdk", 5, errFunds.Error()}},
+		{"unset", "", nil, errInternal, info{"undefined", 1, "internal details"}},
+		{"code", ErrorRedactionCode, nil, errFunds, info{"sdk", 5, "error redacted: codespace sdk, code 5"}},
+		{"code of an internal error", ErrorRedactionCode, nil, errInternal, info{"undefined", 1, "error redacted: codespace undefined, code 1"}},
+		{"full", ErrorRedactionFull, nil, errFunds, info{"", 5, "error redacted"}},
+		{"allowlisted", ErrorRedactionFull, []string{"mymodule"}, errCustom, info{"mymodule", 2, errCustom.Error()}},
+		{"not allowlisted", ErrorRedactionFull, []string{"mymodule"}, errFunds, info{"", 5, "error redacted"}},
+		{"success", ErrorRedactionFull, nil, nil, info{"", 0, ""}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app := &BaseApp{}
+			app.SetErrorRedaction(tc.redaction, tc.allowlist)
+
+			space, code, log := app.errorEncoder().abciInfo(tc.err)
+			require.Equal(t, tc.exp, info{space, code, log})
+
+			res := responseExecTxResultWithEvents(tc.err, 1, 2, nil, app.errorEncoder())
+			require.Equal(t, tc.exp, info{res.Codespace, res.Code, res.Log})
+		})
+	}
+
+	require.Panics(t, func() { (&BaseApp{}).SetErrorRedaction("partial", nil) })
+}
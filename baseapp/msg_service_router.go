@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/runtime/protoiface"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -191,10 +195,15 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		)
 	}
 
-	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msr.routes[requestTypeName] = withTelemetry(requestTypeName, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		// the events are converted to ABCI events before the event manager is released
+		eventManager := sdk.AcquireEventManager()
+		defer sdk.ReleaseEventManager(eventManager)
+		ctx = ctx.WithEventManager(eventManager)
+
+		// goCtx is ctx itself, which the handlers unwrap with sdk.UnwrapSDKContext,
+		// so it is not wrapped again in a context value.
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 			return handler(goCtx, msg)
 		}
 
@@ -242,10 +251,35 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			Events:       events,
 			MsgResponses: []*codectypes.Any{anyResp},
 		}, nil
-	}
+	})
 	return nil
 }
 
+// withTelemetry wraps the handler of the messages of the given type to emit
+// their count and execution time, labeled by message type and result code.
+// Simulated messages are not measured.
+func withTelemetry(msgTypeURL string, handler MsgServiceHandler) MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if !telemetry.IsTelemetryEnabled() || ctx.ExecMode() == sdk.ExecModeSimulate {
+			return handler(ctx, msg)
+		}
+
+		start := time.Now()
+		res, err := handler(ctx, msg)
+
+		codespace, code, _ := errorsmod.ABCIInfo(err, false)
+		labels := []metrics.Label{
+			telemetry.NewLabel("msg_type", msgTypeURL),
+			telemetry.NewLabel("codespace", codespace),
+			telemetry.NewLabel("code", strconv.FormatUint(uint64(code), 10)),
+		}
+		telemetry.IncrCounterWithLabels([]string{"tx", "msg", "count"}, 1, labels)
+		telemetry.MeasureSinceWithLabels([]string{"tx", "msg", "time"}, start, labels)
+
+		return res, err
+	}
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -13,11 +14,14 @@ import (
 	authsigning "cosmossdk.io/x/auth/signing"
 	authtx "cosmossdk.io/x/auth/tx"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -201,3 +205,72 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code, "res=%+v", res)
 }
+
+type denyCircuitBreaker struct{}
+
+func (denyCircuitBreaker) IsAllowed(context.Context, string) (bool, error) { return false, nil }
+
+func TestMsgServiceTelemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{
+		MetricsSink: telemetry.MetricSinkInMem,
+		Enabled:     true,
+		ServiceName: "test",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { telemetry.SetTelemetryEnabled(false) })
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(testdata.NewTestInterfaceRegistry())
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := router.Handler(msg)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger()).WithExecMode(sdk.ExecModeFinalize)
+
+	for i := 0; i < 2; i++ {
+		_, err = handler(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// simulated messages are not measured
+	_, err = handler(ctx.WithExecMode(sdk.ExecModeSimulate), msg)
+	require.NoError(t, err)
+
+	router.SetCircuit(denyCircuitBreaker{})
+	_, err = handler(ctx, msg)
+	require.Error(t, err)
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+
+	var summary struct {
+		Counters []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+		Samples []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+
+	counts := map[string]int{}
+	for _, c := range summary.Counters {
+		if c.Name == "test.tx.msg.count" {
+			require.Equal(t, "/testpb.MsgCreateDog", c.Labels["msg_type"])
+			counts[c.Labels["code"]] += c.Count
+		}
+	}
+	require.Equal(t, map[string]int{"0": 2, "1": 1}, counts)
+
+	var samples int
+	for _, s := range summary.Samples {
+		if s.Name == "test.tx.msg.time" {
+			samples += s.Count
+		}
+	}
+	require.Equal(t, 3, samples)
+}
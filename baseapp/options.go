@@ -86,6 +86,16 @@ func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLDisableFastNode(disable) }
 }
 
+// SetCommitConcurrency sets the number of stores hashed and committed
+// concurrently, if the commit multi-store supports it.
+func SetCommitConcurrency(concurrency int) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if cms, ok := bapp.cms.(interface{ SetCommitConcurrency(int) }); ok {
+			cms.SetCommitConcurrency(concurrency)
+		}
+	}
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -132,6 +142,18 @@ func SetIncludeNestedMsgsGas(msgs []sdk.Msg) func(*BaseApp) {
 	}
 }
 
+// SetTxDecodeCacheSize sets the maximum number of txs whose decoding is cached
+// across the ABCI phases.
+func SetTxDecodeCacheSize(maxTxs int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxDecodeCacheSize(maxTxs) }
+}
+
+// SetErrorRedaction sets which information of the errors is returned in the
+// ABCI responses, the errors of the allowlisted codespaces are not redacted.
+func SetErrorRedaction(redaction ErrorRedaction, allowlist []string) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetErrorRedaction(redaction, allowlist) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -252,6 +274,7 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	}
 
 	app.anteHandler = ah
+	app.anteDecorators = nil
 }
 
 func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
@@ -260,6 +283,7 @@ func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
 	}
 
 	app.postHandler = ph
+	app.postDecorators = nil
 }
 
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
@@ -352,6 +376,46 @@ func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	app.mempool = mempool
 }
 
+// SetErrorRedaction sets which information of the errors is returned in the
+// ABCI responses of CheckTx, FinalizeBlock and Query. The errors of the
+// allowlisted codespaces are returned in full whatever the redaction.
+func (app *BaseApp) SetErrorRedaction(redaction ErrorRedaction, allowlist []string) {
+	if app.sealed {
+		panic("SetErrorRedaction() on sealed BaseApp")
+	}
+	if err := redaction.Validate(); err != nil {
+		panic(err)
+	}
+
+	app.errorRedaction = redaction
+	app.errorRedactionAllowlist = make(map[string]struct{}, len(allowlist))
+	for _, codespace := range allowlist {
+		app.errorRedactionAllowlist[codespace] = struct{}{}
+	}
+}
+
+// SetTxDecodeCacheSize sets the maximum number of txs whose decoding is cached.
+// The txs decoded in CheckTx, PrepareProposal and ProcessProposal are cached by
+// hash and reused in the next phases instead of being decoded again. They are
+// evicted once finalized, when their proposal is rejected, or when the cache is
+// full. 0 disables the cache.
+//
+// The cached txs are shared across the phases, so the ante handlers, the post
+// handlers and the msg handlers must not modify them when the cache is enabled.
+func (app *BaseApp) SetTxDecodeCacheSize(maxTxs int) {
+	if app.sealed {
+		panic("SetTxDecodeCacheSize() on sealed BaseApp")
+	}
+	if maxTxs < 0 {
+		panic("tx decode cache size must not be negative")
+	}
+
+	app.txDecodeCache = nil
+	if maxTxs > 0 {
+		app.txDecodeCache = newTxDecodeCache(maxTxs)
+	}
+}
+
 // SetProcessProposal sets the process proposal function for the BaseApp.
 func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	if app.sealed {
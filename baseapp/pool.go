@@ -0,0 +1,28 @@
+package baseapp
+
+import (
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// gasMeterPool is a pool of infinite gas meters, used as the initial gas meters
+// of the txs, reused across the executions of txs to reduce memory allocations.
+var gasMeterPool = sync.Pool{
+	New: func() interface{} {
+		return storetypes.NewInfiniteGasMeter()
+	},
+}
+
+// acquireGasMeter returns an infinite gas meter from the pool. It must be
+// released with releaseGasMeter once it is no longer referenced.
+func acquireGasMeter() storetypes.GasMeter {
+	return gasMeterPool.Get().(storetypes.GasMeter)
+}
+
+// releaseGasMeter resets a gas meter returned by acquireGasMeter and returns it
+// to the pool.
+func releaseGasMeter(meter storetypes.GasMeter) {
+	meter.RefundGas(meter.GasConsumed(), "reset")
+	gasMeterPool.Put(meter)
+}
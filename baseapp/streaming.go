@@ -2,9 +2,14 @@ package baseapp
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	"github.com/spf13/cast"
@@ -150,17 +155,132 @@ func (p listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.Finaliz
 	if p.listener.StartBlock != nil {
 		err := p.listener.StartBlock(appdata.StartBlockData{
 			Height: uint64(req.Height),
+			HeaderJSON: func() (json.RawMessage, error) {
+				return json.Marshal(blockHeaderJSON{
+					Height:          req.Height,
+					Time:            req.Time,
+					Hash:            strings.ToUpper(hex.EncodeToString(req.Hash)),
+					ProposerAddress: strings.ToUpper(hex.EncodeToString(req.ProposerAddress)),
+				})
+			},
 		})
 		if err != nil {
 			return err
 		}
 	}
 
-	//// TODO txs, events
+	if p.listener.OnTx != nil {
+		for i, txBytes := range req.Txs {
+			txBytes := txBytes
+			var result *abci.ExecTxResult
+			if i < len(res.TxResults) {
+				result = res.TxResults[i]
+			}
+			err := p.listener.OnTx(appdata.TxData{
+				TxIndex: int32(i),
+				Bytes:   func() ([]byte, error) { return txBytes, nil },
+				JSON: func() (json.RawMessage, error) {
+					return json.Marshal(newTxResultJSON(txBytes, result))
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if p.listener.OnEvent != nil {
+		for i, event := range res.Events {
+			// the block events are emitted at the beginning of the block, unless
+			// their mode is EndBlock
+			txIndex := int32(-1)
+			if eventAttribute(event, "mode") == "EndBlock" {
+				txIndex = -2
+			}
+			if err := p.onEvent(txIndex, uint32(i), event); err != nil {
+				return err
+			}
+		}
+		for txIndex, result := range res.TxResults {
+			for i, event := range result.Events {
+				if err := p.onEvent(int32(txIndex), uint32(i), event); err != nil {
+					return err
+				}
+			}
+		}
+	}
 
 	return nil
 }
 
+func (p listenerWrapper) onEvent(txIndex int32, eventIndex uint32, event abci.Event) error {
+	msgIndex, _ := strconv.ParseUint(eventAttribute(event, "msg_index"), 10, 32)
+	return p.listener.OnEvent(appdata.EventData{
+		TxIndex:    txIndex,
+		MsgIndex:   uint32(msgIndex),
+		EventIndex: eventIndex,
+		Type:       event.Type,
+		Data: func() (json.RawMessage, error) {
+			data := eventJSON{Attributes: make([]eventAttributeJSON, len(event.Attributes))}
+			for i, attr := range event.Attributes {
+				data.Attributes[i] = eventAttributeJSON{Key: attr.Key, Value: attr.Value}
+			}
+			return json.Marshal(data)
+		},
+	})
+}
+
+// eventAttribute returns the value of the attribute of the event with the key,
+// or an empty string if it has none.
+func eventAttribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// blockHeaderJSON is the JSON representation of the header of the blocks passed
+// to the indexer listeners.
+type blockHeaderJSON struct {
+	Height          int64     `json:"height"`
+	Time            time.Time `json:"time"`
+	Hash            string    `json:"hash"`
+	ProposerAddress string    `json:"proposer_address"`
+}
+
+// txResultJSON is the JSON representation of the txs passed to the indexer
+// listeners, their hash and the result of their execution.
+type txResultJSON struct {
+	Hash      string `json:"hash"`
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace,omitempty"`
+	GasWanted int64  `json:"gas_wanted"`
+	GasUsed   int64  `json:"gas_used"`
+}
+
+func newTxResultJSON(txBytes []byte, result *abci.ExecTxResult) txResultJSON {
+	hash := sha256.Sum256(txBytes)
+	res := txResultJSON{Hash: strings.ToUpper(hex.EncodeToString(hash[:]))}
+	if result != nil {
+		res.Code, res.Codespace = result.Code, result.Codespace
+		res.GasWanted, res.GasUsed = result.GasWanted, result.GasUsed
+	}
+	return res
+}
+
+// eventJSON is the JSON representation of the events passed to the indexer
+// listeners. The attributes are a list, as their keys are not unique.
+type eventJSON struct {
+	Attributes []eventAttributeJSON `json:"attributes"`
+}
+
+type eventAttributeJSON struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 func (p listenerWrapper) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
 	if cb := p.listener.OnKVPair; cb != nil {
 		updates := make([]appdata.ModuleKVPairUpdate, len(changeSet))
@@ -0,0 +1,96 @@
+package baseapp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestListenerWrapperFinalizeBlock(t *testing.T) {
+	var (
+		block  appdata.StartBlockData
+		txs    []appdata.TxData
+		events []appdata.EventData
+	)
+	wrapper := listenerWrapper{listener: appdata.Listener{
+		StartBlock: func(data appdata.StartBlockData) error {
+			block = data
+			return nil
+		},
+		OnTx: func(data appdata.TxData) error {
+			txs = append(txs, data)
+			return nil
+		},
+		OnEvent: func(data appdata.EventData) error {
+			events = append(events, data)
+			return nil
+		},
+	}}
+
+	blockTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	req := abci.FinalizeBlockRequest{
+		Height: 3,
+		Time:   blockTime,
+		Hash:   []byte{0xab},
+		Txs:    [][]byte{[]byte("tx0"), []byte("tx1")},
+	}
+	res := abci.FinalizeBlockResponse{
+		Events: []abci.Event{
+			{Type: "mint", Attributes: []abci.EventAttribute{{Key: "mode", Value: "BeginBlock"}}},
+			{Type: "complete_unbonding", Attributes: []abci.EventAttribute{{Key: "mode", Value: "EndBlock"}}},
+		},
+		TxResults: []*abci.ExecTxResult{
+			{GasWanted: 10, GasUsed: 5, Events: []abci.Event{
+				{Type: "tx", Attributes: []abci.EventAttribute{{Key: "fee", Value: "1stake"}}},
+				{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: "cosmos1abc"}, {Key: "msg_index", Value: "1"}}},
+			}},
+			{Code: 5, Codespace: "sdk"},
+		},
+	}
+	require.NoError(t, wrapper.ListenFinalizeBlock(context.Background(), req, res))
+
+	require.Equal(t, uint64(3), block.Height)
+	header, err := block.HeaderJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"height":3,"time":"2024-01-02T15:04:05Z","hash":"AB","proposer_address":""}`, string(header))
+
+	require.Len(t, txs, 2)
+	bz, err := txs[1].Bytes()
+	require.NoError(t, err)
+	require.Equal(t, []byte("tx1"), bz)
+	txJSON, err := txs[1].JSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"hash":"709B55BD3DA0F5A838125BD0EE20C5BFDD7CABA173912D4281CAE816B79A201B","code":5,"codespace":"sdk","gas_wanted":0,"gas_used":0}`, string(txJSON))
+
+	require.Len(t, events, 4)
+	for i, expected := range []struct {
+		txIndex    int32
+		msgIndex   uint32
+		eventIndex uint32
+		typ        string
+	}{
+		{-1, 0, 0, "mint"},
+		{-2, 0, 1, "complete_unbonding"},
+		{0, 0, 0, "tx"},
+		{0, 1, 1, "transfer"},
+	} {
+		require.Equal(t, expected.txIndex, events[i].TxIndex)
+		require.Equal(t, expected.msgIndex, events[i].MsgIndex)
+		require.Equal(t, expected.eventIndex, events[i].EventIndex)
+		require.Equal(t, expected.typ, events[i].Type)
+	}
+	data, err := events[3].Data()
+	require.NoError(t, err)
+	var eventData struct {
+		Attributes []struct{ Key, Value string }
+	}
+	require.NoError(t, json.Unmarshal(data, &eventData))
+	require.Len(t, eventData.Attributes, 2)
+	require.Equal(t, "cosmos1abc", eventData.Attributes[0].Value)
+}
@@ -0,0 +1,97 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txDecodeCache caches the txs decoded in CheckTx, PrepareProposal and
+// ProcessProposal by hash, so that the next ABCI phases reuse them instead of
+// decoding the same bytes again. The cache is bounded by the number of cached
+// txs, evicting the least recently used txs first. A nil txDecodeCache caches
+// nothing.
+//
+// The cached txs are shared by all the phases decoding the same bytes, possibly
+// concurrently, so they must be treated as immutable: the ante handlers, the
+// post handlers and the msg handlers must not modify the txs nor their msgs.
+type txDecodeCache struct {
+	mtx   sync.Mutex
+	cache *simplelru.LRU // [sha256.Size]byte -> sdk.Tx
+}
+
+func newTxDecodeCache(maxTxs int) *txDecodeCache {
+	cache, err := simplelru.NewLRU(maxTxs, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return &txDecodeCache{cache: cache}
+}
+
+// decode returns the cached tx of txBytes, or decodes it with decoder and
+// caches it.
+func (c *txDecodeCache) decode(decoder sdk.TxDecoder, txBytes []byte) (sdk.Tx, error) {
+	if tx := c.get(txBytes); tx != nil {
+		return tx, nil
+	}
+
+	tx, err := decoder(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	c.add(txBytes, tx)
+	return tx, nil
+}
+
+// get returns the cached tx of txBytes, or nil if it is not cached.
+func (c *txDecodeCache) get(txBytes []byte) sdk.Tx {
+	if c == nil {
+		return nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if tx, ok := c.cache.Get(sha256.Sum256(txBytes)); ok {
+		return tx.(sdk.Tx)
+	}
+
+	return nil
+}
+
+// add caches tx as the decoded tx of txBytes, evicting the least recently used
+// tx if the cache is full.
+func (c *txDecodeCache) add(txBytes []byte, tx sdk.Tx) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := sha256.Sum256(txBytes)
+	if c.cache.Contains(key) {
+		return
+	}
+
+	c.cache.Add(key, tx)
+}
+
+// remove removes the txs from the cache.
+func (c *txDecodeCache) remove(txs [][]byte) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, txBytes := range txs {
+		c.cache.Remove(sha256.Sum256(txBytes))
+	}
+}
@@ -0,0 +1,65 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// sendersTx is a tx only defining its senders.
+type sendersTx struct {
+	sdk.Tx
+	senders []string
+}
+
+func (tx sendersTx) GetSenders() ([]transaction.Identity, error) {
+	senders := make([]transaction.Identity, len(tx.senders))
+	for i, sender := range tx.senders {
+		senders[i] = []byte(sender)
+	}
+	return senders, nil
+}
+
+func TestTxDecodeCache(t *testing.T) {
+	decodes := 0
+	decoder := func(txBytes []byte) (sdk.Tx, error) {
+		decodes++
+		return sendersTx{senders: []string{string(txBytes)}}, nil
+	}
+
+	c := newTxDecodeCache(2)
+
+	tx, err := c.decode(decoder, []byte("abcd"))
+	require.NoError(t, err)
+	require.Equal(t, sendersTx{senders: []string{"abcd"}}, tx)
+	_, err = c.decode(decoder, []byte("abcd"))
+	require.NoError(t, err)
+	require.Equal(t, 1, decodes)
+
+	// the least recently used txs are evicted once the cache is full
+	c.add([]byte("efgh"), sendersTx{})
+	require.NotNil(t, c.get([]byte("abcd")))
+	c.add([]byte("ijkl"), sendersTx{})
+	require.Nil(t, c.get([]byte("efgh")))
+	require.NotNil(t, c.get([]byte("abcd")))
+	require.NotNil(t, c.get([]byte("ijkl")))
+
+	// the size of the txs does not matter
+	c.add(make([]byte, 1<<20), sendersTx{})
+	require.NotNil(t, c.get(make([]byte, 1<<20)))
+	require.Nil(t, c.get([]byte("abcd")))
+
+	c.remove([][]byte{[]byte("ijkl"), []byte("mnop")})
+	require.Nil(t, c.get([]byte("ijkl")))
+	require.Equal(t, 1, c.cache.Len())
+
+	// a nil cache caches nothing
+	var nilCache *txDecodeCache
+	nilCache.add([]byte("abcd"), sendersTx{})
+	require.Nil(t, nilCache.get([]byte("abcd")))
+	nilCache.remove([][]byte{[]byte("abcd")})
+}
@@ -17,6 +17,9 @@ const (
 	DefaultGasAdjustment = 1.0
 	DefaultGasLimit      = 200000
 	GasFlagAuto          = "auto"
+	// GasPricesFlagAuto is the value of the --gas-prices flag querying the gas
+	// prices, it can be suffixed with the denom to pay the fees in, e.g. auto:uatom.
+	GasPricesFlagAuto = "auto"
 
 	// DefaultKeyringBackend defines the default keyring backend to be used
 	DefaultKeyringBackend = keyring.BackendOS
@@ -59,6 +62,7 @@ const (
 	FlagFees             = "fees"
 	FlagGas              = "gas"
 	FlagGasPrices        = "gas-prices"
+	FlagGasPricesURL     = "gas-prices-url"
 	FlagBroadcastMode    = "broadcast-mode"
 	FlagDryRun           = "dry-run"
 	FlagGenerateOnly     = "generate-only"
@@ -126,7 +130,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Uint64P(FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only)")
 	f.String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
 	f.String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
-	f.String(FlagGasPrices, "", "Determine the transaction fee by multiplying max gas units by gas prices (e.g. 0.1uatom), rounding up to nearest denom unit")
+	f.String(FlagGasPrices, "", fmt.Sprintf("Determine the transaction fee by multiplying max gas units by gas prices (e.g. 0.1uatom), rounding up to nearest denom unit; set to %q (or %q) to use the minimum gas prices of the node", GasPricesFlagAuto, GasPricesFlagAuto+":<denom>"))
+	f.String(FlagGasPricesURL, "", fmt.Sprintf("URL of an endpoint recommending network-wide gas prices, used instead of the minimum gas prices of the node, but never below them, when --%s is %q", FlagGasPrices, GasPricesFlagAuto))
 	f.String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT rpc interface for this chain")
 	f.Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	f.Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -10,6 +10,7 @@ import (
 	v11 "github.com/cometbft/cometbft/api/cometbft/p2p/v1"
 	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1053,6 +1054,374 @@ func (m *ABCIQueryResponse) GetProofOps() *v12.ProofOps {
 	return nil
 }
 
+// StoreProofRequest is the request type for the Service/StoreProof RPC method.
+type StoreProofRequest struct {
+	// store_name is the name of the module store, e.g. bank.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// key is the key of the value in the store.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// height is the height to query at, 0 meaning the latest height.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *StoreProofRequest) Reset()         { *m = StoreProofRequest{} }
+func (m *StoreProofRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreProofRequest) ProtoMessage()    {}
+func (*StoreProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{17}
+}
+func (m *StoreProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProofRequest.Merge(m, src)
+}
+func (m *StoreProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProofRequest proto.InternalMessageInfo
+
+func (m *StoreProofRequest) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *StoreProofRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StoreProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// StoreProofResponse is the response type for the Service/StoreProof RPC method.
+type StoreProofResponse struct {
+	// value is the value of the key, empty if the key does not exist.
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// height is the height of the queried state. The proof is verified against the
+	// app hash of the block at height + 1.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// proof_ops are the ICS-23 proofs of the key, from the module store to the
+	// commit multistore.
+	ProofOps *v12.ProofOps `protobuf:"bytes,3,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty"`
+}
+
+func (m *StoreProofResponse) Reset()         { *m = StoreProofResponse{} }
+func (m *StoreProofResponse) String() string { return proto.CompactTextString(m) }
+func (*StoreProofResponse) ProtoMessage()    {}
+func (*StoreProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{18}
+}
+func (m *StoreProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProofResponse.Merge(m, src)
+}
+func (m *StoreProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProofResponse proto.InternalMessageInfo
+
+func (m *StoreProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StoreProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StoreProofResponse) GetProofOps() *v12.ProofOps {
+	if m != nil {
+		return m.ProofOps
+	}
+	return nil
+}
+
+// SearchTxsRequest is the request type for the Service/SearchTxs RPC method.
+type SearchTxsRequest struct {
+	// query is the CometBFT events query, e.g. "message.sender='cosmos1...' AND tx.height>5".
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// order_by is the order of the results by height, "asc" or "desc". Default is "asc".
+	OrderBy string `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// page is the page number of the results, starting from 1. Default is 1.
+	Page uint64 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// limit is the number of results per page. Default is 100.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *SearchTxsRequest) Reset()         { *m = SearchTxsRequest{} }
+func (m *SearchTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchTxsRequest) ProtoMessage()    {}
+func (*SearchTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{19}
+}
+func (m *SearchTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchTxsRequest.Merge(m, src)
+}
+func (m *SearchTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchTxsRequest proto.InternalMessageInfo
+
+func (m *SearchTxsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchTxsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *SearchTxsRequest) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *SearchTxsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// SearchTxsResponse is the response type for the Service/SearchTxs RPC method.
+type SearchTxsResponse struct {
+	// result is the page of decoded transactions matching the query.
+	Result *types.SearchTxsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SearchTxsResponse) Reset()         { *m = SearchTxsResponse{} }
+func (m *SearchTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchTxsResponse) ProtoMessage()    {}
+func (*SearchTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{20}
+}
+func (m *SearchTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchTxsResponse.Merge(m, src)
+}
+func (m *SearchTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchTxsResponse proto.InternalMessageInfo
+
+func (m *SearchTxsResponse) GetResult() *types.SearchTxsResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// SearchBlocksRequest is the request type for the Service/SearchBlocks RPC method.
+type SearchBlocksRequest struct {
+	// query is the CometBFT events query, e.g. "block.height>5".
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// order_by is the order of the results by height, "asc" or "desc". Default is "asc".
+	OrderBy string `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// page is the page number of the results, starting from 1. Default is 1.
+	Page uint64 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// limit is the number of results per page. Default is 100.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *SearchBlocksRequest) Reset()         { *m = SearchBlocksRequest{} }
+func (m *SearchBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchBlocksRequest) ProtoMessage()    {}
+func (*SearchBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{21}
+}
+func (m *SearchBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBlocksRequest.Merge(m, src)
+}
+func (m *SearchBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchBlocksRequest proto.InternalMessageInfo
+
+func (m *SearchBlocksRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchBlocksRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *SearchBlocksRequest) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *SearchBlocksRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// SearchBlocksResponse is the response type for the Service/SearchBlocks RPC method.
+type SearchBlocksResponse struct {
+	// result is the page of blocks matching the query.
+	Result *types.SearchBlocksResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SearchBlocksResponse) Reset()         { *m = SearchBlocksResponse{} }
+func (m *SearchBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchBlocksResponse) ProtoMessage()    {}
+func (*SearchBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{22}
+}
+func (m *SearchBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchBlocksResponse.Merge(m, src)
+}
+func (m *SearchBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchBlocksResponse proto.InternalMessageInfo
+
+func (m *SearchBlocksResponse) GetResult() *types.SearchBlocksResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 // ProofOp defines an operation used for calculating Merkle root. The data could
 // be arbitrary format, providing necessary data for example neighbouring node
 // hash.
@@ -1070,7 +1439,7 @@ func (m *ProofOp) Reset()         { *m = ProofOp{} }
 func (m *ProofOp) String() string { return proto.CompactTextString(m) }
 func (*ProofOp) ProtoMessage()    {}
 func (*ProofOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{17}
+	return fileDescriptor_40c93fb3ef485c5d, []int{23}
 }
 func (m *ProofOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1502,7 @@ func (m *ProofOps) Reset()         { *m = ProofOps{} }
 func (m *ProofOps) String() string { return proto.CompactTextString(m) }
 func (*ProofOps) ProtoMessage()    {}
 func (*ProofOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{18}
+	return fileDescriptor_40c93fb3ef485c5d, []int{24}
 }
 func (m *ProofOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,6 +1556,12 @@ func init() {
 	proto.RegisterType((*Module)(nil), "cosmos.base.tendermint.v1beta1.Module")
 	proto.RegisterType((*ABCIQueryRequest)(nil), "cosmos.base.tendermint.v1beta1.ABCIQueryRequest")
 	proto.RegisterType((*ABCIQueryResponse)(nil), "cosmos.base.tendermint.v1beta1.ABCIQueryResponse")
+	proto.RegisterType((*StoreProofRequest)(nil), "cosmos.base.tendermint.v1beta1.StoreProofRequest")
+	proto.RegisterType((*StoreProofResponse)(nil), "cosmos.base.tendermint.v1beta1.StoreProofResponse")
+	proto.RegisterType((*SearchTxsRequest)(nil), "cosmos.base.tendermint.v1beta1.SearchTxsRequest")
+	proto.RegisterType((*SearchTxsResponse)(nil), "cosmos.base.tendermint.v1beta1.SearchTxsResponse")
+	proto.RegisterType((*SearchBlocksRequest)(nil), "cosmos.base.tendermint.v1beta1.SearchBlocksRequest")
+	proto.RegisterType((*SearchBlocksResponse)(nil), "cosmos.base.tendermint.v1beta1.SearchBlocksResponse")
 	proto.RegisterType((*ProofOp)(nil), "cosmos.base.tendermint.v1beta1.ProofOp")
 	proto.RegisterType((*ProofOps)(nil), "cosmos.base.tendermint.v1beta1.ProofOps")
 }
@@ -1196,99 +1571,116 @@ func init() {
 }
 
 var fileDescriptor_40c93fb3ef485c5d = []byte{
-	// 1467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xda, 0x69, 0x6c, 0x3f, 0xe9, 0xff, 0xdf, 0x64, 0x12, 0xda, 0xad, 0x49, 0xdd, 0x60,
-	0x89, 0xbe, 0x92, 0xdd, 0xda, 0x69, 0xd3, 0x82, 0x4a, 0x51, 0xd2, 0x94, 0x34, 0xa5, 0x2d, 0x61,
-	0x53, 0x81, 0x84, 0x90, 0x56, 0xeb, 0xdd, 0xc9, 0x66, 0x15, 0x7b, 0x67, 0xba, 0x3b, 0x36, 0x58,
-	0x08, 0x09, 0x71, 0xe2, 0x88, 0xc4, 0x57, 0xe0, 0x00, 0x37, 0x0e, 0x15, 0x9c, 0xa8, 0x04, 0xa7,
-	0x8a, 0x53, 0x55, 0x24, 0x54, 0xf5, 0x80, 0x50, 0x8b, 0xc4, 0xd7, 0x40, 0xf3, 0xb2, 0xeb, 0x75,
-	0x5e, 0x6a, 0xa7, 0x37, 0xb8, 0x58, 0xb3, 0xcf, 0xeb, 0xef, 0xf7, 0xcc, 0x33, 0xcf, 0x8c, 0xe1,
-	0x8c, 0x4b, 0xe2, 0x16, 0x89, 0xcd, 0x86, 0x13, 0x63, 0x93, 0xe1, 0xd0, 0xc3, 0x51, 0x2b, 0x08,
-	0x99, 0xd9, 0xa9, 0x35, 0x30, 0x73, 0x6a, 0xe6, 0xdd, 0x36, 0x8e, 0xba, 0x06, 0x8d, 0x08, 0x23,
-	0xa8, 0x22, 0x6d, 0x0d, 0x6e, 0x6b, 0xf4, 0x6c, 0x0d, 0x65, 0x5b, 0x9e, 0xf6, 0x89, 0x4f, 0x84,
-	0xa9, 0xc9, 0x57, 0xd2, 0xab, 0x7c, 0xd4, 0x27, 0xc4, 0x6f, 0x62, 0x53, 0x7c, 0x35, 0xda, 0x1b,
-	0xa6, 0x13, 0xaa, 0x80, 0xe5, 0x19, 0xa5, 0x72, 0x68, 0x60, 0x3a, 0x61, 0x48, 0x98, 0xc3, 0x02,
-	0x12, 0xc6, 0x4a, 0xfb, 0xb2, 0x4b, 0x5a, 0x98, 0x35, 0x36, 0x98, 0x49, 0xeb, 0xd4, 0xec, 0xd4,
-	0x4c, 0xd6, 0xa5, 0x38, 0x51, 0x1e, 0x4b, 0x95, 0x42, 0xba, 0x5d, 0xdd, 0x47, 0x4b, 0x70, 0x48,
-	0x19, 0x51, 0xc7, 0x0f, 0x42, 0x91, 0x68, 0x37, 0xdb, 0x5d, 0x4a, 0x90, 0x8d, 0x7b, 0x54, 0xda,
-	0xda, 0x92, 0xa5, 0xaa, 0xc7, 0x9e, 0x88, 0x1a, 0x4d, 0xe2, 0x6e, 0x29, 0x75, 0x25, 0x55, 0xbb,
-	0x51, 0x97, 0x32, 0xc2, 0xf5, 0x34, 0x22, 0x64, 0x43, 0xe9, 0x27, 0x9d, 0x56, 0x10, 0x12, 0x53,
-	0xfc, 0x4a, 0x51, 0xf5, 0x73, 0x0d, 0x2a, 0x2b, 0x98, 0xbd, 0xef, 0x34, 0x03, 0xcf, 0x61, 0x24,
-	0x5a, 0xc7, 0x6c, 0xa9, 0x7b, 0x1d, 0x07, 0xfe, 0x26, 0xb3, 0xf0, 0xdd, 0x36, 0x8e, 0x19, 0x3a,
-	0x0c, 0x63, 0x9b, 0x42, 0xa0, 0x6b, 0xb3, 0xda, 0xa9, 0xbc, 0xa5, 0xbe, 0xd0, 0xdb, 0x00, 0x3d,
-	0x9e, 0x7a, 0x6e, 0x56, 0x3b, 0x35, 0x5e, 0x3f, 0x61, 0x64, 0xf7, 0x4f, 0x6e, 0xac, 0xe2, 0x68,
-	0xac, 0x39, 0x3e, 0x56, 0x31, 0xad, 0x8c, 0x67, 0xf5, 0xb1, 0x06, 0xc7, 0xf7, 0x84, 0x10, 0x53,
-	0x12, 0xc6, 0x18, 0xbd, 0x02, 0x07, 0x05, 0x51, 0xbb, 0x0f, 0xc9, 0xb8, 0x90, 0x49, 0x53, 0xb4,
-	0x0a, 0xd0, 0x49, 0x42, 0xc4, 0x7a, 0x6e, 0x36, 0x7f, 0x6a, 0xbc, 0x7e, 0xda, 0x78, 0x7e, 0x3b,
-	0x19, 0x69, 0x52, 0x2b, 0xe3, 0x8c, 0x56, 0xfa, 0x98, 0xe5, 0x05, 0xb3, 0x93, 0x03, 0x99, 0x49,
-	0xa8, 0x7d, 0xd4, 0x36, 0x60, 0x66, 0x05, 0xb3, 0x9b, 0x0e, 0xc3, 0x71, 0x1f, 0xbf, 0xa4, 0xb4,
-	0xfd, 0x25, 0xd4, 0x5e, 0xb8, 0x84, 0xbf, 0x6b, 0x70, 0x6c, 0x8f, 0x44, 0xff, 0xee, 0x02, 0xde,
-	0xd7, 0xa0, 0x94, 0xa6, 0x40, 0x75, 0x28, 0x38, 0x9e, 0x17, 0xe1, 0x38, 0x16, 0xf8, 0x4b, 0x4b,
-	0xfa, 0xa3, 0x7b, 0x73, 0xd3, 0x2a, 0xec, 0xa2, 0xd4, 0xac, 0xb3, 0x28, 0x08, 0x7d, 0x2b, 0x31,
-	0x44, 0x73, 0x50, 0xa0, 0xed, 0x86, 0xbd, 0x85, 0xbb, 0xaa, 0x45, 0xa7, 0x0d, 0x39, 0x11, 0x8c,
-	0x64, 0x58, 0x18, 0x8b, 0x61, 0xd7, 0x1a, 0xa3, 0xed, 0xc6, 0x3b, 0xb8, 0xcb, 0xeb, 0xd4, 0x21,
-	0x2c, 0x08, 0x7d, 0x9b, 0x92, 0x8f, 0x71, 0x24, 0xb0, 0xe7, 0xad, 0x71, 0x29, 0x5b, 0xe3, 0x22,
-	0x74, 0x16, 0x26, 0x69, 0x44, 0x28, 0x89, 0x71, 0x64, 0xd3, 0x28, 0x20, 0x51, 0xc0, 0xba, 0xfa,
-	0xa8, 0xb0, 0x9b, 0x48, 0x14, 0x6b, 0x4a, 0x5e, 0xad, 0xc1, 0x91, 0x15, 0xcc, 0x96, 0x78, 0x99,
-	0x87, 0x3c, 0x57, 0xd5, 0x27, 0x1a, 0xe8, 0x3b, 0x7d, 0xd4, 0x3e, 0x5e, 0x80, 0xa2, 0xdc, 0xc7,
-	0xc0, 0x53, 0xfd, 0x52, 0x36, 0x92, 0x53, 0x6f, 0xc8, 0x29, 0xd2, 0xa9, 0x19, 0xc2, 0x77, 0x75,
-	0xd9, 0x2a, 0x08, 0xdb, 0x55, 0x0f, 0x19, 0x70, 0x40, 0x2c, 0x55, 0x0d, 0xf4, 0xbd, 0x7c, 0x2c,
-	0x69, 0x86, 0x3e, 0x80, 0x52, 0xec, 0x6d, 0xd9, 0xd2, 0x47, 0xee, 0xdf, 0xab, 0x83, 0x5a, 0x41,
-	0x02, 0x9e, 0x7a, 0x72, 0x6f, 0xee, 0x90, 0xb4, 0x9c, 0x8b, 0xbd, 0xad, 0xd9, 0x73, 0xc6, 0xf9,
-	0x8b, 0x56, 0x31, 0xf6, 0xb6, 0x84, 0xba, 0x7a, 0x04, 0x5e, 0x4a, 0x1b, 0x55, 0x66, 0x94, 0xd5,
-	0xe0, 0x53, 0xe0, 0xf0, 0x76, 0xcd, 0x7f, 0x84, 0xf3, 0x14, 0x4c, 0xae, 0x60, 0xb6, 0xde, 0x0d,
-	0x5d, 0xde, 0x99, 0x8a, 0xaf, 0x01, 0x28, 0x2b, 0x54, 0x54, 0x75, 0x28, 0xc4, 0x52, 0x24, 0x98,
-	0x16, 0xad, 0xe4, 0xb3, 0x3a, 0x2d, 0xec, 0x6f, 0x13, 0x0f, 0xaf, 0x86, 0x1b, 0x24, 0x89, 0xf2,
-	0xb3, 0x06, 0x53, 0x7d, 0x62, 0x15, 0xe7, 0x26, 0x4c, 0x7a, 0x78, 0xc3, 0x69, 0x37, 0x99, 0x1d,
-	0x12, 0x0f, 0xdb, 0x41, 0xb8, 0x41, 0x54, 0xed, 0x66, 0x7b, 0x75, 0xa0, 0x75, 0xca, 0xab, 0xb0,
-	0x2c, 0x2d, 0xd3, 0x20, 0x87, 0xbc, 0x7e, 0x01, 0xfa, 0x08, 0xa6, 0x1c, 0x4a, 0x9b, 0x81, 0x2b,
-	0x0e, 0xa5, 0xdd, 0xc1, 0x51, 0xdc, 0x1b, 0xf9, 0x67, 0x07, 0x8e, 0x08, 0x69, 0x2e, 0x42, 0xa3,
-	0x4c, 0x1c, 0x25, 0xaf, 0xfe, 0x94, 0x83, 0xf1, 0x8c, 0x0d, 0x42, 0x30, 0x1a, 0x3a, 0x2d, 0x2c,
-	0x8f, 0xb8, 0x25, 0xd6, 0xe8, 0x28, 0x14, 0x1d, 0x4a, 0x6d, 0x21, 0xcf, 0x09, 0x79, 0xc1, 0xa1,
-	0xf4, 0x36, 0x57, 0xe9, 0x50, 0x48, 0x00, 0xe5, 0xa5, 0x46, 0x7d, 0xa2, 0x63, 0x00, 0x7e, 0xc0,
-	0x6c, 0x97, 0xb4, 0x5a, 0x01, 0x13, 0x27, 0xb4, 0x64, 0x95, 0xfc, 0x80, 0x5d, 0x15, 0x02, 0xae,
-	0x6e, 0xb4, 0x83, 0xa6, 0x67, 0x33, 0xc7, 0x8f, 0xf5, 0x03, 0x52, 0x2d, 0x24, 0x77, 0x1c, 0x3f,
-	0x16, 0xde, 0x24, 0xe5, 0x3a, 0xa6, 0xbc, 0x89, 0x42, 0x8a, 0xae, 0x25, 0xde, 0x1e, 0xa6, 0xb1,
-	0x5e, 0x10, 0xd3, 0xf2, 0xc4, 0xa0, 0x52, 0xdc, 0x22, 0x5e, 0xbb, 0x89, 0x55, 0x96, 0x65, 0x4c,
-	0x63, 0xb4, 0x08, 0x48, 0x5d, 0xf7, 0xbc, 0xf7, 0x92, 0x6c, 0x45, 0x31, 0xdd, 0x76, 0x69, 0xab,
-	0x79, 0x6b, 0x42, 0x0a, 0xd6, 0xbd, 0xad, 0xa4, 0x7e, 0xd7, 0x61, 0x4c, 0xc6, 0xe5, 0x95, 0xa3,
-	0x0e, 0xdb, 0x4c, 0x2a, 0xc7, 0xd7, 0xd9, 0xf2, 0xe4, 0xfa, 0xcb, 0x33, 0x01, 0xf9, 0xb8, 0xdd,
-	0x52, 0x45, 0xe3, 0xcb, 0xea, 0x26, 0x4c, 0x2c, 0x2e, 0x5d, 0x5d, 0x7d, 0x8f, 0xcf, 0xe6, 0x64,
-	0x4a, 0x21, 0x18, 0xf5, 0x1c, 0xe6, 0x88, 0x98, 0x07, 0x2d, 0xb1, 0x4e, 0xf3, 0xe4, 0x32, 0x79,
-	0x7a, 0xd3, 0x2c, 0xdf, 0xf7, 0x4a, 0x98, 0x86, 0x03, 0x34, 0x22, 0x1d, 0x2c, 0xea, 0x5f, 0xb4,
-	0xe4, 0x47, 0xf5, 0xcb, 0x1c, 0x4c, 0x66, 0x52, 0xa9, 0xae, 0x45, 0x30, 0xea, 0x12, 0x4f, 0xee,
-	0xfc, 0xff, 0x2c, 0xb1, 0xe6, 0x28, 0x9b, 0xc4, 0x4f, 0x50, 0x36, 0x89, 0xcf, 0xad, 0x44, 0x3b,
-	0xcb, 0x0d, 0x15, 0x6b, 0x9e, 0x25, 0x08, 0x3d, 0xfc, 0x89, 0xd8, 0xc6, 0xbc, 0x25, 0x3f, 0xb8,
-	0x2f, 0x9f, 0xfb, 0x63, 0x02, 0x3a, 0x5f, 0x72, 0xbb, 0x8e, 0xd3, 0x6c, 0x63, 0xbd, 0x20, 0x64,
-	0xf2, 0x23, 0x83, 0xbd, 0xd4, 0x87, 0x7d, 0x06, 0x4a, 0x1c, 0x43, 0x4c, 0x1d, 0x17, 0xeb, 0x20,
-	0x3b, 0x20, 0x15, 0xa0, 0xd7, 0xa1, 0x24, 0x1e, 0x57, 0x36, 0xa1, 0xb1, 0x3e, 0x2e, 0xce, 0xc2,
-	0x4c, 0xef, 0x6c, 0xc9, 0x17, 0x18, 0x3f, 0x5e, 0x6b, 0xdc, 0xe8, 0x5d, 0x1a, 0x5b, 0x45, 0xaa,
-	0x56, 0x37, 0x46, 0x8b, 0xb9, 0x89, 0xfc, 0x8d, 0xd1, 0x62, 0x71, 0xa2, 0x54, 0x5d, 0x81, 0x82,
-	0xb2, 0xe0, 0xcc, 0xf8, 0x68, 0x4a, 0xf6, 0x8f, 0xaf, 0x13, 0x0e, 0xb9, 0x1e, 0x87, 0x64, 0x47,
-	0xf2, 0xbd, 0x1d, 0x79, 0x23, 0xa7, 0x6b, 0xd5, 0x3b, 0x50, 0x4c, 0x52, 0xa1, 0x65, 0xc8, 0x73,
-	0x54, 0x9a, 0x68, 0xcb, 0x93, 0x83, 0xda, 0x52, 0xb9, 0x2d, 0x95, 0x1e, 0xfc, 0x71, 0x7c, 0xe4,
-	0xdb, 0xbf, 0xbf, 0x3f, 0xa3, 0x59, 0xdc, 0x9d, 0x47, 0xad, 0xff, 0x02, 0x50, 0x58, 0xc7, 0x51,
-	0x27, 0x70, 0x31, 0xfa, 0x4e, 0x83, 0xf1, 0xcc, 0xb4, 0x41, 0xf5, 0x41, 0x81, 0x77, 0x4e, 0xac,
-	0xf2, 0xfc, 0xbe, 0x7c, 0x64, 0x63, 0x54, 0x6b, 0x5f, 0xfc, 0xf6, 0xd7, 0xd7, 0xb9, 0xb3, 0xe8,
-	0xb4, 0x39, 0xe0, 0x1d, 0x9d, 0x0e, 0x3b, 0xf4, 0x8d, 0x06, 0xd0, 0x1b, 0xb0, 0xa8, 0x36, 0x44,
-	0xda, 0xfe, 0x09, 0x5d, 0xae, 0xef, 0xc7, 0x45, 0x01, 0x35, 0x05, 0xd0, 0xd3, 0xe8, 0xe4, 0x20,
-	0xa0, 0x6a, 0xac, 0xa3, 0x1f, 0x34, 0xf8, 0x7f, 0xff, 0xb5, 0x87, 0x2e, 0x0c, 0x91, 0x77, 0xe7,
-	0x05, 0x5a, 0x5e, 0xd8, 0xaf, 0x9b, 0x82, 0x7c, 0x41, 0x40, 0x36, 0xd1, 0xdc, 0x20, 0xc8, 0xe2,
-	0x62, 0x8c, 0xcd, 0xa6, 0x88, 0x81, 0xee, 0x6b, 0x30, 0xb1, 0xfd, 0x95, 0x82, 0x2e, 0x0e, 0x81,
-	0x61, 0xb7, 0xb7, 0x50, 0xf9, 0xd2, 0xfe, 0x1d, 0x15, 0xfc, 0x8b, 0x02, 0x7e, 0x0d, 0x99, 0x43,
-	0xc2, 0xff, 0x54, 0x9e, 0xed, 0xcf, 0xd0, 0x23, 0x2d, 0xf3, 0x14, 0xc9, 0xbe, 0x99, 0xd1, 0xe5,
-	0xa1, 0x2b, 0xb9, 0xcb, 0x9b, 0xbe, 0xfc, 0xe6, 0x0b, 0x7a, 0x2b, 0x3e, 0x97, 0x05, 0x9f, 0x05,
-	0x74, 0x7e, 0x10, 0x9f, 0xde, 0x73, 0x1b, 0xb3, 0x74, 0x57, 0x9e, 0x68, 0xe2, 0xbd, 0xb9, 0xdb,
-	0x7f, 0x29, 0x74, 0x65, 0x08, 0x60, 0xcf, 0xf9, 0x1f, 0x58, 0x7e, 0xeb, 0x85, 0xfd, 0x15, 0xb5,
-	0x2b, 0x82, 0xda, 0x25, 0xb4, 0xb0, 0x3f, 0x6a, 0xe9, 0x8e, 0xfd, 0xa8, 0x41, 0x29, 0xbd, 0x34,
-	0xd0, 0xb9, 0x41, 0x70, 0xb6, 0x5f, 0x65, 0xe5, 0xda, 0x3e, 0x3c, 0x14, 0xe4, 0x6b, 0xbf, 0xee,
-	0xb8, 0x82, 0x17, 0x04, 0x8b, 0xd7, 0xd0, 0x99, 0x41, 0x2c, 0x9c, 0x86, 0x1b, 0xd8, 0xe2, 0x7f,
-	0xce, 0xd2, 0xad, 0x07, 0x4f, 0x2b, 0xda, 0xc3, 0xa7, 0x15, 0xed, 0xcf, 0xa7, 0x15, 0xed, 0xab,
-	0x67, 0x95, 0x91, 0x87, 0xcf, 0x2a, 0x23, 0x8f, 0x9f, 0x55, 0x46, 0x3e, 0x9c, 0xf7, 0x03, 0xb6,
-	0xd9, 0x6e, 0xf0, 0x7b, 0x23, 0x89, 0xd7, 0x4b, 0x67, 0xba, 0xcd, 0x00, 0x87, 0xcc, 0xf4, 0x23,
-	0xea, 0x9a, 0x6e, 0x8b, 0xc5, 0x72, 0x0e, 0x37, 0xc6, 0xc4, 0x5f, 0x97, 0xf9, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xed, 0x64, 0xd5, 0x13, 0x59, 0x11, 0x00, 0x00,
+	// 1744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x8f, 0x23, 0x47,
+	0x15, 0xdf, 0xb6, 0xbd, 0x63, 0xfb, 0xcd, 0xc2, 0x7a, 0x6a, 0x86, 0xc4, 0x6b, 0x76, 0x9d, 0xa1,
+	0x11, 0x64, 0x3f, 0x32, 0xdd, 0xb1, 0x67, 0x67, 0x77, 0x89, 0x42, 0xd0, 0x4c, 0x26, 0xcc, 0x4e,
+	0x48, 0xc2, 0xd0, 0x5e, 0x05, 0x09, 0xad, 0xd4, 0x6a, 0xbb, 0x6b, 0x7a, 0x5a, 0x63, 0x77, 0x55,
+	0xba, 0xca, 0x66, 0xad, 0x28, 0x12, 0xe2, 0xc4, 0x05, 0x09, 0x89, 0x7f, 0x81, 0x03, 0xdc, 0x38,
+	0x44, 0x70, 0x22, 0x12, 0xe2, 0xb2, 0xe2, 0x14, 0x05, 0x09, 0x45, 0x7b, 0x40, 0x68, 0x17, 0x89,
+	0x7f, 0x03, 0xd5, 0x47, 0xb7, 0xbb, 0xc7, 0x9e, 0xb5, 0xbd, 0x07, 0x24, 0xb8, 0x58, 0x55, 0xef,
+	0xfb, 0xf7, 0xea, 0xd5, 0xab, 0xd7, 0x86, 0x9b, 0x3d, 0xc2, 0x06, 0x84, 0xd9, 0x5d, 0x8f, 0x61,
+	0x9b, 0xe3, 0xc8, 0xc7, 0xf1, 0x20, 0x8c, 0xb8, 0x3d, 0x6a, 0x75, 0x31, 0xf7, 0x5a, 0xf6, 0x47,
+	0x43, 0x1c, 0x8f, 0x2d, 0x1a, 0x13, 0x4e, 0x50, 0x53, 0xc9, 0x5a, 0x42, 0xd6, 0x9a, 0xc8, 0x5a,
+	0x5a, 0xb6, 0xb1, 0x11, 0x90, 0x80, 0x48, 0x51, 0x5b, 0xac, 0x94, 0x56, 0xe3, 0x4a, 0x40, 0x48,
+	0xd0, 0xc7, 0xb6, 0xdc, 0x75, 0x87, 0xc7, 0xb6, 0x17, 0x69, 0x83, 0x8d, 0xab, 0x9a, 0xe5, 0xd1,
+	0xd0, 0xf6, 0xa2, 0x88, 0x70, 0x8f, 0x87, 0x24, 0x62, 0x9a, 0xfb, 0xf5, 0x1e, 0x19, 0x60, 0xde,
+	0x3d, 0xe6, 0x36, 0x6d, 0x53, 0x7b, 0xd4, 0xb2, 0xf9, 0x98, 0xe2, 0x84, 0x79, 0x2d, 0x65, 0x4a,
+	0xea, 0x59, 0x76, 0x0e, 0x96, 0xc4, 0x90, 0x22, 0xa2, 0x5e, 0x10, 0x46, 0xd2, 0x91, 0x96, 0xfd,
+	0x66, 0x56, 0xd6, 0xeb, 0xf6, 0xc2, 0x54, 0x54, 0x6c, 0x66, 0x19, 0x9c, 0x91, 0xa7, 0xac, 0xf3,
+	0x2b, 0x4a, 0xd6, 0x55, 0xa9, 0x50, 0x9b, 0xf3, 0xc3, 0xee, 0xf6, 0x49, 0xef, 0x54, 0xb3, 0x9b,
+	0x29, 0xbb, 0x17, 0x8f, 0x29, 0x27, 0x82, 0x4f, 0x63, 0x42, 0x8e, 0x35, 0x7f, 0xcd, 0x1b, 0x84,
+	0x11, 0xb1, 0xe5, 0xaf, 0x22, 0x99, 0x3f, 0x33, 0xa0, 0x79, 0x80, 0xf9, 0x87, 0x5e, 0x3f, 0xf4,
+	0x3d, 0x4e, 0xe2, 0x0e, 0xe6, 0x7b, 0xe3, 0xfb, 0x38, 0x0c, 0x4e, 0xb8, 0x83, 0x3f, 0x1a, 0x62,
+	0xc6, 0xd1, 0x4b, 0xb0, 0x72, 0x22, 0x09, 0x75, 0x63, 0xd3, 0xb8, 0x5e, 0x74, 0xf4, 0x0e, 0x7d,
+	0x1f, 0x60, 0x92, 0x8c, 0x7a, 0x61, 0xd3, 0xb8, 0xbe, 0xda, 0xfe, 0xb6, 0x95, 0x3d, 0x64, 0x75,
+	0xfa, 0x1a, 0xa3, 0x75, 0xe4, 0x05, 0x58, 0xdb, 0x74, 0x32, 0x9a, 0xe6, 0x97, 0x06, 0xbc, 0x72,
+	0x6e, 0x08, 0x8c, 0x92, 0x88, 0x61, 0xf4, 0x0d, 0xb8, 0x24, 0x81, 0xba, 0xb9, 0x48, 0x56, 0x25,
+	0x4d, 0x89, 0xa2, 0x43, 0x80, 0x51, 0x62, 0x82, 0xd5, 0x0b, 0x9b, 0xc5, 0xeb, 0xab, 0xed, 0x1b,
+	0xd6, 0xf3, 0x6b, 0xce, 0x4a, 0x9d, 0x3a, 0x19, 0x65, 0x74, 0x90, 0x43, 0x56, 0x94, 0xc8, 0x5e,
+	0x9d, 0x8b, 0x4c, 0x85, 0x9a, 0x83, 0x76, 0x0c, 0x57, 0x0f, 0x30, 0x7f, 0xcf, 0xe3, 0x98, 0xe5,
+	0xf0, 0x25, 0xa9, 0xcd, 0xa7, 0xd0, 0x78, 0xe1, 0x14, 0xfe, 0xdd, 0x80, 0x6b, 0xe7, 0x38, 0xfa,
+	0xdf, 0x4e, 0xe0, 0x67, 0x06, 0x54, 0x53, 0x17, 0xa8, 0x0d, 0x65, 0xcf, 0xf7, 0x63, 0xcc, 0x98,
+	0x8c, 0xbf, 0xba, 0x57, 0xff, 0xe2, 0xd3, 0xad, 0x0d, 0x6d, 0x76, 0x57, 0x71, 0x3a, 0x3c, 0x0e,
+	0xa3, 0xc0, 0x49, 0x04, 0xd1, 0x16, 0x94, 0xe9, 0xb0, 0xeb, 0x9e, 0xe2, 0xb1, 0x2e, 0xd1, 0x0d,
+	0x4b, 0xb5, 0x0d, 0x2b, 0xe9, 0x28, 0xd6, 0x6e, 0x34, 0x76, 0x56, 0xe8, 0xb0, 0xfb, 0x03, 0x3c,
+	0x16, 0x79, 0x1a, 0x11, 0x1e, 0x46, 0x81, 0x4b, 0xc9, 0x4f, 0x71, 0x2c, 0x63, 0x2f, 0x3a, 0xab,
+	0x8a, 0x76, 0x24, 0x48, 0xe8, 0x16, 0xac, 0xd1, 0x98, 0x50, 0xc2, 0x70, 0xec, 0xd2, 0x38, 0x24,
+	0x71, 0xc8, 0xc7, 0xf5, 0x92, 0x94, 0xab, 0x25, 0x8c, 0x23, 0x4d, 0x37, 0x5b, 0xf0, 0xf2, 0x01,
+	0xe6, 0x7b, 0x22, 0xcd, 0x0b, 0xde, 0x2b, 0xf3, 0x89, 0x01, 0xf5, 0x69, 0x1d, 0x7d, 0x8e, 0x3b,
+	0x50, 0x51, 0xe7, 0x18, 0xfa, 0xba, 0x5e, 0x1a, 0x56, 0x72, 0xeb, 0x2d, 0xd5, 0x45, 0x46, 0x2d,
+	0x4b, 0xea, 0x1e, 0xee, 0x3b, 0x65, 0x29, 0x7b, 0xe8, 0x23, 0x0b, 0x2e, 0xca, 0xa5, 0xce, 0x41,
+	0xfd, 0x3c, 0x1d, 0x47, 0x89, 0xa1, 0x1f, 0x43, 0x95, 0xf9, 0xa7, 0xae, 0xd2, 0x51, 0xe7, 0xf7,
+	0xad, 0x79, 0xa5, 0xa0, 0x02, 0x5e, 0x7f, 0xf2, 0xe9, 0xd6, 0x65, 0x25, 0xb9, 0xc5, 0xfc, 0xd3,
+	0xcd, 0xd7, 0xad, 0xdb, 0x77, 0x9d, 0x0a, 0xf3, 0x4f, 0x25, 0xdb, 0x7c, 0x19, 0xbe, 0x96, 0x16,
+	0xaa, 0xf2, 0xa8, 0xb2, 0x21, 0xba, 0xc0, 0x4b, 0x67, 0x39, 0xff, 0x27, 0x98, 0xd7, 0x61, 0xed,
+	0x00, 0xf3, 0xce, 0x38, 0xea, 0x89, 0xca, 0xd4, 0x78, 0x2d, 0x40, 0x59, 0xa2, 0x86, 0x5a, 0x87,
+	0x32, 0x53, 0x24, 0x89, 0xb4, 0xe2, 0x24, 0x5b, 0x73, 0x43, 0xca, 0x7f, 0x40, 0x7c, 0x7c, 0x18,
+	0x1d, 0x93, 0xc4, 0xca, 0x9f, 0x0d, 0x58, 0xcf, 0x91, 0xb5, 0x9d, 0xf7, 0x60, 0xcd, 0xc7, 0xc7,
+	0xde, 0xb0, 0xcf, 0xdd, 0x88, 0xf8, 0xd8, 0x0d, 0xa3, 0x63, 0xa2, 0x73, 0xb7, 0x39, 0xc9, 0x03,
+	0x6d, 0x53, 0x91, 0x85, 0x7d, 0x25, 0x99, 0x1a, 0xb9, 0xec, 0xe7, 0x09, 0xe8, 0x21, 0xac, 0x7b,
+	0x94, 0xf6, 0xc3, 0x9e, 0xbc, 0x94, 0xee, 0x08, 0xc7, 0x6c, 0xd2, 0xf2, 0x6f, 0xcd, 0x6d, 0x11,
+	0x4a, 0x5c, 0x9a, 0x46, 0x19, 0x3b, 0x9a, 0x6e, 0xfe, 0xa9, 0x00, 0xab, 0x19, 0x19, 0x84, 0xa0,
+	0x14, 0x79, 0x03, 0xac, 0xae, 0xb8, 0x23, 0xd7, 0xe8, 0x0a, 0x54, 0x3c, 0x4a, 0x5d, 0x49, 0x2f,
+	0x48, 0x7a, 0xd9, 0xa3, 0xf4, 0x03, 0xc1, 0xaa, 0x43, 0x39, 0x09, 0xa8, 0xa8, 0x38, 0x7a, 0x8b,
+	0xae, 0x01, 0x04, 0x21, 0x77, 0x7b, 0x64, 0x30, 0x08, 0xb9, 0xbc, 0xa1, 0x55, 0xa7, 0x1a, 0x84,
+	0xfc, 0x6d, 0x49, 0x10, 0xec, 0xee, 0x30, 0xec, 0xfb, 0x2e, 0xf7, 0x02, 0x56, 0xbf, 0xa8, 0xd8,
+	0x92, 0xf2, 0xc0, 0x0b, 0x98, 0xd4, 0x26, 0x29, 0xd6, 0x15, 0xad, 0x4d, 0x74, 0xa4, 0xe8, 0x9d,
+	0x44, 0xdb, 0xc7, 0x94, 0xd5, 0xcb, 0x9b, 0xc5, 0xa9, 0xd6, 0x3d, 0x23, 0x15, 0xef, 0x13, 0x7f,
+	0xd8, 0xc7, 0xda, 0xcb, 0x3e, 0xa6, 0x0c, 0xed, 0x02, 0xd2, 0xcf, 0xbd, 0xa8, 0xbd, 0xc4, 0x5b,
+	0x45, 0x76, 0xb7, 0x19, 0x65, 0xb5, 0xed, 0xd4, 0x14, 0xa1, 0xe3, 0x9f, 0x26, 0xf9, 0xbb, 0x0f,
+	0x2b, 0xca, 0xae, 0xc8, 0x1c, 0xf5, 0xf8, 0x49, 0x92, 0x39, 0xb1, 0xce, 0xa6, 0xa7, 0x90, 0x4f,
+	0x4f, 0x0d, 0x8a, 0x6c, 0x38, 0xd0, 0x49, 0x13, 0x4b, 0xf3, 0x04, 0x6a, 0xbb, 0x7b, 0x6f, 0x1f,
+	0xfe, 0x48, 0xf4, 0xe6, 0xa4, 0x4b, 0x21, 0x28, 0xf9, 0x1e, 0xf7, 0xa4, 0xcd, 0x4b, 0x8e, 0x5c,
+	0xa7, 0x7e, 0x0a, 0x19, 0x3f, 0x93, 0x6e, 0x56, 0xcc, 0x4d, 0x09, 0x1b, 0x70, 0x91, 0xc6, 0x64,
+	0x84, 0x65, 0xfe, 0x2b, 0x8e, 0xda, 0x98, 0xbf, 0x28, 0xc0, 0x5a, 0xc6, 0x95, 0xae, 0x5a, 0x04,
+	0xa5, 0x1e, 0xf1, 0xd5, 0xc9, 0x7f, 0xc5, 0x91, 0x6b, 0x11, 0x65, 0x9f, 0x04, 0x49, 0x94, 0x7d,
+	0x12, 0x08, 0x29, 0x59, 0xce, 0xea, 0x40, 0xe5, 0x5a, 0x78, 0x09, 0x23, 0x1f, 0x3f, 0x92, 0xc7,
+	0x58, 0x74, 0xd4, 0x46, 0xe8, 0x8a, 0xbe, 0xbf, 0x22, 0x43, 0x17, 0x4b, 0x21, 0x37, 0xf2, 0xfa,
+	0x43, 0x5c, 0x2f, 0x4b, 0x9a, 0xda, 0x64, 0x62, 0xaf, 0xe6, 0x62, 0xbf, 0x0a, 0x55, 0x11, 0x03,
+	0xa3, 0x5e, 0x0f, 0xd7, 0x41, 0x55, 0x40, 0x4a, 0x40, 0xdf, 0x81, 0xaa, 0x1c, 0xae, 0x5c, 0x42,
+	0x59, 0x7d, 0x55, 0xde, 0x85, 0xab, 0x93, 0xbb, 0xa5, 0x26, 0x30, 0x71, 0xbd, 0x8e, 0x84, 0xd0,
+	0x0f, 0x29, 0x73, 0x2a, 0x54, 0xaf, 0xde, 0x2d, 0x55, 0x0a, 0xb5, 0xe2, 0xbb, 0xa5, 0x4a, 0xa5,
+	0x56, 0x35, 0x1f, 0xc2, 0x5a, 0x87, 0x93, 0x18, 0x4b, 0xb1, 0x24, 0xeb, 0xd7, 0x00, 0x98, 0x20,
+	0xba, 0x99, 0x9b, 0x50, 0x95, 0x14, 0x59, 0xf3, 0x1a, 0x58, 0x61, 0x02, 0xec, 0x9c, 0xf4, 0x9b,
+	0x9f, 0x00, 0xca, 0x5a, 0xd7, 0x89, 0x4e, 0xd3, 0x60, 0xcc, 0x4e, 0x43, 0x21, 0x97, 0x86, 0x1c,
+	0xd0, 0xe2, 0x32, 0x40, 0xcd, 0x01, 0xd4, 0x3a, 0xd8, 0x8b, 0x7b, 0x27, 0x0f, 0x1e, 0xb1, 0x04,
+	0xdb, 0x06, 0x5c, 0x94, 0xaf, 0xbf, 0x86, 0xa5, 0x36, 0xe2, 0x86, 0x93, 0xd8, 0xc7, 0xb1, 0xdb,
+	0x1d, 0x27, 0x85, 0x2a, 0xf7, 0x7b, 0x63, 0x55, 0x6e, 0x01, 0x96, 0xae, 0x4b, 0x8e, 0x5c, 0x0b,
+	0x23, 0xfd, 0x30, 0xb9, 0xd6, 0x25, 0x47, 0x6d, 0xcc, 0x0f, 0x61, 0x2d, 0xe3, 0x4e, 0x83, 0xdd,
+	0x85, 0x95, 0x18, 0xb3, 0x61, 0x9f, 0xeb, 0x06, 0x98, 0x9f, 0x69, 0xe4, 0x90, 0x9e, 0xdc, 0xcf,
+	0xac, 0xf2, 0xb0, 0xcf, 0x1d, 0xad, 0x68, 0x52, 0x58, 0x57, 0x2c, 0xd9, 0xd0, 0xff, 0x1b, 0x48,
+	0x1e, 0xc2, 0x46, 0xde, 0xa3, 0x06, 0xb3, 0x7f, 0x06, 0xcc, 0x6b, 0xf3, 0xc0, 0xa4, 0xfa, 0x59,
+	0x3c, 0x07, 0x50, 0xd6, 0x87, 0x25, 0x42, 0x12, 0xcf, 0x61, 0xd2, 0x33, 0xc4, 0x7a, 0x46, 0x79,
+	0x25, 0x5d, 0xa0, 0x38, 0xe9, 0x02, 0x6f, 0x14, 0xea, 0x86, 0xf9, 0x00, 0x2a, 0xc9, 0xa9, 0xa3,
+	0x7d, 0x28, 0x8a, 0x02, 0x31, 0x36, 0x8b, 0x53, 0xd3, 0xde, 0x8c, 0x56, 0xa8, 0xd5, 0xf6, 0xaa,
+	0x8f, 0xff, 0xf1, 0xca, 0x85, 0xdf, 0xfe, 0xfb, 0xf7, 0x37, 0x0d, 0x47, 0xa8, 0x0b, 0xab, 0xed,
+	0x5f, 0x5e, 0x86, 0x72, 0x07, 0xc7, 0xa3, 0xb0, 0x87, 0xd1, 0xef, 0x0c, 0x58, 0xcd, 0xbc, 0x70,
+	0xa8, 0x3d, 0xcf, 0xf0, 0xf4, 0x2b, 0xd9, 0xd8, 0x5e, 0x4a, 0x47, 0x65, 0xda, 0x6c, 0xfd, 0xfc,
+	0x6f, 0xff, 0xfa, 0x75, 0xe1, 0x16, 0xba, 0x61, 0xcf, 0xf9, 0x76, 0x4b, 0x1f, 0x58, 0xf4, 0x1b,
+	0x03, 0x60, 0xf2, 0xa8, 0xa3, 0xd6, 0x02, 0x6e, 0xf3, 0x53, 0x41, 0xa3, 0xbd, 0x8c, 0x8a, 0x0e,
+	0xd4, 0x96, 0x81, 0xde, 0x40, 0xaf, 0xce, 0x0b, 0x54, 0x8f, 0x12, 0xe8, 0x0f, 0x06, 0x7c, 0x35,
+	0x3f, 0x6a, 0xa1, 0x9d, 0x05, 0xfc, 0x4e, 0x0f, 0x6d, 0x8d, 0x3b, 0xcb, 0xaa, 0xe9, 0x90, 0x77,
+	0x64, 0xc8, 0x36, 0xda, 0x9a, 0x17, 0xb2, 0x1c, 0xc6, 0x98, 0xdd, 0x97, 0x36, 0xd0, 0x67, 0x06,
+	0xd4, 0xce, 0x4e, 0xc6, 0xe8, 0xee, 0x02, 0x31, 0xcc, 0x9a, 0xbf, 0x1b, 0xf7, 0x96, 0x57, 0xd4,
+	0xe1, 0xdf, 0x95, 0xe1, 0xb7, 0x90, 0xbd, 0x60, 0xf8, 0x1f, 0xab, 0x46, 0xfa, 0x09, 0xfa, 0xc2,
+	0xc8, 0x8c, 0xbf, 0xd9, 0xef, 0x34, 0xf4, 0xe6, 0xc2, 0x99, 0x9c, 0xf1, 0x1d, 0xd9, 0xf8, 0xee,
+	0x0b, 0x6a, 0x6b, 0x3c, 0x6f, 0x4a, 0x3c, 0x77, 0xd0, 0xed, 0x79, 0x78, 0x26, 0x9f, 0x78, 0x98,
+	0xa7, 0xa7, 0xf2, 0xc4, 0x90, 0xdf, 0x38, 0xb3, 0xbe, 0xdf, 0xd1, 0x5b, 0x0b, 0x04, 0xf6, 0x9c,
+	0xff, 0x1e, 0x1a, 0xdf, 0x7b, 0x61, 0x7d, 0x0d, 0xed, 0x2d, 0x09, 0xed, 0x1e, 0xba, 0xb3, 0x1c,
+	0xb4, 0xf4, 0xc4, 0xfe, 0x68, 0x40, 0x35, 0x1d, 0x54, 0xd0, 0xeb, 0xf3, 0xc2, 0x39, 0x3b, 0x3e,
+	0x35, 0x5a, 0x4b, 0x68, 0xe8, 0x90, 0xdf, 0xf9, 0xeb, 0xd4, 0xd8, 0x77, 0x47, 0xa2, 0x78, 0x0d,
+	0xdd, 0x9c, 0x87, 0x42, 0x3c, 0x00, 0xae, 0x7a, 0x86, 0x1e, 0x1b, 0x00, 0x93, 0xa7, 0x7f, 0x7e,
+	0x33, 0x9a, 0x1a, 0x42, 0x1a, 0xed, 0x65, 0x54, 0x74, 0xf0, 0x9d, 0xa9, 0xe0, 0x77, 0xda, 0x32,
+	0xf8, 0x37, 0xd0, 0xbd, 0xb9, 0xfd, 0x49, 0x4e, 0x3c, 0x72, 0x76, 0xb0, 0x3f, 0x9e, 0x8c, 0x3f,
+	0xea, 0x10, 0xd2, 0xa7, 0x79, 0xfe, 0x21, 0x9c, 0x9d, 0x38, 0x1a, 0xad, 0x25, 0x34, 0xce, 0x3d,
+	0x84, 0x9d, 0xf6, 0xa2, 0x87, 0xc0, 0x1f, 0x31, 0x9b, 0x49, 0x8b, 0xe8, 0x2f, 0x06, 0x5c, 0xca,
+	0xbe, 0xc3, 0x68, 0x7b, 0xb1, 0x50, 0x72, 0x73, 0x46, 0xe3, 0xf6, 0x72, 0x4a, 0x1a, 0xc2, 0xfd,
+	0xf3, 0x20, 0x2c, 0xde, 0x77, 0x15, 0x8a, 0xbd, 0xf7, 0x1f, 0x3f, 0x6d, 0x1a, 0x9f, 0x3f, 0x6d,
+	0x1a, 0xff, 0x7c, 0xda, 0x34, 0x7e, 0xf5, 0xac, 0x79, 0xe1, 0xf3, 0x67, 0xcd, 0x0b, 0x5f, 0x3e,
+	0x6b, 0x5e, 0xf8, 0xc9, 0x76, 0x10, 0xf2, 0x93, 0x61, 0x57, 0x4c, 0x83, 0x89, 0xc9, 0x89, 0x47,
+	0xbb, 0xd7, 0x0f, 0x71, 0xc4, 0xed, 0x20, 0xa6, 0x3d, 0xbb, 0x37, 0xe0, 0x4c, 0x3d, 0xe9, 0xdd,
+	0x15, 0xf9, 0xcf, 0xcb, 0xf6, 0x7f, 0x06, 0x00, 0x16, 0x02, 0x1e, 0xf6, 0x3d, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1319,6 +1711,15 @@ type ServiceClient interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// StoreProof queries the value of a key in a module store, along with its
+	// ICS-23 proof of existence or non-existence, at a given height.
+	StoreProof(ctx context.Context, in *StoreProofRequest, opts ...grpc.CallOption) (*StoreProofResponse, error)
+	// SearchTxs searches for the transactions matching an events query with the
+	// CometBFT tx_search RPC method, and returns them decoded.
+	SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error)
+	// SearchBlocks searches for the blocks matching an events query with the
+	// CometBFT block_search RPC method.
+	SearchBlocks(ctx context.Context, in *SearchBlocksRequest, opts ...grpc.CallOption) (*SearchBlocksResponse, error)
 }
 
 type serviceClient struct {
@@ -1392,6 +1793,33 @@ func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) StoreProof(ctx context.Context, in *StoreProofRequest, opts ...grpc.CallOption) (*StoreProofResponse, error) {
+	out := new(StoreProofResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/StoreProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SearchTxs(ctx context.Context, in *SearchTxsRequest, opts ...grpc.CallOption) (*SearchTxsResponse, error) {
+	out := new(SearchTxsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/SearchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SearchBlocks(ctx context.Context, in *SearchBlocksRequest, opts ...grpc.CallOption) (*SearchBlocksResponse, error) {
+	out := new(SearchBlocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/SearchBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// GetNodeInfo queries the current node info.
@@ -1410,6 +1838,15 @@ type ServiceServer interface {
 	// application, bypassing Tendermint completely. The ABCI query must contain
 	// a valid and supported path, including app, custom, p2p, and store.
 	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// StoreProof queries the value of a key in a module store, along with its
+	// ICS-23 proof of existence or non-existence, at a given height.
+	StoreProof(context.Context, *StoreProofRequest) (*StoreProofResponse, error)
+	// SearchTxs searches for the transactions matching an events query with the
+	// CometBFT tx_search RPC method, and returns them decoded.
+	SearchTxs(context.Context, *SearchTxsRequest) (*SearchTxsResponse, error)
+	// SearchBlocks searches for the blocks matching an events query with the
+	// CometBFT block_search RPC method.
+	SearchBlocks(context.Context, *SearchBlocksRequest) (*SearchBlocksResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1437,6 +1874,15 @@ func (*UnimplementedServiceServer) GetValidatorSetByHeight(ctx context.Context,
 func (*UnimplementedServiceServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABCIQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
 }
+func (*UnimplementedServiceServer) StoreProof(ctx context.Context, req *StoreProofRequest) (*StoreProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreProof not implemented")
+}
+func (*UnimplementedServiceServer) SearchTxs(ctx context.Context, req *SearchTxsRequest) (*SearchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTxs not implemented")
+}
+func (*UnimplementedServiceServer) SearchBlocks(ctx context.Context, req *SearchBlocksRequest) (*SearchBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlocks not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1568,26 +2014,80 @@ func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Service_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.base.tendermint.v1beta1.Service",
-	HandlerType: (*ServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetNodeInfo",
-			Handler:    _Service_GetNodeInfo_Handler,
-		},
-		{
-			MethodName: "GetSyncing",
-			Handler:    _Service_GetSyncing_Handler,
-		},
-		{
-			MethodName: "GetLatestBlock",
-			Handler:    _Service_GetLatestBlock_Handler,
-		},
-		{
-			MethodName: "GetBlockByHeight",
-			Handler:    _Service_GetBlockByHeight_Handler,
-		},
+func _Service_StoreProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/StoreProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreProof(ctx, req.(*StoreProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SearchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/SearchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchTxs(ctx, req.(*SearchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SearchBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/SearchBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchBlocks(ctx, req.(*SearchBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.tendermint.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _Service_GetNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetSyncing",
+			Handler:    _Service_GetSyncing_Handler,
+		},
+		{
+			MethodName: "GetLatestBlock",
+			Handler:    _Service_GetLatestBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockByHeight",
+			Handler:    _Service_GetBlockByHeight_Handler,
+		},
 		{
 			MethodName: "GetLatestValidatorSet",
 			Handler:    _Service_GetLatestValidatorSet_Handler,
@@ -1600,6 +2100,18 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ABCIQuery",
 			Handler:    _Service_ABCIQuery_Handler,
 		},
+		{
+			MethodName: "StoreProof",
+			Handler:    _Service_StoreProof_Handler,
+		},
+		{
+			MethodName: "SearchTxs",
+			Handler:    _Service_SearchTxs_Handler,
+		},
+		{
+			MethodName: "SearchBlocks",
+			Handler:    _Service_SearchBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
@@ -2402,7 +2914,7 @@ func (m *ABCIQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProofOp) Marshal() (dAtA []byte, err error) {
+func (m *StoreProofRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2412,22 +2924,20 @@ func (m *ProofOp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProofOp) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreProofRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProofOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StoreProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
@@ -2436,17 +2946,17 @@ func (m *ProofOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProofOps) Marshal() (dAtA []byte, err error) {
+func (m *StoreProofResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2456,138 +2966,393 @@ func (m *ProofOps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProofOps) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreProofResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProofOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StoreProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ops) > 0 {
-		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.ProofOps != nil {
+		{
+			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SearchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetValidatorSetByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *SearchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetValidatorSetByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SearchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetLatestValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SearchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetLatestValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SearchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SearchBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ProposerPriority != 0 {
-		n += 1 + sovQuery(uint64(m.ProposerPriority))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofOps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofOps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetValidatorSetByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetValidatorSetByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ProposerPriority != 0 {
+		n += 1 + sovQuery(uint64(m.ProposerPriority))
+	}
+	return n
 }
 
 func (m *GetBlockByHeightRequest) Size() (n int) {
@@ -2829,13 +3594,13 @@ func (m *ABCIQueryResponse) Size() (n int) {
 	return n
 }
 
-func (m *ProofOp) Size() (n int) {
+func (m *StoreProofRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Type)
+	l = len(m.StoreName)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -2843,30 +3608,142 @@ func (m *ProofOp) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Data)
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *StoreProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *ProofOps) Size() (n int) {
+func (m *SearchTxsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Ops) > 0 {
-		for _, e := range m.Ops {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SearchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *SearchBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofOps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -4254,7 +5131,635 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildTags = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildDeps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildDeps = append(m.BuildDeps, &Module{})
+			if err := m.BuildDeps[len(m.BuildDeps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSdkVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSdkVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Module) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Module: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Module: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ABCIQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ABCIQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ABCIQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ABCIQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4282,11 +5787,11 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4314,13 +5819,13 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppName = string(dAtA[iNdEx:postIndex])
+			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4330,29 +5835,16 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4362,29 +5854,31 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4394,27 +5888,48 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildTags = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4442,11 +5957,11 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildDeps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4473,14 +5988,66 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildDeps = append(m.BuildDeps, &Module{})
-			if err := m.BuildDeps[len(m.BuildDeps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProofOps == nil {
+				m.ProofOps = &v12.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSdkVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4508,8 +6075,61 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosSdkVersion = string(dAtA[iNdEx:postIndex])
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4531,7 +6151,7 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Module) Unmarshal(dAtA []byte) error {
+func (m *StoreProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4554,17 +6174,17 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Module: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Module: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4574,29 +6194,31 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4606,29 +6228,16 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4638,23 +6247,27 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sum = string(dAtA[iNdEx:postIndex])
+			if m.ProofOps == nil {
+				m.ProofOps = &v12.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4677,7 +6290,7 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
+func (m *SearchTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4700,17 +6313,17 @@ func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ABCIQueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ABCIQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4720,29 +6333,27 @@ func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4770,13 +6381,13 @@ func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			m.Height = 0
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4786,16 +6397,16 @@ func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.Page |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4805,12 +6416,11 @@ func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4832,7 +6442,7 @@ func (m *ABCIQueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *SearchTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4855,36 +6465,17 @@ func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ABCIQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ABCIQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4894,80 +6485,83 @@ func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Log = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if m.Result == nil {
+				m.Result = &types.SearchTxsResult{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4977,31 +6571,29 @@ func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5011,31 +6603,29 @@ func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			m.Height = 0
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5045,16 +6635,16 @@ func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.Page |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5064,27 +6654,64 @@ func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5111,10 +6738,10 @@ func (m *ABCIQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProofOps == nil {
-				m.ProofOps = &v12.ProofOps{}
+			if m.Result == nil {
+				m.Result = &types.SearchBlocksResult{}
 			}
-			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
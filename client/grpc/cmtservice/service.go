@@ -2,6 +2,8 @@ package cmtservice
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -10,6 +12,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -264,6 +268,44 @@ func (s queryServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABC
 	}, nil
 }
 
+// StoreProof implements ServiceServer.StoreProof
+func (s queryServer) StoreProof(ctx context.Context, req *StoreProofRequest) (*StoreProofResponse, error) {
+	if s.queryFn == nil {
+		return nil, status.Error(codes.Internal, "ABCI Query handler undefined")
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StoreName == "" || strings.Contains(req.StoreName, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid store name: %q", req.StoreName)
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+	if req.Height < 0 || req.Height == 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d: proofs are only available for heights greater than 1", req.Height)
+	}
+
+	res, err := s.queryFn(ctx, &abci.QueryRequest{
+		Path:   fmt.Sprintf("/%s/%s/key", baseapp.QueryPathStore, req.StoreName),
+		Data:   req.Key,
+		Height: req.Height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, errorsmod.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	return &StoreProofResponse{
+		Value:    res.Value,
+		Height:   res.Height,
+		ProofOps: res.ProofOps,
+	}, nil
+}
+
 // RegisterTendermintService registers the CometBFT queries on the gRPC router.
 func RegisterTendermintService(
 	clientCtx client.Context,
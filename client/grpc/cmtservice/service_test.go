@@ -0,0 +1,147 @@
+package cmtservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestStoreProof(t *testing.T) {
+	var queried *abci.QueryRequest
+	queryFn := func(_ context.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+		queried = req
+		if req.Height > 10 {
+			return &abci.QueryResponse{
+				Codespace: sdkerrors.ErrInvalidHeight.Codespace(),
+				Code:      sdkerrors.ErrInvalidHeight.ABCICode(),
+				Log:       "height in the future",
+			}, nil
+		}
+
+		return &abci.QueryResponse{
+			Value:    []byte("value"),
+			Height:   10,
+			ProofOps: &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{{Type: "ics23:iavl"}, {Type: "ics23:simple"}}},
+		}, nil
+	}
+	srv := cmtservice.NewQueryServer(client.Context{}, nil, queryFn)
+
+	res, err := srv.StoreProof(context.Background(), &cmtservice.StoreProofRequest{StoreName: "bank", Key: []byte{0x01}})
+	require.NoError(t, err)
+	require.Equal(t, "/store/bank/key", queried.Path)
+	require.Equal(t, []byte{0x01}, queried.Data)
+	require.True(t, queried.Prove)
+	require.Equal(t, []byte("value"), res.Value)
+	require.Equal(t, int64(10), res.Height)
+	require.Len(t, res.ProofOps.Ops, 2)
+
+	// query errors are returned
+	_, err = srv.StoreProof(context.Background(), &cmtservice.StoreProofRequest{StoreName: "bank", Key: []byte{0x01}, Height: 11})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	// invalid requests are rejected before querying
+	for _, req := range []*cmtservice.StoreProofRequest{
+		nil,
+		{Key: []byte{0x01}},
+		{StoreName: "bank/key", Key: []byte{0x01}},
+		{StoreName: "bank"},
+		{StoreName: "bank", Key: []byte{0x01}, Height: -1},
+		{StoreName: "bank", Key: []byte{0x01}, Height: 1},
+	} {
+		queried = nil
+		_, err := srv.StoreProof(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Nil(t, queried)
+	}
+}
+
+// mockSearchClient is a CometRPC serving a single block at height 5 with a single tx.
+type mockSearchClient struct {
+	client.CometRPC
+
+	blockTime time.Time
+	txBz      []byte
+
+	page, perPage int
+	orderBy       string
+}
+
+func (m *mockSearchClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: *height, Time: m.blockTime}}}, nil
+}
+
+func (m *mockSearchClient) TxSearch(_ context.Context, _ string, _ bool, page, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+	m.page, m.perPage, m.orderBy = *page, *perPage, orderBy
+	return &coretypes.ResultTxSearch{
+		Txs:        []*coretypes.ResultTx{{Hash: []byte{0x01}, Height: 5, Tx: m.txBz}},
+		TotalCount: 1,
+	}, nil
+}
+
+func (m *mockSearchClient) BlockSearch(ctx context.Context, _ string, page, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
+	m.page, m.perPage, m.orderBy = *page, *perPage, orderBy
+	height := int64(5)
+	block, err := m.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultBlockSearch{Blocks: []*coretypes.ResultBlock{block}, TotalCount: 1}, nil
+}
+
+func TestSearch(t *testing.T) {
+	txConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).TxConfig
+	txBuilder := txConfig.NewTxBuilder()
+	txBuilder.SetMemo("memo")
+	txBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	node := &mockSearchClient{blockTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), txBz: txBz}
+	srv := cmtservice.NewQueryServer(client.Context{}.WithClient(node).WithTxConfig(txConfig), nil, nil)
+
+	txsRes, err := srv.SearchTxs(context.Background(), &cmtservice.SearchTxsRequest{Query: "tx.height=5", OrderBy: "desc", Page: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, node.page)
+	require.Equal(t, 100, node.perPage)
+	require.Equal(t, "desc", node.orderBy)
+	require.Equal(t, uint64(1), txsRes.Result.TotalCount)
+	require.Len(t, txsRes.Result.Txs, 1)
+	require.Equal(t, int64(5), txsRes.Result.Txs[0].Height)
+	require.Equal(t, "2024-01-01T00:00:00Z", txsRes.Result.Txs[0].Timestamp)
+
+	var decoded tx.Tx
+	require.NoError(t, decoded.Unmarshal(txsRes.Result.Txs[0].Tx.Value))
+	require.Equal(t, "memo", decoded.Body.Memo)
+
+	blocksRes, err := srv.SearchBlocks(context.Background(), &cmtservice.SearchBlocksRequest{Query: "block.height=5", Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, node.page)
+	require.Equal(t, 10, node.perPage)
+	require.Len(t, blocksRes.Result.Blocks, 1)
+	require.Equal(t, int64(5), blocksRes.Result.Blocks[0].Header.Height)
+
+	// invalid requests are rejected before searching
+	for _, req := range []*cmtservice.SearchTxsRequest{
+		nil,
+		{},
+		{Query: "tx.height=5", OrderBy: "random"},
+		{Query: "tx.height=5", Limit: 101},
+	} {
+		_, err := srv.SearchTxs(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -41,6 +41,7 @@ const (
 	flagPubKeyBase64 = "pubkey-base64"
 	flagIndiscreet   = "indiscreet"
 	flagMnemonicSrc  = "source"
+	flagPassphrase   = "bip39-passphrase"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -58,6 +59,10 @@ and encrypted with the given password. The only input that is required is the en
 
 If run with -i, it will prompt the user for BIP44 path, BIP39 mnemonic, and passphrase.
 The flag --recover allows one to recover a key from a seed passphrase.
+The flag --bip39-passphrase prompts for a BIP39 passphrase (the "25th word") combined with
+the mnemonic to derive the key. The passphrase is never stored: it is required, along with
+the mnemonic, to recover the key. It cannot be used with --ledger, the passphrase of a Ledger
+device is set on the device itself.
 If run with --dry-run, a key would be generated (or recovered) but not stored to the
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
@@ -96,6 +101,7 @@ Example:
 	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
 	f.Bool(flagIndiscreet, false, "Print seed phrase directly on current terminal (only valid when --no-backup is false)")
 	f.String(flagMnemonicSrc, "", "Import mnemonic from a file (only usable when recover or interactive is passed)")
+	f.Bool(flagPassphrase, false, "Prompt for a BIP39 passphrase combined with the mnemonic to derive the key (it is never stored)")
 
 	// support old flags name for backwards compatibility
 	f.SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -137,6 +143,7 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return errors.New("the provided name is invalid or empty after trimming whitespace")
 	}
 	interactive, _ := cmd.Flags().GetBool(flagInteractive)
+	usePassphrase, _ := cmd.Flags().GetBool(flagPassphrase)
 	kb := ctx.Keyring
 	outputFormat := ctx.OutputFormat
 
@@ -270,16 +277,19 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	hdPath, _ := cmd.Flags().GetString(flagHDPath)
 	useLedger, _ := cmd.Flags().GetBool(flags.FlagUseLedger)
 
-	if len(hdPath) == 0 {
-		hdPath = hd.CreateHDPath(coinType, account, index).String()
-	} else if useLedger {
-		return errors.New("cannot set custom bip32 path with ledger")
-	}
-
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if useLedger {
+		if usePassphrase {
+			return fmt.Errorf("flag --%s cannot be used with a Ledger device, set the passphrase on the device instead", flagPassphrase)
+		}
+
 		bech32PrefixAccAddr := ctx.AddressPrefix
-		k, err := kb.SaveLedgerKey(name, hd.Secp256k1, bech32PrefixAccAddr, coinType, account, index)
+		var k *keyring.Record
+		if len(hdPath) == 0 {
+			k, err = kb.SaveLedgerKey(name, hd.Secp256k1, bech32PrefixAccAddr, coinType, account, index)
+		} else {
+			k, err = kb.SaveLedgerKeyWithPath(name, hd.Secp256k1, bech32PrefixAccAddr, hdPath)
+		}
 		if err != nil {
 			return err
 		}
@@ -287,6 +297,10 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
 	}
 
+	if len(hdPath) == 0 {
+		hdPath = hd.CreateHDPath(coinType, account, index).String()
+	}
+
 	// Get bip39 mnemonic
 	var mnemonic, bip39Passphrase string
 
@@ -340,25 +354,11 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	}
 
 	// override bip39 passphrase
-	if interactive {
-		bip39Passphrase, err = input.GetSecretString(
-			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
-				"Most users should just hit enter to use the default, \"\"\n", inBuf)
+	if interactive || usePassphrase {
+		bip39Passphrase, err = readBIP39Passphrase(inBuf)
 		if err != nil {
 			return err
 		}
-
-		// if they use one, make them re-enter it
-		if len(bip39Passphrase) != 0 {
-			p2, err := input.GetSecretString("Repeat the passphrase:\n", inBuf)
-			if err != nil {
-				return err
-			}
-
-			if bip39Passphrase != p2 {
-				return errors.New("passphrases don't match")
-			}
-		}
 	}
 
 	k, err := kb.NewAccount(name, mnemonic, bip39Passphrase, hdPath, algo)
@@ -377,7 +377,16 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		mnemonic = ""
 	}
 
-	return printCreate(ctx, cmd, k, showMnemonic, showMnemonicIndiscreetly, mnemonic, outputFormat)
+	if err := printCreate(ctx, cmd, k, showMnemonic, showMnemonicIndiscreetly, mnemonic, outputFormat); err != nil {
+		return err
+	}
+
+	// the mnemonic alone does not recover a key derived with a passphrase
+	if showMnemonic && bip39Passphrase != "" && outputFormat == flags.OutputFormatText {
+		cmd.PrintErrln("\n**Important** this key is derived with a bip39 passphrase, which is not stored.\nIt is required along with the mnemonic phrase to recover your account.")
+	}
+
+	return nil
 }
 
 func printCreate(ctx client.Context, cmd *cobra.Command, k *keyring.Record, showMnemonic, showMnemonicIndiscreetly bool, mnemonic, outputFormat string) error {
@@ -429,6 +438,31 @@ func printCreate(ctx client.Context, cmd *cobra.Command, k *keyring.Record, show
 	return nil
 }
 
+// readBIP39Passphrase prompts for the bip39 passphrase, and for its
+// confirmation if it is not empty.
+func readBIP39Passphrase(inBuf *bufio.Reader) (string, error) {
+	bip39Passphrase, err := input.GetSecretString(
+		"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
+			"Most users should just hit enter to use the default, \"\"\n", inBuf)
+	if err != nil {
+		return "", err
+	}
+
+	// if they use one, make them re-enter it
+	if len(bip39Passphrase) != 0 {
+		p2, err := input.GetSecretString("Repeat the passphrase:\n", inBuf)
+		if err != nil {
+			return "", err
+		}
+
+		if bip39Passphrase != p2 {
+			return "", errors.New("passphrases don't match")
+		}
+	}
+
+	return bip39Passphrase, nil
+}
+
 func readMnemonicFromFile(filePath string) (string, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
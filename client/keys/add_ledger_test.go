@@ -144,6 +144,54 @@ func Test_runAddCmdLedger(t *testing.T) {
 		pub.String())
 }
 
+func Test_runAddCmdLedgerWithHDPath(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{
+		"keyname1",
+		fmt.Sprintf("--%s=true", flags.FlagUseLedger),
+		fmt.Sprintf("--%s=m/44'/330'/0'/0/0", flagHDPath),
+		fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyType, hd.Secp256k1Type),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	mockIn.Reset("test1234\ntest1234\n")
+
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = kb.Delete("keyname1")
+	})
+
+	mockIn.Reset("test1234\n")
+	key1, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/330'/0'/0/0", key1.GetLedger().GetPath().String())
+
+	// the key is the same as the one derived with the coin type 330
+	pub, err := key1.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t,
+		"PubKeySecp256k1{03028F0D5A9FD41600191CDEFDEA05E77A68DFBCE286241C0190805B9346667D07}",
+		pub.String())
+}
+
 func Test_runAddCmdLedgerDryRun(t *testing.T) {
 	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
 	testData := []struct {
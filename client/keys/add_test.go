@@ -398,3 +398,72 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func TestAddRecoverWithBIP39Passphrase(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithInput(mockIn).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	const (
+		mnemonic   = "decide praise business actor peasant farm drastic weather extend front hurt later song give verb rhythm worry fun pond reform school tumble august one"
+		passphrase = "25th word"
+	)
+
+	args := func(name string, extra ...string) []string {
+		return append([]string{
+			name,
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			fmt.Sprintf("--%s", flagRecover),
+		}, extra...)
+	}
+
+	cmd.SetArgs(args("nopassphrase"))
+	mockIn.Reset(mnemonic + "\n")
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	cmd.SetArgs(args("passphrase", fmt.Sprintf("--%s", flagPassphrase)))
+	mockIn.Reset(mnemonic + "\n" + passphrase + "\n" + passphrase + "\n")
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	// passphrases don't match
+	cmd.SetArgs(args("mismatch", fmt.Sprintf("--%s", flagPassphrase)))
+	mockIn.Reset(mnemonic + "\n" + passphrase + "\n" + "fail\n")
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "passphrases don't match")
+
+	// the passphrase of a Ledger device is set on the device
+	cmd.SetArgs(args("ledger", fmt.Sprintf("--%s", flagPassphrase), fmt.Sprintf("--%s", flags.FlagUseLedger)))
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "cannot be used with a Ledger device")
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	noPassphraseKey, err := kb.Key("nopassphrase")
+	require.NoError(t, err)
+	passphraseKey, err := kb.Key("passphrase")
+	require.NoError(t, err)
+
+	noPassphraseAddr, err := noPassphraseKey.GetAddress()
+	require.NoError(t, err)
+	passphraseAddr, err := passphraseKey.GetAddress()
+	require.NoError(t, err)
+	require.NotEqual(t, noPassphraseAddr, passphraseAddr)
+
+	// the key is derived from both the mnemonic and the passphrase
+	derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, passphrase, sdk.FullFundraiserPath)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(hd.Secp256k1.Generate()(derivedPriv).PubKey().Address()), passphraseAddr)
+}
@@ -42,6 +42,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		ExportKeyCommand(),
 		ImportKeyCommand(),
 		ImportKeyHexCommand(),
+		ExportSharesCommand(),
+		ImportSharesCommand(),
 		ListKeysCmd(),
 		ListKeyTypesCmd(),
 		ShowKeysCmd(),
@@ -0,0 +1,151 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/shamir"
+)
+
+const (
+	flagThreshold = "threshold"
+	flagShares    = "shares"
+)
+
+// ExportSharesCommand exports private keys from the key store as Shamir shares.
+func ExportSharesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-shares <name>",
+		Short: "Export private keys as Shamir secret shares",
+		Long: `Export a private key from the local keyring in ASCII-armored encrypted format, split into
+Shamir secret shares, printed one per line. Any --threshold of the --shares shares recover the key
+with import-shares, while fewer shares reveal nothing about it. The key remains encrypted with the
+given passphrase, which is also required to recover it.
+
+Every share is checksummed to detect its corruption, and carries a fingerprint of the key to detect
+shares of different keys.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+			threshold, _ := cmd.Flags().GetInt(flagThreshold)
+			n, _ := cmd.Flags().GetInt(flagShares)
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
+			if err != nil {
+				return err
+			}
+
+			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			shares, err := shamir.Split([]byte(armored), n, threshold)
+			if err != nil {
+				return err
+			}
+
+			for _, share := range shares {
+				cmd.Println(share.String())
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagThreshold, 2, "Number of shares required to recover the key")
+	cmd.Flags().Int(flagShares, 3, "Number of shares to split the key into")
+
+	return cmd
+}
+
+// ImportSharesCommand imports private keys exported with ExportSharesCommand.
+func ImportSharesCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-shares <name> [share-file...]",
+		Short: "Import private keys from Shamir secret shares into the local keybase",
+		Long: `Import a private key exported with export-shares into the local keybase.
+The shares are read from the given files, one share per file, or prompted for if no file is given.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			if strings.TrimSpace(name) == "" {
+				return errors.New("the provided name is invalid or empty after trimming whitespace")
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			var shares []shamir.Share
+			if len(args) > 1 {
+				shares, err = readSharesFromFiles(args[1:])
+			} else {
+				shares, err = readShares(buf)
+			}
+			if err != nil {
+				return err
+			}
+
+			armored, err := shamir.Combine(shares)
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.Keyring.ImportPrivKey(name, string(armored), passphrase)
+		},
+	}
+}
+
+func readSharesFromFiles(files []string) ([]shamir.Share, error) {
+	shares := make([]shamir.Share, len(files))
+	for i, file := range files {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		shares[i], err = shamir.ParseShare(strings.TrimSpace(string(bz)))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+	}
+
+	return shares, nil
+}
+
+// readShares prompts for shares until the threshold of the first one is reached.
+func readShares(buf *bufio.Reader) ([]shamir.Share, error) {
+	var shares []shamir.Share
+	for len(shares) == 0 || len(shares) < shares[0].Threshold {
+		str, err := input.GetSecretString(fmt.Sprintf("Enter share %d:", len(shares)+1), buf)
+		if err != nil {
+			return nil, err
+		}
+
+		share, err := shamir.ParseShare(strings.TrimSpace(str))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,104 @@
+package keys
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_runExportImportSharesCmd(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kbHome := t.TempDir()
+	const passphrase = "12345678"
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+	k, err := kb.NewAccount("keyname1", testdata.TestMnemonic, "", sdk.GetFullBIP44Path(), hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	execute := func(cmd *cobra.Command, args []string, userInput string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		))
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(userInput)
+		mockInBuf := bufio.NewReader(mockIn)
+
+		clientCtx := client.Context{}.
+			WithKeyringDir(kbHome).
+			WithKeyring(kb).
+			WithInput(mockInBuf).
+			WithCodec(cdc)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		err := cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	_, err = execute(ExportSharesCommand(), []string{"keyname1", "--threshold=3", "--shares=2"}, passphrase+"\n")
+	require.ErrorContains(t, err, "at least the threshold")
+
+	out, err := execute(ExportSharesCommand(), []string{"keyname1", "--threshold=2", "--shares=3"}, passphrase+"\n")
+	require.NoError(t, err)
+	shares := strings.Fields(out)
+	require.Len(t, shares, 3)
+
+	// import from prompted shares
+	_, err = execute(ImportSharesCommand(), []string{"prompted"}, shares[2]+"\n"+shares[0]+"\n"+passphrase+"\n")
+	require.NoError(t, err)
+
+	// import from share files
+	dir := t.TempDir()
+	files := []string{filepath.Join(dir, "share1"), filepath.Join(dir, "share2")}
+	require.NoError(t, os.WriteFile(files[0], []byte(shares[1]+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(files[1], []byte(shares[2]), 0o600))
+	_, err = execute(ImportSharesCommand(), append([]string{"fromfiles"}, files...), passphrase+"\n")
+	require.NoError(t, err)
+
+	for _, name := range []string{"prompted", "fromfiles"} {
+		imported, err := kb.Key(name)
+		require.NoError(t, err)
+		importedAddr, err := imported.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, addr, importedAddr)
+	}
+
+	// a single share is not enough
+	_, err = execute(ImportSharesCommand(), []string{"notenough", files[0]}, passphrase+"\n")
+	require.ErrorContains(t, err, "2 shares are required")
+
+	// a corrupted share is rejected
+	corrupted := []byte(shares[0])
+	if corrupted[20] == '0' {
+		corrupted[20] = '1'
+	} else {
+		corrupted[20] = '0'
+	}
+	_, err = execute(ImportSharesCommand(), []string{"corrupted"}, string(corrupted)+"\n")
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	// the wrong passphrase fails to decrypt the key
+	_, err = execute(ImportSharesCommand(), []string{"wrongpassphrase"}, shares[0]+"\n"+shares[1]+"\n"+"wrongpass\n")
+	require.Error(t, err)
+}
@@ -16,6 +16,8 @@ func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra
 		ListSnapshotsCmd,
 		RestoreSnapshotCmd(appCreator),
 		ExportSnapshotCmd(appCreator),
+		ExportStoresCmd(appCreator),
+		RestoreStoresCmd(appCreator),
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
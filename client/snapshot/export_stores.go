@@ -0,0 +1,83 @@
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// ExportStoresCmd returns a command to export the state of some modules as a partial snapshot
+func ExportStoresCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-stores <store-name>...",
+		Short: "Export the state of some modules to a partial snapshot file",
+		Long: `Export the state of the given stores, usually named after their module (e.g. bank staking),
+to a partial snapshot file. Partial snapshots can't be used for state sync, they are meant for analytics
+and partial migrations, and are restored into a fresh node with the restore-stores command.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := client.GetConfigFromCmd(cmd)
+			viper := client.GetViperFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64("height")
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			home := cfg.RootDir
+			db, err := openDB(home, server.GetAppDBBackend(viper))
+			if err != nil {
+				return err
+			}
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, viper)
+
+			if height == 0 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+
+			if output == "" {
+				output = fmt.Sprintf("%d-stores.snapshot", height)
+			}
+
+			sm := app.SnapshotManager()
+
+			fp, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer fp.Close()
+
+			cmd.Printf("Exporting stores %v at height %d\n", args, height)
+
+			bufWriter := bufio.NewWriter(fp)
+			if err := sm.ExportStores(uint64(height), args, bufWriter); err != nil {
+				return err
+			}
+			if err := bufWriter.Flush(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Partial snapshot written to %s\n", output)
+			return fp.Close()
+		},
+	}
+
+	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().StringP("output", "o", "", "output file, default to <height>-stores.snapshot")
+
+	return cmd
+}
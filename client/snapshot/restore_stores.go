@@ -0,0 +1,58 @@
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// RestoreStoresCmd returns a command to restore a partial snapshot into a fresh node
+func RestoreStoresCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore-stores <height> <snapshot-file>",
+		Short: "Restore a partial snapshot file into a fresh node",
+		Long: `Restore a partial snapshot file written by the export-stores command at the height it was exported at.
+The node must not have any state, the stores missing from the snapshot are left empty.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := client.GetConfigFromCmd(cmd)
+			viper := client.GetViperFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fp, err := os.Open(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to open snapshot file: %w", err)
+			}
+			defer fp.Close()
+
+			home := cfg.RootDir
+			db, err := openDB(home, server.GetAppDBBackend(viper))
+			if err != nil {
+				return err
+			}
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, viper)
+
+			if version := app.CommitMultiStore().LastCommitID().Version; version != 0 {
+				return fmt.Errorf("cannot restore a partial snapshot into a node with state at height %d", version)
+			}
+
+			sm := app.SnapshotManager()
+
+			return sm.RestoreStores(height, bufio.NewReader(fp))
+		},
+	}
+}
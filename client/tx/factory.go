@@ -46,6 +46,9 @@ type Factory struct {
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
+	gasPricesAuto      bool
+	gasPricesDenom     string
+	gasPricesURL       string
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -122,6 +125,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasPricesStr := clientCtx.Viper.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	gasPricesURL := clientCtx.Viper.GetString(flags.FlagGasPricesURL)
+	f = f.WithGasPricesURL(gasPricesURL)
+
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
 	return f, nil
@@ -136,12 +142,18 @@ func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) GasPricesURL() string                      { return f.gasPricesURL }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
 func (f Factory) Unordered() bool                           { return f.unordered }
 func (f Factory) FromName() string                          { return f.fromName }
 
+// GasPricesAuto returns whether the gas prices are queried from the node, or
+// the endpoint at GasPricesURL, when the factory is prepared, and the denom of
+// the gas price to pay the fees with, if any.
+func (f Factory) GasPricesAuto() (auto bool, denom string) { return f.gasPricesAuto, f.gasPricesDenom }
+
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
@@ -182,13 +194,27 @@ func (f Factory) WithFees(fees string) Factory {
 }
 
 // WithGasPrices returns a copy of the Factory with updated gas prices.
+// The gas prices "auto" and "auto:<denom>" are queried when the factory is
+// prepared, see QueryGasPrices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
+	if auto, denom := parseGasPricesAuto(gasPrices); auto {
+		f.gasPrices, f.gasPricesAuto, f.gasPricesDenom = nil, true, denom
+		return f
+	}
+
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 	if err != nil {
 		panic(err)
 	}
 
-	f.gasPrices = parsedGasPrices
+	f.gasPrices, f.gasPricesAuto, f.gasPricesDenom = parsedGasPrices, false, ""
+	return f
+}
+
+// WithGasPricesURL returns a copy of the Factory with an updated URL of the
+// endpoint recommending the gas prices queried with the gas prices "auto".
+func (f Factory) WithGasPricesURL(url string) Factory {
+	f.gasPricesURL = url
 	return f
 }
 
@@ -333,6 +359,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, errors.New("chain ID required but not specified")
 	}
 
+	if f.gasPricesAuto {
+		return nil, errors.New("gas prices auto must be queried before building the transaction")
+	}
+
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -386,6 +416,11 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
+	f, err := f.resolveGasPrices(clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if f.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -506,7 +541,8 @@ func (f Factory) getSimSignatureData(pk cryptotypes.PubKey) signing.SignatureDat
 
 // Prepare ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory.
+// they will be queried for and set on the provided Factory. The gas prices
+// "auto" are queried as well.
 // A new Factory with the updated fields will be returned.
 // Note: When in offline mode, the Prepare does nothing and returns the original factory.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
@@ -533,5 +569,5 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 		}
 	}
 
-	return fc, nil
+	return fc.resolveGasPrices(clientCtx)
 }
@@ -0,0 +1,142 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gasPricesTimeout is the timeout of the queries of the gas prices.
+const gasPricesTimeout = 10 * time.Second
+
+// GasPricesResponse is the response expected from the endpoints recommending
+// network-wide gas prices, e.g. {"gas_prices":[{"denom":"uatom","amount":"0.025"}]}.
+type GasPricesResponse struct {
+	GasPrices sdk.DecCoins `json:"gas_prices"`
+}
+
+// parseGasPricesAuto returns whether gasPrices is the "auto" value of the
+// --gas-prices flag, and the denom it selects, if any.
+func parseGasPricesAuto(gasPrices string) (auto bool, denom string) {
+	gasPrices = strings.TrimSpace(gasPrices)
+	if gasPrices == flags.GasPricesFlagAuto {
+		return true, ""
+	}
+	if denom, ok := strings.CutPrefix(gasPrices, flags.GasPricesFlagAuto+":"); ok {
+		return true, denom
+	}
+	return false, ""
+}
+
+// QueryGasPrices returns the gas prices to pay the fees of the transactions
+// with. They are the minimum gas prices of the node or, if url is not empty,
+// the gas prices recommended by the endpoint at url, raised to the minimum gas
+// prices of the node where they are lower.
+//
+// Only the gas price of denom is returned, or of the first denom if it is
+// empty, as the fees only need to be paid in one of them. No gas prices are
+// returned if the node has no minimum gas prices and no endpoint is queried.
+func QueryGasPrices(clientCtx gogogrpc.ClientConn, url, denom string) (sdk.DecCoins, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gasPricesTimeout)
+	defer cancel()
+
+	res, err := node.NewServiceClient(clientCtx).Config(ctx, &node.ConfigRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the minimum gas prices of the node: %w", err)
+	}
+	minGasPrices, err := sdk.ParseDecCoins(res.MinimumGasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid minimum gas prices of the node: %w", err)
+	}
+
+	gasPrices := minGasPrices
+	if url != "" {
+		recommended, err := queryRecommendedGasPrices(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		gasPrices = make(sdk.DecCoins, 0, len(recommended))
+		for _, gp := range recommended {
+			if minAmount := minGasPrices.AmountOf(gp.Denom); minAmount.GT(gp.Amount) {
+				gp = sdk.NewDecCoinFromDec(gp.Denom, minAmount)
+			}
+			gasPrices = append(gasPrices, gp)
+		}
+	}
+
+	if gasPrices.IsZero() {
+		return nil, nil
+	}
+	if denom == "" {
+		return gasPrices[:1], nil
+	}
+	for _, gp := range gasPrices {
+		if gp.Denom == denom {
+			return sdk.DecCoins{gp}, nil
+		}
+	}
+	return nil, fmt.Errorf("no gas price for denom %s, the gas prices are %s", denom, gasPrices)
+}
+
+// queryRecommendedGasPrices queries the gas prices recommended by the endpoint at url.
+func queryRecommendedGasPrices(ctx context.Context, url string) (sdk.DecCoins, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the recommended gas prices: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query the recommended gas prices: %s", resp.Status)
+	}
+
+	var res GasPricesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("invalid recommended gas prices: %w", err)
+	}
+	gasPrices := res.GasPrices.Sort()
+	if err := gasPrices.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid recommended gas prices: %w", err)
+	}
+	if gasPrices.IsZero() {
+		return nil, errors.New("no recommended gas prices")
+	}
+	return gasPrices, nil
+}
+
+// resolveGasPrices returns a copy of the Factory with the gas prices queried if
+// they were set to "auto".
+func (f Factory) resolveGasPrices(clientCtx client.Context) (Factory, error) {
+	if !f.gasPricesAuto {
+		return f, nil
+	}
+	if clientCtx.Offline {
+		return f, errors.New("cannot query the gas prices in offline mode")
+	}
+	if !f.fees.IsZero() {
+		return f, errors.New("cannot provide both fees and gas prices")
+	}
+
+	gasPrices, err := QueryGasPrices(clientCtx, f.gasPricesURL, f.gasPricesDenom)
+	if err != nil {
+		return f, err
+	}
+
+	f.gasPrices, f.gasPricesAuto, f.gasPricesDenom = gasPrices, false, ""
+	return f, nil
+}
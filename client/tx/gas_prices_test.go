@@ -0,0 +1,104 @@
+package tx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockNodeConfig is a mock gRPC client connection returning the given minimum
+// gas prices of the node.
+type mockNodeConfig struct {
+	minGasPrices string
+}
+
+func (m mockNodeConfig) Invoke(_ context.Context, _ string, _, reply interface{}, _ ...grpc.CallOption) error {
+	*(reply.(*node.ConfigResponse)) = node.ConfigResponse{MinimumGasPrice: m.minGasPrices}
+	return nil
+}
+
+func (mockNodeConfig) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestQueryGasPrices(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gas-prices":
+			_, _ = w.Write([]byte(`{"gas_prices":[{"denom":"uatom","amount":"0.01"},{"denom":"stake","amount":"0.5"}]}`))
+		case "/invalid":
+			_, _ = w.Write([]byte(`{"gas_prices":[{"denom":"stake","amount":"-1"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	decCoins := func(s string) sdk.DecCoins {
+		coins, err := sdk.ParseDecCoins(s)
+		require.NoError(t, err)
+		return coins
+	}
+
+	testCases := []struct {
+		name         string
+		minGasPrices string
+		url          string
+		denom        string
+		expected     sdk.DecCoins
+		expErr       string
+	}{
+		{"node minimum gas prices", "0.025uatom,0.1stake", "", "", decCoins("0.1stake"), ""},
+		{"node minimum gas price of denom", "0.025uatom,0.1stake", "", "uatom", decCoins("0.025uatom"), ""},
+		{"no node minimum gas prices", "", "", "", nil, ""},
+		{"unknown denom", "0.025uatom", "", "stake", nil, "no gas price for denom stake"},
+		{"recommended gas prices", "", srv.URL + "/gas-prices", "", decCoins("0.5stake"), ""},
+		{"recommended gas price raised to the node minimum", "0.025uatom", srv.URL + "/gas-prices", "uatom", decCoins("0.025uatom"), ""},
+		{"invalid recommended gas prices", "", srv.URL + "/invalid", "", nil, "invalid recommended gas prices"},
+		{"recommended gas prices not found", "", srv.URL + "/missing", "", nil, "404 Not Found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gasPrices, err := QueryGasPrices(mockNodeConfig{tc.minGasPrices}, tc.url, tc.denom)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, gasPrices)
+		})
+	}
+}
+
+func TestFactoryGasPricesAuto(t *testing.T) {
+	txCfg, _ := newTestTxConfig()
+	txf := Factory{}.
+		WithTxConfig(txCfg).
+		WithChainID("test-chain").
+		WithGas(100).
+		WithAccountRetriever(client.MockAccountRetriever{ReturnAccNum: 1, ReturnAccSeq: 1})
+
+	auto, denom := txf.WithGasPrices("auto:uatom").GasPricesAuto()
+	require.True(t, auto)
+	require.Equal(t, "uatom", denom)
+
+	// the gas prices must be queried before building the transaction
+	_, err := txf.WithGasPrices("auto").BuildUnsignedTx()
+	require.Error(t, err)
+
+	_, err = txf.WithGasPrices("auto").WithFees("10uatom").Prepare(client.Context{}.WithFrom("foo"))
+	require.ErrorContains(t, err, "cannot provide both fees and gas prices")
+
+	// the gas prices cannot be queried in offline mode
+	err = txf.WithGasPrices("auto").PrintUnsignedTx(client.Context{}.WithOffline(true))
+	require.ErrorContains(t, err, "offline mode")
+}
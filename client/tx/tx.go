@@ -3,6 +3,7 @@ package tx
 import (
 	"bufio"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -99,6 +101,12 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	// The private key of a watch-only key is not in the keyring, the tx can only
+	// be signed externally.
+	if isWatchOnly(txf, clientCtx.FromName) {
+		return printPendingTx(clientCtx, txf, tx)
+	}
+
 	if !clientCtx.SkipConfirm {
 		encoder := txf.txConfig.TxJSONEncoder()
 		if encoder == nil {
@@ -144,6 +152,52 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	return clientCtx.PrintProto(res)
 }
 
+// isWatchOnly returns true if the named key is a watch-only key, i.e. a key
+// stored in the keyring with its public key only.
+func isWatchOnly(txf Factory, name string) bool {
+	if txf.keybase == nil {
+		return false
+	}
+
+	k, err := txf.keybase.Key(name)
+	return err == nil && k.GetType() == keyring.TypeOffline
+}
+
+// printPendingTx prints the tx to be signed externally by the watch-only key
+// of the client context, and the bytes to sign. The tx is completed with the
+// signature by the sign command.
+func printPendingTx(clientCtx client.Context, txf Factory, unsignedTx client.TxBuilder) error {
+	// The sign command wraps the decoded tx, which sets its default fee payer
+	// explicitly. It is done here as well for the sign bytes to match.
+	tx, err := txf.txConfig.WrapTxBuilder(unsignedTx.GetTx())
+	if err != nil {
+		return err
+	}
+
+	bytesToSign, err := GetSignBytes(clientCtx.CmdContext, txf, clientCtx.FromName, tx, true)
+	if err != nil {
+		return err
+	}
+
+	encoder := txf.txConfig.TxJSONEncoder()
+	if encoder == nil {
+		return errors.New("failed to encode transaction: tx json encoder is nil")
+	}
+
+	txBytes, err := encoder(tx.GetTx())
+	if err != nil {
+		return fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	if err := clientCtx.PrintRaw(json.RawMessage(txBytes)); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "%s is a watch-only key, sign the following bytes externally and add the signature with the sign command:\n%s\n",
+		clientCtx.FromName, base64.StdEncoding.EncodeToString(bytesToSign))
+	return nil
+}
+
 // CalculateGas simulates the execution of a transaction and returns the
 // simulation response obtained by the query and the adjusted gas amount.
 func CalculateGas(
@@ -249,36 +303,101 @@ func checkMultipleSigners(tx authsigning.Tx) error {
 // return an error.
 // An error is returned upon failure.
 func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
+	signMode, pubKey, bytesToSign, err := prepareSignature(ctx, txf, name, txBuilder, overwriteSig)
+	if err != nil {
+		return err
+	}
+
+	// Sign those bytes
+	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign, signMode)
+	if err != nil {
+		return err
+	}
+
+	return setSignature(txf, name, txBuilder, pubKey, signMode, sigBytes)
+}
+
+// GetSignBytes prepares a given tx to be signed with a named key, and returns the
+// bytes to sign. It is used for the watch-only keys, whose private key is not in
+// the keyring: the signer info of the key is added to the transaction builder with
+// an empty signature, overwriting the previous ones if overwrite=true (otherwise,
+// it will be appended), and the signature produced externally over the returned
+// bytes is then added with AddSignature.
+func GetSignBytes(ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) ([]byte, error) {
+	_, _, bytesToSign, err := prepareSignature(ctx, txf, name, txBuilder, overwriteSig)
+	return bytesToSign, err
+}
+
+// AddSignature sets the signature of a named key, produced externally over the
+// bytes returned by GetSignBytes, on a given tx. The tx must contain the empty
+// signature of the key added by GetSignBytes, which is replaced once the signature
+// is verified against the public key of the key.
+func AddSignature(ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, sigBytes []byte) error {
 	if txf.keybase == nil {
 		return errors.New("keybase must be set prior to signing a transaction")
 	}
 
+	k, err := txf.keybase.Key(name)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	i := pendingSignature(sigs, pubKey)
+	if i < 0 {
+		return fmt.Errorf("no pending signature for key %s", name)
+	}
+	signMode := sigs[i].Data.(*signing.SingleSignatureData).SignMode
+
+	bytesToSign, err := authsigning.GetSignBytesAdapter(ctx, txf.txConfig.SignModeHandler(), signMode, signerData(txf, pubKey), txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(bytesToSign, sigBytes) {
+		return sdkerrors.ErrUnauthorized.Wrapf("invalid signature for key %s", name)
+	}
+
+	return setSignature(txf, name, txBuilder, pubKey, signMode, sigBytes)
+}
+
+// prepareSignature adds the signer info of a named key to a given tx with an
+// empty signature, and returns the sign mode, the public key and the bytes to
+// sign.
+func prepareSignature(
+	ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool,
+) (signing.SignMode, cryptotypes.PubKey, []byte, error) {
+	if txf.keybase == nil {
+		return 0, nil, nil, errors.New("keybase must be set prior to signing a transaction")
+	}
+
 	var err error
 	signMode := txf.signMode
 	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
 		// use the SignModeHandler's default mode if unspecified
 		signMode, err = authsigning.APISignModeToInternal(txf.txConfig.SignModeHandler().DefaultMode())
 		if err != nil {
-			return err
+			return 0, nil, nil, err
 		}
 	}
 
 	k, err := txf.keybase.Key(name)
 	if err != nil {
-		return err
+		return 0, nil, nil, err
 	}
 
 	pubKey, err := k.GetPubKey()
 	if err != nil {
-		return err
-	}
-
-	signerData := authsigning.SignerData{
-		ChainID:       txf.chainID,
-		AccountNumber: txf.accountNumber,
-		Sequence:      txf.sequence,
-		PubKey:        pubKey,
-		Address:       sdk.AccAddress(pubKey.Address()).String(),
+		return 0, nil, nil, err
 	}
 
 	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
@@ -299,59 +418,57 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		Sequence: txf.Sequence(),
 	}
 
-	var prevSignatures []signing.SignatureV2
+	// Overwrite or append signer infos.
+	var sigs []signing.SignatureV2
 	if !overwriteSig {
-		prevSignatures, err = txBuilder.GetTx().GetSignaturesV2()
+		sigs, err = txBuilder.GetTx().GetSignaturesV2()
 		if err != nil {
-			return err
+			return 0, nil, nil, err
 		}
 	}
-	// Overwrite or append signer infos.
-	var sigs []signing.SignatureV2
-	if overwriteSig {
-		sigs = []signing.SignatureV2{sig}
-	} else {
-		sigs = append(sigs, prevSignatures...)
-		sigs = append(sigs, sig)
-	}
+	sigs = append(sigs, sig)
 	if err := txBuilder.SetSignatures(sigs...); err != nil {
-		return err
+		return 0, nil, nil, err
 	}
 
 	if err := checkMultipleSigners(txBuilder.GetTx()); err != nil {
-		return err
+		return 0, nil, nil, err
 	}
 
-	bytesToSign, err := authsigning.GetSignBytesAdapter(ctx, txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
+	bytesToSign, err := authsigning.GetSignBytesAdapter(ctx, txf.txConfig.SignModeHandler(), signMode, signerData(txf, pubKey), txBuilder.GetTx())
 	if err != nil {
-		return err
+		return 0, nil, nil, err
 	}
 
-	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign, signMode)
+	return signMode, pubKey, bytesToSign, nil
+}
+
+// setSignature replaces the empty signature of pubKey added by prepareSignature
+// with the given signature, and runs the optional preprocessing of the tx.
+func setSignature(
+	txf Factory, name string, txBuilder client.TxBuilder, pubKey cryptotypes.PubKey, signMode signing.SignMode, sigBytes []byte,
+) error {
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
 	if err != nil {
 		return err
 	}
 
-	// Construct the SignatureV2 struct
-	sigData = signing.SingleSignatureData{
-		SignMode:  signMode,
-		Signature: sigBytes,
-	}
-	sig = signing.SignatureV2{
-		PubKey:   pubKey,
-		Data:     &sigData,
-		Sequence: txf.Sequence(),
+	i := pendingSignature(sigs, pubKey)
+	if i < 0 {
+		return fmt.Errorf("no pending signature for key %s", name)
 	}
 
-	if overwriteSig {
-		err = txBuilder.SetSignatures(sig)
-	} else {
-		prevSignatures = append(prevSignatures, sig)
-		err = txBuilder.SetSignatures(prevSignatures...)
+	// Construct the SignatureV2 struct
+	sigs[i] = signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: sigBytes,
+		},
+		Sequence: txf.Sequence(),
 	}
 
-	if err != nil {
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
 		return fmt.Errorf("unable to set signatures on payload: %w", err)
 	}
 
@@ -360,6 +477,30 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 	return txf.PreprocessTx(name, txBuilder)
 }
 
+// pendingSignature returns the index of the last empty signature of pubKey in
+// sigs, or -1 if there is none.
+func pendingSignature(sigs []signing.SignatureV2, pubKey cryptotypes.PubKey) int {
+	for i := len(sigs) - 1; i >= 0; i-- {
+		data, ok := sigs[i].Data.(*signing.SingleSignatureData)
+		if ok && len(data.Signature) == 0 && sigs[i].PubKey != nil && sigs[i].PubKey.Equals(pubKey) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// signerData returns the signer data of pubKey for the tx built by txf.
+func signerData(txf Factory, pubKey cryptotypes.PubKey) authsigning.SignerData {
+	return authsigning.SignerData{
+		ChainID:       txf.chainID,
+		AccountNumber: txf.accountNumber,
+		Sequence:      txf.sequence,
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+	}
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
@@ -20,6 +20,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
@@ -372,6 +373,65 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignWatchOnly(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	countertypes.RegisterInterfaces(cdc.InterfaceRegistry())
+	requireT := require.New(t)
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	requireT.NoError(err)
+
+	// the private key is held outside of the keyring
+	from := "watch_key"
+	priv, otherPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	_, err = kb.SaveOfflineKey(from, priv.PubKey())
+	requireT.NoError(err)
+
+	msg := &countertypes.MsgIncreaseCounter{Signer: sdk.AccAddress(priv.PubKey().Address()).String(), Count: 1}
+	for _, signMode := range []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			requireT := require.New(t)
+			txf := mockTxFactory(txConfig).WithKeybase(kb).WithSignMode(signMode)
+			unsignedTx, err := txf.BuildUnsignedTx(msg)
+			requireT.NoError(err)
+			// as the sign command, the tx is signed from its encoding
+			txb, err := txConfig.WrapTxBuilder(unsignedTx.GetTx())
+			requireT.NoError(err)
+
+			requireT.ErrorContains(AddSignature(context.TODO(), txf, from, txb, []byte("signature")), "no pending signature")
+			requireT.ErrorIs(Sign(context.TODO(), txf, from, txb, true), keyring.ErrOfflineSign)
+
+			bytesToSign, err := GetSignBytes(context.TODO(), txf, from, txb, true)
+			requireT.NoError(err)
+			testSigners(requireT, txb.GetTx(), priv.PubKey())
+
+			// the pending tx is exported and imported back to be completed
+			txJSON, err := txConfig.TxJSONEncoder()(txb.GetTx())
+			requireT.NoError(err)
+			pendingTx, err := txConfig.TxJSONDecoder()(txJSON)
+			requireT.NoError(err)
+			txb, err = txConfig.WrapTxBuilder(pendingTx)
+			requireT.NoError(err)
+
+			otherSig, err := otherPriv.Sign(bytesToSign)
+			requireT.NoError(err)
+			requireT.ErrorContains(AddSignature(context.TODO(), txf, from, txb, otherSig), "invalid signature")
+
+			sig, err := priv.Sign(bytesToSign)
+			requireT.NoError(err)
+			requireT.NoError(AddSignature(context.TODO(), txf, from, txb, sig))
+
+			sigs := testSigners(requireT, txb.GetTx(), priv.PubKey())
+			requireT.Equal(&signingtypes.SingleSignatureData{SignMode: signMode, Signature: sig}, sigs[0].Data)
+
+			// the signature is set once
+			requireT.ErrorContains(AddSignature(context.TODO(), txf, from, txb, sig), "no pending signature")
+		})
+	}
+}
+
 func TestPreprocessHook(t *testing.T) {
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -20,6 +20,11 @@ type (
 		TxDecoder() sdk.TxDecoder
 		TxJSONEncoder() sdk.TxEncoder
 		TxJSONDecoder() sdk.TxDecoder
+		// TxCBOREncoder and TxCBORDecoder encode txs to a deterministic CBOR
+		// envelope, for signers in constrained environments (e.g. hardware
+		// wallets) where JSON is too heavy and raw protobuf is hard to inspect.
+		TxCBOREncoder() sdk.TxEncoder
+		TxCBORDecoder() sdk.TxDecoder
 		MarshalSignatureJSON([]signingtypes.SignatureV2) ([]byte, error)
 		UnmarshalSignatureJSON([]byte) ([]signingtypes.SignatureV2, error)
 	}
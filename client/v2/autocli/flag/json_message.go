@@ -2,14 +2,18 @@ package flag
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"regexp"
+	"strings"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 
 	"cosmossdk.io/client/v2/internal/util"
 )
@@ -21,10 +25,11 @@ type jsonMessageFlagType struct {
 }
 
 func (j jsonMessageFlagType) NewValue(_ *context.Context, builder *Builder) Value {
+	resolver := dynamicTypeResolver{builder}
 	return &jsonMessageFlagValue{
 		messageType:          util.ResolveMessageType(builder.TypeResolver, j.messageDesc),
-		jsonMarshalOptions:   protojson.MarshalOptions{Resolver: builder.TypeResolver},
-		jsonUnmarshalOptions: protojson.UnmarshalOptions{Resolver: builder.TypeResolver},
+		jsonMarshalOptions:   protojson.MarshalOptions{Resolver: resolver},
+		jsonUnmarshalOptions: protojson.UnmarshalOptions{Resolver: resolver},
 	}
 }
 
@@ -84,3 +89,46 @@ func (j *jsonMessageFlagValue) Set(s string) error {
 func (j *jsonMessageFlagValue) Type() string {
 	return fmt.Sprintf("%s (json)", j.messageType.Descriptor().FullName())
 }
+
+// dynamicTypeResolver resolves message types with the type resolver of the
+// builder, and falls back to dynamic message types built from the file resolver.
+// It lets the google.protobuf.Any values of JSON messages hold types without
+// generated protobuf-go code, such as app-specific evidence types.
+type dynamicTypeResolver struct {
+	builder *Builder
+}
+
+func (r dynamicTypeResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	typ, err := r.builder.TypeResolver.FindMessageByName(name)
+	if err == nil || !errors.Is(err, protoregistry.NotFound) {
+		return typ, err
+	}
+
+	desc, err := r.builder.FileResolver.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	return dynamicpb.NewMessageType(msgDesc), nil
+}
+
+func (r dynamicTypeResolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+func (r dynamicTypeResolver) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return r.builder.TypeResolver.FindExtensionByName(field)
+}
+
+func (r dynamicTypeResolver) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return r.builder.TypeResolver.FindExtensionByNumber(message, field)
+}
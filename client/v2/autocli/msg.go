@@ -2,6 +2,7 @@ package autocli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
@@ -12,6 +13,7 @@ import (
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/client/v2/chainidentity"
 	"cosmossdk.io/client/v2/internal/flags"
 	"cosmossdk.io/client/v2/internal/util"
 	addresscodec "cosmossdk.io/core/address"
@@ -127,6 +129,10 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 		clientCtx = clientCtx.WithCmdContext(cmd.Context())
 		clientCtx = clientCtx.WithOutput(cmd.OutOrStdout())
 
+		if err := verifyChainIdentity(cmd, clientCtx); err != nil {
+			return err
+		}
+
 		fd := input.Descriptor().Fields().ByName(protoreflect.Name(flag.GetSignerFieldName(input.Descriptor())))
 		addressCodec := b.Builder.AddressCodec
 
@@ -175,6 +181,7 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 	if b.AddTxConnFlags != nil {
 		b.AddTxConnFlags(cmd)
 	}
+	addChainIdentityFlags(cmd)
 
 	// silence usage only for inner txs & queries commands
 	cmd.SilenceUsage = true
@@ -226,3 +233,40 @@ func (b *Builder) handleGovProposal(
 
 	return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
 }
+
+// addChainIdentityFlags adds the flags verifying the identity of the chain of the node before broadcasting.
+func addChainIdentityFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flags.FlagVerifyChain, false, "Verify that the node is part of the chain of --chain-id before broadcasting")
+	cmd.Flags().BytesHex(flags.FlagGenesisHash, nil, "Verify that the node has this genesis hash (hex) before broadcasting, implies --verify-chain")
+	cmd.Flags().Int64(flags.FlagTrustedHeight, 0, "Height of --trusted-app-hash")
+	cmd.Flags().BytesHex(flags.FlagTrustedAppHash, nil, "Verify that the node has this app hash (hex) at --trusted-height before broadcasting, implies --verify-chain")
+}
+
+// verifyChainIdentity verifies that the node is part of the expected chain when requested by the flags,
+// so that the transaction isn't signed against the wrong network. Nothing is verified when the transaction
+// is only generated.
+func verifyChainIdentity(cmd *cobra.Command, clientCtx client.Context) error {
+	verify, _ := cmd.Flags().GetBool(flags.FlagVerifyChain)
+	genesisHash, _ := cmd.Flags().GetBytesHex(flags.FlagGenesisHash)
+	trustedHeight, _ := cmd.Flags().GetInt64(flags.FlagTrustedHeight)
+	trustedAppHash, _ := cmd.Flags().GetBytesHex(flags.FlagTrustedAppHash)
+	if !verify && len(genesisHash) == 0 && trustedHeight == 0 && len(trustedAppHash) == 0 {
+		return nil
+	}
+
+	if clientCtx.GenerateOnly || clientCtx.Offline {
+		return nil
+	}
+
+	node, ok := clientCtx.Client.(chainidentity.Node)
+	if !ok {
+		return errors.New("the node client doesn't support the verification of the chain identity")
+	}
+
+	return chainidentity.Verify(cmd.Context(), node, chainidentity.Identity{
+		ChainID:        clientCtx.ChainID,
+		GenesisHash:    genesisHash,
+		TrustedHeight:  trustedHeight,
+		TrustedAppHash: trustedAppHash,
+	})
+}
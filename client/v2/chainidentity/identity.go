@@ -0,0 +1,118 @@
+// Package chainidentity verifies the identity of the chain a node is part of,
+// before sending it signed transactions, so that a misconfigured endpoint can't
+// make the users sign transactions against the wrong network.
+package chainidentity
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// Node is the connection to the node whose chain is verified. The CometBFT RPC
+// clients, e.g. *http.HTTP, implement it.
+type Node interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	GenesisChunked(ctx context.Context, id uint) (*coretypes.ResultGenesisChunk, error)
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+}
+
+// Identity is the expected identity of a chain.
+type Identity struct {
+	// ChainID is the chain id of the chain.
+	ChainID string
+	// GenesisHash is the hash of the genesis of the chain, as returned by
+	// GenesisHash. It is only verified if set.
+	GenesisHash []byte
+	// TrustedHeight and TrustedAppHash are the app hash in the header of the
+	// block at a trusted height of the chain. They are only verified if set.
+	TrustedHeight  int64
+	TrustedAppHash []byte
+}
+
+// Validate validates the expected identity.
+func (id Identity) Validate() error {
+	if id.ChainID == "" {
+		return errors.New("empty chain id")
+	}
+	if id.TrustedHeight < 0 {
+		return fmt.Errorf("invalid trusted height %d", id.TrustedHeight)
+	}
+	if (id.TrustedHeight == 0) != (len(id.TrustedAppHash) == 0) {
+		return errors.New("the trusted height and app hash must be set together")
+	}
+
+	return nil
+}
+
+// Verify verifies that node is part of the chain identified by id: that it has
+// its chain id and, if set, its genesis hash and its app hash at the trusted
+// height.
+func Verify(ctx context.Context, node Node, id Identity) error {
+	if err := id.Validate(); err != nil {
+		return fmt.Errorf("invalid chain identity: %w", err)
+	}
+
+	status, err := node.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the status of the node: %w", err)
+	}
+	if status.NodeInfo.Network != id.ChainID {
+		return fmt.Errorf("the node is part of chain %q, expected %q", status.NodeInfo.Network, id.ChainID)
+	}
+
+	if len(id.GenesisHash) > 0 {
+		genesisHash, err := GenesisHash(ctx, node)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(genesisHash, id.GenesisHash) {
+			return fmt.Errorf("the genesis hash of the node is %X, expected %X", genesisHash, id.GenesisHash)
+		}
+	}
+
+	if id.TrustedHeight > 0 {
+		res, err := node.Block(ctx, &id.TrustedHeight)
+		if err != nil {
+			return fmt.Errorf("failed to get the block at trusted height %d: %w", id.TrustedHeight, err)
+		}
+		if res.Block == nil || res.Block.Height != id.TrustedHeight {
+			return fmt.Errorf("the node didn't return the block at trusted height %d", id.TrustedHeight)
+		}
+		if !bytes.Equal(res.Block.AppHash, id.TrustedAppHash) {
+			return fmt.Errorf("the app hash of the node at height %d is %X, expected %X", id.TrustedHeight, res.Block.AppHash.Bytes(), id.TrustedAppHash)
+		}
+	}
+
+	return nil
+}
+
+// GenesisHash returns the SHA-256 hash of the genesis document of the chain of
+// node, as served in chunks by its genesis_chunked endpoint. It is computed once
+// from a trusted node, to be verified against the other nodes.
+func GenesisHash(ctx context.Context, node Node) ([]byte, error) {
+	h := sha256.New()
+	for id, total := uint(0), uint(1); id < total; id++ {
+		chunk, err := node.GenesisChunked(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the genesis chunk %d of the node: %w", id, err)
+		}
+		if chunk.TotalChunks <= 0 {
+			return nil, fmt.Errorf("invalid number of genesis chunks %d", chunk.TotalChunks)
+		}
+		total = uint(chunk.TotalChunks)
+
+		bz, err := base64.StdEncoding.DecodeString(chunk.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis chunk %d: %w", id, err)
+		}
+		h.Write(bz)
+	}
+
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,92 @@
+package chainidentity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockNode serves the status, the genesis chunks and the blocks of a chain.
+type mockNode struct {
+	chainID   string
+	genesis   []string
+	appHashes map[int64][]byte
+}
+
+func (n mockNode) Status(context.Context) (*coretypes.ResultStatus, error) {
+	status := &coretypes.ResultStatus{}
+	status.NodeInfo.Network = n.chainID
+	return status, nil
+}
+
+func (n mockNode) GenesisChunked(_ context.Context, id uint) (*coretypes.ResultGenesisChunk, error) {
+	if id >= uint(len(n.genesis)) {
+		return nil, fmt.Errorf("there are %d chunks, %d is invalid", len(n.genesis), id)
+	}
+
+	return &coretypes.ResultGenesisChunk{
+		ChunkNumber: int(id),
+		TotalChunks: len(n.genesis),
+		Data:        base64.StdEncoding.EncodeToString([]byte(n.genesis[id])),
+	}, nil
+}
+
+func (n mockNode) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	appHash, ok := n.appHashes[*height]
+	if !ok {
+		return nil, errors.New("block pruned")
+	}
+
+	block := &cmttypes.Block{}
+	block.Height = *height
+	block.AppHash = appHash
+	return &coretypes.ResultBlock{Block: block}, nil
+}
+
+func TestVerify(t *testing.T) {
+	node := mockNode{
+		chainID:   "test-chain",
+		genesis:   []string{`{"chain_id":`, `"test-chain"}`},
+		appHashes: map[int64][]byte{10: {0xAB, 0xCD}},
+	}
+
+	genesisHash, err := GenesisHash(context.Background(), node)
+	require.NoError(t, err)
+	expected := sha256.Sum256([]byte(`{"chain_id":"test-chain"}`))
+	require.Equal(t, expected[:], genesisHash)
+
+	testCases := []struct {
+		name   string
+		id     Identity
+		errMsg string
+	}{
+		{"chain id", Identity{ChainID: "test-chain"}, ""},
+		{"genesis hash", Identity{ChainID: "test-chain", GenesisHash: genesisHash}, ""},
+		{"trusted app hash", Identity{ChainID: "test-chain", TrustedHeight: 10, TrustedAppHash: []byte{0xAB, 0xCD}}, ""},
+		{"empty chain id", Identity{}, "empty chain id"},
+		{"other chain", Identity{ChainID: "other-chain"}, `the node is part of chain "test-chain", expected "other-chain"`},
+		{"other genesis", Identity{ChainID: "test-chain", GenesisHash: []byte{0x01}}, "expected 01"},
+		{"other app hash", Identity{ChainID: "test-chain", TrustedHeight: 10, TrustedAppHash: []byte{0x01}}, "the app hash of the node at height 10 is ABCD, expected 01"},
+		{"pruned trusted height", Identity{ChainID: "test-chain", TrustedHeight: 5, TrustedAppHash: []byte{0x01}}, "failed to get the block at trusted height 5"},
+		{"trusted height without app hash", Identity{ChainID: "test-chain", TrustedHeight: 10}, "must be set together"},
+		{"negative trusted height", Identity{ChainID: "test-chain", TrustedHeight: -1, TrustedAppHash: []byte{0x01}}, "invalid trusted height -1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Verify(context.Background(), node, tc.id)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package chainregistry
+
+import (
+	"context"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/client/v2/tx"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Chain contains everything needed to build transactions for a chain whose
+// metadata comes from the chain registry.
+type Chain struct {
+	Info ChainInfo
+
+	AddressCodec          address.Codec
+	ValidatorAddressCodec address.Codec
+	ConsensusAddressCodec address.Codec
+
+	// TxConfig builds, signs and encodes the transactions of the chain with
+	// client/v2.
+	TxConfig *tx.TxConfig
+}
+
+// Bootstrap fetches the metadata of the chain with the given name from the
+// source and constructs a Chain from it.
+// The codec must know the messages that will be included in the transactions.
+func Bootstrap(ctx context.Context, src Source, chainName string, cdc codec.Codec) (Chain, error) {
+	info, err := src.ChainInfo(ctx, chainName)
+	if err != nil {
+		return Chain{}, err
+	}
+
+	return NewChain(info, cdc)
+}
+
+// NewChain constructs a Chain from the given chain metadata. The address codecs
+// use the bech32 prefixes derived from the chain's prefix, as done by the SDK.
+func NewChain(info ChainInfo, cdc codec.Codec) (Chain, error) {
+	if err := info.Validate(); err != nil {
+		return Chain{}, err
+	}
+
+	chain := Chain{
+		Info:                  info,
+		AddressCodec:          addresscodec.NewBech32Codec(info.Bech32Prefix),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec(info.Bech32Prefix + sdk.PrefixValidator + sdk.PrefixOperator),
+		ConsensusAddressCodec: addresscodec.NewBech32Codec(info.Bech32Prefix + sdk.PrefixValidator + sdk.PrefixConsensus),
+	}
+
+	txConfig, err := tx.NewTxConfig(tx.ConfigOptions{
+		SigningOptions: &txsigning.Options{
+			FileResolver:          cdc.InterfaceRegistry(),
+			AddressCodec:          chain.AddressCodec,
+			ValidatorAddressCodec: chain.ValidatorAddressCodec,
+		},
+	})
+	if err != nil {
+		return Chain{}, err
+	}
+	chain.TxConfig = txConfig
+
+	return chain, nil
+}
+
+// NewTxBuilder returns a builder of a transaction of the chain with the given
+// gas limit, paying the fees of the gas limit at the gas prices of the chain.
+func (c Chain) NewTxBuilder(gasLimit uint64) *tx.TxBuilder {
+	txBuilder := c.TxConfig.NewTxBuilder()
+	txBuilder.SetGasLimit(gasLimit)
+	txBuilder.SetFeeAmount(c.Fees(gasLimit))
+
+	return txBuilder
+}
+
+// Fees returns the fees of the given gas limit at the gas prices of the chain,
+// rounded up.
+func (c Chain) Fees(gasLimit uint64) []*basev1beta1.Coin {
+	gasPrices := c.Info.GasPrices()
+	fees := make([]*basev1beta1.Coin, 0, len(gasPrices))
+	for _, gp := range gasPrices {
+		amount := gp.Amount.Mul(math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit))).Ceil().RoundInt()
+		if amount.IsZero() {
+			continue
+		}
+		fees = append(fees, &basev1beta1.Coin{Denom: gp.Denom, Amount: amount.String()})
+	}
+
+	return fees
+}
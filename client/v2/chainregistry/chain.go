@@ -0,0 +1,194 @@
+package chainregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChainInfo contains the metadata of a chain needed to build and broadcast
+// transactions for it.
+type ChainInfo struct {
+	// ChainName is the name of the chain in the registry.
+	ChainName string
+	// ChainID is the chain id of the chain.
+	ChainID string
+	// Bech32Prefix is the bech32 prefix of the account addresses of the chain.
+	Bech32Prefix string
+	// CoinType is the SLIP-44 coin type of the keys of the chain.
+	CoinType uint32
+	// FeeTokens are the tokens that can be used to pay fees on the chain.
+	FeeTokens []FeeToken
+	// RPCEndpoints are the CometBFT RPC endpoints of the chain.
+	RPCEndpoints []string
+	// GRPCEndpoints are the gRPC endpoints of the chain.
+	GRPCEndpoints []string
+}
+
+// FeeToken is a token that can be used to pay fees, with its gas prices.
+type FeeToken struct {
+	Denom string
+	// FixedMinGasPrice is the minimum gas price accepted by the chain.
+	FixedMinGasPrice math.LegacyDec
+	// AverageGasPrice is the gas price recommended for a transaction to be included.
+	AverageGasPrice math.LegacyDec
+}
+
+// FeeDenoms returns the denoms of the fee tokens of the chain.
+func (c ChainInfo) FeeDenoms() []string {
+	denoms := make([]string, 0, len(c.FeeTokens))
+	for _, token := range c.FeeTokens {
+		denoms = append(denoms, token.Denom)
+	}
+
+	return denoms
+}
+
+// HDPath returns the BIP44 derivation path of the key of the given account and
+// address index for the chain, using its coin type.
+func (c ChainInfo) HDPath(account, index uint32) string {
+	return hd.CreateHDPath(c.CoinType, account, index).String()
+}
+
+// GasPrices returns the gas prices to use for the chain, i.e. the average gas
+// price of its first fee token, or its fixed minimum gas price if no average is
+// set. It returns empty gas prices if the chain has no fee token.
+func (c ChainInfo) GasPrices() sdk.DecCoins {
+	if len(c.FeeTokens) == 0 {
+		return sdk.DecCoins{}
+	}
+
+	token := c.FeeTokens[0]
+	price := token.AverageGasPrice
+	if price.IsNil() || price.IsZero() {
+		price = token.FixedMinGasPrice
+	}
+	if price.IsNil() {
+		price = math.LegacyZeroDec()
+	}
+
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(token.Denom, price))
+}
+
+// Validate performs a basic validation of the chain metadata.
+func (c ChainInfo) Validate() error {
+	if strings.TrimSpace(c.ChainID) == "" {
+		return errors.New("chain id cannot be empty")
+	}
+	if strings.TrimSpace(c.Bech32Prefix) == "" {
+		return errors.New("bech32 prefix cannot be empty")
+	}
+	for _, token := range c.FeeTokens {
+		if err := sdk.ValidateDenom(token.Denom); err != nil {
+			return fmt.Errorf("invalid fee token: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// registryChain is the subset of the chain.json file of the chain registry
+// that is used to build a ChainInfo.
+type registryChain struct {
+	ChainName    string  `json:"chain_name"`
+	ChainID      string  `json:"chain_id"`
+	Bech32Prefix string  `json:"bech32_prefix"`
+	Slip44       *uint32 `json:"slip44"`
+	Fees         struct {
+		FeeTokens []struct {
+			Denom            string      `json:"denom"`
+			FixedMinGasPrice json.Number `json:"fixed_min_gas_price"`
+			AverageGasPrice  json.Number `json:"average_gas_price"`
+		} `json:"fee_tokens"`
+	} `json:"fees"`
+	APIs struct {
+		RPC  []registryEndpoint `json:"rpc"`
+		GRPC []registryEndpoint `json:"grpc"`
+	} `json:"apis"`
+}
+
+type registryEndpoint struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider"`
+}
+
+// ParseChainInfo parses the content of a chain.json file of the chain registry.
+func ParseChainInfo(bz []byte) (ChainInfo, error) {
+	var chain registryChain
+	if err := json.Unmarshal(bz, &chain); err != nil {
+		return ChainInfo{}, fmt.Errorf("failed to parse chain registry file: %w", err)
+	}
+
+	info := ChainInfo{
+		ChainName:     chain.ChainName,
+		ChainID:       chain.ChainID,
+		Bech32Prefix:  chain.Bech32Prefix,
+		CoinType:      sdk.CoinType,
+		RPCEndpoints:  endpointAddresses(chain.APIs.RPC),
+		GRPCEndpoints: endpointAddresses(chain.APIs.GRPC),
+	}
+
+	if chain.Slip44 != nil {
+		info.CoinType = *chain.Slip44
+	}
+
+	for _, token := range chain.Fees.FeeTokens {
+		fixedMinGasPrice, err := parseGasPrice(token.FixedMinGasPrice)
+		if err != nil {
+			return ChainInfo{}, fmt.Errorf("invalid fixed min gas price of %s: %w", token.Denom, err)
+		}
+
+		averageGasPrice, err := parseGasPrice(token.AverageGasPrice)
+		if err != nil {
+			return ChainInfo{}, fmt.Errorf("invalid average gas price of %s: %w", token.Denom, err)
+		}
+
+		info.FeeTokens = append(info.FeeTokens, FeeToken{
+			Denom:            token.Denom,
+			FixedMinGasPrice: fixedMinGasPrice,
+			AverageGasPrice:  averageGasPrice,
+		})
+	}
+
+	if err := info.Validate(); err != nil {
+		return ChainInfo{}, err
+	}
+
+	return info, nil
+}
+
+func endpointAddresses(endpoints []registryEndpoint) []string {
+	addresses := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint.Address != "" {
+			addresses = append(addresses, endpoint.Address)
+		}
+	}
+
+	return addresses
+}
+
+// parseGasPrice parses a gas price of the chain registry, which is a JSON
+// number that may be in exponent notation.
+func parseGasPrice(n json.Number) (math.LegacyDec, error) {
+	if n == "" {
+		return math.LegacyZeroDec(), nil
+	}
+
+	r, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return math.LegacyDec{}, fmt.Errorf("invalid number: %s", n)
+	}
+	if r.Sign() < 0 {
+		return math.LegacyDec{}, fmt.Errorf("gas price cannot be negative: %s", n)
+	}
+
+	return math.LegacyNewDecFromStr(r.FloatString(math.LegacyPrecision))
+}
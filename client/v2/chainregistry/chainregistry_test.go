@@ -0,0 +1,188 @@
+package chainregistry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/testutil"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const osmosisChainFile = `{
+  "$schema": "../chain.schema.json",
+  "chain_name": "osmosis",
+  "chain_id": "osmosis-1",
+  "bech32_prefix": "osmo",
+  "slip44": 118,
+  "fees": {
+    "fee_tokens": [
+      {
+        "denom": "uosmo",
+        "fixed_min_gas_price": 0.0025,
+        "low_gas_price": 0.0025,
+        "average_gas_price": 2.5e-2,
+        "high_gas_price": 0.04
+      },
+      {
+        "denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+        "fixed_min_gas_price": 0.0001
+      }
+    ]
+  },
+  "apis": {
+    "rpc": [
+      {"address": "https://rpc.osmosis.zone", "provider": "Osmosis Foundation"},
+      {"address": "", "provider": "empty"}
+    ],
+    "grpc": [
+      {"address": "grpc.osmosis.zone:9090", "provider": "Osmosis Foundation"}
+    ]
+  }
+}`
+
+func getCodec() codec.Codec {
+	registry := testutil.CodecOptions{}.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+
+	return codec.NewProtoCodec(registry)
+}
+
+func TestParseChainInfo(t *testing.T) {
+	info, err := ParseChainInfo([]byte(osmosisChainFile))
+	require.NoError(t, err)
+	require.Equal(t, "osmosis", info.ChainName)
+	require.Equal(t, "osmosis-1", info.ChainID)
+	require.Equal(t, "osmo", info.Bech32Prefix)
+	require.Equal(t, uint32(118), info.CoinType)
+	require.Equal(t, "m/44'/118'/2'/0/5", info.HDPath(2, 5))
+	require.Equal(t, []string{"https://rpc.osmosis.zone"}, info.RPCEndpoints)
+	require.Equal(t, []string{"grpc.osmosis.zone:9090"}, info.GRPCEndpoints)
+	require.Equal(t, []string{"uosmo", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, info.FeeDenoms())
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.0025"), info.FeeTokens[0].FixedMinGasPrice)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.025"), info.FeeTokens[0].AverageGasPrice)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uosmo", math.LegacyMustNewDecFromStr("0.025"))), info.GasPrices())
+
+	// the fixed min gas price is used when there is no average gas price
+	info.FeeTokens = info.FeeTokens[1:]
+	require.Equal(t, "0.000100000000000000ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", info.GasPrices().String())
+
+	// the coin type of the chain is used in its HD paths
+	info, err = ParseChainInfo([]byte(`{"chain_id": "secret-4", "bech32_prefix": "secret", "slip44": 529}`))
+	require.NoError(t, err)
+	require.Equal(t, uint32(529), info.CoinType)
+	require.Equal(t, "m/44'/529'/0'/0/0", info.HDPath(0, 0))
+
+	// the default coin type is used when the chain doesn't set it
+	info, err = ParseChainInfo([]byte(`{"chain_id": "osmosis-1", "bech32_prefix": "osmo"}`))
+	require.NoError(t, err)
+	require.Equal(t, uint32(sdk.CoinType), info.CoinType)
+
+	testCases := []struct {
+		name   string
+		file   string
+		errMsg string
+	}{
+		{"invalid json", `{`, "failed to parse chain registry file"},
+		{"missing chain id", `{"bech32_prefix": "osmo"}`, "chain id cannot be empty"},
+		{"missing bech32 prefix", `{"chain_id": "osmosis-1"}`, "bech32 prefix cannot be empty"},
+		{"invalid fee denom", `{"chain_id": "osmosis-1", "bech32_prefix": "osmo", "fees": {"fee_tokens": [{"denom": "1"}]}}`, "invalid fee token"},
+		{"negative gas price", `{"chain_id": "osmosis-1", "bech32_prefix": "osmo", "fees": {"fee_tokens": [{"denom": "uosmo", "average_gas_price": -1}]}}`, "gas price cannot be negative"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseChainInfo([]byte(tc.file))
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestFSSource(t *testing.T) {
+	src := NewFSSource(fstest.MapFS{
+		"osmosis/chain.json": &fstest.MapFile{Data: []byte(osmosisChainFile)},
+	})
+
+	info, err := src.ChainInfo(context.Background(), "osmosis")
+	require.NoError(t, err)
+	require.Equal(t, "osmosis-1", info.ChainID)
+
+	_, err = src.ChainInfo(context.Background(), "cosmoshub")
+	require.Error(t, err)
+
+	_, err = src.ChainInfo(context.Background(), "../osmosis")
+	require.ErrorContains(t, err, "invalid chain name")
+}
+
+func TestHTTPSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/registry/osmosis/chain.json" {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write([]byte(osmosisChainFile))
+	}))
+	defer server.Close()
+
+	src := NewHTTPSource(server.URL+"/registry", server.Client())
+
+	info, err := src.ChainInfo(context.Background(), "osmosis")
+	require.NoError(t, err)
+	require.Equal(t, "osmosis-1", info.ChainID)
+
+	_, err = src.ChainInfo(context.Background(), "cosmoshub")
+	require.ErrorContains(t, err, "404 Not Found")
+
+	_, err = src.ChainInfo(context.Background(), "")
+	require.ErrorContains(t, err, "invalid chain name")
+}
+
+func TestBootstrap(t *testing.T) {
+	src := NewFSSource(fstest.MapFS{
+		"osmosis/chain.json": &fstest.MapFile{Data: []byte(osmosisChainFile)},
+	})
+
+	chain, err := Bootstrap(context.Background(), src, "osmosis", getCodec())
+	require.NoError(t, err)
+
+	addr, err := chain.AddressCodec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(addr, "osmo1"))
+
+	valAddr, err := chain.ValidatorAddressCodec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(valAddr, "osmovaloper1"))
+
+	consAddr, err := chain.ConsensusAddressCodec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(consAddr, "osmovalcons1"))
+
+	// the fees of the gas limit are paid at the gas prices of the chain, rounded up
+	txBuilder := chain.NewTxBuilder(100_001)
+	txBuilder.SetMemo("bootstrap")
+	require.Equal(t, uint64(100_001), txBuilder.GetTx().AuthInfo.Fee.GasLimit)
+	require.Len(t, txBuilder.GetTx().AuthInfo.Fee.Amount, 1)
+	require.Equal(t, "uosmo", txBuilder.GetTx().AuthInfo.Fee.Amount[0].Denom)
+	require.Equal(t, "2501", txBuilder.GetTx().AuthInfo.Fee.Amount[0].Amount)
+
+	// the tx config encodes transactions built with it
+	bz, err := chain.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	decoded, err := chain.TxConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+	require.Equal(t, "bootstrap", decoded.Body.Memo)
+
+	_, err = Bootstrap(context.Background(), src, "cosmoshub", getCodec())
+	require.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package chainregistry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// DefaultRegistryURL is the URL of the raw content of the Cosmos chain registry.
+const DefaultRegistryURL = "https://raw.githubusercontent.com/cosmos/chain-registry/master"
+
+// chainFileName is the name of the file describing a chain in the registry.
+const chainFileName = "chain.json"
+
+// maxChainFileSize is the maximum size of a chain file read from a source.
+const maxChainFileSize = 10 << 20
+
+// Source defines a source of chain metadata, laid out like the chain registry,
+// i.e. with a <chain_name>/chain.json file per chain.
+type Source interface {
+	// ChainInfo returns the metadata of the chain with the given name.
+	ChainInfo(ctx context.Context, chainName string) (ChainInfo, error)
+}
+
+var (
+	_ Source = HTTPSource{}
+	_ Source = FSSource{}
+)
+
+// HTTPSource fetches the chain metadata from a chain registry served over HTTP.
+type HTTPSource struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewHTTPSource returns a Source fetching the chain metadata from the registry
+// at the given base URL. If client is nil, http.DefaultClient is used.
+func NewHTTPSource(baseURL string, client *http.Client) HTTPSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return HTTPSource{baseURL: baseURL, client: client}
+}
+
+// ChainInfo implements Source.
+func (s HTTPSource) ChainInfo(ctx context.Context, chainName string) (ChainInfo, error) {
+	if err := validateChainName(chainName); err != nil {
+		return ChainInfo{}, err
+	}
+
+	u, err := url.JoinPath(s.baseURL, chainName, chainFileName)
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return ChainInfo{}, fmt.Errorf("failed to fetch %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ChainInfo{}, fmt.Errorf("failed to fetch %s: %s", u, resp.Status)
+	}
+
+	bz, err := io.ReadAll(io.LimitReader(resp.Body, maxChainFileSize))
+	if err != nil {
+		return ChainInfo{}, fmt.Errorf("failed to read %s: %w", u, err)
+	}
+
+	return ParseChainInfo(bz)
+}
+
+// FSSource reads the chain metadata from a file system, e.g. a local clone of
+// the chain registry.
+type FSSource struct {
+	fsys fs.FS
+}
+
+// NewFSSource returns a Source reading the chain metadata from the given file system.
+func NewFSSource(fsys fs.FS) FSSource {
+	return FSSource{fsys: fsys}
+}
+
+// ChainInfo implements Source.
+func (s FSSource) ChainInfo(_ context.Context, chainName string) (ChainInfo, error) {
+	if err := validateChainName(chainName); err != nil {
+		return ChainInfo{}, err
+	}
+
+	bz, err := fs.ReadFile(s.fsys, path.Join(chainName, chainFileName))
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	return ParseChainInfo(bz)
+}
+
+// validateChainName ensures the chain name is a single path element.
+func validateChainName(chainName string) error {
+	if chainName == "" || chainName == "." || chainName == ".." || path.Base(chainName) != chainName {
+		return fmt.Errorf("invalid chain name: %q", chainName)
+	}
+
+	return nil
+}
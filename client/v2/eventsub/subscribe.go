@@ -0,0 +1,269 @@
+// Package eventsub subscribes to the typed events emitted by the transactions,
+// or the blocks, of a node over its CometBFT event stream, and decodes them into
+// their proto messages, so that bots and relayers don't need to parse the raw
+// event attributes.
+package eventsub
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/client/v2/txresult"
+)
+
+// Filter selects the events of a subscription.
+type Filter struct {
+	// Attributes are the values of the fields of the typed events, by field
+	// name. They are compared to the JSON encoding of the fields, so that e.g.
+	// a string field matches a string value, while the 64-bit integers, which
+	// are encoded as strings, match string values too.
+	Attributes map[string]any
+	// Query is an additional CometBFT query the transactions, or blocks, must
+	// match, e.g. "tx.height > 100".
+	Query string
+	// Blocks subscribes to the events emitted by the blocks, outside of the
+	// transactions, e.g. by the begin and end blockers, instead of the events
+	// emitted by the transactions.
+	Blocks bool
+}
+
+// Event is a typed event received from a subscription.
+type Event[T gogoproto.Message] struct {
+	// Height is the height of the block the event was emitted in.
+	Height int64
+	// TxHash is the hash of the transaction which emitted the event, empty for
+	// the events emitted by blocks.
+	TxHash string
+	// MsgIndex is the index of the message which emitted the event, or -1 for
+	// an event emitted outside of the messages.
+	MsgIndex int
+	// Attributes are the raw attributes of the event.
+	Attributes []abci.EventAttribute
+	// Event is the typed event.
+	Event T
+}
+
+// Subscription is a subscription to the typed events of type T.
+type Subscription[T gogoproto.Message] struct {
+	// C receives the events of the subscription, in the order they were
+	// emitted. It is closed when the subscription ends, see Err.
+	C <-chan Event[T]
+
+	mu  sync.Mutex
+	err error
+}
+
+// Err returns the error which ended the subscription once C is closed, or nil
+// if it ended as its context was done.
+func (s *Subscription[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Subscribe subscribes to the typed events of type T matching the filter, e.g.
+// Subscribe[*banktypes.EventSend](ctx, client, "relayer", Filter{...}), until
+// ctx is done. client is the event stream of the node, e.g. the HTTP client of
+// a client.Context started with Start. subscriber identifies the subscriptions
+// of the caller on the node.
+//
+// The node matches the transactions emitting an event with the attributes of
+// the filter, while the events are filtered again once decoded, as a
+// transaction may emit several events of the same type. Without attributes, all
+// the transactions are streamed from the node to be filtered by the client.
+func Subscribe[T gogoproto.Message](ctx context.Context, client rpcclient.EventsClient, subscriber string, filter Filter) (*Subscription[T], error) {
+	eventType, err := typedEventName[T]()
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := encodeAttributes(filter.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	query, err := buildQuery(eventType, attrs, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := client.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to %q: %w", query, err)
+	}
+
+	out := make(chan Event[T])
+	sub := &Subscription[T]{C: out}
+	go func() {
+		defer close(out)
+		// the subscription outlives ctx on the node, it must be ended explicitly
+		defer client.Unsubscribe(context.Background(), subscriber, query) //nolint:errcheck // the subscription is ended anyway
+
+		for {
+			var res coretypes.ResultEvent
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case res, ok = <-in:
+				if !ok {
+					sub.setErr(errors.New("the event stream was closed by the node"))
+					return
+				}
+			}
+
+			events, err := decodeEvents[T](res, eventType, attrs)
+			if err != nil {
+				sub.setErr(err)
+				return
+			}
+			for _, event := range events {
+				select {
+				case <-ctx.Done():
+					return
+				case out <- event:
+				}
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+func (s *Subscription[T]) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// typedEventName returns the type of the typed events of type T, i.e. the full
+// name of their registered message.
+func typedEventName[T gogoproto.Message]() (string, error) {
+	var zero T
+	typ := reflect.TypeOf(zero)
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		return "", fmt.Errorf("typed events must be pointers to messages, got %v", typ)
+	}
+
+	name := gogoproto.MessageName(reflect.New(typ.Elem()).Interface().(T))
+	if name == "" || gogoproto.MessageType(name) == nil {
+		return "", fmt.Errorf("typed event %v is not a registered message", typ)
+	}
+	return name, nil
+}
+
+// encodeAttributes encodes the values of the attributes as the fields of the
+// typed events.
+func encodeAttributes(attributes map[string]any) (map[string]string, error) {
+	attrs := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of attribute %s: %w", key, err)
+		}
+		attrs[key] = string(bz)
+	}
+	return attrs, nil
+}
+
+// buildQuery returns the CometBFT query of the transactions, or blocks,
+// emitting an event of type eventType with the attributes.
+func buildQuery(eventType string, attrs map[string]string, filter Filter) (string, error) {
+	source := cmttypes.EventTx
+	if filter.Blocks {
+		source = cmttypes.EventNewBlockEvents
+	}
+	conds := []string{fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, source)}
+
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if strings.ContainsAny(key, "' ") || strings.Contains(attrs[key], "'") {
+			return "", fmt.Errorf("attribute %s cannot be queried, it contains a quote or space", key)
+		}
+		conds = append(conds, fmt.Sprintf("%s.%s='%s'", eventType, key, attrs[key]))
+	}
+
+	if filter.Query != "" {
+		conds = append(conds, filter.Query)
+	}
+	return strings.Join(conds, " AND "), nil
+}
+
+// decodeEvents decodes the typed events of type eventType with the attributes
+// emitted by the transaction, or block, of res.
+func decodeEvents[T gogoproto.Message](res coretypes.ResultEvent, eventType string, attrs map[string]string) ([]Event[T], error) {
+	var (
+		height int64
+		txHash string
+		raw    []abci.Event
+	)
+	switch data := res.Data.(type) {
+	case cmttypes.EventDataTx:
+		height, raw = data.Height, data.Result.Events
+		txHash = strings.ToUpper(hex.EncodeToString(cmttypes.Tx(data.Tx).Hash()))
+	case cmttypes.EventDataNewBlockEvents:
+		height, raw = data.Height, data.Events
+	default:
+		return nil, fmt.Errorf("unexpected event data %T", res.Data)
+	}
+
+	var matching []abci.Event
+	for _, event := range raw {
+		if event.Type == eventType && hasAttributes(event, attrs) {
+			matching = append(matching, event)
+		}
+	}
+
+	decoded, err := txresult.DecodeEvents(matching)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the events of block %d: %w", height, err)
+	}
+
+	events := make([]Event[T], 0, len(decoded))
+	for _, event := range decoded {
+		typed, ok := event.Typed.(T)
+		if !ok {
+			return nil, fmt.Errorf("expected event %s to be %T, got %T", eventType, typed, event.Typed)
+		}
+		events = append(events, Event[T]{
+			Height:     height,
+			TxHash:     txHash,
+			MsgIndex:   event.MsgIndex,
+			Attributes: event.Attributes,
+			Event:      typed,
+		})
+	}
+
+	return events, nil
+}
+
+func hasAttributes(event abci.Event, attrs map[string]string) bool {
+	for key, value := range attrs {
+		found := false
+		for _, attr := range event.Attributes {
+			if attr.Key == key && attr.Value == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package eventsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/authz"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockEventsClient streams the events sent to its channel.
+type mockEventsClient struct {
+	events       chan coretypes.ResultEvent
+	query        string
+	unsubscribed chan string
+}
+
+func newMockEventsClient() *mockEventsClient {
+	return &mockEventsClient{
+		events:       make(chan coretypes.ResultEvent, 10),
+		unsubscribed: make(chan string, 1),
+	}
+}
+
+func (m *mockEventsClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	m.query = query
+	return m.events, nil
+}
+
+func (m *mockEventsClient) Unsubscribe(_ context.Context, _, query string) error {
+	m.unsubscribed <- query
+	return nil
+}
+
+func (m *mockEventsClient) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+func grantEvent(t *testing.T, grantee string, msgIndex string) abci.Event {
+	t.Helper()
+	event, err := sdk.TypedEventToEvent(&authz.EventGrant{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Granter: "cosmos1granter", Grantee: grantee})
+	require.NoError(t, err)
+	if msgIndex != "" {
+		event = event.AppendAttributes(sdk.NewAttribute("msg_index", msgIndex))
+	}
+	return abci.Event(event)
+}
+
+func txEvent(height int64, tx string, events ...abci.Event) coretypes.ResultEvent {
+	return coretypes.ResultEvent{Data: cmttypes.EventDataTx{TxResult: abci.TxResult{
+		Height: height,
+		Tx:     []byte(tx),
+		Result: abci.ExecTxResult{Events: events},
+	}}}
+}
+
+func receive[T any](t *testing.T, c <-chan T) T {
+	t.Helper()
+	select {
+	case v, ok := <-c:
+		require.True(t, ok, "channel closed")
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+		panic("unreachable")
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	client := newMockEventsClient()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sub, err := Subscribe[*authz.EventGrant](ctx, client, "test", Filter{
+		Attributes: map[string]any{"grantee": "cosmos1alice"},
+		Query:      "tx.height > 10",
+	})
+	require.NoError(t, err)
+	require.Equal(t, `tm.event='Tx' AND cosmos.authz.v1beta1.EventGrant.grantee='"cosmos1alice"' AND tx.height > 10`, client.query)
+
+	// only the matching events of the transactions are received
+	client.events <- txEvent(11, "tx1",
+		abci.Event{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "/cosmos.authz.v1beta1.MsgGrant"}}},
+		grantEvent(t, "cosmos1bob", "0"),
+		grantEvent(t, "cosmos1alice", "1"),
+	)
+	client.events <- txEvent(12, "tx2", grantEvent(t, "cosmos1alice", ""))
+
+	event := receive(t, sub.C)
+	require.Equal(t, int64(11), event.Height)
+	require.Equal(t, "709B55BD3DA0F5A838125BD0EE20C5BFDD7CABA173912D4281CAE816B79A201B", event.TxHash)
+	require.Equal(t, 1, event.MsgIndex)
+	require.Equal(t, "cosmos1alice", event.Event.Grantee)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", event.Event.MsgTypeUrl)
+
+	event = receive(t, sub.C)
+	require.Equal(t, int64(12), event.Height)
+	require.Equal(t, -1, event.MsgIndex)
+
+	// the subscription ends with its context
+	cancel()
+	require.Equal(t, client.query, receive(t, client.unsubscribed))
+	_, ok := <-sub.C
+	require.False(t, ok)
+	require.NoError(t, sub.Err())
+}
+
+func TestSubscribeBlockEvents(t *testing.T) {
+	client := newMockEventsClient()
+	sub, err := Subscribe[*authz.EventGrant](context.Background(), client, "test", Filter{Blocks: true})
+	require.NoError(t, err)
+	require.Equal(t, `tm.event='NewBlockEvents'`, client.query)
+
+	client.events <- coretypes.ResultEvent{Data: cmttypes.EventDataNewBlockEvents{
+		Height: 5,
+		Events: []abci.Event{grantEvent(t, "cosmos1alice", "")},
+	}}
+	event := receive(t, sub.C)
+	require.Equal(t, int64(5), event.Height)
+	require.Empty(t, event.TxHash)
+
+	// an invalid typed event ends the subscription
+	client.events <- txEvent(6, "tx", abci.Event{
+		Type:       "cosmos.authz.v1beta1.EventGrant",
+		Attributes: []abci.EventAttribute{{Key: "grantee", Value: "not json"}},
+	})
+	receive(t, client.unsubscribed)
+	_, ok := <-sub.C
+	require.False(t, ok)
+	require.ErrorContains(t, sub.Err(), "failed to decode the events of block 6")
+}
+
+func TestSubscribeInvalid(t *testing.T) {
+	_, err := Subscribe[*authz.EventGrant](context.Background(), newMockEventsClient(), "test", Filter{
+		Attributes: map[string]any{"it's": "x"},
+	})
+	require.ErrorContains(t, err, "cannot be queried")
+
+	_, err = Subscribe[*authz.EventGrant](context.Background(), newMockEventsClient(), "test", Filter{
+		Attributes: map[string]any{"grantee": func() {}},
+	})
+	require.ErrorContains(t, err, "invalid value of attribute grantee")
+}
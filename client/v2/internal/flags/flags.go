@@ -17,6 +17,17 @@ const (
 	// FlagNoProposal is the flag convert a gov proposal command into a normal command.
 	// This is used to allow user of chains with custom authority to not use gov submit proposals for usual proposal commands.
 	FlagNoProposal = "no-proposal"
+
+	// FlagVerifyChain is the flag to verify the chain id of the node before broadcasting a transaction.
+	FlagVerifyChain = "verify-chain"
+
+	// FlagGenesisHash is the flag to set the expected genesis hash of the chain, verified before broadcasting a transaction.
+	FlagGenesisHash = "genesis-hash"
+
+	// FlagTrustedHeight and FlagTrustedAppHash are the flags to set the expected app hash of the chain at a trusted height,
+	// verified before broadcasting a transaction.
+	FlagTrustedHeight  = "trusted-height"
+	FlagTrustedAppHash = "trusted-app-hash"
 )
 
 // List of supported output formats
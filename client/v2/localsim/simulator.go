@@ -0,0 +1,144 @@
+// Package localsim simulates transactions locally, against a state snapshot
+// loaded into an in-memory app, without any connection to a node. It gives
+// instant gas estimates, e.g. to CI pipelines or wallets.
+package localsim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// simulateMethod is the full method name of the Simulate RPC of the tx service.
+const simulateMethod = "/cosmos.tx.v1beta1.Service/Simulate"
+
+// App is the application the state snapshot is loaded into. *baseapp.BaseApp,
+// and thus the apps embedding it, implement it.
+type App interface {
+	InitChain(*abci.InitChainRequest) (*abci.InitChainResponse, error)
+	FinalizeBlock(*abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error)
+	Commit() (*abci.CommitResponse, error)
+	Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+}
+
+var _ gogogrpc.ClientConn = &Simulator{}
+
+// Simulator simulates transactions against the state of an App loaded from a
+// genesis file, as produced by the export command of a node. It implements the
+// Simulate RPC of the tx service as a gogogrpc.ClientConn, so that it can be used
+// in place of a node connection to estimate the gas of the transactions, e.g. by
+// the tx.CalculateGas function.
+type Simulator struct {
+	mu  sync.Mutex
+	app App
+}
+
+// NewSimulator loads the state of genesis into app, which must be created with
+// an in-memory database and the chain id of genesis, and returns a Simulator of
+// its transactions.
+func NewSimulator(app App, genesis *genutiltypes.AppGenesis) (*Simulator, error) {
+	if err := genesis.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	consensusParams := genesis.Consensus.Params.ToProto()
+	if _, err := app.InitChain(&abci.InitChainRequest{
+		Time:            genesis.GenesisTime,
+		ChainId:         genesis.ChainID,
+		InitialHeight:   genesis.InitialHeight,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   genesis.AppState,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to init chain: %w", err)
+	}
+
+	// the state is committed by the first block, for the simulations to run against it
+	if _, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: genesis.InitialHeight,
+		Time:   genesis.GenesisTime,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to finalize block: %w", err)
+	}
+	if _, err := app.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return &Simulator{app: app}, nil
+}
+
+// NewSimulatorFromFile loads the state of the genesis file genFile into app, as
+// NewSimulator does.
+func NewSimulatorFromFile(app App, genFile string) (*Simulator, error) {
+	genesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSimulator(app, genesis)
+}
+
+// Simulate simulates the encoded transaction txBytes. The simulations don't
+// modify the state, they all run against the loaded state snapshot.
+func (s *Simulator) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.app.Simulate(txBytes)
+}
+
+// Invoke implements gogogrpc.ClientConn. Only the Simulate RPC of the tx service
+// is supported.
+func (s *Simulator) Invoke(_ context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	if method != simulateMethod {
+		return status.Errorf(codes.Unimplemented, "%s is not supported by the local simulator", method)
+	}
+
+	req, ok := args.(*txtypes.SimulateRequest)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "expected %T, got %T", req, args)
+	}
+	res, ok := reply.(*txtypes.SimulateResponse)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "expected %T, got %T", res, reply)
+	}
+
+	txBytes := req.TxBytes
+	if txBytes == nil && req.Tx != nil {
+		var err error
+		txBytes, err = proto.Marshal(req.Tx)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+		}
+	}
+	if txBytes == nil {
+		return status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	gasInfo, result, err := s.Simulate(txBytes)
+	if err != nil {
+		return status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
+	}
+
+	*res = txtypes.SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+	}
+	return nil
+}
+
+// NewStream implements gogogrpc.ClientConn. Streams are not supported.
+func (s *Simulator) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streaming rpc not supported by the local simulator")
+}
@@ -0,0 +1,174 @@
+package localsim
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const signer = "cosmos1x33fy6rusfprkntvjsfregss7rvsvyy4lkwrqu"
+
+var countKey = []byte("count")
+
+// counterServer increases the counter initialized from the genesis, consuming
+// 1000 gas per increment.
+type counterServer struct {
+	key *storetypes.KVStoreKey
+}
+
+func (s counterServer) IncreaseCount(ctx context.Context, msg *countertypes.MsgIncreaseCounter) (*countertypes.MsgIncreaseCountResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(s.key)
+
+	bz := store.Get(countKey)
+	if bz == nil {
+		return nil, errors.New("counter not initialized")
+	}
+	if msg.Count <= 0 {
+		return nil, errors.New("count must be positive")
+	}
+
+	sdkCtx.GasMeter().ConsumeGas(uint64(msg.Count)*1000, "increase count")
+	count := int64(binary.BigEndian.Uint64(bz)) + msg.Count
+	store.Set(countKey, binary.BigEndian.AppendUint64(nil, uint64(count)))
+
+	return &countertypes.MsgIncreaseCountResponse{NewCount: count}, nil
+}
+
+// paramStore stores the consensus params in memory.
+type paramStore struct {
+	params *cmtproto.ConsensusParams
+}
+
+func (s *paramStore) Get(context.Context) (cmtproto.ConsensusParams, error) {
+	if s.params == nil {
+		return cmtproto.ConsensusParams{}, errors.New("consensus params not set")
+	}
+
+	return *s.params, nil
+}
+
+func (s *paramStore) Has(context.Context) (bool, error) {
+	return s.params != nil, nil
+}
+
+func (s *paramStore) Set(_ context.Context, cp cmtproto.ConsensusParams) error {
+	s.params = &cp
+	return nil
+}
+
+func newApp(t *testing.T) (*baseapp.BaseApp, client.TxConfig) {
+	t.Helper()
+
+	registry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	countertypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), addresscodec.NewBech32Codec("cosmos"), addresscodec.NewBech32Codec("cosmosvaloper"), authtx.DefaultSignModes)
+
+	key := storetypes.NewKVStoreKey("counter")
+	app := baseapp.NewBaseApp("localsim", log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder(), baseapp.SetChainID("test-chain"))
+	app.SetInterfaceRegistry(registry)
+	app.SetParamStore(&paramStore{})
+	app.MountStores(key)
+	app.SetInitChainer(func(ctx sdk.Context, req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+		var state struct {
+			Count uint64 `json:"count"`
+		}
+		if err := json.Unmarshal(req.AppStateBytes, &state); err != nil {
+			return nil, err
+		}
+
+		ctx.KVStore(key).Set(countKey, binary.BigEndian.AppendUint64(nil, state.Count))
+		return &abci.InitChainResponse{}, nil
+	})
+	countertypes.RegisterMsgServer(app.MsgServiceRouter(), counterServer{key: key})
+	require.NoError(t, app.LoadLatestVersion())
+
+	return app, txConfig
+}
+
+func newGenesis() *genutiltypes.AppGenesis {
+	genesis := genutiltypes.NewAppGenesisWithVersion("test-chain", json.RawMessage(`{"count":5}`))
+	genesis.GenesisTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	genesis.InitialHeight = 10
+
+	return genesis
+}
+
+func TestSimulator(t *testing.T) {
+	app, txConfig := newApp(t)
+	sim, err := NewSimulator(app, newGenesis())
+	require.NoError(t, err)
+	require.Equal(t, int64(10), app.LastBlockHeight())
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&countertypes.MsgIncreaseCounter{Signer: signer, Count: 3}))
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// the simulations run against the loaded state, without modifying it
+	for i := 0; i < 2; i++ {
+		gasInfo, result, err := sim.Simulate(txBytes)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, gasInfo.GasUsed, uint64(3000))
+
+		require.Len(t, result.MsgResponses, 1)
+		var res countertypes.MsgIncreaseCountResponse
+		require.NoError(t, res.Unmarshal(result.MsgResponses[0].Value))
+		require.Equal(t, int64(8), res.NewCount)
+	}
+
+	// the simulator is used as a connection to estimate the gas of the transactions
+	txf := clienttx.Factory{}.
+		WithTxConfig(txConfig).
+		WithChainID("test-chain").
+		WithGasAdjustment(1.5)
+	simRes, gas, err := clienttx.CalculateGas(sim, txf, &countertypes.MsgIncreaseCounter{Signer: signer, Count: 10})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, simRes.GasInfo.GasUsed, uint64(10_000))
+	require.Equal(t, uint64(1.5*float64(simRes.GasInfo.GasUsed)), gas)
+
+	_, _, err = clienttx.CalculateGas(sim, txf, &countertypes.MsgIncreaseCounter{Signer: signer, Count: -1})
+	require.ErrorContains(t, err, "count must be positive")
+
+	err = sim.Invoke(context.Background(), "/cosmos.tx.v1beta1.Service/GetTx", &txtypes.GetTxRequest{}, &txtypes.GetTxResponse{})
+	require.ErrorContains(t, err, "not supported by the local simulator")
+}
+
+func TestNewSimulatorInvalidGenesis(t *testing.T) {
+	app, _ := newApp(t)
+	genesis := newGenesis()
+	genesis.ChainID = "other-chain"
+
+	_, err := NewSimulator(app, genesis)
+	require.ErrorContains(t, err, "invalid chain-id on InitChain")
+
+	app, _ = newApp(t)
+	genesis = newGenesis()
+	genesis.ChainID = ""
+
+	_, err = NewSimulator(app, genesis)
+	require.ErrorContains(t, err, "invalid genesis")
+}
@@ -0,0 +1,150 @@
+package offchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// msgSignDataType is the amino type of the ADR-36 MsgSignData.
+const msgSignDataType = "sign/MsgSignData"
+
+// ArbitrarySignature is the signature of an arbitrary payload, as produced by
+// the wallets implementing ADR-36. It is encoded in JSON as a legacy amino
+// StdSignature.
+type ArbitrarySignature struct {
+	PubKey    cryptotypes.PubKey `json:"pub_key"`
+	Signature []byte             `json:"signature"`
+}
+
+// arbitrarySignDoc is the ADR-36 sign doc of an arbitrary payload: a legacy
+// amino sign doc of a single MsgSignData, whose chain-id, account number,
+// sequence, fee and memo are empty. The fields are sorted as in the canonical
+// JSON signed over.
+type arbitrarySignDoc struct {
+	AccountNumber string         `json:"account_number"`
+	ChainID       string         `json:"chain_id"`
+	Fee           arbitraryFee   `json:"fee"`
+	Memo          string         `json:"memo"`
+	Msgs          []arbitraryMsg `json:"msgs"`
+	Sequence      string         `json:"sequence"`
+}
+
+type arbitraryFee struct {
+	Amount []struct{} `json:"amount"`
+	Gas    string     `json:"gas"`
+}
+
+type arbitraryMsg struct {
+	Type  string      `json:"type"`
+	Value msgSignData `json:"value"`
+}
+
+type msgSignData struct {
+	Data   []byte `json:"data"`
+	Signer string `json:"signer"`
+}
+
+// GetArbitrarySignBytes returns the ADR-36 sign bytes of data signed by signer.
+func GetArbitrarySignBytes(signer string, data []byte) ([]byte, error) {
+	return json.Marshal(arbitrarySignDoc{
+		AccountNumber: "0",
+		ChainID:       ExpectedChainID,
+		Fee: arbitraryFee{
+			Amount: []struct{}{},
+			Gas:    "0",
+		},
+		Msgs: []arbitraryMsg{{
+			Type: msgSignDataType,
+			Value: msgSignData{
+				Data:   data,
+				Signer: signer,
+			},
+		}},
+		Sequence: "0",
+	})
+}
+
+// SignArbitrary signs data with the given key, over its ADR-36 sign doc.
+func SignArbitrary(ctx client.Context, fromName string, data []byte) (*ArbitrarySignature, error) {
+	keybase, err := keyring.NewAutoCLIKeyring(ctx.Keyring)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := keybase.GetPubKey(fromName)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ctx.AddressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := GetArbitrarySignBytes(signer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sign doc is a legacy amino one, which is also supported by Ledger
+	sig, err := keybase.Sign(fromName, signBytes, apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArbitrarySignature{
+		PubKey:    pubKey,
+		Signature: sig,
+	}, nil
+}
+
+// VerifyArbitrary verifies the ADR-36 signature of data by signer.
+func VerifyArbitrary(ctx client.Context, signer string, data []byte, sig *ArbitrarySignature) error {
+	if sig == nil || sig.PubKey == nil {
+		return errors.New("missing public key")
+	}
+
+	addr, err := ctx.AddressCodec.StringToBytes(signer)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(sig.PubKey.Address(), addr) {
+		return errors.New("signature does not match its respective signer")
+	}
+
+	signBytes, err := GetArbitrarySignBytes(signer, data)
+	if err != nil {
+		return err
+	}
+
+	if !sig.PubKey.VerifySignature(signBytes, sig.Signature) {
+		return errors.New("unable to verify single signer signature")
+	}
+
+	return nil
+}
+
+// MarshalArbitrarySignature encodes sig as a legacy amino StdSignature JSON.
+func MarshalArbitrarySignature(sig *ArbitrarySignature) ([]byte, error) {
+	return legacy.Cdc.MarshalJSON(sig)
+}
+
+// UnmarshalArbitrarySignature decodes a legacy amino StdSignature JSON.
+func UnmarshalArbitrarySignature(bz []byte) (*ArbitrarySignature, error) {
+	sig := &ArbitrarySignature{}
+	if err := legacy.Cdc.UnmarshalJSON(bz, sig); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return sig, nil
+}
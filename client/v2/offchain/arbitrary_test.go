@@ -0,0 +1,93 @@
+package offchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+func Test_GetArbitrarySignBytes(t *testing.T) {
+	// the sign doc signed over by the wallets implementing ADR-36
+	got, err := GetArbitrarySignBytes("cosmos1x33fy6rusfprkntvjsfregss7rvsvyy4lkwrqu", []byte("Hello <world> & co"))
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"SGVsbG8gPHdvcmxkPiAmIGNv","signer":"cosmos1x33fy6rusfprkntvjsfregss7rvsvyy4lkwrqu"}}],"sequence":"0"}`,
+		string(got),
+	)
+}
+
+func Test_SignVerifyArbitrary(t *testing.T) {
+	k := keyring.NewInMemory(getCodec())
+	ctx := client.Context{
+		Keyring:      k,
+		AddressCodec: address.NewBech32Codec("cosmos"),
+	}
+
+	record, err := k.NewAccount("signer", mnemonic, "", "m/44'/118'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	signer := addr.String()
+
+	data := []byte("Hello world!")
+	sig, err := SignArbitrary(ctx, "signer", data)
+	require.NoError(t, err)
+
+	// the signature is exchanged as a legacy amino StdSignature
+	bz, err := MarshalArbitrarySignature(sig)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"pub_key":{"type":"tendermint/PubKeySecp256k1"`)
+	sig, err = UnmarshalArbitrarySignature(bz)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		signer  string
+		data    []byte
+		sig     *ArbitrarySignature
+		wantErr string
+	}{
+		{
+			name:   "verify",
+			signer: signer,
+			data:   data,
+			sig:    sig,
+		},
+		{
+			name:    "wrong signer",
+			signer:  "cosmos1450l4uau674z55c36df0v7904rnvdk9aq8w96j",
+			data:    data,
+			sig:     sig,
+			wantErr: "signature does not match its respective signer",
+		},
+		{
+			name:    "wrong data",
+			signer:  signer,
+			data:    []byte("Hello world?"),
+			sig:     sig,
+			wantErr: "unable to verify single signer signature",
+		},
+		{
+			name:    "missing public key",
+			signer:  signer,
+			data:    data,
+			sig:     &ArbitrarySignature{Signature: sig.Signature},
+			wantErr: "missing public key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyArbitrary(ctx, tt.signer, tt.data, tt.sig)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package offchain
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 
@@ -30,6 +31,9 @@ func OffChain() *cobra.Command {
 	cmd.AddCommand(
 		SignFile(),
 		VerifyFile(),
+		SignArbitraryFile(),
+		VerifyArbitraryFile(),
+		VerifyDocument(),
 	)
 
 	flags.AddKeyringFlags(cmd.PersistentFlags())
@@ -114,3 +118,125 @@ func VerifyFile() *cobra.Command {
 	cmd.Flags().String(flagFileFormat, "json", "Choose what's the file format to be verified (json|text)")
 	return cmd
 }
+
+// SignArbitraryFile signs a file with a key, producing an ADR-36 signature.
+func SignArbitraryFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-arbitrary <keyName> <fileName>",
+		Short: "Sign a file as an ADR-36 arbitrary message.",
+		Long: `Sign the content of a file using a given key, over the ADR-36 sign doc of a MsgSignData.
+The result is the signature as a legacy amino StdSignature, as produced by the wallets implementing ADR-36.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			sig, err := SignArbitrary(clientCtx, args[0], bz)
+			if err != nil {
+				return err
+			}
+
+			out, err := MarshalArbitrarySignature(sig)
+			if err != nil {
+				return err
+			}
+
+			outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputFile != "" {
+				fp, err := os.OpenFile(filepath.Clean(outputFile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+				if err != nil {
+					return err
+				}
+				defer fp.Close()
+				cmd.SetOut(fp)
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signature will be written to the given file instead of STDOUT")
+	return cmd
+}
+
+// VerifyArbitraryFile verifies the ADR-36 signature of a file by a signer.
+func VerifyArbitraryFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-arbitrary <signerAddress> <fileName> <signatureFile>",
+		Short: "Verify the ADR-36 signature of a file.",
+		Long:  "Verify the ADR-36 signature of the content of a file by the given signer, with the signature given as a legacy amino StdSignature.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			sigBz, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			sig, err := UnmarshalArbitrarySignature(sigBz)
+			if err != nil {
+				return err
+			}
+
+			err = VerifyArbitrary(clientCtx, args[0], bz, sig)
+			if err == nil {
+				cmd.Println("Verification OK!")
+			}
+			return err
+		},
+	}
+
+	return cmd
+}
+
+// VerifyDocument verifies an ADR-36 signed document and prints the verdict.
+func VerifyDocument() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-document <fileName>",
+		Short: "Verify an ADR-36 signed document.",
+		Long: `Verify an ADR-36 signed document, as sent by a wallet to prove the ownership of its address.
+The document is a JSON object with the signer address, and the data, the public key and the signature encoded in base64:
+
+{"signer":"cosmos1...","data":"SGVsbG8=","pub_key":"A/Bf...","signature":"gRuf..."}
+
+The public key can also be a legacy amino public key, as produced by the wallets. It is tried as each registered public key type.
+The verdict is printed in JSON, e.g. {"valid":true,"signer":"cosmos1...","pub_key_type":"/cosmos.crypto.secp256k1.PubKey"}.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			verdict := NewVerifier(clientCtx.AddressCodec, clientCtx.InterfaceRegistry).VerifyJSON(bz)
+			out, err := json.Marshal(verdict)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -75,6 +75,14 @@ func (t testConfig) TxJSONDecoder() sdk.TxDecoder {
 	return nil
 }
 
+func (t testConfig) TxCBOREncoder() sdk.TxEncoder {
+	return nil
+}
+
+func (t testConfig) TxCBORDecoder() sdk.TxDecoder {
+	return nil
+}
+
 func (t testConfig) MarshalSignatureJSON(v2s []signingtypes.SignatureV2) ([]byte, error) {
 	return nil, nil
 }
@@ -95,7 +103,7 @@ func (t testConfig) SigningContext() *signing.Context {
 	return nil
 }
 
-func newTestConfig(t *testing.T) *testConfig {
+func newTestConfig(t testing.TB) *testConfig {
 	t.Helper()
 
 	enabledSignModes := []signingtypes.SignMode{
@@ -0,0 +1,81 @@
+package offchain
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	v2flags "cosmossdk.io/client/v2/internal/flags"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// FuzzVerify checks that verifying fuzzed off-chain signed documents never
+// panics, and that the documents which can be unmarshalled are unchanged by
+// marshalling and unmarshalling them again.
+func FuzzVerify(f *testing.F) {
+	if testing.Short() {
+		f.Skip("Skipping in -short mode")
+	}
+
+	k := keyring.NewInMemory(getCodec())
+	_, err := k.NewAccount("fuzzVerify", mnemonic, "", "m/44'/118'/0'/0/0", hd.Secp256k1)
+	if err != nil {
+		f.Fatal(err)
+	}
+	ctx := client.Context{
+		TxConfig:     newTestConfig(f),
+		Codec:        getCodec(),
+		AddressCodec: address.NewBech32Codec("cosmos"),
+		Keyring:      k,
+	}
+
+	// 1. Add the documents signed in every output format as seeds.
+	for _, raw := range []string{"digest", `{"name": "John", "age": 15}`, ""} {
+		for _, text := range []bool{false, true} {
+			signed, err := Sign(ctx, []byte(raw), "fuzzVerify", "", noEncoder, fileFormat(text), false)
+			if err != nil {
+				f.Fatal(err)
+			}
+			f.Add([]byte(signed), text)
+		}
+	}
+
+	// 2. Now fuzz it.
+	f.Fuzz(func(t *testing.T, digest []byte, text bool) {
+		format := fileFormat(text)
+		// Just ensure it doesn't crash.
+		_ = Verify(ctx, digest, format)
+
+		tx, err := unmarshal(digest, format)
+		if err != nil {
+			return
+		}
+		m, err := getMarshaller(format, "", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bz, err := m.Marshal(tx)
+		if err != nil {
+			t.Fatalf("unmarshalled document cannot be marshalled: %v", err)
+		}
+		tx2, err := unmarshal(bz, format)
+		if err != nil {
+			t.Fatalf("marshalled document cannot be unmarshalled: %v\n%s", err, bz)
+		}
+		if !proto.Equal(tx, tx2) {
+			t.Fatalf("document changed after marshalling:\n%v\n%v", tx, tx2)
+		}
+	})
+}
+
+func fileFormat(text bool) string {
+	if text {
+		return v2flags.OutputFormatText
+	}
+	return v2flags.OutputFormatJSON
+}
@@ -0,0 +1,187 @@
+package offchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// pubKeyInterfaceName is the name of the PubKey interface in the interface registry.
+const pubKeyInterfaceName = "cosmos.crypto.PubKey"
+
+// SignedDocument is an ADR-36 arbitrary message signed off-chain, as sent by a
+// wallet to prove the ownership of its address, e.g. to log in to a backend.
+type SignedDocument struct {
+	// Signer is the address of the signer.
+	Signer string `json:"signer"`
+	// Data is the signed payload, encoded in base64.
+	Data []byte `json:"data"`
+	// PubKey is the public key of the signer, encoded in base64.
+	PubKey DocumentPubKey `json:"pub_key"`
+	// Signature is the signature, encoded in base64.
+	Signature []byte `json:"signature"`
+}
+
+// DocumentPubKey is the public key of a SignedDocument. It is decoded from its
+// raw bytes encoded in base64, or from a legacy amino public key, as produced by
+// the wallets, whose type is ignored.
+type DocumentPubKey []byte
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (pk *DocumentPubKey) UnmarshalJSON(bz []byte) error {
+	var aminoPubKey struct {
+		Value []byte `json:"value"`
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(bz), []byte("{")) {
+		if err := json.Unmarshal(bz, &aminoPubKey); err != nil {
+			return err
+		}
+		*pk = aminoPubKey.Value
+		return nil
+	}
+
+	var raw []byte
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+	*pk = raw
+	return nil
+}
+
+// Verdict is the machine-readable result of the verification of a SignedDocument.
+type Verdict struct {
+	// Valid is true if the document is signed by its signer.
+	Valid bool `json:"valid"`
+	// Signer is the address of the signer of the document.
+	Signer string `json:"signer"`
+	// PubKeyType is the type URL of the public key that verified the signature.
+	PubKeyType string `json:"pub_key_type,omitempty"`
+	// Error is the reason why the document is not valid.
+	Error string `json:"error,omitempty"`
+}
+
+// Verifier verifies the SignedDocuments against the public key types registered
+// in an interface registry.
+type Verifier struct {
+	addressCodec address.Codec
+	registry     codectypes.InterfaceRegistry
+}
+
+// NewVerifier returns a Verifier resolving the addresses of the signers with
+// addressCodec, and trying the public key types registered in registry.
+func NewVerifier(addressCodec address.Codec, registry codectypes.InterfaceRegistry) *Verifier {
+	return &Verifier{
+		addressCodec: addressCodec,
+		registry:     registry,
+	}
+}
+
+// VerifyJSON decodes a SignedDocument from JSON and verifies it.
+func (v *Verifier) VerifyJSON(bz []byte) Verdict {
+	var doc SignedDocument
+	if err := json.Unmarshal(bz, &doc); err != nil {
+		return Verdict{Error: fmt.Sprintf("invalid document: %v", err)}
+	}
+
+	return v.Verify(doc)
+}
+
+// Verify verifies that doc is signed by its signer. The public key of the
+// document is tried as each of the registered public key types, the document is
+// valid if one of them has the address of the signer and verifies the signature
+// of the ADR-36 sign doc of the data.
+func (v *Verifier) Verify(doc SignedDocument) Verdict {
+	verdict := Verdict{Signer: doc.Signer}
+
+	typeURL, err := v.verify(doc)
+	if err != nil {
+		verdict.Error = err.Error()
+		return verdict
+	}
+
+	verdict.Valid = true
+	verdict.PubKeyType = typeURL
+	return verdict
+}
+
+func (v *Verifier) verify(doc SignedDocument) (string, error) {
+	addr, err := v.addressCodec.StringToBytes(doc.Signer)
+	if err != nil {
+		return "", fmt.Errorf("invalid signer: %w", err)
+	}
+
+	if len(doc.PubKey) == 0 {
+		return "", errors.New("missing public key")
+	}
+
+	signBytes, err := GetArbitrarySignBytes(doc.Signer, doc.Data)
+	if err != nil {
+		return "", err
+	}
+
+	matched := false
+	for _, typeURL := range v.registry.ListImplementations(pubKeyInterfaceName) {
+		pubKey, ok := v.pubKey(typeURL, doc.PubKey)
+		if !ok || !matchesAddress(pubKey, addr) {
+			continue
+		}
+
+		matched = true
+		if safeVerifySignature(pubKey, signBytes, doc.Signature) {
+			return typeURL, nil
+		}
+	}
+
+	if !matched {
+		return "", errors.New("public key does not match the signer")
+	}
+
+	return "", errors.New("unable to verify single signer signature")
+}
+
+// pubKey returns the public key of type typeURL with the given raw bytes, if
+// they are a valid key of this type.
+func (v *Verifier) pubKey(typeURL string, key []byte) (cryptotypes.PubKey, bool) {
+	// the public keys hold their raw bytes in their first field
+	value := protowire.AppendTag(nil, 1, protowire.BytesType)
+	value = protowire.AppendBytes(value, key)
+
+	var pubKey cryptotypes.PubKey
+	if err := v.registry.UnpackAny(&codectypes.Any{TypeUrl: typeURL, Value: value}, &pubKey); err != nil {
+		return nil, false
+	}
+
+	return pubKey, true
+}
+
+// matchesAddress returns true if pubKey has the address addr. Some public keys
+// panic on invalid bytes, they don't match.
+func matchesAddress(pubKey cryptotypes.PubKey, addr []byte) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	return bytes.Equal(pubKey.Address(), addr)
+}
+
+// safeVerifySignature returns true if sig is a signature of msg by pubKey. Some
+// public keys panic on invalid bytes, they don't verify any signature.
+func safeVerifySignature(pubKey cryptotypes.PubKey, msg, sig []byte) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	return pubKey.VerifySignature(msg, sig)
+}
@@ -0,0 +1,144 @@
+package offchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func Test_Verifier(t *testing.T) {
+	cdc := getCodec()
+	ac := address.NewBech32Codec("cosmos")
+	verifier := NewVerifier(ac, cdc.InterfaceRegistry())
+	data := []byte("Hello world!")
+
+	// a document signed by a wallet, with its amino public key
+	k := keyring.NewInMemory(cdc)
+	_, err := k.NewAccount("signer", mnemonic, "", "m/44'/118'/0'/0/0", hd.Secp256k1)
+	require.NoError(t, err)
+	sig, err := SignArbitrary(client.Context{Keyring: k, AddressCodec: ac}, "signer", data)
+	require.NoError(t, err)
+	walletSig, err := MarshalArbitrarySignature(sig)
+	require.NoError(t, err)
+	signer, err := ac.BytesToString(sig.PubKey.Address())
+	require.NoError(t, err)
+	walletDoc := fmt.Sprintf(`{"signer":%q,"data":"SGVsbG8gd29ybGQh",%s`, signer, walletSig[1:])
+
+	t.Run("wallet document", func(t *testing.T) {
+		verdict := verifier.VerifyJSON([]byte(walletDoc))
+		require.Equal(t, Verdict{Valid: true, Signer: signer, PubKeyType: "/cosmos.crypto.secp256k1.PubKey"}, verdict)
+	})
+
+	// the documents signed by the other registered public key types
+	ed25519Priv := ed25519.GenPrivKey()
+	secp256r1Priv, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	signDocument := func(priv cryptotypes.PrivKey, signer string, data []byte) SignedDocument {
+		signBytes, err := GetArbitrarySignBytes(signer, data)
+		require.NoError(t, err)
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+		return SignedDocument{
+			Signer:    signer,
+			Data:      data,
+			PubKey:    DocumentPubKey(rawPubKey(t, priv.PubKey())),
+			Signature: sig,
+		}
+	}
+	ed25519Signer, err := ac.BytesToString(ed25519Priv.PubKey().Address())
+	require.NoError(t, err)
+	secp256r1Signer, err := ac.BytesToString(secp256r1Priv.PubKey().Address())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		doc     SignedDocument
+		verdict Verdict
+	}{
+		{
+			name:    "ed25519",
+			doc:     signDocument(ed25519Priv, ed25519Signer, data),
+			verdict: Verdict{Valid: true, Signer: ed25519Signer, PubKeyType: "/cosmos.crypto.ed25519.PubKey"},
+		},
+		{
+			name:    "secp256r1",
+			doc:     signDocument(secp256r1Priv, secp256r1Signer, data),
+			verdict: Verdict{Valid: true, Signer: secp256r1Signer, PubKeyType: "/cosmos.crypto.secp256r1.PubKey"},
+		},
+		{
+			name:    "wrong signer",
+			doc:     signDocument(ed25519Priv, secp256r1Signer, data),
+			verdict: Verdict{Signer: secp256r1Signer, Error: "public key does not match the signer"},
+		},
+		{
+			name: "wrong data",
+			doc: func() SignedDocument {
+				doc := signDocument(ed25519Priv, ed25519Signer, data)
+				doc.Data = []byte("Hello world?")
+				return doc
+			}(),
+			verdict: Verdict{Signer: ed25519Signer, Error: "unable to verify single signer signature"},
+		},
+		{
+			name: "invalid signer",
+			doc: func() SignedDocument {
+				doc := signDocument(ed25519Priv, ed25519Signer, data)
+				doc.Signer = "cosmos1invalid"
+				return doc
+			}(),
+			verdict: Verdict{Signer: "cosmos1invalid", Error: "invalid signer: decoding bech32 failed: invalid character not part of charset: 105"},
+		},
+		{
+			name: "missing public key",
+			doc: func() SignedDocument {
+				doc := signDocument(ed25519Priv, ed25519Signer, data)
+				doc.PubKey = nil
+				return doc
+			}(),
+			verdict: Verdict{Signer: ed25519Signer, Error: "missing public key"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.verdict, verifier.Verify(tt.doc))
+
+			// the same verdict is given for the JSON document
+			bz, err := json.Marshal(tt.doc)
+			require.NoError(t, err)
+			require.Equal(t, tt.verdict, verifier.VerifyJSON(bz))
+		})
+	}
+
+	t.Run("invalid document", func(t *testing.T) {
+		verdict := verifier.VerifyJSON([]byte("{"))
+		require.False(t, verdict.Valid)
+		require.Contains(t, verdict.Error, "invalid document")
+	})
+}
+
+// rawPubKey returns the raw bytes of pubKey, as held by its first field.
+func rawPubKey(t *testing.T, pubKey cryptotypes.PubKey) []byte {
+	t.Helper()
+
+	switch pk := pubKey.(type) {
+	case *ed25519.PubKey:
+		return pk.Key
+	case *secp256r1.PubKey:
+		bz, err := pk.Key.Marshal()
+		require.NoError(t, err)
+		return bz
+	default:
+		return pubKey.Bytes()
+	}
+}
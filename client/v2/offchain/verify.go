@@ -31,6 +31,10 @@ func Verify(ctx client.Context, digest []byte, fileFormat string) error {
 
 // verify verifies given Tx.
 func verify(ctx client.Context, tx *apitx.Tx) error {
+	if err := validateTx(tx); err != nil {
+		return err
+	}
+
 	sigTx := builder{
 		cdc: ctx.Codec,
 		tx:  tx,
@@ -54,11 +58,18 @@ func verify(ctx client.Context, tx *apitx.Tx) error {
 
 	for i, sig := range sigs {
 		pubKey := sig.PubKey
-		if !bytes.Equal(pubKey.Address(), signers[i]) {
+		if pubKey == nil {
+			return fmt.Errorf("missing public key of signer %d", i)
+		}
+		pubKeyAddr, err := pubKeyAddress(pubKey)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(pubKeyAddr, signers[i]) {
 			return errors.New("signature does not match its respective signer")
 		}
 
-		addr, err := ctx.AddressCodec.BytesToString(pubKey.Address())
+		addr, err := ctx.AddressCodec.BytesToString(pubKeyAddr)
 		if err != nil {
 			return err
 		}
@@ -92,6 +103,37 @@ func verify(ctx client.Context, tx *apitx.Tx) error {
 	return nil
 }
 
+// validateTx checks that an unmarshalled Tx has all the fields required to
+// verify it, as they may be missing from a malformed digest.
+func validateTx(tx *apitx.Tx) error {
+	if tx.Body == nil {
+		return errors.New("missing tx body")
+	}
+	if tx.AuthInfo == nil || tx.AuthInfo.Fee == nil {
+		return errors.New("missing tx auth info")
+	}
+	if len(tx.AuthInfo.SignerInfos) != len(tx.Signatures) {
+		return errors.New("mismatch between the number of signatures and signer infos")
+	}
+	for i, signerInfo := range tx.AuthInfo.SignerInfos {
+		if signerInfo == nil || signerInfo.PublicKey == nil || signerInfo.ModeInfo == nil {
+			return fmt.Errorf("missing public key or mode info of signer %d", i)
+		}
+	}
+	return nil
+}
+
+// pubKeyAddress returns the address of a public key, which panics if the key
+// unmarshalled from the digest is malformed.
+func pubKeyAddress(pubKey cryptotypes.PubKey) (addr cryptotypes.Address, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid public key: %v", r)
+		}
+	}()
+	return pubKey.Address(), nil
+}
+
 // unmarshal unmarshalls a digest to a Tx using protobuf protojson.
 func unmarshal(digest []byte, fileFormat string) (*apitx.Tx, error) {
 	var err error
@@ -0,0 +1,59 @@
+package tx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// maxBatchLineSize is the maximum size of a transaction of a batch.
+const maxBatchLineSize = 10 << 20
+
+// SignBatch signs the newline-delimited JSON transactions read from r, as
+// decoded by the JSON decoder, and writes them signed to w, one per line as
+// encoded by the JSON encoder. The transactions are signed by the same account,
+// the first one with the sequence of opts, the next ones with incrementing
+// sequences. They are streamed one at a time, so that batches of any size can
+// be signed. Empty lines are skipped.
+//
+// It returns the number of signed transactions, the ones signed before an
+// error being already written.
+func (c *TxConfig) SignBatch(ctx context.Context, r io.Reader, w io.Writer, signer Signer, opts SignerOptions) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxBatchLineSize)
+
+	signed := 0
+	for line := 1; scanner.Scan(); line++ {
+		bz := bytes.TrimSpace(scanner.Bytes())
+		if len(bz) == 0 {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return signed, err
+		}
+
+		tx, err := c.txJSONDecoder(bz)
+		if err != nil {
+			return signed, fmt.Errorf("failed to decode the transaction of line %d: %w", line, err)
+		}
+
+		if err := c.Sign(ctx, tx, signer, opts); err != nil {
+			return signed, fmt.Errorf("failed to sign the transaction of line %d: %w", line, err)
+		}
+
+		bz, err = c.txJSONEncoder(tx)
+		if err != nil {
+			return signed, fmt.Errorf("failed to encode the transaction of line %d: %w", line, err)
+		}
+		if _, err := w.Write(append(bz, '\n')); err != nil {
+			return signed, err
+		}
+
+		signed++
+		opts.Sequence++
+	}
+
+	return signed, scanner.Err()
+}
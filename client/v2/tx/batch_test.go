@@ -0,0 +1,87 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+)
+
+func unsignedTxJSON(t *testing.T, txConfig *TxConfig, from, memo string) string {
+	t.Helper()
+	bz, err := txConfig.TxJSONEncoder()(&txv1beta1.Tx{
+		Body:     &txv1beta1.TxBody{Messages: []*anypb.Any{sendMsg(t, from)}, Memo: memo},
+		AuthInfo: &txv1beta1.AuthInfo{Fee: &txv1beta1.Fee{GasLimit: 200000}},
+	})
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestSignBatch(t *testing.T) {
+	txConfig, err := NewTxConfig(ConfigOptions{SigningOptions: signingOptions()})
+	require.NoError(t, err)
+	key, from := newAccount(t)
+	opts := SignerOptions{
+		Address:       from,
+		ChainID:       "test-chain",
+		AccountNumber: 4,
+		Sequence:      10,
+		SignMode:      apitxsigning.SignMode_SIGN_MODE_DIRECT,
+	}
+
+	in := unsignedTxJSON(t, txConfig, from, "first") + "\n\n" + unsignedTxJSON(t, txConfig, from, "second") + "\n"
+	var out bytes.Buffer
+	n, err := txConfig.SignBatch(context.Background(), strings.NewReader(in), &out, key, opts)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	for i, line := range lines {
+		tx, err := txConfig.TxJSONDecoder()([]byte(line))
+		require.NoError(t, err)
+		require.Len(t, tx.Signatures, 1)
+
+		// the transactions are signed with incrementing sequences
+		signerInfo := tx.AuthInfo.SignerInfos[0]
+		require.Equal(t, uint64(10+i), signerInfo.Sequence)
+
+		txData, err := signingTxData(tx)
+		require.NoError(t, err)
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(context.Background(), apitxsigning.SignMode_SIGN_MODE_DIRECT, txsigning.SignerData{
+			Address:       opts.Address,
+			ChainID:       opts.ChainID,
+			AccountNumber: opts.AccountNumber,
+			Sequence:      signerInfo.Sequence,
+			PubKey:        signerInfo.PublicKey,
+		}, txData)
+		require.NoError(t, err)
+		require.True(t, key.PubKey().VerifySignature(signBytes, tx.Signatures[0]))
+	}
+
+	// the transactions signed before an invalid one are written
+	out.Reset()
+	in = unsignedTxJSON(t, txConfig, from, "first") + "\n{not json}\n"
+	n, err = txConfig.SignBatch(context.Background(), strings.NewReader(in), &out, key, opts)
+	require.ErrorContains(t, err, "failed to decode the transaction of line 2")
+	require.Equal(t, 1, n)
+	require.Equal(t, 1, strings.Count(out.String(), "\n"))
+
+	// the transactions can be signed in any enabled sign mode
+	out.Reset()
+	opts.SignMode = apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	n, err = txConfig.SignBatch(context.Background(), strings.NewReader(unsignedTxJSON(t, txConfig, from, "amino")), &out, key, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// the sign mode must be enabled
+	_, err = txConfig.SignBatch(context.Background(), strings.NewReader(unsignedTxJSON(t, txConfig, from, "")), &out, key, SignerOptions{Address: from, SignMode: apitxsigning.SignMode_SIGN_MODE_TEXTUAL})
+	require.ErrorContains(t, err, "failed to sign the transaction of line 1")
+}
@@ -0,0 +1,175 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/spf13/pflag"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// TxBuilder builds the transactions signed with client/v2, see TxConfig.Sign.
+type TxBuilder struct {
+	signingCtx *txsigning.Context
+	tx         *apitx.Tx
+}
+
+// NewTxBuilder returns a builder of an empty transaction, paid by the fee payer
+// and granter of the ConfigOptions if any.
+func (c *TxConfig) NewTxBuilder() *TxBuilder {
+	return &TxBuilder{
+		signingCtx: c.signingCtx,
+		tx: &apitx.Tx{
+			Body: &apitx.TxBody{},
+			AuthInfo: &apitx.AuthInfo{
+				Fee: &apitx.Fee{
+					Payer:   c.feePayer,
+					Granter: c.feeGranter,
+				},
+			},
+		},
+	}
+}
+
+// GetTx returns the transaction being built.
+func (b *TxBuilder) GetTx() *apitx.Tx {
+	return b.tx
+}
+
+// SetMsgs sets the messages of the transaction.
+func (b *TxBuilder) SetMsgs(msgs ...protov2.Message) error {
+	anys := make([]*anypb.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = anyutil.New(msg)
+		if err != nil {
+			return err
+		}
+	}
+	b.tx.Body.Messages = anys
+	return nil
+}
+
+// SetMemo sets the memo of the transaction.
+func (b *TxBuilder) SetMemo(memo string) {
+	b.tx.Body.Memo = memo
+}
+
+// SetTimeoutHeight sets the height after which the transaction is not valid.
+func (b *TxBuilder) SetTimeoutHeight(height uint64) {
+	b.tx.Body.TimeoutHeight = height
+}
+
+// SetFeeAmount sets the fees of the transaction.
+func (b *TxBuilder) SetFeeAmount(amount []*basev1beta1.Coin) {
+	b.tx.AuthInfo.Fee.Amount = amount
+}
+
+// SetGasLimit sets the gas limit of the transaction.
+func (b *TxBuilder) SetGasLimit(gasLimit uint64) {
+	b.tx.AuthInfo.Fee.GasLimit = gasLimit
+}
+
+// SetFeeGranter sets the account paying the fees of the transaction with a
+// fee grant, none if empty.
+func (b *TxBuilder) SetFeeGranter(granter string) error {
+	if err := b.validateAddress(granter); err != nil {
+		return fmt.Errorf("invalid fee granter: %w", err)
+	}
+	b.tx.AuthInfo.Fee.Granter = granter
+	return nil
+}
+
+// SetFeePayer sets the account paying the fees of the transaction, the first
+// signer if empty. The fee payer must sign the transaction, see GetSigners.
+func (b *TxBuilder) SetFeePayer(payer string) error {
+	if err := b.validateAddress(payer); err != nil {
+		return fmt.Errorf("invalid fee payer: %w", err)
+	}
+	b.tx.AuthInfo.Fee.Payer = payer
+	return nil
+}
+
+// SetFeeFlags sets the fee payer and the fee granter of the transaction from
+// the --fee-payer and --fee-granter flags of flagSet, as added by
+// flags.AddTxFlagsToCmd. The flags which are not set are ignored.
+func (b *TxBuilder) SetFeeFlags(flagSet *pflag.FlagSet) error {
+	if flagSet.Changed(flags.FlagFeePayer) {
+		payer, err := flagSet.GetString(flags.FlagFeePayer)
+		if err != nil {
+			return err
+		}
+		if err := b.SetFeePayer(payer); err != nil {
+			return err
+		}
+	}
+
+	if flagSet.Changed(flags.FlagFeeGranter) {
+		granter, err := flagSet.GetString(flags.FlagFeeGranter)
+		if err != nil {
+			return err
+		}
+		if err := b.SetFeeGranter(granter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the addresses of the accounts which must sign the
+// transaction, in the order of their signatures: the signers of the messages,
+// then the fee payer if it is not one of them.
+func (b *TxBuilder) GetSigners() ([][]byte, error) {
+	return txSigners(b.signingCtx, b.tx)
+}
+
+// txSigners returns the addresses of the accounts which must sign tx, in the
+// order of their signatures, see TxBuilder.GetSigners.
+func txSigners(signingCtx *txsigning.Context, tx *apitx.Tx) ([][]byte, error) {
+	var signers [][]byte
+	seen := make(map[string]bool)
+	for _, anyMsg := range tx.Body.Messages {
+		msg, err := anyutil.Unpack(anyMsg, signingCtx.FileResolver(), signingCtx.TypeResolver())
+		if err != nil {
+			return nil, err
+		}
+		msgSigners, err := signingCtx.GetSigners(msg)
+		if err != nil {
+			return nil, err
+		}
+		for _, signer := range msgSigners {
+			if !seen[string(signer)] {
+				signers = append(signers, signer)
+				seen[string(signer)] = true
+			}
+		}
+	}
+
+	if tx.AuthInfo.Fee != nil && tx.AuthInfo.Fee.Payer != "" {
+		payerBz, err := signingCtx.AddressCodec().StringToBytes(tx.AuthInfo.Fee.Payer)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[string(payerBz)] {
+			signers = append(signers, payerBz)
+		}
+	}
+
+	return signers, nil
+}
+
+func (b *TxBuilder) validateAddress(addr string) error {
+	if addr == "" {
+		return nil
+	}
+	_, err := b.signingCtx.AddressCodec().StringToBytes(addr)
+	return err
+}
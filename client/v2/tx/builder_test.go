@@ -0,0 +1,92 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestTxBuilderFeePayer(t *testing.T) {
+	txConfig, err := NewTxConfig(ConfigOptions{SigningOptions: signingOptions()})
+	require.NoError(t, err)
+	codec := addresscodec.NewBech32Codec("cosmos")
+	newAddress := func() ([]byte, string) {
+		addr := secp256k1.GenPrivKey().PubKey().Address()
+		addrStr, err := codec.BytesToString(addr)
+		require.NoError(t, err)
+		return addr, addrStr
+	}
+	sender, senderStr := newAddress()
+	_, recipientStr := newAddress()
+	payer, payerStr := newAddress()
+	_, granterStr := newAddress()
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&bankv1beta1.MsgSend{
+		FromAddress: senderStr,
+		ToAddress:   recipientStr,
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "1"}},
+	}))
+	builder.SetFeeAmount([]*basev1beta1.Coin{{Denom: "stake", Amount: "10"}})
+	builder.SetGasLimit(100)
+
+	signers, err := builder.GetSigners()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{sender}, signers)
+
+	// the fee payer must sign after the signers of the messages
+	require.NoError(t, builder.SetFeePayer(payerStr))
+	require.NoError(t, builder.SetFeeGranter(granterStr))
+	signers, err = builder.GetSigners()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{sender, payer}, signers)
+	require.Equal(t, payerStr, builder.GetTx().AuthInfo.Fee.Payer)
+	require.Equal(t, granterStr, builder.GetTx().AuthInfo.Fee.Granter)
+
+	// a fee payer signing messages isn't required twice
+	require.NoError(t, builder.SetFeePayer(senderStr))
+	signers, err = builder.GetSigners()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{sender}, signers)
+
+	require.ErrorContains(t, builder.SetFeePayer("invalid"), "invalid fee payer")
+	require.ErrorContains(t, builder.SetFeeGranter("invalid"), "invalid fee granter")
+	require.Equal(t, senderStr, builder.GetTx().AuthInfo.Fee.Payer)
+	require.Equal(t, granterStr, builder.GetTx().AuthInfo.Fee.Granter)
+}
+
+func TestTxBuilderFeeOptions(t *testing.T) {
+	_, payer := newAccount(t)
+	_, granter := newAccount(t)
+	_, other := newAccount(t)
+
+	// the fee accounts of the config are set on the built transactions
+	txConfig, err := NewTxConfig(ConfigOptions{SigningOptions: signingOptions(), FeePayer: payer, FeeGranter: granter})
+	require.NoError(t, err)
+	builder := txConfig.NewTxBuilder()
+	require.Equal(t, payer, builder.GetTx().AuthInfo.Fee.Payer)
+	require.Equal(t, granter, builder.GetTx().AuthInfo.Fee.Granter)
+
+	_, err = NewTxConfig(ConfigOptions{SigningOptions: signingOptions(), FeeGranter: "invalid"})
+	require.ErrorContains(t, err, "invalid fee account")
+
+	// the flags override them, the unset flags being ignored
+	flagSet := pflag.NewFlagSet("tx", pflag.ContinueOnError)
+	flagSet.String(flags.FlagFeePayer, "", "")
+	flagSet.String(flags.FlagFeeGranter, "", "")
+	require.NoError(t, flagSet.Parse([]string{"--" + flags.FlagFeeGranter, other}))
+	require.NoError(t, builder.SetFeeFlags(flagSet))
+	require.Equal(t, payer, builder.GetTx().AuthInfo.Fee.Payer)
+	require.Equal(t, other, builder.GetTx().AuthInfo.Fee.Granter)
+
+	require.NoError(t, flagSet.Parse([]string{"--" + flags.FlagFeePayer, "invalid"}))
+	require.ErrorContains(t, builder.SetFeeFlags(flagSet), "invalid fee payer")
+}
@@ -0,0 +1,229 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+	"cosmossdk.io/x/tx/signing/direct"
+	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip191"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+// DefaultSignModes are the sign modes enabled by NewHandlerMap when none are
+// configured.
+var DefaultSignModes = []apitxsigning.SignMode{
+	apitxsigning.SignMode_SIGN_MODE_DIRECT,
+	apitxsigning.SignMode_SIGN_MODE_DIRECT_AUX,
+	apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+}
+
+// ConfigOptions configure the sign mode handlers and the encoding of the
+// transactions signed with client/v2, see NewTxConfig.
+type ConfigOptions struct {
+	// SigningOptions are the options of the signing context and of the sign
+	// mode handlers. They are required.
+	SigningOptions *txsigning.Options
+	// EnabledSignModes are the sign modes to enable, DefaultSignModes if empty.
+	EnabledSignModes []apitxsigning.SignMode
+	// CustomSignModes are sign mode handlers enabled along with the enabled
+	// sign modes.
+	CustomSignModes []txsigning.SignModeHandler
+	// TextualCoinMetadataQueryFn queries the metadata of the coins rendered by
+	// SIGN_MODE_TEXTUAL. It is required if SIGN_MODE_TEXTUAL or
+	// SIGN_MODE_EIP_191 is enabled.
+	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// EIP191 are the options of the SIGN_MODE_EIP_191 handler, which signs the
+	// plain text rendering of the SIGN_MODE_TEXTUAL screens of the transactions
+	// as an Ethereum personal message, e.g. with Metamask. Their Textual
+	// handler, rendering the screens, defaults to the SIGN_MODE_TEXTUAL handler
+	// built with TextualCoinMetadataQueryFn.
+	EIP191 eip191.SignModeHandlerOptions
+	// TxEncoder encodes the transactions, as TxRaw if nil.
+	TxEncoder TxEncoder
+	// TxDecoder decodes the transactions, from TxRaw if nil.
+	TxDecoder TxDecoder
+	// TxJSONEncoder encodes the transactions to JSON, as the JSON of a Tx if
+	// nil.
+	TxJSONEncoder TxEncoder
+	// TxJSONDecoder decodes the transactions from JSON, from the JSON of a Tx
+	// if nil.
+	TxJSONDecoder TxDecoder
+	// FeePayer is the fee payer of the transactions built by NewTxBuilder, the
+	// first signer of each transaction if empty.
+	FeePayer string
+	// FeeGranter is the fee granter of the transactions built by NewTxBuilder,
+	// none if empty.
+	FeeGranter string
+}
+
+// TxConfig is the configuration of the transactions signed with client/v2:
+// their sign mode handlers and encodings. Apps with a custom transaction
+// envelope, e.g. wrapping the transactions in an extension, plug their own
+// encoders in the ConfigOptions while reusing the sign mode handlers.
+type TxConfig struct {
+	signingCtx    *txsigning.Context
+	handlerMap    *txsigning.HandlerMap
+	txEncoder     TxEncoder
+	txDecoder     TxDecoder
+	txJSONEncoder TxEncoder
+	txJSONDecoder TxDecoder
+	feePayer      string
+	feeGranter    string
+}
+
+// NewTxConfig returns the TxConfig configured by opts.
+func NewTxConfig(opts ConfigOptions) (*TxConfig, error) {
+	handlerMap, err := NewHandlerMap(opts)
+	if err != nil {
+		return nil, err
+	}
+	signingCtx, err := txsigning.NewContext(*opts.SigningOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	txConfig := &TxConfig{
+		signingCtx:    signingCtx,
+		handlerMap:    handlerMap,
+		txEncoder:     opts.TxEncoder,
+		txDecoder:     opts.TxDecoder,
+		txJSONEncoder: opts.TxJSONEncoder,
+		txJSONDecoder: opts.TxJSONDecoder,
+		feePayer:      opts.FeePayer,
+		feeGranter:    opts.FeeGranter,
+	}
+	for _, addr := range []string{opts.FeePayer, opts.FeeGranter} {
+		if addr == "" {
+			continue
+		}
+		if _, err := signingCtx.AddressCodec().StringToBytes(addr); err != nil {
+			return nil, fmt.Errorf("invalid fee account %s: %w", addr, err)
+		}
+	}
+	if txConfig.txEncoder == nil {
+		txConfig.txEncoder = encodeTx
+	}
+	if txConfig.txDecoder == nil {
+		txConfig.txDecoder = decodeTx
+	}
+	if txConfig.txJSONEncoder == nil {
+		txConfig.txJSONEncoder = encodeTxJSON
+	}
+	if txConfig.txJSONDecoder == nil {
+		txConfig.txJSONDecoder = decodeTxJSON
+	}
+
+	return txConfig, nil
+}
+
+// SignModeHandler returns the sign mode handlers of the enabled sign modes.
+func (c *TxConfig) SignModeHandler() *txsigning.HandlerMap {
+	return c.handlerMap
+}
+
+// TxEncoder returns the encoder of the transactions.
+func (c *TxConfig) TxEncoder() TxEncoder {
+	return c.txEncoder
+}
+
+// TxDecoder returns the decoder of the transactions.
+func (c *TxConfig) TxDecoder() TxDecoder {
+	return c.txDecoder
+}
+
+// TxJSONEncoder returns the JSON encoder of the transactions.
+func (c *TxConfig) TxJSONEncoder() TxEncoder {
+	return c.txJSONEncoder
+}
+
+// TxJSONDecoder returns the JSON decoder of the transactions.
+func (c *TxConfig) TxJSONDecoder() TxDecoder {
+	return c.txJSONDecoder
+}
+
+// NewHandlerMap returns the sign mode handlers of the enabled sign modes.
+func NewHandlerMap(opts ConfigOptions) (*txsigning.HandlerMap, error) {
+	if opts.SigningOptions == nil {
+		return nil, errors.New("signing options not provided")
+	}
+	signingOpts := opts.SigningOptions
+
+	signModes := opts.EnabledSignModes
+	if len(signModes) == 0 {
+		signModes = DefaultSignModes
+	}
+
+	// the SIGN_MODE_TEXTUAL handler is built once, as it is also used by
+	// SIGN_MODE_EIP_191
+	var textualHandler *textual.SignModeHandler
+	getTextual := func() (*textual.SignModeHandler, error) {
+		if textualHandler != nil {
+			return textualHandler, nil
+		}
+		if opts.TextualCoinMetadataQueryFn == nil {
+			return nil, errors.New("cannot enable SIGN_MODE_TEXTUAL without a TextualCoinMetadataQueryFn")
+		}
+		var err error
+		textualHandler, err = textual.NewSignModeHandler(textual.SignModeOptions{
+			CoinMetadataQuerier: opts.TextualCoinMetadataQueryFn,
+			FileResolver:        signingOpts.FileResolver,
+			TypeResolver:        signingOpts.TypeResolver,
+		})
+		return textualHandler, err
+	}
+
+	handlers := make([]txsigning.SignModeHandler, 0, len(signModes)+len(opts.CustomSignModes))
+	for _, mode := range signModes {
+		switch mode {
+		case apitxsigning.SignMode_SIGN_MODE_DIRECT:
+			handlers = append(handlers, &direct.SignModeHandler{})
+		case apitxsigning.SignMode_SIGN_MODE_DIRECT_AUX:
+			signersContext, err := txsigning.NewContext(*signingOpts)
+			if err != nil {
+				return nil, err
+			}
+			handler, err := directaux.NewSignModeHandler(directaux.SignModeHandlerOptions{
+				TypeResolver:   signingOpts.TypeResolver,
+				SignersContext: signersContext,
+			})
+			if err != nil {
+				return nil, err
+			}
+			handlers = append(handlers, handler)
+		case apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+			handlers = append(handlers, aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{
+				FileResolver: signingOpts.FileResolver,
+				TypeResolver: signingOpts.TypeResolver,
+			}))
+		case apitxsigning.SignMode_SIGN_MODE_TEXTUAL:
+			handler, err := getTextual()
+			if err != nil {
+				return nil, err
+			}
+			handlers = append(handlers, handler)
+		case apitxsigning.SignMode_SIGN_MODE_EIP_191: //nolint:staticcheck // the sign mode is deprecated in the proto but is the one of EIP-191
+			eip191Opts := opts.EIP191
+			if eip191Opts.Textual == nil {
+				var err error
+				eip191Opts.Textual, err = getTextual()
+				if err != nil {
+					return nil, fmt.Errorf("SIGN_MODE_EIP_191 renders the SIGN_MODE_TEXTUAL screens: %w", err)
+				}
+			}
+			handler, err := eip191.NewSignModeHandler(eip191Opts)
+			if err != nil {
+				return nil, err
+			}
+			handlers = append(handlers, handler)
+		default:
+			return nil, fmt.Errorf("unsupported sign mode %s", mode)
+		}
+	}
+	handlers = append(handlers, opts.CustomSignModes...)
+
+	return txsigning.NewHandlerMap(handlers...), nil
+}
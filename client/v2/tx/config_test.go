@@ -0,0 +1,145 @@
+package tx
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip191"
+	"cosmossdk.io/x/tx/signing/testutil"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+)
+
+func emptyCoinMetadataQuerier(context.Context, string) (*bankv1beta1.Metadata, error) {
+	return nil, nil
+}
+
+func signingOptions() *txsigning.Options {
+	return &txsigning.Options{
+		AddressCodec:          addresscodec.NewBech32Codec("cosmos"),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
+	}
+}
+
+func TestNewHandlerMap(t *testing.T) {
+	handlerMap, err := NewHandlerMap(ConfigOptions{SigningOptions: signingOptions()})
+	require.NoError(t, err)
+	require.Equal(t, DefaultSignModes, handlerMap.SupportedModes())
+
+	_, err = NewHandlerMap(ConfigOptions{})
+	require.ErrorContains(t, err, "signing options not provided")
+
+	_, err = NewHandlerMap(ConfigOptions{
+		SigningOptions:   signingOptions(),
+		EnabledSignModes: []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_TEXTUAL},
+	})
+	require.ErrorContains(t, err, "without a TextualCoinMetadataQueryFn")
+
+	_, err = NewHandlerMap(ConfigOptions{
+		SigningOptions:   signingOptions(),
+		EnabledSignModes: []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_UNSPECIFIED},
+	})
+	require.ErrorContains(t, err, "unsupported sign mode")
+}
+
+func TestNewHandlerMapEIP191(t *testing.T) {
+	eip191Mode := apitxsigning.SignMode_SIGN_MODE_EIP_191 //nolint:staticcheck // testing the deprecated sign mode
+
+	// SIGN_MODE_EIP_191 renders the screens of SIGN_MODE_TEXTUAL
+	_, err := NewHandlerMap(ConfigOptions{
+		SigningOptions:   signingOptions(),
+		EnabledSignModes: []apitxsigning.SignMode{eip191Mode},
+	})
+	require.ErrorContains(t, err, "SIGN_MODE_EIP_191 renders the SIGN_MODE_TEXTUAL screens")
+
+	handlerMap, err := NewHandlerMap(ConfigOptions{
+		SigningOptions:             signingOptions(),
+		EnabledSignModes:           []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_DIRECT, eip191Mode},
+		TextualCoinMetadataQueryFn: emptyCoinMetadataQuerier,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_DIRECT, eip191Mode}, handlerMap.SupportedModes())
+
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Msg: &bankv1beta1.MsgSend{
+			FromAddress: "foo",
+			ToAddress:   "bar",
+			Amount:      []*basev1beta1.Coin{{Denom: "demon", Amount: "100"}},
+		},
+		AccNum:        1,
+		AccSeq:        2,
+		SignerAddress: "signerAddress",
+		Fee: &txv1beta1.Fee{
+			Amount: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
+		},
+	})
+	require.NoError(t, err)
+
+	signBytes, err := handlerMap.GetSignBytes(context.Background(), eip191Mode, signerData, txData)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(signBytes), eip191.MessagePrefix))
+	require.Contains(t, string(signBytes), "Chain id: test-chain\n")
+}
+
+func TestNewTxConfig(t *testing.T) {
+	tx := &txv1beta1.Tx{
+		Body: &txv1beta1.TxBody{Memo: "memo"},
+		AuthInfo: &txv1beta1.AuthInfo{
+			Fee: &txv1beta1.Fee{GasLimit: 100},
+		},
+		Signatures: [][]byte{[]byte("sig")},
+	}
+
+	txConfig, err := NewTxConfig(ConfigOptions{SigningOptions: signingOptions()})
+	require.NoError(t, err)
+	require.Equal(t, DefaultSignModes, txConfig.SignModeHandler().SupportedModes())
+
+	// transactions are encoded as TxRaw by default
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	var raw txv1beta1.TxRaw
+	require.NoError(t, protov2.Unmarshal(bz, &raw))
+	require.Equal(t, tx.Signatures, raw.Signatures)
+	decoded, err := txConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+	require.True(t, protov2.Equal(tx, decoded))
+
+	bz, err = txConfig.TxJSONEncoder()(tx)
+	require.NoError(t, err)
+	decoded, err = txConfig.TxJSONDecoder()(bz)
+	require.NoError(t, err)
+	require.True(t, protov2.Equal(tx, decoded))
+
+	// custom encoders replace the default ones
+	txConfig, err = NewTxConfig(ConfigOptions{
+		SigningOptions: signingOptions(),
+		TxEncoder: func(tx *txv1beta1.Tx) ([]byte, error) {
+			return []byte("custom"), nil
+		},
+		TxDecoder: func(bz []byte) (*txv1beta1.Tx, error) {
+			return tx, nil
+		},
+	})
+	require.NoError(t, err)
+	bz, err = txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("custom"), bz)
+	decoded, err = txConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+	require.Equal(t, tx, decoded)
+	_, err = txConfig.TxJSONEncoder()(tx)
+	require.NoError(t, err)
+
+	_, err = NewTxConfig(ConfigOptions{})
+	require.ErrorContains(t, err, "signing options not provided")
+}
@@ -0,0 +1,70 @@
+package tx
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+)
+
+// TxEncoder encodes a transaction.
+type TxEncoder func(tx *apitx.Tx) ([]byte, error)
+
+// TxDecoder decodes a transaction.
+type TxDecoder func(bz []byte) (*apitx.Tx, error)
+
+// encodeTx encodes the transaction as a TxRaw, the encoding broadcast to and
+// stored by the nodes.
+func encodeTx(tx *apitx.Tx) ([]byte, error) {
+	opts := protov2.MarshalOptions{Deterministic: true}
+	bodyBytes, err := opts.Marshal(tx.Body)
+	if err != nil {
+		return nil, err
+	}
+	authInfoBytes, err := opts.Marshal(tx.AuthInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return opts.Marshal(&apitx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    tx.Signatures,
+	})
+}
+
+// decodeTx decodes a transaction encoded as a TxRaw.
+func decodeTx(bz []byte) (*apitx.Tx, error) {
+	var raw apitx.TxRaw
+	if err := protov2.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+
+	tx := &apitx.Tx{
+		Body:       &apitx.TxBody{},
+		AuthInfo:   &apitx.AuthInfo{},
+		Signatures: raw.Signatures,
+	}
+	if err := protov2.Unmarshal(raw.BodyBytes, tx.Body); err != nil {
+		return nil, err
+	}
+	if err := protov2.Unmarshal(raw.AuthInfoBytes, tx.AuthInfo); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// encodeTxJSON encodes the transaction as the JSON of a Tx.
+func encodeTxJSON(tx *apitx.Tx) ([]byte, error) {
+	return protojson.Marshal(tx)
+}
+
+// decodeTxJSON decodes a transaction encoded as the JSON of a Tx.
+func decodeTxJSON(bz []byte) (*apitx.Tx, error) {
+	var tx apitx.Tx
+	if err := protojson.Unmarshal(bz, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
@@ -0,0 +1,108 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/grpc"
+	protov2 "google.golang.org/protobuf/proto"
+
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+)
+
+// Simulator simulates transactions, e.g. the apitx.ServiceClient of a node.
+type Simulator interface {
+	Simulate(ctx context.Context, in *apitx.SimulateRequest, opts ...grpc.CallOption) (*apitx.SimulateResponse, error)
+}
+
+// GasOptions configure the estimation of the gas limit of the transactions by
+// simulation, see SimulateAndSign.
+type GasOptions struct {
+	// Adjustment multiplies the gas used by the simulation, to account for the
+	// changes of the state before the transaction is executed. It defaults to 1.
+	Adjustment float64
+	// Cap is the maximum gas limit, none if 0. The gas limit is capped once
+	// adjusted, while a transaction using more gas than the cap in simulation
+	// is an error.
+	Cap uint64
+	// OnSimulate, if set, is called with the response of the simulation, e.g.
+	// to inspect its events and message responses. Returning an error aborts
+	// the signing.
+	OnSimulate func(*apitx.SimulateResponse) error
+}
+
+// SimulateAndSign sets the gas limit of the transaction to the gas used by its
+// simulation, as adjusted by gasOpts, and signs it.
+func (c *TxConfig) SimulateAndSign(ctx context.Context, simulator Simulator, tx *apitx.Tx, signer Signer, opts SignerOptions, gasOpts GasOptions) error {
+	gasLimit, err := c.SimulateGas(ctx, simulator, tx, signer, opts, gasOpts)
+	if err != nil {
+		return err
+	}
+
+	if tx.AuthInfo.Fee == nil {
+		tx.AuthInfo.Fee = &apitx.Fee{}
+	}
+	tx.AuthInfo.Fee.GasLimit = gasLimit
+
+	return c.Sign(ctx, tx, signer, opts)
+}
+
+// SimulateGas returns the gas limit of the transaction, the gas used by its
+// simulation as adjusted by gasOpts. The transaction is simulated as signed by
+// the signer, with an empty signature, and is left unchanged.
+func (c *TxConfig) SimulateGas(ctx context.Context, simulator Simulator, tx *apitx.Tx, signer Signer, opts SignerOptions, gasOpts GasOptions) (uint64, error) {
+	if tx.Body == nil || tx.AuthInfo == nil {
+		return 0, errors.New("missing tx body or auth info")
+	}
+	adjustment := gasOpts.Adjustment
+	if adjustment == 0 {
+		adjustment = 1
+	}
+	if adjustment < 0 {
+		return 0, fmt.Errorf("invalid gas adjustment %v", adjustment)
+	}
+
+	simTx := protov2.Clone(tx).(*apitx.Tx)
+	signerInfo, err := newSignerInfo(signer.PubKey(), opts)
+	if err != nil {
+		return 0, err
+	}
+	simTx.AuthInfo.SignerInfos = []*apitx.SignerInfo{signerInfo}
+	simTx.Signatures = [][]byte{{}}
+
+	txBytes, err := c.txEncoder(simTx)
+	if err != nil {
+		return 0, err
+	}
+	res, err := simulator.Simulate(ctx, &apitx.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, fmt.Errorf("failed to simulate the transaction: %w", err)
+	}
+	if res.GasInfo == nil {
+		return 0, errors.New("the simulation returned no gas info")
+	}
+
+	if gasOpts.OnSimulate != nil {
+		if err := gasOpts.OnSimulate(res); err != nil {
+			return 0, err
+		}
+	}
+
+	gasUsed := res.GasInfo.GasUsed
+	if gasOpts.Cap != 0 && gasUsed > gasOpts.Cap {
+		return 0, fmt.Errorf("the transaction used %d gas in simulation, more than the cap of %d", gasUsed, gasOpts.Cap)
+	}
+
+	adjusted := adjustment * float64(gasUsed)
+	gasLimit := uint64(math.MaxUint64)
+	if adjusted < math.MaxUint64 {
+		gasLimit = uint64(adjusted)
+	}
+	if gasOpts.Cap != 0 && gasLimit > gasOpts.Cap {
+		gasLimit = gasOpts.Cap
+	}
+
+	return gasLimit, nil
+}
@@ -0,0 +1,91 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	abciv1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+)
+
+// mockSimulator returns gasUsed for the simulated transactions.
+type mockSimulator struct {
+	gasUsed uint64
+	txs     []*txv1beta1.Tx
+	decoder TxDecoder
+}
+
+func (m *mockSimulator) Simulate(_ context.Context, in *txv1beta1.SimulateRequest, _ ...grpc.CallOption) (*txv1beta1.SimulateResponse, error) {
+	tx, err := m.decoder(in.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+	m.txs = append(m.txs, tx)
+	return &txv1beta1.SimulateResponse{
+		GasInfo: &abciv1beta1.GasInfo{GasUsed: m.gasUsed},
+		Result:  &abciv1beta1.Result{Log: "simulated"},
+	}, nil
+}
+
+func TestSimulateAndSign(t *testing.T) {
+	txConfig, err := NewTxConfig(ConfigOptions{SigningOptions: signingOptions()})
+	require.NoError(t, err)
+	simulator := &mockSimulator{gasUsed: 1000, decoder: txConfig.TxDecoder()}
+	key, signer := newAccount(t)
+	opts := SignerOptions{
+		Address:  signer,
+		ChainID:  "test-chain",
+		Sequence: 3,
+		SignMode: apitxsigning.SignMode_SIGN_MODE_DIRECT,
+	}
+	newTx := func() *txv1beta1.Tx {
+		return &txv1beta1.Tx{Body: &txv1beta1.TxBody{Messages: []*anypb.Any{sendMsg(t, signer)}, Memo: "memo"}, AuthInfo: &txv1beta1.AuthInfo{}}
+	}
+
+	var simulated *txv1beta1.SimulateResponse
+	tx := newTx()
+	err = txConfig.SimulateAndSign(context.Background(), simulator, tx, key, opts, GasOptions{
+		Adjustment: 1.5,
+		OnSimulate: func(res *txv1beta1.SimulateResponse) error {
+			simulated = res
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500), tx.AuthInfo.Fee.GasLimit)
+	require.Len(t, tx.Signatures, 1)
+	require.NotEmpty(t, tx.Signatures[0])
+	require.Equal(t, "simulated", simulated.Result.Log)
+
+	// the transaction is simulated as signed by the signer, with an empty signature
+	simTx := simulator.txs[0]
+	require.Equal(t, uint64(3), simTx.AuthInfo.SignerInfos[0].Sequence)
+	require.Equal(t, [][]byte{{}}, simTx.Signatures)
+
+	// the gas limit is capped
+	gasLimit, err := txConfig.SimulateGas(context.Background(), simulator, newTx(), key, opts, GasOptions{Adjustment: 1.5, Cap: 1200})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1200), gasLimit)
+	gasLimit, err = txConfig.SimulateGas(context.Background(), simulator, newTx(), key, opts, GasOptions{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), gasLimit)
+
+	_, err = txConfig.SimulateGas(context.Background(), simulator, newTx(), key, opts, GasOptions{Cap: 999})
+	require.ErrorContains(t, err, "more than the cap of 999")
+	_, err = txConfig.SimulateGas(context.Background(), simulator, newTx(), key, opts, GasOptions{Adjustment: -1})
+	require.ErrorContains(t, err, "invalid gas adjustment")
+
+	// the hook can abort the signing
+	tx = newTx()
+	err = txConfig.SimulateAndSign(context.Background(), simulator, tx, key, opts, GasOptions{
+		OnSimulate: func(*txv1beta1.SimulateResponse) error { return errors.New("unexpected events") },
+	})
+	require.ErrorContains(t, err, "unexpected events")
+	require.Empty(t, tx.Signatures)
+}
@@ -0,0 +1,98 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// HardwareSigner is a Signer signing on a hardware device, e.g. a Ledger,
+// which renders the sign bytes for the user to review before signing them, and
+// so must know their sign mode. TxConfig.Sign signs with SignWithMode rather
+// than Sign.
+type HardwareSigner interface {
+	Signer
+	// SignWithMode signs the sign bytes of the sign mode, returning an error
+	// if the device can't render them.
+	SignWithMode(signBytes []byte, signMode apitxsigning.SignMode) ([]byte, error)
+}
+
+var _ HardwareSigner = (*LedgerSigner)(nil)
+
+// LedgerSigner is the HardwareSigner of a key stored on a Ledger device, which
+// renders the sign bytes of SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL.
+type LedgerSigner struct {
+	path   hd.BIP44Params
+	pubKey cryptotypes.PubKey
+}
+
+// NewLedgerSigner returns the LedgerSigner of the keyring record of a Ledger
+// key, which holds its derivation path on the device.
+func NewLedgerSigner(record *keyring.Record) (*LedgerSigner, error) {
+	ledgerInfo := record.GetLedger()
+	if ledgerInfo == nil || ledgerInfo.GetPath() == nil {
+		return nil, fmt.Errorf("key %s is not a Ledger key", record.Name)
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &LedgerSigner{
+		path:   *ledgerInfo.GetPath(),
+		pubKey: pubKey,
+	}, nil
+}
+
+// Path returns the derivation path of the key on the device.
+func (s *LedgerSigner) Path() hd.BIP44Params {
+	return s.path
+}
+
+// PubKey implements Signer.
+func (s *LedgerSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Sign implements Signer, signing the sign bytes of SIGN_MODE_TEXTUAL.
+func (s *LedgerSigner) Sign(signBytes []byte) ([]byte, error) {
+	return s.SignWithMode(signBytes, apitxsigning.SignMode_SIGN_MODE_TEXTUAL)
+}
+
+// SignWithMode implements HardwareSigner. The key on the device must be the
+// one of the keyring record.
+func (s *LedgerSigner) SignWithMode(signBytes []byte, signMode apitxsigning.SignMode) ([]byte, error) {
+	if signMode != apitxsigning.SignMode_SIGN_MODE_TEXTUAL && signMode != apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return nil, fmt.Errorf("ledger devices can't sign %s, only SIGN_MODE_TEXTUAL and SIGN_MODE_LEGACY_AMINO_JSON", signMode)
+	}
+
+	priv, err := ledger.NewPrivKeySecp256k1Unsafe(s.path)
+	if err != nil {
+		return nil, err
+	}
+	if !s.pubKey.Equals(priv.PubKey()) {
+		return nil, fmt.Errorf("the public key of the ledger device %v does not match the one of the key %v", priv.PubKey(), s.pubKey)
+	}
+
+	var sig []byte
+	if signMode == apitxsigning.SignMode_SIGN_MODE_TEXTUAL {
+		sig, err = priv.Sign(signBytes)
+	} else {
+		sig, err = priv.SignLedgerAminoJSON(signBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.pubKey.VerifySignature(signBytes, sig) {
+		return nil, errors.New("the ledger device returned an invalid signature")
+	}
+	return sig, nil
+}
@@ -0,0 +1,75 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// mockHardwareSigner records the sign modes it signs with.
+type mockHardwareSigner struct {
+	cryptotypes.PrivKey
+	signModes []apitxsigning.SignMode
+}
+
+func (m *mockHardwareSigner) SignWithMode(signBytes []byte, signMode apitxsigning.SignMode) ([]byte, error) {
+	m.signModes = append(m.signModes, signMode)
+	return m.Sign(signBytes)
+}
+
+func TestSignHardwareSigner(t *testing.T) {
+	txConfig, err := NewTxConfig(ConfigOptions{
+		SigningOptions:             signingOptions(),
+		EnabledSignModes:           []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, apitxsigning.SignMode_SIGN_MODE_TEXTUAL},
+		TextualCoinMetadataQueryFn: emptyCoinMetadataQuerier,
+	})
+	require.NoError(t, err)
+
+	key, address := newAccount(t)
+	signer := &mockHardwareSigner{PrivKey: key}
+	for _, signMode := range []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, apitxsigning.SignMode_SIGN_MODE_TEXTUAL} {
+		tx := &txv1beta1.Tx{
+			Body:     &txv1beta1.TxBody{Messages: []*anypb.Any{sendMsg(t, address)}, Memo: "memo"},
+			AuthInfo: &txv1beta1.AuthInfo{Fee: &txv1beta1.Fee{GasLimit: 100}},
+		}
+		err := txConfig.Sign(context.Background(), tx, signer, SignerOptions{
+			Address:  address,
+			ChainID:  "test-chain",
+			SignMode: signMode,
+		})
+		require.NoError(t, err)
+		require.Len(t, tx.Signatures, 1)
+	}
+	// the sign bytes are routed to the device with their sign mode
+	require.Equal(t, []apitxsigning.SignMode{apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, apitxsigning.SignMode_SIGN_MODE_TEXTUAL}, signer.signModes)
+}
+
+func TestNewLedgerSigner(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+
+	localRecord, err := keyring.NewLocalRecord("local", secp256k1.GenPrivKey(), pubKey)
+	require.NoError(t, err)
+	_, err = NewLedgerSigner(localRecord)
+	require.ErrorContains(t, err, "key local is not a Ledger key")
+
+	path := hd.NewFundraiserParams(2, 118, 3)
+	record, err := keyring.NewLedgerRecord("ledger", pubKey, path)
+	require.NoError(t, err)
+	signer, err := NewLedgerSigner(record)
+	require.NoError(t, err)
+	require.Equal(t, *path, signer.Path())
+	require.True(t, pubKey.Equals(signer.PubKey()))
+
+	_, err = signer.SignWithMode([]byte("sign bytes"), apitxsigning.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "ledger devices can't sign SIGN_MODE_DIRECT")
+}
@@ -0,0 +1,182 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+
+	apimultisig "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// GetSignBytesFunc returns the sign bytes of the transaction signed by a
+// multisig key for the sign mode of a signature and the sequence of the
+// multisig account.
+type GetSignBytesFunc func(signMode apitxsigning.SignMode, sequence uint64) ([]byte, error)
+
+// MultisigBuilder assembles the signature of a multisig key from the
+// signatures of its keys, collected from their signers, e.g. as JSON, see
+// UnmarshalSignatureJSON.
+type MultisigBuilder struct {
+	pubKey       *multisig.LegacyAminoPubKey
+	getSignBytes GetSignBytesFunc
+	// sigs are the signatures of the keys, by index of the key
+	sigs     map[int]SignatureData
+	sequence uint64
+}
+
+// NewMultisigBuilder returns a MultisigBuilder assembling the signature of the
+// multisig key pubKey. The signatures of its keys are verified against the sign
+// bytes returned by getSignBytes.
+func NewMultisigBuilder(pubKey cryptotypes.PubKey, getSignBytes GetSignBytesFunc) (*MultisigBuilder, error) {
+	multisigKey, ok := pubKey.(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a multisig key, got %T", pubKey)
+	}
+	if getSignBytes == nil {
+		return nil, errors.New("missing sign bytes of the signatures")
+	}
+
+	return &MultisigBuilder{
+		pubKey:       multisigKey,
+		getSignBytes: getSignBytes,
+		sigs:         make(map[int]SignatureData),
+	}, nil
+}
+
+// AddSignature verifies and adds the signature of one of the keys of the
+// multisig key, which may itself be a multisig key. All the signatures must be
+// for the same sequence, the one of the multisig account.
+func (b *MultisigBuilder) AddSignature(sig Signature) error {
+	if sig.PubKey == nil {
+		return errors.New("missing public key of signature")
+	}
+	if sig.Data == nil {
+		return errors.New("empty signature data")
+	}
+
+	index := -1
+	for i, key := range b.pubKey.GetPubKeys() {
+		if key.Equals(sig.PubKey) {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return fmt.Errorf("key %X is not a key of the multisig key %X", sig.PubKey.Bytes(), b.pubKey.Address())
+	}
+
+	if _, ok := b.sigs[index]; ok {
+		return fmt.Errorf("key %X already signed", sig.PubKey.Bytes())
+	}
+	if len(b.sigs) > 0 && sig.Sequence != b.sequence {
+		return fmt.Errorf("signature of sequence %d, expected %d", sig.Sequence, b.sequence)
+	}
+	if err := verifySignature(sig.PubKey, sig.Data, sig.Sequence, b.getSignBytes); err != nil {
+		return fmt.Errorf("invalid signature of key %X: %w", sig.PubKey.Bytes(), err)
+	}
+
+	b.sigs[index] = sig.Data
+	b.sequence = sig.Sequence
+	return nil
+}
+
+// Signature returns the signature of the multisig key, once signed by at least
+// threshold of its keys.
+func (b *MultisigBuilder) Signature() (Signature, error) {
+	threshold := int(b.pubKey.GetThreshold())
+	if len(b.sigs) < threshold {
+		return Signature{}, fmt.Errorf("%d signatures of the multisig key, its threshold is %d", len(b.sigs), threshold)
+	}
+
+	keys := b.pubKey.GetPubKeys()
+	bitArray := cryptotypes.NewCompactBitArray(len(keys))
+	sigs := make([]SignatureData, 0, len(b.sigs))
+	for i := range keys {
+		sig, ok := b.sigs[i]
+		if !ok {
+			continue
+		}
+		bitArray.SetIndex(i, true)
+		sigs = append(sigs, sig)
+	}
+
+	return Signature{
+		PubKey: b.pubKey,
+		Data: &MultiSignatureData{
+			BitArray: &apimultisig.CompactBitArray{
+				ExtraBitsStored: bitArray.ExtraBitsStored,
+				Elems:           bitArray.Elems,
+			},
+			Signatures: sigs,
+		},
+		Sequence: b.sequence,
+	}, nil
+}
+
+// verifySignature verifies the signature data of the key pubKey, made for the
+// given sequence, against the sign bytes returned by getSignBytes.
+func verifySignature(pubKey cryptotypes.PubKey, data SignatureData, sequence uint64, getSignBytes GetSignBytesFunc) error {
+	switch data := data.(type) {
+	case *SingleSignatureData:
+		signBytes, err := getSignBytes(data.SignMode, sequence)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, data.Signature) {
+			return errors.New("signature verification failed")
+		}
+
+		return nil
+
+	case *MultiSignatureData:
+		multisigKey, ok := pubKey.(multisigtypes.PubKey)
+		if !ok {
+			return fmt.Errorf("multisig signature of a %T key", pubKey)
+		}
+		legacyData, err := toLegacyMultiSignatureData(data)
+		if err != nil {
+			return err
+		}
+
+		return multisigKey.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
+			return getSignBytes(apitxsigning.SignMode(mode), sequence)
+		}, legacyData)
+
+	default:
+		return fmt.Errorf("unexpected signature data %T", data)
+	}
+}
+
+// toLegacyMultiSignatureData converts the signature data of a multisig key to
+// the one of the legacy client, verified by the multisig keys.
+func toLegacyMultiSignatureData(data *MultiSignatureData) (*signing.MultiSignatureData, error) {
+	if data.BitArray == nil {
+		return nil, errors.New("missing bit array of multisig signature")
+	}
+
+	sigs := make([]signing.SignatureData, len(data.Signatures))
+	for i, sig := range data.Signatures {
+		switch sig := sig.(type) {
+		case *SingleSignatureData:
+			sigs[i] = &signing.SingleSignatureData{SignMode: signing.SignMode(sig.SignMode), Signature: sig.Signature}
+		case *MultiSignatureData:
+			nested, err := toLegacyMultiSignatureData(sig)
+			if err != nil {
+				return nil, err
+			}
+			sigs[i] = nested
+		default:
+			return nil, fmt.Errorf("unexpected signature data %T", sig)
+		}
+	}
+
+	return &signing.MultiSignatureData{
+		BitArray:   &cryptotypes.CompactBitArray{ExtraBitsStored: data.BitArray.ExtraBitsStored, Elems: data.BitArray.Elems},
+		Signatures: sigs,
+	}, nil
+}
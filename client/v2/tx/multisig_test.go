@@ -0,0 +1,95 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func singleSignature(t *testing.T, key cryptotypes.PrivKey, msg []byte, sequence uint64) Signature {
+	t.Helper()
+	sig, err := key.Sign(msg)
+	require.NoError(t, err)
+	return Signature{
+		PubKey:   key.PubKey(),
+		Data:     &SingleSignatureData{SignMode: apitxsigning.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		Sequence: sequence,
+	}
+}
+
+func TestMultisigBuilder(t *testing.T) {
+	// the sign bytes of the transaction at a sequence
+	msg := func(sequence uint64) []byte {
+		return []byte(fmt.Sprintf("sign bytes at sequence %d", sequence))
+	}
+	getSignBytes := func(signMode apitxsigning.SignMode, sequence uint64) ([]byte, error) {
+		require.Equal(t, apitxsigning.SignMode_SIGN_MODE_DIRECT, signMode)
+		return msg(sequence), nil
+	}
+	k1, k2, k3, k4 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	nestedKey := multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{k3.PubKey(), k4.PubKey()})
+	multisigKey := multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{k1.PubKey(), k2.PubKey(), nestedKey})
+
+	_, err := NewMultisigBuilder(k1.PubKey(), getSignBytes)
+	require.ErrorContains(t, err, "expected a multisig key")
+	_, err = NewMultisigBuilder(multisigKey, nil)
+	require.ErrorContains(t, err, "missing sign bytes")
+
+	// the nested multisig key signs with one of its keys
+	nested, err := NewMultisigBuilder(nestedKey, getSignBytes)
+	require.NoError(t, err)
+	require.NoError(t, nested.AddSignature(singleSignature(t, k4, msg(5), 5)))
+	nestedSig, err := nested.Signature()
+	require.NoError(t, err)
+
+	builder, err := NewMultisigBuilder(multisigKey, getSignBytes)
+	require.NoError(t, err)
+	require.NoError(t, builder.AddSignature(nestedSig))
+	_, err = builder.Signature()
+	require.ErrorContains(t, err, "1 signatures of the multisig key, its threshold is 2")
+
+	require.ErrorContains(t, builder.AddSignature(singleSignature(t, k3, msg(5), 5)), "is not a key of the multisig key")
+	require.ErrorContains(t, builder.AddSignature(nestedSig), "already signed")
+	require.ErrorContains(t, builder.AddSignature(singleSignature(t, k1, msg(6), 6)), "signature of sequence 6, expected 5")
+
+	// the signatures are verified against the sign bytes
+	require.ErrorContains(t, builder.AddSignature(singleSignature(t, k1, msg(6), 5)), "signature verification failed")
+	forged := nestedSig
+	forged.Data = &MultiSignatureData{BitArray: nestedSig.Data.(*MultiSignatureData).BitArray, Signatures: []SignatureData{&SingleSignatureData{SignMode: apitxsigning.SignMode_SIGN_MODE_DIRECT, Signature: []byte("forged")}}}
+	otherBuilder, err := NewMultisigBuilder(multisigKey, getSignBytes)
+	require.NoError(t, err)
+	require.ErrorContains(t, otherBuilder.AddSignature(forged), "invalid signature of key")
+
+	require.NoError(t, builder.AddSignature(singleSignature(t, k1, msg(5), 5)))
+
+	sig, err := builder.Signature()
+	require.NoError(t, err)
+	require.True(t, multisigKey.Equals(sig.PubKey))
+	require.Equal(t, uint64(5), sig.Sequence)
+
+	// the signatures are ordered as the keys
+	data := sig.Data.(*MultiSignatureData)
+	require.Len(t, data.Signatures, 2)
+	require.IsType(t, &SingleSignatureData{}, data.Signatures[0])
+	require.IsType(t, &MultiSignatureData{}, data.Signatures[1])
+
+	legacyData, err := toLegacyMultiSignatureData(data)
+	require.NoError(t, err)
+	err = multisigKey.VerifyMultisignature(func(signing.SignMode) ([]byte, error) { return msg(5), nil }, legacyData)
+	require.NoError(t, err)
+
+	// the signature is exchanged as JSON like the ones of the keys
+	bz, err := MarshalSignatureJSON([]Signature{sig})
+	require.NoError(t, err)
+	decoded, err := UnmarshalSignatureJSON(newRegistry(), bz)
+	require.NoError(t, err)
+	require.True(t, multisigKey.Equals(decoded[0].PubKey))
+}
@@ -0,0 +1,214 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Signer signs the sign bytes of transactions, e.g. a cryptotypes.PrivKey. See
+// HardwareSigner for the signers which must know the sign mode.
+type Signer interface {
+	// PubKey returns the public key verifying the signatures.
+	PubKey() cryptotypes.PubKey
+	// Sign signs the sign bytes.
+	Sign(signBytes []byte) ([]byte, error)
+}
+
+// SignerOptions identify the account signing a transaction.
+type SignerOptions struct {
+	// Address is the address of the account.
+	Address string
+	// ChainID is the chain the transaction is signed for.
+	ChainID string
+	// AccountNumber is the number of the account.
+	AccountNumber uint64
+	// Sequence is the sequence of the account.
+	Sequence uint64
+	// SignMode is the sign mode of the signature, which must be enabled.
+	SignMode apitxsigning.SignMode
+}
+
+// SetSignerInfos sets the signer infos of all the signers of the transaction,
+// in the order of TxBuilder.GetSigners, and clears its signatures. The signer
+// infos are part of the auth info signed by every signer, so that they must be
+// set before any signer of a transaction with several signers signs, see Sign.
+// pubKeys and opts hold the public key and the signer options of each signer,
+// in any order.
+func (c *TxConfig) SetSignerInfos(tx *apitx.Tx, pubKeys []cryptotypes.PubKey, opts []SignerOptions) error {
+	if tx.Body == nil || tx.AuthInfo == nil {
+		return errors.New("missing tx body or auth info")
+	}
+	if len(pubKeys) != len(opts) {
+		return fmt.Errorf("%d public keys for %d signer options", len(pubKeys), len(opts))
+	}
+
+	signers, err := txSigners(c.signingCtx, tx)
+	if err != nil {
+		return err
+	}
+	if len(opts) != len(signers) {
+		return fmt.Errorf("%d signer options for %d signers", len(opts), len(signers))
+	}
+
+	signerInfos := make([]*apitx.SignerInfo, len(signers))
+	for i, o := range opts {
+		index, err := c.signerIndex(signers, o.Address)
+		if err != nil {
+			return err
+		}
+		if signerInfos[index] != nil {
+			return fmt.Errorf("duplicate signer options of %s", o.Address)
+		}
+
+		signerInfos[index], err = newSignerInfo(pubKeys[i], o)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx.AuthInfo.SignerInfos = signerInfos
+	tx.Signatures = make([][]byte, len(signers))
+	return nil
+}
+
+// Sign adds the signature of one of the signers of the transaction, at its
+// index in the signers returned by TxBuilder.GetSigners, replacing its previous
+// signature if any.
+//
+// The signer info of a transaction with a single signer is set by Sign. The
+// signer infos of a transaction with several signers are part of the auth info
+// signed by all of them, so that they must be set with SetSignerInfos before
+// any of them signs, the signers then signing in any order.
+func (c *TxConfig) Sign(ctx context.Context, tx *apitx.Tx, signer Signer, opts SignerOptions) error {
+	if tx.Body == nil || tx.AuthInfo == nil {
+		return errors.New("missing tx body or auth info")
+	}
+
+	signers, err := txSigners(c.signingCtx, tx)
+	if err != nil {
+		return err
+	}
+	index, err := c.signerIndex(signers, opts.Address)
+	if err != nil {
+		return err
+	}
+
+	signerInfo, err := newSignerInfo(signer.PubKey(), opts)
+	if err != nil {
+		return err
+	}
+	if len(signers) == 1 {
+		tx.AuthInfo.SignerInfos = []*apitx.SignerInfo{signerInfo}
+		tx.Signatures = [][]byte{nil}
+	} else {
+		if len(tx.AuthInfo.SignerInfos) != len(signers) {
+			return fmt.Errorf("%d signer infos for %d signers, the signer infos must be set before signing, see SetSignerInfos", len(tx.AuthInfo.SignerInfos), len(signers))
+		}
+		if !protov2.Equal(tx.AuthInfo.SignerInfos[index], signerInfo) {
+			return fmt.Errorf("the signer info of %s does not match its signer options", opts.Address)
+		}
+		if len(tx.Signatures) != len(signers) {
+			signatures := make([][]byte, len(signers))
+			copy(signatures, tx.Signatures)
+			tx.Signatures = signatures
+		}
+	}
+
+	txData, err := signingTxData(tx)
+	if err != nil {
+		return err
+	}
+	signBytes, err := c.handlerMap.GetSignBytes(ctx, opts.SignMode, txsigning.SignerData{
+		Address:       opts.Address,
+		ChainID:       opts.ChainID,
+		AccountNumber: opts.AccountNumber,
+		Sequence:      opts.Sequence,
+		PubKey:        signerInfo.PublicKey,
+	}, txData)
+	if err != nil {
+		return err
+	}
+
+	var sig []byte
+	if hw, ok := signer.(HardwareSigner); ok {
+		sig, err = hw.SignWithMode(signBytes, opts.SignMode)
+	} else {
+		sig, err = signer.Sign(signBytes)
+	}
+	if err != nil {
+		return err
+	}
+
+	tx.Signatures[index] = sig
+	return nil
+}
+
+// signerIndex returns the index of address in signers.
+func (c *TxConfig) signerIndex(signers [][]byte, address string) (int, error) {
+	addressBz, err := c.signingCtx.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return 0, fmt.Errorf("invalid signer address %s: %w", address, err)
+	}
+
+	for i, signer := range signers {
+		if bytes.Equal(signer, addressBz) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%s is not a signer of the transaction", address)
+}
+
+// newSignerInfo returns the signer info of the signer with the public key pubKey.
+func newSignerInfo(pubKey cryptotypes.PubKey, opts SignerOptions) (*apitx.SignerInfo, error) {
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apitx.SignerInfo{
+		PublicKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+		ModeInfo: &apitx.ModeInfo{
+			Sum: &apitx.ModeInfo_Single_{
+				Single: &apitx.ModeInfo_Single{Mode: opts.SignMode},
+			},
+		},
+		Sequence: opts.Sequence,
+	}, nil
+}
+
+// signingTxData returns the data of the transaction its sign bytes are
+// generated from.
+func signingTxData(tx *apitx.Tx) (txsigning.TxData, error) {
+	opts := protov2.MarshalOptions{Deterministic: true}
+	bodyBytes, err := opts.Marshal(tx.Body)
+	if err != nil {
+		return txsigning.TxData{}, err
+	}
+	authInfoBytes, err := opts.Marshal(tx.AuthInfo)
+	if err != nil {
+		return txsigning.TxData{}, err
+	}
+
+	return txsigning.TxData{
+		Body:          tx.Body,
+		AuthInfo:      tx.AuthInfo,
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// newAccount returns the key of a new account and its address.
+func newAccount(t *testing.T) (*secp256k1.PrivKey, string) {
+	t.Helper()
+	key := secp256k1.GenPrivKey()
+	addr, err := addresscodec.NewBech32Codec("cosmos").BytesToString(key.PubKey().Address())
+	require.NoError(t, err)
+	return key, addr
+}
+
+// sendMsg returns a bank send of from, signed by from.
+func sendMsg(t *testing.T, from string) *anypb.Any {
+	t.Helper()
+	msg, err := anyutil.New(&bankv1beta1.MsgSend{
+		FromAddress: from,
+		ToAddress:   from,
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+	})
+	require.NoError(t, err)
+	return msg
+}
+
+func TestSignSeveralSigners(t *testing.T) {
+	txConfig, err := NewTxConfig(ConfigOptions{SigningOptions: signingOptions()})
+	require.NoError(t, err)
+	senderKey, sender := newAccount(t)
+	payerKey, payer := newAccount(t)
+	otherKey, other := newAccount(t)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&bankv1beta1.MsgSend{
+		FromAddress: sender,
+		ToAddress:   other,
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "1"}},
+	}))
+	require.NoError(t, builder.SetFeePayer(payer))
+	tx := builder.GetTx()
+
+	opts := func(address string, sequence uint64, signMode apitxsigning.SignMode) SignerOptions {
+		return SignerOptions{
+			Address:  address,
+			ChainID:  "test-chain",
+			Sequence: sequence,
+			SignMode: signMode,
+		}
+	}
+	senderOpts := opts(sender, 1, apitxsigning.SignMode_SIGN_MODE_DIRECT)
+	payerOpts := opts(payer, 7, apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+	// the signer infos of all the signers must be set before signing
+	err = txConfig.Sign(context.Background(), tx, senderKey, senderOpts)
+	require.ErrorContains(t, err, "the signer infos must be set before signing")
+
+	err = txConfig.SetSignerInfos(tx, []cryptotypes.PubKey{senderKey.PubKey()}, []SignerOptions{senderOpts})
+	require.ErrorContains(t, err, "1 signer options for 2 signers")
+	err = txConfig.SetSignerInfos(tx, []cryptotypes.PubKey{senderKey.PubKey(), otherKey.PubKey()}, []SignerOptions{senderOpts, opts(other, 1, apitxsigning.SignMode_SIGN_MODE_DIRECT)})
+	require.ErrorContains(t, err, other+" is not a signer of the transaction")
+	err = txConfig.SetSignerInfos(tx, []cryptotypes.PubKey{senderKey.PubKey(), senderKey.PubKey()}, []SignerOptions{senderOpts, senderOpts})
+	require.ErrorContains(t, err, "duplicate signer options of "+sender)
+
+	require.NoError(t, txConfig.SetSignerInfos(tx, []cryptotypes.PubKey{payerKey.PubKey(), senderKey.PubKey()}, []SignerOptions{payerOpts, senderOpts}))
+
+	// the signers sign in any order, with options matching their signer infos
+	err = txConfig.Sign(context.Background(), tx, payerKey, opts(payer, 8, apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+	require.ErrorContains(t, err, "the signer info of "+payer+" does not match its signer options")
+	err = txConfig.Sign(context.Background(), tx, otherKey, opts(other, 1, apitxsigning.SignMode_SIGN_MODE_DIRECT))
+	require.ErrorContains(t, err, other+" is not a signer of the transaction")
+
+	require.NoError(t, txConfig.Sign(context.Background(), tx, payerKey, payerOpts))
+	require.NoError(t, txConfig.Sign(context.Background(), tx, senderKey, senderOpts))
+
+	require.Len(t, tx.Signatures, 2)
+	require.Len(t, tx.AuthInfo.SignerInfos, 2)
+	txData, err := signingTxData(tx)
+	require.NoError(t, err)
+	for i, signer := range []struct {
+		key  *secp256k1.PrivKey
+		opts SignerOptions
+	}{{senderKey, senderOpts}, {payerKey, payerOpts}} {
+		signerInfo := tx.AuthInfo.SignerInfos[i]
+		require.Equal(t, signer.opts.Sequence, signerInfo.Sequence)
+
+		// all the signatures are valid for the final transaction
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(context.Background(), signer.opts.SignMode, txsigning.SignerData{
+			Address:  signer.opts.Address,
+			ChainID:  "test-chain",
+			Sequence: signer.opts.Sequence,
+			PubKey:   signerInfo.PublicKey,
+		}, txData)
+		require.NoError(t, err)
+		require.True(t, signer.key.PubKey().VerifySignature(signBytes, tx.Signatures[i]))
+	}
+}
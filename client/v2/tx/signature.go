@@ -0,0 +1,202 @@
+// Package tx holds the transaction signing primitives of client/v2, built on the
+// cosmossdk.io/api types rather than on the legacy client/tx package.
+package tx
+
+import (
+	"errors"
+	"fmt"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apimultisig "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+
+	// the public keys are JSON encoded as Any, their types must be registered
+	_ "cosmossdk.io/api/cosmos/crypto/ed25519"
+	_ "cosmossdk.io/api/cosmos/crypto/multisig"
+	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	_ "cosmossdk.io/api/cosmos/crypto/secp256r1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Signature is the signature of a transaction by one of its signers.
+type Signature struct {
+	// PubKey is the public key to use for verifying the signature.
+	PubKey cryptotypes.PubKey
+
+	// Data is the actual data of the signature which includes SignMode's and
+	// the signatures themselves for either single or multi-signatures.
+	Data SignatureData
+
+	// Sequence is the sequence of the account of the signer.
+	Sequence uint64
+}
+
+// SignatureData is the data of a signature, either a SingleSignatureData or a
+// MultiSignatureData.
+type SignatureData interface {
+	isSignatureData()
+}
+
+func (m *SingleSignatureData) isSignatureData() {}
+
+func (m *MultiSignatureData) isSignatureData() {}
+
+// SingleSignatureData is the signature of a single key.
+type SingleSignatureData struct {
+	// SignMode represents the SignMode of the signature.
+	SignMode apitxsigning.SignMode
+
+	// Signature is the raw signature.
+	Signature []byte
+}
+
+// MultiSignatureData is the signature of a multisig key, made of the
+// signatures of some of its keys, which can be multisig keys themselves.
+type MultiSignatureData struct {
+	// BitArray indicates which keys of the multisig key signed.
+	BitArray *apimultisig.CompactBitArray
+
+	// Signatures are the signatures of the keys which signed, in the order of
+	// the keys.
+	Signatures []SignatureData
+}
+
+// MarshalSignatureJSON encodes the signatures to JSON, as the
+// cosmos.tx.signing.v1beta1.SignatureDescriptors of the legacy client, so that
+// they can be exchanged with other signers in offline, e.g. multisig, flows.
+func MarshalSignatureJSON(sigs []Signature) ([]byte, error) {
+	descs := make([]*apitxsigning.SignatureDescriptor, len(sigs))
+	for i, sig := range sigs {
+		data, err := signatureDataToProto(sig.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", i, err)
+		}
+
+		var pubKey *anypb.Any
+		if sig.PubKey != nil {
+			anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+			if err != nil {
+				return nil, err
+			}
+			pubKey = &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			}
+		}
+
+		descs[i] = &apitxsigning.SignatureDescriptor{
+			PublicKey: pubKey,
+			Data:      data,
+			Sequence:  sig.Sequence,
+		}
+	}
+
+	return protojson.Marshal(&apitxsigning.SignatureDescriptors{Signatures: descs})
+}
+
+// UnmarshalSignatureJSON decodes signatures encoded by MarshalSignatureJSON, or
+// by the legacy client. The public keys are unpacked with unpacker, e.g. the
+// interface registry of the app.
+func UnmarshalSignatureJSON(unpacker gogoprotoany.AnyUnpacker, bz []byte) ([]Signature, error) {
+	var descs apitxsigning.SignatureDescriptors
+	if err := protojson.Unmarshal(bz, &descs); err != nil {
+		return nil, err
+	}
+
+	sigs := make([]Signature, len(descs.Signatures))
+	for i, desc := range descs.Signatures {
+		data, err := signatureDataFromProto(desc.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", i, err)
+		}
+
+		var pubKey cryptotypes.PubKey
+		if desc.PublicKey != nil {
+			anyPk := &codectypes.Any{TypeUrl: desc.PublicKey.TypeUrl, Value: desc.PublicKey.Value}
+			if err := unpacker.UnpackAny(anyPk, &pubKey); err != nil {
+				return nil, fmt.Errorf("invalid public key of signature %d: %w", i, err)
+			}
+		}
+
+		sigs[i] = Signature{
+			PubKey:   pubKey,
+			Data:     data,
+			Sequence: desc.Sequence,
+		}
+	}
+
+	return sigs, nil
+}
+
+// signatureDataToProto converts a SignatureData to its proto representation,
+// recursively for the multisig keys.
+func signatureDataToProto(data SignatureData) (*apitxsigning.SignatureDescriptor_Data, error) {
+	switch data := data.(type) {
+	case *SingleSignatureData:
+		return &apitxsigning.SignatureDescriptor_Data{
+			Sum: &apitxsigning.SignatureDescriptor_Data_Single_{
+				Single: &apitxsigning.SignatureDescriptor_Data_Single{
+					Mode:      data.SignMode,
+					Signature: data.Signature,
+				},
+			},
+		}, nil
+	case *MultiSignatureData:
+		sigs := make([]*apitxsigning.SignatureDescriptor_Data, len(data.Signatures))
+		for i, sig := range data.Signatures {
+			var err error
+			sigs[i], err = signatureDataToProto(sig)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &apitxsigning.SignatureDescriptor_Data{
+			Sum: &apitxsigning.SignatureDescriptor_Data_Multi_{
+				Multi: &apitxsigning.SignatureDescriptor_Data_Multi{
+					Bitarray:   data.BitArray,
+					Signatures: sigs,
+				},
+			},
+		}, nil
+	case nil:
+		return nil, errors.New("empty signature data")
+	default:
+		return nil, fmt.Errorf("unexpected signature data type %T", data)
+	}
+}
+
+// signatureDataFromProto converts the proto representation of a SignatureData
+// back, recursively for the multisig keys.
+func signatureDataFromProto(data *apitxsigning.SignatureDescriptor_Data) (SignatureData, error) {
+	if data == nil {
+		return nil, errors.New("empty signature data")
+	}
+
+	switch sum := data.Sum.(type) {
+	case *apitxsigning.SignatureDescriptor_Data_Single_:
+		return &SingleSignatureData{
+			SignMode:  sum.Single.Mode,
+			Signature: sum.Single.Signature,
+		}, nil
+	case *apitxsigning.SignatureDescriptor_Data_Multi_:
+		sigs := make([]SignatureData, len(sum.Multi.Signatures))
+		for i, sig := range sum.Multi.Signatures {
+			var err error
+			sigs[i], err = signatureDataFromProto(sig)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &MultiSignatureData{
+			BitArray:   sum.Multi.Bitarray,
+			Signatures: sigs,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected signature data type %T", data.Sum)
+	}
+}
@@ -0,0 +1,123 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apimultisig "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func newRegistry() codectypes.InterfaceRegistry {
+	registry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return registry
+}
+
+func testSignatures() []Signature {
+	k1 := secp256k1.GenPrivKey().PubKey()
+	k2 := secp256k1.GenPrivKey().PubKey()
+	k3 := secp256k1.GenPrivKey().PubKey()
+	nested := multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{k2, k3})
+	multi := multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{k1, nested})
+
+	return []Signature{
+		{
+			PubKey:   k1,
+			Data:     &SingleSignatureData{SignMode: apitxsigning.SignMode_SIGN_MODE_DIRECT, Signature: []byte("sig1")},
+			Sequence: 3,
+		},
+		{
+			PubKey: multi,
+			Data: &MultiSignatureData{
+				BitArray: &apimultisig.CompactBitArray{ExtraBitsStored: 2, Elems: []byte{0xc0}},
+				Signatures: []SignatureData{
+					&SingleSignatureData{SignMode: apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte("sig2")},
+					&MultiSignatureData{
+						BitArray: &apimultisig.CompactBitArray{ExtraBitsStored: 2, Elems: []byte{0x40}},
+						Signatures: []SignatureData{
+							&SingleSignatureData{SignMode: apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte("sig3")},
+						},
+					},
+				},
+			},
+			Sequence: 7,
+		},
+	}
+}
+
+func TestSignatureJSON(t *testing.T) {
+	registry := newRegistry()
+	sigs := testSignatures()
+
+	bz, err := MarshalSignatureJSON(sigs)
+	require.NoError(t, err)
+
+	decoded, err := UnmarshalSignatureJSON(registry, bz)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(sigs))
+	for i := range sigs {
+		require.True(t, sigs[i].PubKey.Equals(decoded[i].PubKey))
+		require.Equal(t, sigs[i].Sequence, decoded[i].Sequence)
+	}
+	require.Equal(t, sigs[0].Data, decoded[0].Data)
+
+	multi := decoded[1].Data.(*MultiSignatureData)
+	require.Len(t, multi.Signatures, 2)
+	require.Equal(t, []byte("sig2"), multi.Signatures[0].(*SingleSignatureData).Signature)
+	nested := multi.Signatures[1].(*MultiSignatureData)
+	require.Equal(t, []byte{0x40}, nested.BitArray.Elems)
+	require.Equal(t, apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nested.Signatures[0].(*SingleSignatureData).SignMode)
+}
+
+func TestSignatureJSONLegacyCompatibility(t *testing.T) {
+	registry := newRegistry()
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), addresscodec.NewBech32Codec("cosmos"), addresscodec.NewBech32Codec("cosmosvaloper"), authtx.DefaultSignModes)
+	sigs := testSignatures()
+
+	// the signatures encoded by client/v2 are decoded by the legacy client
+	bz, err := MarshalSignatureJSON(sigs)
+	require.NoError(t, err)
+	legacySigs, err := txConfig.UnmarshalSignatureJSON(bz)
+	require.NoError(t, err)
+	require.Len(t, legacySigs, 2)
+	require.True(t, sigs[1].PubKey.Equals(legacySigs[1].PubKey))
+	legacyMulti := legacySigs[1].Data.(*signing.MultiSignatureData)
+	require.Equal(t, 2, legacyMulti.BitArray.Count())
+	require.Equal(t, []byte("sig2"), legacyMulti.Signatures[0].(*signing.SingleSignatureData).Signature)
+
+	// and the other way around
+	bz, err = txConfig.MarshalSignatureJSON(legacySigs)
+	require.NoError(t, err)
+	decoded, err := UnmarshalSignatureJSON(registry, bz)
+	require.NoError(t, err)
+	require.Equal(t, sigs[0].Data, decoded[0].Data)
+	require.Equal(t, uint64(7), decoded[1].Sequence)
+	require.Equal(t, []byte("sig3"), decoded[1].Data.(*MultiSignatureData).Signatures[1].(*MultiSignatureData).Signatures[0].(*SingleSignatureData).Signature)
+}
+
+func TestSignatureJSONInvalid(t *testing.T) {
+	_, err := MarshalSignatureJSON([]Signature{{PubKey: secp256k1.GenPrivKey().PubKey()}})
+	require.ErrorContains(t, err, "empty signature data")
+
+	_, err = MarshalSignatureJSON([]Signature{{Data: &MultiSignatureData{Signatures: []SignatureData{nil}}}})
+	require.ErrorContains(t, err, "invalid signature 0")
+
+	_, err = UnmarshalSignatureJSON(newRegistry(), []byte(`{"signatures":[{"sequence":"1"}]}`))
+	require.ErrorContains(t, err, "empty signature data")
+
+	_, err = UnmarshalSignatureJSON(newRegistry(), []byte(`{"signatures":[{"public_key":{"@type":"/cosmos.crypto.multisig.v1beta1.CompactBitArray","elems":"AA=="},"data":{"single":{"mode":"SIGN_MODE_DIRECT"}}}]}`))
+	require.ErrorContains(t, err, "invalid public key of signature 0")
+}
@@ -0,0 +1,49 @@
+package txqueue
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ Node = grpcNode{}
+
+// grpcNode is a Node querying the tx service of a node.
+type grpcNode struct {
+	client txtypes.ServiceClient
+}
+
+// NewNode returns a Node broadcasting the transactions with the tx service of
+// the node conn is connected to, e.g. a client.Context.
+func NewNode(conn gogogrpc.ClientConn) Node {
+	return grpcNode{client: txtypes.NewServiceClient(conn)}
+}
+
+func (n grpcNode) BroadcastTx(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error) {
+	res, err := n.client.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.TxResponse, nil
+}
+
+func (n grpcNode) GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	res, err := n.client.GetTx(ctx, &txtypes.GetTxRequest{Hash: hash})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res.TxResponse, nil
+}
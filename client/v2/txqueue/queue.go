@@ -0,0 +1,395 @@
+// Package txqueue implements a durable queue of outbound transactions. The
+// transactions are persisted in a local database before being broadcast, so
+// that they survive restarts of the process, and their broadcast is retried
+// with an exponential backoff until they reach a terminal state: committed in a
+// block, or failed.
+package txqueue
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// State is the state of a queued transaction.
+type State string
+
+const (
+	// StatePending is the state of the transactions waiting to be broadcast.
+	StatePending State = "pending"
+	// StateAccepted is the state of the transactions accepted in the mempool
+	// of the node, waiting to be committed.
+	StateAccepted State = "accepted"
+	// StateCommitted is the terminal state of the transactions committed in a
+	// block successfully.
+	StateCommitted State = "committed"
+	// StateFailed is the terminal state of the transactions rejected by the
+	// node, failed in a block, or whose broadcast was retried too many times.
+	StateFailed State = "failed"
+)
+
+// IsTerminal returns whether the state is terminal, i.e. the transaction is no
+// longer processed by the queue.
+func (s State) IsTerminal() bool {
+	return s == StateCommitted || s == StateFailed
+}
+
+// keyPrefix is the prefix of the keys of the entries in the database.
+const keyPrefix = "tx/"
+
+// Entry is a transaction of the queue.
+type Entry struct {
+	// Hash is the hash of the transaction, identifying it in the queue.
+	Hash string `json:"hash"`
+	// TxBytes is the encoded transaction.
+	TxBytes []byte `json:"tx_bytes"`
+	// State is the state of the transaction.
+	State State `json:"state"`
+	// Attempts is the number of broadcast attempts of the transaction.
+	Attempts int `json:"attempts"`
+	// NextAttempt is the time of the next broadcast attempt, or confirmation
+	// check, of the transaction.
+	NextAttempt time.Time `json:"next_attempt"`
+	// LastError is the error of the last attempt, if any.
+	LastError string `json:"last_error,omitempty"`
+	// Code is the result code of the transaction, once in a terminal state.
+	Code uint32 `json:"code"`
+	// Codespace is the codespace of the result code of the transaction.
+	Codespace string `json:"codespace,omitempty"`
+	// Height is the height of the block the transaction was committed in.
+	Height int64 `json:"height,omitempty"`
+	// AcceptedAt is the time the transaction was last accepted in the mempool.
+	AcceptedAt time.Time `json:"accepted_at,omitempty"`
+	// CreatedAt is the time the transaction was enqueued.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt is the time the entry was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Node broadcasts the transactions and queries their results.
+type Node interface {
+	// BroadcastTx broadcasts the transaction synchronously, returning the
+	// result of its CheckTx.
+	BroadcastTx(ctx context.Context, txBytes []byte) (*sdk.TxResponse, error)
+	// GetTx returns the result of the transaction committed in a block, or nil
+	// if it is not committed (yet).
+	GetTx(ctx context.Context, hash string) (*sdk.TxResponse, error)
+}
+
+// Options are the options of a Queue.
+type Options struct {
+	// MinBackoff is the delay before the first retry of a broadcast, doubled
+	// for every following retry. Defaults to 1 second.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between two retries of a broadcast.
+	// Defaults to 1 minute.
+	MaxBackoff time.Duration
+	// MaxAttempts is the number of broadcast attempts after which a transaction
+	// fails. Zero means unlimited attempts.
+	MaxAttempts int
+	// ConfirmInterval is the delay between two checks of whether an accepted
+	// transaction is committed. Defaults to 5 seconds.
+	ConfirmInterval time.Duration
+	// ConfirmTimeout is the delay after which an accepted transaction which is
+	// still not committed is broadcast again, e.g. as it was evicted from the
+	// mempool. Defaults to 1 minute.
+	ConfirmTimeout time.Duration
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+func (o Options) withDefaults() Options {
+	if o.MinBackoff <= 0 {
+		o.MinBackoff = time.Second
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = time.Minute
+	}
+	if o.ConfirmInterval <= 0 {
+		o.ConfirmInterval = 5 * time.Second
+	}
+	if o.ConfirmTimeout <= 0 {
+		o.ConfirmTimeout = time.Minute
+	}
+	if o.Now == nil {
+		o.Now = time.Now
+	}
+	return o
+}
+
+// Queue is a durable queue of outbound transactions.
+type Queue struct {
+	mu   sync.Mutex
+	db   dbm.DB
+	node Node
+	opts Options
+}
+
+// Open opens the queue persisted in the goleveldb database name in dir,
+// creating it if it doesn't exist. The queue must be closed with Close.
+func Open(name, dir string, node Node, opts Options) (*Queue, error) {
+	db, err := dbm.NewDB(name, dbm.GoLevelDBBackend, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the tx queue database: %w", err)
+	}
+
+	return New(db, node, opts), nil
+}
+
+// New returns a queue persisted in db.
+func New(db dbm.DB, node Node, opts Options) *Queue {
+	return &Queue{db: db, node: node, opts: opts.withDefaults()}
+}
+
+// Close closes the database of the queue.
+func (q *Queue) Close() error {
+	return q.db.Close()
+}
+
+// Enqueue persists the transaction in the queue, to be broadcast by the next
+// call to Process. Enqueuing a transaction which is already queued returns its
+// entry.
+func (q *Queue) Enqueue(txBytes []byte) (Entry, error) {
+	if len(txBytes) == 0 {
+		return Entry{}, errors.New("empty transaction")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	hash := TxHash(txBytes)
+	entry, found, err := q.get(hash)
+	if err != nil || found {
+		return entry, err
+	}
+
+	now := q.opts.Now()
+	entry = Entry{
+		Hash:        hash,
+		TxBytes:     txBytes,
+		State:       StatePending,
+		NextAttempt: now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	return entry, q.set(entry)
+}
+
+// Get returns the entry of the transaction with the given hash.
+func (q *Queue) Get(hash string) (Entry, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.get(strings.ToUpper(hash))
+}
+
+// List returns the entries of the queue, ordered by hash.
+func (q *Queue) List() ([]Entry, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.list()
+}
+
+// Remove removes the transaction with the given hash from the queue, e.g. once
+// its terminal state was handled.
+func (q *Queue) Remove(hash string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.db.DeleteSync(key(strings.ToUpper(hash)))
+}
+
+// Process broadcasts the pending transactions, and checks whether the accepted
+// ones are committed, whose next attempt is due. The entries are persisted
+// after every attempt.
+func (q *Queue) Process(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries, err := q.list()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.State.IsTerminal() || entry.NextAttempt.After(q.opts.Now()) {
+			continue
+		}
+
+		switch entry.State {
+		case StatePending:
+			entry = q.broadcast(ctx, entry)
+		case StateAccepted:
+			entry = q.confirm(ctx, entry)
+		}
+
+		entry.UpdatedAt = q.opts.Now()
+		if err := q.set(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run processes the queue every interval until ctx is done.
+func (q *Queue) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := q.Process(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (q *Queue) broadcast(ctx context.Context, entry Entry) Entry {
+	entry.Attempts++
+
+	res, err := q.node.BroadcastTx(ctx, entry.TxBytes)
+	switch {
+	case err != nil:
+		return q.retry(entry, err.Error())
+
+	case res.Code == 0 || isCodespaceError(res, sdkerrors.ErrTxInMempoolCache):
+		entry.State, entry.LastError = StateAccepted, ""
+		entry.AcceptedAt = q.opts.Now()
+		entry.NextAttempt = entry.AcceptedAt.Add(q.opts.ConfirmInterval)
+		return entry
+
+	case isCodespaceError(res, sdkerrors.ErrMempoolIsFull):
+		return q.retry(entry, res.RawLog)
+
+	default:
+		entry.State, entry.LastError = StateFailed, res.RawLog
+		entry.Code, entry.Codespace = res.Code, res.Codespace
+		return entry
+	}
+}
+
+func (q *Queue) confirm(ctx context.Context, entry Entry) Entry {
+	res, err := q.node.GetTx(ctx, entry.Hash)
+	switch {
+	case err != nil:
+		entry.LastError = err.Error()
+		entry.NextAttempt = q.opts.Now().Add(q.opts.ConfirmInterval)
+		return entry
+
+	case res == nil:
+		// broadcast the transaction again if it was not committed in time
+		if q.opts.Now().Sub(entry.AcceptedAt) >= q.opts.ConfirmTimeout {
+			entry.State = StatePending
+			return q.retry(entry, "transaction not committed in time")
+		}
+		entry.NextAttempt = q.opts.Now().Add(q.opts.ConfirmInterval)
+		return entry
+
+	default:
+		entry.State, entry.LastError = StateCommitted, ""
+		if res.Code != 0 {
+			entry.State, entry.LastError = StateFailed, res.RawLog
+		}
+		entry.Code, entry.Codespace, entry.Height = res.Code, res.Codespace, res.Height
+		return entry
+	}
+}
+
+// retry schedules the next broadcast of the entry with an exponential backoff,
+// or fails it if it reached the maximum number of attempts.
+func (q *Queue) retry(entry Entry, reason string) Entry {
+	entry.LastError = reason
+	if q.opts.MaxAttempts > 0 && entry.Attempts >= q.opts.MaxAttempts {
+		entry.State = StateFailed
+		entry.LastError = fmt.Sprintf("giving up after %d attempts: %s", entry.Attempts, reason)
+		return entry
+	}
+
+	backoff := q.opts.MinBackoff
+	for i := 1; i < entry.Attempts && backoff < q.opts.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > q.opts.MaxBackoff {
+		backoff = q.opts.MaxBackoff
+	}
+
+	entry.NextAttempt = q.opts.Now().Add(backoff)
+	return entry
+}
+
+func (q *Queue) get(hash string) (Entry, bool, error) {
+	bz, err := q.db.Get(key(hash))
+	if err != nil || bz == nil {
+		return Entry{}, false, err
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return Entry{}, false, fmt.Errorf("invalid entry %s: %w", hash, err)
+	}
+	return entry, true, nil
+}
+
+func (q *Queue) set(entry Entry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return q.db.SetSync(key(entry.Hash), bz)
+}
+
+func (q *Queue) list() ([]Entry, error) {
+	// '0' follows '/', ending the range of the keys with the prefix
+	it, err := q.db.Iterator([]byte(keyPrefix), []byte("tx0"))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var entries []Entry
+	for ; it.Valid(); it.Next() {
+		var entry Entry
+		if err := json.Unmarshal(it.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("invalid entry %s: %w", it.Key(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, it.Error()
+}
+
+func key(hash string) []byte {
+	return []byte(keyPrefix + hash)
+}
+
+// TxHash returns the hash of the encoded transaction, as upper-case hex.
+func TxHash(txBytes []byte) string {
+	hash := sha256.Sum256(txBytes)
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+func isCodespaceError(res *sdk.TxResponse, err interface{ ABCICode() uint32 }) bool {
+	return res.Codespace == sdkerrors.RootCodespace && res.Code == err.ABCICode()
+}
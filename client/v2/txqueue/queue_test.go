@@ -0,0 +1,234 @@
+package txqueue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// mockNode returns the queued broadcast results, and the committed results of
+// the transactions by hash.
+type mockNode struct {
+	broadcasts []*sdk.TxResponse
+	errs       []error
+	committed  map[string]*sdk.TxResponse
+	calls      int
+}
+
+func (m *mockNode) BroadcastTx(_ context.Context, _ []byte) (*sdk.TxResponse, error) {
+	m.calls++
+	if len(m.errs) > 0 {
+		err := m.errs[0]
+		m.errs = m.errs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	res := m.broadcasts[0]
+	m.broadcasts = m.broadcasts[1:]
+	return res, nil
+}
+
+func (m *mockNode) GetTx(_ context.Context, hash string) (*sdk.TxResponse, error) {
+	return m.committed[hash], nil
+}
+
+type clock struct{ now time.Time }
+
+func newClock() *clock { return &clock{now: time.Unix(1700000000, 0).UTC()} }
+
+func (c *clock) Now() time.Time          { return c.now }
+func (c *clock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+func (c *clock) options() Options        { return Options{Now: c.Now, MaxAttempts: 4} }
+
+func sdkError(err interface{ ABCICode() uint32 }) *sdk.TxResponse {
+	return &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: err.ABCICode(), RawLog: "error"}
+}
+
+func TestQueueRetryAndCommit(t *testing.T) {
+	c := newClock()
+	node := &mockNode{
+		errs:       []error{errors.New("connection refused"), nil},
+		broadcasts: []*sdk.TxResponse{sdkError(sdkerrors.ErrMempoolIsFull), {Code: 0}},
+		committed:  map[string]*sdk.TxResponse{},
+	}
+	q := New(dbm.NewMemDB(), node, c.options())
+	ctx := context.Background()
+
+	entry, err := q.Enqueue([]byte("tx"))
+	require.NoError(t, err)
+	require.Equal(t, StatePending, entry.State)
+
+	// enqueuing the same transaction is a no-op
+	again, err := q.Enqueue([]byte("tx"))
+	require.NoError(t, err)
+	require.Equal(t, entry, again)
+
+	// the first broadcast fails, retried after the min backoff
+	require.NoError(t, q.Process(ctx))
+	entry, _, err = q.Get(entry.Hash)
+	require.NoError(t, err)
+	require.Equal(t, StatePending, entry.State)
+	require.Equal(t, "connection refused", entry.LastError)
+	require.Equal(t, c.now.Add(time.Second), entry.NextAttempt)
+
+	// not due yet
+	require.NoError(t, q.Process(ctx))
+	require.Equal(t, 1, node.calls)
+
+	// the mempool is full, retried after twice the backoff
+	c.Advance(time.Second)
+	require.NoError(t, q.Process(ctx))
+	entry, _, _ = q.Get(entry.Hash)
+	require.Equal(t, StatePending, entry.State)
+	require.Equal(t, c.now.Add(2*time.Second), entry.NextAttempt)
+
+	// accepted in the mempool
+	c.Advance(2 * time.Second)
+	require.NoError(t, q.Process(ctx))
+	entry, _, _ = q.Get(entry.Hash)
+	require.Equal(t, StateAccepted, entry.State)
+	require.Equal(t, 3, entry.Attempts)
+
+	// not committed yet
+	c.Advance(5 * time.Second)
+	require.NoError(t, q.Process(ctx))
+	entry, _, _ = q.Get(entry.Hash)
+	require.Equal(t, StateAccepted, entry.State)
+
+	node.committed[entry.Hash] = &sdk.TxResponse{Code: 0, Height: 42}
+	c.Advance(5 * time.Second)
+	require.NoError(t, q.Process(ctx))
+	entry, _, _ = q.Get(entry.Hash)
+	require.Equal(t, StateCommitted, entry.State)
+	require.Equal(t, int64(42), entry.Height)
+
+	// terminal entries are no longer processed
+	c.Advance(time.Hour)
+	require.NoError(t, q.Process(ctx))
+	require.Equal(t, 3, node.calls)
+
+	require.NoError(t, q.Remove(entry.Hash))
+	entries, err := q.List()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestQueueFailures(t *testing.T) {
+	c := newClock()
+	node := &mockNode{
+		errs: []error{nil, errors.New("unavailable"), errors.New("unavailable"), errors.New("unavailable"), errors.New("unavailable"), nil},
+		broadcasts: []*sdk.TxResponse{
+			sdkError(sdkerrors.ErrWrongSequence),
+			sdkError(sdkerrors.ErrTxInMempoolCache),
+		},
+		committed: map[string]*sdk.TxResponse{},
+	}
+	q := New(dbm.NewMemDB(), node, c.options())
+	ctx := context.Background()
+
+	// rejected by the node
+	rejected, err := q.Enqueue([]byte("rejected"))
+	require.NoError(t, err)
+	require.NoError(t, q.Process(ctx))
+	rejected, _, _ = q.Get(rejected.Hash)
+	require.Equal(t, StateFailed, rejected.State)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), rejected.Code)
+
+	// retried until the max attempts
+	unreachable, err := q.Enqueue([]byte("unreachable"))
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, q.Process(ctx))
+		c.Advance(time.Minute)
+	}
+	unreachable, _, _ = q.Get(unreachable.Hash)
+	require.Equal(t, StateFailed, unreachable.State)
+	require.Equal(t, 4, unreachable.Attempts)
+	require.Contains(t, unreachable.LastError, "giving up after 4 attempts")
+
+	// already in the mempool cache, then failed in a block
+	inBlock, err := q.Enqueue([]byte("in block"))
+	require.NoError(t, err)
+	require.NoError(t, q.Process(ctx))
+	inBlock, _, _ = q.Get(inBlock.Hash)
+	require.Equal(t, StateAccepted, inBlock.State)
+
+	node.committed[inBlock.Hash] = &sdk.TxResponse{Code: 5, Codespace: "bank", RawLog: "insufficient funds", Height: 7}
+	c.Advance(time.Minute)
+	require.NoError(t, q.Process(ctx))
+	inBlock, _, _ = q.Get(inBlock.Hash)
+	require.Equal(t, StateFailed, inBlock.State)
+	require.Equal(t, "insufficient funds", inBlock.LastError)
+	require.Equal(t, int64(7), inBlock.Height)
+}
+
+func TestQueueRebroadcastUncommitted(t *testing.T) {
+	c := newClock()
+	node := &mockNode{broadcasts: []*sdk.TxResponse{{Code: 0}, {Code: 0}}}
+	q := New(dbm.NewMemDB(), node, c.options())
+	ctx := context.Background()
+
+	entry, err := q.Enqueue([]byte("evicted"))
+	require.NoError(t, err)
+	require.NoError(t, q.Process(ctx))
+
+	// evicted from the mempool, broadcast again after the confirm timeout
+	for i := 0; i < 11; i++ {
+		c.Advance(5 * time.Second)
+		require.NoError(t, q.Process(ctx))
+	}
+	entry, _, _ = q.Get(entry.Hash)
+	require.Equal(t, StateAccepted, entry.State)
+	c.Advance(5 * time.Second)
+	require.NoError(t, q.Process(ctx))
+	entry, _, _ = q.Get(entry.Hash)
+	require.Equal(t, StatePending, entry.State)
+	require.Equal(t, "transaction not committed in time", entry.LastError)
+
+	c.Advance(time.Minute)
+	require.NoError(t, q.Process(ctx))
+	entry, _, _ = q.Get(entry.Hash)
+	require.Equal(t, StateAccepted, entry.State)
+	require.Equal(t, 2, entry.Attempts)
+}
+
+func TestQueueRestart(t *testing.T) {
+	dir := t.TempDir()
+	c := newClock()
+	ctx := context.Background()
+
+	q, err := Open("txqueue", dir, &mockNode{errs: []error{errors.New("connection refused")}}, c.options())
+	require.NoError(t, err)
+	entry, err := q.Enqueue([]byte("tx"))
+	require.NoError(t, err)
+	require.NoError(t, q.Process(ctx))
+	require.NoError(t, q.Close())
+
+	// the pending transaction is broadcast after the restart
+	node := &mockNode{broadcasts: []*sdk.TxResponse{{Code: 0}}}
+	q, err = Open("txqueue", dir, node, c.options())
+	require.NoError(t, err)
+	defer q.Close()
+
+	entries, err := q.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, entry.Hash, entries[0].Hash)
+	require.Equal(t, 1, entries[0].Attempts)
+
+	c.Advance(time.Second)
+	require.NoError(t, q.Process(ctx))
+	entry, _, err = q.Get(entry.Hash)
+	require.NoError(t, err)
+	require.Equal(t, StateAccepted, entry.State)
+	require.Equal(t, 2, entry.Attempts)
+	require.Equal(t, 1, node.calls)
+}
@@ -0,0 +1,181 @@
+// Package txresult decodes the results of the transactions, as returned when
+// broadcasting or querying them, into typed messages: the responses of their
+// messages and their typed events, so that they don't need to be parsed from the
+// raw event attributes.
+package txresult
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// msgIndexKey is the key of the attribute holding the index of the message
+// which emitted an event.
+const msgIndexKey = "msg_index"
+
+// Result is the decoded result of a transaction.
+type Result struct {
+	// TxResponse is the raw result of the transaction.
+	TxResponse *sdk.TxResponse
+	// MsgResponses are the responses of the messages of the transaction, in
+	// order. They are only known once the transaction is included in a block.
+	MsgResponses []gogoproto.Message
+	// Events are the events emitted by the transaction.
+	Events []Event
+}
+
+// Event is an event emitted by a transaction.
+type Event struct {
+	// Type is the type of the event, i.e. the full name of its message for a
+	// typed event.
+	Type string
+	// Attributes are the raw attributes of the event.
+	Attributes []abci.EventAttribute
+	// MsgIndex is the index of the message which emitted the event, or -1 for an
+	// event emitted outside of the messages, e.g. by the ante handler.
+	MsgIndex int
+	// Typed is the typed event, or nil if the event was not emitted as a typed event.
+	Typed gogoproto.Message
+}
+
+// Attribute returns the value of the attribute key of the event.
+func (e Event) Attribute(key string) (string, bool) {
+	for _, attr := range e.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Decoder decodes the results of the transactions, resolving the types of the
+// message responses with an interface registry and the types of the typed
+// events with the registered proto messages.
+type Decoder struct {
+	registry codectypes.InterfaceRegistry
+}
+
+// NewDecoder returns a Decoder resolving the message responses with registry.
+func NewDecoder(registry codectypes.InterfaceRegistry) Decoder {
+	return Decoder{registry: registry}
+}
+
+// Decode decodes the message responses and the events of res.
+func (d Decoder) Decode(res *sdk.TxResponse) (*Result, error) {
+	msgResponses, err := d.decodeMsgResponses(res.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := DecodeEvents(res.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		TxResponse:   res,
+		MsgResponses: msgResponses,
+		Events:       events,
+	}, nil
+}
+
+func (d Decoder) decodeMsgResponses(data string) ([]gogoproto.Message, error) {
+	if data == "" {
+		return nil, nil
+	}
+
+	bz, err := hex.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx data: %w", err)
+	}
+
+	var msgData sdk.TxMsgData
+	if err := msgData.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("invalid tx data: %w", err)
+	}
+
+	msgResponses := make([]gogoproto.Message, 0, len(msgData.MsgResponses))
+	for i, msgResponse := range msgData.MsgResponses {
+		msg, err := d.registry.Resolve(msgResponse.TypeUrl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the response of message %d: %w", i, err)
+		}
+		if err := gogoproto.Unmarshal(msgResponse.Value, msg); err != nil {
+			return nil, fmt.Errorf("failed to decode the response of message %d: %w", i, err)
+		}
+
+		msgResponses = append(msgResponses, msg)
+	}
+
+	return msgResponses, nil
+}
+
+// DecodeEvents decodes the typed events among events. The other events are
+// returned with their raw attributes only.
+func DecodeEvents(events []abci.Event) ([]Event, error) {
+	decoded := make([]Event, 0, len(events))
+	for _, event := range events {
+		e := Event{
+			Type:       event.Type,
+			Attributes: event.Attributes,
+			MsgIndex:   -1,
+		}
+
+		if v, ok := e.Attribute(msgIndexKey); ok {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid message index of event %s: %w", event.Type, err)
+			}
+			e.MsgIndex = i
+		}
+
+		// the typed events have the full name of their registered message as type
+		if gogoproto.MessageType(event.Type) != nil {
+			typed, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode event %s: %w", event.Type, err)
+			}
+			e.Typed = typed
+		}
+
+		decoded = append(decoded, e)
+	}
+
+	return decoded, nil
+}
+
+// TypedEvents returns the typed events of type T emitted by the transaction.
+func TypedEvents[T gogoproto.Message](r *Result) []T {
+	var events []T
+	for _, event := range r.Events {
+		if typed, ok := event.Typed.(T); ok {
+			events = append(events, typed)
+		}
+	}
+
+	return events
+}
+
+// MsgResponse returns the response of the message at index i of the
+// transaction, which must be of type T.
+func MsgResponse[T gogoproto.Message](r *Result, i int) (T, error) {
+	var zero T
+	if i < 0 || i >= len(r.MsgResponses) {
+		return zero, fmt.Errorf("no response for message %d, the transaction has %d message responses", i, len(r.MsgResponses))
+	}
+
+	res, ok := r.MsgResponses[i].(T)
+	if !ok {
+		return zero, fmt.Errorf("expected the response of message %d to be %T, got %T", i, zero, r.MsgResponses[i])
+	}
+
+	return res, nil
+}
@@ -0,0 +1,106 @@
+package txresult
+
+import (
+	"encoding/hex"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/authz"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDecode(t *testing.T) {
+	registry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	countertypes.RegisterInterfaces(registry)
+	decoder := NewDecoder(registry)
+
+	msgResponse, err := codectypes.NewAnyWithValue(&countertypes.MsgIncreaseCountResponse{NewCount: 8})
+	require.NoError(t, err)
+	data, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}}).Marshal()
+	require.NoError(t, err)
+
+	grant := &authz.EventGrant{
+		MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+		Granter:    "cosmos1x33fy6rusfprkntvjsfregss7rvsvyy4lkwrqu",
+		Grantee:    "cosmos1450l4uau674z55c36df0v7904rnvdk9aq8w96j",
+	}
+	grantEvent, err := sdk.TypedEventToEvent(grant)
+	require.NoError(t, err)
+	grantEvent = grantEvent.AppendAttributes(sdk.NewAttribute("msg_index", "0"))
+
+	res := &sdk.TxResponse{
+		TxHash: "ABCD",
+		Data:   hex.EncodeToString(data),
+		Events: []abci.Event{
+			{Type: "tx", Attributes: []abci.EventAttribute{{Key: "fee", Value: "10stake"}}},
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "/cosmos.authz.v1beta1.MsgGrant"}, {Key: "msg_index", Value: "0"}}},
+			abci.Event(grantEvent),
+		},
+	}
+
+	result, err := decoder.Decode(res)
+	require.NoError(t, err)
+	require.Equal(t, res, result.TxResponse)
+
+	// the message responses are typed
+	require.Len(t, result.MsgResponses, 1)
+	counterRes, err := MsgResponse[*countertypes.MsgIncreaseCountResponse](result, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), counterRes.NewCount)
+	_, err = MsgResponse[*authz.MsgGrantResponse](result, 0)
+	require.ErrorContains(t, err, "expected the response of message 0")
+	_, err = MsgResponse[*countertypes.MsgIncreaseCountResponse](result, 1)
+	require.ErrorContains(t, err, "no response for message 1")
+
+	// the typed events are decoded, the others keep their raw attributes
+	require.Len(t, result.Events, 3)
+	require.Equal(t, -1, result.Events[0].MsgIndex)
+	require.Nil(t, result.Events[0].Typed)
+	fee, ok := result.Events[0].Attribute("fee")
+	require.True(t, ok)
+	require.Equal(t, "10stake", fee)
+
+	require.Equal(t, 0, result.Events[1].MsgIndex)
+	require.Nil(t, result.Events[1].Typed)
+
+	require.Equal(t, "cosmos.authz.v1beta1.EventGrant", result.Events[2].Type)
+	require.Equal(t, 0, result.Events[2].MsgIndex)
+	require.Equal(t, []*authz.EventGrant{grant}, TypedEvents[*authz.EventGrant](result))
+}
+
+func TestDecodeErrors(t *testing.T) {
+	decoder := NewDecoder(codectestutil.CodecOptions{}.NewInterfaceRegistry())
+
+	// a broadcast result without data has no message responses
+	result, err := decoder.Decode(&sdk.TxResponse{})
+	require.NoError(t, err)
+	require.Empty(t, result.MsgResponses)
+	require.Empty(t, result.Events)
+
+	_, err = decoder.Decode(&sdk.TxResponse{Data: "not hex"})
+	require.ErrorContains(t, err, "invalid tx data")
+
+	// the message responses must be registered
+	msgResponse, err := codectypes.NewAnyWithValue(&countertypes.MsgIncreaseCountResponse{NewCount: 8})
+	require.NoError(t, err)
+	data, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}}).Marshal()
+	require.NoError(t, err)
+	_, err = decoder.Decode(&sdk.TxResponse{Data: hex.EncodeToString(data)})
+	require.ErrorContains(t, err, "failed to decode the response of message 0")
+
+	_, err = decoder.Decode(&sdk.TxResponse{Events: []abci.Event{
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "msg_index", Value: "first"}}},
+	}})
+	require.ErrorContains(t, err, "invalid message index of event message")
+
+	_, err = decoder.Decode(&sdk.TxResponse{Events: []abci.Event{
+		{Type: "cosmos.authz.v1beta1.EventGrant", Attributes: []abci.EventAttribute{{Key: "granter", Value: "{"}}},
+	}})
+	require.ErrorContains(t, err, "failed to decode event cosmos.authz.v1beta1.EventGrant")
+}
@@ -0,0 +1,183 @@
+// Package txtemplate provides typed builders assembling the transactions of
+// common flows (bank send, delegate and undelegate, gov vote, authz grant):
+// their validated messages, a suggested gas limit and the memo, in one call.
+package txtemplate
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/address"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The gas limits suggested for the common flows. They are conservative
+// estimates, which can be refined by simulating the transactions.
+const (
+	BankSendGas   uint64 = 100_000
+	DelegateGas   uint64 = 250_000
+	UndelegateGas uint64 = 300_000
+	VoteGas       uint64 = 100_000
+	AuthzGrantGas uint64 = 120_000
+)
+
+// Template is a transaction assembled by a Builder: its messages, the suggested
+// gas limit and the memo.
+type Template struct {
+	Msgs []sdk.Msg
+	Gas  uint64
+	Memo string
+}
+
+// Apply returns the factory set with the gas limit and the memo of the template.
+func (t Template) Apply(txf clienttx.Factory) clienttx.Factory {
+	return txf.WithGas(t.Gas).WithMemo(t.Memo)
+}
+
+// BuildUnsignedTx builds the unsigned transaction of the template with the
+// factory, set with the gas limit and the memo of the template.
+func (t Template) BuildUnsignedTx(txf clienttx.Factory) (client.TxBuilder, error) {
+	return t.Apply(txf).BuildUnsignedTx(t.Msgs...)
+}
+
+// Builder builds the Templates of the common flows, validating their inputs.
+type Builder struct {
+	addressCodec          address.Codec
+	validatorAddressCodec address.Codec
+	memo                  string
+}
+
+// NewBuilder returns a Builder validating the account and validator addresses
+// with the given codecs.
+func NewBuilder(addressCodec, validatorAddressCodec address.Codec) Builder {
+	return Builder{
+		addressCodec:          addressCodec,
+		validatorAddressCodec: validatorAddressCodec,
+	}
+}
+
+// WithMemo returns a copy of the Builder setting the memo on the templates. The
+// memo must not exceed the default maximum memo length of the auth module.
+func (b Builder) WithMemo(memo string) (Builder, error) {
+	if uint64(len(memo)) > authtypes.DefaultMaxMemoCharacters {
+		return b, fmt.Errorf("memo is too long: %d > %d characters", len(memo), authtypes.DefaultMaxMemoCharacters)
+	}
+
+	b.memo = memo
+	return b, nil
+}
+
+// BankSend returns the template sending amount from one account to another.
+func (b Builder) BankSend(from, to string, amount sdk.Coins) (Template, error) {
+	if err := b.validateAddresses(from, to); err != nil {
+		return Template{}, err
+	}
+	if err := validateCoins(amount); err != nil {
+		return Template{}, err
+	}
+
+	return b.template(BankSendGas, banktypes.NewMsgSend(from, to, amount)), nil
+}
+
+// Delegate returns the template delegating amount from a delegator to a validator.
+func (b Builder) Delegate(delegator, validator string, amount sdk.Coin) (Template, error) {
+	if err := b.validateDelegation(delegator, validator, amount); err != nil {
+		return Template{}, err
+	}
+
+	return b.template(DelegateGas, stakingtypes.NewMsgDelegate(delegator, validator, amount)), nil
+}
+
+// Undelegate returns the template undelegating amount of a delegator from a validator.
+func (b Builder) Undelegate(delegator, validator string, amount sdk.Coin) (Template, error) {
+	if err := b.validateDelegation(delegator, validator, amount); err != nil {
+		return Template{}, err
+	}
+
+	return b.template(UndelegateGas, stakingtypes.NewMsgUndelegate(delegator, validator, amount)), nil
+}
+
+// Vote returns the template voting option on a proposal.
+func (b Builder) Vote(voter string, proposalID uint64, option govv1.VoteOption) (Template, error) {
+	if err := b.validateAddresses(voter); err != nil {
+		return Template{}, err
+	}
+	if !govv1.ValidVoteOption(option) || option == govv1.OptionEmpty {
+		return Template{}, fmt.Errorf("invalid vote option: %s", option)
+	}
+
+	return b.template(VoteGas, govv1.NewMsgVote(voter, proposalID, option, "")), nil
+}
+
+// AuthzGrant returns the template granting authorization from a granter to a
+// grantee, until expiration if not nil.
+func (b Builder) AuthzGrant(granter, grantee string, authorization authz.Authorization, expiration *time.Time) (Template, error) {
+	if err := b.validateAddresses(granter, grantee); err != nil {
+		return Template{}, err
+	}
+	if granter == grantee {
+		return Template{}, errors.New("granter and grantee cannot be the same")
+	}
+	if authorization == nil {
+		return Template{}, errors.New("missing authorization")
+	}
+	if err := authorization.ValidateBasic(); err != nil {
+		return Template{}, err
+	}
+
+	msg, err := authz.NewMsgGrant(granter, grantee, authorization, expiration)
+	if err != nil {
+		return Template{}, err
+	}
+
+	return b.template(AuthzGrantGas, msg), nil
+}
+
+func (b Builder) template(gas uint64, msgs ...sdk.Msg) Template {
+	return Template{
+		Msgs: msgs,
+		Gas:  gas,
+		Memo: b.memo,
+	}
+}
+
+func (b Builder) validateDelegation(delegator, validator string, amount sdk.Coin) error {
+	if err := b.validateAddresses(delegator); err != nil {
+		return err
+	}
+	if _, err := b.validatorAddressCodec.StringToBytes(validator); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", validator, err)
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s", amount)
+	}
+
+	return nil
+}
+
+func (b Builder) validateAddresses(addrs ...string) error {
+	for _, addr := range addrs {
+		if _, err := b.addressCodec.StringToBytes(addr); err != nil {
+			return fmt.Errorf("invalid address %s: %w", addr, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCoins(amount sdk.Coins) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return fmt.Errorf("invalid amount: %s", amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package txtemplate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	authtx "cosmossdk.io/x/auth/tx"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	addr1     = "cosmos1x33fy6rusfprkntvjsfregss7rvsvyy4lkwrqu"
+	addr2     = "cosmos1450l4uau674z55c36df0v7904rnvdk9aq8w96j"
+	validator = "cosmosvaloper1x33fy6rusfprkntvjsfregss7rvsvyy46z6kv0"
+)
+
+func newBuilder() Builder {
+	return NewBuilder(addresscodec.NewBech32Codec("cosmos"), addresscodec.NewBech32Codec("cosmosvaloper"))
+}
+
+func TestBuilder(t *testing.T) {
+	b := newBuilder()
+	coin := sdk.NewInt64Coin("stake", 10)
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	grant, err := authz.NewMsgGrant(addr1, addr2, authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend"), &expiration)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		build    func() (Template, error)
+		expected Template
+		expErr   string
+	}{
+		{
+			name:     "bank send",
+			build:    func() (Template, error) { return b.BankSend(addr1, addr2, sdk.NewCoins(coin)) },
+			expected: Template{Msgs: []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(coin))}, Gas: BankSendGas},
+		},
+		{
+			name:   "bank send invalid address",
+			build:  func() (Template, error) { return b.BankSend(addr1, "cosmos1invalid", sdk.NewCoins(coin)) },
+			expErr: "invalid address cosmos1invalid",
+		},
+		{
+			name:   "bank send no amount",
+			build:  func() (Template, error) { return b.BankSend(addr1, addr2, sdk.NewCoins()) },
+			expErr: "invalid amount",
+		},
+		{
+			name:     "delegate",
+			build:    func() (Template, error) { return b.Delegate(addr1, validator, coin) },
+			expected: Template{Msgs: []sdk.Msg{stakingtypes.NewMsgDelegate(addr1, validator, coin)}, Gas: DelegateGas},
+		},
+		{
+			name:   "delegate to an account",
+			build:  func() (Template, error) { return b.Delegate(addr1, addr2, coin) },
+			expErr: "invalid validator address",
+		},
+		{
+			name:   "delegate zero",
+			build:  func() (Template, error) { return b.Delegate(addr1, validator, sdk.NewInt64Coin("stake", 0)) },
+			expErr: "invalid amount",
+		},
+		{
+			name:     "undelegate",
+			build:    func() (Template, error) { return b.Undelegate(addr1, validator, coin) },
+			expected: Template{Msgs: []sdk.Msg{stakingtypes.NewMsgUndelegate(addr1, validator, coin)}, Gas: UndelegateGas},
+		},
+		{
+			name:     "vote",
+			build:    func() (Template, error) { return b.Vote(addr1, 1, govv1.OptionYes) },
+			expected: Template{Msgs: []sdk.Msg{govv1.NewMsgVote(addr1, 1, govv1.OptionYes, "")}, Gas: VoteGas},
+		},
+		{
+			name:   "vote empty option",
+			build:  func() (Template, error) { return b.Vote(addr1, 1, govv1.OptionEmpty) },
+			expErr: "invalid vote option",
+		},
+		{
+			name: "authz grant",
+			build: func() (Template, error) {
+				return b.AuthzGrant(addr1, addr2, authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend"), &expiration)
+			},
+			expected: Template{Msgs: []sdk.Msg{grant}, Gas: AuthzGrantGas},
+		},
+		{
+			name: "authz grant to self",
+			build: func() (Template, error) {
+				return b.AuthzGrant(addr1, addr1, authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend"), nil)
+			},
+			expErr: "granter and grantee cannot be the same",
+		},
+		{
+			name:   "authz grant without authorization",
+			build:  func() (Template, error) { return b.AuthzGrant(addr1, addr2, nil, nil) },
+			expErr: "missing authorization",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.build()
+			if tt.expErr != "" {
+				require.ErrorContains(t, err, tt.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBuilderWithMemo(t *testing.T) {
+	_, err := newBuilder().WithMemo(strings.Repeat("a", 257))
+	require.ErrorContains(t, err, "memo is too long")
+
+	b, err := newBuilder().WithMemo("memo")
+	require.NoError(t, err)
+
+	tmpl, err := b.Vote(addr1, 1, govv1.OptionNo)
+	require.NoError(t, err)
+	require.Equal(t, "memo", tmpl.Memo)
+}
+
+func TestTemplateBuildUnsignedTx(t *testing.T) {
+	registry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig := authtx.NewTxConfig(cdc, addresscodec.NewBech32Codec("cosmos"), addresscodec.NewBech32Codec("cosmosvaloper"), authtx.DefaultSignModes)
+
+	b, err := newBuilder().WithMemo("memo")
+	require.NoError(t, err)
+	tmpl, err := b.BankSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, err)
+
+	txf := clienttx.Factory{}.
+		WithTxConfig(txConfig).
+		WithChainID("test-chain").
+		WithGasPrices("0.1stake")
+	txb, err := tmpl.BuildUnsignedTx(txf)
+	require.NoError(t, err)
+
+	tx := txb.GetTx()
+	require.Equal(t, tmpl.Msgs, tx.GetMsgs())
+	require.Equal(t, BankSendGas, tx.GetGas())
+	require.Equal(t, "memo", tx.GetMemo())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000)), tx.GetFee())
+}
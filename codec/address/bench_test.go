@@ -19,6 +19,16 @@ func BenchmarkCodecWithoutCache(b *testing.B) {
 	bytesToString(b, cdc)
 }
 
+func BenchmarkMultiPrefixCodec(b *testing.B) {
+	mc, err := NewCachedMultiPrefixCodec(Bech32Prefixes{
+		Account:   "cosmos",
+		Validator: "cosmosvaloper",
+		Consensus: "cosmosvalcons",
+	}, DefaultCacheSize)
+	require.NoError(b, err)
+	bytesToString(b, mc.ValidatorAddressCodec())
+}
+
 func bytesToString(b *testing.B, cdc address.Codec) {
 	b.Helper()
 	addresses, err := generateAddresses(10)
@@ -0,0 +1,226 @@
+package address
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdkAddress "github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AddressKind is the kind of address a bech32 prefix is used for.
+type AddressKind int
+
+const (
+	AccountAddressKind AddressKind = iota
+	ValidatorAddressKind
+	ConsensusAddressKind
+
+	numAddressKinds = 3
+)
+
+// String implements fmt.Stringer.
+func (k AddressKind) String() string {
+	switch k {
+	case AccountAddressKind:
+		return "account"
+	case ValidatorAddressKind:
+		return "validator"
+	case ConsensusAddressKind:
+		return "consensus"
+	default:
+		return fmt.Sprintf("AddressKind(%d)", int(k))
+	}
+}
+
+// Bech32Prefixes are the bech32 prefixes of the account, validator and
+// consensus addresses of a chain.
+type Bech32Prefixes struct {
+	Account   string
+	Validator string
+	Consensus string
+}
+
+// DefaultCacheSize is the default number of addresses the LRU caches of a
+// MultiPrefixCodec hold.
+const DefaultCacheSize = 256
+
+var _ address.Codec = multiPrefixKindCodec{}
+
+// MultiPrefixCodec converts the account, validator and consensus addresses of
+// a chain, resolving which kind an address is from its bech32 prefix. It caches
+// the conversions in both directions in LRU caches shared by all the prefixes,
+// as bech32 conversions are hot in the profiles of query heavy nodes.
+//
+// Unlike codecs created by NewCachedBech32Codec sharing a cache, a decoded
+// address is only accepted by the codec of its own prefix. It is safe for
+// concurrent use.
+type MultiPrefixCodec struct {
+	prefixes [numAddressKinds]string
+
+	mu sync.Mutex
+	// encoded caches the addresses of bytes, by kind
+	encoded *simplelru.LRU
+	// decoded caches the decoded addresses, by address
+	decoded *simplelru.LRU
+}
+
+type decodedAddress struct {
+	kind AddressKind
+	bz   []byte
+}
+
+// NewCachedMultiPrefixCodec returns a MultiPrefixCodec for the prefixes, each
+// of its caches holding cacheSize addresses, or DefaultCacheSize if cacheSize
+// is not positive. The prefixes must be non-empty and distinct.
+func NewCachedMultiPrefixCodec(prefixes Bech32Prefixes, cacheSize int) (*MultiPrefixCodec, error) {
+	if cacheSize <= 0 {
+		cacheSize = DefaultCacheSize
+	}
+	c := &MultiPrefixCodec{
+		prefixes: [numAddressKinds]string{prefixes.Account, prefixes.Validator, prefixes.Consensus},
+	}
+	for kind, prefix := range c.prefixes {
+		if prefix == "" {
+			return nil, fmt.Errorf("empty %s address bech32 prefix", AddressKind(kind))
+		}
+		for other := range kind {
+			if c.prefixes[other] == prefix {
+				return nil, fmt.Errorf("%s and %s addresses have the same bech32 prefix %s", AddressKind(other), AddressKind(kind), prefix)
+			}
+		}
+	}
+
+	var err error
+	if c.encoded, err = simplelru.NewLRU(cacheSize, nil); err != nil {
+		return nil, fmt.Errorf("failed to create LRU cache: %w", err)
+	}
+	if c.decoded, err = simplelru.NewLRU(cacheSize, nil); err != nil {
+		return nil, fmt.Errorf("failed to create LRU cache: %w", err)
+	}
+	return c, nil
+}
+
+// AddressCodec returns the codec of the account addresses.
+func (c *MultiPrefixCodec) AddressCodec() address.Codec {
+	return multiPrefixKindCodec{c: c, kind: AccountAddressKind}
+}
+
+// ValidatorAddressCodec returns the codec of the validator addresses.
+func (c *MultiPrefixCodec) ValidatorAddressCodec() address.ValidatorAddressCodec {
+	return multiPrefixKindCodec{c: c, kind: ValidatorAddressKind}
+}
+
+// ConsensusAddressCodec returns the codec of the consensus addresses.
+func (c *MultiPrefixCodec) ConsensusAddressCodec() address.ConsensusAddressCodec {
+	return multiPrefixKindCodec{c: c, kind: ConsensusAddressKind}
+}
+
+// Resolve decodes an address of any of the prefixes of the codec, and returns
+// its kind and bytes.
+func (c *MultiPrefixCodec) Resolve(text string) (AddressKind, []byte, error) {
+	c.mu.Lock()
+	cached, ok := c.decoded.Get(text)
+	c.mu.Unlock()
+	if ok {
+		addr := cached.(decodedAddress)
+		return addr.kind, bytes.Clone(addr.bz), nil
+	}
+
+	if len(strings.TrimSpace(text)) == 0 {
+		return 0, []byte{}, errEmptyAddress
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(text)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(bz) > sdkAddress.MaxAddrLen {
+		return 0, nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "address max length is %d, got %d", sdkAddress.MaxAddrLen, len(bz))
+	}
+
+	kind, ok := c.kindOf(hrp)
+	if !ok {
+		return 0, nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "hrp does not match any bech32 prefix: expected one of %v got '%s'", c.prefixes, hrp)
+	}
+
+	c.mu.Lock()
+	c.decoded.Add(text, decodedAddress{kind: kind, bz: bytes.Clone(bz)})
+	c.mu.Unlock()
+	return kind, bz, nil
+}
+
+func (c *MultiPrefixCodec) kindOf(hrp string) (AddressKind, bool) {
+	for kind, prefix := range c.prefixes {
+		if prefix == hrp {
+			return AddressKind(kind), true
+		}
+	}
+	return 0, false
+}
+
+func (c *MultiPrefixCodec) encode(kind AddressKind, bz []byte) (string, error) {
+	if len(bz) == 0 {
+		return "", nil
+	}
+
+	c.mu.Lock()
+	var text string
+	if cached, ok := c.encoded.Get(string(bz)); ok {
+		text = cached.(*[numAddressKinds]string)[kind]
+	}
+	c.mu.Unlock()
+	if text != "" {
+		return text, nil
+	}
+
+	text, err := Bech32Codec{Bech32Prefix: c.prefixes[kind]}.BytesToString(bz)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the entry may have been evicted or added since it was looked up
+	cached, ok := c.encoded.Get(string(bz))
+	if !ok {
+		cached = new([numAddressKinds]string)
+		c.encoded.Add(string(bz), cached)
+	}
+	cached.(*[numAddressKinds]string)[kind] = text
+	return text, nil
+}
+
+// multiPrefixKindCodec is the codec of one kind of address of a
+// MultiPrefixCodec.
+type multiPrefixKindCodec struct {
+	c    *MultiPrefixCodec
+	kind AddressKind
+}
+
+// StringToBytes decodes text to bytes, it fails if text is an address of
+// another kind.
+func (kc multiPrefixKindCodec) StringToBytes(text string) ([]byte, error) {
+	kind, bz, err := kc.c.Resolve(text)
+	if err != nil {
+		return bz, err
+	}
+	if kind != kc.kind {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "hrp does not match bech32 prefix: expected '%s' got '%s'", kc.c.prefixes[kc.kind], kc.c.prefixes[kind])
+	}
+	return bz, nil
+}
+
+// BytesToString encodes bytes to text
+func (kc multiPrefixKindCodec) BytesToString(bz []byte) (string, error) {
+	return kc.c.encode(kc.kind, bz)
+}
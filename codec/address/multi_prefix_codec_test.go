@@ -0,0 +1,145 @@
+package address
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var cosmosPrefixes = Bech32Prefixes{
+	Account:   "cosmos",
+	Validator: "cosmosvaloper",
+	Consensus: "cosmosvalcons",
+}
+
+func TestNewCachedMultiPrefixCodec(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes Bech32Prefixes
+		error    string
+	}{
+		{
+			name:     "valid prefixes",
+			prefixes: cosmosPrefixes,
+		},
+		{
+			name:     "empty consensus prefix",
+			prefixes: Bech32Prefixes{Account: "cosmos", Validator: "cosmosvaloper"},
+			error:    "empty consensus address bech32 prefix",
+		},
+		{
+			name:     "same account and validator prefixes",
+			prefixes: Bech32Prefixes{Account: "cosmos", Validator: "cosmos", Consensus: "cosmosvalcons"},
+			error:    "account and validator addresses have the same bech32 prefix cosmos",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCachedMultiPrefixCodec(tt.prefixes, 0)
+			if tt.error != "" {
+				require.EqualError(t, err, tt.error)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMultiPrefixCodec(t *testing.T) {
+	mc, err := NewCachedMultiPrefixCodec(cosmosPrefixes, 2)
+	require.NoError(t, err)
+
+	tests := []struct {
+		kind    AddressKind
+		prefix  string
+		address string
+	}{
+		{AccountAddressKind, "cosmos", "cosmos1p8s0p6gqc6c9gt77lgr2qqujz49huhu6a80smx"},
+		{ValidatorAddressKind, "cosmosvaloper", "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"},
+		{ConsensusAddressKind, "cosmosvalcons", "cosmosvalcons1ntk8eualewuprz0gamh8hnvcem2nrcdsgz563h"},
+	}
+	codecs := map[AddressKind]interface {
+		StringToBytes(string) ([]byte, error)
+		BytesToString([]byte) (string, error)
+	}{
+		AccountAddressKind:   mc.AddressCodec(),
+		ValidatorAddressKind: mc.ValidatorAddressCodec(),
+		ConsensusAddressKind: mc.ConsensusAddressCodec(),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			expected, err := NewBech32Codec(tt.prefix).StringToBytes(tt.address)
+			require.NoError(t, err)
+
+			// decoding and encoding twice exercises the caches
+			for i := 0; i < 2; i++ {
+				kind, bz, err := mc.Resolve(tt.address)
+				require.NoError(t, err)
+				require.Equal(t, tt.kind, kind)
+				require.Equal(t, expected, bz)
+				// the cached bytes are not shared with the callers
+				bz[0]++
+
+				bz, err = codecs[tt.kind].StringToBytes(tt.address)
+				require.NoError(t, err)
+				require.Equal(t, expected, bz)
+
+				text, err := codecs[tt.kind].BytesToString(bz)
+				require.NoError(t, err)
+				require.Equal(t, tt.address, text)
+			}
+
+			// the codecs of the other kinds reject the address, even if it is cached
+			for kind, cdc := range codecs {
+				if kind == tt.kind {
+					continue
+				}
+				_, err := cdc.StringToBytes(tt.address)
+				require.ErrorContains(t, err, "hrp does not match bech32 prefix")
+			}
+		})
+	}
+
+	osmoAddr, err := NewBech32Codec("osmo").BytesToString([]byte{1, 2, 3})
+	require.NoError(t, err)
+	_, _, err = mc.Resolve(osmoAddr)
+	require.ErrorContains(t, err, "hrp does not match any bech32 prefix")
+	_, _, err = mc.Resolve(" ")
+	require.ErrorIs(t, err, errEmptyAddress)
+	text, err := mc.AddressCodec().BytesToString(nil)
+	require.NoError(t, err)
+	require.Empty(t, text)
+}
+
+func TestMultiPrefixCodecRace(t *testing.T) {
+	mc, err := NewCachedMultiPrefixCodec(cosmosPrefixes, 4)
+	require.NoError(t, err)
+	addresses, err := generateAddresses(8)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				cdc := mc.AddressCodec()
+				if j%2 == 1 {
+					cdc = mc.ValidatorAddressCodec()
+				}
+				text, err := cdc.BytesToString(addresses[(i+j)%len(addresses)])
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := cdc.StringToBytes(text); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -9,6 +9,7 @@ import (
 
 	counterv1 "cosmossdk.io/api/cosmos/counter/v1"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -101,3 +102,28 @@ func BenchmarkProtoreflectGetSignersDynamicpb(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkProtoCodecUnmarshal(b *testing.B) {
+	registry := testdata.NewTestInterfaceRegistry()
+	animalAny, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(b, err)
+	hasAnimalAny, err := codectypes.NewAnyWithValue(&testdata.HasAnimal{Animal: animalAny})
+	require.NoError(b, err)
+	bz, err := codec.NewProtoCodec(registry).Marshal(&testdata.HasHasAnimal{HasAnimal: hasAnimalAny})
+	require.NoError(b, err)
+
+	for name, cdc := range map[string]*codec.ProtoCodec{
+		"eager": codec.NewProtoCodec(registry),
+		"lazy":  codec.NewLazyProtoCodec(registry),
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var hha testdata.HasHasAnimal
+				if err := cdc.Unmarshal(bz, &hha); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
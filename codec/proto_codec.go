@@ -32,6 +32,8 @@ type ProtoCodecMarshaler interface {
 // encoding.
 type ProtoCodec struct {
 	interfaceRegistry types.InterfaceRegistry
+	// lazy indicates that the Any values of decoded messages are unpacked on first access.
+	lazy bool
 }
 
 var _ Codec = (*ProtoCodec)(nil)
@@ -43,6 +45,21 @@ func NewProtoCodec(interfaceRegistry types.InterfaceRegistry) *ProtoCodec {
 	}
 }
 
+// NewLazyProtoCodec returns a reference to a new ProtoCodec which does not unpack
+// the Any values of the messages it decodes. They are instead unpacked on first
+// access with types.UnpackedValue, one level at a time, which saves the
+// allocations of the values that are never accessed.
+//
+// Messages decoded by a lazy codec must not be accessed through the cached values
+// of their Anys (i.e. Any.GetCachedValue) before being unpacked, so it must only
+// be used where all the accesses go through types.UnpackedValue.
+func NewLazyProtoCodec(interfaceRegistry types.InterfaceRegistry) *ProtoCodec {
+	return &ProtoCodec{
+		interfaceRegistry: interfaceRegistry,
+		lazy:              true,
+	}
+}
+
 // Marshal implements BinaryMarshaler.Marshal method.
 // NOTE: this function must be used with a concrete type which
 // implements proto.Message. For interface please use the codec.MarshalInterface
@@ -98,6 +115,9 @@ func (pc *ProtoCodec) Unmarshal(bz []byte, ptr gogoproto.Message) error {
 	if err != nil {
 		return err
 	}
+	if pc.lazy {
+		return nil
+	}
 	err = types.UnpackInterfaces(ptr, pc.interfaceRegistry)
 	if err != nil {
 		return err
@@ -206,6 +226,9 @@ func (pc *ProtoCodec) UnmarshalJSON(bz []byte, ptr gogoproto.Message) error {
 	if err != nil {
 		return err
 	}
+	if pc.lazy {
+		return nil
+	}
 
 	return types.UnpackInterfaces(ptr, pc.interfaceRegistry)
 }
@@ -289,8 +312,12 @@ func (pc *ProtoCodec) UnmarshalInterfaceJSON(bz []byte, iface interface{}) error
 
 // UnpackAny implements AnyUnpacker.UnpackAny method,
 // it unpacks the value in any to the interface pointer passed in as
-// iface.
+// iface. A lazy codec leaves the Anys nested in the value packed.
 func (pc *ProtoCodec) UnpackAny(any *types.Any, iface interface{}) error {
+	if pc.lazy {
+		return types.UnpackAnyLazily(pc.interfaceRegistry, any, iface)
+	}
+
 	return pc.interfaceRegistry.UnpackAny(any, iface)
 }
 
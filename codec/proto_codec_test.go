@@ -47,6 +47,52 @@ func TestProtoCodec(t *testing.T) {
 	testMarshaling(t, cdc)
 }
 
+func TestLazyProtoCodec(t *testing.T) {
+	cdc := codec.NewLazyProtoCodec(testdata.NewTestInterfaceRegistry())
+	testMarshaling(t, cdc)
+
+	spot := &testdata.Dog{Name: "Spot"}
+	animalAny, err := types.NewAnyWithValue(spot)
+	require.NoError(t, err)
+	hasAnimalAny, err := types.NewAnyWithValue(&testdata.HasAnimal{Animal: animalAny})
+	require.NoError(t, err)
+	bz, err := cdc.Marshal(&testdata.HasHasAnimal{HasAnimal: hasAnimalAny})
+	require.NoError(t, err)
+
+	// the Anys are not unpacked when decoding
+	var hha testdata.HasHasAnimal
+	require.NoError(t, cdc.Unmarshal(bz, &hha))
+	require.Nil(t, hha.HasAnimal.GetCachedValue())
+
+	// they are unpacked one level at a time on first access
+	hasAnimal, err := types.UnpackedValue[testdata.HasAnimalI](cdc, hha.HasAnimal)
+	require.NoError(t, err)
+	require.Equal(t, hasAnimal, hha.HasAnimal.GetCachedValue())
+	nested := hasAnimal.(*testdata.HasAnimal).Animal
+	require.Nil(t, nested.GetCachedValue())
+
+	animal, err := types.UnpackedValue[testdata.Animal](cdc, nested)
+	require.NoError(t, err)
+	require.Equal(t, spot, animal)
+
+	// accessing an unpacked value again returns the cached value
+	hasAnimal2, err := types.UnpackedValue[testdata.HasAnimalI](cdc, hha.HasAnimal)
+	require.NoError(t, err)
+	require.Same(t, hasAnimal, hasAnimal2)
+
+	// the JSON decoding is lazy as well
+	jsonBz, err := cdc.MarshalJSON(&hha)
+	require.NoError(t, err)
+	var hhaJSON testdata.HasHasAnimal
+	require.NoError(t, cdc.UnmarshalJSON(jsonBz, &hhaJSON))
+	require.Nil(t, hhaJSON.HasAnimal.GetCachedValue())
+	hasAnimal, err = types.UnpackedValue[testdata.HasAnimalI](cdc, hhaJSON.HasAnimal)
+	require.NoError(t, err)
+	animal, err = types.UnpackedValue[testdata.Animal](cdc, hasAnimal.(*testdata.HasAnimal).Animal)
+	require.NoError(t, err)
+	require.Equal(t, spot, animal)
+}
+
 func TestEnsureRegistered(t *testing.T) {
 	interfaceRegistry := types.NewInterfaceRegistry()
 	cat := &testdata.Cat{Moniker: "Garfield"}
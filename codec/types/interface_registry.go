@@ -230,6 +230,12 @@ func (registry *interfaceRegistry) ListImplementations(ifaceName string) []strin
 }
 
 func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error {
+	return registry.unpackAny(any, iface, true)
+}
+
+// unpackAny unpacks the value of any into iface, unpacking the Anys nested in
+// the value as well if recursive is set.
+func (registry *interfaceRegistry) unpackAny(any *Any, iface interface{}, recursive bool) error {
 	// here we gracefully handle the case in which `any` itself is `nil`, which may occur in message decoding
 	if any == nil {
 		return nil
@@ -277,9 +283,11 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 		return err
 	}
 
-	err = UnpackInterfaces(msg, registry)
-	if err != nil {
-		return err
+	if recursive {
+		err = UnpackInterfaces(msg, registry)
+		if err != nil {
+			return err
+		}
 	}
 
 	rv.Elem().Set(reflect.ValueOf(msg))
@@ -0,0 +1,33 @@
+package types
+
+import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+)
+
+// UnpackAnyLazily unpacks the value of any into iface like InterfaceRegistry.UnpackAny,
+// except that the Anys nested in the value are left packed, to be unpacked in turn
+// on first access with UnpackedValue. It is used by the lazy ProtoCodec.
+//
+// InterfaceRegistry implementations not created by this package unpack the
+// nested Anys as well.
+func UnpackAnyLazily(registry InterfaceRegistry, any *Any, iface interface{}) error {
+	if r, ok := registry.(*interfaceRegistry); ok {
+		return r.unpackAny(any, iface, false)
+	}
+
+	return registry.UnpackAny(any, iface)
+}
+
+// UnpackedValue returns the value packed in a, unpacking it with unpacker if it
+// is accessed for the first time. The Anys decoded by a lazy ProtoCodec are not
+// unpacked until then, so their cached value must be accessed with UnpackedValue.
+// It returns the zero value of T if a is nil or empty.
+//
+// Example:
+//
+//	animal, err := types.UnpackedValue[Animal](cdc, msg.Animal)
+func UnpackedValue[T any](unpacker gogoprotoany.AnyUnpacker, a *Any) (T, error) {
+	var value T
+	err := unpacker.UnpackAny(a, &value)
+	return value, err
+}
@@ -121,6 +121,15 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context,
 	return m.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
 }
 
+// IterateRaw iterates over the index using the raw bytes of its Pair[ReferenceKey, PrimaryKey] keys.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) IterateRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (
+	iter collections.Iterator[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue], err error,
+) {
+	return m.refKeys.IterateRaw(ctx, start, end, order)
+}
+
 func (m *Multi[K1, K2, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K1, K2]] {
 	return m.refKeys.KeyCodec()
 }
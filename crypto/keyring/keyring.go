@@ -94,6 +94,10 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveLedgerKeyWithPath retrieves a public key reference from a Ledger device at the given
+	// BIP44 HD path and persists it.
+	SaveLedgerKeyWithPath(uid string, algo SignatureAlgo, hrp, hdPath string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -440,8 +444,23 @@ func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coi
 		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, fmt.Sprintf("signature algo %s is not defined in the keyring options", algo.Name()))
 	}
 
-	hdPath := hd.NewFundraiserParams(account, coinType, index)
+	return ks.saveLedgerKey(uid, hrp, hd.NewFundraiserParams(account, coinType, index))
+}
+
+func (ks keystore) SaveLedgerKeyWithPath(uid string, algo SignatureAlgo, hrp, hdPath string) (*Record, error) {
+	if !ks.options.SupportedAlgosLedger.Contains(algo) {
+		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, fmt.Sprintf("signature algo %s is not defined in the keyring options", algo.Name()))
+	}
+
+	params, err := hd.NewParamsFromPath(hdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.saveLedgerKey(uid, hrp, params)
+}
 
+func (ks keystore) saveLedgerKey(uid, hrp string, hdPath *hd.BIP44Params) (*Record, error) {
 	priv, _, err := ledger.NewPrivKeySecp256k1(*hdPath, hrp)
 	if err != nil {
 		return nil, errorsmod.Wrap(ErrLedgerGenerateKey, err.Error())
@@ -53,6 +53,35 @@ func TestInMemoryCreateLedger(t *testing.T) {
 	require.Equal(t, "m/44'/118'/3'/0/1", path.String())
 }
 
+func TestInMemoryCreateLedgerWithPath(t *testing.T) {
+	cdc := getCodec()
+	kb := NewInMemory(cdc)
+
+	_, err := kb.SaveLedgerKeyWithPath("key", notSupportedAlgo{}, "cosmos", "m/44'/118'/3'/0/1")
+	require.True(t, errors.Is(err, ErrUnsupportedSigningAlgo))
+
+	_, err = kb.SaveLedgerKeyWithPath("key", hd.Secp256k1, "cosmos", "m/44'/118'/3'")
+	require.EqualError(t, err, "invalid path length m/44'/118'/3'")
+
+	k, err := kb.SaveLedgerKeyWithPath("some_account", hd.Secp256k1, "cosmos", "m/44'/118'/3'/0/1")
+	if err != nil {
+		require.Equal(t, "ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+		return
+	}
+
+	// the key is the same as the one created with the same BIP44 params
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, "PubKeySecp256k1{03602C0CB4D8C0081FEE794BDE96E7B95FA16F2B5283B764AC070584327B2C7202}", pubKey.String())
+	require.Equal(t, "m/44'/118'/3'/0/1", k.GetLedger().GetPath().String())
+
+	// other coin types are supported
+	k, err = kb.SaveLedgerKeyWithPath("other_coin_type", hd.Secp256k1, "cosmos", "m/44'/529'/2'/0/7")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/529'/2'/0/7", k.GetLedger().GetPath().String())
+}
+
 // TestSignVerify does some detailed checks on how we sign and validate
 // signatures
 func TestSignVerifyKeyRingWithLedger(t *testing.T) {
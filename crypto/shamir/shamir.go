@@ -0,0 +1,221 @@
+// Package shamir implements Shamir's secret sharing over GF(2^8), splitting a
+// secret into shares of which any threshold number recovers the secret while
+// fewer shares reveal nothing about it.
+//
+// The shares carry a checksum, detecting corrupted shares, and a fingerprint
+// of the secret, detecting shares of different secrets and verifying the
+// recovered secret.
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+const (
+	// MaxShares is the maximum number of shares a secret can be split into.
+	MaxShares = 255
+
+	shareVersion      = 1
+	fingerprintLength = 8
+	checksumLength    = 4
+	// headerLength is the length of the version, threshold, index and fingerprint of an encoded share.
+	headerLength = 3 + fingerprintLength
+)
+
+// Share is a share of a secret split with Split.
+type Share struct {
+	// Threshold is the number of shares required to recover the secret.
+	Threshold int
+	// Index is the x coordinate of the share, between 1 and MaxShares.
+	Index int
+	// Fingerprint is the truncated SHA-256 hash of the secret.
+	Fingerprint []byte
+	// Value is the y coordinates of the share, as long as the secret.
+	Value []byte
+}
+
+// String encodes the share as hex, see ParseShare.
+func (s Share) String() string {
+	bz := make([]byte, 0, headerLength+len(s.Value)+checksumLength)
+	bz = append(bz, shareVersion, byte(s.Threshold), byte(s.Index))
+	bz = append(bz, s.Fingerprint...)
+	bz = append(bz, s.Value...)
+	bz = append(bz, checksum(bz)...)
+	return hex.EncodeToString(bz)
+}
+
+// ParseShare decodes a share encoded with Share.String, verifying its checksum.
+func ParseShare(str string) (Share, error) {
+	bz, err := hex.DecodeString(str)
+	if err != nil {
+		return Share{}, fmt.Errorf("invalid share encoding: %w", err)
+	}
+	if len(bz) <= headerLength+checksumLength {
+		return Share{}, errors.New("invalid share: too short")
+	}
+
+	payload, sum := bz[:len(bz)-checksumLength], bz[len(bz)-checksumLength:]
+	if !bytes.Equal(checksum(payload), sum) {
+		return Share{}, errors.New("invalid share: checksum mismatch")
+	}
+	if payload[0] != shareVersion {
+		return Share{}, fmt.Errorf("invalid share: unsupported version %d", payload[0])
+	}
+
+	share := Share{
+		Threshold:   int(payload[1]),
+		Index:       int(payload[2]),
+		Fingerprint: payload[3:headerLength],
+		Value:       payload[headerLength:],
+	}
+	if share.Threshold == 0 || share.Index == 0 {
+		return Share{}, errors.New("invalid share: threshold and index must be positive")
+	}
+	return share, nil
+}
+
+// Split splits the secret into n shares, any threshold of which recover the secret.
+func Split(secret []byte, n, threshold int) ([]Share, error) {
+	switch {
+	case len(secret) == 0:
+		return nil, errors.New("cannot split an empty secret")
+	case threshold < 2:
+		return nil, errors.New("threshold must be at least 2")
+	case n < threshold:
+		return nil, errors.New("number of shares must be at least the threshold")
+	case n > MaxShares:
+		return nil, fmt.Errorf("number of shares must be at most %d", MaxShares)
+	}
+
+	fp := fingerprint(secret)
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = Share{Threshold: threshold, Index: i + 1, Fingerprint: fp, Value: make([]byte, len(secret))}
+	}
+
+	// every byte of the secret is the constant term of a random polynomial of
+	// degree threshold-1, evaluated at the index of every share
+	coefficients := make([]byte, threshold)
+	for i, b := range secret {
+		coefficients[0] = b
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for j := range shares {
+			shares[j].Value[i] = evaluate(coefficients, byte(shares[j].Index))
+		}
+	}
+
+	return shares, nil
+}
+
+// Combine recovers the secret from at least threshold shares of the same
+// secret and verifies it against the fingerprint of the shares.
+func Combine(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no shares")
+	}
+
+	first := shares[0]
+	if len(shares) < first.Threshold {
+		return nil, fmt.Errorf("%d shares are required, got %d", first.Threshold, len(shares))
+	}
+	shares = shares[:first.Threshold]
+
+	seen := make(map[int]bool, len(shares))
+	for _, share := range shares {
+		if share.Threshold != first.Threshold || !bytes.Equal(share.Fingerprint, first.Fingerprint) || len(share.Value) != len(first.Value) {
+			return nil, errors.New("shares are not shares of the same secret")
+		}
+		if share.Index < 1 || share.Index > MaxShares {
+			return nil, fmt.Errorf("invalid share index %d", share.Index)
+		}
+		if seen[share.Index] {
+			return nil, fmt.Errorf("duplicate share %d", share.Index)
+		}
+		seen[share.Index] = true
+	}
+
+	// Lagrange interpolation of the polynomials at 0
+	secret := make([]byte, len(first.Value))
+	for i, share := range shares {
+		basis := byte(1)
+		for j, other := range shares {
+			if i != j {
+				// in GF(2^8), 0 - x_j = x_j and x_i - x_j = x_i ^ x_j
+				basis = mul(basis, div(byte(other.Index), byte(share.Index)^byte(other.Index)))
+			}
+		}
+		for k, y := range share.Value {
+			secret[k] ^= mul(y, basis)
+		}
+	}
+
+	if !bytes.Equal(fingerprint(secret), first.Fingerprint) {
+		return nil, errors.New("recovered secret does not match the fingerprint of the shares")
+	}
+	return secret, nil
+}
+
+// evaluate evaluates the polynomial with the given coefficients, from the
+// constant term, at x.
+func evaluate(coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coefficients[i]
+	}
+	return y
+}
+
+func fingerprint(secret []byte) []byte {
+	sum := sha256.Sum256(secret)
+	return sum[:fingerprintLength]
+}
+
+func checksum(bz []byte) []byte {
+	sum := sha256.Sum256(bz)
+	return sum[:checksumLength]
+}
+
+// expTable and logTable are the exponentials and logarithms of the generator 3
+// of GF(2^8) with the AES reduction polynomial x^8 + x^4 + x^3 + x + 1.
+var expTable, logTable = func() (exp [510]byte, log [256]byte) {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i], exp[i+255] = x, x
+		log[x] = byte(i)
+		// multiply by 3 = x + 1
+		x ^= xtime(x)
+	}
+	return exp, log
+}()
+
+// xtime multiplies by x (2) in GF(2^8).
+func xtime(b byte) byte {
+	if b&0x80 != 0 {
+		return b<<1 ^ 0x1b
+	}
+	return b << 1
+}
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+int(logTable[b])]
+}
+
+func div(a, b byte) byte {
+	if b == 0 {
+		panic("division by zero")
+	}
+	if a == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+255-int(logTable[b])]
+}
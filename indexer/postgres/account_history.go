@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// HistoryCategory is the category of an entry of the history of an account.
+type HistoryCategory string
+
+const (
+	// HistoryCategoryTx is the category of the messages of the txs signed or sent by the account.
+	HistoryCategoryTx HistoryCategory = "tx"
+	// HistoryCategoryTransfer is the category of the transfers of coins from or to the account.
+	HistoryCategoryTransfer HistoryCategory = "transfer"
+	// HistoryCategoryStaking is the category of the delegations, unbondings and rewards of the account.
+	HistoryCategoryStaking HistoryCategory = "staking"
+	// HistoryCategoryOther is the category of the other events the account appears in.
+	HistoryCategoryOther HistoryCategory = "other"
+)
+
+// historyEventCategories are the categories of the event types of the
+// history of an account, the types which are not listed are HistoryCategoryOther.
+var historyEventCategories = map[string]HistoryCategory{
+	"message":                     HistoryCategoryTx,
+	"tx":                          HistoryCategoryTx,
+	"transfer":                    HistoryCategoryTransfer,
+	"coin_spent":                  HistoryCategoryTransfer,
+	"coin_received":               HistoryCategoryTransfer,
+	"coinbase":                    HistoryCategoryTransfer,
+	"burn":                        HistoryCategoryTransfer,
+	"delegate":                    HistoryCategoryStaking,
+	"unbond":                      HistoryCategoryStaking,
+	"redelegate":                  HistoryCategoryStaking,
+	"complete_unbonding":          HistoryCategoryStaking,
+	"complete_redelegation":       HistoryCategoryStaking,
+	"cancel_unbonding_delegation": HistoryCategoryStaking,
+	"withdraw_rewards":            HistoryCategoryStaking,
+	"withdraw_commission":         HistoryCategoryStaking,
+	"create_validator":            HistoryCategoryStaking,
+	"edit_validator":              HistoryCategoryStaking,
+}
+
+// AccountHistoryQuery selects the history of an account in a range of heights.
+type AccountHistoryQuery struct {
+	// Address is the address of the account, as it appears in the attributes of the events.
+	Address string
+	// FromHeight is the first height of the range, 0 starts from the first indexed block.
+	FromHeight uint64
+	// ToHeight is the last height of the range, 0 ends at the last indexed block.
+	ToHeight uint64
+}
+
+// AccountHistoryEntry is an event of the history of an account.
+type AccountHistoryEntry struct {
+	BlockNumber uint64 `json:"block_number"`
+	// BlockTime is the time of the block as indexed in its header, if any.
+	BlockTime string `json:"block_time,omitempty"`
+	// TxIndex is the index of the tx in the block, or -1 if the event was emitted outside of a tx.
+	TxIndex    int64           `json:"tx_index"`
+	MsgIndex   int64           `json:"msg_index"`
+	EventIndex int64           `json:"event_index"`
+	EventType  string          `json:"event_type"`
+	Category   HistoryCategory `json:"category"`
+	// Event is the data of the event, as indexed.
+	Event json.RawMessage `json:"event"`
+	// Tx is the data of the tx the event was emitted in, as indexed, if any.
+	Tx json.RawMessage `json:"tx,omitempty"`
+}
+
+// accountHistorySql selects the events having an attribute whose value is the
+// address, with the tx they were emitted in.
+const accountHistorySql = `SELECT e.block_number, COALESCE(b.header->>'time', ''), COALESCE(t.index_in_block, -1),
+    COALESCE(e.msg_index, 0), COALESCE(e.event_index, 0), e.type, e.data, t.data
+FROM event e
+    JOIN block b ON b.number = e.block_number
+    LEFT JOIN tx t ON t.id = e.tx_id
+WHERE e.block_number >= $2
+    AND ($3 = 0 OR e.block_number <= $3)
+    AND EXISTS (SELECT 1 FROM jsonb_array_elements(e.data->'attributes') a WHERE a->>'value' = $1)
+ORDER BY e.block_number, e.id;`
+
+// AccountHistory returns the txs, transfers and staking events affecting the
+// account of the query, read from the block, tx and event tables of the base
+// schema, ordered by height and by their order in the blocks.
+func AccountHistory(ctx context.Context, conn DBConn, query AccountHistoryQuery) ([]AccountHistoryEntry, error) {
+	if query.Address == "" {
+		return nil, errors.New("missing address")
+	}
+	if query.ToHeight != 0 && query.ToHeight < query.FromHeight {
+		return nil, fmt.Errorf("invalid height range: %d > %d", query.FromHeight, query.ToHeight)
+	}
+
+	rows, err := conn.QueryContext(ctx, accountHistorySql, query.Address, query.FromHeight, query.ToHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []AccountHistoryEntry
+	for rows.Next() {
+		var (
+			entry     AccountHistoryEntry
+			eventData []byte
+			txData    []byte
+		)
+		err := rows.Scan(&entry.BlockNumber, &entry.BlockTime, &entry.TxIndex, &entry.MsgIndex, &entry.EventIndex,
+			&entry.EventType, &eventData, &txData)
+		if err != nil {
+			return nil, err
+		}
+		entry.Category = historyCategory(entry.EventType)
+		entry.Event = eventData
+		if txData != nil {
+			entry.Tx = txData
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func historyCategory(eventType string) HistoryCategory {
+	if category, ok := historyEventCategories[eventType]; ok {
+		return category
+	}
+	return HistoryCategoryOther
+}
+
+// accountHistoryCSVHeader is the header of the CSV exports of the history of an account.
+var accountHistoryCSVHeader = []string{
+	"block_number", "block_time", "tx_index", "msg_index", "event_index", "event_type", "category", "event", "tx",
+}
+
+// WriteAccountHistoryCSV writes the entries as CSV, with a header row. The
+// event and tx columns contain their JSON data.
+func WriteAccountHistoryCSV(w io.Writer, entries []AccountHistoryEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(accountHistoryCSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		err := cw.Write([]string{
+			strconv.FormatUint(entry.BlockNumber, 10),
+			entry.BlockTime,
+			strconv.FormatInt(entry.TxIndex, 10),
+			strconv.FormatInt(entry.MsgIndex, 10),
+			strconv.FormatInt(entry.EventIndex, 10),
+			entry.EventType,
+			string(entry.Category),
+			string(entry.Event),
+			string(entry.Tx),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteAccountHistoryJSON writes the entries as a JSON array.
+func WriteAccountHistoryJSON(w io.Writer, entries []AccountHistoryEntry) error {
+	if entries == nil {
+		entries = []AccountHistoryEntry{}
+	}
+	return json.NewEncoder(w).Encode(entries)
+}
+
+// AccountHistoryHandler returns an http.Handler exporting the history of an
+// account from the database. It takes the query parameters address, from and
+// to for the height range, and format which is either csv or json (the default).
+func AccountHistoryHandler(db *sql.DB) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		query, format, err := parseAccountHistoryRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entries, err := AccountHistory(r.Context(), db, query)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if format == "csv" {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, query.Address))
+			_ = WriteAccountHistoryCSV(w, entries)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = WriteAccountHistoryJSON(w, entries)
+	})
+}
+
+func parseAccountHistoryRequest(r *http.Request) (query AccountHistoryQuery, format string, err error) {
+	params := r.URL.Query()
+
+	query.Address = strings.TrimSpace(params.Get("address"))
+	if query.Address == "" {
+		return query, "", errors.New("missing address")
+	}
+
+	if query.FromHeight, err = parseHeightParam(params.Get("from"), "from"); err != nil {
+		return query, "", err
+	}
+	if query.ToHeight, err = parseHeightParam(params.Get("to"), "to"); err != nil {
+		return query, "", err
+	}
+	if query.ToHeight != 0 && query.ToHeight < query.FromHeight {
+		return query, "", fmt.Errorf("invalid height range: %d > %d", query.FromHeight, query.ToHeight)
+	}
+
+	format = strings.ToLower(params.Get("format"))
+	switch format {
+	case "":
+		format = "json"
+	case "csv", "json":
+	default:
+		return query, "", fmt.Errorf("unknown format %q, expected csv or json", format)
+	}
+	return query, format, nil
+}
+
+func parseHeightParam(s, name string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	height, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s height %q", name, s)
+	}
+	return height, nil
+}
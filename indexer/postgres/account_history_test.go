@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testHistoryEntries = []AccountHistoryEntry{
+	{
+		BlockNumber: 2,
+		BlockTime:   "2024-01-02T15:04:05Z",
+		TxIndex:     0,
+		EventType:   "transfer",
+		Category:    HistoryCategoryTransfer,
+		Event:       []byte(`{"attributes":[{"key":"recipient","value":"cosmos1abc"}]}`),
+		Tx:          []byte(`{"hash":"AB"}`),
+	},
+	{
+		BlockNumber: 3,
+		TxIndex:     -1,
+		EventIndex:  4,
+		EventType:   "withdraw_rewards",
+		Category:    HistoryCategoryStaking,
+		Event:       []byte(`{"attributes":[]}`),
+	},
+}
+
+func TestWriteAccountHistoryCSV(t *testing.T) {
+	w := new(strings.Builder)
+	if err := WriteAccountHistoryCSV(w, testHistoryEntries); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `block_number,block_time,tx_index,msg_index,event_index,event_type,category,event,tx
+2,2024-01-02T15:04:05Z,0,0,0,transfer,transfer,"{""attributes"":[{""key"":""recipient"",""value"":""cosmos1abc""}]}","{""hash"":""AB""}"
+3,,-1,0,4,withdraw_rewards,staking,"{""attributes"":[]}",
+`
+	if w.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, w.String())
+	}
+}
+
+func TestWriteAccountHistoryJSON(t *testing.T) {
+	w := new(strings.Builder)
+	if err := WriteAccountHistoryJSON(w, testHistoryEntries); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `[{"block_number":2,"block_time":"2024-01-02T15:04:05Z","tx_index":0,"msg_index":0,"event_index":0,"event_type":"transfer","category":"transfer","event":{"attributes":[{"key":"recipient","value":"cosmos1abc"}]},"tx":{"hash":"AB"}},` +
+		`{"block_number":3,"tx_index":-1,"msg_index":0,"event_index":4,"event_type":"withdraw_rewards","category":"staking","event":{"attributes":[]}}]` + "\n"
+	if w.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, w.String())
+	}
+
+	w.Reset()
+	if err := WriteAccountHistoryJSON(w, nil); err != nil {
+		t.Fatal(err)
+	}
+	if w.String() != "[]\n" {
+		t.Fatalf("expected an empty array, got %s", w.String())
+	}
+}
+
+func TestHistoryCategory(t *testing.T) {
+	for eventType, expected := range map[string]HistoryCategory{
+		"message":          HistoryCategoryTx,
+		"coin_received":    HistoryCategoryTransfer,
+		"delegate":         HistoryCategoryStaking,
+		"withdraw_rewards": HistoryCategoryStaking,
+		"submit_proposal":  HistoryCategoryOther,
+	} {
+		if category := historyCategory(eventType); category != expected {
+			t.Errorf("expected category %s for %s, got %s", expected, eventType, category)
+		}
+	}
+}
+
+func TestParseAccountHistoryRequest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		url    string
+		query  AccountHistoryQuery
+		format string
+		err    string
+	}{
+		"defaults": {
+			url:    "/?address=cosmos1abc",
+			query:  AccountHistoryQuery{Address: "cosmos1abc"},
+			format: "json",
+		},
+		"range and csv": {
+			url:    "/?address=cosmos1abc&from=10&to=20&format=CSV",
+			query:  AccountHistoryQuery{Address: "cosmos1abc", FromHeight: 10, ToHeight: 20},
+			format: "csv",
+		},
+		"missing address": {
+			url: "/?from=10",
+			err: "missing address",
+		},
+		"invalid height": {
+			url: "/?address=cosmos1abc&to=-1",
+			err: `invalid to height "-1"`,
+		},
+		"invalid range": {
+			url: "/?address=cosmos1abc&from=20&to=10",
+			err: "invalid height range: 20 > 10",
+		},
+		"unknown format": {
+			url: "/?address=cosmos1abc&format=xml",
+			err: `unknown format "xml", expected csv or json`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			query, format, err := parseAccountHistoryRequest(httptest.NewRequest(http.MethodGet, tc.url, nil))
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(query, tc.query) || format != tc.format {
+				t.Fatalf("expected %+v and %s, got %+v and %s", tc.query, tc.format, query, format)
+			}
+		})
+	}
+}
+
+func TestAccountHistoryHandlerErrors(t *testing.T) {
+	handler := AccountHistoryHandler(nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/?address=cosmos1abc", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected status %d, got %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?format=csv", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	_, err := AccountHistory(context.Background(), nil, AccountHistoryQuery{Address: "cosmos1abc", FromHeight: 2, ToHeight: 1})
+	if err == nil || err.Error() != "invalid height range: 2 > 1" {
+		t.Fatalf("expected an invalid height range error, got %v", err)
+	}
+}
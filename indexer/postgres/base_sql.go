@@ -3,7 +3,8 @@ package postgres
 // BaseSQL is the base SQL that is always included in the schema.
 const BaseSQL = `
 CREATE OR REPLACE FUNCTION nanos_to_timestamptz(nanos bigint) RETURNS timestamptz AS $$
-    SELECT to_timestamp(nanos / 1000000000) + (nanos / 1000000000) * INTERVAL '1 microsecond'
+    SELECT to_timestamp(secs) + ((nanos - secs * 1000000000) / 1000) * INTERVAL '1 microsecond'
+    FROM (SELECT floor(nanos / 1000000000.0)::bigint AS secs) AS t
 $$ LANGUAGE SQL IMMUTABLE;
 
 CREATE TABLE IF NOT EXISTS block
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+const (
+	insertBlockSql = `INSERT INTO block (number, header) VALUES ($1, $2) ON CONFLICT (number) DO NOTHING;`
+	insertTxSql    = `INSERT INTO tx (block_number, index_in_block, data) VALUES ($1, $2, $3) RETURNING id;`
+	insertEventSql = `INSERT INTO event (block_number, tx_id, msg_index, event_index, type, data) VALUES ($1, $2, $3, $4, $5, $6);`
+)
+
+// blockIndexer indexes the blocks, txs and events in the block, tx and event
+// tables of the base schema.
+type blockIndexer struct {
+	options Options
+
+	height uint64
+	// txIDs are the ids of the txs of the current block by their index in it
+	txIDs map[int32]int64
+}
+
+func (b *blockIndexer) startBlock(ctx context.Context, conn DBConn, data appdata.StartBlockData) error {
+	b.height, b.txIDs = data.Height, map[int32]int64{}
+
+	var header interface{}
+	if data.HeaderJSON != nil {
+		bz, err := data.HeaderJSON()
+		if err != nil {
+			return err
+		}
+		if bz != nil {
+			header = string(bz)
+		}
+	}
+
+	b.log("Insert block", insertBlockSql, data.Height, header)
+	_, err := conn.ExecContext(ctx, insertBlockSql, data.Height, header)
+	return err
+}
+
+func (b *blockIndexer) onTx(ctx context.Context, conn DBConn, data appdata.TxData) error {
+	txJSON, err := toJSONParam(data.JSON)
+	if err != nil {
+		return err
+	}
+
+	// the tx data must not be null, so the raw bytes are stored if the tx has no
+	// JSON representation
+	if txJSON == "{}" && data.Bytes != nil {
+		bz, err := data.Bytes()
+		if err != nil {
+			return err
+		}
+		txJSON = fmt.Sprintf(`{"bytes":"%x"}`, bz)
+	}
+
+	b.log("Insert tx", insertTxSql, b.height, data.TxIndex, txJSON)
+	var id int64
+	err = conn.QueryRowContext(ctx, insertTxSql, b.height, data.TxIndex, txJSON).Scan(&id)
+	if err != nil {
+		return err
+	}
+	b.txIDs[data.TxIndex] = id
+	return nil
+}
+
+func (b *blockIndexer) onEvent(ctx context.Context, conn DBConn, data appdata.EventData) error {
+	eventJSON, err := toJSONParam(data.Data)
+	if err != nil {
+		return err
+	}
+
+	// the events emitted outside of a tx, or in a tx which was not indexed, have
+	// no tx id
+	var txID interface{}
+	if id, ok := b.txIDs[data.TxIndex]; ok && data.TxIndex >= 0 {
+		txID = id
+	}
+
+	params := []interface{}{b.height, txID, data.MsgIndex, data.EventIndex, data.Type, eventJSON}
+	b.log("Insert event", insertEventSql, params...)
+	_, err = conn.ExecContext(ctx, insertEventSql, params...)
+	return err
+}
+
+func (b *blockIndexer) log(msg, sqlStr string, params ...interface{}) {
+	if b.options.Logger != nil {
+		b.options.Logger(msg, sqlStr, params...)
+	}
+}
+
+// toJSONParam returns the JSON returned by f as a string parameter, or an empty
+// JSON object if there is none.
+func toJSONParam(f appdata.ToJSON) (string, error) {
+	if f == nil {
+		return "{}", nil
+	}
+	bz, err := f()
+	if err != nil {
+		return "", err
+	}
+	if len(bz) == 0 {
+		return "{}", nil
+	}
+	return string(bz), nil
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// commentSql writes the COMMENT ON statements describing the table and its columns
+// from the metadata of the object type, so that the database is self-documenting.
+// Columns without a description or any semantics beyond their type are not commented.
+func (tm *ObjectIndexer) commentSql(writer io.Writer) error {
+	tableComment := fmt.Sprintf("Object type %s of module %s.", tm.typ.Name, tm.moduleName)
+	if len(tm.typ.KeyFields) == 0 {
+		tableComment += " It is a singleton stored in a single row."
+	}
+	if tm.retainDeletions() {
+		tableComment += " Deleted objects are retained and flagged by the _deleted column."
+	}
+	tableComment = joinComment(tm.typ.Description, tableComment)
+
+	_, err := fmt.Fprintf(writer, "\nCOMMENT ON TABLE %q IS %s;", tm.TableName(), quoteLiteral(tableComment))
+	if err != nil {
+		return err
+	}
+
+	if len(tm.typ.KeyFields) == 0 {
+		err = tm.writeColumnComment(writer, "_id", "Id of the singleton row, always 1.")
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, fields := range [][]schema.Field{tm.typ.KeyFields, tm.typ.ValueFields} {
+		for _, field := range fields {
+			err = tm.writeFieldComments(writer, field)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, col := range tm.generatedColumns() {
+		err = tm.writeColumnComment(writer, col.Name, fmt.Sprintf("Generated as %s.", col.Expression))
+		if err != nil {
+			return err
+		}
+	}
+
+	if tm.retainDeletions() {
+		return tm.writeColumnComment(writer, "_deleted", "Whether the object was deleted.")
+	}
+	return nil
+}
+
+// writeFieldComments writes the comments of the columns of the field.
+func (tm *ObjectIndexer) writeFieldComments(writer io.Writer, field schema.Field) error {
+	switch field.Kind {
+	case schema.TimeKind:
+		nanosColName := fmt.Sprintf("%s_nanos", field.Name)
+		err := tm.writeColumnComment(writer, field.Name, joinComment(field.Description,
+			fmt.Sprintf("Generated from %s with microsecond precision.", nanosColName)))
+		if err != nil {
+			return err
+		}
+		return tm.writeColumnComment(writer, nanosColName, joinComment(field.Description,
+			"Time in nanoseconds since the Unix epoch."))
+	case schema.DurationKind:
+		return tm.writeColumnComment(writer, field.Name, joinComment(field.Description, "Duration in nanoseconds."))
+	case schema.AddressKind:
+		return tm.writeColumnComment(writer, field.Name, joinComment(field.Description,
+			"Address encoded as text by the address codec of the indexer."))
+	default:
+		return tm.writeColumnComment(writer, field.Name, field.Description)
+	}
+}
+
+func (tm *ObjectIndexer) writeColumnComment(writer io.Writer, column, comment string) error {
+	if comment == "" {
+		return nil
+	}
+	_, err := fmt.Fprintf(writer, "\nCOMMENT ON COLUMN %q.%q IS %s;", tm.TableName(), column, quoteLiteral(comment))
+	return err
+}
+
+// joinComment joins the description of a type or field with the description of its storage.
+func joinComment(description, storage string) string {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return storage
+	}
+	if !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+	return description + " " + storage
+}
+
+// quoteLiteral quotes s as a string literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -23,7 +23,8 @@ func (tm *ObjectIndexer) CreateTable(ctx context.Context, conn DBConn) error {
 	return err
 }
 
-// CreateTableSql generates a CREATE TABLE statement for the object type.
+// CreateTableSql generates a CREATE TABLE statement for the object type, followed by
+// the COMMENT ON statements describing the table and its columns.
 func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 	_, err := fmt.Fprintf(writer, "CREATE TABLE IF NOT EXISTS %q (\n\t", tm.TableName())
 	if err != nil {
@@ -52,6 +53,13 @@ func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 		}
 	}
 
+	for _, col := range tm.generatedColumns() {
+		err = tm.createGeneratedColumnDefinition(writer, col)
+		if err != nil {
+			return err
+		}
+	}
+
 	// add _deleted column when we have RetainDeletions set and enabled
 	if !tm.options.DisableRetainDeletions && tm.typ.RetainDeletions {
 		_, err = fmt.Fprintf(writer, "_deleted BOOLEAN NOT NULL DEFAULT FALSE,\n\t")
@@ -92,5 +100,5 @@ func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 		return err
 	}
 
-	return nil
+	return tm.commentSql(writer)
 }
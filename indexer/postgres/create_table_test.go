@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"os"
+	"strings"
+	"testing"
 
 	"cosmossdk.io/indexer/postgres/internal/testdata"
 	"cosmossdk.io/schema"
@@ -32,12 +34,19 @@ func ExampleObjectIndexer_CreateTableSql_allKinds() {
 	//	"duration" BIGINT NOT NULL,
 	//	"float32" REAL NOT NULL,
 	//	"float64" DOUBLE PRECISION NOT NULL,
-	//	"bech32address" TEXT NOT NULL,
+	//	"address" TEXT NOT NULL,
 	//	"enum" "test_my_enum" NOT NULL,
 	//	"json" JSONB NOT NULL,
 	//	PRIMARY KEY ("id", "ts_nanos")
 	// );
 	// GRANT SELECT ON TABLE "test_all_kinds" TO PUBLIC;
+	// COMMENT ON TABLE "test_all_kinds" IS 'Object type all_kinds of module test.';
+	// COMMENT ON COLUMN "test_all_kinds"."ts" IS 'Generated from ts_nanos with microsecond precision.';
+	// COMMENT ON COLUMN "test_all_kinds"."ts_nanos" IS 'Time in nanoseconds since the Unix epoch.';
+	// COMMENT ON COLUMN "test_all_kinds"."time" IS 'Generated from time_nanos with microsecond precision.';
+	// COMMENT ON COLUMN "test_all_kinds"."time_nanos" IS 'Time in nanoseconds since the Unix epoch.';
+	// COMMENT ON COLUMN "test_all_kinds"."duration" IS 'Duration in nanoseconds.';
+	// COMMENT ON COLUMN "test_all_kinds"."address" IS 'Address encoded as text by the address codec of the indexer.';
 }
 
 func ExampleObjectIndexer_CreateTableSql_singleton() {
@@ -51,6 +60,8 @@ func ExampleObjectIndexer_CreateTableSql_singleton() {
 	//	PRIMARY KEY (_id)
 	// );
 	// GRANT SELECT ON TABLE "test_singleton" TO PUBLIC;
+	// COMMENT ON TABLE "test_singleton" IS 'Object type singleton of module test. It is a singleton stored in a single row.';
+	// COMMENT ON COLUMN "test_singleton"."_id" IS 'Id of the singleton row, always 1.';
 }
 
 func ExampleObjectIndexer_CreateTableSql_vote() {
@@ -64,6 +75,10 @@ func ExampleObjectIndexer_CreateTableSql_vote() {
 	// 	PRIMARY KEY ("proposal", "address")
 	// );
 	// GRANT SELECT ON TABLE "test_vote" TO PUBLIC;
+	// COMMENT ON TABLE "test_vote" IS 'The votes of the accounts on governance proposals. Object type vote of module test. Deleted objects are retained and flagged by the _deleted column.';
+	// COMMENT ON COLUMN "test_vote"."address" IS 'The address of the voter. Address encoded as text by the address codec of the indexer.';
+	// COMMENT ON COLUMN "test_vote"."vote" IS 'The option the voter can''t change once voted.';
+	// COMMENT ON COLUMN "test_vote"."_deleted" IS 'Whether the object was deleted.';
 }
 
 func ExampleObjectIndexer_CreateTableSql_vote_no_retain_delete() {
@@ -76,6 +91,63 @@ func ExampleObjectIndexer_CreateTableSql_vote_no_retain_delete() {
 	//	PRIMARY KEY ("proposal", "address")
 	// );
 	// GRANT SELECT ON TABLE "test_vote" TO PUBLIC;
+	// COMMENT ON TABLE "test_vote" IS 'The votes of the accounts on governance proposals. Object type vote of module test.';
+	// COMMENT ON COLUMN "test_vote"."address" IS 'The address of the voter. Address encoded as text by the address codec of the indexer.';
+	// COMMENT ON COLUMN "test_vote"."vote" IS 'The option the voter can''t change once voted.';
+}
+
+func ExampleObjectIndexer_CreateTableSql_generatedColumns() {
+	balance := schema.ObjectType{
+		Name: "balance",
+		KeyFields: []schema.Field{
+			{Name: "address", Kind: schema.StringKind},
+			{Name: "denom", Kind: schema.StringKind},
+		},
+		ValueFields: []schema.Field{
+			{Name: "amount", Kind: schema.IntegerStringKind},
+		},
+	}
+	tm := NewObjectIndexer("bank", balance, Options{
+		GeneratedColumns: map[string][]GeneratedColumn{
+			"bank_balance": {
+				DisplayAmountColumn("display_amount", "amount", 6),
+				LowercaseColumn("address_lower", "address"),
+			},
+		},
+	})
+	err := tm.CreateTableSql(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "bank_balance" (
+	// 	"address" TEXT NOT NULL,
+	// 	"denom" TEXT NOT NULL,
+	// 	"amount" NUMERIC NOT NULL,
+	// 	"display_amount" NUMERIC GENERATED ALWAYS AS ("amount"::NUMERIC / 10::NUMERIC ^ 6) STORED,
+	// 	"address_lower" TEXT GENERATED ALWAYS AS (lower("address")) STORED,
+	// 	PRIMARY KEY ("address", "denom")
+	// );
+	// GRANT SELECT ON TABLE "bank_balance" TO PUBLIC;
+	// COMMENT ON TABLE "bank_balance" IS 'Object type balance of module bank.';
+	// COMMENT ON COLUMN "bank_balance"."display_amount" IS 'Generated as "amount"::NUMERIC / 10::NUMERIC ^ 6.';
+	// COMMENT ON COLUMN "bank_balance"."address_lower" IS 'Generated as lower("address").';
+}
+
+func TestCreateTableSqlInvalidGeneratedColumns(t *testing.T) {
+	for name, col := range map[string]GeneratedColumn{
+		"clashing name":      LowercaseColumn("vote", "vote"),
+		"missing expression": {Name: "foo", Type: "TEXT"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tm := NewObjectIndexer("test", testdata.VoteObject, Options{
+				GeneratedColumns: map[string][]GeneratedColumn{"test_vote": {col}},
+			})
+			if err := tm.CreateTableSql(new(strings.Builder)); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
 }
 
 func exampleCreateTable(objectType schema.ObjectType) {
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Delete deletes the row with the provided key, or marks it as deleted when deletions are retained.
+func (tm *ObjectIndexer) Delete(ctx context.Context, conn DBConn, key interface{}) error {
+	keyCols, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return err
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.deleteSql(buf, keyCols, keyParams)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	tm.log("Delete", sqlStr, params...)
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// deleteSql generates a DELETE statement, or an UPDATE marking the row as deleted when
+// deletions are retained, and the parameters for the row with the provided key.
+func (tm *ObjectIndexer) deleteSql(w io.Writer, keyCols []string, keyParams []interface{}) ([]interface{}, error) {
+	var err error
+	if tm.retainDeletions() {
+		_, err = fmt.Fprintf(w, "UPDATE %q SET _deleted = TRUE", tm.TableName())
+	} else {
+		_, err = fmt.Fprintf(w, "DELETE FROM %q", tm.TableName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSql(w, keyCols, keyParams, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
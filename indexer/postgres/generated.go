@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"fmt"
+	"io"
+)
+
+// GeneratedColumn declares a column computed by PostgreSQL from the other columns of a table,
+// so that common query patterns, such as displaying amounts in their display denomination, don't
+// require transforming the indexed values in the application.
+type GeneratedColumn struct {
+	// Name is the name of the column. It must not clash with the name of a field of the object type.
+	Name string `json:"name"`
+
+	// Type is the PostgreSQL type of the column, e.g. NUMERIC or TEXT.
+	Type string `json:"type"`
+
+	// Expression is the SQL expression computing the column from the other columns of the table.
+	// It must be immutable, as required by PostgreSQL for generated columns, and the names of the
+	// columns it references should be double-quoted.
+	Expression string `json:"expression"`
+}
+
+// DisplayAmountColumn returns a generated column dividing the amount column by 10^exponent, e.g.
+// to display an amount of uatom in atom.
+func DisplayAmountColumn(name, amountColumn string, exponent uint32) GeneratedColumn {
+	return GeneratedColumn{
+		Name:       name,
+		Type:       "NUMERIC",
+		Expression: fmt.Sprintf("%q::NUMERIC / 10::NUMERIC ^ %d", amountColumn, exponent),
+	}
+}
+
+// LowercaseColumn returns a generated column converting the text column to lowercase, e.g. to
+// query addresses case-insensitively.
+func LowercaseColumn(name, column string) GeneratedColumn {
+	return GeneratedColumn{
+		Name:       name,
+		Type:       "TEXT",
+		Expression: fmt.Sprintf("lower(%q)", column),
+	}
+}
+
+// generatedColumns returns the generated columns of the table of the object type.
+func (tm *ObjectIndexer) generatedColumns() []GeneratedColumn {
+	return tm.options.GeneratedColumns[tm.TableName()]
+}
+
+// createGeneratedColumnDefinition writes a generated column definition within a CREATE TABLE statement.
+func (tm *ObjectIndexer) createGeneratedColumnDefinition(writer io.Writer, col GeneratedColumn) error {
+	if col.Name == "" || col.Type == "" || col.Expression == "" {
+		return fmt.Errorf("generated column %q of table %s must have a name, type and expression", col.Name, tm.TableName())
+	}
+	if _, ok := tm.allFields[col.Name]; ok {
+		return fmt.Errorf("generated column %q of table %s clashes with a field of the same name", col.Name, tm.TableName())
+	}
+
+	_, err := fmt.Fprintf(writer, "%q %s GENERATED ALWAYS AS (%s) STORED,\n\t", col.Name, col.Type, col.Expression)
+	return err
+}
@@ -18,6 +18,16 @@ type Config struct {
 
 	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
 	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+
+	// AddressCodec is the codec used to store address fields as text. It defaults to hex encoding.
+	AddressCodec AddressCodec `json:"-"`
+
+	// GeneratedColumns are the generated columns added to the tables, by table name, e.g. bank_balances.
+	GeneratedColumns map[string][]GeneratedColumn `json:"generated_columns"`
+
+	// Migrations are the renames of object types and fields across an upgrade, by module name, applied
+	// to the tables and columns of the module when it is initialized. See Migration.
+	Migrations map[string]Migration `json:"migrations"`
 }
 
 type SqlLogger = func(msg, sql string, params ...interface{})
@@ -52,9 +62,23 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 	opts := Options{
 		DisableRetainDeletions: config.DisableRetainDeletions,
 		Logger:                 logger,
+		AddressCodec:           config.AddressCodec,
+		GeneratedColumns:       config.GeneratedColumns,
+		Migrations:             config.Migrations,
 	}
 
+	blocks := &blockIndexer{options: opts}
+
 	return appdata.Listener{
+		StartBlock: func(data appdata.StartBlockData) error {
+			return blocks.startBlock(ctx, tx, data)
+		},
+		OnTx: func(data appdata.TxData) error {
+			return blocks.onTx(ctx, tx, data)
+		},
+		OnEvent: func(data appdata.EventData) error {
+			return blocks.onEvent(ctx, tx, data)
+		},
 		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
 			moduleName := data.ModuleName
 			modSchema := data.Schema
@@ -68,13 +92,37 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 
 			return mm.InitializeSchema(ctx, tx)
 		},
-		Commit: func(data appdata.CommitData) error {
-			err = tx.Commit()
-			if err != nil {
-				return err
+		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+			mm, ok := moduleIndexers[data.ModuleName]
+			if !ok {
+				return fmt.Errorf("module %s not initialized", data.ModuleName)
 			}
 
+			for _, update := range data.Updates {
+				tm, ok := mm.tables[update.TypeName]
+				if !ok {
+					return fmt.Errorf("object type %s not found in schema for module %s", update.TypeName, data.ModuleName)
+				}
+
+				var err error
+				if update.Delete {
+					err = tm.Delete(ctx, tx, update.Key)
+				} else {
+					err = tm.InsertUpdate(ctx, tx, update.Key, update.Value)
+				}
+				if err != nil {
+					return fmt.Errorf("failed to index update of %s in module %s: %v", update.TypeName, data.ModuleName, err) //nolint:errorlint // using %v for go 1.12 compat
+				}
+			}
+			return nil
+		},
+		Commit: func(data appdata.CommitData) error {
+			// a failed commit rolls back the transaction, so a new one is started in any case
+			commitErr := tx.Commit()
 			tx, err = db.BeginTx(ctx, nil)
+			if commitErr != nil {
+				return commitErr
+			}
 			return err
 		},
 	}, nil
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// InsertUpdate inserts or updates the row with the provided key and value.
+func (tm *ObjectIndexer) InsertUpdate(ctx context.Context, conn DBConn, key, value interface{}) error {
+	keyCols, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return err
+	}
+
+	valueCols, valueParams, err := tm.bindValueParams(value)
+	if err != nil {
+		return err
+	}
+
+	// an update of the value fields only may not set all of them, so the row
+	// is updated if it exists before inserting it
+	if len(valueCols) > 0 || tm.retainDeletions() {
+		buf := new(strings.Builder)
+		params, err := tm.updateSql(buf, keyCols, keyParams, valueCols, valueParams)
+		if err != nil {
+			return err
+		}
+
+		sqlStr := buf.String()
+		tm.log("Update", sqlStr, params...)
+		res, err := conn.ExecContext(ctx, sqlStr, params...)
+		if err != nil {
+			return err
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows > 0 {
+			return nil
+		}
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.insertSql(buf, keyCols, keyParams, valueCols, valueParams)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	tm.log("Insert", sqlStr, params...)
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// insertSql generates an INSERT statement and the parameters for the provided columns.
+// Inserting an existing row does nothing, which only happens for objects without value fields.
+func (tm *ObjectIndexer) insertSql(w io.Writer, keyCols []string, keyParams []interface{}, valueCols []string, valueParams []interface{}) ([]interface{}, error) {
+	cols := append(append([]string{}, keyCols...), valueCols...)
+	params := append(append([]interface{}{}, keyParams...), valueParams...)
+
+	paramBindings := make([]string, len(cols))
+	for i := range cols {
+		paramBindings[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	_, err := fmt.Fprintf(w, "INSERT INTO %q (%s) VALUES (%s) ON CONFLICT DO NOTHING;",
+		tm.TableName(),
+		strings.Join(cols, ", "),
+		strings.Join(paramBindings, ", "),
+	)
+	return params, err
+}
+
+// updateSql generates an UPDATE statement and the parameters setting the value columns of
+// the row with the provided key, marking the row as not deleted when deletions are retained.
+func (tm *ObjectIndexer) updateSql(w io.Writer, keyCols []string, keyParams []interface{}, valueCols []string, valueParams []interface{}) ([]interface{}, error) {
+	_, err := fmt.Fprintf(w, "UPDATE %q SET ", tm.TableName())
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]string, 0, len(valueCols)+1)
+	for i, col := range valueCols {
+		sets = append(sets, fmt.Sprintf("%s = $%d", col, i+1))
+	}
+	if tm.retainDeletions() {
+		sets = append(sets, "_deleted = FALSE")
+	}
+	_, err = fmt.Fprintf(w, "%s", strings.Join(sets, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	params := append([]interface{}{}, valueParams...)
+	params, err = tm.whereSql(w, keyCols, keyParams, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
+
+// retainDeletions returns true if the deleted rows are retained and marked as deleted.
+func (tm *ObjectIndexer) retainDeletions() bool {
+	return !tm.options.DisableRetainDeletions && tm.typ.RetainDeletions
+}
+
+// log logs a statement with the logger of the indexer, if any.
+func (tm *ObjectIndexer) log(action, sqlStr string, params ...interface{}) {
+	if tm.options.Logger != nil {
+		tm.options.Logger(fmt.Sprintf("%s %s", action, tm.TableName()), sqlStr, params...)
+	}
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+)
+
+func TestObjectIndexerSql(t *testing.T) {
+	vote := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	singleton := NewObjectIndexer("test", testdata.SingletonObject, Options{})
+	noRetainDelete := NewObjectIndexer("test", testdata.VoteObject, Options{DisableRetainDeletions: true})
+
+	voteKey := []interface{}{int64(1), []byte{0xab, 0xcd}}
+
+	for name, tc := range map[string]struct {
+		statement func(w *strings.Builder) ([]interface{}, error)
+		sql       string
+		params    []interface{}
+	}{
+		"insert": {
+			statement: func(w *strings.Builder) ([]interface{}, error) {
+				return sqlWithParams(vote, voteKey, "yes", vote.insertSql, w)
+			},
+			sql:    `INSERT INTO "test_vote" ("proposal", "address", "vote") VALUES ($1, $2, $3) ON CONFLICT DO NOTHING;`,
+			params: []interface{}{int64(1), "abcd", "yes"},
+		},
+		"update": {
+			statement: func(w *strings.Builder) ([]interface{}, error) {
+				return sqlWithParams(vote, voteKey, "no", vote.updateSql, w)
+			},
+			sql:    `UPDATE "test_vote" SET "vote" = $1, _deleted = FALSE WHERE "proposal" = $2 AND "address" = $3;`,
+			params: []interface{}{"no", int64(1), "abcd"},
+		},
+		"update singleton fields": {
+			statement: func(w *strings.Builder) ([]interface{}, error) {
+				return sqlWithParams(singleton, nil, schema.MapValueUpdates{"bar": nil, "foo": "x"}, singleton.updateSql, w)
+			},
+			sql:    `UPDATE "test_singleton" SET "bar" = $1, "foo" = $2 WHERE _id = $3;`,
+			params: []interface{}{nil, "x", 1},
+		},
+		"delete": {
+			statement: func(w *strings.Builder) ([]interface{}, error) {
+				keyCols, keyParams, err := vote.bindKeyParams(voteKey)
+				if err != nil {
+					return nil, err
+				}
+				return vote.deleteSql(w, keyCols, keyParams)
+			},
+			sql:    `UPDATE "test_vote" SET _deleted = TRUE WHERE "proposal" = $1 AND "address" = $2;`,
+			params: []interface{}{int64(1), "abcd"},
+		},
+		"delete without retaining deletions": {
+			statement: func(w *strings.Builder) ([]interface{}, error) {
+				keyCols, keyParams, err := noRetainDelete.bindKeyParams(voteKey)
+				if err != nil {
+					return nil, err
+				}
+				return noRetainDelete.deleteSql(w, keyCols, keyParams)
+			},
+			sql:    `DELETE FROM "test_vote" WHERE "proposal" = $1 AND "address" = $2;`,
+			params: []interface{}{int64(1), "abcd"},
+		},
+		"select": {
+			statement: func(w *strings.Builder) ([]interface{}, error) {
+				keyCols, keyParams, err := vote.bindKeyParams(voteKey)
+				if err != nil {
+					return nil, err
+				}
+				return vote.selectSql(w, []string{`"vote"`}, keyCols, keyParams)
+			},
+			sql:    `SELECT "vote" FROM "test_vote" WHERE "proposal" = $1 AND "address" = $2 AND NOT _deleted;`,
+			params: []interface{}{int64(1), "abcd"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf := new(strings.Builder)
+			params, err := tc.statement(buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tc.sql {
+				t.Fatalf("expected sql %s, got %s", tc.sql, buf.String())
+			}
+			if len(params) != len(tc.params) {
+				t.Fatalf("expected params %v, got %v", tc.params, params)
+			}
+			for i := range params {
+				if !equalParams(params[i], tc.params[i]) {
+					t.Fatalf("expected params %v, got %v", tc.params, params)
+				}
+			}
+		})
+	}
+}
+
+func TestBindParam(t *testing.T) {
+	tm := NewObjectIndexer("test", testdata.AllKindsObject, Options{})
+	ts := time.Unix(0, -1500)
+	bigInt, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+
+	for _, tc := range []struct {
+		kind     schema.Kind
+		value    interface{}
+		param    interface{}
+		read     interface{}
+		nullable bool
+	}{
+		{kind: schema.Uint64Kind, value: uint64(1<<64 - 1), param: "18446744073709551615"},
+		{kind: schema.Int8Kind, value: int8(-8), param: int64(-8)},
+		{kind: schema.Float32Kind, value: float32(1.5), param: float64(1.5)},
+		{kind: schema.TimeKind, value: ts, param: int64(-1500)},
+		{kind: schema.DurationKind, value: time.Duration(-3), param: int64(-3)},
+		{kind: schema.BytesKind, value: []byte(nil), param: []byte{}},
+		{kind: schema.AddressKind, value: []byte{0x01, 0xff}, param: "01ff"},
+		{kind: schema.StringKind, value: nil, param: nil, nullable: true},
+		{kind: schema.IntegerStringKind, value: "-42", param: "-42"},
+		{kind: schema.IntegerStringKind, value: bigInt, param: "-123456789012345678901234567890", read: "-123456789012345678901234567890"},
+		{kind: schema.IntegerStringKind, value: testInt{bigInt}, param: "-123456789012345678901234567890", read: "-123456789012345678901234567890"},
+		{kind: schema.DecimalStringKind, value: "1.5e-3", param: "1.5e-3"},
+		{kind: schema.DecimalStringKind, value: big.NewFloat(0.25), param: "0.25", read: "0.25"},
+		{kind: schema.DecimalStringKind, value: testDec{big.NewInt(1500000000000000000)}, param: "1.500000000000000000", read: "1.500000000000000000"},
+	} {
+		field := schema.Field{Name: "field", Kind: tc.kind, Nullable: tc.nullable}
+		param, err := tm.bindParam(field, tc.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalParams(param, tc.param) {
+			t.Fatalf("%s: expected param %v (%T), got %v (%T)", tc.kind, tc.param, tc.param, param, param)
+		}
+
+		// the stored params are read back as the original values
+		if tc.value == nil {
+			continue
+		}
+		reader := tm.newColumnReader(field)
+		setDest(t, reader.dest, param)
+		value, err := reader.value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tc.read != nil {
+			tc.value = tc.read
+		}
+		if !equalParams(value, tc.value) && !(tc.kind == schema.BytesKind && len(value.([]byte)) == 0) {
+			t.Fatalf("%s: expected value %v, got %v", tc.kind, tc.value, value)
+		}
+	}
+
+	_, err := tm.bindParam(schema.Field{Name: "field", Kind: schema.StringKind}, nil)
+	if err == nil {
+		t.Fatal("expected error for null value of non-nullable field")
+	}
+
+	for _, tc := range []struct {
+		kind  schema.Kind
+		value interface{}
+	}{
+		{kind: schema.IntegerStringKind, value: "1.5"},
+		{kind: schema.IntegerStringKind, value: int64(1)},
+		{kind: schema.IntegerStringKind, value: (*big.Int)(nil)},
+		{kind: schema.IntegerStringKind, value: testInt{}},
+		{kind: schema.IntegerStringKind, value: big.NewFloat(1)},
+		{kind: schema.IntegerStringKind, value: testDec{big.NewInt(2000000000000000000)}},
+		{kind: schema.DecimalStringKind, value: testInt{bigInt}},
+		{kind: schema.DecimalStringKind, value: "abc"},
+	} {
+		_, err := tm.bindParam(schema.Field{Name: "field", Kind: tc.kind}, tc.value)
+		if err == nil {
+			t.Fatalf("%s: expected error for value %v (%T)", tc.kind, tc.value, tc.value)
+		}
+	}
+}
+
+// testInt is an arbitrary-precision integer like math.Int.
+type testInt struct {
+	i *big.Int
+}
+
+func (i testInt) BigInt() *big.Int {
+	if i.i == nil {
+		return nil
+	}
+	return new(big.Int).Set(i.i)
+}
+
+// testDec is a decimal with 18 decimal places like math.LegacyDec, whose BigInt
+// is scaled.
+type testDec struct {
+	i *big.Int
+}
+
+func (d testDec) BigInt() *big.Int {
+	return new(big.Int).Set(d.i)
+}
+
+func (d testDec) String() string {
+	quo, rem := new(big.Int).QuoRem(d.i, big.NewInt(1e18), new(big.Int))
+	return fmt.Sprintf("%s.%018s", quo, rem)
+}
+
+func sqlWithParams(
+	tm *ObjectIndexer,
+	key, value interface{},
+	statement func(w io.Writer, keyCols []string, keyParams []interface{}, valueCols []string, valueParams []interface{}) ([]interface{}, error),
+	w *strings.Builder,
+) ([]interface{}, error) {
+	keyCols, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+	valueCols, valueParams, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+	return statement(w, keyCols, keyParams, valueCols, valueParams)
+}
+
+// setDest sets the scan destination of a column reader as the database driver would.
+func setDest(t *testing.T, dest, param interface{}) {
+	t.Helper()
+	var err error
+	switch dest := dest.(type) {
+	case *sql.NullInt64:
+		err = dest.Scan(param)
+	case *sql.NullFloat64:
+		err = dest.Scan(param)
+	case *sql.NullString:
+		err = dest.Scan(param)
+	case *[]byte:
+		*dest = param.([]byte)
+	default:
+		t.Fatalf("unexpected dest %T", dest)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func equalParams(a, b interface{}) bool {
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		return ok && ta.Equal(tb)
+	}
+	return reflect.DeepEqual(a, b)
+}
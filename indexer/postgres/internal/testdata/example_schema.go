@@ -79,14 +79,16 @@ var VoteObject = schema.ObjectType{
 			Kind: schema.Int64Kind,
 		},
 		{
-			Name: "address",
-			Kind: schema.AddressKind,
+			Name:        "address",
+			Kind:        schema.AddressKind,
+			Description: "The address of the voter",
 		},
 	},
 	ValueFields: []schema.Field{
 		{
-			Name: "vote",
-			Kind: schema.EnumKind,
+			Name:        "vote",
+			Description: "The option the voter can't change once voted.",
+			Kind:        schema.EnumKind,
 			EnumType: schema.EnumType{
 				Name:   "vote_type",
 				Values: []string{"yes", "no", "abstain"},
@@ -94,6 +96,7 @@ var VoteObject = schema.ObjectType{
 		},
 	},
 	RetainDeletions: true,
+	Description:     "The votes of the accounts on governance proposals.",
 }
 
 var MyEnum = schema.EnumType{
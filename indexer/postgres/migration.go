@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// Migration maps the names of the object types and fields of a module before an upgrade to their
+// new names, so that the tables and columns of the renamed object types and fields are renamed
+// instead of new empty tables and columns being created, orphaning the indexed data.
+type Migration struct {
+	// ObjectTypes maps the old names of the renamed object types to their new names.
+	ObjectTypes map[string]string `json:"object_types"`
+
+	// Fields maps the old names of the renamed fields to their new names, by the new name of
+	// their object type.
+	Fields map[string]map[string]string `json:"fields"`
+}
+
+// ExistsFunc returns whether the column of the table exists, or the table itself if column is empty.
+type ExistsFunc = func(table, column string) (bool, error)
+
+// MigrateSchema renames the tables and columns of the object types and fields renamed by the
+// migration of the module, if any. Tables and columns already renamed, e.g. on a restart, are
+// skipped. It is called by InitializeSchema before the missing tables are created.
+func (m *ModuleIndexer) MigrateSchema(ctx context.Context, conn DBConn) error {
+	buf := new(strings.Builder)
+	err := m.MigrateSchemaSql(buf, func(table, column string) (bool, error) {
+		return relationExists(ctx, conn, table, column)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to migrate the schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	sqlStr := buf.String()
+	if sqlStr == "" {
+		return nil
+	}
+	if m.options.Logger != nil {
+		m.options.Logger(fmt.Sprintf("Migrating schema of module %s", m.moduleName), sqlStr)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr)
+	return err
+}
+
+// MigrateSchemaSql generates the ALTER TABLE statements renaming the tables and columns of the
+// object types and fields renamed by the migration of the module, given the existing tables and
+// columns.
+func (m *ModuleIndexer) MigrateSchemaSql(writer io.Writer, exists ExistsFunc) error {
+	migration, ok := m.options.Migrations[m.moduleName]
+	if !ok {
+		return nil
+	}
+
+	oldTypeNames := make(map[string]string, len(migration.ObjectTypes))
+	for _, oldName := range sortedKeys(migration.ObjectTypes) {
+		newName := migration.ObjectTypes[oldName]
+		if _, ok := m.schema.LookupType(oldName); ok {
+			return fmt.Errorf("object type %q renamed to %q still exists", oldName, newName)
+		}
+		if !m.isObjectType(newName) {
+			return fmt.Errorf("object type %q renamed from %q not found", newName, oldName)
+		}
+		if _, ok := oldTypeNames[newName]; ok {
+			return fmt.Errorf("object type %q renamed from several object types", newName)
+		}
+		oldTypeNames[newName] = oldName
+	}
+	for typeName := range migration.Fields {
+		if !m.isObjectType(typeName) {
+			return fmt.Errorf("object type %q of the renamed fields not found", typeName)
+		}
+	}
+
+	var err error
+	m.schema.ObjectTypes(func(typ schema.ObjectType) bool {
+		err = m.migrateObjectTypeSql(writer, typ, oldTypeNames[typ.Name], migration.Fields[typ.Name], exists)
+		return err == nil
+	})
+	return err
+}
+
+// migrateObjectTypeSql writes the statements renaming the table of the object type from its old
+// name, if any, and its renamed columns.
+func (m *ModuleIndexer) migrateObjectTypeSql(writer io.Writer, typ schema.ObjectType, oldName string, fields map[string]string, exists ExistsFunc) error {
+	tm := NewObjectIndexer(m.moduleName, typ, m.options)
+	table := tm.TableName()
+
+	// the existing columns are looked up in the table before it is renamed
+	currentTable := table
+	if oldName != "" {
+		oldTable := NewObjectIndexer(m.moduleName, schema.ObjectType{Name: oldName}, m.options).TableName()
+		rename, err := needsRename(exists, oldTable, table, "", "")
+		if err != nil {
+			return err
+		}
+		if rename {
+			currentTable = oldTable
+			_, err = fmt.Fprintf(writer, "ALTER TABLE %q RENAME TO %q;\n", oldTable, table)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, oldField := range sortedKeys(fields) {
+		newField := fields[oldField]
+		field, ok := tm.allFields[newField]
+		if !ok {
+			return fmt.Errorf("field %q of object type %q renamed from %q not found", newField, typ.Name, oldField)
+		}
+		if _, ok := tm.allFields[oldField]; ok {
+			return fmt.Errorf("field %q of object type %q renamed to %q still exists", oldField, typ.Name, newField)
+		}
+
+		// time fields are stored in the _nanos column, see updatableColumnName
+		oldCol, newCol := oldField, newField
+		if field.Kind == schema.TimeKind {
+			oldCol, newCol = oldCol+"_nanos", newCol+"_nanos"
+		}
+		rename, err := needsRename(exists, currentTable, currentTable, oldCol, newCol)
+		if err != nil {
+			return err
+		}
+		if !rename {
+			continue
+		}
+
+		renames := [][2]string{{oldCol, newCol}}
+		if field.Kind == schema.TimeKind {
+			// the generated TIMESTAMPTZ column is renamed along with the _nanos column
+			renames = append(renames, [2]string{oldField, newField})
+		}
+		for _, r := range renames {
+			_, err = fmt.Fprintf(writer, "ALTER TABLE %q RENAME COLUMN %q TO %q;\n", table, r[0], r[1])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// needsRename returns whether the old table or column must be renamed, i.e. it exists while the
+// new one doesn't. Both existing is an error, as the data of the old one would be orphaned.
+func needsRename(exists ExistsFunc, oldTable, newTable, oldCol, newCol string) (bool, error) {
+	oldExists, err := exists(oldTable, oldCol)
+	if err != nil || !oldExists {
+		return false, err
+	}
+	newExists, err := exists(newTable, newCol)
+	if err != nil {
+		return false, err
+	}
+	if newExists {
+		if oldCol == "" {
+			return false, fmt.Errorf("cannot rename table %q to %q which already exists", oldTable, newTable)
+		}
+		return false, fmt.Errorf("cannot rename column %q of table %q to %q which already exists", oldCol, oldTable, newCol)
+	}
+	return true, nil
+}
+
+// relationExists returns whether the column of the table exists in the current schema, or the
+// table itself if column is empty.
+func relationExists(ctx context.Context, conn DBConn, table, column string) (bool, error) {
+	var row *sql.Row
+	if column == "" {
+		row = conn.QueryRowContext(ctx,
+			"SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1", table)
+	} else {
+		row = conn.QueryRowContext(ctx,
+			"SELECT 1 FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2", table, column)
+	}
+
+	var res interface{}
+	if err := row.Scan(&res); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check if %q exists: %v", strings.TrimSuffix(table+"."+column, "."), err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+	return true, nil
+}
+
+func (m *ModuleIndexer) isObjectType(name string) bool {
+	typ, ok := m.schema.LookupType(name)
+	if !ok {
+		return false
+	}
+	_, ok = typ.(schema.ObjectType)
+	return ok
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+)
+
+// existing returns an ExistsFunc of the given tables and columns, as table or table.column.
+func existing(relations ...string) ExistsFunc {
+	return func(table, column string) (bool, error) {
+		name := table
+		if column != "" {
+			name += "." + column
+		}
+		for _, r := range relations {
+			if r == name {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func ExampleModuleIndexer_MigrateSchemaSql() {
+	m := NewModuleIndexer("test", testdata.ExampleSchema, Options{
+		Migrations: map[string]Migration{
+			"test": {
+				ObjectTypes: map[string]string{"ballot": "vote", "kinds": "all_kinds"},
+				Fields: map[string]map[string]string{
+					"vote":      {"voter": "address"},
+					"all_kinds": {"timestamp": "time", "text": "string"},
+				},
+			},
+		},
+	})
+
+	// the kinds table was already renamed, but not its columns
+	err := m.MigrateSchemaSql(os.Stdout, existing(
+		"test_ballot", "test_ballot.voter",
+		"test_all_kinds", "test_all_kinds.timestamp_nanos", "test_all_kinds.text",
+	))
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// ALTER TABLE "test_all_kinds" RENAME COLUMN "text" TO "string";
+	// ALTER TABLE "test_all_kinds" RENAME COLUMN "timestamp_nanos" TO "time_nanos";
+	// ALTER TABLE "test_all_kinds" RENAME COLUMN "timestamp" TO "time";
+	// ALTER TABLE "test_ballot" RENAME TO "test_vote";
+	// ALTER TABLE "test_vote" RENAME COLUMN "voter" TO "address";
+}
+
+func TestMigrateSchemaSql(t *testing.T) {
+	migrated := existing("test_vote", "test_vote.address")
+
+	for name, tc := range map[string]struct {
+		migration Migration
+		exists    ExistsFunc
+		err       string
+	}{
+		"already migrated": {
+			migration: Migration{
+				ObjectTypes: map[string]string{"ballot": "vote"},
+				Fields:      map[string]map[string]string{"vote": {"voter": "address"}},
+			},
+			exists: migrated,
+		},
+		"unknown object type": {
+			migration: Migration{ObjectTypes: map[string]string{"ballot": "votes"}},
+			exists:    migrated,
+			err:       `object type "votes" renamed from "ballot" not found`,
+		},
+		"old object type still exists": {
+			migration: Migration{ObjectTypes: map[string]string{"singleton": "vote"}},
+			exists:    migrated,
+			err:       `object type "singleton" renamed to "vote" still exists`,
+		},
+		"enum type": {
+			migration: Migration{ObjectTypes: map[string]string{"ballot": "my_enum"}},
+			exists:    migrated,
+			err:       `object type "my_enum" renamed from "ballot" not found`,
+		},
+		"unknown object type of fields": {
+			migration: Migration{Fields: map[string]map[string]string{"ballot": {"voter": "address"}}},
+			exists:    migrated,
+			err:       `object type "ballot" of the renamed fields not found`,
+		},
+		"unknown field": {
+			migration: Migration{Fields: map[string]map[string]string{"vote": {"voter": "addr"}}},
+			exists:    migrated,
+			err:       `field "addr" of object type "vote" renamed from "voter" not found`,
+		},
+		"old field still exists": {
+			migration: Migration{Fields: map[string]map[string]string{"vote": {"proposal": "address"}}},
+			exists:    migrated,
+			err:       `field "proposal" of object type "vote" renamed to "address" still exists`,
+		},
+		"both tables exist": {
+			migration: Migration{ObjectTypes: map[string]string{"ballot": "vote"}},
+			exists:    existing("test_ballot", "test_vote"),
+			err:       `cannot rename table "test_ballot" to "test_vote" which already exists`,
+		},
+		"both columns exist": {
+			migration: Migration{Fields: map[string]map[string]string{"vote": {"voter": "address"}}},
+			exists:    existing("test_vote", "test_vote.voter", "test_vote.address"),
+			err:       `cannot rename column "voter" of table "test_vote" to "address" which already exists`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := NewModuleIndexer("test", testdata.ExampleSchema, Options{
+				Migrations: map[string]Migration{"test": tc.migration},
+			})
+			buf := new(strings.Builder)
+			err := m.MigrateSchemaSql(buf, tc.exists)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if buf.Len() != 0 {
+					t.Fatalf("expected no statements, got %s", buf.String())
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.err {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
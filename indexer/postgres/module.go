@@ -27,10 +27,15 @@ func NewModuleIndexer(moduleName string, modSchema schema.ModuleSchema, options
 	}
 }
 
-// InitializeSchema creates tables for all object types in the module schema and creates enum types.
+// InitializeSchema creates tables for all object types in the module schema and creates enum types,
+// after renaming the tables and columns of the renamed object types and fields, see Migration.
 func (m *ModuleIndexer) InitializeSchema(ctx context.Context, conn DBConn) error {
+	err := m.MigrateSchema(ctx, conn)
+	if err != nil {
+		return err
+	}
+
 	// create enum types
-	var err error
 	m.schema.EnumTypes(func(enumType schema.EnumType) bool {
 		err = m.CreateEnumType(ctx, conn, enumType)
 		return err == nil
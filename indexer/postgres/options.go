@@ -1,5 +1,7 @@
 package postgres
 
+import "encoding/hex"
+
 // Options are the options for module and object indexers.
 type Options struct {
 	// DisableRetainDeletions disables retain deletions functionality even on object types that have it set.
@@ -7,4 +9,36 @@ type Options struct {
 
 	// Logger is the logger for the indexer to use.
 	Logger SqlLogger
+
+	// AddressCodec is the codec used to store address fields as text. It defaults to hex encoding.
+	AddressCodec AddressCodec
+
+	// GeneratedColumns are the generated columns added to the tables, by table name.
+	GeneratedColumns map[string][]GeneratedColumn
+
+	// Migrations are the renames of object types and fields applied to the tables, by module name.
+	Migrations map[string]Migration
+}
+
+// AddressCodec converts addresses between their bytes and string representations.
+type AddressCodec interface {
+	StringToBytes(text string) ([]byte, error)
+	BytesToString(bz []byte) (string, error)
+}
+
+func (o Options) addressCodec() AddressCodec {
+	if o.AddressCodec != nil {
+		return o.AddressCodec
+	}
+	return hexAddressCodec{}
+}
+
+type hexAddressCodec struct{}
+
+func (hexAddressCodec) StringToBytes(text string) ([]byte, error) {
+	return hex.DecodeString(text)
+}
+
+func (hexAddressCodec) BytesToString(bz []byte) (string, error) {
+	return hex.EncodeToString(bz), nil
 }
@@ -0,0 +1,328 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// bindKeyParams binds the key to the columns and parameters identifying the row of the object.
+func (tm *ObjectIndexer) bindKeyParams(key interface{}) ([]string, []interface{}, error) {
+	n := len(tm.typ.KeyFields)
+	switch n {
+	case 0:
+		// singleton objects are stored in a single row
+		return []string{"_id"}, []interface{}{1}, nil
+	case 1:
+		return tm.bindParams(tm.typ.KeyFields, []interface{}{key})
+	default:
+		values, ok := key.([]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("expected key to be a slice of %d values, got %T", n, key)
+		}
+		return tm.bindParams(tm.typ.KeyFields, values)
+	}
+}
+
+// bindValueParams binds the value to the columns and parameters to update. Only the
+// updated fields are bound when the value is a schema.ValueUpdates.
+func (tm *ObjectIndexer) bindValueParams(value interface{}) ([]string, []interface{}, error) {
+	if valueUpdates, ok := value.(schema.ValueUpdates); ok {
+		var fields []schema.Field
+		var values []interface{}
+		var err error
+		iterErr := valueUpdates.Iterate(func(name string, value interface{}) bool {
+			field, ok := tm.valueFields[name]
+			if !ok {
+				err = fmt.Errorf("unknown value field %q", name)
+				return false
+			}
+			fields = append(fields, field)
+			values = append(values, value)
+			return true
+		})
+		if iterErr != nil {
+			return nil, nil, iterErr
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return tm.bindParams(fields, values)
+	}
+
+	n := len(tm.typ.ValueFields)
+	switch n {
+	case 0:
+		return nil, nil, nil
+	case 1:
+		return tm.bindParams(tm.typ.ValueFields, []interface{}{value})
+	default:
+		values, ok := value.([]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("expected value to be a slice of %d values, got %T", n, value)
+		}
+		return tm.bindParams(tm.typ.ValueFields, values)
+	}
+}
+
+func (tm *ObjectIndexer) bindParams(fields []schema.Field, values []interface{}) ([]string, []interface{}, error) {
+	if len(fields) != len(values) {
+		return nil, nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+	}
+
+	cols := make([]string, len(fields))
+	params := make([]interface{}, len(fields))
+	for i, field := range fields {
+		col, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, nil, err
+		}
+		cols[i] = col
+
+		params[i], err = tm.bindParam(field, values[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid value for field %q: %v", field.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+	return cols, params, nil
+}
+
+// bindParam converts the value of a field to the parameter stored in its column.
+func (tm *ObjectIndexer) bindParam(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		if !field.Nullable {
+			return nil, fmt.Errorf("expected non-null value")
+		}
+		return nil, nil
+	}
+
+	if field.Kind == schema.IntegerStringKind || field.Kind == schema.DecimalStringKind {
+		return numericParam(field.Kind, value)
+	}
+
+	if err := field.Kind.ValidateValueType(value); err != nil {
+		return nil, err
+	}
+
+	switch field.Kind {
+	case schema.Int8Kind:
+		return int64(value.(int8)), nil
+	case schema.Int16Kind:
+		return int64(value.(int16)), nil
+	case schema.Int32Kind:
+		return int64(value.(int32)), nil
+	case schema.Uint8Kind:
+		return int64(value.(uint8)), nil
+	case schema.Uint16Kind:
+		return int64(value.(uint16)), nil
+	case schema.Uint32Kind:
+		return int64(value.(uint32)), nil
+	case schema.Uint64Kind:
+		// uint64 values above the max int64 cannot be passed as integers
+		return strconv.FormatUint(value.(uint64), 10), nil
+	case schema.Float32Kind:
+		return float64(value.(float32)), nil
+	case schema.BytesKind:
+		// a nil slice would be stored as NULL
+		if bz := value.([]byte); bz != nil {
+			return bz, nil
+		}
+		return []byte{}, nil
+	case schema.TimeKind:
+		return value.(time.Time).UnixNano(), nil
+	case schema.DurationKind:
+		return int64(value.(time.Duration)), nil
+	case schema.AddressKind:
+		return tm.options.addressCodec().BytesToString(value.([]byte))
+	case schema.JSONKind:
+		return string(value.(json.RawMessage)), nil
+	default:
+		return value, nil
+	}
+}
+
+// bigInteger is implemented by the arbitrary-precision integers converting to
+// *big.Int, such as math.Int and math.Uint.
+type bigInteger interface {
+	BigInt() *big.Int
+}
+
+// numericParam converts the value of an IntegerStringKind or DecimalStringKind
+// field to the text stored in its NUMERIC column. Besides strings, big integers
+// are accepted, so that integers beyond the range of int64 are stored with full
+// precision, as well as *big.Float and fmt.Stringer values for decimals. The
+// decimals with a scaled BigInt, such as math.LegacyDec, are stored with their
+// string and rejected for integers.
+func numericParam(kind schema.Kind, value interface{}) (interface{}, error) {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case *big.Int:
+		if v == nil {
+			return nil, fmt.Errorf("expected non-nil *big.Int")
+		}
+		str = v.String()
+	case bigInteger:
+		i := v.BigInt()
+		if i == nil {
+			return nil, fmt.Errorf("expected non-nil %T", value)
+		}
+		str = i.String()
+
+		// the BigInt of decimals, such as math.LegacyDec, is scaled: they are
+		// told apart by their string, which differs from the one of their BigInt
+		if s, ok := v.(fmt.Stringer); ok && s.String() != str {
+			if kind != schema.DecimalStringKind {
+				return nil, fmt.Errorf("expected integer, got scaled decimal %T", value)
+			}
+			str = s.String()
+		} else if kind != schema.IntegerStringKind {
+			return nil, fmt.Errorf("expected decimal, got %T", value)
+		}
+	case *big.Float:
+		if kind != schema.DecimalStringKind || v == nil || v.IsInf() {
+			return nil, fmt.Errorf("expected finite decimal, got %v", value)
+		}
+		str = v.Text('f', -1)
+	case fmt.Stringer:
+		if kind != schema.DecimalStringKind {
+			return nil, fmt.Errorf("expected integer, got %T", value)
+		}
+		str = v.String()
+	default:
+		return nil, kind.ValidateValueType(value)
+	}
+
+	if err := kind.ValidateValue(str); err != nil {
+		return nil, err
+	}
+	return str, nil
+}
+
+// columnReader scans a column and converts it back to the value of its field.
+type columnReader struct {
+	field schema.Field
+	dest  interface{}
+	read  func() (value interface{}, null bool, err error)
+}
+
+// newColumnReader returns the reader of the column storing the value of field.
+func (tm *ObjectIndexer) newColumnReader(field schema.Field) *columnReader {
+	r := &columnReader{field: field}
+
+	switch field.Kind {
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind, schema.Int64Kind,
+		schema.Uint8Kind, schema.Uint16Kind, schema.Uint32Kind,
+		schema.TimeKind, schema.DurationKind:
+		var dest sql.NullInt64
+		r.dest = &dest
+		r.read = func() (interface{}, bool, error) {
+			if !dest.Valid {
+				return nil, true, nil
+			}
+			return intValue(field.Kind, dest.Int64), false, nil
+		}
+	case schema.Float32Kind, schema.Float64Kind:
+		var dest sql.NullFloat64
+		r.dest = &dest
+		r.read = func() (interface{}, bool, error) {
+			if !dest.Valid {
+				return nil, true, nil
+			}
+			if field.Kind == schema.Float32Kind {
+				return float32(dest.Float64), false, nil
+			}
+			return dest.Float64, false, nil
+		}
+	case schema.BoolKind:
+		var dest sql.NullBool
+		r.dest = &dest
+		r.read = func() (interface{}, bool, error) {
+			return dest.Bool, !dest.Valid, nil
+		}
+	case schema.BytesKind, schema.JSONKind:
+		var dest []byte
+		r.dest = &dest
+		r.read = func() (interface{}, bool, error) {
+			if dest == nil {
+				return nil, true, nil
+			}
+			if field.Kind == schema.JSONKind {
+				return json.RawMessage(dest), false, nil
+			}
+			return dest, false, nil
+		}
+	default:
+		// the other kinds are read as strings
+		var dest sql.NullString
+		r.dest = &dest
+		r.read = func() (interface{}, bool, error) {
+			if !dest.Valid {
+				return nil, true, nil
+			}
+			switch field.Kind {
+			case schema.Uint64Kind:
+				v, err := strconv.ParseUint(dest.String, 10, 64)
+				return v, false, err
+			case schema.IntegerStringKind:
+				// NUMERIC columns hold integers of any precision
+				v, ok := new(big.Int).SetString(dest.String, 10)
+				if !ok {
+					return nil, false, fmt.Errorf("invalid integer %q", dest.String)
+				}
+				return v.String(), false, nil
+			case schema.AddressKind:
+				v, err := tm.options.addressCodec().StringToBytes(dest.String)
+				return v, false, err
+			default:
+				return dest.String, false, nil
+			}
+		}
+	}
+
+	return r
+}
+
+// value returns the value of the field read from the column.
+func (r *columnReader) value() (interface{}, error) {
+	value, null, err := r.read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for field %q: %v", r.field.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+	if null {
+		if !r.field.Nullable {
+			return nil, fmt.Errorf("unexpected null value for field %q", r.field.Name)
+		}
+		return nil, nil
+	}
+	return value, nil
+}
+
+func intValue(kind schema.Kind, v int64) interface{} {
+	switch kind {
+	case schema.Int8Kind:
+		return int8(v)
+	case schema.Int16Kind:
+		return int16(v)
+	case schema.Int32Kind:
+		return int32(v)
+	case schema.Uint8Kind:
+		return uint8(v)
+	case schema.Uint16Kind:
+		return uint16(v)
+	case schema.Uint32Kind:
+		return uint32(v)
+	case schema.TimeKind:
+		return time.Unix(0, v)
+	case schema.DurationKind:
+		return time.Duration(v)
+	default:
+		return v
+	}
+}
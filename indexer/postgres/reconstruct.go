@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/schema"
+)
+
+// KVStore is the store the state of a module is reconstructed into, e.g. a cosmos-db DB or Batch.
+type KVStore interface {
+	Set(key, value []byte) error
+}
+
+// IndexedHeight returns the height of the last block indexed, or 0 if no block was indexed.
+func IndexedHeight(ctx context.Context, conn DBConn) (uint64, error) {
+	var height uint64
+	err := conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(number), 0) FROM block;").Scan(&height)
+	return height, err
+}
+
+// ReconstructModuleState reconstructs the key-value state of a module from its objects indexed in
+// the database, encoded with the KVEncoder of the module codec, and writes it into store, which
+// should be empty. It enables the recovery of the state of a module from the index, e.g. after a
+// disaster, as well as targeted state surgery, by editing the objects before the reconstruction.
+// It returns the number of key-value pairs written.
+//
+// The tables only hold the latest state of the objects, so height must be the height of the last
+// indexed block, as a safeguard against reconstructing the state of another height than expected.
+// The deleted objects retained in the tables are excluded.
+func ReconstructModuleState(
+	ctx context.Context,
+	conn DBConn,
+	moduleName string,
+	codec schema.ModuleCodec,
+	height uint64,
+	store KVStore,
+	options Options,
+) (int, error) {
+	if codec.KVEncoder == nil {
+		return 0, fmt.Errorf("module %s doesn't support state reconstruction, its codec has no KVEncoder", moduleName)
+	}
+
+	indexedHeight, err := IndexedHeight(ctx, conn)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the indexed height: %v", err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+	if indexedHeight != height {
+		return 0, fmt.Errorf("the index is at height %d, not %d", indexedHeight, height)
+	}
+
+	n := 0
+	codec.Schema.ObjectTypes(func(typ schema.ObjectType) bool {
+		tm := NewObjectIndexer(moduleName, typ, options)
+		var written int
+		written, err = reconstructObjects(typ.Name, codec.KVEncoder, store, func(f func(key, value interface{}) bool) error {
+			return tm.Iterate(ctx, conn, f)
+		})
+		n += written
+		if err != nil {
+			err = fmt.Errorf("failed to reconstruct the objects of type %s of module %s: %v", typ.Name, moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+		return err == nil
+	})
+
+	return n, err
+}
+
+// reconstructObjects encodes the objects of the type, iterated by iterate, into the key-value pairs
+// written into store, returning the number of pairs written.
+func reconstructObjects(
+	typeName string,
+	encoder schema.KVEncoder,
+	store KVStore,
+	iterate func(f func(key, value interface{}) bool) error,
+) (int, error) {
+	n := 0
+	var err error
+	iterErr := iterate(func(key, value interface{}) bool {
+		var pairs []schema.KVPairUpdate
+		pairs, err = encoder(schema.ObjectUpdate{TypeName: typeName, Key: key, Value: value})
+		if err != nil {
+			err = fmt.Errorf("failed to encode object %v: %v", key, err) //nolint:errorlint // using %v for go 1.12 compat
+			return false
+		}
+
+		for _, pair := range pairs {
+			if pair.Delete {
+				err = errors.New("the encoder returned a delete for an object set")
+				return false
+			}
+			err = store.Set(pair.Key, pair.Value)
+			if err != nil {
+				return false
+			}
+			n++
+		}
+		return true
+	})
+	if iterErr != nil {
+		return n, iterErr
+	}
+	return n, err
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+)
+
+func ExampleObjectIndexer_selectAllSql() {
+	vote := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	err := vote.selectAllSql(os.Stdout, []string{`"proposal"`, `"address"`, `"vote"`})
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// SELECT "proposal", "address", "vote" FROM "test_vote" WHERE NOT _deleted ORDER BY "proposal", "address";
+}
+
+// mapStore is a KVStore failing to set the key "fail".
+type mapStore map[string]string
+
+func (s mapStore) Set(key, value []byte) error {
+	if string(key) == "fail" {
+		return errors.New("store failure")
+	}
+	s[string(key)] = string(value)
+	return nil
+}
+
+func TestReconstructObjects(t *testing.T) {
+	// the votes are stored by proposal and voter, and indexed by voter
+	encoder := func(update schema.ObjectUpdate) ([]schema.KVPairUpdate, error) {
+		key := update.Key.([]interface{})
+		if key[0].(int64) < 0 {
+			return nil, fmt.Errorf("invalid proposal %d", key[0])
+		}
+		pairs := []schema.KVPairUpdate{
+			{Key: []byte(fmt.Sprintf("vote/%d/%s", key[0], key[1])), Value: []byte(update.Value.(string))},
+			{Key: []byte(fmt.Sprintf("voter/%s/%d", key[1], key[0]))},
+		}
+		if key[1] == "fail" {
+			pairs = append(pairs, schema.KVPairUpdate{Key: []byte("fail")})
+		}
+		if key[1] == "delete" {
+			pairs = append(pairs, schema.KVPairUpdate{Key: []byte("x"), Delete: true})
+		}
+		return pairs, nil
+	}
+	iterate := func(objects ...[2]interface{}) func(f func(key, value interface{}) bool) error {
+		return func(f func(key, value interface{}) bool) error {
+			for _, object := range objects {
+				if !f(object[0], object[1]) {
+					return nil
+				}
+			}
+			return nil
+		}
+	}
+	vote := func(proposal int64, voter, option string) [2]interface{} {
+		return [2]interface{}{[]interface{}{proposal, voter}, option}
+	}
+
+	store := mapStore{}
+	n, err := reconstructObjects("vote", encoder, store, iterate(vote(1, "alice", "yes"), vote(2, "bob", "no")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 4 {
+		t.Fatalf("expected 4 pairs written, got %d", n)
+	}
+	expected := mapStore{"vote/1/alice": "yes", "vote/2/bob": "no", "voter/alice/1": "", "voter/bob/2": ""}
+	if !reflect.DeepEqual(store, expected) {
+		t.Fatalf("expected store %v, got %v", expected, store)
+	}
+
+	for name, tc := range map[string]struct {
+		iterate func(f func(key, value interface{}) bool) error
+		err     string
+	}{
+		"encoder error": {
+			iterate: iterate(vote(1, "alice", "yes"), vote(-1, "bob", "no")),
+			err:     "failed to encode object [-1 bob]: invalid proposal -1",
+		},
+		"store error": {
+			iterate: iterate(vote(1, "fail", "yes")),
+			err:     "store failure",
+		},
+		"delete": {
+			iterate: iterate(vote(1, "delete", "yes")),
+			err:     "the encoder returned a delete for an object set",
+		},
+		"iterate error": {
+			iterate: func(func(key, value interface{}) bool) error { return errors.New("connection lost") },
+			err:     "connection lost",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := reconstructObjects("vote", encoder, mapStore{}, tc.iterate)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestReconstructModuleStateWithoutEncoder(t *testing.T) {
+	_, err := ReconstructModuleState(context.Background(), nil, "test", schema.ModuleCodec{Schema: testdata.ExampleSchema}, 1, mapStore{}, Options{})
+	if err == nil || !strings.Contains(err.Error(), "doesn't support state reconstruction") {
+		t.Fatalf("expected error for codec without encoder, got %v", err)
+	}
+}
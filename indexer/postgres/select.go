@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// Get reads the value of the object with the provided key, in the same format as the values of the
+// object updates: nil when the object type has no value fields, the value of the field when it has
+// one, and a slice of the values otherwise. found is false if there is no object with the key, or if
+// it was deleted.
+func (tm *ObjectIndexer) Get(ctx context.Context, conn DBConn, key interface{}) (value interface{}, found bool, err error) {
+	keyCols, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	readers := make([]*columnReader, len(tm.typ.ValueFields))
+	dests := make([]interface{}, len(tm.typ.ValueFields))
+	cols := make([]string, len(tm.typ.ValueFields))
+	for i, field := range tm.typ.ValueFields {
+		readers[i] = tm.newColumnReader(field)
+		dests[i] = readers[i].dest
+		cols[i], err = tm.updatableColumnName(field)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.selectSql(buf, cols, keyCols, keyParams)
+	if err != nil {
+		return nil, false, err
+	}
+
+	sqlStr := buf.String()
+	tm.log("Select", sqlStr, params...)
+	row := conn.QueryRowContext(ctx, sqlStr, params...)
+	if len(dests) == 0 {
+		var exists int
+		dests = append(dests, &exists)
+	}
+	if err := row.Scan(dests...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	values := make([]interface{}, len(readers))
+	for i, reader := range readers {
+		values[i], err = reader.value()
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	return objectValue(values), true, nil
+}
+
+// Iterate calls f with the key and value of every object of the table ordered by key, excluding the
+// deleted ones, until f returns false. The keys and values are in the same format as the keys and
+// values of the object updates, see Get.
+func (tm *ObjectIndexer) Iterate(ctx context.Context, conn DBConn, f func(key, value interface{}) bool) error {
+	fields := make([]schema.Field, 0, len(tm.typ.KeyFields)+len(tm.typ.ValueFields))
+	fields = append(append(fields, tm.typ.KeyFields...), tm.typ.ValueFields...)
+
+	readers := make([]*columnReader, len(fields))
+	dests := make([]interface{}, len(fields))
+	cols := make([]string, len(fields))
+	var err error
+	for i, field := range fields {
+		readers[i] = tm.newColumnReader(field)
+		dests[i] = readers[i].dest
+		cols[i], err = tm.updatableColumnName(field)
+		if err != nil {
+			return err
+		}
+	}
+
+	buf := new(strings.Builder)
+	err = tm.selectAllSql(buf, cols)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	tm.log("Select all", sqlStr)
+	rows, err := conn.QueryContext(ctx, sqlStr)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if len(dests) == 0 {
+		var exists int
+		dests = append(dests, &exists)
+	}
+	values := make([]interface{}, len(readers))
+	for rows.Next() {
+		if err := rows.Scan(dests...); err != nil {
+			return err
+		}
+		for i, reader := range readers {
+			values[i], err = reader.value()
+			if err != nil {
+				return err
+			}
+		}
+
+		n := len(tm.typ.KeyFields)
+		if !f(objectValue(values[:n]), objectValue(values[n:])) {
+			break
+		}
+	}
+	return rows.Err()
+}
+
+// objectValue returns the key or value of an object from the values of its fields: nil when there
+// are no fields, the value of the field when there is one, and a copy of the values otherwise.
+func objectValue(values []interface{}) interface{} {
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return append([]interface{}(nil), values...)
+	}
+}
+
+// Count returns the number of objects in the table, excluding the deleted ones.
+func (tm *ObjectIndexer) Count(ctx context.Context, conn DBConn) (int, error) {
+	sqlStr := fmt.Sprintf("SELECT COUNT(*) FROM %q", tm.TableName())
+	if tm.retainDeletions() {
+		sqlStr += " WHERE NOT _deleted"
+	}
+	sqlStr += ";"
+
+	tm.log("Count", sqlStr)
+	var count int
+	err := conn.QueryRowContext(ctx, sqlStr).Scan(&count)
+	return count, err
+}
+
+// selectSql generates a SELECT statement and the parameters reading the provided columns of the
+// row with the provided key, excluding the row if it was deleted.
+func (tm *ObjectIndexer) selectSql(w io.Writer, cols, keyCols []string, keyParams []interface{}) ([]interface{}, error) {
+	selected := "1"
+	if len(cols) > 0 {
+		selected = strings.Join(cols, ", ")
+	}
+
+	_, err := fmt.Fprintf(w, "SELECT %s FROM %q", selected, tm.TableName())
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSql(w, keyCols, keyParams, nil, tm.retainDeletions())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
+
+// selectAllSql generates a SELECT statement reading the provided columns of all the rows ordered by
+// key, excluding the deleted rows.
+func (tm *ObjectIndexer) selectAllSql(w io.Writer, cols []string) error {
+	selected := "1"
+	if len(cols) > 0 {
+		selected = strings.Join(cols, ", ")
+	}
+
+	_, err := fmt.Fprintf(w, "SELECT %s FROM %q", selected, tm.TableName())
+	if err != nil {
+		return err
+	}
+
+	if tm.retainDeletions() {
+		_, err = fmt.Fprintf(w, " WHERE NOT _deleted")
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(tm.typ.KeyFields) > 0 {
+		_, err = fmt.Fprintf(w, " ORDER BY %s", strings.Join(cols[:len(tm.typ.KeyFields)], ", "))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return err
+}
+
+// whereSql writes a WHERE clause matching the row with the provided key, appending the key
+// parameters to params, and excluding the deleted rows if excludeDeleted is true.
+func (tm *ObjectIndexer) whereSql(w io.Writer, keyCols []string, keyParams, params []interface{}, excludeDeleted bool) ([]interface{}, error) {
+	conds := make([]string, 0, len(keyCols)+1)
+	for i, col := range keyCols {
+		params = append(params, keyParams[i])
+		conds = append(conds, fmt.Sprintf("%s = $%d", col, len(params)))
+	}
+	if excludeDeleted {
+		conds = append(conds, "NOT _deleted")
+	}
+
+	_, err := fmt.Fprintf(w, " WHERE %s", strings.Join(conds, " AND "))
+	return params, err
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestAccountHistory(t *testing.T) {
+	ctx := context.Background()
+	connectionUrl := createTestDB(t)
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	listener, err := postgres.StartIndexer(ctx, nil, postgres.Config{DatabaseURL: connectionUrl})
+	require.NoError(t, err)
+
+	const alice, bob = "cosmos1alice", "cosmos1bob"
+	event := func(txIndex int32, eventIndex uint32, typ string, attrs ...string) appdata.EventData {
+		return appdata.EventData{
+			TxIndex:    txIndex,
+			EventIndex: eventIndex,
+			Type:       typ,
+			Data: func() (json.RawMessage, error) {
+				data := map[string][]map[string]string{"attributes": {}}
+				for i := 0; i < len(attrs); i += 2 {
+					data["attributes"] = append(data["attributes"], map[string]string{"key": attrs[i], "value": attrs[i+1]})
+				}
+				return json.Marshal(data)
+			},
+		}
+	}
+
+	for height := uint64(1); height <= 3; height++ {
+		require.NoError(t, listener.StartBlock(appdata.StartBlockData{
+			Height: height,
+			HeaderJSON: func() (json.RawMessage, error) {
+				return json.RawMessage(`{"time":"2024-01-02T15:04:05Z"}`), nil
+			},
+		}))
+		require.NoError(t, listener.OnTx(appdata.TxData{
+			TxIndex: 0,
+			JSON:    func() (json.RawMessage, error) { return json.RawMessage(`{"hash":"AB"}`), nil },
+		}))
+		require.NoError(t, listener.OnEvent(event(0, 0, "message", "sender", alice)))
+		require.NoError(t, listener.OnEvent(event(0, 1, "transfer", "sender", alice, "recipient", bob, "amount", "1stake")))
+		require.NoError(t, listener.OnEvent(event(0, 2, "delegate", "delegator", bob)))
+		require.NoError(t, listener.OnEvent(event(-1, 0, "withdraw_rewards", "delegator", alice)))
+		require.NoError(t, listener.Commit(appdata.CommitData{}))
+	}
+
+	entries, err := postgres.AccountHistory(ctx, db, postgres.AccountHistoryQuery{Address: alice, FromHeight: 2})
+	require.NoError(t, err)
+	require.Len(t, entries, 6)
+	for i, entry := range entries {
+		require.Equal(t, uint64(2+i/3), entry.BlockNumber)
+		require.Equal(t, "2024-01-02T15:04:05Z", entry.BlockTime)
+	}
+	require.Equal(t, postgres.HistoryCategoryTx, entries[0].Category)
+	require.Equal(t, postgres.HistoryCategoryTransfer, entries[1].Category)
+	require.Equal(t, postgres.HistoryCategoryStaking, entries[2].Category)
+	require.Equal(t, int64(-1), entries[2].TxIndex)
+	require.Nil(t, entries[2].Tx)
+	require.JSONEq(t, `{"hash":"AB"}`, string(entries[1].Tx))
+
+	entries, err = postgres.AccountHistory(ctx, db, postgres.AccountHistoryQuery{Address: bob, ToHeight: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "transfer", entries[0].EventType)
+	require.Equal(t, "delegate", entries[1].EventType)
+}
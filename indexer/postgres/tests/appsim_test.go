@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/schema"
+	schematesting "cosmossdk.io/schema/testing"
+	"cosmossdk.io/schema/testing/appdatasim"
+	"cosmossdk.io/schema/testing/statesim"
+)
+
+// TestAppSim runs the indexer against blocks of simulated app data in a PostgreSQL server provisioned
+// with testcontainers, and checks that the tables contain the objects of the simulated app state.
+func TestAppSim(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping container based integration test in short mode")
+	}
+
+	ctx := context.Background()
+	connectionUrl := startPostgresContainer(t)
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	listener, err := postgres.StartIndexer(ctx, nil, postgres.Config{DatabaseURL: connectionUrl})
+	require.NoError(t, err)
+
+	sim, err := appdatasim.NewSimulator(appdatasim.Options{
+		AppSchema: schematesting.ExampleAppSchema,
+		Listener:  listener,
+	})
+	require.NoError(t, err)
+
+	const numBlocks = 20
+	blockGen := sim.BlockDataGenN(10, 50)
+	for i := 0; i < numBlocks; i++ {
+		require.NoError(t, sim.ProcessBlockData(blockGen.Example(i)))
+	}
+	require.Equal(t, uint64(numBlocks), sim.BlockNum())
+
+	sim.AppState().Modules(func(moduleName string, modState statesim.ModuleState) bool {
+		modState.ObjectCollections(func(coll statesim.ObjectCollectionState) bool {
+			checkTable(ctx, t, db, moduleName, coll)
+			return true
+		})
+		return true
+	})
+}
+
+// checkTable checks that the table of the object collection contains exactly the objects of the collection.
+func checkTable(ctx context.Context, t *testing.T, db *sql.DB, moduleName string, coll statesim.ObjectCollectionState) {
+	t.Helper()
+	objectType := coll.ObjectType()
+	tm := postgres.NewObjectIndexer(moduleName, objectType, postgres.Options{})
+
+	count, err := tm.Count(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, coll.Len(), count, "number of objects in table %s", tm.TableName())
+
+	coll.AllState(func(expected schema.ObjectUpdate) bool {
+		actual, found, err := tm.Get(ctx, db, expected.Key)
+		require.NoError(t, err)
+		require.True(t, found, "object %v not found in table %s", expected.Key, tm.TableName())
+
+		expectedValues := objectValues(objectType.ValueFields, expected.Value)
+		actualValues := objectValues(objectType.ValueFields, actual)
+		for i, field := range objectType.ValueFields {
+			require.Equal(t, canonicalValue(field, expectedValues[i]), canonicalValue(field, actualValues[i]),
+				"field %s of object %v in table %s", field.Name, expected.Key, tm.TableName())
+		}
+		return true
+	})
+}
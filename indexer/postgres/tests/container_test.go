@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// postgresImage is the image of the PostgreSQL server provisioned for the integration tests.
+const postgresImage = "postgres:16-alpine"
+
+// startPostgresContainer provisions a PostgreSQL server in a container which is terminated at the end
+// of the test, and returns the connection URL of its database. The test is skipped if Docker is not
+// available.
+func startPostgresContainer(t *testing.T) (connectionUrl string) {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	ctx := context.Background()
+	container, err := tcpostgres.Run(ctx, postgresImage,
+		tcpostgres.WithDatabase("indexer"),
+		tcpostgres.WithUsername("indexer"),
+		tcpostgres.WithPassword("indexer"),
+		// the server is restarted once the database is initialized, wait for it to be ready again
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(time.Minute),
+		),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(context.Background()))
+	})
+
+	connectionUrl, err = container.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	return connectionUrl
+}
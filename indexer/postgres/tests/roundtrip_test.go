@@ -0,0 +1,256 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/btree"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	schematesting "cosmossdk.io/schema/testing"
+)
+
+// maxIdentifierLen is the maximum length of a PostgreSQL identifier, longer names are silently truncated.
+const maxIdentifierLen = 63
+
+// TestRoundTrip generates random module schemas and object updates, indexes them through the listener
+// and checks that the objects read back from the database are the ones that were written.
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	connectionUrl := createTestDB(t)
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	listener, err := postgres.StartIndexer(ctx, nil, postgres.Config{DatabaseURL: connectionUrl})
+	require.NoError(t, err)
+
+	iteration := 0
+	rapid.Check(t, func(t *rapid.T) {
+		// every iteration uses its own module so that the tables of the previous ones don't interfere
+		iteration++
+		moduleName := fmt.Sprintf("m%d", iteration)
+		modSchema := indexableModuleSchemaGen.Draw(t, "schema")
+
+		// a failed statement aborts the whole transaction, commit it so that the next iteration starts clean
+		defer func() { _ = listener.Commit(appdata.CommitData{}) }()
+
+		require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+			ModuleName: moduleName,
+			Schema:     modSchema,
+		}))
+		require.NoError(t, listener.Commit(appdata.CommitData{}))
+
+		var objectTypes []schema.ObjectType
+		states := map[string]*btree.Map[string, schema.ObjectUpdate]{}
+		modSchema.ObjectTypes(func(objectType schema.ObjectType) bool {
+			objectTypes = append(objectTypes, objectType)
+			states[objectType.Name] = &btree.Map[string, schema.ObjectUpdate]{}
+			return true
+		})
+
+		numBlocks := rapid.IntRange(1, 5).Draw(t, "numBlocks")
+		for i := 0; i < numBlocks; i++ {
+			numUpdates := rapid.IntRange(1, 20).Draw(t, fmt.Sprintf("numUpdates[%d]", i))
+			updates := make([]schema.ObjectUpdate, numUpdates)
+			for j := range updates {
+				objectType := rapid.SampledFrom(objectTypes).Draw(t, fmt.Sprintf("objectType[%d][%d]", i, j))
+				state := states[objectType.Name]
+				updates[j] = schematesting.ObjectUpdateGen(objectType, state).Draw(t, fmt.Sprintf("update[%d][%d]", i, j))
+				applyUpdate(t, objectType, state, updates[j])
+			}
+
+			require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+				ModuleName: moduleName,
+				Updates:    updates,
+			}))
+			require.NoError(t, listener.Commit(appdata.CommitData{}))
+		}
+
+		for _, objectType := range objectTypes {
+			checkObjects(ctx, t, db, moduleName, objectType, states[objectType.Name])
+		}
+	})
+}
+
+// indexableModuleSchemaGen generates module schemas whose names fit in PostgreSQL identifiers once
+// prefixed with the module name, and which don't clash with the columns added by the indexer.
+var indexableModuleSchemaGen = schematesting.ModuleSchemaGen.Filter(func(modSchema schema.ModuleSchema) bool {
+	// leave room for module names up to 8 characters and the underscore separating them from the type name
+	const maxNameLen = maxIdentifierLen - 9
+
+	ok := true
+	modSchema.ObjectTypes(func(objectType schema.ObjectType) bool {
+		if len(objectType.Name) > maxNameLen {
+			ok = false
+			return false
+		}
+
+		columns := map[string]bool{"_id": true, "_deleted": true}
+		for _, field := range append(append([]schema.Field{}, objectType.KeyFields...), objectType.ValueFields...) {
+			names := []string{field.Name}
+			if field.Kind == schema.TimeKind {
+				names = append(names, field.Name+"_nanos")
+			}
+			if field.Kind == schema.EnumKind && len(field.EnumType.Name) > maxNameLen {
+				ok = false
+				return false
+			}
+			for _, name := range names {
+				if len(name) > maxIdentifierLen || columns[name] {
+					ok = false
+					return false
+				}
+				columns[name] = true
+			}
+		}
+		return true
+	})
+	return ok
+})
+
+// applyUpdate applies the update to the expected state, merging value updates into the full
+// value of the existing object.
+func applyUpdate(t *rapid.T, objectType schema.ObjectType, state *btree.Map[string, schema.ObjectUpdate], update schema.ObjectUpdate) {
+	key := canonicalKey(objectType.KeyFields, update.Key)
+	if update.Delete {
+		state.Delete(key)
+		return
+	}
+
+	valueUpdates, ok := update.Value.(schema.ValueUpdates)
+	if !ok {
+		state.Set(key, update)
+		return
+	}
+
+	values := make([]interface{}, len(objectType.ValueFields))
+	if existing, found := state.Get(key); found {
+		values = objectValues(objectType.ValueFields, existing.Value)
+		update.Key = existing.Key
+	}
+	fieldIndexes := map[string]int{}
+	for i, field := range objectType.ValueFields {
+		fieldIndexes[field.Name] = i
+	}
+	require.NoError(t, valueUpdates.Iterate(func(name string, value interface{}) bool {
+		values[fieldIndexes[name]] = value
+		return true
+	}))
+
+	if len(values) == 1 {
+		update.Value = values[0]
+	} else {
+		update.Value = values
+	}
+	state.Set(key, update)
+}
+
+// checkObjects checks that the objects of the type stored in the database match the expected state.
+func checkObjects(ctx context.Context, t *rapid.T, db *sql.DB, moduleName string, objectType schema.ObjectType, state *btree.Map[string, schema.ObjectUpdate]) {
+	tm := postgres.NewObjectIndexer(moduleName, objectType, postgres.Options{})
+
+	count, err := tm.Count(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, state.Len(), count, "number of objects of type %s", objectType.Name)
+
+	state.Scan(func(key string, expected schema.ObjectUpdate) bool {
+		actual, found, err := tm.Get(ctx, db, expected.Key)
+		require.NoError(t, err)
+		require.True(t, found, "object %s of type %s not found", key, objectType.Name)
+
+		expectedValues := objectValues(objectType.ValueFields, expected.Value)
+		actualValues := objectValues(objectType.ValueFields, actual)
+		for i, field := range objectType.ValueFields {
+			require.Equal(t, canonicalValue(field, expectedValues[i]), canonicalValue(field, actualValues[i]),
+				"field %s of object %s of type %s", field.Name, key, objectType.Name)
+
+			if field.Kind == schema.TimeKind && expectedValues[i] != nil {
+				checkTimestamp(ctx, t, db, expectedValues[i].(time.Time))
+			}
+		}
+		return true
+	})
+}
+
+// checkTimestamp checks that the timestamp generated from the nanos of a time column is the time
+// truncated to the microsecond precision of PostgreSQL.
+func checkTimestamp(ctx context.Context, t *rapid.T, db *sql.DB, expected time.Time) {
+	var actual time.Time
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT nanos_to_timestamptz($1);", expected.UnixNano()).Scan(&actual))
+	require.True(t, expected.Truncate(time.Microsecond).Equal(actual), "expected timestamp %s, got %s", expected, actual)
+}
+
+// objectValues returns the object value as a slice of the values of each value field.
+func objectValues(valueFields []schema.Field, value interface{}) []interface{} {
+	switch len(valueFields) {
+	case 0:
+		return nil
+	case 1:
+		return []interface{}{value}
+	default:
+		return value.([]interface{})
+	}
+}
+
+// canonicalKey returns a string representation of the object key that is equal for the keys that
+// PostgreSQL considers equal.
+func canonicalKey(keyFields []schema.Field, key interface{}) string {
+	values := objectValues(keyFields, key)
+	strs := make([]string, len(values))
+	for i, field := range keyFields {
+		strs[i] = canonicalValue(field, values[i])
+	}
+	return strings.Join(strs, ",")
+}
+
+// canonicalValue returns a string representation of the field value that is equal for the values
+// that PostgreSQL considers equal, e.g. numerically equal decimals or the positive and negative zeros.
+func canonicalValue(field schema.Field, value interface{}) string {
+	if value == nil {
+		return "null"
+	}
+
+	switch field.Kind {
+	case schema.IntegerStringKind, schema.DecimalStringKind:
+		r, ok := new(big.Rat).SetString(value.(string))
+		if !ok {
+			return fmt.Sprintf("invalid number %q", value)
+		}
+		return r.RatString()
+	case schema.Float32Kind:
+		return canonicalFloat(float64(value.(float32)))
+	case schema.Float64Kind:
+		return canonicalFloat(value.(float64))
+	case schema.TimeKind:
+		return fmt.Sprintf("%d", value.(time.Time).UnixNano())
+	case schema.BytesKind, schema.AddressKind, schema.JSONKind:
+		return fmt.Sprintf("%x", value)
+	default:
+		return fmt.Sprintf("%#v", value)
+	}
+}
+
+func canonicalFloat(f float64) string {
+	if f == 0 {
+		// -0 and 0 are equal
+		return "0"
+	}
+	if math.IsNaN(f) {
+		return "NaN"
+	}
+	return fmt.Sprintf("%g", f)
+}
@@ -51,6 +51,11 @@ func BenchmarkLoggers(b *testing.B) {
 			keyVals: []any{"foo", 10 * time.Second},
 		},
 
+		{
+			name:    "single stringer",
+			keyVals: []any{"foo", time.January},
+		},
+
 		{
 			name:    "two values",
 			keyVals: []any{"foo", "foo", "bar", "bar"},
@@ -159,3 +164,17 @@ func BenchmarkLoggers_StructuredVsFields(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkLoggers_FilteredModule(b *testing.B) {
+	b.ReportAllocs()
+
+	filter, err := log.ParseLogLevel("consensus:debug,*:info")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	logger := log.NewLogger(io.Discard, log.FilterOption(filter), log.OutputJSONOption()).With(log.ModuleKey, "server")
+	for i := 0; i < b.N; i++ {
+		logger.Debug(message, "foo", "foo", "bar", 100000, "baz", time.January)
+	}
+}
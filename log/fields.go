@@ -0,0 +1,68 @@
+package log
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// appendFields adds the key/value pairs to the event. The values of the common
+// types are encoded directly, without going through reflection, so that logging
+// them does not allocate. The other values are encoded by zerolog, as with
+// zerolog.Event.Fields.
+func appendFields(e *zerolog.Event, keyVals []any) *zerolog.Event {
+	if e == nil {
+		// the level is disabled, nothing is encoded
+		return e
+	}
+
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		key, ok := keyVals[i].(string)
+		if !ok {
+			// as zerolog, skip the pairs whose key is not a string
+			continue
+		}
+
+		switch val := keyVals[i+1].(type) {
+		case string:
+			e = e.Str(key, val)
+		case bool:
+			e = e.Bool(key, val)
+		case int:
+			e = e.Int(key, val)
+		case int32:
+			e = e.Int32(key, val)
+		case int64:
+			e = e.Int64(key, val)
+		case uint:
+			e = e.Uint(key, val)
+		case uint32:
+			e = e.Uint32(key, val)
+		case uint64:
+			e = e.Uint64(key, val)
+		case float64:
+			e = e.Float64(key, val)
+		case []byte:
+			e = e.Bytes(key, val)
+		case time.Duration:
+			e = e.Dur(key, val)
+		case time.Time:
+			e = e.Time(key, val)
+		case json.Marshaler, encoding.TextMarshaler, error, zerolog.LogObjectMarshaler, nil:
+			// these are encoded with their own encoding, and the errors with the
+			// stack trace when enabled
+			e = e.Fields(keyVals[i : i+2 : i+2])
+		case fmt.Stringer:
+			// as the default zerolog.InterfaceMarshalFunc, without encoding the
+			// string with reflection
+			e = e.Str(key, val.String())
+		default:
+			e = e.Fields(keyVals[i : i+2 : i+2])
+		}
+	}
+
+	return e
+}
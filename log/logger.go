@@ -79,6 +79,14 @@ func WithJSONMarshal(marshaler func(v any) ([]byte, error)) {
 
 type zeroLogWrapper struct {
 	*zerolog.Logger
+
+	// filter is the filter of the logger, used by the module loggers to discard
+	// the filtered entries before their fields are encoded. It is applied to each
+	// entry, as the filter may change at runtime.
+	filter FilterFunc
+	// module is the module of the logger, if any.
+	module    string
+	hasModule bool
 }
 
 // NewLogger returns a new logger that writes to the given destination.
@@ -96,6 +104,10 @@ func NewLogger(dst io.Writer, options ...Option) Logger {
 		opt(&logCfg)
 	}
 
+	if logCfg.RingBuffer != nil {
+		dst = io.MultiWriter(dst, logCfg.RingBuffer)
+	}
+
 	output := dst
 	if !logCfg.OutputJSON {
 		output = zerolog.ConsoleWriter{
@@ -128,48 +140,81 @@ func NewLogger(dst io.Writer, options ...Option) Logger {
 
 	logger = logger.Hook(logCfg.Hooks...)
 
-	return zeroLogWrapper{&logger}
+	return zeroLogWrapper{Logger: &logger, filter: logCfg.Filter}
 }
 
 // NewCustomLogger returns a new logger with the given zerolog logger.
 func NewCustomLogger(logger zerolog.Logger) Logger {
-	return zeroLogWrapper{&logger}
+	return zeroLogWrapper{Logger: &logger}
 }
 
 // Info takes a message and a set of key/value pairs and logs with level INFO.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Info(msg string, keyVals ...interface{}) {
-	l.Logger.Info().Fields(keyVals).Msg(msg)
+	if l.filtered(zerolog.InfoLevel) {
+		return
+	}
+	appendFields(l.Logger.Info(), keyVals).Msg(msg)
 }
 
 // Warn takes a message and a set of key/value pairs and logs with level WARN.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Warn(msg string, keyVals ...interface{}) {
-	l.Logger.Warn().Fields(keyVals).Msg(msg)
+	if l.filtered(zerolog.WarnLevel) {
+		return
+	}
+	appendFields(l.Logger.Warn(), keyVals).Msg(msg)
 }
 
 // Error takes a message and a set of key/value pairs and logs with level ERROR.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Error(msg string, keyVals ...interface{}) {
-	l.Logger.Error().Fields(keyVals).Msg(msg)
+	if l.filtered(zerolog.ErrorLevel) {
+		return
+	}
+	appendFields(l.Logger.Error(), keyVals).Msg(msg)
 }
 
 // Debug takes a message and a set of key/value pairs and logs with level DEBUG.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
-	l.Logger.Debug().Fields(keyVals).Msg(msg)
+	if l.filtered(zerolog.DebugLevel) {
+		return
+	}
+	appendFields(l.Logger.Debug(), keyVals).Msg(msg)
 }
 
 // With returns a new wrapped logger with additional context provided by a set.
 func (l zeroLogWrapper) With(keyVals ...interface{}) Logger {
-	logger := l.Logger.With().Fields(keyVals).Logger()
-	return zeroLogWrapper{&logger}
+	return l.with(keyVals)
 }
 
 // WithContext returns a new wrapped logger with additional context provided by a set.
 func (l zeroLogWrapper) WithContext(keyVals ...interface{}) any {
+	return l.with(keyVals)
+}
+
+// with returns a new wrapped logger with additional context provided by a set.
+// When the set provides the module of the logger, the entries filtered for the
+// module are discarded before being encoded.
+func (l zeroLogWrapper) with(keyVals []interface{}) zeroLogWrapper {
 	logger := l.Logger.With().Fields(keyVals).Logger()
-	return zeroLogWrapper{&logger}
+	wrapper := zeroLogWrapper{Logger: &logger, filter: l.filter, module: l.module, hasModule: l.hasModule}
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		if key, ok := keyVals[i].(string); ok && key == ModuleKey {
+			if module, ok := keyVals[i+1].(string); ok {
+				wrapper.module, wrapper.hasModule = module, true
+			}
+		}
+	}
+
+	return wrapper
+}
+
+// filtered returns whether the entries of the given level are filtered for the
+// module of the logger. The other entries are still filtered when written.
+func (l zeroLogWrapper) filtered(level zerolog.Level) bool {
+	return l.filter != nil && l.hasModule && l.filter(l.module, level.String())
 }
 
 // Impl returns the underlying zerolog logger.
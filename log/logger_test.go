@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"gotest.tools/v3/assert"
@@ -54,3 +55,103 @@ func TestLoggerOptionHooks(t *testing.T) {
 	logger.Info("hello world")
 	assert.Assert(t, strings.Contains(buf.String(), "hello world"))
 }
+
+type countingStringer struct{ calls *int }
+
+func (s countingStringer) String() string {
+	*s.calls++
+	return "stringer"
+}
+
+func TestLoggerFilterModuleLevel(t *testing.T) {
+	buf := new(bytes.Buffer)
+	filter, err := log.ParseLogLevel("consensus:debug,*:error")
+	assert.NilError(t, err)
+
+	calls := 0
+	logger := log.NewLogger(buf, log.FilterOption(filter), log.OutputJSONOption())
+	consensus := logger.With(log.ModuleKey, "consensus")
+	server := logger.With(log.ModuleKey, "server")
+
+	consensus.Debug("displayed", "value", countingStringer{&calls})
+	assert.Assert(t, strings.Contains(buf.String(), "displayed"))
+	assert.Equal(t, 1, calls)
+	buf.Reset()
+
+	// the entries filtered for the module are discarded before being encoded
+	server.Info("filtered", "value", countingStringer{&calls})
+	assert.Equal(t, 0, buf.Len())
+	assert.Equal(t, 1, calls)
+
+	server.Error("displayed", "value", countingStringer{&calls})
+	assert.Assert(t, strings.Contains(buf.String(), "displayed"))
+	assert.Equal(t, 2, calls)
+	buf.Reset()
+
+	// the module of a logger can be overridden
+	server.With(log.ModuleKey, "consensus").Debug("displayed")
+	assert.Assert(t, strings.Contains(buf.String(), "displayed"))
+}
+
+func TestLoggerFilterModuleLevelChange(t *testing.T) {
+	buf := new(bytes.Buffer)
+	filter, err := log.ParseLogLevel("info")
+	assert.NilError(t, err)
+
+	logger := log.NewLogger(buf, log.FilterOption(func(key, level string) bool {
+		return filter(key, level)
+	}), log.OutputJSONOption())
+	server := logger.With(log.ModuleKey, "server")
+
+	server.Debug("filtered")
+	assert.Equal(t, 0, buf.Len())
+
+	// the module loggers follow the changes of the filter
+	filter, err = log.ParseLogLevel("debug")
+	assert.NilError(t, err)
+
+	server.Debug("displayed")
+	assert.Assert(t, strings.Contains(buf.String(), "displayed"))
+	buf.Reset()
+
+	filter, err = log.ParseLogLevel("server:error,*:debug")
+	assert.NilError(t, err)
+
+	server.Warn("filtered")
+	assert.Equal(t, 0, buf.Len())
+}
+
+func TestLoggerFields(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	stringer, slice := countingStringer{new(int)}, []int{1, 2}
+	keyVals := []any{
+		"string", "foo",
+		"bool", true,
+		"int", -1,
+		"int32", int32(-2),
+		"int64", int64(-3),
+		"uint", uint(1),
+		"uint32", uint32(2),
+		"uint64", uint64(3),
+		"float", 2.5,
+		"bytes", []byte("bar"),
+		"duration", time.Second,
+		"time", now,
+		"nil", nil,
+		"error", errors.New("err"),
+		"stringer", stringer,
+		"int8", int8(4),
+		"slice", slice,
+		42, "not a key",
+		"odd",
+	}
+
+	// the fields are encoded as by zerolog
+	expected := new(bytes.Buffer)
+	zl := zerolog.New(expected)
+	zl.Info().Fields(keyVals).Msg("msg")
+
+	buf := new(bytes.Buffer)
+	log.NewLogger(buf, log.OutputJSONOption(), log.TimeFormatOption("")).Info("msg", keyVals...)
+	assert.Equal(t, expected.String(), buf.String())
+}
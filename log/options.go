@@ -15,6 +15,7 @@ var defaultConfig = Config{
 	StackTrace: false,
 	TimeFormat: time.Kitchen,
 	Hooks:      nil,
+	RingBuffer: nil,
 }
 
 // Config defines configuration for the logger.
@@ -26,11 +27,14 @@ type Config struct {
 	StackTrace bool
 	TimeFormat string
 	Hooks      []zerolog.Hook
+	RingBuffer *RingBuffer
 }
 
 type Option func(*Config)
 
 // FilterOption sets the filter for the Logger.
+// The loggers of a module, returned by With(ModuleKey, module), discard the
+// entries of the levels filtered for the module before encoding their fields.
 func FilterOption(filter FilterFunc) Option {
 	return func(cfg *Config) {
 		cfg.Filter = filter
@@ -96,3 +100,11 @@ func HooksOption(hooks ...zerolog.Hook) Option {
 		cfg.Hooks = append(cfg.Hooks, hooks...)
 	}
 }
+
+// RingBufferOption additionally writes the log entries to the ring buffer,
+// formatted as they are written to the destination of the Logger.
+func RingBufferOption(rb *RingBuffer) Option {
+	return func(cfg *Config) {
+		cfg.RingBuffer = rb
+	}
+}
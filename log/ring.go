@@ -0,0 +1,92 @@
+package log
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// RingBuffer is an io.Writer keeping the last entries written to it in memory,
+// so that the recent logs of a node can be dumped on request or on a crash,
+// e.g. when its logs are not kept on disk. Every write is an entry, as the
+// loggers write each of their entries at once.
+// It is safe for concurrent use.
+type RingBuffer struct {
+	mu      sync.Mutex
+	entries [][]byte
+	// next is the index of the entry to overwrite
+	next int
+	full bool
+}
+
+// NewRingBuffer returns a RingBuffer keeping the last size entries.
+func NewRingBuffer(size int) *RingBuffer {
+	if size <= 0 {
+		panic("ring buffer size must be positive")
+	}
+	return &RingBuffer{entries: make([][]byte, size)}
+}
+
+// Write implements io.Writer, p is copied.
+func (rb *RingBuffer) Write(p []byte) (int, error) {
+	entry := make([]byte, len(p))
+	copy(entry, p)
+
+	rb.mu.Lock()
+	rb.entries[rb.next] = entry
+	rb.next++
+	if rb.next == len(rb.entries) {
+		rb.next, rb.full = 0, true
+	}
+	rb.mu.Unlock()
+	return len(p), nil
+}
+
+// Entries returns the entries of the buffer, from the oldest to the newest.
+// The entries must not be modified.
+func (rb *RingBuffer) Entries() [][]byte {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	if !rb.full {
+		return append([][]byte(nil), rb.entries[:rb.next]...)
+	}
+	return append(append(make([][]byte, 0, len(rb.entries)), rb.entries[rb.next:]...), rb.entries[:rb.next]...)
+}
+
+// WriteTo implements io.WriterTo, it writes the entries of the buffer to w,
+// from the oldest to the newest.
+func (rb *RingBuffer) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for _, entry := range rb.Entries() {
+		n, err := w.Write(entry)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ServeHTTP implements http.Handler, it responds with the entries of the
+// buffer, so that it can be mounted on an admin endpoint of a node.
+func (rb *RingBuffer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = rb.WriteTo(w)
+}
+
+// DumpOnPanic writes the entries of the buffer to w if the goroutine is
+// panicking, and then resumes panicking. It must be deferred:
+//
+//	defer rb.DumpOnPanic(os.Stderr)
+func (rb *RingBuffer) DumpOnPanic(w io.Writer) {
+	if r := recover(); r != nil {
+		_, _ = io.WriteString(w, "panic: dumping the last log entries\n")
+		_, _ = rb.WriteTo(w)
+		panic(r)
+	}
+}
@@ -0,0 +1,92 @@
+package log_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/log"
+)
+
+func TestRingBuffer(t *testing.T) {
+	rb := log.NewRingBuffer(3)
+	assert.Equal(t, len(rb.Entries()), 0)
+
+	p := []byte("first\n")
+	_, err := rb.Write(p)
+	assert.NilError(t, err)
+	// the entry is copied
+	copy(p, "xxxxx")
+	assert.DeepEqual(t, [][]byte{[]byte("first\n")}, rb.Entries())
+
+	for i := 0; i < 4; i++ {
+		_, err := fmt.Fprintf(rb, "entry %d\n", i)
+		assert.NilError(t, err)
+	}
+	assert.DeepEqual(t, [][]byte{[]byte("entry 1\n"), []byte("entry 2\n"), []byte("entry 3\n")}, rb.Entries())
+
+	buf := new(bytes.Buffer)
+	n, err := rb.WriteTo(buf)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	assert.Equal(t, "entry 1\nentry 2\nentry 3\n", buf.String())
+}
+
+func TestRingBufferServeHTTP(t *testing.T) {
+	rb := log.NewRingBuffer(2)
+	_, _ = rb.Write([]byte("first\n"))
+	_, _ = rb.Write([]byte("second\n"))
+
+	rec := httptest.NewRecorder()
+	rb.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/logs", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "first\nsecond\n", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	rb.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/logs", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestRingBufferDumpOnPanic(t *testing.T) {
+	rb := log.NewRingBuffer(2)
+	_, _ = rb.Write([]byte("before the crash\n"))
+
+	buf := new(bytes.Buffer)
+	func() {
+		defer func() {
+			assert.Equal(t, "boom", recover())
+		}()
+		defer rb.DumpOnPanic(buf)
+		panic("boom")
+	}()
+	assert.Assert(t, strings.HasSuffix(buf.String(), "before the crash\n"))
+
+	buf.Reset()
+	func() {
+		defer rb.DumpOnPanic(buf)
+	}()
+	assert.Equal(t, 0, buf.Len())
+}
+
+func TestLoggerRingBufferOption(t *testing.T) {
+	rb := log.NewRingBuffer(2)
+	buf := new(bytes.Buffer)
+	logger := log.NewLogger(buf, log.ColorOption(false), log.RingBufferOption(rb), log.LevelOption(zerolog.InfoLevel))
+	logger.Debug("filtered")
+	logger.Info("first")
+	logger.Info("second")
+	logger.Info("third")
+
+	entries := rb.Entries()
+	assert.Equal(t, len(entries), 2)
+	assert.Assert(t, strings.Contains(string(entries[0]), "second"))
+	assert.Assert(t, strings.Contains(string(entries[1]), "third"))
+	// the entries are formatted as they are written to the destination
+	assert.Assert(t, strings.HasSuffix(buf.String(), string(entries[0])+string(entries[1])))
+}
@@ -0,0 +1,170 @@
+package log
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rotatedTimeFormat is the format of the timestamp suffixed to the path of a
+// rotated log file, it sorts in chronological order.
+const rotatedTimeFormat = "20060102T150405.000000000"
+
+// RotationConfig defines when a RotatingFile is rotated, and how many of its
+// rotated files are kept.
+type RotationConfig struct {
+	// MaxSize is the size in bytes above which the file is rotated, 0 disables
+	// the rotation by size.
+	MaxSize int64
+	// MaxAge is the duration after which the file is rotated, 0 disables the
+	// rotation by time.
+	MaxAge time.Duration
+	// MaxBackups is the number of rotated files kept, the oldest ones are
+	// removed. 0 keeps all of them.
+	MaxBackups int
+}
+
+// RotatingFile is an io.WriteCloser appending to a log file, which it rotates
+// when it would exceed its maximum size, or when its maximum age is reached.
+// The rotated files are renamed to the path of the log file suffixed with the
+// UTC time of their rotation, e.g. node.log.20240102T150405.000000000.
+//
+// A single write is never split across files, so that a log entry larger than
+// the maximum size is written to a file of its own. It is safe for concurrent use.
+type RotatingFile struct {
+	path string
+	cfg  RotationConfig
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	now      func() time.Time
+}
+
+// NewRotatingFile opens, or creates, the log file at path, rotated as
+// configured by cfg.
+func NewRotatingFile(path string, cfg RotationConfig) (*RotatingFile, error) {
+	if cfg.MaxSize < 0 || cfg.MaxAge < 0 || cfg.MaxBackups < 0 {
+		return nil, fmt.Errorf("invalid log rotation config %+v: negative values are not allowed", cfg)
+	}
+
+	f := &RotatingFile{path: path, cfg: cfg, now: time.Now}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Write implements io.Writer.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if f.size > 0 && (f.cfg.MaxSize > 0 && f.size+int64(len(p)) > f.cfg.MaxSize ||
+		f.cfg.MaxAge > 0 && f.now().Sub(f.openedAt) >= f.cfg.MaxAge) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Rotate rotates the log file, e.g. on SIGHUP.
+func (f *RotatingFile) Rotate() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return os.ErrClosed
+	}
+	return f.rotate()
+}
+
+// Close closes the log file.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *RotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	f.file, f.size, f.openedAt = file, info.Size(), f.now()
+	return nil
+}
+
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+	f.file = nil
+
+	rotated := f.path + "." + f.now().UTC().Format(rotatedTimeFormat)
+	if err := os.Rename(f.path, rotated); err != nil {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	return f.removeOldBackups()
+}
+
+// removeOldBackups removes the oldest rotated files beyond MaxBackups.
+func (f *RotatingFile) removeOldBackups() error {
+	if f.cfg.MaxBackups == 0 {
+		return nil
+	}
+
+	matches, err := filepath.Glob(f.path + ".*")
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, match := range matches {
+		if _, err := time.Parse(rotatedTimeFormat, strings.TrimPrefix(match, f.path+".")); err == nil {
+			backups = append(backups, match)
+		}
+	}
+	if len(backups) <= f.cfg.MaxBackups {
+		return nil
+	}
+
+	sort.Strings(backups)
+	for _, backup := range backups[:len(backups)-f.cfg.MaxBackups] {
+		if err := os.Remove(backup); err != nil {
+			return fmt.Errorf("failed to remove rotated log file: %w", err)
+		}
+	}
+	return nil
+}
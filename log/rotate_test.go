@@ -0,0 +1,105 @@
+package log_test
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/log"
+)
+
+func TestRotatingFileBySize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "node.log")
+	f, err := log.NewRotatingFile(path, log.RotationConfig{MaxSize: 10, MaxBackups: 2})
+	assert.NilError(t, err)
+	defer f.Close()
+
+	for _, entry := range []string{"first\n", "second\n", "third\n", "a much longer entry\n", "last\n"} {
+		_, err := f.Write([]byte(entry))
+		assert.NilError(t, err)
+	}
+
+	assert.Equal(t, "last\n", readFile(t, path))
+
+	// only the two newest rotated files are kept
+	backups := rotatedFiles(t, path)
+	assert.Equal(t, len(backups), 2)
+	assert.Equal(t, "third\n", readFile(t, backups[0]))
+	assert.Equal(t, "a much longer entry\n", readFile(t, backups[1]))
+}
+
+func TestRotatingFileByAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.log")
+	f, err := log.NewRotatingFile(path, log.RotationConfig{MaxAge: time.Millisecond})
+	assert.NilError(t, err)
+	defer f.Close()
+
+	_, err = f.Write([]byte("first\n"))
+	assert.NilError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	_, err = f.Write([]byte("second\n"))
+	assert.NilError(t, err)
+
+	assert.Equal(t, "second\n", readFile(t, path))
+	backups := rotatedFiles(t, path)
+	assert.Equal(t, len(backups), 1)
+	assert.Equal(t, "first\n", readFile(t, backups[0]))
+}
+
+func TestRotatingFileAppendsAndRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.log")
+	assert.NilError(t, os.WriteFile(path, []byte("existing\n"), 0o600))
+
+	f, err := log.NewRotatingFile(path, log.RotationConfig{})
+	assert.NilError(t, err)
+	_, err = f.Write([]byte("appended\n"))
+	assert.NilError(t, err)
+	assert.Equal(t, "existing\nappended\n", readFile(t, path))
+
+	assert.NilError(t, f.Rotate())
+	assert.Equal(t, "", readFile(t, path))
+	assert.Equal(t, len(rotatedFiles(t, path)), 1)
+
+	assert.NilError(t, f.Close())
+	_, err = f.Write([]byte("closed\n"))
+	assert.ErrorIs(t, err, os.ErrClosed)
+
+	_, err = log.NewRotatingFile(path, log.RotationConfig{MaxSize: -1})
+	assert.ErrorContains(t, err, "negative values are not allowed")
+}
+
+func TestLoggerWithRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.log")
+	f, err := log.NewRotatingFile(path, log.RotationConfig{MaxSize: 1})
+	assert.NilError(t, err)
+	defer f.Close()
+
+	logger := log.NewLogger(f, log.OutputJSONOption())
+	logger.Info("first")
+	logger.Info("second")
+
+	assert.Assert(t, strings.Contains(readFile(t, path), `"message":"second"`))
+	backups := rotatedFiles(t, path)
+	assert.Equal(t, len(backups), 1)
+	assert.Assert(t, strings.Contains(readFile(t, backups[0]), `"message":"first"`))
+}
+
+func rotatedFiles(t *testing.T, path string) []string {
+	t.Helper()
+	matches, err := filepath.Glob(path + ".*")
+	assert.NilError(t, err)
+	sort.Strings(matches)
+	return matches
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	bz, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	return string(bz)
+}
@@ -0,0 +1,492 @@
+package math
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxDecPrecision is the maximum number of decimal places of a Dec.
+const MaxDecPrecision = 64
+
+// Dec errors
+var (
+	// ErrInvalidDec is the error returned when a string is not a valid decimal
+	ErrInvalidDec = errors.New("invalid decimal")
+	// ErrInvalidDecPrecision is the error returned when a precision exceeds MaxDecPrecision
+	ErrInvalidDecPrecision = errors.New("invalid decimal precision")
+	// ErrDecOverflow is the error returned when a decimal overflow occurs
+	ErrDecOverflow = errors.New("decimal overflow")
+)
+
+// RoundingMode defines how a decimal is rounded when digits are removed from it.
+type RoundingMode uint8
+
+const (
+	// RoundHalfEven rounds to the nearest neighbour, ties to the even one
+	// (bankers rounding, as LegacyDec does).
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest neighbour, ties away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest neighbour, ties towards zero.
+	RoundHalfDown
+	// RoundDown rounds towards zero, i.e. truncates.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+)
+
+// String implements fmt.Stringer.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "half_even"
+	case RoundHalfUp:
+		return "half_up"
+	case RoundHalfDown:
+		return "half_down"
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	case RoundFloor:
+		return "floor"
+	case RoundCeiling:
+		return "ceiling"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", m)
+	}
+}
+
+var (
+	// pow10s[n] is 10^n, do not mutate
+	pow10s [2*MaxDecPrecision + 1]*big.Int
+	// decBounds[prec] is the exclusive bound of the absolute coefficient of a
+	// Dec with precision prec, do not mutate
+	decBounds [MaxDecPrecision + 1]*big.Int
+)
+
+func init() {
+	maxInt := new(big.Int).Lsh(oneInt, MaxBitLen)
+	for n := range pow10s {
+		pow10s[n] = new(big.Int).Exp(tenInt, big.NewInt(int64(n)), nil)
+	}
+	for prec := range decBounds {
+		decBounds[prec] = new(big.Int).Mul(maxInt, pow10s[prec])
+	}
+}
+
+// Dec is a signed decimal number with a fixed number of decimal places, its
+// precision, of up to MaxDecPrecision. Unlike LegacyDec, whose precision is
+// always 18, the precision of a Dec is chosen by its user, and every operation
+// which may remove digits takes the precision of its result and a RoundingMode.
+//
+// As for Int, the absolute value of a Dec is less than 2^MaxBitLen, the
+// operations whose result would exceed it fail with ErrDecOverflow. A Dec is
+// immutable, and its zero value is 0 with a precision of 0.
+type Dec struct {
+	// i is the coefficient of the decimal, which is i * 10^-prec
+	i    *big.Int
+	prec uint32
+}
+
+// NewDecFromInt64 creates a Dec with a precision of 0 from an integer.
+func NewDecFromInt64(i int64) Dec {
+	return Dec{i: big.NewInt(i)}
+}
+
+// NewDecWithPrec creates the Dec coeff * 10^-prec, with a precision of prec.
+// CONTRACT: prec <= MaxDecPrecision
+func NewDecWithPrec(coeff int64, prec uint32) Dec {
+	if prec > MaxDecPrecision {
+		panic(fmt.Sprintf("too much precision, maximum %v, provided %v", MaxDecPrecision, prec))
+	}
+	return Dec{i: big.NewInt(coeff), prec: prec}
+}
+
+// NewDecFromInt creates a Dec with a precision of 0 from an Int.
+func NewDecFromInt(i Int) Dec {
+	if i.IsNil() {
+		return Dec{}
+	}
+	return Dec{i: i.BigInt()}
+}
+
+// NewDecFromBigIntWithPrec creates the Dec coeff * 10^-prec, with a precision
+// of prec. The coefficient is copied.
+func NewDecFromBigIntWithPrec(coeff *big.Int, prec uint32) (Dec, error) {
+	if coeff == nil {
+		return Dec{}, ErrInvalidDec
+	}
+	return newDec(new(big.Int).Set(coeff), prec)
+}
+
+// NewDecFromLegacyDec creates a Dec with a precision of LegacyPrecision from a
+// LegacyDec, a nil LegacyDec is 0.
+func NewDecFromLegacyDec(d LegacyDec) Dec {
+	if d.IsNil() {
+		return Dec{prec: LegacyPrecision}
+	}
+	return Dec{i: d.BigInt(), prec: LegacyPrecision}
+}
+
+// NewDecFromString creates a Dec from a decimal string of the form
+//
+//	(-) whole integers (.) decimal integers
+//
+// The precision of the Dec is the number of its decimal integers, so that
+// "1.50" has a precision of 2.
+func NewDecFromString(s string) (Dec, error) {
+	str := s
+	neg := false
+	if len(str) > 0 && str[0] == '-' {
+		neg = true
+		str = str[1:]
+	}
+
+	whole, frac, hasPoint := strings.Cut(str, ".")
+	if !hasOnlyDigits(whole) || (hasPoint && !hasOnlyDigits(frac)) {
+		return Dec{}, fmt.Errorf("%w: %q", ErrInvalidDec, s)
+	}
+	if len(frac) > MaxDecPrecision {
+		return Dec{}, fmt.Errorf("%w: %q has %d decimal places, max %d", ErrInvalidDecPrecision, s, len(frac), MaxDecPrecision)
+	}
+
+	coeff, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return Dec{}, fmt.Errorf("%w: %q", ErrInvalidDec, s)
+	}
+	if neg {
+		coeff.Neg(coeff)
+	}
+	return newDec(coeff, uint32(len(frac)))
+}
+
+// MustNewDecFromString creates a Dec from a decimal string, it panics on error.
+func MustNewDecFromString(s string) Dec {
+	d, err := NewDecFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// newDec creates a Dec owning coeff, checking its precision and range.
+func newDec(coeff *big.Int, prec uint32) (Dec, error) {
+	if prec > MaxDecPrecision {
+		return Dec{}, fmt.Errorf("%w: %d, max %d", ErrInvalidDecPrecision, prec, MaxDecPrecision)
+	}
+	if coeff.CmpAbs(decBounds[prec]) >= 0 {
+		return Dec{}, ErrDecOverflow
+	}
+	return Dec{i: coeff, prec: prec}, nil
+}
+
+// coeff returns the coefficient of d, do not mutate the result.
+func (d Dec) coeff() *big.Int {
+	if d.i == nil {
+		return zeroInt
+	}
+	return d.i
+}
+
+// scaledCoeff returns the coefficient of d scaled to prec >= d.prec, do not
+// mutate the result.
+func (d Dec) scaledCoeff(prec uint32) *big.Int {
+	if prec == d.prec {
+		return d.coeff()
+	}
+	return new(big.Int).Mul(d.coeff(), pow10(prec-d.prec))
+}
+
+// Precision returns the number of decimal places of d.
+func (d Dec) Precision() uint32 { return d.prec }
+
+// Coefficient returns a copy of the coefficient of d, which is
+// d * 10^Precision().
+func (d Dec) Coefficient() *big.Int { return new(big.Int).Set(d.coeff()) }
+
+func (d Dec) Sign() int        { return d.coeff().Sign() }      // sign of the decimal, -1, 0 or 1
+func (d Dec) IsZero() bool     { return d.coeff().Sign() == 0 } // is equal to zero
+func (d Dec) IsNegative() bool { return d.coeff().Sign() < 0 }  // is negative
+func (d Dec) IsPositive() bool { return d.coeff().Sign() > 0 }  // is positive
+
+// Cmp compares d and d2, returning -1, 0 or +1 when d is less than, equal to or
+// greater than d2. The precisions of d and d2 are ignored, 1.5 equals 1.50.
+func (d Dec) Cmp(d2 Dec) int {
+	prec := Max(d.prec, d2.prec)
+	return d.scaledCoeff(prec).Cmp(d2.scaledCoeff(prec))
+}
+
+func (d Dec) Equal(d2 Dec) bool { return d.Cmp(d2) == 0 } // equal decimals
+func (d Dec) GT(d2 Dec) bool    { return d.Cmp(d2) > 0 }  // greater than
+func (d Dec) GTE(d2 Dec) bool   { return d.Cmp(d2) >= 0 } // greater than or equal
+func (d Dec) LT(d2 Dec) bool    { return d.Cmp(d2) < 0 }  // less than
+func (d Dec) LTE(d2 Dec) bool   { return d.Cmp(d2) <= 0 } // less than or equal
+
+// Neg returns -d.
+func (d Dec) Neg() Dec { return Dec{i: new(big.Int).Neg(d.coeff()), prec: d.prec} }
+
+// Abs returns the absolute value of d.
+func (d Dec) Abs() Dec { return Dec{i: new(big.Int).Abs(d.coeff()), prec: d.prec} }
+
+// IsInteger returns true when d has no fractional part.
+func (d Dec) IsInteger() bool {
+	return new(big.Int).Rem(d.coeff(), pow10(d.prec)).Sign() == 0
+}
+
+// Add returns d + d2, with the greatest precision of d and d2.
+func (d Dec) Add(d2 Dec) (Dec, error) {
+	prec := Max(d.prec, d2.prec)
+	return newDec(new(big.Int).Add(d.scaledCoeff(prec), d2.scaledCoeff(prec)), prec)
+}
+
+// Sub returns d - d2, with the greatest precision of d and d2.
+func (d Dec) Sub(d2 Dec) (Dec, error) {
+	prec := Max(d.prec, d2.prec)
+	return newDec(new(big.Int).Sub(d.scaledCoeff(prec), d2.scaledCoeff(prec)), prec)
+}
+
+// Mul returns d * d2 with a precision of prec, rounded with mode.
+func (d Dec) Mul(d2 Dec, prec uint32, mode RoundingMode) (Dec, error) {
+	if prec > MaxDecPrecision {
+		return Dec{}, fmt.Errorf("%w: %d, max %d", ErrInvalidDecPrecision, prec, MaxDecPrecision)
+	}
+	// the exact product has a precision of d.prec + d2.prec
+	coeff := new(big.Int).Mul(d.coeff(), d2.coeff())
+	if exact := d.prec + d2.prec; prec >= exact {
+		coeff.Mul(coeff, pow10(prec-exact))
+	} else {
+		coeff = quoRound(coeff, pow10(exact-prec), mode)
+	}
+	return newDec(coeff, prec)
+}
+
+// Quo returns d / d2 with a precision of prec, rounded with mode.
+func (d Dec) Quo(d2 Dec, prec uint32, mode RoundingMode) (Dec, error) {
+	if prec > MaxDecPrecision {
+		return Dec{}, fmt.Errorf("%w: %d, max %d", ErrInvalidDecPrecision, prec, MaxDecPrecision)
+	}
+	if d2.IsZero() {
+		return Dec{}, ErrDivideByZero
+	}
+	// d / d2 = d.i / d2.i * 10^(d2.prec - d.prec), scaled by 10^prec
+	num, den := d.coeff(), d2.coeff()
+	if shift := int64(prec) + int64(d2.prec) - int64(d.prec); shift >= 0 {
+		num = new(big.Int).Mul(num, pow10(uint32(shift)))
+	} else {
+		den = new(big.Int).Mul(den, pow10(uint32(-shift)))
+	}
+	return newDec(quoRound(num, den, mode), prec)
+}
+
+// Round returns d with a precision of prec, rounded with mode when prec is
+// less than the precision of d.
+func (d Dec) Round(prec uint32, mode RoundingMode) (Dec, error) {
+	if prec > MaxDecPrecision {
+		return Dec{}, fmt.Errorf("%w: %d, max %d", ErrInvalidDecPrecision, prec, MaxDecPrecision)
+	}
+	if prec >= d.prec {
+		return newDec(new(big.Int).Set(d.scaledCoeff(prec)), prec)
+	}
+	return newDec(quoRound(d.coeff(), pow10(d.prec-prec), mode), prec)
+}
+
+// Reduce returns d with the smallest precision representing it exactly, i.e.
+// without trailing zeros.
+func (d Dec) Reduce() Dec {
+	if d.IsZero() {
+		return Dec{i: new(big.Int)}
+	}
+	coeff, prec := d.coeff(), d.prec
+	quo, rem := new(big.Int), new(big.Int)
+	for prec > 0 {
+		quo.QuoRem(coeff, tenInt, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+		coeff, quo = quo, new(big.Int)
+		prec--
+	}
+	return Dec{i: new(big.Int).Set(coeff), prec: prec}
+}
+
+// ToInt returns d rounded to an integer with mode.
+func (d Dec) ToInt(mode RoundingMode) (Int, error) {
+	coeff := quoRound(d.coeff(), pow10(d.prec), mode)
+	if coeff.BitLen() > MaxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+	return NewIntFromBigIntMut(coeff), nil
+}
+
+// Int64 returns d rounded to an int64 with mode.
+func (d Dec) Int64(mode RoundingMode) (int64, error) {
+	coeff := quoRound(d.coeff(), pow10(d.prec), mode)
+	if !coeff.IsInt64() {
+		return 0, ErrIntOverflow
+	}
+	return coeff.Int64(), nil
+}
+
+// ToLegacyDec returns d as a LegacyDec, rounded with mode when d has a
+// precision greater than LegacyPrecision.
+func (d Dec) ToLegacyDec(mode RoundingMode) (LegacyDec, error) {
+	r, err := d.Round(LegacyPrecision, mode)
+	if err != nil {
+		return LegacyDec{}, err
+	}
+	if r.i.BitLen() > maxDecBitLen {
+		return LegacyDec{}, fmt.Errorf("decimal out of range; got: %d, max: %d", r.i.BitLen(), maxDecBitLen)
+	}
+	return LegacyDec{r.i}, nil
+}
+
+// Float64 returns the float64 representation of d.
+func (d Dec) Float64() (float64, error) {
+	return strconv.ParseFloat(d.String(), 64)
+}
+
+// String returns d with exactly Precision() decimal places.
+func (d Dec) String() string {
+	digits := new(big.Int).Abs(d.coeff()).String()
+	prec := int(d.prec)
+
+	var sb strings.Builder
+	if d.IsNegative() {
+		sb.WriteByte('-')
+	}
+	if prec == 0 {
+		sb.WriteString(digits)
+		return sb.String()
+	}
+	if len(digits) <= prec {
+		sb.WriteString("0.")
+		sb.WriteString(strings.Repeat("0", prec-len(digits)))
+		sb.WriteString(digits)
+		return sb.String()
+	}
+	sb.WriteString(digits[:len(digits)-prec])
+	sb.WriteByte('.')
+	sb.WriteString(digits[len(digits)-prec:])
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Dec) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Dec) UnmarshalText(text []byte) error {
+	v, err := NewDecFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON marshals the decimal as a string.
+func (d Dec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON unmarshals the decimal from a string.
+func (d *Dec) UnmarshalJSON(bz []byte) error {
+	var text string
+	if err := json.Unmarshal(bz, &text); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(text))
+}
+
+// MarshalYAML returns the YAML representation.
+func (d Dec) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// Marshal implements the gogo proto custom type interface, a Dec is encoded as
+// its string, preserving its precision.
+func (d Dec) Marshal() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (d *Dec) MarshalTo(data []byte) (n int, err error) {
+	return copy(data, d.String()), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface, empty data is 0.
+func (d *Dec) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*d = Dec{}
+		return nil
+	}
+	return d.UnmarshalText(data)
+}
+
+// Size implements the gogo proto custom type interface.
+func (d *Dec) Size() int {
+	return len(d.String())
+}
+
+// pow10 returns 10^n, do not mutate the result.
+func pow10(n uint32) *big.Int {
+	if int(n) < len(pow10s) {
+		return pow10s[n]
+	}
+	return new(big.Int).Exp(tenInt, big.NewInt(int64(n)), nil)
+}
+
+// quoRound returns num / den rounded to an integer with mode. It does not
+// mutate its arguments.
+func quoRound(num, den *big.Int, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// the exact quotient is between quo and quo + 1 away from zero
+	neg := num.Sign() != den.Sign()
+	var away bool
+	switch mode {
+	case RoundDown:
+		away = false
+	case RoundUp:
+		away = true
+	case RoundFloor:
+		away = neg
+	case RoundCeiling:
+		away = !neg
+	case RoundHalfEven, RoundHalfUp, RoundHalfDown:
+		// compare the remainder to half of the divisor
+		half := new(big.Int).Abs(rem)
+		half.Lsh(half, 1)
+		switch half.CmpAbs(den) {
+		case -1:
+			away = false
+		case 1:
+			away = true
+		default:
+			away = mode == RoundHalfUp || (mode == RoundHalfEven && quo.Bit(0) == 1)
+		}
+	default:
+		panic(fmt.Sprintf("invalid rounding mode %s", mode))
+	}
+
+	if !away {
+		return quo
+	}
+	if neg {
+		return quo.Sub(quo, oneInt)
+	}
+	return quo.Add(quo, oneInt)
+}
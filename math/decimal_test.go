@@ -0,0 +1,283 @@
+package math_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestNewDecFromString(t *testing.T) {
+	tests := []struct {
+		str     string
+		exp     string
+		prec    uint32
+		wantErr error
+	}{
+		{str: "0", exp: "0", prec: 0},
+		{str: "-0.00", exp: "0.00", prec: 2},
+		{str: "123", exp: "123", prec: 0},
+		{str: "-123.4560", exp: "-123.4560", prec: 4},
+		{str: "0.000001", exp: "0.000001", prec: 6},
+		{str: "007.5", exp: "7.5", prec: 1},
+		{str: "0." + strings.Repeat("1", math.MaxDecPrecision), exp: "0." + strings.Repeat("1", math.MaxDecPrecision), prec: math.MaxDecPrecision},
+		{str: "0." + strings.Repeat("1", math.MaxDecPrecision+1), wantErr: math.ErrInvalidDecPrecision},
+		{str: "", wantErr: math.ErrInvalidDec},
+		{str: "-", wantErr: math.ErrInvalidDec},
+		{str: "+1", wantErr: math.ErrInvalidDec},
+		{str: ".5", wantErr: math.ErrInvalidDec},
+		{str: "5.", wantErr: math.ErrInvalidDec},
+		{str: "1.2.3", wantErr: math.ErrInvalidDec},
+		{str: "1e5", wantErr: math.ErrInvalidDec},
+		{str: "1_000", wantErr: math.ErrInvalidDec},
+		{str: " 1", wantErr: math.ErrInvalidDec},
+		{str: new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen).String(), wantErr: math.ErrDecOverflow},
+		{str: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1)).String() + ".999", exp: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1)).String() + ".999", prec: 3},
+	}
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			d, err := math.NewDecFromString(tc.str)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, d.String())
+			require.Equal(t, tc.prec, d.Precision())
+		})
+	}
+}
+
+func TestDecZeroValue(t *testing.T) {
+	var d math.Dec
+	require.True(t, d.IsZero())
+	require.Equal(t, "0", d.String())
+	require.True(t, d.Equal(math.NewDecWithPrec(0, 5)))
+
+	sum, err := d.Add(math.NewDecWithPrec(15, 1))
+	require.NoError(t, err)
+	require.Equal(t, "1.5", sum.String())
+}
+
+func TestDecComparison(t *testing.T) {
+	a := math.MustNewDecFromString("1.5")
+	b := math.MustNewDecFromString("1.500")
+	c := math.MustNewDecFromString("-2.25")
+
+	require.True(t, a.Equal(b))
+	require.Equal(t, 0, a.Cmp(b))
+	require.True(t, c.LT(a))
+	require.True(t, a.GT(c))
+	require.True(t, a.GTE(b))
+	require.True(t, a.LTE(b))
+	require.Equal(t, -1, c.Sign())
+	require.Equal(t, "2.25", c.Abs().String())
+	require.Equal(t, "2.25", c.Neg().String())
+	require.True(t, math.MustNewDecFromString("3.000").IsInteger())
+	require.False(t, a.IsInteger())
+}
+
+func TestDecArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func() (math.Dec, error)
+		exp  string
+	}{
+		{"add keeps the greatest precision", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1.5").Add(math.MustNewDecFromString("0.25"))
+		}, "1.75"},
+		{"sub keeps the greatest precision", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1").Sub(math.MustNewDecFromString("1.001"))
+		}, "-0.001"},
+		{"mul pads", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1.5").Mul(math.MustNewDecFromString("2"), 4, math.RoundHalfEven)
+		}, "3.0000"},
+		{"mul rounds", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1.25").Mul(math.MustNewDecFromString("0.5"), 2, math.RoundHalfEven)
+		}, "0.62"},
+		{"mul rounds up", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1.25").Mul(math.MustNewDecFromString("0.5"), 2, math.RoundHalfUp)
+		}, "0.63"},
+		{"quo", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1").Quo(math.MustNewDecFromString("3"), 6, math.RoundHalfEven)
+		}, "0.333333"},
+		{"quo ceiling", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1").Quo(math.MustNewDecFromString("3"), 6, math.RoundCeiling)
+		}, "0.333334"},
+		{"quo by a more precise divisor", func() (math.Dec, error) {
+			return math.MustNewDecFromString("-2").Quo(math.MustNewDecFromString("0.0003"), 0, math.RoundFloor)
+		}, "-6667"},
+		{"quo to a smaller precision", func() (math.Dec, error) {
+			return math.MustNewDecFromString("10.000").Quo(math.MustNewDecFromString("4"), 1, math.RoundDown)
+		}, "2.5"},
+		{"round down", func() (math.Dec, error) {
+			return math.MustNewDecFromString("-1.999").Round(1, math.RoundDown)
+		}, "-1.9"},
+		{"round pads", func() (math.Dec, error) {
+			return math.MustNewDecFromString("1.5").Round(3, math.RoundDown)
+		}, "1.500"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.op()
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, got.String())
+		})
+	}
+}
+
+func TestDecRoundingModes(t *testing.T) {
+	values := []string{"2.5", "-2.5", "1.5", "-1.5", "1.51", "-1.49", "1.2", "-1.2", "3"}
+	exp := map[math.RoundingMode][]string{
+		math.RoundHalfEven: {"2", "-2", "2", "-2", "2", "-1", "1", "-1", "3"},
+		math.RoundHalfUp:   {"3", "-3", "2", "-2", "2", "-1", "1", "-1", "3"},
+		math.RoundHalfDown: {"2", "-2", "1", "-1", "2", "-1", "1", "-1", "3"},
+		math.RoundDown:     {"2", "-2", "1", "-1", "1", "-1", "1", "-1", "3"},
+		math.RoundUp:       {"3", "-3", "2", "-2", "2", "-2", "2", "-2", "3"},
+		math.RoundFloor:    {"2", "-3", "1", "-2", "1", "-2", "1", "-2", "3"},
+		math.RoundCeiling:  {"3", "-2", "2", "-1", "2", "-1", "2", "-1", "3"},
+	}
+	for mode, exps := range exp {
+		t.Run(mode.String(), func(t *testing.T) {
+			for i, v := range values {
+				got, err := math.MustNewDecFromString(v).Round(0, mode)
+				require.NoError(t, err)
+				require.Equal(t, exps[i], got.String(), "rounding %s", v)
+
+				i64, err := math.MustNewDecFromString(v).Int64(mode)
+				require.NoError(t, err)
+				require.Equal(t, exps[i], math.NewDecFromInt64(i64).String(), "rounding %s", v)
+			}
+		})
+	}
+
+	require.Panics(t, func() {
+		_, _ = math.MustNewDecFromString("0.5").Round(0, math.RoundingMode(42))
+	})
+}
+
+func TestDecErrors(t *testing.T) {
+	maxDec := math.NewDecFromInt(math.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1))))
+	one := math.NewDecFromInt64(1)
+
+	_, err := maxDec.Add(one)
+	require.ErrorIs(t, err, math.ErrDecOverflow)
+	_, err = maxDec.Neg().Sub(one)
+	require.ErrorIs(t, err, math.ErrDecOverflow)
+	_, err = maxDec.Mul(math.NewDecFromInt64(2), 0, math.RoundDown)
+	require.ErrorIs(t, err, math.ErrDecOverflow)
+	_, err = maxDec.Quo(math.MustNewDecFromString("0.5"), 0, math.RoundDown)
+	require.ErrorIs(t, err, math.ErrDecOverflow)
+	_, err = one.Quo(math.MustNewDecFromString("0.000"), 2, math.RoundDown)
+	require.ErrorIs(t, err, math.ErrDivideByZero)
+	_, err = one.Round(math.MaxDecPrecision+1, math.RoundDown)
+	require.ErrorIs(t, err, math.ErrInvalidDecPrecision)
+	_, err = one.Mul(one, math.MaxDecPrecision+1, math.RoundDown)
+	require.ErrorIs(t, err, math.ErrInvalidDecPrecision)
+	_, err = math.NewDecFromBigIntWithPrec(big.NewInt(1), math.MaxDecPrecision+1)
+	require.ErrorIs(t, err, math.ErrInvalidDecPrecision)
+	_, err = math.NewDecFromInt64(1<<62).Mul(math.NewDecFromInt64(4), 0, math.RoundDown)
+	require.NoError(t, err)
+	_, err = math.MustNewDecFromString("9223372036854775807.5").Int64(math.RoundUp)
+	require.ErrorIs(t, err, math.ErrIntOverflow)
+
+	require.Panics(t, func() { math.NewDecWithPrec(1, math.MaxDecPrecision+1) })
+}
+
+func TestDecImmutability(t *testing.T) {
+	coeff := big.NewInt(15)
+	d, err := math.NewDecFromBigIntWithPrec(coeff, 1)
+	require.NoError(t, err)
+	coeff.SetInt64(7)
+	require.Equal(t, "1.5", d.String())
+
+	d.Coefficient().SetInt64(7)
+	_, err = d.Add(d)
+	require.NoError(t, err)
+	_, err = d.Mul(d, 0, math.RoundDown)
+	require.NoError(t, err)
+	_, err = d.Round(5, math.RoundDown)
+	require.NoError(t, err)
+	_ = d.Reduce()
+	require.Equal(t, "1.5", d.String())
+}
+
+func TestDecReduce(t *testing.T) {
+	require.Equal(t, "1.5", math.MustNewDecFromString("1.5000").Reduce().String())
+	require.Equal(t, "-100", math.MustNewDecFromString("-100.00").Reduce().String())
+	require.Equal(t, "0", math.MustNewDecFromString("0.000").Reduce().String())
+	require.Equal(t, "0.01", math.MustNewDecFromString("0.01").Reduce().String())
+}
+
+func TestDecLegacyCompat(t *testing.T) {
+	legacy := math.LegacyMustNewDecFromStr("-12.345")
+	d := math.NewDecFromLegacyDec(legacy)
+	require.Equal(t, uint32(math.LegacyPrecision), d.Precision())
+	require.True(t, d.Equal(math.MustNewDecFromString("-12.345")))
+	require.True(t, math.NewDecFromLegacyDec(math.LegacyDec{}).IsZero())
+
+	back, err := d.ToLegacyDec(math.RoundHalfEven)
+	require.NoError(t, err)
+	require.True(t, legacy.Equal(back))
+
+	precise := math.MustNewDecFromString("0." + strings.Repeat("0", 17) + "15")
+	got, err := precise.ToLegacyDec(math.RoundHalfEven)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 18), got)
+	got, err = precise.ToLegacyDec(math.RoundDown)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 18), got)
+
+	i, err := math.MustNewDecFromString("7.5").ToInt(math.RoundHalfEven)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(8), i)
+	require.True(t, math.NewDecFromInt(math.NewInt(-3)).Equal(math.NewDecFromInt64(-3)))
+
+	f, err := math.MustNewDecFromString("-0.125").Float64()
+	require.NoError(t, err)
+	require.Equal(t, -0.125, f)
+}
+
+func TestDecEncoding(t *testing.T) {
+	d := math.MustNewDecFromString("-1.500")
+
+	bz, err := json.Marshal(d)
+	require.NoError(t, err)
+	require.Equal(t, `"-1.500"`, string(bz))
+	var fromJSON math.Dec
+	require.NoError(t, json.Unmarshal(bz, &fromJSON))
+	require.Equal(t, d.String(), fromJSON.String())
+	require.Error(t, json.Unmarshal([]byte(`"1.5x"`), &fromJSON))
+	require.Error(t, json.Unmarshal([]byte(`1.5`), &fromJSON))
+
+	bz, err = d.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, d.Size(), len(bz))
+	buf := make([]byte, d.Size())
+	n, err := d.MarshalTo(buf)
+	require.NoError(t, err)
+	require.Equal(t, bz, buf[:n])
+	var fromProto math.Dec
+	require.NoError(t, fromProto.Unmarshal(bz))
+	require.Equal(t, d.String(), fromProto.String())
+	require.Equal(t, uint32(3), fromProto.Precision())
+	require.NoError(t, fromProto.Unmarshal(nil))
+	require.True(t, fromProto.IsZero())
+
+	y, err := d.MarshalYAML()
+	require.NoError(t, err)
+	require.Equal(t, "-1.500", y)
+}
+
+func BenchmarkDecQuo(b *testing.B) {
+	d1 := math.MustNewDecFromString("1234567890.123456789")
+	d2 := math.MustNewDecFromString("0.000000007")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = d1.Quo(d2, 18, math.RoundHalfEven)
+	}
+}
@@ -2,5 +2,9 @@
 Package math implements custom Cosmos SDK math types used for arithmetic
 operations. Signed and unsigned integer types utilize Golang's standard library
 big integers types, having a maximum bit length of 256 bits.
+
+Decimals are implemented by LegacyDec, with a fixed precision of 18 decimal
+places and bankers rounding, and by Dec, whose precision and rounding mode are
+chosen by its user.
 */
 package math
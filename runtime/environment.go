@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/log"
 	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -57,6 +58,13 @@ func EnvWithQueryRouterService(queryServiceRouter *baseapp.GRPCQueryRouter) EnvO
 	}
 }
 
+// EnvWithStoreGasConfig sets the gas config returned by the environment gas service.
+func EnvWithStoreGasConfig(gasConfig *storetypes.GasConfig) EnvOption {
+	return func(env *appmodule.Environment) {
+		env.GasService = GasService{gasConfig: fixedGasConfig(gasConfig)}
+	}
+}
+
 func EnvWithMemStoreService(memStoreService store.MemoryStoreService) EnvOption {
 	return func(env *appmodule.Environment) {
 		env.MemStoreService = memStoreService
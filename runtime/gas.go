@@ -12,7 +12,61 @@ import (
 
 var _ gas.Service = GasService{}
 
-type GasService struct{}
+// GasCosts defines the gas costs charged to modules and transactions, replacing
+// the SDK defaults. It lets app chains tune their economics without forking, and
+// can be supplied to the app with depinject.Supply:
+//
+//	depinject.Supply(&runtime.GasCosts{
+//		Default:   &storetypes.GasConfig{...},
+//		Modules:   map[string]storetypes.GasConfig{"bank": {...}},
+//		SigVerify: map[string]uint64{"cosmos.crypto.secp256k1.PubKey": 1000},
+//	})
+type GasCosts struct {
+	// Default is the KV store gas config of the modules not present in Modules.
+	// When nil, the gas config of the context (storetypes.KVGasConfig) is used.
+	Default *storetypes.GasConfig
+	// Modules maps a module name to its KV store gas config.
+	Modules map[string]storetypes.GasConfig
+	// SigVerify maps the proto message names of public key types to the gas
+	// consumed by the verification of their signatures, in place of the costs
+	// of the x/auth params, see ante.NewSigVerificationGasConsumer.
+	SigVerify map[string]uint64
+}
+
+// ForModule returns the KV store gas config of the given module, or nil if the
+// gas config of the context must be used.
+func (c *GasCosts) ForModule(moduleName string) *storetypes.GasConfig {
+	if c == nil {
+		return nil
+	}
+
+	if cfg, ok := c.Modules[moduleName]; ok {
+		return &cfg
+	}
+
+	return c.Default
+}
+
+// storeGasConfig returns the function resolving the KV store gas config of the
+// given module. The gas costs are resolved lazily, as they are only set on the
+// app by SetupAppBuilder, after the environments of the modules are provided.
+func (a *AppBuilder) storeGasConfig(moduleName string) func() *storetypes.GasConfig {
+	return func() *storetypes.GasConfig {
+		return a.app.gasCosts.ForModule(moduleName)
+	}
+}
+
+// fixedGasConfig returns a function always resolving to the given gas config.
+func fixedGasConfig(gasConfig *storetypes.GasConfig) func() *storetypes.GasConfig {
+	return func() *storetypes.GasConfig {
+		return gasConfig
+	}
+}
+
+type GasService struct {
+	// gasConfig resolves the gas config overriding the one of the context, if any.
+	gasConfig func() *storetypes.GasConfig
+}
 
 func (g GasService) GasMeter(ctx context.Context) gas.Meter {
 	return CoreGasmeter{gm: sdk.UnwrapSDKContext(ctx).GasMeter()}
@@ -23,6 +77,12 @@ func (g GasService) BlockGasMeter(ctx context.Context) gas.Meter {
 }
 
 func (g GasService) GasConfig(ctx context.Context) gas.GasConfig {
+	if g.gasConfig != nil {
+		if gasConfig := g.gasConfig(); gasConfig != nil {
+			return gas.GasConfig(*gasConfig)
+		}
+	}
+
 	return gas.GasConfig(sdk.UnwrapSDKContext(ctx).KVGasConfig())
 }
 
@@ -0,0 +1,68 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/gas"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestGasCosts(t *testing.T) {
+	custom := storetypes.GasConfig{
+		HasCost:          1,
+		DeleteCost:       2,
+		ReadCostFlat:     3,
+		ReadCostPerByte:  4,
+		WriteCostFlat:    5,
+		WriteCostPerByte: 6,
+		IterNextCostFlat: 7,
+	}
+
+	var nilCfg *GasCosts
+	require.Nil(t, nilCfg.ForModule("bank"))
+
+	cfg := &GasCosts{Modules: map[string]storetypes.GasConfig{"bank": custom}}
+	require.Equal(t, custom, *cfg.ForModule("bank"))
+	require.Nil(t, cfg.ForModule("staking"))
+
+	defaultCfg := storetypes.TransientGasConfig()
+	cfg.Default = &defaultCfg
+	require.Equal(t, defaultCfg, *cfg.ForModule("staking"))
+	require.Equal(t, custom, *cfg.ForModule("bank"))
+}
+
+func TestKVStoreServiceGasConfig(t *testing.T) {
+	sk := storetypes.NewKVStoreKey("test")
+	tsk := storetypes.NewTransientStoreKey("transient-test")
+	ctx := testutil.DefaultContext(sk, tsk).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	custom := storetypes.GasConfig{WriteCostFlat: 10, WriteCostPerByte: 1}
+	kvService := kvStoreService{key: sk, gasConfig: fixedGasConfig(&custom)}
+	require.NoError(t, kvService.OpenKVStore(ctx).Set([]byte("key"), []byte("value")))
+	require.Equal(t, storetypes.Gas(10+len("key")+len("value")), ctx.GasMeter().GasConsumed())
+
+	// without override the gas config of the context is used
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	kvService = kvStoreService{key: sk}
+	require.NoError(t, kvService.OpenKVStore(ctx).Set([]byte("key"), []byte("value")))
+	defaultCfg := storetypes.KVGasConfig()
+	require.Equal(t, defaultCfg.WriteCostFlat+defaultCfg.WriteCostPerByte*uint64(len("key")+len("value")), ctx.GasMeter().GasConsumed())
+
+	require.Equal(t, gas.GasConfig(custom), GasService{gasConfig: fixedGasConfig(&custom)}.GasConfig(ctx))
+	require.Equal(t, gas.GasConfig(defaultCfg), GasService{}.GasConfig(ctx))
+}
+
+func TestAppBuilderStoreGasConfig(t *testing.T) {
+	builder := &AppBuilder{app: &App{}}
+	gasConfig := builder.storeGasConfig("bank")
+	require.Nil(t, gasConfig())
+
+	// the gas costs set up after the environment is provided are used
+	custom := storetypes.GasConfig{WriteCostFlat: 10, WriteCostPerByte: 1}
+	builder.app.gasCosts = &GasCosts{Modules: map[string]storetypes.GasConfig{"bank": custom}}
+	require.Equal(t, custom, *gasConfig())
+}
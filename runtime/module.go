@@ -160,6 +160,7 @@ type AppInputs struct {
 	BaseAppOptions    []BaseAppOption
 	InterfaceRegistry codectypes.InterfaceRegistry
 	LegacyAmino       legacy.Amino
+	GasCosts          *GasCosts `optional:"true"`
 }
 
 func SetupAppBuilder(inputs AppInputs) {
@@ -167,6 +168,7 @@ func SetupAppBuilder(inputs AppInputs) {
 	app.baseAppOptions = inputs.BaseAppOptions
 	app.config = inputs.Config
 	app.logger = inputs.Logger
+	app.gasCosts = inputs.GasCosts
 	app.ModuleManager = inputs.ModuleManager
 	app.ModuleManager.RegisterInterfaces(inputs.InterfaceRegistry)
 	app.ModuleManager.RegisterLegacyAminoCodec(inputs.LegacyAmino)
@@ -257,22 +259,27 @@ func ProvideEnvironment(
 		memKvService store.MemoryStoreService = failingStoreService{}
 	)
 
+	gasConfig := app.storeGasConfig(key.Name())
+
 	// skips modules that have no store
 	if !slices.Contains(config.SkipStoreKeys, key.Name()) {
 		storeKey := ProvideKVStoreKey(config, key, app)
-		kvService = kvStoreService{key: storeKey}
+		kvService = kvStoreService{key: storeKey, gasConfig: gasConfig}
 
 		memStoreKey := ProvideMemoryStoreKey(config, key, app)
 		memKvService = memStoreService{key: memStoreKey}
 	}
 
-	return kvService, memKvService, NewEnvironment(
+	env := NewEnvironment(
 		kvService,
 		logger.With(log.ModuleKey, fmt.Sprintf("x/%s", key.Name())),
 		EnvWithMsgRouterService(msgServiceRouter),
 		EnvWithQueryRouterService(queryServiceRouter),
 		EnvWithMemStoreService(memKvService),
 	)
+	env.GasService = GasService{gasConfig: gasConfig}
+
+	return kvService, memKvService, env
 }
 
 func ProvideTransientStoreService(
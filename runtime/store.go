@@ -18,10 +18,19 @@ func NewKVStoreService(storeKey *storetypes.KVStoreKey) store.KVStoreService {
 
 type kvStoreService struct {
 	key *storetypes.KVStoreKey
+	// gasConfig resolves the gas config overriding the one of the context, if any.
+	gasConfig func() *storetypes.GasConfig
 }
 
 func (k kvStoreService) OpenKVStore(ctx context.Context) store.KVStore {
-	return newKVStore(sdk.UnwrapSDKContext(ctx).KVStore(k.key))
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.gasConfig != nil {
+		if gasConfig := k.gasConfig(); gasConfig != nil {
+			sdkCtx = sdkCtx.WithKVGasConfig(*gasConfig)
+		}
+	}
+
+	return newKVStore(sdkCtx.KVStore(k.key))
 }
 
 type memStoreService struct {
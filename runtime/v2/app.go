@@ -3,6 +3,7 @@ package runtime
 import (
 	"encoding/json"
 	"errors"
+	"io"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"golang.org/x/exp/slices"
@@ -80,7 +81,12 @@ func (a *App[T]) LoadHeight(height uint64) error {
 }
 
 // Close is called in start cmd to gracefully cleanup resources.
+// It flushes and closes the store.
 func (a *App[T]) Close() error {
+	if closer, ok := a.db.(io.Closer); ok {
+		return closer.Close()
+	}
+
 	return nil
 }
 
@@ -119,21 +119,26 @@ func (m *MM[T]) DefaultGenesis() map[string]json.RawMessage {
 
 // ValidateGenesis performs genesis state validation for all modules
 func (m *MM[T]) ValidateGenesis(genesisData map[string]json.RawMessage) error {
-	for name, b := range m.modules {
-		if mod, ok := b.(appmodule.HasGenesisBasics); ok {
-			if err := mod.ValidateGenesis(genesisData[name]); err != nil {
-				return err
-			}
-		} else if mod, ok := b.(appmodulev2.HasGenesis); ok {
-			if err := mod.ValidateGenesis(genesisData[name]); err != nil {
-				return err
-			}
+	for name := range m.modules {
+		if err := m.ValidateModuleGenesis(name, genesisData[name]); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// ValidateModuleGenesis performs genesis state validation for a single module
+func (m *MM[T]) ValidateModuleGenesis(moduleName string, genesisData json.RawMessage) error {
+	if mod, ok := m.modules[moduleName].(appmodule.HasGenesisBasics); ok {
+		return mod.ValidateGenesis(genesisData)
+	} else if mod, ok := m.modules[moduleName].(appmodulev2.HasGenesis); ok {
+		return mod.ValidateGenesis(genesisData)
+	}
+
+	return nil
+}
+
 // InitGenesisJSON performs init genesis functionality for modules from genesis data in JSON format
 func (m *MM[T]) InitGenesisJSON(
 	ctx context.Context,
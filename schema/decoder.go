@@ -16,6 +16,10 @@ type ModuleCodec struct {
 	// KVDecoder is a function that decodes a key-value pair into an ObjectUpdate.
 	// If it is nil, the module doesn't support state decoding directly.
 	KVDecoder KVDecoder
+
+	// KVEncoder is a function that encodes an ObjectUpdate into key-value pairs, the reverse of
+	// KVDecoder. If it is nil, the module doesn't support reconstructing its state from indexed objects.
+	KVEncoder KVEncoder
 }
 
 // KVDecoder is a function that decodes a key-value pair into one or more ObjectUpdate's.
@@ -26,6 +30,11 @@ type ModuleCodec struct {
 // were decodable to aid debugging.
 type KVDecoder = func(KVPairUpdate) ([]ObjectUpdate, error)
 
+// KVEncoder is a function that encodes an ObjectUpdate into the key-value pair sets or deletes of the
+// state which KVDecoder decodes back into the ObjectUpdate. It is used to reconstruct the state of a
+// module from its indexed objects.
+type KVEncoder = func(ObjectUpdate) ([]KVPairUpdate, error)
+
 // KVPairUpdate represents a key-value pair set or delete.
 type KVPairUpdate struct {
 	// Key is the key of the key-value pair.
@@ -18,6 +18,10 @@ type Field struct {
 	// the same values for the same enum name. This possibly introduces some duplication of
 	// definitions but makes it easier to reason about correctness and validation in isolation.
 	EnumType EnumType
+
+	// Description is an optional human-readable description of the semantics of
+	// the field, which indexers can attach to the columns storing it.
+	Description string
 }
 
 // Validate validates the field.
@@ -45,6 +45,13 @@ type Config struct {
 	// receive state updates for.
 	// Only one of include or exclude modules should be specified.
 	ExcludeModules []string `json:"exclude_modules"`
+
+	// ModuleSchemaHashes pins the expected schema hashes of modules by their name, as returned by
+	// schema.ModuleSchema.Hash. If the schema of a pinned module doesn't match its hash, e.g. because
+	// it changed across an upgrade, the indexing of that module is halted with a MigrationRequiredError
+	// while the other modules keep being indexed. Modules which aren't pinned are indexed whatever
+	// their schema.
+	ModuleSchemaHashes map[string]string `json:"module_schema_hashes"`
 }
 
 type InitFunc = func(InitParams) (InitResult, error)
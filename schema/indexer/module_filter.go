@@ -0,0 +1,130 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/logutil"
+)
+
+// MigrationRequiredError is the error with which the indexing of a module is halted when its schema
+// doesn't match the schema hash pinned in the config.
+type MigrationRequiredError struct {
+	// ModuleName is the name of the halted module.
+	ModuleName string
+
+	// ExpectedHash is the schema hash pinned in the config.
+	ExpectedHash string
+
+	// ActualHash is the hash of the schema of the module.
+	ActualHash string
+}
+
+func (e MigrationRequiredError) Error() string {
+	return fmt.Sprintf("migration required: the schema of module %s has hash %s but %s is pinned, "+
+		"its indexing is halted until its indexed data is migrated and its pinned schema hash is updated",
+		e.ModuleName, e.ActualHash, e.ExpectedHash)
+}
+
+// FilterModules returns a listener forwarding to target only the data of the modules selected by the
+// IncludeModules and ExcludeModules options of the config, and whose schema matches the hash pinned
+// in ModuleSchemaHashes, if any. A module whose schema doesn't match is halted: its initialization
+// and the following updates are not forwarded, and a MigrationRequiredError is logged, while the
+// other modules keep being indexed.
+func FilterModules(target appdata.Listener, cfg Config, logger logutil.Logger) (appdata.Listener, error) {
+	if len(cfg.IncludeModules) > 0 && len(cfg.ExcludeModules) > 0 {
+		return appdata.Listener{}, errors.New("only one of include_modules or exclude_modules can be specified")
+	}
+	if logger == nil {
+		logger = logutil.NoopLogger{}
+	}
+
+	f := &moduleFilter{
+		cfg:     cfg,
+		logger:  logger,
+		include: stringSet(cfg.IncludeModules),
+		exclude: stringSet(cfg.ExcludeModules),
+		halted:  map[string]bool{},
+	}
+
+	if initializeModuleData := target.InitializeModuleData; initializeModuleData != nil {
+		target.InitializeModuleData = func(data appdata.ModuleInitializationData) error {
+			if !f.initializeModule(data) {
+				return nil
+			}
+			return initializeModuleData(data)
+		}
+	}
+
+	if onObjectUpdate := target.OnObjectUpdate; onObjectUpdate != nil {
+		target.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+			if !f.allowed(data.ModuleName) {
+				return nil
+			}
+			return onObjectUpdate(data)
+		}
+	}
+
+	if onKVPair := target.OnKVPair; onKVPair != nil {
+		target.OnKVPair = func(data appdata.KVPairData) error {
+			updates := make([]appdata.ModuleKVPairUpdate, 0, len(data.Updates))
+			for _, update := range data.Updates {
+				if f.allowed(update.ModuleName) {
+					updates = append(updates, update)
+				}
+			}
+			if len(updates) == 0 {
+				return nil
+			}
+			return onKVPair(appdata.KVPairData{Updates: updates})
+		}
+	}
+
+	return target, nil
+}
+
+type moduleFilter struct {
+	cfg     Config
+	logger  logutil.Logger
+	include map[string]bool
+	exclude map[string]bool
+	// halted are the modules whose schema doesn't match their pinned hash
+	halted map[string]bool
+}
+
+// allowed returns whether the data of the module is forwarded.
+func (f *moduleFilter) allowed(moduleName string) bool {
+	if f.halted[moduleName] || f.exclude[moduleName] {
+		return false
+	}
+	return len(f.include) == 0 || f.include[moduleName]
+}
+
+// initializeModule checks the schema of the module against its pinned hash, halts the module if it
+// doesn't match and returns whether its initialization is forwarded.
+func (f *moduleFilter) initializeModule(data appdata.ModuleInitializationData) bool {
+	if !f.allowed(data.ModuleName) {
+		return false
+	}
+
+	hash := data.Schema.Hash()
+	expected, pinned := f.cfg.ModuleSchemaHashes[data.ModuleName]
+	if pinned && expected != hash {
+		f.halted[data.ModuleName] = true
+		f.logger.Error("halting module indexing", "module", data.ModuleName, "err",
+			MigrationRequiredError{ModuleName: data.ModuleName, ExpectedHash: expected, ActualHash: hash})
+		return false
+	}
+
+	f.logger.Info("initializing module indexing", "module", data.ModuleName, "schema_hash", hash)
+	return true
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
@@ -0,0 +1,117 @@
+package indexer
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestFilterModules(t *testing.T) {
+	schema1 := testModuleSchema(t, schema.StringKind)
+	schema2 := testModuleSchema(t, schema.Int64Kind)
+
+	var calls []string
+	target := appdata.Listener{
+		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+			calls = append(calls, "init "+data.ModuleName)
+			return nil
+		},
+		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+			calls = append(calls, "update "+data.ModuleName)
+			return nil
+		},
+		OnKVPair: func(data appdata.KVPairData) error {
+			for _, update := range data.Updates {
+				calls = append(calls, "kv "+update.ModuleName)
+			}
+			return nil
+		},
+	}
+
+	logger := &testLogger{}
+	listener, err := FilterModules(target, Config{
+		IncludeModules: []string{"bank", "staking", "gov"},
+		ModuleSchemaHashes: map[string]string{
+			"bank":    schema1.Hash(),
+			"staking": schema1.Hash(),
+		},
+	}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, module := range []struct {
+		name   string
+		schema schema.ModuleSchema
+	}{
+		{"bank", schema1},
+		// the schema of staking changed
+		{"staking", schema2},
+		// gov is not pinned
+		{"gov", schema2},
+		// auth is not included
+		{"auth", schema1},
+	} {
+		err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: module.name, Schema: module.schema})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, module := range []string{"bank", "staking", "gov", "auth"} {
+		if err := listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: module}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = listener.OnKVPair(appdata.KVPairData{Updates: []appdata.ModuleKVPairUpdate{
+		{ModuleName: "staking"}, {ModuleName: "bank"}, {ModuleName: "auth"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"init bank", "init gov", "update bank", "update gov", "kv bank"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected calls %v, got %v", expected, calls)
+	}
+
+	if len(logger.errors) != 1 || !strings.Contains(logger.errors[0], "migration required: the schema of module staking has hash "+schema2.Hash()) {
+		t.Fatalf("expected a migration required error for staking, got %v", logger.errors)
+	}
+}
+
+func TestFilterModulesInvalidConfig(t *testing.T) {
+	_, err := FilterModules(appdata.Listener{}, Config{IncludeModules: []string{"bank"}, ExcludeModules: []string{"gov"}}, nil)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func testModuleSchema(t *testing.T, kind schema.Kind) schema.ModuleSchema {
+	t.Helper()
+	s, err := schema.NewModuleSchema([]schema.ObjectType{
+		{Name: "object", KeyFields: []schema.Field{{Name: "key", Kind: kind}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+type testLogger struct {
+	errors []string
+}
+
+func (l *testLogger) Info(string, ...interface{}) {}
+
+func (l *testLogger) Warn(string, ...interface{}) {}
+
+func (l *testLogger) Error(msg string, keyVals ...interface{}) {
+	l.errors = append(l.errors, fmt.Sprint(append([]interface{}{msg}, keyVals...)...))
+}
+
+func (l *testLogger) Debug(string, ...interface{}) {}
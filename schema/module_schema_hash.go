@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+// Hash returns a hex encoded hash of the structure of the module schema, i.e. of its object and enum
+// types, their fields and kinds. It can be used to pin the expected schema of a module, so that a
+// change of the schema, e.g. across an upgrade, is detected before it is indexed. Descriptions do
+// not change the hash.
+func (s ModuleSchema) Hash() string {
+	h := sha256.New()
+	s.Types(func(t Type) bool {
+		switch t := t.(type) {
+		case ObjectType:
+			_, _ = fmt.Fprintf(h, "object %q retain_deletions=%t\n", t.Name, t.RetainDeletions)
+			writeFieldsHash(h, "key", t.KeyFields)
+			writeFieldsHash(h, "value", t.ValueFields)
+		case EnumType:
+			_, _ = fmt.Fprintf(h, "enum %q %q\n", t.Name, t.Values)
+		}
+		return true
+	})
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeFieldsHash(w io.Writer, prefix string, fields []Field) {
+	for _, field := range fields {
+		_, _ = fmt.Fprintf(w, "%s %q %s nullable=%t enum=%q\n", prefix, field.Name, field.Kind, field.Nullable, field.EnumType.Name)
+	}
+}
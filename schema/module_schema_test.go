@@ -408,3 +408,38 @@ func TestModuleSchema_EnumTypes(t *testing.T) {
 		t.Fatalf("expected %v, got %v", expected, typeNames)
 	}
 }
+
+func TestModuleSchema_Hash(t *testing.T) {
+	newSchema := func(nullable bool, description string) ModuleSchema {
+		t.Helper()
+		s, err := NewModuleSchema([]ObjectType{
+			{
+				Name:        "object1",
+				Description: description,
+				KeyFields:   []Field{{Name: "field1", Kind: StringKind}},
+				ValueFields: []Field{{Name: "field2", Kind: Int32Kind, Nullable: nullable, Description: description}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	hash := newSchema(false, "").Hash()
+	if len(hash) != 64 {
+		t.Fatalf("expected a hex encoded sha256 hash, got %q", hash)
+	}
+	if hash != newSchema(false, "").Hash() {
+		t.Fatalf("expected the hash to be deterministic")
+	}
+	if hash != newSchema(false, "described").Hash() {
+		t.Fatalf("expected the descriptions not to change the hash")
+	}
+	if hash == newSchema(true, "").Hash() {
+		t.Fatalf("expected a change of the fields to change the hash")
+	}
+	if hash == exampleSchema(t).Hash() {
+		t.Fatalf("expected different schemas to have different hashes")
+	}
+}
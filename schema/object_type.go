@@ -25,6 +25,10 @@ type ObjectType struct {
 	// though it is still valid in order to save space. Indexers will want to have
 	// the option of retaining such data and distinguishing from other "true" deletions.
 	RetainDeletions bool
+
+	// Description is an optional human-readable description of the object type,
+	// which indexers can attach to the tables or collections storing it.
+	Description string
 }
 
 // TypeName implements the Type interface.
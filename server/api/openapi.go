@@ -0,0 +1,414 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// OpenAPIPath is the path at which the API server serves the OpenAPI document
+// of the gRPC services exposed by the application.
+const OpenAPIPath = "/openapi.json"
+
+// openAPIVersion is the version of the OpenAPI specification of the document.
+const openAPIVersion = "3.0.3"
+
+// pathParamRegex matches the variables of a google.api.http path template,
+// e.g. {address} or {denom=**}.
+var pathParamRegex = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+type openAPIDocument struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       openAPIInfo                `json:"info"`
+	Paths      map[string]openAPIPathItem `json:"paths"`
+	Components openAPIComponents          `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// openAPIPathItem maps the lowercase HTTP methods of a path to their operation.
+type openAPIPathItem map[string]*openAPIOperation
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Tags        []string                   `json:"tags"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required,omitempty"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*openAPISchema `json:"schemas"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// registerOpenAPI registers the handler serving the OpenAPI document of the
+// gRPC services registered on the gRPC server.
+// The document is generated at the first request, once all services are registered.
+func (s *Server) registerOpenAPI() {
+	if s.GRPCSrv == nil {
+		return
+	}
+
+	var (
+		once sync.Once
+		bz   []byte
+		err  error
+	)
+
+	s.Router.HandleFunc(OpenAPIPath, func(w http.ResponseWriter, _ *http.Request) {
+		once.Do(func() {
+			var doc *openAPIDocument
+			if doc, err = newOpenAPIDocument(s.GRPCSrv.GetServiceInfo()); err == nil {
+				bz, err = json.Marshal(doc)
+			}
+		})
+
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("failed to generate OpenAPI document: %s", err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}).Methods("GET")
+}
+
+// newOpenAPIDocument generates the OpenAPI document of the methods of the given
+// gRPC services that are exposed through the gRPC-gateway, i.e. annotated with
+// a google.api.http rule.
+func newOpenAPIDocument(services map[string]grpc.ServiceInfo) (*openAPIDocument, error) {
+	doc := &openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info:    openAPIInfo{Title: version.AppName, Version: version.Version},
+		Paths:   map[string]openAPIPathItem{},
+		Components: openAPIComponents{
+			Schemas: map[string]*openAPISchema{},
+		},
+	}
+	if doc.Info.Version == "" {
+		doc.Info.Version = "unknown"
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			// the service is not defined with protobuf, so it has no HTTP rules
+			continue
+		}
+
+		sd, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+
+		for i := 0; i < sd.Methods().Len(); i++ {
+			if err := doc.addMethod(sd.Methods().Get(i)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+// addMethod adds the operations of the HTTP rules of the method to the document.
+func (doc *openAPIDocument) addMethod(md protoreflect.MethodDescriptor) error {
+	rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return nil
+	}
+
+	for i, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+		method, template := httpMethodAndTemplate(binding)
+		if template == "" {
+			continue
+		}
+
+		operationID := string(md.Name())
+		if i > 0 {
+			operationID = fmt.Sprintf("%s%d", operationID, i+1)
+		}
+
+		op := &openAPIOperation{
+			OperationID: operationID,
+			Tags:        []string{string(md.Parent().FullName())},
+			Responses: map[string]openAPIResponse{
+				"200": {
+					Description: "A successful response.",
+					Content:     jsonContent(doc.messageSchema(md.Output())),
+				},
+				"default": {
+					Description: "An unexpected error response.",
+					Content: jsonContent(&openAPISchema{
+						Type: "object",
+						Properties: map[string]*openAPISchema{
+							"error":   {Type: "string"},
+							"code":    {Type: "integer", Format: "int32"},
+							"message": {Type: "string"},
+							"details": {Type: "array", Items: &openAPISchema{Type: "object"}},
+						},
+					}),
+				},
+			},
+		}
+
+		pathFields := map[string]bool{}
+		for _, match := range pathParamRegex.FindAllStringSubmatch(template, -1) {
+			pathFields[match[1]] = true
+			op.Parameters = append(op.Parameters, openAPIParameter{
+				Name:     match[1],
+				In:       "path",
+				Required: true,
+				Schema:   doc.fieldSchema(fieldByPath(md.Input(), match[1])),
+			})
+		}
+
+		switch binding.Body {
+		case "":
+			op.Parameters = append(op.Parameters, doc.queryParameters(md.Input(), "", pathFields, map[protoreflect.FullName]bool{})...)
+		case "*":
+			op.RequestBody = &openAPIRequestBody{Required: true, Content: jsonContent(doc.messageSchema(md.Input()))}
+		default:
+			if fd := md.Input().Fields().ByName(protoreflect.Name(binding.Body)); fd != nil {
+				op.RequestBody = &openAPIRequestBody{Required: true, Content: jsonContent(doc.fieldSchema(fd))}
+			}
+		}
+
+		// OpenAPI path templates only have the variable name
+		path := pathParamRegex.ReplaceAllString(template, "{$1}")
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = openAPIPathItem{}
+		}
+		doc.Paths[path][strings.ToLower(method)] = op
+	}
+
+	return nil
+}
+
+// queryParameters returns the query parameters of the scalar fields of the
+// message, nested fields being named by their path, e.g. pagination.key.
+func (doc *openAPIDocument) queryParameters(
+	msg protoreflect.MessageDescriptor,
+	prefix string,
+	pathFields map[string]bool,
+	visited map[protoreflect.FullName]bool,
+) []openAPIParameter {
+	// avoid infinite recursion on recursive messages
+	if visited[msg.FullName()] {
+		return nil
+	}
+	visited[msg.FullName()] = true
+	defer delete(visited, msg.FullName())
+
+	var params []openAPIParameter
+	for i := 0; i < msg.Fields().Len(); i++ {
+		fd := msg.Fields().Get(i)
+		name := prefix + string(fd.Name())
+		if pathFields[name] {
+			continue
+		}
+
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() && wellKnownSchema(fd.Message()) == nil {
+			params = append(params, doc.queryParameters(fd.Message(), name+".", pathFields, visited)...)
+			continue
+		}
+
+		if fd.IsMap() || (fd.IsList() && fd.Kind() == protoreflect.MessageKind) {
+			// the gRPC-gateway does not populate maps and lists of messages from the query
+			continue
+		}
+
+		params = append(params, openAPIParameter{Name: name, In: "query", Schema: doc.fieldSchema(fd)})
+	}
+
+	return params
+}
+
+// messageSchema returns a reference to the schema of the message, adding it
+// to the components of the document if needed.
+func (doc *openAPIDocument) messageSchema(msg protoreflect.MessageDescriptor) *openAPISchema {
+	if schema := wellKnownSchema(msg); schema != nil {
+		return schema
+	}
+
+	name := string(msg.FullName())
+	ref := &openAPISchema{Ref: "#/components/schemas/" + name}
+	if _, ok := doc.Components.Schemas[name]; ok {
+		return ref
+	}
+
+	schema := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	// register the schema before its fields for recursive messages
+	doc.Components.Schemas[name] = schema
+	for i := 0; i < msg.Fields().Len(); i++ {
+		fd := msg.Fields().Get(i)
+		schema.Properties[string(fd.Name())] = doc.fieldSchema(fd)
+	}
+
+	return ref
+}
+
+// fieldSchema returns the schema of the JSON encoding of the field, which uses
+// the original proto field names.
+func (doc *openAPIDocument) fieldSchema(fd protoreflect.FieldDescriptor) *openAPISchema {
+	if fd == nil {
+		return &openAPISchema{Type: "string"}
+	}
+
+	switch {
+	case fd.IsMap():
+		return &openAPISchema{Type: "object", AdditionalProperties: doc.kindSchema(fd.MapValue())}
+	case fd.IsList():
+		return &openAPISchema{Type: "array", Items: doc.kindSchema(fd)}
+	default:
+		return doc.kindSchema(fd)
+	}
+}
+
+// kindSchema returns the schema of a single value of the field.
+func (doc *openAPIDocument) kindSchema(fd protoreflect.FieldDescriptor) *openAPISchema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &openAPISchema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// 64-bit integers are encoded as strings in JSON
+		return &openAPISchema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &openAPISchema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case protoreflect.BytesKind:
+		return &openAPISchema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		enum := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			enum = append(enum, string(values.Get(i).Name()))
+		}
+		return &openAPISchema{Type: "string", Enum: enum}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return doc.messageSchema(fd.Message())
+	default:
+		return &openAPISchema{Type: "string"}
+	}
+}
+
+// wellKnownSchema returns the schema of the protobuf well-known types that have
+// a special JSON encoding, or nil for other messages.
+func wellKnownSchema(msg protoreflect.MessageDescriptor) *openAPISchema {
+	switch msg.FullName() {
+	case "google.protobuf.Timestamp":
+		return &openAPISchema{Type: "string", Format: "date-time"}
+	case "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return &openAPISchema{Type: "string"}
+	case "google.protobuf.Any":
+		return &openAPISchema{
+			Type:                 "object",
+			Properties:           map[string]*openAPISchema{"@type": {Type: "string"}},
+			AdditionalProperties: &openAPISchema{},
+		}
+	case "google.protobuf.Struct", "google.protobuf.Value":
+		return &openAPISchema{Type: "object"}
+	default:
+		return nil
+	}
+}
+
+// httpMethodAndTemplate returns the HTTP method and path template of the rule.
+// It returns an empty template for custom rules.
+func httpMethodAndTemplate(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	default:
+		return "", ""
+	}
+}
+
+// fieldByPath returns the field of the message at the given dot separated path,
+// or nil if there is none.
+func fieldByPath(msg protoreflect.MessageDescriptor, path string) protoreflect.FieldDescriptor {
+	var fd protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if msg == nil {
+			return nil
+		}
+
+		if fd = msg.Fields().ByName(protoreflect.Name(name)); fd == nil {
+			return nil
+		}
+		msg = fd.Message()
+	}
+
+	return fd
+}
+
+func jsonContent(schema *openAPISchema) map[string]openAPIMediaType {
+	return map[string]openAPIMediaType{"application/json": {Schema: schema}}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestNewOpenAPIDocument(t *testing.T) {
+	grpcSrv := grpc.NewServer()
+	txtypes.RegisterServiceServer(grpcSrv, &txtypes.UnimplementedServiceServer{})
+
+	doc, err := newOpenAPIDocument(grpcSrv.GetServiceInfo())
+	require.NoError(t, err)
+	require.Equal(t, openAPIVersion, doc.OpenAPI)
+
+	// the simulation endpoint takes the request as body
+	simulate := doc.Paths["/cosmos/tx/v1beta1/simulate"]["post"]
+	require.NotNil(t, simulate)
+	require.Equal(t, "Simulate", simulate.OperationID)
+	require.Equal(t, "#/components/schemas/cosmos.tx.v1beta1.SimulateRequest",
+		simulate.RequestBody.Content["application/json"].Schema.Ref)
+	require.Equal(t, "#/components/schemas/cosmos.tx.v1beta1.SimulateResponse",
+		simulate.Responses["200"].Content["application/json"].Schema.Ref)
+
+	// path variables are path parameters
+	getTx := doc.Paths["/cosmos/tx/v1beta1/txs/{hash}"]["get"]
+	require.NotNil(t, getTx)
+	require.Equal(t, []openAPIParameter{{Name: "hash", In: "path", Required: true, Schema: &openAPISchema{Type: "string"}}}, getTx.Parameters)
+
+	// the other fields of queries are query parameters
+	getTxs := doc.Paths["/cosmos/tx/v1beta1/txs"]["get"]
+	require.NotNil(t, getTxs)
+	params := map[string]*openAPISchema{}
+	for _, p := range getTxs.Parameters {
+		require.Equal(t, "query", p.In)
+		params[p.Name] = p.Schema
+	}
+	require.Equal(t, &openAPISchema{Type: "string", Format: "byte"}, params["pagination.key"])
+	require.Equal(t, &openAPISchema{Type: "string", Format: "uint64"}, params["page"])
+	require.Equal(t, "string", params["order_by"].Type)
+	require.Contains(t, params["order_by"].Enum, "ORDER_BY_DESC")
+
+	// message schemas use the proto field names
+	schema := doc.Components.Schemas["cosmos.tx.v1beta1.GetTxResponse"]
+	require.NotNil(t, schema)
+	require.Equal(t, "#/components/schemas/cosmos.base.abci.v1beta1.TxResponse", schema.Properties["tx_response"].Ref)
+	require.Equal(t, &openAPISchema{Type: "string", Format: "int64"}, doc.Components.Schemas["cosmos.base.abci.v1beta1.TxResponse"].Properties["height"])
+}
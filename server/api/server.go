@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
@@ -104,6 +105,19 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	cmtCfg.WriteTimeout = time.Duration(cfg.API.RPCWriteTimeout) * time.Second
 	cmtCfg.MaxBodyBytes = int64(cfg.API.RPCMaxBodyBytes)
 
+	handler := http.Handler(s.Router)
+	allowedHeaders := []string{"Content-Type"}
+	if cfg.API.RateLimit.Enable {
+		limiter, err := ratelimit.New(cfg.API.RateLimit)
+		if err != nil {
+			s.mtx.Unlock()
+			return fmt.Errorf("invalid API rate limit config: %w", err)
+		}
+
+		handler = limiter.HTTPMiddleware(handler)
+		allowedHeaders = append(allowedHeaders, ratelimit.APIKeyHeader)
+	}
+
 	listener, err := tmrpcserver.Listen(cfg.API.Address, cmtCfg.MaxOpenConnections)
 	if err != nil {
 		s.mtx.Unlock()
@@ -113,6 +127,9 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	s.listener = listener
 	s.mtx.Unlock()
 
+	// register the OpenAPI document of the gRPC services
+	s.registerOpenAPI()
+
 	// register grpc-gateway routes
 	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 
@@ -125,10 +142,10 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 		s.logger.Info("starting API server...", "address", cfg.API.Address)
 
 		if enableUnsafeCORS {
-			allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(s.Router), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			allowAllCORS := handlers.CORS(handlers.AllowedHeaders(allowedHeaders))
+			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(handler), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		} else {
-			errCh <- tmrpcserver.Serve(s.listener, s.Router, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, handler, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		}
 	}(cfg.API.EnableUnsafeCORS)
 
@@ -88,6 +88,23 @@ type BaseConfig struct {
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// CommitConcurrency defines the number of stores hashed and committed
+	// concurrently. The stores are committed sequentially if it is lower than 2.
+	CommitConcurrency int `mapstructure:"commit-concurrency"`
+
+	// TxDecodeCacheSize defines the maximum number of txs whose decoding is
+	// cached across the ABCI phases. 0 disables the cache.
+	TxDecodeCacheSize int `mapstructure:"tx-decode-cache-size"`
+
+	// ErrorRedaction defines which information of the errors is redacted from
+	// the ABCI responses: none, code (only the codespace and code are returned)
+	// or full (only the code is returned).
+	ErrorRedaction string `mapstructure:"error-redaction"`
+
+	// ErrorRedactionAllowlist defines the codespaces whose errors are never
+	// redacted from the ABCI responses.
+	ErrorRedactionAllowlist []string `mapstructure:"error-redaction-allowlist"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -119,6 +136,9 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the CometBFT maximum request body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// RateLimit defines the rate limiting and authentication of the API server.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -139,6 +159,57 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// RateLimit defines the rate limiting and authentication of the gRPC server.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+
+	// QueryCache defines the cache of the responses of the gRPC queries.
+	QueryCache QueryCacheConfig `mapstructure:"query-cache"`
+}
+
+// QueryCacheConfig defines the configuration of the cache of the responses of
+// the gRPC queries pinned to a committed height. As the state at such a height
+// never changes, their responses can be served from the cache.
+type QueryCacheConfig struct {
+	// Enable defines if the query response cache is enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Size defines the maximum number of cached responses.
+	Size int `mapstructure:"size"`
+
+	// TTL defines the time (in seconds) after which a cached response is evicted.
+	// 0 means the responses are only evicted when the cache is full.
+	TTL uint `mapstructure:"ttl"`
+}
+
+// RateLimitConfig defines the rate limiting and authentication configuration
+// of the API and gRPC servers.
+type RateLimitConfig struct {
+	// Enable defines if the rate limiting and authentication middleware is enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// RequestsPerSecond defines the number of requests per second allowed per client.
+	// 0 means unlimited.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+
+	// Burst defines the number of requests a client can make at once.
+	// 0 means the requests per second, rounded up.
+	Burst int `mapstructure:"burst"`
+
+	// RouteLimits defines the requests per second allowed per client on specific
+	// routes, as "route=requests-per-second" entries overriding RequestsPerSecond.
+	// Routes are path prefixes for the API server and full method name prefixes,
+	// e.g. /cosmos.tx.v1beta1.Service/Simulate, for the gRPC server.
+	RouteLimits []string `mapstructure:"route-limits"`
+
+	// APIKeys defines the keys authorized to access the server. When set, clients
+	// must provide one of them in the X-API-Key header (x-api-key metadata for gRPC).
+	// Clients are rate limited per API key instead of per IP address.
+	APIKeys []string `mapstructure:"api-keys"`
+
+	// Allowlist defines the IP addresses or CIDR ranges of trusted clients,
+	// which are neither authenticated nor rate limited.
+	Allowlist []string `mapstructure:"allowlist"`
 }
 
 // StateSyncConfig defines the state sync snapshot configuration.
@@ -212,17 +283,19 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:            defaultMinGasPrices,
+			QueryGasLimit:           0,
+			InterBlockCache:         true,
+			Pruning:                 pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:       "0",
+			PruningInterval:         "0",
+			MinRetainBlocks:         0,
+			IndexEvents:             make([]string, 0),
+			IAVLCacheSize:           781250,
+			IAVLDisableFastNode:     false,
+			ErrorRedaction:          "none",
+			ErrorRedactionAllowlist: make([]string, 0),
+			AppDBBackend:            "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -235,12 +308,19 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			RateLimit:          DefaultRateLimitConfig(),
 		},
 		GRPC: GRPCConfig{
 			Enable:         true,
 			Address:        DefaultGRPCAddress,
 			MaxRecvMsgSize: DefaultGRPCMaxRecvMsgSize,
 			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
+			RateLimit:      DefaultGRPCRateLimitConfig(),
+			QueryCache: QueryCacheConfig{
+				Enable: false,
+				Size:   1000,
+				TTL:    600,
+			},
 		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
@@ -258,6 +338,29 @@ func DefaultConfig() *Config {
 	}
 }
 
+// DefaultRateLimitConfig returns the default rate limiting configuration,
+// which is disabled.
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Enable:            false,
+		RequestsPerSecond: 10,
+		Burst:             20,
+		RouteLimits:       []string{},
+		APIKeys:           []string{},
+		Allowlist:         []string{},
+	}
+}
+
+// DefaultGRPCRateLimitConfig returns the default rate limiting configuration of
+// the gRPC server, which is disabled and allowlists the loopback interface so
+// that the requests forwarded by the API server are not limited twice.
+func DefaultGRPCRateLimitConfig() RateLimitConfig {
+	cfg := DefaultRateLimitConfig()
+	cfg.Allowlist = []string{"127.0.0.1", "::1"}
+
+	return cfg
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	conf := DefaultConfig()
@@ -70,6 +70,33 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# CommitConcurrency defines the number of stores hashed and committed concurrently,
+# which shortens the commits of apps with many stores.
+# The stores are committed sequentially if it is set to 0 or 1.
+commit-concurrency = {{ .BaseConfig.CommitConcurrency }}
+
+# TxDecodeCacheSize defines the maximum number of txs whose decoding is cached
+# across the ABCI phases: the txs decoded in CheckTx and PrepareProposal are
+# reused in ProcessProposal and FinalizeBlock instead of being decoded again.
+# The cached txs are shared, so the app must not modify the decoded txs.
+# The cache is disabled if it is set to 0.
+tx-decode-cache-size = {{ .BaseConfig.TxDecodeCacheSize }}
+
+# ErrorRedaction defines which information of the errors is redacted from the ABCI
+# responses of CheckTx, FinalizeBlock and Query:
+# none: the codespace, code and message of the errors are returned
+# code: only the codespace and code of the errors are returned
+# full: only the code of the errors is returned
+# The code is never redacted, as it is part of the tx results agreed on by consensus.
+error-redaction = "{{ .BaseConfig.ErrorRedaction }}"
+
+# ErrorRedactionAllowlist defines the codespaces whose errors are never redacted,
+# e.g. so that a private network exposes the errors of its own modules only.
+#
+# Example:
+# ["bank", "mymodule"]
+error-redaction-allowlist = [{{ range .BaseConfig.ErrorRedactionAllowlist }}{{ printf "%q, " . }}{{end}}]
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
@@ -151,6 +178,32 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+[api.rate-limit]
+
+# Enable defines if the rate limiting and authentication middleware is enabled.
+enable = {{ .API.RateLimit.Enable }}
+
+# RequestsPerSecond defines the number of requests per second allowed per client.
+# 0 means unlimited.
+requests-per-second = {{ .API.RateLimit.RequestsPerSecond }}
+
+# Burst defines the number of requests a client can make at once.
+# 0 means the requests per second, rounded up.
+burst = {{ .API.RateLimit.Burst }}
+
+# RouteLimits defines the requests per second allowed per client on specific routes,
+# as "route=requests-per-second" entries overriding requests-per-second.
+# Routes are path prefixes, e.g. "/cosmos/tx/v1beta1/simulate=1".
+route-limits = [{{ range .API.RateLimit.RouteLimits }}{{ printf "%q, " . }}{{end}}]
+
+# APIKeys defines the keys authorized to access the server. When set, clients must
+# provide one of them in the X-API-Key header and are rate limited per key instead of per IP address.
+api-keys = [{{ range .API.RateLimit.APIKeys }}{{ printf "%q, " . }}{{end}}]
+
+# Allowlist defines the IP addresses or CIDR ranges of trusted clients,
+# which are neither authenticated nor rate limited.
+allowlist = [{{ range .API.RateLimit.Allowlist }}{{ printf "%q, " . }}{{end}}]
+
 ###############################################################################
 ###                           gRPC Configuration                            ###
 ###############################################################################
@@ -171,6 +224,48 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+[grpc.rate-limit]
+
+# Enable defines if the rate limiting and authentication middleware is enabled.
+enable = {{ .GRPC.RateLimit.Enable }}
+
+# RequestsPerSecond defines the number of requests per second allowed per client.
+# 0 means unlimited.
+requests-per-second = {{ .GRPC.RateLimit.RequestsPerSecond }}
+
+# Burst defines the number of requests a client can make at once.
+# 0 means the requests per second, rounded up.
+burst = {{ .GRPC.RateLimit.Burst }}
+
+# RouteLimits defines the requests per second allowed per client on specific routes,
+# as "route=requests-per-second" entries overriding requests-per-second.
+# Routes are full method name prefixes, e.g. "/cosmos.tx.v1beta1.Service/Simulate=1".
+route-limits = [{{ range .GRPC.RateLimit.RouteLimits }}{{ printf "%q, " . }}{{end}}]
+
+# APIKeys defines the keys authorized to access the server. When set, clients must
+# provide one of them in the x-api-key metadata and are rate limited per key instead of per IP address.
+api-keys = [{{ range .GRPC.RateLimit.APIKeys }}{{ printf "%q, " . }}{{end}}]
+
+# Allowlist defines the IP addresses or CIDR ranges of trusted clients,
+# which are neither authenticated nor rate limited.
+# The loopback interface is allowlisted by default, so that the requests forwarded
+# by the API server are authenticated and rate limited by the API server only.
+allowlist = [{{ range .GRPC.RateLimit.Allowlist }}{{ printf "%q, " . }}{{end}}]
+
+[grpc.query-cache]
+
+# Enable defines if the responses of the gRPC queries pinned to a committed height,
+# with the x-cosmos-block-height metadata, are cached.
+# Only the methods of the Query services are cached.
+enable = {{ .GRPC.QueryCache.Enable }}
+
+# Size defines the maximum number of cached responses.
+size = {{ .GRPC.QueryCache.Size }}
+
+# TTL defines the time (in seconds) after which a cached response is evicted.
+# 0 means the responses are only evicted when the cache is full.
+ttl = {{ .GRPC.QueryCache.TTL }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
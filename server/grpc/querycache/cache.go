@@ -0,0 +1,146 @@
+// Package querycache implements a cache of the responses of the gRPC queries
+// pinned to a committed height.
+package querycache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// entry is a cached response.
+type entry struct {
+	resp      any
+	expiresAt time.Time
+}
+
+// Cache caches the responses of the gRPC queries pinned to a committed height.
+// The state at such a height never changes, so that the queries are idempotent
+// and their responses can be served again until they are evicted.
+type Cache struct {
+	cache *lru.Cache
+	ttl   time.Duration
+	// lastHeight returns the last committed height
+	lastHeight func() int64
+	// now is the clock of the cache, overridable in tests
+	now func() time.Time
+}
+
+// New returns a Cache from the given configuration. lastHeight returns the last
+// committed height, above which the queries are not cached.
+func New(cfg config.QueryCacheConfig, lastHeight func() int64) (*Cache, error) {
+	if cfg.Size <= 0 {
+		return nil, fmt.Errorf("size must be positive: %d", cfg.Size)
+	}
+
+	cache, err := lru.New(cfg.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		cache:      cache,
+		ttl:        time.Duration(cfg.TTL) * time.Second,
+		lastHeight: lastHeight,
+		now:        time.Now,
+	}, nil
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor serving the queries pinned
+// to a committed height from the cache. Only the methods of the Query services
+// are cached, as the other services, such as the tx service, do not only read
+// the state of the application.
+func (c *Cache) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key, height, ok := c.key(ctx, req, info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if resp, ok := c.get(key); ok {
+			// set the height header as the query handlers do
+			md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+			if err := grpc.SetHeader(ctx, md); err != nil {
+				return nil, err
+			}
+
+			return resp, nil
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		c.add(key, resp)
+		return resp, nil
+	}
+}
+
+// key returns the cache key of a query, made of its method, height and request
+// bytes, and false if the query is not cached.
+func (c *Cache) key(ctx context.Context, req any, method string) (string, int64, bool) {
+	if !isQueryMethod(method) {
+		return "", 0, false
+	}
+
+	// only the queries pinned to a committed height are cached, as the
+	// responses of the other queries change with the latest height
+	values := metadata.ValueFromIncomingContext(ctx, grpctypes.GRPCBlockHeightHeader)
+	if len(values) != 1 {
+		return "", 0, false
+	}
+
+	height, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || height <= 0 || height > c.lastHeight() {
+		return "", 0, false
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", 0, false
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return method + "\x00" + strconv.FormatInt(height, 10) + "\x00" + string(bz), height, true
+}
+
+func (c *Cache) get(key string) (any, bool) {
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	e := value.(entry)
+	if c.ttl > 0 && !c.now().Before(e.expiresAt) {
+		c.cache.Remove(key)
+		return nil, false
+	}
+
+	return e.resp, true
+}
+
+func (c *Cache) add(key string, resp any) {
+	c.cache.Add(key, entry{resp: resp, expiresAt: c.now().Add(c.ttl)})
+}
+
+// isQueryMethod returns true if the full method name, e.g.
+// /cosmos.bank.v1beta1.Query/Balance, is a method of a Query service.
+func isQueryMethod(method string) bool {
+	service, _, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	return ok && (service == "Query" || strings.HasSuffix(service, ".Query"))
+}
@@ -0,0 +1,135 @@
+package querycache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(config.DefaultConfig().GRPC.QueryCache, nil)
+	require.NoError(t, err)
+
+	_, err = New(config.QueryCacheConfig{Size: 0}, nil)
+	require.ErrorContains(t, err, "size must be positive")
+}
+
+func TestIsQueryMethod(t *testing.T) {
+	require.True(t, isQueryMethod("/cosmos.bank.v1beta1.Query/Balance"))
+	require.True(t, isQueryMethod("/Query/Balance"))
+	require.False(t, isQueryMethod("/cosmos.tx.v1beta1.Service/BroadcastTx"))
+	require.False(t, isQueryMethod("/cosmos.bank.v1beta1.MyQuery/Balance"))
+	require.False(t, isQueryMethod("Query"))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	c, err := New(config.QueryCacheConfig{Size: 2, TTL: 10}, func() int64 { return 10 })
+	require.NoError(t, err)
+
+	now := time.Unix(0, 0)
+	c.now = func() time.Time { return now }
+
+	calls := 0
+	handler := func(_ context.Context, req any) (any, error) {
+		calls++
+		if req.(*testdata.EchoRequest).Message == "fail" {
+			return nil, errors.New("query failed")
+		}
+		return &testdata.EchoResponse{Message: req.(*testdata.EchoRequest).Message}, nil
+	}
+
+	interceptor := c.UnaryServerInterceptor()
+	query := func(method, height, msg string) (any, error) {
+		ctx := context.Background()
+		if height != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, height))
+		}
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &headerStream{})
+		return interceptor(ctx, &testdata.EchoRequest{Message: msg}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	const method = "/testpb.Query/Echo"
+	requireCalls := func(expCalls int, method, height, msg string) {
+		t.Helper()
+		resp, err := query(method, height, msg)
+		require.NoError(t, err)
+		require.Equal(t, msg, resp.(*testdata.EchoResponse).Message)
+		require.Equal(t, expCalls, calls)
+	}
+
+	// the queries pinned to a committed height are cached
+	requireCalls(1, method, "5", "hello")
+	requireCalls(1, method, "5", "hello")
+
+	// per height, request and method
+	requireCalls(2, method, "6", "hello")
+	requireCalls(3, method, "5", "world")
+	requireCalls(4, "/testpb.Other.Query/Echo", "5", "hello")
+
+	// the queries of the latest or a future height and of other services are not cached
+	requireCalls(5, method, "", "hello")
+	requireCalls(6, method, "", "hello")
+	requireCalls(7, method, "11", "hello")
+	requireCalls(8, method, "11", "hello")
+	requireCalls(9, "/testpb.Service/Echo", "5", "hello")
+	requireCalls(10, "/testpb.Service/Echo", "5", "hello")
+
+	// nor are the errors
+	_, err = query(method, "5", "fail")
+	require.Error(t, err)
+	_, err = query(method, "5", "fail")
+	require.Error(t, err)
+	require.Equal(t, 12, calls)
+
+	// the least recently used responses are evicted
+	requireCalls(13, method, "5", "hello")
+	requireCalls(13, method, "5", "hello")
+
+	// the responses expire after the TTL
+	now = now.Add(10 * time.Second)
+	requireCalls(14, method, "5", "hello")
+	requireCalls(14, method, "5", "hello")
+}
+
+func TestUnaryServerInterceptorHeader(t *testing.T) {
+	c, err := New(config.QueryCacheConfig{Size: 1}, func() int64 { return 10 })
+	require.NoError(t, err)
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &testdata.EchoResponse{}, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "05"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/testpb.Query/Echo"}
+	for i := 0; i < 2; i++ {
+		stream := &headerStream{}
+		_, err = c.UnaryServerInterceptor()(grpc.NewContextWithServerTransportStream(ctx, stream), &testdata.EchoRequest{}, info, handler)
+		require.NoError(t, err)
+		if i == 1 {
+			// the cached responses are served with the height header
+			require.Equal(t, []string{"5"}, stream.header.Get(grpctypes.GRPCBlockHeightHeader))
+		}
+	}
+}
+
+// headerStream is a grpc.ServerTransportStream recording the headers set.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
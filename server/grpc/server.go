@@ -13,7 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	"github.com/cosmos/cosmos-sdk/server/grpc/querycache"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino" // Import amino.proto file for reflection
 )
@@ -31,11 +33,36 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 		maxRecvMsgSize = config.DefaultGRPCMaxRecvMsgSize
 	}
 
-	grpcSrv := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
 		grpc.MaxSendMsgSize(maxSendMsgSize),
 		grpc.MaxRecvMsgSize(maxRecvMsgSize),
-	)
+	}
+
+	if cfg.RateLimit.Enable {
+		limiter, err := ratelimit.New(cfg.RateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gRPC rate limit config: %w", err)
+		}
+
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(limiter.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(limiter.StreamServerInterceptor()),
+		)
+	}
+
+	if cfg.QueryCache.Enable {
+		cache, err := querycache.New(cfg.QueryCache, func() int64 {
+			return app.CommitMultiStore().LastCommitID().Version
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid gRPC query cache config: %w", err)
+		}
+
+		opts = append(opts, grpc.ChainUnaryInterceptor(cache.UnaryServerInterceptor()))
+	}
+
+	grpcSrv := grpc.NewServer(opts...)
 
 	app.RegisterGRPCServer(grpcSrv)
 
@@ -0,0 +1,58 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor returns a gRPC interceptor rejecting the unauthorized
+// calls with Unauthenticated and the rate limited ones with ResourceExhausted.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.allowGRPC(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the streaming counterpart of UnaryServerInterceptor.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allowGRPC(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// allowGRPC checks the gRPC call of the given method.
+func (l *Limiter) allowGRPC(ctx context.Context, method string) error {
+	var ip net.IP
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = remoteIP(p.Addr.String())
+	}
+
+	var apiKey string
+	if values := metadata.ValueFromIncomingContext(ctx, strings.ToLower(APIKeyHeader)); len(values) > 0 {
+		apiKey = values[0]
+	}
+
+	switch err := l.Allow(ip, apiKey, method); err {
+	case nil:
+		return nil
+	case ErrUnauthorized:
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+}
@@ -0,0 +1,42 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+)
+
+// HTTPMiddleware returns a middleware rejecting the unauthorized requests with
+// 401 Unauthorized and the rate limited ones with 429 Too Many Requests.
+func (l *Limiter) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := l.Allow(remoteIP(r.RemoteAddr), r.Header.Get(APIKeyHeader), r.URL.Path)
+		switch err {
+		case nil:
+			next.ServeHTTP(w, r)
+		case ErrUnauthorized:
+			writeError(w, http.StatusUnauthorized, err)
+		default:
+			writeError(w, http.StatusTooManyRequests, err)
+		}
+	})
+}
+
+// writeError writes a JSON error response, in the format of the API server errors.
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+// remoteIP returns the IP address of a host:port address, or nil if it cannot be parsed.
+func remoteIP(addr string) net.IP {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	return net.ParseIP(host)
+}
@@ -0,0 +1,230 @@
+// Package ratelimit implements the rate limiting and API key authentication
+// middleware of the API and gRPC servers.
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// APIKeyHeader is the HTTP header, and lowercased the gRPC metadata key, in which
+// clients provide their API key.
+const APIKeyHeader = "X-API-Key"
+
+// cleanupInterval is the interval at which the limiters of inactive clients are removed.
+const cleanupInterval = time.Minute
+
+var (
+	// ErrUnauthorized is returned when a client does not provide a valid API key.
+	ErrUnauthorized = errors.New("missing or invalid API key")
+	// ErrRateLimited is returned when a client exceeds its rate limit.
+	ErrRateLimited = errors.New("rate limit exceeded")
+)
+
+// routeLimit is the rate limit of the routes starting with prefix.
+type routeLimit struct {
+	prefix string
+	limit  rate.Limit
+	burst  int
+}
+
+// clientLimiter is the rate limiter of a client on a route.
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter authenticates and rate limits the clients of a server.
+type Limiter struct {
+	defaultLimit routeLimit
+	// routes are sorted by decreasing prefix length, so that the most specific route matches first
+	routes    []routeLimit
+	apiKeys   map[string]struct{}
+	allowlist []*net.IPNet
+	// now is the clock of the limiter, overridable in tests
+	now func() time.Time
+
+	mtx         sync.Mutex
+	clients     map[string]*clientLimiter
+	lastCleanup time.Time
+}
+
+// New returns a Limiter from the given configuration.
+func New(cfg config.RateLimitConfig) (*Limiter, error) {
+	if cfg.RequestsPerSecond < 0 {
+		return nil, fmt.Errorf("requests per second cannot be negative: %v", cfg.RequestsPerSecond)
+	}
+
+	if cfg.Burst < 0 {
+		return nil, fmt.Errorf("burst cannot be negative: %d", cfg.Burst)
+	}
+
+	l := &Limiter{
+		defaultLimit: newRouteLimit("", cfg.RequestsPerSecond, cfg.Burst),
+		apiKeys:      make(map[string]struct{}, len(cfg.APIKeys)),
+		now:          time.Now,
+		clients:      map[string]*clientLimiter{},
+	}
+
+	for _, entry := range cfg.RouteLimits {
+		prefix, rpsStr, ok := strings.Cut(entry, "=")
+		if !ok || prefix == "" {
+			return nil, fmt.Errorf("invalid route limit %q: expected route=requests-per-second", entry)
+		}
+
+		rps, err := strconv.ParseFloat(rpsStr, 64)
+		if err != nil || rps < 0 {
+			return nil, fmt.Errorf("invalid route limit %q: invalid requests per second %q", entry, rpsStr)
+		}
+
+		l.routes = append(l.routes, newRouteLimit(prefix, rps, cfg.Burst))
+	}
+	sort.SliceStable(l.routes, func(i, j int) bool { return len(l.routes[i].prefix) > len(l.routes[j].prefix) })
+
+	for _, key := range cfg.APIKeys {
+		if key == "" {
+			return nil, errors.New("API keys cannot be empty")
+		}
+		l.apiKeys[key] = struct{}{}
+	}
+
+	for _, entry := range cfg.Allowlist {
+		ipNet, err := parseIPNet(entry)
+		if err != nil {
+			return nil, err
+		}
+		l.allowlist = append(l.allowlist, ipNet)
+	}
+
+	return l, nil
+}
+
+// newRouteLimit returns the limit of the routes starting with prefix.
+// A burst of 0 defaults to the requests per second, rounded up.
+func newRouteLimit(prefix string, rps float64, burst int) routeLimit {
+	if rps == 0 {
+		return routeLimit{prefix: prefix, limit: rate.Inf}
+	}
+
+	if burst == 0 {
+		burst = int(math.Ceil(rps))
+	}
+
+	return routeLimit{prefix: prefix, limit: rate.Limit(rps), burst: burst}
+}
+
+// parseIPNet parses an IP address or a CIDR range.
+func parseIPNet(entry string) (*net.IPNet, error) {
+	if strings.Contains(entry, "/") {
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowlist entry %q: %w", entry, err)
+		}
+
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(entry)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid allowlist entry %q: invalid IP address", entry)
+	}
+
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// Allow authenticates the client with the given IP address and API key and
+// checks its rate limit on the given route.
+// It returns ErrUnauthorized or ErrRateLimited if the request must be rejected.
+func (l *Limiter) Allow(ip net.IP, apiKey, route string) error {
+	if l.isAllowlisted(ip) {
+		return nil
+	}
+
+	// clients are identified by their API key when keys are required
+	client := ip.String()
+	if len(l.apiKeys) > 0 {
+		if _, ok := l.apiKeys[apiKey]; !ok {
+			return ErrUnauthorized
+		}
+		client = "key:" + apiKey
+	}
+
+	limit := l.routeLimit(route)
+	if limit.limit == rate.Inf {
+		return nil
+	}
+
+	if !l.clientLimiter(client+"|"+limit.prefix, limit).AllowN(l.now(), 1) {
+		return ErrRateLimited
+	}
+
+	return nil
+}
+
+// isAllowlisted returns whether the client is trusted.
+func (l *Limiter) isAllowlisted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, ipNet := range l.allowlist {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// routeLimit returns the limit of the given route.
+func (l *Limiter) routeLimit(route string) routeLimit {
+	for _, r := range l.routes {
+		if strings.HasPrefix(route, r.prefix) {
+			return r
+		}
+	}
+
+	return l.defaultLimit
+}
+
+// clientLimiter returns the rate limiter of the given client key, creating it if
+// needed, and removes the limiters of the inactive clients.
+func (l *Limiter) clientLimiter(key string, limit routeLimit) *rate.Limiter {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastCleanup) > cleanupInterval {
+		for k, c := range l.clients {
+			if now.Sub(c.lastSeen) > cleanupInterval {
+				delete(l.clients, k)
+			}
+		}
+		l.lastCleanup = now
+	}
+
+	c, ok := l.clients[key]
+	if !ok {
+		c = &clientLimiter{limiter: rate.NewLimiter(limit.limit, limit.burst)}
+		l.clients[key] = c
+	}
+	c.lastSeen = now
+
+	return c.limiter
+}
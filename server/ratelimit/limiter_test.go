@@ -0,0 +1,210 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    config.RateLimitConfig
+		expErr string
+	}{
+		{"default", config.DefaultRateLimitConfig(), ""},
+		{"negative rps", config.RateLimitConfig{RequestsPerSecond: -1}, "requests per second cannot be negative"},
+		{"negative burst", config.RateLimitConfig{Burst: -1}, "burst cannot be negative"},
+		{"valid route limit", config.RateLimitConfig{RouteLimits: []string{"/cosmos/tx=0.5"}}, ""},
+		{"route limit without rps", config.RateLimitConfig{RouteLimits: []string{"/cosmos/tx"}}, "expected route=requests-per-second"},
+		{"route limit with invalid rps", config.RateLimitConfig{RouteLimits: []string{"/cosmos/tx=fast"}}, "invalid requests per second"},
+		{"empty api key", config.RateLimitConfig{APIKeys: []string{""}}, "API keys cannot be empty"},
+		{"valid allowlist", config.RateLimitConfig{Allowlist: []string{"10.0.0.1", "192.168.0.0/16", "::1"}}, ""},
+		{"invalid ip", config.RateLimitConfig{Allowlist: []string{"10.0.0"}}, "invalid IP address"},
+		{"invalid cidr", config.RateLimitConfig{Allowlist: []string{"10.0.0.0/33"}}, "invalid allowlist entry"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := New(tc.cfg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	l, err := New(config.RateLimitConfig{
+		RequestsPerSecond: 1,
+		Burst:             2,
+		RouteLimits:       []string{"/cosmos/tx=0", "/cosmos/tx/v1beta1/simulate=0.5"},
+		Allowlist:         []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+
+	now := time.Unix(0, 0)
+	l.now = func() time.Time { return now }
+
+	client, other := net.ParseIP("1.2.3.4"), net.ParseIP("5.6.7.8")
+
+	// the burst is allowed, then the client is limited
+	require.NoError(t, l.Allow(client, "", "/cosmos/bank/v1beta1/balances"))
+	require.NoError(t, l.Allow(client, "", "/cosmos/bank/v1beta1/params"))
+	require.ErrorIs(t, l.Allow(client, "", "/cosmos/bank/v1beta1/balances"), ErrRateLimited)
+
+	// clients are limited independently
+	require.NoError(t, l.Allow(other, "", "/cosmos/bank/v1beta1/balances"))
+
+	// the most specific route limit applies
+	require.NoError(t, l.Allow(client, "", "/cosmos/tx/v1beta1/txs"))
+	require.NoError(t, l.Allow(client, "", "/cosmos/tx/v1beta1/simulate"))
+	require.NoError(t, l.Allow(client, "", "/cosmos/tx/v1beta1/simulate"))
+	require.ErrorIs(t, l.Allow(client, "", "/cosmos/tx/v1beta1/simulate"), ErrRateLimited)
+
+	// allowlisted clients are not limited
+	for i := 0; i < 5; i++ {
+		require.NoError(t, l.Allow(net.ParseIP("10.1.2.3"), "", "/cosmos/tx/v1beta1/simulate"))
+	}
+
+	// loopback clients are limited unless allowlisted
+	loopback := net.ParseIP("127.0.0.1")
+	require.NoError(t, l.Allow(loopback, "", "/cosmos/tx/v1beta1/simulate"))
+	require.NoError(t, l.Allow(loopback, "", "/cosmos/tx/v1beta1/simulate"))
+	require.ErrorIs(t, l.Allow(loopback, "", "/cosmos/tx/v1beta1/simulate"), ErrRateLimited)
+
+	// the tokens are refilled over time
+	now = now.Add(time.Second)
+	require.NoError(t, l.Allow(client, "", "/cosmos/bank/v1beta1/balances"))
+
+	// inactive clients are removed
+	now = now.Add(2 * cleanupInterval)
+	require.NoError(t, l.Allow(other, "", "/cosmos/bank/v1beta1/balances"))
+	require.Len(t, l.clients, 1)
+}
+
+func TestLimiterAPIKeys(t *testing.T) {
+	l, err := New(config.RateLimitConfig{
+		RequestsPerSecond: 1,
+		APIKeys:           []string{"key1", "key2"},
+		Allowlist:         []string{"10.0.0.1"},
+	})
+	require.NoError(t, err)
+	l.now = func() time.Time { return time.Unix(0, 0) }
+
+	client := net.ParseIP("1.2.3.4")
+	require.ErrorIs(t, l.Allow(client, "", "/"), ErrUnauthorized)
+	require.ErrorIs(t, l.Allow(client, "unknown", "/"), ErrUnauthorized)
+
+	// clients are limited per API key
+	require.NoError(t, l.Allow(client, "key1", "/"))
+	require.ErrorIs(t, l.Allow(net.ParseIP("5.6.7.8"), "key1", "/"), ErrRateLimited)
+	require.NoError(t, l.Allow(client, "key2", "/"))
+
+	// allowlisted clients are not authenticated
+	require.NoError(t, l.Allow(net.ParseIP("10.0.0.1"), "", "/"))
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	l, err := New(config.RateLimitConfig{RequestsPerSecond: 1, APIKeys: []string{"key"}})
+	require.NoError(t, err)
+
+	handler := l.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(remoteAddr, apiKey string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/params", nil)
+		req.RemoteAddr = remoteAddr
+		if apiKey != "" {
+			req.Header.Set(APIKeyHeader, apiKey)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve("1.2.3.4:1234", "")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.JSONEq(t, `{"error":"missing or invalid API key"}`, rec.Body.String())
+
+	require.Equal(t, http.StatusOK, serve("1.2.3.4:1234", "key").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve("1.2.3.4:1234", "key").Code)
+
+	// requests from the loopback interface, e.g. through a local reverse proxy, are authenticated too
+	require.Equal(t, http.StatusUnauthorized, serve("127.0.0.1:1234", "").Code)
+	require.Equal(t, http.StatusUnauthorized, serve("[::1]:1234", "").Code)
+}
+
+func TestHTTPMiddlewareAPIDefaults(t *testing.T) {
+	cfg := config.DefaultConfig().API.RateLimit
+	cfg.APIKeys = []string{"key"}
+	l, err := New(cfg)
+	require.NoError(t, err)
+
+	handler := l.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/params", nil)
+	req.RemoteAddr = "127.0.0.1:1234"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l, err := New(config.RateLimitConfig{RequestsPerSecond: 1, APIKeys: []string{"key"}})
+	require.NoError(t, err)
+
+	interceptor := l.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/Params"}
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1234}})
+	_, err = interceptor(ctx, nil, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "key"))
+	resp, err := interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+
+	_, err = interceptor(ctx, nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestUnaryServerInterceptorGRPCDefaults(t *testing.T) {
+	cfg := config.DefaultConfig().GRPC.RateLimit
+	cfg.APIKeys = []string{"key"}
+	l, err := New(cfg)
+	require.NoError(t, err)
+
+	interceptor := l.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/Params"}
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	// the requests forwarded by the API server over the loopback interface are allowlisted
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}})
+	_, err = interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1234}})
+	_, err = interceptor(ctx, nil, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	// ReloadKeyLogLevel is the configuration key of the log level.
+	ReloadKeyLogLevel = flags.FlagLogLevel
+	// ReloadKeyAPIEnable is the configuration key of the API server enablement.
+	ReloadKeyAPIEnable = "api.enable"
+	// ReloadKeyTelemetryEnabled is the configuration key of the telemetry enablement.
+	ReloadKeyTelemetryEnabled = "telemetry.enabled"
+)
+
+// ReloadableConfig is the subset of the node configuration that can be reloaded
+// at runtime, without restarting the node.
+type ReloadableConfig struct {
+	LogLevel         string
+	APIEnable        bool
+	TelemetryEnabled bool
+}
+
+// ReloadableConfigFromViper reads the reloadable configuration values from v.
+func ReloadableConfigFromViper(v *viper.Viper) ReloadableConfig {
+	return ReloadableConfig{
+		LogLevel:         v.GetString(ReloadKeyLogLevel),
+		APIEnable:        v.GetBool(ReloadKeyAPIEnable),
+		TelemetryEnabled: v.GetBool(ReloadKeyTelemetryEnabled),
+	}
+}
+
+// Validate performs basic validation of the reloadable configuration values.
+func (c ReloadableConfig) Validate() error {
+	if c.LogLevel != "" {
+		if _, err := log.ParseLogLevel(c.LogLevel); err != nil {
+			return fmt.Errorf("invalid %s: %w", ReloadKeyLogLevel, err)
+		}
+	}
+
+	return nil
+}
+
+// ConfigChange describes a configuration value modified by a reload.
+type ConfigChange struct {
+	Key string
+	Old any
+	New any
+	// Err is set when the new value could not be applied.
+	Err error
+}
+
+// ConfigReloader reloads the reloadable configuration values from the node
+// configuration files and applies them to the running node.
+type ConfigReloader struct {
+	mtx     sync.Mutex
+	logger  log.Logger
+	rootDir string
+	current ReloadableConfig
+
+	logLevel        *logLevelFilter
+	apiToggle       func(enable bool) error
+	telemetryToggle func(enabled bool) error
+}
+
+// NewConfigReloader returns a ConfigReloader for the node of the given server
+// context. The current configuration is read from the server context viper.
+func NewConfigReloader(svrCtx *Context) *ConfigReloader {
+	return &ConfigReloader{
+		logger:   svrCtx.Logger,
+		rootDir:  svrCtx.Config.RootDir,
+		current:  ReloadableConfigFromViper(svrCtx.Viper),
+		logLevel: svrCtx.logLevel,
+	}
+}
+
+// SetAPIToggle sets the function used to start or stop the API server when its
+// enablement changes.
+func (r *ConfigReloader) SetAPIToggle(toggle func(enable bool) error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.apiToggle = toggle
+}
+
+// SetTelemetryToggle sets the function used to pause or resume telemetry when
+// its enablement changes.
+func (r *ConfigReloader) SetTelemetryToggle(toggle func(enabled bool) error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.telemetryToggle = toggle
+}
+
+// Reload reads the configuration files again and applies the reloadable values
+// that changed. Invalid configurations are rejected as a whole, while a value
+// that fails to be applied is reported through its ConfigChange.Err and kept
+// at its previous value.
+func (r *ConfigReloader) Reload() ([]ConfigChange, error) {
+	v, err := readConfigFiles(r.rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.apply(ReloadableConfigFromViper(v))
+}
+
+func (r *ConfigReloader) apply(next ReloadableConfig) ([]ConfigChange, error) {
+	if err := next.Validate(); err != nil {
+		return nil, err
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var changes []ConfigChange
+	if next.LogLevel != r.current.LogLevel {
+		change := ConfigChange{Key: ReloadKeyLogLevel, Old: r.current.LogLevel, New: next.LogLevel}
+		if r.logLevel == nil {
+			change.Err = errors.New("the logger does not support reloading its level, a restart is required")
+		} else {
+			change.Err = r.logLevel.Set(next.LogLevel)
+		}
+		if change.Err == nil {
+			r.current.LogLevel = next.LogLevel
+		}
+		changes = append(changes, change)
+	}
+
+	if next.APIEnable != r.current.APIEnable {
+		change := ConfigChange{Key: ReloadKeyAPIEnable, Old: r.current.APIEnable, New: next.APIEnable}
+		change.Err = applyToggle(r.apiToggle, next.APIEnable)
+		if change.Err == nil {
+			r.current.APIEnable = next.APIEnable
+		}
+		changes = append(changes, change)
+	}
+
+	if next.TelemetryEnabled != r.current.TelemetryEnabled {
+		change := ConfigChange{Key: ReloadKeyTelemetryEnabled, Old: r.current.TelemetryEnabled, New: next.TelemetryEnabled}
+		change.Err = applyToggle(r.telemetryToggle, next.TelemetryEnabled)
+		if change.Err == nil {
+			r.current.TelemetryEnabled = next.TelemetryEnabled
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func applyToggle(toggle func(bool) error, value bool) error {
+	if toggle == nil {
+		return errors.New("the value cannot be changed at runtime, a restart is required")
+	}
+
+	return toggle(value)
+}
+
+// ListenForReloadSignals reloads the configuration each time the process
+// receives a SIGHUP, until ctx is done. The outcome of every reload is logged.
+func (r *ConfigReloader) ListenForReloadSignals(ctx context.Context) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(sigCh)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sigCh:
+				r.logger.Info("caught signal, reloading configuration", "signal", syscall.SIGHUP.String())
+				changes, err := r.Reload()
+				if err != nil {
+					r.logger.Error("failed to reload configuration", "err", err)
+					continue
+				}
+
+				if len(changes) == 0 {
+					r.logger.Info("configuration reloaded, nothing changed")
+					continue
+				}
+
+				for _, change := range changes {
+					if change.Err != nil {
+						r.logger.Error("failed to apply configuration change", "key", change.Key, "old", change.Old, "new", change.New, "err", change.Err)
+						continue
+					}
+
+					r.logger.Info("configuration change applied", "key", change.Key, "old", change.Old, "new", change.New)
+				}
+			}
+		}
+	}()
+}
+
+// readConfigFiles reads the CometBFT and app configuration files of the node
+// into a new viper instance.
+func readConfigFiles(rootDir string) (*viper.Viper, error) {
+	configPath := filepath.Join(rootDir, "config")
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	v.SetConfigFile(filepath.Join(configPath, "config.toml"))
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read in %s: %w", v.ConfigFileUsed(), err)
+	}
+
+	v.SetConfigFile(filepath.Join(configPath, "app.toml"))
+	if err := v.MergeInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to merge configuration: %w", err)
+	}
+
+	return v, nil
+}
+
+// logLevelFilter is a log filter whose log level can be changed at runtime.
+type logLevelFilter struct {
+	filter atomic.Pointer[log.FilterFunc]
+}
+
+func newLogLevelFilter(logLevel string) (*logLevelFilter, error) {
+	f := &logLevelFilter{}
+	if err := f.Set(logLevel); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Set replaces the filter with one parsed from the given log level, which is
+// either a plain level (e.g. "info") or a list of module:level pairs.
+func (f *logLevelFilter) Set(logLevel string) error {
+	filter, err := log.ParseLogLevel(logLevel)
+	if err != nil {
+		return err
+	}
+
+	f.filter.Store(&filter)
+	return nil
+}
+
+// Filter implements log.FilterFunc.
+func (f *logLevelFilter) Filter(key, level string) bool {
+	filter := f.filter.Load()
+	if filter == nil {
+		return false
+	}
+
+	return (*filter)(key, level)
+}
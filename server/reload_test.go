@@ -0,0 +1,123 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func writeConfigFiles(t *testing.T, rootDir, logLevel, appToml string) {
+	t.Helper()
+	configPath := filepath.Join(rootDir, "config")
+	require.NoError(t, os.MkdirAll(configPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(configPath, "config.toml"), []byte("log_level = \""+logLevel+"\"\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(configPath, "app.toml"), []byte(appToml), 0o600))
+}
+
+func TestConfigReloader(t *testing.T) {
+	rootDir := t.TempDir()
+	writeConfigFiles(t, rootDir, "info", "[api]\nenable = false\n\n[telemetry]\nenabled = true\n")
+
+	v := viper.New()
+	v.Set(ReloadKeyLogLevel, "info")
+	v.Set(ReloadKeyTelemetryEnabled, true)
+	svrCtx := NewContext(v, cmtcfg.DefaultConfig(), log.NewNopLogger())
+	svrCtx.Config.RootDir = rootDir
+	_, err := CreateSDKLogger(svrCtx, os.Stdout)
+	require.NoError(t, err)
+	require.True(t, svrCtx.logLevel.Filter("consensus", "debug"))
+
+	reloader := NewConfigReloader(svrCtx)
+
+	// nothing changed
+	changes, err := reloader.Reload()
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// invalid log level, nothing is applied
+	writeConfigFiles(t, rootDir, "consensus:foo", "[api]\nenable = true\n")
+	_, err = reloader.Reload()
+	require.ErrorContains(t, err, "invalid log_level")
+
+	// values without toggle cannot be changed
+	writeConfigFiles(t, rootDir, "consensus:debug,*:info", "[api]\nenable = true\n\n[telemetry]\nenabled = true\n")
+	changes, err = reloader.Reload()
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, ReloadKeyLogLevel, changes[0].Key)
+	require.NoError(t, changes[0].Err)
+	require.Equal(t, ReloadKeyAPIEnable, changes[1].Key)
+	require.ErrorContains(t, changes[1].Err, "restart is required")
+	require.False(t, svrCtx.logLevel.Filter("consensus", "debug"))
+	require.True(t, svrCtx.logLevel.Filter("p2p", "debug"))
+
+	var apiEnabled, telemetryEnabled bool
+	reloader.SetAPIToggle(func(enable bool) error {
+		apiEnabled = enable
+		return nil
+	})
+	reloader.SetTelemetryToggle(func(enabled bool) error {
+		return errors.New("cannot toggle telemetry")
+	})
+
+	writeConfigFiles(t, rootDir, "consensus:debug,*:info", "[api]\nenable = true\n\n[telemetry]\nenabled = false\n")
+	changes, err = reloader.Reload()
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, ReloadKeyAPIEnable, changes[0].Key)
+	require.NoError(t, changes[0].Err)
+	require.True(t, apiEnabled)
+	require.Equal(t, ReloadKeyTelemetryEnabled, changes[1].Key)
+	require.ErrorContains(t, changes[1].Err, "cannot toggle telemetry")
+	require.False(t, telemetryEnabled)
+
+	// failed changes are attempted again on the next reload
+	reloader.SetTelemetryToggle(func(enabled bool) error {
+		telemetryEnabled = enabled
+		return nil
+	})
+	telemetryEnabled = true
+	changes, err = reloader.Reload()
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.NoError(t, changes[0].Err)
+	require.False(t, telemetryEnabled)
+}
+
+func TestConfigReloaderModuleLogger(t *testing.T) {
+	rootDir := t.TempDir()
+	writeConfigFiles(t, rootDir, "info", "")
+
+	v := viper.New()
+	v.Set(ReloadKeyLogLevel, "info")
+	svrCtx := NewContext(v, cmtcfg.DefaultConfig(), log.NewNopLogger())
+	svrCtx.Config.RootDir = rootDir
+	buf := new(bytes.Buffer)
+	logger, err := CreateSDKLogger(svrCtx, buf)
+	require.NoError(t, err)
+
+	// the module logger is created before the reload
+	moduleLogger := logger.With(log.ModuleKey, "consensus")
+	moduleLogger.Debug("before reload")
+	require.Empty(t, buf.String())
+
+	reloader := NewConfigReloader(svrCtx)
+	writeConfigFiles(t, rootDir, "debug", "")
+	changes, err := reloader.Reload()
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.NoError(t, changes[0].Err)
+
+	moduleLogger.Debug("after reload")
+	require.True(t, strings.Contains(buf.String(), "after reload"))
+	require.False(t, strings.Contains(buf.String(), "before reload"))
+}
@@ -5,12 +5,14 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"runtime/pprof"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cometbft/cometbft/abci/server"
@@ -75,8 +77,13 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+	FlagCommitConcurrency   = "commit-concurrency"
+	FlagTxDecodeCacheSize   = "tx-decode-cache-size"
 	FlagShutdownGrace       = "shutdown-grace"
 
+	FlagErrorRedaction          = "error-redaction"
+	FlagErrorRedactionAllowlist = "error-redaction-allowlist"
+
 	// state sync-related flags
 
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -232,13 +239,23 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 
 	emitServerInfoMetrics()
 
+	reloader := NewConfigReloader(svrCtx)
+	reloader.SetTelemetryToggle(func(enabled bool) error {
+		if enabled && metrics == nil {
+			return errors.New("telemetry was disabled at startup, a restart is required to enable it")
+		}
+
+		telemetry.SetTelemetryEnabled(enabled)
+		return nil
+	})
+
 	if !withCmt {
-		return startStandAlone[T](svrCtx, svrCfg, clientCtx, app, metrics, opts)
+		return startStandAlone[T](svrCtx, svrCfg, clientCtx, app, metrics, reloader, opts)
 	}
-	return startInProcess[T](svrCtx, svrCfg, clientCtx, app, metrics, opts)
+	return startInProcess[T](svrCtx, svrCfg, clientCtx, app, metrics, reloader, opts)
 }
 
-func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app T, metrics *telemetry.Metrics, opts StartCmdOptions[T]) error {
+func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app T, metrics *telemetry.Metrics, reloader *ConfigReloader, opts StartCmdOptions[T]) error {
 	addr := svrCtx.Viper.GetString(flagAddress)
 	transport := svrCtx.Viper.GetString(flagTransport)
 
@@ -251,6 +268,7 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 	svr.SetLogger(servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger.With("module", "abci-server")})
 
 	g, ctx := getCtx(svrCtx, false)
+	reloader.ListenForReloadSignals(ctx)
 
 	// Add the tx service to the gRPC router. We only need to register this
 	// service if API or gRPC is enabled, and avoid doing so in the general
@@ -277,7 +295,7 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 		return err
 	}
 
-	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, svrCtx.Config.RootDir, grpcSrv, metrics)
+	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, svrCtx.Config.RootDir, grpcSrv, metrics, reloader)
 	if err != nil {
 		return err
 	}
@@ -305,12 +323,13 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 }
 
 func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app T,
-	metrics *telemetry.Metrics, opts StartCmdOptions[T],
+	metrics *telemetry.Metrics, reloader *ConfigReloader, opts StartCmdOptions[T],
 ) error {
 	cmtCfg := svrCtx.Config
 	gRPCOnly := svrCtx.Viper.GetBool(flagGRPCOnly)
 
 	g, ctx := getCtx(svrCtx, true)
+	reloader.ListenForReloadSignals(ctx)
 
 	if gRPCOnly {
 		// TODO: Generalize logic so that gRPC only is really in startStandAlone
@@ -343,7 +362,7 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 		return err
 	}
 
-	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, cmtCfg.RootDir, grpcSrv, metrics)
+	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, cmtCfg.RootDir, grpcSrv, metrics, reloader)
 	if err != nil {
 		return err
 	}
@@ -539,23 +558,85 @@ func startAPIServer(
 	home string,
 	grpcSrv *grpc.Server,
 	metrics *telemetry.Metrics,
+	reloader *ConfigReloader,
 ) error {
-	if !svrCfg.API.Enable {
-		return nil
+	clientCtx = clientCtx.WithHomeDir(home)
+
+	// The API server relies on the services registered when the API or the gRPC
+	// server is enabled at startup, so it can only be toggled at runtime if one of them is.
+	if svrCfg.API.Enable || svrCfg.GRPC.Enable {
+		apiCfg := svrCfg
+		apiCfg.API.Enable = true
+
+		toggle := &apiServerToggle{
+			ctx:    ctx,
+			logger: svrCtx.Logger,
+			start: func(ctx context.Context) error {
+				apiSrv := api.New(clientCtx, svrCtx.Logger.With("module", "api-server"), grpcSrv)
+				app.RegisterAPIRoutes(apiSrv, apiCfg.API)
+
+				if apiCfg.Telemetry.Enabled {
+					apiSrv.SetTelemetry(metrics)
+				}
+
+				return apiSrv.Start(ctx, apiCfg)
+			},
+		}
+		reloader.SetAPIToggle(toggle.SetEnabled)
+
+		if svrCfg.API.Enable {
+			g.Go(toggle.run)
+		}
 	}
 
-	clientCtx = clientCtx.WithHomeDir(home)
+	return nil
+}
+
+// apiServerToggle starts and stops the API server when its enablement is changed
+// by a configuration reload.
+type apiServerToggle struct {
+	mtx    sync.Mutex
+	ctx    context.Context
+	logger log.Logger
+	start  func(ctx context.Context) error
+	// cancel stops the running API server, it is nil when the server is stopped.
+	cancel context.CancelFunc
+}
 
-	apiSrv := api.New(clientCtx, svrCtx.Logger.With("module", "api-server"), grpcSrv)
-	app.RegisterAPIRoutes(apiSrv, svrCfg.API)
+// run starts the API server and blocks until it is stopped.
+func (t *apiServerToggle) run() error {
+	t.mtx.Lock()
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.cancel = cancel
+	t.mtx.Unlock()
 
-	if svrCfg.Telemetry.Enabled {
-		apiSrv.SetTelemetry(metrics)
+	return t.start(ctx)
+}
+
+// SetEnabled starts or stops the API server. A server started at runtime does
+// not stop the node when it fails, the failure is only logged.
+func (t *apiServerToggle) SetEnabled(enable bool) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if enable == (t.cancel != nil) {
+		return nil
 	}
 
-	g.Go(func() error {
-		return apiSrv.Start(ctx, svrCfg)
-	})
+	if !enable {
+		t.cancel()
+		t.cancel = nil
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.cancel = cancel
+	go func() {
+		if err := t.start(ctx); err != nil {
+			t.logger.Error("API server stopped", "err", err)
+		}
+	}()
+
 	return nil
 }
 
@@ -1008,6 +1089,10 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Int(FlagCommitConcurrency, 0, "Number of stores hashed and committed concurrently")
+	cmd.Flags().Int(FlagTxDecodeCacheSize, 0, "Maximum number of txs whose decoding is cached across the ABCI phases (0 disables the cache)")
+	cmd.Flags().String(FlagErrorRedaction, "none", "Information of the errors redacted from the ABCI responses (none|code|full)")
+	cmd.Flags().StringSlice(FlagErrorRedactionAllowlist, []string{}, "Codespaces whose errors are never redacted from the ABCI responses")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
@@ -17,7 +17,6 @@ import (
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
-	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -51,6 +50,9 @@ type Context struct {
 	Viper  *viper.Viper
 	Config *cmtcfg.Config
 	Logger log.Logger
+
+	// logLevel is the log level filter of Logger, when it has been created by CreateSDKLogger.
+	logLevel *logLevelFilter
 }
 
 func NewDefaultContext() *Context {
@@ -62,7 +64,7 @@ func NewDefaultContext() *Context {
 }
 
 func NewContext(v *viper.Viper, config *cmtcfg.Config, logger log.Logger) *Context {
-	return &Context{v, config, logger}
+	return &Context{Viper: v, Config: config, Logger: logger}
 }
 
 func bindFlags(basename string, cmd *cobra.Command, v *viper.Viper) (err error) {
@@ -186,19 +188,13 @@ func CreateSDKLogger(ctx *Context, out io.Writer) (log.Logger, error) {
 		return log.NewLogger(out, opts...), nil
 	}
 
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
-	switch {
-	case err != nil:
-		// If the log level is not a valid zerolog level, then we try to parse it as a key filter.
-		filterFunc, err := log.ParseLogLevel(logLvlStr)
-		if err != nil {
-			return nil, err
-		}
-
-		opts = append(opts, log.FilterOption(filterFunc))
-	default:
-		opts = append(opts, log.LevelOption(logLvl))
+	// the log level filter can be changed at runtime by a configuration reload
+	logLevel, err := newLogLevelFilter(logLvlStr)
+	if err != nil {
+		return nil, err
 	}
+	ctx.logLevel = logLevel
+	opts = append(opts, log.FilterOption(logLevel.Filter))
 
 	return log.NewLogger(out, opts...), nil
 }
@@ -547,9 +543,15 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
+		baseapp.SetCommitConcurrency(cast.ToInt(appOpts.Get(FlagCommitConcurrency))),
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetTxDecodeCacheSize(cast.ToInt(appOpts.Get(FlagTxDecodeCacheSize))),
+		baseapp.SetErrorRedaction(
+			baseapp.ErrorRedaction(cast.ToString(appOpts.Get(FlagErrorRedaction))),
+			cast.ToStringSlice(appOpts.Get(FlagErrorRedactionAllowlist)),
+		),
 	}
 }
 
@@ -0,0 +1,138 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+// bundleFiles are the files of a diagnostic bundle, with the diagnostics
+// server path they are fetched from.
+var bundleFiles = []struct {
+	name string
+	path string
+}{
+	{"goroutines.txt", "/debug/pprof/goroutine?debug=2"},
+	{"heap.pb.gz", "/debug/pprof/heap"},
+	{"runtime.json", "/debug/runtime"},
+	{"config.json", "/debug/config"},
+	{"logs.txt", "/debug/logs"},
+}
+
+// BundleCmd returns a command capturing a diagnostic bundle of a running node,
+// with its goroutines, heap profile, runtime stats, config and recent logs,
+// to attach to support requests.
+func BundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Capture a diagnostic bundle of a running node",
+		Long: `Capture a diagnostic bundle of a running node from its diagnostics server, as a tar.gz archive
+holding its goroutines, heap profile, runtime stats, config and recent logs. The diagnostics server
+must be enabled in the node config. Secrets of the config, such as the diagnostics auth token, are redacted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+
+			output, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = fmt.Sprintf("diagnostics-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z"))
+			}
+
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if err := writeBundle(cmd.Context(), f, "http://"+v.GetString(FlagAddress), v.GetString(FlagAuthToken), timeout); err != nil {
+				_ = os.Remove(output)
+				return err
+			}
+
+			cmd.Printf("Diagnostic bundle written to %s\n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, DefaultConfig().Address, "Address of the diagnostics server of the node")
+	cmd.Flags().String(FlagAuthToken, "", "Auth token of the diagnostics server of the node")
+	cmd.Flags().String(FlagOutput, "", "Path of the bundle file (default diagnostics-<time>.tar.gz)")
+	cmd.Flags().Duration(FlagTimeout, 30*time.Second, "Timeout of each query to the diagnostics server")
+
+	return cmd
+}
+
+// writeBundle fetches the bundle files from the diagnostics server at url and
+// writes them as a tar.gz archive to w.
+func writeBundle(ctx context.Context, w io.Writer, url, authToken string, timeout time.Duration) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	client := &http.Client{Timeout: timeout}
+	for _, file := range bundleFiles {
+		bz, err := fetch(ctx, client, url+file.path, authToken)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", file.name, err)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    file.name,
+			Mode:    0o600,
+			Size:    int64(len(bz)),
+			ModTime: time.Now(),
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(bz); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func fetch(ctx context.Context, client *http.Client, url, authToken string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s: %s", resp.Status, bz)
+	}
+
+	return bz, nil
+}
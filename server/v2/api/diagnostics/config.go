@@ -0,0 +1,39 @@
+package diagnostics
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable: false,
+		// DefaultDiagnosticsAddress defines the default address to bind the diagnostics server to.
+		Address:   "localhost:6062",
+		AuthToken: "",
+	}
+}
+
+// Config defines configuration for the diagnostics server.
+type Config struct {
+	// Enable defines if the diagnostics server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the diagnostics server, serving the pprof, execution trace, runtime stats and recent logs endpoints, should be enabled."`
+
+	// Address defines the diagnostics server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the diagnostics server address to bind to."`
+
+	// AuthToken defines the bearer token required to query the diagnostics server.
+	// No authentication is required if empty.
+	AuthToken string `mapstructure:"auth-token" toml:"auth-token" comment:"AuthToken defines the bearer token required to query the diagnostics server.\nNo authentication is required if empty, so only bind the server to a public address with a token."`
+}
+
+type CfgOption func(*Config)
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
+
+// Enable the diagnostics server by default (default disabled).
+func Enable() CfgOption {
+	return func(cfg *Config) {
+		cfg.Enable = true
+	}
+}
@@ -0,0 +1,22 @@
+package diagnostics
+
+import "fmt"
+
+// start flags are prefixed with the server name
+// as the config in prefixed with the server name
+// this allows viper to properly bind the flags
+func prefix(f string) string {
+	return fmt.Sprintf("%s.%s", ServerName, f)
+}
+
+var (
+	FlagAddress   = prefix("address")
+	FlagAuthToken = prefix("auth-token")
+)
+
+const (
+	// FlagOutput is the flag of the bundle command setting the bundle file path.
+	FlagOutput = "output"
+	// FlagTimeout is the flag of the bundle command setting the timeout of each query.
+	FlagTimeout = "timeout"
+)
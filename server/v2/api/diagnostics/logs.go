@@ -0,0 +1,66 @@
+package diagnostics
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// maxRecentLogs is the number of recent log lines kept by the diagnostics server.
+const maxRecentLogs = 1000
+
+var _ io.Writer = (*recentLogs)(nil)
+
+// recentLogs is a ring buffer of the most recent log lines.
+type recentLogs struct {
+	mu    sync.Mutex
+	lines [][]byte
+	next  int
+	full  bool
+}
+
+func newRecentLogs(size int) *recentLogs {
+	return &recentLogs{lines: make([][]byte, size)}
+}
+
+// Write implements io.Writer. The logger writes one entry per call, which may
+// span several lines.
+func (l *recentLogs) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, line := range bytes.SplitAfter(p, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		l.lines[l.next] = bytes.Clone(line)
+		l.next = (l.next + 1) % len(l.lines)
+		if l.next == 0 {
+			l.full = true
+		}
+	}
+
+	return len(p), nil
+}
+
+// WriteTo writes the recent log lines to w, oldest first.
+func (l *recentLogs) WriteTo(w io.Writer) (int64, error) {
+	l.mu.Lock()
+	lines := append([][]byte{}, l.lines[:l.next]...)
+	if l.full {
+		lines = append(append([][]byte{}, l.lines[l.next:]...), lines...)
+	}
+	l.mu.Unlock()
+
+	var n int64
+	for _, line := range lines {
+		m, err := w.Write(line)
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
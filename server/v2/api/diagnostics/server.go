@@ -0,0 +1,265 @@
+package diagnostics
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+var (
+	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasStartFlags                   = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasCLICommands                  = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasLogWriter                    = (*Server[transaction.Tx])(nil)
+)
+
+const (
+	ServerName = "diagnostics"
+
+	// redacted replaces the secrets of the config served by the diagnostics server.
+	redacted = "[redacted]"
+)
+
+// Server serves the pprof profiles, the execution trace, the runtime stats,
+// the config and the recent logs of the node, to diagnose performance issues.
+type Server[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+
+	startTime time.Time
+	settings  map[string]any
+	logs      *recentLogs
+	httpSrv   *http.Server
+}
+
+// New creates a new diagnostics server.
+func New[T transaction.Tx](cfgOptions ...CfgOption) *Server[T] {
+	return &Server[T]{
+		cfgOptions: cfgOptions,
+		logs:       newRecentLogs(maxRecentLogs),
+	}
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config == (&Config{}) {
+		cfg := DefaultConfig()
+		// overwrite the default config with the provided options
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
+
+// LogWriter implements serverv2.HasLogWriter, to serve the recent logs.
+func (s *Server[T]) LogWriter() io.Writer {
+	return s.logs
+}
+
+func (s *Server[T]) Init(_ serverv2.AppI[T], v *viper.Viper, logger log.Logger) error {
+	cfg := s.Config().(*Config)
+	if v != nil {
+		if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+
+		s.settings = redactSettings(v.AllSettings())
+	}
+
+	s.logger = logger.With(log.ModuleKey, s.Name())
+	s.config = cfg
+	s.startTime = time.Now()
+
+	if cfg.Enable {
+		if cfg.AuthToken == "" {
+			s.logger.Warn("diagnostics server enabled without auth token, make sure it is not publicly reachable", "address", cfg.Address)
+		}
+
+		s.httpSrv = &http.Server{
+			Handler:           s.router(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+	}
+
+	return nil
+}
+
+func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
+	flags.String(FlagAddress, "localhost:6062", "Listen address")
+	return flags
+}
+
+func (s *Server[T]) CLICommands() serverv2.CLIConfig {
+	return serverv2.CLIConfig{
+		Commands: []*cobra.Command{BundleCmd()},
+	}
+}
+
+// Start serves the diagnostics endpoints until the server is stopped.
+func (s *Server[T]) Start(context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+	}
+
+	s.logger.Info("starting diagnostics server...", "address", s.config.Address)
+	if err := s.httpSrv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error("failed to start diagnostics server", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	s.logger.Info("stopping diagnostics server...", "address", s.config.Address)
+	if err := s.httpSrv.Shutdown(ctx); err != nil {
+		// profiles and traces may take a while, do not wait for them
+		return s.httpSrv.Close()
+	}
+
+	return nil
+}
+
+// router returns the router of the diagnostics endpoints, which require the
+// auth token if set.
+func (s *Server[T]) router() *mux.Router {
+	r := mux.NewRouter()
+	r.Use(s.authenticate)
+
+	r.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	r.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	r.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	r.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	// the index serves the other profiles, such as goroutine or heap, by name
+	r.PathPrefix("/debug/pprof/").HandlerFunc(pprof.Index)
+
+	r.HandleFunc("/debug/runtime", s.handleRuntime).Methods(http.MethodGet)
+	r.HandleFunc("/debug/config", s.handleConfig).Methods(http.MethodGet)
+	r.HandleFunc("/debug/logs", s.handleLogs).Methods(http.MethodGet)
+
+	return r
+}
+
+func (s *Server[T]) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.config.AuthToken != "" {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.config.AuthToken)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RuntimeStats are the runtime stats of the node.
+type RuntimeStats struct {
+	GoVersion    string        `json:"go_version"`
+	NumCPU       int           `json:"num_cpu"`
+	GOMAXPROCS   int           `json:"gomaxprocs"`
+	NumGoroutine int           `json:"num_goroutine"`
+	NumCgoCall   int64         `json:"num_cgo_call"`
+	Uptime       time.Duration `json:"uptime"`
+
+	HeapAlloc    uint64 `json:"heap_alloc"`
+	HeapInuse    uint64 `json:"heap_inuse"`
+	HeapObjects  uint64 `json:"heap_objects"`
+	TotalAlloc   uint64 `json:"total_alloc"`
+	Sys          uint64 `json:"sys"`
+	NumGC        uint32 `json:"num_gc"`
+	PauseTotalNs uint64 `json:"pause_total_ns"`
+	LastGC       int64  `json:"last_gc"`
+}
+
+func (s *Server[T]) handleRuntime(w http.ResponseWriter, _ *http.Request) {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	writeJSON(w, RuntimeStats{
+		GoVersion:    runtime.Version(),
+		NumCPU:       runtime.NumCPU(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		NumGoroutine: runtime.NumGoroutine(),
+		NumCgoCall:   runtime.NumCgoCall(),
+		Uptime:       time.Since(s.startTime),
+		HeapAlloc:    m.HeapAlloc,
+		HeapInuse:    m.HeapInuse,
+		HeapObjects:  m.HeapObjects,
+		TotalAlloc:   m.TotalAlloc,
+		Sys:          m.Sys,
+		NumGC:        m.NumGC,
+		PauseTotalNs: m.PauseTotalNs,
+		LastGC:       int64(m.LastGC),
+	})
+}
+
+func (s *Server[T]) handleConfig(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, s.settings)
+}
+
+func (s *Server[T]) handleLogs(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = s.logs.WriteTo(w)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// redactSettings redacts the secrets of the node settings.
+func redactSettings(settings map[string]any) map[string]any {
+	if cfg, ok := settings[ServerName].(map[string]any); ok {
+		if _, ok := cfg["auth-token"]; ok {
+			cfg["auth-token"] = redacted
+		}
+	}
+
+	return settings
+}
@@ -0,0 +1,104 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+)
+
+func TestRecentLogs(t *testing.T) {
+	logs := newRecentLogs(3)
+	for i := 0; i < 4; i++ {
+		_, err := fmt.Fprintf(logs, "line %d\n", i)
+		require.NoError(t, err)
+	}
+
+	var buf bytes.Buffer
+	_, err := logs.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, "line 1\nline 2\nline 3\n", buf.String())
+}
+
+func TestServer(t *testing.T) {
+	v := viper.New()
+	v.Set("diagnostics.enable", true)
+	v.Set("diagnostics.auth-token", "secret")
+
+	srv := New[transaction.Tx]()
+	logger := log.NewLogger(srv.LogWriter())
+	require.NoError(t, srv.Init(nil, v, logger))
+	logger.Info("hello diagnostics")
+
+	ts := httptest.NewServer(srv.router())
+	defer ts.Close()
+
+	get := func(path, token string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+path, nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	// the auth token is required
+	for _, token := range []string{"", "invalid"} {
+		resp := get("/debug/runtime", token)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp.Body.Close()
+	}
+
+	resp := get("/debug/runtime", "secret")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var stats RuntimeStats
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&stats))
+	resp.Body.Close()
+	require.Positive(t, stats.NumGoroutine)
+
+	// the auth token is redacted from the config
+	resp = get("/debug/config", "secret")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var settings map[string]map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&settings))
+	resp.Body.Close()
+	require.Equal(t, redacted, settings[ServerName]["auth-token"])
+
+	var bundle bytes.Buffer
+	require.NoError(t, writeBundle(context.Background(), &bundle, ts.URL, "secret", time.Minute))
+	require.Error(t, writeBundle(context.Background(), io.Discard, ts.URL, "", time.Minute))
+
+	gr, err := gzip.NewReader(&bundle)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	files := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		files[hdr.Name], err = io.ReadAll(tr)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, files, len(bundleFiles))
+	require.Contains(t, string(files["goroutines.txt"]), "goroutine")
+	require.Contains(t, string(files["logs.txt"]), "hello diagnostics")
+	require.NotContains(t, string(files["config.json"]), "secret")
+}
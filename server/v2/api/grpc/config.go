@@ -1,6 +1,10 @@
 package grpc
 
-import "math"
+import (
+	"math"
+
+	serverv2 "cosmossdk.io/server/v2"
+)
 
 func DefaultConfig() *Config {
 	return &Config{
@@ -31,6 +35,16 @@ type Config struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size" toml:"max-send-msg-size" comment:"MaxSendMsgSize defines the max message size in bytes the server can send.\nThe default value is math.MaxInt32."`
+
+	// Listeners defines additional listeners of the gRPC server, such as a unix
+	// socket for local sidecars or a TCP address with TLS.
+	Listeners []serverv2.ListenerConfig `mapstructure:"listeners" toml:"listeners" comment:"Listeners defines additional listeners of the gRPC server, such as a unix socket for local sidecars or a TCP address with TLS.\nThey replace the empty list below, e.g.:\n  [[grpc.listeners]]\n  address = \"unix:///var/run/app.sock\"\n\n  [[grpc.listeners]]\n  address = \"0.0.0.0:9091\"\n  tls-cert-file = \"/path/to/cert.pem\"\n  tls-key-file = \"/path/to/key.pem\""`
+}
+
+// listenerConfigs returns the configs of all the listeners of the server,
+// starting with the main address.
+func (c *Config) listenerConfigs() []serverv2.ListenerConfig {
+	return append([]serverv2.ListenerConfig{{Address: c.Address}}, c.Listeners...)
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -0,0 +1,17 @@
+package grpc
+
+import (
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+// Extension is an additional gRPC service served by the gRPC server alongside
+// the app queries, such as node services reading the store directly. The app
+// queries are only used for the methods not registered by the extensions.
+type Extension interface {
+	// Name returns the name of the extension, which must be unique.
+	Name() string
+
+	// RegisterServices registers the gRPC services of the extension on the gRPC
+	// server.
+	RegisterServices(s gogogrpc.Server) error
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net"
 	"strconv"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -33,6 +32,7 @@ type Server[T transaction.Tx] struct {
 	logger     log.Logger
 	config     *Config
 	cfgOptions []CfgOption
+	extensions []Extension
 
 	grpcSrv *grpc.Server
 }
@@ -44,6 +44,13 @@ func New[T transaction.Tx](cfgOptions ...CfgOption) *Server[T] {
 	}
 }
 
+// WithExtensions serves the given extensions on the gRPC server, alongside the
+// app queries. It must be called before the server is initialized.
+func (s *Server[T]) WithExtensions(extensions ...Extension) *Server[T] {
+	s.extensions = append(s.extensions, extensions...)
+	return s
+}
+
 // Init returns a correctly configured and initialized gRPC server.
 // Note, the caller is responsible for starting the server.
 func (s *Server[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger log.Logger) error {
@@ -64,8 +71,26 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger log.Logge
 		),
 	)
 
+	names := make(map[string]bool, len(s.extensions))
+	for _, ext := range s.extensions {
+		if names[ext.Name()] {
+			return fmt.Errorf("duplicate gRPC server extension %s", ext.Name())
+		}
+		names[ext.Name()] = true
+
+		if err := ext.RegisterServices(grpcSrv); err != nil {
+			return fmt.Errorf("failed to register services of gRPC server extension %s: %w", ext.Name(), err)
+		}
+	}
+
 	// Reflection allows external clients to see what services and methods the gRPC server exposes.
-	gogoreflection.Register(grpcSrv, maps.Keys(methodsMap), logger.With("sub-module", "grpc-reflection"))
+	methods := maps.Keys(methodsMap)
+	for name, info := range grpcSrv.GetServiceInfo() {
+		for _, method := range info.Methods {
+			methods = append(methods, fmt.Sprintf("/%s/%s", name, method.Name))
+		}
+	}
+	gogoreflection.Register(grpcSrv, methods, logger.With("sub-module", "grpc-reflection"))
 
 	s.grpcSrv = grpcSrv
 	s.config = cfg
@@ -166,35 +191,57 @@ func (s *Server[T]) Start(ctx context.Context) error {
 		return nil
 	}
 
-	listener, err := net.Listen("tcp", s.config.Address)
+	listeners, err := serverv2.ListenAll(s.config.listenerConfigs(), "h2")
 	if err != nil {
-		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+		return err
 	}
 
-	errCh := make(chan error)
+	errCh := make(chan error, len(listeners))
 
-	// Start the gRPC in an external goroutine as Serve is blocking and will return
+	// Start the gRPC in external goroutines as Serve is blocking and will return
 	// an error upon failure, which we'll send on the error channel that will be
 	// consumed by the for block below.
-	go func() {
-		s.logger.Info("starting gRPC server...", "address", s.config.Address)
-		errCh <- s.grpcSrv.Serve(listener)
-	}()
+	for _, listener := range listeners {
+		listener := listener
+		go func() {
+			s.logger.Info("starting gRPC server...", "address", listener.Addr().String())
+			errCh <- s.grpcSrv.Serve(listener)
+		}()
+	}
 
-	// Start a blocking select to wait for an indication to stop the server or that
-	// the server failed to start properly.
-	err = <-errCh
-	s.logger.Error("failed to start gRPC server", "err", err)
-	return err
+	// Wait for an indication to stop the server or that the server failed to
+	// start properly on one of the listeners.
+	for range listeners {
+		if err := <-errCh; err != nil {
+			s.logger.Error("failed to start gRPC server", "err", err)
+			return err
+		}
+	}
+
+	return nil
 }
 
+// Stop stops the gRPC server from accepting new requests, and waits for the
+// in-flight ones to complete until ctx is done, before closing the server.
 func (s *Server[T]) Stop(ctx context.Context) error {
 	if !s.config.Enable {
 		return nil
 	}
 
 	s.logger.Info("stopping gRPC server...", "address", s.config.Address)
-	s.grpcSrv.GracefulStop()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.logger.Warn("gRPC server did not drain in-flight requests in time, closing them", "err", ctx.Err())
+		s.grpcSrv.Stop()
+	}
 
 	return nil
 }
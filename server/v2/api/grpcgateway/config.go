@@ -1,14 +1,31 @@
 package grpcgateway
 
+import serverv2 "cosmossdk.io/server/v2"
+
 func DefaultConfig() *Config {
 	return &Config{
 		Enable: true,
+		// DefaultAPIAddress defines the default address to bind the API server to.
+		Address: "localhost:1317",
 	}
 }
 
 type Config struct {
 	// Enable defines if the gRPC-gateway should be enabled.
 	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the gRPC-gateway should be enabled."`
+
+	// Address defines the API server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the API server address to bind to, serving the gRPC-gateway routes and the extensions."`
+
+	// Listeners defines additional listeners of the API server, such as a unix
+	// socket for local sidecars or a TCP address with TLS.
+	Listeners []serverv2.ListenerConfig `mapstructure:"listeners" toml:"listeners" comment:"Listeners defines additional listeners of the API server, such as a unix socket for local sidecars or a TCP address with TLS.\nThey replace the empty list below, e.g.:\n  [[grpc-gateway.listeners]]\n  address = \"unix:///var/run/app.sock\"\n\n  [[grpc-gateway.listeners]]\n  address = \"0.0.0.0:1318\"\n  tls-cert-file = \"/path/to/cert.pem\"\n  tls-key-file = \"/path/to/key.pem\""`
+}
+
+// listenerConfigs returns the configs of all the listeners of the server,
+// starting with the main address.
+func (c *Config) listenerConfigs() []serverv2.ListenerConfig {
+	return append([]serverv2.ListenerConfig{{Address: c.Address}}, c.Listeners...)
 }
 
 type CfgOption func(*Config)
@@ -0,0 +1,29 @@
+package grpcgateway
+
+import (
+	"context"
+
+	"github.com/gorilla/mux"
+)
+
+// Extension is an additional HTTP service mounted on the API server, such as
+// custom endpoints, static dashboards or webhooks. It allows apps to serve
+// those services from the API server instead of running sidecar HTTP servers.
+type Extension interface {
+	// Name returns the name of the extension, which must be unique.
+	Name() string
+
+	// RegisterRoutes registers the routes of the extension on the API server
+	// router. The routes registered by the extensions take precedence over the
+	// gRPC-gateway routes.
+	RegisterRoutes(r *mux.Router) error
+}
+
+// HasLifecycle is an extension with a lifecycle bound to the API server one.
+type HasLifecycle interface {
+	// Start is called before the API server starts serving. It must not block.
+	Start(context.Context) error
+
+	// Stop is called after the API server stopped serving.
+	Stop(context.Context) error
+}
@@ -0,0 +1,113 @@
+package grpcgateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	coreapp "cosmossdk.io/core/app"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/appmanager"
+)
+
+var _ serverv2.AppI[transaction.Tx] = mockApp{}
+
+type mockApp struct{}
+
+func (mockApp) Name() string                                          { return "mock" }
+func (mockApp) InterfaceRegistry() coreapp.InterfaceRegistry          { return nil }
+func (mockApp) GetAppManager() *appmanager.AppManager[transaction.Tx] { return nil }
+func (mockApp) GetConsensusAuthority() string                         { return "" }
+func (mockApp) GetStore() any                                         { return nil }
+
+func (mockApp) GetGPRCMethodsToMessageMap() map[string]func() gogoproto.Message {
+	return map[string]func() gogoproto.Message{}
+}
+
+var (
+	_ Extension    = &mockExtension{}
+	_ HasLifecycle = &mockExtension{}
+)
+
+type mockExtension struct {
+	name    string
+	started bool
+	stopped bool
+}
+
+func (e *mockExtension) Name() string { return e.name }
+
+func (e *mockExtension) RegisterRoutes(r *mux.Router) error {
+	r.HandleFunc(fmt.Sprintf("/%s/status", e.name), func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(e.name))
+	}).Methods(http.MethodGet)
+	return nil
+}
+
+func (e *mockExtension) Start(context.Context) error {
+	e.started = true
+	return nil
+}
+
+func (e *mockExtension) Stop(context.Context) error {
+	e.stopped = true
+	return nil
+}
+
+func TestExtensions(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	// the routes are also served on a unix socket
+	socket := filepath.Join(t.TempDir(), "api.sock")
+	unixClient := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+		},
+	}}
+
+	ext := &mockExtension{name: "dashboard"}
+	srv := New[transaction.Tx](nil, nil, OverwriteDefaultConfig(&Config{
+		Enable:    true,
+		Address:   address,
+		Listeners: []serverv2.ListenerConfig{{Address: "unix://" + socket}},
+	})).WithExtensions(ext)
+	require.NoError(t, srv.Init(mockApp{}, nil, log.NewNopLogger()))
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.Start(context.Background()) }()
+
+	var resp *http.Response
+	require.Eventually(t, func() bool {
+		resp, err = http.Get(fmt.Sprintf("http://%s/dashboard/status", address))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.True(t, ext.started)
+
+	unixResp, err := unixClient.Get("http://unix/dashboard/status")
+	require.NoError(t, err)
+	defer unixResp.Body.Close()
+	require.Equal(t, http.StatusOK, unixResp.StatusCode)
+
+	require.NoError(t, srv.Stop(context.Background()))
+	require.NoError(t, <-errCh)
+	require.True(t, ext.stopped)
+
+	// the extension names must be unique
+	srv = New[transaction.Tx](nil, nil).WithExtensions(ext, &mockExtension{name: "dashboard"})
+	require.ErrorContains(t, srv.Init(mockApp{}, nil, log.NewNopLogger()), "duplicate API server extension dashboard")
+}
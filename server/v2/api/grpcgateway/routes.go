@@ -0,0 +1,194 @@
+package grpcgateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/httprule"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// querier queries the application at a given height.
+type querier interface {
+	Query(ctx context.Context, version uint64, msg gogoproto.Message) (gogoproto.Message, error)
+}
+
+// registerGatewayRoutes registers a REST route for each query of the methods map
+// annotated with a google.api.http rule, so that modules do not need to register
+// their gRPC-gateway routes themselves.
+// The methods map maps the gRPC method names to their request message factories.
+func registerGatewayRoutes(router *runtime.ServeMux, methodsMap map[string]func() gogoproto.Message, q querier) error {
+	for method, makeMsg := range methodsMap {
+		rules, err := httpRules(method)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			httpMethod, path := httpMethodAndPath(rule)
+			if path == "" {
+				continue
+			}
+
+			// only the request message is supported as body, which is what queries use
+			if rule.Body != "" && rule.Body != "*" {
+				return fmt.Errorf("%s: unsupported http body %q", method, rule.Body)
+			}
+
+			compiler, err := httprule.Parse(path)
+			if err != nil {
+				return fmt.Errorf("%s: invalid http path %q: %w", method, path, err)
+			}
+
+			tmpl := compiler.Compile()
+			pattern, err := runtime.NewPattern(1, tmpl.OpCodes, tmpl.Pool, tmpl.Verb, runtime.AssumeColonVerbOpt(false))
+			if err != nil {
+				return fmt.Errorf("%s: invalid http path %q: %w", method, path, err)
+			}
+
+			router.Handle(httpMethod, pattern, newGatewayHandler(router, makeMsg, rule.Body == "*", tmpl.Fields, q))
+		}
+	}
+
+	return nil
+}
+
+// httpRules returns the google.api.http rules, including the additional bindings,
+// of the given gRPC method. It returns no rule if the method is not annotated.
+func httpRules(method string) ([]*annotations.HttpRule, error) {
+	// gRPC method names are of the form /package.Service/Method
+	service, name, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid gRPC method name %s", method)
+	}
+
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find descriptor of %s: %w", service, err)
+	}
+
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", service)
+	}
+
+	md := sd.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, fmt.Errorf("method %s not found in service %s", name, service)
+	}
+
+	rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return nil, nil
+	}
+
+	return append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...), nil
+}
+
+// httpMethodAndPath returns the HTTP method and path template of the rule.
+// It returns an empty path for custom rules.
+func httpMethodAndPath(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	default:
+		return "", ""
+	}
+}
+
+// newGatewayHandler returns the handler of a REST route, which builds the request
+// message from the request path, query and body, and queries the application.
+func newGatewayHandler(
+	router *runtime.ServeMux,
+	makeMsg func() gogoproto.Message,
+	hasBody bool,
+	pathFields []string,
+	q querier,
+) runtime.HandlerFunc {
+	// query parameters do not override the fields set by the path
+	seqs := make([][]string, 0, len(pathFields))
+	for _, field := range pathFields {
+		seqs = append(seqs, strings.Split(field, "."))
+	}
+	filter := utilities.NewDoubleArray(seqs)
+
+	return func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(router, req)
+		msg, height, err := parseGatewayRequest(req, pathParams, makeMsg, hasBody, filter, inboundMarshaler)
+		if err != nil {
+			runtime.HTTPError(ctx, router, outboundMarshaler, w, req, err)
+			return
+		}
+
+		resp, err := q.Query(ctx, height, msg)
+		if err != nil {
+			runtime.HTTPError(ctx, router, outboundMarshaler, w, req, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, router, outboundMarshaler, w, req, resp, router.GetForwardResponseOptions()...)
+	}
+}
+
+// parseGatewayRequest builds the request message of a REST request and returns
+// it with the height to query, 0 meaning the latest height.
+func parseGatewayRequest(
+	req *http.Request,
+	pathParams map[string]string,
+	makeMsg func() gogoproto.Message,
+	hasBody bool,
+	filter *utilities.DoubleArray,
+	marshaler runtime.Marshaler,
+) (gogoproto.Message, uint64, error) {
+	msg := makeMsg()
+	if hasBody {
+		if err := marshaler.NewDecoder(req.Body).Decode(msg); err != nil {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	for field, value := range pathParams {
+		if err := runtime.PopulateFieldFromPath(msg, field, value); err != nil {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", field, err)
+		}
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if err := runtime.PopulateQueryParameters(msg, req.Form, filter); err != nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var height uint64
+	if heightStr := req.Header.Get(GRPCBlockHeightHeader); heightStr != "" {
+		var err error
+		if height, err = strconv.ParseUint(heightStr, 10, 64); err != nil {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "invalid height header %s: %v", heightStr, err)
+		}
+	}
+
+	return msg, height, nil
+}
@@ -0,0 +1,84 @@
+package grpcgateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/transaction"
+)
+
+type mockQuerier struct {
+	height uint64
+	req    gogoproto.Message
+}
+
+func (q *mockQuerier) Query(_ context.Context, version uint64, msg gogoproto.Message) (gogoproto.Message, error) {
+	q.height, q.req = version, msg
+
+	req, ok := msg.(*bankv1beta1.QueryBalanceRequest)
+	if !ok {
+		return nil, errors.New("unexpected request")
+	}
+
+	if req.Denom == "unknown" {
+		return nil, status.Error(codes.NotFound, "unknown denom")
+	}
+
+	return &bankv1beta1.QueryBalanceResponse{Balance: &basev1beta1.Coin{Denom: req.Denom, Amount: "10"}}, nil
+}
+
+func TestRegisterGatewayRoutes(t *testing.T) {
+	srv := New[transaction.Tx](nil, nil)
+	q := &mockQuerier{}
+
+	methodsMap := map[string]func() gogoproto.Message{
+		"/cosmos.bank.v1beta1.Query/Balance": func() gogoproto.Message { return &bankv1beta1.QueryBalanceRequest{} },
+	}
+	require.NoError(t, registerGatewayRoutes(srv.GRPCGatewayRouter, methodsMap, q))
+
+	// the address is read from the path and the denom from the query
+	req := httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr/by_denom?denom=stake&address=other", nil)
+	req.Header.Set(GRPCBlockHeightHeader, "7")
+	rec := httptest.NewRecorder()
+	srv.GRPCGatewayRouter.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"balance":{"denom":"stake","amount":"10"}}`, rec.Body.String())
+	require.Equal(t, uint64(7), q.height)
+	require.Equal(t, "cosmos1addr", q.req.(*bankv1beta1.QueryBalanceRequest).Address)
+
+	// query errors are converted to HTTP errors
+	req = httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr/by_denom?denom=unknown", nil)
+	rec = httptest.NewRecorder()
+	srv.GRPCGatewayRouter.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, uint64(0), q.height)
+
+	req = httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr/by_denom", nil)
+	req.Header.Set(GRPCBlockHeightHeader, "latest")
+	rec = httptest.NewRecorder()
+	srv.GRPCGatewayRouter.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// unknown routes are not implemented
+	req = httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/unknown", nil)
+	rec = httptest.NewRecorder()
+	srv.GRPCGatewayRouter.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotImplemented, rec.Code)
+
+	// methods must be defined in a registered service
+	methodsMap = map[string]func() gogoproto.Message{
+		"/cosmos.bank.v1beta1.Unknown/Balance": func() gogoproto.Message { return &bankv1beta1.QueryBalanceRequest{} },
+	}
+	require.ErrorContains(t, registerGatewayRoutes(srv.GRPCGatewayRouter, methodsMap, q), "failed to find descriptor")
+}
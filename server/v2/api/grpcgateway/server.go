@@ -2,14 +2,17 @@ package grpcgateway
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	gateway "github.com/cosmos/gogogateway"
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 
@@ -31,6 +34,9 @@ type GRPCGatewayServer[T transaction.Tx] struct {
 	logger     log.Logger
 	config     *Config
 	cfgOptions []CfgOption
+	extensions []Extension
+
+	httpSrv *http.Server
 
 	GRPCSrv           *grpc.Server
 	GRPCGatewayRouter *runtime.ServeMux
@@ -65,6 +71,13 @@ func New[T transaction.Tx](grpcSrv *grpc.Server, ir jsonpb.AnyResolver, cfgOptio
 	}
 }
 
+// WithExtensions mounts the given extensions on the API server, alongside the
+// gRPC-gateway routes. It must be called before the server is initialized.
+func (s *GRPCGatewayServer[T]) WithExtensions(extensions ...Extension) *GRPCGatewayServer[T] {
+	s.extensions = append(s.extensions, extensions...)
+	return s
+}
+
 func (g *GRPCGatewayServer[T]) Name() string {
 	return ServerName
 }
@@ -91,35 +104,110 @@ func (s *GRPCGatewayServer[T]) Init(appI serverv2.AppI[transaction.Tx], v *viper
 		}
 	}
 
-	// Register the gRPC-Gateway server.
-	// appI.RegisterGRPCGatewayRoutes(s.GRPCGatewayRouter, s.GRPCSrv)
+	if cfg.Enable {
+		// Register the gRPC-Gateway routes of the queries from their HTTP annotations.
+		if err := registerGatewayRoutes(s.GRPCGatewayRouter, appI.GetGPRCMethodsToMessageMap(), appI.GetAppManager()); err != nil {
+			return fmt.Errorf("failed to register gRPC-gateway routes: %w", err)
+		}
+
+		// Register the routes of the extensions before the gRPC-gateway catch-all route.
+		router := mux.NewRouter()
+		names := make(map[string]bool, len(s.extensions))
+		for _, ext := range s.extensions {
+			if names[ext.Name()] {
+				return fmt.Errorf("duplicate API server extension %s", ext.Name())
+			}
+			names[ext.Name()] = true
+
+			if err := ext.RegisterRoutes(router); err != nil {
+				return fmt.Errorf("failed to register routes of API server extension %s: %w", ext.Name(), err)
+			}
+		}
+
+		if err := s.Register(router); err != nil {
+			return err
+		}
+
+		s.httpSrv = &http.Server{
+			Handler:           router,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+	}
 
-	s.logger = logger
+	s.logger = logger.With(log.ModuleKey, s.Name())
 	s.config = cfg
 
 	return nil
 }
 
+func (s *GRPCGatewayServer[T]) StartCmdFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
+	flags.String(FlagAddress, "localhost:1317", "Listen address")
+	return flags
+}
+
+// Start starts the extensions, then serves the API server until it is stopped.
 func (s *GRPCGatewayServer[T]) Start(ctx context.Context) error {
 	if !s.config.Enable {
 		return nil
 	}
 
-	// TODO start a normal Go http server (and do not leverage comet's like https://github.com/cosmos/cosmos-sdk/blob/9df6019de6ee7999fe9864bac836deb2f36dd44a/server/api/server.go#L98)
+	for _, ext := range s.extensions {
+		if lifecycle, ok := ext.(HasLifecycle); ok {
+			if err := lifecycle.Start(ctx); err != nil {
+				return fmt.Errorf("failed to start API server extension %s: %w", ext.Name(), err)
+			}
+		}
+	}
+
+	listeners, err := serverv2.ListenAll(s.config.listenerConfigs(), "h2", "http/1.1")
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, len(listeners))
+	for _, listener := range listeners {
+		listener := listener
+		go func() {
+			s.logger.Info("starting API server...", "address", listener.Addr().String())
+			errCh <- s.httpSrv.Serve(listener)
+		}()
+	}
+
+	for range listeners {
+		if err := <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("failed to start API server", "err", err)
+			return err
+		}
+	}
 
 	return nil
 }
 
+// Stop gracefully shuts down the API server, then stops the extensions. The
+// in-flight requests are drained until ctx is done.
 func (s *GRPCGatewayServer[T]) Stop(ctx context.Context) error {
 	if !s.config.Enable {
 		return nil
 	}
 
-	return nil
+	s.logger.Info("stopping API server...", "address", s.config.Address)
+
+	errs := []error{shutdown(ctx, s.httpSrv, s.logger)}
+
+	for _, ext := range s.extensions {
+		if lifecycle, ok := ext.(HasLifecycle); ok {
+			if err := lifecycle.Stop(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("failed to stop API server extension %s: %w", ext.Name(), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 // Register implements registers a grpc-gateway server
-func (s *GRPCGatewayServer[T]) Register(r mux.Router) error {
+func (s *GRPCGatewayServer[T]) Register(r *mux.Router) error {
 	// configure grpc-gatway server
 	r.PathPrefix("/").Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		// Fall back to grpc gateway server.
@@ -129,6 +217,18 @@ func (s *GRPCGatewayServer[T]) Register(r mux.Router) error {
 	return nil
 }
 
+// shutdown stops the HTTP server from accepting new requests, and waits for the
+// in-flight ones to complete until ctx is done, before closing the server.
+func shutdown(ctx context.Context, srv *http.Server, logger log.Logger) error {
+	err := srv.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		logger.Warn("API server did not drain in-flight requests in time, closing them", "err", err)
+		return srv.Close()
+	}
+
+	return err
+}
+
 // CustomGRPCHeaderMatcher for mapping request headers to
 // GRPC metadata.
 // HTTP headers that start with 'Grpc-Metadata-' are automatically mapped to
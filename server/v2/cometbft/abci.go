@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -18,6 +19,7 @@ import (
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/appmanager"
 	"cosmossdk.io/server/v2/cometbft/client/grpc/cmtservice"
 	"cosmossdk.io/server/v2/cometbft/handlers"
@@ -39,6 +41,8 @@ type Consensus[T transaction.Tx] struct {
 	txCodec            transaction.Codec[T]
 	store              types.Store
 	streaming          streaming.Manager
+	changelog          *changelog
+	finality           serverv2.FinalityFeed
 	snapshotManager    *snapshots.Manager
 	mempool            mempool.Mempool[T]
 
@@ -52,6 +56,11 @@ type Consensus[T transaction.Tx] struct {
 	// committed block.
 	lastCommittedHeight atomic.Int64
 
+	// blockLock is held while a block is finalized or committed, so that the
+	// consensus stops at a block boundary.
+	blockLock sync.Mutex
+	stopped   bool
+
 	prepareProposalHandler handlers.PrepareHandler[T]
 	processProposalHandler handlers.ProcessHandler[T]
 	verifyVoteExt          handlers.VerifyVoteExtensionhandler
@@ -86,6 +95,7 @@ func NewConsensus[T transaction.Tx](
 		logger:                 logger,
 		txCodec:                txCodec,
 		streaming:              streaming.Manager{},
+		changelog:              newChangelog(cfg.AppTomlConfig.ChangelogSize),
 		snapshotManager:        nil,
 		mempool:                mp,
 		lastCommittedHeight:    atomic.Int64{},
@@ -99,6 +109,25 @@ func NewConsensus[T transaction.Tx](
 	}
 }
 
+// Stop waits for the block being finalized or committed, if any, and rejects
+// the next ones, so that the store can be closed at a block boundary.
+func (c *Consensus[T]) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		c.blockLock.Lock()
+		c.stopped = true
+		c.blockLock.Unlock()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for the block being processed: %w", ctx.Err())
+	}
+}
+
 // SetStreamingManager sets the streaming manager for the consensus module.
 func (c *Consensus[T]) SetStreamingManager(sm streaming.Manager) {
 	c.streaming = sm
@@ -314,7 +343,7 @@ func (c *Consensus[T]) PrepareProposal(
 		return nil, errors.New("PrepareProposal called with invalid height")
 	}
 
-	decodedTxs := make([]T, len(req.Txs))
+	decodedTxs := make([]T, 0, len(req.Txs))
 	for _, tx := range req.Txs {
 		decTx, err := c.txCodec.Decode(tx)
 		if err != nil {
@@ -354,7 +383,7 @@ func (c *Consensus[T]) ProcessProposal(
 	ctx context.Context,
 	req *abciproto.ProcessProposalRequest,
 ) (*abciproto.ProcessProposalResponse, error) {
-	decodedTxs := make([]T, len(req.Txs))
+	decodedTxs := make([]T, 0, len(req.Txs))
 	for _, tx := range req.Txs {
 		decTx, err := c.txCodec.Decode(tx)
 		if err != nil {
@@ -392,6 +421,12 @@ func (c *Consensus[T]) FinalizeBlock(
 	ctx context.Context,
 	req *abciproto.FinalizeBlockRequest,
 ) (*abciproto.FinalizeBlockResponse, error) {
+	c.blockLock.Lock()
+	defer c.blockLock.Unlock()
+	if c.stopped {
+		return nil, errors.New("consensus is stopped")
+	}
+
 	if err := c.validateFinalizeBlockHeight(req); err != nil {
 		return nil, err
 	}
@@ -407,6 +442,7 @@ func (c *Consensus[T]) FinalizeBlock(
 			return nil, fmt.Errorf("unable to commit the changeset: %w", err)
 		}
 		c.lastCommittedHeight.Store(req.Height)
+		c.finality.Send(serverv2.FinalizedBlock{Height: uint64(req.Height), Time: req.Time, Hash: req.Hash, AppHash: appHash})
 		return &abciproto.FinalizeBlockResponse{
 			AppHash: appHash,
 		}, nil
@@ -465,8 +501,13 @@ func (c *Consensus[T]) FinalizeBlock(
 	}
 	events = append(events, resp.EndBlockEvents...)
 
+	cp, err := c.GetConsensusParams(ctx) // we get the consensus params from the latest state because we committed state above
+	if err != nil {
+		return nil, err
+	}
+
 	// listen to state streaming changes in accordance with the block
-	err = c.streamDeliverBlockChanges(ctx, req.Height, req.Txs, resp.TxResults, events, stateChanges)
+	err = c.streamDeliverBlockChanges(ctx, req, resp.TxResults, events, stateChanges, cp, appHash)
 	if err != nil {
 		return nil, err
 	}
@@ -478,11 +519,13 @@ func (c *Consensus[T]) FinalizeBlock(
 	}
 
 	c.lastCommittedHeight.Store(req.Height)
-
-	cp, err := c.GetConsensusParams(ctx) // we get the consensus params from the latest state because we committed state above
-	if err != nil {
-		return nil, err
-	}
+	c.finality.Send(serverv2.FinalizedBlock{
+		Height:  uint64(req.Height),
+		Time:    req.Time,
+		Hash:    req.Hash,
+		AppHash: appHash,
+		NumTxs:  len(req.Txs),
+	})
 
 	return finalizeBlockResponse(resp, cp, appHash, c.indexedEvents)
 }
@@ -490,6 +533,9 @@ func (c *Consensus[T]) FinalizeBlock(
 // Commit implements types.Application.
 // It is called by cometbft to notify the application that a block was committed.
 func (c *Consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (*abciproto.CommitResponse, error) {
+	c.blockLock.Lock()
+	defer c.blockLock.Unlock()
+
 	lastCommittedHeight := c.lastCommittedHeight.Load()
 
 	c.snapshotManager.SnapshotIfApplicable(lastCommittedHeight)
@@ -0,0 +1,211 @@
+package cometbft
+
+import (
+	"context"
+	"sync"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/server/v2/streaming"
+)
+
+// minSubscriberBuffer is the minimum number of blocks buffered for a subscriber
+// before it is dropped, when the changelog keeps fewer blocks.
+const minSubscriberBuffer = 16
+
+// changelog keeps the recent finalized blocks of the node along with their state
+// changes, and streams them to the subscribers, such as the indexer nodes
+// following the node. With a size of 0, it keeps no block and only streams the
+// new ones.
+type changelog struct {
+	mu     sync.Mutex
+	size   int
+	blocks []*streaming.FinalizedBlock // ordered by height
+
+	subscribers map[chan *streaming.FinalizedBlock]struct{}
+}
+
+func newChangelog(size uint64) *changelog {
+	return &changelog{
+		size:        int(size),
+		blocks:      make([]*streaming.FinalizedBlock, 0, size),
+		subscribers: make(map[chan *streaming.FinalizedBlock]struct{}),
+	}
+}
+
+// append records a finalized block and sends it to the subscribers. The
+// subscribers too slow to keep up with the node are dropped.
+func (c *changelog) append(block *streaming.FinalizedBlock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size > 0 {
+		if len(c.blocks) == c.size {
+			c.blocks[0] = nil
+			c.blocks = c.blocks[1:]
+		}
+		c.blocks = append(c.blocks, block)
+	}
+
+	for ch := range c.subscribers {
+		select {
+		case ch <- block:
+		default:
+			delete(c.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns the kept blocks from startHeight, and a channel receiving
+// the next ones, which is closed if the subscriber does not keep up.
+func (c *changelog) subscribe(startHeight int64) ([]*streaming.FinalizedBlock, chan *streaming.FinalizedBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var blocks []*streaming.FinalizedBlock
+	if startHeight > 0 && len(c.blocks) > 0 {
+		if earliest := c.blocks[0].Height; startHeight < earliest {
+			return nil, nil, status.Errorf(codes.OutOfRange, "block %d is not kept in the changelog anymore, the earliest one is %d", startHeight, earliest)
+		}
+
+		for _, block := range c.blocks {
+			if block.Height >= startHeight {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	ch := make(chan *streaming.FinalizedBlock, max(c.size, minSubscriberBuffer))
+	c.subscribers[ch] = struct{}{}
+	return blocks, ch, nil
+}
+
+// unsubscribe stops sending the blocks to a subscriber.
+func (c *changelog) unsubscribe(ch chan *streaming.FinalizedBlock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.subscribers[ch]; ok {
+		delete(c.subscribers, ch)
+		close(ch)
+	}
+}
+
+// stream sends the blocks from startHeight, in order and without gap, until ctx
+// is done. It fails if the block at startHeight is not kept anymore.
+func (c *changelog) stream(ctx context.Context, startHeight int64, send func(*streaming.FinalizedBlock) error) error {
+	if startHeight < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid start height %d", startHeight)
+	}
+
+	blocks, ch, err := c.subscribe(startHeight)
+	if err != nil {
+		return err
+	}
+	defer c.unsubscribe(ch)
+
+	// the kept blocks may also have been sent on the channel
+	lastHeight := startHeight - 1
+	sendNext := func(block *streaming.FinalizedBlock) error {
+		if block.Height <= lastHeight {
+			return nil
+		}
+		if startHeight > 0 && block.Height != lastHeight+1 {
+			return status.Errorf(codes.OutOfRange, "block %d is not kept in the changelog, set changelog-size to resume the streams", lastHeight+1)
+		}
+
+		if err := send(block); err != nil {
+			return err
+		}
+		lastHeight = block.Height
+		return nil
+	}
+
+	for _, block := range blocks {
+		if err := sendNext(block); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case block, ok := <-ch:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber did not keep up with the changelog")
+			}
+			if err := sendNext(block); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var _ streaming.ChangelogServiceServer = changelogServer{}
+
+// changelogServer implements the streaming ChangelogService.
+type changelogServer struct {
+	// changelog returns the changelog of the node, nil if the node is not
+	// initialized.
+	changelog func() *changelog
+}
+
+// Subscribe implements streaming.ChangelogServiceServer.
+func (s changelogServer) Subscribe(req *streaming.SubscribeRequest, stream streaming.ChangelogService_SubscribeServer) error {
+	changelog := s.changelog()
+	if changelog == nil || changelog.size == 0 {
+		return status.Error(codes.Unavailable, "changelog is disabled, set changelog-size to enable it")
+	}
+
+	return changelog.stream(stream.Context(), req.StartHeight, stream.Send)
+}
+
+var _ streaming.BlockResultsServiceServer = blockResultsServer{}
+
+// blockResultsServer implements the streaming BlockResultsService.
+type blockResultsServer struct {
+	// changelog returns the changelog of the node, nil if the node is not
+	// initialized.
+	changelog func() *changelog
+}
+
+// Subscribe implements streaming.BlockResultsServiceServer.
+func (s blockResultsServer) Subscribe(req *streaming.SubscribeBlockResultsRequest, stream streaming.BlockResultsService_SubscribeServer) error {
+	changelog := s.changelog()
+	if changelog == nil {
+		return status.Error(codes.Unavailable, "node is not initialized")
+	}
+
+	return changelog.stream(stream.Context(), req.StartHeight, func(block *streaming.FinalizedBlock) error {
+		return stream.Send(&streaming.BlockResults{
+			Height:                block.Height,
+			Time:                  block.Time,
+			Txs:                   block.Txs,
+			TxResults:             block.TxResults,
+			Events:                block.Events,
+			ConsensusParamUpdates: block.ConsensusParamUpdates,
+			AppHash:               block.AppHash,
+		})
+	})
+}
+
+// RegisterServices registers the changelog and block results services of the
+// node on the gRPC server, when it is registered as an extension of the gRPC
+// server.
+func (s *CometBFTServer[T]) RegisterServices(srv gogogrpc.Server) error {
+	changelog := func() *changelog {
+		if s.Consensus == nil {
+			return nil
+		}
+		return s.Consensus.changelog
+	}
+
+	streaming.RegisterChangelogServiceServer(srv, changelogServer{changelog: changelog})
+	streaming.RegisterBlockResultsServiceServer(srv, blockResultsServer{changelog: changelog})
+	return nil
+}
@@ -0,0 +1,108 @@
+package cometbft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/streaming"
+)
+
+func TestChangelog(t *testing.T) {
+	c := newChangelog(3)
+	for h := int64(1); h <= 5; h++ {
+		c.append(&streaming.FinalizedBlock{Height: h})
+	}
+
+	// only the last 3 blocks are kept
+	_, _, err := c.subscribe(2)
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	blocks, ch, err := c.subscribe(4)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	require.Equal(t, int64(4), blocks[0].Height)
+	require.Equal(t, int64(5), blocks[1].Height)
+
+	c.append(&streaming.FinalizedBlock{Height: 6})
+	require.Equal(t, int64(6), (<-ch).Height)
+
+	// a subscriber not keeping up is dropped
+	for h := int64(7); h <= 7+minSubscriberBuffer; h++ {
+		c.append(&streaming.FinalizedBlock{Height: h})
+	}
+	for range minSubscriberBuffer {
+		<-ch
+	}
+	_, ok := <-ch
+	require.False(t, ok)
+
+	c.unsubscribe(ch)
+	require.Empty(t, c.subscribers)
+}
+
+func TestChangelogStream(t *testing.T) {
+	c := newChangelog(3)
+	for h := int64(1); h <= 5; h++ {
+		c.append(&streaming.FinalizedBlock{Height: h})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	heights := make(chan int64)
+	done := make(chan error)
+	go func() {
+		done <- c.stream(ctx, 4, func(block *streaming.FinalizedBlock) error {
+			heights <- block.Height
+			return nil
+		})
+	}()
+
+	// the kept blocks are resumed, then the new ones follow
+	require.Equal(t, int64(4), <-heights)
+	require.Equal(t, int64(5), <-heights)
+	c.append(&streaming.FinalizedBlock{Height: 6})
+	require.Equal(t, int64(6), <-heights)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	require.Equal(t, codes.InvalidArgument, status.Code(c.stream(context.Background(), -1, nil)))
+
+	// without kept blocks, only a stream of the next blocks can be resumed
+	c = newChangelog(0)
+	c.append(&streaming.FinalizedBlock{Height: 1})
+	require.Empty(t, c.blocks)
+
+	done = make(chan error)
+	go func() {
+		done <- c.stream(context.Background(), 1, func(*streaming.FinalizedBlock) error { return nil })
+	}()
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.subscribers) == 1
+	}, time.Second, time.Millisecond)
+	c.append(&streaming.FinalizedBlock{Height: 2})
+	require.Equal(t, codes.OutOfRange, status.Code(<-done))
+}
+
+func TestIntoStateChanges(t *testing.T) {
+	stateChanges := []store.StateChanges{
+		{Actor: []byte("bank"), StateChanges: []store.KVPair{
+			{Key: []byte("a"), Value: []byte("1")},
+			{Key: []byte("b"), Remove: true},
+		}},
+		{Actor: []byte("staking"), StateChanges: []store.KVPair{
+			{Key: []byte("c"), Value: []byte("2")},
+		}},
+	}
+
+	require.Equal(t, stateChanges, intoStateChanges(intoStreamingKVPairs(stateChanges)))
+}
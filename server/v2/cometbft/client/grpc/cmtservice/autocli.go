@@ -46,6 +46,27 @@ var CometBFTAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
 			RpcMethod: "ABCIQuery",
 			Skip:      true,
 		},
+		{
+			RpcMethod:      "StoreProof",
+			Use:            "store-proof [store-name] [key]",
+			Short:          "Query a value of a module store with its proof",
+			Long:           "Query the value of a key, given in hex or base64, in a module store along with its ICS-23 proof. Use --height to query at a given height.",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "store_name"}, {ProtoField: "key"}},
+		},
+		{
+			RpcMethod:      "SearchTxs",
+			Use:            "search-txs [query]",
+			Short:          "Search for transactions matching a CometBFT event query",
+			Long:           "Search for transactions matching a CometBFT event query, e.g. \"message.sender='cosmos1...' AND tx.height>5\", using the CometBFT RPC `tx_search` method. Use --page and --limit to paginate the results.",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "query"}},
+		},
+		{
+			RpcMethod:      "SearchBlocks",
+			Use:            "search-blocks [query]",
+			Short:          "Search for blocks matching a CometBFT event query",
+			Long:           "Search for blocks matching a CometBFT event query, e.g. \"block.height>5\", using the CometBFT RPC `block_search` method. Use --page and --limit to paginate the results.",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "query"}},
+		},
 	},
 }
 
@@ -0,0 +1,133 @@
+package cmtservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	querytypes "github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// maxSearchLimit is the maximum number of results per page of the CometBFT
+// tx_search and block_search RPC methods.
+const maxSearchLimit = 100
+
+// SearchTxs implements ServiceServer.SearchTxs
+func (s queryServer) SearchTxs(ctx context.Context, req *SearchTxsRequest) (*SearchTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if s.txDecoder == nil {
+		return nil, status.Error(codes.Internal, "tx decoder undefined")
+	}
+
+	page, limit, err := validateSearch(req.Query, req.OrderBy, req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resTxs, err := s.client.TxSearch(ctx, req.Query, false, &page, &limit, req.OrderBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for txs: %w", err)
+	}
+
+	// the tx responses hold the time of their block
+	blockTimes := make(map[int64]string)
+	txs := make([]*sdk.TxResponse, len(resTxs.Txs))
+	for i, resTx := range resTxs.Txs {
+		if _, ok := blockTimes[resTx.Height]; !ok {
+			resBlock, err := s.client.Block(ctx, &resTx.Height)
+			if err != nil {
+				return nil, err
+			}
+			blockTimes[resTx.Height] = resBlock.Block.Time.Format(time.RFC3339)
+		}
+
+		tx, err := s.txDecoder(resTx.Tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode tx %X: %w", resTx.Hash, err)
+		}
+		protoTx, ok := tx.(interface{ AsTx() (*txtypes.Tx, error) })
+		if !ok {
+			return nil, fmt.Errorf("unexpected tx type %T", tx)
+		}
+		p, err := protoTx.AsTx()
+		if err != nil {
+			return nil, err
+		}
+		anyTx, err := codectypes.NewAnyWithValue(p)
+		if err != nil {
+			return nil, err
+		}
+
+		txs[i] = sdk.NewResponseResultTx(resTx, anyTx, blockTimes[resTx.Height])
+	}
+
+	return &SearchTxsResponse{
+		Result: sdk.NewSearchTxsResult(uint64(resTxs.TotalCount), uint64(len(txs)), uint64(page), uint64(limit), txs),
+	}, nil
+}
+
+// SearchBlocks implements ServiceServer.SearchBlocks
+func (s queryServer) SearchBlocks(ctx context.Context, req *SearchBlocksRequest) (*SearchBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	page, limit, err := validateSearch(req.Query, req.OrderBy, req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlocks, err := s.client.BlockSearch(ctx, req.Query, &page, &limit, req.OrderBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for blocks: %w", err)
+	}
+
+	blocks := make([]*cmtproto.Block, len(resBlocks.Blocks))
+	for i, resBlock := range resBlocks.Blocks {
+		blocks[i], err = resBlock.Block.ToProto()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &SearchBlocksResponse{
+		Result: sdk.NewSearchBlocksResult(int64(resBlocks.TotalCount), int64(len(blocks)), int64(page), int64(limit), blocks),
+	}, nil
+}
+
+// validateSearch validates a search request and returns its CometBFT page
+// number and page size, with their defaults.
+func validateSearch(query, orderBy string, page, limit uint64) (int, int, error) {
+	if query == "" {
+		return 0, 0, status.Error(codes.InvalidArgument, "query cannot be empty")
+	}
+	if orderBy != "" && orderBy != "asc" && orderBy != "desc" {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "invalid order by %q, expected asc or desc", orderBy)
+	}
+
+	// CometBFT pages start from 1
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = querytypes.DefaultLimit
+	}
+	if limit > maxSearchLimit {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "limit %d exceeds the maximum of %d", limit, maxSearchLimit)
+	}
+	if page > math.MaxInt32 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "invalid page %d", page)
+	}
+
+	return int(page), int(limit), nil
+}
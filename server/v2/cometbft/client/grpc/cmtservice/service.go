@@ -2,6 +2,7 @@ package cmtservice
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -12,6 +13,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/server/v2/cometbft/client/rpc"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -35,10 +38,12 @@ const (
 
 type (
 	abciQueryFn = func(context.Context, *abci.QueryRequest) (*abci.QueryResponse, error)
+	txDecoderFn = func(txBytes []byte) (transaction.Tx, error)
 
 	queryServer struct {
-		client  rpc.CometRPC
-		queryFn abciQueryFn
+		client    rpc.CometRPC
+		queryFn   abciQueryFn
+		txDecoder txDecoderFn
 	}
 )
 
@@ -46,10 +51,12 @@ type (
 func NewQueryServer(
 	client rpc.CometRPC,
 	queryFn abciQueryFn,
+	txDecoder txDecoderFn,
 ) ServiceServer {
 	return queryServer{
-		queryFn: queryFn,
-		client:  client,
+		queryFn:   queryFn,
+		client:    client,
+		txDecoder: txDecoder,
 	}
 }
 
@@ -288,13 +295,52 @@ func (s queryServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABC
 	return FromABCIResponseQuery(res), nil
 }
 
+// StoreProof implements ServiceServer.StoreProof
+func (s queryServer) StoreProof(ctx context.Context, req *StoreProofRequest) (*StoreProofResponse, error) {
+	if s.queryFn == nil {
+		return nil, status.Error(codes.Internal, "ABCI Query handler undefined")
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StoreName == "" || strings.Contains(req.StoreName, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid store name: %q", req.StoreName)
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+	if req.Height < 0 || req.Height == 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d: proofs are only available for heights greater than 1", req.Height)
+	}
+
+	res, err := s.queryFn(ctx, &abci.QueryRequest{
+		Path:   fmt.Sprintf("/%s/%s/key", QueryPathStore, req.StoreName),
+		Data:   req.Key,
+		Height: req.Height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, errorsmod.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	return &StoreProofResponse{
+		Value:    res.Value,
+		Height:   res.Height,
+		ProofOps: res.ProofOps,
+	}, nil
+}
+
 // RegisterTendermintService registers the CometBFT queries on the gRPC router.
 func RegisterTendermintService(
 	client rpc.CometRPC,
 	server gogogrpc.Server,
 	queryFn abciQueryFn,
+	txDecoder txDecoderFn,
 ) {
-	RegisterServiceServer(server, NewQueryServer(client, queryFn))
+	RegisterServiceServer(server, NewQueryServer(client, queryFn, txDecoder))
 }
 
 // RegisterGRPCGatewayRoutes mounts the CometBFT service's GRPC-gateway routes on the
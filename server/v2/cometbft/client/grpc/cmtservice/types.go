@@ -18,21 +18,6 @@ func (req *ABCIQueryRequest) ToABCIRequestQuery() *abci.QueryRequest {
 // FromABCIResponseQuery converts an ABCI ResponseQuery type to a gRPC
 // ABCIQueryResponse type.
 func FromABCIResponseQuery(res *abci.QueryResponse) *ABCIQueryResponse {
-	var proofOps *ProofOps
-
-	if res.ProofOps != nil {
-		proofOps = &ProofOps{
-			Ops: make([]ProofOp, len(res.ProofOps.Ops)),
-		}
-		for i, proofOp := range res.ProofOps.Ops {
-			proofOps.Ops[i] = ProofOp{
-				Type: proofOp.Type,
-				Key:  proofOp.Key,
-				Data: proofOp.Data,
-			}
-		}
-	}
-
 	return &ABCIQueryResponse{
 		Code:      res.Code,
 		Log:       res.Log,
@@ -40,7 +25,7 @@ func FromABCIResponseQuery(res *abci.QueryResponse) *ABCIQueryResponse {
 		Index:     res.Index,
 		Key:       res.Key,
 		Value:     res.Value,
-		ProofOps:  proofOps,
+		ProofOps:  res.ProofOps,
 		Height:    res.Height,
 		Codespace: res.Codespace,
 	}
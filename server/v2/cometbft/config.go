@@ -1,6 +1,8 @@
 package cometbft
 
 import (
+	"fmt"
+
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/spf13/viper"
 
@@ -23,6 +25,8 @@ func DefaultAppTomlConfig() *AppTomlConfig {
 		Transport:       "socket",
 		Trace:           false,
 		Standalone:      false,
+		ChangelogSize:   0,
+		Follow:          "",
 	}
 }
 
@@ -35,6 +39,8 @@ type AppTomlConfig struct {
 	Transport       string   `mapstructure:"transport" toml:"transport" comment:"transport defines the CometBFT RPC server transport protocol: socket, grpc"`
 	Trace           bool     `mapstructure:"trace" toml:"trace" comment:"trace enables the CometBFT RPC server to output trace information about its internal operations."`
 	Standalone      bool     `mapstructure:"standalone" toml:"standalone" comment:"standalone starts the application without the CometBFT node. The node should be started separately."`
+	ChangelogSize   uint64   `mapstructure:"changelog-size" toml:"changelog-size" comment:"changelog-size defines the number of recent finalized blocks, with their state changes, kept by the node and streamed through the gRPC changelog service to the indexer nodes following it. The subscribers of the gRPC block results service can resume their stream from the kept blocks. A value of 0 disables the changelog service and only streams the new block results."`
+	Follow          string   `mapstructure:"follow" toml:"follow" comment:"follow defines the gRPC address of a trusted node to follow in indexer-only mode: the node does not run CometBFT, it applies the blocks finalized by the trusted node and only runs the indexer and the query servers. The trusted node must enable its changelog, and the store must first be bootstrapped from a snapshot or a copy of its data."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -64,3 +70,45 @@ func getConfigTomlFromViper(v *viper.Viper) *cmtcfg.Config {
 
 	return conf.SetRoot(rootDir)
 }
+
+// ValidateConfig implements serverv2.HasConfigValidation.
+func (s *CometBFTServer[T]) ValidateConfig(v *viper.Viper) []serverv2.ConfigIssue {
+	var issues []serverv2.ConfigIssue
+
+	cfg := DefaultAppTomlConfig()
+	if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+		return []serverv2.ConfigIssue{{Key: s.Name(), Error: err.Error()}}
+	}
+
+	cmtCfg := getConfigTomlFromViper(v)
+	if err := cmtCfg.ValidateBasic(); err != nil {
+		issues = append(issues, serverv2.ConfigIssue{Key: "config.toml", Error: err.Error()})
+	}
+
+	if cfg.Transport != "socket" && cfg.Transport != "grpc" {
+		issues = append(issues, serverv2.ConfigIssue{Key: prefix("transport"), Error: fmt.Sprintf("unsupported transport %q, expected socket or grpc", cfg.Transport)})
+	}
+
+	if cfg.Follow != "" {
+		if cfg.Standalone {
+			issues = append(issues, serverv2.ConfigIssue{Key: prefix("follow"), Error: "a node following a trusted node does not run CometBFT, it cannot be standalone"})
+		}
+		if cmtCfg.StateSync.Enable {
+			issues = append(issues, serverv2.ConfigIssue{Key: prefix("follow"), Error: "a node following a trusted node does not run CometBFT, it cannot state sync; bootstrap its store from a snapshot instead"})
+		}
+	}
+
+	// the state commitment versions must be kept until their state sync snapshot is taken
+	if interval := s.serverOptions.SnapshotOptions.Interval; interval > 0 {
+		keepRecent := v.GetUint64("store.options.sc-pruning-option.keep-recent")
+		if v.GetUint64("store.options.sc-pruning-option.interval") > 0 && keepRecent < interval {
+			issues = append(issues, serverv2.ConfigIssue{
+				Key:     "store.options.sc-pruning-option.keep-recent",
+				Error:   fmt.Sprintf("state sync snapshots are taken every %d blocks while only %d versions are kept, a version may be pruned before its snapshot is taken", interval, keepRecent),
+				Warning: true,
+			})
+		}
+	}
+
+	return issues
+}
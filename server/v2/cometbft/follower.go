@@ -0,0 +1,132 @@
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/core/store"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/streaming"
+)
+
+// followRetryDelay is the delay before resubscribing to the changelog of the
+// followed node after the subscription failed.
+const followRetryDelay = 5 * time.Second
+
+// errAppHashMismatch is returned when a block applied by an indexer node does not
+// lead to the app hash of the followed node.
+var errAppHashMismatch = errors.New("app hash mismatch")
+
+// follow runs the indexer-only mode of the node. Instead of running consensus,
+// it applies the finalized blocks streamed by the changelog of the trusted node
+// at addr, and streams them to the listeners of the node, until ctx is done.
+// The store must have been bootstrapped first, from a snapshot or a copy of the
+// data of the followed node, as the genesis is not part of the changelog.
+func (c *Consensus[T]) follow(ctx context.Context, addr string) error {
+	version, err := c.store.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version == 0 {
+		return errors.New("the store must be bootstrapped from a snapshot or a copy of the data of the followed node before following it")
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to followed node %s: %w", addr, err)
+	}
+	defer conn.Close()
+
+	client := streaming.NewChangelogServiceClient(conn)
+	for {
+		err := c.followChangelog(ctx, client)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, errAppHashMismatch) {
+			return err
+		}
+
+		c.logger.Error("changelog subscription to followed node failed, retrying", "address", addr, "err", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(followRetryDelay):
+		}
+	}
+}
+
+// followChangelog subscribes to the changelog from the block following the
+// latest version of the store, and applies the streamed blocks.
+func (c *Consensus[T]) followChangelog(ctx context.Context, client streaming.ChangelogServiceClient) error {
+	version, err := c.store.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &streaming.SubscribeRequest{StartHeight: int64(version) + 1})
+	if err != nil {
+		return err
+	}
+	c.logger.Info("following the changelog of the followed node", "height", version+1)
+
+	for {
+		block, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("changelog stream closed by the followed node")
+			}
+			return err
+		}
+
+		if err := c.applyFinalizedBlock(ctx, block); err != nil {
+			return err
+		}
+	}
+}
+
+// applyFinalizedBlock commits the state changes of a block finalized by the
+// followed node, checks that it leads to the same app hash, and streams the
+// block to the listeners of the node.
+func (c *Consensus[T]) applyFinalizedBlock(ctx context.Context, block *streaming.FinalizedBlock) error {
+	c.blockLock.Lock()
+	defer c.blockLock.Unlock()
+	if c.stopped {
+		return errors.New("consensus is stopped")
+	}
+
+	version, err := c.store.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if block.Height != int64(version)+1 {
+		return fmt.Errorf("invalid height of the streamed block, expected %d, got %d", version+1, block.Height)
+	}
+
+	appHash, err := c.store.Commit(&store.Changeset{Changes: intoStateChanges(block.ChangeSet)})
+	if err != nil {
+		return fmt.Errorf("unable to commit the changeset: %w", err)
+	}
+	if !bytes.Equal(appHash, block.AppHash) {
+		return fmt.Errorf("%w at height %d: expected %X, got %X", errAppHashMismatch, block.Height, block.AppHash, appHash)
+	}
+
+	c.lastCommittedHeight.Store(block.Height)
+	c.finality.Send(serverv2.FinalizedBlock{Height: uint64(block.Height), AppHash: appHash, NumTxs: len(block.Txs)})
+	c.streamFinalizedBlock(ctx, block)
+	if c.snapshotManager != nil {
+		c.snapshotManager.SnapshotIfApplicable(block.Height)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package cometbft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	gateway "github.com/cosmos/gogogateway"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/api/grpcgateway"
+	"cosmossdk.io/server/v2/cometbft/client/grpc/cmtservice"
+)
+
+var _ grpcgateway.Extension = (*CometBFTServer[transaction.Tx])(nil)
+
+// cmtServicePrefix is the path prefix of the REST routes of the CometBFT service.
+const cmtServicePrefix = "/cosmos/base/tendermint/v1beta1/"
+
+// RegisterRoutes implements grpcgateway.Extension. It serves the CometBFT
+// service queries, such as the tx and block searches, on the API server.
+// The results are fetched from the CometBFT RPC of the node and the txs are
+// decoded with the tx codec of the server.
+// The comet server must be initialized before the API server.
+func (s *CometBFTServer[T]) RegisterRoutes(r *mux.Router) error {
+	if s.Consensus == nil {
+		return errors.New("comet server must be initialized before registering its routes")
+	}
+
+	client, err := rpchttp.New(s.config.ConfigTomlConfig.RPC.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to create CometBFT RPC client: %w", err)
+	}
+
+	txDecoder := func(txBytes []byte) (transaction.Tx, error) {
+		return s.initTxCodec.Decode(txBytes)
+	}
+
+	gwMux := runtime.NewServeMux(
+		// Custom marshaler option is required for gogo proto
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{EmitDefaults: true, OrigName: true}),
+		runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+	)
+	if err := cmtservice.RegisterServiceHandlerServer(
+		context.Background(),
+		gwMux,
+		cmtservice.NewQueryServer(client, s.Consensus.Query, txDecoder),
+	); err != nil {
+		return err
+	}
+
+	r.PathPrefix(cmtServicePrefix).Handler(gwMux)
+	return nil
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path/filepath"
 
 	abciserver "github.com/cometbft/cometbft/abci/server"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -34,12 +37,20 @@ var (
 	_ serverv2.ServerComponent[transaction.Tx] = (*CometBFTServer[transaction.Tx])(nil)
 	_ serverv2.HasCLICommands                  = (*CometBFTServer[transaction.Tx])(nil)
 	_ serverv2.HasStartFlags                   = (*CometBFTServer[transaction.Tx])(nil)
+	_ serverv2.ConsensusComponent              = (*CometBFTServer[transaction.Tx])(nil)
+	_ serverv2.ConsensusEngine[transaction.Tx] = (*CometBFTServer[transaction.Tx])(nil)
 )
 
 type CometBFTServer[T transaction.Tx] struct {
 	Node      *node.Node
 	Consensus *Consensus[T]
 
+	// abciServer is the ABCI server of the app, in standalone mode.
+	abciServer service.Service
+	// stopFollowing is closed to stop following the trusted node, in
+	// indexer-only mode.
+	stopFollowing chan struct{}
+
 	initTxCodec   transaction.Codec[T]
 	logger        log.Logger
 	serverOptions ServerOptions[T]
@@ -93,6 +104,9 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger l
 	consensus.extendVote = s.serverOptions.ExtendVoteHandler
 	consensus.addrPeerFilter = s.serverOptions.AddrPeerFilter
 	consensus.idPeerFilter = s.serverOptions.IdPeerFilter
+	if s.config.AppTomlConfig.Follow != "" {
+		s.stopFollowing = make(chan struct{})
+	}
 
 	ss := store.GetStateStorage().(snapshots.StorageSnapshotter)
 	sc := store.GetStateCommitment().(snapshots.CommitSnapshotter)
@@ -113,6 +127,22 @@ func (s *CometBFTServer[T]) Name() string {
 }
 
 func (s *CometBFTServer[T]) Start(ctx context.Context) error {
+	if addr := s.config.AppTomlConfig.Follow; addr != "" {
+		s.logger.Info("starting in indexer-only mode, following trusted node", "address", addr)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-s.stopFollowing:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		return s.Consensus.follow(ctx, addr)
+	}
+
 	wrappedLogger := cometlog.CometLoggerWrapper{Logger: s.logger}
 	if s.config.AppTomlConfig.Standalone {
 		svr, err := abciserver.NewServer(s.config.AppTomlConfig.Address, s.config.AppTomlConfig.Transport, s.Consensus)
@@ -121,6 +151,7 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 		}
 
 		svr.SetLogger(wrappedLogger)
+		s.abciServer = svr
 
 		return svr.Start()
 	}
@@ -148,14 +179,63 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 	return s.Node.Start()
 }
 
-func (s *CometBFTServer[T]) Stop(context.Context) error {
-	if s.Node != nil && s.Node.IsRunning() {
-		return s.Node.Stop()
+// IsConsensusComponent implements serverv2.ConsensusComponent.
+func (s *CometBFTServer[T]) IsConsensusComponent() {}
+
+// SubmitTx implements serverv2.ConsensusEngine. The tx is checked by the app
+// then added to the mempool of the node, which gossips it to its peers.
+func (s *CometBFTServer[T]) SubmitTx(ctx context.Context, tx T) error {
+	if s.Node == nil || !s.Node.IsRunning() {
+		return errors.New("cannot submit tx: CometBFT node is not running")
 	}
 
+	reqRes, err := s.Node.Mempool().CheckTx(cmttypes.Tx(tx.Bytes()), "")
+	if err != nil {
+		return err
+	}
+	reqRes.Wait()
+
+	res := reqRes.Response.GetCheckTx()
+	if res == nil {
+		return errors.New("cannot submit tx: no CheckTx response")
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("tx rejected with code %d (codespace %s): %s", res.Code, res.Codespace, res.Log)
+	}
 	return nil
 }
 
+// SubscribeFinalized implements serverv2.ConsensusEngine. The blocks are sent
+// once committed to the store in FinalizeBlock, or applied in indexer-only mode.
+func (s *CometBFTServer[T]) SubscribeFinalized(ctx context.Context) <-chan serverv2.FinalizedBlock {
+	return s.Consensus.finality.Subscribe(ctx)
+}
+
+// Stop stops the node, or the ABCI server in standalone mode, then waits for
+// the block being processed, if any, so that the app stops at a block boundary.
+func (s *CometBFTServer[T]) Stop(ctx context.Context) error {
+	if s.stopFollowing != nil {
+		close(s.stopFollowing)
+	}
+
+	if s.abciServer != nil && s.abciServer.IsRunning() {
+		if err := s.abciServer.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.Node != nil && s.Node.IsRunning() {
+		if err := s.Node.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if s.Consensus == nil {
+		return nil
+	}
+	return s.Consensus.Stop(ctx)
+}
+
 // returns a function which returns the genesis doc from the genesis file.
 func getGenDocProvider(cfg *cmtcfg.Config) func() (node.ChecksummedGenesisDoc, error) {
 	return func() (node.ChecksummedGenesisDoc, error) {
@@ -203,6 +283,8 @@ func (s *CometBFTServer[T]) StartCmdFlags() *pflag.FlagSet {
 	flags.Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	flags.Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	flags.Bool(Standalone, false, "Run app without CometBFT")
+	flags.Uint64(FlagChangelogSize, 0, "Number of recent finalized blocks kept and streamed to the indexer nodes following the node and to the block results subscribers, 0 disables the changelog service")
+	flags.String(FlagFollow, "", "Run in indexer-only mode, following the changelog of the trusted node at the given gRPC address instead of running CometBFT")
 
 	// add comet flags, we use an empty command to avoid duplicating CometBFT's AddNodeFlags.
 	// we can then merge the flag sets.
@@ -3,6 +3,9 @@ package cometbft
 import (
 	"context"
 
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
 	coreappmgr "cosmossdk.io/core/app"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
@@ -12,11 +15,12 @@ import (
 // streamDeliverBlockChanges will stream all the changes happened during deliver block.
 func (c *Consensus[T]) streamDeliverBlockChanges(
 	ctx context.Context,
-	height int64,
-	txs [][]byte,
+	req *abciproto.FinalizeBlockRequest,
 	txResults []coreappmgr.TxResult,
 	events []event.Event,
 	stateChanges []store.StateChanges,
+	cp *cmtproto.ConsensusParams,
+	appHash []byte,
 ) error {
 	// convert txresults to streaming txresults
 	streamingTxResults := make([]*streaming.ExecTxResult, len(txResults))
@@ -33,21 +37,38 @@ func (c *Consensus[T]) streamDeliverBlockChanges(
 		}
 	}
 
+	c.streamFinalizedBlock(ctx, &streaming.FinalizedBlock{
+		Height:                req.Height,
+		Txs:                   req.Txs,
+		Events:                streaming.IntoStreamingEvents(events),
+		TxResults:             streamingTxResults,
+		ChangeSet:             intoStreamingKVPairs(stateChanges),
+		AppHash:               appHash,
+		Time:                  req.Time,
+		ConsensusParamUpdates: cp,
+	})
+	return nil
+}
+
+// streamFinalizedBlock records a finalized block in the changelog and streams it
+// to the listeners.
+func (c *Consensus[T]) streamFinalizedBlock(ctx context.Context, block *streaming.FinalizedBlock) {
+	c.changelog.append(block)
+
 	for _, streamingListener := range c.streaming.Listeners {
 		if err := streamingListener.ListenDeliverBlock(ctx, streaming.ListenDeliverBlockRequest{
-			BlockHeight: height,
-			Txs:         txs,
-			TxResults:   streamingTxResults,
-			Events:      streaming.IntoStreamingEvents(events),
+			BlockHeight: block.Height,
+			Txs:         block.Txs,
+			TxResults:   block.TxResults,
+			Events:      block.Events,
 		}); err != nil {
-			c.logger.Error("ListenDeliverBlock listening hook failed", "height", height, "err", err)
+			c.logger.Error("ListenDeliverBlock listening hook failed", "height", block.Height, "err", err)
 		}
 
-		if err := streamingListener.ListenStateChanges(ctx, intoStreamingKVPairs(stateChanges)); err != nil {
-			c.logger.Error("ListenStateChanges listening hook failed", "height", height, "err", err)
+		if err := streamingListener.ListenStateChanges(ctx, block.ChangeSet); err != nil {
+			c.logger.Error("ListenStateChanges listening hook failed", "height", block.Height, "err", err)
 		}
 	}
-	return nil
 }
 
 func intoStreamingKVPairs(stateChanges []store.StateChanges) []*streaming.StoreKVPair {
@@ -75,3 +96,24 @@ func intoStreamingKVPairs(stateChanges []store.StateChanges) []*streaming.StoreK
 	}
 	return streamKvPairs
 }
+
+// intoStateChanges groups the streamed key-value pairs by store, in order.
+func intoStateChanges(pairs []*streaming.StoreKVPair) []store.StateChanges {
+	var stateChanges []store.StateChanges
+	actors := make(map[string]int)
+	for _, pair := range pairs {
+		i, ok := actors[string(pair.Address)]
+		if !ok {
+			i = len(stateChanges)
+			actors[string(pair.Address)] = i
+			stateChanges = append(stateChanges, store.StateChanges{Actor: pair.Address})
+		}
+
+		stateChanges[i].StateChanges = append(stateChanges[i].StateChanges, store.KVPair{
+			Key:    pair.Key,
+			Value:  pair.Value,
+			Remove: pair.Delete,
+		})
+	}
+	return stateChanges
+}
@@ -2,7 +2,10 @@ package serverv2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -70,6 +73,14 @@ func AddCommands[T transaction.Tx](
 	cmds.Commands = append(cmds.Commands, startCmd)
 	rootCmd.AddCommand(cmds.Commands...)
 
+	if configCmd := findSubCommand(rootCmd, "config"); configCmd != nil {
+		configCmd.AddCommand(validateConfigCommand(server))
+	} else {
+		configCmd := topLevelCmd(rootCmd.Context(), "config", "Configuration subcommands")
+		configCmd.AddCommand(validateConfigCommand(server))
+		rootCmd.AddCommand(configCmd)
+	}
+
 	if len(cmds.Queries) > 0 {
 		if queryCmd := findSubCommand(rootCmd, "query"); queryCmd != nil {
 			queryCmd.AddCommand(cmds.Queries...)
@@ -116,26 +127,36 @@ func createStartCommand[T transaction.Tx](
 			}
 
 			ctx, cancelFn := context.WithCancel(cmd.Context())
+			stopErrCh := make(chan error, 1)
 			go func() {
 				sigCh := make(chan os.Signal, 1)
 				signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-				sig := <-sigCh
-				cancelFn()
-				cmd.Printf("caught %s signal\n", sig.String())
-
-				if err := server.Stop(ctx); err != nil {
-					cmd.PrintErrln("failed to stop servers:", err)
+				select {
+				case sig := <-sigCh:
+					cmd.Printf("caught %s signal\n", sig.String())
+				case <-ctx.Done():
 				}
+
+				// stop the servers before cancelling the start context, so that the
+				// command only returns once they are fully stopped.
+				stopErrCh <- server.Stop(context.WithoutCancel(ctx))
+				cancelFn()
 			}()
 
 			if err := server.Start(ctx); err != nil {
 				return err
 			}
 
+			if err := <-stopErrCh; err != nil {
+				return fmt.Errorf("failed to stop servers: %w", err)
+			}
+
 			return nil
 		},
 	}
 
+	cmd.Flags().Duration(FlagShutdownDrainTimeout, DefaultShutdownDrainTimeout, "Time given to the servers to drain their in-flight requests on shutdown")
+
 	// add the start flags to the command
 	for _, startFlags := range flags {
 		cmd.Flags().AddFlagSet(startFlags)
@@ -144,6 +165,69 @@ func createStartCommand[T transaction.Tx](
 	return cmd
 }
 
+// validateConfigCommand creates the command validating the configuration of
+// the node before it starts.
+func validateConfigCommand[T transaction.Tx](server *Server[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the configuration of the node",
+		Long: `Validate the app.toml and config.toml configuration of the node against the schema of the
+server components configs, reporting the unknown keys, the type errors and the dangerous
+combinations of settings, such as pruning state too early for state sync snapshots.
+The command fails if any issue other than a warning is found.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home, err := cmd.Flags().GetString(FlagHome)
+			if err != nil {
+				return err
+			}
+
+			appTomlPath := filepath.Join(home, "config", "app.toml")
+			appToml, err := os.ReadFile(appTomlPath)
+			if err != nil {
+				return err
+			}
+
+			issues := server.ValidateConfig(appToml, GetViperFromCmd(cmd))
+
+			output, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return err
+			}
+			if output == OutputFormatJSON {
+				bz, err := json.MarshalIndent(issues, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			} else {
+				for _, issue := range issues {
+					fmt.Fprintln(cmd.OutOrStdout(), issue.String())
+				}
+			}
+
+			var errCount int
+			for _, issue := range issues {
+				if !issue.Warning {
+					errCount++
+				}
+			}
+			if errCount > 0 {
+				return fmt.Errorf("found %d configuration errors in %s", errCount, filepath.Dir(appTomlPath))
+			}
+
+			if output != OutputFormatJSON {
+				fmt.Fprintln(cmd.OutOrStdout(), "configuration is valid")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
 // configHandle writes the default config to the home directory if it does not exist and sets the server context
 func configHandle[T transaction.Tx](s *Server[T], cmd *cobra.Command) error {
 	home, err := cmd.Flags().GetString(FlagHome)
@@ -169,7 +253,7 @@ func configHandle[T transaction.Tx](s *Server[T], cmd *cobra.Command) error {
 		return err
 	}
 
-	log, err := NewLogger(v, cmd.OutOrStdout())
+	log, err := NewLogger(v, io.MultiWriter(append([]io.Writer{cmd.OutOrStdout()}, s.LogWriters()...)...))
 	if err != nil {
 		return err
 	}
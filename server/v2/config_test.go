@@ -1,12 +1,17 @@
 package serverv2_test
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
 	grpc "cosmossdk.io/server/v2/api/grpc"
 )
@@ -37,3 +42,60 @@ func TestUnmarshalSubConfig(t *testing.T) {
 	require.True(t, grpc.DefaultConfig().Enable)
 	require.False(t, grpcConfig.Enable)
 }
+
+type mockValidatingServer struct {
+	mockServer
+}
+
+func (s *mockValidatingServer) ValidateConfig(v *viper.Viper) []serverv2.ConfigIssue {
+	if v.GetInt("mock-server-2.mock_field_two") > 10 {
+		return []serverv2.ConfigIssue{{Key: "mock-server-2.mock_field_two", Error: "too high", Warning: true}}
+	}
+	return nil
+}
+
+func TestValidateConfig(t *testing.T) {
+	server := serverv2.NewServer(
+		log.NewNopLogger(),
+		grpc.New[transaction.Tx](),
+		&mockServer{name: "mock-server-1"},
+		&mockValidatingServer{mockServer{name: "mock-server-2"}},
+	)
+
+	appToml := []byte(`
+[grpc]
+enable = true
+adress = 'localhost:9090'
+max-recv-msg-size = 'ten'
+listeners = [{address = 'unix:///tmp/grpc.sock'}]
+
+[mock-server-1]
+mock_field = 'value'
+mock_field_two = '2'
+
+[mock-server-2]
+mock_field_two = 11
+
+[unknown]
+key = 1
+`)
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(bytes.NewReader(appToml)))
+
+	require.Equal(t, []serverv2.ConfigIssue{
+		{Key: "grpc.adress", Error: "unknown key"},
+		{Key: "grpc.max-recv-msg-size", Error: "expected int: cannot parse '' as int: strconv.ParseInt: parsing \"ten\": invalid syntax"},
+		{Key: "unknown", Error: "unknown key"},
+		{Key: "mock-server-2.mock_field_two", Error: "too high", Warning: true},
+	}, server.ValidateConfig(appToml, v))
+
+	// the default config is valid
+	defaultAppToml, err := toml.Marshal(server.Configs())
+	require.NoError(t, err)
+	require.Empty(t, server.ValidateConfig(defaultAppToml, viper.New()))
+
+	issues := server.ValidateConfig([]byte("[grpc"), v)
+	require.Len(t, issues, 1)
+	require.Equal(t, "app.toml", issues[0].Key)
+}
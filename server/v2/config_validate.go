@@ -0,0 +1,167 @@
+package serverv2
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/viper"
+)
+
+// ConfigIssue is an issue found while validating the configuration of the node.
+type ConfigIssue struct {
+	// Key is the key of the configuration with the issue, e.g. grpc.address.
+	Key string `json:"key"`
+	// Error describes the issue.
+	Error string `json:"error"`
+	// Warning is true if the issue does not prevent the node from starting,
+	// such as a dangerous combination of settings.
+	Warning bool `json:"warning,omitempty"`
+}
+
+func (i ConfigIssue) String() string {
+	level := "error"
+	if i.Warning {
+		level = "warning"
+	}
+	return fmt.Sprintf("%s: %s: %s", level, i.Key, i.Error)
+}
+
+// HasConfigValidation is a server module validating its configuration before
+// the node starts, beyond the keys and types of its config, such as the
+// dangerous combinations with the config of the other server modules.
+type HasConfigValidation interface {
+	// ValidateConfig validates the configuration of the node, holding the
+	// app.toml and config.toml settings.
+	ValidateConfig(v *viper.Viper) []ConfigIssue
+}
+
+// ValidateConfig validates the app.toml configuration against the schema of the
+// configs of the server components, reporting the unknown keys and the type
+// errors, then runs the validations of the server components.
+func (s *Server[T]) ValidateConfig(appToml []byte, v *viper.Viper) []ConfigIssue {
+	var issues []ConfigIssue
+
+	var settings map[string]any
+	if err := toml.Unmarshal(appToml, &settings); err != nil {
+		return []ConfigIssue{{Key: "app.toml", Error: err.Error()}}
+	}
+
+	schema := configSchema{}
+	for name, cfg := range s.Configs() {
+		schema.add(name, reflect.TypeOf(cfg))
+	}
+	issues = append(issues, schema.validate("", settings)...)
+
+	for _, mod := range s.components {
+		if validatemod, ok := mod.(HasConfigValidation); ok {
+			issues = append(issues, validatemod.ValidateConfig(v)...)
+		}
+	}
+
+	return issues
+}
+
+// configSchema maps the keys of the configuration to their type. The tables,
+// the structs, are mapped to a nil type.
+type configSchema map[string]reflect.Type
+
+// add adds to the schema the keys of a config of type t at key, following the
+// mapstructure tags as the configs are decoded with mapstructure.
+func (s configSchema) add(key string, t reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		s[key] = t
+		return
+	}
+
+	s[key] = nil
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == "-" {
+			continue
+		}
+		if opts == "squash" {
+			s.add(key, field.Type)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		s.add(joinKey(key, strings.ToLower(name)), field.Type)
+	}
+}
+
+// validate validates the settings of the table at key.
+func (s configSchema) validate(key string, settings map[string]any) []ConfigIssue {
+	var issues []ConfigIssue
+	for _, name := range sortedKeys(settings) {
+		subKey := joinKey(key, name)
+		value := settings[name]
+
+		t, ok := s[strings.ToLower(subKey)]
+		if !ok {
+			issues = append(issues, ConfigIssue{Key: subKey, Error: "unknown key"})
+			continue
+		}
+
+		table, isTable := value.(map[string]any)
+		switch {
+		case t == nil && isTable:
+			issues = append(issues, s.validate(subKey, table)...)
+		case t == nil:
+			issues = append(issues, ConfigIssue{Key: subKey, Error: fmt.Sprintf("expected a table, got %T", value)})
+		default:
+			if err := decodeConfigValue(value, t); err != nil {
+				issues = append(issues, ConfigIssue{Key: subKey, Error: fmt.Sprintf("expected %s: %v", t, err)})
+			}
+		}
+	}
+
+	return issues
+}
+
+// decodeConfigValue decodes a value into type t, as UnmarshalSubConfig does.
+func decodeConfigValue(value any, t reflect.Type) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
+		Result:           reflect.New(t).Interface(),
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(value)
+}
+
+func joinKey(key, name string) string {
+	if key == "" {
+		return name
+	}
+	return key + "." + name
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,94 @@
+package serverv2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/transaction"
+)
+
+// ConsensusComponent is a server module driving the consensus, such as the
+// CometBFT server. On shutdown, it is stopped after the other server modules,
+// and must only return once the block being processed, if any, is committed.
+type ConsensusComponent interface {
+	IsConsensusComponent()
+}
+
+// ConsensusEngine is a consensus component sourcing the blocks of the app. It
+// drives the app only through its AppManager, delivering the blocks and
+// committing their state changes, so that the app and its modules are not
+// tied to a consensus engine and the engine can be swapped in the server
+// components of the app.
+type ConsensusEngine[T transaction.Tx] interface {
+	ServerComponent[T]
+	ConsensusComponent
+
+	// SubmitTx validates a tx against the latest state and submits it for
+	// inclusion in a later block.
+	SubmitTx(ctx context.Context, tx T) error
+
+	// SubscribeFinalized returns a channel receiving the blocks finalized by
+	// the engine, until ctx is done.
+	SubscribeFinalized(ctx context.Context) <-chan FinalizedBlock
+}
+
+// FinalizedBlock is the finality signal of a block committed by a consensus
+// engine. Once sent, the state of the block is queryable at its height.
+type FinalizedBlock struct {
+	Height  uint64
+	Time    time.Time
+	Hash    []byte
+	AppHash []byte
+	NumTxs  int
+}
+
+// finalitySubscriberBuffer is the number of finality signals buffered for a
+// subscriber before it starts missing them.
+const finalitySubscriberBuffer = 16
+
+// FinalityFeed sends the finality signals of a consensus engine to the
+// subscribers. The zero value is ready to use. It never blocks the engine: a
+// subscriber not keeping up misses the signals, and must query the latest
+// state if it needs every block.
+type FinalityFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan FinalizedBlock]struct{}
+}
+
+// Subscribe returns a channel receiving the blocks sent after the call, which
+// is closed once ctx is done.
+func (f *FinalityFeed) Subscribe(ctx context.Context) <-chan FinalizedBlock {
+	ch := make(chan FinalizedBlock, finalitySubscriberBuffer)
+
+	f.mu.Lock()
+	if f.subscribers == nil {
+		f.subscribers = make(map[chan FinalizedBlock]struct{})
+	}
+	f.subscribers[ch] = struct{}{}
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.subscribers, ch)
+		close(ch)
+	}()
+
+	return ch
+}
+
+// Send sends a finalized block to the subscribers.
+func (f *FinalityFeed) Send(block FinalizedBlock) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- block:
+		default:
+		}
+	}
+}
@@ -10,6 +10,13 @@ const (
 	FlagLogNoColor = "log_no_color" // Disables colored log output
 	FlagTrace      = "trace"        // Enables trace-level logging
 
+	// FlagShutdownDrainTimeout specifies the time given to the servers to drain
+	// their in-flight requests on shutdown.
+	FlagShutdownDrainTimeout = "shutdown-drain-timeout"
+
+	// FlagOutput specifies the output format flag.
+	FlagOutput = "output"
+
 	// OutputFormatJSON defines the JSON output format option.
 	OutputFormatJSON = "json"
 )
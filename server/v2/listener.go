@@ -0,0 +1,135 @@
+package serverv2
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+)
+
+// unixPrefix is the address prefix of the unix socket listeners.
+const unixPrefix = "unix://"
+
+// ListenerConfig defines a listener of a server, to serve it on several
+// addresses, such as a local unix socket without authentication for sidecars
+// and a TCP address with TLS.
+type ListenerConfig struct {
+	// Address defines the address to bind to, either host:port or unix:///path/to/socket.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the address to bind to, either host:port or unix:///path/to/socket."`
+
+	// TLSCertFile defines the TLS certificate file. TLS is enabled if set.
+	TLSCertFile string `mapstructure:"tls-cert-file" toml:"tls-cert-file" comment:"TLSCertFile defines the TLS certificate file. TLS is enabled if set."`
+
+	// TLSKeyFile defines the TLS private key file.
+	TLSKeyFile string `mapstructure:"tls-key-file" toml:"tls-key-file" comment:"TLSKeyFile defines the TLS private key file."`
+
+	// TLSClientCAFile defines the CA certificates file to verify the client
+	// certificates with. Client certificates are required if set.
+	TLSClientCAFile string `mapstructure:"tls-client-ca-file" toml:"tls-client-ca-file" comment:"TLSClientCAFile defines the CA certificates file to verify the client certificates with.\nClient certificates are required if set."`
+}
+
+// Listen binds the listener. nextProtos are the application protocols
+// negotiated over TLS, such as h2 for gRPC.
+func (cfg ListenerConfig) Listen(nextProtos ...string) (net.Listener, error) {
+	tlsConfig, err := cfg.tlsConfig(nextProtos)
+	if err != nil {
+		return nil, err
+	}
+
+	network, address := "tcp", cfg.Address
+	if path, ok := strings.CutPrefix(cfg.Address, unixPrefix); ok {
+		network, address = "unix", path
+		if err := removeStaleSocket(path); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on address %s: %w", cfg.Address, err)
+	}
+
+	if tlsConfig != nil {
+		return tls.NewListener(listener, tlsConfig), nil
+	}
+	return listener, nil
+}
+
+func (cfg ListenerConfig) tlsConfig(nextProtos []string) (*tls.Config, error) {
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		if cfg.TLSClientCAFile != "" {
+			return nil, fmt.Errorf("listener %s: client CA file requires a TLS certificate", cfg.Address)
+		}
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("listener %s: failed to load TLS certificate: %w", cfg.Address, err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   nextProtos,
+	}
+
+	if cfg.TLSClientCAFile != "" {
+		bz, err := os.ReadFile(cfg.TLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("listener %s: failed to read client CA file: %w", cfg.Address, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("listener %s: no certificate found in client CA file", cfg.Address)
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// removeStaleSocket removes the unix socket left at path by a previous run.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&fs.ModeSocket == 0 {
+		return fmt.Errorf("cannot listen on %s: file exists and is not a unix socket", path)
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("cannot listen on %s: unix socket is in use", path)
+	}
+
+	return os.Remove(path)
+}
+
+// ListenAll binds all the listeners, or none if one fails.
+func ListenAll(cfgs []ListenerConfig, nextProtos ...string) ([]net.Listener, error) {
+	listeners := make([]net.Listener, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		listener, err := cfg.Listen(nextProtos...)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return nil, err
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return listeners, nil
+}
@@ -0,0 +1,117 @@
+package serverv2_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+// writeTestCert writes a self-signed certificate for localhost and its key in dir.
+func writeTestCert(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+
+	return certFile, keyFile
+}
+
+func TestListenerConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir)
+	socket := filepath.Join(dir, "app.sock")
+
+	listeners, err := serverv2.ListenAll([]serverv2.ListenerConfig{
+		{Address: "unix://" + socket},
+		{Address: "127.0.0.1:0", TLSCertFile: certFile, TLSKeyFile: keyFile},
+	}, "h2")
+	require.NoError(t, err)
+	require.Len(t, listeners, 2)
+
+	for _, l := range listeners {
+		l := l
+		go func() {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			// complete the TLS handshake, if any, before closing
+			_, _ = conn.Write([]byte("ok"))
+			_ = conn.Close()
+		}()
+	}
+
+	// the unix socket is in use
+	_, err = serverv2.ListenerConfig{Address: "unix://" + socket}.Listen()
+	require.ErrorContains(t, err, "in use")
+
+	conn, err := net.Dial("unix", socket)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	certPool := x509.NewCertPool()
+	bz, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	require.True(t, certPool.AppendCertsFromPEM(bz))
+
+	tlsConn, err := tls.Dial("tcp", listeners[1].Addr().String(), &tls.Config{RootCAs: certPool, ServerName: "localhost", NextProtos: []string{"h2"}})
+	require.NoError(t, err)
+	require.Equal(t, "h2", tlsConn.ConnectionState().NegotiatedProtocol)
+	require.NoError(t, tlsConn.Close())
+
+	for _, l := range listeners {
+		require.NoError(t, l.Close())
+	}
+
+	// a stale unix socket is removed
+	stale, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	l, err := serverv2.ListenerConfig{Address: "unix://" + socket}.Listen()
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	// invalid configs are rejected
+	for _, cfg := range []serverv2.ListenerConfig{
+		{Address: "unix://" + certFile},
+		{Address: "127.0.0.1:0", TLSClientCAFile: certFile},
+		{Address: "127.0.0.1:0", TLSCertFile: certFile},
+		{Address: "127.0.0.1:0", TLSCertFile: certFile, TLSKeyFile: keyFile, TLSClientCAFile: keyFile},
+	} {
+		_, err := cfg.Listen()
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,38 @@
+package sequencer
+
+import "time"
+
+func DefaultConfig() *Config {
+	return &Config{
+		BlockTime:      time.Second,
+		MaxBlockTxs:    1000,
+		MaxMempoolTxs:  5000,
+		SkipEmptyBlock: false,
+	}
+}
+
+// Config defines the configuration of the sequencer.
+type Config struct {
+	// BlockTime is the interval at which the sequencer produces blocks.
+	BlockTime time.Duration `mapstructure:"block-time" toml:"block-time" comment:"BlockTime is the interval at which the sequencer produces blocks."`
+
+	// MaxBlockTxs is the maximum number of txs included in a block.
+	MaxBlockTxs uint64 `mapstructure:"max-block-txs" toml:"max-block-txs" comment:"MaxBlockTxs is the maximum number of txs included in a block."`
+
+	// MaxMempoolTxs is the maximum number of txs waiting for inclusion, the
+	// submitted txs are rejected once it is reached.
+	MaxMempoolTxs uint64 `mapstructure:"max-mempool-txs" toml:"max-mempool-txs" comment:"MaxMempoolTxs is the maximum number of txs waiting for inclusion, the submitted txs are rejected once it is reached."`
+
+	// SkipEmptyBlock skips the production of the blocks without txs.
+	SkipEmptyBlock bool `mapstructure:"skip-empty-block" toml:"skip-empty-block" comment:"SkipEmptyBlock skips the production of the blocks without txs."`
+}
+
+// CfgOption is a function that allows to overwrite the default server configuration.
+type CfgOption func(*Config)
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
@@ -0,0 +1,16 @@
+package sequencer
+
+import "fmt"
+
+// start flags are prefixed with the server name
+// as the config in prefixed with the server name
+// this allows viper to properly bind the flags
+func prefix(f string) string {
+	return fmt.Sprintf("%s.%s", ServerName, f)
+}
+
+var (
+	FlagBlockTime      = prefix("block-time")
+	FlagMaxBlockTxs    = prefix("max-block-txs")
+	FlagSkipEmptyBlock = prefix("skip-empty-block")
+)
@@ -0,0 +1,116 @@
+package sequencer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	coreappmgr "cosmossdk.io/core/app"
+	"cosmossdk.io/core/store"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+// genesis holds the fields of the genesis file read by the sequencer.
+type genesis struct {
+	GenesisTime   time.Time       `json:"genesis_time"`
+	ChainID       string          `json:"chain_id"`
+	InitialHeight json.Number     `json:"initial_height"`
+	AppState      json.RawMessage `json:"app_state"`
+}
+
+func readGenesis(path string) (*genesis, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis file: %w", err)
+	}
+
+	var gen genesis
+	if err := json.Unmarshal(bz, &gen); err != nil {
+		return nil, fmt.Errorf("failed to parse genesis file %s: %w", path, err)
+	}
+	if gen.ChainID == "" {
+		return nil, errors.New("genesis file must set chain_id")
+	}
+
+	return &gen, nil
+}
+
+// initialHeight returns the height of the genesis block, 1 if unset.
+func (g *genesis) initialHeight() (uint64, error) {
+	if g.InitialHeight == "" {
+		return 1, nil
+	}
+
+	height, err := g.InitialHeight.Int64()
+	if err != nil || height < 0 {
+		return 0, fmt.Errorf("invalid initial_height %s", g.InitialHeight)
+	}
+	if height == 0 {
+		return 1, nil
+	}
+	return uint64(height), nil
+}
+
+// initChain initializes the state of the app from the genesis file and commits
+// it at the initial height, unless the store already holds a state.
+func (s *Sequencer[T]) initChain(ctx context.Context) error {
+	version, err := s.store.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	gen, err := readGenesis(filepath.Join(s.home, "config", "genesis.json"))
+	if err != nil {
+		return err
+	}
+	s.chainID = gen.ChainID
+
+	if version > 0 {
+		s.logger.Info("resuming chain", "chain_id", s.chainID, "height", version)
+		return nil
+	}
+
+	initialHeight, err := gen.initialHeight()
+	if err != nil {
+		return err
+	}
+	s.logger.Info("initializing chain from genesis", "chain_id", s.chainID, "initial_height", initialHeight)
+
+	hash := sha256.Sum256(nil)
+	resp, genesisState, err := s.app.InitGenesis(ctx, &coreappmgr.BlockRequest[T]{
+		Height:    initialHeight - 1,
+		Time:      gen.GenesisTime,
+		Hash:      hash[:],
+		ChainId:   gen.ChainID,
+		IsGenesis: true,
+	}, gen.AppState, s.txCodec)
+	if err != nil {
+		return fmt.Errorf("genesis state init failure: %w", err)
+	}
+	for _, txRes := range resp.TxResults {
+		if txRes.Error != nil {
+			s.logger.Warn("genesis tx failed", "code", txRes.Code, "log", txRes.Log, "error", txRes.Error)
+		}
+	}
+
+	if err := s.store.SetInitialVersion(initialHeight); err != nil {
+		return fmt.Errorf("failed to set initial version: %w", err)
+	}
+
+	stateChanges, err := genesisState.GetStateChanges()
+	if err != nil {
+		return err
+	}
+	appHash, err := s.store.Commit(&store.Changeset{Changes: stateChanges})
+	if err != nil {
+		return fmt.Errorf("unable to commit the genesis changeset: %w", err)
+	}
+
+	s.finality.Send(serverv2.FinalizedBlock{Height: initialHeight, Time: gen.GenesisTime, Hash: hash[:], AppHash: appHash})
+	return nil
+}
@@ -0,0 +1,73 @@
+package sequencer
+
+import (
+	"errors"
+	"sync"
+
+	"cosmossdk.io/core/transaction"
+)
+
+var (
+	errMempoolFull = errors.New("mempool is full")
+	errTxInMempool = errors.New("tx already in mempool")
+)
+
+// mempool keeps the submitted txs in their order of arrival until they are
+// included in a block.
+type mempool[T transaction.Tx] struct {
+	mu     sync.Mutex
+	size   int
+	txs    []T
+	hashes map[[32]byte]struct{}
+}
+
+func newMempool[T transaction.Tx](size uint64) *mempool[T] {
+	return &mempool[T]{
+		size:   int(size),
+		hashes: make(map[[32]byte]struct{}),
+	}
+}
+
+// push adds a tx to the mempool.
+func (m *mempool[T]) push(tx T) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hash := tx.Hash()
+	if _, ok := m.hashes[hash]; ok {
+		return errTxInMempool
+	}
+	if len(m.txs) >= m.size {
+		return errMempoolFull
+	}
+
+	m.txs = append(m.txs, tx)
+	m.hashes[hash] = struct{}{}
+	return nil
+}
+
+// pop removes and returns up to n txs, the oldest first.
+func (m *mempool[T]) pop(n uint64) []T {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if uint64(len(m.txs)) < n {
+		n = uint64(len(m.txs))
+	}
+
+	txs := make([]T, n)
+	copy(txs, m.txs[:n])
+	m.txs = m.txs[n:]
+	for _, tx := range txs {
+		delete(m.hashes, tx.Hash())
+	}
+	return txs
+}
+
+// len returns the number of txs in the mempool.
+func (m *mempool[T]) len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.txs)
+}
@@ -0,0 +1,263 @@
+// Package sequencer implements a single-node sequencer, a reference consensus
+// engine of the server/v2 consensus abstraction. The node orders the submitted
+// txs and produces the blocks alone, at a fixed interval, which is enough to
+// run an app in a rollup-style setup or in local development, without CometBFT
+// and without any change to the app or its modules.
+package sequencer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	coreappmgr "cosmossdk.io/core/app"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/appmanager"
+	"cosmossdk.io/store/v2/proof"
+)
+
+const ServerName = "sequencer"
+
+var (
+	_ serverv2.ServerComponent[transaction.Tx] = (*Sequencer[transaction.Tx])(nil)
+	_ serverv2.HasStartFlags                   = (*Sequencer[transaction.Tx])(nil)
+	_ serverv2.ConsensusEngine[transaction.Tx] = (*Sequencer[transaction.Tx])(nil)
+)
+
+// Store is the store of the app committed by the sequencer.
+type Store interface {
+	// GetLatestVersion returns the latest committed version.
+	GetLatestVersion() (uint64, error)
+	// LastCommitID returns the commit ID of the latest committed version.
+	LastCommitID() (proof.CommitID, error)
+	// SetInitialVersion sets the initial version of the store.
+	SetInitialVersion(uint64) error
+	// Commit commits the provided changeset and returns the new state root.
+	Commit(*store.Changeset) (store.Hash, error)
+}
+
+// Sequencer is a single-node consensus engine: it includes the submitted txs,
+// in their order of arrival, in a block produced every block time.
+type Sequencer[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+	txCodec    transaction.Codec[T]
+
+	home    string
+	chainID string
+	app     *appmanager.AppManager[T]
+	store   Store
+	mempool *mempool[T]
+
+	finality serverv2.FinalityFeed
+
+	// blockLock is held while a block is produced, so that the sequencer stops
+	// at a block boundary.
+	blockLock sync.Mutex
+	stopped   bool
+	stopOnce  sync.Once
+	stop      chan struct{}
+}
+
+// New creates a new sequencer.
+func New[T transaction.Tx](txCodec transaction.Codec[T], cfgOptions ...CfgOption) *Sequencer[T] {
+	return &Sequencer[T]{
+		txCodec:    txCodec,
+		cfgOptions: cfgOptions,
+		stop:       make(chan struct{}),
+	}
+}
+
+func (s *Sequencer[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger log.Logger) error {
+	cfg := s.Config().(*Config)
+	if v != nil {
+		if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+		s.home = v.GetString(serverv2.FlagHome)
+	}
+	if cfg.BlockTime <= 0 {
+		return fmt.Errorf("invalid block time %s", cfg.BlockTime)
+	}
+
+	store, ok := appI.GetStore().(Store)
+	if !ok {
+		return fmt.Errorf("app store of type %T cannot be committed by the sequencer", appI.GetStore())
+	}
+
+	s.config = cfg
+	s.logger = logger.With(log.ModuleKey, s.Name())
+	s.app = appI.GetAppManager()
+	s.store = store
+	s.mempool = newMempool[T](cfg.MaxMempoolTxs)
+
+	return nil
+}
+
+func (s *Sequencer[T]) Name() string {
+	return ServerName
+}
+
+// Start initializes the chain from the genesis file if the store is empty,
+// then produces a block every block time until the sequencer is stopped.
+func (s *Sequencer[T]) Start(ctx context.Context) error {
+	if err := s.initChain(ctx); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.config.BlockTime)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case now := <-ticker.C:
+			if err := s.produceBlock(ctx, now.UTC()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Stop stops the production of blocks, once the block being produced, if any,
+// is committed.
+func (s *Sequencer[T]) Stop(context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+
+	s.blockLock.Lock()
+	defer s.blockLock.Unlock()
+	s.stopped = true
+
+	return nil
+}
+
+// IsConsensusComponent implements serverv2.ConsensusComponent.
+func (s *Sequencer[T]) IsConsensusComponent() {}
+
+// SubmitTx implements serverv2.ConsensusEngine. The tx is validated against
+// the latest state then queued for inclusion.
+func (s *Sequencer[T]) SubmitTx(ctx context.Context, tx T) error {
+	res, err := s.app.ValidateTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if res.Error != nil {
+		return fmt.Errorf("tx rejected with code %d: %w", res.Code, res.Error)
+	}
+
+	return s.mempool.push(tx)
+}
+
+// SubscribeFinalized implements serverv2.ConsensusEngine.
+func (s *Sequencer[T]) SubscribeFinalized(ctx context.Context) <-chan serverv2.FinalizedBlock {
+	return s.finality.Subscribe(ctx)
+}
+
+// produceBlock delivers a block with the txs of the mempool and commits it.
+func (s *Sequencer[T]) produceBlock(ctx context.Context, now time.Time) error {
+	s.blockLock.Lock()
+	defer s.blockLock.Unlock()
+	if s.stopped {
+		// the sequencer is stopping, the block is not produced
+		return nil
+	}
+
+	if s.config.SkipEmptyBlock && s.mempool.len() == 0 {
+		return nil
+	}
+
+	lastCommit, err := s.store.LastCommitID()
+	if err != nil {
+		return err
+	}
+
+	height := lastCommit.Version + 1
+	txs := s.mempool.pop(s.config.MaxBlockTxs)
+	hash := blockHash(height, lastCommit.Hash, txs)
+
+	resp, newState, err := s.app.DeliverBlock(ctx, &coreappmgr.BlockRequest[T]{
+		Height:  height,
+		Time:    now,
+		Hash:    hash,
+		ChainId: s.chainID,
+		AppHash: lastCommit.Hash,
+		Txs:     txs,
+	})
+	if err != nil {
+		return err
+	}
+	for _, txRes := range resp.TxResults {
+		if txRes.Error != nil {
+			s.logger.Debug("tx failed", "height", height, "code", txRes.Code, "error", txRes.Error)
+		}
+	}
+
+	stateChanges, err := newState.GetStateChanges()
+	if err != nil {
+		return err
+	}
+	appHash, err := s.store.Commit(&store.Changeset{Changes: stateChanges})
+	if err != nil {
+		return fmt.Errorf("unable to commit the changeset: %w", err)
+	}
+
+	s.logger.Debug("committed block", "height", height, "txs", len(txs), "app_hash", fmt.Sprintf("%X", appHash))
+	s.finality.Send(serverv2.FinalizedBlock{
+		Height:  height,
+		Time:    now,
+		Hash:    hash,
+		AppHash: appHash,
+		NumTxs:  len(txs),
+	})
+
+	return nil
+}
+
+// blockHash returns the hash of a block, committing to its height, to the app
+// hash of its parent and to its txs.
+func blockHash[T transaction.Tx](height uint64, parentAppHash []byte, txs []T) []byte {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, height)
+	h.Write(parentAppHash)
+	for _, tx := range txs {
+		txHash := tx.Hash()
+		h.Write(txHash[:])
+	}
+	return h.Sum(nil)
+}
+
+func (s *Sequencer[T]) StartCmdFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
+	flags.Duration(FlagBlockTime, time.Second, "Interval at which the sequencer produces blocks")
+	flags.Uint64(FlagMaxBlockTxs, 1000, "Maximum number of txs included in a block")
+	flags.Bool(FlagSkipEmptyBlock, false, "Skip the production of the blocks without txs")
+	return flags
+}
+
+// Config returns the (app.toml) server configuration.
+func (s *Sequencer[T]) Config() any {
+	if s.config == nil || s.config == (&Config{}) {
+		cfg := DefaultConfig()
+		// overwrite the default config with the provided options
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
@@ -0,0 +1,282 @@
+package sequencer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	coreappmgr "cosmossdk.io/core/app"
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/server/v2/appmanager"
+	"cosmossdk.io/store/v2/proof"
+)
+
+var actor = []byte("app")
+
+type mockTx []byte
+
+func (t mockTx) Hash() [32]byte                              { return sha256.Sum256(t) }
+func (t mockTx) GetMessages() ([]transaction.Msg, error)     { return nil, nil }
+func (t mockTx) GetSenders() ([]transaction.Identity, error) { return nil, nil }
+func (t mockTx) GetGasLimit() (uint64, error)                { return 0, nil }
+func (t mockTx) Bytes() []byte                               { return t }
+
+type mockCodec struct{}
+
+func (mockCodec) Decode(bz []byte) (mockTx, error) { return bz, nil }
+func (mockCodec) DecodeJSON(bz []byte) (mockTx, error) {
+	var tx string
+	err := json.Unmarshal(bz, &tx)
+	return mockTx(tx), err
+}
+
+// mockStore keeps the state of the app actor at every version.
+type mockStore struct {
+	initialVersion uint64
+	latest         uint64
+	versions       map[uint64]coretesting.MemKV
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{versions: map[uint64]coretesting.MemKV{0: coretesting.NewMemKV()}}
+}
+
+func (s *mockStore) GetLatestVersion() (uint64, error) { return s.latest, nil }
+
+func (s *mockStore) LastCommitID() (proof.CommitID, error) {
+	return proof.CommitID{Version: s.latest, Hash: s.hash(s.latest)}, nil
+}
+
+func (s *mockStore) SetInitialVersion(v uint64) error {
+	s.initialVersion = v
+	return nil
+}
+
+func (s *mockStore) Commit(cs *corestore.Changeset) (corestore.Hash, error) {
+	version := s.latest + 1
+	if s.latest == 0 && s.initialVersion > 0 {
+		version = s.initialVersion
+	}
+
+	kv := coretesting.NewMemKV()
+	iter, err := s.versions[s.latest].Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for ; iter.Valid(); iter.Next() {
+		if err := kv.Set(iter.Key(), iter.Value()); err != nil {
+			return nil, err
+		}
+	}
+	for _, changes := range cs.Changes {
+		for _, pair := range changes.StateChanges {
+			if err := kv.Set(pair.Key, pair.Value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	s.versions[version] = kv
+	s.latest = version
+	return s.hash(version), nil
+}
+
+func (s *mockStore) hash(version uint64) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d", version)))
+	return hash[:]
+}
+
+func (s *mockStore) StateLatest() (uint64, corestore.ReaderMap, error) {
+	return s.latest, mockReaderMap{s.versions[s.latest]}, nil
+}
+
+func (s *mockStore) StateAt(version uint64) (corestore.ReaderMap, error) {
+	kv, ok := s.versions[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown version %d", version)
+	}
+	return mockReaderMap{kv}, nil
+}
+
+type mockReaderMap struct{ kv coretesting.MemKV }
+
+func (m mockReaderMap) GetReader([]byte) (corestore.Reader, error) { return m.kv, nil }
+
+// mockWriterMap records the changes of the app actor.
+type mockWriterMap struct {
+	mockReaderMap
+	changes []corestore.KVPair
+}
+
+func (m *mockWriterMap) set(key, value string) {
+	m.changes = append(m.changes, corestore.KVPair{Key: []byte(key), Value: []byte(value)})
+}
+
+func (m *mockWriterMap) GetWriter([]byte) (corestore.Writer, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockWriterMap) ApplyStateChanges(stateChanges []corestore.StateChanges) error {
+	for _, sc := range stateChanges {
+		m.changes = append(m.changes, sc.StateChanges...)
+	}
+	return nil
+}
+
+func (m *mockWriterMap) GetStateChanges() ([]corestore.StateChanges, error) {
+	return []corestore.StateChanges{{Actor: actor, StateChanges: m.changes}}, nil
+}
+
+// mockSTF records the height and the txs of the delivered blocks, and rejects
+// the txs named "invalid".
+type mockSTF struct{}
+
+func (mockSTF) DeliverBlock(
+	_ context.Context,
+	block *coreappmgr.BlockRequest[mockTx],
+	state corestore.ReaderMap,
+) (*coreappmgr.BlockResponse, corestore.WriterMap, error) {
+	newState := &mockWriterMap{}
+	newState.set("height", fmt.Sprintf("%d", block.Height))
+	newState.set("chain-id", block.ChainId)
+
+	resp := &coreappmgr.BlockResponse{}
+	for _, tx := range block.Txs {
+		newState.set("tx/"+string(tx), fmt.Sprintf("%d", block.Height))
+		resp.TxResults = append(resp.TxResults, coreappmgr.TxResult{})
+	}
+	return resp, newState, nil
+}
+
+func (mockSTF) ValidateTx(_ context.Context, _ corestore.ReaderMap, _ uint64, tx mockTx) coreappmgr.TxResult {
+	if string(tx) == "invalid" {
+		return coreappmgr.TxResult{Code: 1, Error: errors.New("invalid tx")}
+	}
+	return coreappmgr.TxResult{}
+}
+
+func (mockSTF) Simulate(context.Context, corestore.ReaderMap, uint64, mockTx) (coreappmgr.TxResult, corestore.WriterMap) {
+	return coreappmgr.TxResult{}, nil
+}
+
+func (mockSTF) Query(context.Context, corestore.ReaderMap, uint64, transaction.Msg) (transaction.Msg, error) {
+	return nil, nil
+}
+
+func (mockSTF) RunWithCtx(ctx context.Context, state corestore.ReaderMap, closure func(ctx context.Context) error) (corestore.WriterMap, error) {
+	return &mockWriterMap{}, closure(ctx)
+}
+
+func newTestSequencer(t *testing.T, store *mockStore) *Sequencer[mockTx] {
+	t.Helper()
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	genesis := `{"chain_id":"test-chain","initial_height":"5","genesis_time":"2024-01-01T00:00:00Z","app_state":{}}`
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "genesis.json"), []byte(genesis), 0o600))
+
+	app, err := appmanager.Builder[mockTx]{
+		STF: mockSTF{},
+		DB:  store,
+		InitGenesis: func(ctx context.Context, src io.Reader, txHandler func(json.RawMessage) error) error {
+			return txHandler(json.RawMessage(`"gentx"`))
+		},
+	}.Build()
+	require.NoError(t, err)
+
+	s := New[mockTx](mockCodec{})
+	s.config = &Config{BlockTime: time.Millisecond, MaxBlockTxs: 2, MaxMempoolTxs: 3}
+	s.logger = log.NewNopLogger()
+	s.home = home
+	s.app = app
+	s.store = store
+	s.mempool = newMempool[mockTx](s.config.MaxMempoolTxs)
+	return s
+}
+
+func TestSequencer(t *testing.T) {
+	store := newMockStore()
+	s := newTestSequencer(t, store)
+	ctx := context.Background()
+
+	finalized := s.SubscribeFinalized(ctx)
+
+	require.NoError(t, s.initChain(ctx))
+	require.Equal(t, uint64(5), store.latest)
+	value, err := store.versions[5].Get([]byte("tx/gentx"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("4"), value)
+	require.Equal(t, uint64(5), (<-finalized).Height)
+
+	require.NoError(t, s.SubmitTx(ctx, mockTx("a")))
+	require.ErrorIs(t, s.SubmitTx(ctx, mockTx("a")), errTxInMempool)
+	require.ErrorContains(t, s.SubmitTx(ctx, mockTx("invalid")), "invalid tx")
+	require.NoError(t, s.SubmitTx(ctx, mockTx("b")))
+	require.NoError(t, s.SubmitTx(ctx, mockTx("c")))
+	require.ErrorIs(t, s.SubmitTx(ctx, mockTx("d")), errMempoolFull)
+
+	// the txs are included in their order of arrival, up to the max per block
+	require.NoError(t, s.produceBlock(ctx, time.Now()))
+	block := <-finalized
+	require.Equal(t, uint64(6), block.Height)
+	require.Equal(t, 2, block.NumTxs)
+	require.Equal(t, store.hash(6), block.AppHash)
+	for _, tx := range []string{"a", "b"} {
+		value, err := store.versions[6].Get([]byte("tx/" + tx))
+		require.NoError(t, err)
+		require.Equal(t, []byte("6"), value)
+	}
+	value, err = store.versions[6].Get([]byte("chain-id"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("test-chain"), value)
+
+	require.NoError(t, s.produceBlock(ctx, time.Now()))
+	block = <-finalized
+	require.Equal(t, uint64(7), block.Height)
+	require.Equal(t, 1, block.NumTxs)
+
+	// empty blocks are skipped if configured
+	s.config.SkipEmptyBlock = true
+	require.NoError(t, s.produceBlock(ctx, time.Now()))
+	require.Equal(t, uint64(7), store.latest)
+
+	// the chain resumes from the store
+	s = newTestSequencer(t, store)
+	require.NoError(t, s.initChain(ctx))
+	require.Equal(t, uint64(7), store.latest)
+	require.Equal(t, "test-chain", s.chainID)
+}
+
+func TestSequencerStartStop(t *testing.T) {
+	store := newMockStore()
+	s := newTestSequencer(t, store)
+
+	finalized := s.SubscribeFinalized(context.Background())
+
+	done := make(chan error)
+	go func() { done <- s.Start(context.Background()) }()
+
+	// genesis and a first block
+	<-finalized
+	<-finalized
+
+	require.NoError(t, s.Stop(context.Background()))
+	require.NoError(t, <-done)
+
+	// no block is produced once stopped
+	latest := store.latest
+	require.NoError(t, s.produceBlock(context.Background(), time.Now()))
+	require.Equal(t, latest, store.latest)
+}
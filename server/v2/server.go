@@ -2,10 +2,14 @@ package serverv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/spf13/cobra"
@@ -44,6 +48,13 @@ type HasCLICommands interface {
 	CLICommands() CLIConfig
 }
 
+// HasLogWriter is a server module that receives a copy of the node logs.
+type HasLogWriter interface {
+	// LogWriter returns the writer to which the logs are written, in addition
+	// to the command output.
+	LogWriter() io.Writer
+}
+
 // CLIConfig defines the CLI configuration for a module server.
 type CLIConfig struct {
 	// Commands defines the main command of a module server.
@@ -58,9 +69,15 @@ type CLIConfig struct {
 
 var _ ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
 
+// DefaultShutdownDrainTimeout is the default time given to the servers to
+// drain their in-flight requests on shutdown.
+const DefaultShutdownDrainTimeout = 10 * time.Second
+
 type Server[T transaction.Tx] struct {
-	logger     log.Logger
-	components []ServerComponent[T]
+	logger       log.Logger
+	components   []ServerComponent[T]
+	app          AppI[T]
+	drainTimeout time.Duration
 }
 
 func NewServer[T transaction.Tx](
@@ -68,8 +85,9 @@ func NewServer[T transaction.Tx](
 	components ...ServerComponent[T],
 ) *Server[T] {
 	return &Server[T]{
-		logger:     logger,
-		components: components,
+		logger:       logger,
+		components:   components,
+		drainTimeout: DefaultShutdownDrainTimeout,
 	}
 }
 
@@ -81,11 +99,11 @@ func (s *Server[T]) Name() string {
 func (s *Server[T]) Start(ctx context.Context) error {
 	s.logger.Info("starting servers...")
 
-	g, ctx := errgroup.WithContext(ctx)
+	g, gctx := errgroup.WithContext(ctx)
 	for _, mod := range s.components {
 		mod := mod
 		g.Go(func() error {
-			return mod.Start(ctx)
+			return mod.Start(gctx)
 		})
 	}
 
@@ -98,19 +116,60 @@ func (s *Server[T]) Start(ctx context.Context) error {
 	return nil
 }
 
-// Stop stops all components concurrently.
+// Stop stops all components in order, to avoid corrupt shutdowns:
+//   - the components serving requests are stopped concurrently, they stop
+//     accepting new requests and get the drain timeout to complete the
+//     in-flight ones;
+//   - the consensus components are stopped, at a block boundary;
+//   - the app is closed, flushing its store.
 func (s *Server[T]) Stop(ctx context.Context) error {
 	s.logger.Info("stopping servers...")
 
-	g, ctx := errgroup.WithContext(ctx)
+	var servers, consensus []ServerComponent[T]
 	for _, mod := range s.components {
-		mod := mod
-		g.Go(func() error {
-			return mod.Stop(ctx)
-		})
+		if _, ok := mod.(ConsensusComponent); ok {
+			consensus = append(consensus, mod)
+		} else {
+			servers = append(servers, mod)
+		}
+	}
+
+	drainCtx, cancel := context.WithTimeout(ctx, s.drainTimeout)
+	defer cancel()
+	serversErr := stopComponents(drainCtx, servers)
+
+	if err := stopComponents(ctx, consensus); err != nil {
+		// do not close the store, as a block may still be processed
+		return errors.Join(serversErr, err)
+	}
+
+	if closer, ok := s.app.(io.Closer); ok {
+		s.logger.Info("closing app...")
+		if err := closer.Close(); err != nil {
+			return errors.Join(serversErr, fmt.Errorf("failed to close app: %w", err))
+		}
 	}
 
-	return g.Wait()
+	return serversErr
+}
+
+// stopComponents stops the given components concurrently.
+func stopComponents[T transaction.Tx](ctx context.Context, components []ServerComponent[T]) error {
+	errs := make([]error, len(components))
+
+	var wg sync.WaitGroup
+	for i, mod := range components {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := mod.Stop(ctx); err != nil {
+				errs[i] = fmt.Errorf("failed to stop %s: %w", mod.Name(), err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
 }
 
 // CLICommands returns all CLI commands of all components.
@@ -167,6 +226,10 @@ func (s *Server[T]) Configs() map[string]any {
 // Init initializes all server components with the provided application, configuration, and logger.
 // It returns an error if any component fails to initialize.
 func (s *Server[T]) Init(appI AppI[T], v *viper.Viper, logger log.Logger) error {
+	if v != nil && v.IsSet(FlagShutdownDrainTimeout) {
+		s.drainTimeout = v.GetDuration(FlagShutdownDrainTimeout)
+	}
+
 	var components []ServerComponent[T]
 	for _, mod := range s.components {
 		mod := mod
@@ -178,9 +241,22 @@ func (s *Server[T]) Init(appI AppI[T], v *viper.Viper, logger log.Logger) error
 	}
 
 	s.components = components
+	s.app = appI
 	return nil
 }
 
+// LogWriters returns the log writers of all server components.
+func (s *Server[T]) LogWriters() []io.Writer {
+	var writers []io.Writer
+	for _, mod := range s.components {
+		if logmod, ok := mod.(HasLogWriter); ok {
+			writers = append(writers, logmod.LogWriter())
+		}
+	}
+
+	return writers
+}
+
 // WriteConfig writes the config to the given path.
 // Note: it does not use viper.WriteConfigAs because we do not want to store flag values in the config.
 func (s *Server[T]) WriteConfig(configPath string) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -96,3 +97,72 @@ func TestServer(t *testing.T) {
 	err = server.Start(ctx)
 	require.NoError(t, err)
 }
+
+// stopRecorder records the order in which the components and the app are stopped.
+type stopRecorder struct {
+	mu      sync.Mutex
+	stopped []string
+}
+
+func (r *stopRecorder) record(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stopped = append(r.stopped, name)
+}
+
+type mockClosableApp[T transaction.Tx] struct {
+	mockApp[T]
+	recorder *stopRecorder
+}
+
+func (a *mockClosableApp[T]) Close() error {
+	a.recorder.record("app")
+	return nil
+}
+
+type mockStopServer struct {
+	mockServer
+	recorder *stopRecorder
+	drained  bool
+}
+
+func (s *mockStopServer) Start(context.Context) error { return nil }
+
+func (s *mockStopServer) Stop(ctx context.Context) error {
+	// in-flight requests are drained until the drain timeout
+	<-ctx.Done()
+	s.drained = true
+	s.recorder.record(s.name)
+	return nil
+}
+
+type mockConsensusServer struct {
+	mockStopServer
+}
+
+func (s *mockConsensusServer) IsConsensusComponent() {}
+
+func (s *mockConsensusServer) Stop(ctx context.Context) error {
+	s.recorder.record(s.name)
+	return ctx.Err()
+}
+
+func TestServerStop(t *testing.T) {
+	recorder := &stopRecorder{}
+	consensus := &mockConsensusServer{mockStopServer{mockServer: mockServer{name: "consensus"}, recorder: recorder}}
+	api := &mockStopServer{mockServer: mockServer{name: "api"}, recorder: recorder}
+
+	v := viper.New()
+	v.Set(serverv2.FlagShutdownDrainTimeout, 100*time.Millisecond)
+
+	server := serverv2.NewServer[transaction.Tx](log.NewNopLogger(), consensus, api)
+	require.NoError(t, server.Init(&mockClosableApp[transaction.Tx]{recorder: recorder}, v, log.NewNopLogger()))
+
+	start := time.Now()
+	require.NoError(t, server.Stop(context.Background()))
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// the consensus is stopped once the requests are drained, and the app last
+	require.True(t, api.drained)
+	require.Equal(t, []string{"api", "consensus", "app"}, recorder.stopped)
+}
@@ -1,6 +1,12 @@
 package store
 
 import (
+	"fmt"
+	"sort"
+
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/db"
 	"cosmossdk.io/store/v2/root"
 )
 
@@ -15,3 +21,57 @@ type Config struct {
 	AppDBBackend string       `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
 	Options      root.Options `mapstructure:"options" toml:"options"`
 }
+
+// validate validates the store config, returning the issues keyed relative to
+// the store section.
+func (c *Config) validate() []serverv2.ConfigIssue {
+	var issues []serverv2.ConfigIssue
+	switch db.DBType(c.AppDBBackend) {
+	case db.DBTypeGoLevelDB, db.DBTypePebbleDB:
+	default:
+		issues = append(issues, serverv2.ConfigIssue{
+			Key:   FlagAppDBBackend,
+			Error: fmt.Sprintf("unsupported database type %q, expected goleveldb or pebbledb", c.AppDBBackend),
+		})
+	}
+
+	switch c.Options.SSType {
+	case root.SSTypeSQLite, root.SSTypePebble, root.SSTypeRocks:
+	default:
+		issues = append(issues, serverv2.ConfigIssue{Key: "options.ss-type", Error: fmt.Sprintf("unsupported state storage type %d", c.Options.SSType)})
+	}
+	switch c.Options.SCType {
+	case root.SCTypeIavl, root.SCTypeIavlV2:
+	default:
+		issues = append(issues, serverv2.ConfigIssue{Key: "options.sc-type", Error: fmt.Sprintf("unsupported state commitment type %d", c.Options.SCType)})
+	}
+
+	for key, opt := range map[string]*storev2.PruningOption{
+		"options.ss-pruning-option": c.Options.SSPruningOption,
+		"options.sc-pruning-option": c.Options.SCPruningOption,
+	} {
+		if opt != nil && opt.Interval == 0 && opt.KeepRecent != 0 {
+			issues = append(issues, serverv2.ConfigIssue{
+				Key:     key + ".keep-recent",
+				Error:   "keep-recent is ignored as the interval is 0, which disables pruning",
+				Warning: true,
+			})
+		}
+	}
+
+	if ss, sc := c.Options.SSPruningOption, c.Options.SCPruningOption; pruned(sc) && (!pruned(ss) || sc.KeepRecent < ss.KeepRecent) {
+		issues = append(issues, serverv2.ConfigIssue{
+			Key:     "options.sc-pruning-option.keep-recent",
+			Error:   "the state commitment keeps fewer versions than the state storage, the proofs of the older queryable versions are not available",
+			Warning: true,
+		})
+	}
+
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Key < issues[j].Key })
+	return issues
+}
+
+// pruned returns true if the pruning option prunes the old versions.
+func pruned(opt *storev2.PruningOption) bool {
+	return opt != nil && opt.Interval > 0
+}
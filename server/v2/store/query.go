@@ -0,0 +1,219 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	crypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/store/types"
+	storev2 "cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+const (
+	// defaultIterateLimit is the number of pairs returned by Query/Iterate when
+	// no limit is given.
+	defaultIterateLimit = 100
+	// maxIterateLimit is the maximum number of pairs returned by Query/Iterate.
+	maxIterateLimit = 1000
+)
+
+// queryStore defines the store methods needed to read the state at a version.
+type queryStore interface {
+	GetLatestVersion() (uint64, error)
+	StateAt(version uint64) (corestore.ReaderMap, error)
+	Query(storeKey []byte, version uint64, key []byte, prove bool) (storev2.QueryResult, error)
+}
+
+var _ types.QueryServer = queryServer{}
+
+// queryServer implements the store Query service.
+type queryServer struct {
+	// store is set once the store component is initialized.
+	store func() queryStore
+}
+
+// RegisterServices implements the gRPC server Extension interface, serving the
+// store Query service on the gRPC server.
+func (s *StoreComponent[T]) RegisterServices(srv gogogrpc.Server) error {
+	types.RegisterQueryServer(srv, queryServer{store: func() queryStore { return s.store }})
+	return nil
+}
+
+// Get implements types.QueryServer.
+func (q queryServer) Get(_ context.Context, req *types.GetRequest) (*types.GetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StoreKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "store key cannot be empty")
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "key cannot be empty")
+	}
+
+	store, version, err := q.stateAt(req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := store.GetReader([]byte(req.StoreKey)); err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown store key %s", req.StoreKey)
+	}
+
+	res, err := q.store().Query([]byte(req.StoreKey), version, req.Key, req.Prove)
+	if err != nil {
+		return nil, queryError(version, err)
+	}
+
+	resp := &types.GetResponse{
+		Value:   res.Value,
+		Exists:  res.Value != nil,
+		Version: version,
+	}
+	if req.Prove {
+		resp.ProofOps = &crypto.ProofOps{Ops: make([]crypto.ProofOp, 0, len(res.ProofOps))}
+		for _, op := range res.ProofOps {
+			bz, err := op.Proof.Marshal()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to marshal proof: %v", err)
+			}
+
+			resp.ProofOps.Ops = append(resp.ProofOps.Ops, crypto.ProofOp{
+				Type: op.Type,
+				Key:  op.Key,
+				Data: bz,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// Iterate implements types.QueryServer.
+func (q queryServer) Iterate(_ context.Context, req *types.IterateRequest) (*types.IterateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StoreKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "store key cannot be empty")
+	}
+	if len(req.StartKey) > 0 && !bytes.HasPrefix(req.StartKey, req.Prefix) {
+		return nil, status.Error(codes.InvalidArgument, "start key must start with the prefix")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultIterateLimit
+	}
+	if limit > maxIterateLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d exceeds the maximum of %d", limit, maxIterateLimit)
+	}
+
+	store, version, err := q.stateAt(req.Version)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := store.GetReader([]byte(req.StoreKey))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown store key %s", req.StoreKey)
+	}
+
+	var iter corestore.Iterator
+	if req.Reverse {
+		end := prefixEnd(req.Prefix)
+		if len(req.StartKey) > 0 {
+			// the start key is inclusive, while the end of the domain is not
+			end = append(bytes.Clone(req.StartKey), 0)
+		}
+		iter, err = reader.ReverseIterator(prefixStart(req.Prefix), end)
+	} else {
+		start := req.Prefix
+		if len(req.StartKey) > 0 {
+			start = req.StartKey
+		}
+		iter, err = reader.Iterator(prefixStart(start), prefixEnd(req.Prefix))
+	}
+	if err != nil {
+		return nil, queryError(version, err)
+	}
+	defer iter.Close()
+
+	resp := &types.IterateResponse{Version: version}
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(resp.Pairs)) == limit {
+			resp.NextKey = iter.Key()
+			break
+		}
+
+		resp.Pairs = append(resp.Pairs, &types.KVPair{Key: iter.Key(), Value: iter.Value()})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, queryError(version, err)
+	}
+
+	return resp, nil
+}
+
+// stateAt returns the state at version, or at the latest version if version is 0.
+func (q queryServer) stateAt(version uint64) (corestore.ReaderMap, uint64, error) {
+	store := q.store()
+	if store == nil {
+		return nil, 0, status.Error(codes.Unavailable, "store is not initialized")
+	}
+
+	latest, err := store.GetLatestVersion()
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to get latest version: %v", err)
+	}
+	if version == 0 {
+		version = latest
+	}
+	if version > latest {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "version %d is greater than the latest version %d", version, latest)
+	}
+
+	state, err := store.StateAt(version)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.NotFound, "version %d is not available: %v", version, err)
+	}
+
+	return state, version, nil
+}
+
+// queryError converts a store error to a gRPC status error.
+func queryError(version uint64, err error) error {
+	var errPruned storeerrors.ErrVersionPruned
+	if errors.As(err, &errPruned) {
+		return status.Error(codes.NotFound, errPruned.Error())
+	}
+
+	return status.Errorf(codes.Internal, "failed to read version %d: %v", version, err)
+}
+
+// prefixStart returns the start of the domain of the keys starting with prefix,
+// or nil if there is none, as the store iterators reject empty keys.
+func prefixStart(prefix []byte) []byte {
+	if len(prefix) == 0 {
+		return nil
+	}
+	return prefix
+}
+
+// prefixEnd returns the end of the domain of the keys starting with prefix, or
+// nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
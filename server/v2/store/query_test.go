@@ -0,0 +1,201 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/server/v2/store/types"
+	storev2 "cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+var _ queryStore = &mockQueryStore{}
+
+// mockQueryStore keeps the state of the bank store at every version, the
+// versions below earliest being pruned.
+type mockQueryStore struct {
+	latest   uint64
+	earliest uint64
+	versions map[uint64]coretesting.MemKV
+}
+
+func (s *mockQueryStore) GetLatestVersion() (uint64, error) { return s.latest, nil }
+
+func (s *mockQueryStore) StateAt(version uint64) (corestore.ReaderMap, error) {
+	if version < s.earliest {
+		return nil, storeerrors.ErrVersionPruned{RequestedVersion: version, EarliestVersion: s.earliest}
+	}
+	return mockReaderMap{s.versions[version]}, nil
+}
+
+func (s *mockQueryStore) Query(storeKey []byte, version uint64, key []byte, prove bool) (storev2.QueryResult, error) {
+	value, err := s.versions[version].Get(key)
+	return storev2.QueryResult{Key: key, Value: value, Version: version}, err
+}
+
+type mockReaderMap struct{ kv coretesting.MemKV }
+
+func (m mockReaderMap) GetReader(actor []byte) (corestore.Reader, error) {
+	if string(actor) != "bank" {
+		return nil, fmt.Errorf("unknown store key %s", actor)
+	}
+	return mockReader{m.kv}, nil
+}
+
+// mockReader wraps the iterators of the in-memory store, which report an error
+// once exhausted, unlike the store ones.
+type mockReader struct{ coretesting.MemKV }
+
+func (r mockReader) Iterator(start, end []byte) (corestore.Iterator, error) {
+	iter, err := r.MemKV.Iterator(start, end)
+	return mockIterator{iter}, err
+}
+
+func (r mockReader) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	iter, err := r.MemKV.ReverseIterator(start, end)
+	return mockIterator{iter}, err
+}
+
+type mockIterator struct{ corestore.Iterator }
+
+func (i mockIterator) Error() error {
+	if !i.Valid() {
+		return nil
+	}
+	return i.Iterator.Error()
+}
+
+func newMockQueryStore(t *testing.T) *mockQueryStore {
+	t.Helper()
+
+	s := &mockQueryStore{latest: 3, earliest: 2, versions: map[uint64]coretesting.MemKV{}}
+	for v := uint64(1); v <= s.latest; v++ {
+		kv := coretesting.NewMemKV()
+		for i := 0; i < 5; i++ {
+			require.NoError(t, kv.Set([]byte(fmt.Sprintf("balance/%d", i)), []byte(fmt.Sprintf("%d", v))))
+		}
+		require.NoError(t, kv.Set([]byte("supply"), []byte(fmt.Sprintf("%d", v))))
+		s.versions[v] = kv
+	}
+
+	return s
+}
+
+func TestQueryGet(t *testing.T) {
+	store := newMockQueryStore(t)
+	q := queryServer{store: func() queryStore { return store }}
+	ctx := context.Background()
+
+	res, err := q.Get(ctx, &types.GetRequest{StoreKey: "bank", Key: []byte("supply")})
+	require.NoError(t, err)
+	require.Equal(t, &types.GetResponse{Value: []byte("3"), Exists: true, Version: 3}, res)
+
+	res, err = q.Get(ctx, &types.GetRequest{StoreKey: "bank", Key: []byte("supply"), Version: 2})
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), res.Value)
+	require.Equal(t, uint64(2), res.Version)
+
+	res, err = q.Get(ctx, &types.GetRequest{StoreKey: "bank", Key: []byte("unknown")})
+	require.NoError(t, err)
+	require.False(t, res.Exists)
+
+	for name, tc := range map[string]struct {
+		req  *types.GetRequest
+		code codes.Code
+	}{
+		"no store key":      {&types.GetRequest{Key: []byte("supply")}, codes.InvalidArgument},
+		"no key":            {&types.GetRequest{StoreKey: "bank"}, codes.InvalidArgument},
+		"unknown store key": {&types.GetRequest{StoreKey: "staking", Key: []byte("supply")}, codes.NotFound},
+		"future version":    {&types.GetRequest{StoreKey: "bank", Key: []byte("supply"), Version: 4}, codes.InvalidArgument},
+		"pruned version":    {&types.GetRequest{StoreKey: "bank", Key: []byte("supply"), Version: 1}, codes.NotFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := q.Get(ctx, tc.req)
+			require.Equal(t, tc.code, status.Code(err), err)
+		})
+	}
+
+	_, err = queryServer{store: func() queryStore { return nil }}.Get(ctx, &types.GetRequest{StoreKey: "bank", Key: []byte("supply")})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestQueryIterate(t *testing.T) {
+	store := newMockQueryStore(t)
+	q := queryServer{store: func() queryStore { return store }}
+	ctx := context.Background()
+
+	keys := func(res *types.IterateResponse) []string {
+		var keys []string
+		for _, pair := range res.Pairs {
+			keys = append(keys, string(pair.Key))
+		}
+		return keys
+	}
+
+	res, err := q.Iterate(ctx, &types.IterateRequest{StoreKey: "bank"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"balance/0", "balance/1", "balance/2", "balance/3", "balance/4", "supply"}, keys(res))
+	require.Empty(t, res.NextKey)
+
+	// paginate over the prefix
+	req := &types.IterateRequest{StoreKey: "bank", Prefix: []byte("balance/"), Limit: 2, Version: 2}
+	res, err = q.Iterate(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"balance/0", "balance/1"}, keys(res))
+	require.Equal(t, []byte("2"), res.Pairs[0].Value)
+	require.Equal(t, []byte("balance/2"), res.NextKey)
+
+	req.StartKey = res.NextKey
+	res, err = q.Iterate(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"balance/2", "balance/3"}, keys(res))
+
+	req.StartKey = res.NextKey
+	res, err = q.Iterate(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"balance/4"}, keys(res))
+	require.Empty(t, res.NextKey)
+
+	// paginate in reverse
+	req = &types.IterateRequest{StoreKey: "bank", Prefix: []byte("balance/"), Limit: 3, Reverse: true}
+	res, err = q.Iterate(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"balance/4", "balance/3", "balance/2"}, keys(res))
+	require.Equal(t, []byte("balance/1"), res.NextKey)
+
+	req.StartKey = res.NextKey
+	res, err = q.Iterate(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"balance/1", "balance/0"}, keys(res))
+	require.Empty(t, res.NextKey)
+
+	for name, tc := range map[string]struct {
+		req  *types.IterateRequest
+		code codes.Code
+	}{
+		"no store key":         {&types.IterateRequest{}, codes.InvalidArgument},
+		"start key off prefix": {&types.IterateRequest{StoreKey: "bank", Prefix: []byte("balance/"), StartKey: []byte("supply")}, codes.InvalidArgument},
+		"limit too high":       {&types.IterateRequest{StoreKey: "bank", Limit: maxIterateLimit + 1}, codes.InvalidArgument},
+		"unknown store key":    {&types.IterateRequest{StoreKey: "staking"}, codes.NotFound},
+		"pruned version":       {&types.IterateRequest{StoreKey: "bank", Version: 1}, codes.NotFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := q.Iterate(ctx, tc.req)
+			require.Equal(t, tc.code, status.Code(err), err)
+		})
+	}
+}
+
+func TestPrefixEnd(t *testing.T) {
+	require.Nil(t, prefixEnd(nil))
+	require.Equal(t, []byte("b"), prefixEnd([]byte("a")))
+	require.Equal(t, []byte{0x01}, prefixEnd([]byte{0x00, 0xff}))
+	require.Nil(t, prefixEnd([]byte{0xff, 0xff}))
+}
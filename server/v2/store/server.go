@@ -13,9 +13,12 @@ import (
 )
 
 // StoreComponent manages store config
-// and contains prune & snapshot commands
+// and contains prune & snapshot commands.
+// It also serves the store Query service, reading the state at the versions kept
+// by the node, when registered as an extension of the gRPC server.
 type StoreComponent[T transaction.Tx] struct {
 	config *Config
+	store  queryStore
 }
 
 func New[T transaction.Tx]() *StoreComponent[T] {
@@ -30,6 +33,15 @@ func (s *StoreComponent[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger l
 		}
 	}
 	s.config = cfg
+
+	if appI != nil {
+		store, ok := appI.GetStore().(queryStore)
+		if !ok {
+			return fmt.Errorf("app store of type %T cannot be read by the store query service", appI.GetStore())
+		}
+		s.store = store
+	}
+
 	return nil
 }
 
@@ -45,6 +57,20 @@ func (s *StoreComponent[T]) Stop(ctx context.Context) error {
 	return nil
 }
 
+// ValidateConfig implements serverv2.HasConfigValidation.
+func (s *StoreComponent[T]) ValidateConfig(v *viper.Viper) []serverv2.ConfigIssue {
+	cfg := DefaultConfig()
+	if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+		return []serverv2.ConfigIssue{{Key: s.Name(), Error: err.Error()}}
+	}
+
+	issues := cfg.validate()
+	for i := range issues {
+		issues[i].Key = s.Name() + "." + issues[i].Key
+	}
+	return issues
+}
+
 func (s *StoreComponent[T]) GetCommands() []*cobra.Command {
 	return []*cobra.Command{
 		s.PrunesCmd(),
@@ -1,51 +1,96 @@
 package simapp
 
 import (
-	"errors"
-
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/ante/unorderedtx"
 	circuitante "cosmossdk.io/x/circuit/ante"
+	"cosmossdk.io/x/validate"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// FlagAnteDecorators is the app config listing the names of the decorators of
+// the AnteHandler, in order.
+const FlagAnteDecorators = "ante.decorators"
+
+// The names of the decorators registered by simapp in addition to the default
+// SDK ones.
+const (
+	CircuitBreakerName = "circuit-breaker"
+	UnorderedTxName    = "unordered-tx"
+)
+
+// DefaultAnteDecorators are the names of the decorators of the simapp
+// AnteHandler, in order, when none are configured.
+var DefaultAnteDecorators = []string{
+	validate.SetUpContextName,
+	CircuitBreakerName,
+	validate.ExtensionOptionsName,
+	validate.ValidateBasicName,
+	validate.TxTimeoutHeightName,
+	UnorderedTxName,
+	validate.ValidateMemoName,
+	validate.ConsumeGasForTxSizeName,
+	validate.DeductFeeName,
+	validate.ValidateSigCountName,
+	validate.SigVerificationName,
+}
+
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	ante.HandlerOptions
 	CircuitKeeper circuitante.CircuitBreaker
 	TxManager     *unorderedtx.Manager
+	// Decorators are the names of the enabled decorators, in order, e.g. read
+	// from the ante.decorators app config. DefaultAnteDecorators are enabled if
+	// empty.
+	Decorators []string
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
-	if options.AccountKeeper == nil {
-		return nil, errors.New("account keeper is required for ante builder")
+	anteDecorators, err := NewAnteDecorators(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// NewAnteDecorators returns the decorators composing the AnteHandler returned
+// by NewAnteHandler, so that they can be set on the app with SetAnteDecorators.
+func NewAnteDecorators(options HandlerOptions) ([]sdk.AnteDecorator, error) {
+	registry, err := NewAnteRegistry(options)
+	if err != nil {
+		return nil, err
+	}
+
+	names := options.Decorators
+	if len(names) == 0 {
+		names = DefaultAnteDecorators
 	}
 
-	if options.BankKeeper == nil {
-		return nil, errors.New("bank keeper is required for ante builder")
+	return registry.AnteDecorators(names)
+}
+
+// NewAnteRegistry returns the registry of the decorators which can be enabled
+// in the simapp AnteHandler, i.e. the default SDK ones along with the circuit
+// breaker and unordered transactions decorators.
+func NewAnteRegistry(options HandlerOptions) (*validate.Registry, error) {
+	registry, err := validate.NewRegistry(options.HandlerOptions)
+	if err != nil {
+		return nil, err
 	}
 
-	if options.SignModeHandler == nil {
-		return nil, errors.New("sign mode handler is required for ante builder")
+	if err := registry.RegisterAnte(CircuitBreakerName, circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper)); err != nil {
+		return nil, err
 	}
 
-	anteDecorators := []sdk.AnteDecorator{
-		ante.NewSetUpContextDecorator(options.Environment), // outermost AnteDecorator. SetUpContext must be called first
-		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
-		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
-		ante.NewValidateBasicDecorator(options.Environment),
-		ante.NewTxTimeoutHeightDecorator(options.Environment),
-		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.TxManager, options.Environment, ante.DefaultSha256Cost),
-		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+	if err := registry.RegisterAnte(UnorderedTxName, ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.TxManager, options.Environment, ante.DefaultSha256Cost)); err != nil {
+		return nil, err
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+	return registry, nil
 }
@@ -128,6 +128,14 @@ func NewSimApp(
 	var (
 		app        = &SimApp{}
 		appBuilder *runtime.AppBuilder
+		// For providing custom gas costs, globally or per module, set them below.
+		// By default the SDK gas costs (storetypes.KVGasConfig and the x/auth params) are used.
+		//
+		// &runtime.GasCosts{
+		// 	Modules:   map[string]storetypes.GasConfig{"bank": <- custom KV store gas config ->},
+		// 	SigVerify: map[string]uint64{"cosmos.crypto.secp256k1.PubKey": <- custom signature verification cost ->},
+		// }
+		gasCosts = &runtime.GasCosts{}
 
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
@@ -175,6 +183,9 @@ func NewSimApp(
 
 				// For providing a custom inflation function for x/mint add here your
 				// custom function that implements the minttypes.MintFn interface.
+
+				// supply the custom gas costs
+				gasCosts,
 			),
 		)
 	)
@@ -311,7 +322,7 @@ func NewSimApp(
 	}
 
 	// set custom ante handlers
-	app.setCustomAnteHandler()
+	app.setCustomAnteHandler(cast.ToStringSlice(appOpts.Get(FlagAnteDecorators)), gasCosts.SigVerify)
 
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
@@ -322,27 +333,35 @@ func NewSimApp(
 
 // overwrite default ante handlers with custom ante handlers
 // set SkipAnteHandler to true in app config and set custom ante handler on baseapp
-func (app *SimApp) setCustomAnteHandler() {
-	anteHandler, err := NewAnteHandler(
+func (app *SimApp) setCustomAnteHandler(decorators []string, sigVerifyCosts map[string]uint64) {
+	sigCache, err := ante.NewSignatureCache(ante.DefaultSignatureCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
+	anteDecorators, err := NewAnteDecorators(
 		HandlerOptions{
 			ante.HandlerOptions{
 				AccountKeeper:   app.AuthKeeper,
 				BankKeeper:      app.BankKeeper,
 				SignModeHandler: app.txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
-				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				SigGasConsumer:  ante.NewSigVerificationGasConsumer(sigVerifyCosts),
 				Environment:     app.AuthKeeper.Environment,
+				SignatureCache:  sigCache,
 			},
 			&app.CircuitBreakerKeeper,
 			app.UnorderedTxManager,
+			decorators,
 		},
 	)
 	if err != nil {
 		panic(err)
 	}
 
-	// Set the AnteHandler for the app
-	app.SetAnteHandler(anteHandler)
+	// Set the AnteHandler for the app, its decorators can be enumerated with
+	// app.AnteDecorators() and emit their own metrics
+	app.SetAnteDecorators(anteDecorators...)
 }
 
 // Close implements the Application interface and closes all necessary application
@@ -0,0 +1,167 @@
+//go:build sims
+
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	minttypes "cosmossdk.io/x/mint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutils/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+var (
+	// bridgeEscrowAddr holds the tokens transferred by the bridge until their transfer is acknowledged
+	bridgeEscrowAddr = authtypes.NewModuleAddress("bridge-escrow")
+	// bridgeLockedAddr holds the tokens of the acknowledged transfers
+	bridgeLockedAddr = authtypes.NewModuleAddress("bridge-locked")
+)
+
+// bridgeTransfer is the data of the transfer packets and of their acknowledgements.
+type bridgeTransfer struct {
+	Receiver sdk.AccAddress `json:"receiver"`
+	Amount   sdk.Coins      `json:"amount"`
+}
+
+// TestMultiAppBridge simulates two SimApp chains side by side with a token bridge between them.
+// The first chain escrows the transferred tokens, the second one mints vouchers of the tokens it
+// receives and acknowledges the transfers, and the first one locks the tokens of the acknowledged
+// transfers. At the end, the escrowed, locked and minted tokens must match the packets in flight.
+func TestMultiAppBridge(t *testing.T) {
+	sims.RunMultiApp(t, NewSimApp, setupStateFactory,
+		func(apps []sims.TestInstance[*SimApp]) []*simulation.BridgeEndpoint {
+			src, dst := apps[0].App, apps[1].App
+			srcOutbox, dstOutbox := &[]simulation.Packet{}, &[]simulation.Packet{}
+			return []*simulation.BridgeEndpoint{
+				{
+					Operations: simulation.WeightedOperations{
+						simulation.NewWeightedOperation(100, bridgeTransferOp(src, srcOutbox)),
+					},
+					SendPackets:   drainOutbox(srcOutbox),
+					ReceivePacket: bridgeAckOp(src),
+				},
+				{
+					SendPackets:   drainOutbox(dstOutbox),
+					ReceivePacket: bridgeReceiveOp(dst, dstOutbox),
+				},
+			}
+		},
+		func(t *testing.T, apps []sims.TestInstance[*SimApp], undelivered []simulation.Packet) {
+			t.Helper()
+			src, dst := apps[0].App, apps[1].App
+			srcCtx, dstCtx := src.NewContext(true), dst.NewContext(true)
+
+			inFlightTransfers, inFlightAcks := sdk.NewCoins(), sdk.NewCoins()
+			for _, packet := range undelivered {
+				var transfer bridgeTransfer
+				require.NoError(t, json.Unmarshal(packet.Data, &transfer))
+				if packet.Source == sims.MultiAppName(0) {
+					inFlightTransfers = inFlightTransfers.Add(transfer.Amount...)
+				} else {
+					inFlightAcks = inFlightAcks.Add(transfer.Amount...)
+				}
+			}
+
+			// the transfers which are not acknowledged yet are escrowed
+			escrowed := src.BankKeeper.GetAllBalances(srcCtx, bridgeEscrowAddr)
+			require.True(t, escrowed.Equal(inFlightTransfers.Add(inFlightAcks...)), "escrowed %s", escrowed)
+
+			// every received transfer was minted, and is either acknowledged or its acknowledgement is in flight
+			locked := src.BankKeeper.GetAllBalances(srcCtx, bridgeLockedAddr)
+			received := voucherCoin(sdk.NewCoin(sdk.DefaultBondDenom, locked.Add(inFlightAcks...).AmountOf(sdk.DefaultBondDenom)))
+			require.Equal(t, received, dst.BankKeeper.GetSupply(dstCtx, received.Denom))
+		},
+	)
+}
+
+// drainOutbox returns the packets sent by the operations of the block and empties the outbox.
+func drainOutbox(outbox *[]simulation.Packet) func(sdk.Context) ([]simulation.Packet, error) {
+	return func(sdk.Context) ([]simulation.Packet, error) {
+		packets := *outbox
+		*outbox = nil
+		return packets, nil
+	}
+}
+
+// voucherCoin returns the voucher minted by the second chain for a coin transferred by the first one.
+func voucherCoin(coin sdk.Coin) sdk.Coin {
+	return sdk.NewCoin("bridge/"+coin.Denom, coin.Amount)
+}
+
+// bridgeTransferOp escrows a random amount of bond tokens of a random account and sends a transfer
+// packet to the second chain.
+func bridgeTransferOp(app *SimApp, outbox *[]simulation.Packet) simtypes.Operation {
+	return func(r *rand.Rand, _ simtypes.AppEntrypoint, ctx sdk.Context, accs []simtypes.Account, _ string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		spendable := app.BankKeeper.SpendableCoins(ctx, acc.Address).AmountOf(sdk.DefaultBondDenom)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg("bridge", "transfer", "no spendable bond tokens"), nil, nil
+		}
+		amount := simtypes.RandomAmount(r, spendable)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg("bridge", "transfer", "zero amount"), nil, nil
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+		if err := app.BankKeeper.SendCoins(ctx, acc.Address, bridgeEscrowAddr, coins); err != nil {
+			return simtypes.NoOpMsg("bridge", "transfer", "escrow failed"), nil, err
+		}
+
+		data, err := json.Marshal(bridgeTransfer{Receiver: acc.Address, Amount: coins})
+		if err != nil {
+			return simtypes.NoOpMsg("bridge", "transfer", "invalid packet"), nil, err
+		}
+		*outbox = append(*outbox, simulation.Packet{Destination: sims.MultiAppName(1), Data: data})
+		return simtypes.NewOperationMsgBasic("bridge", "transfer", "", true, data), nil, nil
+	}
+}
+
+// bridgeReceiveOp mints the vouchers of a received transfer to its receiver and acknowledges it.
+func bridgeReceiveOp(app *SimApp, outbox *[]simulation.Packet) func(simulation.Packet) simtypes.Operation {
+	return func(packet simulation.Packet) simtypes.Operation {
+		return func(_ *rand.Rand, _ simtypes.AppEntrypoint, ctx sdk.Context, _ []simtypes.Account, _ string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+			var transfer bridgeTransfer
+			if err := json.Unmarshal(packet.Data, &transfer); err != nil {
+				return simtypes.NoOpMsg("bridge", "receive", "invalid packet"), nil, err
+			}
+
+			vouchers := sdk.NewCoins()
+			for _, coin := range transfer.Amount {
+				vouchers = vouchers.Add(voucherCoin(coin))
+			}
+			if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, vouchers); err != nil {
+				return simtypes.NoOpMsg("bridge", "receive", "mint failed"), nil, err
+			}
+			if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, transfer.Receiver, vouchers); err != nil {
+				return simtypes.NoOpMsg("bridge", "receive", "voucher transfer failed"), nil, err
+			}
+
+			*outbox = append(*outbox, simulation.Packet{Destination: packet.Source, Data: packet.Data})
+			return simtypes.NewOperationMsgBasic("bridge", "receive", "", true, packet.Data), nil, nil
+		}
+	}
+}
+
+// bridgeAckOp locks the escrowed tokens of an acknowledged transfer.
+func bridgeAckOp(app *SimApp) func(simulation.Packet) simtypes.Operation {
+	return func(packet simulation.Packet) simtypes.Operation {
+		return func(_ *rand.Rand, _ simtypes.AppEntrypoint, ctx sdk.Context, _ []simtypes.Account, _ string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+			var transfer bridgeTransfer
+			if err := json.Unmarshal(packet.Data, &transfer); err != nil {
+				return simtypes.NoOpMsg("bridge", "acknowledge", "invalid packet"), nil, err
+			}
+			if err := app.BankKeeper.SendCoins(ctx, bridgeEscrowAddr, bridgeLockedAddr, transfer.Amount); err != nil {
+				return simtypes.NoOpMsg("bridge", "acknowledge", "unlock failed"), nil, err
+			}
+			return simtypes.NewOperationMsgBasic("bridge", "acknowledge", "", true, packet.Data), nil, nil
+		}
+	}
+}
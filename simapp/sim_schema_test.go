@@ -0,0 +1,219 @@
+//go:build sims
+
+package simapp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/schema"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutils/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestAppSchemaInvariants runs the simulation checking invariants of the bank and staking state,
+// indexed with the schemas of these modules, after every block.
+func TestAppSchemaInvariants(t *testing.T) {
+	sims.Run(t, NewSimApp, func(app *SimApp) sims.SimStateFactory {
+		stateFactory := setupStateFactory(app)
+		stateFactory.SchemaCodecs = map[string]schema.ModuleCodec{
+			banktypes.StoreKey:    bankSchemaCodec(),
+			stakingtypes.StoreKey: stakingSchemaCodec(app),
+		}
+		stateFactory.SchemaInvariants = []sims.SchemaInvariant{
+			{Name: "bank/supply-conservation", Check: supplyConservationInvariant},
+			{Name: "staking/delegation-shares", Check: delegationSharesInvariant},
+		}
+		return stateFactory
+	})
+}
+
+// maxReportedViolations is the maximum number of objects listed in the report of a broken invariant.
+const maxReportedViolations = 10
+
+// bankSchemaCodec decodes the balances and the supply, with the codecs of the bank keeper collections,
+// which the keeper interface of the app doesn't expose.
+func bankSchemaCodec() schema.ModuleCodec {
+	amount := schema.Field{Name: "amount", Kind: schema.IntegerStringKind}
+	denom := schema.Field{Name: "denom", Kind: schema.StringKind}
+	return sims.NewModuleCodec(
+		[]schema.ObjectType{
+			{
+				Name:        "balance",
+				KeyFields:   []schema.Field{{Name: "address", Kind: schema.Bech32AddressKind, AddressPrefix: sdk.GetConfig().GetBech32AccountAddrPrefix()}, denom},
+				ValueFields: []schema.Field{amount},
+			},
+			{
+				Name:        "supply",
+				KeyFields:   []schema.Field{denom},
+				ValueFields: []schema.Field{amount},
+			},
+		},
+		sims.CollectionDecoder("balance", banktypes.BalancesPrefix.Bytes(),
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), banktypes.BalanceValueCodec,
+			func(key collections.Pair[sdk.AccAddress, string]) any {
+				return []any{[]byte(key.K1()), key.K2()}
+			},
+			func(amount math.Int) any { return amount.String() },
+		),
+		sims.CollectionDecoder("supply", banktypes.SupplyKey.Bytes(),
+			collections.StringKey, sdk.IntValue,
+			func(denom string) any { return denom },
+			func(amount math.Int) any { return amount.String() },
+		),
+	)
+}
+
+// stakingSchemaCodec decodes the validators and the delegations.
+func stakingSchemaCodec(app *SimApp) schema.ModuleCodec {
+	validatorField := schema.Field{Name: "validator", Kind: schema.Bech32AddressKind, AddressPrefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix()}
+	return sims.NewModuleCodec(
+		[]schema.ObjectType{
+			{
+				Name:      "validator",
+				KeyFields: []schema.Field{validatorField},
+				ValueFields: []schema.Field{
+					{Name: "tokens", Kind: schema.IntegerStringKind},
+					{Name: "delegator_shares", Kind: schema.DecimalStringKind},
+				},
+			},
+			{
+				Name:        "delegation",
+				KeyFields:   []schema.Field{{Name: "delegator", Kind: schema.Bech32AddressKind, AddressPrefix: sdk.GetConfig().GetBech32AccountAddrPrefix()}, validatorField},
+				ValueFields: []schema.Field{{Name: "shares", Kind: schema.DecimalStringKind}},
+			},
+		},
+		sims.CollectionDecoder("validator", app.StakingKeeper.Validators.GetPrefix(),
+			app.StakingKeeper.Validators.KeyCodec(), app.StakingKeeper.Validators.ValueCodec(),
+			func(operator []byte) any { return operator },
+			func(validator stakingtypes.Validator) any {
+				return []any{validator.Tokens.String(), validator.DelegatorShares.String()}
+			},
+		),
+		sims.CollectionDecoder("delegation", app.StakingKeeper.Delegations.GetPrefix(),
+			app.StakingKeeper.Delegations.KeyCodec(), app.StakingKeeper.Delegations.ValueCodec(),
+			func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) any {
+				return []any{[]byte(key.K1()), []byte(key.K2())}
+			},
+			func(delegation stakingtypes.Delegation) any { return delegation.Shares.String() },
+		),
+	)
+}
+
+// supplyConservationInvariant checks that the supply of every denom is the sum of its balances.
+func supplyConservationInvariant(state *sims.IndexedState) error {
+	balances := map[string]math.Int{}
+	state.Iterate(banktypes.StoreKey, "balance", func(object sims.IndexedObject) bool {
+		denom := object.Key.([]any)[1].(string)
+		sum, ok := balances[denom]
+		if !ok {
+			sum = math.ZeroInt()
+		}
+		balances[denom] = sum.Add(mustParseInt(object.Value.(string)))
+		return true
+	})
+
+	supplies := map[string]math.Int{}
+	state.Iterate(banktypes.StoreKey, "supply", func(object sims.IndexedObject) bool {
+		supplies[object.Key.(string)] = mustParseInt(object.Value.(string))
+		return true
+	})
+
+	var violations []string
+	for _, denom := range sortedKeys(balances, supplies) {
+		supply, balance := zeroIfMissing(supplies, denom), zeroIfMissing(balances, denom)
+		if !supply.Equal(balance) {
+			violations = append(violations, fmt.Sprintf("denom %s: supply %s, sum of balances %s", denom, supply, balance))
+		}
+	}
+	return violationsError(violations)
+}
+
+// delegationSharesInvariant checks that the delegator shares of every validator are the sum of the
+// shares of its delegations.
+func delegationSharesInvariant(state *sims.IndexedState) error {
+	shares := map[string]math.LegacyDec{}
+	state.Iterate(stakingtypes.StoreKey, "delegation", func(object sims.IndexedObject) bool {
+		validator := string(object.Key.([]any)[1].([]byte))
+		sum, ok := shares[validator]
+		if !ok {
+			sum = math.LegacyZeroDec()
+		}
+		shares[validator] = sum.Add(math.LegacyMustNewDecFromStr(object.Value.(string)))
+		return true
+	})
+
+	var violations []string
+	state.Iterate(stakingtypes.StoreKey, "validator", func(object sims.IndexedObject) bool {
+		operator := object.Key.([]byte)
+		delegatorShares := math.LegacyMustNewDecFromStr(object.Value.([]any)[1].(string))
+		sum, ok := shares[string(operator)]
+		if !ok {
+			sum = math.LegacyZeroDec()
+		}
+		if !delegatorShares.Equal(sum) {
+			violations = append(violations, fmt.Sprintf("validator %s: delegator shares %s, sum of delegation shares %s",
+				sdk.ValAddress(operator), delegatorShares, sum))
+		}
+		delete(shares, string(operator))
+		return true
+	})
+
+	// the remaining shares are delegated to validators which don't exist
+	for _, operator := range sortedKeys(shares, nil) {
+		violations = append(violations, fmt.Sprintf("validator %s: not found, sum of delegation shares %s",
+			sdk.ValAddress(operator), shares[operator]))
+	}
+	return violationsError(violations)
+}
+
+func mustParseInt(s string) math.Int {
+	i, ok := math.NewIntFromString(s)
+	if !ok {
+		panic(fmt.Sprintf("invalid integer %q", s))
+	}
+	return i
+}
+
+func zeroIfMissing(amounts map[string]math.Int, denom string) math.Int {
+	if amount, ok := amounts[denom]; ok {
+		return amount
+	}
+	return math.ZeroInt()
+}
+
+// sortedKeys returns the union of the keys of the maps in sorted order.
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := map[string]bool{}
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// violationsError returns an error listing the violations of an invariant, or nil if there are none.
+func violationsError(violations []string) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	report := violations
+	if len(report) > maxReportedViolations {
+		report = append(report[:maxReportedViolations:maxReportedViolations], fmt.Sprintf("... and %d more", len(violations)-maxReportedViolations))
+	}
+	return fmt.Errorf("%d violations:\n\t%s", len(violations), strings.Join(report, "\n\t"))
+}
@@ -147,6 +147,15 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	})
 }
 
+// TestAppReplayTrace replays the trace recorded by a simulation run with -TracePath, to find the first block
+// of which the app hash differs with the current build of the app.
+func TestAppReplayTrace(t *testing.T) {
+	if simcli.FlagReplayTraceValue == "" {
+		t.Skip("no trace to replay, set one with -ReplayTrace")
+	}
+	sims.Replay(t, NewSimApp, simcli.FlagReplayTraceValue)
+}
+
 func IsEmptyValidatorSetErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "validator set is empty after InitGenesis")
 }
@@ -5,6 +5,8 @@ import (
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 
+	"cosmossdk.io/simapp"
+
 	clientconfig "github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -81,10 +83,16 @@ func initAppConfig() (string, interface{}) {
 		CustomField string `mapstructure:"custom-field"`
 	}
 
+	// AnteConfig defines the decorators of the AnteHandler of simapp.
+	type AnteConfig struct {
+		Decorators []string `mapstructure:"decorators"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
 
 		Custom CustomConfig `mapstructure:"custom"`
+		Ante   AnteConfig   `mapstructure:"ante"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -111,6 +119,9 @@ func initAppConfig() (string, interface{}) {
 		Custom: CustomConfig{
 			CustomField: "anything",
 		},
+		Ante: AnteConfig{
+			Decorators: simapp.DefaultAnteDecorators,
+		},
 	}
 
 	// The default SDK app template is defined in serverconfig.DefaultConfigTemplate.
@@ -120,7 +131,13 @@ func initAppConfig() (string, interface{}) {
 [custom]
 # That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
 # Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
+custom-field = "{{ .Custom.CustomField }}"
+
+[ante]
+# The names of the decorators of the AnteHandler, in order, i.e. the checks run
+# on the transactions before their execution. The default decorators are used
+# if empty. Custom decorators must be registered by the app to be enabled.
+decorators = [{{ range .Ante.Decorators }}{{ printf "%q, " . }}{{end}}]`
 
 	return customAppTemplate, customAppConfig
 }
@@ -92,7 +92,10 @@ func NewSimApp[T transaction.Tx](
 	logger log.Logger,
 	viper *viper.Viper,
 ) *SimApp[T] {
-	viper.Set(serverv2.FlagHome, DefaultNodeHome) // TODO possibly set earlier when viper is created
+	if viper.GetString(serverv2.FlagHome) == "" {
+		// the home is set by the start command, or by the test network for the in-process nodes
+		viper.Set(serverv2.FlagHome, DefaultNodeHome)
+	}
 	var (
 		app        = &SimApp[T]{}
 		appBuilder *runtime.AppBuilder[T]
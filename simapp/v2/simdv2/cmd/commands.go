@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/log"
 	runtimev2 "cosmossdk.io/runtime/v2"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/api/diagnostics"
 	"cosmossdk.io/server/v2/api/grpc"
 	"cosmossdk.io/server/v2/cometbft"
 	"cosmossdk.io/server/v2/store"
@@ -70,14 +71,18 @@ func initRootCmd[T transaction.Tx](
 		offchain.OffChain(),
 	)
 
-	// wire server commands
+	// wire server commands, the store and CometBFT components serving their
+	// Query and changelog services on the gRPC server
+	storeComponent := store.New[T]()
+	cometBFTServer := cometbft.New(&genericTxDecoder[T]{txConfig}, cometbft.DefaultServerOptions[T]())
 	if err = serverv2.AddCommands(
 		rootCmd,
 		newApp,
 		logger,
-		cometbft.New(&genericTxDecoder[T]{txConfig}, cometbft.DefaultServerOptions[T]()),
-		grpc.New[T](),
-		store.New[T](),
+		cometBFTServer,
+		grpc.New[T]().WithExtensions(storeComponent, cometBFTServer),
+		storeComponent,
+		diagnostics.New[T](),
 	); err != nil {
 		panic(err)
 	}
@@ -148,6 +153,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
 		authcmd.GetValidateSignaturesCommand(),
+		authcmd.GetSigningPackageCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
@@ -0,0 +1,39 @@
+/*
+Package network implements and exposes a fully operational in-process test
+network of server/v2 nodes, consisting of one or many validators. It replaces
+the testutil/network package of the Cosmos SDK, which only supports baseapp
+applications, for the integration tests of server/v2 applications.
+
+Every validator runs the same components as a node started with the start
+command: the CometBFT server, producing blocks with the other validators over
+P2P and serving the CometBFT RPC, the gRPC server, serving the app queries, and
+the store component. The application is created by the AppCreator of the
+network config, SimApp v2 by default, with its own home directory, database and
+configuration.
+
+Unlike the legacy test network, all validators expose their RPC and gRPC
+servers. A Validator provides a client.Context connected to them, with the
+keyring of the validator account, which can be used with the client/v2 and
+autocli commands or to query the app directly over gRPC. Several networks can
+run at the same time, as all ports are chosen at random.
+
+A typical testing flow might look like the following:
+
+	func TestIntegration(t *testing.T) {
+		cfg, err := network.DefaultConfig()
+		require.NoError(t, err)
+		cfg.NumValidators = 2
+
+		n, err := network.New(t, t.TempDir(), cfg)
+		require.NoError(t, err)
+		defer n.Cleanup()
+
+		_, err = n.WaitForHeight(2)
+		require.NoError(t, err)
+
+		val := n.Validators[0]
+		res, err := banktypes.NewQueryClient(val.GetClientCtx()).Balance(context.Background(), &banktypes.QueryBalanceRequest{...})
+		// ...
+	}
+*/
+package network
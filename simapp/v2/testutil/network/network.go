@@ -0,0 +1,376 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/math/unsafe"
+	"cosmossdk.io/runtime/v2"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/simapp/v2"
+	authtx "cosmossdk.io/x/auth/tx"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+)
+
+// Config defines the necessary configuration used to bootstrap and start an
+// in-process test network of server/v2 nodes.
+type Config struct {
+	Codec             codec.Codec
+	InterfaceRegistry codectypes.InterfaceRegistry
+
+	TxConfig         client.TxConfig
+	AccountRetriever client.AccountRetriever
+	AppCreator       serverv2.AppCreator[transaction.Tx] // the app constructor, called with the viper of every validator
+	GenesisState     map[string]json.RawMessage          // custom genesis state to provide
+	TimeoutCommit    time.Duration                       // the consensus commitment timeout
+	ChainID          string                              // the network chain-id
+	NumValidators    int                                 // the total number of validators to create and bond
+	BondDenom        string                              // the staking bond denomination
+	AccountTokens    sdkmath.Int                         // the amount of unique validator tokens (e.g. 1000node0)
+	StakingTokens    sdkmath.Int                         // the amount of tokens each validator has available to stake
+	BondedTokens     sdkmath.Int                         // the amount of tokens each validator stakes
+	EnableLogging    bool                                // enable logging to STDOUT
+	CleanupDir       bool                                // remove base temporary directory during cleanup
+	SigningAlgo      string                              // signing algorithm for keys
+
+	AddressCodec          address.Codec                 // address codec
+	ValidatorAddressCodec address.ValidatorAddressCodec // validator address codec
+	ConsensusAddressCodec address.ConsensusAddressCodec // consensus address codec
+}
+
+// DefaultConfig returns a sane default configuration suitable for nearly all
+// testing requirements, running SimApp v2 on every validator.
+func DefaultConfig() (Config, error) {
+	var (
+		moduleManager         *runtime.MM[transaction.Tx]
+		cdc                   codec.Codec
+		interfaceRegistry     codectypes.InterfaceRegistry
+		txConfigOpts          authtx.ConfigOptions
+		addressCodec          address.Codec
+		validatorAddressCodec address.ValidatorAddressCodec
+		consensusAddressCodec address.ConsensusAddressCodec
+	)
+
+	if err := depinject.Inject(
+		depinject.Configs(
+			simapp.AppConfig(),
+			depinject.Supply(log.NewNopLogger()),
+			depinject.Provide(
+				codec.ProvideInterfaceRegistry,
+				codec.ProvideAddressCodec,
+				codec.ProvideProtoCodec,
+				codec.ProvideLegacyAmino,
+			),
+			depinject.Invoke(
+				std.RegisterInterfaces,
+				std.RegisterLegacyAminoCodec,
+			),
+		),
+		&moduleManager,
+		&cdc,
+		&interfaceRegistry,
+		&txConfigOpts,
+		&addressCodec,
+		&validatorAddressCodec,
+		&consensusAddressCodec,
+	); err != nil {
+		return Config{}, err
+	}
+
+	txConfig, err := authtx.NewTxConfigWithOptions(cdc, txConfigOpts)
+	if err != nil {
+		return Config{}, err
+	}
+
+	return Config{
+		Codec:             cdc,
+		InterfaceRegistry: interfaceRegistry,
+		TxConfig:          txConfig,
+		AccountRetriever:  authtypes.AccountRetriever{},
+		AppCreator: func(logger log.Logger, v *viper.Viper) serverv2.AppI[transaction.Tx] {
+			return simapp.NewSimApp[transaction.Tx](logger, v)
+		},
+		GenesisState:          moduleManager.DefaultGenesis(),
+		TimeoutCommit:         2 * time.Second,
+		ChainID:               "chain-" + unsafe.Str(6),
+		NumValidators:         4,
+		BondDenom:             sdk.DefaultBondDenom,
+		AccountTokens:         sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction),
+		StakingTokens:         sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction),
+		BondedTokens:          sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
+		CleanupDir:            true,
+		SigningAlgo:           string(hd.Secp256k1Type),
+		AddressCodec:          addressCodec,
+		ValidatorAddressCodec: validatorAddressCodec,
+		ConsensusAddressCodec: consensusAddressCodec,
+	}, nil
+}
+
+type (
+	// Network defines a local in-process testing network of server/v2 nodes.
+	// Every validator runs its own app, CometBFT node and gRPC server, and
+	// exposes a client.Context connected to them.
+	Network struct {
+		Logger     Logger
+		BaseDir    string
+		Validators []*Validator
+
+		Config Config
+	}
+
+	// Logger is a network logger interface that exposes testnet-level Log() methods for an in-process testing network
+	// This is not to be confused with logging that may happen at an individual node or validator level
+	Logger interface {
+		Log(args ...interface{})
+		Logf(format string, args ...interface{})
+	}
+)
+
+// New creates and starts a new Network. The validators share the same genesis,
+// with an account and a gentx for each of them, and connect to each other as
+// persistent peers.
+func New(l Logger, baseDir string, cfg Config) (*Network, error) {
+	if cfg.NumValidators < 1 {
+		return nil, errors.New("the network must have at least one validator")
+	}
+
+	network := &Network{
+		Logger:     l,
+		BaseDir:    baseDir,
+		Validators: make([]*Validator, cfg.NumValidators),
+		Config:     cfg,
+	}
+
+	l.Logf("preparing test network with chain-id \"%s\"\n", cfg.ChainID)
+
+	gentxsDir := filepath.Join(baseDir, "gentxs")
+	cmtConfigs, err := network.initValidators(gentxsDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := initGenFiles(cfg, network.Validators, cmtConfigs); err != nil {
+		return nil, err
+	}
+	if err := collectGenFiles(cfg, network.Validators, cmtConfigs, gentxsDir); err != nil {
+		return nil, err
+	}
+
+	l.Log("starting test network...")
+	for _, val := range network.Validators {
+		if err := val.start(cfg); err != nil {
+			network.Cleanup()
+			return nil, fmt.Errorf("failed to start validator %s: %w", val.moniker, err)
+		}
+	}
+
+	l.Log("started test network")
+	return network, nil
+}
+
+// initValidators creates the home directory, the keys, the gentx and the
+// configuration of every validator, and returns their CometBFT configuration.
+func (n *Network) initValidators(gentxsDir string) ([]*cmtcfg.Config, error) {
+	cfg := n.Config
+	cmtConfigs := make([]*cmtcfg.Config, cfg.NumValidators)
+
+	for i := 0; i < cfg.NumValidators; i++ {
+		moniker := fmt.Sprintf("node%d", i)
+		nodeDir := filepath.Join(n.BaseDir, moniker, "simdv2")
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm); err != nil {
+			return nil, err
+		}
+
+		var addresses [3]string // rpc, p2p and grpc
+		for j := range addresses {
+			addr, err := freeTCPAddr()
+			if err != nil {
+				return nil, err
+			}
+			addresses[j] = addr
+		}
+
+		cmtCfg := cmtcfg.DefaultConfig()
+		cmtCfg.SetRoot(nodeDir)
+		cmtCfg.Moniker = moniker
+		cmtCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
+		cmtCfg.RPC.ListenAddress = "tcp://" + addresses[0]
+		cmtCfg.P2P.ListenAddress = "tcp://" + addresses[1]
+		cmtCfg.P2P.AddrBookStrict = false
+		cmtCfg.P2P.PexReactor = false
+		cmtCfg.P2P.AllowDuplicateIP = true
+		cmtConfigs[i] = cmtCfg
+
+		nodeID, pubKey, err := genutil.InitializeNodeValidatorFiles(cmtCfg, cfg.SigningAlgo)
+		if err != nil {
+			return nil, err
+		}
+
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, nodeDir, nil, cfg.Codec)
+		if err != nil {
+			return nil, err
+		}
+
+		val := &Validator{
+			moniker:     moniker,
+			dir:         nodeDir,
+			nodeID:      nodeID,
+			pubKey:      pubKey,
+			rpcAddress:  cmtCfg.RPC.ListenAddress,
+			p2pAddress:  cmtCfg.P2P.ListenAddress,
+			grpcAddress: addresses[2],
+			keyring:     kb,
+		}
+		n.Validators[i] = val
+
+		// the memo of the gentx is used to set the persistent peers of the other validators
+		memo := fmt.Sprintf("%s@%s", nodeID, addresses[1])
+		if err := val.initAccount(cfg, memo, gentxsDir); err != nil {
+			return nil, err
+		}
+		if err := val.writeConfig(cfg, cmtCfg); err != nil {
+			return nil, err
+		}
+	}
+
+	return cmtConfigs, nil
+}
+
+// LatestHeight returns the latest height committed by all the validators, the
+// lowest of their heights, or an error if a query fails or no validators exist.
+func (n *Network) LatestHeight() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return n.latestHeight(ctx)
+}
+
+func (n *Network) latestHeight(ctx context.Context) (int64, error) {
+	if len(n.Validators) == 0 {
+		return 0, errors.New("no validators available")
+	}
+
+	var latestHeight int64
+	for i, val := range n.Validators {
+		height, err := val.latestHeight(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query the height of validator %s: %w", val.moniker, err)
+		}
+		if i == 0 || height < latestHeight {
+			latestHeight = height
+		}
+	}
+	return latestHeight, nil
+}
+
+// WaitForHeight performs a blocking check where it waits for a block to be
+// committed by all the validators after a given block, so that it can be
+// queried from any of them. If that height is not reached within a timeout,
+// an error is returned. Regardless, the latest height queried is returned.
+func (n *Network) WaitForHeight(h int64) (int64, error) {
+	return n.WaitForHeightWithTimeout(h, 10*time.Second)
+}
+
+// WaitForHeightWithTimeout is the same as WaitForHeight except the caller can
+// provide a custom timeout.
+func (n *Network) WaitForHeightWithTimeout(h int64, t time.Duration) (int64, error) {
+	if len(n.Validators) == 0 {
+		return 0, errors.New("no validators available")
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), t)
+	defer cancel()
+
+	var latestHeight int64
+	for {
+		select {
+		case <-ctx.Done():
+			return latestHeight, errors.New("timeout exceeded waiting for block")
+		case <-ticker.C:
+			height, err := n.latestHeight(ctx)
+			if err == nil {
+				latestHeight = height
+				if latestHeight >= h {
+					return latestHeight, nil
+				}
+			}
+		}
+	}
+}
+
+// RetryForBlocks will wait for the next block and execute the function provided.
+// It will do this until the function returns a nil error or until the number of
+// blocks has been reached.
+func (n *Network) RetryForBlocks(retryFunc func() error, blocks int) error {
+	for i := 0; i < blocks; i++ {
+		_ = n.WaitForNextBlock()
+		err := retryFunc()
+		if err == nil {
+			return nil
+		}
+		// we've reached the last block to wait, return the error
+		if i == blocks-1 {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitForNextBlock waits for the next block to be committed, returning an error
+// upon failure.
+func (n *Network) WaitForNextBlock() error {
+	lastBlock, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	_, err = n.WaitForHeight(lastBlock + 1)
+	return err
+}
+
+// Cleanup stops the servers of all validators, closes their apps and client
+// connections, then removes the root testing (temporary) directory if
+// configured to. This method must be called when a test is finished,
+// typically in a defer.
+func (n *Network) Cleanup() {
+	n.Logger.Log("cleaning up test network...")
+
+	for _, val := range n.Validators {
+		if val == nil {
+			continue
+		}
+		if err := val.stop(); err != nil {
+			n.Logger.Logf("failed to stop validator %s: %v\n", val.moniker, err)
+		}
+	}
+
+	if n.Config.CleanupDir {
+		_ = os.RemoveAll(n.BaseDir)
+	}
+
+	n.Logger.Log("finished cleaning up test network")
+}
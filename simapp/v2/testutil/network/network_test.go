@@ -0,0 +1,151 @@
+package network_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/math"
+	"cosmossdk.io/simapp/v2/testutil/network"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	cfg, err := network.DefaultConfig()
+	require.NoError(t, err)
+	cfg.NumValidators = 2
+	cfg.TimeoutCommit = 500 * time.Millisecond
+
+	n, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer n.Cleanup()
+
+	height, err := n.WaitForHeightWithTimeout(3, 30*time.Second)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, height, int64(3))
+
+	// every validator serves the app queries over gRPC
+	ctx := context.Background()
+	for _, val := range n.Validators {
+		addr, err := cfg.AddressCodec.BytesToString(val.GetAddress())
+		require.NoError(t, err)
+		res, err := banktypes.NewQueryClient(val.GetClientCtx()).Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: addr,
+			Denom:   val.GetMoniker() + "token",
+		})
+		require.NoError(t, err)
+		require.Equal(t, cfg.AccountTokens, res.Balance.Amount)
+	}
+
+	// the client/v2 commands query the validators over gRPC
+	for _, val := range n.Validators {
+		addr, err := cfg.AddressCodec.BytesToString(val.GetAddress())
+		require.NoError(t, err)
+		out, err := runBalanceQueryCommand(cfg, val, addr, val.GetMoniker()+"token")
+		require.NoError(t, err)
+		require.Contains(t, out, cfg.AccountTokens.String())
+	}
+
+	// a tx broadcast to the first validator is included in a block and applied by the second one
+	sender, recipient := n.Validators[0], n.Validators[1]
+	senderAddr, err := cfg.AddressCodec.BytesToString(sender.GetAddress())
+	require.NoError(t, err)
+	recipientAddr, err := cfg.AddressCodec.BytesToString(recipient.GetAddress())
+	require.NoError(t, err)
+
+	clientCtx := sender.GetClientCtx()
+	accRes, err := authtypes.NewQueryClient(clientCtx).AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: senderAddr})
+	require.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewCoin(sender.GetMoniker()+"token", math.NewInt(10)))
+	txBuilder := cfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(senderAddr, recipientAddr, amount)))
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+
+	txFactory := tx.Factory{}.
+		WithChainID(cfg.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(cfg.TxConfig).
+		WithAccountNumber(accRes.Info.AccountNumber).
+		WithSequence(accRes.Info.Sequence)
+	require.NoError(t, tx.Sign(ctx, txFactory, sender.GetMoniker(), txBuilder, true))
+	txBz, err := cfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	res, err := clientCtx.BroadcastTx(txBz)
+	require.NoError(t, err)
+	require.Zero(t, res.Code, res.RawLog)
+
+	require.NoError(t, n.RetryForBlocks(func() error {
+		res, err := banktypes.NewQueryClient(recipient.GetClientCtx()).Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: recipientAddr,
+			Denom:   sender.GetMoniker() + "token",
+		})
+		if err != nil {
+			return err
+		}
+		if !res.Balance.Amount.Equal(math.NewInt(10)) {
+			return errors.New("the tx is not applied yet")
+		}
+		return nil
+	}, 10))
+}
+
+// runBalanceQueryCommand runs the autocli command of the Balance query of the bank module against
+// a validator, and returns its output.
+func runBalanceQueryCommand(cfg network.Config, val *network.Validator, addr, denom string) (string, error) {
+	builder := &autocli.Builder{
+		Builder: flag.Builder{
+			TypeResolver:          protoregistry.GlobalTypes,
+			FileResolver:          cfg.InterfaceRegistry,
+			AddressCodec:          cfg.AddressCodec,
+			ValidatorAddressCodec: cfg.ValidatorAddressCodec,
+			ConsensusAddressCodec: cfg.ConsensusAddressCodec,
+		},
+		GetClientConn: func(*cobra.Command) (grpc.ClientConnInterface, error) {
+			return val.GetClientCtx(), nil
+		},
+		AddQueryConnFlags: flags.AddQueryFlagsToCmd,
+	}
+	if err := builder.ValidateAndComplete(); err != nil {
+		return "", err
+	}
+
+	descriptor := bankv1beta1.File_cosmos_bank_v1beta1_query_proto.Services().ByName("Query").Methods().ByName("Balance")
+	cmd, err := builder.BuildQueryMethodCommand(context.Background(), descriptor, &autocliv1.RpcCommandOptions{
+		RpcMethod:      "Balance",
+		Use:            "balance [address] [denom]",
+		PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{addr, denom, "--output", "json"})
+	if err := cmd.ExecuteContext(context.Background()); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,181 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"path/filepath"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttime "github.com/cometbft/cometbft/types/time"
+
+	"cosmossdk.io/core/transaction"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const nodeDirPerm = 0o755
+
+// initGenFiles writes the genesis file of every validator, with the default
+// genesis state of the config funding the accounts of the validators.
+func initGenFiles(cfg Config, vals []*Validator, cmtConfigs []*cmtcfg.Config) error {
+	// the genesis state of the config is shared by networks, do not modify it
+	appGenState := maps.Clone(cfg.GenesisState)
+
+	genAccounts := make([]authtypes.GenesisAccount, len(vals))
+	genBalances := make([]banktypes.Balance, len(vals))
+	for i, val := range vals {
+		addr, err := cfg.AddressCodec.BytesToString(val.address)
+		if err != nil {
+			return err
+		}
+		genAccounts[i] = authtypes.NewBaseAccount(val.address, nil, 0, 0)
+		genBalances[i] = banktypes.Balance{
+			Address: addr,
+			Coins: sdk.NewCoins(
+				sdk.NewCoin(fmt.Sprintf("%stoken", val.moniker), cfg.AccountTokens),
+				sdk.NewCoin(cfg.BondDenom, cfg.StakingTokens),
+			),
+		}
+	}
+
+	// set the accounts in the genesis state
+	var authGenState authtypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return err
+	}
+
+	authGenState.Accounts = append(authGenState.Accounts, accounts...)
+	appGenState[authtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&authGenState)
+
+	// set the balances in the genesis state
+	var bankGenState banktypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+
+	bankGenState.Balances, err = banktypes.SanitizeGenesisBalances(append(bankGenState.Balances, genBalances...), cfg.AddressCodec)
+	if err != nil {
+		return err
+	}
+	for _, bal := range genBalances {
+		bankGenState.Supply = bankGenState.Supply.Add(bal.Coins...)
+	}
+	appGenState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenState)
+
+	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(cfg.ChainID, appGenStateJSON)
+	// generate empty genesis files for each validator and save
+	for _, cmtCfg := range cmtConfigs {
+		if err := appGenesis.SaveAs(cmtCfg.GenesisFile()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectGenFiles delivers the gentxs in the genesis of every validator, and
+// sets the persistent peers of the validators from their memos.
+func collectGenFiles(cfg Config, vals []*Validator, cmtConfigs []*cmtcfg.Config, gentxsDir string) error {
+	var appState json.RawMessage
+	genTime := cmttime.Now()
+
+	for i, val := range vals {
+		cmtCfg := cmtConfigs[i]
+		initCfg := genutiltypes.NewInitConfig(cfg.ChainID, gentxsDir, val.nodeID, val.pubKey)
+
+		appGenesis, err := genutiltypes.AppGenesisFromFile(cmtCfg.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(cfg.Codec, cfg.TxConfig, cmtCfg, initCfg, appGenesis, genutiltypes.DefaultMessageValidator,
+			cfg.ValidatorAddressCodec, cfg.AddressCodec)
+		if err != nil {
+			return err
+		}
+
+		if appState == nil {
+			// set the canonical application state (they should not differ)
+			appState = nodeAppState
+		}
+
+		// overwrite each validator's genesis file to have a canonical genesis time
+		if err := genutil.ExportGenesisFileWithTime(cmtCfg.GenesisFile(), cfg.ChainID, nil, appState, genTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// freeTCPAddr returns a free local address, which is released for the node to
+// listen on it.
+func freeTCPAddr() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+
+	return l.Addr().String(), nil
+}
+
+func writeFile(name, dir string, contents []byte) error {
+	file := filepath.Join(dir, name)
+
+	if err := os.MkdirAll(dir, nodeDirPerm); err != nil {
+		return fmt.Errorf("could not create directory %q: %w", dir, err)
+	}
+
+	return os.WriteFile(file, contents, 0o600)
+}
+
+var _ transaction.Codec[transaction.Tx] = &txCodec{}
+
+// txCodec decodes the txs of the CometBFT server with the tx config of the network.
+type txCodec struct {
+	txConfig client.TxConfig
+}
+
+// Decode implements transaction.Codec.
+func (t *txCodec) Decode(bz []byte) (transaction.Tx, error) {
+	tx, err := t.txConfig.TxDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	out, ok := tx.(transaction.Tx)
+	if !ok {
+		return nil, errors.New("unexpected Tx type")
+	}
+	return out, nil
+}
+
+// DecodeJSON implements transaction.Codec.
+func (t *txCodec) DecodeJSON(bz []byte) (transaction.Tx, error) {
+	tx, err := t.txConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	out, ok := tx.(transaction.Tx)
+	if !ok {
+		return nil, errors.New("unexpected Tx type")
+	}
+	return out, nil
+}
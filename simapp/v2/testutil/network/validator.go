@@ -0,0 +1,305 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	serverv2 "cosmossdk.io/server/v2"
+	grpcserver "cosmossdk.io/server/v2/api/grpc"
+	"cosmossdk.io/server/v2/cometbft"
+	"cosmossdk.io/server/v2/store"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validator defines an in-process server/v2 validator node. Through this
+// object, a client can make RPC and gRPC calls and interact with any client
+// command or handler.
+type Validator struct {
+	moniker     string
+	dir         string
+	nodeID      string
+	pubKey      cryptotypes.PubKey
+	rpcAddress  string
+	p2pAddress  string
+	grpcAddress string
+	address     sdk.AccAddress
+	valAddress  sdk.ValAddress
+	keyring     keyring.Keyring
+
+	clientCtx   client.Context
+	viper       *viper.Viper
+	logger      log.Logger
+	app         serverv2.AppI[transaction.Tx]
+	server      *serverv2.Server[transaction.Tx]
+	cometServer *cometbft.CometBFTServer[transaction.Tx]
+	rpcClient   *rpchttp.HTTP
+	grpcConn    *grpc.ClientConn
+	errGroup    *errgroup.Group
+	cancelFn    context.CancelFunc
+}
+
+// GetMoniker returns the moniker of the validator, which is also the name of
+// its key in its keyring.
+func (v *Validator) GetMoniker() string {
+	return v.moniker
+}
+
+// GetHomeDir returns the home directory of the node, containing its
+// configuration, data and keyring.
+func (v *Validator) GetHomeDir() string {
+	return v.dir
+}
+
+func (v *Validator) GetNodeID() string {
+	return v.nodeID
+}
+
+func (v *Validator) GetPubKey() cryptotypes.PubKey {
+	return v.pubKey
+}
+
+func (v *Validator) GetAddress() sdk.AccAddress {
+	return v.address
+}
+
+func (v *Validator) GetValAddress() sdk.ValAddress {
+	return v.valAddress
+}
+
+func (v *Validator) GetRPCAddress() string {
+	return v.rpcAddress
+}
+
+func (v *Validator) GetP2PAddress() string {
+	return v.p2pAddress
+}
+
+func (v *Validator) GetGRPCAddress() string {
+	return v.grpcAddress
+}
+
+// GetClientCtx returns a client context connected to the gRPC server and the
+// CometBFT RPC of the validator, with its keyring.
+func (v *Validator) GetClientCtx() client.Context {
+	return v.clientCtx
+}
+
+// GetGRPCConn returns the client connection to the gRPC server of the validator.
+func (v *Validator) GetGRPCConn() *grpc.ClientConn {
+	return v.grpcConn
+}
+
+func (v *Validator) GetRPCClient() *rpchttp.HTTP {
+	return v.rpcClient
+}
+
+func (v *Validator) GetViper() *viper.Viper {
+	return v.viper
+}
+
+func (v *Validator) GetLogger() log.Logger {
+	return v.logger
+}
+
+// GetApp returns the app run by the validator.
+func (v *Validator) GetApp() serverv2.AppI[transaction.Tx] {
+	return v.app
+}
+
+// GetCometServer returns the CometBFT server of the validator, implementing
+// serverv2.ConsensusEngine.
+func (v *Validator) GetCometServer() *cometbft.CometBFTServer[transaction.Tx] {
+	return v.cometServer
+}
+
+// initAccount creates the key of the validator account in its keyring, and
+// writes the gentx creating the validator in the gentxs directory.
+func (v *Validator) initAccount(cfg Config, memo, gentxsDir string) error {
+	keyringAlgos, _ := v.keyring.SupportedAlgorithms()
+	algo, err := keyring.NewSigningAlgoFromString(cfg.SigningAlgo, keyringAlgos)
+	if err != nil {
+		return err
+	}
+
+	addr, _, err := testutil.GenerateSaveCoinKey(v.keyring, v.moniker, "", true, algo, sdk.GetFullBIP44Path())
+	if err != nil {
+		return err
+	}
+	v.address, v.valAddress = addr, sdk.ValAddress(addr)
+
+	valStr, err := cfg.ValidatorAddressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+	createValMsg, err := stakingtypes.NewMsgCreateValidator(
+		valStr,
+		v.pubKey,
+		sdk.NewCoin(cfg.BondDenom, cfg.BondedTokens),
+		stakingtypes.NewDescription(v.moniker, "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
+		sdkmath.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	txBuilder := cfg.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(createValMsg); err != nil {
+		return err
+	}
+	txBuilder.SetMemo(memo)
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+	txBuilder.SetFeePayer(addr)
+
+	txFactory := tx.Factory{}.
+		WithChainID(cfg.ChainID).
+		WithMemo(memo).
+		WithKeybase(v.keyring).
+		WithTxConfig(cfg.TxConfig)
+	if err := tx.Sign(context.Background(), txFactory, v.moniker, txBuilder, true); err != nil {
+		return err
+	}
+
+	txBz, err := cfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	return writeFile(fmt.Sprintf("%v.json", v.moniker), gentxsDir, txBz)
+}
+
+// writeConfig creates the server components of the validator and writes their
+// configuration, the config.toml and app.toml files, in the node home.
+func (v *Validator) writeConfig(cfg Config, cmtCfg *cmtcfg.Config) error {
+	storeComponent := store.New[transaction.Tx]()
+	cometServer := cometbft.New[transaction.Tx](
+		&txCodec{cfg.TxConfig},
+		cometbft.DefaultServerOptions[transaction.Tx](),
+		cometbft.OverwriteDefaultConfigTomlConfig(cmtCfg),
+	)
+	grpcConfig := grpcserver.DefaultConfig()
+	grpcConfig.Address = v.grpcAddress
+	grpcServer := grpcserver.New[transaction.Tx](grpcserver.OverwriteDefaultConfig(grpcConfig)).
+		WithExtensions(storeComponent, cometServer)
+
+	v.server = serverv2.NewServer[transaction.Tx](log.NewNopLogger(), cometServer, grpcServer, storeComponent)
+	v.cometServer = cometServer
+
+	return v.server.WriteConfig(filepath.Join(v.dir, "config"))
+}
+
+// start creates the app of the validator with the configuration of its home,
+// starts its servers and connects its clients to them.
+func (v *Validator) start(cfg Config) error {
+	var err error
+	v.viper, err = serverv2.ReadConfig(filepath.Join(v.dir, "config"))
+	if err != nil {
+		return err
+	}
+	v.viper.Set(serverv2.FlagHome, v.dir)
+
+	v.logger = log.NewNopLogger()
+	if cfg.EnableLogging {
+		v.logger = log.NewLogger(os.Stdout).With("validator", v.moniker)
+	}
+
+	v.app = cfg.AppCreator(v.logger, v.viper)
+	if err := v.server.Init(v.app, v.viper, v.logger); err != nil {
+		return err
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	v.cancelFn = cancelFn
+	v.errGroup, ctx = errgroup.WithContext(ctx)
+	v.errGroup.Go(func() error {
+		return v.server.Start(ctx)
+	})
+
+	v.rpcClient, err = client.NewClientFromNode(v.rpcAddress)
+	if err != nil {
+		return err
+	}
+
+	v.grpcConn, err = grpc.NewClient(
+		v.grpcAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(cfg.InterfaceRegistry).GRPCCodec())),
+	)
+	if err != nil {
+		return err
+	}
+
+	v.clientCtx = client.Context{}.
+		WithKeyringDir(v.dir).
+		WithKeyring(v.keyring).
+		WithHomeDir(v.dir).
+		WithChainID(cfg.ChainID).
+		WithInterfaceRegistry(cfg.InterfaceRegistry).
+		WithCodec(cfg.Codec).
+		WithTxConfig(cfg.TxConfig).
+		WithAccountRetriever(cfg.AccountRetriever).
+		WithAddressCodec(cfg.AddressCodec).
+		WithValidatorAddressCodec(cfg.ValidatorAddressCodec).
+		WithConsensusAddressCodec(cfg.ConsensusAddressCodec).
+		WithNodeURI(v.rpcAddress).
+		WithClient(v.rpcClient).
+		WithGRPCClient(v.grpcConn).
+		WithFromAddress(v.address).
+		WithFromName(v.moniker).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	return nil
+}
+
+// latestHeight returns the latest height committed by the app of the validator.
+func (v *Validator) latestHeight(ctx context.Context) (int64, error) {
+	if v.rpcClient == nil {
+		return 0, errors.New("validator not started")
+	}
+
+	res, err := v.rpcClient.ABCIInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.Response.LastBlockHeight, nil
+}
+
+// stop stops the servers of the validator, which closes its app, and its
+// client connection.
+func (v *Validator) stop() error {
+	if v.cancelFn == nil {
+		return nil
+	}
+	v.cancelFn()
+
+	err := v.server.Stop(context.Background())
+	if waitErr := v.errGroup.Wait(); waitErr != nil {
+		err = errors.Join(err, waitErr)
+	}
+	if v.grpcConn != nil {
+		err = errors.Join(err, v.grpcConn.Close())
+	}
+	return err
+}
@@ -27,6 +27,9 @@ type Manager struct {
 	// These are the heights that are multiples of snapshotInterval and kept for state sync snapshots.
 	// The heights are added to be pruned when a snapshot is complete.
 	pruneSnapshotHeights []int64
+	// These are the heights of the snapshots in progress which are not multiples of snapshotInterval,
+	// e.g. partial exports. They are kept until released, counting concurrent snapshots of a height.
+	announcedSnapshotHeights map[int64]int
 }
 
 // NegativeHeightsError is returned when a negative height is provided to the manager.
@@ -48,10 +51,11 @@ var pruneSnapshotHeightsKey = []byte("s/prunesnapshotheights")
 // by calling SetOptions.
 func NewManager(db dbm.DB, logger storetypes.Logger) *Manager {
 	return &Manager{
-		db:                   db,
-		logger:               logger,
-		opts:                 types.NewPruningOptions(types.PruningNothing),
-		pruneSnapshotHeights: []int64{0},
+		db:                       db,
+		logger:                   logger,
+		opts:                     types.NewPruningOptions(types.PruningNothing),
+		pruneSnapshotHeights:     []int64{0},
+		announcedSnapshotHeights: make(map[int64]int),
 	}
 }
 
@@ -94,6 +98,34 @@ func (m *Manager) HandleSnapshotHeight(height int64) {
 	}
 }
 
+// AnnounceSnapshotHeight keeps the given height from being pruned until it is released with
+// ReleaseSnapshotHeight. It must be called before snapshotting a height that is not tracked
+// through HandleSnapshotHeight.
+func (m *Manager) AnnounceSnapshotHeight(height int64) {
+	if height <= 0 {
+		return
+	}
+
+	m.pruneSnapshotHeightsMx.Lock()
+	defer m.pruneSnapshotHeightsMx.Unlock()
+
+	m.logger.Debug("AnnounceSnapshotHeight", "height", height)
+	m.announcedSnapshotHeights[height]++
+}
+
+// ReleaseSnapshotHeight releases a height announced with AnnounceSnapshotHeight, so that it can
+// be pruned again.
+func (m *Manager) ReleaseSnapshotHeight(height int64) {
+	m.pruneSnapshotHeightsMx.Lock()
+	defer m.pruneSnapshotHeightsMx.Unlock()
+
+	if m.announcedSnapshotHeights[height] <= 1 {
+		delete(m.announcedSnapshotHeights, height)
+		return
+	}
+	m.announcedSnapshotHeights[height]--
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
@@ -118,6 +150,13 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 	m.pruneSnapshotHeightsMx.RLock()
 	defer m.pruneSnapshotHeightsMx.RUnlock()
 
+	// the announced snapshots are still in progress, so we can't prune their heights
+	for height := range m.announcedSnapshotHeights {
+		if height-1 < pruneHeight {
+			pruneHeight = height - 1
+		}
+	}
+
 	// snapshotInterval is zero, indicating that all heights can be pruned
 	if m.snapshotInterval <= 0 {
 		return pruneHeight
@@ -196,6 +196,33 @@ func TestPruningHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestAnnounceSnapshotHeight(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	require.NotNil(t, manager)
+	manager.SetOptions(types.NewPruningOptions(types.PruningEverything))
+
+	// keep recent is 2, so height 97 can be pruned at height 100
+	require.Equal(t, int64(97), manager.GetPruningHeight(100))
+
+	// non positive heights are ignored
+	manager.AnnounceSnapshotHeight(0)
+	require.Equal(t, int64(97), manager.GetPruningHeight(100))
+
+	// an announced height is kept until all its announcements are released
+	manager.AnnounceSnapshotHeight(55)
+	manager.AnnounceSnapshotHeight(55)
+	require.Equal(t, int64(54), manager.GetPruningHeight(100))
+	manager.ReleaseSnapshotHeight(55)
+	require.Equal(t, int64(54), manager.GetPruningHeight(100))
+	manager.ReleaseSnapshotHeight(55)
+	require.Equal(t, int64(97), manager.GetPruningHeight(100))
+
+	// announced heights above the pruning height don't change it
+	manager.AnnounceSnapshotHeight(99)
+	require.Equal(t, int64(97), manager.GetPruningHeight(100))
+	manager.ReleaseSnapshotHeight(99)
+}
+
 func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -1,6 +1,7 @@
 package rootmulti_test
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -233,6 +234,45 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotStores_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(store.LastCommitID().Version)
+
+	testcases := map[string][]string{
+		"unknown store":       {"iavl1", "unknown"},
+		"duplicate store":     {"iavl1", "iavl1"},
+		"non-persisted store": {"trans1"},
+	}
+	for name, storeNames := range testcases {
+		t.Run(name, func(t *testing.T) {
+			err := store.SnapshotStores(version, nil, storeNames)
+			require.ErrorIs(t, err, types.ErrLogic)
+		})
+	}
+}
+
+func TestMultistoreSnapshotStoresRestore(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	manager := snapshots.NewManager(nil, snapshottypes.NewSnapshotOptions(0, 0), source, nil, log.NewNopLogger())
+	var buf bytes.Buffer
+	require.NoError(t, manager.ExportStores(version, []string{"iavl2"}, &buf))
+
+	manager = snapshots.NewManager(nil, snapshottypes.NewSnapshotOptions(0, 0), target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreStores(version, &buf))
+
+	require.EqualValues(t, version, target.LastCommitID().Version)
+	assertStoresEqual(t,
+		source.GetStoreByName("iavl2").(types.CommitKVStore),
+		target.GetStoreByName("iavl2").(types.CommitKVStore))
+	for _, name := range []string{"iavl1", "iavl3"} {
+		assert.False(t, target.GetStoreByName(name).(types.CommitKVStore).Iterator(nil, nil).Valid(),
+			"store %v not empty", name)
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
@@ -61,6 +61,7 @@ type Store struct {
 	pruningManager      *pruning.Manager
 	iavlCacheSize       int
 	iavlDisableFastNode bool
+	commitConcurrency   int
 	storesParams        map[types.StoreKey]storeParams
 	stores              map[types.StoreKey]types.CommitKVStore
 	keysByName          map[string]types.StoreKey
@@ -131,6 +132,13 @@ func (rs *Store) SetIAVLDisableFastNode(disableFastNode bool) {
 	rs.iavlDisableFastNode = disableFastNode
 }
 
+// SetCommitConcurrency sets the number of stores hashed and committed
+// concurrently. The stores are hashed and committed sequentially if it is lower
+// than 2.
+func (rs *Store) SetCommitConcurrency(concurrency int) {
+	rs.commitConcurrency = concurrency
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -347,6 +355,18 @@ func (rs *Store) PruneSnapshotHeight(height int64) {
 	rs.pruningManager.HandleSnapshotHeight(height)
 }
 
+// AnnounceSnapshotHeight implements snapshottypes.StoresSnapshotter. It keeps the given height
+// from being pruned until it is released.
+func (rs *Store) AnnounceSnapshotHeight(height int64) {
+	rs.pruningManager.AnnounceSnapshotHeight(height)
+}
+
+// ReleaseSnapshotHeight implements snapshottypes.StoresSnapshotter. It releases a height
+// announced with AnnounceSnapshotHeight.
+func (rs *Store) ReleaseSnapshotHeight(height int64) {
+	rs.pruningManager.ReleaseSnapshotHeight(height)
+}
+
 // SetInterBlockCache sets the Store's internal inter-block (persistent) cache.
 // When this is defined, all CommitKVStores will be wrapped with their respective
 // inter-block cache.
@@ -493,7 +513,7 @@ func (rs *Store) Commit() types.CommitID {
 		rs.PausePruning(true)
 		// unset the committing flag on all stores to continue the pruning
 		defer rs.PausePruning(false)
-		rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+		rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap, rs.commitConcurrency)
 	}()
 
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
@@ -527,26 +547,23 @@ func (rs *Store) Commit() types.CommitID {
 // WorkingHash returns the current hash of the store.
 // it will be used to get the current app hash before commit.
 func (rs *Store) WorkingHash() []byte {
-	storeInfos := make([]types.StoreInfo, 0, len(rs.stores))
-	storeKeys := keysFromStoreKeyMap(rs.stores)
-
-	for _, key := range storeKeys {
-		store := rs.stores[key]
-
-		if store.GetStoreType() != types.StoreTypeIAVL {
-			continue
+	storeKeys := make([]types.StoreKey, 0, len(rs.stores))
+	for _, key := range keysFromStoreKeyMap(rs.stores) {
+		if rs.stores[key].GetStoreType() == types.StoreTypeIAVL && !rs.removalMap[key] {
+			storeKeys = append(storeKeys, key)
 		}
+	}
 
-		if !rs.removalMap[key] {
-			si := types.StoreInfo{
-				Name: key.Name(),
-				CommitId: types.CommitID{
-					Hash: store.WorkingHash(),
-				},
-			}
-			storeInfos = append(storeInfos, si)
+	// the trees of the stores are independent, so they are hashed concurrently
+	storeInfos := make([]types.StoreInfo, len(storeKeys))
+	forEachConcurrently(len(storeKeys), rs.commitConcurrency, func(i int) {
+		storeInfos[i] = types.StoreInfo{
+			Name: storeKeys[i].Name(),
+			CommitId: types.CommitID{
+				Hash: rs.stores[storeKeys[i]].WorkingHash(),
+			},
 		}
-	}
+	})
 
 	sort.SliceStable(storeInfos, func(i, j int) bool {
 		return storeInfos[i].Name < storeInfos[j].Name
@@ -834,6 +851,13 @@ func parsePath(path string) (storeName, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return rs.SnapshotStores(height, protoWriter, nil)
+}
+
+// SnapshotStores implements snapshottypes.StoresSnapshotter. It writes a snapshot of the given
+// stores only, or of all stores if none is given, in the same format as Snapshot. Such a partial
+// snapshot can be restored with Restore, which only imports the stores present in the stream.
+func (rs *Store) SnapshotStores(height uint64, protoWriter protoio.Writer, storeNames []string) error {
 	if height == 0 {
 		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
 	}
@@ -848,12 +872,30 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	}
 	stores := []namedStore{}
 	keys := keysFromStoreKeyMap(rs.stores)
+	if len(storeNames) > 0 {
+		keys = make([]types.StoreKey, 0, len(storeNames))
+		seen := make(map[string]struct{}, len(storeNames))
+		for _, name := range storeNames {
+			key, ok := rs.keysByName[name]
+			if !ok {
+				return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot unknown store %q", name)
+			}
+			if _, ok := seen[name]; ok {
+				return errorsmod.Wrapf(types.ErrLogic, "duplicate store %q", name)
+			}
+			seen[name] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
 	for _, key := range keys {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
 			stores = append(stores, namedStore{name: key.Name(), Store: store})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
+			if len(storeNames) > 0 {
+				return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot non-persisted store %q", key.Name())
+			}
 			continue
 		default:
 			return errorsmod.Wrapf(types.ErrLogic,
@@ -931,6 +973,22 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
+	return rs.restore(height, format, protoReader, false)
+}
+
+// RestoreStores implements snapshottypes.StoresSnapshotter. It restores a snapshot written by
+// SnapshotStores, which may only contain some of the stores. The IAVL stores missing from the
+// snapshot are committed empty at the given height, so the multistore must be a fresh one.
+func (rs *Store) RestoreStores(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	return rs.restore(height, format, protoReader, true)
+}
+
+func (rs *Store) restore(
+	height uint64, format uint32, protoReader protoio.Reader, partial bool,
+) (snapshottypes.SnapshotItem, error) {
+	restored := map[string]struct{}{}
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
@@ -964,6 +1022,7 @@ loop:
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
 			}
 			defer importer.Close()
+			restored[item.Store.Name] = struct{}{}
 			// Importer height must reflect the node height (which usually matches the block height, but not always)
 			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
 
@@ -1008,6 +1067,25 @@ loop:
 		importer.Close()
 	}
 
+	if partial {
+		for _, key := range keysFromStoreKeyMap(rs.stores) {
+			store, ok := rs.stores[key].(*iavl.Store)
+			if _, found := restored[key.Name()]; found || !ok {
+				continue
+			}
+			// importing no nodes commits an empty tree at the given height
+			importer, err := store.Import(int64(height))
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(err, "import of empty store %q failed", key.Name())
+			}
+			err = importer.Commit()
+			importer.Close()
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(err, "IAVL commit of empty store %q failed", key.Name())
+			}
+		}
+	}
+
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
 	return snapshotItem, rs.LoadLatestVersion()
 }
@@ -1196,25 +1274,34 @@ func GetLatestVersion(db dbm.DB) int64 {
 }
 
 // Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool) *types.CommitInfo {
+//
+// Up to concurrency stores are committed concurrently, as their trees are
+// independent. Each store writes its own tree to the database when committed,
+// only the commit info and the latest version being written together by the
+// caller, see flushMetadata.
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool, concurrency int) *types.CommitInfo {
 	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
 	storeKeys := keysFromStoreKeyMap(storeMap)
 
-	for _, key := range storeKeys {
-		store := storeMap[key]
+	commitIDs := make([]types.CommitID, len(storeKeys))
+	forEachConcurrently(len(storeKeys), concurrency, func(i int) {
+		store := storeMap[storeKeys[i]]
 		last := store.LastCommitID()
 
 		// If a commit event execution is interrupted, a new iavl store's version
 		// will be larger than the RMS's metadata, when the block is replayed, we
 		// should avoid committing that iavl store again.
-		var commitID types.CommitID
 		if last.Version >= version {
 			last.Version = version
-			commitID = last
+			commitIDs[i] = last
 		} else {
-			commitID = store.Commit()
+			commitIDs[i] = store.Commit()
 		}
+	})
 
+	for i, key := range storeKeys {
+		store := storeMap[key]
+		commitID := commitIDs[i]
 		storeType := store.GetStoreType()
 		if storeType == types.StoreTypeTransient || storeType == types.StoreTypeMemory {
 			continue
@@ -1238,6 +1325,45 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	}
 }
 
+// forEachConcurrently calls fn for each index lower than n, with up to
+// concurrency calls running concurrently. The calls are sequential if
+// concurrency is lower than 2. A panic of a call is propagated to the caller
+// once all the calls are done.
+func forEachConcurrently(n, concurrency int, fn func(i int)) {
+	if concurrency < 2 || n < 2 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	var (
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicVal  any
+	)
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicVal = r })
+				}
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+
+	if panicVal != nil {
+		panic(panicVal)
+	}
+}
+
 func flushCommitInfo(batch dbm.Batch, version int64, cInfo *types.CommitInfo) {
 	bz, err := cInfo.Marshal()
 	if err != nil {
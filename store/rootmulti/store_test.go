@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -150,6 +151,73 @@ func TestHashStableWithEmptyCommit(t *testing.T) {
 	require.Equal(t, hash, cID.Hash)
 }
 
+func TestMultistoreConcurrentCommit(t *testing.T) {
+	sequential := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, sequential.LoadLatestVersion())
+
+	db := dbm.NewMemDB()
+	concurrent := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	concurrent.SetCommitConcurrency(2)
+	require.NoError(t, concurrent.LoadLatestVersion())
+
+	for i := 0; i < 3; i++ {
+		for _, ms := range []*Store{sequential, concurrent} {
+			for _, name := range []string{"store1", "store2", "store3"} {
+				ms.GetStoreByName(name).(types.KVStore).Set([]byte(fmt.Sprintf("key%d", i)), []byte(name))
+			}
+		}
+
+		workingHash := concurrent.WorkingHash()
+		require.Equal(t, sequential.WorkingHash(), workingHash)
+
+		commitID := concurrent.Commit()
+		require.Equal(t, sequential.Commit(), commitID)
+		require.Equal(t, workingHash, commitID.Hash)
+	}
+
+	// the concurrently committed stores are loaded back
+	reloaded := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, concurrent.LastCommitID(), reloaded.LastCommitID())
+	require.Equal(t, []byte("store2"), reloaded.GetStoreByName("store2").(types.KVStore).Get([]byte("key2")))
+}
+
+func TestForEachConcurrently(t *testing.T) {
+	var (
+		mtx     sync.Mutex
+		running int
+		maxRun  int
+		called  = make([]bool, 10)
+	)
+	forEachConcurrently(len(called), 3, func(i int) {
+		mtx.Lock()
+		running++
+		maxRun = max(maxRun, running)
+		called[i] = true
+		mtx.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mtx.Lock()
+		running--
+		mtx.Unlock()
+	})
+	require.LessOrEqual(t, maxRun, 3)
+	require.NotContains(t, called, false)
+
+	// a panic is propagated once all the calls are done
+	done := make([]bool, 4)
+	require.PanicsWithValue(t, "failure", func() {
+		forEachConcurrently(len(done), 2, func(i int) {
+			if i == 1 {
+				panic("failure")
+			}
+			done[i] = true
+		})
+	})
+	require.Equal(t, []bool{true, false, true, true}, done)
+}
+
 func TestMultistoreCommitLoad(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -1023,7 +1091,7 @@ func TestCommitStores(t *testing.T) {
 			store.Committed = 0
 			var version int64 = 1
 			removalMap := map[types.StoreKey]bool{}
-			res := commitStores(version, storeMap, removalMap)
+			res := commitStores(version, storeMap, removalMap, 0)
 			for _, s := range res.StoreInfos {
 				require.Equal(t, version, s.CommitId.Version)
 			}
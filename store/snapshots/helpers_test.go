@@ -171,6 +171,42 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockStoresSnapshotter records the heights announced while the stores are snapshotted.
+type mockStoresSnapshotter struct {
+	mockSnapshotter
+	announcedHeights map[int64]int
+	snapshotHeights  map[int64]int
+}
+
+var _ snapshottypes.StoresSnapshotter = (*mockStoresSnapshotter)(nil)
+
+func newMockStoresSnapshotter(items [][]byte) *mockStoresSnapshotter {
+	return &mockStoresSnapshotter{
+		mockSnapshotter:  mockSnapshotter{items: items, prunedHeights: make(map[int64]struct{})},
+		announcedHeights: make(map[int64]int),
+		snapshotHeights:  make(map[int64]int),
+	}
+}
+
+func (m *mockStoresSnapshotter) SnapshotStores(height uint64, protoWriter protoio.Writer, storeNames []string) error {
+	m.snapshotHeights[int64(height)] = m.announcedHeights[int64(height)]
+	return m.Snapshot(height, protoWriter)
+}
+
+func (m *mockStoresSnapshotter) RestoreStores(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	return m.Restore(height, format, protoReader)
+}
+
+func (m *mockStoresSnapshotter) AnnounceSnapshotHeight(height int64) {
+	m.announcedHeights[height]++
+}
+
+func (m *mockStoresSnapshotter) ReleaseSnapshotHeight(height int64) {
+	m.announcedHeights[height]--
+}
+
 type mockErrorSnapshotter struct{}
 
 var _ snapshottypes.Snapshotter = (*mockErrorSnapshotter)(nil)
@@ -229,6 +229,104 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 	}
 }
 
+// ExportStores writes a partial snapshot of the given stores at the given height to w, in the
+// snapshot stream format. Unlike Create, the snapshot is not saved in the snapshot store, as it
+// can't be used for state sync, and it doesn't include the extension snapshots.
+// It is meant for analytics and partial migrations, and is restored by RestoreStores.
+func (m *Manager) ExportStores(height uint64, storeNames []string, w io.Writer) error {
+	if m == nil {
+		return errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+	if len(storeNames) == 0 {
+		return errorsmod.Wrap(storetypes.ErrLogic, "no store to export")
+	}
+	multistore, ok := m.multistore.(types.StoresSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "multistore %T doesn't support partial snapshots", m.multistore)
+	}
+
+	// the height is held back from pruning while it is exported, the same way the snapshot
+	// interval heights are held back until Create hands them over to PruneSnapshotHeight. It is
+	// released rather than handed over, as it's usually not on the snapshot interval.
+	multistore.AnnounceSnapshotHeight(int64(height))
+	defer multistore.ReleaseSnapshotHeight(int64(height))
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := NewStreamWriter(ch)
+		if streamWriter == nil {
+			return
+		}
+		if err := multistore.SnapshotStores(height, streamWriter, storeNames); err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	// the chunks must all be consumed, even on error, for the writer goroutine to exit
+	var copyErr error
+	for chunk := range ch {
+		if copyErr == nil {
+			_, copyErr = io.Copy(w, chunk)
+		}
+		if err := chunk.Close(); err != nil && copyErr == nil {
+			copyErr = err
+		}
+	}
+
+	return copyErr
+}
+
+// RestoreStores restores a partial snapshot written by ExportStores at the given height into
+// a fresh multistore. The stores missing from the snapshot are left empty.
+func (m *Manager) RestoreStores(height uint64, r io.Reader) error {
+	if m == nil {
+		return errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+	if height == 0 {
+		return errorsmod.Wrap(storetypes.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	multistore, ok := m.multistore.(types.StoresSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "multistore %T doesn't support partial snapshots", m.multistore)
+	}
+
+	err := m.begin(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	ch := make(chan io.ReadCloser, 1)
+	ch <- io.NopCloser(r)
+	close(ch)
+
+	streamReader, err := NewStreamReader(ch)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	nextItem, err := multistore.RestoreStores(height, types.CurrentFormat, streamReader)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+	if nextItem.Item != nil {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "unexpected snapshot item %T in partial snapshot", nextItem.Item)
+	}
+
+	return nil
+}
+
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
 func (m *Manager) List() ([]*types.Snapshot, error) {
 	return m.store.List()
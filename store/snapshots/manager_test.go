@@ -1,6 +1,7 @@
 package snapshots_test
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
@@ -256,3 +257,17 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_ExportStores(t *testing.T) {
+	snapshotter := newMockStoresSnapshotter([][]byte{{1, 2, 3}, {4, 5, 6}})
+	manager := snapshots.NewManager(setupStore(t), opts, snapshotter, nil, log.NewNopLogger())
+
+	var buf bytes.Buffer
+	require.NoError(t, manager.ExportStores(7, []string{"bank"}, &buf))
+	require.NotEmpty(t, buf.Bytes())
+
+	// the height is announced while exported, released afterwards and not handed over for pruning
+	assert.Equal(t, map[int64]int{7: 1}, snapshotter.snapshotHeights)
+	assert.Equal(t, 0, snapshotter.announcedHeights[7])
+	assert.Empty(t, snapshotter.prunedHeights)
+}
@@ -26,6 +26,24 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// StoresSnapshotter is a Snapshotter that can also snapshot and restore a subset of its stores.
+type StoresSnapshotter interface {
+	Snapshotter
+
+	// AnnounceSnapshotHeight keeps the given height from being pruned until it is released. Unlike
+	// PruneSnapshotHeight, it can be used for heights that are not multiples of the snapshot interval.
+	AnnounceSnapshotHeight(height int64)
+
+	// ReleaseSnapshotHeight releases a height announced with AnnounceSnapshotHeight.
+	ReleaseSnapshotHeight(height int64)
+
+	// SnapshotStores writes snapshot items of the given stores into the protobuf writer.
+	SnapshotStores(height uint64, protoWriter protoio.Writer, storeNames []string) error
+
+	// RestoreStores restores a snapshot written by SnapshotStores into a fresh store.
+	RestoreStores(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-metrics"
@@ -16,12 +17,20 @@ import (
 )
 
 // globalTelemetryEnabled is a private variable that stores the telemetry enabled state.
-// It is set on initialization and does not change for the lifetime of the program.
-var globalTelemetryEnabled bool
+// It is set on initialization and only changes when telemetry is paused or resumed
+// through SetTelemetryEnabled.
+var globalTelemetryEnabled atomic.Bool
 
 // IsTelemetryEnabled provides controlled access to check if telemetry is enabled.
 func IsTelemetryEnabled() bool {
-	return globalTelemetryEnabled
+	return globalTelemetryEnabled.Load()
+}
+
+// SetTelemetryEnabled pauses or resumes the emission of metrics through the
+// telemetry package function wrappers. Resuming only has an effect if metrics
+// sinks have been configured by New with telemetry enabled.
+func SetTelemetryEnabled(enabled bool) {
+	globalTelemetryEnabled.Store(enabled)
 }
 
 // globalLabels defines the set of global labels that will be applied to all
@@ -105,7 +114,7 @@ type GatherResponse struct {
 
 // New creates a new instance of Metrics
 func New(cfg Config) (_ *Metrics, rerr error) {
-	globalTelemetryEnabled = cfg.Enabled
+	globalTelemetryEnabled.Store(cfg.Enabled)
 	if !cfg.Enabled {
 		return nil, nil
 	}
@@ -100,6 +100,16 @@ func MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
 }
 
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric with global labels (if any) along with the provided labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), append(labels, globalLabels...))
+}
+
 // Now return the current time if telemetry is enabled or a zero time if it's not
 func Now() time.Time {
 	if !IsTelemetryEnabled() {
@@ -11,7 +11,7 @@ import (
 var mu sync.Mutex
 
 func initTelemetry(v bool) {
-	globalTelemetryEnabled = v
+	globalTelemetryEnabled.Store(v)
 }
 
 // Reset the global state to a known disabled state before each test.
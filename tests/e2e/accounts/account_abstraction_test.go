@@ -7,9 +7,14 @@ import (
 	"testing"
 
 	"cosmossdk.io/simapp"
+	"cosmossdk.io/x/accounts"
+	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 )
@@ -71,6 +76,68 @@ func TestAccountAbstraction(t *testing.T) {
 }
 */
 
+func TestExecuteBundle(t *testing.T) {
+	app := setupApp(t)
+	ak := app.AccountsKeeper
+	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger())
+
+	_, aaAddr, err := ak.Init(ctx, "aa_minimal", accCreator, &rotationv1.MsgInit{
+		PubKeyBytes: privKey.PubKey().Bytes(),
+	}, nil)
+	require.NoError(t, err)
+	fundAccount(t, app, ctx, aaAddr, "1000stake")
+
+	send := func(from []byte, amount string) sdk.Msg {
+		return &banktypes.MsgSend{
+			FromAddress: bechify(t, app, from),
+			ToAddress:   bechify(t, app, aliceAddr),
+			Amount:      coins(t, amount),
+		}
+	}
+
+	resp, err := accounts.NewMsgServer(ak).ExecuteBundle(ctx, &accountsv1.MsgExecuteBundle{
+		Bundler: bechify(t, app, bundlerAddr),
+		Txs: []*tx.TxRaw{
+			bundledTx(t, send(aaAddr, "100stake")),
+			// the first send is reverted with the failure of the second one
+			bundledTx(t, send(aaAddr, "100stake"), send(aaAddr, "5000stake")),
+			bundledTx(t, send(aliceAddr, "100stake")),
+			bundledTx(t, send(aaAddr, "100stake"), send(aliceAddr, "100stake")),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 4)
+
+	require.Empty(t, resp.Responses[0].Error)
+	require.Len(t, resp.Responses[0].ExecResponses, 1)
+	require.Contains(t, resp.Responses[1].Error, accounts.ErrExecution.Error())
+	require.Empty(t, resp.Responses[1].ExecResponses)
+	require.Contains(t, resp.Responses[2].Error, accounts.ErrAuthentication.Error())
+	require.Contains(t, resp.Responses[3].Error, "messages have different signers")
+
+	balanceIs(t, ctx, app, aaAddr, "900stake")
+	balanceIs(t, ctx, app, sdk.AccAddress(aliceAddr), "100stake")
+
+	_, err = accounts.NewMsgServer(ak).ExecuteBundle(ctx, &accountsv1.MsgExecuteBundle{
+		Bundler: bechify(t, app, bundlerAddr),
+	})
+	require.ErrorContains(t, err, "empty bundle")
+}
+
+func bundledTx(t *testing.T, msgs ...gogoproto.Message) *tx.TxRaw {
+	t.Helper()
+	bodyBytes, err := (&tx.TxBody{Messages: intoAny(t, msgs...)}).Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := (&tx.AuthInfo{Fee: &tx.Fee{}}).Marshal()
+	require.NoError(t, err)
+
+	return &tx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{mockSignature.Value},
+	}
+}
+
 func intoAny(t *testing.T, msgs ...gogoproto.Message) (anys []*codectypes.Any) {
 	t.Helper()
 	for _, msg := range msgs {
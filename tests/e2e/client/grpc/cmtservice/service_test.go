@@ -351,3 +351,49 @@ func (s *E2ETestSuite) TestABCIQuery() {
 		})
 	}
 }
+
+func (s *E2ETestSuite) TestStoreProof() {
+	testCases := []struct {
+		name      string
+		req       *cmtservice.StoreProofRequest
+		expectErr string
+	}{
+		{
+			name: "existing key",
+			req:  &cmtservice.StoreProofRequest{StoreName: "gov", Key: []byte{0x03}},
+		},
+		{
+			name: "missing key",
+			req:  &cmtservice.StoreProofRequest{StoreName: "gov", Key: []byte{0xff}},
+		},
+		{
+			name:      "empty store name",
+			req:       &cmtservice.StoreProofRequest{Key: []byte{0x03}},
+			expectErr: "invalid store name",
+		},
+		{
+			name:      "unknown store",
+			req:       &cmtservice.StoreProofRequest{StoreName: "foo", Key: []byte{0x03}},
+			expectErr: "no such store",
+		},
+		{
+			name:      "empty key",
+			req:       &cmtservice.StoreProofRequest{StoreName: "gov"},
+			expectErr: "empty key",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.StoreProof(context.Background(), tc.req)
+			if tc.expectErr != "" {
+				s.Require().ErrorContains(err, tc.expectErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Greater(res.Height, int64(1))
+			s.Require().Greater(len(res.ProofOps.Ops), 0, "expected proofs")
+		})
+	}
+}
@@ -68,6 +68,7 @@ require (
 	cosmossdk.io/schema v0.1.1 // indirect
 	cosmossdk.io/x/circuit v0.0.0-20230613133644-0a778132a60f // indirect
 	cosmossdk.io/x/epochs v0.0.0-20240522060652-a1ae4c3e0337 // indirect
+	cosmossdk.io/x/validate v0.0.0-00010101000000-000000000000 // indirect
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/99designs/go-keychain v0.0.0-20191008050251-8e49817e8af4 // indirect
 	github.com/99designs/keyring v1.2.2 // indirect
@@ -264,6 +265,7 @@ replace (
 	cosmossdk.io/x/staking => ../x/staking
 	cosmossdk.io/x/tx => ../x/tx
 	cosmossdk.io/x/upgrade => ../x/upgrade
+	cosmossdk.io/x/validate => ../x/validate
 )
 
 // Below are the long-lived replace for tests.
@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"strings"
@@ -29,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -498,6 +500,57 @@ func (s *CLITestSuite) TestCLISendGenerateSignAndBroadcast() {
 	s.Require().NoError(err)
 }
 
+func (s *CLITestSuite) TestCLISignWatchOnly() {
+	// the private key of the watch-only key is held outside of the keyring
+	priv := secp256k1.GenPrivKey()
+	watchOnly, err := s.clientCtx.Keyring.SaveOfflineKey("watchOnly", priv.PubKey())
+	s.Require().NoError(err)
+	addr, err := watchOnly.GetAddress()
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr.String(),
+		ToAddress:   s.val.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	unsignedTx, err := clitestutil.SubmitTestTx(s.clientCtx, msgSend, addr, clitestutil.TestTxConfig{GenOnly: true})
+	s.Require().NoError(err)
+	unsignedTxFile := testutil.WriteToNewTempFile(s.T(), unsignedTx.String())
+	defer unsignedTxFile.Close()
+
+	// the tx is printed pending the signature, followed by the bytes to sign
+	res, err := authtestutil.TxSignExec(s.clientCtx, addr, unsignedTxFile.Name())
+	s.Require().NoError(err)
+	lines := strings.Split(strings.TrimSpace(res.String()), "\n")
+	s.Require().Len(lines, 3)
+	s.Require().Contains(lines[1], "watchOnly is a watch-only key")
+	bytesToSign, err := base64.StdEncoding.DecodeString(lines[2])
+	s.Require().NoError(err)
+
+	pendingTxFile := testutil.WriteToNewTempFile(s.T(), lines[0])
+	defer pendingTxFile.Close()
+
+	// a signature over other bytes is rejected
+	invalidSig, err := priv.Sign([]byte("other bytes"))
+	s.Require().NoError(err)
+	_, err = authtestutil.TxSignExec(s.clientCtx, addr, pendingTxFile.Name(),
+		fmt.Sprintf("--signature=%s", base64.StdEncoding.EncodeToString(invalidSig)))
+	s.Require().ErrorContains(err, "invalid signature")
+
+	sig, err := priv.Sign(bytesToSign)
+	s.Require().NoError(err)
+	signedTx, err := authtestutil.TxSignExec(s.clientCtx, addr, pendingTxFile.Name(),
+		fmt.Sprintf("--signature=%s", base64.StdEncoding.EncodeToString(sig)))
+	s.Require().NoError(err)
+
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx.String())
+	defer signedTxFile.Close()
+
+	res, err = authtestutil.TxValidateSignaturesExec(s.clientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+	s.Require().Contains(res.String(), "[OK]")
+}
+
 func (s *CLITestSuite) TestCLIMultisignInsufficientCosigners() {
 	// Fetch account and a multisig info
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
@@ -983,6 +1036,57 @@ func (s *CLITestSuite) TestSignWithMultiSignersAminoJSON() {
 	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
 }
 
+// TestCLISigningPackage tests the signing of a transaction with 2 signers
+// through a signing package, signed by each signer on their own copy.
+func (s *CLITestSuite) TestCLISigningPackage() {
+	val0, val1 := s.val, s.val1
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	err := txBuilder.SetMsgs(
+		banktypes.NewMsgSend(val0.String(), addr1.String(), sdk.NewCoins(sdk.NewInt64Coin("test1token", 10))),
+		banktypes.NewMsgSend(val1.String(), addr1.String(), sdk.NewCoins(sdk.NewInt64Coin("test2token", 10))),
+	)
+	s.Require().NoError(err)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit() * 2)
+
+	txJSON, err := s.clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	unsignedTxFile := testutil.WriteToNewTempFile(s.T(), string(txJSON))
+	defer unsignedTxFile.Close()
+
+	pkg, err := clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetSigningPackageCommand(), []string{"create", unsignedTxFile.Name()})
+	s.Require().NoError(err)
+	pkgFile := testutil.WriteToNewTempFile(s.T(), pkg.String())
+	defer pkgFile.Close()
+
+	signedPkgFiles := []string{}
+	for _, signer := range []sdk.AccAddress{val0, val1} {
+		signedPkg, err := clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetSigningPackageCommand(),
+			[]string{"sign", pkgFile.Name(), fmt.Sprintf("--%s=%s", flags.FlagFrom, signer.String())})
+		s.Require().NoError(err)
+		signedPkgFile := testutil.WriteToNewTempFile(s.T(), signedPkg.String())
+		defer signedPkgFile.Close()
+		signedPkgFiles = append(signedPkgFiles, signedPkgFile.Name())
+	}
+
+	// the package is not finalized until all the signatures are collected
+	_, err = clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetSigningPackageCommand(),
+		[]string{"finalize", pkgFile.Name(), signedPkgFiles[0]})
+	s.Require().ErrorContains(err, "missing the signatures of "+val1.String())
+
+	signedTx, err := clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetSigningPackageCommand(),
+		append([]string{"finalize", pkgFile.Name()}, signedPkgFiles...))
+	s.Require().NoError(err)
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx.String())
+	defer signedTxFile.Close()
+
+	res, err := authtestutil.TxValidateSignaturesExec(s.clientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+	s.Require().Equal(2, strings.Count(res.String(), "[OK]"))
+}
+
 func (s *CLITestSuite) TestAuxSigner() {
 	s.T().Skip("re-enable this when we bring back sign mode aux client testing")
 	val0Coin := sdk.NewCoin("testtoken", math.NewInt(10))
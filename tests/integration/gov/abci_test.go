@@ -473,6 +473,167 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestSnapshotVotingPower(t *testing.T) {
+	testcases := []struct {
+		name                string
+		snapshotVotingPower bool
+		expStatus           v1.ProposalStatus
+	}{
+		{
+			name:                "without snapshot, stake delegated during the voting period is tallied",
+			snapshotVotingPower: false,
+			expStatus:           v1.StatusRejected,
+		},
+		{
+			name:                "with snapshot, stake delegated during the voting period is not tallied",
+			snapshotVotingPower: true,
+			expStatus:           v1.StatusPassed,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.app
+			ctx := app.BaseApp.NewContext(false)
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			params, err := suite.GovKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			params.SnapshotVotingPower = tc.snapshotVotingPower
+			require.NoError(t, suite.GovKeeper.Params.Set(ctx, params))
+
+			for _, addr := range addrs {
+				suite.AccountKeeper.SetAccount(ctx, suite.AccountKeeper.NewAccountWithAddress(ctx, addr))
+			}
+
+			valAddr := sdk.ValAddress(addrs[0])
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			_, err = suite.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			proposal, err := suite.GovKeeper.SubmitProposal(ctx, nil, "metadata", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+
+			addr0Str, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+			require.NoError(t, err)
+			_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addr0Str, proposal.Id, params.MinDeposit))
+			require.NoError(t, err)
+
+			// the validator votes yes
+			require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+			// once the voting period started, a larger stake is delegated to the validator and votes no
+			addr1Str, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[1])
+			require.NoError(t, err)
+			valAddrStr, err := suite.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+			require.NoError(t, err)
+			delegation := sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 40))
+			_, err = stakingMsgSvr.Delegate(ctx, stakingtypes.NewMsgDelegate(addr1Str, valAddrStr, delegation))
+			require.NoError(t, err)
+			require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+			// the delegation is snapshotted for the proposal that snapshotted the validator
+			has, err := suite.GovKeeper.DelegationSnapshots.Has(ctx, collections.Join3(proposal.Id, addrs[1], valAddr))
+			require.NoError(t, err)
+			require.Equal(t, tc.snapshotVotingPower, has)
+
+			newHeader := ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+			ctx = ctx.WithHeaderInfo(newHeader)
+
+			require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, proposal.Status)
+
+			// the snapshot is deleted once the proposal is tallied, along with its validator index
+			has, err = suite.GovKeeper.TotalBondedSnapshots.Has(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.False(t, has)
+			iter, err := suite.GovKeeper.ValidatorSnapshots.Indexes.Validator.MatchExact(ctx, valAddr)
+			require.NoError(t, err)
+			snapshotted, err := iter.PrimaryKeys()
+			require.NoError(t, err)
+			require.Empty(t, snapshotted)
+		})
+	}
+}
+
+func TestMultipleChoiceProposal_ExecutesWinningOptionMessages(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.app
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+	suite.AccountKeeper.SetAccount(ctx, acc)
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	_, err := suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	govAddr := suite.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	govAddrStr, err := suite.AccountKeeper.AddressCodec().BytesToString(govAddr)
+	require.NoError(t, err)
+	proposerStr, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	recipientOneStr, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	recipientTwoStr, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[2])
+	require.NoError(t, err)
+
+	// fund the gov account so that the option messages can be executed
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000)))
+	require.NoError(t, suite.BankKeeper.SendCoins(ctx, addrs[0], govAddr, amount))
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	msg, err := v1.NewMultipleChoiceMsgSubmitProposal(proposalCoins, proposerStr, "metadata", "title", "summary", &v1.ProposalVoteOptions{
+		OptionOne: "send to the first recipient",
+		OptionTwo: "send to the second recipient",
+	})
+	require.NoError(t, err)
+	require.NoError(t, msg.SetOptionMsgs(v1.OptionOne, []sdk.Msg{banktypes.NewMsgSend(govAddrStr, recipientOneStr, amount)}))
+	require.NoError(t, msg.SetOptionMsgs(v1.OptionTwo, []sdk.Msg{banktypes.NewMsgSend(govAddrStr, recipientTwoStr, amount)}))
+
+	res, err := govMsgSvr.SubmitMultipleChoiceProposal(ctx, msg)
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, res.ProposalId, addrs[0], v1.NewNonSplitVoteOption(v1.OptionTwo), "")
+	require.NoError(t, err)
+
+	recipientOneBalance := suite.BankKeeper.GetAllBalances(ctx, addrs[1])
+	recipientTwoBalance := suite.BankKeeper.GetAllBalances(ctx, addrs[2])
+
+	params, _ := suite.GovKeeper.Params.Get(ctx)
+	newHeader := ctx.HeaderInfo()
+	newHeader.Time = ctx.HeaderInfo().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithHeaderInfo(newHeader)
+
+	err = suite.GovKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	proposal, err := suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	// only the messages of the winning option are executed
+	require.Equal(t, recipientOneBalance, suite.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, recipientTwoBalance.Add(amount...), suite.BankKeeper.GetAllBalances(ctx, addrs[2]))
+}
+
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -188,6 +188,76 @@ func TestImportExportQueues(t *testing.T) {
 	assert.Assert(t, proposal2.Status == v1.StatusRejected)
 }
 
+func TestImportExportVotingPowerSnapshots(t *testing.T) {
+	suite := createTestSuite(t)
+	ctx := suite.app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	params, err := suite.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.SnapshotVotingPower = true
+	require.NoError(t, suite.GovKeeper.Params.Set(ctx, params))
+
+	for _, addr := range addrs {
+		suite.AccountKeeper.SetAccount(ctx, suite.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	valAddr := sdk.ValAddress(addrs[0])
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	_, err = suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// put a proposal in voting period, which snapshots the voting power
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, nil, "metadata", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	addr0Str, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addr0Str, proposal.Id, params.MinDeposit))
+	require.NoError(t, err)
+
+	// a delegation created during the voting period is snapshotted
+	addr1Str, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	valAddrStr, err := suite.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	delegation := sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 40))
+	_, err = stakingMsgSvr.Delegate(ctx, stakingtypes.NewMsgDelegate(addr1Str, valAddrStr, delegation))
+	require.NoError(t, err)
+
+	genState, err := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.NoError(t, err)
+	require.Len(t, genState.VotingPowerSnapshots, 1)
+	snapshot := genState.VotingPowerSnapshots[0]
+	require.Equal(t, proposal.Id, snapshot.ProposalId)
+	snapshotted := make([]string, len(snapshot.Validators))
+	for i, validator := range snapshot.Validators {
+		snapshotted[i] = validator.ValidatorAddress
+	}
+	require.Contains(t, snapshotted, valAddrStr)
+	require.Len(t, snapshot.Delegations, 1)
+	require.Equal(t, addr1Str, snapshot.Delegations[0].DelegatorAddress)
+	require.NoError(t, v1.ValidateGenesis(suite.AccountKeeper.AddressCodec(), genState))
+
+	// the snapshot is restored on import
+	require.NoError(t, suite.GovKeeper.TotalBondedSnapshots.Clear(ctx, nil))
+	valSnapshots, err := suite.GovKeeper.ValidatorSnapshots.Iterate(ctx, nil)
+	require.NoError(t, err)
+	valSnapshotKeys, err := valSnapshots.Keys()
+	require.NoError(t, err)
+	for _, key := range valSnapshotKeys {
+		require.NoError(t, suite.GovKeeper.ValidatorSnapshots.Remove(ctx, key))
+	}
+	require.NoError(t, suite.GovKeeper.DelegationSnapshots.Clear(ctx, nil))
+	require.NoError(t, gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, genState))
+
+	exported, err := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.NoError(t, err)
+	require.Equal(t, genState.VotingPowerSnapshots, exported.VotingPowerSnapshots)
+}
+
 func clearDB(t *testing.T, db *dbm.MemDB) {
 	t.Helper()
 	iter, err := db.Iterator(nil, nil)
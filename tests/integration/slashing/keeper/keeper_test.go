@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/comet"
 	coreheader "cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth"
 	authkeeper "cosmossdk.io/x/auth/keeper"
@@ -404,6 +405,203 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	assert.DeepEqual(t, resultingTokens, validator.GetTokens())
 }
 
+// Test a validator being punished for downtime with the parameters of its class
+func TestHandleValidatorClass(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := f.valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	// validators up to the power of 100 have a shorter window and are not slashed
+	params, err := f.slashingKeeper.Params.Get(f.ctx)
+	assert.NilError(t, err)
+	class := slashingtypes.NewValidatorClass("small", power, 20, math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyZeroDec())
+	params.ValidatorClasses = []slashingtypes.ValidatorClass{class}
+	assert.NilError(t, f.slashingKeeper.Params.Set(f.ctx, params))
+
+	assert.NilError(t, f.slashingKeeper.AddrPubkeyRelation.Set(f.ctx, pks[0].Address(), pks[0]))
+
+	consaddr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(val.Address())
+	assert.NilError(t, err)
+
+	info := slashingtypes.NewValidatorSigningInfo(consaddr, f.ctx.HeaderInfo().Height, time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.ctx, sdk.ConsAddress(val.Address()), info))
+
+	acc := f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(addr))
+	f.accountKeeper.SetAccount(f.ctx, acc)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// first blocks of the class window OK
+	height := int64(0)
+	for ; height < class.SignedBlocksWindow; height++ {
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+		err = f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagCommit)
+		assert.NilError(t, err)
+	}
+
+	// more than half of the class window missed
+	for ; height < class.SignedBlocksWindow+class.SignedBlocksWindow/2+1; height++ {
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height, Time: time.Unix(0, 0)})
+		err = f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagAbsent)
+		assert.NilError(t, err)
+	}
+
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// validator should have been jailed for the class duration, but not slashed
+	validator, _ := f.stakingKeeper.GetValidatorByConsAddr(f.ctx, sdk.GetConsAddress(val))
+	assert.Equal(t, sdk.Unbonding, validator.GetStatus())
+	assert.DeepEqual(t, amt, validator.GetTokens())
+
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, sdk.ConsAddress(val.Address()))
+	assert.NilError(t, err)
+	assert.Equal(t, time.Unix(0, 0).Add(time.Hour).UTC(), info.JailedUntil.UTC())
+}
+
+// Test the missed blocks of a validator being carried over when it changes class
+func TestHandleValidatorClassChange(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := f.valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	params, err := f.slashingKeeper.Params.Get(f.ctx)
+	assert.NilError(t, err)
+	class := slashingtypes.NewValidatorClass("small", power, 20, math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyZeroDec())
+	params.ValidatorClasses = []slashingtypes.ValidatorClass{class}
+	assert.NilError(t, f.slashingKeeper.Params.Set(f.ctx, params))
+
+	assert.NilError(t, f.slashingKeeper.AddrPubkeyRelation.Set(f.ctx, pks[0].Address(), pks[0]))
+
+	consAddr := sdk.ConsAddress(val.Address())
+	consaddrStr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	assert.NilError(t, err)
+
+	info := slashingtypes.NewValidatorSigningInfo(consaddrStr, f.ctx.HeaderInfo().Height, time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.ctx, consAddr, info))
+
+	acc := f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(addr))
+	f.accountKeeper.SetAccount(f.ctx, acc)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// the validator misses blocks in the window of its class
+	height := int64(0)
+	for ; height < class.SignedBlocksWindow/2; height++ {
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagAbsent))
+	}
+
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, class.SignedBlocksWindow, info.SignedBlocksWindow)
+	assert.Equal(t, class.SignedBlocksWindow/2, info.MissedBlocksCounter)
+
+	// once its power exceeds the class, its missed blocks are tracked with the window of the params
+	f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+	assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power+1, comet.BlockIDFlagCommit))
+
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, params.SignedBlocksWindow, info.SignedBlocksWindow)
+	assert.Equal(t, int64(0), info.StartHeight)
+	assert.Equal(t, class.SignedBlocksWindow/2, info.MissedBlocksCounter)
+
+	missedBlocks, err := f.slashingKeeper.GetValidatorMissedBlocks(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, int(class.SignedBlocksWindow/2), len(missedBlocks))
+
+	// the validator misses every other block in the window of the params
+	for height++; height < 3*class.SignedBlocksWindow; height++ {
+		signed := comet.BlockIDFlagCommit
+		if height%2 == 0 {
+			signed = comet.BlockIDFlagAbsent
+		}
+
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power+1, signed))
+	}
+
+	// back in its class, only the missed blocks of the last class window are kept
+	f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+	assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagCommit))
+
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, class.SignedBlocksWindow, info.SignedBlocksWindow)
+	assert.Equal(t, int64(0), info.StartHeight)
+	assert.Equal(t, class.SignedBlocksWindow/2-1, info.MissedBlocksCounter)
+
+	missedBlocks, err = f.slashingKeeper.GetValidatorMissedBlocks(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, int(class.SignedBlocksWindow/2-1), len(missedBlocks))
+}
+
+// Test an absent validator changing class before each window completes still being jailed
+func TestHandleValidatorClassFlipping(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := f.valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	params, err := f.slashingKeeper.Params.Get(f.ctx)
+	assert.NilError(t, err)
+	class := slashingtypes.NewValidatorClass("small", power, 20, math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyZeroDec())
+	params.ValidatorClasses = []slashingtypes.ValidatorClass{class}
+	assert.NilError(t, f.slashingKeeper.Params.Set(f.ctx, params))
+
+	assert.NilError(t, f.slashingKeeper.AddrPubkeyRelation.Set(f.ctx, pks[0].Address(), pks[0]))
+
+	consAddr := sdk.ConsAddress(val.Address())
+	consaddrStr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	assert.NilError(t, err)
+
+	info := slashingtypes.NewValidatorSigningInfo(consaddrStr, f.ctx.HeaderInfo().Height, time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.ctx, consAddr, info))
+
+	acc := f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(addr))
+	f.accountKeeper.SetAccount(f.ctx, acc)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// the absent validator crosses the max power of its class every 15 blocks,
+	// before the window of its class completes
+	for height := int64(0); height < 10*class.SignedBlocksWindow; height++ {
+		valPower := power
+		if (height/15)%2 == 1 {
+			valPower = power + 1
+		}
+
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height, Time: time.Unix(0, 0)})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), valPower, comet.BlockIDFlagAbsent))
+	}
+
+	// validator should have been jailed
+	validator, err := f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, validator.IsJailed())
+}
+
 // Test a validator dipping in and out of the validator set
 // Ensure that missed blocks are tracked correctly and that
 // the start height of the signing info is reset correctly
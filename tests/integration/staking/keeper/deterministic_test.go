@@ -472,7 +472,7 @@ func TestGRPCValidatorDelegations(t *testing.T) {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.ValidatorDelegations, 14610, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.ValidatorDelegations, 14691, false)
 }
 
 func TestGRPCValidatorUnbondingDelegations(t *testing.T) {
@@ -562,7 +562,7 @@ func TestGRPCDelegation(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Delegation, 4680, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Delegation, 4707, false)
 }
 
 func TestGRPCUnbondingDelegation(t *testing.T) {
@@ -645,7 +645,7 @@ func TestGRPCDelegatorDelegations(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.DelegatorDelegations, 4283, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.DelegatorDelegations, 4310, false)
 }
 
 func TestGRPCDelegatorValidator(t *testing.T) {
@@ -780,7 +780,7 @@ func TestGRPCPool(t *testing.T) {
 
 	f = initDeterministicFixture(t) // reset
 	getStaticValidator(t, f)
-	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6287, false)
+	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6314, false)
 }
 
 func TestGRPCRedelegations(t *testing.T) {
@@ -860,13 +860,15 @@ func TestGRPCParams(t *testing.T) {
 	rapid.Check(t, func(rt *rapid.T) {
 		bondDenom := rapid.StringMatching(coinDenomRegex).Draw(rt, "bond-denom")
 		params := stakingtypes.Params{
-			BondDenom:         bondDenom,
-			UnbondingTime:     durationGenerator().Draw(rt, "duration"),
-			MaxValidators:     rapid.Uint32Min(1).Draw(rt, "max-validators"),
-			MaxEntries:        rapid.Uint32Min(1).Draw(rt, "max-entries"),
-			HistoricalEntries: rapid.Uint32Min(1).Draw(rt, "historical-entries"),
-			MinCommissionRate: math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
-			KeyRotationFee:    sdk.NewInt64Coin(bondDenom, rapid.Int64Range(10000, 100000).Draw(rt, "amount")),
+			BondDenom:                    bondDenom,
+			UnbondingTime:                durationGenerator().Draw(rt, "duration"),
+			MaxValidators:                rapid.Uint32Min(1).Draw(rt, "max-validators"),
+			MaxEntries:                   rapid.Uint32Min(1).Draw(rt, "max-entries"),
+			HistoricalEntries:            rapid.Uint32Min(1).Draw(rt, "historical-entries"),
+			MinCommissionRate:            math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
+			KeyRotationFee:               sdk.NewInt64Coin(bondDenom, rapid.Int64Range(10000, 100000).Draw(rt, "amount")),
+			MinSelfDelegation:            math.NewInt(rapid.Int64Min(0).Draw(rt, "min-self-delegation")),
+			MinSelfDelegationGracePeriod: durationGenerator().Draw(rt, "grace-period"),
 		}
 
 		err := f.stakingKeeper.Params.Set(f.ctx, params)
@@ -876,17 +878,19 @@ func TestGRPCParams(t *testing.T) {
 	})
 
 	params := stakingtypes.Params{
-		BondDenom:         "denom",
-		UnbondingTime:     time.Hour,
-		MaxValidators:     85,
-		MaxEntries:        5,
-		HistoricalEntries: 5,
-		MinCommissionRate: math.LegacyNewDecWithPrec(5, 2),
-		KeyRotationFee:    sdk.NewInt64Coin("denom", 10000),
+		BondDenom:                    "denom",
+		UnbondingTime:                time.Hour,
+		MaxValidators:                85,
+		MaxEntries:                   5,
+		HistoricalEntries:            5,
+		MinCommissionRate:            math.LegacyNewDecWithPrec(5, 2),
+		KeyRotationFee:               sdk.NewInt64Coin("denom", 10000),
+		MinSelfDelegation:            math.NewInt(1000),
+		MinSelfDelegationGracePeriod: time.Hour,
 	}
 
 	err := f.stakingKeeper.Params.Set(f.ctx, params)
 	assert.NilError(t, err)
 
-	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1162, false)
+	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1195, false)
 }
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMinSelfDelegationGracePeriod(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	stakingKeeper := f.stakingKeeper
+	msgServer := keeper.NewMsgServerImpl(stakingKeeper)
+	querier := keeper.NewQuerier(stakingKeeper)
+
+	addrs := simtestutil.AddTestAddrsIncremental(f.bankKeeper, stakingKeeper, ctx, 4, stakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+
+	newMsgCreateValidator := func(i int, power int64) *types.MsgCreateValidator {
+		comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+		msg, err := types.NewMsgCreateValidator(valAddrs[i].String(), PKs[i], sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, power)),
+			types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+		assert.NilError(t, err)
+		return msg
+	}
+
+	for _, addr := range addrs {
+		f.accountKeeper.SetAccount(ctx, f.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	// create validators self-delegating 30, 20 and 40
+	for i, power := range []int64{30, 20, 40} {
+		_, err := msgServer.CreateValidator(ctx, newMsgCreateValidator(i, power))
+		assert.NilError(t, err)
+	}
+
+	_, err := stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	params, err := stakingKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.MinSelfDelegation = stakingKeeper.TokensFromConsensusPower(ctx, 35)
+	params.MinSelfDelegationGracePeriod = time.Hour
+	startTime := ctx.HeaderInfo().Time
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: stakingKeeper.GetAuthority(), Params: params})
+	assert.NilError(t, err)
+
+	// validators below the minimum are recorded when the param changes
+
+	res, err := querier.ValidatorsBelowMinSelfDelegation(ctx, &types.QueryValidatorsBelowMinSelfDelegationRequest{})
+	assert.NilError(t, err)
+	belowMin := make(map[string]types.ValidatorBelowMinSelfDelegation)
+	for _, val := range res.Validators {
+		belowMin[val.ValidatorAddress] = val
+	}
+	assert.Equal(t, 2, len(belowMin))
+	assert.DeepEqual(t, stakingKeeper.TokensFromConsensusPower(ctx, 30), belowMin[valAddrs[0].String()].SelfDelegation)
+	assert.Equal(t, startTime, belowMin[valAddrs[0].String()].Since)
+	assert.Equal(t, startTime.Add(time.Hour), belowMin[valAddrs[0].String()].JailTime)
+	_, found := belowMin[valAddrs[1].String()]
+	assert.Assert(t, found)
+
+	// creating a validator self-delegating less than the minimum fails
+	_, err = msgServer.CreateValidator(ctx, newMsgCreateValidator(3, 10))
+	assert.ErrorContains(t, err, "less than the chain minimum")
+
+	// the second validator self-delegates enough before the end of the grace period
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(addrs[1].String(), valAddrs[1].String(), sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 20))))
+	assert.NilError(t, err)
+
+	has, err := stakingKeeper.SelfDelegationShortfalls.Has(ctx, valAddrs[1])
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+
+	// the third validator is recorded once it undelegates below the minimum
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1, Time: startTime.Add(30 * time.Minute)})
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(addrs[2].String(), valAddrs[2].String(), sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 10))))
+	assert.NilError(t, err)
+
+	since, err := stakingKeeper.SelfDelegationShortfalls.Get(ctx, valAddrs[2])
+	assert.NilError(t, err)
+	assert.Equal(t, startTime.Add(30*time.Minute), since)
+
+	_, err = stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+	validator, err := stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(t, !validator.Jailed)
+
+	// the first validator is jailed at the end of the grace period
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1, Time: startTime.Add(time.Hour)})
+	_, err = stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	validator, err = stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(t, validator.Jailed)
+	has, err = stakingKeeper.SelfDelegationShortfalls.Has(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+
+	// the third validator is still within its grace period
+	validator, err = stakingKeeper.GetValidator(ctx, valAddrs[2])
+	assert.NilError(t, err)
+	assert.Assert(t, !validator.Jailed)
+	has, err = stakingKeeper.SelfDelegationShortfalls.Has(ctx, valAddrs[2])
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+
+	// it cannot be unjailed while self-delegating less than the minimum
+	validator, err = stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+	assert.ErrorIs(t, stakingKeeper.Unjail(ctx, consAddr), types.ErrSelfDelegationBelowMinimum)
+}
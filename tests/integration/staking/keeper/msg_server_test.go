@@ -6,6 +6,7 @@ import (
 
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/testutil"
@@ -179,6 +180,61 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 	}
 }
 
+func TestMultiDelegate(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	addrs := simtestutil.AddTestAddrsIncremental(f.bankKeeper, f.stakingKeeper, ctx, 3, math.NewInt(10000))
+	delegatorAddr := addrs[2]
+	f.accountKeeper.SetAccount(ctx, f.accountKeeper.NewAccountWithAddress(ctx, delegatorAddr))
+
+	// setup a bonded and an unbonded validator
+	bondedVal, err := types.NewValidator(sdk.ValAddress(addrs[0]).String(), PKs[0], types.NewDescription("Bonded", "", "", "", ""))
+	assert.NilError(t, err)
+	bondedVal.Status = types.Bonded
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, bondedVal))
+
+	unbondedVal, err := types.NewValidator(sdk.ValAddress(addrs[1]).String(), PKs[1], types.NewDescription("Unbonded", "", "", "", ""))
+	assert.NilError(t, err)
+	assert.NilError(t, f.stakingKeeper.SetValidator(ctx, unbondedVal))
+
+	bondedPool := f.stakingKeeper.GetBondedPool(ctx)
+	notBondedPool := f.stakingKeeper.GetNotBondedPool(ctx)
+	bondedPoolBalance := f.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+	notBondedPoolBalance := f.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
+
+	res, err := msgServer.MultiDelegate(ctx, types.NewMsgMultiDelegate(delegatorAddr.String(), sdk.NewInt64Coin(bondDenom, 1000), []types.WeightedDelegation{
+		types.NewWeightedDelegation(bondedVal.OperatorAddress, math.LegacyNewDecWithPrec(7, 1)),
+		types.NewWeightedDelegation(unbondedVal.OperatorAddress, math.LegacyNewDecWithPrec(3, 1)),
+	}))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []sdk.Coin{sdk.NewInt64Coin(bondDenom, 700), sdk.NewInt64Coin(bondDenom, 300)}, res.Amounts)
+
+	// the delegated tokens are sent to the pool of each validator
+	assert.DeepEqual(t, bondedPoolBalance.AddAmount(math.NewInt(700)), f.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+	assert.DeepEqual(t, notBondedPoolBalance.AddAmount(math.NewInt(300)), f.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom))
+	assert.DeepEqual(t, sdk.NewInt64Coin(bondDenom, 9000), f.bankKeeper.GetBalance(ctx, delegatorAddr, bondDenom))
+
+	for _, tc := range []struct {
+		valAddr string
+		shares  math.LegacyDec
+	}{
+		{bondedVal.OperatorAddress, math.LegacyNewDec(700)},
+		{unbondedVal.OperatorAddress, math.LegacyNewDec(300)},
+	} {
+		valAddr, err := sdk.ValAddressFromBech32(tc.valAddr)
+		assert.NilError(t, err)
+		delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delegatorAddr, valAddr))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, tc.shares, delegation.Shares)
+	}
+}
+
 func TestRotateConsPubKey(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
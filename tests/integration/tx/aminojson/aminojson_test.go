@@ -363,14 +363,18 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		"staking/msg_update_params": {
 			gogo: &stakingtypes.MsgUpdateParams{
 				Params: stakingtypes.Params{
-					UnbondingTime:  0,
-					KeyRotationFee: types.Coin{},
+					UnbondingTime:                0,
+					KeyRotationFee:               types.Coin{},
+					MinSelfDelegation:            math.ZeroInt(),
+					MinSelfDelegationGracePeriod: 0,
 				},
 			},
 			pulsar: &stakingapi.MsgUpdateParams{
 				Params: &stakingapi.Params{
-					UnbondingTime:  &durationpb.Duration{Seconds: 0},
-					KeyRotationFee: &v1beta1.Coin{},
+					UnbondingTime:                &durationpb.Duration{Seconds: 0},
+					KeyRotationFee:               &v1beta1.Coin{},
+					MinSelfDelegation:            "0",
+					MinSelfDelegationGracePeriod: &durationpb.Duration{Seconds: 0},
 				},
 			},
 		},
@@ -3,6 +3,7 @@ package sims
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
@@ -49,12 +50,17 @@ func SetupSimulation(config simtypes.Config, dirPrefix, dbName string, verbose,
 	return db, dir, logger, false, nil
 }
 
-// SimulationOperations retrieves the simulation params from the provided file path
-// and returns all the modules weighted operations
+// SimulationOperations retrieves the simulation params from the provided params file or profile
+// and returns the weighted operations of all the modules which are not disabled by the profile
 func SimulationOperations(app runtime.AppSimI, cdc codec.Codec, config simtypes.Config, txConfig client.TxConfig) []simtypes.WeightedOperation {
+	appParams, err := LoadAppParams(config)
+	if err != nil {
+		panic(err)
+	}
+
 	signingCtx := cdc.InterfaceRegistry().SigningContext()
 	simState := module.SimulationState{
-		AppParams:      make(simtypes.AppParams),
+		AppParams:      appParams,
 		Cdc:            cdc,
 		AddressCodec:   signingCtx.AddressCodec(),
 		ValidatorCodec: signingCtx.ValidatorAddressCodec(),
@@ -62,21 +68,78 @@ func SimulationOperations(app runtime.AppSimI, cdc codec.Codec, config simtypes.
 		BondDenom:      sdk.DefaultBondDenom,
 	}
 
-	if config.ParamsFile != "" {
-		bz, err := os.ReadFile(config.ParamsFile)
+	simManager := app.SimulationManager()
+	if config.ProfileFile != "" {
+		profile, err := simtypes.ReadProfile(config.ProfileFile)
 		if err != nil {
 			panic(err)
 		}
-
-		err = json.Unmarshal(bz, &simState.AppParams)
+		simManager, err = simulatedModules(simManager, profile)
 		if err != nil {
 			panic(err)
 		}
 	}
 
-	simState.LegacyProposalContents = app.SimulationManager().GetProposalContents(simState) //nolint:staticcheck // we're testing the old way here
-	simState.ProposalMsgs = app.SimulationManager().GetProposalMsgs(simState)
-	return app.SimulationManager().WeightedOperations(simState)
+	simState.LegacyProposalContents = simManager.GetProposalContents(simState) //nolint:staticcheck // we're testing the old way here
+	simState.ProposalMsgs = simManager.GetProposalMsgs(simState)
+	return simManager.WeightedOperations(simState)
+}
+
+// LoadAppParams returns the simulation params of the params file or of the profile of the config.
+// Without any of them, the params are empty and generated randomly by the modules.
+func LoadAppParams(config simtypes.Config) (simtypes.AppParams, error) {
+	switch {
+	case config.ParamsFile != "" && config.ProfileFile != "":
+		return nil, errors.New("cannot provide both a params file and a simulation profile")
+
+	case config.ProfileFile != "":
+		profile, err := simtypes.ReadProfile(config.ProfileFile)
+		if err != nil {
+			return nil, err
+		}
+		return profile.AppParams(config.Seed)
+
+	case config.ParamsFile != "":
+		bz, err := os.ReadFile(config.ParamsFile)
+		if err != nil {
+			return nil, err
+		}
+
+		appParams := make(simtypes.AppParams)
+		if err := json.Unmarshal(bz, &appParams); err != nil {
+			return nil, err
+		}
+		return appParams, nil
+
+	default:
+		return make(simtypes.AppParams), nil
+	}
+}
+
+// simulatedModules returns a simulation manager with the modules of which the operations are
+// not disabled by the profile. Only the modules exposing their name can be disabled.
+func simulatedModules(simManager *module.SimulationManager, profile simtypes.Profile) (*module.SimulationManager, error) {
+	names := make(map[string]bool, len(simManager.Modules))
+	modules := make([]module.AppModuleSimulation, 0, len(simManager.Modules))
+	for _, m := range simManager.Modules {
+		if named, ok := m.(interface{ Name() string }); ok {
+			names[named.Name()] = true
+			if !profile.ModuleEnabled(named.Name()) {
+				continue
+			}
+		}
+		modules = append(modules, m)
+	}
+
+	for _, name := range profile.DisabledModules {
+		if !names[name] {
+			return nil, fmt.Errorf("cannot disable unknown simulation module %s", name)
+		}
+	}
+
+	enabled := module.NewSimulationManager(modules...)
+	enabled.StoreDecoders = simManager.StoreDecoders
+	return enabled, nil
 }
 
 // CheckExportSimulation exports the app state and simulation parameters to JSON
@@ -100,22 +100,13 @@ func appStateFnWithExtendedCbs(
 			chainID = genesisDoc.ChainID
 			simAccs = accounts
 
-		case config.ParamsFile != "":
-			appParams := make(simtypes.AppParams)
-			bz, err := os.ReadFile(config.ParamsFile)
-			if err != nil {
-				panic(err)
-			}
-
-			err = json.Unmarshal(bz, &appParams)
+		default:
+			// the params of the params file or of the profile override the random ones
+			appParams, err := LoadAppParams(config)
 			if err != nil {
 				panic(err)
 			}
 			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, genesisState, addressCodec, validatorCodec)
-
-		default:
-			appParams := make(simtypes.AppParams)
-			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, genesisState, addressCodec, validatorCodec)
 		}
 
 		rawState := make(map[string]json.RawMessage)
@@ -0,0 +1,106 @@
+package sims
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+// multiAppCount is the number of app instances simulated side by side by RunMultiApp.
+const multiAppCount = 2
+
+// MultiAppName returns the name of the i-th app instance of a multi app simulation, which
+// identifies it in the packets of the bridge.
+func MultiAppName(i int) string {
+	return fmt.Sprintf("app%d", i)
+}
+
+// RunMultiApp runs, for each default seed, the simulations of two instances of the app side by
+// side, advanced one block at a time in lockstep, with a bridge passing packets between them.
+// It is used to test modules whose logic depends on external acknowledgements, in IBC-like flows,
+// without a relayer.
+//
+// The i-th instance is named MultiAppName(i) and simulated with the seed incremented by i.
+// setupBridge returns the bridge endpoints of the instances, by instance index. The post run
+// actions get the packets which were still in flight when the simulations ended.
+func RunMultiApp[T SimulationApp](
+	t *testing.T,
+	appFactory func(
+		logger log.Logger,
+		db dbm.DB,
+		traceStore io.Writer,
+		loadLatest bool,
+		appOpts servertypes.AppOptions,
+		baseAppOptions ...func(*baseapp.BaseApp),
+	) T,
+	setupStateFactory func(app T) SimStateFactory,
+	setupBridge func(apps []TestInstance[T]) []*simulation.BridgeEndpoint,
+	postRunActions ...func(t *testing.T, apps []TestInstance[T], undelivered []simulation.Packet),
+) {
+	t.Helper()
+	cfg := cli.NewConfigFromFlags()
+	cfg.ChainID = SimAppChainID
+	for i := range defaultSeeds {
+		seed := defaultSeeds[i]
+		t.Run(fmt.Sprintf("seed: %d", seed), func(t *testing.T) {
+			t.Parallel()
+			apps := make([]TestInstance[T], multiAppCount)
+			for i := range apps {
+				tCfg := cfg.With(t, seed+int64(i), nil)
+				if tCfg.TracePath != "" {
+					tCfg.TracePath = tracePathForSeed(tCfg.TracePath, tCfg.Seed)
+				}
+				apps[i] = NewSimulationAppInstance(t, tCfg, appFactory)
+			}
+
+			endpoints := setupBridge(apps)
+			require.Len(t, endpoints, multiAppCount, "one bridge endpoint per app instance")
+
+			var runLogger log.Logger
+			if cli.FlagVerboseValue {
+				runLogger = log.NewTestLogger(t)
+			} else {
+				runLogger = log.NewTestLoggerInfo(t)
+			}
+			runLogger = runLogger.With("seed", seed)
+
+			chains := make([]simulation.MultiAppChain, multiAppCount)
+			for i, ti := range apps {
+				app := ti.App
+				stateFactory := setupStateFactory(app)
+				chains[i] = simulation.MultiAppChain{
+					Name:         MultiAppName(i),
+					Endpoint:     endpoints[i],
+					Logger:       runLogger.With("app", MultiAppName(i)),
+					App:          app.GetBaseApp(),
+					AppStateFn:   stateFactory.AppStateFn,
+					RandAccFn:    simtypes.RandomAccounts,
+					Ops:          simtestutil.SimulationOperations(app, stateFactory.Codec, ti.Cfg, app.TxConfig()),
+					BlockedAddrs: stateFactory.BlockedAddr,
+					Config:       ti.Cfg,
+					Cdc:          stateFactory.Codec,
+					AddressCodec: app.TxConfig().SigningContext().AddressCodec(),
+					LogWriter:    ti.ExecLogWriter,
+				}
+			}
+
+			undelivered, err := simulation.SimulateMultiApp(t, WriteToDebugLog(runLogger), chains)
+			require.NoError(t, err)
+			for _, step := range postRunActions {
+				step(t, apps, undelivered)
+			}
+		})
+	}
+}
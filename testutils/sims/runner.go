@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -41,6 +43,11 @@ type SimStateFactory struct {
 	Codec       codec.Codec
 	AppStateFn  simtypes.AppStateFn
 	BlockedAddr map[string]bool
+
+	// SchemaCodecs are the codecs of the module schemas, by store key name, with which the state is
+	// indexed for the SchemaInvariants to be checked after every block. See EnableSchemaInvariants.
+	SchemaCodecs     map[string]schema.ModuleCodec
+	SchemaInvariants []SchemaInvariant
 }
 
 // SimulationApp abstract app that is used by sims
@@ -105,6 +112,10 @@ func RunWithSeeds[T SimulationApp](
 			t.Parallel()
 			// setup environment
 			tCfg := cfg.With(t, seed, fuzzSeed)
+			if tCfg.TracePath != "" {
+				// the seeds run in parallel, each of them records its own trace
+				tCfg.TracePath = tracePathForSeed(tCfg.TracePath, seed)
+			}
 			testInstance := NewSimulationAppInstance(t, tCfg, appFactory)
 			var runLogger log.Logger
 			if cli.FlagVerboseValue {
@@ -116,6 +127,10 @@ func RunWithSeeds[T SimulationApp](
 
 			app := testInstance.App
 			stateFactory := setupStateFactory(app)
+			if len(stateFactory.SchemaInvariants) != 0 {
+				require.True(t, tCfg.Commit, "schema invariants are checked on commit, run the simulation with -Commit=true")
+				EnableSchemaInvariants(t, app.GetBaseApp(), stateFactory.SchemaCodecs, stateFactory.SchemaInvariants...)
+			}
 			simParams, err := simulation.SimulateFromSeedX(
 				t,
 				runLogger,
@@ -143,6 +158,42 @@ func RunWithSeeds[T SimulationApp](
 	}
 }
 
+// tracePathForSeed inserts the seed into the trace file name, before its extension.
+func tracePathForSeed(path string, seed int64) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s_seed_%d%s", strings.TrimSuffix(path, ext), seed, ext)
+}
+
+// Replay replays the blocks and txs of a trace recorded by a simulation run with the TracePath flag
+// against a new instance of the app, and fails at the first block of which the app hash differs from
+// the recorded one. It is used to localize nondeterminism between two builds of an app.
+func Replay[T SimulationApp](
+	t *testing.T,
+	appFactory func(
+		logger log.Logger,
+		db dbm.DB,
+		traceStore io.Writer,
+		loadLatest bool,
+		appOpts servertypes.AppOptions,
+		baseAppOptions ...func(*baseapp.BaseApp),
+	) T,
+	tracePath string,
+) {
+	t.Helper()
+	trace, err := simulation.ReadTrace(tracePath)
+	require.NoError(t, err)
+
+	cfg := cli.NewConfigFromFlags()
+	cfg.ChainID = SimAppChainID
+	testInstance := NewSimulationAppInstance(t, cfg.With(t, trace.Seed, nil), appFactory)
+
+	mismatches, err := simulation.ReplayTrace(testInstance.App.GetBaseApp(), trace)
+	if len(mismatches) != 0 {
+		t.Fatalf("%s (%d of %d replayed blocks differ)", mismatches[0], len(mismatches), len(trace.Blocks))
+	}
+	require.NoError(t, err)
+}
+
 // TestInstance is a generic type that represents an instance of a SimulationApp used for testing simulations.
 // It contains the following fields:
 //   - App: The instance of the SimulationApp under test.
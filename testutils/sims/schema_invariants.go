@@ -0,0 +1,308 @@
+package sims
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/schema"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// SchemaInvariant is an invariant checked against the app state indexed with the module schemas
+// after every committed block of a simulation. Compared to the invariants of the crisis module,
+// it only reads the indexed objects, so it doesn't depend on the keepers, and it returns an error
+// describing the objects which break it instead of panicking.
+type SchemaInvariant struct {
+	// Name identifies the invariant in the failure reports.
+	Name string
+	// Check returns an error describing the violation if the invariant is broken.
+	Check func(state *IndexedState) error
+}
+
+// IndexedObject is an object of the app state indexed by an IndexedState. Key and Value follow the
+// conventions of schema.ObjectUpdate: they hold a single value if the object type has a single
+// key or value field, and a slice of the values of the fields otherwise. The value is always complete,
+// the value updates of the modules are merged into it.
+type IndexedObject struct {
+	Key   any
+	Value any
+}
+
+// IndexedState is an in-memory index of the app state, built by decoding the state changes of every
+// block into objects with the codecs of the module schemas. The modules are identified by the name of
+// their store key.
+type IndexedState struct {
+	codecs  map[string]schema.ModuleCodec
+	objects map[string]map[string]map[string]IndexedObject // module -> object type -> key -> object
+	height  int64
+}
+
+// NewIndexedState returns an empty IndexedState for the modules of the given codecs, by store key name.
+func NewIndexedState(codecs map[string]schema.ModuleCodec) *IndexedState {
+	return &IndexedState{
+		codecs:  codecs,
+		objects: make(map[string]map[string]map[string]IndexedObject, len(codecs)),
+	}
+}
+
+// Height returns the height of the last block of which the state changes were indexed.
+func (s *IndexedState) Height() int64 {
+	return s.height
+}
+
+// Apply decodes and indexes the state changes of a block. The changes to stores without a codec and
+// the ones which the codecs don't decode into objects are ignored.
+func (s *IndexedState) Apply(height int64, changeSet []*storetypes.StoreKVPair) error {
+	s.height = height
+	for _, pair := range changeSet {
+		cdc, ok := s.codecs[pair.StoreKey]
+		if !ok || cdc.KVDecoder == nil {
+			continue
+		}
+
+		updates, err := cdc.KVDecoder(schema.KVPairUpdate{Key: pair.Key, Value: pair.Value, Delete: pair.Delete})
+		if err != nil {
+			return fmt.Errorf("failed to decode key %x of module %s: %w", pair.Key, pair.StoreKey, err)
+		}
+		for _, update := range updates {
+			if err := cdc.Schema.ValidateObjectUpdate(update); err != nil {
+				return fmt.Errorf("invalid update of key %x of module %s: %w", pair.Key, pair.StoreKey, err)
+			}
+			if err := s.applyUpdate(pair.StoreKey, cdc.Schema, update); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *IndexedState) applyUpdate(moduleName string, modSchema schema.ModuleSchema, update schema.ObjectUpdate) error {
+	types, ok := s.objects[moduleName]
+	if !ok {
+		types = map[string]map[string]IndexedObject{}
+		s.objects[moduleName] = types
+	}
+	objects, ok := types[update.TypeName]
+	if !ok {
+		objects = map[string]IndexedObject{}
+		types[update.TypeName] = objects
+	}
+
+	key := objectKeyString(update.Key)
+	if update.Delete {
+		delete(objects, key)
+		return nil
+	}
+
+	valueUpdates, ok := update.Value.(schema.ValueUpdates)
+	if !ok {
+		objects[key] = IndexedObject{Key: update.Key, Value: update.Value}
+		return nil
+	}
+
+	// merge the updated fields into the value of the existing object
+	var valueFields []schema.Field
+	for _, objectType := range modSchema.ObjectTypes {
+		if objectType.Name == update.TypeName {
+			valueFields = objectType.ValueFields
+		}
+	}
+	values := make([]any, len(valueFields))
+	if existing, found := objects[key]; found {
+		if len(valueFields) == 1 {
+			values[0] = existing.Value
+		} else {
+			copy(values, existing.Value.([]any))
+		}
+	}
+	fieldIndexes := make(map[string]int, len(valueFields))
+	for i, field := range valueFields {
+		fieldIndexes[field.Name] = i
+	}
+	err := valueUpdates.Iterate(func(name string, value any) bool {
+		values[fieldIndexes[name]] = value
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read the value updates of an object of type %s of module %s: %w", update.TypeName, moduleName, err)
+	}
+
+	object := IndexedObject{Key: update.Key, Value: values}
+	if len(values) == 1 {
+		object.Value = values[0]
+	}
+	objects[key] = object
+	return nil
+}
+
+// Get returns the indexed object of a type of a module with the given key.
+func (s *IndexedState) Get(moduleName, typeName string, key any) (IndexedObject, bool) {
+	object, found := s.objects[moduleName][typeName][objectKeyString(key)]
+	return object, found
+}
+
+// Len returns the number of indexed objects of a type of a module.
+func (s *IndexedState) Len(moduleName, typeName string) int {
+	return len(s.objects[moduleName][typeName])
+}
+
+// Iterate iterates over the indexed objects of a type of a module, in a deterministic order, until
+// f returns false.
+func (s *IndexedState) Iterate(moduleName, typeName string, f func(object IndexedObject) bool) {
+	objects := s.objects[moduleName][typeName]
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !f(objects[key]) {
+			return
+		}
+	}
+}
+
+// objectKeyString returns a string uniquely identifying an object key within its object type.
+func objectKeyString(key any) string {
+	return fmt.Sprintf("%#v", key)
+}
+
+// ObjectDecoder decodes the key-value pairs of a collection into the objects of a schema object type.
+// It returns false if the key doesn't belong to the collection.
+type ObjectDecoder func(update schema.KVPairUpdate) (schema.ObjectUpdate, bool, error)
+
+// CollectionDecoder returns an ObjectDecoder for the objects of a type stored in a collection with the
+// given prefix and codecs. objectKey and objectValue convert the keys and values of the collection into
+// the key and value of the objects.
+func CollectionDecoder[K, V any](
+	typeName string,
+	prefix []byte,
+	keyCodec codec.KeyCodec[K],
+	valueCodec codec.ValueCodec[V],
+	objectKey func(K) any,
+	objectValue func(V) any,
+) ObjectDecoder {
+	return func(update schema.KVPairUpdate) (schema.ObjectUpdate, bool, error) {
+		if !bytes.HasPrefix(update.Key, prefix) {
+			return schema.ObjectUpdate{}, false, nil
+		}
+
+		_, key, err := keyCodec.Decode(update.Key[len(prefix):])
+		if err != nil {
+			return schema.ObjectUpdate{}, true, fmt.Errorf("failed to decode the key of a %s: %w", typeName, err)
+		}
+		objectUpdate := schema.ObjectUpdate{TypeName: typeName, Key: objectKey(key), Delete: update.Delete}
+		if update.Delete {
+			return objectUpdate, true, nil
+		}
+
+		value, err := valueCodec.Decode(update.Value)
+		if err != nil {
+			return objectUpdate, true, fmt.Errorf("failed to decode the value of a %s: %w", typeName, err)
+		}
+		objectUpdate.Value = objectValue(value)
+		return objectUpdate, true, nil
+	}
+}
+
+// NewModuleCodec returns a module codec with the given object types which decodes the key-value
+// pairs of the module with the first decoder matching their key.
+func NewModuleCodec(objectTypes []schema.ObjectType, decoders ...ObjectDecoder) schema.ModuleCodec {
+	return schema.ModuleCodec{
+		Schema: schema.ModuleSchema{ObjectTypes: objectTypes},
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			for _, decoder := range decoders {
+				objectUpdate, ok, err := decoder(update)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					return []schema.ObjectUpdate{objectUpdate}, nil
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+// EnableSchemaInvariants indexes the state changes of the stores of the given module codecs, by store key
+// name, into an IndexedState, and checks the invariants against it after every commit. The test fails
+// at the first block which breaks an invariant, with a report of all the broken invariants.
+//
+// It must be called before the chain is initialized, for the genesis state to be indexed, and it adds
+// a listener to the streaming manager of the app.
+func EnableSchemaInvariants(tb testing.TB, app *baseapp.BaseApp, codecs map[string]schema.ModuleCodec, invariants ...SchemaInvariant) {
+	tb.Helper()
+	for name, cdc := range codecs {
+		if err := cdc.Schema.Validate(); err != nil {
+			tb.Fatalf("invalid schema of module %s: %v", name, err)
+		}
+	}
+
+	cms, ok := app.CommitMultiStore().(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		tb.Fatal("the commit multi-store doesn't expose its store keys")
+	}
+	var keys []storetypes.StoreKey
+	for name := range codecs {
+		key, ok := cms.StoreKeysByName()[name]
+		if !ok {
+			tb.Fatalf("no store for the schema of module %s", name)
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+	app.CommitMultiStore().AddListeners(keys)
+
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, &schemaInvariantListener{
+		tb:         tb,
+		state:      NewIndexedState(codecs),
+		invariants: invariants,
+	})
+	app.SetStreamingManager(streamingManager)
+}
+
+// schemaInvariantListener indexes the state changes of every block and checks the schema invariants
+// on commit. The errors of commit listeners are only logged by the app, so it fails the test directly.
+type schemaInvariantListener struct {
+	tb         testing.TB
+	state      *IndexedState
+	invariants []SchemaInvariant
+	height     int64
+}
+
+var _ storetypes.ABCIListener = (*schemaInvariantListener)(nil)
+
+func (l *schemaInvariantListener) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, _ abci.FinalizeBlockResponse) error {
+	l.height = req.Height
+	return nil
+}
+
+func (l *schemaInvariantListener) ListenCommit(_ context.Context, _ abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	if err := l.state.Apply(l.height, changeSet); err != nil {
+		l.tb.Fatalf("failed to index the state changes of block %d: %v", l.height, err)
+	}
+
+	var report strings.Builder
+	for _, invariant := range l.invariants {
+		if err := invariant.Check(l.state); err != nil {
+			fmt.Fprintf(&report, "\n%s: %v", invariant.Name, err)
+		}
+	}
+	if report.Len() != 0 {
+		l.tb.Fatalf("schema invariants broken at height %d:%s", l.height, report.String())
+	}
+	return nil
+}
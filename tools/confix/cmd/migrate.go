@@ -80,6 +80,7 @@ In case of any error in updating the file, no output is written.`,
 
 			// get transformation steps and formatDoc in which plan need to be applied
 			steps, formatDoc := plan(rawFile, targetVersion, configType)
+			steps = append(steps, confix.ExtensionsPlan(rawFile, targetVersion, configType)...)
 
 			if err := confix.Upgrade(ctx, steps, formatDoc, configPath, outputPath, FlagSkipValidate); err != nil {
 				return fmt.Errorf("failed to migrate config: %w", err)
@@ -0,0 +1,139 @@
+package confix
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/creachadair/tomledit"
+	"github.com/creachadair/tomledit/transform"
+	"github.com/spf13/viper"
+)
+
+// Extension defines application specific configuration that confix handles
+// alongside the built-in server and client configuration.
+type Extension struct {
+	// Name is the name of the extension.
+	Name string
+	// ConfigType is the type of the configuration extended (app or client).
+	ConfigType string
+	// Default is the TOML document of the default values of the extension.
+	// It is added to the default configuration of every version, so that its
+	// keys are kept by `config migrate` and compared by `config diff`.
+	Default string
+	// Migrations are the transformations of the extension, keyed by the version
+	// they migrate to. They are built from the configuration before migration
+	// and applied after the built-in migration.
+	Migrations map[string]func(from *tomledit.Document) transform.Plan
+	// Validate validates the configuration after a migration.
+	Validate func(v *viper.Viper) error
+}
+
+var extensions []Extension
+
+// RegisterExtension registers an application configuration extension.
+// It must be called before executing the confix commands.
+func RegisterExtension(ext Extension) error {
+	if ext.Name == "" {
+		return errors.New("extension name cannot be empty")
+	}
+
+	for _, e := range extensions {
+		if e.Name == ext.Name {
+			return fmt.Errorf("extension %s already registered", ext.Name)
+		}
+	}
+
+	ext.ConfigType = strings.ToLower(ext.ConfigType)
+	if ext.ConfigType != AppConfigType && ext.ConfigType != ClientConfigType {
+		return fmt.Errorf("extension %s: unsupported config type: %q", ext.Name, ext.ConfigType)
+	}
+
+	for version := range ext.Migrations {
+		if _, ok := Migrations[version]; !ok {
+			return fmt.Errorf("extension %s: unknown version %q", ext.Name, version)
+		}
+	}
+
+	if _, err := tomledit.Parse(strings.NewReader(ext.Default)); err != nil {
+		return fmt.Errorf("extension %s: failed to parse default config: %w", ext.Name, err)
+	}
+
+	extensions = append(extensions, ext)
+	return nil
+}
+
+// ExtensionsPlan returns the transformation plan of the registered extensions
+// of the given config type for a migration of from to the given version.
+func ExtensionsPlan(from *tomledit.Document, to, configType string) transform.Plan {
+	plan := transform.Plan{}
+	for _, ext := range extensionsOf(configType) {
+		if migration, ok := ext.Migrations[to]; ok {
+			plan = append(plan, migration(from)...)
+		}
+	}
+
+	return plan
+}
+
+// extensionsOf returns the registered extensions of the given config type.
+func extensionsOf(configType string) []Extension {
+	var exts []Extension
+	for _, ext := range extensions {
+		if ext.ConfigType == strings.ToLower(configType) {
+			exts = append(exts, ext)
+		}
+	}
+
+	return exts
+}
+
+// addExtensionDefaults adds the default values of the registered extensions
+// of the given config type to doc.
+func addExtensionDefaults(doc *tomledit.Document, configType string) error {
+	for _, ext := range extensionsOf(configType) {
+		// parse the default again, as the document is modified by the migrations
+		extDoc, err := tomledit.Parse(strings.NewReader(ext.Default))
+		if err != nil {
+			return fmt.Errorf("extension %s: failed to parse default config: %w", ext.Name, err)
+		}
+
+		for _, section := range extDoc.Sections {
+			if doc.First(section.Name...) != nil {
+				return fmt.Errorf("extension %s: section %s already defined", ext.Name, section.Name)
+			}
+		}
+
+		if extDoc.Global != nil {
+			for _, kv := range allKVs(extDoc.Global) {
+				if doc.First(kv.Key) != nil {
+					return fmt.Errorf("extension %s: key %s already defined", ext.Name, kv.Key)
+				}
+			}
+
+			if doc.Global == nil {
+				doc.Global = &tomledit.Section{}
+			}
+			doc.Global.Items = append(doc.Global.Items, extDoc.Global.Items...)
+		}
+		doc.Sections = append(doc.Sections, extDoc.Sections...)
+	}
+
+	return nil
+}
+
+// validateExtensions validates the configuration with the registered
+// extensions of the given config type.
+func validateExtensions(v *viper.Viper, configType string) error {
+	for _, ext := range extensionsOf(configType) {
+		if ext.Validate == nil {
+			continue
+		}
+
+		if err := ext.Validate(v); err != nil {
+			return fmt.Errorf("%s config invalid: %w", ext.Name, err)
+		}
+	}
+
+	return nil
+}
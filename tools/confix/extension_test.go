@@ -0,0 +1,117 @@
+package confix
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/creachadair/tomledit"
+	"github.com/creachadair/tomledit/parser"
+	"github.com/creachadair/tomledit/transform"
+	"github.com/spf13/viper"
+	"gotest.tools/v3/assert"
+)
+
+const testExtensionDefault = `
+[myapp]
+mode = "slow"
+`
+
+// renameModeMigration migrates the legacy myapp.old-mode key to myapp.mode.
+func renameModeMigration(from *tomledit.Document) transform.Plan {
+	oldEntry := getEntry(from, "myapp.old-mode")
+	if oldEntry == nil {
+		return nil
+	}
+
+	return transform.Plan{createUpdateStep("myapp.old-mode", "myapp.mode", oldEntry)}
+}
+
+func testExtension() Extension {
+	return Extension{
+		Name:       "myapp",
+		ConfigType: AppConfigType,
+		Default:    testExtensionDefault,
+		Migrations: map[string]func(*tomledit.Document) transform.Plan{
+			"v0.52": renameModeMigration,
+		},
+		Validate: func(v *viper.Viper) error {
+			if mode := v.GetString("myapp.mode"); mode != "slow" && mode != "fast" {
+				return errors.New("invalid mode")
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestRegisterExtension(t *testing.T) {
+	t.Cleanup(func() { extensions = nil })
+
+	assert.NilError(t, RegisterExtension(testExtension()))
+	assert.ErrorContains(t, RegisterExtension(testExtension()), "already registered")
+
+	ext := testExtension()
+	ext.Name = ""
+	assert.ErrorContains(t, RegisterExtension(ext), "extension name cannot be empty")
+
+	ext = testExtension()
+	ext.Name, ext.ConfigType = "other", "config"
+	assert.ErrorContains(t, RegisterExtension(ext), "unsupported config type")
+
+	ext = testExtension()
+	ext.Name, ext.Migrations = "other", map[string]func(*tomledit.Document) transform.Plan{"v0.0": renameModeMigration}
+	assert.ErrorContains(t, RegisterExtension(ext), "unknown version")
+
+	ext = testExtension()
+	ext.Name, ext.Default = "other", "[myapp"
+	assert.ErrorContains(t, RegisterExtension(ext), "failed to parse default config")
+
+	// the extension must not redefine a built-in section
+	ext = testExtension()
+	ext.Name, ext.Default = "other", "[api]\nenable = true\n"
+	assert.NilError(t, RegisterExtension(ext))
+	_, err := LoadLocalConfig("v0.52", AppConfigType)
+	assert.ErrorContains(t, err, "section api already defined")
+}
+
+func TestExtensionMigration(t *testing.T) {
+	t.Cleanup(func() { extensions = nil })
+	assert.NilError(t, RegisterExtension(testExtension()))
+
+	// the extension defaults are included in the app config only
+	doc, err := LoadLocalConfig("v0.52", AppConfigType)
+	assert.NilError(t, err)
+	assert.Assert(t, doc.First("myapp", "mode") != nil)
+
+	doc, err = LoadLocalConfig("v0.52", ClientConfigType)
+	assert.NilError(t, err)
+	assert.Assert(t, doc.First("myapp", "mode") == nil)
+
+	// migrate a v0.50 config with the legacy key of the extension
+	from, err := LoadConfig("data/v0.50-app.toml")
+	assert.NilError(t, err)
+	from.Sections = append(from.Sections, &tomledit.Section{
+		Heading: &parser.Heading{Name: parser.Key{"myapp"}},
+		Items:   []parser.Item{&parser.KeyValue{Name: parser.Key{"old-mode"}, Value: parser.MustValue(`"fast"`)}},
+	})
+
+	plan, formatDoc := PlanBuilder(from, "v0.52", AppConfigType)
+	plan = append(plan, ExtensionsPlan(from, "v0.52", AppConfigType)...)
+	assert.NilError(t, plan.Apply(context.Background(), formatDoc))
+
+	mode := formatDoc.First("myapp", "mode")
+	assert.Assert(t, mode != nil)
+	assert.Equal(t, `"fast"`, mode.Value.String())
+	assert.Assert(t, formatDoc.First("myapp", "old-mode") == nil)
+
+	var buf bytes.Buffer
+	assert.NilError(t, tomledit.Format(&buf, formatDoc))
+	assert.NilError(t, CheckValid(AppConfig, buf.Bytes()))
+
+	// the extension validates the config
+	invalid := strings.Replace(buf.String(), `mode = "fast"`, `mode = "unknown"`, 1)
+	assert.ErrorContains(t, CheckValid(AppConfig, []byte(invalid)), "myapp config invalid: invalid mode")
+}
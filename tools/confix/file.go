@@ -13,7 +13,8 @@ import (
 //go:embed data
 var data embed.FS
 
-// LoadLocalConfig loads and parses the TOML document from confix data
+// LoadLocalConfig loads and parses the TOML document from confix data,
+// including the default values of the registered extensions.
 func LoadLocalConfig(name, configType string) (*tomledit.Document, error) {
 	fileName, err := getFileName(name, configType)
 	if err != nil {
@@ -26,7 +27,16 @@ func LoadLocalConfig(name, configType string) (*tomledit.Document, error) {
 	}
 	defer f.Close()
 
-	return tomledit.Parse(f)
+	doc, err := tomledit.Parse(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := addExtensionDefaults(doc, configType); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
 }
 
 // LoadConfig loads and parses the TOML document from path.
@@ -65,7 +65,8 @@ func Upgrade(ctx context.Context, plan transform.Plan, doc *tomledit.Document, c
 }
 
 // CheckValid checks whether the specified config appears to be a valid Cosmos SDK config file.
-// It tries to unmarshal the config into both the server and client config structs.
+// It tries to unmarshal the config into both the server and client config structs,
+// and validates it with the registered extensions.
 func CheckValid(fileName string, data []byte) error {
 	v := viper.New()
 	v.SetConfigType("toml")
@@ -74,8 +75,10 @@ func CheckValid(fileName string, data []byte) error {
 		return fmt.Errorf("reading config: %w", err)
 	}
 
+	var configType string
 	switch {
 	case strings.HasSuffix(fileName, AppConfig):
+		configType = AppConfigType
 		var cfg srvcfg.Config
 		if err := v.Unmarshal(&cfg); err != nil {
 			return fmt.Errorf("failed to unmarshal as server config: %w", err)
@@ -85,6 +88,7 @@ func CheckValid(fileName string, data []byte) error {
 			return fmt.Errorf("server config invalid: %w", err)
 		}
 	case strings.HasSuffix(fileName, ClientConfig):
+		configType = ClientConfigType
 		var cfg clientcfg.ClientConfig
 		if err := v.Unmarshal(&cfg); err != nil {
 			return fmt.Errorf("failed to unmarshal as client config: %w", err)
@@ -100,5 +104,5 @@ func CheckValid(fileName string, data []byte) error {
 		return fmt.Errorf("unknown config: %s", fileName)
 	}
 
-	return nil
+	return validateExtensions(v, configType)
 }
@@ -38,6 +38,8 @@ const (
 	EnvTimeFormatLogs           = "COSMOVISOR_TIMEFORMAT_LOGS"
 	EnvCustomPreupgrade         = "COSMOVISOR_CUSTOM_PREUPGRADE"
 	EnvDisableRecase            = "COSMOVISOR_DISABLE_RECASE"
+	EnvAutoRollback             = "COSMOVISOR_AUTO_ROLLBACK"
+	EnvRollbackBlocks           = "COSMOVISOR_ROLLBACK_BLOCKS"
 )
 
 const (
@@ -46,6 +48,8 @@ const (
 	upgradesDir = "upgrades"
 	currentLink = "current"
 
+	rollbackInfoFileName = "rollback-info.json"
+
 	cfgFileName  = "config"
 	cfgExtension = "toml"
 )
@@ -68,6 +72,8 @@ type Config struct {
 	TimeFormatLogs           string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
 	CustomPreUpgrade         string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
 	DisableRecase            bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	AutoRollback             bool          `toml:"cosmovisor_auto_rollback" mapstructure:"cosmovisor_auto_rollback" default:"false"`
+	RollbackBlocks           uint64        `toml:"cosmovisor_rollback_blocks" mapstructure:"cosmovisor_rollback_blocks" default:"0"`
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -109,6 +115,11 @@ func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", upgradetypes.UpgradeInfoFilename)
 }
 
+// RollbackInfoFilePath is the file where the state needed to roll back the last upgrade is recorded.
+func (cfg *Config) RollbackInfoFilePath() string {
+	return filepath.Join(cfg.Root(), rollbackInfoFileName)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -241,6 +252,9 @@ func GetConfigFromEnv() (*Config, error) {
 	if cfg.DisableRecase, err = BooleanOption(EnvDisableRecase, false); err != nil {
 		errs = append(errs, err)
 	}
+	if cfg.AutoRollback, err = BooleanOption(EnvAutoRollback, false); err != nil {
+		errs = append(errs, err)
+	}
 
 	interval := os.Getenv(EnvInterval)
 	if interval != "" {
@@ -281,6 +295,11 @@ func GetConfigFromEnv() (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	envRollbackBlocksVal := os.Getenv(EnvRollbackBlocks)
+	if cfg.RollbackBlocks, err = strconv.ParseUint(envRollbackBlocksVal, 10, 64); err != nil && envRollbackBlocksVal != "" {
+		errs = append(errs, fmt.Errorf("%s could not be parsed to uint: %w", EnvRollbackBlocks, err))
+	}
+
 	errs = append(errs, cfg.validate()...)
 	if len(errs) > 0 {
 		return nil, errors.Join(errs...)
@@ -344,6 +363,11 @@ func (cfg *Config) validate() []error {
 
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
+		// a rollback restores the data directory from the backup taken before the upgrade
+		if cfg.AutoRollback {
+			errs = append(errs, fmt.Errorf("%s requires data backups, %s must be false", EnvAutoRollback, EnvSkipBackup))
+		}
+
 		return errs
 	}
 
@@ -545,6 +569,8 @@ func (cfg Config) DetailString() string {
 		{EnvTimeFormatLogs, cfg.TimeFormatLogs},
 		{EnvCustomPreupgrade, cfg.CustomPreUpgrade},
 		{EnvDisableRecase, fmt.Sprintf("%t", cfg.DisableRecase)},
+		{EnvAutoRollback, fmt.Sprintf("%t", cfg.AutoRollback)},
+		{EnvRollbackBlocks, fmt.Sprintf("%d", cfg.RollbackBlocks)},
 	}
 
 	derivedEntries := []struct{ name, value string }{
@@ -553,6 +579,7 @@ func (cfg Config) DetailString() string {
 		{"Genesis Bin", cfg.GenesisBin()},
 		{"Monitored File", cfg.UpgradeInfoFilePath()},
 		{"Data Backup Dir", cfg.DataBackupPath},
+		{"Rollback Info File", cfg.RollbackInfoFilePath()},
 	}
 
 	var sb strings.Builder
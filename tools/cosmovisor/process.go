@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -46,7 +47,7 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 	}
 
 	if err := plan.EnsureBinary(bin); err != nil {
-		return false, fmt.Errorf("current binary is invalid: %w", err)
+		return false, l.rollbackOnFailure(fmt.Errorf("current binary is invalid: %w", err))
 	}
 
 	l.logger.Info("running app", "path", bin, "args", args)
@@ -54,27 +55,46 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
 	if err := cmd.Start(); err != nil {
-		return false, fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err)
+		return false, l.rollbackOnFailure(fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err))
 	}
 
+	var terminated atomic.Bool
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
 	go func() {
 		sig := <-sigs
+		terminated.Store(true)
 		if err := cmd.Process.Signal(sig); err != nil {
 			l.logger.Error("terminated", "error", err, "bin", bin)
 			os.Exit(1)
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
-		return false, err
+	stopMonitor := func() {}
+	if l.cfg.AutoRollback {
+		stopMonitor = l.monitorUpgradeHeight()
+	}
+
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	stopMonitor()
+	if err != nil {
+		// a failure caused by the operator stopping the app does not trigger a rollback
+		if terminated.Load() {
+			return false, err
+		}
+
+		return false, l.rollbackOnFailure(err)
+	}
+
+	if !needsUpdate {
+		return false, nil
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		backupDir, err := l.doBackup()
+		if err != nil {
 			return false, err
 		}
 
@@ -82,12 +102,19 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 			return false, err
 		}
 
+		if l.cfg.AutoRollback {
+			if err := l.recordRollbackInfo(backupDir); err != nil {
+				return false, fmt.Errorf("error while recording rollback info: %w", err)
+			}
+		}
+
 		if err := UpgradeBinary(l.logger, l.cfg, l.fw.currentInfo); err != nil {
-			return false, err
+			// the upgrade was not applied, there is nothing to roll back
+			return false, errors.Join(err, l.cfg.clearRollbackInfo())
 		}
 
 		if err = l.doPreUpgrade(); err != nil {
-			return false, err
+			return false, l.rollbackOnFailure(err)
 		}
 
 		return true, nil
@@ -161,22 +188,24 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// doBackup takes a backup of the data directory, unless UNSAFE_SKIP_BACKUP is set.
+// It returns the backup directory, empty if no backup was taken.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(l.cfg.UpgradeInfoFilePath())
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return errors.New("upgrade-info.json is empty")
+			return "", errors.New("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -188,15 +217,17 @@ func (l Launcher) doBackup() error {
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
 		et := time.Now()
 		l.logger.Info("backup completed", "backup saved at", dst, "backup completion time", et, "time taken to complete backup", et.Sub(st))
+
+		return dst, nil
 	}
 
-	return nil
+	return "", nil
 }
 
 // doCustomPreUpgrade executes the custom preupgrade script if provided.
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/fs"
+	"os"
 	"path/filepath"
 	"sync"
 	"testing"
@@ -194,6 +195,95 @@ func (s *processTestSuite) TestPlanShutdownGrace() {
 	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
 }
 
+// TestLaunchProcessWithRollback will upgrade to a binary that fails and check the upgrade is rolled back
+func (s *processTestSuite) TestLaunchProcessWithRollback() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: home, AutoRollback: true}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	stdout, stderr := newBuffer(), newBuffer()
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	upgradeFile := cfg.UpgradeInfoFilePath()
+
+	args := []string{"foo", "bar", "1234", upgradeFile}
+	doUpgrade, err := launcher.Run(args, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	// the upgrade awaits confirmation
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+
+	info, err := cfg.RollbackInfo()
+	require.NoError(err)
+	require.NotNil(info)
+	require.Equal("chain2", info.Upgrade.Name)
+	require.Equal(int64(49), info.Upgrade.Height)
+	require.DirExists(info.BackupDir)
+
+	// the new binary writes to the data directory and fails
+	corrupted := filepath.Join(home, "data", "corrupted")
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = launcher.Run([]string{corrupted}, stdout, stderr)
+	require.ErrorContains(err, `upgrade "chain2" failed and was rolled back`)
+	require.False(doUpgrade)
+	require.Equal("Chain 2 is broken!\n", stdout.String())
+
+	// the data directory and the binary from before the upgrade are restored
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.GenesisBin(), currentBin)
+	require.NoFileExists(corrupted)
+	// the upgrade instructions of the failed upgrade are not restored
+	require.NoFileExists(upgradeFile)
+
+	info, err = cfg.RollbackInfo()
+	require.NoError(err)
+	require.Nil(info)
+}
+
+// TestLaunchProcessWithRollbackMissingBackup will upgrade to a binary that fails and check the
+// data directory is kept when its backup is missing
+func (s *processTestSuite) TestLaunchProcessWithRollbackMissingBackup() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: home, AutoRollback: true}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	stdout, stderr := newBuffer(), newBuffer()
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	// the backup is pruned before the upgrade is confirmed
+	info, err := cfg.RollbackInfo()
+	require.NoError(err)
+	require.NotNil(info)
+	require.NoError(os.RemoveAll(info.BackupDir))
+
+	corrupted := filepath.Join(home, "data", "corrupted")
+	stdout.Reset()
+	stderr.Reset()
+	_, err = launcher.Run([]string{corrupted}, stdout, stderr)
+	require.ErrorContains(err, `rollback of upgrade "chain2" failed: error while reading data backup`)
+
+	// the data directory and the upgraded binary are kept
+	require.FileExists(corrupted)
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+}
+
 // TestLaunchProcess will try running the script a few times and watch upgrades work properly
 // and args are passed through
 func (s *processTestSuite) TestLaunchProcessWithDownloads() {
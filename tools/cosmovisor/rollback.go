@@ -0,0 +1,206 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/otiai10/copy"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// RollbackInfo is the state recorded before applying an upgrade, used to roll it back
+// when the new binary fails before the upgrade is confirmed.
+type RollbackInfo struct {
+	// Upgrade is the upgrade being applied.
+	Upgrade upgradetypes.Plan `json:"upgrade"`
+	// BackupDir is the backup of the data directory taken before the upgrade.
+	BackupDir string `json:"backup_dir"`
+	// PreviousDir is the directory the current link pointed to before the upgrade.
+	PreviousDir string `json:"previous_dir"`
+}
+
+// RollbackInfo returns the rollback info of the upgrade awaiting confirmation.
+// It returns nil if there is no such upgrade.
+func (cfg *Config) RollbackInfo() (*RollbackInfo, error) {
+	bz, err := os.ReadFile(cfg.RollbackInfoFilePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error while reading %s: %w", rollbackInfoFileName, err)
+	}
+
+	var info RollbackInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return nil, fmt.Errorf("error while parsing %s: %w", rollbackInfoFileName, err)
+	}
+
+	return &info, nil
+}
+
+// clearRollbackInfo removes the rollback info, marking the last upgrade as confirmed.
+func (cfg *Config) clearRollbackInfo() error {
+	if err := os.Remove(cfg.RollbackInfoFilePath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// recordRollbackInfo records the state needed to roll back the upgrade about to be applied.
+func (l Launcher) recordRollbackInfo(backupDir string) error {
+	previousDir, err := os.Readlink(filepath.Join(l.cfg.Root(), currentLink))
+	if err != nil {
+		previousDir = filepath.Join(l.cfg.Root(), genesisDir)
+	}
+
+	bz, err := json.Marshal(RollbackInfo{
+		Upgrade:     l.fw.currentInfo,
+		BackupDir:   backupDir,
+		PreviousDir: previousDir,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(l.cfg.RollbackInfoFilePath(), bz, 0o600)
+}
+
+// monitorUpgradeHeight confirms the upgrade awaiting confirmation, if any, once the chain
+// reaches COSMOVISOR_ROLLBACK_BLOCKS blocks after the upgrade height.
+// The returned function stops the monitoring and waits for it to finish.
+func (l Launcher) monitorUpgradeHeight() func() {
+	info, err := l.cfg.RollbackInfo()
+	if err != nil || info == nil {
+		return func() {}
+	}
+
+	target := info.Upgrade.Height + int64(l.cfg.RollbackBlocks)
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(l.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				height, err := l.fw.checkHeight()
+				if err != nil || height < target {
+					continue
+				}
+
+				if err := l.cfg.clearRollbackInfo(); err != nil {
+					l.logger.Error("failed to clear rollback info", "error", err)
+					return
+				}
+
+				l.logger.Info("upgrade confirmed, automatic rollback disabled", "upgrade", info.Upgrade.Name, "height", height)
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// rollbackOnFailure rolls back the upgrade awaiting confirmation, if any, after the
+// app failed with the given error. It returns the error to report.
+func (l Launcher) rollbackOnFailure(cause error) error {
+	if !l.cfg.AutoRollback {
+		return cause
+	}
+
+	info, err := l.cfg.RollbackInfo()
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+
+	if info == nil {
+		return cause
+	}
+
+	l.logger.Error("upgraded app failed before the upgrade was confirmed, rolling back", "upgrade", info.Upgrade.Name, "error", cause)
+	if err := l.doRollback(info); err != nil {
+		return errors.Join(cause, fmt.Errorf("rollback of upgrade %q failed: %w", info.Upgrade.Name, err))
+	}
+
+	return fmt.Errorf("upgrade %q failed and was rolled back, replace its binary before restarting: %w", info.Upgrade.Name, cause)
+}
+
+// doRollback restores the data directory from the backup taken before the upgrade
+// and points the current link back to the binary that was running before it.
+func (l Launcher) doRollback(info *RollbackInfo) error {
+	st := time.Now()
+	l.logger.Info("starting to restore data directory", "backup", info.BackupDir)
+
+	// the data directory is only replaced once the backup is fully restored next to it,
+	// so that it is kept if the backup is missing or can't be copied
+	entries, err := os.ReadDir(info.BackupDir)
+	if err != nil {
+		return fmt.Errorf("error while reading data backup: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return fmt.Errorf("data backup %s is empty", info.BackupDir)
+	}
+
+	restoreDir, err := os.MkdirTemp(l.cfg.Home, "data-restore-")
+	if err != nil {
+		return fmt.Errorf("error while creating restore directory: %w", err)
+	}
+	defer os.RemoveAll(restoreDir)
+
+	if err := copy.Copy(info.BackupDir, restoreDir); err != nil {
+		return fmt.Errorf("error while restoring data backup: %w", err)
+	}
+
+	dataDir := filepath.Join(l.cfg.Home, "data")
+	if err := os.RemoveAll(dataDir); err != nil {
+		return fmt.Errorf("error while removing data directory: %w", err)
+	}
+
+	if err := os.Rename(restoreDir, dataDir); err != nil {
+		return fmt.Errorf("error while moving restored data directory: %w", err)
+	}
+
+	// the backup was taken after the app wrote the upgrade instructions, which would trigger
+	// the upgrade again on the next start. The app writes them again when it halts at the
+	// upgrade height.
+	if err := os.Remove(l.cfg.UpgradeInfoFilePath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error while removing %s from the restored data directory: %w", upgradetypes.UpgradeInfoFilename, err)
+	}
+
+	link := filepath.Join(l.cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove existing link: %w", err)
+	}
+
+	if err := os.Symlink(info.PreviousDir, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+
+	// the upgrade info is read again from the restored current directory
+	l.cfg.currentUpgrade = upgradetypes.Plan{}
+
+	if err := l.cfg.clearRollbackInfo(); err != nil {
+		return err
+	}
+
+	et := time.Now()
+	l.logger.Info("rollback completed", "upgrade", info.Upgrade.Name, "binary dir", info.PreviousDir, "time taken to complete rollback", et.Sub(st))
+
+	return nil
+}
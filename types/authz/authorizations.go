@@ -16,3 +16,11 @@ type AcceptResponse struct {
 	// it must use the updated version and handle the update on the storage level.
 	Updated sdk.Msg
 }
+
+// GasCostPerIteration is the gas consumed by the authorizations for each item
+// they iterate over when accepting a msg, such as an allowed address or a value
+// of a constraint. An iteration only compares values already loaded in memory,
+// so it is priced at 10 gas, two orders of magnitude below the flat cost of a KV
+// store read (storetypes.KVGasConfig), which is just enough to bound the cost of
+// the authorizations holding long lists.
+const GasCostPerIteration = uint64(10)
@@ -80,3 +80,15 @@ func BenchmarkIntMarshal(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkTypedEventToEvent(b *testing.B) {
+	coin := sdk.NewCoin("stake", sdkmath.NewInt(1999999))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := sdk.TypedEventToEvent(&coin)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -61,6 +61,7 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.Info
 	headerInfo           header.Info
+	iteratorPrefetch     int
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -89,6 +90,7 @@ func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.trans
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 func (c Context) CometInfo() comet.Info                         { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) IteratorPrefetch() int                         { return c.iteratorPrefetch }
 
 // BlockHeader returns the header by value.
 func (c Context) BlockHeader() cmtproto.Header {
@@ -294,6 +296,16 @@ func (c Context) WithPriority(p int64) Context {
 	return c
 }
 
+// WithIteratorPrefetch returns a Context whose KVStore iterators read ahead the
+// entries of the underlying stores in batches of the given size, overlapping the
+// backend reads with the processing of the entries. It is meant for large
+// read-only scans, such as genesis exports: the stores must not be written
+// while such an iterator is open. A size of 0 disables the read-ahead.
+func (c Context) WithIteratorPrefetch(size int) Context {
+	c.iteratorPrefetch = size
+	return c
+}
+
 // WithStreamingManager returns a Context with an updated streaming manager
 func (c Context) WithStreamingManager(sm storetypes.StreamingManager) Context {
 	c.streamingManager = sm
@@ -338,7 +350,12 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	parent := c.ms.GetKVStore(key)
+	if c.iteratorPrefetch > 0 {
+		parent = newPrefetchStore(parent, c.iteratorPrefetch)
+	}
+
+	return gaskv.NewStore(parent, c.gasMeter, c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
@@ -229,3 +229,42 @@ func (s *contextTestSuite) TestUnwrapSDKContext() {
 	sdkCtx2 = types.UnwrapSDKContext(ctx)
 	s.Require().Equal(sdkCtx, sdkCtx2)
 }
+
+func (s *contextTestSuite) TestIteratorPrefetch() {
+	key := storetypes.NewKVStoreKey(s.T().Name() + "_TestIteratorPrefetch")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
+	store := ctx.KVStore(key)
+	for i := byte(0); i < 10; i++ {
+		store.Set([]byte{i}, []byte{i, i})
+	}
+
+	collect := func(it storetypes.Iterator) (pairs [][2][]byte) {
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			pairs = append(pairs, [2][]byte{it.Key(), it.Value()})
+		}
+		s.Require().NoError(it.Error())
+		return pairs
+	}
+
+	for _, size := range []int{1, 3, 10, 20} {
+		ctx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		prefetchCtx := ctx.WithIteratorPrefetch(size).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		s.Require().Equal(size, prefetchCtx.IteratorPrefetch())
+
+		// the prefetching iterators iterate and consume gas as the others
+		s.Require().Equal(collect(ctx.KVStore(key).Iterator(nil, nil)), collect(prefetchCtx.KVStore(key).Iterator(nil, nil)))
+		s.Require().Equal(collect(ctx.KVStore(key).ReverseIterator([]byte{2}, []byte{8})), collect(prefetchCtx.KVStore(key).ReverseIterator([]byte{2}, []byte{8})))
+		s.Require().Equal(ctx.GasMeter().GasConsumed(), prefetchCtx.GasMeter().GasConsumed())
+
+		// the iterators can be closed before being exhausted
+		it := prefetchCtx.KVStore(key).Iterator([]byte{2}, nil)
+		start, end := it.Domain()
+		s.Require().Equal([]byte{2}, start)
+		s.Require().Nil(end)
+		s.Require().Equal([]byte{2}, it.Key())
+		it.Next()
+		s.Require().Equal([]byte{3, 3}, it.Value())
+		s.Require().NoError(it.Close())
+	}
+}
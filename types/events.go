@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -40,6 +42,71 @@ func NewEventManager() *EventManager {
 	return &EventManager{EmptyEvents()}
 }
 
+var (
+	// eventManagerPool is a pool of event managers, reused across the executions
+	// of txs to reduce memory allocations.
+	eventManagerPool = sync.Pool{
+		New: func() interface{} {
+			return &EventManager{make(Events, 0, eventCountHint())}
+		},
+	}
+
+	// eventCountAvg is an exponentially weighted moving average of the number of
+	// events emitted through the pooled event managers, used to preallocate their
+	// events. It is stored in fixed point with eventCountAvgShift fractional bits,
+	// so that it still converges when it differs from the samples by a few events.
+	eventCountAvg atomic.Int64
+)
+
+const (
+	eventCountAvgShift  = 4 // number of fractional bits of eventCountAvg
+	eventCountAvgWeight = 8 // inverse of the weight of a sample in eventCountAvg
+)
+
+// eventCountHint returns the moving average of the number of events, rounded to
+// the nearest integer.
+func eventCountHint() int64 {
+	return (eventCountAvg.Load() + 1<<(eventCountAvgShift-1)) >> eventCountAvgShift
+}
+
+// recordEventCount adds a sample to the moving average of the number of events.
+// The average is updated with a compare-and-swap, so that concurrent releases do
+// not overwrite each other's samples.
+func recordEventCount(n int) {
+	sample := int64(n) << eventCountAvgShift
+	for {
+		avg := eventCountAvg.Load()
+		if eventCountAvg.CompareAndSwap(avg, avg+(sample-avg)/eventCountAvgWeight) {
+			return
+		}
+	}
+}
+
+// AcquireEventManager returns an empty EventManager from a pool. The caller must
+// release it with ReleaseEventManager once neither the EventManager nor the
+// slice returned by its Events method are referenced anymore. The events
+// converted with ABCIEvents can still be used after the release.
+func AcquireEventManager() *EventManager {
+	return eventManagerPool.Get().(*EventManager)
+}
+
+// ReleaseEventManager removes the events of an EventManager returned by
+// AcquireEventManager and returns it to the pool.
+func ReleaseEventManager(em *EventManager) {
+	recordEventCount(len(em.events))
+	hint := eventCountHint()
+
+	// the events are reallocated when they grew well past the usual number of
+	// events, so that the pool does not retain the memory of a few large txs
+	if int64(cap(em.events)) > 4*hint+16 {
+		em.events = make(Events, 0, hint)
+	} else {
+		clear(em.events)
+		em.events = em.events[:0]
+	}
+	eventManagerPool.Put(em)
+}
+
 func (em *EventManager) Events() Events { return em.events }
 
 // EmitEvent stores a single Event object.
@@ -72,22 +139,46 @@ func (em *EventManager) EmitTypedEvent(tev proto.Message) error {
 
 // EmitTypedEvents takes series of typed events and emit
 func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
-	events := make(Events, len(tevs))
-	for i, tev := range tevs {
+	// no event is emitted if any typed event fails to convert
+	n := len(em.events)
+	em.events = slices.Grow(em.events, len(tevs))
+	for _, tev := range tevs {
 		res, err := TypedEventToEvent(tev)
 		if err != nil {
+			clear(em.events[n:])
+			em.events = em.events[:n]
 			return err
 		}
-		events[i] = res
+		em.events = append(em.events, res)
 	}
 
-	em.EmitEvents(events)
 	return nil
 }
 
-// TypedEventToEvent takes typed event and converts to Event object
+// TypedEventToEvent takes typed event and converts to Event object.
+// The attributes of the event are the fields of the typed event, with their
+// values encoded as in the JSON encoding of the typed event. The typed events
+// whose fields are all scalars or custom types are converted without encoding
+// the whole typed event to JSON.
 func TypedEventToEvent(tev proto.Message) (Event, error) {
-	evtType := proto.MessageName(tev)
+	info := getTypedEventInfo(tev)
+	if info.fields != nil {
+		attrs, err := info.attributes(tev)
+		if err != nil {
+			return Event{}, err
+		}
+
+		return Event{
+			Type:       info.eventType,
+			Attributes: attrs,
+		}, nil
+	}
+
+	return typedEventToEventJSON(tev, info)
+}
+
+// typedEventToEventJSON converts a typed event to an Event through its JSON encoding.
+func typedEventToEventJSON(tev proto.Message, info *typedEventInfo) (Event, error) {
 	evtJSON, err := codec.ProtoMarshalJSON(tev, nil)
 	if err != nil {
 		return Event{}, err
@@ -106,6 +197,9 @@ func TypedEventToEvent(tev proto.Message) (Event, error) {
 	attrs := make([]abci.EventAttribute, 0, len(attrMap))
 	for _, k := range keys {
 		v := attrMap[k]
+		if key, ok := info.keys[k]; ok {
+			k = key
+		}
 		attrs = append(attrs, abci.EventAttribute{
 			Key:   k,
 			Value: string(v),
@@ -113,7 +207,7 @@ func TypedEventToEvent(tev proto.Message) (Event, error) {
 	}
 
 	return Event{
-		Type:       evtType,
+		Type:       info.eventType,
 		Attributes: attrs,
 	}, nil
 }
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventCountHint(t *testing.T) {
+	eventCountAvg.Store(0)
+	t.Cleanup(func() { eventCountAvg.Store(0) })
+
+	// the average converges even when the samples are close to it
+	for i := 0; i < 100; i++ {
+		recordEventCount(3)
+	}
+	require.Equal(t, int64(3), eventCountHint())
+
+	for i := 0; i < 100; i++ {
+		recordEventCount(2)
+	}
+	require.Equal(t, int64(2), eventCountHint())
+
+	for i := 0; i < 100; i++ {
+		recordEventCount(0)
+	}
+	require.Equal(t, int64(0), eventCountHint())
+}
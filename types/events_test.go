@@ -6,13 +6,16 @@ import (
 	"testing"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type eventsTestSuite struct {
@@ -88,6 +91,22 @@ func (s *eventsTestSuite) TestEventManager() {
 	s.Require().Equal(em.Events(), events.AppendEvent(event))
 }
 
+func (s *eventsTestSuite) TestEventManagerPool() {
+	em := sdk.AcquireEventManager()
+	em.EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("sender", "foo")))
+	abciEvents := em.ABCIEvents()
+	sdk.ReleaseEventManager(em)
+
+	// the converted events are not altered by the reuse of the event manager
+	em = sdk.AcquireEventManager()
+	s.Require().Empty(em.Events())
+	em.EmitEvent(sdk.NewEvent("reward", sdk.NewAttribute("x", "y")))
+	s.Require().Len(em.Events(), 1)
+	s.Require().Equal("transfer", abciEvents[0].Type)
+	s.Require().Equal("sender", abciEvents[0].Attributes[0].Key)
+	sdk.ReleaseEventManager(em)
+}
+
 func (s *eventsTestSuite) TestEmitTypedEvent() {
 	s.Run("deterministic key-value order", func() {
 		for i := 0; i < 10; i++ {
@@ -103,6 +122,51 @@ func (s *eventsTestSuite) TestEmitTypedEvent() {
 	})
 }
 
+func (s *eventsTestSuite) TestTypedEventToEvent() {
+	animal, err := codectypes.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield", Lives: 6})
+	s.Require().NoError(err)
+	maxInt := math.NewIntFromUint64(1<<63 + 1)
+
+	for _, tev := range []proto.Message{
+		&sdk.Coin{Denom: "stake", Amount: math.NewInt(1999999)},
+		&sdk.Coin{Denom: "a<b>&\"c\\é\u2028\n", Amount: maxInt.Neg()},
+		&sdk.DecCoin{Denom: "stake", Amount: math.LegacyNewDecWithPrec(15, 1)},
+		&testdata.Cat{Moniker: "Garfield", Lives: -6},
+		&testdata.TableModel{Id: 1 << 63, Name: "table", Metadata: []byte{0, 1, 255}},
+		&testdata.TableModel{Metadata: []byte{}},
+		&query.PageRequest{Offset: 1, CountTotal: true},
+		&testdata.HasAnimal{X: 1000, Animal: animal},
+		&testdata.BadMultiSignature{Signatures: [][]byte{{1}}},
+	} {
+		// the events hold the JSON encoding of each field of the typed event
+		bz, err := codec.ProtoMarshalJSON(tev, nil)
+		s.Require().NoError(err)
+		var fields map[string]json.RawMessage
+		s.Require().NoError(json.Unmarshal(bz, &fields))
+
+		event, err := sdk.TypedEventToEvent(tev)
+		s.Require().NoError(err)
+		s.Require().Equal(proto.MessageName(tev), event.Type)
+		s.Require().Len(event.Attributes, len(fields))
+		for i, attr := range event.Attributes {
+			if i > 0 {
+				s.Require().Less(event.Attributes[i-1].Key, attr.Key)
+			}
+			s.Require().Equal(string(fields[attr.Key]), attr.Value, "%s: %s", event.Type, attr.Key)
+		}
+	}
+}
+
+func (s *eventsTestSuite) TestEmitTypedEventsError() {
+	em := sdk.NewEventManager()
+	em.EmitEvent(sdk.NewEvent("transfer"))
+
+	// no event is emitted if any typed event fails to convert
+	invalid := &testdata.HasAnimal{Animal: &codectypes.Any{TypeUrl: "/unknown"}}
+	s.Require().Error(em.EmitTypedEvents(&sdk.Coin{Denom: "stake", Amount: math.OneInt()}, invalid))
+	s.Require().Len(em.Events(), 1)
+}
+
 func (s *eventsTestSuite) TestEventManagerTypedEvents() {
 	em := sdk.NewEventManager()
 
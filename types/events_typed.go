@@ -0,0 +1,185 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+)
+
+var (
+	jsonMarshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonpbMarshalerType   = reflect.TypeOf((*jsonpb.JSONPBMarshaler)(nil)).Elem()
+	unpackInterfacesType  = reflect.TypeOf((*gogoprotoany.UnpackInterfacesMessage)(nil)).Elem()
+	wellKnownType         = reflect.TypeOf((*interface{ XXX_WellKnownType() string })(nil)).Elem()
+	timeType              = reflect.TypeOf(time.Time{})
+	bytesType             = reflect.TypeOf([]byte(nil))
+	typedEventInfos       sync.Map // reflect.Type -> *typedEventInfo
+	typedEventFieldValues = map[reflect.Type]func(reflect.Value) (string, error){
+		reflect.TypeOf(""):        stringFieldValue,
+		reflect.TypeOf(false):     func(v reflect.Value) (string, error) { return strconv.FormatBool(v.Bool()), nil },
+		reflect.TypeOf(int32(0)):  func(v reflect.Value) (string, error) { return strconv.FormatInt(v.Int(), 10), nil },
+		reflect.TypeOf(uint32(0)): func(v reflect.Value) (string, error) { return strconv.FormatUint(v.Uint(), 10), nil },
+		reflect.TypeOf(int64(0)):  func(v reflect.Value) (string, error) { return strconv.Quote(strconv.FormatInt(v.Int(), 10)), nil },
+		reflect.TypeOf(uint64(0)): func(v reflect.Value) (string, error) { return strconv.Quote(strconv.FormatUint(v.Uint(), 10)), nil },
+		bytesType:                 bytesFieldValue,
+	}
+)
+
+// typedEventInfo holds how the typed events of a proto message type are
+// converted to events.
+type typedEventInfo struct {
+	// eventType is the type of the events, the name of the proto message.
+	eventType string
+	// fields are the fields of the message sorted by attribute key, or nil if
+	// the events must be converted through the JSON encoding of the message.
+	fields []typedEventField
+	// keys interns the attribute keys of the message, so that the events
+	// converted through JSON encoding share their keys.
+	keys map[string]string
+}
+
+// typedEventField is a message field converted to an event attribute.
+type typedEventField struct {
+	index int
+	key   string
+	value func(reflect.Value) (string, error)
+}
+
+// getTypedEventInfo returns how the typed events of the type of tev are
+// converted to events.
+func getTypedEventInfo(tev proto.Message) *typedEventInfo {
+	t := reflect.TypeOf(tev)
+	if info, ok := typedEventInfos.Load(t); ok {
+		return info.(*typedEventInfo)
+	}
+
+	info := newTypedEventInfo(tev, t)
+	typedEventInfos.Store(t, info)
+	return info
+}
+
+func newTypedEventInfo(tev proto.Message, t reflect.Type) *typedEventInfo {
+	info := &typedEventInfo{
+		eventType: proto.MessageName(tev),
+		keys:      map[string]string{},
+	}
+
+	// the messages with a custom JSON encoding or holding interfaces are
+	// converted through JSON encoding
+	direct := t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct &&
+		!t.Implements(jsonpbMarshalerType) && !t.Implements(unpackInterfacesType) && !t.Implements(wellKnownType)
+	if !direct {
+		return info
+	}
+
+	st := t.Elem()
+	fields := make([]typedEventField, 0, st.NumField())
+	for i := 0; i < st.NumField(); i++ {
+		field := st.Field(i)
+		if strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+		if field.Tag.Get("protobuf_oneof") != "" {
+			direct = false
+			continue
+		}
+
+		tag := field.Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+
+		var key string
+		isEnum := false
+		for _, opt := range strings.Split(tag, ",") {
+			switch {
+			case strings.HasPrefix(opt, "name="):
+				key = strings.TrimPrefix(opt, "name=")
+			case strings.HasPrefix(opt, "enum="):
+				isEnum = true
+			}
+		}
+		info.keys[key] = key
+
+		value := typedEventFieldValue(field.Type)
+		if value == nil || isEnum {
+			direct = false
+			continue
+		}
+
+		fields = append(fields, typedEventField{index: i, key: key, value: value})
+	}
+
+	if direct {
+		slices.SortFunc(fields, func(a, b typedEventField) int { return strings.Compare(a.key, b.key) })
+		info.fields = fields
+	}
+
+	return info
+}
+
+// typedEventFieldValue returns the function encoding the values of a field of
+// type t as the JSON encoding of the message does, or nil if t is not supported.
+func typedEventFieldValue(t reflect.Type) func(reflect.Value) (string, error) {
+	if value, ok := typedEventFieldValues[t]; ok {
+		return value
+	}
+
+	// custom types, such as math.Int, are encoded with their own JSON encoding
+	if t.Kind() == reflect.Struct && t != timeType && t.Implements(jsonMarshalerType) {
+		return func(v reflect.Value) (string, error) {
+			bz, err := v.Interface().(json.Marshaler).MarshalJSON()
+			return string(bz), err
+		}
+	}
+
+	return nil
+}
+
+// attributes returns the attributes of a typed event.
+func (info *typedEventInfo) attributes(tev proto.Message) ([]abci.EventAttribute, error) {
+	v := reflect.ValueOf(tev).Elem()
+	attrs := make([]abci.EventAttribute, len(info.fields))
+	for i, field := range info.fields {
+		value, err := field.value(v.Field(field.index))
+		if err != nil {
+			return nil, err
+		}
+
+		attrs[i] = abci.EventAttribute{Key: field.key, Value: value}
+	}
+
+	return attrs, nil
+}
+
+// stringFieldValue encodes a string as JSON, without going through the JSON
+// encoder for strings which don't need to be escaped.
+func stringFieldValue(v reflect.Value) (string, error) {
+	s := v.String()
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c > 0x7e || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			bz, err := json.Marshal(s)
+			return string(bz), err
+		}
+	}
+
+	return `"` + s + `"`, nil
+}
+
+func bytesFieldValue(v reflect.Value) (string, error) {
+	if v.IsNil() {
+		return "null", nil
+	}
+
+	return `"` + base64.StdEncoding.EncodeToString(v.Bytes()) + `"`, nil
+}
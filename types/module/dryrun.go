@@ -0,0 +1,158 @@
+package module
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MigrationReport is the result of a migrations dry-run. It contains one entry
+// per module, in the order the migrations were executed.
+type MigrationReport struct {
+	Modules []ModuleMigrationReport `json:"modules"`
+}
+
+// ModuleMigrationReport describes what running the migrations of a single
+// module did to the branched state.
+type ModuleMigrationReport struct {
+	Module      string `json:"module"`
+	FromVersion uint64 `json:"from_version"`
+	ToVersion   uint64 `json:"to_version"`
+	// InitGenesis is true when the module was not present in the from version map
+	// and InitGenesis was run with its default genesis instead of migrations.
+	InitGenesis bool          `json:"init_genesis"`
+	Duration    time.Duration `json:"duration"`
+	ChangedKeys []ChangedKey  `json:"changed_keys"`
+	Error       string        `json:"error,omitempty"`
+}
+
+// ChangedKey is a key written or deleted by a module migration.
+type ChangedKey struct {
+	Store   string `json:"store"`
+	Key     []byte `json:"key"`
+	Deleted bool   `json:"deleted"`
+}
+
+// HasErrors returns true if the migrations of at least one module failed.
+func (r MigrationReport) HasErrors() bool {
+	for _, m := range r.Modules {
+		if m.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// DryRunMigrations runs the same migrations as RunMigrations against a branch of
+// the state held by ctx, and discards the branch afterwards. Nothing is ever
+// written to the underlying state.
+//
+// Each module runs on top of the changes made by the modules migrated before it,
+// so the report reflects what RunMigrations would do. The first failing module
+// stops the dry-run; its error is recorded in the report instead of being
+// returned, so that operators get the report for all the modules that ran before it.
+//
+// Example:
+//
+//	report, err := app.ModuleManager.DryRunMigrations(ctx, app.Configurator(), fromVM)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, m := range report.Modules {
+//	    ctx.Logger().Info("dry-run migration", "module", m.Module, "changed_keys", len(m.ChangedKeys), "duration", m.Duration)
+//	}
+func (m Manager) DryRunMigrations(ctx context.Context, cfg Configurator, fromVM appmodule.VersionMap) (MigrationReport, error) {
+	c, ok := cfg.(*configurator)
+	if !ok {
+		return MigrationReport{}, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &configurator{}, cfg)
+	}
+	modules := m.OrderMigrations
+	if modules == nil {
+		modules = DefaultMigrationsOrder(m.ModuleNames())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// branch holds the changes of all the migrated modules and is never written.
+	branch := sdkCtx.MultiStore().CacheMultiStore()
+
+	report := MigrationReport{}
+	for _, moduleName := range modules {
+		fromVersion, exists := fromVM[moduleName]
+		moduleReport := ModuleMigrationReport{
+			Module:      moduleName,
+			FromVersion: fromVersion,
+			InitGenesis: !exists,
+		}
+
+		// Changes of the module are traced when they are flushed to the branch,
+		// so only written and deleted keys end up in the trace.
+		var trace bytes.Buffer
+		moduleStore := branch.SetTracer(&trace).CacheMultiStore()
+		moduleCtx := sdkCtx.
+			WithMultiStore(moduleStore).
+			WithEventManager(sdk.NewEventManager())
+
+		start := time.Now()
+		toVersion, err := m.runModuleMigration(moduleCtx, c, moduleName, fromVM)
+		moduleReport.Duration = time.Since(start)
+		moduleReport.ToVersion = toVersion
+		if err != nil {
+			moduleReport.Error = err.Error()
+			report.Modules = append(report.Modules, moduleReport)
+			break
+		}
+
+		moduleStore.Write()
+		moduleReport.ChangedKeys, err = parseChangedKeys(&trace)
+		if err != nil {
+			return report, err
+		}
+
+		report.Modules = append(report.Modules, moduleReport)
+	}
+
+	return report, nil
+}
+
+// parseChangedKeys extracts the written and deleted keys from a store trace.
+func parseChangedKeys(trace *bytes.Buffer) ([]ChangedKey, error) {
+	var changed []ChangedKey
+	scanner := bufio.NewScanner(trace)
+	scanner.Buffer(make([]byte, 0, 64*1024), trace.Len()+1)
+	for scanner.Scan() {
+		var op struct {
+			Operation string         `json:"operation"`
+			Key       string         `json:"key"`
+			Metadata  map[string]any `json:"metadata"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return nil, fmt.Errorf("failed to decode store trace: %w", err)
+		}
+		if op.Operation != "write" && op.Operation != "delete" {
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode traced key: %w", err)
+		}
+		storeName, _ := op.Metadata["store_name"].(string)
+		changed = append(changed, ChangedKey{
+			Store:   storeName,
+			Key:     key,
+			Deleted: op.Operation == "delete",
+		})
+	}
+
+	return changed, scanner.Err()
+}
@@ -0,0 +1,79 @@
+package module_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type versionedModule struct {
+	version uint64
+}
+
+func (versionedModule) IsOnePerModuleType() {}
+func (versionedModule) IsAppModule()        {}
+
+func (m versionedModule) ConsensusVersion() uint64 { return m.version }
+
+func TestManager_DryRunMigrations(t *testing.T) {
+	key := storetypes.NewKVStoreKey("foo")
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx
+
+	ctx.KVStore(key).Set([]byte("old"), []byte("value"))
+
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"foo": versionedModule{version: 3},
+		"bar": versionedModule{version: 2},
+	})
+	mm.SetOrderMigrations("foo", "bar")
+
+	cfg := module.NewConfigurator(nil, nil, nil)
+	require.NoError(t, cfg.RegisterMigration("foo", 1, func(ctx sdk.Context) error {
+		ctx.KVStore(key).Set([]byte("new"), []byte("value"))
+		return nil
+	}))
+	require.NoError(t, cfg.RegisterMigration("foo", 2, func(ctx sdk.Context) error {
+		ctx.KVStore(key).Delete([]byte("old"))
+		return nil
+	}))
+	require.NoError(t, cfg.RegisterMigration("bar", 1, func(ctx sdk.Context) error {
+		// bar sees the changes made by foo's migrations
+		require.False(t, ctx.KVStore(key).Has([]byte("old")))
+		return nil
+	}))
+
+	report, err := mm.DryRunMigrations(ctx, cfg, appmodule.VersionMap{"foo": 1, "bar": 1})
+	require.NoError(t, err)
+	require.False(t, report.HasErrors())
+	require.Len(t, report.Modules, 2)
+
+	require.Equal(t, "foo", report.Modules[0].Module)
+	require.Equal(t, uint64(1), report.Modules[0].FromVersion)
+	require.Equal(t, uint64(3), report.Modules[0].ToVersion)
+	require.ElementsMatch(t, []module.ChangedKey{
+		{Store: "foo", Key: []byte("new")},
+		{Store: "foo", Key: []byte("old"), Deleted: true},
+	}, report.Modules[0].ChangedKeys)
+
+	require.Equal(t, "bar", report.Modules[1].Module)
+	require.Empty(t, report.Modules[1].ChangedKeys)
+
+	// nothing has been written to the state
+	require.True(t, ctx.KVStore(key).Has([]byte("old")))
+	require.False(t, ctx.KVStore(key).Has([]byte("new")))
+
+	// a missing migration is reported and stops the dry-run
+	report, err = mm.DryRunMigrations(ctx, cfg, appmodule.VersionMap{"foo": 0, "bar": 1})
+	require.NoError(t, err)
+	require.True(t, report.HasErrors())
+	require.Len(t, report.Modules, 1)
+	require.Contains(t, report.Modules[0].Error, "no migration found for module foo from version 0 to version 1")
+}
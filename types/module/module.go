@@ -317,21 +317,26 @@ func (m *Manager) DefaultGenesis() map[string]json.RawMessage {
 
 // ValidateGenesis performs genesis state validation for all modules
 func (m *Manager) ValidateGenesis(genesisData map[string]json.RawMessage) error {
-	for name, b := range m.Modules {
-		if mod, ok := b.(HasGenesisBasics); ok {
-			if err := mod.ValidateGenesis(genesisData[name]); err != nil {
-				return err
-			}
-		} else if mod, ok := b.(appmodule.HasGenesis); ok {
-			if err := mod.ValidateGenesis(genesisData[name]); err != nil {
-				return err
-			}
+	for name := range m.Modules {
+		if err := m.ValidateModuleGenesis(name, genesisData[name]); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// ValidateModuleGenesis performs genesis state validation for a single module
+func (m *Manager) ValidateModuleGenesis(moduleName string, genesisData json.RawMessage) error {
+	if mod, ok := m.Modules[moduleName].(HasGenesisBasics); ok {
+		return mod.ValidateGenesis(genesisData)
+	} else if mod, ok := m.Modules[moduleName].(appmodule.HasGenesis); ok {
+		return mod.ValidateGenesis(genesisData)
+	}
+
+	return nil
+}
+
 // RegisterGRPCGatewayRoutes registers all module rest routes
 func (m *Manager) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *runtime.ServeMux) {
 	for _, b := range m.Modules {
@@ -639,6 +644,8 @@ func (m *Manager) assertNoForgottenModules(setOrderFnName string, moduleNames []
 //	    return app.mm.RunMigrations(ctx, cfg, fromVM)
 //	})
 //
+// To rehearse an upgrade without committing any state change, use DryRunMigrations.
+//
 // Please also refer to https://docs.cosmos.network/main/core/upgrade for more information.
 func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM appmodule.VersionMap) (appmodule.VersionMap, error) {
 	c, ok := cfg.(*configurator)
@@ -653,45 +660,9 @@ func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM app
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	updatedVM := appmodule.VersionMap{}
 	for _, moduleName := range modules {
-		module := m.Modules[moduleName]
-		fromVersion, exists := fromVM[moduleName]
-		toVersion := uint64(0)
-		if module, ok := module.(appmodule.HasConsensusVersion); ok {
-			toVersion = module.ConsensusVersion()
-		}
-
-		// We run migration if the module is specified in `fromVM`.
-		// Otherwise we run InitGenesis.
-		//
-		// The module won't exist in the fromVM in two cases:
-		// 1. A new module is added. In this case we run InitGenesis with an
-		// empty genesis state.
-		// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
-		// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
-		if exists {
-			err := c.runModuleMigrations(sdkCtx, moduleName, fromVersion, toVersion)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			sdkCtx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
-			if module, ok := m.Modules[moduleName].(HasGenesis); ok {
-				if err := module.InitGenesis(sdkCtx, module.DefaultGenesis()); err != nil {
-					return nil, err
-				}
-			}
-			if module, ok := m.Modules[moduleName].(HasABCIGenesis); ok {
-				moduleValUpdates, err := module.InitGenesis(sdkCtx, module.DefaultGenesis())
-				if err != nil {
-					return nil, err
-				}
-
-				// The module manager assumes only one module will update the
-				// validator set, and it can't be a new module.
-				if len(moduleValUpdates) > 0 {
-					return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
-				}
-			}
+		toVersion, err := m.runModuleMigration(sdkCtx, c, moduleName, fromVM)
+		if err != nil {
+			return nil, err
 		}
 
 		updatedVM[moduleName] = toVersion
@@ -700,6 +671,55 @@ func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM app
 	return updatedVM, nil
 }
 
+// runModuleMigration migrates a single module from its version in fromVM to its
+// latest consensus version, or runs its InitGenesis if it is not in fromVM.
+// It returns the version the module has been migrated to.
+func (m Manager) runModuleMigration(sdkCtx sdk.Context, c *configurator, moduleName string, fromVM appmodule.VersionMap) (uint64, error) {
+	module := m.Modules[moduleName]
+	fromVersion, exists := fromVM[moduleName]
+	toVersion := uint64(0)
+	if module, ok := module.(appmodule.HasConsensusVersion); ok {
+		toVersion = module.ConsensusVersion()
+	}
+
+	// We run migration if the module is specified in `fromVM`.
+	// Otherwise we run InitGenesis.
+	//
+	// The module won't exist in the fromVM in two cases:
+	// 1. A new module is added. In this case we run InitGenesis with an
+	// empty genesis state.
+	// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
+	// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
+	if exists {
+		if err := c.runModuleMigrations(sdkCtx, moduleName, fromVersion, toVersion); err != nil {
+			return toVersion, err
+		}
+
+		return toVersion, nil
+	}
+
+	sdkCtx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+	if module, ok := module.(HasGenesis); ok {
+		if err := module.InitGenesis(sdkCtx, module.DefaultGenesis()); err != nil {
+			return toVersion, err
+		}
+	}
+	if module, ok := module.(HasABCIGenesis); ok {
+		moduleValUpdates, err := module.InitGenesis(sdkCtx, module.DefaultGenesis())
+		if err != nil {
+			return toVersion, err
+		}
+
+		// The module manager assumes only one module will update the
+		// validator set, and it can't be a new module.
+		if len(moduleValUpdates) > 0 {
+			return toVersion, errorsmod.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
+		}
+	}
+
+	return toVersion, nil
+}
+
 // PreBlock performs begin block functionality for upgrade module.
 // It takes the current context as a parameter and returns a boolean value
 // indicating whether the migration was successfully executed or not.
@@ -6,11 +6,13 @@ import "testing"
 type Config struct {
 	GenesisFile string // custom simulation genesis file; cannot be used with params file
 	ParamsFile  string // custom simulation params file which overrides any random params; cannot be used with genesis
+	ProfileFile string // custom simulation profile file (TOML or JSON) with operation weights, params and disabled modules; cannot be used with params
 
 	ExportParamsPath   string // custom file path to save the exported params JSON
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	TracePath          string // custom file path to record the simulated blocks and txs for replay
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
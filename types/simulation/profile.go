@@ -0,0 +1,150 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Profile is a simulation profile loaded from a TOML or JSON file at simulation start. It lets
+// the operation weights, the simulation params and the simulated modules be changed without
+// code changes, so that multiple fuzzing profiles can be maintained for an app.
+//
+// An example TOML profile:
+//
+//	disabled_modules = ["nft", "group"]
+//
+//	[weights]
+//	op_weight_msg_send = 100
+//	op_weight_msg_multisend = 0
+//
+//	[params]
+//	send_enabled = true
+//
+//	[ranges]
+//	max_memo_characters = { min = 100, max = 500 }
+type Profile struct {
+	// Weights are the weights of the operations, by their simulation params key.
+	Weights map[string]int `json:"weights" toml:"weights"`
+	// Params are fixed values of simulation params, by their key.
+	Params map[string]any `json:"params" toml:"params"`
+	// Ranges are the integer ranges from which simulation params are drawn for every seed, by their key.
+	Ranges map[string]ParamRange `json:"ranges" toml:"ranges"`
+	// DisabledModules are the names of the modules of which the operations are not simulated.
+	DisabledModules []string `json:"disabled_modules" toml:"disabled_modules"`
+}
+
+// ParamRange is an inclusive range of integer values for a simulation param.
+type ParamRange struct {
+	Min int64 `json:"min" toml:"min"`
+	Max int64 `json:"max" toml:"max"`
+}
+
+// ReadProfile reads a simulation profile from a file. Files with the .toml extension are decoded
+// as TOML, and the other ones as JSON.
+func ReadProfile(path string) (Profile, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	var profile Profile
+	if filepath.Ext(path) == ".toml" {
+		decoder := toml.NewDecoder(bytes.NewReader(bz))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&profile)
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(bz))
+		decoder.DisallowUnknownFields()
+		decoder.UseNumber()
+		err = decoder.Decode(&profile)
+	}
+	if err != nil {
+		return Profile{}, fmt.Errorf("failed to decode simulation profile %s: %w", path, err)
+	}
+
+	return profile, profile.Validate()
+}
+
+// Validate checks that the profile doesn't set a param more than once, and that its ranges are valid.
+func (p Profile) Validate() error {
+	keys := make(map[string]bool, len(p.Weights)+len(p.Params)+len(p.Ranges))
+	for key := range p.Weights {
+		keys[key] = true
+	}
+	for key := range p.Params {
+		if keys[key] {
+			return fmt.Errorf("simulation param %s is set more than once", key)
+		}
+		keys[key] = true
+	}
+	for key, r := range p.Ranges {
+		if keys[key] {
+			return fmt.Errorf("simulation param %s is set more than once", key)
+		}
+		if r.Min > r.Max {
+			return fmt.Errorf("invalid range of simulation param %s: min %d is greater than max %d", key, r.Min, r.Max)
+		}
+		if uint64(r.Max)-uint64(r.Min) >= math.MaxInt64 {
+			return fmt.Errorf("invalid range of simulation param %s: too large", key)
+		}
+	}
+	for key, weight := range p.Weights {
+		if weight < 0 {
+			return fmt.Errorf("invalid weight %d of operation %s", weight, key)
+		}
+	}
+	return nil
+}
+
+// AppParams returns the simulation params set by the profile. The values of the ranges are drawn
+// from a source seeded with the simulation seed, so that they only change with the seed.
+func (p Profile) AppParams(seed int64) (AppParams, error) {
+	appParams := make(AppParams, len(p.Weights)+len(p.Params)+len(p.Ranges))
+	for key, weight := range p.Weights {
+		appParams[key] = json.RawMessage(fmt.Sprintf("%d", weight))
+	}
+	for key, value := range p.Params {
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of simulation param %s: %w", key, err)
+		}
+		appParams[key] = bz
+	}
+
+	// the ranges are drawn in the order of their keys for the values to be deterministic
+	r := rand.New(rand.NewSource(seed))
+	for _, key := range sortedKeys(p.Ranges) {
+		rng := p.Ranges[key]
+		value := rng.Min + r.Int63n(rng.Max-rng.Min+1)
+		appParams[key] = json.RawMessage(fmt.Sprintf("%d", value))
+	}
+
+	return appParams, nil
+}
+
+// ModuleEnabled returns false if the operations of the module are disabled by the profile.
+func (p Profile) ModuleEnabled(moduleName string) bool {
+	for _, disabled := range p.DisabledModules {
+		if disabled == moduleName {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
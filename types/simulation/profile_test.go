@@ -0,0 +1,108 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestReadProfile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	tomlPath := filepath.Join(dir, "profile.toml")
+	require.NoError(t, os.WriteFile(tomlPath, []byte(`
+disabled_modules = ["nft"]
+
+[weights]
+op_weight_msg_send = 100
+
+[params]
+send_enabled = true
+max_validators = 10000000000
+
+[ranges]
+max_memo_characters = { min = 100, max = 500 }
+`), 0o600))
+
+	jsonPath := filepath.Join(dir, "profile.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{
+	"disabled_modules": ["nft"],
+	"weights": {"op_weight_msg_send": 100},
+	"params": {"send_enabled": true, "max_validators": 10000000000},
+	"ranges": {"max_memo_characters": {"min": 100, "max": 500}}
+}`), 0o600))
+
+	for _, path := range []string{tomlPath, jsonPath} {
+		profile, err := simulation.ReadProfile(path)
+		require.NoError(t, err)
+		require.False(t, profile.ModuleEnabled("nft"))
+		require.True(t, profile.ModuleEnabled("bank"))
+
+		appParams, err := profile.AppParams(1)
+		require.NoError(t, err)
+		require.JSONEq(t, "100", string(appParams["op_weight_msg_send"]))
+		require.JSONEq(t, "true", string(appParams["send_enabled"]))
+		require.Equal(t, "10000000000", string(appParams["max_validators"]))
+
+		var memoChars int64
+		require.NoError(t, json.Unmarshal(appParams["max_memo_characters"], &memoChars))
+		require.GreaterOrEqual(t, memoChars, int64(100))
+		require.LessOrEqual(t, memoChars, int64(500))
+
+		// the ranges are drawn deterministically from the seed
+		sameSeed, err := profile.AppParams(1)
+		require.NoError(t, err)
+		require.Equal(t, appParams, sameSeed)
+	}
+
+	unknownField := filepath.Join(dir, "unknown.toml")
+	require.NoError(t, os.WriteFile(unknownField, []byte(`disabled = ["nft"]`), 0o600))
+	_, err := simulation.ReadProfile(unknownField)
+	require.Error(t, err)
+}
+
+func TestProfileValidate(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		profile simulation.Profile
+		expErr  string
+	}{
+		"valid": {
+			profile: simulation.Profile{
+				Weights: map[string]int{"op_weight_msg_send": 0},
+				Ranges:  map[string]simulation.ParamRange{"max_memo_characters": {Min: 1, Max: 1}},
+			},
+		},
+		"negative weight": {
+			profile: simulation.Profile{Weights: map[string]int{"op_weight_msg_send": -1}},
+			expErr:  "invalid weight",
+		},
+		"param set twice": {
+			profile: simulation.Profile{
+				Weights: map[string]int{"op_weight_msg_send": 1},
+				Params:  map[string]any{"op_weight_msg_send": 2},
+			},
+			expErr: "set more than once",
+		},
+		"empty range": {
+			profile: simulation.Profile{Ranges: map[string]simulation.ParamRange{"max_memo_characters": {Min: 2, Max: 1}}},
+			expErr:  "min 2 is greater than max 1",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.profile.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
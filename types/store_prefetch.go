@@ -0,0 +1,150 @@
+package types
+
+import (
+	"bytes"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// prefetchStore is a KVStore whose iterators read ahead the entries of their
+// parent in batches.
+type prefetchStore struct {
+	storetypes.KVStore
+	batchSize int
+}
+
+func newPrefetchStore(parent storetypes.KVStore, batchSize int) storetypes.KVStore {
+	return &prefetchStore{KVStore: parent, batchSize: batchSize}
+}
+
+func (s *prefetchStore) Iterator(start, end []byte) storetypes.Iterator {
+	return newPrefetchIterator(s.KVStore.Iterator(start, end), s.batchSize)
+}
+
+func (s *prefetchStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return newPrefetchIterator(s.KVStore.ReverseIterator(start, end), s.batchSize)
+}
+
+// prefetchBatch is a batch of entries read ahead from the parent iterator, with
+// the error of the parent iterator once it is exhausted.
+type prefetchBatch struct {
+	pairs []kv.Pair
+	err   error
+}
+
+// prefetchIterator reads ahead the entries of its parent in batches, in a
+// goroutine, so that the reads from the backend of the next batch overlap with
+// the processing of the current one. The parent iterator is only used by this
+// goroutine until the prefetchIterator is closed.
+type prefetchIterator struct {
+	parent     storetypes.Iterator
+	start, end []byte
+
+	batches chan prefetchBatch
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	batch prefetchBatch
+	pos   int
+}
+
+func newPrefetchIterator(parent storetypes.Iterator, batchSize int) *prefetchIterator {
+	start, end := parent.Domain()
+	it := &prefetchIterator{
+		parent:  parent,
+		start:   start,
+		end:     end,
+		batches: make(chan prefetchBatch, 1),
+		done:    make(chan struct{}),
+	}
+
+	it.wg.Add(1)
+	go it.prefetch(batchSize)
+
+	it.batch = <-it.batches
+	return it
+}
+
+// prefetch reads the entries of the parent iterator in batches until it is
+// exhausted or the iterator is closed. The last batch carries the error of the
+// parent iterator.
+func (it *prefetchIterator) prefetch(batchSize int) {
+	defer it.wg.Done()
+	defer close(it.batches)
+
+	for {
+		batch := prefetchBatch{pairs: make([]kv.Pair, 0, batchSize)}
+		for ; len(batch.pairs) < batchSize && it.parent.Valid(); it.parent.Next() {
+			// the parent may reuse its key and value once advanced
+			batch.pairs = append(batch.pairs, kv.Pair{
+				Key:   bytes.Clone(it.parent.Key()),
+				Value: bytes.Clone(it.parent.Value()),
+			})
+		}
+
+		last := !it.parent.Valid()
+		if last {
+			batch.err = it.parent.Error()
+		}
+
+		select {
+		case it.batches <- batch:
+		case <-it.done:
+			return
+		}
+
+		if last {
+			return
+		}
+	}
+}
+
+func (it *prefetchIterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+func (it *prefetchIterator) Valid() bool {
+	return it.pos < len(it.batch.pairs)
+}
+
+func (it *prefetchIterator) Next() {
+	if !it.Valid() {
+		panic("prefetchIterator: Next() called on invalid iterator")
+	}
+
+	it.pos++
+	if it.pos == len(it.batch.pairs) && it.batch.err == nil {
+		if batch, ok := <-it.batches; ok {
+			it.batch, it.pos = batch, 0
+		}
+	}
+}
+
+func (it *prefetchIterator) Key() []byte {
+	if !it.Valid() {
+		panic("prefetchIterator: Key() called on invalid iterator")
+	}
+
+	return it.batch.pairs[it.pos].Key
+}
+
+func (it *prefetchIterator) Value() []byte {
+	if !it.Valid() {
+		panic("prefetchIterator: Value() called on invalid iterator")
+	}
+
+	return it.batch.pairs[it.pos].Value
+}
+
+func (it *prefetchIterator) Error() error {
+	return it.batch.err
+}
+
+func (it *prefetchIterator) Close() error {
+	close(it.done)
+	it.wg.Wait()
+	return it.parent.Close()
+}
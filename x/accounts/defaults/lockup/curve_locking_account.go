@@ -0,0 +1,349 @@
+package lockup
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Compile-time type assertions
+var _ accountstd.Interface = (*CurveLockingAccount)(nil)
+
+// NewCurveLockingAccount creates a new CurveLockingAccount object.
+func NewCurveLockingAccount(d accountstd.Dependencies) (*CurveLockingAccount, error) {
+	baseLockup := newBaseLockup(d)
+
+	curveLockingAccount := CurveLockingAccount{
+		BaseLockup:     baseLockup,
+		StartTime:      collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		UnlockingCurve: collections.NewVec(d.SchemaBuilder, UnlockingCurvePrefix, "unlocking_curve", codec.CollValue[lockuptypes.CurvePoint](d.LegacyStateCodec)),
+	}
+
+	return &curveLockingAccount, nil
+}
+
+// CurveLockingAccount is a lockup account whose coins unlock along a
+// piecewise-linear curve defined at creation. The curve starts at the start
+// time with nothing unlocked, goes through each of its points, and ends at its
+// last point with all the coins unlocked.
+type CurveLockingAccount struct {
+	*BaseLockup
+	StartTime      collections.Item[time.Time]
+	UnlockingCurve collections.Vec[lockuptypes.CurvePoint]
+}
+
+func (cla CurveLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitCurveLockingAccount) (*lockuptypes.MsgInitCurveLockingAccountResponse, error) {
+	owner, err := cla.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'owner' address: %s", err)
+	}
+
+	hs := cla.headerService.HeaderInfo(ctx)
+
+	if msg.StartTime.Before(hs.Time) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("start time %s should be after block time", msg.StartTime)
+	}
+
+	if err := validateCurve(msg.Curve); err != nil {
+		return nil, err
+	}
+
+	for _, point := range msg.Curve {
+		err = cla.UnlockingCurve.Push(ctx, point)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lastPoint := msg.Curve[len(msg.Curve)-1]
+	totalCoins := lastPoint.Unlocked
+	endTime := msg.StartTime.Add(lastPoint.Time)
+
+	funds := accountstd.Funds(ctx)
+	if !funds.Equal(totalCoins) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
+	}
+
+	sortedAmt := totalCoins.Sort()
+	for _, coin := range sortedAmt {
+		err := cla.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		// Set initial value for all withdrawed token
+		err = cla.WithdrawedCoins.Set(ctx, coin.Denom, math.ZeroInt())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set initial value for all locked token
+	err = cla.DelegatedFree.Set(ctx, bondDenom, math.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
+	// Set initial value for all locked token
+	err = cla.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
+	err = cla.StartTime.Set(ctx, msg.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	err = cla.EndTime.Set(ctx, endTime)
+	if err != nil {
+		return nil, err
+	}
+	err = cla.Owner.Set(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitCurveLockingAccountResponse{}, nil
+}
+
+func (cla *CurveLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.Delegate(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CurveLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.SendCoins(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CurveLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (
+	*lockuptypes.MsgWithdrawResponse, error,
+) {
+	return cla.BaseLockup.WithdrawUnlockedCoins(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+// GetCurve returns the points of the unlocking curve.
+func (cla CurveLockingAccount) GetCurve(ctx context.Context) ([]lockuptypes.CurvePoint, error) {
+	curve := []lockuptypes.CurvePoint{}
+	err := cla.UnlockingCurve.Walk(ctx, nil, func(_ uint64, point lockuptypes.CurvePoint) (stop bool, err error) {
+		curve = append(curve, point)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return curve, nil
+}
+
+// GetLockCoinsInfo returns the total number of locked and unlocked coins.
+func (cla CurveLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
+	// We must handle the case where the start time for a lockup account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	originalLocking := sdk.Coins{}
+	err = cla.IterateCoinEntries(ctx, cla.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+		originalLocking = append(originalLocking, sdk.NewCoin(key, value))
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if blockTime.Before(startTime) {
+		return sdk.Coins{}, originalLocking, nil
+	}
+
+	curve, err := cla.GetCurve(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlockedCoins = unlockedAt(curve, blockTime.Sub(startTime))
+	lockedCoins = originalLocking.Sub(unlockedCoins...)
+
+	return unlockedCoins, lockedCoins, nil
+}
+
+// GetLockedCoins returns the total number of locked coins. If no coins are
+// locked, nil is returned.
+func (cla CurveLockingAccount) GetLockedCoins(ctx context.Context, blockTime time.Time) (sdk.Coins, error) {
+	_, lockedCoins, err := cla.GetLockCoinsInfo(ctx, blockTime)
+	if err != nil {
+		return nil, err
+	}
+	return lockedCoins, nil
+}
+
+// GetLockCoinInfoWithDenom returns the total number of locked and unlocked coin for a specific denom.
+func (cla CurveLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	originalLockingAmt, err := cla.OriginalLocking.Get(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlockedCoins, _, err := cla.GetLockCoinsInfo(ctx, blockTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlocked := sdk.NewCoin(denom, unlockedCoins.AmountOf(denom))
+	locked := sdk.NewCoin(denom, originalLockingAmt.Sub(unlocked.Amount))
+
+	return &unlocked, &locked, nil
+}
+
+// GetLockedCoinsWithDenoms returns the total number of locked coins. If no coins are
+// locked, nil is returned.
+func (cla CurveLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	lockedCoins := sdk.Coins{}
+	for _, denom := range denoms {
+		_, lockedCoin, err := cla.GetLockCoinInfoWithDenom(ctx, blockTime, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = append(lockedCoins, *lockedCoin)
+	}
+	return lockedCoins, nil
+}
+
+func (cla CurveLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
+	*lockuptypes.QueryLockupAccountInfoResponse, error,
+) {
+	resp, err := cla.BaseLockup.QueryLockupAccountBaseInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hs := cla.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, err := cla.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	resp.StartTime = &startTime
+	resp.LockedCoins = lockedCoins
+	resp.UnlockedCoins = unlockedCoins
+	return resp, nil
+}
+
+func (cla CurveLockingAccount) QueryUnlockingCurve(ctx context.Context, _ *lockuptypes.QueryUnlockingCurveRequest) (
+	*lockuptypes.QueryUnlockingCurveResponse, error,
+) {
+	curve, err := cla.GetCurve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &lockuptypes.QueryUnlockingCurveResponse{
+		Curve: curve,
+	}, nil
+}
+
+// QueryUnlockedCoins returns the coins unlocked and locked at the requested
+// time, which can be in the past or in the future.
+func (cla CurveLockingAccount) QueryUnlockedCoins(ctx context.Context, req *lockuptypes.QueryUnlockedCoinsRequest) (
+	*lockuptypes.QueryUnlockedCoinsResponse, error,
+) {
+	unlockedCoins, lockedCoins, err := cla.GetLockCoinsInfo(ctx, req.Time)
+	if err != nil {
+		return nil, err
+	}
+	return &lockuptypes.QueryUnlockedCoinsResponse{
+		UnlockedCoins: unlockedCoins,
+		LockedCoins:   lockedCoins,
+	}, nil
+}
+
+// Implement smart account interface
+func (cla CurveLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, cla.Init)
+}
+
+func (cla CurveLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, cla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, cla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, cla.WithdrawUnlockedCoins)
+	cla.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (cla CurveLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, cla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, cla.QueryUnlockingCurve)
+	accountstd.RegisterQueryHandler(builder, cla.QueryUnlockedCoins)
+}
+
+// validateCurve validates the points of an unlocking curve: their times and
+// unlocked amounts can't decrease, and the last point must unlock a non zero
+// amount. Two points with the same time define an instant unlock.
+func validateCurve(curve []lockuptypes.CurvePoint) error {
+	if len(curve) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty unlocking curve")
+	}
+
+	prev := lockuptypes.CurvePoint{}
+	for i, point := range curve {
+		if point.Time < prev.Time {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid time %s of curve point %d, should not be before the previous point", point.Time, i)
+		}
+
+		if !point.Unlocked.IsValid() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid unlocked amount %s of curve point %d", point.Unlocked, i)
+		}
+
+		if !prev.Unlocked.IsAllLTE(point.Unlocked) {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid unlocked amount %s of curve point %d, should not be less than the previous point", point.Unlocked, i)
+		}
+
+		prev = point
+	}
+
+	return validateAmount(prev.Unlocked)
+}
+
+// unlockedAt returns the coins unlocked by the curve after elapsed time since
+// the start of the lockup. Between two points, the unlocked amounts are
+// interpolated linearly and rounded down.
+func unlockedAt(curve []lockuptypes.CurvePoint, elapsed time.Duration) sdk.Coins {
+	prev := lockuptypes.CurvePoint{}
+	for _, point := range curve {
+		if elapsed >= point.Time {
+			prev = point
+			continue
+		}
+
+		// elapsed is strictly between the previous point and this one, so the
+		// segment has a non zero length
+		unlocked := sdk.Coins{}
+		for _, coin := range point.Unlocked {
+			from := prev.Unlocked.AmountOf(coin.Denom)
+			amt := coin.Amount.Sub(from).
+				Mul(math.NewInt(int64(elapsed - prev.Time))).
+				Quo(math.NewInt(int64(point.Time - prev.Time)))
+			unlocked = unlocked.Add(sdk.NewCoin(coin.Denom, from.Add(amt)))
+		}
+		return unlocked
+	}
+
+	return prev.Unlocked
+}
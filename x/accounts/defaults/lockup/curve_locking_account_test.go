@@ -0,0 +1,187 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testCurve is a cliff of one minute, followed by a linear unlock of 4 coins
+// for a minute, an instant unlock of 2 coins, and a linear unlock of the 4
+// remaining coins for two minutes.
+func testCurve() []lockuptypes.CurvePoint {
+	return []lockuptypes.CurvePoint{
+		{Time: time.Minute, Unlocked: sdk.Coins{}},
+		{Time: time.Minute * 2, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))},
+		{Time: time.Minute * 2, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6)))},
+		{Time: time.Minute * 4, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))},
+	}
+}
+
+func setupCurveAccount(t *testing.T, ctx context.Context, ss store.KVStoreService) *CurveLockingAccount {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	owner := "owner"
+
+	acc, err := NewCurveLockingAccount(deps)
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitCurveLockingAccount{
+		Owner:     owner,
+		StartTime: time.Now(),
+		Curve:     testCurve(),
+	})
+	require.NoError(t, err)
+
+	return acc
+}
+
+func TestCurveAccountInit(t *testing.T) {
+	testCases := []struct {
+		name   string
+		curve  []lockuptypes.CurvePoint
+		errMsg string
+	}{
+		{"valid", testCurve(), ""},
+		{"empty curve", nil, "empty unlocking curve"},
+		{
+			"decreasing time",
+			[]lockuptypes.CurvePoint{
+				{Time: time.Minute * 2, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))},
+				{Time: time.Minute, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))},
+			},
+			"should not be before the previous point",
+		},
+		{
+			"decreasing amount",
+			[]lockuptypes.CurvePoint{
+				{Time: time.Minute, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))},
+				{Time: time.Minute * 2, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))},
+			},
+			"should not be less than the previous point",
+		},
+		{
+			"nothing unlocked",
+			[]lockuptypes.CurvePoint{{Time: time.Minute, Unlocked: sdk.Coins{}}},
+			"invalid coins",
+		},
+		{
+			"not funded",
+			[]lockuptypes.CurvePoint{{Time: time.Minute, Unlocked: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))}},
+			"invalid funding amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+				Time: time.Now(),
+			})
+
+			acc, err := NewCurveLockingAccount(makeMockDependencies(ss))
+			require.NoError(t, err)
+			_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitCurveLockingAccount{
+				Owner:     "owner",
+				StartTime: time.Now(),
+				Curve:     tc.curve,
+			})
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestCurveAccountSendCoins(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupCurveAccount(t, sdkCtx, ss)
+	_, err := acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))),
+	})
+	require.Error(t, err)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to the middle of the first linear unlock
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Second * 90),
+	})
+
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))),
+	})
+	require.NoError(t, err)
+}
+
+func TestCurveAccountGetLockCoinInfo(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupCurveAccount(t, sdkCtx, ss)
+
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		time     time.Time
+		unlocked int64
+	}{
+		{"before start", startTime.Add(-time.Minute), 0},
+		{"during cliff", startTime.Add(time.Second * 30), 0},
+		{"end of cliff", startTime.Add(time.Minute), 0},
+		{"first linear unlock", startTime.Add(time.Second * 75), 1},
+		{"rounded down", startTime.Add(time.Second * 100), 2},
+		{"instant unlock", startTime.Add(time.Minute * 2), 6},
+		{"second linear unlock", startTime.Add(time.Minute * 3), 8},
+		{"end", startTime.Add(time.Minute * 4), 10},
+		{"after end", startTime.Add(time.Hour), 10},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, tc.time)
+			require.NoError(t, err)
+			require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(tc.unlocked)))
+			require.True(t, locked.AmountOf("test").Equal(math.NewInt(10-tc.unlocked)))
+
+			unlockedCoin, lockedCoin, err := acc.GetLockCoinInfoWithDenom(sdkCtx, tc.time, "test")
+			require.NoError(t, err)
+			require.True(t, unlockedCoin.Amount.Equal(math.NewInt(tc.unlocked)))
+			require.True(t, lockedCoin.Amount.Equal(math.NewInt(10-tc.unlocked)))
+
+			res, err := acc.QueryUnlockedCoins(sdkCtx, &lockuptypes.QueryUnlockedCoinsRequest{Time: tc.time})
+			require.NoError(t, err)
+			require.Equal(t, unlocked, res.UnlockedCoins)
+			require.Equal(t, locked, res.LockedCoins)
+		})
+	}
+
+	res, err := acc.QueryUnlockingCurve(sdkCtx, &lockuptypes.QueryUnlockingCurveRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Curve, 4)
+	require.Equal(t, time.Minute*4, res.Curve[3].Time)
+}
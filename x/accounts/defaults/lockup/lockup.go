@@ -34,10 +34,12 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	UnlockingCurvePrefix   = collections.NewPrefix(8)
 )
 
 var (
 	CONTINUOUS_LOCKING_ACCOUNT = "continuous-locking-account"
+	CURVE_LOCKING_ACCOUNT      = "curve-locking-account"
 	DELAYED_LOCKING_ACCOUNT    = "delayed-locking-account"
 	PERIODIC_LOCKING_ACCOUNT   = "periodic-locking-account"
 	PERMANENT_LOCKING_ACCOUNT  = "permanent-locking-account"
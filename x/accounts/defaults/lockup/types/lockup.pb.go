@@ -84,8 +84,64 @@ func (m *Period) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// CurvePoint defines a point of the unlocking curve of a curve locking account.
+type CurvePoint struct {
+	// time is the duration since the start of the lockup at which the point is reached.
+	Time time.Duration `protobuf:"bytes,1,opt,name=time,proto3,stdduration" json:"time"`
+	// unlocked is the total amount of coins unlocked at the point.
+	Unlocked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unlocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked"`
+}
+
+func (m *CurvePoint) Reset()         { *m = CurvePoint{} }
+func (m *CurvePoint) String() string { return proto.CompactTextString(m) }
+func (*CurvePoint) ProtoMessage()    {}
+func (*CurvePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b466256e1a079c, []int{1}
+}
+func (m *CurvePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurvePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurvePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurvePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurvePoint.Merge(m, src)
+}
+func (m *CurvePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurvePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurvePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurvePoint proto.InternalMessageInfo
+
+func (m *CurvePoint) GetTime() time.Duration {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *CurvePoint) GetUnlocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unlocked
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.accounts.defaults.lockup.Period")
+	proto.RegisterType((*CurvePoint)(nil), "cosmos.accounts.defaults.lockup.CurvePoint")
 }
 
 func init() {
@@ -93,28 +149,31 @@ func init() {
 }
 
 var fileDescriptor_79b466256e1a079c = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x90, 0x32, 0x04, 0x18, 0xa8, 0x18, 0x4a, 0x07, 0xa7, 0x62, 0xaa, 0x2a, 0x6a,
-	0xab, 0x70, 0x01, 0x54, 0x10, 0xac, 0x88, 0x91, 0x05, 0x39, 0x8e, 0xeb, 0x5a, 0x4d, 0xf2, 0xaa,
-	0xda, 0x41, 0xf4, 0x16, 0x8c, 0x88, 0x13, 0x20, 0xa6, 0x5e, 0x02, 0xa9, 0x63, 0x47, 0x26, 0x8a,
-	0x9a, 0xa1, 0xd7, 0x40, 0xb1, 0x9d, 0x91, 0xc5, 0xef, 0x59, 0xfe, 0xbf, 0xf7, 0xbf, 0x5f, 0x8e,
-	0xce, 0x39, 0xe8, 0x1c, 0x34, 0x65, 0x9c, 0x43, 0x59, 0x18, 0x4d, 0x53, 0x31, 0x66, 0x65, 0x66,
-	0x34, 0xcd, 0x80, 0x4f, 0xcb, 0x99, 0x2f, 0x64, 0x36, 0x07, 0x03, 0xad, 0xd8, 0xa9, 0x49, 0xa3,
-	0x26, 0x8d, 0x9a, 0x38, 0x59, 0xe7, 0x98, 0xe5, 0xaa, 0x00, 0x6a, 0x4f, 0xc7, 0x74, 0xb0, 0x77,
-	0x48, 0x98, 0x16, 0xf4, 0x79, 0x98, 0x08, 0xc3, 0x86, 0x94, 0x83, 0x2a, 0xfc, 0xfb, 0x89, 0x04,
-	0x09, 0xb6, 0xa5, 0x75, 0xd7, 0x50, 0x12, 0x40, 0x66, 0x82, 0xda, 0x5b, 0x52, 0x8e, 0x69, 0x5a,
-	0xce, 0x99, 0x51, 0xe0, 0xa9, 0xb3, 0x2f, 0x14, 0x85, 0xf7, 0x62, 0xae, 0x20, 0x6d, 0x5d, 0x45,
-	0x61, 0x26, 0x0a, 0x69, 0x26, 0x6d, 0xd4, 0x45, 0xbd, 0x83, 0x8b, 0x53, 0xe2, 0x58, 0xd2, 0xb0,
-	0xe4, 0xc6, 0xb3, 0xa3, 0xa3, 0xd5, 0x4f, 0x1c, 0xbc, 0x6d, 0x62, 0xf4, 0xb1, 0x5b, 0xf6, 0xd1,
-	0x83, 0xe7, 0x5a, 0x8b, 0x28, 0x64, 0x79, 0x1d, 0xa8, 0xbd, 0xd7, 0xdd, 0xb7, 0x13, 0x7c, 0xce,
-	0x7a, 0x67, 0xe2, 0x77, 0x26, 0xd7, 0xa0, 0x8a, 0xd1, 0x6d, 0x3d, 0xe1, 0x73, 0x13, 0xf7, 0xa4,
-	0x32, 0x93, 0x32, 0x21, 0x1c, 0x72, 0xea, 0x03, 0xba, 0x32, 0xd0, 0xe9, 0x94, 0x9a, 0xc5, 0x4c,
-	0x68, 0x0b, 0xe8, 0xf7, 0xdd, 0xb2, 0x7f, 0x98, 0x09, 0xc9, 0xf8, 0xe2, 0xa9, 0x4e, 0xad, 0xbd,
-	0xb5, 0x33, 0x1c, 0xdd, 0xad, 0xb6, 0x18, 0xad, 0xb7, 0x18, 0xfd, 0x6e, 0x31, 0x7a, 0xad, 0x70,
-	0xb0, 0xae, 0x70, 0xf0, 0x5d, 0xe1, 0xe0, 0x71, 0xe0, 0xe6, 0xe9, 0x74, 0x4a, 0x14, 0xd0, 0x97,
-	0xff, 0x7f, 0xc8, 0x9a, 0x25, 0xa1, 0x4d, 0x7b, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xa5,
-	0x33, 0xd0, 0xd1, 0x01, 0x00, 0x00,
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x4f, 0xe3, 0x30,
+	0x14, 0xc7, 0xe3, 0xbb, 0x53, 0x74, 0xf2, 0xdd, 0x0d, 0x17, 0xdd, 0xd0, 0xeb, 0x90, 0x54, 0x9d,
+	0xaa, 0xea, 0x6a, 0xab, 0xc7, 0xca, 0x80, 0x5a, 0x04, 0x6b, 0xc5, 0xc8, 0x82, 0x9c, 0xc4, 0x4d,
+	0xad, 0x26, 0x79, 0x55, 0x6c, 0x57, 0x74, 0xe0, 0x3b, 0x30, 0x22, 0x3e, 0x01, 0x62, 0xea, 0x97,
+	0x40, 0x2a, 0x5b, 0x47, 0x26, 0x8a, 0xda, 0xa1, 0x5f, 0x03, 0xc5, 0x71, 0xd8, 0x18, 0x58, 0x58,
+	0xf2, 0x5e, 0xe4, 0xff, 0xef, 0xfd, 0xdf, 0x5f, 0x36, 0xfe, 0x17, 0x81, 0xcc, 0x40, 0x52, 0x16,
+	0x45, 0xa0, 0x73, 0x25, 0x69, 0xcc, 0xc7, 0x4c, 0xa7, 0x4a, 0xd2, 0x14, 0xa2, 0xa9, 0x9e, 0xd9,
+	0x42, 0x66, 0x05, 0x28, 0xf0, 0x82, 0x4a, 0x4d, 0x6a, 0x35, 0xa9, 0xd5, 0xa4, 0x92, 0x35, 0x7f,
+	0xb3, 0x4c, 0xe4, 0x40, 0xcd, 0xb7, 0x62, 0x9a, 0xbe, 0x75, 0x08, 0x99, 0xe4, 0x74, 0xde, 0x0f,
+	0xb9, 0x62, 0x7d, 0x1a, 0x81, 0xc8, 0xed, 0xf9, 0x9f, 0x04, 0x12, 0x30, 0x2d, 0x2d, 0xbb, 0x9a,
+	0x4a, 0x00, 0x92, 0x94, 0x53, 0xf3, 0x17, 0xea, 0x31, 0x8d, 0x75, 0xc1, 0x94, 0x00, 0x4b, 0xb5,
+	0x1f, 0x10, 0x76, 0x47, 0xbc, 0x10, 0x10, 0x7b, 0x47, 0xd8, 0x4d, 0x79, 0x9e, 0xa8, 0x49, 0x03,
+	0xb5, 0x50, 0xe7, 0xc7, 0xff, 0xbf, 0xa4, 0x62, 0x49, 0xcd, 0x92, 0x63, 0xcb, 0x0e, 0x7e, 0xad,
+	0x9e, 0x03, 0xe7, 0x66, 0x13, 0xa0, 0xbb, 0xfd, 0xb2, 0x8b, 0xce, 0x2c, 0xe7, 0x2d, 0xb0, 0xcb,
+	0xb2, 0x32, 0x50, 0xe3, 0x4b, 0xeb, 0xab, 0x99, 0x60, 0x73, 0x96, 0x3b, 0x13, 0xbb, 0x33, 0x19,
+	0x82, 0xc8, 0x07, 0x27, 0xe5, 0x84, 0xfb, 0x4d, 0xd0, 0x49, 0x84, 0x9a, 0xe8, 0x90, 0x44, 0x90,
+	0x51, 0x1b, 0xb0, 0x2a, 0x3d, 0x19, 0x4f, 0xa9, 0x5a, 0xcc, 0xb8, 0x34, 0x80, 0xbc, 0xdd, 0x2f,
+	0xbb, 0x3f, 0x53, 0x9e, 0xb0, 0x68, 0x71, 0x51, 0xa6, 0x96, 0xd6, 0xba, 0x32, 0x6c, 0x3f, 0x22,
+	0x8c, 0x87, 0xba, 0x98, 0xf3, 0x11, 0x88, 0x5c, 0x79, 0x87, 0xf8, 0x9b, 0x12, 0x19, 0xff, 0x70,
+	0x12, 0x43, 0x79, 0x57, 0xf8, 0xbb, 0xce, 0xcb, 0x9b, 0xe0, 0xf1, 0xe7, 0x25, 0x79, 0xb3, 0x1c,
+	0x9c, 0xae, 0xb6, 0x3e, 0x5a, 0x6f, 0x7d, 0xf4, 0xb2, 0xf5, 0xd1, 0xf5, 0xce, 0x77, 0xd6, 0x3b,
+	0xdf, 0x79, 0xda, 0xf9, 0xce, 0x79, 0xaf, 0x9a, 0x28, 0xe3, 0x29, 0x11, 0x40, 0x2f, 0xdf, 0x7f,
+	0x6d, 0xc6, 0x2e, 0x74, 0x4d, 0xde, 0x83, 0xd7, 0x01, 0x00, 0xe8, 0xad, 0xa2, 0x30, 0x9d, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Period) Marshal() (dAtA []byte, err error) {
@@ -162,6 +221,51 @@ func (m *Period) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CurvePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurvePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurvePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unlocked) > 0 {
+		for iNdEx := len(m.Unlocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLockup(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLockup(v)
 	base := offset
@@ -190,6 +294,23 @@ func (m *Period) Size() (n int) {
 	return n
 }
 
+func (m *CurvePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Time)
+	n += 1 + l + sovLockup(uint64(l))
+	if len(m.Unlocked) > 0 {
+		for _, e := range m.Unlocked {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovLockup(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -313,6 +434,123 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CurvePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurvePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurvePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocked = append(m.Unlocked, types.Coin{})
+			if err := m.Unlocked[len(m.Unlocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLockup(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
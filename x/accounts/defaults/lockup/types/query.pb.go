@@ -258,11 +258,201 @@ func (m *QueryLockingPeriodsResponse) GetLockingPeriods() []*Period {
 	return nil
 }
 
+// QueryUnlockingCurveRequest is used to query the curve lockup account unlocking curve.
+type QueryUnlockingCurveRequest struct {
+}
+
+func (m *QueryUnlockingCurveRequest) Reset()         { *m = QueryUnlockingCurveRequest{} }
+func (m *QueryUnlockingCurveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockingCurveRequest) ProtoMessage()    {}
+func (*QueryUnlockingCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{4}
+}
+func (m *QueryUnlockingCurveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockingCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockingCurveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockingCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockingCurveRequest.Merge(m, src)
+}
+func (m *QueryUnlockingCurveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockingCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockingCurveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockingCurveRequest proto.InternalMessageInfo
+
+// QueryUnlockingCurveResponse returns the curve lockup account unlocking curve.
+type QueryUnlockingCurveResponse struct {
+	// curve defines the points of the unlocking curve of the curve lockup account.
+	Curve []CurvePoint `protobuf:"bytes,1,rep,name=curve,proto3" json:"curve"`
+}
+
+func (m *QueryUnlockingCurveResponse) Reset()         { *m = QueryUnlockingCurveResponse{} }
+func (m *QueryUnlockingCurveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockingCurveResponse) ProtoMessage()    {}
+func (*QueryUnlockingCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{5}
+}
+func (m *QueryUnlockingCurveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockingCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockingCurveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockingCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockingCurveResponse.Merge(m, src)
+}
+func (m *QueryUnlockingCurveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockingCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockingCurveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockingCurveResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockingCurveResponse) GetCurve() []CurvePoint {
+	if m != nil {
+		return m.Curve
+	}
+	return nil
+}
+
+// QueryUnlockedCoinsRequest is used to query the coins of the curve lockup account
+// unlocked at a given time.
+type QueryUnlockedCoinsRequest struct {
+	// time defines the time at which the unlocked coins are computed.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QueryUnlockedCoinsRequest) Reset()         { *m = QueryUnlockedCoinsRequest{} }
+func (m *QueryUnlockedCoinsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockedCoinsRequest) ProtoMessage()    {}
+func (*QueryUnlockedCoinsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{6}
+}
+func (m *QueryUnlockedCoinsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockedCoinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockedCoinsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockedCoinsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockedCoinsRequest.Merge(m, src)
+}
+func (m *QueryUnlockedCoinsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockedCoinsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockedCoinsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockedCoinsRequest proto.InternalMessageInfo
+
+func (m *QueryUnlockedCoinsRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// QueryUnlockedCoinsResponse returns the coins of the curve lockup account unlocked
+// at a given time.
+type QueryUnlockedCoinsResponse struct {
+	// unlocked_coins defines the value of the account coins unlocked at the time.
+	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
+	// locked_coins defines the value of the account coins still locked at the time.
+	LockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked_coins,json=lockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_coins"`
+}
+
+func (m *QueryUnlockedCoinsResponse) Reset()         { *m = QueryUnlockedCoinsResponse{} }
+func (m *QueryUnlockedCoinsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockedCoinsResponse) ProtoMessage()    {}
+func (*QueryUnlockedCoinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{7}
+}
+func (m *QueryUnlockedCoinsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockedCoinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockedCoinsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockedCoinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockedCoinsResponse.Merge(m, src)
+}
+func (m *QueryUnlockedCoinsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockedCoinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockedCoinsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockedCoinsResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockedCoinsResponse) GetUnlockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockedCoins
+	}
+	return nil
+}
+
+func (m *QueryUnlockedCoinsResponse) GetLockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedCoins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryLockupAccountInfoRequest)(nil), "cosmos.accounts.defaults.lockup.QueryLockupAccountInfoRequest")
 	proto.RegisterType((*QueryLockupAccountInfoResponse)(nil), "cosmos.accounts.defaults.lockup.QueryLockupAccountInfoResponse")
 	proto.RegisterType((*QueryLockingPeriodsRequest)(nil), "cosmos.accounts.defaults.lockup.QueryLockingPeriodsRequest")
 	proto.RegisterType((*QueryLockingPeriodsResponse)(nil), "cosmos.accounts.defaults.lockup.QueryLockingPeriodsResponse")
+	proto.RegisterType((*QueryUnlockingCurveRequest)(nil), "cosmos.accounts.defaults.lockup.QueryUnlockingCurveRequest")
+	proto.RegisterType((*QueryUnlockingCurveResponse)(nil), "cosmos.accounts.defaults.lockup.QueryUnlockingCurveResponse")
+	proto.RegisterType((*QueryUnlockedCoinsRequest)(nil), "cosmos.accounts.defaults.lockup.QueryUnlockedCoinsRequest")
+	proto.RegisterType((*QueryUnlockedCoinsResponse)(nil), "cosmos.accounts.defaults.lockup.QueryUnlockedCoinsResponse")
 }
 
 func init() {
@@ -270,39 +460,45 @@ func init() {
 }
 
 var fileDescriptor_f06fad50e16c8e9b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0xda, 0xf4, 0x63, 0x03, 0x6d, 0x89, 0x7a, 0x30, 0x01, 0xec, 0x28, 0x17, 0x22,
-	0x41, 0x77, 0x69, 0x39, 0x72, 0x40, 0x04, 0x09, 0x84, 0xd4, 0x43, 0xb1, 0x38, 0x71, 0xb1, 0xfc,
-	0x31, 0x31, 0xab, 0x38, 0x3b, 0xae, 0x77, 0x5d, 0xda, 0xb7, 0xe8, 0x73, 0xf0, 0x24, 0x3d, 0xf6,
-	0xc8, 0x89, 0xa2, 0xe4, 0x3d, 0x10, 0xb2, 0x77, 0x37, 0xa8, 0x12, 0x55, 0x39, 0xa4, 0xa7, 0xfd,
-	0x9a, 0x99, 0xdf, 0xcc, 0xfe, 0x77, 0x96, 0x3c, 0x4f, 0x50, 0x4e, 0x51, 0xb2, 0x28, 0x49, 0xb0,
-	0x12, 0x4a, 0xb2, 0x14, 0xc6, 0x51, 0x95, 0x2b, 0xc9, 0x72, 0x4c, 0x26, 0x55, 0xc1, 0x8e, 0x2b,
-	0x28, 0xcf, 0x68, 0x51, 0xa2, 0xc2, 0xae, 0xaf, 0x8d, 0xa9, 0x35, 0xa6, 0xd6, 0x98, 0x6a, 0xe3,
-	0xde, 0x8b, 0xdb, 0xa2, 0xe9, 0x41, 0x87, 0xeb, 0x79, 0xc6, 0x3a, 0x8e, 0x24, 0xb0, 0x93, 0xfd,
-	0x18, 0x54, 0xb4, 0xcf, 0x12, 0xe4, 0xc2, 0x9c, 0xef, 0x66, 0x98, 0x61, 0x33, 0x65, 0xf5, 0xcc,
-	0xec, 0xfa, 0x19, 0x62, 0x96, 0x03, 0x6b, 0x56, 0x71, 0x35, 0x66, 0x8a, 0x4f, 0x41, 0xaa, 0x68,
-	0x6a, 0xc2, 0x0e, 0x7c, 0xf2, 0xf4, 0x53, 0x9d, 0xf4, 0x61, 0xc3, 0x7a, 0xab, 0x53, 0xf9, 0x28,
-	0xc6, 0x18, 0xc0, 0x71, 0x05, 0x52, 0x0d, 0x7e, 0xb7, 0x89, 0x77, 0x93, 0x85, 0x2c, 0x50, 0x48,
-	0xe8, 0x9e, 0x90, 0x1d, 0x2c, 0x79, 0xc6, 0x45, 0x94, 0x87, 0x75, 0xce, 0x5c, 0x64, 0xae, 0xd3,
-	0x5f, 0x19, 0x76, 0x0e, 0x1e, 0x51, 0x73, 0x09, 0x75, 0xd6, 0xd4, 0x64, 0x4d, 0xdf, 0x21, 0x17,
-	0xa3, 0x97, 0x17, 0x3f, 0xfd, 0xd6, 0xf7, 0x2b, 0x7f, 0x98, 0x71, 0xf5, 0xb5, 0x8a, 0x69, 0x82,
-	0x53, 0x66, 0x4a, 0xd4, 0xc3, 0x9e, 0x4c, 0x27, 0x4c, 0x9d, 0x15, 0x20, 0x1b, 0x07, 0x19, 0x6c,
-	0x5b, 0xc8, 0xa1, 0x66, 0x74, 0x4b, 0xb2, 0x95, 0x42, 0x0e, 0x59, 0xa4, 0x20, 0x0d, 0xc7, 0x25,
-	0x80, 0x7b, 0x6f, 0xf9, 0xd4, 0x07, 0x0b, 0xc4, 0xfb, 0x12, 0xa0, 0x7b, 0x4a, 0x1e, 0xfe, 0x65,
-	0xda, 0x62, 0x57, 0x96, 0x8f, 0xdd, 0x59, 0x50, 0x6c, 0xb5, 0x6f, 0x08, 0x91, 0x2a, 0x2a, 0x55,
-	0x58, 0x4b, 0xe8, 0xae, 0xf6, 0x9d, 0x61, 0xe7, 0xa0, 0x47, 0xb5, 0xbe, 0xd4, 0xea, 0x4b, 0x3f,
-	0x5b, 0x7d, 0x47, 0xab, 0xe7, 0x57, 0xbe, 0x13, 0x6c, 0x36, 0x3e, 0xf5, 0x6e, 0xf7, 0x35, 0xd9,
-	0x00, 0x91, 0x6a, 0xf7, 0xf6, 0x7f, 0xba, 0xaf, 0x83, 0x48, 0x1b, 0x67, 0x41, 0xee, 0xd7, 0xd5,
-	0x42, 0x1a, 0xd6, 0x6f, 0x4e, 0xba, 0x6b, 0xcb, 0x2f, 0xb9, 0xa3, 0x01, 0xcd, 0xa2, 0xd6, 0xb6,
-	0x12, 0xd7, 0x88, 0xeb, 0x77, 0xa0, 0xad, 0x45, 0x68, 0xe6, 0x2e, 0x69, 0xe3, 0x37, 0x01, 0xa5,
-	0xbb, 0xd1, 0x77, 0x86, 0x9b, 0x81, 0x5e, 0x0c, 0x9e, 0x90, 0xde, 0xe2, 0xfd, 0x73, 0x91, 0x1d,
-	0x41, 0xc9, 0x31, 0x95, 0xb6, 0x3d, 0x90, 0x3c, 0xfe, 0xe7, 0xa9, 0x69, 0x8d, 0x23, 0xb2, 0x6d,
-	0x1e, 0x49, 0x58, 0xe8, 0x23, 0xd3, 0x19, 0xcf, 0xe8, 0x2d, 0xdf, 0x03, 0xd5, 0xa1, 0x82, 0xad,
-	0xfc, 0x5a, 0xe4, 0xd1, 0x87, 0x8b, 0x99, 0xe7, 0x5c, 0xce, 0x3c, 0xe7, 0xd7, 0xcc, 0x73, 0xce,
-	0xe7, 0x5e, 0xeb, 0x72, 0xee, 0xb5, 0x7e, 0xcc, 0xbd, 0xd6, 0x97, 0x3d, 0x1d, 0x51, 0xa6, 0x13,
-	0xca, 0x91, 0x9d, 0xde, 0xfc, 0xaf, 0x34, 0x57, 0x10, 0xaf, 0x35, 0xa2, 0xbf, 0xfa, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x8d, 0xa3, 0x8b, 0xf7, 0xd5, 0x04, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xdb, 0xa4, 0x4d, 0x37, 0xd0, 0x16, 0xab, 0x87, 0x34, 0x05, 0x3b, 0xca, 0x85, 0x48,
+	0xa5, 0xbb, 0xb4, 0x5c, 0x90, 0x38, 0x20, 0x52, 0x89, 0x0a, 0xa9, 0x87, 0x60, 0xd1, 0x0b, 0x97,
+	0xc8, 0xb1, 0x27, 0xc6, 0x8a, 0xb3, 0x9b, 0x7a, 0xd7, 0xa1, 0xbd, 0xf1, 0x08, 0x7d, 0x0e, 0x9e,
+	0xa4, 0xc7, 0x1e, 0x39, 0x51, 0x94, 0xbc, 0x07, 0x42, 0xfb, 0xe3, 0x40, 0x21, 0x55, 0x38, 0x24,
+	0x27, 0xef, 0xee, 0xcc, 0x37, 0xdf, 0x37, 0x3b, 0xdf, 0x26, 0x68, 0x3f, 0x60, 0x7c, 0xc0, 0x38,
+	0xf1, 0x83, 0x80, 0x65, 0x54, 0x70, 0x12, 0x42, 0xcf, 0xcf, 0x12, 0xc1, 0x49, 0xc2, 0x82, 0x7e,
+	0x36, 0x24, 0xe7, 0x19, 0xa4, 0x97, 0x78, 0x98, 0x32, 0xc1, 0x6c, 0x57, 0x27, 0xe3, 0x3c, 0x19,
+	0xe7, 0xc9, 0x58, 0x27, 0xd7, 0x9e, 0xcd, 0xab, 0xa6, 0x3f, 0xba, 0x5c, 0xcd, 0x31, 0xd9, 0x5d,
+	0x9f, 0x03, 0x19, 0x1d, 0x76, 0x41, 0xf8, 0x87, 0x24, 0x60, 0x31, 0x35, 0xf1, 0x9d, 0x88, 0x45,
+	0x4c, 0x2d, 0x89, 0x5c, 0x99, 0x53, 0x37, 0x62, 0x2c, 0x4a, 0x80, 0xa8, 0x5d, 0x37, 0xeb, 0x11,
+	0x11, 0x0f, 0x80, 0x0b, 0x7f, 0x60, 0xca, 0x36, 0x5c, 0xf4, 0xe4, 0xbd, 0x14, 0x7d, 0xaa, 0xb8,
+	0xde, 0x68, 0x29, 0xef, 0x68, 0x8f, 0x79, 0x70, 0x9e, 0x01, 0x17, 0x8d, 0x9f, 0x25, 0xe4, 0xdc,
+	0x97, 0xc1, 0x87, 0x8c, 0x72, 0xb0, 0x47, 0x68, 0x9b, 0xa5, 0x71, 0x14, 0x53, 0x3f, 0xe9, 0x48,
+	0xcd, 0x31, 0x8d, 0xaa, 0x56, 0x7d, 0xb5, 0x59, 0x39, 0xda, 0xc5, 0xe6, 0x12, 0xa4, 0x6a, 0x6c,
+	0x54, 0xe3, 0x63, 0x16, 0xd3, 0xd6, 0xf3, 0xeb, 0xef, 0x6e, 0xe1, 0xeb, 0xad, 0xdb, 0x8c, 0x62,
+	0xf1, 0x29, 0xeb, 0xe2, 0x80, 0x0d, 0x88, 0x69, 0x51, 0x7f, 0x0e, 0x78, 0xd8, 0x27, 0xe2, 0x72,
+	0x08, 0x5c, 0x01, 0xb8, 0xb7, 0x95, 0x93, 0x9c, 0x6a, 0x0e, 0x3b, 0x45, 0x9b, 0x21, 0x24, 0x10,
+	0xf9, 0x02, 0xc2, 0x4e, 0x2f, 0x05, 0xa8, 0xae, 0x2c, 0x9e, 0xf5, 0xe1, 0x94, 0xe2, 0x6d, 0x0a,
+	0x60, 0x5f, 0xa0, 0x47, 0xbf, 0x39, 0xf3, 0x66, 0x57, 0x17, 0x4f, 0xbb, 0x3d, 0x65, 0xc9, 0xbb,
+	0x7d, 0x8d, 0x10, 0x17, 0x7e, 0x2a, 0x3a, 0x72, 0x84, 0xd5, 0x62, 0xdd, 0x6a, 0x56, 0x8e, 0x6a,
+	0x58, 0xcf, 0x17, 0xe7, 0xf3, 0xc5, 0x1f, 0xf2, 0xf9, 0xb6, 0x8a, 0x57, 0xb7, 0xae, 0xe5, 0x6d,
+	0x28, 0x8c, 0x3c, 0xb5, 0x5f, 0xa1, 0x32, 0xd0, 0x50, 0xc3, 0x4b, 0xff, 0x09, 0x5f, 0x07, 0x1a,
+	0x2a, 0x30, 0x45, 0x0f, 0x64, 0xb7, 0x10, 0x76, 0xa4, 0xe7, 0x78, 0x75, 0x6d, 0xf1, 0x2d, 0x57,
+	0x34, 0x81, 0xda, 0xc8, 0xd9, 0x66, 0xf4, 0x0e, 0xe3, 0xfa, 0x12, 0x66, 0x9b, 0x53, 0x68, 0xce,
+	0x1d, 0x54, 0x62, 0x9f, 0x29, 0xa4, 0xd5, 0x72, 0xdd, 0x6a, 0x6e, 0x78, 0x7a, 0xd3, 0x78, 0x8c,
+	0x6a, 0x53, 0xff, 0xc7, 0x34, 0x6a, 0x43, 0x1a, 0xb3, 0x90, 0xe7, 0xcf, 0x83, 0xa1, 0xbd, 0x99,
+	0x51, 0xf3, 0x34, 0xda, 0x68, 0xcb, 0x98, 0xa4, 0x33, 0xd4, 0x21, 0xf3, 0x32, 0x9e, 0xe2, 0x39,
+	0x3f, 0x0f, 0x58, 0x97, 0xf2, 0x36, 0x93, 0x3b, 0x95, 0xa7, 0x72, 0xce, 0xa8, 0x09, 0x1c, 0x67,
+	0xe9, 0x08, 0x72, 0x39, 0x3d, 0xb4, 0x37, 0x33, 0x6a, 0xe4, 0x9c, 0xa0, 0x52, 0x20, 0x0f, 0x8c,
+	0x88, 0xfd, 0xb9, 0x22, 0x14, 0xbc, 0xcd, 0x62, 0x2a, 0x5a, 0x45, 0x79, 0xbd, 0x9e, 0xc6, 0x37,
+	0xce, 0xd0, 0xee, 0x1f, 0x3c, 0xe6, 0x02, 0x8d, 0x08, 0xfb, 0x25, 0x2a, 0x2a, 0x93, 0x59, 0x73,
+	0x4d, 0x56, 0x96, 0x35, 0x95, 0xd1, 0x14, 0xa2, 0xf1, 0x65, 0x05, 0xd5, 0x66, 0xd5, 0x35, 0xf2,
+	0xff, 0x35, 0x85, 0xb5, 0x74, 0x53, 0xfc, 0x6d, 0xfc, 0x95, 0xe5, 0x1a, 0xbf, 0x75, 0x72, 0x3d,
+	0x76, 0xac, 0x9b, 0xb1, 0x63, 0xfd, 0x18, 0x3b, 0xd6, 0xd5, 0xc4, 0x29, 0xdc, 0x4c, 0x9c, 0xc2,
+	0xb7, 0x89, 0x53, 0xf8, 0x78, 0xa0, 0xe1, 0x3c, 0xec, 0xe3, 0x98, 0x91, 0x8b, 0xfb, 0xff, 0x37,
+	0x54, 0xed, 0xee, 0x9a, 0xba, 0xef, 0x17, 0xbf, 0x06, 0x00, 0x83, 0x42, 0x71, 0xb7, 0xb5, 0x06,
+	0x00, 0x00,
 }
 
 func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -508,6 +704,148 @@ func (m *QueryLockingPeriodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUnlockingCurveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockingCurveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockingCurveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockingCurveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockingCurveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockingCurveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curve) > 0 {
+		for iNdEx := len(m.Curve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Curve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockedCoinsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockedCoinsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockedCoinsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockedCoinsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockedCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockedCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UnlockedCoins) > 0 {
+		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -603,22 +941,78 @@ func (m *QueryLockingPeriodsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryUnlockingCurveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryUnlockingCurveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Curve) > 0 {
+		for _, e := range m.Curve {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
+
+func (m *QueryUnlockedCoinsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnlockedCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1117,6 +1511,341 @@ func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUnlockingCurveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockingCurveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockingCurveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockingCurveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockingCurveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockingCurveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curve = append(m.Curve, CurvePoint{})
+			if err := m.Curve[len(m.Curve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockedCoinsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockedCoinsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockedCoinsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnlockedCoinsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnlockedCoinsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnlockedCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedCoins = append(m.LockedCoins, types.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
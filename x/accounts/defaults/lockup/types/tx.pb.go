@@ -237,6 +237,111 @@ func (m *MsgInitPeriodicLockingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInitPeriodicLockingAccountResponse proto.InternalMessageInfo
 
+// MsgInitCurveLockingAccount defines a message that enables creating a curve locking
+// account, whose coins unlock along a piecewise-linear curve.
+type MsgInitCurveLockingAccount struct {
+	// owner of the lockup account
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// start of lockup
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// curve defines the points of the unlocking curve, ordered by time. The unlocked
+	// amounts are interpolated linearly between the start of the lockup, where nothing
+	// is unlocked, and the points. The last point must unlock all the funds.
+	Curve []CurvePoint `protobuf:"bytes,3,rep,name=curve,proto3" json:"curve"`
+}
+
+func (m *MsgInitCurveLockingAccount) Reset()         { *m = MsgInitCurveLockingAccount{} }
+func (m *MsgInitCurveLockingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgInitCurveLockingAccount) ProtoMessage()    {}
+func (*MsgInitCurveLockingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{4}
+}
+func (m *MsgInitCurveLockingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitCurveLockingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitCurveLockingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitCurveLockingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitCurveLockingAccount.Merge(m, src)
+}
+func (m *MsgInitCurveLockingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitCurveLockingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitCurveLockingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitCurveLockingAccount proto.InternalMessageInfo
+
+func (m *MsgInitCurveLockingAccount) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgInitCurveLockingAccount) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgInitCurveLockingAccount) GetCurve() []CurvePoint {
+	if m != nil {
+		return m.Curve
+	}
+	return nil
+}
+
+// MsgInitCurveLockingAccountResponse defines the Msg/InitCurveLockingAccount
+// response type.
+type MsgInitCurveLockingAccountResponse struct {
+}
+
+func (m *MsgInitCurveLockingAccountResponse) Reset()         { *m = MsgInitCurveLockingAccountResponse{} }
+func (m *MsgInitCurveLockingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitCurveLockingAccountResponse) ProtoMessage()    {}
+func (*MsgInitCurveLockingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{5}
+}
+func (m *MsgInitCurveLockingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitCurveLockingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitCurveLockingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitCurveLockingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitCurveLockingAccountResponse.Merge(m, src)
+}
+func (m *MsgInitCurveLockingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitCurveLockingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitCurveLockingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitCurveLockingAccountResponse proto.InternalMessageInfo
+
 // MsgDelegate defines a message that enable lockup account to execute delegate message
 type MsgDelegate struct {
 	// sender is the owner of the lockup account
@@ -249,7 +354,7 @@ func (m *MsgDelegate) Reset()         { *m = MsgDelegate{} }
 func (m *MsgDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegate) ProtoMessage()    {}
 func (*MsgDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{4}
+	return fileDescriptor_e5f39108a4d67f92, []int{6}
 }
 func (m *MsgDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +394,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{5}
+	return fileDescriptor_e5f39108a4d67f92, []int{7}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +433,7 @@ func (m *MsgWithdrawReward) Reset()         { *m = MsgWithdrawReward{} }
 func (m *MsgWithdrawReward) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawReward) ProtoMessage()    {}
 func (*MsgWithdrawReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{6}
+	return fileDescriptor_e5f39108a4d67f92, []int{8}
 }
 func (m *MsgWithdrawReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +473,7 @@ func (m *MsgSend) Reset()         { *m = MsgSend{} }
 func (m *MsgSend) String() string { return proto.CompactTextString(m) }
 func (*MsgSend) ProtoMessage()    {}
 func (*MsgSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{7}
+	return fileDescriptor_e5f39108a4d67f92, []int{9}
 }
 func (m *MsgSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +511,7 @@ func (m *MsgExecuteMessagesResponse) Reset()         { *m = MsgExecuteMessagesRe
 func (m *MsgExecuteMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteMessagesResponse) ProtoMessage()    {}
 func (*MsgExecuteMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{8}
+	return fileDescriptor_e5f39108a4d67f92, []int{10}
 }
 func (m *MsgExecuteMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +559,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{9}
+	return fileDescriptor_e5f39108a4d67f92, []int{11}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +598,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{10}
+	return fileDescriptor_e5f39108a4d67f92, []int{12}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,6 +646,8 @@ func init() {
 	proto.RegisterType((*MsgInitLockupAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitLockupAccountResponse")
 	proto.RegisterType((*MsgInitPeriodicLockingAccount)(nil), "cosmos.accounts.defaults.lockup.MsgInitPeriodicLockingAccount")
 	proto.RegisterType((*MsgInitPeriodicLockingAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitPeriodicLockingAccountResponse")
+	proto.RegisterType((*MsgInitCurveLockingAccount)(nil), "cosmos.accounts.defaults.lockup.MsgInitCurveLockingAccount")
+	proto.RegisterType((*MsgInitCurveLockingAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitCurveLockingAccountResponse")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.accounts.defaults.lockup.MsgDelegate")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.accounts.defaults.lockup.MsgUndelegate")
 	proto.RegisterType((*MsgWithdrawReward)(nil), "cosmos.accounts.defaults.lockup.MsgWithdrawReward")
@@ -555,58 +662,61 @@ func init() {
 }
 
 var fileDescriptor_e5f39108a4d67f92 = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0xaa, 0xc1, 0x43, 0x81, 0xb2, 0x58, 0xc5, 0x58, 0x65, 0x97, 0x5a, 0x42, 0x58,
-	0x56, 0xbd, 0x5b, 0x68, 0xa5, 0x56, 0x56, 0x2f, 0xb8, 0xf4, 0x97, 0x54, 0x57, 0xc8, 0xf4, 0x87,
-	0xd4, 0x1c, 0xac, 0xf1, 0xee, 0x30, 0xac, 0xf0, 0xce, 0x58, 0x3b, 0x63, 0x1b, 0xdf, 0xa2, 0x28,
-	0x87, 0x88, 0x13, 0xe7, 0x9c, 0x38, 0x45, 0x11, 0x27, 0x47, 0xe2, 0x8f, 0xe0, 0x88, 0x38, 0x71,
-	0x0a, 0x91, 0x89, 0x64, 0xfe, 0x8c, 0x68, 0x77, 0x66, 0x89, 0x0d, 0x04, 0x1c, 0x1f, 0x12, 0x29,
-	0x17, 0xef, 0xcc, 0xbc, 0xef, 0xbd, 0xf7, 0xbd, 0x6f, 0xde, 0xdb, 0x35, 0xc8, 0x5a, 0x94, 0xb9,
-	0x94, 0x99, 0xd0, 0xb2, 0x68, 0x83, 0x70, 0x66, 0xda, 0x68, 0x0b, 0x36, 0x6a, 0x9c, 0x99, 0x35,
-	0x6a, 0xed, 0x34, 0xea, 0x26, 0xdf, 0x35, 0xea, 0x1e, 0xe5, 0x54, 0xd5, 0x05, 0xd2, 0x08, 0x91,
-	0x46, 0x88, 0x34, 0x04, 0x32, 0x3d, 0x03, 0x5d, 0x87, 0x50, 0x33, 0xf8, 0x15, 0x3e, 0x69, 0x4d,
-	0x46, 0xaf, 0x42, 0x86, 0xcc, 0xe6, 0x4a, 0x15, 0x71, 0xb8, 0x62, 0x5a, 0xd4, 0x21, 0xd2, 0xfe,
-	0xcd, 0x7d, 0xd9, 0xc5, 0x43, 0xa2, 0xe7, 0x24, 0xda, 0x65, 0xd8, 0x6c, 0xae, 0xf8, 0x0f, 0x69,
-	0x98, 0x17, 0x86, 0x4a, 0xb0, 0x33, 0x25, 0x4f, 0x61, 0x4a, 0x62, 0x8a, 0xa9, 0x38, 0xf7, 0x57,
-	0xa1, 0x03, 0xa6, 0x14, 0xd7, 0x90, 0x19, 0xec, 0xaa, 0x8d, 0x2d, 0x13, 0x92, 0xb6, 0x34, 0xe9,
-	0xd7, 0x4d, 0xdc, 0x71, 0x11, 0xe3, 0xd0, 0x95, 0x2c, 0x32, 0x0f, 0xa3, 0x20, 0x59, 0x62, 0xf8,
-	0x0f, 0xe2, 0xf0, 0x3f, 0x03, 0x76, 0x6b, 0x82, 0xbc, 0x6a, 0x80, 0xcf, 0x68, 0x8b, 0x20, 0x2f,
-	0xa5, 0x2c, 0x2a, 0xd9, 0x44, 0x31, 0x75, 0x7a, 0x94, 0x4f, 0x4a, 0x2e, 0x6b, 0xb6, 0xed, 0x21,
-	0xc6, 0x36, 0xb9, 0xe7, 0x10, 0x5c, 0x16, 0x30, 0x75, 0x1d, 0x8c, 0x23, 0x62, 0x57, 0xfc, 0xf8,
-	0xa9, 0xe8, 0xa2, 0x92, 0x9d, 0x58, 0x4d, 0x1b, 0x22, 0xb9, 0x11, 0x26, 0x37, 0xfe, 0x0e, 0x93,
-	0x17, 0x27, 0x8f, 0x5f, 0xea, 0x91, 0xfd, 0x73, 0x5d, 0x79, 0xde, 0xeb, 0xe4, 0x94, 0xf2, 0x18,
-	0x22, 0xb6, 0x6f, 0x54, 0x7f, 0x07, 0x80, 0x71, 0xe8, 0x71, 0x11, 0x27, 0xf6, 0xbe, 0x71, 0x12,
-	0x81, 0xb3, 0x6f, 0x2e, 0x64, 0x2f, 0x0f, 0x74, 0x65, 0xaf, 0xd7, 0xc9, 0xc9, 0x9b, 0xce, 0x33,
-	0x7b, 0xc7, 0xbc, 0xad, 0xd2, 0x8c, 0x06, 0xbe, 0xba, 0xed, 0xbc, 0x8c, 0x58, 0x9d, 0x12, 0x86,
-	0x32, 0xcf, 0xa2, 0x60, 0x41, 0x02, 0x36, 0x90, 0xe7, 0x50, 0xdb, 0xb1, 0x7c, 0xa0, 0x43, 0xf0,
-	0xa8, 0x5a, 0x0d, 0x56, 0x19, 0x1d, 0xbd, 0x4a, 0xf5, 0x01, 0x98, 0xae, 0x09, 0x2e, 0x95, 0x7a,
-	0xc0, 0x8d, 0xa5, 0x62, 0x8b, 0xb1, 0xec, 0xc4, 0xea, 0xb2, 0x71, 0x4f, 0x83, 0x1b, 0xa2, 0x96,
-	0x62, 0xc2, 0x8f, 0x2d, 0xe2, 0x4e, 0xc9, 0x50, 0xc2, 0xc2, 0x0a, 0xc6, 0xe5, 0x81, 0x1e, 0xf1,
-	0x25, 0x5c, 0xba, 0x29, 0xa1, 0xc0, 0x0c, 0x0a, 0xb9, 0x0c, 0x96, 0xee, 0xd4, 0xe9, 0x4a, 0xd1,
-	0xae, 0x02, 0x26, 0x4a, 0x0c, 0xaf, 0xa3, 0x1a, 0xc2, 0x90, 0x23, 0xf5, 0x5b, 0x10, 0x67, 0x88,
-	0xd8, 0x43, 0x08, 0x28, 0x71, 0xea, 0x5f, 0x60, 0xa6, 0x09, 0x6b, 0x8e, 0x0d, 0x39, 0xf5, 0x2a,
-	0x50, 0x40, 0x02, 0x21, 0x13, 0xc5, 0xaf, 0x4f, 0x8f, 0xf2, 0x0b, 0xd2, 0xf9, 0xdf, 0x10, 0x33,
-	0x18, 0xe5, 0x8b, 0xe6, 0xb5, 0x73, 0xf5, 0x27, 0x10, 0x87, 0xae, 0xcf, 0x51, 0xf6, 0xdc, 0x7c,
-	0x28, 0x9f, 0x3f, 0xeb, 0x86, 0x9c, 0x75, 0xe3, 0x67, 0xea, 0x90, 0x7e, 0xc1, 0xa4, 0x4f, 0x61,
-	0xf6, 0xc9, 0x81, 0x1e, 0xf1, 0xc5, 0x7a, 0xd4, 0xeb, 0xe4, 0x24, 0xc5, 0xcc, 0x6b, 0x05, 0x4c,
-	0x96, 0x18, 0xfe, 0x87, 0xd8, 0x9f, 0x74, 0x99, 0x87, 0x0a, 0x98, 0x29, 0x31, 0xfc, 0x9f, 0xc3,
-	0xb7, 0x6d, 0x0f, 0xb6, 0xca, 0xa8, 0x05, 0x3d, 0xfb, 0xe3, 0x97, 0x7a, 0x3b, 0xd9, 0xc7, 0x51,
-	0x30, 0x56, 0x62, 0x78, 0x13, 0x91, 0x51, 0x28, 0xfe, 0x00, 0x00, 0xa7, 0xd7, 0xb8, 0xbd, 0xdb,
-	0x2b, 0xc1, 0x69, 0x28, 0x7b, 0xbb, 0x4f, 0xf6, 0xd8, 0xdd, 0xb2, 0xff, 0xea, 0xcb, 0x7e, 0x78,
-	0xae, 0x67, 0xb1, 0xc3, 0xb7, 0x1b, 0x55, 0xc3, 0xa2, 0xae, 0xfc, 0x04, 0x98, 0x7d, 0x43, 0xc8,
-	0xdb, 0x75, 0xc4, 0x02, 0x07, 0xf6, 0xb4, 0xd7, 0xc9, 0x7d, 0xee, 0x37, 0x98, 0xd5, 0xae, 0xf8,
-	0xdf, 0x22, 0x36, 0xc4, 0x9d, 0x6d, 0x80, 0x74, 0x89, 0xe1, 0x5f, 0x76, 0x91, 0xd5, 0xe0, 0xa8,
-	0x84, 0x18, 0x83, 0x18, 0xb1, 0x70, 0x3a, 0xd5, 0x55, 0x90, 0xf0, 0xe4, 0x9a, 0xa5, 0x94, 0x80,
-	0x70, 0xf2, 0xc6, 0xcb, 0x69, 0x8d, 0xb4, 0xcb, 0x6f, 0x61, 0x99, 0x17, 0x62, 0xa2, 0xc3, 0x2e,
-	0x50, 0x7f, 0x04, 0xa0, 0x25, 0xd7, 0x43, 0x08, 0xdc, 0x87, 0x1d, 0x5d, 0xe4, 0x2f, 0x41, 0xdc,
-	0x46, 0x84, 0xba, 0xe2, 0x0d, 0x98, 0x28, 0xcb, 0x5d, 0x61, 0xae, 0x5f, 0x81, 0xbe, 0x4c, 0x99,
-	0x33, 0x05, 0xcc, 0x0e, 0x74, 0xae, 0xac, 0xff, 0x7b, 0x30, 0xee, 0x21, 0x0b, 0x39, 0xcd, 0x21,
-	0x98, 0x5f, 0x21, 0xd5, 0x3d, 0x05, 0x4c, 0x0b, 0xcd, 0x2b, 0xf2, 0xcc, 0x4e, 0x45, 0x3f, 0xd4,
-	0x6d, 0x4f, 0x89, 0xcc, 0x65, 0x99, 0xb8, 0xf8, 0xdb, 0x71, 0x57, 0x53, 0x4e, 0xba, 0x9a, 0xf2,
-	0xaa, 0xab, 0x29, 0xfb, 0x17, 0x5a, 0xe4, 0xe4, 0x42, 0x8b, 0x9c, 0x5d, 0x68, 0x91, 0xff, 0xf3,
-	0x22, 0x2e, 0xb3, 0x77, 0x0c, 0x87, 0x9a, 0xbb, 0x77, 0xfc, 0x53, 0xf2, 0x93, 0x56, 0xe3, 0xc1,
-	0x85, 0x7f, 0xf7, 0x26, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xb6, 0xac, 0x3d, 0x59, 0x09, 0x00, 0x00,
+	// 860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0xc7, 0xe3, 0x44, 0x9b, 0xdd, 0x0c, 0xdd, 0xdd, 0xe2, 0x8d, 0x4a, 0x88, 0x8a, 0x4d, 0xad,
+	0x22, 0xa2, 0xb4, 0xb1, 0x0b, 0xad, 0xd4, 0x2a, 0xea, 0x85, 0x40, 0xdf, 0x24, 0x52, 0xa1, 0xd0,
+	0x17, 0xa9, 0x3d, 0x44, 0x13, 0x7b, 0x30, 0x16, 0xf1, 0x4c, 0xe4, 0x99, 0x24, 0xe4, 0x56, 0x55,
+	0x3d, 0x54, 0x9c, 0x38, 0xf4, 0xd4, 0x13, 0xa7, 0xaa, 0xe2, 0x94, 0x4a, 0x7c, 0x08, 0x8e, 0x88,
+	0x13, 0xa7, 0x52, 0x85, 0x4a, 0xe1, 0x63, 0x54, 0xe3, 0x19, 0xd3, 0x04, 0x42, 0x48, 0x73, 0x68,
+	0xab, 0xbd, 0x10, 0x7b, 0x9e, 0xff, 0x3c, 0xcf, 0xff, 0xf9, 0xcd, 0x8b, 0x01, 0x39, 0x9b, 0x50,
+	0x9f, 0x50, 0x0b, 0xda, 0x36, 0x69, 0x62, 0x46, 0x2d, 0x07, 0xed, 0xc0, 0x66, 0x9d, 0x51, 0xab,
+	0x4e, 0xec, 0xbd, 0x66, 0xc3, 0x62, 0xfb, 0x66, 0x23, 0x20, 0x8c, 0xa8, 0xba, 0x50, 0x9a, 0x91,
+	0xd2, 0x8c, 0x94, 0xa6, 0x50, 0x66, 0x67, 0xa1, 0xef, 0x61, 0x62, 0x85, 0x7f, 0xc5, 0x9c, 0xac,
+	0x26, 0xb3, 0xd7, 0x20, 0x45, 0x56, 0x6b, 0xa5, 0x86, 0x18, 0x5c, 0xb1, 0x6c, 0xe2, 0x61, 0x19,
+	0x7f, 0xfb, 0xa1, 0xea, 0xe2, 0x47, 0xaa, 0xe7, 0xa4, 0xda, 0xa7, 0xae, 0xd5, 0x5a, 0xe1, 0x3f,
+	0x32, 0x30, 0x2f, 0x02, 0xd5, 0xf0, 0xcd, 0x92, 0x3e, 0x45, 0x28, 0xed, 0x12, 0x97, 0x88, 0x71,
+	0xfe, 0x14, 0x4d, 0x70, 0x09, 0x71, 0xeb, 0xc8, 0x0a, 0xdf, 0x6a, 0xcd, 0x1d, 0x0b, 0xe2, 0x8e,
+	0x0c, 0xe9, 0xb7, 0x43, 0xcc, 0xf3, 0x11, 0x65, 0xd0, 0x97, 0x2e, 0x8c, 0xef, 0xe2, 0x20, 0x5d,
+	0xa6, 0xee, 0x67, 0xd8, 0x63, 0x9b, 0xa1, 0xbb, 0x35, 0x61, 0x5e, 0x35, 0xc1, 0x23, 0xd2, 0xc6,
+	0x28, 0xc8, 0x28, 0x8b, 0x4a, 0x2e, 0x55, 0xca, 0x9c, 0x9f, 0x14, 0xd2, 0xd2, 0xcb, 0x9a, 0xe3,
+	0x04, 0x88, 0xd2, 0x6d, 0x16, 0x78, 0xd8, 0xad, 0x08, 0x99, 0xba, 0x01, 0x9e, 0x20, 0xec, 0x54,
+	0x79, 0xfe, 0x4c, 0x7c, 0x51, 0xc9, 0xcd, 0xac, 0x66, 0x4d, 0x51, 0xdc, 0x8c, 0x8a, 0x9b, 0x5f,
+	0x44, 0xc5, 0x4b, 0x4f, 0x4f, 0x7f, 0xd7, 0x63, 0x87, 0x97, 0xba, 0xf2, 0x6b, 0xbf, 0x9b, 0x57,
+	0x2a, 0x8f, 0x11, 0x76, 0x78, 0x50, 0xfd, 0x14, 0x00, 0xca, 0x60, 0xc0, 0x44, 0x9e, 0xc4, 0x3f,
+	0xcd, 0x93, 0x0a, 0x27, 0xf3, 0x70, 0x31, 0x77, 0x7d, 0xa4, 0x2b, 0x07, 0xfd, 0x6e, 0x5e, 0xae,
+	0x74, 0x81, 0x3a, 0x7b, 0xd6, 0xa8, 0x4e, 0x0d, 0x0d, 0xbc, 0x3e, 0x6a, 0xbc, 0x82, 0x68, 0x83,
+	0x60, 0x8a, 0x8c, 0x5f, 0xe2, 0x60, 0x41, 0x0a, 0xb6, 0x50, 0xe0, 0x11, 0xc7, 0xb3, 0xb9, 0xd0,
+	0xc3, 0xee, 0xb4, 0xac, 0x86, 0xbb, 0x8c, 0x4f, 0xdf, 0xa5, 0xfa, 0x2d, 0x78, 0x5e, 0x17, 0x5e,
+	0xaa, 0x8d, 0xd0, 0x1b, 0xcd, 0x24, 0x16, 0x13, 0xb9, 0x99, 0xd5, 0x65, 0xf3, 0x81, 0x0d, 0x6e,
+	0x8a, 0x5e, 0x4a, 0x29, 0x9e, 0x5b, 0xe4, 0x7d, 0x26, 0x53, 0x89, 0x08, 0x2d, 0x9a, 0xd7, 0x47,
+	0x7a, 0x8c, 0x23, 0x5c, 0xba, 0x8b, 0x50, 0x68, 0x86, 0x41, 0x2e, 0x83, 0xa5, 0xb1, 0x9c, 0x6e,
+	0x88, 0xfe, 0x14, 0x07, 0x59, 0xa9, 0x5c, 0x6f, 0x06, 0x2d, 0xf4, 0xbf, 0xc1, 0xb9, 0x09, 0x1e,
+	0xd9, 0xdc, 0x90, 0x84, 0xf8, 0xd6, 0x83, 0x10, 0x43, 0xfb, 0x5b, 0xc4, 0xc3, 0x6c, 0x10, 0xa4,
+	0x48, 0x32, 0x96, 0xdf, 0x88, 0xbe, 0x8d, 0x37, 0x81, 0x71, 0x7f, 0xf4, 0x06, 0x5e, 0x4f, 0x01,
+	0x33, 0x65, 0xea, 0x6e, 0xa0, 0x3a, 0x72, 0x21, 0x43, 0xea, 0x3b, 0x20, 0x49, 0x11, 0x76, 0x26,
+	0xc0, 0x25, 0x75, 0xea, 0xe7, 0x60, 0xb6, 0x05, 0xeb, 0x9e, 0x03, 0x19, 0x09, 0xaa, 0x50, 0x48,
+	0x42, 0x6c, 0xa9, 0xd2, 0x1b, 0xe7, 0x27, 0x85, 0x05, 0x39, 0xf9, 0xab, 0x48, 0x33, 0x9c, 0xe5,
+	0xd5, 0xd6, 0xad, 0x71, 0xf5, 0x43, 0x90, 0x84, 0x3e, 0xf7, 0x28, 0x0f, 0xec, 0x7c, 0x84, 0x8d,
+	0x5f, 0x94, 0xa6, 0xbc, 0x28, 0xcd, 0x75, 0xe2, 0xe1, 0x41, 0x48, 0x72, 0x4e, 0xf1, 0xc5, 0x8f,
+	0x47, 0x7a, 0x8c, 0x93, 0xfa, 0xbe, 0xdf, 0xcd, 0x4b, 0x8b, 0xc6, 0x9f, 0x0a, 0x78, 0x5a, 0xa6,
+	0xee, 0x97, 0xd8, 0x79, 0xa9, 0xdb, 0x3c, 0x56, 0xc0, 0x6c, 0x99, 0xba, 0x5f, 0x7b, 0x6c, 0xd7,
+	0x09, 0x60, 0xbb, 0x82, 0xda, 0x30, 0x70, 0xfe, 0xfb, 0x56, 0x47, 0x9b, 0xfd, 0x21, 0x0e, 0x1e,
+	0x97, 0xa9, 0xbb, 0x8d, 0xf0, 0x34, 0x16, 0xdf, 0x07, 0x80, 0x91, 0x5b, 0xde, 0xee, 0x9f, 0x95,
+	0x62, 0x24, 0xc2, 0xde, 0x19, 0xc0, 0x9e, 0x18, 0x8f, 0xfd, 0x63, 0x8e, 0xfd, 0xf8, 0x52, 0xcf,
+	0xb9, 0x1e, 0xdb, 0x6d, 0xd6, 0x4c, 0x9b, 0xf8, 0xf2, 0xfb, 0x69, 0x0d, 0x9c, 0x40, 0xd6, 0x69,
+	0x20, 0x1a, 0x4e, 0xa0, 0x3f, 0xf7, 0xbb, 0xf9, 0x57, 0xf8, 0x06, 0xb3, 0x3b, 0x55, 0xfe, 0x21,
+	0xa7, 0x13, 0xac, 0xd9, 0x56, 0x78, 0x77, 0x7d, 0xb4, 0x8f, 0xec, 0x26, 0x43, 0x65, 0x44, 0x29,
+	0x74, 0x11, 0x8d, 0x4e, 0xa7, 0xba, 0x0a, 0x52, 0x81, 0x7c, 0xa6, 0x19, 0x25, 0x34, 0x9c, 0xbe,
+	0x73, 0x15, 0xad, 0xe1, 0x4e, 0xe5, 0x6f, 0x99, 0xf1, 0x9b, 0x38, 0xd1, 0xd1, 0x2e, 0x50, 0x3f,
+	0x00, 0xa0, 0x2d, 0x9f, 0x27, 0x00, 0x3c, 0xa0, 0x9d, 0x1e, 0xf2, 0x6b, 0x20, 0xe9, 0x20, 0x4c,
+	0x7c, 0xf1, 0xf9, 0x48, 0x55, 0xe4, 0x5b, 0x71, 0x6e, 0x90, 0xc0, 0x40, 0x25, 0xe3, 0x42, 0x01,
+	0x2f, 0x86, 0x76, 0xae, 0xec, 0xff, 0x3d, 0xf0, 0x24, 0x40, 0x36, 0xf2, 0x5a, 0x13, 0x38, 0xbf,
+	0x51, 0xaa, 0x07, 0x0a, 0x78, 0x2e, 0x98, 0x57, 0xe5, 0x98, 0x93, 0x89, 0xff, 0x5b, 0xab, 0xfd,
+	0x4c, 0x54, 0xae, 0xc8, 0xc2, 0xa5, 0x4f, 0x4e, 0x7b, 0x9a, 0x72, 0xd6, 0xd3, 0x94, 0x3f, 0x7a,
+	0x9a, 0x72, 0x78, 0xa5, 0xc5, 0xce, 0xae, 0xb4, 0xd8, 0xc5, 0x95, 0x16, 0xfb, 0xa6, 0x20, 0xf2,
+	0x52, 0x67, 0xcf, 0xf4, 0x88, 0xb5, 0x3f, 0xe6, 0xdf, 0x4c, 0x5e, 0xb4, 0x96, 0x0c, 0x17, 0xfc,
+	0xdd, 0xbf, 0x06, 0x00, 0x98, 0x4f, 0x0d, 0x8c, 0x96, 0x0a, 0x00, 0x00,
 }
 
 func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
@@ -783,6 +893,81 @@ func (m *MsgInitPeriodicLockingAccountResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgInitCurveLockingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitCurveLockingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitCurveLockingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curve) > 0 {
+		for iNdEx := len(m.Curve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Curve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitCurveLockingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitCurveLockingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitCurveLockingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1159,6 +1344,36 @@ func (m *MsgInitPeriodicLockingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInitCurveLockingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Curve) > 0 {
+		for _, e := range m.Curve {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInitCurveLockingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1697,6 +1912,205 @@ func (m *MsgInitPeriodicLockingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgInitCurveLockingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitCurveLockingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitCurveLockingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curve = append(m.Curve, CurvePoint{})
+			if err := m.Curve[len(m.Curve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitCurveLockingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitCurveLockingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitCurveLockingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
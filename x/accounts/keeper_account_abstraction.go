@@ -1,12 +1,15 @@
 package accounts
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
 
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -19,6 +22,8 @@ var (
 	ErrBundlerPayment = errors.New("bundler payment failed")
 	// ErrExecution is returned when the execution fails.
 	ErrExecution = errors.New("execution failed")
+	// ErrInvalidBundledTx is returned when a bundled tx is malformed.
+	ErrInvalidBundledTx = errors.New("invalid bundled tx")
 )
 
 // IsAbstractedAccount returns if the provided address is an abstracted account or not.
@@ -51,3 +56,92 @@ func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, bundler
 	}
 	return nil
 }
+
+// ExecuteBundledTx authenticates the bundled tx against its signer, which must be an
+// abstracted account, and executes its messages on behalf of it. The bundled tx is
+// executed atomically: if it fails its state changes are reverted and the error is
+// reported in the response, so that the other txs of the bundle are not affected.
+func (k Keeper) ExecuteBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw) *v1.BundledTxResponse {
+	var execResponses []*implementation.Any
+	err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		execResponses, err = k.executeBundledTx(ctx, bundler, bundledTx)
+		return err
+	})
+	if err != nil {
+		return &v1.BundledTxResponse{Error: err.Error()}
+	}
+	return &v1.BundledTxResponse{ExecResponses: execResponses}
+}
+
+func (k Keeper) executeBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw) ([]*implementation.Any, error) {
+	protoTx, signer, err := k.decodeBundledTx(bundledTx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAA, err := k.IsAbstractedAccount(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	if !isAA {
+		return nil, fmt.Errorf("%w: signer is not an abstracted account", ErrAuthentication)
+	}
+
+	// the account validates the signatures of the bundled tx itself.
+	if err := k.AuthenticateAccount(ctx, signer, bundler, bundledTx, protoTx, 0); err != nil {
+		return nil, err
+	}
+
+	execResponses, err := k.sendAnyMessages(ctx, signer, protoTx.Body.Messages)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrExecution, err)
+	}
+	return execResponses, nil
+}
+
+// decodeBundledTx decodes the bundled tx and returns it alongside its signer, which
+// is the single signer of all its messages.
+func (k Keeper) decodeBundledTx(bundledTx *tx.TxRaw) (*tx.Tx, []byte, error) {
+	if bundledTx == nil {
+		return nil, nil, fmt.Errorf("%w: empty tx", ErrInvalidBundledTx)
+	}
+
+	body := new(tx.TxBody)
+	if err := k.codec.Unmarshal(bundledTx.BodyBytes, body); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidBundledTx, err)
+	}
+	authInfo := new(tx.AuthInfo)
+	if err := k.codec.Unmarshal(bundledTx.AuthInfoBytes, authInfo); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidBundledTx, err)
+	}
+	if len(body.Messages) == 0 {
+		return nil, nil, fmt.Errorf("%w: no messages", ErrInvalidBundledTx)
+	}
+
+	var signer []byte
+	for i, anyMsg := range body.Messages {
+		msg, err := implementation.UnpackAnyRaw(anyMsg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: message %d: %w", ErrInvalidBundledTx, i, err)
+		}
+		signers, _, err := k.codec.GetMsgSigners(msg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: message %d: %w", ErrInvalidBundledTx, i, err)
+		}
+		if len(signers) != 1 {
+			return nil, nil, fmt.Errorf("%w: message %d has %d signers, expected one", ErrInvalidBundledTx, i, len(signers))
+		}
+		if signer == nil {
+			signer = signers[0]
+		} else if !bytes.Equal(signer, signers[0]) {
+			return nil, nil, fmt.Errorf("%w: messages have different signers", ErrInvalidBundledTx)
+		}
+	}
+
+	return &tx.Tx{
+		Body:       body,
+		AuthInfo:   authInfo,
+		Signatures: bundledTx.Signatures,
+	}, signer, nil
+}
@@ -23,6 +23,9 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	// SignatureCache, if set, is used to skip the verification of the signatures
+	// already verified in CheckTx when executing a block.
+	SignatureCache *SignatureCache
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -50,7 +53,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper).
+			WithSignatureCache(options.SignatureCache),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -5,7 +5,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var SimSecp256k1PubkeyInternal = simSecp256k1Pubkey
+var (
+	SimSecp256k1PubkeyInternal = simSecp256k1Pubkey
+	CacheableSignature         = cacheableSignature
+)
 
 func SetSVDPubKey(svd SigVerificationDecorator, ctx sdk.Context, acc sdk.AccountI, txPubKey cryptotypes.PubKey) error {
 	return svd.setPubKey(ctx, acc, txPubKey)
@@ -0,0 +1,110 @@
+package ante
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// DefaultSignatureCacheSize is the default number of signature verifications
+// kept by a SignatureCache.
+const DefaultSignatureCacheSize = 10_000
+
+// sigCacheKey identifies the signature of a signer in a tx.
+type sigCacheKey struct {
+	txHash   [sha256.Size]byte
+	signer   string
+	sequence uint64
+}
+
+// sigCacheEntry holds the signer data a signature was successfully verified with.
+type sigCacheEntry struct {
+	chainID       string
+	accountNumber uint64
+	pubKey        []byte
+}
+
+// SignatureCache caches the successful signature verifications of CheckTx, so
+// that the signatures of a tx are not verified again when the tx is included in
+// a block. Signatures are keyed by tx hash, signer and sequence, and are only
+// reused if they were verified with the same chain id, account number and
+// public key. An entry is removed once its tx is executed in a block, since the
+// sequence of the signer has then changed, and the least recently used entries
+// are evicted when the cache is full.
+//
+// Only the signatures of the SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON
+// sign modes are cached, as their sign bytes only depend on the tx and the
+// signer data. The sign bytes of other sign modes, e.g. SIGN_MODE_TEXTUAL, can
+// depend on the state, which can change between CheckTx and the execution of
+// the tx in a block.
+//
+// Only the verification itself is skipped, the gas of the signature
+// verification is still consumed, so that cache hits do not change the
+// outcome of a tx.
+type SignatureCache struct {
+	cache *lru.Cache
+}
+
+// NewSignatureCache returns a SignatureCache holding up to size verifications.
+func NewSignatureCache(size int) (*SignatureCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignatureCache{cache: cache}, nil
+}
+
+// Len returns the number of cached signature verifications.
+func (c *SignatureCache) Len() int {
+	return c.cache.Len()
+}
+
+// add records the successful verification of a signature.
+func (c *SignatureCache) add(key sigCacheKey, entry sigCacheEntry) {
+	c.cache.Add(key, entry)
+}
+
+// verified returns whether the signature was already verified with the given signer data.
+// The entry is removed if the signer data does not match.
+func (c *SignatureCache) verified(key sigCacheKey, entry sigCacheEntry) bool {
+	v, ok := c.cache.Get(key)
+	if !ok {
+		return false
+	}
+
+	cached := v.(sigCacheEntry)
+	if cached.chainID != entry.chainID || cached.accountNumber != entry.accountNumber || !bytes.Equal(cached.pubKey, entry.pubKey) {
+		c.cache.Remove(key)
+		return false
+	}
+
+	return true
+}
+
+// remove removes the verification of a signature.
+func (c *SignatureCache) remove(key sigCacheKey) {
+	c.cache.Remove(key)
+}
+
+// cacheableSignature returns whether the verification of a signature can be
+// cached, i.e. whether all its sign modes have sign bytes which do not depend on
+// the state.
+func cacheableSignature(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_DIRECT || v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if !cacheableSignature(s) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
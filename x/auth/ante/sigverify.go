@@ -2,11 +2,13 @@ package ante
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -72,6 +74,7 @@ type SigVerificationDecorator struct {
 	aaKeeper        AccountAbstractionKeeper
 	signModeHandler *txsigning.HandlerMap
 	sigGasConsumer  SignatureVerificationGasConsumer
+	sigCache        *SignatureCache
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
@@ -83,6 +86,13 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 	}
 }
 
+// WithSignatureCache returns a copy of the decorator which skips the verification
+// of the signatures already verified in CheckTx, see SignatureCache.
+func (svd SigVerificationDecorator) WithSignatureCache(cache *SignatureCache) SigVerificationDecorator {
+	svd.sigCache = cache
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -233,6 +243,10 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 
 	// not an AA, proceed with standard auth flow.
 
+	if err := svd.checkSignMode(ctx, sig.Data); err != nil {
+		return err
+	}
+
 	// newlyCreated is a flag that indicates if the account was newly created.
 	// This is only the case when the user is sending their first tx.
 	newlyCreated := false
@@ -274,6 +288,34 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 	return nil
 }
 
+// checkSignMode checks that the signature, or all the signatures of a multisig,
+// use a sign mode accepted by the chain.
+func (svd SigVerificationDecorator) checkSignMode(ctx sdk.Context, sigData signing.SignatureData) error {
+	params := svd.ak.GetParams(ctx)
+	if len(params.AcceptedSignModes) == 0 {
+		return nil
+	}
+
+	return checkAcceptedSignMode(params, sigData)
+}
+
+func checkAcceptedSignMode(params types.Params, sigData signing.SignatureData) error {
+	switch data := sigData.(type) {
+	case *signing.SingleSignatureData:
+		if !params.IsSignModeAccepted(data.SignMode) {
+			return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "sign mode %s is not accepted by the chain", data.SignMode)
+		}
+	case *signing.MultiSignatureData:
+		for _, sig := range data.Signatures {
+			if err := checkAcceptedSignMode(params, sig); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // consumeSignatureGas will consume gas according to the pub-key being verified.
 func (svd SigVerificationDecorator) consumeSignatureGas(
 	ctx sdk.Context,
@@ -310,7 +352,8 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 	// we're in simulation mode, or in ReCheckTx, or context is not
 	// on sig verify tx, then we do not need to verify the signatures
 	// in the tx.
-	if svd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+	execMode := svd.ak.GetEnvironment().TransactionService.ExecMode(ctx)
+	if execMode == transaction.ExecModeSimulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
 		return nil
 	}
 
@@ -353,6 +396,25 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 	if !ok {
 		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 	}
+
+	// skip the signatures already verified in CheckTx, the sequence of the
+	// signer changes once the tx is executed so the entry can be dropped then.
+	useCache := svd.sigCache != nil && len(ctx.TxBytes()) > 0 && cacheableSignature(sig.Data)
+	var (
+		cacheKey   sigCacheKey
+		cacheEntry sigCacheEntry
+	)
+	if useCache {
+		cacheKey = sigCacheKey{txHash: sha256.Sum256(ctx.TxBytes()), signer: signerData.Address, sequence: signerData.Sequence}
+		cacheEntry = sigCacheEntry{chainID: chainID, accountNumber: accNum, pubKey: pubKey.Bytes()}
+		if svd.sigCache.verified(cacheKey, cacheEntry) {
+			if execMode == transaction.ExecModeFinalize {
+				svd.sigCache.remove(cacheKey)
+			}
+			return nil
+		}
+	}
+
 	txData := adaptableTx.GetSigningTxData()
 	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 	if err != nil {
@@ -367,6 +429,10 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
 	}
 
+	if useCache && execMode == transaction.ExecModeCheck {
+		svd.sigCache.add(cacheKey, cacheEntry)
+	}
+
 	return nil
 }
 
@@ -534,14 +600,62 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 	}
 }
 
+// NewSigVerificationGasConsumer returns a SignatureVerificationGasConsumer
+// consuming the gas costs of the given table for the public key types it
+// contains, keyed by their proto message names (e.g.
+// "cosmos.crypto.secp256k1.PubKey"), in place of the costs of the params. The
+// other public key types are handled as by DefaultSigVerificationGasConsumer.
+func NewSigVerificationGasConsumer(costs map[string]uint64) SignatureVerificationGasConsumer {
+	var consumer SignatureVerificationGasConsumer
+	consumer = func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error {
+		if pubkey, ok := sig.PubKey.(multisig.PubKey); ok {
+			multisignature, ok := sig.Data.(*signing.MultiSignatureData)
+			if !ok {
+				return fmt.Errorf("expected %T, got, %T", &signing.MultiSignatureData{}, sig.Data)
+			}
+
+			return consumeMultisignatureVerificationGas(meter, multisignature, pubkey, params, sig.Sequence, consumer)
+		}
+
+		var (
+			cost uint64
+			ok   bool
+		)
+		if sig.PubKey != nil {
+			cost, ok = costs[gogoproto.MessageName(sig.PubKey)]
+		}
+		if !ok {
+			return DefaultSigVerificationGasConsumer(meter, sig, params)
+		}
+
+		meter.ConsumeGas(cost, "ante verify: "+sig.PubKey.Type())
+		if _, ok := sig.PubKey.(*ed25519.PubKey); ok {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+		}
+
+		return nil
+	}
+
+	return consumer
+}
+
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubKey signature.
 func ConsumeMultisignatureVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubKey multisig.PubKey,
 	params types.Params, accSeq uint64,
+) error {
+	return consumeMultisignatureVerificationGas(meter, sig, pubKey, params, accSeq, DefaultSigVerificationGasConsumer)
+}
+
+// consumeMultisignatureVerificationGas consumes the gas of the signatures of a
+// multisig pubKey with the given consumer.
+func consumeMultisignatureVerificationGas(
+	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubKey multisig.PubKey,
+	params types.Params, accSeq uint64, consumer SignatureVerificationGasConsumer,
 ) error {
 	// if BitArray is nil, it means tx has been built for simulation.
 	if sig.BitArray == nil {
-		return multisignatureSimulationVerificationGas(meter, sig, pubKey, params, accSeq)
+		return multisignatureSimulationVerificationGas(meter, sig, pubKey, params, accSeq, consumer)
 	}
 
 	size := sig.BitArray.Count()
@@ -557,7 +671,7 @@ func ConsumeMultisignatureVerificationGas(
 			Sequence: accSeq,
 		}
 
-		err := DefaultSigVerificationGasConsumer(meter, sigV2, params)
+		err := consumer(meter, sigV2, params)
 		if err != nil {
 			return err
 		}
@@ -572,7 +686,7 @@ func ConsumeMultisignatureVerificationGas(
 // a simulation tx the number of signatures its equal to the multisig threshold.
 func multisignatureSimulationVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubKey multisig.PubKey,
-	params types.Params, accSeq uint64,
+	params types.Params, accSeq uint64, consumer SignatureVerificationGasConsumer,
 ) error {
 	for i := 0; i < len(sig.Signatures); i++ {
 		sigV2 := signing.SignatureV2{
@@ -581,7 +695,7 @@ func multisignatureSimulationVerificationGas(
 			Sequence: accSeq,
 		}
 
-		err := DefaultSigVerificationGasConsumer(meter, sigV2, params)
+		err := consumer(meter, sigV2, params)
 		if err != nil {
 			return err
 		}
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -93,6 +94,46 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}
 }
 
+func TestNewSigVerificationGasConsumer(t *testing.T) {
+	params := types.DefaultParams()
+	consumer := ante.NewSigVerificationGasConsumer(map[string]uint64{
+		"cosmos.crypto.secp256k1.PubKey": 100,
+		"cosmos.crypto.ed25519.PubKey":   50,
+	})
+	skR1, _ := secp256r1.GenPrivKey()
+	pkSet, _ := generatePubKeysAndSignatures(3, []byte{1, 2, 3, 4}, false)
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pkSet)
+	multisigSimulationSignature := &signing.MultiSignatureData{
+		Signatures: []signing.SignatureData{&signing.SingleSignatureData{}, &signing.SingleSignatureData{}},
+	}
+
+	tests := []struct {
+		name        string
+		sig         signing.SignatureData
+		pubkey      cryptotypes.PubKey
+		gasConsumed uint64
+		shouldErr   bool
+	}{
+		{"PubKeySecp256k1", nil, secp256k1.GenPrivKey().PubKey(), 100, false},
+		{"PubKeyEd25519 still unsupported", nil, ed25519.GenPrivKey().PubKey(), 50, true},
+		{"PubKeySecp256r1 not in the table", nil, skR1.PubKey(), params.SigVerifyCostSecp256r1(), false},
+		{"Multisig simulation", multisigSimulationSignature, multisigKey, 2 * 100, false},
+		{"unknown key", nil, nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meter := storetypes.NewInfiniteGasMeter()
+			err := consumer(meter, signing.SignatureV2{PubKey: tt.pubkey, Data: tt.sig}, params)
+			if tt.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.gasConsumed, meter.GasConsumed())
+		})
+	}
+}
+
 func TestSigVerification(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 	suite.txBankKeeper.EXPECT().DenomMetadataV2(gomock.Any(), gomock.Any()).Return(&bankv1beta1.QueryDenomMetadataResponse{}, nil).AnyTimes()
@@ -234,6 +275,36 @@ func TestSigVerification(t *testing.T) {
 			})
 		}
 	}
+
+	// only the accepted sign modes can be used once AcceptedSignModes is set
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	params.AcceptedSignModes = []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT}
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+	for _, signMode := range enabledSignModes {
+		t.Run(fmt.Sprintf("accepted sign modes with %s", signMode), func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithIsSigverifyTx(true)
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+
+			privs := []cryptotypes.PrivKey{priv1, priv2, priv3}
+			accNums := []uint64{accs[0].GetAccountNumber(), accs[1].GetAccountNumber(), accs[2].GetAccountNumber()}
+			tx, err := suite.CreateTestTx(ctx, privs, accNums, []uint64{0, 0, 0}, ctx.ChainID(), signMode)
+			require.NoError(t, err)
+
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			_, err = antehandler(ctx.WithTxBytes(txBytes), tx, false)
+			if signMode == signing.SignMode_SIGN_MODE_DIRECT {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+			}
+		})
+	}
 }
 
 func TestSigIntegration(t *testing.T) {
@@ -256,6 +327,78 @@ func TestSigIntegration(t *testing.T) {
 	require.Equal(t, initialSigCost*uint64(len(privs)), doubleCost-initialCost)
 }
 
+func TestSigVerificationCache(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithIsSigverifyTx(true)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	cache, err := ante.NewSignatureCache(ante.DefaultSignatureCacheSize)
+	require.NoError(t, err)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil).
+		WithSignatureCache(cache)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	runTx := func(ctx sdk.Context) (storetypes.Gas, error) {
+		ctx, _ = ctx.WithTxBytes(txBytes).CacheContext()
+		before := ctx.GasMeter().GasConsumed()
+		ctx, err := antehandler(ctx, tx, false)
+		return ctx.GasMeter().GasConsumed() - before, err
+	}
+
+	// the signature verified in CheckTx is cached
+	checkGas, err := runTx(suite.ctx.WithExecMode(sdk.ExecModeCheck))
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	// the cached signature is not reused with a different chain id
+	_, err = runTx(suite.ctx.WithExecMode(sdk.ExecModeFinalize).WithChainID("other-chain"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, 0, cache.Len())
+
+	// the cached signature is reused, and removed, when executing the tx in a block
+	_, err = runTx(suite.ctx.WithExecMode(sdk.ExecModeCheck))
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+	finalizeGas, err := runTx(suite.ctx.WithExecMode(sdk.ExecModeFinalize))
+	require.NoError(t, err)
+	require.Equal(t, 0, cache.Len())
+
+	// cache hits consume the same gas
+	require.Equal(t, checkGas, finalizeGas)
+}
+
+func TestSigVerificationCacheSignModes(t *testing.T) {
+	single := func(mode signing.SignMode) signing.SignatureData {
+		return &signing.SingleSignatureData{SignMode: mode}
+	}
+	multi := func(sigs ...signing.SignatureData) signing.SignatureData {
+		return &signing.MultiSignatureData{Signatures: sigs}
+	}
+
+	require.True(t, ante.CacheableSignature(single(signing.SignMode_SIGN_MODE_DIRECT)))
+	require.True(t, ante.CacheableSignature(single(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)))
+	require.True(t, ante.CacheableSignature(multi(single(signing.SignMode_SIGN_MODE_DIRECT), single(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))))
+
+	// the sign bytes of SIGN_MODE_TEXTUAL depend on the state
+	require.False(t, ante.CacheableSignature(single(signing.SignMode_SIGN_MODE_TEXTUAL)))
+	require.False(t, ante.CacheableSignature(single(signing.SignMode_SIGN_MODE_DIRECT_AUX)))
+	require.False(t, ante.CacheableSignature(multi(single(signing.SignMode_SIGN_MODE_DIRECT), single(signing.SignMode_SIGN_MODE_TEXTUAL))))
+}
+
 func runSigDecorators(t *testing.T, params types.Params, _ bool, privs ...cryptotypes.PrivKey) (storetypes.Gas, error) {
 	t.Helper()
 	suite := SetupTestSuite(t, true)
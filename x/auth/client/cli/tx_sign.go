@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"os"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +28,7 @@ const (
 	flagSkipSignatureVerification = "skip-signature-verification"
 	flagNoAutoIncrement           = "no-auto-increment"
 	flagAppend                    = "append"
+	flagSignature                 = "signature"
 )
 
 // GetSignBatchCommand returns the transaction sign-batch command.
@@ -337,6 +340,11 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+A transaction signed by a watch-only key, added to the keyring with its public key
+only, is printed with its signer info and an empty signature, along with the bytes
+to sign in base64. Once signed externally, the signature is added to the printed
+transaction by signing it again with the --signature=<base64_signature> flag.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(),
@@ -346,6 +354,7 @@ be generated via the 'multisign' command.
 	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the transaction shall be signed")
 	cmd.Flags().Bool(flagOverwrite, false, "Overwrite existing signatures with a new one. If disabled, new signature will be appended")
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the signatures")
+	cmd.Flags().String(flagSignature, "", "Add the base64 signature produced externally for a watch-only key")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -418,6 +427,8 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txFactory tx.Factory,
 		return err
 	}
 
+	var bytesToSign []byte
+
 	if multisigKey != "" {
 		sigOnly = true
 
@@ -459,12 +470,17 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txFactory tx.Factory,
 			return err
 		}
 	} else {
-		err = authclient.SignTx(txFactory, clientCtx, clientCtx.FromName, txBuilder, clientCtx.Offline, overwrite)
+		bytesToSign, err = signOrPrepareTx(cmd, clientCtx, txFactory, fromName, txBuilder, overwrite)
 	}
 	if err != nil {
 		return err
 	}
 
+	// the tx pending the signature of a watch-only key is printed in full
+	if bytesToSign != nil {
+		sigOnly = false
+	}
+
 	// set output
 	closeFunc, err := setOutputFile(cmd)
 	if err != nil {
@@ -482,9 +498,47 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txFactory tx.Factory,
 
 	cmd.Printf("%s\n", json)
 
+	if bytesToSign != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s is a watch-only key, sign the following bytes externally and add the signature with the --%s flag:\n%s\n",
+			fromName, flagSignature, base64.StdEncoding.EncodeToString(bytesToSign))
+	}
+
 	return err
 }
 
+// signOrPrepareTx signs the tx with the from key, or adds the signature produced
+// externally given by the --signature flag. The private key of a watch-only key
+// is not in the keyring: the tx is then prepared to be signed externally, and the
+// bytes to sign are returned.
+func signOrPrepareTx(
+	cmd *cobra.Command, clientCtx client.Context, txFactory tx.Factory, fromName string, txBuilder client.TxBuilder, overwrite bool,
+) ([]byte, error) {
+	signature, err := cmd.Flags().GetString(flagSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	if signature != "" {
+		sig, err := base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature: %w", err)
+		}
+
+		return nil, authclient.AddSignature(txFactory, clientCtx, fromName, txBuilder, clientCtx.Offline, sig)
+	}
+
+	fromRecord, err := txFactory.Keybase().Key(fromName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account from keybase: %w", err)
+	}
+
+	if fromRecord.GetType() == keyring.TypeOffline {
+		return authclient.GetSignBytes(txFactory, clientCtx, fromName, txBuilder, clientCtx.Offline, overwrite)
+	}
+
+	return nil, authclient.SignTx(txFactory, clientCtx, fromName, txBuilder, clientCtx.Offline, overwrite)
+}
+
 func marshalSignatureJSON(txConfig client.TxConfig, tx signing.Tx, signatureOnly bool) ([]byte, error) {
 	if signatureOnly {
 		sigs, err := tx.GetSignaturesV2()
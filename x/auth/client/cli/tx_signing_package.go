@@ -0,0 +1,335 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	authclient "cosmossdk.io/x/auth/client"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagListen = "listen"
+
+	defaultSigningPackageListenAddr = "localhost:8090"
+)
+
+// GetSigningPackageCommand returns the signing-package command, collecting the
+// signatures of a transaction with several signers.
+func GetSigningPackageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-package",
+		Short: "Collect the signatures of a transaction with several signers",
+		Long: fmt.Sprintf(`Collect the signatures of a transaction with several signers, possibly on
+different machines, through a signing package.
+
+The initiator creates the signing package of a transaction generated with the
+--generate-only flag, and shares it with the signers, either as a file or by serving
+it over HTTP. Each signer signs the package, and the initiator finalizes it into the
+signed transaction once all the signatures are collected.
+
+Example:
+$ %[1]s tx signing-package create tx.json > package.json
+$ %[1]s tx signing-package sign package.json --from alice > alice.json
+$ %[1]s tx signing-package sign package.json --from bob > bob.json
+$ %[1]s tx signing-package finalize package.json alice.json bob.json > signed.json
+
+Or over HTTP:
+$ %[1]s tx signing-package serve package.json --listen 0.0.0.0:8090
+$ %[1]s tx signing-package sign http://<initiator>:8090 --from alice
+$ %[1]s tx signing-package finalize package.json > signed.json
+
+The package is validated at each step: its transaction must be unsigned, and the
+signatures are verified as they are added. They are produced in the amino-json sign
+mode, which allows the signers to sign in any order.`, version.AppName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getCreateSigningPackageCommand(),
+		getSignSigningPackageCommand(),
+		getServeSigningPackageCommand(),
+		getFinalizeSigningPackageCommand(),
+	)
+
+	return cmd
+}
+
+func getCreateSigningPackageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file]",
+		Short: "Create the signing package of a transaction generated offline",
+		Long: `Create the signing package of a transaction created with the --generate-only flag.
+The account and sequence numbers of the signers of the transaction are queried from a node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Offline {
+				return errors.New("cannot create a signing package during offline mode")
+			}
+
+			parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			pkg, err := authclient.NewSigningPackage(clientCtx, clientCtx.ChainID, parsedTx)
+			if err != nil {
+				return err
+			}
+
+			return printSigningPackage(cmd, pkg)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSignSigningPackageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [package]",
+		Short: "Sign a signing package",
+		Long: `Sign the signing package read from the [package] file, and print the package with
+the signature added. If [package] is the URL of a package served by the initiator,
+the signature is sent to the initiator instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			from, _ := cmd.Flags().GetString(flags.FlagFrom)
+			_, fromName, _, err := client.GetFromFields(clientCtx, txFactory.Keybase(), from)
+			if err != nil {
+				return fmt.Errorf("error getting account from keybase: %w", err)
+			}
+
+			url := args[0]
+			if !isURL(url) {
+				pkg, err := authclient.ReadSigningPackageFromFile(clientCtx, url)
+				if err != nil {
+					return err
+				}
+				if _, err := authclient.SignSigningPackage(txFactory, clientCtx, fromName, pkg); err != nil {
+					return err
+				}
+
+				return printSigningPackage(cmd, pkg)
+			}
+
+			pkg, err := authclient.FetchSigningPackage(cmd.Context(), clientCtx.TxConfig, url)
+			if err != nil {
+				return err
+			}
+			sigs, err := authclient.SignSigningPackage(txFactory, clientCtx, fromName, pkg)
+			if err != nil {
+				return err
+			}
+
+			pkg, err = authclient.PostSignatures(cmd.Context(), clientCtx.TxConfig, url, sigs)
+			if err != nil {
+				return err
+			}
+
+			return printSigningPackage(cmd, pkg)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getServeSigningPackageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve [package]",
+		Short: "Serve a signing package over HTTP to collect the signatures",
+		Long: `Serve the signing package read from the [package] file over HTTP. The signers
+get the package with a GET request and send their signatures with a POST request,
+as done by the sign command given the URL of the server. The signatures can also be
+posted as output by the tx sign command with the --signature-only flag.
+
+The [package] file is updated as the signatures are added, and the server stops once
+all the signatures are collected.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			filename := args[0]
+			pkg, err := authclient.ReadSigningPackageFromFile(clientCtx, filename)
+			if err != nil {
+				return err
+			}
+			if len(pkg.PendingSigners()) == 0 {
+				cmd.PrintErrln("all the signatures are collected")
+				return nil
+			}
+
+			done := make(chan struct{})
+			var doneOnce sync.Once
+			srv := authclient.NewSigningPackageServer(clientCtx.TxConfig, pkg, func(pkg *authclient.SigningPackage) error {
+				if err := writeSigningPackage(filename, pkg); err != nil {
+					return err
+				}
+
+				pending := pkg.PendingSigners()
+				if len(pending) == 0 {
+					doneOnce.Do(func() { close(done) })
+				} else {
+					cmd.PrintErrf("waiting for the signatures of %s\n", strings.Join(pending, ", "))
+				}
+
+				return nil
+			})
+
+			addr, _ := cmd.Flags().GetString(flagListen)
+			ln, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+
+			httpSrv := &http.Server{Handler: srv, ReadHeaderTimeout: 10 * time.Second}
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- httpSrv.Serve(ln)
+			}()
+			cmd.PrintErrf("serving the signing package on http://%s, waiting for the signatures of %s\n",
+				ln.Addr(), strings.Join(pkg.PendingSigners(), ", "))
+
+			select {
+			case <-done:
+				cmd.PrintErrln("all the signatures are collected")
+			case <-cmd.Context().Done():
+			case err := <-errCh:
+				return err
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			return httpSrv.Shutdown(shutdownCtx)
+		},
+	}
+
+	cmd.Flags().String(flagListen, defaultSigningPackageListenAddr, "The address the signing package is served on")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getFinalizeSigningPackageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [package] [[signed-package]...]",
+		Short: "Finalize a signing package into the signed transaction",
+		Long: `Finalize the signing package read from the [package] file into the signed transaction,
+once all the signatures are collected. The signatures of the copies of the package signed
+by the signers, given as [signed-package] files, are added first.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pkg, err := authclient.ReadSigningPackageFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, filename := range args[1:] {
+				signed, err := authclient.ReadSigningPackageFromFile(clientCtx, filename)
+				if err != nil {
+					return fmt.Errorf("%s: %w", filename, err)
+				}
+				if err := pkg.Merge(cmd.Context(), clientCtx.TxConfig, signed); err != nil {
+					return fmt.Errorf("%s: %w", filename, err)
+				}
+			}
+
+			signedTx, err := pkg.Finalize(cmd.Context(), clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			closeFunc, err := setOutputFile(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFunc()
+
+			cmd.Printf("%s\n", bz)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func printSigningPackage(cmd *cobra.Command, pkg *authclient.SigningPackage) error {
+	bz, err := json.Marshal(pkg)
+	if err != nil {
+		return err
+	}
+
+	closeFunc, err := setOutputFile(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	cmd.Printf("%s\n", bz)
+	return nil
+}
+
+func writeSigningPackage(filename string, pkg *authclient.SigningPackage) error {
+	bz, err := json.Marshal(pkg)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(bz, '\n'), 0o644)
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
@@ -0,0 +1,452 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SigningPackage is a transaction to be signed by several signers, possibly on
+// different machines. The initiator creates the package from an unsigned
+// transaction, the signers add their signature to it, exchanging the package as
+// a file or over HTTP, and the initiator finalizes it into the signed transaction
+// once all the signatures are collected.
+//
+// The signatures are produced in the SIGN_MODE_LEGACY_AMINO_JSON sign mode, whose
+// sign bytes don't depend on the other signers, so that they can sign in any order.
+type SigningPackage struct {
+	// ChainID is the chain id the transaction is signed for.
+	ChainID string `json:"chain_id"`
+	// Tx is the JSON encoded unsigned transaction.
+	Tx json.RawMessage `json:"tx"`
+	// Signers are the signers of the transaction, in order.
+	Signers []PackageSigner `json:"signers"`
+}
+
+// PackageSigner is a signer of a SigningPackage.
+type PackageSigner struct {
+	Address       string `json:"address"`
+	AccountNumber uint64 `json:"account_number,string"`
+	Sequence      uint64 `json:"sequence,string"`
+	// Signature is the JSON encoded signature of the signer, in the format of the
+	// sign command with the --signature-only flag. It is empty while pending.
+	Signature json.RawMessage `json:"signature,omitempty"`
+}
+
+// NewSigningPackage returns the SigningPackage of the unsigned transaction
+// tx for the chain chainID. The account and sequence numbers of its signers are
+// queried from state.
+func NewSigningPackage(clientCtx client.Context, chainID string, tx sdk.Tx) (*SigningPackage, error) {
+	if chainID == "" {
+		return nil, errors.New("set the chain id with either the --chain-id flag or config file")
+	}
+
+	// the tx is wrapped as it will be by the signers, for the sign bytes to match
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnsigned(txBuilder); err != nil {
+		return nil, err
+	}
+
+	bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := txBuilder.GetTx().GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &SigningPackage{ChainID: chainID, Tx: bz}
+	for _, signer := range signers {
+		addr := sdk.AccAddress(signer)
+		accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Signers = append(p.Signers, PackageSigner{
+			Address:       addr.String(),
+			AccountNumber: accNum,
+			Sequence:      seq,
+		})
+	}
+
+	return p, nil
+}
+
+// DecodeSigningPackage decodes a JSON encoded SigningPackage and validates it.
+func DecodeSigningPackage(ctx context.Context, txConfig client.TxConfig, bz []byte) (*SigningPackage, error) {
+	var p SigningPackage
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return nil, fmt.Errorf("invalid signing package: %w", err)
+	}
+
+	if err := p.Validate(ctx, txConfig); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// ReadSigningPackageFromFile reads a SigningPackage from the given filename and
+// validates it.
+func ReadSigningPackageFromFile(ctx client.Context, filename string) (*SigningPackage, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeSigningPackage(ctx.CmdContext, ctx.TxConfig, bz)
+}
+
+// Validate checks that the transaction of the package is unsigned, that the
+// signers of the package are the signers of the transaction, and that the
+// signatures collected so far are valid.
+func (p *SigningPackage) Validate(ctx context.Context, txConfig client.TxConfig) error {
+	if p.ChainID == "" {
+		return errors.New("invalid signing package: empty chain id")
+	}
+
+	txBuilder, err := p.txBuilder(txConfig)
+	if err != nil {
+		return err
+	}
+	if err := checkUnsigned(txBuilder); err != nil {
+		return err
+	}
+
+	signers, err := txBuilder.GetTx().GetSigners()
+	if err != nil {
+		return err
+	}
+	if len(signers) != len(p.Signers) {
+		return fmt.Errorf("invalid signing package: expected %d signers, got %d", len(signers), len(p.Signers))
+	}
+
+	for i, signer := range p.Signers {
+		if addr := sdk.AccAddress(signers[i]).String(); signer.Address != addr {
+			return fmt.Errorf("invalid signing package: expected signer %s, got %s", addr, signer.Address)
+		}
+		if len(signer.Signature) == 0 {
+			continue
+		}
+
+		sig, err := decodeSignature(txConfig, signer.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature of %s: %w", signer.Address, err)
+		}
+		if err := p.verifySignature(ctx, txConfig, txBuilder, signer, sig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSignatures adds the JSON encoded signatures, as output by the sign command
+// with the --signature-only flag, to the package. The signatures are verified, and
+// none is added if any is invalid or conflicts with a signature already collected.
+func (p *SigningPackage) AddSignatures(ctx context.Context, txConfig client.TxConfig, sigsJSON []byte) error {
+	sigs, err := txConfig.UnmarshalSignatureJSON(sigsJSON)
+	if err != nil {
+		return fmt.Errorf("invalid signatures: %w", err)
+	}
+	if len(sigs) == 0 {
+		return errors.New("invalid signatures: no signature")
+	}
+
+	txBuilder, err := p.txBuilder(txConfig)
+	if err != nil {
+		return err
+	}
+
+	added := make(map[int]json.RawMessage, len(sigs))
+	for _, sig := range sigs {
+		if sig.PubKey == nil {
+			return errors.New("invalid signatures: missing public key")
+		}
+		addr := sdk.AccAddress(sig.PubKey.Address()).String()
+		i := p.signerIndex(addr)
+		if i < 0 {
+			return fmt.Errorf("%w: %s is not a signer of the transaction", sdkerrors.ErrorInvalidSigner, addr)
+		}
+
+		if err := p.verifySignature(ctx, txConfig, txBuilder, p.Signers[i], sig); err != nil {
+			return err
+		}
+
+		bz, err := txConfig.MarshalSignatureJSON([]signing.SignatureV2{sig})
+		if err != nil {
+			return err
+		}
+		if len(p.Signers[i].Signature) != 0 && !jsonEqual(p.Signers[i].Signature, bz) {
+			return fmt.Errorf("%s has already signed the transaction", addr)
+		}
+
+		added[i] = bz
+	}
+
+	for i, bz := range added {
+		p.Signers[i].Signature = bz
+	}
+
+	return nil
+}
+
+// Merge adds the signatures collected by another copy of the package, e.g. one
+// signed by a signer on another machine.
+func (p *SigningPackage) Merge(ctx context.Context, txConfig client.TxConfig, other *SigningPackage) error {
+	if !p.sameTx(other) {
+		return errors.New("the signing packages are for different transactions")
+	}
+
+	for _, signer := range other.Signers {
+		if len(signer.Signature) == 0 {
+			continue
+		}
+
+		if err := p.AddSignatures(ctx, txConfig, signer.Signature); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PendingSigners returns the addresses of the signers which haven't signed yet.
+func (p *SigningPackage) PendingSigners() []string {
+	var pending []string
+	for _, signer := range p.Signers {
+		if len(signer.Signature) == 0 {
+			pending = append(pending, signer.Address)
+		}
+	}
+
+	return pending
+}
+
+// Finalize returns the transaction of the package, signed with the signatures
+// of all its signers.
+func (p *SigningPackage) Finalize(ctx context.Context, txConfig client.TxConfig) (sdk.Tx, error) {
+	if err := p.Validate(ctx, txConfig); err != nil {
+		return nil, err
+	}
+
+	if pending := p.PendingSigners(); len(pending) != 0 {
+		return nil, fmt.Errorf("missing the signatures of %s", strings.Join(pending, ", "))
+	}
+
+	txBuilder, err := p.txBuilder(txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]signing.SignatureV2, 0, len(p.Signers))
+	for _, signer := range p.Signers {
+		sig, err := decodeSignature(txConfig, signer.Signature)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+// SignSigningPackage signs the transaction of the package with the `name` key
+// stored in Keybase, adds the signature to the package and returns it JSON encoded.
+func SignSigningPackage(txFactory tx.Factory, clientCtx client.Context, name string, p *SigningPackage) ([]byte, error) {
+	k, err := txFactory.Keybase().Key(name)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	i := p.signerIndex(sdk.AccAddress(pubKey.Address()).String())
+	if i < 0 {
+		return nil, fmt.Errorf("%w: %s", sdkerrors.ErrorInvalidSigner, name)
+	}
+
+	txBuilder, err := p.txBuilder(clientCtx.TxConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txFactory = txFactory.
+		WithChainID(p.ChainID).
+		WithAccountNumber(p.Signers[i].AccountNumber).
+		WithSequence(p.Signers[i].Sequence).
+		WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err := tx.Sign(clientCtx.CmdContext, txFactory, name, txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := clientCtx.TxConfig.MarshalSignatureJSON(sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.AddSignatures(clientCtx.CmdContext, clientCtx.TxConfig, bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// txBuilder returns the TxBuilder of the unsigned transaction of the package.
+func (p *SigningPackage) txBuilder(txConfig client.TxConfig) (client.TxBuilder, error) {
+	decodedTx, err := txConfig.TxJSONDecoder()(p.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing package transaction: %w", err)
+	}
+
+	return txConfig.WrapTxBuilder(decodedTx)
+}
+
+// verifySignature verifies the signature sig of the signer over the transaction
+// of the package.
+func (p *SigningPackage) verifySignature(
+	ctx context.Context, txConfig client.TxConfig, txBuilder client.TxBuilder, signer PackageSigner, sig signing.SignatureV2,
+) error {
+	if addr := sdk.AccAddress(sig.PubKey.Address()).String(); addr != signer.Address {
+		return fmt.Errorf("signature of %s doesn't match the signer %s", addr, signer.Address)
+	}
+	if sig.Sequence != signer.Sequence {
+		return fmt.Errorf("signature of %s has sequence %d, expected %d", signer.Address, sig.Sequence, signer.Sequence)
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+	signerData := txsigning.SignerData{
+		ChainID:       p.ChainID,
+		AccountNumber: signer.AccountNumber,
+		Sequence:      signer.Sequence,
+		Address:       signer.Address,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	builtTx := txBuilder.GetTx()
+	adaptableTx, ok := builtTx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", builtTx)
+	}
+
+	err = authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+	if err != nil {
+		return fmt.Errorf("couldn't verify signature of %s: %w", signer.Address, err)
+	}
+
+	return nil
+}
+
+// copy returns a copy of the package, whose signatures can be added without
+// modifying the package.
+func (p *SigningPackage) copy() *SigningPackage {
+	c := *p
+	c.Signers = append([]PackageSigner(nil), p.Signers...)
+	return &c
+}
+
+func (p *SigningPackage) signerIndex(addr string) int {
+	for i, signer := range p.Signers {
+		if signer.Address == addr {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// sameTx returns true if both packages are for the same transaction and signers.
+func (p *SigningPackage) sameTx(other *SigningPackage) bool {
+	if p.ChainID != other.ChainID || len(p.Signers) != len(other.Signers) {
+		return false
+	}
+
+	if !jsonEqual(p.Tx, other.Tx) {
+		return false
+	}
+
+	for i, signer := range p.Signers {
+		o := other.Signers[i]
+		if signer.Address != o.Address || signer.AccountNumber != o.AccountNumber || signer.Sequence != o.Sequence {
+			return false
+		}
+	}
+
+	return true
+}
+
+// jsonEqual returns true if a and b are the same JSON documents, regardless of
+// their indentation.
+func jsonEqual(a, b []byte) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return false
+	}
+
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
+func checkUnsigned(txBuilder client.TxBuilder) error {
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if len(sigs) != 0 {
+		return errors.New("the transaction of a signing package must be unsigned")
+	}
+
+	return nil
+}
+
+func decodeSignature(txConfig client.TxConfig, bz []byte) (signing.SignatureV2, error) {
+	sigs, err := txConfig.UnmarshalSignatureJSON(bz)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if len(sigs) != 1 {
+		return signing.SignatureV2{}, fmt.Errorf("expected 1 signature, got %d", len(sigs))
+	}
+	if sigs[0].PubKey == nil {
+		return signing.SignatureV2{}, errors.New("missing public key")
+	}
+
+	return sigs[0], nil
+}
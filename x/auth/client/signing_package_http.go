@@ -0,0 +1,132 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// maxSignaturesSize is the maximum size of the signatures posted to a
+// SigningPackageServer.
+const maxSignaturesSize = 1 << 20
+
+// SigningPackageServer exchanges a SigningPackage over HTTP. The signers get the
+// package with a GET request, and add their signatures with a POST request whose
+// body is the JSON encoded signatures, as output by the sign command with the
+// --signature-only flag. Both requests are answered with the JSON encoded package.
+type SigningPackageServer struct {
+	txConfig client.TxConfig
+	onUpdate func(*SigningPackage) error
+
+	mu  sync.Mutex
+	pkg *SigningPackage
+}
+
+// NewSigningPackageServer returns a SigningPackageServer exchanging pkg. onUpdate,
+// if not nil, is called with the package each time signatures are added, e.g. to
+// persist it. The signatures are rejected if it returns an error.
+func NewSigningPackageServer(txConfig client.TxConfig, pkg *SigningPackage, onUpdate func(*SigningPackage) error) *SigningPackageServer {
+	return &SigningPackageServer{
+		txConfig: txConfig,
+		onUpdate: onUpdate,
+		pkg:      pkg,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (s *SigningPackageServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		sigs, err := io.ReadAll(io.LimitReader(r.Body, maxSignaturesSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// the signatures are added to a copy, kept only once persisted
+		pkg := s.pkg.copy()
+		if err := pkg.AddSignatures(r.Context(), s.txConfig, sigs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if s.onUpdate != nil {
+			if err := s.onUpdate(pkg); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+		s.pkg = pkg
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	bz, err := json.Marshal(s.pkg)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// Package returns a copy of the package exchanged by the server.
+func (s *SigningPackageServer) Package() *SigningPackage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pkg.copy()
+}
+
+// FetchSigningPackage gets the SigningPackage served at url and validates it.
+func FetchSigningPackage(ctx context.Context, txConfig client.TxConfig, url string) (*SigningPackage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return doSigningPackageRequest(ctx, txConfig, req)
+}
+
+// PostSignatures posts the JSON encoded signatures to the SigningPackage served
+// at url, and returns the updated package.
+func PostSignatures(ctx context.Context, txConfig client.TxConfig, url string, sigs []byte) (*SigningPackage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(sigs))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return doSigningPackageRequest(ctx, txConfig, req)
+}
+
+func doSigningPackageRequest(ctx context.Context, txConfig client.TxConfig, req *http.Request) (*SigningPackage, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signing package server responded with %s: %s", resp.Status, strings.TrimSpace(string(bz)))
+	}
+
+	return DecodeSigningPackage(ctx, txConfig, bz)
+}
@@ -0,0 +1,226 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authclient "cosmossdk.io/x/auth/client"
+	"cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type signingPackageFixture struct {
+	clientCtx client.Context
+	txFactory tx.Factory
+	addrs     []sdk.AccAddress
+	unsigned  sdk.Tx
+}
+
+func newSigningPackageFixture(t *testing.T) signingPackageFixture {
+	t.Helper()
+
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	encodingConfig.Amino.RegisterConcrete(&testdata.TestMsg{}, "testdata.TestMsg")
+
+	kr := keyring.NewInMemory(encodingConfig.Codec)
+	accounts := map[string]client.TestAccount{}
+	var addrs []sdk.AccAddress
+	for i, name := range []string{"alice", "bob", "carol"} {
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+
+		addrs = append(addrs, addr)
+		accounts[addr.String()] = client.TestAccount{Address: addr, Num: uint64(i + 1), Seq: uint64(10 * i)}
+	}
+
+	clientCtx := client.Context{}.
+		WithTxConfig(encodingConfig.TxConfig).
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithKeyring(kr).
+		WithAccountRetriever(client.TestAccountRetriever{Accounts: accounts}).
+		WithCmdContext(context.Background())
+
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1])))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetMemo("memo")
+
+	return signingPackageFixture{
+		clientCtx: clientCtx,
+		txFactory: tx.Factory{}.WithKeybase(kr).WithTxConfig(encodingConfig.TxConfig),
+		addrs:     addrs,
+		unsigned:  txBuilder.GetTx(),
+	}
+}
+
+func TestSigningPackage(t *testing.T) {
+	f := newSigningPackageFixture(t)
+	ctx, txConfig := f.clientCtx.CmdContext, f.clientCtx.TxConfig
+
+	_, err := authclient.NewSigningPackage(f.clientCtx, "", f.unsigned)
+	require.ErrorContains(t, err, "set the chain id")
+
+	pkg, err := authclient.NewSigningPackage(f.clientCtx, "test-chain", f.unsigned)
+	require.NoError(t, err)
+	require.Equal(t, []authclient.PackageSigner{
+		{Address: f.addrs[0].String(), AccountNumber: 1, Sequence: 0},
+		{Address: f.addrs[1].String(), AccountNumber: 2, Sequence: 10},
+	}, pkg.Signers)
+	require.Equal(t, []string{f.addrs[0].String(), f.addrs[1].String()}, pkg.PendingSigners())
+
+	// the package is exchanged as JSON
+	bz, err := json.Marshal(pkg)
+	require.NoError(t, err)
+	pkg, err = authclient.DecodeSigningPackage(ctx, txConfig, bz)
+	require.NoError(t, err)
+
+	// the signers sign their own copy of the package
+	alicePkg, err := authclient.DecodeSigningPackage(ctx, txConfig, bz)
+	require.NoError(t, err)
+	_, err = authclient.SignSigningPackage(f.txFactory, f.clientCtx, "alice", alicePkg)
+	require.NoError(t, err)
+	require.Equal(t, []string{f.addrs[1].String()}, alicePkg.PendingSigners())
+
+	bobPkg, err := authclient.DecodeSigningPackage(ctx, txConfig, bz)
+	require.NoError(t, err)
+	bobSigs, err := authclient.SignSigningPackage(f.txFactory, f.clientCtx, "bob", bobPkg)
+	require.NoError(t, err)
+
+	_, err = authclient.SignSigningPackage(f.txFactory, f.clientCtx, "carol", bobPkg)
+	require.ErrorContains(t, err, "carol")
+
+	// the package can't be finalized before all the signatures are collected
+	_, err = pkg.Finalize(ctx, txConfig)
+	require.ErrorContains(t, err, "missing the signatures of "+f.addrs[0].String()+", "+f.addrs[1].String())
+
+	require.NoError(t, pkg.Merge(ctx, txConfig, alicePkg))
+	require.NoError(t, pkg.AddSignatures(ctx, txConfig, bobSigs))
+	// adding the same signature again is a no-op
+	require.NoError(t, pkg.Merge(ctx, txConfig, bobPkg))
+	require.Empty(t, pkg.PendingSigners())
+
+	signedTx, err := pkg.Finalize(ctx, txConfig)
+	require.NoError(t, err)
+	sigTx, ok := signedTx.(signing.Tx)
+	require.True(t, ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	require.Equal(t, f.addrs[0], sdk.AccAddress(sigs[0].PubKey.Address()))
+	require.Equal(t, f.addrs[1], sdk.AccAddress(sigs[1].PubKey.Address()))
+	require.Equal(t, uint64(10), sigs[1].Sequence)
+
+	// a signed package of another transaction can't be merged
+	otherPkg, err := authclient.NewSigningPackage(f.clientCtx, "other-chain", f.unsigned)
+	require.NoError(t, err)
+	require.ErrorContains(t, otherPkg.Merge(ctx, txConfig, alicePkg), "different transactions")
+
+	// and the signatures for another transaction are rejected
+	require.ErrorContains(t, otherPkg.AddSignatures(ctx, txConfig, bobSigs), "couldn't verify signature")
+	require.Len(t, otherPkg.PendingSigners(), 2)
+}
+
+func TestDecodeSigningPackage(t *testing.T) {
+	f := newSigningPackageFixture(t)
+	ctx, txConfig := f.clientCtx.CmdContext, f.clientCtx.TxConfig
+
+	pkg, err := authclient.NewSigningPackage(f.clientCtx, "test-chain", f.unsigned)
+	require.NoError(t, err)
+	_, err = authclient.SignSigningPackage(f.txFactory, f.clientCtx, "alice", pkg)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		malleate func(pkg *authclient.SigningPackage)
+		errMsg   string
+	}{
+		{"valid", func(*authclient.SigningPackage) {}, ""},
+		{"empty chain id", func(pkg *authclient.SigningPackage) { pkg.ChainID = "" }, "empty chain id"},
+		{"missing signer", func(pkg *authclient.SigningPackage) { pkg.Signers = pkg.Signers[:1] }, "expected 2 signers, got 1"},
+		{"wrong signer", func(pkg *authclient.SigningPackage) { pkg.Signers[1].Address = f.addrs[2].String() }, "expected signer " + f.addrs[1].String()},
+		{"wrong account number", func(pkg *authclient.SigningPackage) { pkg.Signers[0].AccountNumber = 5 }, "couldn't verify signature"},
+		{"wrong sequence", func(pkg *authclient.SigningPackage) { pkg.Signers[0].Sequence = 1 }, "has sequence 0, expected 1"},
+		{"signature of another signer", func(pkg *authclient.SigningPackage) { pkg.Signers[1].Signature = pkg.Signers[0].Signature }, "doesn't match the signer"},
+		{"signed tx", func(pkg *authclient.SigningPackage) {
+			txBuilder, err := txConfig.WrapTxBuilder(f.unsigned)
+			require.NoError(t, err)
+			require.NoError(t, tx.Sign(ctx, f.txFactory.WithChainID("test-chain"), "carol", txBuilder, true))
+			pkg.Tx, err = txConfig.TxJSONEncoder()(txBuilder.GetTx())
+			require.NoError(t, err)
+		}, "must be unsigned"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := json.Marshal(pkg)
+			require.NoError(t, err)
+			var malleated authclient.SigningPackage
+			require.NoError(t, json.Unmarshal(bz, &malleated))
+			tc.malleate(&malleated)
+
+			bz, err = json.Marshal(&malleated)
+			require.NoError(t, err)
+			_, err = authclient.DecodeSigningPackage(ctx, txConfig, bz)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestSigningPackageServer(t *testing.T) {
+	f := newSigningPackageFixture(t)
+	ctx, txConfig := f.clientCtx.CmdContext, f.clientCtx.TxConfig
+
+	pkg, err := authclient.NewSigningPackage(f.clientCtx, "test-chain", f.unsigned)
+	require.NoError(t, err)
+
+	var updates int
+	srv := httptest.NewServer(authclient.NewSigningPackageServer(txConfig, pkg, func(*authclient.SigningPackage) error {
+		updates++
+		return nil
+	}))
+	defer srv.Close()
+
+	for _, name := range []string{"alice", "bob"} {
+		fetched, err := authclient.FetchSigningPackage(ctx, txConfig, srv.URL)
+		require.NoError(t, err)
+
+		sigs, err := authclient.SignSigningPackage(f.txFactory, f.clientCtx, name, fetched)
+		require.NoError(t, err)
+		_, err = authclient.PostSignatures(ctx, txConfig, srv.URL, sigs)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, updates)
+
+	_, err = authclient.PostSignatures(ctx, txConfig, srv.URL, []byte("{}"))
+	require.ErrorContains(t, err, "400 Bad Request")
+
+	collected, err := authclient.FetchSigningPackage(ctx, txConfig, srv.URL)
+	require.NoError(t, err)
+	require.Empty(t, collected.PendingSigners())
+	_, err = collected.Finalize(ctx, txConfig)
+	require.NoError(t, err)
+
+	// the package given to the server isn't modified
+	require.Len(t, pkg.PendingSigners(), 2)
+}
@@ -33,11 +33,49 @@ func (gr GasEstimateResponse) String() string {
 // The new signature is appended to the TxBuilder when overwrite=false or overwritten otherwise.
 // Don't perform online validation or lookups if offline is true.
 func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuilder client.TxBuilder, offline, overwriteSig bool) error {
-	k, err := txFactory.Keybase().Key(name)
+	txFactory, err := signerTxFactory(txFactory, clientCtx, name, txBuilder, offline)
 	if err != nil {
 		return err
 	}
 
+	return tx.Sign(clientCtx.CmdContext, txFactory, name, txBuilder, overwriteSig)
+}
+
+// GetSignBytes prepares a transaction managed by the TxBuilder to be signed externally with
+// the watch-only `name` key stored in Keybase, and returns the bytes to sign.
+// The signer info of the key is appended to the TxBuilder when overwrite=false or overwritten otherwise.
+// Don't perform online validation or lookups if offline is true.
+func GetSignBytes(txFactory tx.Factory, clientCtx client.Context, name string, txBuilder client.TxBuilder, offline, overwriteSig bool) ([]byte, error) {
+	txFactory, err := signerTxFactory(txFactory, clientCtx, name, txBuilder, offline)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.GetSignBytes(clientCtx.CmdContext, txFactory, name, txBuilder, overwriteSig)
+}
+
+// AddSignature adds the signature of the `name` key stored in Keybase, produced externally
+// over the bytes returned by GetSignBytes, to a transaction managed by the TxBuilder.
+// Don't perform online validation or lookups if offline is true.
+func AddSignature(txFactory tx.Factory, clientCtx client.Context, name string, txBuilder client.TxBuilder, offline bool, sig []byte) error {
+	txFactory, err := signerTxFactory(txFactory, clientCtx, name, txBuilder, offline)
+	if err != nil {
+		return err
+	}
+
+	return tx.AddSignature(clientCtx.CmdContext, txFactory, name, txBuilder, sig)
+}
+
+// signerTxFactory returns the tx factory to sign a transaction managed by the TxBuilder
+// with the `name` key stored in Keybase, checking that the key is a signer of the
+// transaction. The account and sequence numbers are populated from state unless
+// offline is true.
+func signerTxFactory(txFactory tx.Factory, clientCtx client.Context, name string, txBuilder client.TxBuilder, offline bool) (tx.Factory, error) {
+	k, err := txFactory.Keybase().Key(name)
+	if err != nil {
+		return txFactory, err
+	}
+
 	// Ledger and Multisigs only support LEGACY_AMINO_JSON signing.
 	if txFactory.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED &&
 		(k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeMulti) {
@@ -46,24 +84,24 @@ func SignTx(txFactory tx.Factory, clientCtx client.Context, name string, txBuild
 
 	pubKey, err := k.GetPubKey()
 	if err != nil {
-		return err
+		return txFactory, err
 	}
 	addr := sdk.AccAddress(pubKey.Address())
 	signers, err := txBuilder.GetTx().GetSigners()
 	if err != nil {
-		return err
+		return txFactory, err
 	}
 	if !isTxSigner(addr, signers) {
-		return fmt.Errorf("%w: %s", sdkerrors.ErrorInvalidSigner, name)
+		return txFactory, fmt.Errorf("%w: %s", sdkerrors.ErrorInvalidSigner, name)
 	}
 	if !offline {
 		txFactory, err = populateAccountFromState(txFactory, clientCtx, addr)
 		if err != nil {
-			return err
+			return txFactory, err
 		}
 	}
 
-	return tx.Sign(clientCtx.CmdContext, txFactory, name, txBuilder, overwriteSig)
+	return txFactory, nil
 }
 
 // SignTxWithSignerAddress attaches a signature to a transaction.
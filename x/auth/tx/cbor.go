@@ -0,0 +1,228 @@
+package tx
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/tx/decode"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The CBOR envelope of a tx is its TxRaw encoded as a CBOR (RFC 8949) map
+// keyed by the TxRaw field numbers:
+//
+//	{1: body_bytes, 2: auth_info_bytes, 3: [signatures...]}
+//
+// It is encoded deterministically (RFC 8949, section 4.2.1): all three entries
+// are always present, in ascending key order, with definite lengths and the
+// shortest argument encodings, so a tx has exactly one CBOR envelope. The body
+// and auth info stay protobuf encoded, so that the bytes signed with
+// SIGN_MODE_DIRECT are preserved.
+const (
+	cborMajorUint       byte = 0
+	cborMajorByteString byte = 2
+	cborMajorArray      byte = 4
+	cborMajorMap        byte = 5
+
+	cborKeyBodyBytes     = 1
+	cborKeyAuthInfoBytes = 2
+	cborKeySignatures    = 3
+)
+
+// DefaultCBORTxEncoder returns a default TxEncoder encoding txs to their
+// deterministic CBOR envelope.
+func DefaultCBORTxEncoder() sdk.TxEncoder {
+	return func(tx sdk.Tx) ([]byte, error) {
+		gogoWrapper, ok := tx.(*gogoTxWrapper)
+		if !ok {
+			return nil, fmt.Errorf("unexpected tx type: %T", tx)
+		}
+		return marshalCBORTxRaw(gogoWrapper.TxRaw), nil
+	}
+}
+
+// DefaultCBORTxDecoder returns a default TxDecoder decoding txs from their
+// deterministic CBOR envelope. Envelopes which are not deterministically
+// encoded are rejected, and the tx is then decoded as its protobuf encoding.
+func DefaultCBORTxDecoder(addrCodec address.Codec, cdc codec.Codec, decoder *decode.Decoder) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		raw, err := unmarshalCBORTxRaw(txBytes)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		protoTxBytes, err := marshalOption.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		decodedTx, err := decoder.Decode(protoTxBytes)
+		if err != nil {
+			return nil, err
+		}
+		return newWrapperFromDecodedTx(addrCodec, cdc, decodedTx)
+	}
+}
+
+func marshalCBORTxRaw(raw *txv1beta1.TxRaw) []byte {
+	bz := appendCBORHead(nil, cborMajorMap, 3)
+	bz = appendCBORHead(bz, cborMajorUint, cborKeyBodyBytes)
+	bz = appendCBORBytes(bz, raw.BodyBytes)
+	bz = appendCBORHead(bz, cborMajorUint, cborKeyAuthInfoBytes)
+	bz = appendCBORBytes(bz, raw.AuthInfoBytes)
+	bz = appendCBORHead(bz, cborMajorUint, cborKeySignatures)
+	bz = appendCBORHead(bz, cborMajorArray, uint64(len(raw.Signatures)))
+	for _, sig := range raw.Signatures {
+		bz = appendCBORBytes(bz, sig)
+	}
+	return bz
+}
+
+func unmarshalCBORTxRaw(bz []byte) (*txv1beta1.TxRaw, error) {
+	r := &cborReader{bz: bz}
+	n, err := r.readHead(cborMajorMap)
+	if err != nil {
+		return nil, err
+	}
+	if n != 3 {
+		return nil, fmt.Errorf("expected 3 CBOR tx envelope entries, got %d", n)
+	}
+
+	raw := &txv1beta1.TxRaw{}
+	if err := r.readKey(cborKeyBodyBytes); err != nil {
+		return nil, err
+	}
+	if raw.BodyBytes, err = r.readBytes(); err != nil {
+		return nil, err
+	}
+	if err := r.readKey(cborKeyAuthInfoBytes); err != nil {
+		return nil, err
+	}
+	if raw.AuthInfoBytes, err = r.readBytes(); err != nil {
+		return nil, err
+	}
+	if err := r.readKey(cborKeySignatures); err != nil {
+		return nil, err
+	}
+	n, err = r.readHead(cborMajorArray)
+	if err != nil {
+		return nil, err
+	}
+	// every signature takes at least one byte
+	if n > uint64(len(r.bz)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	raw.Signatures = make([][]byte, n)
+	for i := range raw.Signatures {
+		if raw.Signatures[i], err = r.readBytes(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.bz) != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after the CBOR tx envelope", len(r.bz))
+	}
+	return raw, nil
+}
+
+// appendCBORHead appends the initial byte of a data item of the major type,
+// followed by its argument in its shortest encoding.
+func appendCBORHead(bz []byte, major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return append(bz, major<<5|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(bz, major<<5|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(bz, major<<5|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(bz, major<<5|26), uint32(arg))
+	default:
+		return binary.BigEndian.AppendUint64(append(bz, major<<5|27), arg)
+	}
+}
+
+func appendCBORBytes(bz, b []byte) []byte {
+	return append(appendCBORHead(bz, cborMajorByteString, uint64(len(b))), b...)
+}
+
+// cborReader reads the deterministically encoded data items of a CBOR tx
+// envelope.
+type cborReader struct {
+	bz []byte
+}
+
+// readHead reads the initial byte of a data item of the major type and its
+// argument, which must be in its shortest encoding.
+func (r *cborReader) readHead(major byte) (uint64, error) {
+	if len(r.bz) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	initial := r.bz[0]
+	if initial>>5 != major {
+		return 0, fmt.Errorf("expected CBOR major type %d, got %d", major, initial>>5)
+	}
+
+	var size int
+	switch info := initial & 0x1f; {
+	case info < 24:
+		r.bz = r.bz[1:]
+		return uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, errors.New("indefinite length CBOR data items are not allowed")
+	}
+	if len(r.bz) < 1+size {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	var arg uint64
+	for _, b := range r.bz[1 : 1+size] {
+		arg = arg<<8 | uint64(b)
+	}
+	if len(appendCBORHead(nil, major, arg)) != 1+size {
+		return 0, fmt.Errorf("CBOR argument %d is not in its shortest encoding", arg)
+	}
+	r.bz = r.bz[1+size:]
+	return arg, nil
+}
+
+func (r *cborReader) readKey(key uint64) error {
+	k, err := r.readHead(cborMajorUint)
+	if err != nil {
+		return err
+	}
+	if k != key {
+		return fmt.Errorf("expected CBOR tx envelope key %d, got %d", key, k)
+	}
+	return nil
+}
+
+func (r *cborReader) readBytes() ([]byte, error) {
+	n, err := r.readHead(cborMajorByteString)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.bz)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := r.bz[:n:n]
+	r.bz = r.bz[n:]
+	return b, nil
+}
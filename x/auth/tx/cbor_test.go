@@ -0,0 +1,49 @@
+package tx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+)
+
+func TestCBORTxRaw(t *testing.T) {
+	raw := &txv1beta1.TxRaw{
+		BodyBytes:     []byte("body"),
+		AuthInfoBytes: bytes.Repeat([]byte{1}, 300),
+		Signatures:    [][]byte{[]byte("sig1"), {}},
+	}
+
+	bz := marshalCBORTxRaw(raw)
+	require.Equal(t, []byte{0xa3, 0x01, 0x44}, bz[:3], "map of 3 entries, key 1, byte string of 4 bytes")
+	require.Equal(t, []byte{0x02, 0x59, 0x01, 0x2c}, bz[7:11], "key 2, byte string of 300 bytes")
+
+	decoded, err := unmarshalCBORTxRaw(bz)
+	require.NoError(t, err)
+	require.Equal(t, raw.BodyBytes, decoded.BodyBytes)
+	require.Equal(t, raw.AuthInfoBytes, decoded.AuthInfoBytes)
+	require.Equal(t, raw.Signatures, decoded.Signatures)
+	require.Equal(t, bz, marshalCBORTxRaw(decoded))
+
+	for _, tc := range []struct {
+		name string
+		bz   []byte
+	}{
+		{"empty", nil},
+		{"not a map", []byte{0x83, 0x40, 0x40, 0x80}},
+		{"missing entry", []byte{0xa2, 0x01, 0x40, 0x02, 0x40}},
+		{"unsorted keys", []byte{0xa3, 0x02, 0x40, 0x01, 0x40, 0x03, 0x80}},
+		{"indefinite length", []byte{0xa3, 0x01, 0x5f, 0xff, 0x02, 0x40, 0x03, 0x80}},
+		{"non shortest argument", []byte{0xa3, 0x01, 0x58, 0x00, 0x02, 0x40, 0x03, 0x80}},
+		{"truncated byte string", []byte{0xa3, 0x01, 0x44, 0x00}},
+		{"truncated signatures", []byte{0xa3, 0x01, 0x40, 0x02, 0x40, 0x03, 0x9a, 0xff, 0xff, 0xff, 0xff}},
+		{"trailing bytes", append(marshalCBORTxRaw(raw), 0x00)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := unmarshalCBORTxRaw(tc.bz)
+			require.Error(t, err)
+		})
+	}
+}
@@ -24,6 +24,8 @@ type config struct {
 	encoder        sdk.TxEncoder
 	jsonDecoder    sdk.TxDecoder
 	jsonEncoder    sdk.TxEncoder
+	cborDecoder    sdk.TxDecoder
+	cborEncoder    sdk.TxEncoder
 	protoCodec     codec.Codec
 	signingContext *txsigning.Context
 	txDecoder      *txdecode.Decoder
@@ -57,6 +59,10 @@ type ConfigOptions struct {
 	JSONDecoder sdk.TxDecoder
 	// JSONEncoder is the encoder that will be used to encode json transactions.
 	JSONEncoder sdk.TxEncoder
+	// CBORDecoder is the decoder that will be used to decode cbor transactions.
+	CBORDecoder sdk.TxDecoder
+	// CBOREncoder is the encoder that will be used to encode cbor transactions.
+	CBOREncoder sdk.TxEncoder
 }
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
@@ -173,6 +179,8 @@ func NewTxConfigWithOptions(protoCodec codec.Codec, configOptions ConfigOptions)
 		encoder:     configOptions.ProtoEncoder,
 		jsonDecoder: configOptions.JSONDecoder,
 		jsonEncoder: configOptions.JSONEncoder,
+		cborDecoder: configOptions.CBORDecoder,
+		cborEncoder: configOptions.CBOREncoder,
 	}
 
 	var err error
@@ -210,6 +218,12 @@ func NewTxConfigWithOptions(protoCodec codec.Codec, configOptions ConfigOptions)
 	if configOptions.JSONEncoder == nil {
 		txConfig.jsonEncoder = DefaultJSONTxEncoder(protoCodec)
 	}
+	if configOptions.CBORDecoder == nil {
+		txConfig.cborDecoder = DefaultCBORTxDecoder(configOptions.SigningOptions.AddressCodec, protoCodec, txConfig.txDecoder)
+	}
+	if configOptions.CBOREncoder == nil {
+		txConfig.cborEncoder = DefaultCBORTxEncoder()
+	}
 
 	txConfig.signingContext = configOptions.SigningContext
 
@@ -260,6 +274,14 @@ func (g config) TxJSONDecoder() sdk.TxDecoder {
 	return g.jsonDecoder
 }
 
+func (g config) TxCBOREncoder() sdk.TxEncoder {
+	return g.cborEncoder
+}
+
+func (g config) TxCBORDecoder() sdk.TxDecoder {
+	return g.cborDecoder
+}
+
 func (g config) SigningContext() *txsigning.Context {
 	return g.signingContext
 }
@@ -55,6 +55,7 @@ type ModuleInputs struct {
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
 	CustomGetSigners       []txsigning.CustomGetSigner        `optional:"true"`
+	GasCosts               *runtime.GasCosts                  `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -148,13 +149,18 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 		return nil, errors.New("both AccountKeeper and BankKeeper are required")
 	}
 
+	sigGasConsumer := ante.DefaultSigVerificationGasConsumer
+	if in.GasCosts != nil && len(in.GasCosts.SigVerify) > 0 {
+		sigGasConsumer = ante.NewSigVerificationGasConsumer(in.GasCosts.SigVerify)
+	}
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
 			AccountKeeper:   in.AccountKeeper,
 			BankKeeper:      in.BankKeeper,
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			SigGasConsumer:  sigGasConsumer,
 			Environment:     in.Environment,
 		},
 	)
@@ -1,16 +1,21 @@
 package tx_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	coretransaction "cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/auth/tx"
 	txtestutil "cosmossdk.io/x/auth/tx/testutil"
 	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip191"
+	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -40,3 +45,26 @@ func TestConfigOptions(t *testing.T) {
 	handler := txConfig.SignModeHandler()
 	require.NotNil(t, handler)
 }
+
+func TestConfigOptionsCustomSignModes(t *testing.T) {
+	interfaceRegistry := testutil.CodecOptions{}.NewInterfaceRegistry()
+	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+
+	textualHandler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil },
+	})
+	require.NoError(t, err)
+	eip191Handler, err := eip191.NewSignModeHandler(eip191.SignModeHandlerOptions{Textual: textualHandler})
+	require.NoError(t, err)
+
+	configOptions := tx.ConfigOptions{
+		SigningOptions: &signing.Options{
+			AddressCodec:          interfaceRegistry.SigningContext().AddressCodec(),
+			ValidatorAddressCodec: interfaceRegistry.SigningContext().ValidatorAddressCodec(),
+		},
+		CustomSignModes: []signing.SignModeHandler{eip191Handler},
+	}
+	txConfig, err := tx.NewTxConfigWithOptions(protoCodec, configOptions)
+	require.NoError(t, err)
+	require.Contains(t, txConfig.SignModeHandler().SupportedModes(), signingv1beta1.SignMode_SIGN_MODE_EIP_191) //nolint:staticcheck // the sign mode is deprecated in the proto
+}
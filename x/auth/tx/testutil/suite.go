@@ -312,6 +312,32 @@ func (s *TxConfigTestSuite) TestTxEncodeDecode() {
 	s.Require().Equal([]cryptotypes.PubKey{pubkey}, pks)
 	msg2 := tx2.GetMsgs()[0].(*testdata.TestMsg)
 	s.Require().Equal(pi, msg2.DecField.String())
+
+	log("CBOR encode transaction")
+	cborTxBytes, err := s.TxConfig.TxCBOREncoder()(tx)
+	s.Require().NoError(err)
+	s.Require().NotNil(cborTxBytes)
+
+	log("CBOR decode transaction")
+	tx2, err = s.TxConfig.TxCBORDecoder()(cborTxBytes)
+	s.Require().NoError(err)
+	tx3, ok = tx2.(signing.Tx)
+	s.Require().True(ok)
+	s.Require().Equal([]sdk.Msg{msg}, tx3.GetMsgs())
+	s.Require().Equal(feeAmount, tx3.GetFee())
+	s.Require().Equal(gasLimit, tx3.GetGas())
+	s.Require().Equal(memo, tx3.GetMemo())
+	tx3Sigs, err = tx3.GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Equal([]signingtypes.SignatureV2{sig}, tx3Sigs)
+
+	// the CBOR envelope wraps the same protobuf encoded tx
+	protoTxBytes, err := s.TxConfig.TxEncoder()(tx2)
+	s.Require().NoError(err)
+	s.Require().Equal(txBytes, protoTxBytes)
+	cborTxBytes2, err := s.TxConfig.TxCBOREncoder()(tx2)
+	s.Require().NoError(err)
+	s.Require().Equal(cborTxBytes, cborTxBytes2)
 }
 
 func (s *TxConfigTestSuite) TestWrapTxBuilder() {
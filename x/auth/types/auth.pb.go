@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -172,6 +173,11 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// accepted_sign_modes defines the sign modes transactions can be signed with.
+	// An empty list accepts all the sign modes supported by the node.
+	//
+	// Since: cosmos-sdk 0.52
+	AcceptedSignModes []signing.SignMode `protobuf:"varint,6,rep,packed,name=accepted_sign_modes,json=acceptedSignModes,proto3,enum=cosmos.tx.signing.v1beta1.SignMode" json:"accepted_sign_modes,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -242,6 +248,13 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetAcceptedSignModes() []signing.SignMode {
+	if m != nil {
+		return m.AcceptedSignModes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -252,54 +265,57 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xf3, 0x46,
-	0x10, 0x8e, 0x93, 0x94, 0xbf, 0x6c, 0xf8, 0xa1, 0x98, 0x94, 0x9a, 0xa8, 0x8a, 0x4d, 0xa4, 0x8a,
-	0x08, 0x35, 0x0e, 0x09, 0xa5, 0x15, 0xb9, 0x91, 0xb4, 0xaa, 0x10, 0x85, 0x22, 0x47, 0xe5, 0xc0,
-	0xc5, 0x5a, 0xdb, 0x8b, 0x59, 0x25, 0xf6, 0xba, 0xde, 0x35, 0x8a, 0x79, 0x02, 0xd4, 0x53, 0xd5,
-	0x4b, 0xaf, 0xb4, 0x4f, 0xc0, 0x81, 0x87, 0xa8, 0x7a, 0x42, 0x5c, 0xda, 0x53, 0x54, 0x85, 0x03,
-	0xa8, 0xea, 0x43, 0x54, 0xde, 0x75, 0x48, 0x82, 0x72, 0x89, 0x3c, 0xdf, 0xf7, 0xcd, 0xcc, 0x37,
-	0x93, 0xb1, 0x41, 0xd9, 0x26, 0xd4, 0x23, 0xb4, 0x0e, 0x23, 0x76, 0x59, 0xbf, 0x6a, 0x58, 0x88,
-	0xc1, 0x06, 0x0f, 0xf4, 0x20, 0x24, 0x8c, 0xc8, 0x6b, 0x82, 0xd7, 0x39, 0x94, 0xf2, 0xa5, 0x55,
-	0xe8, 0x61, 0x9f, 0xd4, 0xf9, 0xaf, 0xd0, 0x95, 0x36, 0x84, 0xce, 0xe4, 0x51, 0x3d, 0x4d, 0x12,
-	0x54, 0xd1, 0x25, 0x2e, 0x11, 0x78, 0xf2, 0x34, 0x4e, 0x70, 0x09, 0x71, 0xfb, 0xa8, 0xce, 0x23,
-	0x2b, 0xba, 0xa8, 0x43, 0x3f, 0x16, 0x54, 0xe5, 0xb7, 0x2c, 0x28, 0xb4, 0x21, 0x45, 0x07, 0xb6,
-	0x4d, 0x22, 0x9f, 0xc9, 0x4d, 0xf0, 0x0e, 0x3a, 0x4e, 0x88, 0x28, 0x55, 0x24, 0x4d, 0xaa, 0x2e,
-	0xb6, 0x95, 0xc7, 0xfb, 0x5a, 0x31, 0xed, 0x71, 0x20, 0x98, 0x2e, 0x0b, 0xb1, 0xef, 0x1a, 0x63,
-	0xa1, 0x7c, 0x06, 0xde, 0x05, 0x91, 0x65, 0xf6, 0x50, 0xac, 0x64, 0x35, 0xa9, 0x5a, 0x68, 0x16,
-	0x75, 0xd1, 0x50, 0x1f, 0x37, 0xd4, 0x0f, 0xfc, 0xb8, 0xbd, 0xf5, 0xef, 0x50, 0x2d, 0x06, 0x91,
-	0xd5, 0xc7, 0x76, 0xa2, 0xfd, 0x9c, 0x78, 0x98, 0x21, 0x2f, 0x60, 0xf1, 0xef, 0xcf, 0x77, 0xdb,
-	0x60, 0x42, 0x18, 0x0b, 0x41, 0x64, 0x1d, 0xa1, 0x58, 0xfe, 0x0c, 0x2c, 0x43, 0x61, 0xcb, 0xf4,
-	0x23, 0xcf, 0x42, 0xa1, 0x92, 0xd3, 0xa4, 0x6a, 0xde, 0x78, 0x9f, 0xa2, 0x27, 0x1c, 0x94, 0x4b,
-	0xe0, 0x43, 0x8a, 0x7e, 0x8c, 0x90, 0x6f, 0x23, 0x25, 0xcf, 0x05, 0xaf, 0x71, 0xab, 0x73, 0x73,
-	0xab, 0x66, 0x5e, 0x6e, 0xd5, 0xcc, 0x9f, 0xf7, 0xb5, 0x4f, 0xe7, 0xac, 0x57, 0x4f, 0xe7, 0x3e,
-	0xfc, 0xe9, 0xf9, 0x6e, 0x7b, 0x5d, 0x08, 0x6a, 0xd4, 0xe9, 0xd5, 0xa7, 0x76, 0x52, 0xf9, 0x4f,
-	0x02, 0xef, 0x8f, 0x89, 0x13, 0xf5, 0x5f, 0xb7, 0x74, 0x08, 0x96, 0x2c, 0x48, 0x91, 0x99, 0x1a,
-	0xe1, 0xab, 0x2a, 0x34, 0x35, 0x7d, 0x5e, 0x87, 0xa9, 0x4a, 0xed, 0xfc, 0xc3, 0x50, 0x95, 0x8c,
-	0x82, 0x35, 0xb5, 0x70, 0x19, 0xe4, 0x7d, 0xe8, 0x21, 0xbe, 0xb9, 0x45, 0x83, 0x3f, 0xcb, 0x1a,
-	0x28, 0x04, 0x28, 0xf4, 0x30, 0xa5, 0x98, 0xf8, 0x54, 0xc9, 0x69, 0xb9, 0xea, 0xa2, 0x31, 0x0d,
-	0xb5, 0xce, 0x6f, 0xc4, 0x4c, 0x95, 0x79, 0x1d, 0x67, 0xbc, 0xf2, 0xc9, 0x94, 0xa9, 0xc9, 0x66,
-	0xd8, 0x5f, 0x9e, 0xef, 0xb6, 0x97, 0x3d, 0x8e, 0x8c, 0x87, 0xa9, 0xfc, 0x2a, 0x81, 0x8f, 0x84,
-	0xa8, 0x13, 0x22, 0x07, 0xf9, 0x0c, 0xc3, 0xbe, 0xac, 0x82, 0x42, 0x2a, 0xe3, 0x6e, 0xf9, 0x6d,
-	0x18, 0x40, 0x40, 0x27, 0x89, 0xe7, 0x2d, 0xb0, 0xe2, 0xa0, 0x10, 0x5f, 0x41, 0x86, 0x89, 0x9f,
-	0xfc, 0x8d, 0x54, 0xc9, 0x6a, 0xb9, 0xea, 0x92, 0xb1, 0x3c, 0x81, 0x8f, 0x50, 0x4c, 0x5b, 0xfb,
-	0x8f, 0xf7, 0xb5, 0x95, 0x89, 0x1f, 0x6d, 0x47, 0xff, 0xe2, 0xab, 0xc4, 0xe3, 0xe6, 0x94, 0xc7,
-	0x6f, 0x43, 0x12, 0x05, 0xa9, 0xc5, 0x89, 0x89, 0xca, 0x5f, 0x59, 0xb0, 0x70, 0x0a, 0x43, 0xe8,
-	0x51, 0x59, 0x07, 0x6b, 0x1e, 0x1c, 0x98, 0x1e, 0xf2, 0x88, 0x69, 0x5f, 0xc2, 0x10, 0xda, 0x0c,
-	0x85, 0xe2, 0x66, 0xf3, 0xc6, 0xaa, 0x07, 0x07, 0xc7, 0xc8, 0x23, 0x9d, 0x57, 0x42, 0xd6, 0xc0,
-	0x12, 0x1b, 0x98, 0x14, 0xbb, 0x66, 0x1f, 0x7b, 0x98, 0xf1, 0x75, 0xe7, 0x0d, 0xc0, 0x06, 0x5d,
-	0xec, 0x7e, 0x97, 0x20, 0xf2, 0x0e, 0xf8, 0x98, 0x2b, 0xae, 0x91, 0x69, 0x13, 0xca, 0xcc, 0x00,
-	0x85, 0xa6, 0x15, 0x33, 0x94, 0x1e, 0xdd, 0x6a, 0x22, 0xbd, 0x46, 0x1d, 0x42, 0xd9, 0x29, 0x0a,
-	0xdb, 0x31, 0x43, 0xf2, 0xf7, 0xe0, 0x93, 0xa4, 0xe0, 0x15, 0x0a, 0xf1, 0x45, 0x2c, 0x92, 0x90,
-	0xd3, 0xdc, 0xdb, 0x6b, 0xec, 0x8b, 0x3b, 0x6c, 0x2b, 0xa3, 0xa1, 0x5a, 0xec, 0x62, 0xf7, 0x8c,
-	0x2b, 0x92, 0xd4, 0x6f, 0xbe, 0xe6, 0xbc, 0x51, 0xa4, 0x33, 0xa8, 0xc8, 0x92, 0x7f, 0x00, 0x1b,
-	0x6f, 0x0b, 0x52, 0x64, 0x07, 0xcd, 0xbd, 0x2f, 0x7b, 0x0d, 0xe5, 0x03, 0x5e, 0xb2, 0x34, 0x1a,
-	0xaa, 0xeb, 0x33, 0x25, 0xbb, 0x63, 0x85, 0xb1, 0x4e, 0xe7, 0xe2, 0xad, 0xcd, 0x97, 0x5b, 0x55,
-	0x7a, 0x7b, 0x06, 0x03, 0xf1, 0x19, 0x12, 0xeb, 0x6c, 0xef, 0xfe, 0x31, 0x2a, 0x4b, 0x0f, 0xa3,
-	0xb2, 0xf4, 0xcf, 0xa8, 0x2c, 0xfd, 0xfc, 0x54, 0xce, 0x3c, 0x3c, 0x95, 0x33, 0x7f, 0x3f, 0x95,
-	0x33, 0xe7, 0xe9, 0xc7, 0x86, 0x3a, 0x3d, 0x1d, 0x93, 0x71, 0x16, 0x8b, 0x03, 0x44, 0xad, 0x05,
-	0xfe, 0x7a, 0xef, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x84, 0x7c, 0x64, 0xd8, 0x04, 0x00,
-	0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x67, 0x97, 0x94, 0xcc, 0xa6, 0x29, 0x71, 0x96, 0xe0, 0x46, 0x68, 0xed, 0x2e, 0x42,
+	0x59, 0x45, 0xc4, 0x6e, 0xb6, 0x04, 0xd4, 0xdc, 0xb2, 0x01, 0xa1, 0xaa, 0xa4, 0x54, 0x5e, 0xd1,
+	0x43, 0x2f, 0xd6, 0xd8, 0x7e, 0x75, 0x47, 0xd9, 0xf1, 0x18, 0xcf, 0x38, 0x5a, 0xf7, 0x2f, 0xa8,
+	0x38, 0x21, 0x2e, 0x5c, 0x03, 0x67, 0x0e, 0x39, 0xe4, 0x8f, 0x40, 0x9c, 0xa2, 0x9e, 0x38, 0x45,
+	0x68, 0x73, 0x48, 0x85, 0xf8, 0x23, 0x2a, 0xcf, 0xd8, 0xfb, 0xa3, 0xda, 0x8b, 0xe5, 0xf9, 0xbe,
+	0xef, 0xbd, 0xf7, 0xbd, 0x37, 0x4f, 0x83, 0xda, 0x01, 0xe3, 0x94, 0x71, 0x07, 0x67, 0xe2, 0xa5,
+	0x73, 0xba, 0xe7, 0x83, 0xc0, 0x7b, 0xf2, 0x60, 0x27, 0x29, 0x13, 0x4c, 0xdf, 0x50, 0xbc, 0x2d,
+	0xa1, 0x92, 0xdf, 0x5a, 0xc7, 0x94, 0xc4, 0xcc, 0x91, 0x5f, 0xa5, 0xdb, 0xba, 0xab, 0x74, 0x9e,
+	0x3c, 0x39, 0x65, 0x90, 0xa2, 0xb6, 0xcb, 0x12, 0x62, 0xe4, 0x70, 0x12, 0xc5, 0x24, 0x8e, 0x26,
+	0x85, 0xca, 0x73, 0x29, 0x6c, 0x45, 0x2c, 0x62, 0x2a, 0x41, 0xf1, 0x57, 0x65, 0x8e, 0x18, 0x8b,
+	0x86, 0xe0, 0xc8, 0x93, 0x9f, 0xbd, 0x70, 0x70, 0x9c, 0x2b, 0xaa, 0xf3, 0xfb, 0x12, 0x6a, 0xf6,
+	0x31, 0x87, 0xc3, 0x20, 0x60, 0x59, 0x2c, 0xf4, 0x1e, 0xba, 0x85, 0xc3, 0x30, 0x05, 0xce, 0x0d,
+	0xcd, 0xd2, 0xba, 0x2b, 0x7d, 0xe3, 0xcd, 0xc5, 0x6e, 0xab, 0x34, 0x73, 0xa8, 0x98, 0x81, 0x48,
+	0x49, 0x1c, 0xb9, 0x95, 0x50, 0x7f, 0x86, 0x6e, 0x25, 0x99, 0xef, 0x9d, 0x40, 0x6e, 0x2c, 0x59,
+	0x5a, 0xb7, 0xd9, 0x6b, 0xd9, 0xaa, 0xa0, 0x5d, 0x15, 0xb4, 0x0f, 0xe3, 0xbc, 0xbf, 0xfd, 0xdf,
+	0x95, 0xd9, 0x4a, 0x32, 0x7f, 0x48, 0x82, 0x42, 0xfb, 0x05, 0xa3, 0x44, 0x00, 0x4d, 0x44, 0xfe,
+	0xc7, 0xcd, 0xf9, 0x0e, 0x9a, 0x12, 0xee, 0x72, 0x92, 0xf9, 0x8f, 0x21, 0xd7, 0x3f, 0x47, 0x6b,
+	0x58, 0xd9, 0xf2, 0xe2, 0x8c, 0xfa, 0x90, 0x1a, 0x75, 0x4b, 0xeb, 0x36, 0xdc, 0xdb, 0x25, 0xfa,
+	0x44, 0x82, 0xfa, 0x16, 0xfa, 0x90, 0xc3, 0x4f, 0x19, 0xc4, 0x01, 0x18, 0x0d, 0x29, 0x98, 0x9c,
+	0x0f, 0x8e, 0x5e, 0x9f, 0x99, 0xb5, 0xb7, 0x67, 0x66, 0xed, 0xef, 0x8b, 0xdd, 0x4f, 0x17, 0xdc,
+	0x83, 0x5d, 0xf6, 0xfd, 0xe8, 0xe7, 0x9b, 0xf3, 0x9d, 0x4d, 0x25, 0xd8, 0xe5, 0xe1, 0x89, 0x33,
+	0x33, 0x93, 0xce, 0xff, 0x1a, 0xba, 0x7d, 0xcc, 0xc2, 0x6c, 0x38, 0x99, 0xd2, 0x23, 0xb4, 0xea,
+	0x63, 0x0e, 0x5e, 0x69, 0x44, 0x8e, 0xaa, 0xd9, 0xb3, 0xec, 0x45, 0x15, 0x66, 0x32, 0xf5, 0x1b,
+	0x97, 0x57, 0xa6, 0xe6, 0x36, 0xfd, 0x99, 0x81, 0xeb, 0xa8, 0x11, 0x63, 0x0a, 0x72, 0x72, 0x2b,
+	0xae, 0xfc, 0xd7, 0x2d, 0xd4, 0x4c, 0x20, 0xa5, 0x84, 0x73, 0xc2, 0x62, 0x6e, 0xd4, 0xad, 0x7a,
+	0x77, 0xc5, 0x9d, 0x85, 0x0e, 0x9e, 0xbf, 0x56, 0x3d, 0x75, 0x16, 0x55, 0x9c, 0xf3, 0x2a, 0x3b,
+	0x33, 0x66, 0x3a, 0x9b, 0x63, 0x7f, 0xbd, 0x39, 0xdf, 0x59, 0xa3, 0x12, 0xa9, 0x9a, 0xe9, 0xfc,
+	0xa6, 0xa1, 0x8f, 0x94, 0xe8, 0x28, 0x85, 0x10, 0x62, 0x41, 0xf0, 0x50, 0x37, 0x51, 0xb3, 0x94,
+	0x49, 0xb7, 0x72, 0x37, 0x5c, 0xa4, 0xa0, 0x27, 0x85, 0xe7, 0x6d, 0x74, 0x27, 0x84, 0x94, 0x9c,
+	0x62, 0x41, 0x58, 0x5c, 0x5c, 0x23, 0x37, 0x96, 0xac, 0x7a, 0x77, 0xd5, 0x5d, 0x9b, 0xc2, 0x8f,
+	0x21, 0xe7, 0x07, 0x0f, 0xdf, 0x5c, 0xec, 0xde, 0x99, 0xfa, 0xb1, 0xee, 0xdb, 0x5f, 0x7e, 0x5d,
+	0x78, 0xbc, 0x37, 0xe3, 0xf1, 0xbb, 0x94, 0x65, 0x49, 0x69, 0x71, 0x6a, 0xa2, 0xf3, 0x67, 0x1d,
+	0x2d, 0x3f, 0xc5, 0x29, 0xa6, 0x5c, 0xb7, 0xd1, 0x06, 0xc5, 0x23, 0x8f, 0x02, 0x65, 0x5e, 0xf0,
+	0x12, 0xa7, 0x38, 0x10, 0x90, 0xaa, 0x9d, 0x6d, 0xb8, 0xeb, 0x14, 0x8f, 0x8e, 0x81, 0xb2, 0xa3,
+	0x09, 0xa1, 0x5b, 0x68, 0x55, 0x8c, 0x3c, 0x4e, 0x22, 0x6f, 0x48, 0x28, 0x11, 0x72, 0xdc, 0x0d,
+	0x17, 0x89, 0xd1, 0x80, 0x44, 0xdf, 0x17, 0x88, 0x7e, 0x1f, 0x7d, 0x2c, 0x15, 0xaf, 0xc0, 0x0b,
+	0x18, 0x17, 0x5e, 0x02, 0xa9, 0xe7, 0xe7, 0x02, 0xca, 0xa5, 0x5b, 0x2f, 0xa4, 0xaf, 0xe0, 0x88,
+	0x71, 0xf1, 0x14, 0xd2, 0x7e, 0x2e, 0x40, 0xff, 0x01, 0x7d, 0x52, 0x24, 0x3c, 0x85, 0x94, 0xbc,
+	0xc8, 0x55, 0x10, 0x84, 0xbd, 0xfd, 0xfd, 0xbd, 0x87, 0x6a, 0x0f, 0xfb, 0xc6, 0xf8, 0xca, 0x6c,
+	0x0d, 0x48, 0xf4, 0x4c, 0x2a, 0x8a, 0xd0, 0x6f, 0xbf, 0x91, 0xbc, 0xdb, 0xe2, 0x73, 0xa8, 0x8a,
+	0xd2, 0x7f, 0x44, 0x77, 0xdf, 0x4f, 0xc8, 0x21, 0x48, 0x7a, 0xfb, 0x5f, 0x9d, 0xec, 0x19, 0x1f,
+	0xc8, 0x94, 0x5b, 0xe3, 0x2b, 0x73, 0x73, 0x2e, 0xe5, 0xa0, 0x52, 0xb8, 0x9b, 0x7c, 0x21, 0xae,
+	0x0f, 0xd0, 0x06, 0x0e, 0x02, 0x48, 0x04, 0x84, 0xc5, 0x04, 0x62, 0x8f, 0xb2, 0x10, 0xb8, 0xb1,
+	0x6c, 0xd5, 0xbb, 0x6b, 0xbd, 0xcf, 0xaa, 0xa5, 0x15, 0x23, 0xbb, 0x7a, 0x4b, 0xaa, 0x45, 0x1a,
+	0x90, 0x28, 0x3e, 0x66, 0x21, 0xb8, 0xeb, 0x55, 0x7c, 0x85, 0xf0, 0x83, 0x7b, 0x6f, 0xcf, 0x4c,
+	0xed, 0xfd, 0xdd, 0x1a, 0xa9, 0x47, 0x50, 0xdd, 0x51, 0xff, 0xc1, 0x5f, 0xe3, 0xb6, 0x76, 0x39,
+	0x6e, 0x6b, 0xff, 0x8e, 0xdb, 0xda, 0x2f, 0xd7, 0xed, 0xda, 0xe5, 0x75, 0xbb, 0xf6, 0xcf, 0x75,
+	0xbb, 0xf6, 0xbc, 0x7c, 0xea, 0x78, 0x78, 0x62, 0x13, 0x56, 0x45, 0x89, 0x3c, 0x01, 0xee, 0x2f,
+	0xcb, 0x37, 0xe3, 0xc1, 0xbb, 0x01, 0x00, 0x60, 0x4a, 0xc7, 0x72, 0x56, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +352,14 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if len(this.AcceptedSignModes) != len(that1.AcceptedSignModes) {
+		return false
+	}
+	for i := range this.AcceptedSignModes {
+		if this.AcceptedSignModes[i] != that1.AcceptedSignModes[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +524,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AcceptedSignModes) > 0 {
+		dAtA4 := make([]byte, len(m.AcceptedSignModes)*10)
+		var j3 int
+		for _, num := range m.AcceptedSignModes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAuth(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -625,6 +667,13 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if len(m.AcceptedSignModes) > 0 {
+		l = 0
+		for _, e := range m.AcceptedSignModes {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1178,6 +1227,75 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType == 0 {
+				var v signing.SignMode
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= signing.SignMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptedSignModes = append(m.AcceptedSignModes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AcceptedSignModes) == 0 {
+					m.AcceptedSignModes = make([]signing.SignMode, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v signing.SignMode
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= signing.SignMode(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptedSignModes = append(m.AcceptedSignModes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedSignModes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
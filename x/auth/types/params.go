@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 // Default parameter values
@@ -112,6 +114,43 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateAcceptedSignModes(i interface{}) error {
+	v, ok := i.([]signing.SignMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[signing.SignMode]struct{}, len(v))
+	for _, mode := range v {
+		if _, ok := signing.SignMode_name[int32(mode)]; !ok || mode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+			return fmt.Errorf("invalid accepted sign mode: %s", mode)
+		}
+
+		if _, ok := seen[mode]; ok {
+			return fmt.Errorf("duplicate accepted sign mode: %s", mode)
+		}
+		seen[mode] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsSignModeAccepted returns true if transactions can be signed with the given
+// sign mode. All sign modes are accepted when AcceptedSignModes is empty.
+func (p Params) IsSignModeAccepted(mode signing.SignMode) bool {
+	if len(p.AcceptedSignModes) == 0 {
+		return true
+	}
+
+	for _, accepted := range p.AcceptedSignModes {
+		if accepted == mode {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +168,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateAcceptedSignModes(p.AcceptedSignModes); err != nil {
+		return err
+	}
 
 	return nil
 }
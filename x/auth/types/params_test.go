@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -18,6 +20,21 @@ func TestParamsEqual(t *testing.T) {
 	require.NotEqual(t, p1, p2)
 }
 
+func withAcceptedSignModes(modes ...signing.SignMode) types.Params {
+	params := types.DefaultParams()
+	params.AcceptedSignModes = modes
+	return params
+}
+
+func TestParams_IsSignModeAccepted(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsSignModeAccepted(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+
+	params = withAcceptedSignModes(signing.SignMode_SIGN_MODE_DIRECT)
+	require.True(t, params.IsSignModeAccepted(signing.SignMode_SIGN_MODE_DIRECT))
+	require.False(t, params.IsSignModeAccepted(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+}
+
 func TestParams_Validate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -35,6 +52,10 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid tx size cost per byte: 0")},
+		{"valid accepted sign modes", withAcceptedSignModes(signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_TEXTUAL), nil},
+		{"unspecified accepted sign mode", withAcceptedSignModes(signing.SignMode_SIGN_MODE_UNSPECIFIED), errors.New("invalid accepted sign mode: SIGN_MODE_UNSPECIFIED")},
+		{"unknown accepted sign mode", withAcceptedSignModes(signing.SignMode(42)), errors.New("invalid accepted sign mode: 42")},
+		{"duplicate accepted sign mode", withAcceptedSignModes(signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_DIRECT), errors.New("duplicate accepted sign mode: SIGN_MODE_DIRECT")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -70,6 +70,56 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// ConstrainedAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, as long as the fields of the
+// executed message satisfy all the constraints.
+type ConstrainedAuthorization struct {
+	// Msg, identified by its type URL, to grant constrained permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// constraints on the fields of the Msg, each of the form `<field path> <operator> <value>`:
+	// - `<field path> <= <coins>` limits the sum of the Coin fields at the path over all the
+	//   executions, e.g. `amount <= 100stake`. The coins of each execution are deducted from the
+	//   limit, and the authorization is deleted once the limit is exhausted.
+	// - `<field path> in [<value>, ...]` restricts the scalar fields at the path to the listed
+	//   values, e.g. `to_address in [cosmos1..., cosmos1...]` or `amount.denom in [stake]`.
+	// The field path is made of the proto field names separated by dots, a repeated field
+	// constraining all of its elements.
+	Constraints []string `protobuf:"bytes,2,rep,name=constraints,proto3" json:"constraints,omitempty"`
+}
+
+func (m *ConstrainedAuthorization) Reset()         { *m = ConstrainedAuthorization{} }
+func (m *ConstrainedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ConstrainedAuthorization) ProtoMessage()    {}
+func (*ConstrainedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *ConstrainedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstrainedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstrainedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConstrainedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstrainedAuthorization.Merge(m, src)
+}
+func (m *ConstrainedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConstrainedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstrainedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstrainedAuthorization proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +134,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{2}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +176,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +215,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +246,7 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*ConstrainedAuthorization)(nil), "cosmos.authz.v1beta1.ConstrainedAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +255,37 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
+	// 476 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	0x18, 0xc6, 0xeb, 0x76, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x7a, 0x08, 0x3d, 0xa4, 0x55, 0x90, 0xd0,
+	0x84, 0xd4, 0x44, 0x2b, 0x9c, 0x76, 0xa2, 0x05, 0x69, 0x82, 0x03, 0x12, 0x61, 0x5c, 0xb8, 0x54,
+	0x6e, 0xf3, 0xe2, 0x59, 0xab, 0xed, 0xc8, 0x76, 0xd0, 0xba, 0x8f, 0xc0, 0x69, 0x9f, 0x80, 0x03,
+	0x9f, 0x00, 0xa4, 0x7d, 0x88, 0x8a, 0xd3, 0xc4, 0x89, 0x13, 0x7f, 0xda, 0x03, 0x5f, 0x03, 0xd5,
+	0x6e, 0x44, 0x43, 0x87, 0xd6, 0xc3, 0x2e, 0x91, 0xed, 0xf7, 0x79, 0xde, 0xf7, 0xc9, 0x2f, 0x0e,
+	0x6e, 0x0d, 0xa5, 0xe6, 0x52, 0xc7, 0x24, 0x37, 0x87, 0x27, 0xf1, 0xbb, 0xdd, 0x01, 0x18, 0xb2,
+	0xeb, 0x76, 0x51, 0xa6, 0xa4, 0x91, 0x5e, 0xdd, 0x29, 0x22, 0x77, 0xb6, 0x50, 0x34, 0xee, 0x10,
+	0xce, 0x84, 0x8c, 0xed, 0xd3, 0x09, 0x1b, 0x77, 0x9d, 0xb0, 0x6f, 0x77, 0xf1, 0xc2, 0xe5, 0x4a,
+	0x4d, 0x2a, 0x25, 0x1d, 0x41, 0x6c, 0x77, 0x83, 0xfc, 0x6d, 0x6c, 0x18, 0x07, 0x6d, 0x08, 0xcf,
+	0x16, 0x82, 0x3a, 0x95, 0x54, 0x3a, 0xe3, 0x7c, 0x55, 0x74, 0xfc, 0xd7, 0x46, 0xc4, 0xd8, 0x95,
+	0x42, 0x83, 0xeb, 0xfb, 0x20, 0x40, 0xb1, 0x61, 0x37, 0x37, 0x87, 0x52, 0xb1, 0x13, 0x62, 0x98,
+	0x14, 0xde, 0x6d, 0x5c, 0xe3, 0x9a, 0xfa, 0xa8, 0x85, 0x76, 0x36, 0x93, 0xf9, 0x72, 0xef, 0xf9,
+	0x97, 0xb3, 0x76, 0x78, 0xd1, 0x3b, 0x44, 0x25, 0xe7, 0xfb, 0xdf, 0x9f, 0x1e, 0x34, 0x9d, 0xac,
+	0xad, 0xd3, 0xa3, 0xf8, 0xa2, 0xee, 0xe1, 0x07, 0x84, 0xfd, 0x27, 0x52, 0x68, 0xa3, 0x08, 0x13,
+	0x90, 0x5e, 0x32, 0xda, 0x6b, 0xe1, 0xad, 0x61, 0xa1, 0x36, 0xda, 0xaf, 0xb6, 0x6a, 0x3b, 0x9b,
+	0xc9, 0xf2, 0xd1, 0xde, 0x8b, 0xf5, 0xc3, 0xdd, 0x5b, 0x0a, 0xf7, 0xbf, 0x0c, 0xe1, 0x67, 0x84,
+	0xaf, 0xed, 0x2b, 0x22, 0x8c, 0x37, 0xc0, 0xdb, 0x64, 0xb9, 0x64, 0x73, 0x6d, 0x75, 0xea, 0x91,
+	0x63, 0x1a, 0x15, 0x4c, 0xa3, 0xae, 0x18, 0xf7, 0xee, 0xaf, 0x17, 0x23, 0x29, 0xb7, 0xf4, 0x9e,
+	0x62, 0x0c, 0xc7, 0x19, 0x53, 0x6e, 0x40, 0xd5, 0x0e, 0x68, 0xac, 0x0c, 0x38, 0x28, 0xbe, 0x75,
+	0xef, 0xe6, 0xe4, 0x7b, 0x13, 0x9d, 0xfe, 0x68, 0xa2, 0x64, 0xc9, 0x17, 0x7e, 0xac, 0x62, 0xcf,
+	0x66, 0x2e, 0xe3, 0xec, 0xe0, 0x1b, 0x74, 0x7e, 0x0a, 0xca, 0x21, 0xed, 0xf9, 0x5f, 0xcf, 0xda,
+	0xc5, 0x65, 0xec, 0xa6, 0xa9, 0x02, 0xad, 0x5f, 0x19, 0xc5, 0x04, 0x4d, 0x0a, 0xe1, 0x5f, 0x0f,
+	0xf8, 0xd5, 0xf5, 0x3c, 0xb0, 0x0a, 0xaa, 0x76, 0xf5, 0xa0, 0x1e, 0x97, 0x40, 0x6d, 0x5c, 0x0a,
+	0x6a, 0x63, 0x05, 0xd2, 0x23, 0x7c, 0xcb, 0x32, 0x7a, 0x99, 0x43, 0x0e, 0xcf, 0x0c, 0x70, 0x2f,
+	0xc4, 0xdb, 0x5c, 0xd3, 0xbe, 0x19, 0x67, 0xd0, 0xcf, 0xd5, 0x48, 0xfb, 0xc8, 0x5d, 0x2f, 0xae,
+	0xe9, 0xc1, 0x38, 0x83, 0xd7, 0x6a, 0xa4, 0x7b, 0x9d, 0xc9, 0xaf, 0xa0, 0x32, 0x99, 0x06, 0xe8,
+	0x7c, 0x1a, 0xa0, 0x9f, 0xd3, 0x00, 0x9d, 0xce, 0x82, 0xca, 0xf9, 0x2c, 0xa8, 0x7c, 0x9b, 0x05,
+	0x95, 0x37, 0x0b, 0x30, 0x3a, 0x3d, 0x8a, 0x98, 0x8c, 0x8f, 0xdd, 0x5f, 0x3f, 0xb8, 0x6e, 0xf3,
+	0x3c, 0xfc, 0x33, 0x00, 0x47, 0x77, 0x3e, 0x36, 0x1a, 0x04, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +318,45 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConstrainedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstrainedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstrainedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for iNdEx := len(m.Constraints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Constraints[iNdEx])
+			copy(dAtA[i:], m.Constraints[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Constraints[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -425,6 +517,25 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *ConstrainedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Constraints) > 0 {
+		for _, s := range m.Constraints {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +681,120 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConstrainedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConstrainedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConstrainedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,7 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagConstraint        = "constraint"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -95,12 +96,13 @@ func NewCmdExecAuthorization() *cobra.Command {
 // Migrating this command to AutoCLI is possible but would be CLI breaking.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant [grantee] <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from [granter]",
+		Use:   "grant [grantee] <authorization_type=\"send\"|\"generic\"|\"constrained\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from [granter]",
 		Short: "Grant authorization to an address",
 		Long: fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 Examples:
  $ %[1]s tx authz grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. constrained --msg-type=/cosmos.bank.v1beta1.MsgSend --constraint="amount <= 100stake" --constraint="to_address in [cosmos1ab..]" --from=cosmos1sk..
 	`, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -168,6 +170,21 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "constrained":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				constraints, err := cmd.Flags().GetStringArray(FlagConstraint)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewConstrainedAuthorization(msgType, constraints...)
+				if err := authorization.ValidateBasic(); err != nil {
+					return err
+				}
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -247,7 +264,8 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or ConstrainedAuthorization")
+	cmd.Flags().StringArray(FlagConstraint, []string{}, "Constraint on the Msg fields for ConstrainedAuthorization, e.g. \"amount <= 100stake\" (can be repeated)")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
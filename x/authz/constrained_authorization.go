@@ -0,0 +1,307 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Constraint operators
+const (
+	// OpMaxCoins limits the sum of the Coin fields at a path.
+	OpMaxCoins = "<="
+	// OpIn restricts the scalar fields at a path to a list of values.
+	OpIn = "in"
+)
+
+// coinName is the full name of the Coin message, the only message type
+// supported by OpMaxCoins.
+const coinName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
+// NewConstrainedAuthorization creates a new ConstrainedAuthorization object.
+func NewConstrainedAuthorization(msgTypeURL string, constraints ...string) *ConstrainedAuthorization {
+	return &ConstrainedAuthorization{
+		Msg:         msgTypeURL,
+		Constraints: constraints,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ConstrainedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The msg fields are evaluated with
+// proto reflection, so any Msg can be constrained. The coins accepted by the
+// OpMaxCoins constraints are deducted from their limits, and the authorization
+// is deleted once one of them is exhausted.
+func (a ConstrainedAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	authzEnv, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("environment not set")
+	}
+
+	msgV2, err := toDynamicMsg(msg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	constraints := make([]string, len(a.Constraints))
+	spendLimited, exhausted := false, false
+	for i, s := range a.Constraints {
+		c, err := parseConstraint(s)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		fd, values, err := c.fieldValues(msgV2)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrapf("constraint %q: %s", s, err)
+		}
+
+		if err := authzEnv.GasService.GasMeter(ctx).Consume(authz.GasCostPerIteration*uint64(len(values)+1), "constrained authorization"); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		spent, err := c.check(fd, values)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("constraint %q: %s", s, err)
+		}
+
+		constraints[i] = s
+		if c.op == OpMaxCoins {
+			limitLeft := c.maxCoins.Sub(spent...)
+			spendLimited = true
+			exhausted = exhausted || limitLeft.IsZero()
+			constraints[i] = fmt.Sprintf("%s %s %s", c.fieldPath(), OpMaxCoins, limitLeft)
+		}
+	}
+
+	switch {
+	case exhausted:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	case spendLimited:
+		return authz.AcceptResponse{Accept: true, Updated: NewConstrainedAuthorization(a.Msg, constraints...)}, nil
+	default:
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ConstrainedAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+	if len(a.Constraints) == 0 {
+		return errors.New("constraints cannot be empty, use a GenericAuthorization instead")
+	}
+
+	for _, s := range a.Constraints {
+		if _, err := parseConstraint(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// constraint is a parsed constraint of a ConstrainedAuthorization.
+type constraint struct {
+	path []protoreflect.Name
+	op   string
+	// maxCoins is the value of an OpMaxCoins constraint.
+	maxCoins sdk.Coins
+	// values is the value of an OpIn constraint.
+	values []string
+}
+
+// parseConstraint parses a constraint of the form `<field path> <operator> <value>`.
+func parseConstraint(s string) (constraint, error) {
+	path, rest, _ := strings.Cut(strings.TrimSpace(s), " ")
+	op, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	value = strings.TrimSpace(value)
+	if path == "" || op == "" || value == "" {
+		return constraint{}, fmt.Errorf("invalid constraint %q, expected <field path> <operator> <value>", s)
+	}
+
+	c := constraint{op: op}
+	for _, name := range strings.Split(path, ".") {
+		if !protoreflect.Name(name).IsValid() {
+			return constraint{}, fmt.Errorf("invalid field path %q in constraint %q", path, s)
+		}
+		c.path = append(c.path, protoreflect.Name(name))
+	}
+
+	switch op {
+	case OpMaxCoins:
+		coins, err := sdk.ParseCoinsNormalized(value)
+		if err != nil {
+			return constraint{}, fmt.Errorf("invalid coins in constraint %q: %w", s, err)
+		}
+		if coins.Empty() {
+			return constraint{}, fmt.Errorf("empty coins in constraint %q", s)
+		}
+		c.maxCoins = coins
+
+	case OpIn:
+		if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+			return constraint{}, fmt.Errorf("invalid list in constraint %q, expected [<value>, ...]", s)
+		}
+		for _, v := range strings.Split(value[1:len(value)-1], ",") {
+			v = strings.TrimSpace(v)
+			if v == "" {
+				return constraint{}, fmt.Errorf("empty value in constraint %q", s)
+			}
+			c.values = append(c.values, v)
+		}
+
+	default:
+		return constraint{}, fmt.Errorf("unknown operator %q in constraint %q, expected %q or %q", op, s, OpMaxCoins, OpIn)
+	}
+
+	return c, nil
+}
+
+// fieldPath returns the field path of the constraint.
+func (c constraint) fieldPath() string {
+	names := make([]string, len(c.path))
+	for i, name := range c.path {
+		names[i] = string(name)
+	}
+
+	return strings.Join(names, ".")
+}
+
+// fieldValues returns the descriptor of the field at the path of the
+// constraint and all its values in msg, one per element of the repeated fields
+// along the path.
+func (c constraint) fieldValues(msg protoreflect.Message) (protoreflect.FieldDescriptor, []protoreflect.Value, error) {
+	desc := msg.Descriptor()
+	var fd protoreflect.FieldDescriptor
+	for i, name := range c.path {
+		if desc == nil {
+			return nil, nil, fmt.Errorf("field %s is not a message", c.path[i-1])
+		}
+		fd = desc.Fields().ByName(name)
+		if fd == nil {
+			return nil, nil, fmt.Errorf("field %s not found in %s", name, desc.FullName())
+		}
+		if fd.IsMap() {
+			return nil, nil, fmt.Errorf("map field %s is not supported", name)
+		}
+		desc = fd.Message()
+	}
+
+	values := []protoreflect.Value{protoreflect.ValueOfMessage(msg)}
+	for _, name := range c.path {
+		var next []protoreflect.Value
+		for _, v := range values {
+			m := v.Message()
+			field := m.Descriptor().Fields().ByName(name)
+			value := m.Get(field)
+			if !field.IsList() {
+				next = append(next, value)
+				continue
+			}
+			for j := 0; j < value.List().Len(); j++ {
+				next = append(next, value.List().Get(j))
+			}
+		}
+		values = next
+	}
+
+	return fd, values, nil
+}
+
+// check checks that the values of the field fd satisfy the constraint. It
+// returns the sum of the coins of an OpMaxCoins constraint.
+func (c constraint) check(fd protoreflect.FieldDescriptor, values []protoreflect.Value) (sdk.Coins, error) {
+	switch c.op {
+	case OpMaxCoins:
+		if fd.Message() == nil || fd.Message().FullName() != coinName {
+			return nil, fmt.Errorf("field %s is not a %s", fd.Name(), coinName)
+		}
+
+		total := sdk.NewCoins()
+		for _, v := range values {
+			m := v.Message()
+			amount, ok := math.NewIntFromString(m.Get(m.Descriptor().Fields().ByName("amount")).String())
+			if !ok {
+				return nil, fmt.Errorf("invalid amount in field %s", fd.Name())
+			}
+			// the message is not validated yet, so its coins are validated here instead of panicking
+			coin := sdk.Coin{Denom: m.Get(m.Descriptor().Fields().ByName("denom")).String(), Amount: amount}
+			if err := coin.Validate(); err != nil {
+				return nil, sdkerrors.ErrInvalidCoins.Wrapf("field %s: %s", fd.Name(), err)
+			}
+			total = total.Add(coin)
+		}
+		if !total.IsAllLTE(c.maxCoins) {
+			return nil, fmt.Errorf("%s exceeds %s", total, c.maxCoins)
+		}
+
+		return total, nil
+
+	case OpIn:
+		if fd.Message() != nil {
+			return nil, fmt.Errorf("field %s is not a scalar", fd.Name())
+		}
+
+		for _, v := range values {
+			s := v.String()
+			if fd.Enum() != nil {
+				if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+					s = string(ev.Name())
+				}
+			}
+			if !slices.Contains(c.values, s) {
+				return nil, fmt.Errorf("%s is not allowed", s)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// toDynamicMsg converts msg to a dynamic message that can be evaluated with
+// proto reflection.
+func toDynamicMsg(msg sdk.Msg) (protoreflect.Message, error) {
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(proto.MessageName(msg)))
+	if err != nil {
+		return nil, err
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	msgV2 := dynamicpb.NewMessage(msgDesc)
+	if err := protov2.Unmarshal(bz, msgV2); err != nil {
+		return nil, err
+	}
+
+	return msgV2, nil
+}
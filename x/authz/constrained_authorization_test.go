@@ -0,0 +1,156 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	coregas "cosmossdk.io/core/gas"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockGasService struct {
+	coregas.Service
+}
+
+func (m mockGasService) GasMeter(ctx context.Context) coregas.Meter {
+	return mockGasMeter{}
+}
+
+type mockGasMeter struct {
+	coregas.Meter
+}
+
+func (m mockGasMeter) Consume(amount coregas.Gas, descriptor string) error {
+	return nil
+}
+
+func TestConstrainedAuthorizationValidateBasic(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name        string
+		msgTypeURL  string
+		constraints []string
+		errMsg      string
+	}{
+		{"valid", sendURL, []string{"amount <= 100stake", "to_address in [a, b]"}, ""},
+		{"empty msg type", "", []string{"amount <= 100stake"}, "msg type cannot be empty"},
+		{"no constraints", sendURL, nil, "constraints cannot be empty"},
+		{"missing value", sendURL, []string{"amount <="}, "expected <field path> <operator> <value>"},
+		{"invalid field path", sendURL, []string{"amount..denom in [stake]"}, "invalid field path"},
+		{"invalid coins", sendURL, []string{"amount <= stake"}, "invalid coins"},
+		{"invalid list", sendURL, []string{"to_address in a, b"}, "invalid list"},
+		{"empty list value", sendURL, []string{"to_address in [a, ]"}, "empty value"},
+		{"unknown operator", sendURL, []string{"amount >= 100stake"}, "unknown operator"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := authz.NewConstrainedAuthorization(tc.msgTypeURL, tc.constraints...).ValidateBasic()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestConstrainedAuthorizationAccept(t *testing.T) {
+	ctx := context.WithValue(context.Background(), corecontext.EnvironmentContextKey, appmodule.Environment{
+		GasService: mockGasService{},
+	})
+
+	send := func(to string, amount sdk.Coins) sdk.Msg {
+		return banktypes.NewMsgSend("from", to, amount)
+	}
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{{Address: "from", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))}},
+		Outputs: []banktypes.Output{
+			{Address: "alice", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+			{Address: "bob", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+		},
+	}
+	delegate := &stakingtypes.MsgDelegate{DelegatorAddress: "from", ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 10)}
+
+	testCases := []struct {
+		name        string
+		msg         sdk.Msg
+		constraints []string
+		errMsg      string
+		// expConstraints are the constraints of the updated authorization, nil if not updated
+		expConstraints []string
+		expDelete      bool
+	}{
+		{name: "max amount", msg: send("alice", sdk.NewCoins(sdk.NewInt64Coin("stake", 60))), constraints: []string{"amount <= 100stake"}, expConstraints: []string{"amount <= 40stake"}},
+		{name: "max amount spent", msg: send("alice", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))), constraints: []string{"amount <= 100stake"}, expDelete: true},
+		{name: "max amount of several denoms", msg: send("alice", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))), constraints: []string{"amount <= 10atom,100stake"}, expConstraints: []string{"amount <= 10atom"}},
+		{name: "max amount exceeded", msg: send("alice", sdk.NewCoins(sdk.NewInt64Coin("stake", 101))), constraints: []string{"amount <= 100stake"}, errMsg: "101stake exceeds 100stake"},
+		{name: "max amount other denom", msg: send("alice", sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), constraints: []string{"amount <= 100stake"}, errMsg: "1atom exceeds 100stake"},
+		{name: "negative amount", msg: send("alice", sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}), constraints: []string{"amount <= 100stake"}, errMsg: "invalid coins"},
+		{name: "invalid denom", msg: send("alice", sdk.Coins{{Denom: "1", Amount: math.NewInt(1)}}), constraints: []string{"amount <= 100stake"}, errMsg: "invalid coins"},
+		{name: "allowed recipient", msg: send("alice", nil), constraints: []string{"to_address in [alice, bob]"}},
+		{name: "not allowed recipient", msg: send("eve", nil), constraints: []string{"to_address in [alice, bob]"}, errMsg: "eve is not allowed"},
+		{name: "allowed denoms", msg: send("alice", sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 1))), constraints: []string{"amount.denom in [atom, stake]"}},
+		{name: "not allowed denom", msg: send("alice", sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), constraints: []string{"amount.denom in [stake]"}, errMsg: "atom is not allowed"},
+		{
+			name:        "all constraints",
+			msg:         send("alice", sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			constraints: []string{"amount <= 100stake", "to_address in [bob]"},
+			errMsg:      "alice is not allowed",
+		},
+		{
+			name:           "other constraints kept",
+			msg:            send("bob", sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			constraints:    []string{"to_address in [bob]", "amount  <=  100stake"},
+			expConstraints: []string{"to_address in [bob]", "amount <= 90stake"},
+		},
+		{name: "repeated fields summed", msg: multiSend, constraints: []string{"outputs.coins <= 70stake"}, expConstraints: []string{"outputs.coins <= 10stake"}},
+		{name: "repeated fields sum exceeded", msg: multiSend, constraints: []string{"outputs.coins <= 50stake"}, errMsg: "60stake exceeds 50stake"},
+		{name: "repeated fields all checked", msg: multiSend, constraints: []string{"outputs.address in [alice]"}, errMsg: "bob is not allowed"},
+		{name: "single coin", msg: delegate, constraints: []string{"amount <= 15stake", "validator_address in [val]"}, expConstraints: []string{"amount <= 5stake", "validator_address in [val]"}},
+		{name: "unknown field", msg: send("alice", nil), constraints: []string{"recipient in [alice]"}, errMsg: "field recipient not found"},
+		{name: "not a coin", msg: send("alice", nil), constraints: []string{"to_address <= 10stake"}, errMsg: "is not a cosmos.base.v1beta1.Coin"},
+		{name: "not a scalar", msg: send("alice", nil), constraints: []string{"amount in [stake]"}, errMsg: "is not a scalar"},
+		{name: "not a message", msg: send("alice", nil), constraints: []string{"to_address.denom in [stake]"}, errMsg: "is not a message"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := authz.NewConstrainedAuthorization(sdk.MsgTypeURL(tc.msg), tc.constraints...)
+			require.NoError(t, a.ValidateBasic())
+
+			resp, err := a.Accept(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.expDelete, resp.Delete)
+			if tc.expConstraints == nil {
+				require.Nil(t, resp.Updated)
+				return
+			}
+
+			updated, ok := resp.Updated.(*authz.ConstrainedAuthorization)
+			require.True(t, ok)
+			require.Equal(t, a.Msg, updated.Msg)
+			require.Equal(t, tc.expConstraints, updated.Constraints)
+			require.NoError(t, updated.ValidateBasic())
+		})
+	}
+
+	_, err := authz.NewConstrainedAuthorization(sdk.MsgTypeURL(delegate), "amount <= 10stake").Accept(ctx, multiSend)
+	require.ErrorContains(t, err, "type mismatch")
+}
@@ -20,6 +20,10 @@ func (k MockBankKeeper) MultiSend(ctx context.Context, msg *bank.MsgMultiSend) (
 	return nil, nil
 }
 
+func (k MockBankKeeper) BatchSend(ctx context.Context, msg *bank.MsgBatchSend) (*bank.MsgBatchSendResponse, error) {
+	return nil, nil
+}
+
 func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdateParams) (*bank.MsgUpdateParamsResponse, error) {
 	return nil, nil
 }
@@ -70,6 +70,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "denoms-metadata",
 					Short:     "Query the client metadata for all registered coin denominations",
 				},
+				{
+					RpcMethod:      "DenomsMetadataBySymbol",
+					Use:            "denoms-metadata-by-symbol [symbol]",
+					Short:          "Query the client metadata of all the coin denominations registered with a given display symbol",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "symbol"}},
+				},
+				{
+					RpcMethod:      "DenomsByPrefix",
+					Use:            "denoms-by-prefix [prefix]",
+					Short:          "Query all the coin denominations with client metadata starting with a given prefix",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "prefix"}},
+				},
 				{
 					RpcMethod:      "DenomOwners",
 					Use:            "denom-owners [denom]",
@@ -62,6 +62,7 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	ctx = withScanPrefetch(ctx)
 	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch total supply %w", err)
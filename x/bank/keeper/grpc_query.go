@@ -193,6 +193,64 @@ func (k BaseKeeper) DenomsMetadata(c context.Context, req *types.QueryDenomsMeta
 	}, nil
 }
 
+// DenomsMetadataBySymbol implements Query/DenomsMetadataBySymbol gRPC method.
+func (k BaseKeeper) DenomsMetadataBySymbol(ctx context.Context, req *types.QueryDenomsMetadataBySymbolRequest) (*types.QueryDenomsMetadataBySymbolResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty symbol")
+	}
+
+	metadatas, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.DenomMetadata.Indexes.Symbol,
+		req.Pagination,
+		func(key collections.Pair[[]byte, string], _ collections.NoValue) (types.Metadata, error) {
+			return k.BaseViewKeeper.DenomMetadata.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, string]([]byte(req.Symbol)),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataBySymbolResponse{
+		Metadatas:  metadatas,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomsByPrefix implements Query/DenomsByPrefix gRPC method.
+func (k BaseKeeper) DenomsByPrefix(ctx context.Context, req *types.QueryDenomsByPrefixRequest) (*types.QueryDenomsByPrefixResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	// denom metadata is keyed by base denom, so the denoms matching the prefix
+	// are contiguous in the collection.
+	denoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.DenomMetadata,
+		req.Pagination,
+		func(denom string, _ types.Metadata) (string, error) {
+			return denom, nil
+		},
+		func(opt *query.CollectionsPaginateOptions[string]) {
+			opt.Prefix = &req.Prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsByPrefixResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
 // DenomMetadata implements Query/DenomMetadata gRPC method.
 func (k BaseKeeper) DenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
 	if req == nil {
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/core/header"
@@ -521,6 +522,72 @@ func (suite *KeeperTestSuite) TestQueryDenomMetadata() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomsMetadataBySymbolAndPrefix() {
+	ctx := suite.ctx
+	newMetadata := func(base, symbol string) types.Metadata {
+		return types.Metadata{
+			DenomUnits: []*types.DenomUnit{{Denom: base}},
+			Base:       base,
+			Display:    base,
+			Symbol:     symbol,
+		}
+	}
+	uatom := newMetadata("uatom", "ATOM")
+	ibcAtom := newMetadata("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "ATOM")
+	ibcOsmo := newMetadata("ibc/14F9BC3E44B8A9C1BE1FB08980FAB87034C9905EF17CF2F5008FC085218811CC", "OSMO")
+	for _, metadata := range []types.Metadata{uatom, ibcAtom, ibcOsmo} {
+		suite.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	// changing the symbol of a denom updates the index
+	uosmo := newMetadata("uosmo", "ATOM")
+	suite.bankKeeper.SetDenomMetaData(ctx, uosmo)
+	uosmo.Symbol = "OSMO"
+	suite.bankKeeper.SetDenomMetaData(ctx, uosmo)
+
+	_, err := suite.queryClient.DenomsMetadataBySymbol(ctx, &types.QueryDenomsMetadataBySymbolRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.DenomsMetadataBySymbol(ctx, &types.QueryDenomsMetadataBySymbolRequest{Symbol: "ATOM"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{ibcAtom, uatom}, res.Metadatas)
+
+	res, err = suite.queryClient.DenomsMetadataBySymbol(ctx, &types.QueryDenomsMetadataBySymbolRequest{Symbol: "OSMO", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{ibcOsmo}, res.Metadatas)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.DenomsMetadataBySymbol(ctx, &types.QueryDenomsMetadataBySymbolRequest{Symbol: "FOO"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Metadatas)
+
+	// symbols are not restricted to printable characters
+	null := newMetadata("null", "NUL\x00")
+	suite.bankKeeper.SetDenomMetaData(ctx, null)
+	res, err = suite.queryClient.DenomsMetadataBySymbol(ctx, &types.QueryDenomsMetadataBySymbolRequest{Symbol: null.Symbol})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{null}, res.Metadatas)
+
+	prefixRes, err := suite.queryClient.DenomsByPrefix(ctx, &types.QueryDenomsByPrefixRequest{Prefix: "ibc/"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{ibcOsmo.Base, ibcAtom.Base}, prefixRes.Denoms)
+
+	prefixRes, err = suite.queryClient.DenomsByPrefix(ctx, &types.QueryDenomsByPrefixRequest{Prefix: "u", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{uatom.Base}, prefixRes.Denoms)
+	suite.Require().Equal(uint64(2), prefixRes.Pagination.Total)
+
+	prefixRes, err = suite.queryClient.DenomsByPrefix(ctx, &types.QueryDenomsByPrefixRequest{Prefix: "u", Pagination: &query.PageRequest{Key: prefixRes.Pagination.NextKey}})
+	suite.Require().NoError(err)
+	suite.Require().Len(prefixRes.Denoms, 1)
+	suite.Require().NotEqual(uatom.Base, prefixRes.Denoms[0])
+	suite.Require().True(strings.HasPrefix(prefixRes.Denoms[0], "u"))
+
+	prefixRes, err = suite.queryClient.DenomsByPrefix(ctx, &types.QueryDenomsByPrefixRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(prefixRes.Denoms, 5)
+}
+
 func (suite *KeeperTestSuite) TestQueryDenomMetadataByQueryStringRequest() {
 	var (
 		req         *types.QueryDenomMetadataByQueryStringRequest
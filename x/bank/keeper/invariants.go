@@ -29,6 +29,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
 func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
+		ctx = ctx.WithIteratorPrefetch(scanPrefetchSize)
 		var (
 			msg   string
 			count int
@@ -55,6 +56,7 @@ func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 // TotalSupply checks that the total supply reflects all the coins held in accounts
 func TotalSupply(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
+		ctx = ctx.WithIteratorPrefetch(scanPrefetchSize)
 		expectedTotal := sdk.Coins{}
 		supply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
 		if err != nil {
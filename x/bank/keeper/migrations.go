@@ -1,6 +1,11 @@
 package keeper
 
-import "context"
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/bank/types"
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
@@ -26,3 +31,27 @@ func (m Migrator) Migrate2to3(ctx context.Context) error {
 func (m Migrator) Migrate3to4(ctx context.Context) error {
 	return nil
 }
+
+// Migrate4to5 migrates x/bank storage from version 4 to 5.
+// It populates the index of the denom metadata by symbol.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	var metadatas []types.Metadata
+	err := m.keeper.BaseViewKeeper.DenomMetadata.Walk(ctx, nil, func(_ string, metadata types.Metadata) (stop bool, err error) {
+		metadatas = append(metadatas, metadata)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, metadata := range metadatas {
+		err := m.keeper.BaseViewKeeper.DenomMetadata.Indexes.Symbol.Reference(ctx, metadata.Base, metadata, func() (types.Metadata, error) {
+			return types.Metadata{}, collections.ErrNotFound
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/go-metrics"
 
+	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/bank/types"
 
@@ -127,6 +129,70 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 	return &types.MsgMultiSendResponse{}, nil
 }
 
+func (k msgServer) BatchSend(ctx context.Context, msg *types.MsgBatchSend) (*types.MsgBatchSendResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	if _, err := base.ak.AddressCodec().StringToBytes(msg.FromAddress); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if len(msg.Outputs) == 0 {
+		return nil, types.ErrNoOutputs
+	}
+
+	var total sdk.Coins
+	outputs := make([]types.Output, len(msg.Outputs))
+	for i, out := range msg.Outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		to, err := base.ak.AddressCodec().StringToBytes(out.ToAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+		}
+
+		if k.BlockedAddr(to) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.ToAddress)
+		}
+
+		total = total.Add(out.Amount...)
+		outputs[i] = types.NewOutput(out.ToAddress, out.Amount)
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, total...); err != nil {
+		return nil, err
+	}
+
+	// the sender is debited only once for the total amount, so that the gas
+	// consumed by the message only grows with the number of outputs.
+	if err := k.InputOutputCoins(ctx, types.NewInput(msg.FromAddress, total), outputs); err != nil {
+		return nil, err
+	}
+
+	for i, out := range msg.Outputs {
+		if out.Reference == "" {
+			continue
+		}
+
+		if err := base.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeBatchSendOutput,
+			event.NewAttribute(types.AttributeKeySender, msg.FromAddress),
+			event.NewAttribute(types.AttributeKeyRecipient, out.ToAddress),
+			event.NewAttribute(sdk.AttributeKeyAmount, out.Amount.String()),
+			event.NewAttribute(types.AttributeKeyReference, out.Reference),
+			event.NewAttribute(types.AttributeKeyOutputIndex, strconv.Itoa(i)),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBatchSendResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"strings"
+
 	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 
@@ -271,6 +273,118 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgBatchSend() {
+	origDenom := "sendableCoin"
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(origDenom, 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(origDenom, 50))
+	suite.bankKeeper.SetSendEnabled(suite.ctx, origDenom, true)
+
+	acc0Addr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	acc1Addr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+	acc4Addr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[4])
+	suite.Require().NoError(err)
+	minterAccAddr, err := suite.authKeeper.AddressCodec().BytesToString(minterAcc.GetAddress())
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgBatchSend
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid from address",
+			input:     banktypes.NewMsgBatchSend("", []banktypes.BatchSendOutput{banktypes.NewBatchSendOutput(acc0Addr, sendCoins, "")}),
+			expErr:    true,
+			expErrMsg: "invalid from address",
+		},
+		{
+			name:      "no outputs",
+			input:     banktypes.NewMsgBatchSend(minterAccAddr, nil),
+			expErr:    true,
+			expErrMsg: "no outputs to send transaction",
+		},
+		{
+			name:      "invalid coins",
+			input:     banktypes.NewMsgBatchSend(minterAccAddr, []banktypes.BatchSendOutput{banktypes.NewBatchSendOutput(acc0Addr, sdk.Coins{sdk.NewInt64Coin(origDenom, 0)}, "")}),
+			expErr:    true,
+			expErrMsg: "invalid coins",
+		},
+		{
+			name: "reference too long",
+			input: banktypes.NewMsgBatchSend(minterAccAddr, []banktypes.BatchSendOutput{
+				banktypes.NewBatchSendOutput(acc0Addr, sendCoins, strings.Repeat("a", banktypes.MaxBatchSendReferenceLength+1)),
+			}),
+			expErr:    true,
+			expErrMsg: "reference too long",
+		},
+		{
+			name:      "invalid to address",
+			input:     banktypes.NewMsgBatchSend(minterAccAddr, []banktypes.BatchSendOutput{banktypes.NewBatchSendOutput("", sendCoins, "")}),
+			expErr:    true,
+			expErrMsg: "invalid to address",
+		},
+		{
+			name: "invalid send to blocked address",
+			input: banktypes.NewMsgBatchSend(minterAccAddr, []banktypes.BatchSendOutput{
+				banktypes.NewBatchSendOutput(acc0Addr, sendCoins, ""),
+				banktypes.NewBatchSendOutput(acc4Addr, sendCoins, ""),
+			}),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name: "all good",
+			input: banktypes.NewMsgBatchSend(minterAccAddr, []banktypes.BatchSendOutput{
+				banktypes.NewBatchSendOutput(acc0Addr, sendCoins, ""),
+				banktypes.NewBatchSendOutput(acc1Addr, sendCoins, "withdrawal-1"),
+			}),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.mockMintCoins(minterAcc)
+			err := suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, origCoins)
+			suite.Require().NoError(err)
+			if !tc.expErr {
+				suite.mockInputOutputCoins([]sdk.AccountI{minterAcc}, accAddrs[:2])
+			}
+			_, err = suite.msgServer.BatchSend(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(sendCoins, suite.bankKeeper.GetAllBalances(suite.ctx, accAddrs[1]))
+
+			// only the output with a reference emits a batch send output event
+			var outputEvents []sdk.Event
+			for _, e := range sdk.UnwrapSDKContext(suite.ctx).EventManager().Events() {
+				if e.Type == banktypes.EventTypeBatchSendOutput {
+					outputEvents = append(outputEvents, e)
+				}
+			}
+			suite.Require().Len(outputEvents, 1)
+			reference, ok := outputEvents[0].GetAttribute(banktypes.AttributeKeyReference)
+			suite.Require().True(ok)
+			suite.Require().Equal("withdrawal-1", reference.Value)
+			recipient, ok := outputEvents[0].GetAttribute(banktypes.AttributeKeyRecipient)
+			suite.Require().True(ok)
+			suite.Require().Equal(acc1Addr, recipient.Value)
+			index, ok := outputEvents[0].GetAttribute(banktypes.AttributeKeyOutputIndex)
+			suite.Require().True(ok)
+			suite.Require().Equal("1", index.Value)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 	govAccAddr, err := suite.authKeeper.AddressCodec().BytesToString(govAcc.GetAddress())
 	suite.Require().NoError(err)
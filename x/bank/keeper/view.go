@@ -53,6 +53,30 @@ func (b BalancesIndexes) IndexesList() []collections.Index[collections.Pair[sdk.
 	return []collections.Index[collections.Pair[sdk.AccAddress, string], math.Int]{b.Denom}
 }
 
+func newDenomMetadataIndexes(sb *collections.SchemaBuilder) DenomMetadataIndexes {
+	return DenomMetadataIndexes{
+		Symbol: indexes.NewMulti(
+			sb, types.DenomMetadataBySymbolPrefix, "denom_metadata_by_symbol_index",
+			// symbols are arbitrary strings, so they are indexed as length-prefixed
+			// bytes rather than as delimited strings.
+			collections.BytesKey, collections.StringKey,
+			func(_ string, metadata types.Metadata) ([]byte, error) {
+				return []byte(metadata.Symbol), nil
+			},
+		),
+	}
+}
+
+// DenomMetadataIndexes defines the indexes of the denom metadata.
+type DenomMetadataIndexes struct {
+	// Symbol indexes the base denoms by display symbol.
+	Symbol *indexes.Multi[[]byte, string, types.Metadata]
+}
+
+func (d DenomMetadataIndexes) IndexesList() []collections.Index[string, types.Metadata] {
+	return []collections.Index[string, types.Metadata]{d.Symbol}
+}
+
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
 	appmodule.Environment
@@ -62,7 +86,7 @@ type BaseViewKeeper struct {
 
 	Schema        collections.Schema
 	Supply        collections.Map[string, math.Int]
-	DenomMetadata collections.Map[string, types.Metadata]
+	DenomMetadata *collections.IndexedMap[string, types.Metadata, DenomMetadataIndexes]
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
@@ -76,7 +100,7 @@ func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak type
 		cdc:           cdc,
 		ak:            ak,
 		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		DenomMetadata: collections.NewIndexedMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc), newDenomMetadataIndexes(sb)),
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
@@ -108,6 +132,7 @@ func (k BaseViewKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress)
 
 // GetAccountsBalances returns all the accounts balances from the store.
 func (k BaseViewKeeper) GetAccountsBalances(ctx context.Context) []types.Balance {
+	ctx = withScanPrefetch(ctx)
 	balances := make([]types.Balance, 0)
 	mapAddressToBalancesIdx := make(map[string]int)
 
@@ -246,3 +271,23 @@ func (k BaseViewKeeper) ValidateBalance(ctx context.Context, addr sdk.AccAddress
 
 	return nil
 }
+
+// scanPrefetchSize is the number of entries read ahead by the store iterators
+// of the large scans of the balances and supply.
+const scanPrefetchSize = 1024
+
+// withScanPrefetch returns a context whose store iterators read ahead the
+// entries in batches, for the large read-only scans of the balances and supply
+// such as the genesis export. The bank store must not be written while such an
+// iterator is open.
+func withScanPrefetch(ctx context.Context) context.Context {
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		return sdkCtx.WithIteratorPrefetch(scanPrefetchSize)
+	}
+
+	if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		return context.WithValue(ctx, sdk.SdkContextKey, sdkCtx.WithIteratorPrefetch(scanPrefetchSize))
+	}
+
+	return ctx
+}
@@ -11,6 +11,12 @@ const (
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = sdk.AttributeKeySender
 
+	// batch send output events, emitted for the outputs having a reference
+	EventTypeBatchSendOutput = "batch_send_output"
+
+	AttributeKeyReference   = "reference"
+	AttributeKeyOutputIndex = "output_index"
+
 	// supply and balance tracking events name and attributes
 	EventTypeCoinSpent    = "coin_spent"
 	EventTypeCoinReceived = "coin_received"
@@ -7,6 +7,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxBatchSendReferenceLength is the maximum length of the reference of a
+// batch send output.
+const MaxBatchSendReferenceLength = 256
+
 // ValidateInputOutputs validates that each respective input and output is
 // valid and that the sum of inputs is equal to the sum of outputs.
 func ValidateInputOutputs(input Input, outputs []Output) error {
@@ -82,3 +86,30 @@ func NewOutput(addr string, coins sdk.Coins) Output {
 		Coins:   coins,
 	}
 }
+
+// ValidateBasic - validate batch send output. The address is validated by the
+// message server, using the address codec of the chain.
+func (out BatchSendOutput) ValidateBasic() error {
+	if !out.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, out.Amount.String())
+	}
+
+	if !out.Amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, out.Amount.String())
+	}
+
+	if len(out.Reference) > MaxBatchSendReferenceLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("reference too long: %d > %d", len(out.Reference), MaxBatchSendReferenceLength)
+	}
+
+	return nil
+}
+
+// NewBatchSendOutput - create a batch send output, used with MsgBatchSend
+func NewBatchSendOutput(addr string, amount sdk.Coins, reference string) BatchSendOutput {
+	return BatchSendOutput{
+		ToAddress: addr,
+		Amount:    amount,
+		Reference: reference,
+	}
+}
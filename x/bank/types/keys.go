@@ -39,6 +39,10 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DenomMetadataBySymbolPrefix is the prefix of the denom metadata index by
+	// display symbol.
+	DenomMetadataBySymbolPrefix = collections.NewPrefix(6)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
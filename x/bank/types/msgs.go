@@ -9,6 +9,7 @@ import (
 var (
 	_ coretransaction.Msg = &MsgSend{}
 	_ coretransaction.Msg = &MsgMultiSend{}
+	_ coretransaction.Msg = &MsgBatchSend{}
 	_ coretransaction.Msg = &MsgUpdateParams{}
 )
 
@@ -22,6 +23,11 @@ func NewMsgMultiSend(in Input, out []Output) *MsgMultiSend {
 	return &MsgMultiSend{Inputs: []Input{in}, Outputs: out}
 }
 
+// NewMsgBatchSend - construct a msg to send coins from one account to many accounts.
+func NewMsgBatchSend(fromAddr string, outputs []BatchSendOutput) *MsgBatchSend {
+	return &MsgBatchSend{FromAddress: fromAddr, Outputs: outputs}
+}
+
 // NewMsgSetSendEnabled Construct a message to set one or more SendEnabled entries.
 func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
 	return &MsgSetSendEnabled{
@@ -971,6 +971,228 @@ func (m *QueryDenomMetadataByQueryStringResponse) GetMetadata() Metadata {
 	return Metadata{}
 }
 
+// QueryDenomsMetadataBySymbolRequest is the request type for the Query/DenomsMetadataBySymbol RPC method.
+type QueryDenomsMetadataBySymbolRequest struct {
+	// symbol is the display symbol to query the metadata for (e.g. ATOM).
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) Reset()         { *m = QueryDenomsMetadataBySymbolRequest{} }
+func (m *QueryDenomsMetadataBySymbolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataBySymbolRequest) ProtoMessage()    {}
+func (*QueryDenomsMetadataBySymbolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{20}
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataBySymbolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolRequest.Merge(m, src)
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataBySymbolRequest proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataBySymbolRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomsMetadataBySymbolResponse is the response type for the Query/DenomsMetadataBySymbol RPC
+// method.
+type QueryDenomsMetadataBySymbolResponse struct {
+	// metadatas provides the client information of all the tokens registered with the symbol.
+	Metadatas []Metadata `protobuf:"bytes,1,rep,name=metadatas,proto3" json:"metadatas"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) Reset()         { *m = QueryDenomsMetadataBySymbolResponse{} }
+func (m *QueryDenomsMetadataBySymbolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataBySymbolResponse) ProtoMessage()    {}
+func (*QueryDenomsMetadataBySymbolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{21}
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataBySymbolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolResponse.Merge(m, src)
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataBySymbolResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataBySymbolResponse) GetMetadatas() []Metadata {
+	if m != nil {
+		return m.Metadatas
+	}
+	return nil
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomsByPrefixRequest is the request type for the Query/DenomsByPrefix RPC method.
+type QueryDenomsByPrefixRequest struct {
+	// prefix is the prefix the base denoms must start with (e.g. ibc/).
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsByPrefixRequest) Reset()         { *m = QueryDenomsByPrefixRequest{} }
+func (m *QueryDenomsByPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsByPrefixRequest) ProtoMessage()    {}
+func (*QueryDenomsByPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{22}
+}
+func (m *QueryDenomsByPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsByPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsByPrefixRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsByPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsByPrefixRequest.Merge(m, src)
+}
+func (m *QueryDenomsByPrefixRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsByPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsByPrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsByPrefixRequest proto.InternalMessageInfo
+
+func (m *QueryDenomsByPrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *QueryDenomsByPrefixRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomsByPrefixResponse is the response type for the Query/DenomsByPrefix RPC
+// method.
+type QueryDenomsByPrefixResponse struct {
+	// denoms are the base denoms starting with the prefix.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsByPrefixResponse) Reset()         { *m = QueryDenomsByPrefixResponse{} }
+func (m *QueryDenomsByPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsByPrefixResponse) ProtoMessage()    {}
+func (*QueryDenomsByPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{23}
+}
+func (m *QueryDenomsByPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsByPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsByPrefixResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsByPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsByPrefixResponse.Merge(m, src)
+}
+func (m *QueryDenomsByPrefixResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsByPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsByPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsByPrefixResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsByPrefixResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryDenomsByPrefixResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDenomOwnersRequest defines the request type for the DenomOwners RPC query,
 // which queries for a paginated set of all account holders of a particular
 // denomination.
@@ -985,7 +1207,7 @@ func (m *QueryDenomOwnersRequest) Reset()         { *m = QueryDenomOwnersRequest
 func (m *QueryDenomOwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{20}
+	return fileDescriptor_9c6fc1939682df13, []int{24}
 }
 func (m *QueryDenomOwnersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,7 +1264,7 @@ func (m *DenomOwner) Reset()         { *m = DenomOwner{} }
 func (m *DenomOwner) String() string { return proto.CompactTextString(m) }
 func (*DenomOwner) ProtoMessage()    {}
 func (*DenomOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{21}
+	return fileDescriptor_9c6fc1939682df13, []int{25}
 }
 func (m *DenomOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,7 +1318,7 @@ func (m *QueryDenomOwnersResponse) Reset()         { *m = QueryDenomOwnersRespon
 func (m *QueryDenomOwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{22}
+	return fileDescriptor_9c6fc1939682df13, []int{26}
 }
 func (m *QueryDenomOwnersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1375,7 @@ func (m *QueryDenomOwnersByQueryRequest) Reset()         { *m = QueryDenomOwners
 func (m *QueryDenomOwnersByQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersByQueryRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersByQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{23}
+	return fileDescriptor_9c6fc1939682df13, []int{27}
 }
 func (m *QueryDenomOwnersByQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,7 +1429,7 @@ func (m *QueryDenomOwnersByQueryResponse) Reset()         { *m = QueryDenomOwner
 func (m *QueryDenomOwnersByQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersByQueryResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersByQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{24}
+	return fileDescriptor_9c6fc1939682df13, []int{28}
 }
 func (m *QueryDenomOwnersByQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1263,7 +1485,7 @@ func (m *QuerySendEnabledRequest) Reset()         { *m = QuerySendEnabledRequest
 func (m *QuerySendEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledRequest) ProtoMessage()    {}
 func (*QuerySendEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{25}
+	return fileDescriptor_9c6fc1939682df13, []int{29}
 }
 func (m *QuerySendEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1318,7 +1540,7 @@ func (m *QuerySendEnabledResponse) Reset()         { *m = QuerySendEnabledRespon
 func (m *QuerySendEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledResponse) ProtoMessage()    {}
 func (*QuerySendEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{26}
+	return fileDescriptor_9c6fc1939682df13, []int{30}
 }
 func (m *QuerySendEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1382,6 +1604,10 @@ func init() {
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataResponse")
 	proto.RegisterType((*QueryDenomMetadataByQueryStringRequest)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataByQueryStringRequest")
 	proto.RegisterType((*QueryDenomMetadataByQueryStringResponse)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataByQueryStringResponse")
+	proto.RegisterType((*QueryDenomsMetadataBySymbolRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataBySymbolRequest")
+	proto.RegisterType((*QueryDenomsMetadataBySymbolResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataBySymbolResponse")
+	proto.RegisterType((*QueryDenomsByPrefixRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsByPrefixRequest")
+	proto.RegisterType((*QueryDenomsByPrefixResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsByPrefixResponse")
 	proto.RegisterType((*QueryDenomOwnersRequest)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersRequest")
 	proto.RegisterType((*DenomOwner)(nil), "cosmos.bank.v1beta1.DenomOwner")
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
@@ -1394,97 +1620,107 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x68, 0x1c, 0x55,
-	0x18, 0xcf, 0x6b, 0x69, 0x9a, 0x7c, 0x9b, 0x2a, 0x7d, 0x49, 0x6d, 0x3a, 0xb1, 0xbb, 0x71, 0x5a,
-	0x9a, 0x6d, 0x4c, 0x66, 0x92, 0xdd, 0xd8, 0xb4, 0xb1, 0x16, 0xb2, 0xad, 0x11, 0x51, 0x69, 0xbb,
-	0xb1, 0x97, 0x2a, 0x2c, 0xb3, 0x3b, 0xe3, 0xba, 0x74, 0x77, 0x66, 0xbb, 0x6f, 0xd2, 0xba, 0x94,
-	0x82, 0x08, 0x42, 0x0f, 0x22, 0x82, 0xed, 0x45, 0x10, 0x7a, 0x12, 0xb1, 0x28, 0x3d, 0x54, 0xf0,
-	0xa0, 0x47, 0xa1, 0x14, 0xc4, 0x52, 0x2f, 0xda, 0x83, 0x4a, 0x2a, 0x54, 0xbc, 0x7a, 0x16, 0x64,
-	0xdf, 0x9f, 0x9d, 0x99, 0x9d, 0xb7, 0xb3, 0x93, 0x3f, 0x96, 0xe2, 0x25, 0xec, 0xbe, 0x79, 0xdf,
-	0xfb, 0x7e, 0xbf, 0xdf, 0xfb, 0xe6, 0x7b, 0xbf, 0xb7, 0x81, 0x54, 0xc9, 0x21, 0x35, 0x87, 0xe8,
-	0x45, 0xc3, 0x3e, 0xa7, 0x5f, 0x98, 0x2d, 0x5a, 0xae, 0x31, 0xab, 0x9f, 0x5f, 0xb1, 0x1a, 0x4d,
-	0xad, 0xde, 0x70, 0x5c, 0x07, 0x0f, 0xb3, 0x09, 0x5a, 0x6b, 0x82, 0xc6, 0x27, 0x28, 0x93, 0xed,
-	0x28, 0x62, 0xb1, 0xd9, 0xed, 0xd8, 0xba, 0x51, 0xae, 0xd8, 0x86, 0x5b, 0x71, 0x6c, 0xb6, 0x80,
-	0x32, 0x52, 0x76, 0xca, 0x0e, 0xfd, 0xa8, 0xb7, 0x3e, 0xf1, 0xd1, 0xa7, 0xcb, 0x8e, 0x53, 0xae,
-	0x5a, 0xba, 0x51, 0xaf, 0xe8, 0x86, 0x6d, 0x3b, 0x2e, 0x0d, 0x21, 0xfc, 0x69, 0xd2, 0xbf, 0xbe,
-	0x58, 0xb9, 0xe4, 0x54, 0xec, 0xd0, 0x73, 0x1f, 0x6a, 0x8a, 0x90, 0x3d, 0xdf, 0xc3, 0x9e, 0x17,
-	0x58, 0x5a, 0xce, 0x80, 0x3d, 0x1a, 0xe3, 0xa1, 0x02, 0xb5, 0x9f, 0xac, 0xb2, 0xd3, 0xa8, 0x55,
-	0x6c, 0x47, 0xa7, 0x7f, 0xd9, 0x90, 0x5a, 0x81, 0xe1, 0xd3, 0xad, 0x19, 0x39, 0xa3, 0x6a, 0xd8,
-	0x25, 0x2b, 0x6f, 0x9d, 0x5f, 0xb1, 0x88, 0x8b, 0x33, 0xb0, 0xdd, 0x30, 0xcd, 0x86, 0x45, 0xc8,
-	0x28, 0x1a, 0x47, 0xe9, 0xc1, 0xdc, 0xe8, 0xbd, 0x5b, 0xd3, 0x23, 0x3c, 0xd3, 0x22, 0x7b, 0xb2,
-	0xec, 0x36, 0x2a, 0x76, 0x39, 0x2f, 0x26, 0xe2, 0x11, 0xd8, 0x66, 0x5a, 0xb6, 0x53, 0x1b, 0xdd,
-	0xd2, 0x8a, 0xc8, 0xb3, 0x2f, 0x0b, 0x03, 0x57, 0xae, 0xa7, 0xfa, 0xfe, 0xbc, 0x9e, 0xea, 0x53,
-	0x5f, 0x81, 0x91, 0x60, 0x2a, 0x52, 0x77, 0x6c, 0x62, 0xe1, 0x2c, 0x6c, 0x2f, 0xb2, 0x21, 0x9a,
-	0x2b, 0x91, 0xd9, 0xa3, 0xb5, 0x37, 0x85, 0x58, 0x62, 0x53, 0xb4, 0xe3, 0x4e, 0xc5, 0xce, 0x8b,
-	0x99, 0xea, 0x2f, 0x08, 0x76, 0xd3, 0xd5, 0x16, 0xab, 0x55, 0xbe, 0x20, 0xd9, 0x08, 0xf8, 0x25,
-	0x00, 0x6f, 0x6b, 0x29, 0x83, 0x44, 0xe6, 0x40, 0x00, 0x07, 0x13, 0x52, 0xa0, 0x39, 0x65, 0x94,
-	0x85, 0x58, 0x79, 0x5f, 0x24, 0x3e, 0x0c, 0x3b, 0x1a, 0x16, 0x71, 0xaa, 0x17, 0xac, 0x02, 0x13,
-	0x63, 0xeb, 0x38, 0x4a, 0x0f, 0xe4, 0x86, 0xef, 0xdf, 0x9a, 0x7e, 0x92, 0xad, 0x36, 0x4d, 0xcc,
-	0x73, 0xe3, 0x33, 0xda, 0x73, 0x33, 0xf9, 0x21, 0x3e, 0xf3, 0x44, 0x87, 0x50, 0xab, 0x08, 0x46,
-	0xc3, 0xdc, 0xb8, 0x5a, 0x97, 0x61, 0x80, 0x6b, 0xd0, 0x62, 0xb7, 0x35, 0x52, 0xae, 0xdc, 0xd2,
-	0xed, 0x5f, 0x53, 0x7d, 0x5f, 0xfc, 0x96, 0x4a, 0x97, 0x2b, 0xee, 0xdb, 0x2b, 0x45, 0xad, 0xe4,
-	0xd4, 0x78, 0xb9, 0xe8, 0x1e, 0x18, 0xdd, 0x6d, 0xd6, 0x2d, 0x42, 0x03, 0xc8, 0x27, 0x0f, 0x6f,
-	0x4e, 0x0e, 0x55, 0xad, 0xb2, 0x51, 0x6a, 0x16, 0x5a, 0x05, 0x49, 0x3e, 0x7f, 0x78, 0x73, 0x12,
-	0xe5, 0xdb, 0x29, 0xf1, 0x4b, 0x12, 0x9d, 0x26, 0x7a, 0xea, 0xc4, 0xb0, 0xfb, 0x85, 0x52, 0xbf,
-	0x41, 0xb0, 0x97, 0x92, 0x5c, 0xae, 0x5b, 0xb6, 0x69, 0x14, 0xab, 0xd6, 0x63, 0xb4, 0x8d, 0x0b,
-	0x63, 0x62, 0x33, 0xee, 0x75, 0xee, 0xdb, 0xdc, 0x21, 0xf5, 0x1f, 0x04, 0xc9, 0x6e, 0xd0, 0xff,
-	0x5f, 0xbb, 0xb4, 0x30, 0x2c, 0xe3, 0xff, 0x01, 0x82, 0x7d, 0x52, 0xfe, 0xb9, 0x26, 0x2d, 0xe5,
-	0xcd, 0x6f, 0x22, 0x11, 0xdb, 0x31, 0xaf, 0xd6, 0x61, 0x7f, 0x34, 0x9a, 0x0d, 0xf4, 0x19, 0x99,
-	0x00, 0xf3, 0xea, 0xbb, 0xa2, 0xf9, 0xbc, 0xee, 0xb8, 0x46, 0x75, 0x79, 0xa5, 0x5e, 0xaf, 0x36,
-	0x05, 0xe9, 0x37, 0x02, 0xd2, 0xa3, 0xb5, 0x54, 0xa0, 0xa4, 0x4b, 0xcc, 0x65, 0x03, 0xdb, 0xe1,
-	0xf5, 0x88, 0xbf, 0x45, 0x8f, 0x08, 0x40, 0xe0, 0x4c, 0x9b, 0xd0, 0x4f, 0xe8, 0xc8, 0xa3, 0xab,
-	0x3d, 0x9e, 0x10, 0xbf, 0xb9, 0x81, 0xca, 0xeb, 0xc9, 0x5f, 0x9d, 0xe2, 0x47, 0x08, 0xe3, 0x7b,
-	0xf2, 0x2d, 0x21, 0x7a, 0xbb, 0x6a, 0x90, 0xaf, 0x6a, 0xd4, 0x33, 0xb0, 0xab, 0x63, 0x36, 0xd7,
-	0xe7, 0x28, 0xf4, 0x1b, 0x35, 0x67, 0xc5, 0x76, 0x7b, 0x16, 0x42, 0x6e, 0xb0, 0xa5, 0x0f, 0xa7,
-	0xc8, 0x62, 0xd4, 0x11, 0xc0, 0x74, 0xd9, 0x53, 0x46, 0xc3, 0xa8, 0x89, 0x6e, 0xa5, 0x9e, 0xe1,
-	0x07, 0xa9, 0x18, 0xe5, 0xa9, 0x8e, 0x41, 0x7f, 0x9d, 0x8e, 0xf0, 0x54, 0x63, 0x9a, 0xc4, 0x70,
-	0x68, 0x2c, 0x28, 0x90, 0x8c, 0x45, 0xa9, 0x26, 0x28, 0x74, 0x59, 0x5a, 0xca, 0xe4, 0x35, 0xcb,
-	0x35, 0x4c, 0xc3, 0x35, 0x04, 0xef, 0xa5, 0xf5, 0x17, 0x5b, 0x40, 0xd7, 0xaf, 0x10, 0x8c, 0x49,
-	0xd3, 0x70, 0x16, 0x4b, 0x30, 0x58, 0xe3, 0x63, 0xa2, 0x9f, 0xed, 0x95, 0x12, 0x11, 0x91, 0x7e,
-	0x2a, 0x5e, 0xe8, 0xe6, 0x9d, 0x1e, 0xb3, 0xb0, 0xc7, 0xc3, 0xdb, 0xa9, 0x8a, 0xbc, 0x1a, 0x8a,
-	0x7e, 0x25, 0x43, 0x0c, 0x4f, 0xc0, 0x80, 0x80, 0xc9, 0x75, 0x8c, 0x4f, 0xb0, 0x1d, 0xa9, 0x1e,
-	0x83, 0x03, 0xe1, 0x1c, 0xb9, 0x26, 0xab, 0x42, 0xd6, 0xe9, 0x22, 0x31, 0x3a, 0x30, 0xd1, 0x33,
-	0x7e, 0x53, 0x01, 0x5f, 0xe4, 0x8d, 0x8c, 0x26, 0x3c, 0x79, 0xd1, 0xb6, 0x1a, 0x24, 0x12, 0xe1,
-	0x66, 0x1d, 0xb0, 0xea, 0x35, 0x04, 0xe0, 0x25, 0x5d, 0xd7, 0x51, 0x71, 0xcc, 0xeb, 0xe7, 0x5b,
-	0xd6, 0xf0, 0x1a, 0x47, 0xb5, 0xf6, 0x43, 0xea, 0xb7, 0xa2, 0xaf, 0x06, 0x14, 0xe1, 0x9a, 0xe7,
-	0x60, 0x88, 0xaa, 0x50, 0x70, 0xe8, 0x38, 0x7f, 0x13, 0x52, 0x52, 0xdd, 0xbd, 0xf8, 0x7c, 0xc2,
-	0xf4, 0xd6, 0xfa, 0x8f, 0x8f, 0xe6, 0x6b, 0xc2, 0x9a, 0xf8, 0xe0, 0xf3, 0xfa, 0x79, 0x24, 0xfb,
-	0xba, 0xb0, 0xeb, 0xde, 0xad, 0xe9, 0x9d, 0x1d, 0x46, 0x57, 0xcb, 0xaa, 0xdf, 0x23, 0x48, 0x75,
-	0xc5, 0xf5, 0x38, 0xaa, 0xdb, 0x85, 0xc7, 0x87, 0xe2, 0xe4, 0x5f, 0xb6, 0x6c, 0xf3, 0x45, 0xbb,
-	0xe5, 0x36, 0x4c, 0x21, 0xec, 0x53, 0xd0, 0x4f, 0xa1, 0x30, 0xe4, 0x83, 0x79, 0xfe, 0xad, 0x43,
-	0xda, 0xd2, 0xba, 0xa5, 0x95, 0x5a, 0x91, 0xef, 0x44, 0xbd, 0x06, 0x00, 0x71, 0x45, 0x8f, 0xc3,
-	0x10, 0xb1, 0x6c, 0xb3, 0x60, 0xb1, 0x71, 0xae, 0xe8, 0xb8, 0x54, 0x51, 0x7f, 0x7c, 0x82, 0x78,
-	0x5f, 0x3a, 0x24, 0x2d, 0x6d, 0x6a, 0xc1, 0xce, 0x67, 0x6e, 0x60, 0xd8, 0x46, 0xf1, 0xe3, 0x4f,
-	0x11, 0x6c, 0xe7, 0xce, 0x0d, 0xa7, 0xa5, 0x10, 0x25, 0x17, 0x55, 0xe5, 0x60, 0x8c, 0x99, 0x0c,
-	0x8b, 0xfa, 0xc2, 0x95, 0xd6, 0xfb, 0xff, 0xde, 0x4f, 0x7f, 0x7c, 0xbc, 0x25, 0x83, 0x67, 0x74,
-	0xf9, 0x1d, 0x9b, 0x19, 0x68, 0xfd, 0x12, 0x6f, 0x32, 0x97, 0xf5, 0x62, 0x93, 0x5d, 0xe4, 0xf0,
-	0x75, 0x04, 0x09, 0xdf, 0x85, 0x0c, 0x4f, 0x75, 0xcf, 0x1c, 0xbe, 0x93, 0x2a, 0xd3, 0x31, 0x67,
-	0x73, 0xac, 0x73, 0x1e, 0xd6, 0x83, 0x78, 0x22, 0x26, 0x56, 0xfc, 0x23, 0x82, 0x9d, 0xa1, 0x3b,
-	0x09, 0xce, 0x74, 0x4f, 0xdd, 0xed, 0xee, 0xa5, 0x64, 0xd7, 0x14, 0xc3, 0x41, 0x9f, 0xbe, 0x13,
-	0xee, 0x48, 0x1e, 0x8f, 0x2c, 0x9e, 0x95, 0xf2, 0x20, 0x62, 0xbd, 0x82, 0x84, 0xd1, 0x5f, 0x08,
-	0x76, 0x77, 0xf1, 0xf5, 0xf8, 0x70, 0x7c, 0x8c, 0xc1, 0x8b, 0x89, 0x72, 0x64, 0x1d, 0x91, 0x9c,
-	0xe3, 0xd9, 0x30, 0xc7, 0x79, 0x8f, 0xe3, 0x51, 0xbc, 0xb0, 0x66, 0x8e, 0x5e, 0x85, 0x5d, 0x45,
-	0x90, 0xf0, 0xd9, 0xf9, 0xa8, 0x0a, 0x0b, 0x5f, 0x3c, 0xa2, 0x2a, 0x4c, 0x72, 0x47, 0x50, 0xd3,
-	0x1e, 0xea, 0xbd, 0x78, 0x4c, 0x8e, 0x9a, 0xc1, 0xb8, 0x8a, 0x60, 0x40, 0x58, 0x68, 0x1c, 0xf1,
-	0xbe, 0x75, 0x98, 0x72, 0x65, 0x32, 0xce, 0x54, 0x8e, 0x66, 0xd6, 0x43, 0x73, 0x00, 0xef, 0x8f,
-	0x40, 0xe3, 0xa9, 0xf5, 0x3e, 0x82, 0x7e, 0xe6, 0x9b, 0xf1, 0x44, 0xf7, 0x4c, 0x01, 0x93, 0xae,
-	0xa4, 0x7b, 0x4f, 0x8c, 0x2f, 0x0f, 0x73, 0xe8, 0xf8, 0x06, 0x82, 0x1d, 0x01, 0xbf, 0x86, 0xb5,
-	0xee, 0x59, 0x64, 0x7e, 0x55, 0xd1, 0x63, 0xcf, 0xe7, 0xe0, 0x8e, 0x78, 0xe0, 0x34, 0x3c, 0x25,
-	0x05, 0xc7, 0xce, 0x9e, 0x82, 0x30, 0x7a, 0xfa, 0x25, 0x3a, 0x70, 0x19, 0xdf, 0x47, 0xa0, 0x74,
-	0x77, 0x97, 0xf8, 0xf9, 0x98, 0x50, 0x64, 0x9e, 0x56, 0x39, 0xba, 0xbe, 0x60, 0x4e, 0x6a, 0xd1,
-	0x23, 0x75, 0x08, 0xcf, 0xc5, 0x21, 0x55, 0x28, 0x36, 0x0b, 0xf4, 0xec, 0x29, 0x10, 0x86, 0xfe,
-	0x33, 0x04, 0x4f, 0x04, 0x6f, 0x30, 0xb8, 0x97, 0xb6, 0x9d, 0x57, 0x2a, 0x65, 0x26, 0x7e, 0x40,
-	0xfc, 0xda, 0xed, 0x00, 0x8e, 0xbf, 0x46, 0x90, 0xf0, 0x39, 0xa1, 0xa8, 0x37, 0x3d, 0xec, 0xcc,
-	0xa3, 0xde, 0x74, 0x89, 0x6b, 0x55, 0x5f, 0x8e, 0x6c, 0xcb, 0xcf, 0xe2, 0x83, 0xdd, 0x21, 0x73,
-	0x2b, 0xd6, 0xae, 0x9e, 0x1f, 0x10, 0xe0, 0xb0, 0x83, 0xc3, 0xd9, 0x58, 0x80, 0x82, 0x3e, 0x54,
-	0x99, 0x5b, 0x5b, 0x10, 0x27, 0xf3, 0xea, 0x1d, 0x99, 0x2f, 0xf3, 0xe8, 0x4c, 0xe1, 0xc9, 0x9e,
-	0x74, 0xda, 0x75, 0x83, 0xbf, 0x44, 0x90, 0xf0, 0x19, 0x9f, 0xa8, 0x7d, 0x08, 0x1b, 0xbe, 0xa8,
-	0x7d, 0x90, 0xb8, 0x31, 0x75, 0x31, 0xf2, 0xe8, 0xd8, 0x87, 0x9f, 0x91, 0xb7, 0x3d, 0x9f, 0x81,
-	0xcb, 0x65, 0x6f, 0xaf, 0x26, 0xd1, 0xdd, 0xd5, 0x24, 0xfa, 0x7d, 0x35, 0x89, 0x3e, 0x7a, 0x90,
-	0xec, 0xbb, 0xfb, 0x20, 0xd9, 0xf7, 0xf3, 0x83, 0x64, 0xdf, 0x59, 0xfe, 0x2f, 0x01, 0x62, 0x9e,
-	0xd3, 0x2a, 0x8e, 0xfe, 0x0e, 0x5b, 0x83, 0xfe, 0x6c, 0x53, 0xec, 0xa7, 0xbf, 0xf4, 0x67, 0xff,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x03, 0x25, 0x97, 0x0c, 0x19, 0x00, 0x00,
+	// 1590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x5f, 0x68, 0x14, 0xd7,
+	0x17, 0xce, 0x55, 0x8c, 0xc9, 0xd9, 0xf8, 0xfb, 0xe1, 0x4d, 0xd4, 0x38, 0xa9, 0xbb, 0xe9, 0x68,
+	0x4d, 0x4c, 0xb3, 0x3b, 0xc9, 0x6e, 0x74, 0x35, 0xb5, 0x82, 0xab, 0x46, 0x4a, 0x5b, 0xd4, 0x4d,
+	0xa5, 0x60, 0x0b, 0xcb, 0x6c, 0x76, 0xdc, 0x2e, 0xee, 0xce, 0xac, 0x7b, 0x37, 0xea, 0x20, 0x81,
+	0x52, 0x28, 0xf8, 0x50, 0x4a, 0x8b, 0xfa, 0xd0, 0x42, 0xc1, 0xa7, 0x52, 0x5a, 0x5a, 0x7c, 0xb0,
+	0x50, 0x4a, 0xfb, 0x58, 0x10, 0x4b, 0x51, 0xec, 0x4b, 0xeb, 0x43, 0x5b, 0xa2, 0x60, 0xe9, 0x6b,
+	0x9f, 0x0b, 0x65, 0xef, 0x9f, 0x9d, 0x99, 0x9d, 0xbb, 0xb3, 0x93, 0xb8, 0x8a, 0xf4, 0x45, 0x33,
+	0x77, 0xce, 0xb9, 0xe7, 0xfb, 0xbe, 0x7b, 0xee, 0xb9, 0xf7, 0xcc, 0x42, 0x6c, 0xc1, 0x22, 0x15,
+	0x8b, 0x68, 0x79, 0xdd, 0x3c, 0xa3, 0x9d, 0x9b, 0xce, 0x1b, 0x75, 0x7d, 0x5a, 0x3b, 0xbb, 0x68,
+	0xd4, 0xec, 0x44, 0xb5, 0x66, 0xd5, 0x2d, 0x3c, 0xc8, 0x0c, 0x12, 0x0d, 0x83, 0x04, 0x37, 0x50,
+	0x26, 0x9a, 0x5e, 0xc4, 0x60, 0xd6, 0x4d, 0xdf, 0xaa, 0x5e, 0x2c, 0x99, 0x7a, 0xbd, 0x64, 0x99,
+	0x6c, 0x02, 0x65, 0xa8, 0x68, 0x15, 0x2d, 0xfa, 0xa7, 0xd6, 0xf8, 0x8b, 0x8f, 0x3e, 0x53, 0xb4,
+	0xac, 0x62, 0xd9, 0xd0, 0xf4, 0x6a, 0x49, 0xd3, 0x4d, 0xd3, 0xaa, 0x53, 0x17, 0xc2, 0xdf, 0x46,
+	0xdd, 0xf3, 0x8b, 0x99, 0x17, 0xac, 0x92, 0xe9, 0x7b, 0xef, 0x42, 0x4d, 0x11, 0xb2, 0xf7, 0x5b,
+	0xd9, 0xfb, 0x1c, 0x0b, 0xcb, 0x19, 0xb0, 0x57, 0x23, 0xdc, 0x55, 0xa0, 0x76, 0x93, 0x55, 0x36,
+	0xea, 0x95, 0x92, 0x69, 0x69, 0xf4, 0x5f, 0x36, 0xa4, 0x96, 0x60, 0xf0, 0x44, 0xc3, 0x22, 0xa3,
+	0x97, 0x75, 0x73, 0xc1, 0xc8, 0x1a, 0x67, 0x17, 0x0d, 0x52, 0xc7, 0x49, 0x58, 0xaf, 0x17, 0x0a,
+	0x35, 0x83, 0x90, 0x61, 0x34, 0x8a, 0xc6, 0xfb, 0x33, 0xc3, 0x77, 0x6f, 0xc4, 0x87, 0x78, 0xa4,
+	0x83, 0xec, 0xcd, 0x7c, 0xbd, 0x56, 0x32, 0x8b, 0x59, 0x61, 0x88, 0x87, 0x60, 0x5d, 0xc1, 0x30,
+	0xad, 0xca, 0xf0, 0x9a, 0x86, 0x47, 0x96, 0x3d, 0xcc, 0xf6, 0x5d, 0xba, 0x16, 0xeb, 0xf9, 0xf3,
+	0x5a, 0xac, 0x47, 0x7d, 0x19, 0x86, 0xbc, 0xa1, 0x48, 0xd5, 0x32, 0x89, 0x81, 0x53, 0xb0, 0x3e,
+	0xcf, 0x86, 0x68, 0xac, 0x48, 0x72, 0x6b, 0xa2, 0xb9, 0x28, 0xc4, 0x10, 0x8b, 0x92, 0x38, 0x64,
+	0x95, 0xcc, 0xac, 0xb0, 0x54, 0x7f, 0x45, 0xb0, 0x85, 0xce, 0x76, 0xb0, 0x5c, 0xe6, 0x13, 0x92,
+	0x47, 0x01, 0x3f, 0x07, 0xe0, 0x2c, 0x2d, 0x65, 0x10, 0x49, 0xee, 0xf4, 0xe0, 0x60, 0x42, 0x0a,
+	0x34, 0xc7, 0xf5, 0xa2, 0x10, 0x2b, 0xeb, 0xf2, 0xc4, 0x7b, 0x61, 0x43, 0xcd, 0x20, 0x56, 0xf9,
+	0x9c, 0x91, 0x63, 0x62, 0xac, 0x1d, 0x45, 0xe3, 0x7d, 0x99, 0xc1, 0x7b, 0x37, 0xe2, 0xff, 0x67,
+	0xb3, 0xc5, 0x49, 0xe1, 0xcc, 0xe8, 0x54, 0x62, 0xf7, 0x54, 0x76, 0x80, 0x5b, 0x1e, 0x6e, 0x11,
+	0x6a, 0x19, 0xc1, 0xb0, 0x9f, 0x1b, 0x57, 0x6b, 0x09, 0xfa, 0xb8, 0x06, 0x0d, 0x76, 0x6b, 0x03,
+	0xe5, 0xca, 0xcc, 0xdd, 0xfc, 0x2d, 0xd6, 0xf3, 0xf9, 0xef, 0xb1, 0xf1, 0x62, 0xa9, 0xfe, 0xd6,
+	0x62, 0x3e, 0xb1, 0x60, 0x55, 0x78, 0xba, 0x68, 0x0e, 0x18, 0xad, 0x6e, 0x57, 0x0d, 0x42, 0x1d,
+	0xc8, 0xc7, 0x0f, 0xaf, 0x4f, 0x0c, 0x94, 0x8d, 0xa2, 0xbe, 0x60, 0xe7, 0x1a, 0x09, 0x49, 0x3e,
+	0x7b, 0x78, 0x7d, 0x02, 0x65, 0x9b, 0x21, 0xf1, 0x51, 0x89, 0x4e, 0x63, 0x1d, 0x75, 0x62, 0xd8,
+	0xdd, 0x42, 0xa9, 0xdf, 0x20, 0xd8, 0x46, 0x49, 0xce, 0x57, 0x0d, 0xb3, 0xa0, 0xe7, 0xcb, 0xc6,
+	0x53, 0xb4, 0x8c, 0xb3, 0x23, 0x62, 0x31, 0xee, 0xb6, 0xae, 0xdb, 0xcc, 0x1e, 0xf5, 0x1f, 0x04,
+	0xd1, 0x76, 0xd0, 0xff, 0x5b, 0xab, 0x34, 0x3b, 0x28, 0xe3, 0xff, 0x1e, 0x82, 0xed, 0x52, 0xfe,
+	0x19, 0x9b, 0xa6, 0x72, 0xf7, 0x8b, 0x48, 0xc0, 0x72, 0xa4, 0xd5, 0x2a, 0xec, 0x08, 0x46, 0xf3,
+	0x08, 0x75, 0x46, 0x26, 0x40, 0x5a, 0x7d, 0x5b, 0x14, 0x9f, 0xd7, 0xac, 0xba, 0x5e, 0x9e, 0x5f,
+	0xac, 0x56, 0xcb, 0xb6, 0x20, 0xfd, 0x86, 0x47, 0x7a, 0xb4, 0x92, 0x0c, 0x94, 0x54, 0x89, 0x99,
+	0x94, 0x67, 0x39, 0x9c, 0x1a, 0xf1, 0xb7, 0xa8, 0x11, 0x1e, 0x08, 0x9c, 0xa9, 0x0d, 0xbd, 0x84,
+	0x8e, 0x3c, 0xb9, 0xdc, 0xe3, 0x01, 0xf1, 0x9b, 0x8f, 0x90, 0x79, 0x1d, 0xf9, 0xab, 0x93, 0xfc,
+	0x08, 0x61, 0x7c, 0x8f, 0x9d, 0x16, 0xa2, 0x37, 0xb3, 0x06, 0xb9, 0xb2, 0x46, 0x3d, 0x09, 0x9b,
+	0x5a, 0xac, 0xb9, 0x3e, 0xfb, 0xa1, 0x57, 0xaf, 0x58, 0x8b, 0x66, 0xbd, 0x63, 0x22, 0x64, 0xfa,
+	0x1b, 0xfa, 0x70, 0x8a, 0xcc, 0x47, 0x1d, 0x02, 0x4c, 0xa7, 0x3d, 0xae, 0xd7, 0xf4, 0x8a, 0xa8,
+	0x56, 0xea, 0x49, 0x18, 0xf4, 0x8c, 0xf2, 0x50, 0x07, 0xa0, 0xb7, 0x4a, 0x47, 0x78, 0xa8, 0x91,
+	0x84, 0xe4, 0xc2, 0x91, 0x60, 0x4e, 0x9e, 0x60, 0xcc, 0x4b, 0x2d, 0x80, 0x42, 0xa7, 0xa5, 0xa9,
+	0x4c, 0x5e, 0x35, 0xea, 0x7a, 0x41, 0xaf, 0xeb, 0x82, 0xf7, 0xdc, 0xea, 0x93, 0xcd, 0xa3, 0xeb,
+	0x57, 0x08, 0x46, 0xa4, 0x61, 0x38, 0x8b, 0x39, 0xe8, 0xaf, 0xf0, 0x31, 0x51, 0xcf, 0xb6, 0x49,
+	0x89, 0x08, 0x4f, 0x37, 0x15, 0xc7, 0xb5, 0x7b, 0xa7, 0xc7, 0x34, 0x6c, 0x75, 0xf0, 0xb6, 0xaa,
+	0x22, 0xcf, 0x86, 0x3c, 0x28, 0x32, 0x17, 0xce, 0xf0, 0x30, 0xf4, 0x09, 0x98, 0x5c, 0xc7, 0xf0,
+	0x04, 0x9b, 0x9e, 0xea, 0x01, 0xd8, 0xe9, 0x8f, 0x91, 0xb1, 0x59, 0x16, 0xb2, 0x4a, 0x17, 0x88,
+	0xd1, 0x82, 0xb1, 0x8e, 0xfe, 0x5d, 0x05, 0xfc, 0x11, 0x02, 0x55, 0xb2, 0xf0, 0x19, 0x7b, 0xde,
+	0xae, 0xe4, 0xad, 0xb2, 0x40, 0xbb, 0x19, 0x7a, 0x09, 0x1d, 0xe0, 0x70, 0xf9, 0x53, 0xd7, 0x8e,
+	0x5b, 0x7f, 0x95, 0xdd, 0x9d, 0x54, 0x7f, 0x14, 0xc7, 0x4c, 0x3b, 0x6c, 0x4f, 0x69, 0x72, 0xca,
+	0xd9, 0x7c, 0x88, 0x3c, 0x3b, 0x39, 0x63, 0x1f, 0xaf, 0x19, 0xa7, 0x4b, 0x17, 0x5c, 0x0a, 0x57,
+	0xe9, 0x80, 0x50, 0x98, 0x3d, 0x3d, 0x5e, 0x85, 0x2f, 0x7b, 0xb7, 0xbd, 0x83, 0x89, 0x2b, 0xbb,
+	0x19, 0x7a, 0x69, 0x5e, 0x32, 0x59, 0xfb, 0xb3, 0xfc, 0xe9, 0x31, 0x2b, 0x75, 0x1e, 0xb6, 0x38,
+	0xa0, 0x8e, 0x9d, 0x37, 0x8d, 0x1a, 0x09, 0xdc, 0x35, 0xdd, 0xd2, 0x48, 0xbd, 0x8a, 0x00, 0x9c,
+	0xa0, 0xab, 0xba, 0xbe, 0x1c, 0x70, 0xee, 0x18, 0x6b, 0x56, 0x70, 0xb4, 0x04, 0x5d, 0x37, 0xf6,
+	0xa8, 0xdf, 0x89, 0xb3, 0xde, 0xa3, 0x08, 0x5f, 0xa3, 0x0c, 0x0c, 0x50, 0x15, 0x72, 0x16, 0x1d,
+	0xe7, 0x1b, 0x20, 0x26, 0xdd, 0x00, 0x8e, 0x7f, 0x36, 0x52, 0x70, 0xe6, 0x7a, 0xcc, 0xd7, 0xc5,
+	0xab, 0xe2, 0xba, 0xec, 0x82, 0xcf, 0x6b, 0xda, 0x13, 0x59, 0xd7, 0xd9, 0x4d, 0x77, 0x6f, 0xc4,
+	0x37, 0xb6, 0x34, 0x5f, 0x89, 0x94, 0xfa, 0x03, 0x82, 0x58, 0x5b, 0x5c, 0x4f, 0xa3, 0xba, 0x6d,
+	0x78, 0xbc, 0x2f, 0x6e, 0xa3, 0xf3, 0x86, 0x59, 0x38, 0x62, 0x36, 0x6e, 0xc0, 0x05, 0x57, 0x59,
+	0x91, 0xee, 0x60, 0xaf, 0xb4, 0x0b, 0x5d, 0x2c, 0x2b, 0x33, 0x69, 0xf5, 0x7b, 0x91, 0xaf, 0x1e,
+	0x40, 0x5c, 0xd1, 0x43, 0x30, 0x40, 0x0c, 0xb3, 0x90, 0x33, 0xd8, 0x38, 0x57, 0x74, 0x54, 0xaa,
+	0xa8, 0xdb, 0x3f, 0x42, 0x9c, 0x07, 0x7c, 0x54, 0x02, 0xbf, 0x5b, 0x09, 0x9b, 0x4e, 0xde, 0xde,
+	0x04, 0xeb, 0x28, 0x7e, 0xfc, 0x09, 0x82, 0xf5, 0xbc, 0x9b, 0xc0, 0xe3, 0x52, 0x88, 0x92, 0x8f,
+	0x27, 0xca, 0xae, 0x10, 0x96, 0x0c, 0x8b, 0xfa, 0xe2, 0xa5, 0xc6, 0xfe, 0x7f, 0xe7, 0xe7, 0x07,
+	0x97, 0xd7, 0x24, 0xf1, 0x94, 0x26, 0xff, 0xee, 0x43, 0x5d, 0x88, 0x76, 0x91, 0x17, 0x99, 0x25,
+	0x2d, 0x6f, 0xb3, 0x8f, 0x0b, 0xf8, 0x1a, 0x82, 0x88, 0xeb, 0x23, 0x01, 0x9e, 0x6c, 0x1f, 0xd9,
+	0xff, 0x9d, 0x44, 0x89, 0x87, 0xb4, 0xe6, 0x58, 0x67, 0x1c, 0xac, 0xbb, 0xf0, 0x58, 0x48, 0xac,
+	0xf8, 0x36, 0x82, 0x8d, 0xbe, 0x3e, 0x19, 0x27, 0xdb, 0x87, 0x6e, 0xf7, 0x3d, 0x40, 0x49, 0xad,
+	0xc8, 0x87, 0x83, 0x3e, 0x71, 0xcb, 0x5f, 0x91, 0x1c, 0x1e, 0x29, 0x3c, 0x2d, 0xe5, 0x41, 0xc4,
+	0x7c, 0x39, 0x09, 0xa3, 0xbf, 0x10, 0x6c, 0x69, 0xd3, 0x6b, 0xe2, 0xbd, 0xe1, 0x31, 0x7a, 0x9b,
+	0x65, 0x65, 0xdf, 0x2a, 0x3c, 0x39, 0xc7, 0x53, 0x7e, 0x8e, 0x69, 0x87, 0xe3, 0x7e, 0x3c, 0xbb,
+	0x62, 0x8e, 0x4e, 0x86, 0x5d, 0x41, 0x10, 0x71, 0xb5, 0x98, 0x41, 0x19, 0xe6, 0x6f, 0x86, 0x95,
+	0x78, 0x48, 0x6b, 0x4e, 0x64, 0xdc, 0x41, 0xbd, 0x0d, 0x8f, 0xc8, 0x51, 0x33, 0x18, 0x57, 0x10,
+	0xf4, 0x89, 0xb6, 0x0e, 0x07, 0xec, 0xb7, 0x96, 0x46, 0x51, 0x99, 0x08, 0x63, 0xca, 0xd1, 0x4c,
+	0x3b, 0x68, 0x76, 0xe2, 0x1d, 0x01, 0x68, 0x1c, 0xb5, 0xde, 0x45, 0xd0, 0xcb, 0x7a, 0x39, 0x3c,
+	0xd6, 0x3e, 0x92, 0xa7, 0x71, 0x54, 0xc6, 0x3b, 0x1b, 0x86, 0x97, 0x87, 0x75, 0x8d, 0xf8, 0x0b,
+	0x04, 0x1b, 0x3c, 0x3d, 0x04, 0x4e, 0xb4, 0x8f, 0x22, 0xeb, 0xa1, 0x14, 0x2d, 0xb4, 0x3d, 0x07,
+	0xb7, 0xcf, 0x01, 0x97, 0xc0, 0x93, 0x52, 0x70, 0xec, 0xec, 0xc9, 0x89, 0x1b, 0xb7, 0x76, 0x91,
+	0x0e, 0x2c, 0xe1, 0x7b, 0x08, 0x94, 0xf6, 0x1d, 0x0f, 0x7e, 0x21, 0x24, 0x14, 0x59, 0x9f, 0xa5,
+	0xec, 0x5f, 0x9d, 0x33, 0x27, 0x75, 0xd0, 0x21, 0xb5, 0x07, 0xcf, 0x84, 0x21, 0x95, 0xcb, 0xdb,
+	0x39, 0x7a, 0xf6, 0xe4, 0x08, 0x43, 0xff, 0x29, 0x82, 0xff, 0x79, 0x1b, 0x18, 0xdc, 0x49, 0xdb,
+	0xd6, 0x36, 0x5f, 0x99, 0x0a, 0xef, 0x10, 0x3e, 0x77, 0x5b, 0x80, 0xe3, 0x07, 0x08, 0x36, 0xcb,
+	0x3b, 0x2d, 0x9c, 0x0e, 0x1b, 0xbf, 0xa5, 0x6f, 0x54, 0xf6, 0xae, 0xdc, 0x91, 0x13, 0x78, 0xfd,
+	0x96, 0xbf, 0x33, 0x70, 0x38, 0xed, 0xc3, 0xe9, 0xb0, 0x8b, 0xc1, 0x3a, 0x55, 0xed, 0x22, 0xfb,
+	0x7f, 0x09, 0x7f, 0xdb, 0x5c, 0x0f, 0xd1, 0xee, 0x74, 0x5e, 0x8f, 0x96, 0x66, 0x4d, 0x99, 0x0a,
+	0xef, 0xc0, 0xe9, 0x1c, 0x09, 0xa4, 0x33, 0x86, 0x9f, 0x0b, 0xa2, 0x93, 0xb7, 0x73, 0xbc, 0x1b,
+	0xfc, 0x1a, 0x41, 0xc4, 0x75, 0x5b, 0x0d, 0xaa, 0xc6, 0xfe, 0xee, 0x49, 0x89, 0x87, 0xb4, 0xe6,
+	0x98, 0x5f, 0x0a, 0x3c, 0x3a, 0x9f, 0xc7, 0xbb, 0xda, 0x63, 0xe6, 0xd7, 0xe5, 0xe6, 0x0e, 0xff,
+	0x09, 0x01, 0xf6, 0xdf, 0xb2, 0x71, 0x2a, 0x14, 0x20, 0x6f, 0xaf, 0xa0, 0xcc, 0xac, 0xcc, 0x89,
+	0x93, 0x79, 0xe5, 0x96, 0xec, 0xee, 0xec, 0xd0, 0x99, 0xc4, 0x13, 0x1d, 0xe9, 0x34, 0xf7, 0x36,
+	0xfe, 0x12, 0x41, 0xc4, 0x75, 0x39, 0x0d, 0x5a, 0x07, 0xff, 0xa5, 0x5c, 0x89, 0x87, 0xb4, 0x16,
+	0x45, 0x28, 0xf0, 0x78, 0xdf, 0x8e, 0x9f, 0x95, 0x1f, 0x4d, 0xae, 0x4b, 0x76, 0x26, 0x75, 0x73,
+	0x39, 0x8a, 0xee, 0x2c, 0x47, 0xd1, 0x1f, 0xcb, 0x51, 0xf4, 0xc1, 0xfd, 0x68, 0xcf, 0x9d, 0xfb,
+	0xd1, 0x9e, 0x5f, 0xee, 0x47, 0x7b, 0x4e, 0xf1, 0x9f, 0x12, 0x49, 0xe1, 0x4c, 0xa2, 0x64, 0x69,
+	0x17, 0xd8, 0x1c, 0xf4, 0x73, 0x6f, 0xbe, 0x97, 0xfe, 0x42, 0x98, 0xfa, 0x77, 0x00, 0x36, 0x8e,
+	0x01, 0x6b, 0x44, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1536,6 +1772,12 @@ type QueryClient interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of all the coin
+	// denominations registered with a given display symbol.
+	DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error)
+	// DenomsByPrefix queries all the coin denominations with client metadata whose
+	// base denom starts with a given prefix.
+	DenomsByPrefix(ctx context.Context, in *QueryDenomsByPrefixRequest, opts ...grpc.CallOption) (*QueryDenomsByPrefixResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -1651,6 +1893,24 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error) {
+	out := new(QueryDenomsMetadataBySymbolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomsMetadataBySymbol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsByPrefix(ctx context.Context, in *QueryDenomsByPrefixRequest, opts ...grpc.CallOption) (*QueryDenomsByPrefixResponse, error) {
+	out := new(QueryDenomsByPrefixResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomsByPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error) {
 	out := new(QueryDenomOwnersResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomOwners", in, out, opts...)
@@ -1717,6 +1977,12 @@ type QueryServer interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of all the coin
+	// denominations registered with a given display symbol.
+	DenomsMetadataBySymbol(context.Context, *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error)
+	// DenomsByPrefix queries all the coin denominations with client metadata whose
+	// base denom starts with a given prefix.
+	DenomsByPrefix(context.Context, *QueryDenomsByPrefixRequest) (*QueryDenomsByPrefixResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -1768,6 +2034,12 @@ func (*UnimplementedQueryServer) DenomMetadataByQueryString(ctx context.Context,
 func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (*UnimplementedQueryServer) DenomsMetadataBySymbol(ctx context.Context, req *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataBySymbol not implemented")
+}
+func (*UnimplementedQueryServer) DenomsByPrefix(ctx context.Context, req *QueryDenomsByPrefixRequest) (*QueryDenomsByPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsByPrefix not implemented")
+}
 func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
@@ -1962,6 +2234,42 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomsMetadataBySymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataBySymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomsMetadataBySymbol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, req.(*QueryDenomsMetadataBySymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsByPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsByPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsByPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomsByPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsByPrefix(ctx, req.(*QueryDenomsByPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDenomOwnersRequest)
 	if err := dec(in); err != nil {
@@ -2060,6 +2368,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "DenomsMetadataBySymbol",
+			Handler:    _Query_DenomsMetadataBySymbol_Handler,
+		},
+		{
+			MethodName: "DenomsByPrefix",
+			Handler:    _Query_DenomsByPrefix_Handler,
+		},
 		{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
@@ -2826,7 +3142,7 @@ func (m *QueryDenomMetadataByQueryStringResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomOwnersRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDenomsMetadataBySymbolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2836,12 +3152,12 @@ func (m *QueryDenomOwnersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomOwnersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataBySymbolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataBySymbolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2858,17 +3174,17 @@ func (m *QueryDenomOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomOwner) Marshal() (dAtA []byte, err error) {
+func (m *QueryDenomsMetadataBySymbolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2878,37 +3194,46 @@ func (m *DenomOwner) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomOwner) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataBySymbolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataBySymbolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Metadatas) > 0 {
+		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomOwnersResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDenomsByPrefixRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2918,12 +3243,12 @@ func (m *QueryDenomOwnersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomOwnersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDenomsByPrefixRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDenomsByPrefixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2940,12 +3265,180 @@ func (m *QueryDenomOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DenomOwners) > 0 {
-		for iNdEx := len(m.DenomOwners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsByPrefixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsByPrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsByPrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomOwnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomOwnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomOwnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomOwnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomOwners) > 0 {
+		for iNdEx := len(m.DenomOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
 				}
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
@@ -3447,6 +3940,78 @@ func (m *QueryDenomMetadataByQueryStringResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomsMetadataBySymbolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadatas) > 0 {
+		for _, e := range m.Metadatas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsByPrefixRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsByPrefixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryDenomOwnersRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5514,6 +6079,480 @@ func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomsMetadataBySymbolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsMetadataBySymbolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadatas = append(m.Metadatas, Metadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsByPrefixRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsByPrefixRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsByPrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsByPrefixResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsByPrefixResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsByPrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
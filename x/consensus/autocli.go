@@ -21,6 +21,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current consensus parameters",
 				},
+				{
+					RpcMethod: "GasLimitController",
+					Use:       "gas-limit-controller",
+					Short:     "Query the parameters and the current epoch state of the block gas limit controller",
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"comet": cmtservice.CometBFTAutoCLIDescriptor,
@@ -42,6 +47,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "UpdateGasLimitControllerParams",
+					Use:       "update-gas-limit-controller-proposal [params]",
+					Short:     "Submit a proposal to update the block gas limit controller params. Note: the entire params must be provided.",
+					Example:   fmt.Sprintf(`%s tx consensus update-gas-limit-controller-proposal '{"enabled":true,"min_max_gas":"10000000","max_max_gas":"100000000","target_utilization":"0.5","max_change_rate":"0.125","epoch_length":"100"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "params"},
+					},
+					GovProposal: true,
+				},
 			},
 		},
 	}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/consensus/types"
+)
+
+// UpdateGasLimitControllerParams updates the parameters of the block gas limit
+// controller. The current epoch is restarted with the new parameters.
+func (k Keeper) UpdateGasLimitControllerParams(ctx context.Context, msg *types.MsgUpdateGasLimitControllerParams) (*types.MsgUpdateGasLimitControllerParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.GasLimitControllerParams.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := k.GasLimitControllerState.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		"update_gas_limit_controller_params",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("parameters", msg.Params.String())); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGasLimitControllerParamsResponse{}, nil
+}
+
+// GasLimitController queries the parameters and the state of the block gas
+// limit controller.
+func (k Keeper) GasLimitController(ctx context.Context, _ *types.QueryGasLimitControllerRequest) (*types.QueryGasLimitControllerResponse, error) {
+	params, err := k.getGasLimitControllerParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := k.getGasLimitControllerState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGasLimitControllerResponse{Params: &params, State: &state}, nil
+}
+
+// EndBlock tracks the gas used by the block when the block gas limit controller
+// is enabled. At the end of an epoch, it sets the block max gas of the
+// consensus params according to the average gas used per block.
+func (k Keeper) EndBlock(ctx context.Context) error {
+	params, err := k.getGasLimitControllerParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	cp, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Without a block max gas, the block gas meter is infinite and the gas used
+	// can't be measured, so the max gas is set to the max max gas and the
+	// controller starts adjusting it from the next epoch.
+	if cp.Block != nil && cp.Block.MaxGas <= 0 {
+		if err := k.GasLimitControllerState.Remove(ctx); err != nil {
+			return err
+		}
+
+		cp.Block.MaxGas = params.MaxMaxGas
+		if err := k.ParamsStore.Set(ctx, cp); err != nil {
+			return err
+		}
+
+		return k.EventService.EventManager(ctx).EmitKV(
+			"update_block_gas_limit",
+			event.NewAttribute("max_gas", strconv.FormatInt(cp.Block.MaxGas, 10)),
+		)
+	}
+
+	state, err := k.getGasLimitControllerState(ctx)
+	if err != nil {
+		return err
+	}
+
+	meter := k.GasService.BlockGasMeter(ctx)
+	state.GasUsed += meter.Limit() - meter.Remaining()
+	state.Blocks++
+	if state.Blocks < params.EpochLength {
+		return k.GasLimitControllerState.Set(ctx, state)
+	}
+
+	if err := k.GasLimitControllerState.Remove(ctx); err != nil {
+		return err
+	}
+
+	if cp.Block == nil {
+		return nil
+	}
+
+	avgGasUsed := state.GasUsed / uint64(state.Blocks)
+	maxGas := params.NextMaxGas(cp.Block.MaxGas, avgGasUsed)
+	if maxGas == cp.Block.MaxGas {
+		return nil
+	}
+
+	cp.Block.MaxGas = maxGas
+	if err := k.ParamsStore.Set(ctx, cp); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		"update_block_gas_limit",
+		event.NewAttribute("max_gas", strconv.FormatInt(maxGas, 10)),
+		event.NewAttribute("average_gas_used", strconv.FormatUint(avgGasUsed, 10)),
+	)
+}
+
+// getGasLimitControllerParams returns the parameters of the block gas limit
+// controller, or the default parameters if they were never set.
+func (k Keeper) getGasLimitControllerParams(ctx context.Context) (types.GasLimitControllerParams, error) {
+	params, err := k.GasLimitControllerParams.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultGasLimitControllerParams(), nil
+	}
+
+	return params, err
+}
+
+// getGasLimitControllerState returns the state of the block gas limit
+// controller for the current epoch.
+func (k Keeper) getGasLimitControllerState(ctx context.Context) (types.GasLimitControllerState, error) {
+	state, err := k.GasLimitControllerState.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.GasLimitControllerState{}, nil
+	}
+
+	return state, err
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/consensus/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateGasLimitControllerParams() {
+	s.SetupTest(false)
+
+	params := types.DefaultGasLimitControllerParams()
+	params.Enabled = true
+
+	_, err := s.consensusParamsKeeper.UpdateGasLimitControllerParams(s.ctx, &types.MsgUpdateGasLimitControllerParams{
+		Authority: "invalid",
+		Params:    params,
+	})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	invalidParams := params
+	invalidParams.MaxMaxGas = params.MinMaxGas - 1
+	_, err = s.consensusParamsKeeper.UpdateGasLimitControllerParams(s.ctx, &types.MsgUpdateGasLimitControllerParams{
+		Authority: s.consensusParamsKeeper.GetAuthority(),
+		Params:    invalidParams,
+	})
+	s.Require().ErrorContains(err, "max max gas must be greater than or equal to min max gas")
+
+	res, err := s.queryClient.GasLimitController(s.ctx, &types.QueryGasLimitControllerRequest{})
+	s.Require().NoError(err)
+	s.Require().False(res.Params.Enabled)
+
+	s.Require().NoError(s.consensusParamsKeeper.GasLimitControllerState.Set(s.ctx, types.GasLimitControllerState{GasUsed: 10, Blocks: 1}))
+	_, err = s.consensusParamsKeeper.UpdateGasLimitControllerParams(s.ctx, &types.MsgUpdateGasLimitControllerParams{
+		Authority: s.consensusParamsKeeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.GasLimitController(s.ctx, &types.QueryGasLimitControllerRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, *res.Params)
+	s.Require().Equal(types.GasLimitControllerState{}, *res.State)
+}
+
+func (s *KeeperTestSuite) TestGasLimitControllerEndBlock() {
+	s.SetupTest(false)
+
+	cp, err := s.consensusParamsKeeper.ParamsStore.Get(s.ctx)
+	s.Require().NoError(err)
+	cp.Block.MaxGas = 1000
+	s.Require().NoError(s.consensusParamsKeeper.ParamsStore.Set(s.ctx, cp))
+
+	maxGas := func() int64 {
+		cp, err := s.consensusParamsKeeper.ParamsStore.Get(s.ctx)
+		s.Require().NoError(err)
+		return cp.Block.MaxGas
+	}
+	endBlock := func(gasUsed uint64) {
+		meter := storetypes.NewGasMeter(uint64(maxGas()))
+		meter.ConsumeGas(gasUsed, "test")
+		s.Require().NoError(s.consensusParamsKeeper.EndBlock(s.ctx.WithBlockGasMeter(meter)))
+	}
+
+	// the controller is disabled by default
+	endBlock(1000)
+	s.Require().Equal(int64(1000), maxGas())
+	has, err := s.consensusParamsKeeper.GasLimitControllerState.Has(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	_, err = s.consensusParamsKeeper.UpdateGasLimitControllerParams(s.ctx, &types.MsgUpdateGasLimitControllerParams{
+		Authority: s.consensusParamsKeeper.GetAuthority(),
+		Params: types.GasLimitControllerParams{
+			Enabled:           true,
+			MinMaxGas:         500,
+			MaxMaxGas:         1200,
+			TargetUtilization: math.LegacyNewDecWithPrec(5, 1),
+			MaxChangeRate:     math.LegacyNewDecWithPrec(1, 1),
+			EpochLength:       2,
+		},
+	})
+	s.Require().NoError(err)
+
+	// full blocks increase the max gas by the max change rate at the end of the epoch
+	endBlock(1000)
+	s.Require().Equal(int64(1000), maxGas())
+	state, err := s.consensusParamsKeeper.GasLimitControllerState.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.GasLimitControllerState{GasUsed: 1000, Blocks: 1}, state)
+
+	endBlock(1000)
+	s.Require().Equal(int64(1100), maxGas())
+	has, err = s.consensusParamsKeeper.GasLimitControllerState.Has(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the max gas is bounded by the max max gas
+	endBlock(1100)
+	endBlock(1100)
+	s.Require().Equal(int64(1200), maxGas())
+
+	// blocks at the target utilization keep the max gas
+	endBlock(600)
+	endBlock(600)
+	s.Require().Equal(int64(1200), maxGas())
+
+	// empty blocks decrease the max gas
+	endBlock(0)
+	endBlock(0)
+	s.Require().Equal(int64(1080), maxGas())
+}
+
+func (s *KeeperTestSuite) TestGasLimitControllerEndBlockWithoutMaxGas() {
+	s.SetupTest(false)
+
+	cp, err := s.consensusParamsKeeper.ParamsStore.Get(s.ctx)
+	s.Require().NoError(err)
+	cp.Block.MaxGas = -1
+	s.Require().NoError(s.consensusParamsKeeper.ParamsStore.Set(s.ctx, cp))
+
+	maxGas := func() int64 {
+		cp, err := s.consensusParamsKeeper.ParamsStore.Get(s.ctx)
+		s.Require().NoError(err)
+		return cp.Block.MaxGas
+	}
+
+	_, err = s.consensusParamsKeeper.UpdateGasLimitControllerParams(s.ctx, &types.MsgUpdateGasLimitControllerParams{
+		Authority: s.consensusParamsKeeper.GetAuthority(),
+		Params: types.GasLimitControllerParams{
+			Enabled:           true,
+			MinMaxGas:         500,
+			MaxMaxGas:         1200,
+			TargetUtilization: math.LegacyNewDecWithPrec(5, 1),
+			MaxChangeRate:     math.LegacyNewDecWithPrec(1, 1),
+			EpochLength:       1,
+		},
+	})
+	s.Require().NoError(err)
+
+	// the gas used can't be measured with the infinite block gas meter of a
+	// block without max gas, so the max gas is set to the max max gas
+	meter := storetypes.NewInfiniteGasMeter()
+	meter.ConsumeGas(1000, "test")
+	s.Require().NoError(s.consensusParamsKeeper.EndBlock(s.ctx.WithBlockGasMeter(meter)))
+	s.Require().Equal(int64(1200), maxGas())
+	has, err := s.consensusParamsKeeper.GasLimitControllerState.Has(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the max gas is then adjusted to the gas used
+	meter = storetypes.NewGasMeter(uint64(maxGas()))
+	meter.ConsumeGas(1200, "test")
+	s.Require().NoError(s.consensusParamsKeeper.EndBlock(s.ctx.WithBlockGasMeter(meter)))
+	s.Require().Equal(int64(1200), maxGas())
+
+	meter = storetypes.NewGasMeter(uint64(maxGas()))
+	s.Require().NoError(s.consensusParamsKeeper.EndBlock(s.ctx.WithBlockGasMeter(meter)))
+	s.Require().Equal(int64(1080), maxGas())
+}
@@ -26,6 +26,11 @@ type Keeper struct {
 
 	authority   string
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	// GasLimitControllerParams are the parameters of the block gas limit controller.
+	GasLimitControllerParams collections.Item[types.GasLimitControllerParams]
+	// GasLimitControllerState is the gas usage tracked by the block gas limit
+	// controller during the current epoch.
+	GasLimitControllerState collections.Item[types.GasLimitControllerState]
 }
 
 var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
@@ -33,9 +38,11 @@ var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	return Keeper{
-		Environment: env,
-		authority:   authority,
-		ParamsStore: collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		Environment:              env,
+		authority:                authority,
+		ParamsStore:              collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		GasLimitControllerParams: collections.NewItem(sb, types.GasLimitControllerParamsKey, "gas_limit_controller_params", codec.CollValue[types.GasLimitControllerParams](cdc)),
+		GasLimitControllerState:  collections.NewItem(sb, types.GasLimitControllerStateKey, "gas_limit_controller_state", codec.CollValue[types.GasLimitControllerState](cdc)),
 	}
 }
 
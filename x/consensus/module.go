@@ -26,6 +26,7 @@ var (
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasRegisterInterfaces = AppModule{}
+	_ appmodule.HasEndBlocker         = AppModule{}
 )
 
 // AppModule implements an application module
@@ -73,6 +74,11 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	return nil
 }
 
+// EndBlock adjusts the block gas limit when the block gas limit controller is enabled.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlock(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -4,8 +4,12 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -92,8 +96,150 @@ func (m *CometInfo) GetLastCommit() *v1.CommitInfo {
 	return nil
 }
 
+// GasLimitControllerParams defines the parameters of the block gas limit
+// controller. When enabled, the controller adjusts the max gas of a block at
+// the end of each epoch, based on the average gas used per block during the
+// epoch.
+type GasLimitControllerParams struct {
+	// enabled defines whether the controller adjusts the block max gas.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_max_gas is the lowest block max gas the controller can set.
+	MinMaxGas int64 `protobuf:"varint,2,opt,name=min_max_gas,json=minMaxGas,proto3" json:"min_max_gas,omitempty"`
+	// max_max_gas is the highest block max gas the controller can set.
+	MaxMaxGas int64 `protobuf:"varint,3,opt,name=max_max_gas,json=maxMaxGas,proto3" json:"max_max_gas,omitempty"`
+	// target_utilization is the targeted ratio of gas used to block max gas.
+	// The block max gas is increased when the utilization is above the target
+	// and decreased when it is below.
+	TargetUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=target_utilization,json=targetUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_utilization"`
+	// max_change_rate is the relative change of the block max gas per epoch when
+	// the utilization is twice the target or zero. Larger deviations from the
+	// target are capped to this rate.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// epoch_length is the number of blocks of an epoch.
+	EpochLength int64 `protobuf:"varint,6,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+}
+
+func (m *GasLimitControllerParams) Reset()         { *m = GasLimitControllerParams{} }
+func (m *GasLimitControllerParams) String() string { return proto.CompactTextString(m) }
+func (*GasLimitControllerParams) ProtoMessage()    {}
+func (*GasLimitControllerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed86dd7d42fb61b, []int{1}
+}
+func (m *GasLimitControllerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasLimitControllerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasLimitControllerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasLimitControllerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasLimitControllerParams.Merge(m, src)
+}
+func (m *GasLimitControllerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasLimitControllerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasLimitControllerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasLimitControllerParams proto.InternalMessageInfo
+
+func (m *GasLimitControllerParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GasLimitControllerParams) GetMinMaxGas() int64 {
+	if m != nil {
+		return m.MinMaxGas
+	}
+	return 0
+}
+
+func (m *GasLimitControllerParams) GetMaxMaxGas() int64 {
+	if m != nil {
+		return m.MaxMaxGas
+	}
+	return 0
+}
+
+func (m *GasLimitControllerParams) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// GasLimitControllerState defines the gas usage tracked by the block gas limit
+// controller during the current epoch.
+type GasLimitControllerState struct {
+	// gas_used is the gas used by the blocks of the current epoch.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// blocks is the number of blocks of the current epoch.
+	Blocks int64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *GasLimitControllerState) Reset()         { *m = GasLimitControllerState{} }
+func (m *GasLimitControllerState) String() string { return proto.CompactTextString(m) }
+func (*GasLimitControllerState) ProtoMessage()    {}
+func (*GasLimitControllerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed86dd7d42fb61b, []int{2}
+}
+func (m *GasLimitControllerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasLimitControllerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasLimitControllerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasLimitControllerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasLimitControllerState.Merge(m, src)
+}
+func (m *GasLimitControllerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasLimitControllerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasLimitControllerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasLimitControllerState proto.InternalMessageInfo
+
+func (m *GasLimitControllerState) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *GasLimitControllerState) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CometInfo)(nil), "cosmos.consensus.v1.CometInfo")
+	proto.RegisterType((*GasLimitControllerParams)(nil), "cosmos.consensus.v1.GasLimitControllerParams")
+	proto.RegisterType((*GasLimitControllerState)(nil), "cosmos.consensus.v1.GasLimitControllerState")
 }
 
 func init() {
@@ -101,25 +247,43 @@ func init() {
 }
 
 var fileDescriptor_7ed86dd7d42fb61b = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xeb, 0xbf, 0xbf, 0x10, 0xb8, 0x88, 0xa2, 0xb0, 0x44, 0xa8, 0x58, 0x15, 0x0c, 0x94,
-	0xc5, 0x51, 0xca, 0x02, 0x03, 0x03, 0x74, 0x81, 0x81, 0x25, 0x23, 0x4b, 0xe4, 0x38, 0xae, 0x62,
-	0xd1, 0xe4, 0x46, 0xbe, 0xc6, 0x82, 0xb7, 0xe0, 0xb1, 0x18, 0x3b, 0xc2, 0x86, 0x92, 0x17, 0x41,
-	0x49, 0x28, 0x41, 0x62, 0x3c, 0xc7, 0xe7, 0x93, 0x7c, 0x3f, 0x7a, 0x22, 0x01, 0x73, 0xc0, 0x40,
-	0x42, 0x81, 0xaa, 0xc0, 0x27, 0x0c, 0x5c, 0xd8, 0x07, 0x5e, 0x1a, 0xb0, 0xe0, 0x1d, 0x74, 0x23,
-	0xde, 0xf7, 0x2e, 0x3c, 0x9c, 0x48, 0xc8, 0x95, 0x4d, 0x96, 0x36, 0x10, 0x89, 0xd4, 0x0d, 0x66,
-	0x5f, 0x4a, 0xf5, 0x8d, 0x1c, 0x7f, 0x10, 0xba, 0xb3, 0x68, 0x06, 0x77, 0xc5, 0x12, 0xbc, 0x4b,
-	0xba, 0xad, 0x9c, 0x4e, 0x55, 0x21, 0x95, 0x4f, 0xa6, 0xc3, 0xd9, 0x68, 0x7e, 0xc4, 0x37, 0x38,
-	0x6f, 0x70, 0xee, 0x42, 0x7e, 0xaf, 0x31, 0x51, 0x99, 0x70, 0x1a, 0x4c, 0xf4, 0x33, 0xf7, 0x4e,
-	0xe9, 0xd8, 0x89, 0x95, 0x4e, 0x85, 0x05, 0x83, 0x71, 0x26, 0x30, 0xf3, 0xff, 0x4d, 0xc9, 0x6c,
-	0x37, 0xda, 0xeb, 0xeb, 0x5b, 0x81, 0x99, 0x77, 0x46, 0xf7, 0x4b, 0x03, 0x25, 0xa0, 0x32, 0xb1,
-	0x48, 0x53, 0xa3, 0x10, 0xfd, 0x61, 0xbb, 0x1c, 0x6f, 0xfa, 0xeb, 0xae, 0xf6, 0xae, 0xe8, 0x68,
-	0x25, 0xd0, 0xc6, 0x12, 0xf2, 0x5c, 0x5b, 0xff, 0xff, 0x94, 0xcc, 0x46, 0xf3, 0xc9, 0xdf, 0x1f,
-	0x2d, 0xda, 0xf7, 0xe6, 0x82, 0x88, 0x36, 0x40, 0x97, 0x6f, 0x2e, 0xde, 0x2a, 0x46, 0xd6, 0x15,
-	0x23, 0x9f, 0x15, 0x23, 0xaf, 0x35, 0x1b, 0xac, 0x6b, 0x36, 0x78, 0xaf, 0xd9, 0xe0, 0x81, 0x75,
-	0xa2, 0x30, 0x7d, 0xe4, 0x1a, 0x82, 0xe7, 0x5f, 0x56, 0x5b, 0x37, 0xc9, 0x56, 0x2b, 0xe7, 0xfc,
-	0x2b, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x63, 0x02, 0x4f, 0x76, 0x01, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0xd2, 0x9f, 0x4d, 0x21, 0xd4, 0x45, 0xe0, 0x96, 0xe2, 0x86, 0x72, 0x20, 0x20,
+	0xd5, 0xa6, 0x41, 0x20, 0x40, 0xe2, 0x40, 0x53, 0xa9, 0x20, 0xa5, 0x12, 0x32, 0xea, 0x85, 0x03,
+	0xd6, 0x78, 0xbd, 0xb5, 0x57, 0xf1, 0xee, 0x46, 0xde, 0x8d, 0xe5, 0xf2, 0x14, 0x3c, 0x06, 0x47,
+	0x0e, 0x7d, 0x88, 0x1e, 0xab, 0x9e, 0x80, 0x43, 0x85, 0x92, 0x03, 0x0f, 0xc0, 0x0b, 0x20, 0xaf,
+	0x9d, 0x06, 0x54, 0x6e, 0x5c, 0xac, 0x9d, 0xef, 0x9b, 0xf9, 0xe6, 0x9b, 0x59, 0x2f, 0xba, 0x87,
+	0x85, 0x64, 0x42, 0xba, 0x58, 0x70, 0x49, 0xb8, 0x1c, 0x4a, 0x37, 0xdb, 0x9e, 0x06, 0xce, 0x20,
+	0x15, 0x4a, 0x98, 0x2b, 0x65, 0x92, 0x33, 0xc5, 0xb3, 0xed, 0xb5, 0x75, 0x2c, 0x18, 0x51, 0xc1,
+	0xa1, 0x72, 0x21, 0xc0, 0xb4, 0x28, 0x53, 0x47, 0x03, 0x52, 0x95, 0xac, 0xdd, 0x88, 0x44, 0x24,
+	0xf4, 0xd1, 0x2d, 0x4e, 0x15, 0xba, 0x5a, 0x0a, 0xf9, 0x25, 0x51, 0xa9, 0x96, 0xd4, 0x32, 0x30,
+	0xca, 0x85, 0xab, 0xbf, 0x25, 0xb4, 0xf9, 0xcd, 0x40, 0x8b, 0xdd, 0xa2, 0xc9, 0x1b, 0x7e, 0x28,
+	0xcc, 0xe7, 0x68, 0x81, 0x64, 0x34, 0x24, 0x1c, 0x13, 0xcb, 0x68, 0xd5, 0xdb, 0x8d, 0xce, 0x1d,
+	0x67, 0x62, 0xc1, 0x29, 0x2c, 0x38, 0xd9, 0xb6, 0xb3, 0x4f, 0x65, 0x40, 0x62, 0xc8, 0xa8, 0x48,
+	0xbd, 0x8b, 0x74, 0xf3, 0x3e, 0x6a, 0x66, 0x90, 0xd0, 0x10, 0x94, 0x48, 0xa5, 0x1f, 0x83, 0x8c,
+	0xad, 0x99, 0x96, 0xd1, 0x5e, 0xf2, 0xae, 0x4d, 0xe1, 0xd7, 0x20, 0x63, 0xf3, 0x01, 0xba, 0x3e,
+	0x48, 0xc5, 0x40, 0x48, 0x92, 0xfa, 0x10, 0x86, 0x29, 0x91, 0xd2, 0xaa, 0xeb, 0xcc, 0xe6, 0x04,
+	0x7f, 0x55, 0xc2, 0xe6, 0x4b, 0xd4, 0x48, 0x40, 0x2a, 0x1f, 0x0b, 0xc6, 0xa8, 0xb2, 0x66, 0x5b,
+	0x46, 0xbb, 0xd1, 0x59, 0xbf, 0xec, 0xa8, 0xab, 0xf9, 0x62, 0x02, 0x0f, 0x15, 0x05, 0x65, 0xbc,
+	0xf9, 0x6b, 0x06, 0x59, 0x7b, 0x20, 0x7b, 0x94, 0x51, 0xd5, 0x15, 0x5c, 0xa5, 0x22, 0x49, 0x48,
+	0xfa, 0x16, 0x52, 0x60, 0xd2, 0xb4, 0xd0, 0x3c, 0xe1, 0x10, 0x24, 0x24, 0xb4, 0x8c, 0x96, 0xd1,
+	0x5e, 0xf0, 0x26, 0xa1, 0x69, 0xa3, 0x06, 0xa3, 0xdc, 0x67, 0x90, 0xfb, 0x11, 0x48, 0x3d, 0x45,
+	0xdd, 0x5b, 0x64, 0x94, 0xef, 0x43, 0xbe, 0x07, 0x52, 0xf3, 0x90, 0x5f, 0xf0, 0xf5, 0x8a, 0x87,
+	0xbc, 0xe2, 0x09, 0x32, 0x15, 0xa4, 0x11, 0x51, 0xfe, 0x50, 0xd1, 0x84, 0x7e, 0x04, 0x45, 0x05,
+	0xd7, 0xe6, 0x17, 0x77, 0x9e, 0x9e, 0x9c, 0x6f, 0xd4, 0xbe, 0x9f, 0x6f, 0xdc, 0x2e, 0xef, 0x45,
+	0x86, 0x7d, 0x87, 0x0a, 0x97, 0x81, 0x8a, 0x9d, 0x1e, 0x89, 0x00, 0x1f, 0xed, 0x12, 0x7c, 0x76,
+	0xbc, 0x85, 0xaa, 0x6b, 0xdb, 0x25, 0xf8, 0xf3, 0xcf, 0x2f, 0x0f, 0x0d, 0x6f, 0xb9, 0x54, 0x3c,
+	0x98, 0x0a, 0x9a, 0x1f, 0x50, 0xb3, 0xb0, 0x80, 0x63, 0xe0, 0x11, 0xf1, 0x53, 0x50, 0xc4, 0xba,
+	0xf2, 0x5f, 0x3d, 0xae, 0x32, 0xc8, 0xbb, 0x5a, 0xcd, 0x03, 0x45, 0xcc, 0xbb, 0x68, 0x89, 0x0c,
+	0x04, 0x8e, 0xfd, 0x84, 0xf0, 0x48, 0xc5, 0xd6, 0x9c, 0x9e, 0xb3, 0xa1, 0xb1, 0x9e, 0x86, 0x5e,
+	0xac, 0x9c, 0x1d, 0x6f, 0x35, 0x4b, 0x99, 0x2d, 0x19, 0xf6, 0x5b, 0x8f, 0x9c, 0x27, 0x9d, 0x4d,
+	0x40, 0xb7, 0x2e, 0x2f, 0xfd, 0x9d, 0x2a, 0x24, 0x57, 0xd1, 0x42, 0x04, 0xd2, 0x1f, 0xca, 0x6a,
+	0xe9, 0xb3, 0xde, 0x7c, 0x04, 0xf2, 0x40, 0x92, 0xd0, 0xbc, 0x89, 0xe6, 0x82, 0x44, 0xe0, 0xfe,
+	0x64, 0xdf, 0x55, 0xf4, 0xcf, 0x16, 0x3b, 0xcf, 0x4e, 0x46, 0xb6, 0x71, 0x3a, 0xb2, 0x8d, 0x1f,
+	0x23, 0xdb, 0xf8, 0x34, 0xb6, 0x6b, 0xa7, 0x63, 0xbb, 0xf6, 0x75, 0x6c, 0xd7, 0xde, 0xdb, 0x7f,
+	0xcd, 0x9c, 0xff, 0xf1, 0xe4, 0xf4, 0xc3, 0x09, 0xe6, 0xf4, 0x5f, 0xff, 0xf8, 0xf7, 0x00, 0xb7,
+	0x57, 0xbd, 0xa1, 0x93, 0x03, 0x00, 0x00,
 }
 
 func (m *CometInfo) Marshal() (dAtA []byte, err error) {
@@ -185,6 +349,107 @@ func (m *CometInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasLimitControllerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasLimitControllerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasLimitControllerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConsensus(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TargetUtilization.Size()
+		i -= size
+		if _, err := m.TargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConsensus(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxMaxGas != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.MaxMaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinMaxGas != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.MinMaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasLimitControllerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasLimitControllerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasLimitControllerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConsensus(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConsensus(v)
 	base := offset
@@ -223,6 +488,46 @@ func (m *CometInfo) Size() (n int) {
 	return n
 }
 
+func (m *GasLimitControllerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MinMaxGas != 0 {
+		n += 1 + sovConsensus(uint64(m.MinMaxGas))
+	}
+	if m.MaxMaxGas != 0 {
+		n += 1 + sovConsensus(uint64(m.MaxMaxGas))
+	}
+	l = m.TargetUtilization.Size()
+	n += 1 + l + sovConsensus(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovConsensus(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovConsensus(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *GasLimitControllerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovConsensus(uint64(m.GasUsed))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovConsensus(uint64(m.Blocks))
+	}
+	return n
+}
+
 func sovConsensus(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -417,6 +722,289 @@ func (m *CometInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GasLimitControllerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasLimitControllerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasLimitControllerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMaxGas", wireType)
+			}
+			m.MinMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaxGas", wireType)
+			}
+			m.MaxMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasLimitControllerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasLimitControllerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasLimitControllerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipConsensus(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
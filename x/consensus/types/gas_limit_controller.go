@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultGasLimitControllerParams returns the default parameters of the block
+// gas limit controller. The controller is disabled by default.
+func DefaultGasLimitControllerParams() GasLimitControllerParams {
+	return GasLimitControllerParams{
+		Enabled:           false,
+		MinMaxGas:         10_000_000,
+		MaxMaxGas:         100_000_000,
+		TargetUtilization: math.LegacyNewDecWithPrec(5, 1),
+		MaxChangeRate:     math.LegacyNewDecWithPrec(125, 3),
+		EpochLength:       100,
+	}
+}
+
+// Validate performs a basic validation of the block gas limit controller
+// parameters.
+func (p GasLimitControllerParams) Validate() error {
+	if p.MinMaxGas <= 0 {
+		return errors.New("min max gas must be positive")
+	}
+	if p.MaxMaxGas < p.MinMaxGas {
+		return errors.New("max max gas must be greater than or equal to min max gas")
+	}
+	if p.TargetUtilization.IsNil() || !p.TargetUtilization.IsPositive() || p.TargetUtilization.GT(math.LegacyOneDec()) {
+		return errors.New("target utilization must be greater than 0 and at most 1")
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GTE(math.LegacyOneDec()) {
+		return errors.New("max change rate must be greater than 0 and less than 1")
+	}
+	if p.EpochLength <= 0 {
+		return errors.New("epoch length must be positive")
+	}
+
+	return nil
+}
+
+// NextMaxGas returns the block max gas of the next epoch, given the current
+// block max gas and the average gas used per block during the epoch.
+// Like the EIP-1559 base fee, the max gas changes proportionally to the
+// deviation of the utilization from the target, by at most MaxChangeRate, and
+// stays within [MinMaxGas, MaxMaxGas]. A non-positive current max gas, meaning
+// no limit, is treated as MaxMaxGas.
+func (p GasLimitControllerParams) NextMaxGas(maxGas int64, avgGasUsed uint64) int64 {
+	if maxGas <= 0 {
+		maxGas = p.MaxMaxGas
+	}
+
+	current := math.LegacyNewDec(maxGas)
+	target := current.Mul(p.TargetUtilization)
+	change := math.LegacyNewDecFromInt(math.NewIntFromUint64(avgGasUsed)).Sub(target).Quo(target).Mul(p.MaxChangeRate)
+	change = math.LegacyMinDec(math.LegacyMaxDec(change, p.MaxChangeRate.Neg()), p.MaxChangeRate)
+
+	next := current.Mul(math.LegacyOneDec().Add(change)).TruncateInt64()
+	switch {
+	case next < p.MinMaxGas:
+		return p.MinMaxGas
+	case next > p.MaxMaxGas:
+		return p.MaxMaxGas
+	default:
+		return next
+	}
+}
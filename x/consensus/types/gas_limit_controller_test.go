@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/consensus/types"
+)
+
+func TestGasLimitControllerParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*types.GasLimitControllerParams)
+		errMsg   string
+	}{
+		{"default", func(*types.GasLimitControllerParams) {}, ""},
+		{"zero min max gas", func(p *types.GasLimitControllerParams) { p.MinMaxGas = 0 }, "min max gas must be positive"},
+		{"max below min", func(p *types.GasLimitControllerParams) { p.MaxMaxGas = p.MinMaxGas - 1 }, "max max gas must be greater than or equal to min max gas"},
+		{"nil target", func(p *types.GasLimitControllerParams) { p.TargetUtilization = math.LegacyDec{} }, "target utilization"},
+		{"zero target", func(p *types.GasLimitControllerParams) { p.TargetUtilization = math.LegacyZeroDec() }, "target utilization"},
+		{"target above one", func(p *types.GasLimitControllerParams) { p.TargetUtilization = math.LegacyNewDec(2) }, "target utilization"},
+		{"zero change rate", func(p *types.GasLimitControllerParams) { p.MaxChangeRate = math.LegacyZeroDec() }, "max change rate"},
+		{"change rate of one", func(p *types.GasLimitControllerParams) { p.MaxChangeRate = math.LegacyOneDec() }, "max change rate"},
+		{"zero epoch length", func(p *types.GasLimitControllerParams) { p.EpochLength = 0 }, "epoch length must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultGasLimitControllerParams()
+			tc.malleate(&params)
+			err := params.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestGasLimitControllerParamsNextMaxGas(t *testing.T) {
+	params := types.GasLimitControllerParams{
+		MinMaxGas:         500,
+		MaxMaxGas:         2000,
+		TargetUtilization: math.LegacyNewDecWithPrec(5, 1),
+		MaxChangeRate:     math.LegacyNewDecWithPrec(125, 3),
+		EpochLength:       1,
+	}
+
+	testCases := []struct {
+		name       string
+		maxGas     int64
+		avgGasUsed uint64
+		expMaxGas  int64
+	}{
+		{"at target", 1000, 500, 1000},
+		{"full", 1000, 1000, 1125},
+		{"empty", 1000, 0, 875},
+		{"above target", 1000, 750, 1062},
+		{"below target", 1000, 250, 937},
+		{"over the limit is capped", 1000, 5000, 1125},
+		{"bounded by min", 520, 0, 500},
+		{"bounded by max", 1900, 1900, 2000},
+		{"unlimited starts from max", -1, 0, 1750},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMaxGas, params.NextMaxGas(tc.maxGas, tc.avgGasUsed))
+		})
+	}
+}
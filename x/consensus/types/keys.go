@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the name of the x/consensus module.
 	ModuleName = "consensus"
@@ -7,3 +9,10 @@ const (
 	// StoreKey defines the module's store key.
 	StoreKey = ModuleName
 )
+
+var (
+	// GasLimitControllerParamsKey is the key of the block gas limit controller parameters.
+	GasLimitControllerParamsKey = collections.NewPrefix(1)
+	// GasLimitControllerStateKey is the key of the block gas limit controller state.
+	GasLimitControllerStateKey = collections.NewPrefix(2)
+)
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	_ "github.com/cosmos/cosmos-proto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -114,33 +115,138 @@ func (m *QueryParamsResponse) GetParams() *v1.ConsensusParams {
 	return nil
 }
 
+// QueryGasLimitControllerRequest defines the request type for querying the
+// block gas limit controller.
+type QueryGasLimitControllerRequest struct {
+}
+
+func (m *QueryGasLimitControllerRequest) Reset()         { *m = QueryGasLimitControllerRequest{} }
+func (m *QueryGasLimitControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasLimitControllerRequest) ProtoMessage()    {}
+func (*QueryGasLimitControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{2}
+}
+func (m *QueryGasLimitControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasLimitControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasLimitControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasLimitControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasLimitControllerRequest.Merge(m, src)
+}
+func (m *QueryGasLimitControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasLimitControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasLimitControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasLimitControllerRequest proto.InternalMessageInfo
+
+// QueryGasLimitControllerResponse defines the response type for querying the
+// block gas limit controller.
+type QueryGasLimitControllerResponse struct {
+	// params are the parameters of the block gas limit controller.
+	Params *GasLimitControllerParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// state is the gas usage tracked during the current epoch.
+	State *GasLimitControllerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *QueryGasLimitControllerResponse) Reset()         { *m = QueryGasLimitControllerResponse{} }
+func (m *QueryGasLimitControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasLimitControllerResponse) ProtoMessage()    {}
+func (*QueryGasLimitControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{3}
+}
+func (m *QueryGasLimitControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasLimitControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasLimitControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasLimitControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasLimitControllerResponse.Merge(m, src)
+}
+func (m *QueryGasLimitControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasLimitControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasLimitControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasLimitControllerResponse proto.InternalMessageInfo
+
+func (m *QueryGasLimitControllerResponse) GetParams() *GasLimitControllerParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *QueryGasLimitControllerResponse) GetState() *GasLimitControllerState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.consensus.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.consensus.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGasLimitControllerRequest)(nil), "cosmos.consensus.v1.QueryGasLimitControllerRequest")
+	proto.RegisterType((*QueryGasLimitControllerResponse)(nil), "cosmos.consensus.v1.QueryGasLimitControllerResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/query.proto", fileDescriptor_bf54d1e5df04cee9) }
 
 var fileDescriptor_bf54d1e5df04cee9 = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c,
-	0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x68, 0x91,
-	0x92, 0x4b, 0xce, 0xcf, 0x4d, 0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0xa9, 0x2c, 0x48, 0x05, 0x9b,
-	0x58, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x93, 0x57, 0xc6, 0x66, 0x27, 0xc2, 0x7c, 0xb0, 0x22, 0x25,
-	0x11, 0x2e, 0xa1, 0x40, 0x90, 0x33, 0x02, 0xc0, 0x3a, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b,
-	0x94, 0x02, 0xb9, 0x84, 0x51, 0x44, 0x8b, 0x0b, 0x40, 0xda, 0x84, 0xac, 0xb8, 0xd8, 0x20, 0x36,
-	0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x29, 0xe9, 0xc1, 0x9c, 0xa0, 0x07, 0x76, 0x82, 0x5e,
-	0x99, 0xa1, 0x9e, 0x33, 0xcc, 0x02, 0xa8, 0x5e, 0xa8, 0x0e, 0xa3, 0x2e, 0x46, 0x2e, 0x56, 0xb0,
-	0x99, 0x42, 0x0d, 0x8c, 0x5c, 0x6c, 0x10, 0x49, 0x21, 0x75, 0x3d, 0x2c, 0xde, 0xd6, 0xc3, 0x74,
-	0x90, 0x94, 0x06, 0x61, 0x85, 0x10, 0x37, 0x2a, 0x29, 0x37, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x56,
-	0x48, 0x5a, 0x1f, 0x9b, 0xf7, 0x21, 0x8e, 0x71, 0xb2, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0x39, 0x88, 0xae, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x24,
-	0xdd, 0x60, 0x1f, 0x26, 0xb1, 0x81, 0x83, 0xcd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xb9,
-	0xf1, 0x10, 0xd1, 0x01, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x8e, 0x12, 0x31,
+	0x18, 0xc7, 0x29, 0x09, 0x1c, 0xea, 0xc1, 0xa4, 0x78, 0xc0, 0x51, 0x0b, 0x19, 0x0e, 0x62, 0x94,
+	0x56, 0x40, 0x12, 0xc3, 0x11, 0x34, 0x5e, 0x3c, 0x08, 0xde, 0xbc, 0x90, 0x32, 0x54, 0x32, 0x61,
+	0x66, 0x3a, 0x4c, 0x0b, 0x91, 0x9b, 0xf1, 0x09, 0x4c, 0x7c, 0x15, 0x5f, 0xc0, 0x83, 0x89, 0xf1,
+	0x44, 0xf0, 0xe2, 0x71, 0x03, 0xfb, 0x20, 0x9b, 0x69, 0x07, 0x76, 0xd9, 0x19, 0xb2, 0xbb, 0xc7,
+	0xce, 0x7c, 0xff, 0xdf, 0xf7, 0xfb, 0xda, 0x0f, 0x56, 0x1c, 0x21, 0x7d, 0x21, 0xa9, 0x23, 0x02,
+	0xc9, 0x03, 0xb9, 0x90, 0x74, 0xd9, 0xa4, 0xf3, 0x05, 0x8f, 0x56, 0x24, 0x8c, 0x84, 0x12, 0xa8,
+	0x64, 0x0a, 0xc8, 0xa1, 0x80, 0x2c, 0x9b, 0xd6, 0xe3, 0xa9, 0x10, 0x53, 0x8f, 0x53, 0x16, 0xba,
+	0x94, 0x05, 0x81, 0x50, 0x4c, 0xb9, 0x22, 0x90, 0x26, 0x62, 0x61, 0x47, 0xf8, 0x5c, 0x8d, 0x3f,
+	0x2b, 0xaa, 0x56, 0x21, 0xd7, 0xc4, 0x90, 0x45, 0xcc, 0xdf, 0xff, 0xaf, 0x65, 0xf5, 0xbc, 0xe4,
+	0x9b, 0xa2, 0x87, 0xa6, 0x68, 0xa4, 0x4f, 0x34, 0x91, 0xd0, 0x07, 0xfb, 0x01, 0x44, 0x83, 0xd8,
+	0xf0, 0x83, 0x86, 0x0e, 0xf9, 0x7c, 0xc1, 0xa5, 0xb2, 0x07, 0xb0, 0x74, 0xf4, 0x55, 0x86, 0x31,
+	0x11, 0x75, 0x61, 0xd1, 0x34, 0x2f, 0x83, 0x2a, 0xa8, 0xdf, 0x6b, 0xd9, 0x64, 0x6f, 0x47, 0xb4,
+	0x1d, 0x59, 0x36, 0x49, 0x7f, 0xdf, 0x3b, 0xc9, 0x26, 0x09, 0xbb, 0x03, 0xb1, 0x46, 0xbe, 0x63,
+	0xf2, 0xbd, 0xeb, 0xbb, 0xaa, 0x2f, 0x02, 0x15, 0x09, 0xcf, 0xe3, 0x51, 0xd2, 0xb4, 0x5b, 0xda,
+	0xfc, 0x6c, 0xdc, 0x37, 0x72, 0x0d, 0x39, 0x99, 0x55, 0x5f, 0x92, 0x4e, 0xcb, 0xfe, 0x05, 0x60,
+	0xe5, 0x64, 0x2e, 0xd1, 0x7a, 0x7b, 0x4d, 0xab, 0x41, 0x32, 0xee, 0x99, 0xa4, 0x01, 0xc7, 0x86,
+	0xa8, 0x07, 0x0b, 0x52, 0x31, 0xc5, 0xcb, 0x79, 0x4d, 0x79, 0x71, 0x4b, 0xca, 0xc7, 0x38, 0x33,
+	0x34, 0xd1, 0xcc, 0x19, 0x5a, 0x9b, 0x3c, 0x2c, 0xe8, 0x19, 0xd0, 0x57, 0x00, 0x8b, 0xa6, 0x2b,
+	0x7a, 0x9a, 0x89, 0x4f, 0xbf, 0x85, 0x55, 0xbf, 0xb9, 0xd0, 0xdc, 0x83, 0x5d, 0xfb, 0xf6, 0xef,
+	0xfc, 0x47, 0xfe, 0x09, 0x7a, 0x44, 0xb3, 0x96, 0x22, 0x99, 0xf2, 0x37, 0x80, 0x28, 0x3d, 0x04,
+	0x6a, 0x9f, 0xee, 0x72, 0xf2, 0xc5, 0xac, 0x57, 0x77, 0x0b, 0x25, 0x9a, 0x6f, 0xfe, 0xa6, 0xef,
+	0x48, 0x9b, 0x3f, 0x47, 0xcf, 0x32, 0xcd, 0xa7, 0x4c, 0x8e, 0xbc, 0x18, 0x36, 0x72, 0x0e, 0xb4,
+	0xde, 0xeb, 0x3f, 0x5b, 0x0c, 0xd6, 0x5b, 0x0c, 0xce, 0xb6, 0x18, 0x7c, 0xdf, 0xe1, 0xdc, 0x7a,
+	0x87, 0x73, 0xff, 0x77, 0x38, 0xf7, 0x09, 0x1b, 0x86, 0x9c, 0xcc, 0x88, 0x2b, 0xe8, 0x97, 0x2b,
+	0x2c, 0xbd, 0xa4, 0xe3, 0xa2, 0xde, 0xfc, 0xf6, 0xc5, 0x00, 0xbf, 0x6d, 0x26, 0xe3, 0xaf, 0x03,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +263,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GasLimitController queries the parameters and the current epoch state of
+	// the block gas limit controller.
+	GasLimitController(ctx context.Context, in *QueryGasLimitControllerRequest, opts ...grpc.CallOption) (*QueryGasLimitControllerResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +285,22 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GasLimitController(ctx context.Context, in *QueryGasLimitControllerRequest, opts ...grpc.CallOption) (*QueryGasLimitControllerResponse, error) {
+	out := new(QueryGasLimitControllerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Query/GasLimitController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GasLimitController queries the parameters and the current epoch state of
+	// the block gas limit controller.
+	GasLimitController(context.Context, *QueryGasLimitControllerRequest) (*QueryGasLimitControllerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +310,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) GasLimitController(ctx context.Context, req *QueryGasLimitControllerRequest) (*QueryGasLimitControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasLimitController not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +336,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasLimitController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasLimitControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasLimitController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Query/GasLimitController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasLimitController(ctx, req.(*QueryGasLimitControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +362,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "GasLimitController",
+			Handler:    _Query_GasLimitController_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -283,6 +429,76 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGasLimitControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasLimitControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasLimitControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasLimitControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasLimitControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasLimitControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -316,6 +532,32 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasLimitControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasLimitControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +700,178 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasLimitControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasLimitControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasLimitControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasLimitControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasLimitControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasLimitControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &GasLimitControllerParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &GasLimitControllerState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -171,47 +172,156 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateGasLimitControllerParams is the Msg/UpdateGasLimitControllerParams
+// request type.
+type MsgUpdateGasLimitControllerParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the block gas limit controller parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params GasLimitControllerParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateGasLimitControllerParams) Reset()         { *m = MsgUpdateGasLimitControllerParams{} }
+func (m *MsgUpdateGasLimitControllerParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasLimitControllerParams) ProtoMessage()    {}
+func (*MsgUpdateGasLimitControllerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{2}
+}
+func (m *MsgUpdateGasLimitControllerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasLimitControllerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasLimitControllerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasLimitControllerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasLimitControllerParams.Merge(m, src)
+}
+func (m *MsgUpdateGasLimitControllerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasLimitControllerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasLimitControllerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasLimitControllerParams proto.InternalMessageInfo
+
+func (m *MsgUpdateGasLimitControllerParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateGasLimitControllerParams) GetParams() GasLimitControllerParams {
+	if m != nil {
+		return m.Params
+	}
+	return GasLimitControllerParams{}
+}
+
+// MsgUpdateGasLimitControllerParamsResponse defines the response structure for
+// executing a MsgUpdateGasLimitControllerParams message.
+type MsgUpdateGasLimitControllerParamsResponse struct {
+}
+
+func (m *MsgUpdateGasLimitControllerParamsResponse) Reset() {
+	*m = MsgUpdateGasLimitControllerParamsResponse{}
+}
+func (m *MsgUpdateGasLimitControllerParamsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGasLimitControllerParamsResponse) ProtoMessage() {}
+func (*MsgUpdateGasLimitControllerParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{3}
+}
+func (m *MsgUpdateGasLimitControllerParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasLimitControllerParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasLimitControllerParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasLimitControllerParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasLimitControllerParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateGasLimitControllerParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasLimitControllerParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasLimitControllerParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasLimitControllerParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.consensus.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateGasLimitControllerParams)(nil), "cosmos.consensus.v1.MsgUpdateGasLimitControllerParams")
+	proto.RegisterType((*MsgUpdateGasLimitControllerParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateGasLimitControllerParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/tx.proto", fileDescriptor_2135c60575ab504d) }
 
 var fileDescriptor_2135c60575ab504d = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0xb3, 0x36, 0x69, 0xcd, 0x28, 0x14, 0x27, 0x4a, 0xa7, 0xa1, 0x0e, 0x31, 0x88, 0x94,
-	0x60, 0x66, 0x9b, 0x58, 0xff, 0x82, 0x60, 0x23, 0x8a, 0x1e, 0x8a, 0xb2, 0xa5, 0x1e, 0xbc, 0xc8,
-	0x64, 0x77, 0xba, 0x5d, 0xd2, 0xdd, 0x59, 0x76, 0x26, 0x6b, 0x73, 0x13, 0xc1, 0x8b, 0x27, 0xbf,
-	0x88, 0x90, 0x43, 0x3f, 0x84, 0x78, 0x2a, 0x9e, 0xc4, 0x93, 0x24, 0x87, 0x7c, 0x0d, 0xd9, 0x99,
-	0xdd, 0xac, 0x8d, 0x5b, 0xf0, 0x12, 0xc8, 0x3e, 0xcf, 0xef, 0x79, 0x66, 0xde, 0x7d, 0x17, 0x6c,
-	0xd8, 0x5c, 0xf8, 0x5c, 0x98, 0x36, 0x0f, 0x04, 0x0b, 0xc4, 0x50, 0x98, 0x71, 0xc7, 0x94, 0xc7,
-	0x24, 0x8c, 0xb8, 0xe4, 0xb0, 0xa6, 0x55, 0x32, 0x57, 0x49, 0xdc, 0xa9, 0x5f, 0xa1, 0xbe, 0x17,
-	0x70, 0x53, 0xfd, 0x6a, 0x5f, 0x7d, 0x5d, 0xfb, 0xde, 0xa9, 0x7f, 0x66, 0x0a, 0x69, 0x69, 0x2d,
-	0x2d, 0xf0, 0x85, 0x9b, 0x44, 0xfb, 0xc2, 0x4d, 0x05, 0x6c, 0x73, 0x9f, 0xc9, 0xfe, 0x81, 0x34,
-	0xe5, 0x28, 0x64, 0xaa, 0x37, 0xa4, 0x11, 0xf5, 0x33, 0x70, 0x63, 0xae, 0xd3, 0xbe, 0xed, 0xa9,
-	0x63, 0x25, 0x3e, 0xad, 0x36, 0xbf, 0x96, 0xc1, 0xea, 0xae, 0x70, 0xf7, 0x43, 0x87, 0x4a, 0xf6,
-	0x5a, 0x71, 0xf0, 0x1e, 0xa8, 0xd2, 0xa1, 0x3c, 0xe4, 0x91, 0x27, 0x47, 0xc8, 0x68, 0x18, 0x9b,
-	0xd5, 0x1e, 0xfa, 0x71, 0xd2, 0xbe, 0x9a, 0x9e, 0x67, 0xc7, 0x71, 0x22, 0x26, 0xc4, 0x9e, 0x8c,
-	0xbc, 0xc0, 0xb5, 0x72, 0x2b, 0xdc, 0x06, 0x95, 0xfe, 0x11, 0xb7, 0x07, 0xe8, 0x42, 0xc3, 0xd8,
-	0xbc, 0xd4, 0xc5, 0x24, 0x6b, 0x26, 0xba, 0x31, 0xee, 0x90, 0x5e, 0xa2, 0xeb, 0x1a, 0x4b, 0x9b,
-	0xe1, 0x63, 0x70, 0x91, 0xc5, 0x9e, 0xc3, 0x02, 0x9b, 0xa1, 0x25, 0x05, 0xde, 0x28, 0x00, 0x9f,
-	0xa5, 0x96, 0x94, 0x9d, 0x23, 0xf0, 0x09, 0xa8, 0xc6, 0xf4, 0xc8, 0x73, 0xa8, 0xe4, 0x11, 0x2a,
-	0x2b, 0xbe, 0x59, 0xc0, 0xbf, 0xc9, 0x3c, 0x69, 0x40, 0x0e, 0xc1, 0x17, 0xa0, 0x9c, 0x4c, 0x06,
-	0x55, 0x14, 0x7c, 0xbd, 0x00, 0xde, 0xe9, 0x3d, 0x7d, 0xa9, 0xb9, 0xde, 0xb5, 0x5f, 0x27, 0xed,
-	0x55, 0x3d, 0x88, 0xb6, 0x70, 0x06, 0x8d, 0x2d, 0x72, 0x77, 0x0b, 0x19, 0x96, 0x4a, 0x80, 0xfb,
-	0xa0, 0x2a, 0x46, 0x81, 0x7d, 0x18, 0xf1, 0x60, 0x84, 0x96, 0xcf, 0x3d, 0xcb, 0x5e, 0xe6, 0x49,
-	0x33, 0x6b, 0xff, 0x66, 0x76, 0xac, 0x3c, 0x09, 0xbe, 0x02, 0x2b, 0x07, 0x8c, 0xca, 0x61, 0xc4,
-	0xd0, 0x8a, 0x0a, 0x6d, 0x14, 0x84, 0x3e, 0xd7, 0x8e, 0xf3, 0x23, 0xbb, 0x56, 0x96, 0xf2, 0xe8,
-	0xe1, 0xc7, 0xd9, 0xb8, 0x95, 0xbf, 0xb8, 0xcf, 0xb3, 0x71, 0xeb, 0x56, 0x6e, 0x36, 0x8f, 0xff,
-	0xda, 0xe2, 0x85, 0xdd, 0x68, 0xae, 0x83, 0xb5, 0x85, 0x47, 0x16, 0x13, 0x61, 0x62, 0xef, 0x7e,
-	0x32, 0xc0, 0xd2, 0xae, 0x70, 0xe1, 0x7b, 0x70, 0xf9, 0xcc, 0x3a, 0xdd, 0x24, 0x05, 0xdb, 0x4f,
-	0x16, 0x52, 0xea, 0xb7, 0xff, 0xc7, 0x95, 0x75, 0x35, 0x6b, 0xdf, 0x17, 0xef, 0xb7, 0x7d, 0xbf,
-	0x5e, 0xf9, 0x30, 0x1b, 0xb7, 0x8c, 0xde, 0x83, 0x6f, 0x13, 0x6c, 0x9c, 0x4e, 0xb0, 0xf1, 0x7b,
-	0x82, 0x8d, 0x2f, 0x53, 0x5c, 0x3a, 0x9d, 0xe2, 0xd2, 0xcf, 0x29, 0x2e, 0xbd, 0xc5, 0x9a, 0x10,
-	0xce, 0x80, 0x78, 0xfc, 0xcc, 0x35, 0xd5, 0x18, 0xfb, 0xcb, 0xea, 0x9b, 0xb8, 0xf3, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x6c, 0x14, 0x46, 0x20, 0xcd, 0x03, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0xd3, 0x50,
+	0x1c, 0x5f, 0x81, 0x0d, 0xf7, 0x34, 0x21, 0x76, 0x18, 0xca, 0x82, 0x75, 0x4c, 0x63, 0x70, 0x71,
+	0x2d, 0x9b, 0xe0, 0x8f, 0x25, 0x1a, 0x28, 0xf1, 0x57, 0x02, 0x91, 0x94, 0xe0, 0xc1, 0x8b, 0xe9,
+	0xda, 0x47, 0x69, 0xb6, 0xf6, 0x2d, 0x7d, 0x6f, 0x93, 0xdd, 0x8c, 0x47, 0x4f, 0xfe, 0x19, 0x5e,
+	0x48, 0x76, 0xc0, 0xff, 0x81, 0x78, 0x22, 0x9c, 0x8c, 0x07, 0x63, 0xb6, 0xc3, 0xfe, 0x08, 0x2f,
+	0xa6, 0xef, 0xbd, 0xae, 0x32, 0xba, 0x61, 0xf4, 0xb2, 0x6c, 0xef, 0xfb, 0xf9, 0xf1, 0xfd, 0x39,
+	0xb0, 0x60, 0x22, 0xec, 0x22, 0xac, 0x9a, 0xc8, 0xc3, 0xd0, 0xc3, 0x4d, 0xac, 0xb6, 0x4a, 0x2a,
+	0x39, 0x50, 0x1a, 0x3e, 0x22, 0x48, 0xcc, 0xb0, 0xa8, 0x32, 0x88, 0x2a, 0xad, 0x52, 0xf6, 0xaa,
+	0xe1, 0x3a, 0x1e, 0x52, 0xe9, 0x27, 0xc3, 0x65, 0xe7, 0x19, 0xee, 0x2d, 0xfd, 0xa5, 0x72, 0x12,
+	0x0b, 0xcd, 0x71, 0x03, 0x17, 0xdb, 0x81, 0xb4, 0x8b, 0x6d, 0x1e, 0x90, 0x4d, 0xe4, 0x42, 0x52,
+	0xdd, 0x23, 0x2a, 0x69, 0x37, 0x20, 0xf5, 0x6d, 0x18, 0xbe, 0xe1, 0x86, 0xc4, 0x85, 0x41, 0xdc,
+	0xa8, 0x9a, 0x0e, 0x4d, 0x2b, 0xc0, 0xf1, 0xe8, 0xac, 0x8d, 0x6c, 0xc4, 0xec, 0x82, 0x6f, 0xfc,
+	0xf5, 0x66, 0x5c, 0x35, 0x51, 0xf2, 0x14, 0x94, 0x3f, 0x9c, 0x02, 0x33, 0x5b, 0xd8, 0xde, 0x6d,
+	0x58, 0x06, 0x81, 0xdb, 0xd4, 0x52, 0xbc, 0x0f, 0xd2, 0x46, 0x93, 0xec, 0x23, 0xdf, 0x21, 0x6d,
+	0x49, 0xc8, 0x09, 0x4b, 0x69, 0x4d, 0x3a, 0x3d, 0x2a, 0xce, 0xf2, 0x52, 0xd6, 0x2d, 0xcb, 0x87,
+	0x18, 0xef, 0x10, 0xdf, 0xf1, 0x6c, 0x3d, 0x82, 0x8a, 0x2b, 0x20, 0x59, 0xad, 0x23, 0xb3, 0x26,
+	0x4d, 0xe4, 0x84, 0xa5, 0xcb, 0x65, 0x59, 0x09, 0x93, 0x56, 0x58, 0xb2, 0xad, 0x92, 0xa2, 0x05,
+	0x71, 0x66, 0xa3, 0x33, 0xb0, 0xf8, 0x18, 0x5c, 0x82, 0x2d, 0xc7, 0x82, 0x9e, 0x09, 0xa5, 0x49,
+	0x4a, 0x5c, 0x8c, 0x21, 0x3e, 0xe5, 0x10, 0xce, 0x1d, 0x50, 0xc4, 0x35, 0x90, 0x6e, 0x19, 0x75,
+	0xc7, 0x32, 0x08, 0xf2, 0xa5, 0x29, 0xca, 0xcf, 0xc7, 0xf0, 0x5f, 0x87, 0x18, 0x2e, 0x10, 0x91,
+	0xc4, 0x17, 0x60, 0x2a, 0x68, 0xaa, 0x94, 0xa4, 0xe4, 0xeb, 0x31, 0xe4, 0x75, 0x6d, 0xe3, 0x25,
+	0xe3, 0x69, 0xd7, 0xbe, 0x1f, 0x15, 0x67, 0x58, 0x23, 0x8a, 0xd8, 0xaa, 0xe5, 0x96, 0x95, 0xd5,
+	0x65, 0x49, 0xd0, 0xa9, 0x82, 0xb8, 0x0b, 0xd2, 0xb8, 0xed, 0x99, 0xfb, 0x3e, 0xf2, 0xda, 0x52,
+	0x6a, 0x64, 0x2e, 0x3b, 0x21, 0x86, 0x6b, 0x66, 0xce, 0x6b, 0x96, 0xf4, 0x48, 0x49, 0x7c, 0x05,
+	0xa6, 0xf7, 0xa0, 0x41, 0x9a, 0x3e, 0x94, 0xa6, 0xa9, 0x68, 0x2e, 0x46, 0xf4, 0x19, 0x43, 0x8c,
+	0x96, 0x2c, 0xeb, 0xa1, 0x4a, 0xe5, 0xd1, 0x87, 0x7e, 0xa7, 0x10, 0x0d, 0xee, 0x63, 0xbf, 0x53,
+	0xb8, 0x1d, 0x81, 0xd5, 0x83, 0x3f, 0x56, 0x66, 0x68, 0x37, 0xf2, 0xf3, 0x60, 0x6e, 0xe8, 0x49,
+	0x87, 0xb8, 0x11, 0xc0, 0xf3, 0xbf, 0x04, 0xb0, 0x38, 0x88, 0x3d, 0x37, 0xf0, 0xa6, 0xe3, 0x3a,
+	0x64, 0x03, 0x79, 0xc4, 0x47, 0xf5, 0x3a, 0xf4, 0xff, 0x73, 0xb9, 0xb6, 0x41, 0x8a, 0x5d, 0x04,
+	0xdf, 0xae, 0xa2, 0x12, 0x73, 0x8e, 0xca, 0x28, 0x5b, 0x2d, 0x7d, 0xfc, 0xe3, 0x46, 0xe2, 0x73,
+	0xbf, 0x53, 0x10, 0x74, 0xae, 0x53, 0xd9, 0x3c, 0x3d, 0xdf, 0xa3, 0xf1, 0x8d, 0x19, 0x53, 0x5d,
+	0x7e, 0x0d, 0xdc, 0xb9, 0xb0, 0xf8, 0xb0, 0x55, 0x95, 0x4c, 0x8c, 0x75, 0xf9, 0xcb, 0x04, 0x98,
+	0xdc, 0xc2, 0xb6, 0xf8, 0x0e, 0x5c, 0x39, 0x73, 0x8e, 0xb7, 0x62, 0x2b, 0x1d, 0x9a, 0x42, 0xf6,
+	0xee, 0xdf, 0xa0, 0x06, 0xb3, 0xca, 0x7c, 0x1d, 0x4e, 0x60, 0xe5, 0x81, 0x78, 0x28, 0x00, 0xf9,
+	0xa2, 0xe9, 0x8d, 0x77, 0x19, 0xc5, 0xcb, 0x3e, 0xf9, 0x37, 0xde, 0x98, 0x7c, 0x57, 0xcb, 0xd9,
+	0xe4, 0xfb, 0x60, 0x9e, 0xda, 0xc3, 0xe3, 0xae, 0x2c, 0x9c, 0x74, 0x65, 0xe1, 0x67, 0x57, 0x16,
+	0x3e, 0xf5, 0xe4, 0xc4, 0x49, 0x4f, 0x4e, 0x7c, 0xeb, 0xc9, 0x89, 0x37, 0x32, 0x63, 0x60, 0xab,
+	0xa6, 0x38, 0xe8, 0xcc, 0x5a, 0xd3, 0xb3, 0xa9, 0xa6, 0xe8, 0x7f, 0xe0, 0xbd, 0xdf, 0x03, 0x00,
+	0xf3, 0x78, 0xa2, 0xf6, 0xf8, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -229,6 +339,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateGasLimitControllerParams defines a governance operation for updating
+	// the parameters of the block gas limit controller.
+	// The authority is defined in the keeper.
+	UpdateGasLimitControllerParams(ctx context.Context, in *MsgUpdateGasLimitControllerParams, opts ...grpc.CallOption) (*MsgUpdateGasLimitControllerParamsResponse, error)
 }
 
 type msgClient struct {
@@ -248,11 +362,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateGasLimitControllerParams(ctx context.Context, in *MsgUpdateGasLimitControllerParams, opts ...grpc.CallOption) (*MsgUpdateGasLimitControllerParamsResponse, error) {
+	out := new(MsgUpdateGasLimitControllerParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/UpdateGasLimitControllerParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateGasLimitControllerParams defines a governance operation for updating
+	// the parameters of the block gas limit controller.
+	// The authority is defined in the keeper.
+	UpdateGasLimitControllerParams(context.Context, *MsgUpdateGasLimitControllerParams) (*MsgUpdateGasLimitControllerParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -262,6 +389,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGasLimitControllerParams(ctx context.Context, req *MsgUpdateGasLimitControllerParams) (*MsgUpdateGasLimitControllerParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasLimitControllerParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -285,6 +415,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGasLimitControllerParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasLimitControllerParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasLimitControllerParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/UpdateGasLimitControllerParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasLimitControllerParams(ctx, req.(*MsgUpdateGasLimitControllerParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -293,6 +441,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateGasLimitControllerParams",
+			Handler:    _Msg_UpdateGasLimitControllerParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -423,6 +575,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGasLimitControllerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasLimitControllerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasLimitControllerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGasLimitControllerParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasLimitControllerParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasLimitControllerParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -480,6 +695,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGasLimitControllerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGasLimitControllerParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -834,6 +1073,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGasLimitControllerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasLimitControllerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasLimitControllerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGasLimitControllerParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasLimitControllerParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasLimitControllerParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
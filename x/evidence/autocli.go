@@ -30,5 +30,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 			},
 		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: evidencev1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SubmitEvidence",
+					Use:            "submit-evidence [evidence]",
+					Short:          "Submit evidence of misbehavior",
+					Long:           "Submit evidence of misbehavior, given as JSON or as a path to a JSON file. The evidence type is set by its @type field and must have a handler registered in the evidence module.",
+					Example:        fmt.Sprintf("%s tx evidence submit-evidence evidence.json --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "evidence"}},
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
 	}
 }
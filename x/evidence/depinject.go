@@ -1,6 +1,9 @@
 package evidence
 
 import (
+	"slices"
+	"strings"
+
 	modulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -31,6 +34,7 @@ type ModuleInputs struct {
 	Environment      appmodule.Environment
 	Cdc              codec.Codec
 	EvidenceHandlers []eviclient.EvidenceHandler `optional:"true"`
+	HandlerRoutes    []types.HandlerRoute        `optional:"true"`
 	CometService     comet.Service
 
 	StakingKeeper  types.StakingKeeper
@@ -47,6 +51,18 @@ type ModuleOutputs struct {
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+
+	// Default route order is a lexical sort by Route.
+	slices.SortFunc(in.HandlerRoutes, func(x, y types.HandlerRoute) int {
+		return strings.Compare(x.Route, y.Route)
+	})
+
+	router := types.NewRouter()
+	for _, r := range in.HandlerRoutes {
+		router.AddRoute(r.Route, r.Handler)
+	}
+	k.SetRouter(router)
+
 	m := NewAppModule(in.Cdc, *k, in.CometService, in.EvidenceHandlers...)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -235,3 +236,73 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	suite.Error(err)
 	suite.Nil(handler)
 }
+
+// customEvidence is an Equivocation routed to its own handler.
+type customEvidence struct {
+	*types.Equivocation
+}
+
+func (customEvidence) Route() string { return "custom" }
+
+func (suite *KeeperTestSuite) TestSubmitEvidence_CustomHandler() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("evidence_transient_store"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	handled := make(map[string][]exported.Evidence)
+	newHandler := func(route string, err error) types.HandlerRoute {
+		return types.HandlerRoute{Route: route, Handler: func(_ context.Context, e exported.Evidence) error {
+			handled[route] = append(handled[route], e)
+			return err
+		}}
+	}
+
+	// the custom handlers are registered through depinject
+	out := evidence.ProvideModule(evidence.ModuleInputs{
+		Environment: runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger()),
+		Cdc:         suite.encCfg.Codec,
+		HandlerRoutes: []types.HandlerRoute{
+			newHandler(types.RouteEquivocation, nil),
+			newHandler("custom", errors.New("rejected by the custom handler")),
+		},
+		StakingKeeper:  suite.stakingKeeper,
+		SlashingKeeper: suite.slashingKeeper,
+		AddressCodec:   suite.addressCodec,
+	})
+	evidenceKeeper := out.EvidenceKeeper
+
+	consAddr, err := suite.consAddressCodec.BytesToString(ed25519.GenPrivKey().PubKey().Address())
+	suite.Require().NoError(err)
+	e := &types.Equivocation{
+		Height:           1,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: consAddr,
+	}
+
+	// the evidence is routed to the handler of its route, and stored once accepted
+	suite.Require().NoError(evidenceKeeper.SubmitEvidence(ctx, e))
+	suite.Equal([]exported.Evidence{e}, handled[types.RouteEquivocation])
+	res, err := evidenceKeeper.Evidences.Get(ctx, e.Hash())
+	suite.Require().NoError(err)
+	suite.Equal(e, res)
+
+	// the evidence rejected by its handler is not stored
+	custom := customEvidence{&types.Equivocation{
+		Height:           2,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: consAddr,
+	}}
+	err = evidenceKeeper.SubmitEvidence(ctx, custom)
+	suite.ErrorIs(err, types.ErrInvalidEvidence)
+	suite.ErrorContains(err, "rejected by the custom handler")
+	suite.Equal([]exported.Evidence{custom}, handled["custom"])
+	suite.Len(handled[types.RouteEquivocation], 1)
+	_, err = evidenceKeeper.Evidences.Get(ctx, custom.Hash())
+	suite.ErrorIs(err, collections.ErrNotFound)
+
+	// the evidence without a registered handler is rejected
+	_, err = evidenceKeeper.GetEvidenceHandler("unknown")
+	suite.ErrorIs(err, types.ErrNoEvidenceHandlerExists)
+}
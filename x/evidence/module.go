@@ -77,8 +77,14 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 	}
 }
 
-// GetTxCmd returns the evidence module's root tx command.
+// GetTxCmd returns the evidence module's root tx command, mounting the CLI
+// handlers of the custom evidence types. It returns nil when there are none, in
+// which case the tx commands are generated by autocli.
 func (am AppModule) GetTxCmd() *cobra.Command {
+	if len(am.evidenceHandlers) == 0 {
+		return nil
+	}
+
 	evidenceCLIHandlers := make([]*cobra.Command, len(am.evidenceHandlers))
 	for i, evidenceHandler := range am.evidenceHandlers {
 		evidenceCLIHandlers[i] = evidenceHandler.CLIHandler()
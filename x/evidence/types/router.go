@@ -26,12 +26,23 @@ type (
 		Sealed() bool
 	}
 
+	// HandlerRoute defines a Handler and the route of the Evidence type it
+	// handles. Modules defining custom Evidence types provide it through
+	// depinject to register their Handlers with the evidence module's Router.
+	HandlerRoute struct {
+		Route   string
+		Handler Handler
+	}
+
 	router struct {
 		routes map[string]Handler
 		sealed bool
 	}
 )
 
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
+
 func NewRouter() Router {
 	return &router{
 		routes: make(map[string]Handler),
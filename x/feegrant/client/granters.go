@@ -0,0 +1,153 @@
+// Package client contains the client helpers of the feegrant module.
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Candidate is a granter whose allowance can pay the fees of a transaction.
+type Candidate struct {
+	// Granter is the address of the granter, to be set as the fee granter of the
+	// transaction, e.g. with tx.Factory.WithFeeGranter or the --fee-granter flag.
+	Granter string
+	// Allowance is the allowance granted to the signer.
+	Allowance feegrant.FeeAllowanceI
+	// Remaining is the amount the allowance can still spend in the fee denoms,
+	// unless it is Unlimited.
+	Remaining sdk.Coins
+	// Unlimited is true if the allowance has no spend limit.
+	Unlimited bool
+}
+
+// FindGranters queries the allowances granted to grantee and returns the
+// granters whose allowance is active at blockTime, allows all the msgs and
+// covers fee. The candidates are ranked by remaining allowance in the fee
+// denoms, the unlimited allowances first.
+func FindGranters(ctx context.Context, queryClient feegrant.QueryClient, grantee string, msgs []sdk.Msg, fee sdk.Coins, blockTime time.Time) ([]Candidate, error) {
+	msgTypeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	var candidates []Candidate
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.Allowances(ctx, &feegrant.QueryAllowancesRequest{Grantee: grantee, Pagination: pageReq})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the allowances of %s: %w", grantee, err)
+		}
+
+		for _, grant := range res.Allowances {
+			allowance, err := grant.GetGrant()
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowance of granter %s: %w", grant.Granter, err)
+			}
+
+			remaining, unlimited, ok := spendable(allowance, msgTypeURLs, blockTime)
+			if !ok {
+				continue
+			}
+
+			// only the fee denoms are of interest
+			remaining = inDenoms(remaining, fee.Denoms())
+			if !unlimited && !fee.IsAllLTE(remaining) {
+				continue
+			}
+
+			candidates = append(candidates, Candidate{
+				Granter:   grant.Granter,
+				Allowance: allowance,
+				Remaining: remaining,
+				Unlimited: unlimited,
+			})
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	denoms := fee.Denoms()
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Unlimited != b.Unlimited {
+			return a.Unlimited
+		}
+		for _, denom := range denoms {
+			if x, y := a.Remaining.AmountOf(denom), b.Remaining.AmountOf(denom); !x.Equal(y) {
+				return x.GT(y)
+			}
+		}
+
+		return a.Granter < b.Granter
+	})
+
+	return candidates, nil
+}
+
+// spendable returns the amount allowance can still spend at blockTime, and
+// whether it is unlimited. It returns false if the allowance is expired, doesn't
+// allow all the msgTypeURLs or is unknown.
+func spendable(allowance feegrant.FeeAllowanceI, msgTypeURLs []string, blockTime time.Time) (sdk.Coins, bool, bool) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		if a.Expiration != nil && a.Expiration.Before(blockTime) {
+			return nil, false, false
+		}
+
+		return a.SpendLimit, a.SpendLimit == nil, true
+
+	case *feegrant.PeriodicAllowance:
+		if a.Basic.Expiration != nil && a.Basic.Expiration.Before(blockTime) {
+			return nil, false, false
+		}
+
+		// the period is reset by the next transaction once the reset time is reached
+		canSpend := a.PeriodCanSpend
+		if !blockTime.Before(a.PeriodReset) {
+			canSpend = a.PeriodSpendLimit
+		}
+		if a.Basic.SpendLimit != nil {
+			canSpend = canSpend.Min(a.Basic.SpendLimit)
+		}
+
+		return canSpend, false, true
+
+	case *feegrant.AllowedMsgAllowance:
+		for _, msgTypeURL := range msgTypeURLs {
+			if !slices.Contains(a.AllowedMessages, msgTypeURL) {
+				return nil, false, false
+			}
+		}
+
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, false, false
+		}
+
+		return spendable(inner, msgTypeURLs, blockTime)
+
+	default:
+		return nil, false, false
+	}
+}
+
+// inDenoms returns the coins of denoms among coins.
+func inDenoms(coins sdk.Coins, denoms []string) sdk.Coins {
+	filtered := make([]sdk.Coin, 0, len(denoms))
+	for _, denom := range denoms {
+		filtered = append(filtered, sdk.NewCoin(denom, coins.AmountOf(denom)))
+	}
+
+	return sdk.NewCoins(filtered...)
+}
@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/x/feegrant"
+	feegrantclient "cosmossdk.io/x/feegrant/client"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// queryClient serves the allowances of a grantee, one per page.
+type queryClient struct {
+	feegrant.QueryClient
+	grants []feegrant.Grant
+}
+
+func (c queryClient) Allowances(_ context.Context, req *feegrant.QueryAllowancesRequest, _ ...grpc.CallOption) (*feegrant.QueryAllowancesResponse, error) {
+	i := 0
+	if len(req.Pagination.Key) > 0 {
+		i, _ = strconv.Atoi(string(req.Pagination.Key))
+	}
+
+	res := &feegrant.QueryAllowancesResponse{Pagination: &query.PageResponse{}}
+	if i < len(c.grants) {
+		res.Allowances = []*feegrant.Grant{&c.grants[i]}
+	}
+	if i+1 < len(c.grants) {
+		res.Pagination.NextKey = []byte(strconv.Itoa(i + 1))
+	}
+
+	return res, nil
+}
+
+func TestFindGranters(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	msg := &testdata.TestMsg{}
+	msgTypeURL := sdk.MsgTypeURL(msg)
+
+	allowedMsg := func(allowance feegrant.FeeAllowanceI, msgTypeURLs ...string) feegrant.FeeAllowanceI {
+		a, err := feegrant.NewAllowedMsgAllowance(allowance, msgTypeURLs)
+		require.NoError(t, err)
+		return a
+	}
+
+	allowances := map[string]feegrant.FeeAllowanceI{
+		"limited":     &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+		"unlimited":   &feegrant.BasicAllowance{},
+		"expired":     &feegrant.BasicAllowance{Expiration: &past},
+		"other denom": &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500))},
+		"exceeded":    &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))},
+		// the period of the allowance is reset by the next transaction
+		"periodic": &feegrant.PeriodicAllowance{
+			Basic:            feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Expiration: &future},
+			Period:           time.Hour,
+			PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 80)),
+			PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			PeriodReset:      past,
+		},
+		"periodic spent": &feegrant.PeriodicAllowance{
+			Basic:            feegrant.BasicAllowance{},
+			Period:           time.Hour,
+			PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 80)),
+			PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			PeriodReset:      future,
+		},
+		"allowed msg":     allowedMsg(&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))}, msgTypeURL),
+		"not allowed msg": allowedMsg(&feegrant.BasicAllowance{}, "/cosmos.bank.v1beta1.MsgSend"),
+	}
+
+	var grants []feegrant.Grant
+	for granter, allowance := range allowances {
+		grant, err := feegrant.NewGrant(granter, "grantee", allowance)
+		require.NoError(t, err)
+		grants = append(grants, grant)
+	}
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	candidates, err := feegrantclient.FindGranters(context.Background(), queryClient{grants: grants}, "grantee", []sdk.Msg{msg}, fee, now)
+	require.NoError(t, err)
+
+	var granters []string
+	for _, c := range candidates {
+		granters = append(granters, c.Granter)
+	}
+	require.Equal(t, []string{"unlimited", "periodic", "limited", "allowed msg"}, granters)
+	require.True(t, candidates[0].Unlimited)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), candidates[1].Remaining)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), candidates[2].Remaining)
+
+	// the fee denoms must be covered
+	candidates, err = feegrantclient.FindGranters(context.Background(), queryClient{grants: grants}, "grantee", []sdk.Msg{msg}, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), now)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+	require.Equal(t, "unlimited", candidates[0].Granter)
+	require.Equal(t, "other denom", candidates[1].Granter)
+}
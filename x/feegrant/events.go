@@ -8,7 +8,10 @@ const (
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
 
-	AttributeKeyGranter = "granter"
-	AttributeKeyGrantee = "grantee"
-	AttributeKeyPruner  = "pruner"
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyPruner     = "pruner"
+	AttributeKeyFee        = "fee"
+	AttributeKeyUsageCount = "usage_count"
+	AttributeKeyTotalFees  = "total_fees"
 )
@@ -283,11 +283,151 @@ func (m *Grant) GetAllowance() *any.Any {
 	return nil
 }
 
+// AllowanceUsage tracks the cumulative usage of an allowance, so that the granter
+// can monitor it.
+type AllowanceUsage struct {
+	// count is the number of transactions whose fees were paid with the allowance.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// total_fees is the sum of the fees paid with the allowance.
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
+	// last_used_height is the height of the block in which the allowance was last used.
+	LastUsedHeight int64 `protobuf:"varint,3,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
+	// last_used_time is the time of the block in which the allowance was last used.
+	LastUsedTime *time.Time `protobuf:"bytes,4,opt,name=last_used_time,json=lastUsedTime,proto3,stdtime" json:"last_used_time,omitempty"`
+}
+
+func (m *AllowanceUsage) Reset()         { *m = AllowanceUsage{} }
+func (m *AllowanceUsage) String() string { return proto.CompactTextString(m) }
+func (*AllowanceUsage) ProtoMessage()    {}
+func (*AllowanceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *AllowanceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowanceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowanceUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowanceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowanceUsage.Merge(m, src)
+}
+func (m *AllowanceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowanceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowanceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowanceUsage proto.InternalMessageInfo
+
+func (m *AllowanceUsage) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AllowanceUsage) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+func (m *AllowanceUsage) GetLastUsedHeight() int64 {
+	if m != nil {
+		return m.LastUsedHeight
+	}
+	return 0
+}
+
+func (m *AllowanceUsage) GetLastUsedTime() *time.Time {
+	if m != nil {
+		return m.LastUsedTime
+	}
+	return nil
+}
+
+// GrantUsage is the usage of the allowance granted to grantee by granter.
+type GrantUsage struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// usage is the usage of the allowance.
+	Usage AllowanceUsage `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *GrantUsage) Reset()         { *m = GrantUsage{} }
+func (m *GrantUsage) String() string { return proto.CompactTextString(m) }
+func (*GrantUsage) ProtoMessage()    {}
+func (*GrantUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *GrantUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantUsage.Merge(m, src)
+}
+func (m *GrantUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantUsage proto.InternalMessageInfo
+
+func (m *GrantUsage) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *GrantUsage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *GrantUsage) GetUsage() AllowanceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return AllowanceUsage{}
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*AllowanceUsage)(nil), "cosmos.feegrant.v1beta1.AllowanceUsage")
+	proto.RegisterType((*GrantUsage)(nil), "cosmos.feegrant.v1beta1.GrantUsage")
 }
 
 func init() {
@@ -295,49 +435,56 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xf4, 0x83, 0x5f, 0x3a, 0xe5, 0x57, 0x61, 0x6d, 0xe2, 0x96, 0x98, 0x2d, 0x69, 0xa2,
+	0x16, 0x12, 0x76, 0x03, 0xde, 0x38, 0xc1, 0x62, 0x0a, 0x1a, 0x48, 0xc8, 0x22, 0x17, 0x13, 0xb3,
+	0x99, 0xee, 0x0e, 0xcb, 0x86, 0x76, 0xa7, 0xd9, 0x99, 0x2a, 0xbd, 0x19, 0x4f, 0x46, 0x0f, 0x72,
+	0x34, 0x7a, 0xe1, 0x68, 0x3c, 0x71, 0xe0, 0xec, 0x99, 0x78, 0x30, 0xc4, 0x93, 0x5e, 0xc4, 0xc0,
+	0x81, 0xb3, 0xff, 0x81, 0xd9, 0x99, 0xd9, 0x7e, 0x80, 0x04, 0x48, 0x4c, 0x2f, 0xed, 0xce, 0x3b,
+	0xef, 0xfb, 0xbc, 0xcf, 0xf3, 0xbc, 0xd3, 0xe9, 0xc2, 0xbb, 0x0e, 0xa1, 0x0d, 0x42, 0x8d, 0x0d,
+	0x8c, 0xbd, 0x10, 0x05, 0xcc, 0x78, 0x36, 0x5d, 0xc3, 0x0c, 0x4d, 0x77, 0x02, 0x7a, 0x33, 0x24,
+	0x8c, 0x28, 0xb7, 0x44, 0x9e, 0xde, 0x09, 0xcb, 0xbc, 0xb1, 0x82, 0x47, 0x3c, 0xc2, 0x73, 0x8c,
+	0xe8, 0x49, 0xa4, 0x8f, 0x15, 0x3d, 0x42, 0xbc, 0x3a, 0x36, 0xf8, 0xaa, 0xd6, 0xda, 0x30, 0x50,
+	0xd0, 0x8e, 0xb7, 0x04, 0x92, 0x2d, 0x6a, 0x24, 0xac, 0xd8, 0xd2, 0x24, 0x99, 0x1a, 0xa2, 0xb8,
+	0x43, 0xc4, 0x21, 0x7e, 0x20, 0xf7, 0x47, 0x51, 0xc3, 0x0f, 0x88, 0xc1, 0x3f, 0x65, 0xa8, 0x74,
+	0xb6, 0x11, 0xf3, 0x1b, 0x98, 0x32, 0xd4, 0x68, 0xc6, 0x98, 0x67, 0x13, 0xdc, 0x56, 0x88, 0x98,
+	0x4f, 0x24, 0x66, 0x79, 0x37, 0x09, 0xf3, 0x26, 0xa2, 0xbe, 0x33, 0x5f, 0xaf, 0x93, 0xe7, 0x28,
+	0x70, 0xb0, 0xf2, 0x12, 0xc0, 0x1c, 0x6d, 0xe2, 0xc0, 0xb5, 0xeb, 0x7e, 0xc3, 0x67, 0x2a, 0x18,
+	0x4f, 0x55, 0x72, 0x33, 0x45, 0x5d, 0x72, 0x8d, 0xd8, 0xc5, 0xf2, 0xf5, 0x05, 0xe2, 0x07, 0x66,
+	0xf5, 0xe0, 0x67, 0x29, 0xf1, 0xe9, 0xa8, 0x54, 0xf1, 0x7c, 0xb6, 0xd9, 0xaa, 0xe9, 0x0e, 0x69,
+	0x48, 0x61, 0xf2, 0x6b, 0x8a, 0xba, 0x5b, 0x06, 0x6b, 0x37, 0x31, 0xe5, 0x05, 0xf4, 0xfd, 0xe9,
+	0xde, 0xe4, 0x70, 0x1d, 0x7b, 0xc8, 0x69, 0xdb, 0x91, 0x3e, 0xfa, 0xf1, 0x74, 0x6f, 0x12, 0x58,
+	0x90, 0x77, 0x5d, 0x8e, 0x9a, 0x2a, 0x73, 0x10, 0xe2, 0xed, 0xa6, 0x2f, 0xb8, 0xaa, 0xc9, 0x71,
+	0x50, 0xc9, 0xcd, 0x8c, 0xe9, 0x42, 0x8c, 0x1e, 0x8b, 0xd1, 0x1f, 0xc7, 0x6a, 0xcd, 0xf4, 0xce,
+	0x51, 0x09, 0x58, 0x3d, 0x35, 0xb3, 0x8b, 0x5f, 0xf6, 0xa7, 0xee, 0x5c, 0x30, 0x36, 0xbd, 0x8a,
+	0x71, 0x47, 0xf0, 0xc3, 0xd7, 0xa7, 0x7b, 0x93, 0xc5, 0x1e, 0xa6, 0xfd, 0x7e, 0x94, 0x7f, 0xa4,
+	0xe1, 0xe8, 0x2a, 0x0e, 0x7d, 0xe2, 0xf6, 0xba, 0xb4, 0x04, 0x33, 0xb5, 0x28, 0x4f, 0x05, 0x9c,
+	0xdb, 0x3d, 0xfd, 0xa2, 0x56, 0xfd, 0x68, 0x66, 0x36, 0x32, 0x4b, 0xe8, 0x15, 0x00, 0xca, 0x1c,
+	0x1c, 0x6a, 0x72, 0x78, 0x29, 0xb3, 0x78, 0x4e, 0xe6, 0x03, 0x39, 0x33, 0xf3, 0xff, 0xa8, 0xf8,
+	0xdd, 0x51, 0x09, 0x08, 0x00, 0x59, 0xa7, 0xbc, 0x05, 0x50, 0x11, 0x8f, 0x76, 0xef, 0xe0, 0x52,
+	0x83, 0x1a, 0xdc, 0x88, 0x68, 0xbe, 0xd6, 0x1d, 0xdf, 0x1b, 0x00, 0x65, 0xd0, 0x76, 0x50, 0x20,
+	0x58, 0xa9, 0xe9, 0x41, 0xf1, 0xc9, 0x8b, 0xd6, 0x0b, 0x28, 0xe0, 0x94, 0x94, 0x65, 0x38, 0x2c,
+	0xc9, 0x84, 0x98, 0x62, 0xa6, 0x66, 0x2e, 0x3d, 0x4e, 0xdc, 0xe8, 0x9d, 0x8e, 0xd1, 0x39, 0x51,
+	0x6e, 0x45, 0xd5, 0xb3, 0x8f, 0xae, 0x75, 0xb0, 0x6e, 0xf7, 0x30, 0x3f, 0x77, 0x8a, 0xca, 0xbf,
+	0x01, 0xbc, 0xc9, 0x57, 0xd8, 0x5d, 0xa1, 0x5e, 0xf7, 0x74, 0x3d, 0x85, 0x59, 0x14, 0x2f, 0xe4,
+	0x09, 0x2b, 0x9c, 0xa3, 0x3b, 0x1f, 0xb4, 0xcd, 0x89, 0x2b, 0x93, 0xb1, 0xba, 0x88, 0xca, 0x04,
+	0x1c, 0x41, 0xa2, 0xab, 0xdd, 0xc0, 0x94, 0x22, 0x0f, 0x53, 0x35, 0x39, 0x9e, 0xaa, 0x64, 0xad,
+	0x1b, 0x32, 0xbe, 0x22, 0xc3, 0xb3, 0xab, 0xaf, 0x76, 0x4b, 0x89, 0x6b, 0x29, 0xd6, 0x7a, 0x14,
+	0xff, 0x45, 0x5b, 0xf9, 0x2b, 0x80, 0x99, 0xc5, 0x08, 0x42, 0x99, 0x81, 0xff, 0x71, 0x2c, 0x1c,
+	0x72, 0x8d, 0x59, 0x53, 0xfd, 0xb6, 0x3f, 0x55, 0x90, 0x8d, 0xe6, 0x5d, 0x37, 0xc4, 0x94, 0xae,
+	0xb1, 0xd0, 0x0f, 0x3c, 0x2b, 0x4e, 0xec, 0xd6, 0x60, 0x35, 0x79, 0xb5, 0x9a, 0x33, 0x6e, 0xa6,
+	0xfe, 0xb5, 0x9b, 0xe5, 0x0f, 0x49, 0x98, 0xef, 0xec, 0xac, 0x47, 0xb6, 0x29, 0x05, 0x98, 0x71,
+	0x48, 0x2b, 0x60, 0x5c, 0x57, 0xda, 0x12, 0x0b, 0xe5, 0x05, 0x80, 0x90, 0x11, 0x86, 0xea, 0xf6,
+	0x06, 0x96, 0x8e, 0x0f, 0xe4, 0xf7, 0x90, 0xe5, 0x4d, 0xab, 0x18, 0x53, 0xa5, 0x02, 0x47, 0xea,
+	0x88, 0x32, 0xbb, 0x45, 0xb1, 0x6b, 0x6f, 0x62, 0xdf, 0xdb, 0x64, 0xdc, 0x91, 0x94, 0x95, 0x8f,
+	0xe2, 0xeb, 0x14, 0xbb, 0x4b, 0x3c, 0xaa, 0x54, 0x61, 0xbe, 0x9b, 0x19, 0xfd, 0xad, 0xa8, 0xe9,
+	0x2b, 0xde, 0xc2, 0xc3, 0x31, 0x52, 0xb4, 0x51, 0xfe, 0x0c, 0x20, 0xe4, 0xe3, 0x16, 0xce, 0x0c,
+	0x6a, 0xe6, 0x4b, 0x30, 0xd3, 0x8a, 0x1a, 0xaa, 0xa9, 0x4b, 0xee, 0xe7, 0xfe, 0xc9, 0xf5, 0xdd,
+	0xcf, 0x1c, 0xc0, 0x9c, 0x3e, 0x38, 0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1d, 0x6b, 0x60, 0xe7,
+	0x44, 0x4b, 0x1c, 0x9e, 0x68, 0x89, 0xef, 0x27, 0x5a, 0xe2, 0x89, 0x7c, 0x2b, 0xa0, 0xee, 0x96,
+	0xee, 0x13, 0x63, 0xbb, 0xf3, 0xd2, 0x50, 0x1b, 0xe2, 0xde, 0xdc, 0xff, 0x33, 0x00, 0x76, 0x5d,
+	0x91, 0x8a, 0x5f, 0x08, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -557,6 +704,110 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowanceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowanceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowanceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUsedTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUsedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUsedTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastUsedHeight != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.LastUsedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -654,6 +905,50 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *AllowanceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovFeegrant(uint64(m.Count))
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.LastUsedHeight != 0 {
+		n += 1 + sovFeegrant(uint64(m.LastUsedHeight))
+	}
+	if m.LastUsedTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUsedTime)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
 func sovFeegrant(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1265,6 +1560,311 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowanceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowanceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowanceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
+			}
+			m.LastUsedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsedTime == nil {
+				m.LastUsedTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUsedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeegrant(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
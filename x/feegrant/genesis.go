@@ -2,6 +2,10 @@ package feegrant
 
 import (
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ gogoprotoany.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +29,12 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	for _, u := range data.Usages {
+		if !u.Usage.TotalFees.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid total fees %s of usage by granter %s to grantee %s", u.Usage.TotalFees, u.Granter, u.Grantee)
+		}
+	}
 	return nil
 }
 
@@ -71,8 +71,13 @@ func TestImportExportGenesis(t *testing.T) {
 	err := f.feegrantKeeper.GrantAllowance(f.ctx, granterAddr, granteeAddr, allowance)
 	assert.NilError(t, err)
 
+	err = f.feegrantKeeper.UseGrantedFees(f.ctx, granterAddr, granteeAddr, sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(10))), []sdk.Msg{})
+	assert.NilError(t, err)
+
 	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
 	assert.NilError(t, err)
+	assert.Equal(t, len(genesis.Usages), 1)
+	assert.Equal(t, genesis.Usages[0].Usage.Count, uint64(1))
 
 	granter, err := f.accountKeeper.AddressCodec().BytesToString(granterAddr.Bytes())
 	assert.NilError(t, err)
@@ -158,3 +163,17 @@ func TestInitGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestInitGenesisUsageWithoutAllowance(t *testing.T) {
+	f := initFixture(t)
+
+	granter, err := f.accountKeeper.AddressCodec().BytesToString(granterAddr.Bytes())
+	assert.NilError(t, err)
+	grantee, err := f.accountKeeper.AddressCodec().BytesToString(granteeAddr.Bytes())
+	assert.NilError(t, err)
+
+	err = f.feegrantKeeper.InitGenesis(f.ctx, &feegrant.GenesisState{
+		Usages: []feegrant.GrantUsage{{Granter: granter, Grantee: grantee, Usage: feegrant.AllowanceUsage{Count: 1}}},
+	})
+	assert.ErrorContains(t, err, "fee allowance of usage")
+}
@@ -116,3 +116,66 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+func (q Keeper) AllowanceUsage(ctx context.Context, req *feegrant.QueryAllowanceUsageRequest) (*feegrant.QueryAllowanceUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	has, err := q.FeeAllowance.Has(ctx, collections.Join(sdk.AccAddress(granteeAddr), sdk.AccAddress(granterAddr)))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "fee-grant not found for granter %s and grantee %s", req.Granter, req.Grantee)
+	}
+
+	usage, err := q.GetAllowanceUsage(ctx, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowanceUsageResponse{Usage: &usage}, nil
+}
+
+// AllowanceUsagesByGranter queries the usage of all the used allowances granted by the given granter.
+func (q Keeper) AllowanceUsagesByGranter(c context.Context, req *feegrant.QueryAllowanceUsagesByGranterRequest) (*feegrant.QueryAllowanceUsagesByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, pageRes, err := query.CollectionFilteredPaginate(c, q.AllowanceUsages, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ feegrant.AllowanceUsage) (include bool, err error) {
+			return sdk.AccAddress(granterAddr).Equals(key.K2()), nil
+		},
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.AllowanceUsage) (*feegrant.GrantUsage, error) {
+			grantee, err := q.authKeeper.AddressCodec().BytesToString(key.K1())
+			if err != nil {
+				return nil, err
+			}
+
+			return &feegrant.GrantUsage{Granter: req.Granter, Grantee: grantee, Usage: usage}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowanceUsagesByGranterResponse{Usages: usages, Pagination: pageRes}, nil
+}
@@ -233,6 +233,56 @@ func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAllowanceUsageQueries() {
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[2])
+	suite.grantFeeAllowance(suite.addrs[1], suite.addrs[2])
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[1], suite.addrs[2], fee, []sdk.Msg{}))
+
+	_, err := suite.feegrantKeeper.AllowanceUsage(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	_, err = suite.feegrantKeeper.AllowanceUsage(suite.ctx, &feegrant.QueryAllowanceUsageRequest{
+		Granter: suite.encodedAddrs[2],
+		Grantee: suite.encodedAddrs[0],
+	})
+	suite.Require().ErrorContains(err, "fee-grant not found")
+
+	resp, err := suite.feegrantKeeper.AllowanceUsage(suite.ctx, &feegrant.QueryAllowanceUsageRequest{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[1],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), resp.Usage.Count)
+	suite.Require().Equal(fee, resp.Usage.TotalFees)
+
+	// an unused allowance has an empty usage
+	resp, err = suite.feegrantKeeper.AllowanceUsage(suite.ctx, &feegrant.QueryAllowanceUsageRequest{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[2],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), resp.Usage.Count)
+
+	_, err = suite.feegrantKeeper.AllowanceUsagesByGranter(suite.ctx, &feegrant.QueryAllowanceUsagesByGranterRequest{
+		Granter: invalidGrantee,
+	})
+	suite.Require().Error(err)
+
+	usagesResp, err := suite.feegrantKeeper.AllowanceUsagesByGranter(suite.ctx, &feegrant.QueryAllowanceUsagesByGranterRequest{
+		Granter: suite.encodedAddrs[0],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(usagesResp.Usages, 1)
+	suite.Require().Equal(suite.encodedAddrs[0], usagesResp.Usages[0].Granter)
+	suite.Require().Equal(suite.encodedAddrs[1], usagesResp.Usages[0].Grantee)
+	suite.Require().Equal(uint64(1), usagesResp.Usages[0].Usage.Count)
+	suite.Require().Equal(uint64(1), usagesResp.Pagination.Total)
+}
+
 func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress) {
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
 	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -29,6 +31,8 @@ type Keeper struct {
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// AllowanceUsages key: grantee+granter | value: AllowanceUsage
+	AllowanceUsages collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.AllowanceUsage]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -55,6 +59,13 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.Acc
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		AllowanceUsages: collections.NewMap(
+			sb,
+			feegrant.AllowanceUsageKeyPrefix,
+			"allowance_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[feegrant.AllowanceUsage](cdc),
+		),
 	}
 }
 
@@ -162,6 +173,10 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	if err := k.AllowanceUsages.Remove(ctx, collections.Join(grantee, granter)); err != nil {
+		return err
+	}
+
 	exp, err := grant.ExpiresAt()
 	if err != nil {
 		return err
@@ -227,16 +242,22 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	}
 
 	remove, err := grant.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), fee, msgs)
-	if remove && err == nil {
-		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
-		_ = k.revokeAllowance(ctx, granter, grantee)
-
-		return k.emitUseGrantEvent(ctx, granterStr, granteeStr)
+	if err != nil {
+		return err
 	}
+
+	usage, err := k.trackUsage(ctx, granter, grantee, fee)
 	if err != nil {
 		return err
 	}
-	if err := k.emitUseGrantEvent(ctx, granterStr, granteeStr); err != nil {
+
+	if remove {
+		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
+		_ = k.revokeAllowance(ctx, granter, grantee)
+
+		return k.emitUseGrantEvent(ctx, granterStr, granteeStr, fee, usage)
+	}
+	if err := k.emitUseGrantEvent(ctx, granterStr, granteeStr, fee, usage); err != nil {
 		return err
 	}
 
@@ -244,11 +265,43 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
-func (k *Keeper) emitUseGrantEvent(ctx context.Context, granter, grantee string) error {
+// trackUsage adds the fee paid with the allowance granted by granter to grantee
+// to its usage, and returns the updated usage.
+func (k Keeper) trackUsage(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) (feegrant.AllowanceUsage, error) {
+	key := collections.Join(grantee, granter)
+	usage, err := k.AllowanceUsages.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return feegrant.AllowanceUsage{}, err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	usage.Count++
+	usage.TotalFees = usage.TotalFees.Add(fee...)
+	usage.LastUsedHeight = headerInfo.Height
+	usage.LastUsedTime = &headerInfo.Time
+
+	return usage, k.AllowanceUsages.Set(ctx, key, usage)
+}
+
+// GetAllowanceUsage returns the usage of the allowance granted by granter to
+// grantee, which is empty if the allowance was never used.
+func (k Keeper) GetAllowanceUsage(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.AllowanceUsage, error) {
+	usage, err := k.AllowanceUsages.Get(ctx, collections.Join(grantee, granter))
+	if errors.Is(err, collections.ErrNotFound) {
+		return feegrant.AllowanceUsage{}, nil
+	}
+
+	return usage, err
+}
+
+func (k *Keeper) emitUseGrantEvent(ctx context.Context, granter, grantee string, fee sdk.Coins, usage feegrant.AllowanceUsage) error {
 	return k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeUseFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, granter),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
+		event.NewAttribute(feegrant.AttributeKeyFee, fee.String()),
+		event.NewAttribute(feegrant.AttributeKeyUsageCount, strconv.FormatUint(usage.Count, 10)),
+		event.NewAttribute(feegrant.AttributeKeyTotalFees, usage.TotalFees.String()),
 	)
 }
 
@@ -274,6 +327,30 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, u := range data.Usages {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(u.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(u.Grantee)
+		if err != nil {
+			return err
+		}
+
+		key := collections.Join(sdk.AccAddress(grantee), sdk.AccAddress(granter))
+		has, err := k.FeeAllowance.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "fee allowance of usage by granter %s to grantee %s", u.Granter, u.Grantee)
+		}
+
+		if err := k.AllowanceUsages.Set(ctx, key, u.Usage); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -285,9 +362,28 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.GrantUsage
+	err = k.AllowanceUsages.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.AllowanceUsage) (stop bool, err error) {
+		granter, err := k.authKeeper.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		grantee, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		usages = append(usages, feegrant.GrantUsage{Granter: granter, Grantee: grantee, Usage: usage})
+		return false, nil
+	})
 
 	return &feegrant.GenesisState{
 		Allowances: grants,
+		Usages:     usages,
 	}, err
 }
 
@@ -305,6 +401,10 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 			return true, err
 		}
 
+		if err := k.AllowanceUsages.Remove(ctx, collections.Join(grantee, granter)); err != nil {
+			return true, err
+		}
+
 		keysToRemove = append(keysToRemove, key)
 
 		// limit the amount of iterations to avoid taking too much time
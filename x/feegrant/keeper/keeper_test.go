@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -305,6 +306,58 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestAllowanceUsage() {
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	})
+	suite.Require().NoError(err)
+
+	// an unused allowance has an empty usage
+	usage, err := suite.feegrantKeeper.GetAllowanceUsage(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.AllowanceUsage{}, usage)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10, Time: suite.ctx.HeaderInfo().Time.Add(time.Hour)})
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{}))
+
+	// a rejected fee is not tracked
+	suite.Require().Error(suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], suite.atom, []sdk.Msg{}))
+
+	usage, err = suite.feegrantKeeper.GetAllowanceUsage(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), usage.Count)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), usage.TotalFees)
+	suite.Require().Equal(int64(10), usage.LastUsedHeight)
+	suite.Require().Equal(ctx.HeaderInfo().Time, *usage.LastUsedTime)
+
+	var useEvent sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == feegrant.EventTypeUseFeeGrant {
+			useEvent = e
+		}
+	}
+	attr, ok := useEvent.GetAttribute(feegrant.AttributeKeyUsageCount)
+	suite.Require().True(ok)
+	suite.Require().Equal("2", attr.Value)
+	attr, ok = useEvent.GetAttribute(feegrant.AttributeKeyTotalFees)
+	suite.Require().True(ok)
+	suite.Require().Equal("200atom", attr.Value)
+
+	// the usage is removed with the allowance
+	_, err = suite.msgSrvr.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[1],
+	})
+	suite.Require().NoError(err)
+	usage, err = suite.feegrantKeeper.GetAllowanceUsage(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.AllowanceUsage{}, usage)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
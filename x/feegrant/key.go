@@ -20,4 +20,8 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// AllowanceUsageKeyPrefix is the set of the kvstore for fee allowance usage data
+	// - 0x02<allowance_key_bytes>: allowance usage
+	AllowanceUsageKeyPrefix = collections.NewPrefix(2)
 )
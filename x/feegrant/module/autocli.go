@@ -44,6 +44,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "AllowanceUsage",
+					Use:       "usage [granter] [grantee]",
+					Short:     "Query the usage of a single grant",
+					Long:      "Query the number of transactions, total fees and last use of the fee-grant of a granter to a grantee.",
+					Example:   fmt.Sprintf(`$ %s query feegrant usage [granter] [grantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+					},
+				},
+				{
+					RpcMethod: "AllowanceUsagesByGranter",
+					Use:       "usages-by-granter [granter]",
+					Short:     "Query the usage of all the used grants by a granter",
+					Example:   fmt.Sprintf(`$ %s query feegrant usages-by-granter [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -1,24 +1,78 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md"
+const (
+	chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md"
+
+	// FlagFix is the flag to fix the trivially correctable issues of a genesis file.
+	FlagFix = "fix"
+)
+
+// GenesisDiagnostic is an issue found while validating a genesis file.
+type GenesisDiagnostic struct {
+	// Module is the name of the module whose genesis state has the issue, empty
+	// for issues outside of the app state.
+	Module string `json:"module,omitempty"`
+	// Path is the JSON path of the issue in the genesis file.
+	Path string `json:"path"`
+	// Error is the validation error.
+	Error string `json:"error"`
+	// Suggestion is a suggested fix of the issue, if any.
+	Suggestion string `json:"suggestion,omitempty"`
+	// Fixed is true if the issue was fixed with the fix flag.
+	Fixed bool `json:"fixed,omitempty"`
+}
+
+func (d GenesisDiagnostic) String() string {
+	s := fmt.Sprintf("%s: %s", d.Path, d.Error)
+	if d.Fixed {
+		return s + " (fixed)"
+	}
+	if d.Suggestion != "" {
+		s += fmt.Sprintf(" (suggestion: %s)", d.Suggestion)
+	}
+	return s
+}
+
+// genesisValidationResult is the output of the validate genesis command.
+type genesisValidationResult struct {
+	File        string              `json:"file"`
+	Valid       bool                `json:"valid"`
+	Diagnostics []GenesisDiagnostic `json:"diagnostics"`
+}
+
+// moduleGenesisValidator is implemented by module managers able to validate the
+// genesis state of a single module, which allows validating the modules in parallel.
+type moduleGenesisValidator interface {
+	ValidateModuleGenesis(moduleName string, genesisData json.RawMessage) error
+}
 
 // ValidateGenesisCmd takes a genesis file, and makes sure that it is valid.
 func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "validate [file]",
 		Aliases: []string{"validate-genesis"},
 		Args:    cobra.RangeArgs(0, 1),
 		Short:   "Validates the genesis file at the default location or at the location passed as an arg",
+		Long: `Validates the genesis file at the default location or at the location passed as an arg.
+The genesis state of the modules is validated in parallel, and every issue found is reported with
+the module, the JSON path and a suggested fix. With --fix, the trivially correctable issues, such as
+missing module genesis states or unsorted coins, are fixed in place.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			cfg := client.GetConfigFromCmd(cmd)
 
@@ -30,13 +84,28 @@ func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
 				genesis = args[0]
 			}
 
+			fix, err := cmd.Flags().GetBool(FlagFix)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
 			appGenesis, err := types.AppGenesisFromFile(genesis)
 			if err != nil {
 				return err
 			}
 
+			var diagnostics []GenesisDiagnostic
 			if err := appGenesis.ValidateAndComplete(); err != nil {
-				return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %w", chainUpgradeGuide, err)
+				diagnostics = append(diagnostics, GenesisDiagnostic{
+					Path:       "consensus",
+					Error:      fmt.Sprintf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %s", chainUpgradeGuide, err),
+					Suggestion: "migrate the genesis file with the genesis migrate command",
+				})
 			}
 
 			var genState map[string]json.RawMessage
@@ -45,13 +114,243 @@ func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
 			}
 
 			if genMM != nil {
-				if err = genMM.ValidateGenesis(genState); err != nil {
-					return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+				moduleDiagnostics, fixed := validateModulesGenesis(genMM, genState, fix)
+				diagnostics = append(diagnostics, moduleDiagnostics...)
+
+				if fixed {
+					if appGenesis.AppState, err = json.MarshalIndent(genState, "", "  "); err != nil {
+						return err
+					}
+
+					if err := appGenesis.SaveAs(genesis); err != nil {
+						return err
+					}
+				}
+			}
+
+			var errs []error
+			for _, d := range diagnostics {
+				if !d.Fixed {
+					errs = append(errs, errors.New(d.String()))
 				}
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
+			if output == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(genesisValidationResult{
+					File:        genesis,
+					Valid:       len(errs) == 0,
+					Diagnostics: diagnostics,
+				}, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			} else {
+				for _, d := range diagnostics {
+					if d.Fixed {
+						fmt.Fprintln(cmd.OutOrStdout(), d.String())
+					}
+				}
+			}
+
+			if len(errs) > 0 {
+				return fmt.Errorf("error validating genesis file %s: %w", genesis, errors.Join(errs...))
+			}
+
+			if output != flags.OutputFormatJSON {
+				fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
+			}
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(FlagFix, false, "Fix the trivially correctable issues, such as missing module genesis states or unsorted coins, and save the genesis file")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// validateModulesGenesis validates the genesis state of every module, in
+// parallel when the module manager supports it, and returns the diagnostics
+// ordered by module name. When fix is set, the trivially correctable issues
+// are fixed in genState, and fixed is true if genState was updated.
+func validateModulesGenesis(genMM genesisMM, genState map[string]json.RawMessage, fix bool) (diagnostics []GenesisDiagnostic, fixed bool) {
+	validator, ok := genMM.(moduleGenesisValidator)
+	if !ok {
+		if err := genMM.ValidateGenesis(genState); err != nil {
+			return []GenesisDiagnostic{{Path: "app_state", Error: err.Error()}}, false
+		}
+		return nil, false
+	}
+
+	defaultGenesis := genMM.DefaultGenesis()
+	modules := sortedKeys(defaultGenesis)
+	moduleDiagnostics := make([][]GenesisDiagnostic, len(modules))
+	moduleStates := make([]json.RawMessage, len(modules))
+
+	var wg sync.WaitGroup
+	for i, module := range modules {
+		state := genState[module]
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var ds []GenesisDiagnostic
+			if state == nil {
+				d := GenesisDiagnostic{
+					Module:     module,
+					Path:       "app_state." + module,
+					Error:      "missing genesis state",
+					Suggestion: "add the default genesis state of the module",
+				}
+				if !fix {
+					moduleDiagnostics[i] = []GenesisDiagnostic{d}
+					return
+				}
+
+				d.Fixed = true
+				ds = append(ds, d)
+				state = defaultGenesis[module]
+			}
+
+			err := validator.ValidateModuleGenesis(module, state)
+			if err != nil && fix {
+				if fixedState, paths := fixModuleGenesis(state); len(paths) > 0 && validator.ValidateModuleGenesis(module, fixedState) == nil {
+					for _, path := range paths {
+						ds = append(ds, GenesisDiagnostic{
+							Module: module,
+							Path:   fmt.Sprintf("app_state.%s%s", module, path),
+							Error:  "coins are not sorted",
+							Fixed:  true,
+						})
+					}
+					state, err = fixedState, nil
+				}
+			}
+
+			if err != nil {
+				ds = append(ds, GenesisDiagnostic{
+					Module:     module,
+					Path:       "app_state." + module,
+					Error:      err.Error(),
+					Suggestion: suggestFix(err),
+				})
+			}
+
+			moduleDiagnostics[i] = ds
+			moduleStates[i] = state
+		}()
+	}
+	wg.Wait()
+
+	for i, module := range modules {
+		diagnostics = append(diagnostics, moduleDiagnostics[i]...)
+		if moduleStates[i] != nil && !bytes.Equal(moduleStates[i], genState[module]) {
+			genState[module] = moduleStates[i]
+			fixed = true
+		}
+	}
+
+	return diagnostics, fixed
+}
+
+// fixModuleGenesis sorts by denom the coins of a module genesis state. It
+// returns the fixed state and the relative JSON paths of the unsorted coins.
+func fixModuleGenesis(state json.RawMessage) (json.RawMessage, []string) {
+	dec := json.NewDecoder(bytes.NewReader(state))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return state, nil
+	}
+
+	paths := sortCoins(v, "")
+	if len(paths) == 0 {
+		return state, nil
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return state, nil
+	}
+
+	return bz, paths
+}
+
+// sortCoins sorts by denom every array of coins in v, and returns the JSON
+// paths of the arrays that were not sorted.
+func sortCoins(v any, path string) []string {
+	var paths []string
+	switch v := v.(type) {
+	case map[string]any:
+		for _, k := range sortedKeys(v) {
+			paths = append(paths, sortCoins(v[k], path+"."+k)...)
+		}
+
+	case []any:
+		if isCoins(v) {
+			less := func(i, j int) bool {
+				return v[i].(map[string]any)["denom"].(string) < v[j].(map[string]any)["denom"].(string)
+			}
+			if !sort.SliceIsSorted(v, less) {
+				sort.SliceStable(v, less)
+				paths = append(paths, path)
+			}
+			return paths
+		}
+
+		for i, e := range v {
+			paths = append(paths, sortCoins(e, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+
+	return paths
+}
+
+// isCoins returns true if all the elements of v are coins, objects with only a
+// denom and an amount.
+func isCoins(v []any) bool {
+	for _, e := range v {
+		m, ok := e.(map[string]any)
+		if !ok || len(m) != 2 {
+			return false
+		}
+		if _, ok := m["denom"].(string); !ok {
+			return false
+		}
+		if _, ok := m["amount"]; !ok {
+			return false
+		}
+	}
+
+	return len(v) > 0
+}
+
+// sortedKeys returns the sorted keys of m.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// suggestFix returns a suggested fix for common module genesis validation errors.
+func suggestFix(err error) string {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "is not sorted"):
+		return "sort the coins by denom, or run the command with --fix"
+	case strings.Contains(msg, "unknown field"):
+		return "remove the unknown field, or migrate the genesis file with the genesis migrate command"
+	case strings.Contains(msg, "duplicate"):
+		return "remove the duplicate entries"
+	case strings.Contains(msg, "unexpected end of JSON input"):
+		return "add the default genesis state of the module, or run the command with --fix"
+	default:
+		return ""
+	}
 }
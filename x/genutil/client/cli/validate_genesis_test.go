@@ -1,7 +1,10 @@
 package cli_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,7 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 // An example exported genesis file from a 0.37 chain. Note that evidence
@@ -68,3 +73,97 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+type mockGenesisMM struct{}
+
+func (mockGenesisMM) DefaultGenesis() map[string]json.RawMessage {
+	return map[string]json.RawMessage{
+		"auth": json.RawMessage(`{"accounts":[]}`),
+		"bank": json.RawMessage(`{"balances":[]}`),
+	}
+}
+
+func (m mockGenesisMM) ValidateGenesis(genesisData map[string]json.RawMessage) error {
+	for name, data := range genesisData {
+		if err := m.ValidateModuleGenesis(name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mockGenesisMM) ValidateModuleGenesis(moduleName string, genesisData json.RawMessage) error {
+	if moduleName != "bank" {
+		return nil
+	}
+
+	var state struct {
+		Balances []struct {
+			Coins sdk.Coins `json:"coins"`
+		} `json:"balances"`
+	}
+	if err := json.Unmarshal(genesisData, &state); err != nil {
+		return err
+	}
+	for _, b := range state.Balances {
+		if err := b.Coins.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestValidateGenesisDiagnostics(t *testing.T) {
+	newGenesisFile := func(appState string) string {
+		appGenesis, err := types.AppGenesisFromFile("../../types/testdata/app_genesis.json")
+		require.NoError(t, err)
+		appGenesis.AppState = json.RawMessage(appState)
+
+		genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, appGenesis.SaveAs(genesisFile))
+		return genesisFile
+	}
+
+	unsorted := `{"bank":{"balances":[{"address":"addr","coins":[{"denom":"stake","amount":"1"},{"denom":"atom","amount":"1"}]}]}}`
+
+	t.Run("json output", func(t *testing.T) {
+		genesisFile := newGenesisFile(unsorted)
+		out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.ValidateGenesisCmd(mockGenesisMM{}), []string{genesisFile, "--output=json"})
+		require.ErrorContains(t, err, "is not sorted")
+
+		var result struct {
+			Valid       bool                    `json:"valid"`
+			Diagnostics []cli.GenesisDiagnostic `json:"diagnostics"`
+		}
+		require.NoError(t, json.NewDecoder(bytes.NewReader(out.Bytes())).Decode(&result))
+		require.False(t, result.Valid)
+		require.Len(t, result.Diagnostics, 2)
+		require.Equal(t, cli.GenesisDiagnostic{
+			Module:     "auth",
+			Path:       "app_state.auth",
+			Error:      "missing genesis state",
+			Suggestion: "add the default genesis state of the module",
+		}, result.Diagnostics[0])
+		require.Equal(t, "bank", result.Diagnostics[1].Module)
+		require.Equal(t, "app_state.bank", result.Diagnostics[1].Path)
+		require.Contains(t, result.Diagnostics[1].Suggestion, "--fix")
+	})
+
+	t.Run("fix", func(t *testing.T) {
+		genesisFile := newGenesisFile(unsorted)
+		out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.ValidateGenesisCmd(mockGenesisMM{}), []string{genesisFile, "--fix"})
+		require.NoError(t, err)
+		require.Contains(t, out.String(), "app_state.auth: missing genesis state (fixed)")
+		require.Contains(t, out.String(), "app_state.bank.balances[0].coins: coins are not sorted (fixed)")
+
+		appGenesis, err := types.AppGenesisFromFile(genesisFile)
+		require.NoError(t, err)
+		var genState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appGenesis.AppState, &genState))
+		require.JSONEq(t, `{"accounts":[]}`, string(genState["auth"]))
+		require.JSONEq(t, `{"balances":[{"address":"addr","coins":[{"denom":"atom","amount":"1"},{"denom":"stake","amount":"1"}]}]}`, string(genState["bank"]))
+
+		_, err = clitestutil.ExecTestCLICmd(client.Context{}, cli.ValidateGenesisCmd(mockGenesisMM{}), []string{genesisFile})
+		require.NoError(t, err)
+	})
+}
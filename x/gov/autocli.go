@@ -89,6 +89,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "ProposalVoteOptions",
+					Use:       "proposal-vote-options [proposal-id]",
+					Short:     "Query the vote options of a multiple choice proposal",
+					Example:   fmt.Sprintf("%s query gov proposal-vote-options 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
@@ -123,9 +132,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "Vote",
 					Use:       "vote [proposal-id] [option]",
-					Short:     "Vote for an active proposal, options: yes/no/no-with-veto/abstain",
-					Long:      fmt.Sprintf(`Submit a vote for an active proposal. Use the --metadata to optionally give a reason. You can find the proposal-id by running "%s query gov proposals"`, version.AppName),
-					Example:   fmt.Sprintf("$ %s tx gov vote 1 yes --from mykey", version.AppName),
+					Short:     "Vote for an active proposal, options: yes/no/no-with-veto/abstain, or one/two/three/four/spam for multiple choice proposals",
+					Long:      fmt.Sprintf(`Submit a vote for an active proposal. Use the --metadata to optionally give a reason. You can find the proposal-id by running "%s query gov proposals", and the options of a multiple choice proposal by running "%s query gov proposal-vote-options"`, version.AppName, version.AppName),
+					Example: fmt.Sprintf(`$ %s tx gov vote 1 yes --from mykey
+$ %s tx gov vote 2 three --from mykey`, version.AppName, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "proposal_id"},
 						{ProtoField: "option"},
@@ -134,6 +144,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "SubmitMultipleChoiceProposal",
+					Use:       "submit-multiple-choice-proposal [title] [summary] [vote-options]",
+					Short:     "Submit a multiple choice proposal, with up to four vote options",
+					Long:      "Submit a multiple choice proposal. Messages can be attached to options with --option-messages: they are executed when the option wins the vote.",
+					Example: fmt.Sprintf(`$ %s tx gov submit-multiple-choice-proposal "Pick a color" "Chain color" '{"option_one":"red","option_two":"blue"}' --initial-deposit 10stake --from mykey
+$ %s tx gov submit-multiple-choice-proposal "Send funds" "Recipient of the community pool funds" '{"option_one":"alice","option_two":"bob"}' --option-messages '[{"option":"VOTE_OPTION_ONE","messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend",...}]}]' --from mykey`, version.AppName, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "title"},
+						{ProtoField: "summary"},
+						{ProtoField: "vote_options"},
+					},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
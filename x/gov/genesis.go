@@ -73,6 +73,12 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, snapshot := range data.VotingPowerSnapshots {
+		if err := k.SetVotingPowerSnapshot(ctx, snapshot); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +137,19 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export voting power snapshots
+	snapshots, err := k.GetVotingPowerSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:   startingProposalID,
+		Deposits:             proposalsDeposits,
+		Votes:                proposalsVotes,
+		Proposals:            proposals,
+		Params:               &params,
+		Constitution:         constitution,
+		VotingPowerSnapshots: snapshots,
 	}, nil
 }
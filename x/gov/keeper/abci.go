@@ -180,7 +180,7 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 				msg sdk.Msg
 			)
 
-			messages, err := proposal.GetMsgs()
+			messages, err := proposalMsgsToExecute(proposal, tallyResults, params)
 			if err != nil {
 				proposal.Status = v1.StatusFailed
 				proposal.FailedReason = err.Error()
@@ -252,6 +252,13 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
+		// the voting power snapshot is kept while the proposal is still being voted on
+		if proposal.Status != v1.StatusVotingPeriod {
+			if err = k.deleteVotingPowerSnapshot(ctx, proposal.Id); err != nil {
+				return err
+			}
+		}
+
 		// call hook when proposal become active
 		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
 			return k.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
@@ -280,6 +287,26 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 	return nil
 }
 
+// proposalMsgsToExecute returns the messages to execute for a passed proposal.
+// For a multiple choice proposal, those are the messages of the winning option.
+func proposalMsgsToExecute(proposal v1.Proposal, tallyResults v1.TallyResult, params v1.Params) ([]sdk.Msg, error) {
+	if proposal.ProposalType != v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE || len(proposal.OptionMessages) == 0 {
+		return proposal.GetMsgs()
+	}
+
+	threshold, err := multipleChoiceThreshold(params)
+	if err != nil {
+		return nil, err
+	}
+
+	winner, err := tallyResults.WinningOption(threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal.GetOptionMsgs(winner)
+}
+
 // executes route(msg) and recovers from panic.
 func safeExecuteHandler(ctx context.Context, msg sdk.Msg, router router.Service) (res protoiface.MessageV1, err error) {
 	defer func() {
@@ -303,11 +330,16 @@ func failUnsupportedProposal(
 	proposal.Status = v1.StatusFailed
 	proposal.FailedReason = fmt.Sprintf("proposal failed because it cannot be processed by gov: %s", errMsg)
 	proposal.Messages = nil // clear out the messages
+	proposal.OptionMessages = nil
 
 	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
 		return err
 	}
 
+	if err := k.deleteVotingPowerSnapshot(ctx, proposal.Id); err != nil {
+		return err
+	}
+
 	if err := k.RefundAndDeleteDeposits(ctx, proposal.Id); err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -65,6 +66,15 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// TotalBondedSnapshots key: proposalID | value: total bonded tokens when the voting period started
+	// It is only set for proposals whose voting power is snapshotted.
+	TotalBondedSnapshots collections.Map[uint64, math.Int]
+	// ValidatorSnapshots key: proposalID+valAddr | value: ValidatorPowerSnapshot
+	// It is indexed by validator, so that the proposals that snapshotted a validator are found without iterating over all the snapshots.
+	ValidatorSnapshots *collections.IndexedMap[collections.Pair[uint64, sdk.ValAddress], v1.ValidatorPowerSnapshot, ValidatorSnapshotsIndexes]
+	// DelegationSnapshots key: proposalID+delAddr+valAddr | value: DelegationSnapshot
+	// It holds the shares, when the voting period started, of the delegations modified since then.
+	DelegationSnapshots collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], v1.DelegationSnapshot]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -131,6 +141,9 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		TotalBondedSnapshots:   collections.NewMap(sb, types.TotalBondedSnapshotsPrefix, "total_bonded_snapshots", collections.Uint64Key, sdk.IntValue),
+		ValidatorSnapshots:     collections.NewIndexedMap(sb, types.ValidatorSnapshotsPrefix, "validator_snapshots", collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey), codec.CollValue[v1.ValidatorPowerSnapshot](cdc), newValidatorSnapshotsIndexes(sb)),
+		DelegationSnapshots:    collections.NewMap(sb, types.DelegationSnapshotsPrefix, "delegation_snapshots", collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.ValAddressKey), codec.CollValue[v1.DelegationSnapshot](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -158,6 +158,22 @@ func (k msgServer) SubmitMultipleChoiceProposal(ctx context.Context, msg *v1.Msg
 		return nil, err
 	}
 
+	if len(msg.OptionMessages) > 0 {
+		if err := k.validateOptionMessages(ctx, msg.VoteOptions, msg.OptionMessages); err != nil {
+			return nil, err
+		}
+
+		proposal, err := k.Proposals.Get(ctx, resp.ProposalId)
+		if err != nil {
+			return nil, err
+		}
+
+		proposal.OptionMessages = msg.OptionMessages
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	return &v1.MsgSubmitMultipleChoiceProposalResponse{
 		ProposalId: resp.ProposalId,
 	}, nil
@@ -311,6 +311,40 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 	proposerAddr, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
 	suite.Require().NoError(err)
 	initialDeposit := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100000)))
+	govStrAcct, err := suite.acctKeeper.AddressCodec().BytesToString(suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress())
+	suite.Require().NoError(err)
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govStrAcct,
+		ToAddress:   proposerAddr,
+		Amount:      initialDeposit,
+	}
+	voteOptions := &v1.ProposalVoteOptions{
+		OptionOne: "Vote for me",
+		OptionTwo: "Vote for them",
+	}
+	newMsgWithOptionMsgs := func(optionMsgs map[v1.VoteOption][]sdk.Msg) (*v1.MsgSubmitMultipleChoiceProposal, error) {
+		msg, err := v1.NewMultipleChoiceMsgSubmitProposal(
+			initialDeposit,
+			proposerAddr,
+			"mandatory metadata",
+			"Proposal",
+			"description of proposal",
+			voteOptions,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, option := range []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree, v1.OptionFour} {
+			if msgs, ok := optionMsgs[option]; ok {
+				if err := msg.SetOptionMsgs(option, msgs); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return msg, nil
+	}
 
 	cases := map[string]struct {
 		preRun    func() (*v1.MsgSubmitMultipleChoiceProposal, error)
@@ -363,6 +397,48 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 				)
 			},
 		},
+		"option messages for undefined option": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				return newMsgWithOptionMsgs(map[v1.VoteOption][]sdk.Msg{v1.OptionThree: {bankMsg}})
+			},
+			expErr:    true,
+			expErrMsg: "is not a vote option of the proposal",
+		},
+		"option without messages": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				return newMsgWithOptionMsgs(map[v1.VoteOption][]sdk.Msg{v1.OptionOne: {}})
+			},
+			expErr:    true,
+			expErrMsg: "has no messages",
+		},
+		"duplicate option messages": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				msg, err := newMsgWithOptionMsgs(map[v1.VoteOption][]sdk.Msg{v1.OptionOne: {bankMsg}})
+				if err != nil {
+					return nil, err
+				}
+				msg.OptionMessages = append(msg.OptionMessages, msg.OptionMessages[0])
+				return msg, nil
+			},
+			expErr:    true,
+			expErrMsg: "duplicate messages for option",
+		},
+		"option message with invalid signer": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				return newMsgWithOptionMsgs(map[v1.VoteOption][]sdk.Msg{v1.OptionOne: {&banktypes.MsgSend{
+					FromAddress: proposerAddr,
+					ToAddress:   govStrAcct,
+					Amount:      initialDeposit,
+				}}})
+			},
+			expErr:    true,
+			expErrMsg: "expected authority account as only signer for proposal message",
+		},
+		"valid proposal with option messages": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				return newMsgWithOptionMsgs(map[v1.VoteOption][]sdk.Msg{v1.OptionTwo: {bankMsg}})
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -376,6 +452,11 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 			} else {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res.ProposalId)
+
+				proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+				suite.Require().NoError(err)
+				suite.Require().Len(proposal.OptionMessages, len(msg.OptionMessages))
+				suite.Require().Empty(proposal.Messages)
 			}
 		})
 	}
@@ -64,64 +64,7 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 			}
 		}
 
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers, _, err := k.cdc.GetMsgSigners(msg)
-		if err != nil {
-			return v1.Proposal{}, err
-		}
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
-			addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
-			if err != nil {
-				return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, err.Error())
-			}
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, addr)
-		}
-
-		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
-		}
-
-		// Only if it's a MsgExecLegacyContent we try to execute the
-		// proposal in a cached context.
-		// For other Msgs, we do not verify the proposal messages any further.
-		// They may fail upon execution.
-		// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
-		msg, ok := msg.(*v1.MsgExecLegacyContent)
-		if !ok {
-			continue
-		}
-
-		content, err := v1.LegacyContentFromMessage(msg)
-		if err != nil {
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalContent, "%+v", err)
-		}
-
-		// Ensure that the content has a respective handler
-		if !k.legacyRouter.HasRoute(content.ProposalRoute()) {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
-		}
-
-		if err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
-			handler := k.legacyRouter.GetRoute(content.ProposalRoute())
-			// NOTE: the support of legacy gov proposal in server/v2 is different than for baseapp.
-			// Legacy proposal in server/v2 can only access services provided by the gov module environment.
-			if err := handler(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), content); err != nil {
-				return types.ErrInvalidProposalContent.Wrapf("failed to run legacy handler %s, %+v", content.ProposalRoute(), err)
-			}
-
-			return errors.New("we don't want to execute the proposal, we just want to check if it can be executed")
-		}); errors.Is(err, types.ErrInvalidProposalContent) {
+		if err := k.validateProposalMsg(ctx, msg); err != nil {
 			return v1.Proposal{}, err
 		}
 	}
@@ -168,6 +111,141 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	return proposal, nil
 }
 
+// validateProposalMsg checks that a proposal message is valid, has a handler and
+// has the gov module account as its only signer.
+func (k Keeper) validateProposalMsg(ctx context.Context, msg sdk.Msg) error {
+	// perform a basic validation of the message
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 {
+		return types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidSigner, err.Error())
+		}
+		return errorsmod.Wrapf(types.ErrInvalidSigner, addr)
+	}
+
+	if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+		return errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
+	}
+
+	// Only if it's a MsgExecLegacyContent we try to execute the
+	// proposal in a cached context.
+	// For other Msgs, we do not verify the proposal messages any further.
+	// They may fail upon execution.
+	// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
+	legacyMsg, ok := msg.(*v1.MsgExecLegacyContent)
+	if !ok {
+		return nil
+	}
+
+	content, err := v1.LegacyContentFromMessage(legacyMsg)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidProposalContent, "%+v", err)
+	}
+
+	// Ensure that the content has a respective handler
+	if !k.legacyRouter.HasRoute(content.ProposalRoute()) {
+		return errorsmod.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	if err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		handler := k.legacyRouter.GetRoute(content.ProposalRoute())
+		// NOTE: the support of legacy gov proposal in server/v2 is different than for baseapp.
+		// Legacy proposal in server/v2 can only access services provided by the gov module environment.
+		if err := handler(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), content); err != nil {
+			return types.ErrInvalidProposalContent.Wrapf("failed to run legacy handler %s, %+v", content.ProposalRoute(), err)
+		}
+
+		return errors.New("we don't want to execute the proposal, we just want to check if it can be executed")
+	}); errors.Is(err, types.ErrInvalidProposalContent) {
+		return err
+	}
+
+	return nil
+}
+
+// validateOptionMessages checks the messages to execute when an option of a
+// multiple choice proposal wins. Each option must be one of the vote options of
+// the proposal and appear at most once.
+func (k Keeper) validateOptionMessages(ctx context.Context, voteOptions *v1.ProposalVoteOptions, optionMessages []*v1.ProposalOptionMessages) error {
+	seen := make(map[v1.VoteOption]bool, len(optionMessages))
+	for _, om := range optionMessages {
+		if om == nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalMsg, "option messages cannot be nil")
+		}
+
+		if !isDefinedVoteOption(voteOptions, om.Option) {
+			return errorsmod.Wrapf(types.ErrInvalidVote, "option %s is not a vote option of the proposal", om.Option)
+		}
+
+		if seen[om.Option] {
+			return errorsmod.Wrapf(types.ErrInvalidProposalMsg, "duplicate messages for option %s", om.Option)
+		}
+		seen[om.Option] = true
+
+		msgs, err := om.GetMsgs()
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+
+		if len(msgs) == 0 {
+			return errorsmod.Wrapf(types.ErrNoProposalMsgs, "option %s has no messages", om.Option)
+		}
+
+		for _, msg := range msgs {
+			hasMessagedBasedParams, err := k.MessageBasedParams.Has(ctx, sdk.MsgTypeURL(msg))
+			if err != nil {
+				return err
+			}
+
+			if hasMessagedBasedParams {
+				return errorsmod.Wrap(types.ErrInvalidProposalType, "cannot submit non standard proposal with message based params")
+			}
+
+			if err := k.validateProposalMsg(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isDefinedVoteOption returns whether the given option is one of the options
+// defined for a multiple choice proposal.
+func isDefinedVoteOption(voteOptions *v1.ProposalVoteOptions, option v1.VoteOption) bool {
+	if voteOptions == nil {
+		return false
+	}
+
+	switch option {
+	case v1.OptionOne:
+		return voteOptions.OptionOne != ""
+	case v1.OptionTwo:
+		return voteOptions.OptionTwo != ""
+	case v1.OptionThree:
+		return voteOptions.OptionThree != ""
+	case v1.OptionFour:
+		return voteOptions.OptionFour != ""
+	default:
+		return false
+	}
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	proposal, err := k.Proposals.Get(ctx, proposalID)
@@ -261,6 +339,10 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 		}
 	}
 
+	if err := k.deleteVotingPowerSnapshot(ctx, proposalID); err != nil {
+		return err
+	}
+
 	return k.Proposals.Remove(ctx, proposalID)
 }
 
@@ -299,6 +381,12 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 		return err
 	}
 
+	if params.SnapshotVotingPower {
+		if err = k.snapshotVotingPower(ctx, proposal.Id); err != nil {
+			return err
+		}
+	}
+
 	if err = k.InactiveProposalsQueue.Remove(ctx, collections.Join(*proposal.DepositEndTime, proposal.Id)); err != nil {
 		return err
 	}
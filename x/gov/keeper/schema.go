@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/schema"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+var (
+	proposalStatusEnum = schema.EnumDefinition{Name: "proposal_status", Values: sortedEnumNames(v1.ProposalStatus_name)}
+	proposalTypeEnum   = schema.EnumDefinition{Name: "proposal_type", Values: sortedEnumNames(v1.ProposalType_name)}
+)
+
+// sortedEnumNames returns the names of a protobuf enum, ordered by number.
+func sortedEnumNames(names map[int32]string) []string {
+	numbers := make([]int32, 0, len(names))
+	for number := range names {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	res := make([]string, len(numbers))
+	for i, number := range numbers {
+		res[i] = names[number]
+	}
+	return res
+}
+
+// ModuleCodec returns the schema of the proposals, their multiple choice vote options and the votes,
+// and the decoder of their state changes for indexers.
+func (k Keeper) ModuleCodec() (schema.ModuleCodec, error) {
+	proposalID := schema.Field{Name: "proposal_id", Kind: schema.Uint64Kind}
+	optionName := func(name string) schema.Field {
+		return schema.Field{Name: name, Kind: schema.StringKind}
+	}
+	nullableTime := func(name string) schema.Field {
+		return schema.Field{Name: name, Kind: schema.TimeKind, Nullable: true}
+	}
+
+	return schema.ModuleCodec{
+		Schema: schema.ModuleSchema{
+			ObjectTypes: []schema.ObjectType{
+				{
+					Name:      "proposal",
+					KeyFields: []schema.Field{proposalID},
+					ValueFields: []schema.Field{
+						{Name: "title", Kind: schema.StringKind},
+						{Name: "summary", Kind: schema.StringKind},
+						{Name: "metadata", Kind: schema.StringKind},
+						{Name: "proposer", Kind: schema.StringKind},
+						{Name: "status", Kind: schema.EnumKind, EnumDefinition: proposalStatusEnum},
+						{Name: "proposal_type", Kind: schema.EnumKind, EnumDefinition: proposalTypeEnum},
+						nullableTime("submit_time"),
+						nullableTime("deposit_end_time"),
+						nullableTime("voting_start_time"),
+						nullableTime("voting_end_time"),
+						{Name: "final_tally_result", Kind: schema.JSONKind, Nullable: true},
+						{Name: "option_messages", Kind: schema.JSONKind, Nullable: true},
+						{Name: "failed_reason", Kind: schema.StringKind},
+					},
+				},
+				{
+					Name:      "proposal_vote_options",
+					KeyFields: []schema.Field{proposalID},
+					ValueFields: []schema.Field{
+						optionName("option_one"),
+						optionName("option_two"),
+						optionName("option_three"),
+						optionName("option_four"),
+						optionName("option_spam"),
+					},
+				},
+				{
+					Name:      "vote",
+					KeyFields: []schema.Field{proposalID, {Name: "voter", Kind: schema.StringKind}},
+					ValueFields: []schema.Field{
+						{Name: "options", Kind: schema.JSONKind},
+						{Name: "metadata", Kind: schema.StringKind},
+					},
+				},
+			},
+		},
+		KVDecoder: k.decodeKVPair,
+	}, nil
+}
+
+// decodeKVPair decodes the changes of the proposals, of their vote options and of the votes.
+// The changes of the other collections of the module are ignored.
+func (k Keeper) decodeKVPair(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+	switch {
+	case bytes.HasPrefix(update.Key, k.Proposals.GetPrefix()):
+		_, id, err := k.Proposals.KeyCodec().Decode(update.Key[len(k.Proposals.GetPrefix()):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the key of a proposal: %w", err)
+		}
+		objectUpdate := schema.ObjectUpdate{TypeName: "proposal", Key: id, Delete: update.Delete}
+		if !update.Delete {
+			proposal, err := k.Proposals.ValueCodec().Decode(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode proposal %d: %w", id, err)
+			}
+			if objectUpdate.Value, err = k.proposalObjectValue(proposal); err != nil {
+				return nil, fmt.Errorf("failed to decode proposal %d: %w", id, err)
+			}
+		}
+		return []schema.ObjectUpdate{objectUpdate}, nil
+
+	case bytes.HasPrefix(update.Key, k.ProposalVoteOptions.GetPrefix()):
+		_, id, err := k.ProposalVoteOptions.KeyCodec().Decode(update.Key[len(k.ProposalVoteOptions.GetPrefix()):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the key of proposal vote options: %w", err)
+		}
+		objectUpdate := schema.ObjectUpdate{TypeName: "proposal_vote_options", Key: id, Delete: update.Delete}
+		if !update.Delete {
+			options, err := k.ProposalVoteOptions.ValueCodec().Decode(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode the vote options of proposal %d: %w", id, err)
+			}
+			objectUpdate.Value = []any{options.OptionOne, options.OptionTwo, options.OptionThree, options.OptionFour, options.OptionSpam}
+		}
+		return []schema.ObjectUpdate{objectUpdate}, nil
+
+	case bytes.HasPrefix(update.Key, k.Votes.GetPrefix()):
+		_, key, err := k.Votes.KeyCodec().Decode(update.Key[len(k.Votes.GetPrefix()):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the key of a vote: %w", err)
+		}
+		voter, err := k.authKeeper.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return nil, err
+		}
+		objectUpdate := schema.ObjectUpdate{TypeName: "vote", Key: []any{key.K1(), voter}, Delete: update.Delete}
+		if !update.Delete {
+			vote, err := k.Votes.ValueCodec().Decode(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode the vote of %s on proposal %d: %w", voter, key.K1(), err)
+			}
+			if objectUpdate.Value, err = voteObjectValue(vote); err != nil {
+				return nil, fmt.Errorf("failed to decode the vote of %s on proposal %d: %w", voter, key.K1(), err)
+			}
+		}
+		return []schema.ObjectUpdate{objectUpdate}, nil
+	}
+
+	return nil, nil
+}
+
+// proposalObjectValue returns the value fields of a proposal object.
+func (k Keeper) proposalObjectValue(proposal v1.Proposal) ([]any, error) {
+	var finalTallyResult, optionMessages any
+	if proposal.FinalTallyResult != nil {
+		bz, err := k.cdc.MarshalJSON(proposal.FinalTallyResult)
+		if err != nil {
+			return nil, err
+		}
+		finalTallyResult = json.RawMessage(bz)
+	}
+	if len(proposal.OptionMessages) > 0 {
+		msgs := make([]json.RawMessage, len(proposal.OptionMessages))
+		for i, om := range proposal.OptionMessages {
+			bz, err := k.cdc.MarshalJSON(om)
+			if err != nil {
+				return nil, err
+			}
+			msgs[i] = bz
+		}
+		bz, err := json.Marshal(msgs)
+		if err != nil {
+			return nil, err
+		}
+		optionMessages = json.RawMessage(bz)
+	}
+
+	return []any{
+		proposal.Title,
+		proposal.Summary,
+		proposal.Metadata,
+		proposal.Proposer,
+		proposal.Status.String(),
+		proposal.ProposalType.String(),
+		timeValue(proposal.SubmitTime),
+		timeValue(proposal.DepositEndTime),
+		timeValue(proposal.VotingStartTime),
+		timeValue(proposal.VotingEndTime),
+		finalTallyResult,
+		optionMessages,
+		proposal.FailedReason,
+	}, nil
+}
+
+// voteObjectValue returns the value fields of a vote object. The options have their standard
+// names: VOTE_OPTION_ONE to VOTE_OPTION_FOUR of multiple choice proposals are aliases of them.
+func voteObjectValue(vote v1.Vote) ([]any, error) {
+	type weightedOption struct {
+		Option string `json:"option"`
+		Weight string `json:"weight"`
+	}
+
+	options := make([]weightedOption, len(vote.Options))
+	for i, option := range vote.Options {
+		options[i] = weightedOption{Option: option.Option.String(), Weight: option.Weight}
+	}
+	bz, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{json.RawMessage(bz), vote.Metadata}, nil
+}
+
+// timeValue returns the value of a nullable time field.
+func timeValue(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestModuleCodec(t *testing.T) {
+	govKeeper, mocks, _, _ := setupGovKeeper(t)
+	mocks.acctKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	cdc, err := govKeeper.ModuleCodec()
+	require.NoError(t, err)
+	require.NoError(t, cdc.Schema.Validate())
+
+	kvPair := func(prefix collections.Prefix, key, value []byte) schema.KVPairUpdate {
+		return schema.KVPairUpdate{Key: append(append([]byte{}, prefix.Bytes()...), key...), Value: value, Delete: value == nil}
+	}
+
+	votingEndTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	proposal := v1.Proposal{
+		Id:            1,
+		Title:         "Pick a color",
+		Summary:       "Chain color",
+		Proposer:      "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r",
+		Status:        v1.StatusVotingPeriod,
+		ProposalType:  v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+		VotingEndTime: &votingEndTime,
+		OptionMessages: []*v1.ProposalOptionMessages{
+			{Option: v1.OptionOne},
+		},
+	}
+	proposalValue, err := govKeeper.Proposals.ValueCodec().Encode(proposal)
+	require.NoError(t, err)
+
+	voteOptionsValue, err := govKeeper.ProposalVoteOptions.ValueCodec().Encode(v1.ProposalVoteOptions{OptionOne: "red", OptionTwo: "blue", OptionSpam: "spam"})
+	require.NoError(t, err)
+
+	voter := sdk.AccAddress("voter_______________")
+	voterStr, err := address.NewBech32Codec("cosmos").BytesToString(voter)
+	require.NoError(t, err)
+	voteKey := make([]byte, govKeeper.Votes.KeyCodec().Size(collections.Join(uint64(1), voter)))
+	_, err = govKeeper.Votes.KeyCodec().Encode(voteKey, collections.Join(uint64(1), voter))
+	require.NoError(t, err)
+	voteValue, err := govKeeper.Votes.ValueCodec().Encode(v1.Vote{ProposalId: 1, Voter: voterStr, Options: v1.NewNonSplitVoteOption(v1.OptionTwo), Metadata: "metadata"})
+	require.NoError(t, err)
+
+	proposalKey := sdk.Uint64ToBigEndian(1)
+
+	testCases := []struct {
+		name       string
+		update     schema.KVPairUpdate
+		expUpdates []schema.ObjectUpdate
+	}{
+		{
+			name:   "proposal",
+			update: kvPair(types.ProposalsKeyPrefix, proposalKey, proposalValue),
+			expUpdates: []schema.ObjectUpdate{{
+				TypeName: "proposal",
+				Key:      uint64(1),
+				Value: []any{
+					"Pick a color", "Chain color", "", "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r",
+					"PROPOSAL_STATUS_VOTING_PERIOD", "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+					nil, nil, nil, votingEndTime,
+					nil, json.RawMessage(`[{"option":"VOTE_OPTION_YES","messages":[]}]`),
+					"",
+				},
+			}},
+		},
+		{
+			name:   "deleted proposal",
+			update: kvPair(types.ProposalsKeyPrefix, proposalKey, nil),
+			expUpdates: []schema.ObjectUpdate{{
+				TypeName: "proposal",
+				Key:      uint64(1),
+				Delete:   true,
+			}},
+		},
+		{
+			name:   "proposal vote options",
+			update: kvPair(types.ProposalVoteOptionsKeyPrefix, proposalKey, voteOptionsValue),
+			expUpdates: []schema.ObjectUpdate{{
+				TypeName: "proposal_vote_options",
+				Key:      uint64(1),
+				Value:    []any{"red", "blue", "", "", "spam"},
+			}},
+		},
+		{
+			name:   "vote",
+			update: kvPair(types.VotesKeyPrefix, voteKey, voteValue),
+			expUpdates: []schema.ObjectUpdate{{
+				TypeName: "vote",
+				Key:      []any{uint64(1), voterStr},
+				Value:    []any{json.RawMessage(`[{"option":"VOTE_OPTION_ABSTAIN","weight":"1.000000000000000000"}]`), "metadata"},
+			}},
+		},
+		{
+			name:   "ignored collection",
+			update: kvPair(types.ParamsKey, nil, []byte{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updates, err := cdc.KVDecoder(tc.update)
+			require.NoError(t, err)
+			require.Equal(t, tc.expUpdates, updates)
+			for _, update := range updates {
+				require.NoError(t, cdc.Schema.ValidateObjectUpdate(update))
+			}
+		})
+	}
+}
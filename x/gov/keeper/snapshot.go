@@ -0,0 +1,325 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorSnapshotsIndexes indexes the validator snapshots by validator.
+type ValidatorSnapshotsIndexes struct {
+	Validator *indexes.ReversePair[uint64, sdk.ValAddress, v1.ValidatorPowerSnapshot]
+}
+
+func newValidatorSnapshotsIndexes(sb *collections.SchemaBuilder) ValidatorSnapshotsIndexes {
+	return ValidatorSnapshotsIndexes{
+		Validator: indexes.NewReversePair[v1.ValidatorPowerSnapshot](
+			sb, types.ValidatorSnapshotsByValPrefix, "validator_snapshots_by_validator",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey),
+		),
+	}
+}
+
+func (i ValidatorSnapshotsIndexes) IndexesList() []collections.Index[collections.Pair[uint64, sdk.ValAddress], v1.ValidatorPowerSnapshot] {
+	return []collections.Index[collections.Pair[uint64, sdk.ValAddress], v1.ValidatorPowerSnapshot]{i.Validator}
+}
+
+// snapshotVotingPower stores the bonded validators and the total bonded tokens
+// when the voting period of a proposal starts. Delegations are snapshotted lazily,
+// the first time they are modified during the voting period (see StakingHooks).
+func (k Keeper) snapshotVotingPower(ctx context.Context, proposalID uint64) error {
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TotalBondedSnapshots.Set(ctx, proposalID, totalBonded); err != nil {
+		return err
+	}
+
+	var iterErr error
+	if err := k.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator sdk.ValidatorI) (stop bool) {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		iterErr = k.ValidatorSnapshots.Set(ctx, collections.Join(proposalID, sdk.ValAddress(valAddr)), v1.ValidatorPowerSnapshot{
+			BondedTokens:    validator.GetBondedTokens().String(),
+			DelegatorShares: validator.GetDelegatorShares().String(),
+		})
+		return iterErr != nil
+	}); err != nil {
+		return err
+	}
+
+	return iterErr
+}
+
+// deleteVotingPowerSnapshot deletes the voting power snapshot of a proposal, if any.
+func (k Keeper) deleteVotingPowerSnapshot(ctx context.Context, proposalID uint64) error {
+	if err := k.TotalBondedSnapshots.Remove(ctx, proposalID); err != nil {
+		return err
+	}
+
+	iter, err := k.ValidatorSnapshots.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	// the snapshots are removed one by one to keep the validator index in sync
+	for _, key := range keys {
+		if err := k.ValidatorSnapshots.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return k.DelegationSnapshots.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID))
+}
+
+// snapshotDelegation records the current shares of a delegation, for every
+// proposal that snapshotted the validator and has not recorded the delegation
+// yet. It must be called before the delegation is modified.
+func (k Keeper) snapshotDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	var shares *math.LegacyDec
+
+	// only delegations to validators bonded when the voting period started have voting power
+	iter, err := k.ValidatorSnapshots.Indexes.Validator.MatchExact(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	validatorSnapshots, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, validatorSnapshot := range validatorSnapshots {
+		proposalID := validatorSnapshot.K1()
+		key := collections.Join3(proposalID, delAddr, valAddr)
+		hasDelegation, err := k.DelegationSnapshots.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if hasDelegation {
+			continue
+		}
+
+		if shares == nil {
+			current, err := k.getDelegationShares(ctx, delAddr, valAddr)
+			if err != nil {
+				return err
+			}
+			shares = &current
+		}
+
+		if err := k.DelegationSnapshots.Set(ctx, key, v1.DelegationSnapshot{Shares: shares.String()}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getDelegationShares returns the shares of a delegation, or zero if the delegation does not exist.
+func (k Keeper) getDelegationShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	delegation, err := k.sk.Delegation(ctx, delAddr, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return delegation.GetShares(), nil
+}
+
+// getTallyValidators returns the validators whose voting power is tallied for a
+// proposal, along with the total bonded tokens. Those are taken from the voting
+// power snapshot of the proposal if any, or from the current staking state otherwise.
+func (k Keeper) getTallyValidators(ctx context.Context, proposalID uint64) (map[string]v1.ValidatorGovInfo, math.Int, error) {
+	totalBonded, err := k.TotalBondedSnapshots.Get(ctx, proposalID)
+	if errors.Is(err, collections.ErrNotFound) {
+		validators, err := k.getCurrentValidators(ctx)
+		if err != nil {
+			return nil, math.Int{}, err
+		}
+
+		totalBonded, err := k.sk.TotalBondedTokens(ctx)
+		return validators, totalBonded, err
+	} else if err != nil {
+		return nil, math.Int{}, err
+	}
+
+	validators := make(map[string]v1.ValidatorGovInfo)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID)
+	if err := k.ValidatorSnapshots.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.ValAddress], snapshot v1.ValidatorPowerSnapshot) (bool, error) {
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		bondedTokens, ok := math.NewIntFromString(snapshot.BondedTokens)
+		if !ok {
+			return true, errors.New("invalid bonded tokens in validator snapshot")
+		}
+
+		delegatorShares, err := math.LegacyNewDecFromStr(snapshot.DelegatorShares)
+		if err != nil {
+			return true, err
+		}
+
+		validators[valAddrStr] = v1.NewValidatorGovInfo(key.K2(), bondedTokens, delegatorShares, math.LegacyZeroDec(), v1.WeightedVoteOptions{})
+		return false, nil
+	}); err != nil {
+		return nil, math.Int{}, err
+	}
+
+	return validators, totalBonded, nil
+}
+
+// iterateVoterDelegations iterates over the delegations of a voter that are tallied
+// for a proposal. If the voting power of the proposal is snapshotted, the shares of
+// the delegations modified during the voting period are the ones of the snapshot.
+func (k Keeper) iterateVoterDelegations(ctx context.Context, proposalID uint64, voter sdk.AccAddress, fn func(valAddr string, shares math.LegacyDec)) error {
+	hasSnapshot, err := k.TotalBondedSnapshots.Has(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if !hasSnapshot {
+		return k.sk.IterateDelegations(ctx, voter, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+			fn(delegation.GetValidatorAddr(), delegation.GetShares())
+			return false
+		})
+	}
+
+	snapshotted := make(map[string]bool)
+	rng := collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID, voter)
+	if err := k.DelegationSnapshots.Walk(ctx, rng, func(key collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], snapshot v1.DelegationSnapshot) (bool, error) {
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K3())
+		if err != nil {
+			return true, err
+		}
+		snapshotted[valAddrStr] = true
+
+		shares, err := math.LegacyNewDecFromStr(snapshot.Shares)
+		if err != nil {
+			return true, err
+		}
+
+		if shares.IsPositive() {
+			fn(valAddrStr, shares)
+		}
+
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	// delegations not modified since the voting period started are unchanged
+	return k.sk.IterateDelegations(ctx, voter, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		if !snapshotted[delegation.GetValidatorAddr()] {
+			fn(delegation.GetValidatorAddr(), delegation.GetShares())
+		}
+
+		return false
+	})
+}
+
+// SetVotingPowerSnapshot stores the voting power snapshot of a proposal, as exported
+// in genesis.
+func (k Keeper) SetVotingPowerSnapshot(ctx context.Context, snapshot *v1.VotingPowerSnapshot) error {
+	totalBonded, ok := math.NewIntFromString(snapshot.TotalBonded)
+	if !ok {
+		return fmt.Errorf("invalid total bonded tokens in the voting power snapshot of proposal %d: %s", snapshot.ProposalId, snapshot.TotalBonded)
+	}
+	if err := k.TotalBondedSnapshots.Set(ctx, snapshot.ProposalId, totalBonded); err != nil {
+		return err
+	}
+
+	for _, validator := range snapshot.Validators {
+		if validator.Snapshot == nil {
+			return fmt.Errorf("missing snapshot of validator %s in the voting power snapshot of proposal %d", validator.ValidatorAddress, snapshot.ProposalId)
+		}
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.ValidatorSnapshots.Set(ctx, collections.Join(snapshot.ProposalId, sdk.ValAddress(valAddr)), *validator.Snapshot); err != nil {
+			return err
+		}
+	}
+
+	for _, delegation := range snapshot.Delegations {
+		if delegation.Snapshot == nil {
+			return fmt.Errorf("missing snapshot of delegation %s/%s in the voting power snapshot of proposal %d", delegation.DelegatorAddress, delegation.ValidatorAddress, snapshot.ProposalId)
+		}
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.DelegationSnapshots.Set(ctx, collections.Join3(snapshot.ProposalId, sdk.AccAddress(delAddr), sdk.ValAddress(valAddr)), *delegation.Snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetVotingPowerSnapshots returns the voting power snapshots of all the proposals,
+// to be exported in genesis.
+func (k Keeper) GetVotingPowerSnapshots(ctx context.Context) ([]*v1.VotingPowerSnapshot, error) {
+	var snapshots []*v1.VotingPowerSnapshot
+	err := k.TotalBondedSnapshots.Walk(ctx, nil, func(proposalID uint64, totalBonded math.Int) (stop bool, err error) {
+		snapshot := &v1.VotingPowerSnapshot{ProposalId: proposalID, TotalBonded: totalBonded.String()}
+
+		err = k.ValidatorSnapshots.Walk(ctx, collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID), func(key collections.Pair[uint64, sdk.ValAddress], value v1.ValidatorPowerSnapshot) (bool, error) {
+			valAddr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K2())
+			if err != nil {
+				return true, err
+			}
+			snapshot.Validators = append(snapshot.Validators, &v1.GenesisValidatorPowerSnapshot{ValidatorAddress: valAddr, Snapshot: &value})
+			return false, nil
+		})
+		if err != nil {
+			return true, err
+		}
+
+		err = k.DelegationSnapshots.Walk(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID), func(key collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], value v1.DelegationSnapshot) (bool, error) {
+			delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K2())
+			if err != nil {
+				return true, err
+			}
+			valAddr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K3())
+			if err != nil {
+				return true, err
+			}
+			snapshot.Delegations = append(snapshot.Delegations, &v1.GenesisDelegationSnapshot{DelegatorAddress: delAddr, ValidatorAddress: valAddr, Snapshot: &value})
+			return false, nil
+		})
+		if err != nil {
+			return true, err
+		}
+
+		snapshots = append(snapshots, snapshot)
+		return false, nil
+	})
+
+	return snapshots, err
+}
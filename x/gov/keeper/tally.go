@@ -13,7 +13,7 @@ import (
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the voters
 func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
-	validators, err := k.getCurrentValidators(ctx)
+	validators, totalBonded, err := k.getTallyValidators(ctx, proposal.Id)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
@@ -34,11 +34,6 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is no staked coins, the proposal fails
-	totalBonded, err := k.sk.TotalBondedTokens(ctx)
-	if err != nil {
-		return false, false, v1.TallyResult{}, err
-	}
-
 	if totalBonded.IsZero() {
 		return false, false, tallyResults, nil
 	}
@@ -56,7 +51,7 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
 		return k.tallyExpedited(totalVoterPower, totalBonded, results, params)
 	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
-		return k.tallyMultipleChoice(totalVoterPower, totalBonded, results, params)
+		return k.tallyMultipleChoice(proposal, totalVoterPower, totalBonded, results, params)
 	default:
 		return k.tallyStandard(ctx, proposal, totalVoterPower, totalBonded, results, params)
 	}
@@ -193,9 +188,10 @@ func (k Keeper) tallyOptimistic(totalVoterPower math.LegacyDec, totalBonded math
 
 // tallyMultipleChoice tallies the votes of a multiple choice proposal
 // If there is not enough quorum of votes, the proposal fails
+// If the proposal has option messages and no option wins, the proposal fails
 // Any other case, proposal passes
 // Checking for spam votes is done before calling this function
-func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+func (k Keeper) tallyMultipleChoice(proposal v1.Proposal, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is not enough quorum of votes, the proposal fails
@@ -205,7 +201,25 @@ func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
-	// a multiple choice proposal always passes unless it was spam or quorum was not reached.
+	// a proposal executing the messages of the winning option must have a winner:
+	// an option with strictly more votes than the others, and a large enough share of them.
+	if len(proposal.OptionMessages) > 0 {
+		threshold, err := multipleChoiceThreshold(params)
+		if err != nil {
+			return false, false, tallyResults, err
+		}
+
+		winner, err := tallyResults.WinningOption(threshold)
+		if err != nil {
+			return false, false, tallyResults, err
+		}
+
+		if winner == v1.OptionEmpty {
+			return false, false, tallyResults, nil
+		}
+	}
+
+	// a multiple choice proposal otherwise always passes unless it was spam or quorum was not reached.
 
 	return true, false, tallyResults, nil
 }
@@ -266,17 +280,15 @@ func defaultCalculateVoteResultsAndVotingPower(
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
-
+		err = k.iterateVoterDelegations(ctx, proposalID, voter, func(valAddrStr string, shares math.LegacyDec) {
 			if val, ok := validators[valAddrStr]; ok {
 				// There is no need to handle the special case that validator address equal to voter address.
 				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(shares)
 				validators[valAddrStr] = val
 
 				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+				votingPower := shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
 				for _, option := range vote.Options {
 					weight, _ := math.LegacyNewDecFromStr(option.Weight)
@@ -286,8 +298,6 @@ func defaultCalculateVoteResultsAndVotingPower(
 
 				totalVP = totalVP.Add(votingPower)
 			}
-
-			return false
 		})
 		if err != nil {
 			return false, err
@@ -336,3 +346,13 @@ func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
 
 	return results
 }
+
+// multipleChoiceThreshold returns the multiple choice threshold of the params,
+// or the default one if it is not set.
+func multipleChoiceThreshold(params v1.Params) (math.LegacyDec, error) {
+	if params.MultipleChoiceThreshold == "" {
+		return v1.DefaultMultipleChoiceThreshold, nil
+	}
+
+	return math.LegacyNewDecFromStr(params.MultipleChoiceThreshold)
+}
@@ -1156,12 +1156,15 @@ func TestTally_Optimistic(t *testing.T) {
 
 func TestTally_MultipleChoice(t *testing.T) {
 	tests := []struct {
-		name          string
-		setup         func(tallyFixture)
-		expectedPass  bool
-		expectedBurn  bool
-		expectedTally v1.TallyResult
-		expectedError string
+		name           string
+		setup          func(tallyFixture)
+		optionMessages bool
+		threshold      string
+		unsetThreshold bool
+		expectedPass   bool
+		expectedBurn   bool
+		expectedTally  v1.TallyResult
+		expectedError  string
 	}{
 		{
 			name: "no votes, no bonded tokens: prop fails",
@@ -1448,6 +1451,111 @@ func TestTally_MultipleChoice(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			name: "quorum reached, equality with option messages: prop fails",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			optionMessages: true,
+			expectedPass:   false,
+			expectedBurn:   false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "2000000",
+				AbstainCount:     "0",
+				NoCount:          "2000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "2000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "2000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "quorum reached with a majority of option 1 and option messages: prop passes",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[4], v1.VoteOption_VOTE_OPTION_FOUR)
+				validatorVote(s, s.valAddrs[5], v1.VoteOption_VOTE_OPTION_FOUR)
+				validatorVote(s, s.valAddrs[6], v1.VoteOption_VOTE_OPTION_FOUR)
+			},
+			optionMessages: true,
+			threshold:      "0.5",
+			expectedPass:   true,
+			expectedBurn:   false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "4000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "3000000",
+				OptionOneCount:   "4000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "3000000",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "quorum reached with a majority of option 1 below multiple choice threshold: prop fails",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[4], v1.VoteOption_VOTE_OPTION_FOUR)
+				validatorVote(s, s.valAddrs[5], v1.VoteOption_VOTE_OPTION_FOUR)
+				validatorVote(s, s.valAddrs[6], v1.VoteOption_VOTE_OPTION_FOUR)
+			},
+			optionMessages: true,
+			threshold:      "0.6",
+			expectedPass:   false,
+			expectedBurn:   false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "4000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "3000000",
+				OptionOneCount:   "4000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "3000000",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "quorum reached with a majority of option 1 and the multiple choice threshold unset: prop passes",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[2], v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[3], v1.VoteOption_VOTE_OPTION_FOUR)
+			},
+			optionMessages: true,
+			unsetThreshold: true,
+			expectedPass:   true,
+			expectedBurn:   false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "3000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "1000000",
+				OptionOneCount:   "3000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "1000000",
+				SpamCount:        "0",
+			},
+		},
 		{
 			name: "quorum reached with spam > all other votes: prop fails/burn deposit",
 			setup: func(s tallyFixture) {
@@ -1483,6 +1591,12 @@ func TestTally_MultipleChoice(t *testing.T) {
 			// Ensure params value are different than false
 			params.BurnVoteQuorum = true
 			params.BurnVoteVeto = true
+			if tt.threshold != "" {
+				params.MultipleChoiceThreshold = tt.threshold
+			}
+			if tt.unsetThreshold {
+				params.MultipleChoiceThreshold = ""
+			}
 			err := govKeeper.Params.Set(ctx, params)
 			require.NoError(t, err)
 			var (
@@ -1520,6 +1634,9 @@ func TestTally_MultipleChoice(t *testing.T) {
 				OptionFour:  "Vote Option 4",
 			})
 			require.NoError(t, err)
+			if tt.optionMessages {
+				proposal.OptionMessages = []*v1.ProposalOptionMessages{{Option: v1.OptionOne}}
+			}
 			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
 			require.NoError(t, err)
 			suite := tallyFixture{
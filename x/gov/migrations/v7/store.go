@@ -0,0 +1,26 @@
+package v7
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The
+// migration includes:
+//
+// Addition of the gov param for the multiple choice threshold.
+func MigrateStore(ctx context.Context, paramsCollection collections.Item[v1.Params]) error {
+	govParams, err := paramsCollection.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get gov params: %w", err)
+	}
+
+	if govParams.MultipleChoiceThreshold == "" {
+		govParams.MultipleChoiceThreshold = v1.DefaultParams().MultipleChoiceThreshold
+	}
+
+	return paramsCollection.Set(ctx, govParams)
+}
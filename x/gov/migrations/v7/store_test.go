@@ -0,0 +1,52 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/gov"
+	v7 "cosmossdk.io/x/gov/migrations/v7"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, gov.AppModule{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(govKey)
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsCollection := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc))
+
+	// set defaults without newly added fields
+	previousParams := v1.DefaultParams()
+	previousParams.MultipleChoiceThreshold = ""
+	err := paramsCollection.Set(ctx, previousParams)
+	require.NoError(t, err)
+
+	// Run migrations.
+	err = v7.MigrateStore(ctx, paramsCollection)
+	require.NoError(t, err)
+
+	// Check params
+	newParams, err := paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, v1.DefaultParams().MultipleChoiceThreshold, newParams.MultipleChoiceThreshold)
+
+	// a threshold already set is kept
+	previousParams.MultipleChoiceThreshold = "0.5"
+	require.NoError(t, paramsCollection.Set(ctx, previousParams))
+	require.NoError(t, v7.MigrateStore(ctx, paramsCollection))
+	newParams, err = paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.5", newParams.MultipleChoiceThreshold)
+}
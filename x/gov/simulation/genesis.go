@@ -195,6 +195,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			optimisticRejectedThreshold.String(),
 			[]string{},
 			10_000_000,
+			sdkmath.LegacyZeroDec().String(),
+			simState.Rand.Intn(2) == 0,
 		),
 	)
 
@@ -16,16 +16,20 @@ const (
 )
 
 var (
-	ProposalsKeyPrefix           = collections.NewPrefix(0)  // ProposalsKeyPrefix stores the proposals raw bytes.
-	ActiveProposalQueuePrefix    = collections.NewPrefix(1)  // ActiveProposalQueuePrefix stores the active proposals.
-	InactiveProposalQueuePrefix  = collections.NewPrefix(2)  // InactiveProposalQueuePrefix stores the inactive proposals.
-	ProposalIDKey                = collections.NewPrefix(3)  // ProposalIDKey stores the sequence representing the next proposal ID.
-	DepositsKeyPrefix            = collections.NewPrefix(16) // DepositsKeyPrefix stores deposits.
-	VotesKeyPrefix               = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
-	ParamsKey                    = collections.NewPrefix(48) // ParamsKey stores the module's params.
-	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
-	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
-	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	ProposalsKeyPrefix            = collections.NewPrefix(0)  // ProposalsKeyPrefix stores the proposals raw bytes.
+	ActiveProposalQueuePrefix     = collections.NewPrefix(1)  // ActiveProposalQueuePrefix stores the active proposals.
+	InactiveProposalQueuePrefix   = collections.NewPrefix(2)  // InactiveProposalQueuePrefix stores the inactive proposals.
+	ProposalIDKey                 = collections.NewPrefix(3)  // ProposalIDKey stores the sequence representing the next proposal ID.
+	DepositsKeyPrefix             = collections.NewPrefix(16) // DepositsKeyPrefix stores deposits.
+	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
+	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
+	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	ProposalVoteOptionsKeyPrefix  = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
+	MessageBasedParamsKey         = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	TotalBondedSnapshotsPrefix    = collections.NewPrefix(52) // TotalBondedSnapshotsPrefix stores the total bonded tokens when the voting period of proposals started.
+	ValidatorSnapshotsPrefix      = collections.NewPrefix(53) // ValidatorSnapshotsPrefix stores the bonded validators when the voting period of proposals started.
+	DelegationSnapshotsPrefix     = collections.NewPrefix(54) // DelegationSnapshotsPrefix stores the delegations modified since the voting period of proposals started.
+	ValidatorSnapshotsByValPrefix = collections.NewPrefix(55) // ValidatorSnapshotsByValPrefix indexes the proposals that snapshotted a validator by the validator.
 )
 
 // Reserved kvstore keys
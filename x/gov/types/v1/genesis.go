@@ -98,6 +98,24 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate voting power snapshots
+	errGroup.Go(func() error {
+		snapshotIds := make(map[uint64]struct{})
+		for _, s := range data.VotingPowerSnapshots {
+			if _, ok := proposalIds[s.ProposalId]; !ok {
+				return fmt.Errorf("voting power snapshot has non-existent proposal id: %d", s.ProposalId)
+			}
+
+			if _, ok := snapshotIds[s.ProposalId]; ok {
+				return fmt.Errorf("duplicate voting power snapshot of proposal: %d", s.ProposalId)
+			}
+
+			snapshotIds[s.ProposalId] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
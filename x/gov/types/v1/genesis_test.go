@@ -95,6 +95,16 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "veto threshold too large",
 		},
+		{
+			name: "invalid multiple choice threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MultipleChoiceThreshold = "-0.1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "multiple choice threshold cannot be negative",
+		},
 		{
 			name: "duplicate proposals",
 			genesisState: func() *v1.GenesisState {
@@ -172,6 +182,39 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "non-existent proposal id in voting power snapshots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots,
+					&v1.VotingPowerSnapshot{
+						ProposalId:  1,
+						TotalBonded: "100",
+					})
+
+				return state
+			},
+			expErrMsg: "voting power snapshot has non-existent proposal id: 1",
+		},
+		{
+			name: "duplicate voting power snapshots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots,
+					&v1.VotingPowerSnapshot{
+						ProposalId:  1,
+						TotalBonded: "100",
+					},
+					&v1.VotingPowerSnapshot{
+						ProposalId:  1,
+						TotalBonded: "200",
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate voting power snapshot of proposal: 1",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -342,6 +342,9 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// option_messages are the messages to be executed for the winning option of a
+	// multiple choice proposal.
+	OptionMessages []*ProposalOptionMessages `protobuf:"bytes,17,rep,name=option_messages,json=optionMessages,proto3" json:"option_messages,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +493,13 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetOptionMessages() []*ProposalOptionMessages {
+	if m != nil {
+		return m.OptionMessages
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -573,6 +583,62 @@ func (m *ProposalVoteOptions) GetOptionSpam() string {
 	return ""
 }
 
+// ProposalOptionMessages defines the messages to be executed if a given option
+// of a multiple choice proposal wins.
+type ProposalOptionMessages struct {
+	// option is the vote option the messages are bound to.
+	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
+	// messages are the arbitrary messages to be executed if the option wins.
+	Messages []*any.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ProposalOptionMessages) Reset()         { *m = ProposalOptionMessages{} }
+func (m *ProposalOptionMessages) String() string { return proto.CompactTextString(m) }
+func (*ProposalOptionMessages) ProtoMessage()    {}
+func (*ProposalOptionMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
+}
+func (m *ProposalOptionMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalOptionMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalOptionMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalOptionMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalOptionMessages.Merge(m, src)
+}
+func (m *ProposalOptionMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalOptionMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalOptionMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalOptionMessages proto.InternalMessageInfo
+
+func (m *ProposalOptionMessages) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *ProposalOptionMessages) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -602,7 +668,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +782,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,7 +854,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,7 +909,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +963,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,15 +1022,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +1041,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1005,13 +1071,23 @@ type Params struct {
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum      string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
 	ProposalExecutionGas uint64 `protobuf:"varint,22,opt,name=proposal_execution_gas,json=proposalExecutionGas,proto3" json:"proposal_execution_gas,omitempty"`
+	// multiple_choice_threshold defines the minimum proportion of the votes (excluding spam votes) the
+	// winning option of a multiple choice proposal must get. The winning option must also get strictly
+	// more votes than any other option. Its messages, if any, are executed when the proposal passes.
+	// Default value: 0 (a plurality of the votes is enough).
+	MultipleChoiceThreshold string `protobuf:"bytes,23,opt,name=multiple_choice_threshold,json=multipleChoiceThreshold,proto3" json:"multiple_choice_threshold,omitempty"`
+	// snapshot_voting_power defines whether proposals are tallied based on the staking state when
+	// their voting period started, instead of the staking state when their voting period ends.
+	// This prevents stake moved during the voting period from changing the outcome of a proposal.
+	// Default value: false.
+	SnapshotVotingPower bool `protobuf:"varint,24,opt,name=snapshot_voting_power,json=snapshotVotingPower,proto3" json:"snapshot_voting_power,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{9}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,6 +1270,123 @@ func (m *Params) GetProposalExecutionGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetMultipleChoiceThreshold() string {
+	if m != nil {
+		return m.MultipleChoiceThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetSnapshotVotingPower() bool {
+	if m != nil {
+		return m.SnapshotVotingPower
+	}
+	return false
+}
+
+// ValidatorPowerSnapshot defines the voting power of a bonded validator when the
+// voting period of a proposal started.
+type ValidatorPowerSnapshot struct {
+	// bonded_tokens is the amount of tokens bonded to the validator.
+	BondedTokens string `protobuf:"bytes,1,opt,name=bonded_tokens,json=bondedTokens,proto3" json:"bonded_tokens,omitempty"`
+	// delegator_shares is the total amount of shares issued by the validator.
+	DelegatorShares string `protobuf:"bytes,2,opt,name=delegator_shares,json=delegatorShares,proto3" json:"delegator_shares,omitempty"`
+}
+
+func (m *ValidatorPowerSnapshot) Reset()         { *m = ValidatorPowerSnapshot{} }
+func (m *ValidatorPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPowerSnapshot) ProtoMessage()    {}
+func (*ValidatorPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *ValidatorPowerSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPowerSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPowerSnapshot.Merge(m, src)
+}
+func (m *ValidatorPowerSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPowerSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPowerSnapshot proto.InternalMessageInfo
+
+func (m *ValidatorPowerSnapshot) GetBondedTokens() string {
+	if m != nil {
+		return m.BondedTokens
+	}
+	return ""
+}
+
+func (m *ValidatorPowerSnapshot) GetDelegatorShares() string {
+	if m != nil {
+		return m.DelegatorShares
+	}
+	return ""
+}
+
+// DelegationSnapshot defines the shares of a delegation when the voting period
+// of a proposal started.
+type DelegationSnapshot struct {
+	// shares is the amount of shares of the delegation.
+	Shares string `protobuf:"bytes,1,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *DelegationSnapshot) Reset()         { *m = DelegationSnapshot{} }
+func (m *DelegationSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DelegationSnapshot) ProtoMessage()    {}
+func (*DelegationSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *DelegationSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationSnapshot.Merge(m, src)
+}
+func (m *DelegationSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationSnapshot proto.InternalMessageInfo
+
+func (m *DelegationSnapshot) GetShares() string {
+	if m != nil {
+		return m.Shares
+	}
+	return ""
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1215,7 +1408,7 @@ func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
 func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
 func (*MessageBasedParams) ProtoMessage()    {}
 func (*MessageBasedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{10}
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
 }
 func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,145 +1480,159 @@ func init() {
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
 	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
+	proto.RegisterType((*ProposalOptionMessages)(nil), "cosmos.gov.v1.ProposalOptionMessages")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ValidatorPowerSnapshot)(nil), "cosmos.gov.v1.ValidatorPowerSnapshot")
+	proto.RegisterType((*DelegationSnapshot)(nil), "cosmos.gov.v1.DelegationSnapshot")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x19, 0xf6, 0x92, 0xd4, 0x07, 0x5f, 0x51, 0xe4, 0x6a, 0xf4, 0xb5, 0x92, 0xa2, 0x0f, 0x0b, 0x6d,
+	0xa0, 0x3a, 0x11, 0x25, 0x25, 0x55, 0x9b, 0xb8, 0xf1, 0x81, 0x14, 0xd7, 0x36, 0x0d, 0x4b, 0x64,
+	0x97, 0x6b, 0xd9, 0x4e, 0x51, 0x6c, 0x57, 0xdc, 0x31, 0xb5, 0x31, 0x77, 0x87, 0xdd, 0x1d, 0xea,
+	0xa3, 0x7f, 0xa2, 0x39, 0x16, 0x3d, 0x14, 0x05, 0x7a, 0x68, 0x8e, 0x3d, 0x18, 0xfd, 0x0d, 0x41,
+	0x0f, 0x45, 0xe0, 0x53, 0x11, 0xa0, 0x6e, 0x61, 0x1f, 0x8a, 0xe6, 0x27, 0x14, 0x3d, 0x14, 0x33,
+	0x3b, 0xcb, 0x5d, 0x7e, 0x59, 0x94, 0xd1, 0x4b, 0x42, 0xcd, 0x3c, 0xcf, 0x33, 0x33, 0xef, 0xfb,
+	0xcc, 0x3b, 0x2f, 0x69, 0x58, 0xac, 0x13, 0xdf, 0x21, 0xfe, 0x4e, 0x83, 0x9c, 0xed, 0x9c, 0xed,
+	0xb1, 0xff, 0xe5, 0x5b, 0x1e, 0xa1, 0x04, 0x4d, 0x07, 0x13, 0x79, 0x36, 0x72, 0xb6, 0xb7, 0xbc,
+	0x26, 0x70, 0x27, 0xa6, 0x8f, 0x77, 0xce, 0xf6, 0x4e, 0x30, 0x35, 0xf7, 0x76, 0xea, 0xc4, 0x76,
+	0x03, 0xf8, 0xf2, 0x5c, 0x83, 0x34, 0x08, 0xff, 0xb8, 0xc3, 0x3e, 0x89, 0xd1, 0xf5, 0x06, 0x21,
+	0x8d, 0x26, 0xde, 0xe1, 0x7f, 0x9d, 0xb4, 0x9f, 0xed, 0x50, 0xdb, 0xc1, 0x3e, 0x35, 0x9d, 0x96,
+	0x00, 0x2c, 0xf5, 0x02, 0x4c, 0xf7, 0x52, 0x4c, 0xad, 0xf5, 0x4e, 0x59, 0x6d, 0xcf, 0xa4, 0x36,
+	0x09, 0x57, 0x5c, 0x0a, 0x76, 0x64, 0x04, 0x8b, 0x8a, 0xdd, 0x06, 0x53, 0x33, 0xa6, 0x63, 0xbb,
+	0x64, 0x87, 0xff, 0x37, 0x18, 0xda, 0x24, 0x80, 0x1e, 0x63, 0xbb, 0x71, 0x4a, 0xb1, 0x75, 0x4c,
+	0x28, 0xae, 0xb4, 0x98, 0x12, 0xda, 0x83, 0x71, 0xc2, 0x3f, 0x29, 0xd2, 0x86, 0xb4, 0x95, 0xfd,
+	0x68, 0x29, 0xdf, 0x75, 0xea, 0x7c, 0x04, 0xd5, 0x04, 0x10, 0xbd, 0x0f, 0xe3, 0xe7, 0x5c, 0x48,
+	0x49, 0x6c, 0x48, 0x5b, 0xe9, 0x62, 0xf6, 0xe5, 0x8b, 0x6d, 0x10, 0xac, 0x12, 0xae, 0x6b, 0x62,
+	0x76, 0xf3, 0xf7, 0x12, 0x4c, 0x94, 0x70, 0x8b, 0xf8, 0x36, 0x45, 0xeb, 0x30, 0xd5, 0xf2, 0x48,
+	0x8b, 0xf8, 0x66, 0xd3, 0xb0, 0x2d, 0xbe, 0x56, 0x4a, 0x83, 0x70, 0xa8, 0x6c, 0xa1, 0x1f, 0x41,
+	0xda, 0x0a, 0xb0, 0xc4, 0x13, 0xba, 0xca, 0xcb, 0x17, 0xdb, 0x73, 0x42, 0xb7, 0x60, 0x59, 0x1e,
+	0xf6, 0xfd, 0x1a, 0xf5, 0x6c, 0xb7, 0xa1, 0x45, 0x50, 0xf4, 0x19, 0x8c, 0x9b, 0x0e, 0x69, 0xbb,
+	0x54, 0x49, 0x6e, 0x24, 0xb7, 0xa6, 0xa2, 0xfd, 0xb3, 0x34, 0xe5, 0x45, 0x9a, 0xf2, 0x07, 0xc4,
+	0x76, 0x8b, 0xe9, 0xaf, 0x5f, 0xad, 0xdf, 0xf8, 0xea, 0x5f, 0x7f, 0xba, 0x25, 0x69, 0x82, 0xb3,
+	0xf9, 0x87, 0x49, 0x98, 0xac, 0x8a, 0x4d, 0xa0, 0x2c, 0x24, 0x3a, 0x5b, 0x4b, 0xd8, 0x16, 0xda,
+	0x85, 0x49, 0x07, 0xfb, 0xbe, 0xd9, 0xc0, 0xbe, 0x92, 0xe0, 0xe2, 0x73, 0xf9, 0x20, 0x23, 0xf9,
+	0x30, 0x23, 0xf9, 0x82, 0x7b, 0xa9, 0x75, 0x50, 0x68, 0x1f, 0xc6, 0x7d, 0x6a, 0xd2, 0xb6, 0xaf,
+	0x24, 0x79, 0x30, 0x57, 0x7b, 0x82, 0x19, 0x2e, 0x55, 0xe3, 0x20, 0x4d, 0x80, 0xd1, 0x7d, 0x40,
+	0xcf, 0x6c, 0xd7, 0x6c, 0x1a, 0xd4, 0x6c, 0x36, 0x2f, 0x0d, 0x0f, 0xfb, 0xed, 0x26, 0x55, 0x52,
+	0x1b, 0xd2, 0xd6, 0xd4, 0x47, 0xcb, 0x3d, 0x12, 0x3a, 0x83, 0x68, 0x1c, 0xa1, 0xc9, 0x9c, 0x15,
+	0x1b, 0x41, 0x05, 0x98, 0xf2, 0xdb, 0x27, 0x8e, 0x4d, 0x0d, 0x66, 0x33, 0x65, 0x4c, 0x48, 0xf4,
+	0xee, 0x5a, 0x0f, 0x3d, 0x58, 0x4c, 0x7d, 0xf9, 0x8f, 0x75, 0x49, 0x83, 0x80, 0xc4, 0x86, 0xd1,
+	0x03, 0x90, 0x45, 0x74, 0x0d, 0xec, 0x5a, 0x81, 0xce, 0xf8, 0x88, 0x3a, 0x59, 0xc1, 0x54, 0x5d,
+	0x8b, 0x6b, 0x95, 0x61, 0x9a, 0x12, 0x6a, 0x36, 0x0d, 0x31, 0xae, 0x4c, 0x5c, 0x23, 0x47, 0x19,
+	0x4e, 0x0d, 0x0d, 0xf4, 0x10, 0x66, 0xce, 0x08, 0xb5, 0xdd, 0x86, 0xe1, 0x53, 0xd3, 0x13, 0xe7,
+	0x9b, 0x1c, 0x71, 0x5f, 0xb9, 0x80, 0x5a, 0x63, 0x4c, 0xbe, 0xb1, 0xfb, 0x20, 0x86, 0xa2, 0x33,
+	0xa6, 0x47, 0xd4, 0x9a, 0x0e, 0x88, 0xe1, 0x11, 0x97, 0x99, 0x49, 0xa8, 0x69, 0x99, 0xd4, 0x54,
+	0x80, 0xd9, 0x56, 0xeb, 0xfc, 0x8d, 0x7e, 0x00, 0x63, 0xd4, 0xa6, 0x4d, 0xac, 0x4c, 0x71, 0x3f,
+	0xcf, 0x7e, 0xfb, 0x62, 0x3b, 0x17, 0x9c, 0x7c, 0xdb, 0xb7, 0x9e, 0x6f, 0xec, 0xe6, 0x7f, 0xf8,
+	0x63, 0x2d, 0x40, 0xa0, 0x6d, 0x98, 0xf0, 0xdb, 0x8e, 0x63, 0x7a, 0x97, 0x4a, 0x66, 0x38, 0x38,
+	0xc4, 0xa0, 0x7b, 0x30, 0x19, 0xdc, 0x1d, 0xec, 0x29, 0xd3, 0x1c, 0xff, 0xc1, 0xb0, 0xcb, 0x32,
+	0x48, 0xa7, 0x43, 0x46, 0x1f, 0x43, 0x1a, 0x5f, 0xb4, 0xb0, 0x65, 0x53, 0x6c, 0x29, 0xd9, 0x0d,
+	0x69, 0x6b, 0xb2, 0x38, 0xdf, 0xc7, 0xd8, 0xdf, 0x55, 0x24, 0x2d, 0xc2, 0xa1, 0x4f, 0x60, 0xfa,
+	0x99, 0x69, 0x37, 0xb1, 0x65, 0x78, 0xd8, 0xf4, 0x89, 0xab, 0xe4, 0x86, 0x6c, 0x79, 0x7f, 0x57,
+	0xcb, 0x04, 0x48, 0x8d, 0x03, 0x91, 0x06, 0xd3, 0x9d, 0x32, 0x40, 0x2f, 0x5b, 0x58, 0x91, 0xf9,
+	0x3d, 0x59, 0x19, 0x72, 0x4f, 0xf4, 0xcb, 0x16, 0x2e, 0xca, 0xdf, 0xbe, 0xd8, 0xce, 0x5c, 0xb0,
+	0xba, 0xbc, 0x71, 0xb6, 0x9b, 0xff, 0x28, 0xbf, 0xab, 0x65, 0x5a, 0xb1, 0x79, 0xf4, 0x0b, 0xc8,
+	0x05, 0x85, 0xc9, 0xe8, 0xdc, 0xd6, 0x19, 0x6e, 0xb3, 0xef, 0x0f, 0x51, 0x0d, 0xca, 0xd9, 0xa1,
+	0x00, 0x0f, 0xd0, 0xcf, 0x92, 0x2e, 0xc4, 0xe6, 0x5f, 0x24, 0x98, 0x0d, 0xc9, 0x51, 0x3d, 0xf4,
+	0xd1, 0x2a, 0x80, 0x58, 0x99, 0xb8, 0x98, 0x17, 0x8e, 0xb4, 0x96, 0x0e, 0x46, 0x2a, 0x2e, 0x8e,
+	0x4d, 0xd3, 0x73, 0xa2, 0x24, 0xe2, 0xd3, 0xfa, 0x39, 0x41, 0x37, 0x21, 0x13, 0x4e, 0x9f, 0x7a,
+	0x18, 0xf3, 0x92, 0x91, 0xd6, 0xa6, 0x04, 0x80, 0x0d, 0xb1, 0xaa, 0x29, 0x20, 0xcf, 0x48, 0xdb,
+	0xe3, 0x15, 0x21, 0xad, 0x09, 0xd1, 0xbb, 0xa4, 0xed, 0xc5, 0x00, 0x7e, 0xcb, 0x74, 0x94, 0xb1,
+	0x38, 0xa0, 0xd6, 0x32, 0x9d, 0xdb, 0xf2, 0xcb, 0x9e, 0xc3, 0x6d, 0xfe, 0x5a, 0x82, 0x85, 0xc1,
+	0x91, 0x78, 0x97, 0xb7, 0xe0, 0xda, 0x35, 0x72, 0xc0, 0x8e, 0xfe, 0x9b, 0x84, 0xa9, 0x78, 0x11,
+	0xdb, 0x86, 0xf4, 0x25, 0xf6, 0x8d, 0x3a, 0xaf, 0xea, 0x3c, 0xaa, 0x45, 0x39, 0xf6, 0xc4, 0x94,
+	0xd9, 0xa8, 0x36, 0x79, 0x89, 0xfd, 0x03, 0x86, 0x40, 0xfb, 0x30, 0x6d, 0x9e, 0xf8, 0xd4, 0xb4,
+	0x5d, 0x41, 0x49, 0x0c, 0xa1, 0x64, 0x04, 0x2c, 0xa0, 0x7d, 0x00, 0x93, 0x2e, 0x11, 0x8c, 0xe4,
+	0x10, 0xc6, 0x84, 0x4b, 0x02, 0xf0, 0x1d, 0x40, 0x2e, 0x31, 0xce, 0x6d, 0x7a, 0x6a, 0x9c, 0x61,
+	0x1a, 0xd2, 0x52, 0x43, 0x68, 0x39, 0x97, 0x3c, 0xb6, 0xe9, 0xe9, 0x31, 0xa6, 0x82, 0xfe, 0x09,
+	0xc8, 0x91, 0x51, 0x04, 0x79, 0xac, 0xef, 0xed, 0x2c, 0xbb, 0x34, 0xb4, 0x5e, 0xc5, 0xc5, 0xbd,
+	0x4c, 0x7a, 0x1e, 0x2e, 0x3b, 0xfe, 0x36, 0xa6, 0x7e, 0x2e, 0xd6, 0xfc, 0x0c, 0x50, 0xdc, 0x5e,
+	0x82, 0x3b, 0x31, 0x90, 0x2b, 0xc7, 0x4c, 0x17, 0xb0, 0x6f, 0xc3, 0x4c, 0xcc, 0x79, 0x82, 0x3c,
+	0x39, 0x90, 0x9c, 0x8b, 0xfc, 0x18, 0x70, 0xb7, 0x01, 0x98, 0x1b, 0x05, 0x29, 0x3d, 0x90, 0x94,
+	0x66, 0x08, 0x0e, 0xdf, 0xfc, 0xb3, 0x04, 0x29, 0xe6, 0xac, 0xab, 0x7b, 0x84, 0x3c, 0x8c, 0x9d,
+	0x11, 0x8a, 0xaf, 0xee, 0x0f, 0x02, 0x18, 0xfa, 0x09, 0x4c, 0x04, 0x7b, 0xf3, 0x95, 0x14, 0xf7,
+	0xe6, 0xcd, 0x1e, 0x43, 0xf7, 0xf7, 0x43, 0x5a, 0xc8, 0xe8, 0x2a, 0xec, 0x63, 0xdd, 0x85, 0xfd,
+	0x41, 0x6a, 0x32, 0x29, 0xa7, 0x36, 0xff, 0x2e, 0xc1, 0xb4, 0x78, 0x9e, 0xaa, 0xa6, 0x67, 0x3a,
+	0x3e, 0x7a, 0x0a, 0x53, 0x8e, 0xed, 0x76, 0x5e, 0x3b, 0xe9, 0xaa, 0xd7, 0x6e, 0x95, 0xbd, 0x76,
+	0xdf, 0xbd, 0x5a, 0x9f, 0x8f, 0xb1, 0x3e, 0x24, 0x8e, 0x4d, 0xb1, 0xd3, 0xa2, 0x97, 0x1a, 0x38,
+	0xb6, 0x1b, 0xbe, 0x7f, 0x0e, 0x20, 0xc7, 0xbc, 0x08, 0x41, 0x46, 0x0b, 0x7b, 0x36, 0xb1, 0x78,
+	0x20, 0xd8, 0x0a, 0xbd, 0x57, 0xae, 0x24, 0x1a, 0xc5, 0xe2, 0xf7, 0xbe, 0x7b, 0xb5, 0xfe, 0x5e,
+	0x3f, 0x31, 0x5a, 0xe4, 0x37, 0xec, 0x4d, 0x93, 0x1d, 0xf3, 0x22, 0x3c, 0x09, 0x9f, 0xbf, 0x9d,
+	0x50, 0xa4, 0xcd, 0x27, 0x90, 0x39, 0xe6, 0x6f, 0x9d, 0x38, 0x5d, 0x09, 0xc4, 0xdb, 0x17, 0xae,
+	0x2e, 0x5d, 0xb5, 0x7a, 0x8a, 0xab, 0x67, 0x02, 0x56, 0x4c, 0xf9, 0x77, 0x92, 0xb8, 0xf1, 0x42,
+	0xf9, 0x7d, 0x18, 0xff, 0x65, 0x9b, 0x78, 0x6d, 0x47, 0x91, 0xfa, 0xdc, 0xc2, 0x3b, 0xca, 0x60,
+	0x16, 0x7d, 0x08, 0x69, 0x66, 0x66, 0xff, 0x94, 0x34, 0xad, 0x21, 0xcd, 0x67, 0x04, 0x40, 0xfb,
+	0x90, 0xe5, 0x97, 0x35, 0xa2, 0x24, 0x07, 0x52, 0xa6, 0x19, 0x4a, 0x0f, 0x41, 0x7c, 0x83, 0xff,
+	0xce, 0xc2, 0xb8, 0xd8, 0x9b, 0x7a, 0xcd, 0x9c, 0xc6, 0x3a, 0x98, 0x78, 0xfe, 0x0e, 0xdf, 0x2d,
+	0x7f, 0xa9, 0xc1, 0xf9, 0xe9, 0xcf, 0x45, 0xf2, 0x1d, 0x72, 0x11, 0x8b, 0x7b, 0x6a, 0xf4, 0xb8,
+	0x8f, 0x5d, 0x3f, 0xee, 0xe3, 0x23, 0xc4, 0x1d, 0x95, 0x61, 0x89, 0x05, 0xda, 0x76, 0x6d, 0x6a,
+	0x47, 0x2d, 0xa3, 0xc1, 0xb7, 0xaf, 0x4c, 0x0c, 0x54, 0x58, 0x70, 0x6c, 0xb7, 0x1c, 0xe0, 0x45,
+	0x78, 0x34, 0x86, 0x46, 0x8f, 0x60, 0xbe, 0x53, 0x49, 0xea, 0xa6, 0x5b, 0xc7, 0x4d, 0x21, 0x13,
+	0x54, 0xb0, 0x9b, 0xdd, 0x32, 0x83, 0xda, 0x96, 0xd9, 0x90, 0x7f, 0xc0, 0xe9, 0x81, 0xec, 0xcf,
+	0x61, 0xae, 0x57, 0xd6, 0xc2, 0x7e, 0x58, 0xe2, 0x46, 0xef, 0xc0, 0xf6, 0x77, 0x35, 0xd4, 0xad,
+	0x5f, 0xc2, 0x3e, 0x45, 0x5f, 0xc0, 0x62, 0xa7, 0xc7, 0x32, 0xba, 0xb3, 0x0b, 0x57, 0x65, 0x77,
+	0x91, 0x65, 0x77, 0xd0, 0x42, 0xf3, 0x1d, 0xc9, 0xe3, 0x78, 0xe6, 0x35, 0x98, 0x8d, 0xd6, 0x8a,
+	0x12, 0x35, 0x35, 0x6a, 0x7c, 0x50, 0x87, 0x1d, 0x25, 0xf0, 0x09, 0x44, 0x8b, 0x19, 0xf1, 0x3b,
+	0x93, 0xb9, 0xc6, 0x9d, 0x89, 0xb6, 0x75, 0x18, 0x5d, 0x9e, 0x3b, 0x20, 0x9f, 0xb4, 0x3d, 0x97,
+	0x05, 0x05, 0x1b, 0xc2, 0xb1, 0xd3, 0xbc, 0x59, 0x1d, 0xd8, 0x26, 0x67, 0x19, 0x98, 0xd5, 0xf4,
+	0x9f, 0x06, 0xf6, 0x3d, 0x86, 0x55, 0x4e, 0xef, 0x24, 0xaf, 0x73, 0x0b, 0x3d, 0xcc, 0x24, 0x95,
+	0xec, 0x70, 0xad, 0x65, 0xc6, 0x0c, 0xfb, 0xa5, 0xf0, 0x0e, 0x06, 0x34, 0xf4, 0x29, 0x64, 0xa3,
+	0x6d, 0x31, 0x33, 0x2b, 0xb9, 0xe1, 0x42, 0x99, 0x70, 0x53, 0xac, 0x2d, 0x40, 0x87, 0x30, 0x13,
+	0x8b, 0x90, 0x70, 0xa7, 0x3c, 0x6a, 0xf4, 0x73, 0x51, 0x61, 0x09, 0x9c, 0xf9, 0x33, 0x58, 0xee,
+	0x75, 0x26, 0xab, 0x36, 0xc2, 0x3d, 0x33, 0x5c, 0x77, 0xad, 0x4f, 0xb7, 0xbb, 0xeb, 0x5d, 0xec,
+	0xb6, 0xe4, 0xa1, 0x79, 0x21, 0xbc, 0xd2, 0x82, 0x75, 0xf6, 0x28, 0x3a, 0xb6, 0x4f, 0xed, 0xba,
+	0x61, 0xb6, 0xe9, 0x29, 0xf1, 0xec, 0x5f, 0x61, 0xcb, 0x30, 0x03, 0x97, 0x63, 0x5f, 0x41, 0x1b,
+	0xc9, 0xad, 0x74, 0x71, 0xeb, 0x2d, 0x37, 0xa0, 0x7b, 0xad, 0xd5, 0x48, 0xb0, 0xd0, 0xd1, 0x2b,
+	0x84, 0x72, 0xe8, 0x04, 0x62, 0x00, 0xc3, 0xc3, 0x5f, 0xe0, 0x7a, 0xb7, 0x4f, 0x67, 0x47, 0x3a,
+	0xd1, 0x4a, 0x24, 0xa2, 0x09, 0x8d, 0xc8, 0xad, 0x77, 0x00, 0x58, 0x97, 0x29, 0xdc, 0x34, 0x37,
+	0x92, 0x20, 0xeb, 0x4b, 0x85, 0xa7, 0xca, 0x20, 0x47, 0x66, 0x17, 0x22, 0xf3, 0x57, 0x88, 0xec,
+	0xe5, 0x77, 0xf3, 0xbb, 0x5a, 0xae, 0xc3, 0x13, 0x52, 0x77, 0x61, 0xa1, 0x93, 0x3c, 0x7c, 0x81,
+	0xeb, 0x6d, 0xde, 0x77, 0x35, 0x4c, 0x5f, 0x59, 0x60, 0x2d, 0xd0, 0x80, 0x2f, 0x28, 0x9d, 0x32,
+	0xa4, 0x86, 0xf0, 0x7b, 0xa6, 0x8f, 0x3e, 0x87, 0x25, 0xa7, 0xdd, 0xa4, 0x76, 0xab, 0x89, 0x8d,
+	0xfa, 0x29, 0xb1, 0xeb, 0x38, 0x16, 0xb1, 0xc5, 0xd1, 0x3c, 0x10, 0x0a, 0x1c, 0x70, 0x7e, 0x14,
+	0xad, 0x12, 0xcc, 0xfb, 0xae, 0xd9, 0xf2, 0x4f, 0x09, 0xed, 0x94, 0x26, 0x72, 0x8e, 0x3d, 0x45,
+	0xe1, 0x8e, 0xef, 0xdf, 0xe2, 0x6c, 0x08, 0x17, 0x65, 0x87, 0x81, 0x6f, 0xcf, 0xbe, 0xec, 0xbf,
+	0x18, 0x9b, 0xbf, 0x95, 0x60, 0xe1, 0xd8, 0x6c, 0xda, 0x96, 0x49, 0x89, 0xc7, 0x71, 0x35, 0x41,
+	0x45, 0x1f, 0xc3, 0xf4, 0x09, 0x71, 0x2d, 0x96, 0x7a, 0xf2, 0x1c, 0xbb, 0xbe, 0x22, 0x0d, 0x6c,
+	0x26, 0x33, 0x01, 0x48, 0xe7, 0x18, 0xf4, 0x29, 0xfb, 0x01, 0xa3, 0x89, 0x1b, 0x4c, 0xce, 0xf0,
+	0x4f, 0x4d, 0x8f, 0x7f, 0x35, 0x19, 0xf4, 0x7c, 0xe4, 0x3a, 0xb8, 0x1a, 0x87, 0x0d, 0xf8, 0x6e,
+	0x72, 0x04, 0xa8, 0x14, 0x80, 0xd8, 0x37, 0xaa, 0x70, 0x5f, 0xef, 0xc3, 0xb8, 0x10, 0x1e, 0xd2,
+	0xaf, 0xf8, 0xc3, 0xf4, 0xbe, 0x4a, 0x00, 0x12, 0xdf, 0xb7, 0x8a, 0xa6, 0x8f, 0xad, 0xff, 0x67,
+	0x6b, 0x15, 0x7b, 0xce, 0x13, 0x6f, 0x7d, 0xce, 0xb7, 0x07, 0x58, 0xbf, 0xef, 0x3d, 0x8f, 0xac,
+	0xde, 0xf5, 0xfa, 0x27, 0xaf, 0xff, 0xfa, 0xa7, 0x46, 0xe9, 0xba, 0xfa, 0x42, 0x75, 0xeb, 0x8f,
+	0x12, 0x64, 0xe2, 0x3f, 0x04, 0xa0, 0x55, 0x58, 0xaa, 0x6a, 0x95, 0x6a, 0xa5, 0x56, 0x78, 0x68,
+	0xe8, 0x4f, 0xab, 0xaa, 0xf1, 0xe8, 0xa8, 0x56, 0x55, 0x0f, 0xca, 0x77, 0xcb, 0x6a, 0x49, 0xbe,
+	0x81, 0x96, 0x61, 0xa1, 0x7b, 0xba, 0xa6, 0x17, 0x8e, 0x4a, 0x05, 0xad, 0x24, 0x4b, 0xe8, 0x26,
+	0xac, 0x76, 0xcf, 0x1d, 0x3e, 0x7a, 0xa8, 0x97, 0xab, 0x0f, 0x55, 0xe3, 0xe0, 0x7e, 0xa5, 0x7c,
+	0xa0, 0xca, 0x09, 0xf4, 0x1e, 0x28, 0xdd, 0x90, 0x4a, 0x55, 0x2f, 0x1f, 0x96, 0x6b, 0x7a, 0xf9,
+	0x40, 0x4e, 0xa2, 0x15, 0x58, 0xec, 0x9e, 0x55, 0x9f, 0x54, 0xd5, 0x52, 0x59, 0x57, 0x4b, 0x72,
+	0xea, 0xd6, 0x7f, 0x24, 0x80, 0xd8, 0x4f, 0xaa, 0x2b, 0xb0, 0x78, 0x5c, 0xd1, 0x03, 0x81, 0xca,
+	0x51, 0xcf, 0x2e, 0x67, 0x21, 0x17, 0x9f, 0x7c, 0xaa, 0xd6, 0x64, 0xa9, 0x77, 0xb0, 0x72, 0xa4,
+	0xca, 0x12, 0x5a, 0x84, 0xd9, 0xf8, 0x60, 0xa1, 0x58, 0xd3, 0x0b, 0xe5, 0x23, 0x39, 0xd1, 0x8b,
+	0xd6, 0x1f, 0x57, 0xe4, 0x04, 0x42, 0x90, 0x8d, 0x0f, 0x1e, 0x55, 0xe4, 0x24, 0x9a, 0x87, 0x99,
+	0x2e, 0xe0, 0x7d, 0x4d, 0x55, 0xe5, 0x24, 0x3b, 0x69, 0x37, 0xd4, 0x78, 0x5c, 0xd6, 0xef, 0x1b,
+	0xc7, 0xaa, 0x5e, 0x91, 0x53, 0x68, 0x0e, 0xe4, 0xf8, 0xec, 0xdd, 0xca, 0x23, 0xad, 0x7f, 0xb4,
+	0x56, 0x2d, 0x1c, 0xca, 0x63, 0xcb, 0x09, 0x59, 0xba, 0xf5, 0x57, 0x09, 0xb2, 0xdd, 0xbf, 0x6b,
+	0xa2, 0x75, 0x58, 0xe9, 0x04, 0xab, 0xa6, 0x17, 0xf4, 0x47, 0xb5, 0x9e, 0x20, 0x6c, 0xc2, 0x5a,
+	0x2f, 0xa0, 0xa4, 0x56, 0x2b, 0xb5, 0xb2, 0x6e, 0x54, 0x55, 0xad, 0x5c, 0xe9, 0x4d, 0x99, 0xc0,
+	0x1c, 0x57, 0xf4, 0xf2, 0xd1, 0xbd, 0x10, 0x92, 0xe8, 0xca, 0xb8, 0x80, 0x54, 0x0b, 0xb5, 0x9a,
+	0x5a, 0x0a, 0x0e, 0xd9, 0x3b, 0xa7, 0xa9, 0x0f, 0xd4, 0x03, 0x9e, 0xb1, 0x41, 0xcc, 0xbb, 0x85,
+	0xf2, 0x43, 0xb5, 0x24, 0x8f, 0x15, 0xf7, 0xbf, 0x7e, 0xbd, 0x26, 0x7d, 0xf3, 0x7a, 0x4d, 0xfa,
+	0xe7, 0xeb, 0x35, 0xe9, 0xcb, 0x37, 0x6b, 0x37, 0xbe, 0x79, 0xb3, 0x76, 0xe3, 0x6f, 0x6f, 0xd6,
+	0x6e, 0x7c, 0xbe, 0x12, 0xd8, 0xd7, 0xb7, 0x9e, 0xe7, 0x6d, 0xb2, 0xc3, 0xcd, 0xba, 0xc3, 0x7e,
+	0xc5, 0xf2, 0xd9, 0x3f, 0x07, 0x8c, 0xf3, 0x3b, 0xfa, 0xf1, 0xff, 0x06, 0x00, 0x4a, 0x66, 0x64,
+	0xd4, 0x4f, 0x18, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1532,6 +1739,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionMessages) > 0 {
+		for iNdEx := len(m.OptionMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptionMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1735,6 +1958,48 @@ func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalOptionMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalOptionMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalOptionMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2021,6 +2286,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SnapshotVotingPower {
+		i--
+		if m.SnapshotVotingPower {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.MultipleChoiceThreshold) > 0 {
+		i -= len(m.MultipleChoiceThreshold)
+		copy(dAtA[i:], m.MultipleChoiceThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MultipleChoiceThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.ProposalExecutionGas != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalExecutionGas))
 		i--
@@ -2224,6 +2510,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorShares) > 0 {
+		i -= len(m.DelegatorShares)
+		copy(dAtA[i:], m.DelegatorShares)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorShares)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BondedTokens) > 0 {
+		i -= len(m.BondedTokens)
+		copy(dAtA[i:], m.BondedTokens)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.BondedTokens)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		i -= len(m.Shares)
+		copy(dAtA[i:], m.Shares)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Shares)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2406,6 +2759,12 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if len(m.OptionMessages) > 0 {
+		for _, e := range m.OptionMessages {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2438,23 +2797,41 @@ func (m *ProposalVoteOptions) Size() (n int) {
 	return n
 }
 
-func (m *TallyResult) Size() (n int) {
+func (m *ProposalOptionMessages) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.YesCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
 	}
-	l = len(m.NoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
 	}
 	l = len(m.NoWithVetoCount)
 	if l > 0 {
@@ -2658,6 +3035,43 @@ func (m *Params) Size() (n int) {
 	if m.ProposalExecutionGas != 0 {
 		n += 2 + sovGov(uint64(m.ProposalExecutionGas))
 	}
+	l = len(m.MultipleChoiceThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.SnapshotVotingPower {
+		n += 3
+	}
+	return n
+}
+
+func (m *ValidatorPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondedTokens)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DelegatorShares)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Shares)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -3446,6 +3860,40 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionMessages = append(m.OptionMessages, &ProposalOptionMessages{})
+			if err := m.OptionMessages[len(m.OptionMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3677,6 +4125,109 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalOptionMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalOptionMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalOptionMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5234,6 +5785,254 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultipleChoiceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultipleChoiceThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotVotingPower", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotVotingPower = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPowerSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPowerSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedTokens = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorShares = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
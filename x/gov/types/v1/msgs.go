@@ -15,7 +15,7 @@ import (
 
 var (
 	_, _, _, _, _, _, _, _ sdk.Msg                              = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
-	_, _                   gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _                gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgSubmitMultipleChoiceProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -88,6 +88,37 @@ func NewMultipleChoiceMsgSubmitProposal(
 	return m, nil
 }
 
+// SetOptionMsgs packs the messages to execute when the given option wins into
+// m.OptionMessages.
+// NOTE: this will overwrite any existing messages of the option
+func (m *MsgSubmitMultipleChoiceProposal) SetOptionMsgs(option VoteOption, msgs []sdk.Msg) error {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	for _, om := range m.OptionMessages {
+		if om.Option == option {
+			om.Messages = anys
+			return nil
+		}
+	}
+
+	m.OptionMessages = append(m.OptionMessages, &ProposalOptionMessages{Option: option, Messages: anys})
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitMultipleChoiceProposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, om := range m.OptionMessages {
+		if err := om.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // NewMsgDeposit creates a new MsgDeposit instance
 func NewMsgDeposit(depositor string, proposalID uint64, amount sdk.Coins) *MsgDeposit {
 	return &MsgDeposit{proposalID, depositor, amount}
@@ -38,6 +38,8 @@ var (
 	DefaultOptimisticRejectedThreshold         = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses        = []string(nil)
 	DefaultProposalExecutionGas         uint64 = 10_000_000 // ten million
+	DefaultMultipleChoiceThreshold             = sdkmath.LegacyZeroDec()
+	DefaultSnapshotVotingPower                 = false
 )
 
 // NewParams creates a new Params instance with given values.
@@ -49,6 +51,8 @@ func NewParams(
 	minDepositRatio, optimisticRejectedThreshold string,
 	optimisticAuthorizedAddresses []string,
 	proposalExecutionGas uint64,
+	multipleChoiceThreshold string,
+	snapshotVotingPower bool,
 ) Params {
 	return Params{
 		MinDeposit:                    minDeposit,
@@ -73,6 +77,8 @@ func NewParams(
 		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
 		ProposalExecutionGas:          proposalExecutionGas,
+		MultipleChoiceThreshold:       multipleChoiceThreshold,
+		SnapshotVotingPower:           snapshotVotingPower,
 	}
 }
 
@@ -101,6 +107,8 @@ func DefaultParams() Params {
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
 		DefaultProposalExecutionGas,
+		DefaultMultipleChoiceThreshold.String(),
+		DefaultSnapshotVotingPower,
 	)
 }
 
@@ -274,6 +282,17 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("proposal execution gas must be positive: %d", p.ProposalExecutionGas)
 	}
 
+	multipleChoiceThreshold, err := sdkmath.LegacyNewDecFromStr(p.MultipleChoiceThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid multiple choice threshold string: %w", err)
+	}
+	if multipleChoiceThreshold.IsNegative() {
+		return fmt.Errorf("multiple choice threshold cannot be negative: %s", multipleChoiceThreshold)
+	}
+	if multipleChoiceThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("multiple choice threshold too large: %s", multipleChoiceThreshold)
+	}
+
 	return nil
 }
 
@@ -64,6 +64,18 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// GetOptionMsgs returns the messages to execute when the given option of a
+// multiple choice proposal wins. It returns no messages if the option has none.
+func (p Proposal) GetOptionMsgs(option VoteOption) ([]sdk.Msg, error) {
+	for _, om := range p.OptionMessages {
+		if om.Option == option {
+			return om.GetMsgs()
+		}
+	}
+
+	return nil, nil
+}
+
 // GetMinDepositFromParams returns min expedited deposit from the gov params if
 // the proposal is expedited. Otherwise, returns the regular min deposit from
 // gov params.
@@ -76,7 +88,27 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
-	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+	if err := sdktx.UnpackInterfaces(unpacker, p.Messages); err != nil {
+		return err
+	}
+
+	for _, om := range p.OptionMessages {
+		if err := om.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMsgs returns the messages of a multiple choice proposal option
+func (om ProposalOptionMessages) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(om.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (om ProposalOptionMessages) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, om.Messages)
 }
 
 // Proposals is an array of proposal
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -71,3 +73,49 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 		tr.OptionFourCount == comp.OptionFourCount &&
 		tr.SpamCount == comp.SpamCount
 }
+
+// WinningOption returns the option of a multiple choice proposal that received
+// strictly more votes than any other option, provided its share of the non-spam
+// votes is at least threshold. OptionEmpty is returned when there is no such option.
+func (tr TallyResult) WinningOption(threshold math.LegacyDec) (VoteOption, error) {
+	counts := []struct {
+		option VoteOption
+		count  string
+	}{
+		{OptionOne, tr.OptionOneCount},
+		{OptionTwo, tr.OptionTwoCount},
+		{OptionThree, tr.OptionThreeCount},
+		{OptionFour, tr.OptionFourCount},
+	}
+
+	var (
+		winner      = OptionEmpty
+		total       = math.ZeroInt()
+		winnerCount = math.ZeroInt()
+		tie         bool
+	)
+	for _, c := range counts {
+		count, ok := math.NewIntFromString(c.count)
+		if !ok {
+			return OptionEmpty, fmt.Errorf("invalid %s count: %q", c.option, c.count)
+		}
+
+		total = total.Add(count)
+		switch {
+		case count.GT(winnerCount):
+			winner, winnerCount, tie = c.option, count, false
+		case count.Equal(winnerCount):
+			tie = true
+		}
+	}
+
+	if winner == OptionEmpty || tie {
+		return OptionEmpty, nil
+	}
+
+	if math.LegacyNewDecFromInt(winnerCount).Quo(math.LegacyNewDecFromInt(total)).LT(threshold) {
+		return OptionEmpty, nil
+	}
+
+	return winner, nil
+}
@@ -0,0 +1,41 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+func TestTallyResult_WinningOption(t *testing.T) {
+	newTally := func(one, two, three, four int64) v1.TallyResult {
+		return v1.NewTallyResult(sdkmath.NewInt(one), sdkmath.NewInt(two), sdkmath.NewInt(three), sdkmath.NewInt(four), sdkmath.NewInt(100))
+	}
+
+	testCases := []struct {
+		name      string
+		tally     v1.TallyResult
+		threshold sdkmath.LegacyDec
+		expWinner v1.VoteOption
+	}{
+		{"no votes", newTally(0, 0, 0, 0), sdkmath.LegacyZeroDec(), v1.OptionEmpty},
+		{"strict plurality", newTally(10, 20, 30, 25), sdkmath.LegacyZeroDec(), v1.OptionThree},
+		{"tie", newTally(30, 20, 30, 0), sdkmath.LegacyZeroDec(), v1.OptionEmpty},
+		{"tie below the winner", newTally(10, 10, 0, 30), sdkmath.LegacyZeroDec(), v1.OptionFour},
+		{"share equal to threshold", newTally(50, 30, 20, 0), sdkmath.LegacyNewDecWithPrec(5, 1), v1.OptionOne},
+		{"share below threshold", newTally(49, 31, 20, 0), sdkmath.LegacyNewDecWithPrec(5, 1), v1.OptionEmpty},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			winner, err := tc.tally.WinningOption(tc.threshold)
+			require.NoError(t, err)
+			require.Equal(t, tc.expWinner, winner)
+		})
+	}
+
+	_, err := v1.TallyResult{OptionOneCount: "foo"}.WinningOption(sdkmath.LegacyZeroDec())
+	require.Error(t, err)
+}
@@ -842,6 +842,9 @@ type MsgSubmitMultipleChoiceProposal struct {
 	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
 	// vote_options defines the vote options for the proposal.
 	VoteOptions *ProposalVoteOptions `protobuf:"bytes,6,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
+	// option_messages defines the messages to be executed for each option of the proposal.
+	// Options without messages are signaling options.
+	OptionMessages []*ProposalOptionMessages `protobuf:"bytes,7,rep,name=option_messages,json=optionMessages,proto3" json:"option_messages,omitempty"`
 }
 
 func (m *MsgSubmitMultipleChoiceProposal) Reset()         { *m = MsgSubmitMultipleChoiceProposal{} }
@@ -919,6 +922,13 @@ func (m *MsgSubmitMultipleChoiceProposal) GetVoteOptions() *ProposalVoteOptions
 	return nil
 }
 
+func (m *MsgSubmitMultipleChoiceProposal) GetOptionMessages() []*ProposalOptionMessages {
+	if m != nil {
+		return m.OptionMessages
+	}
+	return nil
+}
+
 // MsgSubmitMultipleChoiceProposalResponse defines the Msg/SubmitMultipleChoiceProposal response type.
 type MsgSubmitMultipleChoiceProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -1195,100 +1205,101 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xae, 0xbd, 0xbb, 0x76, 0xd2, 0x22, 0x21, 0x2e, 0xad, 0xf7, 0x7d, 0xcd, 0x7b, 0xbf, 0x79, 0xf3,
-	0x7b, 0x2f, 0x60, 0xa9, 0x81, 0xb0, 0x89, 0x70, 0x51, 0x47, 0xdd, 0x62, 0xb7, 0x5c, 0xb4, 0x77,
-	0x64, 0xab, 0x8d, 0x6c, 0xc4, 0x1f, 0x73, 0xe4, 0xb2, 0x8e, 0xba, 0x72, 0xb7, 0x2c, 0x66, 0x98,
-	0xd9, 0x96, 0x8a, 0x61, 0xb1, 0x5b, 0xde, 0x82, 0xb6, 0x5a, 0x2e, 0x36, 0x90, 0xd1, 0x72, 0xcc,
-	0xc5, 0xe5, 0xe1, 0x30, 0xc4, 0xcb, 0x51, 0xa4, 0x75, 0xa4, 0x23, 0xfa, 0xb3, 0x48, 0x7e, 0x31,
-	0xe9, 0x8a, 0x63, 0x5e, 0x77, 0x14, 0xec, 0x28, 0xa6, 0xd2, 0x11, 0xd2, 0x9b, 0xb0, 0x48, 0xbf,
-	0xb6, 0x3a, 0x9f, 0x16, 0xd5, 0x56, 0x2f, 0x70, 0x88, 0x89, 0x75, 0x72, 0x88, 0x89, 0x75, 0xa6,
-	0x58, 0x50, 0x4d, 0xa3, 0x85, 0x8a, 0xf4, 0x5f, 0x26, 0xca, 0x06, 0xc3, 0xd8, 0x86, 0x09, 0xb1,
-	0xad, 0x9a, 0x96, 0x63, 0x20, 0xfd, 0x38, 0x05, 0x16, 0x6a, 0x58, 0xbf, 0xd1, 0xd9, 0x32, 0x0d,
-	0xfb, 0x7a, 0x1b, 0x59, 0x08, 0xab, 0x4d, 0xbe, 0x04, 0x92, 0x26, 0xc4, 0x58, 0xd5, 0x21, 0x16,
-	0xb8, 0x5c, 0x2c, 0x3f, 0xbb, 0x9e, 0x96, 0x9d, 0x48, 0xb2, 0x1b, 0x49, 0xbe, 0xd2, 0xea, 0x29,
-	0x9e, 0x15, 0x7f, 0x8f, 0x03, 0xf3, 0x46, 0xcb, 0xb0, 0x0d, 0xb5, 0x59, 0xd7, 0xa0, 0x85, 0xb0,
-	0x61, 0x0b, 0x93, 0xd4, 0x73, 0x45, 0x66, 0x85, 0x11, 0xd0, 0x64, 0x06, 0x9a, 0xbc, 0x89, 0x8c,
-	0x56, 0xf5, 0x9d, 0x07, 0x8f, 0xb3, 0x13, 0x3f, 0x3c, 0xc9, 0xe6, 0x75, 0xc3, 0xde, 0xee, 0x6c,
-	0xc9, 0x0d, 0x64, 0x32, 0x14, 0xd8, 0x7f, 0x05, 0xac, 0xdd, 0x2d, 0xda, 0x3d, 0x0b, 0x62, 0xea,
-	0x80, 0xbf, 0x39, 0xd8, 0x5d, 0x4b, 0x35, 0xa1, 0xae, 0x36, 0x7a, 0x75, 0x02, 0x3b, 0xfe, 0xee,
-	0x60, 0x77, 0x8d, 0x53, 0xe6, 0xd8, 0xc9, 0x6f, 0x3b, 0x07, 0xf3, 0x17, 0x40, 0xd2, 0xa2, 0xa5,
-	0xc0, 0xb6, 0x10, 0xcb, 0x71, 0xf9, 0x99, 0xaa, 0xb0, 0xdf, 0x2f, 0xa4, 0x59, 0x1e, 0x57, 0x34,
-	0xad, 0x0d, 0x31, 0xbe, 0x61, 0xb7, 0x8d, 0x96, 0xae, 0x78, 0x96, 0xbc, 0x48, 0x8a, 0xb6, 0x55,
-	0x4d, 0xb5, 0x55, 0x61, 0x8a, 0x78, 0x29, 0xde, 0x37, 0xff, 0x2a, 0x98, 0xb6, 0x0d, 0xbb, 0x09,
-	0x85, 0x69, 0x1a, 0x6e, 0xf1, 0x51, 0xbf, 0x30, 0xef, 0xa7, 0x98, 0x2b, 0xc9, 0x17, 0x5e, 0x57,
-	0x1c, 0x0b, 0xbe, 0x00, 0x12, 0xb8, 0x63, 0x9a, 0x6a, 0xbb, 0x27, 0xc4, 0x47, 0x1b, 0xbb, 0x36,
-	0xfc, 0x79, 0x30, 0x03, 0x77, 0x2c, 0xa8, 0x19, 0x36, 0xd4, 0x84, 0x44, 0x8e, 0xcb, 0x27, 0xab,
-	0x27, 0x42, 0x0e, 0x1b, 0x25, 0x81, 0x53, 0x7c, 0x3b, 0x5e, 0x01, 0xc7, 0x2c, 0x76, 0x57, 0x75,
-	0x02, 0x8f, 0x90, 0xcc, 0x71, 0xf9, 0xb9, 0xf5, 0x93, 0xf2, 0x50, 0xbb, 0xca, 0xee, 0x7d, 0xde,
-	0xec, 0x59, 0xb0, 0x7a, 0xfc, 0x51, 0xbf, 0x90, 0xda, 0x21, 0x3d, 0x99, 0xeb, 0x96, 0xe4, 0x75,
-	0xb9, 0xa4, 0xa4, 0xac, 0x01, 0x7d, 0xa5, 0xfc, 0xe5, 0xc1, 0xee, 0x9a, 0x87, 0xc6, 0xbd, 0x83,
-	0xdd, 0xb5, 0xec, 0xc0, 0x25, 0x74, 0xcb, 0xc5, 0x50, 0x9b, 0x48, 0x97, 0xc0, 0x4a, 0x48, 0xa8,
-	0x40, 0x6c, 0xa1, 0x16, 0x86, 0x7c, 0x16, 0xcc, 0x7a, 0x39, 0x1a, 0x9a, 0xc0, 0xe5, 0xb8, 0xfc,
-	0x94, 0x02, 0x5c, 0xd1, 0x35, 0x4d, 0xfa, 0x89, 0x03, 0xe9, 0x1a, 0xd6, 0xaf, 0xee, 0xc0, 0xc6,
-	0xfb, 0xf4, 0x4a, 0x37, 0x51, 0xcb, 0x86, 0x2d, 0x9b, 0xff, 0x00, 0x24, 0x1a, 0xce, 0x4f, 0xea,
-	0x35, 0xa2, 0xf9, 0xaa, 0x99, 0xbd, 0x7e, 0x41, 0x1c, 0x2a, 0xd8, 0x6d, 0x2d, 0xea, 0xab, 0xb8,
-	0x41, 0xf8, 0x55, 0x30, 0xa3, 0x76, 0xec, 0x6d, 0xd4, 0x36, 0xec, 0x9e, 0x30, 0x49, 0x6f, 0xd6,
-	0x17, 0x54, 0x36, 0x48, 0xdd, 0xfe, 0x37, 0x29, 0x5c, 0x0a, 0x15, 0x1e, 0x4a, 0x52, 0xca, 0x80,
-	0xd5, 0x28, 0xb9, 0x5b, 0xbe, 0xf4, 0x07, 0x07, 0x12, 0x35, 0xac, 0xdf, 0x46, 0x36, 0xe4, 0x37,
-	0x22, 0xa0, 0xa8, 0xa6, 0xff, 0x7a, 0x9c, 0x1d, 0x14, 0x3b, 0xad, 0x3c, 0x00, 0x10, 0x2f, 0x83,
-	0xe9, 0x2e, 0xb2, 0x61, 0xdb, 0xc9, 0x79, 0x4c, 0x0f, 0x3b, 0x66, 0x7c, 0x19, 0xc4, 0x91, 0x65,
-	0x1b, 0xa8, 0x45, 0x9b, 0x7e, 0xce, 0x7f, 0x79, 0xac, 0x1d, 0x48, 0x2e, 0x1f, 0x52, 0x03, 0x85,
-	0x19, 0x8e, 0xeb, 0xf9, 0xca, 0x4b, 0x04, 0x18, 0x27, 0x34, 0x01, 0xe5, 0x44, 0x08, 0x14, 0x12,
-	0x4f, 0x5a, 0x00, 0xf3, 0xec, 0xa7, 0x57, 0xfa, 0xdf, 0x9c, 0x27, 0xfb, 0x08, 0x1a, 0xfa, 0x36,
-	0xe9, 0xd8, 0xff, 0x08, 0x82, 0xb7, 0x40, 0xc2, 0xa9, 0x0c, 0x0b, 0x31, 0xca, 0x3e, 0xa7, 0x03,
-	0x18, 0xb8, 0x09, 0x0d, 0x60, 0xe1, 0x7a, 0x8c, 0x05, 0xe3, 0xb5, 0x61, 0x30, 0x4e, 0x45, 0x82,
-	0xe1, 0x06, 0x97, 0x56, 0xc0, 0x72, 0x40, 0xe4, 0x81, 0xf3, 0x27, 0x07, 0x40, 0x0d, 0xeb, 0x2e,
-	0x55, 0xbd, 0x20, 0x2e, 0x17, 0xc1, 0x0c, 0x63, 0x59, 0x74, 0x38, 0x36, 0xbe, 0x29, 0x7f, 0x09,
-	0xc4, 0x55, 0x13, 0x75, 0x5a, 0x36, 0x83, 0x67, 0x0c, 0x39, 0xcf, 0x10, 0x72, 0x76, 0x4e, 0x66,
-	0x3e, 0x95, 0x73, 0xf4, 0xa9, 0x78, 0xd1, 0x08, 0x10, 0x42, 0x08, 0x08, 0x56, 0x99, 0x94, 0x06,
-	0xbc, 0xff, 0xe5, 0x95, 0xff, 0x8b, 0xd3, 0x1b, 0xb7, 0x2c, 0x4d, 0xb5, 0xe1, 0x75, 0xb5, 0xad,
-	0x9a, 0x98, 0x14, 0xe3, 0xbf, 0x4f, 0xee, 0xb0, 0x62, 0x3c, 0x53, 0xfe, 0x0d, 0x10, 0xb7, 0x68,
-	0x04, 0x8a, 0xc0, 0xec, 0xfa, 0x89, 0x20, 0xfd, 0x51, 0xe5, 0x50, 0x21, 0x8e, 0x7d, 0xe5, 0xda,
-	0x7e, 0x98, 0x92, 0xc3, 0x34, 0x70, 0x66, 0xa0, 0xb6, 0x1d, 0x77, 0xa6, 0x07, 0x92, 0x97, 0x64,
-	0x7a, 0xd5, 0x83, 0x22, 0xb7, 0xd6, 0xca, 0x62, 0xc4, 0x29, 0xd2, 0xb7, 0x1c, 0x1d, 0xb8, 0x9b,
-	0x6a, 0xab, 0x01, 0x9b, 0x03, 0x03, 0x37, 0xa2, 0x0d, 0xe6, 0x03, 0x6d, 0x30, 0xd4, 0x01, 0x83,
-	0x33, 0x6e, 0xf2, 0xa8, 0x33, 0xae, 0x92, 0xdb, 0x0f, 0x8f, 0x96, 0x21, 0xde, 0x97, 0x7e, 0xe5,
-	0x28, 0xa9, 0x0f, 0xe7, 0xe7, 0x91, 0xfa, 0xf3, 0xe7, 0x79, 0x0d, 0x1c, 0x6b, 0xd0, 0x58, 0x50,
-	0xab, 0x93, 0xe5, 0x83, 0xdd, 0x95, 0x18, 0xa2, 0xf4, 0x9b, 0xee, 0x66, 0x52, 0x4d, 0x92, 0x0b,
-	0xbb, 0xff, 0x24, 0xcb, 0x29, 0x29, 0xd7, 0x95, 0x28, 0xf9, 0xb3, 0x60, 0xde, 0x0b, 0xb5, 0x4d,
-	0xdf, 0x15, 0x25, 0xba, 0x29, 0x65, 0xce, 0x15, 0xbf, 0x47, 0xa5, 0x11, 0xc0, 0x6f, 0x94, 0xa4,
-	0xaf, 0x62, 0x20, 0xeb, 0x4d, 0xab, 0x5a, 0xa7, 0x69, 0x1b, 0x56, 0x13, 0x6e, 0x6e, 0x23, 0xa3,
-	0x01, 0xbd, 0x6b, 0x88, 0xda, 0x62, 0xb8, 0xff, 0xc3, 0x16, 0x33, 0xf9, 0x42, 0x5b, 0x4c, 0x2c,
-	0xb0, 0xc5, 0xa4, 0xdd, 0x2d, 0xc6, 0x61, 0x37, 0xb6, 0xb0, 0x08, 0xfe, 0xc2, 0x42, 0xb7, 0x1b,
-	0x7f, 0x37, 0xb9, 0x0a, 0x52, 0x84, 0xf1, 0xea, 0x2e, 0xa5, 0xc6, 0xe9, 0xd5, 0x49, 0x23, 0xb6,
-	0x0c, 0x9f, 0x52, 0xb1, 0x32, 0xdb, 0xf5, 0x3f, 0x2a, 0xab, 0xfb, 0xfd, 0xc2, 0xac, 0xb3, 0x79,
-	0x94, 0xe5, 0x92, 0x1c, 0x68, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65,
-	0x3e, 0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a,
-	0xcb, 0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd,
-	0xe4, 0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63,
-	0x4c, 0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0xfc, 0x23, 0xa5, 0x32, 0xc8, 0x44, 0x17, 0xe1, 0x51,
-	0x4d, 0xa8, 0xf0, 0xef, 0x39, 0x30, 0x4b, 0x61, 0xd5, 0x10, 0xd9, 0x51, 0x5e, 0xb8, 0xda, 0x4d,
-	0x10, 0x33, 0xb1, 0xce, 0x1e, 0x6d, 0xf4, 0x1e, 0x76, 0x72, 0xaf, 0x5f, 0x58, 0x8e, 0x7a, 0x1d,
-	0x35, 0xac, 0x2b, 0xc4, 0xfb, 0xb0, 0xf2, 0x2e, 0x83, 0xc5, 0x81, 0x54, 0xbd, 0xdb, 0x5e, 0x02,
-	0xf1, 0x36, 0xc4, 0x9d, 0xa6, 0xb3, 0x05, 0xa6, 0x14, 0xf6, 0x15, 0xaa, 0x75, 0xfd, 0x5e, 0x12,
-	0xc4, 0x6a, 0x58, 0xe7, 0xef, 0x80, 0xb9, 0xc0, 0xdf, 0x31, 0xb9, 0xe0, 0x15, 0x04, 0xb7, 0x55,
-	0x31, 0x7f, 0x98, 0x85, 0x97, 0x0e, 0x04, 0x0b, 0xe1, 0x55, 0xf5, 0x4c, 0xd8, 0x3d, 0x64, 0x24,
-	0x9e, 0x3b, 0x82, 0x91, 0x77, 0xcc, 0x65, 0x30, 0x45, 0x77, 0xc6, 0xa5, 0xb0, 0x13, 0x91, 0x8b,
-	0x99, 0x68, 0xb9, 0xe7, 0x7f, 0x1b, 0xa4, 0x86, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65,
-	0xbc, 0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48,
-	0x95, 0x17, 0xe8, 0x2e, 0x48, 0x0d, 0x4d, 0xff, 0x88, 0x04, 0x07, 0xf5, 0x51, 0x09, 0x46, 0x4d,
-	0x5b, 0x69, 0x71, 0x2f, 0x3c, 0x6d, 0xf9, 0xcf, 0xc0, 0x5c, 0x60, 0xd2, 0x46, 0xb4, 0xc4, 0xb0,
-	0x45, 0x54, 0x4b, 0x44, 0x4f, 0xc3, 0x88, 0x23, 0x37, 0x4a, 0xfc, 0xd7, 0x1c, 0x58, 0x1d, 0x3b,
-	0x64, 0xe4, 0x51, 0x2d, 0x17, 0x6d, 0x2f, 0x5e, 0x7c, 0x3e, 0x7b, 0x2f, 0xbb, 0xe3, 0x7b, 0xfd,
-	0x42, 0x2a, 0x37, 0xf0, 0x50, 0xf8, 0xcf, 0xc1, 0x62, 0x14, 0x13, 0xbe, 0x3c, 0x0a, 0xe1, 0x21,
-	0x33, 0xb1, 0x70, 0x24, 0xb3, 0x31, 0xc7, 0xdf, 0x01, 0x49, 0x8f, 0x8f, 0xc4, 0xa8, 0xa2, 0x1c,
-	0x9d, 0x28, 0x8d, 0xd6, 0x8d, 0x8e, 0x2e, 0x4e, 0x7f, 0x41, 0xa6, 0x68, 0x75, 0xe3, 0xc1, 0xd3,
-	0x0c, 0xf7, 0xf0, 0x69, 0x86, 0xfb, 0xfd, 0x69, 0x86, 0xbb, 0xff, 0x2c, 0x33, 0xf1, 0xf0, 0x59,
-	0x66, 0xe2, 0xb7, 0x67, 0x99, 0x89, 0x8f, 0x4f, 0x3a, 0x51, 0xb1, 0x76, 0x57, 0x36, 0x10, 0x5b,
-	0xe8, 0xe8, 0x54, 0x2e, 0x76, 0xcb, 0x5b, 0x71, 0x4a, 0x69, 0xe7, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0xac, 0x54, 0xba, 0x09, 0x12, 0x00, 0x00,
+	// 1504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xdb, 0xc6,
+	0x12, 0x36, 0x2d, 0x5b, 0xb2, 0xd7, 0x8a, 0x1d, 0xd3, 0x8e, 0x4d, 0x33, 0x8e, 0xa4, 0x30, 0x2f,
+	0x89, 0x9e, 0xf3, 0x44, 0x49, 0x4e, 0x9c, 0xf7, 0x9e, 0x1a, 0x04, 0x88, 0xdc, 0xb4, 0x0d, 0x50,
+	0xb5, 0x01, 0xf3, 0xa3, 0x40, 0x1b, 0x40, 0xa5, 0xc5, 0x2d, 0x4d, 0x44, 0xd4, 0xb2, 0x5a, 0x4a,
+	0xb0, 0x0f, 0x05, 0x8a, 0x1e, 0x73, 0xca, 0xb1, 0x28, 0xd0, 0x7b, 0xdb, 0x93, 0x0f, 0x3a, 0xb5,
+	0xc7, 0x5e, 0x02, 0x1f, 0x8a, 0xc0, 0x87, 0xa2, 0xc8, 0x21, 0x29, 0x12, 0xb4, 0x06, 0xfa, 0x0f,
+	0xf4, 0xd8, 0x62, 0x97, 0xcb, 0x95, 0x44, 0x52, 0x92, 0x93, 0x02, 0x45, 0x2f, 0x36, 0x39, 0xf3,
+	0xcd, 0xec, 0xcc, 0xb7, 0xc3, 0x99, 0xb1, 0xc1, 0x52, 0x0d, 0x61, 0x1b, 0xe1, 0xbc, 0x89, 0xda,
+	0xf9, 0x76, 0x31, 0xef, 0xee, 0xa8, 0x4e, 0x13, 0xb9, 0x48, 0x3c, 0xe6, 0xc9, 0x55, 0x13, 0xb5,
+	0xd5, 0x76, 0x51, 0x4e, 0x31, 0xd8, 0x96, 0x8e, 0x61, 0xbe, 0x5d, 0xdc, 0x82, 0xae, 0x5e, 0xcc,
+	0xd7, 0x90, 0xd5, 0xf0, 0xe0, 0xf2, 0x72, 0xbf, 0x1b, 0x62, 0xe5, 0x29, 0x16, 0x4d, 0x64, 0x22,
+	0xfa, 0x98, 0x27, 0x4f, 0x4c, 0xba, 0xe2, 0xc1, 0xab, 0x9e, 0x82, 0x1d, 0xc5, 0x54, 0x26, 0x42,
+	0x66, 0x1d, 0xe6, 0xe9, 0xdb, 0x56, 0xeb, 0xa3, 0xbc, 0xde, 0xd8, 0x0d, 0x1c, 0x62, 0x63, 0x93,
+	0x1c, 0x62, 0x63, 0x93, 0x29, 0xe6, 0x75, 0xdb, 0x6a, 0xa0, 0x3c, 0xfd, 0xc9, 0x44, 0xe9, 0xa0,
+	0x1b, 0xd7, 0xb2, 0x21, 0x76, 0x75, 0xdb, 0xf1, 0x00, 0xca, 0xb7, 0x13, 0x60, 0xbe, 0x82, 0xcd,
+	0x5b, 0xad, 0x2d, 0xdb, 0x72, 0x6f, 0x36, 0x91, 0x83, 0xb0, 0x5e, 0x17, 0x0b, 0x60, 0xca, 0x86,
+	0x18, 0xeb, 0x26, 0xc4, 0x92, 0x90, 0x89, 0x65, 0x67, 0xd6, 0x17, 0x55, 0xcf, 0x93, 0xea, 0x7b,
+	0x52, 0xaf, 0x35, 0x76, 0x35, 0x8e, 0x12, 0x1f, 0x08, 0x60, 0xce, 0x6a, 0x58, 0xae, 0xa5, 0xd7,
+	0xab, 0x06, 0x74, 0x10, 0xb6, 0x5c, 0x69, 0x9c, 0x5a, 0xae, 0xa8, 0x2c, 0x31, 0x42, 0x9a, 0xca,
+	0x48, 0x53, 0x37, 0x91, 0xd5, 0x28, 0xbf, 0xf1, 0xe8, 0x69, 0x7a, 0xec, 0x9b, 0x67, 0xe9, 0xac,
+	0x69, 0xb9, 0xdb, 0xad, 0x2d, 0xb5, 0x86, 0x6c, 0xc6, 0x02, 0xfb, 0x95, 0xc3, 0xc6, 0xfd, 0xbc,
+	0xbb, 0xeb, 0x40, 0x4c, 0x0d, 0xf0, 0x17, 0x87, 0x7b, 0x6b, 0xc9, 0x3a, 0x34, 0xf5, 0xda, 0x6e,
+	0x95, 0xd0, 0x8e, 0xbf, 0x3a, 0xdc, 0x5b, 0x13, 0xb4, 0x59, 0x76, 0xf2, 0xeb, 0xde, 0xc1, 0xe2,
+	0x25, 0x30, 0xe5, 0xd0, 0x54, 0x60, 0x53, 0x8a, 0x65, 0x84, 0xec, 0x74, 0x59, 0x3a, 0xe8, 0xe4,
+	0x16, 0x59, 0x1c, 0xd7, 0x0c, 0xa3, 0x09, 0x31, 0xbe, 0xe5, 0x36, 0xad, 0x86, 0xa9, 0x71, 0xa4,
+	0x28, 0x93, 0xa4, 0x5d, 0xdd, 0xd0, 0x5d, 0x5d, 0x9a, 0x20, 0x56, 0x1a, 0x7f, 0x17, 0xff, 0x0d,
+	0x26, 0x5d, 0xcb, 0xad, 0x43, 0x69, 0x92, 0xba, 0x5b, 0x78, 0xd2, 0xc9, 0xcd, 0x75, 0x43, 0xcc,
+	0x14, 0xd4, 0x4b, 0xff, 0xd5, 0x3c, 0x84, 0x98, 0x03, 0x09, 0xdc, 0xb2, 0x6d, 0xbd, 0xb9, 0x2b,
+	0xc5, 0x07, 0x83, 0x7d, 0x8c, 0x78, 0x11, 0x4c, 0xc3, 0x1d, 0x07, 0x1a, 0x96, 0x0b, 0x0d, 0x29,
+	0x91, 0x11, 0xb2, 0x53, 0xe5, 0x13, 0x21, 0x83, 0x8d, 0x82, 0x24, 0x68, 0x5d, 0x9c, 0xa8, 0x81,
+	0x63, 0x0e, 0xbb, 0xab, 0x2a, 0xa1, 0x47, 0x9a, 0xca, 0x08, 0xd9, 0xd9, 0xf5, 0x93, 0x6a, 0x5f,
+	0xb9, 0xaa, 0xfe, 0x7d, 0xde, 0xde, 0x75, 0x60, 0xf9, 0xf8, 0x93, 0x4e, 0x2e, 0xb9, 0x43, 0x6a,
+	0x32, 0xd3, 0x2e, 0xa8, 0xeb, 0x6a, 0x41, 0x4b, 0x3a, 0x3d, 0xfa, 0x52, 0xf1, 0xb3, 0xc3, 0xbd,
+	0x35, 0xce, 0xc6, 0x83, 0xc3, 0xbd, 0xb5, 0x74, 0xcf, 0x25, 0xb4, 0x8b, 0xf9, 0x50, 0x99, 0x28,
+	0x57, 0xc0, 0x4a, 0x48, 0xa8, 0x41, 0xec, 0xa0, 0x06, 0x86, 0x62, 0x1a, 0xcc, 0xf0, 0x18, 0x2d,
+	0x43, 0x12, 0x32, 0x42, 0x76, 0x42, 0x03, 0xbe, 0xe8, 0x86, 0xa1, 0x7c, 0x27, 0x80, 0xc5, 0x0a,
+	0x36, 0xaf, 0xef, 0xc0, 0xda, 0xdb, 0xf4, 0x4a, 0x37, 0x51, 0xc3, 0x85, 0x0d, 0x57, 0x7c, 0x07,
+	0x24, 0x6a, 0xde, 0x23, 0xb5, 0x1a, 0x50, 0x7c, 0xe5, 0xd4, 0x7e, 0x27, 0x27, 0xf7, 0x25, 0xec,
+	0x97, 0x16, 0xb5, 0xd5, 0x7c, 0x27, 0xe2, 0x2a, 0x98, 0xd6, 0x5b, 0xee, 0x36, 0x6a, 0x5a, 0xee,
+	0xae, 0x34, 0x4e, 0x6f, 0xb6, 0x2b, 0x28, 0x6d, 0x90, 0xbc, 0xbb, 0xef, 0x24, 0x71, 0x25, 0x94,
+	0x78, 0x28, 0x48, 0x25, 0x05, 0x56, 0xa3, 0xe4, 0x7e, 0xfa, 0xca, 0x2f, 0x02, 0x48, 0x54, 0xb0,
+	0x79, 0x17, 0xb9, 0x50, 0xdc, 0x88, 0xa0, 0xa2, 0xbc, 0xf8, 0xdb, 0xd3, 0x74, 0xaf, 0xd8, 0x2b,
+	0xe5, 0x1e, 0x82, 0x44, 0x15, 0x4c, 0xb6, 0x91, 0x0b, 0x9b, 0xd2, 0xf8, 0x88, 0x1a, 0xf6, 0x60,
+	0x62, 0x11, 0xc4, 0x91, 0xe3, 0x5a, 0xa8, 0x41, 0x8b, 0x7e, 0xb6, 0xfb, 0xe5, 0xb1, 0x72, 0x20,
+	0xb1, 0xbc, 0x4b, 0x01, 0x1a, 0x03, 0x0e, 0xab, 0xf9, 0xd2, 0xbf, 0x08, 0x31, 0x9e, 0x6b, 0x42,
+	0xca, 0x89, 0x10, 0x29, 0xc4, 0x9f, 0x32, 0x0f, 0xe6, 0xd8, 0x23, 0x4f, 0xfd, 0x0f, 0x81, 0xcb,
+	0xde, 0x83, 0x96, 0xb9, 0x4d, 0x2a, 0xf6, 0x6f, 0xa2, 0xe0, 0x35, 0x90, 0xf0, 0x32, 0xc3, 0x52,
+	0x8c, 0x76, 0x9f, 0xd3, 0x01, 0x0e, 0xfc, 0x80, 0x7a, 0xb8, 0xf0, 0x2d, 0x86, 0x92, 0xf1, 0x9f,
+	0x7e, 0x32, 0x4e, 0x45, 0x92, 0xe1, 0x3b, 0x57, 0x56, 0xc0, 0x72, 0x40, 0xc4, 0xc9, 0xf9, 0x55,
+	0x00, 0xa0, 0x82, 0x4d, 0xbf, 0x55, 0xbd, 0x22, 0x2f, 0x97, 0xc1, 0x34, 0xeb, 0xb2, 0x68, 0x34,
+	0x37, 0x5d, 0xa8, 0x78, 0x05, 0xc4, 0x75, 0x1b, 0xb5, 0x1a, 0x2e, 0xa3, 0x67, 0x48, 0x73, 0x9e,
+	0x26, 0xcd, 0xd9, 0x3b, 0x99, 0xd9, 0x94, 0x2e, 0xd0, 0x4f, 0x85, 0x7b, 0x23, 0x44, 0x48, 0x21,
+	0x22, 0x58, 0x66, 0xca, 0x22, 0x10, 0xbb, 0x6f, 0x3c, 0xfd, 0x1f, 0xbc, 0xda, 0xb8, 0xe3, 0x18,
+	0xba, 0x0b, 0x6f, 0xea, 0x4d, 0xdd, 0xc6, 0x24, 0x99, 0xee, 0xf7, 0x29, 0x8c, 0x4a, 0x86, 0x43,
+	0xc5, 0xff, 0x81, 0xb8, 0x43, 0x3d, 0x50, 0x06, 0x66, 0xd6, 0x4f, 0x04, 0xdb, 0x1f, 0x55, 0xf6,
+	0x25, 0xe2, 0xe1, 0x4b, 0x37, 0x0e, 0xc2, 0x2d, 0x39, 0xdc, 0x06, 0xce, 0xf4, 0xe4, 0xb6, 0xe3,
+	0xcf, 0xf4, 0x40, 0xf0, 0x8a, 0x0a, 0x96, 0x03, 0x22, 0x3f, 0xd7, 0xd2, 0x42, 0xc4, 0x29, 0xca,
+	0x97, 0x02, 0x1d, 0xb8, 0x9b, 0x7a, 0xa3, 0x06, 0xeb, 0x3d, 0x03, 0x37, 0xa2, 0x0c, 0xe6, 0x02,
+	0x65, 0xd0, 0x57, 0x01, 0xbd, 0x33, 0x6e, 0xfc, 0xa8, 0x33, 0xae, 0x94, 0x39, 0x08, 0x8f, 0x96,
+	0xbe, 0xbe, 0xaf, 0xfc, 0x28, 0x80, 0x95, 0x50, 0x7c, 0xbc, 0xa9, 0xbf, 0x7c, 0x9c, 0x37, 0xc0,
+	0xb1, 0x1a, 0xf5, 0x05, 0x8d, 0x2a, 0x59, 0x3e, 0xd8, 0x5d, 0xc9, 0xa1, 0x96, 0x7e, 0xdb, 0xdf,
+	0x4c, 0xca, 0x53, 0xe4, 0xc2, 0x1e, 0x3e, 0x4b, 0x0b, 0x5a, 0xd2, 0x37, 0x25, 0x4a, 0xf1, 0x3c,
+	0x98, 0xe3, 0xae, 0xb6, 0xe9, 0x77, 0x45, 0x1b, 0xdd, 0x84, 0x36, 0xeb, 0x8b, 0xdf, 0xa2, 0xd2,
+	0x08, 0xe2, 0x37, 0x0a, 0xca, 0xef, 0x31, 0x90, 0xe6, 0xd3, 0xaa, 0xd2, 0xaa, 0xbb, 0x96, 0x53,
+	0x87, 0x9b, 0xdb, 0xc8, 0xaa, 0x41, 0x7e, 0x0d, 0x51, 0x5b, 0x8c, 0xf0, 0x4f, 0xd8, 0x62, 0xc6,
+	0x5f, 0x69, 0x8b, 0x89, 0x05, 0xb6, 0x98, 0x45, 0x7f, 0x8b, 0xf1, 0xba, 0x9b, 0xf7, 0x22, 0x4a,
+	0xdd, 0x85, 0x85, 0x6e, 0x37, 0xdd, 0xdd, 0xe4, 0x3a, 0x48, 0x92, 0x8e, 0x57, 0xf5, 0x5b, 0x6a,
+	0x9c, 0x5e, 0x9d, 0x32, 0x60, 0xcb, 0xe8, 0xb6, 0x54, 0xac, 0xcd, 0xb4, 0xbb, 0x2f, 0xe2, 0x87,
+	0x60, 0xce, 0xf3, 0x50, 0xe5, 0x4b, 0x65, 0x82, 0x92, 0x7a, 0x76, 0x80, 0x27, 0xcf, 0xb0, 0xc2,
+	0xc0, 0x11, 0x9b, 0xcb, 0x2c, 0xea, 0x43, 0x94, 0x56, 0x0f, 0x3a, 0xb9, 0x19, 0x0f, 0x51, 0x54,
+	0x0b, 0x6a, 0xa0, 0xa4, 0x3f, 0x00, 0xe7, 0x47, 0x5c, 0xfc, 0x91, 0x97, 0x96, 0xd2, 0x5c, 0xe0,
+	0x24, 0xe5, 0x7b, 0x01, 0x2c, 0xf1, 0x06, 0xc0, 0x02, 0xfa, 0x8b, 0x7d, 0x6d, 0x19, 0x24, 0x6c,
+	0x6c, 0x56, 0x5b, 0xcd, 0x3a, 0xdb, 0x56, 0xe2, 0x36, 0x36, 0xef, 0x34, 0xeb, 0xe2, 0xff, 0x79,
+	0xc3, 0x8b, 0x65, 0x84, 0x88, 0xe1, 0xc6, 0x8e, 0x2f, 0xeb, 0x18, 0x1a, 0xac, 0x17, 0xf9, 0x1d,
+	0xef, 0x54, 0x04, 0x43, 0xdd, 0x23, 0x95, 0x22, 0x48, 0x45, 0x27, 0xc1, 0x9b, 0x59, 0x28, 0xf1,
+	0xaf, 0x05, 0x30, 0x43, 0x69, 0x35, 0x10, 0xd9, 0x82, 0x5e, 0x39, 0xdb, 0x4d, 0x10, 0xb3, 0xb1,
+	0x29, 0x8d, 0x0f, 0xd9, 0xf4, 0x4e, 0xee, 0x77, 0x72, 0xcb, 0x51, 0xdf, 0x5f, 0x05, 0x9b, 0x1a,
+	0xb1, 0x1e, 0x95, 0xde, 0x55, 0xb0, 0xd0, 0x13, 0x2a, 0xbf, 0xed, 0x25, 0x10, 0x6f, 0x42, 0xdc,
+	0xaa, 0x7b, 0x7b, 0x66, 0x52, 0x63, 0x6f, 0xa1, 0x5c, 0xd7, 0x1f, 0x4c, 0x81, 0x58, 0x05, 0x9b,
+	0xe2, 0x3d, 0x30, 0x1b, 0xf8, 0x4b, 0x29, 0x13, 0xbc, 0x82, 0xe0, 0x3e, 0x2c, 0x67, 0x47, 0x21,
+	0x78, 0x38, 0x10, 0xcc, 0x87, 0x97, 0xe1, 0x33, 0x61, 0xf3, 0x10, 0x48, 0xbe, 0x70, 0x04, 0x10,
+	0x3f, 0xe6, 0x2a, 0x98, 0xa0, 0x5b, 0xe9, 0x52, 0xd8, 0x88, 0xc8, 0xe5, 0x54, 0xb4, 0x9c, 0xdb,
+	0xdf, 0x05, 0xc9, 0xbe, 0xd5, 0x6e, 0x00, 0xde, 0xd7, 0xcb, 0xe7, 0x86, 0xeb, 0xb9, 0xdf, 0x37,
+	0x41, 0xc2, 0x6f, 0x7d, 0x2b, 0x61, 0x13, 0xa6, 0x92, 0x4f, 0x0f, 0x54, 0x71, 0x47, 0xf7, 0x41,
+	0xb2, 0x6f, 0xbf, 0x88, 0x08, 0xb0, 0x57, 0x2f, 0x9f, 0x1b, 0xae, 0xe7, 0xbb, 0xcb, 0xc2, 0x7e,
+	0x78, 0x9e, 0x8b, 0x1f, 0x83, 0xd9, 0xc0, 0x2c, 0x8f, 0x28, 0x89, 0x7e, 0x84, 0x9c, 0x1d, 0x85,
+	0x18, 0x72, 0xe4, 0x46, 0x41, 0xfc, 0x5c, 0x00, 0xab, 0x43, 0xc7, 0x98, 0x3a, 0xa8, 0xe4, 0xa2,
+	0xf1, 0xf2, 0xe5, 0x97, 0xc3, 0xf3, 0xe8, 0x8e, 0xef, 0x77, 0x72, 0xc9, 0x4c, 0xcf, 0x87, 0x22,
+	0x7e, 0x02, 0x16, 0xa2, 0x3a, 0xe1, 0xd9, 0x41, 0x0c, 0xf7, 0xc1, 0xe4, 0xdc, 0x91, 0x60, 0x43,
+	0x8e, 0xbf, 0x07, 0xa6, 0x78, 0x3f, 0x92, 0xa3, 0x92, 0xf2, 0x74, 0xb2, 0x32, 0x58, 0x37, 0xd8,
+	0xbb, 0x3c, 0xf9, 0x29, 0x99, 0xd3, 0xe5, 0x8d, 0x47, 0xcf, 0x53, 0xc2, 0xe3, 0xe7, 0x29, 0xe1,
+	0xe7, 0xe7, 0x29, 0xe1, 0xe1, 0x8b, 0xd4, 0xd8, 0xe3, 0x17, 0xa9, 0xb1, 0x9f, 0x5e, 0xa4, 0xc6,
+	0xde, 0x3f, 0xe9, 0x79, 0xc5, 0xc6, 0x7d, 0xd5, 0x42, 0x6c, 0x65, 0xa4, 0x73, 0x9f, 0xfc, 0xaf,
+	0x28, 0x4e, 0x5b, 0xda, 0xc5, 0x3f, 0x07, 0x00, 0x00, 0xd4, 0x27, 0x85, 0x6b, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2288,6 +2299,20 @@ func (m *MsgSubmitMultipleChoiceProposal) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionMessages) > 0 {
+		for iNdEx := len(m.OptionMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptionMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.VoteOptions != nil {
 		{
 			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -2796,6 +2821,12 @@ func (m *MsgSubmitMultipleChoiceProposal) Size() (n int) {
 		l = m.VoteOptions.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.OptionMessages) > 0 {
+		for _, e := range m.OptionMessages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4617,6 +4648,40 @@ func (m *MsgSubmitMultipleChoiceProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionMessages = append(m.OptionMessages, &ProposalOptionMessages{})
+			if err := m.OptionMessages[len(m.OptionMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
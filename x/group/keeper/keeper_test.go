@@ -546,3 +546,105 @@ func (s *TestSuite) TestTallyProposalsAtVPEnd_GroupMemberLeaving() {
 		}
 	})
 }
+
+func (s *TestSuite) TestTimelockThresholdDecisionPolicy() {
+	votingPeriod := 4 * time.Minute
+	timelock := time.Hour
+
+	groupMsg := &group.MsgCreateGroupWithPolicy{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: s.addrsStr[0], Weight: "2"},
+			{Address: s.addrsStr[1], Weight: "1"},
+			{Address: s.addrsStr[2], Weight: "1"},
+		},
+	}
+	policy := group.NewTimelockThresholdDecisionPolicy("2", "1", timelock, votingPeriod, 0)
+	s.Require().NoError(groupMsg.SetDecisionPolicy(policy))
+
+	s.setNextAccount()
+	groupRes, err := s.groupKeeper.CreateGroupWithPolicy(s.ctx, groupMsg)
+	s.Require().NoError(err)
+
+	// submitApproved submits a proposal which is approved by the vote of
+	// the first member at the given block time.
+	submitApproved := func(ctx sdk.Context) uint64 {
+		proposalRes, err := s.groupKeeper.SubmitProposal(ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: groupRes.GroupPolicyAddress,
+			Proposers:          []string{s.addrsStr[0]},
+		})
+		s.Require().NoError(err)
+
+		approvalCtx := ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.Add(time.Minute)})
+		_, err = s.groupKeeper.Vote(approvalCtx, &group.MsgVote{
+			ProposalId: proposalRes.ProposalId,
+			Voter:      s.addrsStr[0],
+			Option:     group.VOTE_OPTION_YES,
+		})
+		s.Require().NoError(err)
+
+		res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, res.Proposal.Status)
+		s.Require().NotNil(res.Proposal.ApprovalTime)
+		s.Require().Equal(approvalCtx.HeaderInfo().Time, *res.Proposal.ApprovalTime)
+		s.Require().Equal(approvalCtx.HeaderInfo().Time.Add(timelock), res.Proposal.VotingPeriodEnd)
+
+		return proposalRes.ProposalId
+	}
+
+	s.Run("executed after the timelock", func() {
+		proposalID := submitApproved(s.sdkCtx)
+
+		// the proposal cannot be executed during the timelock
+		ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(votingPeriod + time.Minute)})
+		res, err := s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: s.addrsStr[0]})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Result)
+
+		// votes are still accepted after the voting period of the policy
+		_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      s.addrsStr[1],
+			Option:     group.VOTE_OPTION_NO,
+		})
+		s.Require().NoError(err)
+
+		ctx = s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(time.Minute + timelock + time.Second)})
+		res, err = s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: s.addrsStr[0]})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+	})
+
+	s.Run("vetoed during the timelock", func() {
+		proposalID := submitApproved(s.sdkCtx)
+
+		ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(timelock / 2)})
+		_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      s.addrsStr[2],
+			Option:     group.VOTE_OPTION_NO_WITH_VETO,
+		})
+		s.Require().NoError(err)
+
+		res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, res.Proposal.Status)
+		s.Require().Equal("1", res.Proposal.FinalTallyResult.NoWithVetoCount)
+
+		ctx = s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(time.Minute + timelock + time.Second)})
+		_, err = s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: s.addrsStr[0]})
+		s.Require().ErrorContains(err, "not possible to exec with proposal status PROPOSAL_STATUS_REJECTED")
+	})
+
+	s.Run("accepted at the end of the timelock", func() {
+		proposalID := submitApproved(s.sdkCtx)
+
+		ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(time.Minute + timelock + time.Second)})
+		s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+		res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, res.Proposal.Status)
+	})
+}
@@ -771,6 +771,21 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 		return nil, err
 	}
 
+	// Proposals of a timelock decision policy are tallied on each vote, so that
+	// their approval, which starts the timelock, is recorded when it happens.
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := policy.(group.TimelockDecisionPolicy); ok {
+		if err := k.doTallyAndUpdate(ctx, &proposal, groupInfo, policyInfo); err != nil {
+			return nil, err
+		}
+		if err := k.proposalTable.Update(kvStore, proposal.Id, &proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	// Try to execute proposal immediately
 	if msg.Exec == group.Exec_EXEC_TRY {
 		_, err = k.Exec(ctx, &group.MsgExec{ProposalId: msg.ProposalId, Executor: msg.Voter})
@@ -801,6 +816,17 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		return errorsmod.Wrap(err, "policy allow")
 	}
 
+	// Approved proposals of a timelock decision policy are held until the end
+	// of the timelock, during which they can still be vetoed. The voting period
+	// end is moved to the end of the timelock, so votes are still accepted and
+	// the proposal is tallied again once the timelock has elapsed.
+	if timelockPolicy, ok := policy.(group.TimelockDecisionPolicy); ok && result.Allow && p.ApprovalTime == nil {
+		approvalTime := k.HeaderService.HeaderInfo(ctx).Time
+		p.ApprovalTime = &approvalTime
+		p.VotingPeriodEnd = approvalTime.Add(timelockPolicy.GetTimelock())
+		return nil
+	}
+
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
 	if isFinal := result.Final || k.HeaderService.HeaderInfo(ctx).Time.After(p.VotingPeriodEnd); isFinal {
@@ -126,6 +126,21 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BatchSend mocks base method.
+func (m *MockBankKeeper) BatchSend(arg0 context.Context, arg1 *types.MsgBatchSend) (*types.MsgBatchSendResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSend", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgBatchSendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchSend indicates an expected call of BatchSend.
+func (mr *MockBankKeeperMockRecorder) BatchSend(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSend", reflect.TypeOf((*MockBankKeeper)(nil).BatchSend), arg0, arg1)
+}
+
 // Burn mocks base method.
 func (m *MockBankKeeper) Burn(arg0 context.Context, arg1 *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +243,108 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// TimelockDecisionPolicy is a DecisionPolicy which delays the execution of
+// approved proposals. Votes are still accepted during the timelock, which lets
+// the policy reject an approved proposal before it can be executed.
+type TimelockDecisionPolicy interface {
+	DecisionPolicy
+
+	// GetTimelock returns the duration after the approval of a proposal
+	// before it can be executed.
+	GetTimelock() time.Duration
+}
+
+// Implements DecisionPolicy Interface
+var _ TimelockDecisionPolicy = &TimelockThresholdDecisionPolicy{}
+
+// NewTimelockThresholdDecisionPolicy creates a new timelock threshold DecisionPolicy
+func NewTimelockThresholdDecisionPolicy(threshold, vetoThreshold string, timelock, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &TimelockThresholdDecisionPolicy{threshold, vetoThreshold, timelock, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of TimelockThresholdDecisionPolicy
+func (p TimelockThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of TimelockThresholdDecisionPolicy
+func (p TimelockThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on TimelockThresholdDecisionPolicy
+func (p TimelockThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return errorsmod.Wrap(err, "threshold")
+	}
+
+	if _, err := math.NewPositiveDecFromString(p.VetoThreshold); err != nil {
+		return errorsmod.Wrap(err, "veto threshold")
+	}
+
+	if p.Timelock <= 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "timelock must be positive")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
+	}
+
+	return nil
+}
+
+// Allow approves a proposal when the tally of yes votes equals or exceeds the
+// threshold, and rejects it when the tally of no with veto votes equals or
+// exceeds the veto threshold. An approved proposal is never final: it is up to
+// the keeper to hold it until the end of the timelock, while vetoes remain
+// possible.
+func (p TimelockThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	vetoThreshold, err := math.NewPositiveDecFromString(p.VetoThreshold)
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "veto threshold")
+	}
+	vetoCount, err := tallyResult.GetNoWithVetoCount()
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "no with veto count")
+	}
+
+	if vetoCount.Cmp(vetoThreshold) >= 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	result, err := ThresholdDecisionPolicy{Threshold: p.Threshold, Windows: p.Windows}.Allow(tallyResult, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// The proposal can still be vetoed during the timelock.
+	if result.Allow {
+		result.Final = false
+	}
+
+	return result, nil
+}
+
+// Validate validates the policy against the group. Like for the
+// ThresholdDecisionPolicy, the thresholds can be greater than the group's total
+// weight.
+func (p *TimelockThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return errorsmod.Wrap(err, "threshold")
+	}
+	if _, err := math.NewPositiveDecFromString(p.VetoThreshold); err != nil {
+		return errorsmod.Wrap(err, "veto threshold")
+	}
+	if _, err := math.NewNonNegativeDecFromString(g.TotalWeight); err != nil {
+		return errorsmod.Wrap(err, "group total weight")
+	}
+
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -418,6 +418,87 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// TimelockThresholdDecisionPolicy is a decision policy where a proposal is
+// approved when the sum of all `YES` voter's weights is greater or equal than
+// the defined `threshold`. An approved proposal can only be executed once the
+// `timelock` after its approval has elapsed, and members can still vote during
+// the timelock: the proposal is rejected if the sum of all `NO_WITH_VETO`
+// voter's weights reaches the `veto_threshold` before the timelock ends.
+type TimelockThresholdDecisionPolicy struct {
+	// threshold is the minimum weighted sum of `YES` votes that must be met or
+	// exceeded for a proposal to be approved.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_threshold is the minimum weighted sum of `NO_WITH_VETO` votes that
+	// rejects a proposal, before or during its timelock.
+	VetoThreshold string `protobuf:"bytes,2,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// timelock is the duration after the approval of a proposal during which it
+	// cannot be executed and can still be vetoed.
+	Timelock time.Duration `protobuf:"bytes,3,opt,name=timelock,proto3,stdduration" json:"timelock"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *TimelockThresholdDecisionPolicy) Reset()         { *m = TimelockThresholdDecisionPolicy{} }
+func (m *TimelockThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*TimelockThresholdDecisionPolicy) ProtoMessage()    {}
+func (*TimelockThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *TimelockThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockThresholdDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockThresholdDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockThresholdDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockThresholdDecisionPolicy.Merge(m, src)
+}
+func (m *TimelockThresholdDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockThresholdDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockThresholdDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockThresholdDecisionPolicy proto.InternalMessageInfo
+
+func (m *TimelockThresholdDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *TimelockThresholdDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *TimelockThresholdDecisionPolicy) GetTimelock() time.Duration {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
+func (m *TimelockThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -441,7 +522,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +589,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +672,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +740,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,13 +815,17 @@ type Proposal struct {
 	Title string `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
 	// summary is a short summary of the proposal
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// approval_time is the timestamp at which the proposal was approved. It is
+	// only set for proposals whose decision policy has a timelock, in which case
+	// the `voting_period_end` is moved to the end of the timelock on approval.
+	ApprovalTime *time.Time `protobuf:"bytes,15,opt,name=approval_time,json=approvalTime,proto3,stdtime" json:"approval_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +870,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +918,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,6 +990,7 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*TimelockThresholdDecisionPolicy)(nil), "cosmos.group.v1.TimelockThresholdDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
@@ -917,93 +1003,99 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0x3a, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x04, 0xc5, 0x6e, 0x53, 0x44, 0xa5, 0x9e, 0xb0, 0x9d, 0x2d, 0x75, 0xd4, 0xda, 0xd6,
-	0x7a, 0x9d, 0xd0, 0x5e, 0x56, 0x1b, 0xef, 0xd4, 0x59, 0xd5, 0xde, 0x31, 0xbb, 0xe3, 0xa4, 0xfe,
-	0x0f, 0x2a, 0x2e, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0x97, 0x1e, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0x84, 0x38, 0x00, 0x6a, 0x0f, 0xe5, 0xc4, 0x89, 0x2b, 0x12, 0xda, 0x99,
-	0x59, 0xc7, 0x1f, 0xb1, 0x4b, 0xaa, 0x8a, 0x4b, 0x94, 0x99, 0xe7, 0x79, 0xdf, 0x79, 0x9f, 0xf7,
-	0x6b, 0x13, 0x58, 0x6d, 0x10, 0xb7, 0x4d, 0xdc, 0x5c, 0xd3, 0x21, 0xdd, 0x4e, 0xee, 0xf0, 0x72,
-	0x8e, 0xf6, 0x3a, 0xd8, 0xcd, 0x76, 0x1c, 0x42, 0x09, 0x4a, 0x70, 0x30, 0xcb, 0xc0, 0xec, 0xe1,
-	0xe5, 0x95, 0xc5, 0x26, 0x69, 0x12, 0x86, 0xe5, 0xbc, 0xdf, 0x38, 0x6d, 0x25, 0xd5, 0x24, 0xa4,
-	0xd9, 0xc2, 0x39, 0x76, 0xda, 0xef, 0xde, 0xcd, 0x99, 0x5d, 0xc7, 0xa0, 0x16, 0xb1, 0x05, 0x9e,
-	0x1e, 0xc5, 0xa9, 0xd5, 0xc6, 0x2e, 0x35, 0xda, 0x1d, 0x41, 0x58, 0xe6, 0xef, 0xe8, 0xdc, 0xb3,
-	0x78, 0x54, 0x40, 0xa3, 0xb6, 0x86, 0xdd, 0x13, 0xd0, 0x19, 0xa3, 0x6d, 0xd9, 0x24, 0xc7, 0x7e,
-	0xf2, 0xab, 0xcc, 0x37, 0x12, 0x84, 0x6e, 0xe1, 0xf6, 0x3e, 0x76, 0xd0, 0x16, 0x84, 0x0d, 0xd3,
-	0x74, 0xb0, 0xeb, 0xca, 0xd2, 0x9a, 0xb4, 0x1e, 0x2d, 0xc8, 0x3f, 0x3d, 0xdd, 0x5c, 0x14, 0xbe,
-	0xf3, 0x1c, 0xa9, 0x51, 0xc7, 0xb2, 0x9b, 0xaa, 0x4f, 0x44, 0xe7, 0x20, 0x74, 0x84, 0xad, 0xe6,
-	0x01, 0x95, 0x03, 0x9e, 0x89, 0x2a, 0x4e, 0x68, 0x05, 0x22, 0x6d, 0x4c, 0x0d, 0xd3, 0xa0, 0x86,
-	0x3c, 0xcb, 0x90, 0xfe, 0x19, 0x6d, 0x43, 0xc4, 0x30, 0x4d, 0x6c, 0xea, 0x06, 0x95, 0x83, 0x6b,
-	0xd2, 0x7a, 0x6c, 0x6b, 0x25, 0xcb, 0x63, 0xce, 0xfa, 0x31, 0x67, 0x35, 0x5f, 0x6f, 0x61, 0xe1,
-	0xd9, 0xaf, 0xe9, 0x99, 0x87, 0xbf, 0xa5, 0xa5, 0xc7, 0xaf, 0x9e, 0x6c, 0x48, 0xec, 0x65, 0x6c,
-	0xe6, 0x69, 0xe6, 0x08, 0x16, 0x78, 0xdc, 0x2a, 0xfe, 0xac, 0x8b, 0x5d, 0xfa, 0x5f, 0x85, 0x9f,
-	0xf9, 0x5e, 0x82, 0x25, 0xed, 0xc0, 0xc1, 0xee, 0x01, 0x69, 0x99, 0xdb, 0xb8, 0x61, 0xb9, 0x16,
-	0xb1, 0xab, 0xa4, 0x65, 0x35, 0x7a, 0xe8, 0x3c, 0x44, 0xa9, 0x0f, 0xf1, 0x28, 0xd4, 0xe3, 0x0b,
-	0xf4, 0x31, 0x84, 0x8f, 0x2c, 0xdb, 0x24, 0x47, 0x2e, 0x7b, 0x2e, 0xb6, 0xf5, 0x6e, 0x76, 0xa4,
-	0x5d, 0xb2, 0xc3, 0xfe, 0xf6, 0x38, 0x5b, 0xf5, 0xcd, 0xae, 0x95, 0x7e, 0x78, 0xba, 0x99, 0x9a,
-	0x6e, 0xf3, 0xf9, 0xab, 0x27, 0x1b, 0x19, 0x4e, 0xd9, 0x74, 0xcd, 0x7b, 0xb9, 0x09, 0xa1, 0x66,
-	0x9e, 0x49, 0x20, 0x57, 0xb1, 0xd3, 0xc0, 0x36, 0x35, 0x9a, 0x78, 0x44, 0x47, 0x0a, 0xa0, 0xd3,
-	0xc7, 0x84, 0x90, 0x81, 0x9b, 0xb7, 0xa0, 0x64, 0xe7, 0xdf, 0x29, 0xb9, 0x30, 0xa0, 0x64, 0x52,
-	0xb4, 0x99, 0xef, 0x24, 0xf8, 0xdf, 0x89, 0xcf, 0xa1, 0x5b, 0xb0, 0x70, 0x48, 0xa8, 0x65, 0x37,
-	0xf5, 0x0e, 0x76, 0x2c, 0xc2, 0x6b, 0x12, 0xdb, 0x5a, 0x1e, 0xeb, 0xb7, 0x6d, 0x31, 0x7f, 0xbc,
-	0xdd, 0xbe, 0xec, 0xb7, 0xdb, 0x3c, 0x37, 0xaf, 0x32, 0x6b, 0x74, 0x07, 0x16, 0xdb, 0x96, 0xad,
-	0xe3, 0xfb, 0xb8, 0xd1, 0xf5, 0xd8, 0xbe, 0xd7, 0xc0, 0x29, 0xbd, 0xa2, 0xb6, 0x65, 0x2b, 0xbe,
-	0x13, 0xee, 0x3b, 0xf3, 0xa7, 0x04, 0xd1, 0x4f, 0xbc, 0x44, 0x94, 0xec, 0xbb, 0x04, 0xc5, 0x21,
-	0x60, 0xf1, 0x68, 0x83, 0x6a, 0xc0, 0x32, 0x51, 0x16, 0xe6, 0x0c, 0xb3, 0x6d, 0xd9, 0xbc, 0x4f,
-	0xa7, 0xb4, 0x36, 0xa7, 0x4d, 0x9d, 0x3f, 0x19, 0xc2, 0x87, 0xd8, 0xf1, 0x92, 0xc5, 0xc6, 0x2f,
-	0xa8, 0xfa, 0x47, 0xf4, 0x0e, 0xcc, 0x53, 0x42, 0x8d, 0x96, 0x2e, 0x86, 0x62, 0x8e, 0x59, 0xc6,
-	0xd8, 0xdd, 0x1e, 0x9f, 0x8c, 0x1b, 0x00, 0x0d, 0x07, 0x1b, 0x94, 0x8f, 0x6f, 0xe8, 0xb4, 0xe3,
-	0x1b, 0x15, 0xc6, 0x79, 0x9a, 0xb9, 0x0d, 0x31, 0xa6, 0x57, 0x6c, 0x9f, 0x65, 0x88, 0xb0, 0x3e,
-	0xd0, 0xfb, 0xba, 0xc3, 0xec, 0x5c, 0x32, 0x51, 0x0e, 0x42, 0x6d, 0x46, 0x12, 0x89, 0x5e, 0x1a,
-	0x6b, 0x36, 0xb1, 0x09, 0x04, 0x2d, 0xf3, 0x77, 0x00, 0x12, 0xcc, 0x37, 0xef, 0x06, 0x96, 0xd1,
-	0x37, 0x59, 0x0f, 0x83, 0x31, 0x05, 0x86, 0x63, 0xea, 0x17, 0x64, 0xf6, 0xf4, 0x05, 0x09, 0x4e,
-	0x2e, 0xc8, 0xdc, 0x70, 0x41, 0x0c, 0x48, 0x98, 0xa2, 0xb1, 0xf5, 0x0e, 0xd3, 0x22, 0x52, 0xbe,
-	0x38, 0x96, 0xf2, 0xbc, 0xdd, 0x2b, 0x64, 0x5e, 0x3f, 0x54, 0x6a, 0xdc, 0x1c, 0x1e, 0xf5, 0xe1,
-	0x82, 0x86, 0xdf, 0xbc, 0xa0, 0xd7, 0x22, 0x0f, 0x1e, 0xa5, 0x67, 0xfe, 0x78, 0x94, 0x96, 0x32,
-	0x8f, 0x43, 0x10, 0xa9, 0x3a, 0xa4, 0x43, 0x5c, 0xa3, 0x35, 0xd6, 0xca, 0x3b, 0xb0, 0xc8, 0x93,
-	0xca, 0x05, 0xe9, 0x7e, 0x55, 0x5e, 0xd7, 0xd9, 0xa8, 0x79, 0x5c, 0x51, 0x81, 0x4c, 0x6d, 0xf3,
-	0x8f, 0x20, 0xda, 0x61, 0x31, 0x60, 0xc7, 0x95, 0x83, 0x6b, 0xb3, 0x53, 0x9d, 0x1f, 0x53, 0xd1,
-	0x0e, 0xc4, 0xdc, 0xee, 0x7e, 0xdb, 0xa2, 0xba, 0xf7, 0xd1, 0x65, 0x15, 0x39, 0x55, 0x46, 0x80,
-	0x5b, 0x7b, 0x38, 0xba, 0x00, 0x0b, 0x5c, 0xab, 0x5f, 0xdf, 0x10, 0x4b, 0xc3, 0x3c, 0xbb, 0xdc,
-	0x15, 0x45, 0xbe, 0x34, 0x92, 0x10, 0x9f, 0x1b, 0x66, 0xdc, 0x41, 0xd9, 0xbe, 0xc5, 0x55, 0x08,
-	0xb9, 0xd4, 0xa0, 0x5d, 0x57, 0x8e, 0xac, 0x49, 0xeb, 0xf1, 0xad, 0xf4, 0xd8, 0x40, 0xf8, 0xd9,
-	0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0e, 0xe8, 0xae, 0x65, 0x1b, 0x2d, 0x9d, 0x1a, 0xad, 0x56,
-	0x4f, 0x77, 0xb0, 0xdb, 0x6d, 0x51, 0x39, 0xca, 0x24, 0x9e, 0x1f, 0x73, 0xa2, 0x79, 0x24, 0x95,
-	0x71, 0x0a, 0x51, 0x4f, 0x24, 0x17, 0x98, 0x64, 0x2e, 0x06, 0x40, 0x54, 0x87, 0x33, 0x43, 0x6b,
-	0x56, 0xc7, 0xb6, 0x29, 0xc3, 0x69, 0x13, 0x97, 0x18, 0xdc, 0xb5, 0x8a, 0x6d, 0xa2, 0x2a, 0x24,
-	0xf8, 0xaa, 0x25, 0x8e, 0x1f, 0x6a, 0x8c, 0xe9, 0x7d, 0x6f, 0xa2, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x1c, 0x0f, 0x9d, 0xd1, 0x25, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x62, 0x57, 0x9e, 0x5f, 0x9b,
-	0x9d, 0x34, 0x48, 0x6a, 0x9f, 0x85, 0xde, 0x87, 0x39, 0x6a, 0xd1, 0x16, 0x96, 0x17, 0x58, 0x7b,
-	0x9e, 0xfd, 0xe5, 0xe9, 0x66, 0xe2, 0xf8, 0x9b, 0xb4, 0x76, 0x29, 0xfb, 0xe1, 0x55, 0x95, 0x33,
-	0xd0, 0x26, 0x84, 0xdd, 0x6e, 0xbb, 0x6d, 0x38, 0x3d, 0x39, 0x3e, 0x99, 0xec, 0x73, 0xae, 0x05,
-	0xbd, 0x71, 0xc9, 0x7c, 0x25, 0x41, 0x6c, 0x30, 0x95, 0xab, 0x10, 0xed, 0x61, 0x57, 0x6f, 0x90,
-	0xae, 0x4d, 0xc5, 0x87, 0x37, 0xd2, 0xc3, 0x6e, 0xd1, 0x3b, 0x7b, 0xed, 0x64, 0xec, 0xbb, 0xd4,
-	0xb0, 0x6c, 0x41, 0xe0, 0x7f, 0xb5, 0xcc, 0x8b, 0x4b, 0x4e, 0x5a, 0x86, 0x88, 0x4d, 0x04, 0xce,
-	0x67, 0x22, 0x6c, 0x13, 0x0e, 0x7d, 0x00, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c,
-	0x7d, 0x12, 0x5f, 0x47, 0x09, 0x9b, 0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xf1, 0xfd,
-	0x25, 0x41, 0x70, 0x97, 0x50, 0x8c, 0xd2, 0x10, 0xeb, 0x88, 0x24, 0x1f, 0xaf, 0x68, 0xf0, 0xaf,
-	0xf8, 0x46, 0x3c, 0x24, 0x54, 0x2c, 0xe9, 0xa9, 0x1b, 0x91, 0xd1, 0xd0, 0x15, 0x08, 0x91, 0x8e,
-	0xf7, 0x01, 0x64, 0x51, 0xc6, 0xb7, 0x56, 0xc7, 0x8a, 0xea, 0xbd, 0x5b, 0x61, 0x14, 0x55, 0x50,
-	0xa7, 0xae, 0xd1, 0xb7, 0x38, 0xb8, 0x1b, 0x5f, 0x48, 0x00, 0xc7, 0xcf, 0xa3, 0x55, 0x58, 0xda,
-	0xad, 0x68, 0x8a, 0x5e, 0xa9, 0x6a, 0xa5, 0x4a, 0x59, 0xaf, 0x97, 0x6b, 0x55, 0xa5, 0x58, 0xba,
-	0x5e, 0x52, 0xb6, 0x93, 0x33, 0xe8, 0x2c, 0x24, 0x06, 0xc1, 0xdb, 0x4a, 0x2d, 0x29, 0xa1, 0x25,
-	0x38, 0x3b, 0x78, 0x99, 0x2f, 0xd4, 0xb4, 0x7c, 0xa9, 0x9c, 0x0c, 0x20, 0x04, 0xf1, 0x41, 0xa0,
-	0x5c, 0x49, 0xce, 0xa2, 0xf3, 0x20, 0x0f, 0xdf, 0xe9, 0x7b, 0x25, 0xed, 0x86, 0xbe, 0xab, 0x68,
-	0x95, 0x64, 0x70, 0x25, 0xf8, 0xe0, 0xeb, 0xd4, 0xcc, 0xc6, 0x8f, 0x12, 0xc4, 0x87, 0xa7, 0x1a,
-	0xa5, 0x61, 0xb5, 0xaa, 0x56, 0xaa, 0x95, 0x5a, 0xfe, 0xa6, 0x5e, 0xd3, 0xf2, 0x5a, 0xbd, 0x36,
-	0x12, 0xd9, 0xff, 0x61, 0x79, 0x94, 0x50, 0xab, 0x17, 0x6e, 0x95, 0x34, 0x4d, 0xd9, 0x4e, 0x4a,
-	0xde, 0xb3, 0xa3, 0x70, 0xbe, 0x58, 0x54, 0xaa, 0x1e, 0x1a, 0x38, 0x09, 0x55, 0x95, 0x1d, 0xa5,
-	0xe8, 0xa1, 0xb3, 0x5e, 0x46, 0xc6, 0x6c, 0x0b, 0x15, 0xd5, 0x03, 0x83, 0x27, 0xbd, 0xeb, 0x09,
-	0xda, 0x56, 0xf3, 0x7b, 0xe5, 0xe4, 0x9c, 0x10, 0xf4, 0xad, 0x04, 0xe7, 0x4e, 0x1e, 0x5b, 0xb4,
-	0x0e, 0x17, 0xfb, 0xf6, 0xca, 0xa7, 0x4a, 0xb1, 0xae, 0x55, 0x54, 0x5d, 0x55, 0x6a, 0xf5, 0x9b,
-	0xda, 0x88, 0xc2, 0x8b, 0xb0, 0x36, 0x91, 0x59, 0xae, 0x68, 0xba, 0x5a, 0x2f, 0x27, 0xa5, 0xa9,
-	0xac, 0x5a, 0xbd, 0x58, 0x54, 0x6a, 0xb5, 0x64, 0x60, 0x2a, 0xeb, 0x7a, 0xbe, 0x74, 0xb3, 0xae,
-	0x2a, 0xc9, 0x59, 0x1e, 0x7c, 0x21, 0xfb, 0xec, 0x45, 0x4a, 0x7a, 0xfe, 0x22, 0x25, 0xfd, 0xfe,
-	0x22, 0x25, 0x3d, 0x7c, 0x99, 0x9a, 0x79, 0xfe, 0x32, 0x35, 0xf3, 0xf3, 0xcb, 0xd4, 0xcc, 0x1d,
-	0xd1, 0xf3, 0xae, 0x79, 0x2f, 0x6b, 0x91, 0xdc, 0x7d, 0xfe, 0x6f, 0xe2, 0x7e, 0x88, 0xb5, 0xdf,
-	0x95, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x6e, 0xa9, 0xde, 0x3d, 0x0e, 0x00, 0x00,
+	// 1465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3d, 0x6c, 0x1b, 0xc7,
+	0x12, 0xd6, 0x51, 0x14, 0x7f, 0x86, 0x12, 0x49, 0xaf, 0xf5, 0xac, 0x93, 0xe4, 0x47, 0xea, 0xd1,
+	0x7e, 0xef, 0x29, 0x0a, 0x44, 0xda, 0x72, 0x12, 0x03, 0xae, 0x42, 0x52, 0xe7, 0x98, 0x82, 0x2d,
+	0x12, 0xc7, 0xa3, 0x64, 0xbb, 0x39, 0x9c, 0x78, 0x6b, 0xea, 0x60, 0xf2, 0x96, 0xb9, 0x5b, 0x4a,
+	0x66, 0x9b, 0xca, 0x48, 0x13, 0x97, 0x69, 0x02, 0x18, 0x48, 0x93, 0xd2, 0x85, 0x91, 0x22, 0x65,
+	0x90, 0xc2, 0x48, 0x11, 0x18, 0x46, 0x8a, 0xc0, 0x45, 0x12, 0xd8, 0x85, 0x53, 0xa5, 0x4a, 0x1b,
+	0x20, 0xb8, 0xdd, 0x3d, 0x8a, 0x3f, 0x12, 0x15, 0x09, 0x46, 0x1a, 0xc3, 0x3b, 0xf3, 0xcd, 0xec,
+	0xcc, 0x37, 0xdf, 0x0e, 0x4f, 0xb0, 0x58, 0x27, 0x6e, 0x8b, 0xb8, 0xb9, 0x86, 0x43, 0x3a, 0xed,
+	0xdc, 0xde, 0xe5, 0x1c, 0xed, 0xb6, 0xb1, 0x9b, 0x6d, 0x3b, 0x84, 0x12, 0x94, 0xe0, 0xce, 0x2c,
+	0x73, 0x66, 0xf7, 0x2e, 0x2f, 0xcc, 0x36, 0x48, 0x83, 0x30, 0x5f, 0xce, 0xfb, 0x1f, 0x87, 0x2d,
+	0xa4, 0x1a, 0x84, 0x34, 0x9a, 0x38, 0xc7, 0x4e, 0x3b, 0x9d, 0x7b, 0x39, 0xb3, 0xe3, 0x18, 0xd4,
+	0x22, 0xb6, 0xf0, 0xa7, 0x87, 0xfd, 0xd4, 0x6a, 0x61, 0x97, 0x1a, 0xad, 0xb6, 0x00, 0xcc, 0xf3,
+	0x7b, 0x74, 0x9e, 0x59, 0x5c, 0x2a, 0x5c, 0xc3, 0xb1, 0x86, 0xdd, 0x15, 0xae, 0x33, 0x46, 0xcb,
+	0xb2, 0x49, 0x8e, 0xfd, 0xcb, 0x4d, 0x99, 0xaf, 0x25, 0x08, 0xdd, 0xc2, 0xad, 0x1d, 0xec, 0xa0,
+	0x35, 0x08, 0x1b, 0xa6, 0xe9, 0x60, 0xd7, 0x95, 0xa5, 0x25, 0x69, 0x39, 0x5a, 0x90, 0x5f, 0x3c,
+	0x5d, 0x9d, 0x15, 0xb9, 0xf3, 0xdc, 0x53, 0xa5, 0x8e, 0x65, 0x37, 0x54, 0x1f, 0x88, 0xce, 0x41,
+	0x68, 0x1f, 0x5b, 0x8d, 0x5d, 0x2a, 0x07, 0xbc, 0x10, 0x55, 0x9c, 0xd0, 0x02, 0x44, 0x5a, 0x98,
+	0x1a, 0xa6, 0x41, 0x0d, 0x79, 0x92, 0x79, 0x7a, 0x67, 0xb4, 0x0e, 0x11, 0xc3, 0x34, 0xb1, 0xa9,
+	0x1b, 0x54, 0x0e, 0x2e, 0x49, 0xcb, 0xb1, 0xb5, 0x85, 0x2c, 0xaf, 0x39, 0xeb, 0xd7, 0x9c, 0xd5,
+	0xfc, 0x7e, 0x0b, 0x33, 0xcf, 0x7e, 0x4e, 0x4f, 0x3c, 0xfa, 0x25, 0x2d, 0x7d, 0xf5, 0xe6, 0xc9,
+	0x8a, 0xc4, 0x6e, 0xc6, 0x66, 0x9e, 0x66, 0xf6, 0x61, 0x86, 0xd7, 0xad, 0xe2, 0x8f, 0x3b, 0xd8,
+	0xa5, 0xff, 0x54, 0xf9, 0x99, 0xef, 0x24, 0x98, 0xd3, 0x76, 0x1d, 0xec, 0xee, 0x92, 0xa6, 0xb9,
+	0x8e, 0xeb, 0x96, 0x6b, 0x11, 0xbb, 0x42, 0x9a, 0x56, 0xbd, 0x8b, 0xce, 0x43, 0x94, 0xfa, 0x2e,
+	0x5e, 0x85, 0x7a, 0x60, 0x40, 0x1f, 0x42, 0x78, 0xdf, 0xb2, 0x4d, 0xb2, 0xef, 0xb2, 0xeb, 0x62,
+	0x6b, 0xff, 0xcb, 0x0e, 0xc9, 0x25, 0x3b, 0x98, 0x6f, 0x9b, 0xa3, 0x55, 0x3f, 0xec, 0x5a, 0xe9,
+	0xfb, 0xa7, 0xab, 0xa9, 0xf1, 0x31, 0x9f, 0xbe, 0x79, 0xb2, 0x92, 0xe1, 0x90, 0x55, 0xd7, 0xbc,
+	0x9f, 0x3b, 0xa2, 0xd4, 0xcc, 0x33, 0x09, 0xe4, 0x0a, 0x76, 0xea, 0xd8, 0xa6, 0x46, 0x03, 0x0f,
+	0xf5, 0x91, 0x02, 0x68, 0xf7, 0x7c, 0xa2, 0x91, 0x3e, 0xcb, 0x5b, 0xe8, 0x64, 0xe3, 0xef, 0x75,
+	0x72, 0xa1, 0xaf, 0x93, 0xa3, 0xaa, 0xcd, 0xfc, 0x18, 0x80, 0xb4, 0x27, 0x98, 0x26, 0xa9, 0xdf,
+	0x3f, 0xdd, 0x64, 0xfe, 0x0b, 0xf1, 0x3d, 0x4c, 0x89, 0x7e, 0x00, 0xe1, 0x7a, 0x98, 0xf1, 0xac,
+	0xbd, 0x94, 0x9e, 0x72, 0xa9, 0xb8, 0x87, 0xc9, 0x22, 0xb6, 0x36, 0x3f, 0xa2, 0xdc, 0x75, 0xf1,
+	0x92, 0xb9, 0x70, 0x3f, 0xef, 0x09, 0xb7, 0x17, 0xd9, 0x4f, 0x5e, 0xf0, 0x74, 0xe4, 0x99, 0xc7,
+	0x93, 0xf7, 0xe2, 0xe9, 0x6a, 0xe2, 0x80, 0xbb, 0xa5, 0x4b, 0xd9, 0xf7, 0xd7, 0x3c, 0x3e, 0x57,
+	0xfa, 0x95, 0x31, 0x9e, 0xb2, 0xcc, 0xb7, 0x12, 0xfc, 0xeb, 0xd0, 0x42, 0xd0, 0x2d, 0x98, 0xd9,
+	0x23, 0xd4, 0xb2, 0x1b, 0x7a, 0x1b, 0x3b, 0x16, 0xe1, 0x84, 0x9e, 0x84, 0x8c, 0x69, 0x1e, 0x5e,
+	0x61, 0xd1, 0xe8, 0x2e, 0xcc, 0xb6, 0x2c, 0x5b, 0xc7, 0x0f, 0x70, 0xbd, 0xe3, 0xa1, 0xfd, 0xac,
+	0x81, 0x13, 0x66, 0x45, 0x2d, 0xcb, 0x56, 0xfc, 0x24, 0x3c, 0x77, 0xe6, 0x77, 0x09, 0xa2, 0x1f,
+	0x79, 0x14, 0x95, 0xec, 0x7b, 0x04, 0xc5, 0x21, 0x60, 0xf1, 0x6a, 0x83, 0x6a, 0xc0, 0x32, 0x51,
+	0x16, 0xa6, 0x0c, 0xb3, 0x65, 0xd9, 0x72, 0xe0, 0x98, 0x8d, 0xc1, 0x61, 0x63, 0xd7, 0x9a, 0x0c,
+	0xe1, 0x3d, 0xec, 0x78, 0x64, 0xb1, 0xb1, 0x06, 0x55, 0xff, 0x88, 0xfe, 0x03, 0xd3, 0x94, 0x50,
+	0xa3, 0xa9, 0x8b, 0x5d, 0x33, 0xc5, 0x22, 0x63, 0xcc, 0xb6, 0xcd, 0x4c, 0xe8, 0x06, 0x40, 0xdd,
+	0xc1, 0x06, 0xe5, 0x5b, 0x31, 0x74, 0xd2, 0xad, 0x18, 0x15, 0xc1, 0x79, 0x9a, 0xb9, 0x03, 0x31,
+	0xd6, 0xaf, 0x58, 0xea, 0xf3, 0x10, 0x61, 0x0a, 0xd1, 0x7b, 0x7d, 0x87, 0xd9, 0xb9, 0x64, 0xa2,
+	0x1c, 0x84, 0x5a, 0x0c, 0x24, 0x88, 0x9e, 0x1b, 0x91, 0xa1, 0x58, 0xb0, 0x02, 0x96, 0xf9, 0x33,
+	0x00, 0x09, 0x96, 0x9b, 0xab, 0x81, 0x31, 0x7a, 0x9a, 0xad, 0xdb, 0x5f, 0x53, 0x60, 0xb0, 0xa6,
+	0xde, 0x40, 0x26, 0x4f, 0x3e, 0x90, 0xe0, 0xd1, 0x03, 0x99, 0x1a, 0x1c, 0x88, 0x01, 0x09, 0x53,
+	0x08, 0x5b, 0x6f, 0xb3, 0x5e, 0x04, 0xe5, 0xb3, 0x23, 0x94, 0xe7, 0xed, 0x6e, 0x21, 0x73, 0xfc,
+	0x73, 0x53, 0xe3, 0xe6, 0xc0, 0x79, 0x68, 0xa0, 0xe1, 0xd3, 0x0f, 0xf4, 0x5a, 0xe4, 0xe1, 0xe3,
+	0xf4, 0xc4, 0x6f, 0x8f, 0xd3, 0x52, 0xe6, 0x93, 0x30, 0x44, 0x2a, 0x0e, 0x69, 0x13, 0xd7, 0x68,
+	0x8e, 0x48, 0x79, 0x03, 0x66, 0x39, 0xa9, 0xbc, 0x21, 0xdd, 0x9f, 0xca, 0x71, 0xca, 0x46, 0x8d,
+	0x83, 0x89, 0x0a, 0xcf, 0x58, 0x99, 0x7f, 0x00, 0xd1, 0x36, 0xab, 0x01, 0x3b, 0xde, 0xfe, 0x9a,
+	0x1c, 0x9b, 0xfc, 0x00, 0x8a, 0x36, 0x20, 0xe6, 0x76, 0x76, 0x5a, 0x16, 0xd5, 0xbd, 0x45, 0x28,
+	0x4f, 0x9d, 0x94, 0x11, 0xe0, 0xd1, 0x9e, 0x1f, 0x5d, 0x80, 0x19, 0xde, 0xab, 0x3f, 0xdf, 0x10,
+	0xa3, 0x61, 0x9a, 0x19, 0xb7, 0xc4, 0x90, 0x2f, 0x0d, 0x11, 0xe2, 0x63, 0xc3, 0x0c, 0xdb, 0xdf,
+	0xb6, 0x1f, 0x71, 0x15, 0x42, 0x2e, 0x35, 0x68, 0xc7, 0x95, 0x23, 0x4b, 0xd2, 0x72, 0x7c, 0x2d,
+	0x3d, 0xf2, 0x20, 0x7c, 0xf6, 0xab, 0x0c, 0xa6, 0x0a, 0x38, 0xaa, 0x01, 0xba, 0x67, 0xd9, 0x46,
+	0x53, 0xa7, 0x46, 0xb3, 0xd9, 0xd5, 0x1d, 0xec, 0x76, 0x9a, 0x54, 0x8e, 0xb2, 0x16, 0xcf, 0x8f,
+	0x24, 0xd1, 0x3c, 0x90, 0xca, 0x30, 0x85, 0xa8, 0xd7, 0x24, 0x6f, 0x30, 0xc9, 0x52, 0xf4, 0x39,
+	0x51, 0x0d, 0xce, 0x0c, 0xac, 0x59, 0x1d, 0xdb, 0xa6, 0x0c, 0x27, 0x25, 0x2e, 0xd1, 0xbf, 0x6b,
+	0x15, 0xdb, 0x44, 0x15, 0x48, 0xf0, 0x55, 0x4b, 0x1c, 0xbf, 0xd4, 0x18, 0xeb, 0xf7, 0xff, 0x47,
+	0xf6, 0xab, 0x08, 0x3c, 0x2f, 0x4c, 0x8d, 0xe3, 0x81, 0x33, 0xba, 0xe4, 0xe9, 0xc5, 0x75, 0x8d,
+	0x06, 0x76, 0xe5, 0xe9, 0xa5, 0xc9, 0xa3, 0x1e, 0x92, 0xda, 0x43, 0xa1, 0x77, 0x60, 0x8a, 0x5a,
+	0xb4, 0x89, 0xe5, 0x19, 0x26, 0xcf, 0xb3, 0x2f, 0x87, 0x7f, 0xae, 0xde, 0xbb, 0xaa, 0x72, 0x04,
+	0x5a, 0x85, 0xb0, 0xdb, 0x69, 0xb5, 0x0c, 0xa7, 0x2b, 0xc7, 0x8f, 0x06, 0xfb, 0x18, 0x74, 0x1b,
+	0x66, 0x8c, 0x76, 0xdb, 0x21, 0x7b, 0x46, 0x93, 0x2b, 0x2d, 0x71, 0x2c, 0x61, 0x73, 0x1e, 0x59,
+	0x2f, 0x47, 0x7f, 0x30, 0xd5, 0x69, 0x3f, 0x93, 0x87, 0xbd, 0x16, 0xf4, 0x1e, 0x62, 0xe6, 0x0b,
+	0x09, 0x62, 0xfd, 0x43, 0x5a, 0x84, 0x68, 0x17, 0xbb, 0x7a, 0x9d, 0x74, 0x6c, 0x2a, 0x3e, 0x2c,
+	0x22, 0x5d, 0xec, 0x16, 0xbd, 0xb3, 0x27, 0x54, 0x63, 0xc7, 0xa5, 0x86, 0x65, 0x0b, 0x00, 0xff,
+	0xac, 0x98, 0x16, 0x46, 0x0e, 0x9a, 0x87, 0x88, 0x4d, 0x84, 0x9f, 0xbf, 0xb6, 0xb0, 0x4d, 0xb8,
+	0xeb, 0x5d, 0x40, 0x36, 0xd1, 0xf7, 0x2d, 0xba, 0xab, 0xb3, 0xef, 0x13, 0x0e, 0xe2, 0x8b, 0x2e,
+	0x61, 0x93, 0x6d, 0x8b, 0xee, 0x6e, 0x61, 0xca, 0xc1, 0xa2, 0xbe, 0x3f, 0x24, 0x08, 0x6e, 0x11,
+	0x8a, 0x51, 0x1a, 0x62, 0x6d, 0x31, 0xbe, 0x83, 0xe5, 0x0f, 0xbe, 0x89, 0xef, 0xda, 0x3d, 0x42,
+	0xc5, 0xfa, 0x1f, 0xbb, 0x6b, 0x19, 0x0c, 0x5d, 0x81, 0x10, 0x69, 0x7b, 0x3f, 0xad, 0xac, 0xca,
+	0xf8, 0xda, 0xe2, 0x88, 0x5c, 0xbc, 0x7b, 0xcb, 0x0c, 0xa2, 0x0a, 0xe8, 0xd8, 0x05, 0xfd, 0x16,
+	0x57, 0xc2, 0xca, 0x67, 0x12, 0xc0, 0xc1, 0xf5, 0x68, 0x11, 0xe6, 0xb6, 0xca, 0x9a, 0xa2, 0x97,
+	0x2b, 0x5a, 0xa9, 0xbc, 0xa9, 0xd7, 0x36, 0xab, 0x15, 0xa5, 0x58, 0xba, 0x5e, 0x52, 0xd6, 0x93,
+	0x13, 0xe8, 0x2c, 0x24, 0xfa, 0x9d, 0x77, 0x94, 0x6a, 0x52, 0x42, 0x73, 0x70, 0xb6, 0xdf, 0x98,
+	0x2f, 0x54, 0xb5, 0x7c, 0x69, 0x33, 0x19, 0x40, 0x08, 0xe2, 0xfd, 0x8e, 0xcd, 0x72, 0x72, 0x12,
+	0x9d, 0x07, 0x79, 0xd0, 0xa6, 0x6f, 0x97, 0xb4, 0x1b, 0xfa, 0x96, 0xa2, 0x95, 0x93, 0xc1, 0x85,
+	0xe0, 0xc3, 0x2f, 0x53, 0x13, 0x2b, 0x3f, 0x48, 0x10, 0x1f, 0xdc, 0x17, 0x28, 0x0d, 0x8b, 0x15,
+	0xb5, 0x5c, 0x29, 0x57, 0xf3, 0x37, 0xf5, 0xaa, 0x96, 0xd7, 0x6a, 0xd5, 0xa1, 0xca, 0xfe, 0x0d,
+	0xf3, 0xc3, 0x80, 0x6a, 0xad, 0x70, 0xab, 0xa4, 0x69, 0xca, 0x7a, 0x52, 0xf2, 0xae, 0x1d, 0x76,
+	0xe7, 0x8b, 0x45, 0xa5, 0xe2, 0x79, 0x03, 0x87, 0x79, 0x55, 0x65, 0x43, 0x29, 0x7a, 0xde, 0x49,
+	0x8f, 0x91, 0x91, 0xd8, 0x42, 0x59, 0xf5, 0x9c, 0xc1, 0xc3, 0xee, 0xf5, 0x1a, 0x5a, 0x57, 0xf3,
+	0xdb, 0x9b, 0xc9, 0x29, 0xd1, 0xd0, 0x37, 0x12, 0x9c, 0x3b, 0x7c, 0x21, 0xa0, 0x65, 0xb8, 0xd8,
+	0x8b, 0x57, 0x6e, 0x2b, 0xc5, 0x9a, 0x56, 0x56, 0x75, 0x55, 0xa9, 0xd6, 0x6e, 0x6a, 0x43, 0x1d,
+	0x5e, 0x84, 0xa5, 0x23, 0x91, 0x9b, 0x65, 0x4d, 0x57, 0x6b, 0x9b, 0x49, 0x69, 0x2c, 0xaa, 0x5a,
+	0x2b, 0x16, 0x95, 0x6a, 0x35, 0x19, 0x18, 0x8b, 0xba, 0x9e, 0x2f, 0xdd, 0xac, 0xa9, 0x4a, 0x72,
+	0x92, 0x17, 0x5f, 0xc8, 0x3e, 0x7b, 0x95, 0x92, 0x9e, 0xbf, 0x4a, 0x49, 0xbf, 0xbe, 0x4a, 0x49,
+	0x8f, 0x5e, 0xa7, 0x26, 0x9e, 0xbf, 0x4e, 0x4d, 0xfc, 0xf4, 0x3a, 0x35, 0x71, 0x57, 0x68, 0xde,
+	0x35, 0xef, 0x67, 0x2d, 0x92, 0x7b, 0xc0, 0xff, 0xae, 0xdf, 0x09, 0x31, 0xf9, 0x5d, 0xf9, 0x6b,
+	0x00, 0x0d, 0x32, 0xb8, 0x68, 0xee, 0x0f, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1228,6 +1320,63 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *TimelockThresholdDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockThresholdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1248,20 +1397,20 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1287,12 +1436,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1389,12 +1538,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1463,6 +1612,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ApprovalTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ApprovalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ApprovalTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintTypes(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1496,12 +1655,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1529,12 +1688,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1639,12 +1798,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1763,6 +1922,29 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *TimelockThresholdDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1909,6 +2091,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ApprovalTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ApprovalTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2529,6 +2715,189 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TimelockThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockThresholdDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3637,6 +4006,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovalTime == nil {
+				m.ApprovalTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ApprovalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
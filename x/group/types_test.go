@@ -386,3 +386,55 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestTimelockThresholdDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr string
+	}{
+		{"all good", group.NewTimelockThresholdDecisionPolicy("2", "1", time.Hour, time.Hour, 0), ""},
+		{"invalid threshold", group.NewTimelockThresholdDecisionPolicy("0", "1", time.Hour, time.Hour, 0), "threshold"},
+		{"invalid veto threshold", group.NewTimelockThresholdDecisionPolicy("2", "", time.Hour, time.Hour, 0), "veto threshold"},
+		{"zero timelock", group.NewTimelockThresholdDecisionPolicy("2", "1", 0, time.Hour, 0), "timelock must be positive"},
+		{"zero voting period", group.NewTimelockThresholdDecisionPolicy("2", "1", time.Hour, 0, 0), "voting period cannot be zero"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestTimelockThresholdDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewTimelockThresholdDecisionPolicy("2", "1", time.Hour, time.Second*100, 0)
+	tally := func(yes, no, veto string) group.TallyResult {
+		return group.TallyResult{YesCount: yes, NoCount: no, AbstainCount: "0", NoWithVetoCount: veto}
+	}
+
+	testCases := []struct {
+		name       string
+		tally      group.TallyResult
+		totalPower string
+		result     group.DecisionPolicyResult
+	}{
+		{"YesCount >= threshold is not final", tally("2", "0", "0"), "4", group.DecisionPolicyResult{Allow: true, Final: false}},
+		{"YesCount == group total weight < threshold", tally("1", "0", "0"), "1", group.DecisionPolicyResult{Allow: true, Final: false}},
+		{"YesCount < threshold", tally("1", "0", "0"), "4", group.DecisionPolicyResult{Allow: false, Final: false}},
+		{"maxYesCount < threshold", tally("1", "2", "0"), "3", group.DecisionPolicyResult{Allow: false, Final: true}},
+		{"vetoed", tally("0", "0", "1"), "4", group.DecisionPolicyResult{Allow: false, Final: true}},
+		{"vetoed after approval", tally("3", "0", "1"), "4", group.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.Allow(tc.tally, tc.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, policyResult)
+		})
+	}
+}
@@ -103,9 +103,19 @@ func (k Keeper) AddCollectedFees(ctx context.Context, fees sdk.Coins) error {
 
 func (k Keeper) DefaultMintFn(ic types.InflationCalculationFn) types.MintFn {
 	return func(ctx context.Context, env appmodule.Environment, minter *types.Minter, epochId string, epochNumber int64) error {
-		// the default mint function is called every block, so we only check if epochId is "block" which is
-		// a special value to indicate that this is not an epoch minting, but a regular block minting.
-		if epochId != "block" {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		// the default mint function is called every block with epochId "block", which is a special value
+		// to indicate that this is not an epoch minting, but a regular block minting. When minting per epoch,
+		// tokens are only minted at the start of the epoch set in the params.
+		if params.IsEpochMinting() {
+			if epochId != params.EpochIdentifier {
+				return nil
+			}
+		} else if epochId != "block" {
 			return nil
 		}
 
@@ -119,15 +129,13 @@ func (k Keeper) DefaultMintFn(ic types.InflationCalculationFn) types.MintFn {
 			return err
 		}
 
-		params, err := k.Params.Get(ctx)
-		if err != nil {
-			return err
-		}
-
 		minter.Inflation = ic(ctx, *minter, params, bondedRatio)
 		minter.AnnualProvisions = minter.NextAnnualProvisions(params, stakingTokenSupply)
 
 		mintedCoin := minter.BlockProvision(params)
+		if params.IsEpochMinting() {
+			mintedCoin = minter.EpochProvision(params)
+		}
 		mintedCoins := sdk.NewCoins(mintedCoin)
 		maxSupply := params.MaxSupply
 		totalSupply := stakingTokenSupply
@@ -136,6 +136,35 @@ func (s *KeeperTestSuite) TestDefaultMintFn() {
 	s.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestDefaultMintFnEpochMinting() {
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
+	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(bondedRatio, nil).AnyTimes()
+
+	params, err := s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+	params.EpochIdentifier = "day"
+	params.EpochsPerYear = 365
+	err = s.mintKeeper.Params.Set(s.ctx, params)
+	s.NoError(err)
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+
+	// nothing is minted every block or at the start of other epochs
+	err = s.mintKeeper.DefaultMintFn(types.DefaultInflationCalculationFn)(s.ctx, s.mintKeeper.Environment, &minter, "block", -1)
+	s.NoError(err)
+	err = s.mintKeeper.DefaultMintFn(types.DefaultInflationCalculationFn)(s.ctx, s.mintKeeper.Environment, &minter, "week", 1)
+	s.NoError(err)
+
+	// the epoch provision is minted at the start of the epoch
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(13698630)))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(13698630)))).Return(nil)
+
+	err = s.mintKeeper.DefaultMintFn(types.DefaultInflationCalculationFn)(s.ctx, s.mintKeeper.Environment, &minter, "day", 1)
+	s.NoError(err)
+}
+
 func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
 	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
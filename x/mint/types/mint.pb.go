@@ -94,6 +94,11 @@ type Params struct {
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// maximum supply for the token
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// epoch_identifier is the identifier of the x/epochs epoch at the start of
+	// which tokens are minted. Tokens are minted every block if empty.
+	EpochIdentifier string `protobuf:"bytes,8,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// expected epochs per year, used instead of blocks_per_year when minting per epoch
+	EpochsPerYear uint64 `protobuf:"varint,9,opt,name=epochs_per_year,json=epochsPerYear,proto3" json:"epochs_per_year,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -143,6 +148,20 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetEpochsPerYear() uint64 {
+	if m != nil {
+		return m.EpochsPerYear
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
@@ -151,38 +170,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x10, 0x82, 0x72, 0xb4, 0x82, 0x5e, 0xa9, 0xe4, 0x16, 0xd5, 0x8d, 0x3a, 0xa0,
-	0xa8, 0xa8, 0xb1, 0xa2, 0x4a, 0x0c, 0x8c, 0x21, 0x4b, 0x11, 0x15, 0x91, 0x19, 0x10, 0x20, 0x61,
-	0xbd, 0xb6, 0xaf, 0xee, 0x11, 0xdf, 0x9d, 0xe5, 0xbb, 0x54, 0xf6, 0x57, 0x60, 0xe2, 0x63, 0x30,
-	0x76, 0x60, 0xe1, 0x1b, 0x74, 0x41, 0xaa, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0x5f, 0x03, 0xf9,
-	0xce, 0xa4, 0xfc, 0x99, 0x08, 0x5d, 0xac, 0xbb, 0xf7, 0x79, 0xdf, 0xdf, 0xf3, 0xd8, 0xf2, 0x8b,
-	0xdd, 0x48, 0x2a, 0x2e, 0x95, 0xc7, 0x99, 0xd0, 0xde, 0x71, 0x3f, 0xa4, 0x1a, 0xfa, 0xe6, 0xd2,
-	0xcb, 0x72, 0xa9, 0x25, 0x59, 0xb5, 0x7a, 0xcf, 0x94, 0x6a, 0x7d, 0xe3, 0x6e, 0x22, 0x13, 0x69,
-	0x74, 0xaf, 0x3a, 0xd9, 0xd6, 0x8d, 0x75, 0xdb, 0x1a, 0x58, 0xa1, 0x9e, 0xb3, 0xd2, 0x0a, 0x70,
-	0x26, 0xa4, 0x67, 0x9e, 0x3f, 0xbb, 0x13, 0x29, 0x93, 0x94, 0x7a, 0xe6, 0x16, 0x4e, 0x0e, 0x3d,
-	0x10, 0xa5, 0x95, 0xb6, 0x3f, 0x23, 0xdc, 0x3a, 0x60, 0x42, 0xd3, 0x9c, 0x3c, 0xc3, 0x6d, 0x26,
-	0x0e, 0x53, 0xd0, 0x4c, 0x0a, 0x07, 0x75, 0x50, 0xb7, 0x3d, 0xe8, 0x9f, 0x9e, 0x6f, 0x35, 0xbe,
-	0x9d, 0x6f, 0xdd, 0xb3, 0x0e, 0x2a, 0x1e, 0xf7, 0x98, 0xf4, 0x38, 0xe8, 0xa3, 0xde, 0x53, 0x9a,
-	0x40, 0x54, 0x0e, 0x69, 0xf4, 0xe5, 0xe3, 0x2e, 0xae, 0x03, 0x0c, 0x69, 0xe4, 0x5f, 0x32, 0xc8,
-	0x1b, 0xbc, 0x02, 0x42, 0x4c, 0x20, 0xad, 0x62, 0x1e, 0x33, 0xc5, 0xa4, 0x50, 0xce, 0xb5, 0x45,
-	0xc1, 0x77, 0x2c, 0x6b, 0x34, 0x47, 0x11, 0x82, 0x9b, 0x31, 0x68, 0x70, 0xae, 0x77, 0x50, 0x77,
-	0xc9, 0x37, 0xe7, 0xed, 0x4f, 0x4d, 0xdc, 0x1a, 0x41, 0x0e, 0x5c, 0x91, 0x4d, 0x8c, 0xab, 0x2f,
-	0x19, 0xc4, 0x54, 0x48, 0x6e, 0x5f, 0xc8, 0x6f, 0x57, 0x95, 0x61, 0x55, 0x20, 0x6f, 0xf1, 0xda,
-	0x3c, 0x6a, 0x90, 0x83, 0xa6, 0x41, 0x74, 0x04, 0x22, 0xa1, 0x75, 0xc2, 0x87, 0xff, 0x9c, 0xf0,
-	0xc3, 0xc5, 0xc9, 0x0e, 0xf2, 0x57, 0xe7, 0x50, 0x1f, 0x34, 0x7d, 0x6c, 0x90, 0xe4, 0x35, 0x5e,
-	0xbe, 0xf4, 0xe2, 0x50, 0x98, 0xc8, 0x8b, 0x7b, 0x2c, 0xcd, 0x61, 0x07, 0x50, 0xfc, 0x01, 0x67,
-	0xc2, 0x69, 0x5e, 0x15, 0x9c, 0x09, 0xf2, 0x02, 0xdf, 0x4a, 0x24, 0xa4, 0x41, 0x28, 0x45, 0x4c,
-	0x63, 0xe7, 0xc6, 0x7f, 0xa1, 0x71, 0x85, 0x1a, 0x18, 0x12, 0xb9, 0x8f, 0x6f, 0x87, 0xa9, 0x8c,
-	0xc6, 0x2a, 0xc8, 0x68, 0x1e, 0x94, 0x14, 0x72, 0xa7, 0xd5, 0x41, 0xdd, 0xa6, 0xbf, 0x6c, 0xcb,
-	0x23, 0x9a, 0xbf, 0xa4, 0x90, 0x93, 0x27, 0x18, 0x73, 0x28, 0x02, 0x35, 0xc9, 0xb2, 0xb4, 0x74,
-	0x6e, 0x1a, 0xff, 0x07, 0xb5, 0xff, 0xda, 0xdf, 0xfe, 0xfb, 0x42, 0xff, 0xe2, 0xbc, 0x2f, 0xb4,
-	0xdf, 0xe6, 0x50, 0x3c, 0x37, 0xd3, 0x8f, 0x36, 0xdf, 0x5d, 0x9c, 0xec, 0x38, 0x56, 0xdb, 0x55,
-	0xf1, 0xd8, 0x2b, 0xec, 0x2e, 0xda, 0x1f, 0x66, 0xb0, 0x77, 0x3a, 0x75, 0xd1, 0xd9, 0xd4, 0x45,
-	0xdf, 0xa7, 0x2e, 0x7a, 0x3f, 0x73, 0x1b, 0x67, 0x33, 0xb7, 0xf1, 0x75, 0xe6, 0x36, 0x5e, 0xad,
-	0xff, 0x66, 0x54, 0x4f, 0xe9, 0x32, 0xa3, 0x2a, 0x6c, 0x99, 0x3d, 0xda, 0xfb, 0x11, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x44, 0x4d, 0x1a, 0xdd, 0x03, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0x69, 0x3e, 0x32, 0x34, 0x6a, 0x33, 0xa5, 0x92, 0x5b, 0x54, 0x37, 0xea,
+	0x02, 0x45, 0x45, 0xb1, 0x1b, 0x2a, 0x21, 0x81, 0x58, 0x85, 0x6c, 0x82, 0xa8, 0x88, 0xcc, 0x02,
+	0x01, 0x12, 0xd6, 0x8d, 0x3d, 0x71, 0x86, 0xd8, 0x33, 0x96, 0x67, 0x12, 0x25, 0xaf, 0xc0, 0x8a,
+	0xc7, 0x60, 0x59, 0xa1, 0x3e, 0x44, 0x37, 0x48, 0x15, 0x2b, 0xd4, 0x45, 0x85, 0x92, 0x45, 0x5f,
+	0x03, 0x79, 0xc6, 0xa4, 0xe5, 0xcf, 0x06, 0xca, 0x26, 0x9a, 0xb9, 0xf7, 0xdc, 0xdf, 0x39, 0x37,
+	0xf2, 0x20, 0xcb, 0xe7, 0x22, 0xe6, 0xc2, 0x89, 0x29, 0x93, 0xce, 0xb8, 0xd9, 0x23, 0x12, 0x9a,
+	0xea, 0x62, 0x27, 0x29, 0x97, 0x1c, 0xaf, 0xeb, 0xbe, 0xad, 0x4a, 0x79, 0x7f, 0xeb, 0x56, 0xc8,
+	0x43, 0xae, 0xfa, 0x4e, 0x76, 0xd2, 0xd2, 0xad, 0x4d, 0x2d, 0xf5, 0x74, 0x23, 0x9f, 0xd3, 0xad,
+	0x2a, 0xc4, 0x94, 0x71, 0x47, 0xfd, 0x7e, 0x57, 0x87, 0x9c, 0x87, 0x11, 0x71, 0xd4, 0xad, 0x37,
+	0xea, 0x3b, 0xc0, 0xa6, 0xba, 0xb5, 0xfb, 0xc9, 0x40, 0xa5, 0x43, 0xca, 0x24, 0x49, 0xf1, 0x33,
+	0x54, 0xa6, 0xac, 0x1f, 0x81, 0xa4, 0x9c, 0x99, 0x46, 0xcd, 0xa8, 0x97, 0x5b, 0xcd, 0x93, 0xf3,
+	0x9d, 0xc2, 0xd9, 0xf9, 0xce, 0x6d, 0xed, 0x20, 0x82, 0xa1, 0x4d, 0xb9, 0x13, 0x83, 0x1c, 0xd8,
+	0x4f, 0x49, 0x08, 0xfe, 0xb4, 0x4d, 0xfc, 0xcf, 0xc7, 0x0d, 0x94, 0x07, 0x68, 0x13, 0xdf, 0xbd,
+	0x64, 0xe0, 0x37, 0xa8, 0x0a, 0x8c, 0x8d, 0x20, 0xca, 0x62, 0x8e, 0xa9, 0xa0, 0x9c, 0x09, 0xf3,
+	0xbf, 0xbf, 0x05, 0xaf, 0x69, 0x56, 0x77, 0x81, 0xc2, 0x18, 0x15, 0x03, 0x90, 0x60, 0x2e, 0xd5,
+	0x8c, 0xfa, 0x8a, 0xab, 0xce, 0xbb, 0x1f, 0x97, 0x51, 0xa9, 0x0b, 0x29, 0xc4, 0x02, 0x6f, 0x23,
+	0x94, 0xfd, 0x93, 0x5e, 0x40, 0x18, 0x8f, 0xf5, 0x42, 0x6e, 0x39, 0xab, 0xb4, 0xb3, 0x02, 0x7e,
+	0x8b, 0x36, 0x16, 0x51, 0xbd, 0x14, 0x24, 0xf1, 0xfc, 0x01, 0xb0, 0x90, 0xe4, 0x09, 0xef, 0xff,
+	0x71, 0xc2, 0x0f, 0x17, 0x47, 0x7b, 0x86, 0xbb, 0xbe, 0x80, 0xba, 0x20, 0xc9, 0x63, 0x85, 0xc4,
+	0xaf, 0x51, 0xe5, 0xd2, 0x2b, 0x86, 0x89, 0xb9, 0x74, 0x2d, 0x8f, 0x95, 0x05, 0xec, 0x10, 0x26,
+	0x3f, 0xc1, 0x29, 0x33, 0x8b, 0xff, 0x0a, 0x4e, 0x19, 0x7e, 0x81, 0x6e, 0x86, 0x1c, 0x22, 0xaf,
+	0xc7, 0x59, 0x40, 0x02, 0x73, 0xf9, 0x5a, 0x68, 0x94, 0xa1, 0x5a, 0x8a, 0x84, 0xef, 0xa0, 0xd5,
+	0x5e, 0xc4, 0xfd, 0xa1, 0xf0, 0x12, 0x92, 0x7a, 0x53, 0x02, 0xa9, 0x59, 0xaa, 0x19, 0xf5, 0xa2,
+	0x5b, 0xd1, 0xe5, 0x2e, 0x49, 0x5f, 0x12, 0x48, 0xf1, 0x13, 0x84, 0x62, 0x98, 0x78, 0x62, 0x94,
+	0x24, 0xd1, 0xd4, 0xfc, 0x5f, 0xf9, 0xdf, 0xcd, 0xfd, 0x37, 0x7e, 0xf5, 0xef, 0x30, 0x79, 0xc5,
+	0xb9, 0xc3, 0xa4, 0x5b, 0x8e, 0x61, 0xf2, 0x5c, 0x4d, 0xe3, 0x47, 0x68, 0x8d, 0x24, 0xdc, 0x1f,
+	0x78, 0x34, 0x20, 0x4c, 0xd2, 0x3e, 0x25, 0xa9, 0x79, 0x43, 0x11, 0xab, 0x67, 0xc7, 0x8d, 0xca,
+	0x44, 0x3d, 0xc6, 0xda, 0x78, 0xdf, 0xbe, 0x67, 0xef, 0xbb, 0xab, 0x4a, 0xda, 0x59, 0x28, 0xf1,
+	0x03, 0xa4, 0x4b, 0x57, 0x12, 0x97, 0xb3, 0xc4, 0xbf, 0x1b, 0xae, 0x68, 0x65, 0xbe, 0xc4, 0xc3,
+	0xed, 0x77, 0x17, 0x47, 0x7b, 0xa6, 0x0e, 0xd5, 0x10, 0xc1, 0xd0, 0xd1, 0x6a, 0x47, 0x7f, 0xa9,
+	0xad, 0x83, 0x93, 0x99, 0x65, 0x9c, 0xce, 0x2c, 0xe3, 0xeb, 0xcc, 0x32, 0xde, 0xcf, 0xad, 0xc2,
+	0xe9, 0xdc, 0x2a, 0x7c, 0x99, 0x5b, 0x85, 0x57, 0x9b, 0x3f, 0x6c, 0x98, 0x4f, 0xc9, 0x69, 0x42,
+	0x44, 0xaf, 0xa4, 0x1e, 0xf0, 0xc1, 0xb7, 0x01, 0x00, 0xb3, 0x87, 0x51, 0x49, 0x56, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -255,6 +278,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochsPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochsPerYear))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -371,6 +406,13 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.EpochsPerYear != 0 {
+		n += 1 + sovMint(uint64(m.EpochsPerYear))
+	}
 	return n
 }
 
@@ -782,6 +824,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerYear", wireType)
+			}
+			m.EpochsPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -43,7 +43,8 @@ func ValidateMinter(minter Minter) error {
 	return nil
 }
 
-// NextInflationRate returns the new inflation rate for the next block.
+// NextInflationRate returns the new inflation rate for the next block, or the
+// next epoch when minting per epoch.
 func (m Minter) NextInflationRate(params Params, bondedRatio math.LegacyDec) math.LegacyDec {
 	// The target annual inflation rate is recalculated for each block. The inflation
 	// is also subject to a rate change (positive or negative) depending on the
@@ -55,7 +56,7 @@ func (m Minter) NextInflationRate(params Params, bondedRatio math.LegacyDec) mat
 	inflationRateChangePerYear := math.LegacyOneDec().
 		Sub(bondedRatio.Quo(params.GoalBonded)).
 		Mul(params.InflationRateChange)
-	inflationRateChange := inflationRateChangePerYear.Quo(math.LegacyNewDec(int64(params.BlocksPerYear)))
+	inflationRateChange := inflationRateChangePerYear.Quo(math.LegacyNewDec(int64(params.PeriodsPerYear())))
 
 	// adjust the new annual inflation for this next block
 	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
@@ -82,6 +83,13 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
 
+// EpochProvision returns the provisions for an epoch based on the annual
+// provisions rate.
+func (m Minter) EpochProvision(params Params) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.QuoInt(math.NewIntFromUint64(params.EpochsPerYear))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
+
 // IsEqual returns true if two minters are equal, it checks all the fields
 func (m Minter) IsEqual(minter Minter) bool {
 	if !m.Inflation.Equal(minter.Inflation) {
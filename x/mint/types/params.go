@@ -59,6 +59,9 @@ func (p Params) Validate() error {
 	if err := validateMaxSupply(p.MaxSupply); err != nil {
 		return err
 	}
+	if err := validateEpochMinting(p.EpochIdentifier, p.EpochsPerYear); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -151,3 +154,33 @@ func validateMaxSupply(v math.Int) error {
 
 	return nil
 }
+
+func validateEpochMinting(epochIdentifier string, epochsPerYear uint64) error {
+	if epochIdentifier == "" {
+		return nil
+	}
+	if strings.TrimSpace(epochIdentifier) != epochIdentifier {
+		return fmt.Errorf("epoch identifier cannot have leading or trailing spaces: %q", epochIdentifier)
+	}
+	if epochsPerYear == 0 {
+		return fmt.Errorf("epochs per year must be positive when minting per epoch: %d", epochsPerYear)
+	}
+
+	return nil
+}
+
+// IsEpochMinting returns true if tokens are minted at the start of an x/epochs
+// epoch rather than every block.
+func (p Params) IsEpochMinting() bool {
+	return p.EpochIdentifier != ""
+}
+
+// PeriodsPerYear returns the expected number of minting periods per year, i.e.
+// the epochs per year when minting per epoch and the blocks per year otherwise.
+func (p Params) PeriodsPerYear() uint64 {
+	if p.IsEpochMinting() {
+		return p.EpochsPerYear
+	}
+
+	return p.BlocksPerYear
+}
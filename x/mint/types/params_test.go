@@ -109,4 +109,22 @@ func TestValidate(t *testing.T) {
 	params.InflationMin = math.LegacyNewDecWithPrec(2, 2)
 	err = params.Validate()
 	require.Error(t, err)
+
+	params = DefaultParams()
+	params.EpochIdentifier = "day"
+	params.EpochsPerYear = 365
+	err = params.Validate()
+	require.NoError(t, err)
+	require.True(t, params.IsEpochMinting())
+	require.Equal(t, uint64(365), params.PeriodsPerYear())
+
+	params.EpochsPerYear = 0
+	err = params.Validate()
+	require.Error(t, err)
+
+	params = DefaultParams()
+	params.EpochIdentifier = " "
+	params.EpochsPerYear = 365
+	err = params.Validate()
+	require.Error(t, err)
 }
@@ -12,5 +12,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgSend{},
 	)
+	registrar.RegisterInterface("cosmos.nft.v1beta1.RoyaltyData", (*RoyaltyData)(nil),
+		&RoyaltyInfo{},
+	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -0,0 +1,94 @@
+package nft
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NFTHooks defines the hooks called by the nft keeper on every mint, burn and
+// transfer of an nft. An error returned by a hook aborts the operation.
+type NFTHooks interface {
+	BeforeMint(ctx context.Context, token NFT, receiver sdk.AccAddress) error
+	AfterMint(ctx context.Context, token NFT, receiver sdk.AccAddress) error
+	BeforeBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error
+	AfterBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error
+	BeforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+	AfterTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
+
+var _ NFTHooks = MultiNFTHooks{}
+
+// MultiNFTHooks combines multiple nft hooks, all hook functions are run in array sequence.
+type MultiNFTHooks []NFTHooks
+
+// NewMultiNFTHooks creates a new MultiNFTHooks.
+func NewMultiNFTHooks(hooks ...NFTHooks) MultiNFTHooks {
+	return hooks
+}
+
+// BeforeMint is called before an nft is minted.
+func (h MultiNFTHooks) BeforeMint(ctx context.Context, token NFT, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeMint(ctx, token, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterMint is called after an nft is minted.
+func (h MultiNFTHooks) AfterMint(ctx context.Context, token NFT, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, token, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeBurn is called before an nft is burned.
+func (h MultiNFTHooks) BeforeBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeBurn(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterBurn is called after an nft is burned.
+func (h MultiNFTHooks) AfterBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterBurn(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeTransfer is called before an nft is transferred.
+func (h MultiNFTHooks) BeforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterTransfer is called after an nft is transferred.
+func (h MultiNFTHooks) AfterTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NFTHooksWrapper is a wrapper for modules to inject NFTHooks using depinject.
+type NFTHooksWrapper struct{ NFTHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (NFTHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,28 @@
+package keeper
+
+import "cosmossdk.io/x/nft"
+
+// hooks houses the NFTHooks of a keeper. It is shared by all the copies of the
+// keeper, so that the hooks can be set after the keeper is passed to the module.
+type hooks struct {
+	nft.NFTHooks
+}
+
+// SetHooks sets the nft hooks.
+func (k Keeper) SetHooks(nh nft.NFTHooks) {
+	if k.hooks.NFTHooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+
+	k.hooks.NFTHooks = nh
+}
+
+// Hooks gets the hooks for nft keeper.
+func (k Keeper) Hooks() nft.NFTHooks {
+	if k.hooks.NFTHooks == nil {
+		// return a no-op implementation if no hooks are set
+		return nft.MultiNFTHooks{}
+	}
+
+	return k.hooks.NFTHooks
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ nft.NFTHooks = &mockHooks{}
+
+// mockHooks records the hook calls, and fails them for the nft ids in reject.
+type mockHooks struct {
+	calls  []string
+	reject map[string]bool
+}
+
+func (h *mockHooks) record(hook, nftID string) error {
+	if h.reject[nftID] {
+		return errors.New("rejected")
+	}
+	h.calls = append(h.calls, fmt.Sprintf("%s %s", hook, nftID))
+	return nil
+}
+
+func (h *mockHooks) BeforeMint(_ context.Context, token nft.NFT, _ sdk.AccAddress) error {
+	return h.record("BeforeMint", token.Id)
+}
+
+func (h *mockHooks) AfterMint(_ context.Context, token nft.NFT, _ sdk.AccAddress) error {
+	return h.record("AfterMint", token.Id)
+}
+
+func (h *mockHooks) BeforeBurn(_ context.Context, _, nftID string, _ sdk.AccAddress) error {
+	return h.record("BeforeBurn", nftID)
+}
+
+func (h *mockHooks) AfterBurn(_ context.Context, _, nftID string, _ sdk.AccAddress) error {
+	return h.record("AfterBurn", nftID)
+}
+
+func (h *mockHooks) BeforeTransfer(_ context.Context, _, nftID string, _, _ sdk.AccAddress) error {
+	return h.record("BeforeTransfer", nftID)
+}
+
+func (h *mockHooks) AfterTransfer(_ context.Context, _, nftID string, _, _ sdk.AccAddress) error {
+	return h.record("AfterTransfer", nftID)
+}
+
+func (s *TestSuite) TestHooks() {
+	hooks := &mockHooks{reject: map[string]bool{"rejected": true}}
+	s.nftKeeper.SetHooks(hooks)
+	s.Require().Panics(func() { s.nftKeeper.SetHooks(hooks) })
+
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID))
+	s.Require().Equal([]string{
+		"BeforeMint kitty1", "AfterMint kitty1",
+		"BeforeTransfer kitty1", "AfterTransfer kitty1",
+		"BeforeBurn kitty1", "AfterBurn kitty1",
+	}, hooks.calls)
+
+	err := s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "rejected"}, s.addrs[0])
+	s.Require().ErrorContains(err, "rejected")
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, "rejected"))
+}
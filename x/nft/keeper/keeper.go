@@ -12,9 +12,10 @@ import (
 type Keeper struct {
 	appmodule.Environment
 
-	cdc codec.BinaryCodec
-	bk  nft.BankKeeper
-	ac  address.Codec
+	cdc   codec.BinaryCodec
+	bk    nft.BankKeeper
+	ac    address.Codec
+	hooks *hooks
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -31,5 +32,6 @@ func NewKeeper(env appmodule.Environment,
 		cdc:         cdc,
 		bk:          bk,
 		ac:          ak.AddressCodec(),
+		hooks:       &hooks{},
 	}
 }
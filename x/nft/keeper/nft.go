@@ -21,6 +21,10 @@ func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress
 		return errors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
+	if err := k.validateRoyalty(token.Data); err != nil {
+		return err
+	}
+
 	return k.mintWithNoCheck(ctx, token, receiver)
 }
 
@@ -28,6 +32,10 @@ func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it.
 func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if err := k.Hooks().BeforeMint(ctx, token, receiver); err != nil {
+		return err
+	}
+
 	k.setNFT(ctx, token)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
@@ -37,11 +45,15 @@ func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).Emit(&nft.EventMint{
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventMint{
 		ClassId: token.ClassId,
 		Id:      token.Id,
 		Owner:   recStr,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterMint(ctx, token, receiver)
 }
 
 // Burn defines a method for burning a nft from a specific account.
@@ -67,6 +79,10 @@ func (k Keeper) Burn(ctx context.Context, classID, nftID string) error {
 // The upper-layer application needs to check it when it needs to use it
 func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.Hooks().BeforeBurn(ctx, classID, nftID, owner); err != nil {
+		return err
+	}
+
 	nftStore := k.getNFTStore(ctx, classID)
 	nftStore.Delete([]byte(nftID))
 
@@ -78,11 +94,15 @@ func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) erro
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).Emit(&nft.EventBurn{
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventBurn{
 		ClassId: classID,
 		Id:      nftID,
 		Owner:   ownerStr,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterBurn(ctx, classID, nftID, owner)
 }
 
 // Update defines a method for updating an exist nft
@@ -95,6 +115,11 @@ func (k Keeper) Update(ctx context.Context, token nft.NFT) error {
 	if !k.HasNFT(ctx, token.ClassId, token.Id) {
 		return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 	}
+
+	if err := k.validateRoyalty(token.Data); err != nil {
+		return err
+	}
+
 	k.updateWithNoCheck(ctx, token)
 	return nil
 }
@@ -137,9 +162,13 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.Hooks().BeforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+		return err
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
-	return nil
+	return k.Hooks().AfterTransfer(ctx, classID, nftID, owner, receiver)
 }
 
 // GetNFT returns the nft information of the specified classID and nftID
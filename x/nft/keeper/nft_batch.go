@@ -24,6 +24,10 @@ func (k Keeper) BatchMint(ctx context.Context,
 			return errors.Wrap(nft.ErrNFTExists, token.Id)
 		}
 
+		if err := k.validateRoyalty(token.Data); err != nil {
+			return err
+		}
+
 		checked[token.ClassId] = true
 		if err := k.mintWithNoCheck(ctx, token, receiver); err != nil {
 			return err
@@ -61,6 +65,10 @@ func (k Keeper) BatchUpdate(ctx context.Context, tokens []nft.NFT) error {
 		if !k.HasNFT(ctx, token.ClassId, token.Id) {
 			return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 		}
+		if err := k.validateRoyalty(token.Data); err != nil {
+			return err
+		}
+
 		checked[token.ClassId] = true
 		k.updateWithNoCheck(ctx, token)
 	}
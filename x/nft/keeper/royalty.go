@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRoyalty returns the royalty of the specified nft, defined by the nft data
+// or else by the class data. It returns false if neither the nft nor the class
+// data implements nft.RoyaltyData.
+func (k Keeper) GetRoyalty(ctx context.Context, classID, nftID string) (nft.RoyaltyInfo, bool) {
+	token, has := k.GetNFT(ctx, classID, nftID)
+	if !has {
+		return nft.RoyaltyInfo{}, false
+	}
+	if royalty := k.unpackRoyalty(token.Data); royalty != nil {
+		return *royalty, true
+	}
+
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nft.RoyaltyInfo{}, false
+	}
+	if royalty := k.unpackRoyalty(class.Data); royalty != nil {
+		return *royalty, true
+	}
+
+	return nft.RoyaltyInfo{}, false
+}
+
+// RoyaltyAmount returns the receiver and the amount of the royalty owed on a
+// sale of the specified nft at salePrice. The amount is empty if the nft has
+// no royalty.
+func (k Keeper) RoyaltyAmount(ctx context.Context, classID, nftID string, salePrice sdk.Coins) (sdk.AccAddress, sdk.Coins, error) {
+	royalty, has := k.GetRoyalty(ctx, classID, nftID)
+	if !has {
+		return nil, sdk.NewCoins(), nil
+	}
+
+	receiver, err := k.ac.StringToBytes(royalty.Receiver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return receiver, royalty.Amount(salePrice), nil
+}
+
+// validateRoyalty validates the royalty of the class or nft data, if any.
+func (k Keeper) validateRoyalty(data *gogoprotoany.Any) error {
+	royalty := k.unpackRoyalty(data)
+	if royalty == nil {
+		return nil
+	}
+
+	if err := royalty.ValidateBasic(); err != nil {
+		return errors.Wrap(nft.ErrInvalidRoyalty, err.Error())
+	}
+	if _, err := k.ac.StringToBytes(royalty.Receiver); err != nil {
+		return errors.Wrapf(nft.ErrInvalidRoyalty, "invalid receiver address: %s", err)
+	}
+
+	return nil
+}
+
+// unpackRoyalty returns the royalty of the class or nft data, or nil if the
+// data does not implement nft.RoyaltyData.
+func (k Keeper) unpackRoyalty(data *gogoprotoany.Any) *nft.RoyaltyInfo {
+	var royaltyData nft.RoyaltyData
+	if err := k.cdc.UnpackAny(data, &royaltyData); err != nil || royaltyData == nil {
+		return nil
+	}
+
+	return royaltyData.Royalty()
+}
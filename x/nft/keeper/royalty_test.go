@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestRoyalty() {
+	newData := func(receiver, feeRate string) *codectypes.Any {
+		data, err := codectypes.NewAnyWithValue(nft.NewRoyaltyInfo(receiver, math.LegacyMustNewDecFromStr(feeRate)))
+		s.Require().NoError(err)
+		return data
+	}
+
+	err := s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Data: newData(s.encodedAddrs[0], "1.5")})
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+	err = s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Data: newData("invalid", "0.05")})
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Data: newData(s.encodedAddrs[0], "0.05")}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[2]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "kitty2", Data: newData(s.encodedAddrs[1], "0.1")}, s.addrs[2]))
+
+	// the royalty of the class applies to the nfts without royalty
+	royalty, has := s.nftKeeper.GetRoyalty(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(s.encodedAddrs[0], royalty.Receiver)
+
+	receiver, amount, err := s.nftKeeper.RoyaltyAmount(s.ctx, testClassID, "kitty2", sdk.NewCoins(sdk.NewInt64Coin("stake", 1005)))
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], receiver)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), amount)
+
+	err = s.nftKeeper.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: "kitty2", Data: newData(s.encodedAddrs[1], "-0.1")})
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+
+	// nfts of classes without royalty have no royalty
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "dog"}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: "dog", Id: "dog1"}, s.addrs[2]))
+	_, has = s.nftKeeper.GetRoyalty(s.ctx, "dog", "dog1")
+	s.Require().False(has)
+}
@@ -1,6 +1,11 @@
 package module
 
 import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -20,6 +25,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetHooks),
 	)
 }
 
@@ -47,3 +53,27 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{NFTKeeper: k, Module: m}
 }
+
+func InvokeSetHooks(keeper keeper.Keeper, nftHooks map[string]nft.NFTHooksWrapper) error {
+	if nftHooks == nil {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	modNames := maps.Keys(nftHooks)
+	order := modNames
+	sort.Strings(order)
+
+	var multiHooks nft.MultiNFTHooks
+	for _, modName := range order {
+		hook, ok := nftHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find nft hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
@@ -4,7 +4,10 @@
 package nft
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -205,35 +208,94 @@ func (m *NFT) GetData() *any.Any {
 	return nil
 }
 
+// RoyaltyInfo defines the royalty paid to a receiver on every sale of an NFT.
+// It can be used as the class or NFT data, or be embedded in the app specific
+// data types implementing the RoyaltyData interface.
+type RoyaltyInfo struct {
+	// receiver is the address receiving the royalty
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// fee_rate is the fraction of the sale price paid to the receiver, between 0 and 1
+	FeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee_rate,json=feeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_rate"`
+}
+
+func (m *RoyaltyInfo) Reset()         { *m = RoyaltyInfo{} }
+func (m *RoyaltyInfo) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyInfo) ProtoMessage()    {}
+func (*RoyaltyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *RoyaltyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyInfo.Merge(m, src)
+}
+func (m *RoyaltyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyInfo proto.InternalMessageInfo
+
+func (m *RoyaltyInfo) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*RoyaltyInfo)(nil), "cosmos.nft.v1beta1.RoyaltyInfo")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0x24, 0x69, 0x12, 0x27, 0x50, 0x64, 0x08, 0xb2, 0xa9, 0xb2, 0x0d, 0x3d, 0xe5, 0x60,
+	0x67, 0x9b, 0xea, 0xc9, 0x5b, 0x63, 0x11, 0x0b, 0xc5, 0xc3, 0xea, 0xc9, 0x4b, 0x98, 0xec, 0x7c,
+	0xbb, 0x19, 0x4c, 0x66, 0xca, 0xcc, 0x6c, 0x70, 0x7f, 0x81, 0x57, 0xff, 0x82, 0xff, 0xa1, 0x47,
+	0x7f, 0x40, 0x8f, 0xa5, 0x27, 0xf1, 0x50, 0x24, 0xf9, 0x23, 0x32, 0xb3, 0x63, 0x50, 0x28, 0x78,
+	0x7b, 0xdf, 0x7b, 0x6f, 0x1f, 0xef, 0x0d, 0x8b, 0x9f, 0x65, 0xca, 0xac, 0x94, 0x49, 0x64, 0x6e,
+	0x93, 0xf5, 0x64, 0x0e, 0x96, 0x4d, 0x1c, 0xa6, 0x57, 0x5a, 0x59, 0x45, 0x48, 0xad, 0x52, 0xc7,
+	0x04, 0xf5, 0x60, 0x58, 0x28, 0x55, 0x2c, 0x21, 0xf1, 0x8e, 0x79, 0x99, 0x27, 0x4c, 0x56, 0xb5,
+	0xfd, 0x60, 0x50, 0xa8, 0x42, 0x79, 0x98, 0x38, 0x14, 0xd8, 0x61, 0x1d, 0x32, 0xab, 0x85, 0x90,
+	0xe8, 0x8f, 0xa3, 0xef, 0x08, 0xef, 0xbd, 0x5e, 0x32, 0x63, 0xc8, 0x3e, 0x6e, 0x0a, 0x1e, 0xa1,
+	0x11, 0x1a, 0x3f, 0x4a, 0x9b, 0x82, 0x13, 0x82, 0xdb, 0x92, 0xad, 0x20, 0x6a, 0x7a, 0xc6, 0x63,
+	0xf2, 0x04, 0x77, 0x4c, 0xb5, 0x9a, 0xab, 0x65, 0xd4, 0xf2, 0x6c, 0xb8, 0xc8, 0x08, 0xf7, 0x39,
+	0x98, 0x4c, 0x8b, 0x2b, 0x2b, 0x94, 0x8c, 0xda, 0x5e, 0xfc, 0x9b, 0x22, 0x8f, 0x71, 0xab, 0xd4,
+	0x22, 0xda, 0xf3, 0x8a, 0x83, 0x64, 0x88, 0x7b, 0xa5, 0x16, 0xb3, 0x05, 0x33, 0x8b, 0xa8, 0xe3,
+	0xe9, 0x6e, 0xa9, 0xc5, 0x5b, 0x66, 0x16, 0x64, 0x8c, 0xdb, 0x9c, 0x59, 0x16, 0x75, 0x47, 0x68,
+	0xdc, 0x3f, 0x1d, 0xd0, 0x7a, 0x2f, 0xfd, 0xb3, 0x97, 0x9e, 0xc9, 0x2a, 0xf5, 0x8e, 0xa3, 0x2f,
+	0x08, 0xb7, 0xde, 0xbd, 0xf9, 0xe0, 0xc2, 0x32, 0xb7, 0x62, 0xb6, 0x9b, 0xd0, 0xf5, 0xf7, 0x05,
+	0x0f, 0xbb, 0x9a, 0xbb, 0x5d, 0xa1, 0x49, 0xeb, 0xe1, 0x26, 0xed, 0x87, 0x9b, 0xe0, 0xff, 0x36,
+	0xf9, 0x86, 0x70, 0x3f, 0x55, 0x15, 0x5b, 0xda, 0xea, 0x42, 0xe6, 0x8a, 0xbc, 0xc4, 0x3d, 0x0d,
+	0x19, 0x88, 0x35, 0xe8, 0xba, 0xd1, 0x34, 0xba, 0xbb, 0x3e, 0x1e, 0x84, 0xc7, 0x3f, 0xe3, 0x5c,
+	0x83, 0x31, 0xef, 0xad, 0x16, 0xb2, 0x48, 0x77, 0x4e, 0x72, 0x89, 0x7b, 0x39, 0xc0, 0x4c, 0x33,
+	0x1b, 0x1e, 0x7e, 0x3a, 0xb9, 0xb9, 0x3f, 0x6c, 0xfc, 0xbc, 0x3f, 0x7c, 0x5a, 0x7f, 0x69, 0xf8,
+	0x27, 0x2a, 0x54, 0xb2, 0x62, 0x76, 0x41, 0x2f, 0xa1, 0x60, 0x59, 0x75, 0x0e, 0xd9, 0xdd, 0xf5,
+	0x31, 0x0e, 0xc1, 0xe7, 0x90, 0xa5, 0xdd, 0x1c, 0x20, 0x65, 0x16, 0x5e, 0xed, 0x3b, 0x41, 0xe6,
+	0x76, 0xb4, 0x3e, 0xa1, 0xa7, 0xf4, 0x64, 0xfa, 0xfc, 0x66, 0x13, 0xa3, 0xdb, 0x4d, 0x8c, 0x7e,
+	0x6d, 0x62, 0xf4, 0x75, 0x1b, 0x37, 0x6e, 0xb7, 0x71, 0xe3, 0xc7, 0x36, 0x6e, 0x7c, 0x24, 0xff,
+	0xa4, 0x7f, 0x76, 0x3f, 0xe0, 0xbc, 0xe3, 0x57, 0xbe, 0xf8, 0x3d, 0x00, 0xfd, 0xae, 0x43, 0x8d,
+	0xa1, 0x02, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +438,46 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoyaltyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +555,21 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *RoyaltyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.FeeRate.Size()
+	n += 1 + l + sovNft(uint64(l))
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1068,122 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RoyaltyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
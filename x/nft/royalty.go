@@ -0,0 +1,59 @@
+package nft
+
+import (
+	"errors"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RoyaltyData is implemented by the class and NFT data types carrying a
+// royalty. Apps register their own data types with
+// RegisterImplementations((*nft.RoyaltyData)(nil), ...) to expose the royalty
+// to the marketplace modules through the keeper.
+type RoyaltyData interface {
+	proto.Message
+
+	// Royalty returns the royalty of the class or NFT.
+	Royalty() *RoyaltyInfo
+}
+
+var _ RoyaltyData = &RoyaltyInfo{}
+
+// NewRoyaltyInfo creates a new RoyaltyInfo object.
+func NewRoyaltyInfo(receiver string, feeRate math.LegacyDec) *RoyaltyInfo {
+	return &RoyaltyInfo{
+		Receiver: receiver,
+		FeeRate:  feeRate,
+	}
+}
+
+// Royalty implements RoyaltyData, so that a RoyaltyInfo can be used as the
+// class or NFT data directly.
+func (r *RoyaltyInfo) Royalty() *RoyaltyInfo {
+	return r
+}
+
+// ValidateBasic performs a basic validation of the royalty.
+func (r RoyaltyInfo) ValidateBasic() error {
+	if r.Receiver == "" {
+		return errors.New("royalty receiver cannot be empty")
+	}
+	if r.FeeRate.IsNil() || r.FeeRate.IsNegative() || r.FeeRate.GT(math.LegacyOneDec()) {
+		return errors.New("royalty fee rate must be between 0 and 1")
+	}
+	return nil
+}
+
+// Amount returns the royalty owed on a sale at salePrice, truncated to integer
+// amounts.
+func (r RoyaltyInfo) Amount(salePrice sdk.Coins) sdk.Coins {
+	amount := sdk.NewCoins()
+	for _, coin := range salePrice {
+		amount = amount.Add(sdk.NewCoin(coin.Denom, r.FeeRate.MulInt(coin.Amount).TruncateInt()))
+	}
+	return amount
+}
@@ -13,10 +13,13 @@ const DefaultSeedValue = 42
 var (
 	FlagGenesisFileValue        string
 	FlagParamsFileValue         string
+	FlagProfileFileValue        string
 	FlagExportParamsPathValue   string
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagTracePathValue          string
+	FlagReplayTraceValue        string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -37,9 +40,12 @@ func GetSimulatorFlags() {
 	// config fields
 	flag.StringVar(&FlagGenesisFileValue, "Genesis", "", "custom simulation genesis file; cannot be used with params file")
 	flag.StringVar(&FlagParamsFileValue, "Params", "", "custom simulation params file which overrides any random params; cannot be used with genesis")
+	flag.StringVar(&FlagProfileFileValue, "Profile", "", "custom simulation profile file (TOML or JSON) with operation weights, params and disabled modules; cannot be used with params file")
 	flag.StringVar(&FlagExportParamsPathValue, "ExportParamsPath", "", "custom file path to save the exported params JSON")
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
+	flag.StringVar(&FlagTracePathValue, "TracePath", "", "custom file path to record the simulated blocks and txs for replay")
+	flag.StringVar(&FlagReplayTraceValue, "ReplayTrace", "", "trace file recorded with TracePath to replay against the app, diffing the app hashes per block")
 	flag.Int64Var(&FlagSeedValue, "Seed", DefaultSeedValue, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -61,10 +67,12 @@ func NewConfigFromFlags() simulation.Config {
 	return simulation.Config{
 		GenesisFile:        FlagGenesisFileValue,
 		ParamsFile:         FlagParamsFileValue,
+		ProfileFile:        FlagProfileFileValue,
 		ExportParamsPath:   FlagExportParamsPathValue,
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		TracePath:          FlagTracePathValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		GenesisTime:        FlagGenesisTimeValue,
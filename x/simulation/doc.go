@@ -91,6 +91,21 @@ To execute simulation from a simulation params file:
 		-Params=/path/to/params.json \
 		-v -timeout 24h
 
+To execute simulation with a simulation profile, a TOML or JSON file setting operation weights,
+params, ranges of params drawn for every seed, and modules of which the operations are disabled
+(see types/simulation.Profile):
+
+	 $ go test -mod=readonly . \
+		-tags='sims' \
+		-run=TestFullAppSimulation \
+		-Enabled=true \
+		-NumBlocks=100 \
+		-BlockSize=200 \
+		-Commit=true \
+		-Seed=99 \
+		-Profile=/path/to/profile.toml \
+		-v -timeout 24h
+
 To export the simulation params to a file at a given block height:
 
 	 $ go test -mod=readonly . \
@@ -120,6 +135,39 @@ To export the simulation app state (i.e genesis) to a file:
 		-ExportStatePath=/path/to/genesis.json \
 		-v -timeout 24h
 
+To record the simulated blocks and txs to a trace file, with the seed inserted in its name:
+
+	 $ go test -mod=readonly . \
+		-tags='sims' \
+	 	-run=TestFullAppSimulation \
+	 	-Enabled=true \
+	 	-NumBlocks=100 \
+	 	-BlockSize=200 \
+	 	-Commit=true \
+	 	-Seed=99 \
+		-TracePath=/path/to/trace.json \
+		-v -timeout 24h
+
+To replay a recorded trace against a modified build of the app, reporting the first block
+of which the app hash differs:
+
+	 $ go test -mod=readonly . \
+		-tags='sims' \
+	 	-run=TestAppReplayTrace \
+		-ReplayTrace=/path/to/trace_seed_99.json \
+		-v -timeout 24h
+
+To simulate two instances of the app side by side, with a bridge passing packets between them
+to test IBC-like flows, see SimulateMultiApp:
+
+	 $ go test -mod=readonly . \
+		-tags='sims' \
+	 	-run=TestMultiAppBridge \
+	 	-NumBlocks=50 \
+	 	-BlockSize=100 \
+	 	-Commit=true \
+		-v -timeout 24h
+
 # Params
 
 Params that are provided to simulation from a JSON file are used to set
@@ -0,0 +1,226 @@
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"cosmossdk.io/core/address"
+	corelog "cosmossdk.io/core/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Packet is a message passed by the bridge of a multi app simulation from the
+// app which sent it to its destination app.
+type Packet struct {
+	// Source is the name of the app which sent the packet, set by the bridge.
+	Source string
+	// Destination is the name of the app the packet is sent to.
+	Destination string
+	// Sequence is the number of the packet among the packets sent by the
+	// source to the destination, starting at 1, set by the bridge.
+	Sequence uint64
+	// Height is the height of the block of the source in which the packet was
+	// sent, set by the bridge.
+	Height int64
+	// Data is the content of the packet, opaque to the bridge.
+	Data []byte
+}
+
+// BridgeEndpoint connects an app of a multi app simulation to the bridge.
+// Acknowledgements, and any other response, are packets sent back by the
+// destination when it receives a packet.
+type BridgeEndpoint struct {
+	// Operations are the operations of the app exercising the bridge, run with
+	// the other operations of the app, e.g. sending packets.
+	Operations WeightedOperations
+
+	// SendPackets returns the packets sent by the app in the block of the
+	// context, usually read from an outbox in the state of the sending module.
+	// It is called after the operations of every block of the app.
+	SendPackets func(ctx sdk.Context) ([]Packet, error)
+
+	// ReceivePacket returns the operation delivering the packet to the app. It
+	// runs at the beginning of the block of the app following the one in which
+	// the packet was sent.
+	ReceivePacket func(packet Packet) simulation.Operation
+}
+
+// MultiAppChain is an app of a multi app simulation with the arguments of its
+// simulation, see SimulateFromSeedX.
+type MultiAppChain struct {
+	// Name is the name of the app in the packets of the bridge.
+	Name string
+	// Endpoint connects the app to the bridge, it is nil if the app neither
+	// sends nor receives packets.
+	Endpoint *BridgeEndpoint
+
+	Logger       corelog.Logger
+	App          *baseapp.BaseApp
+	AppStateFn   simulation.AppStateFn
+	RandAccFn    simulation.RandomAccountFn
+	Ops          WeightedOperations
+	BlockedAddrs map[string]bool
+	Config       simulation.Config
+	Cdc          codec.JSONCodec
+	AddressCodec address.Codec
+	LogWriter    LogWriter
+}
+
+// SimulateMultiApp runs the simulations of several apps side by side, to test
+// modules whose logic depends on another chain, e.g. on acknowledgements in
+// IBC-like flows, without a relayer. The apps are advanced one block at a time
+// in lockstep, and a bridge passes the packets sent by every app in a block to
+// their destination, which receives them at the beginning of its next block.
+//
+// Each app is simulated with its own config and seed as by SimulateFromSeedX.
+// The simulation ends when every app has simulated all its blocks, and the
+// packets which could not be delivered because their destination was done are
+// returned.
+func SimulateMultiApp(
+	tb testing.TB,
+	w io.Writer,
+	chains []MultiAppChain,
+) (undelivered []Packet, err error) {
+	tb.Helper()
+	testingMode, _, b := getTestingMode(tb)
+
+	br := &bridge{
+		sims:      make(map[string]*appSimulation, len(chains)),
+		endpoints: make(map[string]*BridgeEndpoint, len(chains)),
+		sequences: make(map[[2]string]uint64),
+	}
+	sims := make([]*appSimulation, len(chains))
+	for i, chain := range chains {
+		if _, ok := br.sims[chain.Name]; ok || chain.Name == "" {
+			return nil, fmt.Errorf("invalid or duplicate app name %q", chain.Name)
+		}
+
+		ops := chain.Ops
+		if chain.Endpoint != nil {
+			ops = append(append(WeightedOperations{}, ops...), chain.Endpoint.Operations...)
+		}
+
+		sim, err := newAppSimulation(tb, chain.Logger, w, chain.App, chain.AppStateFn, chain.RandAccFn,
+			ops, chain.BlockedAddrs, chain.Config, chain.Cdc, chain.AddressCodec, chain.LogWriter)
+		if sim.recorder != nil {
+			defer func() {
+				if writeErr := sim.writeTrace(); writeErr != nil && err == nil {
+					err = writeErr
+				}
+			}()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("app %s: %w", chain.Name, err)
+		}
+
+		if chain.Endpoint != nil && chain.Endpoint.SendPackets != nil {
+			name, sendPackets := chain.Name, chain.Endpoint.SendPackets
+			sim.onBlock = func(ctx sdk.Context) error {
+				packets, err := sendPackets(ctx)
+				if err != nil {
+					return err
+				}
+				return br.send(name, ctx.BlockHeight(), packets)
+			}
+		}
+
+		sims[i] = sim
+		br.sims[chain.Name] = sim
+		br.endpoints[chain.Name] = chain.Endpoint
+	}
+
+	if !testingMode {
+		b.ResetTimer()
+	} else {
+		// recover logs in case of panic
+		defer func() {
+			if r := recover(); r != nil {
+				for i, sim := range sims {
+					chains[i].Logger.Error("simulation halted due to panic", "height", sim.blockHeight)
+					sim.logWriter.PrintLogs()
+				}
+				panic(r)
+			}
+		}()
+	}
+
+	for {
+		running := false
+		for i, sim := range sims {
+			if sim.done() {
+				continue
+			}
+			running = true
+			if err := sim.step(); err != nil {
+				return nil, fmt.Errorf("app %s: %w", chains[i].Name, err)
+			}
+		}
+		if !running {
+			break
+		}
+
+		// the packets sent in this round of blocks are received in the next one
+		br.deliver()
+	}
+
+	for _, sim := range sims {
+		sim.finish()
+	}
+
+	return br.undelivered, nil
+}
+
+// bridge passes the packets between the apps of a multi app simulation.
+type bridge struct {
+	sims      map[string]*appSimulation
+	endpoints map[string]*BridgeEndpoint
+	// sequences are the sequences of the last packets, by source and destination
+	sequences   map[[2]string]uint64
+	inFlight    []Packet
+	undelivered []Packet
+}
+
+// send validates the packets sent by the source and sets their source,
+// sequence and height.
+func (b *bridge) send(source string, height int64, packets []Packet) error {
+	for _, packet := range packets {
+		endpoint, ok := b.endpoints[packet.Destination]
+		if !ok {
+			return fmt.Errorf("packet sent to unknown app %q", packet.Destination)
+		}
+		if endpoint == nil || endpoint.ReceivePacket == nil {
+			return fmt.Errorf("packet sent to app %s which does not receive packets", packet.Destination)
+		}
+
+		channel := [2]string{source, packet.Destination}
+		b.sequences[channel]++
+		packet.Source = source
+		packet.Sequence = b.sequences[channel]
+		packet.Height = height
+		b.inFlight = append(b.inFlight, packet)
+	}
+	return nil
+}
+
+// deliver queues the operations receiving the packets in flight for the next
+// block of their destinations, in the order in which they were sent.
+func (b *bridge) deliver() {
+	for _, packet := range b.inFlight {
+		dst := b.sims[packet.Destination]
+		if dst.done() {
+			b.undelivered = append(b.undelivered, packet)
+			continue
+		}
+
+		dst.queueOperations([]simulation.FutureOperation{{
+			BlockHeight: int(dst.blockHeight),
+			Op:          b.endpoints[packet.Destination].ReceivePacket(packet),
+		}})
+	}
+	b.inFlight = nil
+}
@@ -35,6 +35,7 @@ func initChain(
 	appStateFn simulation.AppStateFn,
 	config simulation.Config,
 	cdc codec.JSONCodec,
+	recorder *traceRecorder,
 ) (mockValidators, time.Time, []simulation.Account, string) {
 	blockMaxGas := int64(-1)
 	if config.BlockMaxGas > 0 {
@@ -48,6 +49,7 @@ func initChain(
 		ConsensusParams: consensusParams,
 		Time:            genesisTimestamp,
 	}
+	recorder.recordInitChain(&req)
 	res, err := app.InitChain(&req)
 	if err != nil {
 		panic(err)
@@ -97,197 +99,331 @@ func SimulateFromSeedX(
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, _, b := getTestingMode(tb)
 
+	sim, err := newAppSimulation(tb, logger, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc, addressCodec, logWriter)
+	if sim.recorder != nil {
+		defer func() {
+			if writeErr := sim.writeTrace(); writeErr != nil && err == nil {
+				err = writeErr
+			}
+		}()
+	}
+	if err != nil {
+		return sim.params, err
+	}
+
+	if !testingMode {
+		b.ResetTimer()
+	} else {
+		// recover logs in case of panic
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("simulation halted due to panic", "height", sim.blockHeight)
+				logWriter.PrintLogs()
+				panic(r)
+			}
+		}()
+	}
+
+	for !sim.done() {
+		if err := sim.step(); err != nil {
+			return sim.params, err
+		}
+	}
+
+	sim.finish()
+	return sim.exportedParams, nil
+}
+
+// appSimulation is the simulation of an app, advanced one block at a time by
+// step until it is done.
+type appSimulation struct {
+	tb          testing.TB
+	testingMode bool
+	logger      corelog.Logger
+	w           io.Writer
+	app         *baseapp.BaseApp
+	entrypoint  simulation.AppEntrypoint
+	recorder    *traceRecorder
+	config      simulation.Config
+	logWriter   LogWriter
+	r           *rand.Rand
+	params      Params
+	// exportedParams are the params exported at the export height of the config
+	exportedParams Params
+	accs           []simulation.Account
+	eventStats     EventStats
+	startTime      time.Time
+	timeDiff       int64
+	stoppedEarly   bool
+
+	validators         mockValidators
+	nextValidators     mockValidators
+	pastTimes          []time.Time
+	pastVoteInfos      [][]abci.VoteInfo
+	timeOperationQueue []simulation.FutureOperation
+	operationQueue     OperationQueue
+	blockSimulator     blockSimFn
+	finalizeBlockReq   *abci.FinalizeBlockRequest
+	blockHeight        int64
+	blockTime          time.Time
+	proposerAddress    []byte
+	opCount            int
+
+	// onBlock, if set, is called after the operations of every block, with the
+	// context of the block before it is committed.
+	onBlock func(ctx sdk.Context) error
+}
+
+// newAppSimulation initializes the chain of the app and returns its simulation,
+// ready to simulate its first block. The simulation is returned even on error,
+// as the trace recording it started, if any, must still be written.
+func newAppSimulation(
+	tb testing.TB,
+	logger corelog.Logger,
+	w io.Writer,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	config simulation.Config,
+	cdc codec.JSONCodec,
+	addressCodec address.Codec,
+	logWriter LogWriter,
+) (*appSimulation, error) {
+	tb.Helper()
+	testingMode, _, _ := getTestingMode(tb)
+
 	r := rand.New(NewByteSource(config.FuzzSeed, config.Seed))
 	params := RandomParams(r)
 
-	startTime := time.Now()
-	logger.Info("Starting SimulateFromSeed with randomness", "time", startTime)
+	sim := &appSimulation{
+		tb:          tb,
+		testingMode: testingMode,
+		logger:      logger,
+		w:           w,
+		app:         app,
+		entrypoint:  app,
+		config:      config,
+		logWriter:   logWriter,
+		r:           r,
+		params:      params,
+		startTime:   time.Now(),
+		timeDiff:    maxTimePerBlock - minTimePerBlock,
+	}
+	logger.Info("Starting SimulateFromSeed with randomness", "time", sim.startTime)
 	logger.Debug("Randomized simulation setup", "params", mustMarshalJSONIndent(params))
 
-	timeDiff := maxTimePerBlock - minTimePerBlock
 	accs := randAccFn(r, params.NumKeys())
-	eventStats := NewEventStats()
+	sim.eventStats = NewEventStats()
+
+	// when recording a trace, the txs and the state writes of the operations go through recording wrappers
+	if config.TracePath != "" {
+		if !config.Commit {
+			return sim, errors.New("recording a trace requires the simulation to commit")
+		}
+		sim.recorder = newTraceRecorder(config.Seed)
+		sim.entrypoint = traceRecordingApp{app: app, recorder: sim.recorder}
+	}
 
 	// Second variable to keep pending validator set (delayed one block since
 	// TM 0.24) Initially this is the same as the initial validator set
-	validators, blockTime, accs, chainID := initChain(r, params, accs, app, appStateFn, config, cdc)
+	validators, blockTime, accs, chainID := initChain(r, params, accs, app, appStateFn, config, cdc, sim.recorder)
 	// At least 2 accounts must be added here, otherwise when executing SimulateMsgSend
 	// two accounts will be selected to meet the conditions from != to and it will fall into an infinite loop.
 	if len(accs) <= 1 {
-		return params, errors.New("at least two genesis accounts are required")
+		return sim, errors.New("at least two genesis accounts are required")
 	}
 
-	config.ChainID = chainID
+	sim.config.ChainID = chainID
 
 	// remove module account address if they exist in accs
-	var tmpAccs []simulation.Account
-
 	for _, acc := range accs {
 		accAddr, err := addressCodec.BytesToString(acc.Address)
 		if err != nil {
-			return params, err
+			return sim, err
 		}
 		if !blockedAddrs[accAddr] {
-			tmpAccs = append(tmpAccs, acc)
+			sim.accs = append(sim.accs, acc)
 		}
 	}
 
-	accs = tmpAccs
-	nextValidators := validators
-
-	var (
-		pastTimes          []time.Time
-		pastVoteInfos      [][]abci.VoteInfo
-		timeOperationQueue []simulation.FutureOperation
-
-		blockHeight     = int64(config.InitialBlockHeight)
-		proposerAddress = validators.randomProposer(r)
-		opCount         = 0
-	)
+	sim.validators = validators
+	sim.nextValidators = validators
+	sim.blockTime = blockTime
+	sim.blockHeight = int64(config.InitialBlockHeight)
+	sim.proposerAddress = validators.randomProposer(r)
 
-	finalizeBlockReq := RandomRequestFinalizeBlock(
+	sim.finalizeBlockReq = RandomRequestFinalizeBlock(
 		r,
 		params,
 		validators,
-		pastTimes,
-		pastVoteInfos,
-		eventStats.Tally,
-		blockHeight,
+		sim.pastTimes,
+		sim.pastVoteInfos,
+		sim.eventStats.Tally,
+		sim.blockHeight,
 		blockTime,
 		validators.randomProposer(r),
 	)
 
 	// These are operations which have been queued by previous operations
-	operationQueue := NewOperationQueue()
+	sim.operationQueue = NewOperationQueue()
 
-	blockSimulator := createBlockSimulator(
+	sim.blockSimulator = createBlockSimulator(
 		tb,
 		testingMode,
 		w,
 		params,
-		eventStats.Tally,
+		sim.eventStats.Tally,
 		ops,
-		operationQueue,
-		timeOperationQueue,
+		sim.operationQueue,
+		sim.timeOperationQueue,
 		logWriter,
-		config,
+		sim.config,
 	)
 
-	if !testingMode {
-		b.ResetTimer()
-	} else {
-		// recover logs in case of panic
-		defer func() {
-			if r := recover(); r != nil {
-				logger.Error("simulation halted due to panic", "height", blockHeight)
-				logWriter.PrintLogs()
-				panic(r)
-			}
-		}()
-	}
-
 	// set exported params to the initial state
 	if config.ExportParamsPath != "" && config.ExportParamsHeight == 0 {
-		exportedParams = params
+		sim.exportedParams = params
 	}
 
-	for blockHeight < int64(config.NumBlocks+config.InitialBlockHeight) {
-		pastTimes = append(pastTimes, blockTime)
-		pastVoteInfos = append(pastVoteInfos, finalizeBlockReq.DecidedLastCommit.Votes)
+	return sim, nil
+}
 
-		// Run the BeginBlock handler
-		logWriter.AddEntry(BeginBlockEntry(blockTime, blockHeight))
+// done returns true once all the blocks of the simulation are simulated, or if
+// it stopped early.
+func (s *appSimulation) done() bool {
+	return s.stoppedEarly || s.blockHeight >= int64(s.config.NumBlocks+s.config.InitialBlockHeight)
+}
 
-		res, err := app.FinalizeBlock(finalizeBlockReq)
-		if err != nil {
-			return params, fmt.Errorf("block finalization failed at height %d: %w", blockHeight, err)
-		}
+// step simulates a block: it finalizes the block, runs the queued and the
+// standard operations and commits the block.
+func (s *appSimulation) step() error {
+	s.pastTimes = append(s.pastTimes, s.blockTime)
+	s.pastVoteInfos = append(s.pastVoteInfos, s.finalizeBlockReq.DecidedLastCommit.Votes)
 
-		ctx := app.NewContextLegacy(false, cmtproto.Header{
-			Height:          blockHeight,
-			Time:            blockTime,
-			ProposerAddress: proposerAddress,
-			ChainID:         config.ChainID,
-		}).WithHeaderInfo(header.Info{
-			Height:  blockHeight,
-			Time:    blockTime,
-			ChainID: config.ChainID,
-		})
-
-		// run queued operations; ignores block size if block size is too small
-		numQueuedOpsRan, futureOps := runQueuedOperations(
-			tb, operationQueue, blockTime, int(blockHeight), r, app, ctx, accs, logWriter,
-			eventStats.Tally, config.Lean, config.ChainID,
-		)
+	// Run the BeginBlock handler
+	s.logWriter.AddEntry(BeginBlockEntry(s.blockTime, s.blockHeight))
 
-		numQueuedTimeOpsRan, timeFutureOps := runQueuedTimeOperations(tb,
-			timeOperationQueue, int(blockHeight), blockTime,
-			r, app, ctx, accs, logWriter, eventStats.Tally,
-			config.Lean, config.ChainID,
-		)
+	s.recorder.recordBlock(s.finalizeBlockReq)
+	res, err := s.app.FinalizeBlock(s.finalizeBlockReq)
+	if err != nil {
+		return fmt.Errorf("block finalization failed at height %d: %w", s.blockHeight, err)
+	}
 
-		futureOps = append(futureOps, timeFutureOps...)
-		queueOperations(operationQueue, timeOperationQueue, futureOps)
+	ctx := s.app.NewContextLegacy(false, cmtproto.Header{
+		Height:          s.blockHeight,
+		Time:            s.blockTime,
+		ProposerAddress: s.proposerAddress,
+		ChainID:         s.config.ChainID,
+	}).WithHeaderInfo(header.Info{
+		Height:  s.blockHeight,
+		Time:    s.blockTime,
+		ChainID: s.config.ChainID,
+	})
+	if s.recorder != nil {
+		ctx = ctx.WithMultiStore(traceRecordingMultiStore{MultiStore: ctx.MultiStore(), recorder: s.recorder})
+	}
 
-		// run standard operations
-		operations := blockSimulator(r, app, ctx, accs, cmtproto.Header{
-			Height:          blockHeight,
-			Time:            blockTime,
-			ProposerAddress: proposerAddress,
-			ChainID:         config.ChainID,
-		})
-		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
+	// run queued operations; ignores block size if block size is too small
+	numQueuedOpsRan, futureOps := runQueuedOperations(
+		s.tb, s.operationQueue, s.blockTime, int(s.blockHeight), s.r, s.entrypoint, ctx, s.accs, s.logWriter,
+		s.eventStats.Tally, s.config.Lean, s.config.ChainID,
+	)
 
-		blockHeight++
+	numQueuedTimeOpsRan, timeFutureOps := runQueuedTimeOperations(s.tb,
+		s.timeOperationQueue, int(s.blockHeight), s.blockTime,
+		s.r, s.entrypoint, ctx, s.accs, s.logWriter, s.eventStats.Tally,
+		s.config.Lean, s.config.ChainID,
+	)
 
-		logWriter.AddEntry(EndBlockEntry(blockTime, blockHeight))
+	futureOps = append(futureOps, timeFutureOps...)
+	queueOperations(s.operationQueue, s.timeOperationQueue, futureOps)
+
+	// run standard operations
+	operations := s.blockSimulator(s.r, s.entrypoint, ctx, s.accs, cmtproto.Header{
+		Height:          s.blockHeight,
+		Time:            s.blockTime,
+		ProposerAddress: s.proposerAddress,
+		ChainID:         s.config.ChainID,
+	})
+	s.opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
+
+	if s.onBlock != nil {
+		if err := s.onBlock(ctx); err != nil {
+			return fmt.Errorf("block hook failed at height %d: %w", s.blockHeight, err)
+		}
+	}
 
-		blockTime = blockTime.Add(time.Duration(minTimePerBlock) * time.Second)
-		blockTime = blockTime.Add(time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
-		proposerAddress = validators.randomProposer(r)
+	s.blockHeight++
 
-		if config.Commit {
-			app.SimWriteState()
-			if _, err := app.Commit(); err != nil {
-				return params, fmt.Errorf("commit failed at height %d: %w", blockHeight, err)
-			}
-		}
+	s.logWriter.AddEntry(EndBlockEntry(s.blockTime, s.blockHeight))
 
-		if proposerAddress == nil {
-			logger.Info("Simulation stopped early as all validators have been unbonded; nobody left to propose a block", "height", blockHeight)
-			break
+	s.blockTime = s.blockTime.Add(time.Duration(minTimePerBlock) * time.Second)
+	s.blockTime = s.blockTime.Add(time.Duration(int64(s.r.Intn(int(s.timeDiff)))) * time.Second)
+	s.proposerAddress = s.validators.randomProposer(s.r)
+
+	if s.config.Commit {
+		s.app.SimWriteState()
+		if _, err := s.app.Commit(); err != nil {
+			return fmt.Errorf("commit failed at height %d: %w", s.blockHeight, err)
 		}
+		s.recorder.recordAppHash(s.app.LastCommitID().Hash)
+	}
 
-		// Generate a random RequestBeginBlock with the current validator set
-		// for the next block
-		finalizeBlockReq = RandomRequestFinalizeBlock(r, params, validators, pastTimes, pastVoteInfos, eventStats.Tally, blockHeight, blockTime, proposerAddress)
+	if s.proposerAddress == nil {
+		s.logger.Info("Simulation stopped early as all validators have been unbonded; nobody left to propose a block", "height", s.blockHeight)
+		s.stoppedEarly = true
+		return nil
+	}
 
-		// Update the validator set, which will be reflected in the application
-		// on the next block
-		validators = nextValidators
-		nextValidators = updateValidators(tb, r, params, validators, res.ValidatorUpdates, eventStats.Tally)
+	// Generate a random RequestBeginBlock with the current validator set
+	// for the next block
+	s.finalizeBlockReq = RandomRequestFinalizeBlock(s.r, s.params, s.validators, s.pastTimes, s.pastVoteInfos, s.eventStats.Tally, s.blockHeight, s.blockTime, s.proposerAddress)
 
-		// update the exported params
-		if config.ExportParamsPath != "" && int64(config.ExportParamsHeight) == blockHeight {
-			exportedParams = params
-		}
+	// Update the validator set, which will be reflected in the application
+	// on the next block
+	s.validators = s.nextValidators
+	s.nextValidators = updateValidators(s.tb, s.r, s.params, s.validators, res.ValidatorUpdates, s.eventStats.Tally)
+
+	// update the exported params
+	if s.config.ExportParamsPath != "" && int64(s.config.ExportParamsHeight) == s.blockHeight {
+		s.exportedParams = s.params
 	}
 
-	logger.Info("Simulation complete", "height", blockHeight, "block-time", blockTime, "opsCount", opCount,
-		"run-time", time.Since(startTime), "app-hash", hex.EncodeToString(app.LastCommitID().Hash))
+	return nil
+}
+
+// queueOperations queues future operations in the simulation.
+func (s *appSimulation) queueOperations(futureOps []simulation.FutureOperation) {
+	queueOperations(s.operationQueue, s.timeOperationQueue, futureOps)
+}
 
-	if config.ExportStatsPath != "" {
+// finish logs the completion of the simulation and prints or exports its
+// statistics.
+func (s *appSimulation) finish() {
+	s.logger.Info("Simulation complete", "height", s.blockHeight, "block-time", s.blockTime, "opsCount", s.opCount,
+		"run-time", time.Since(s.startTime), "app-hash", hex.EncodeToString(s.app.LastCommitID().Hash))
+
+	if s.config.ExportStatsPath != "" {
 		fmt.Println("Exporting simulation statistics...")
-		eventStats.ExportJSON(config.ExportStatsPath)
+		s.eventStats.ExportJSON(s.config.ExportStatsPath)
 	} else {
-		eventStats.Print(w)
+		s.eventStats.Print(s.w)
 	}
-	return exportedParams, err
+}
+
+// writeTrace writes the recorded trace to the trace path of the config.
+func (s *appSimulation) writeTrace() error {
+	if err := s.recorder.trace.WriteFile(s.config.TracePath); err != nil {
+		return fmt.Errorf("failed to write trace: %w", err)
+	}
+	return nil
 }
 
 type blockSimFn func(
 	r *rand.Rand,
-	app *baseapp.BaseApp,
+	app simulation.AppEntrypoint,
 	ctx sdk.Context,
 	accounts []simulation.Account,
 	header cmtproto.Header,
@@ -306,7 +442,7 @@ func createBlockSimulator(tb testing.TB, printProgress bool, w io.Writer, params
 	selectOp := ops.getSelectOpFn()
 
 	return func(
-		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account, header cmtproto.Header,
+		r *rand.Rand, app simulation.AppEntrypoint, ctx sdk.Context, accounts []simulation.Account, header cmtproto.Header,
 	) (opCount int) {
 		_, _ = fmt.Fprintf(
 			w, "\rSimulating... block %d/%d, operation %d/%d.",
@@ -364,7 +500,7 @@ Comment: %s`,
 }
 
 func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
-	blockTime time.Time, height int, r *rand.Rand, app *baseapp.BaseApp,
+	blockTime time.Time, height int, r *rand.Rand, app simulation.AppEntrypoint,
 	ctx sdk.Context, accounts []simulation.Account, logWriter LogWriter,
 	event func(route, op, evResult string), lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
@@ -402,7 +538,7 @@ func runQueuedOperations(tb testing.TB, queueOps map[int][]simulation.Operation,
 
 func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperation,
 	height int, currentTime time.Time, r *rand.Rand,
-	app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account,
+	app simulation.AppEntrypoint, ctx sdk.Context, accounts []simulation.Account,
 	logWriter LogWriter, event func(route, op, evResult string),
 	lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
@@ -0,0 +1,252 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Trace is the record of a simulation run: the chain initialization, and for every block the
+// finalize block request, the txs delivered by the operations and the state they wrote
+// directly, and the resulting app hash. Replaying it against another build of the app
+// localizes nondeterminism to the first block of which the app hash differs.
+type Trace struct {
+	Seed      int64        `json:"seed"`
+	InitChain []byte       `json:"init_chain"` // protobuf encoded InitChainRequest
+	Blocks    []TraceBlock `json:"blocks"`
+}
+
+// TraceBlock is a block recorded in a simulation trace.
+type TraceBlock struct {
+	Height        int64         `json:"height"`
+	FinalizeBlock []byte        `json:"finalize_block"` // protobuf encoded FinalizeBlockRequest
+	Changes       []TraceChange `json:"changes"`
+	AppHash       []byte        `json:"app_hash"`
+}
+
+// TraceChange is a state change made by an operation after the finalization of a block: either a
+// delivered tx, or a write the operation made directly to a store, outside a tx.
+type TraceChange struct {
+	Tx []byte `json:"tx,omitempty"`
+
+	Store  string `json:"store,omitempty"`
+	Key    []byte `json:"key,omitempty"`
+	Value  []byte `json:"value,omitempty"`
+	Delete bool   `json:"delete,omitempty"`
+}
+
+// ReadTrace reads a trace from a JSON file written by a simulation run.
+func ReadTrace(path string) (Trace, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Trace{}, err
+	}
+
+	var trace Trace
+	if err := json.Unmarshal(bz, &trace); err != nil {
+		return Trace{}, fmt.Errorf("failed to decode trace %s: %w", path, err)
+	}
+	return trace, nil
+}
+
+// WriteFile saves the trace as a JSON file on a given path.
+func (t Trace) WriteFile(path string) error {
+	bz, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// AppHashMismatch is a block of which the app hash differs from the one recorded in the trace.
+type AppHashMismatch struct {
+	Height   int64
+	Expected []byte
+	Actual   []byte
+}
+
+func (m AppHashMismatch) String() string {
+	return fmt.Sprintf("app hash mismatch at height %d: expected %s, got %s",
+		m.Height, hex.EncodeToString(m.Expected), hex.EncodeToString(m.Actual))
+}
+
+// ReplayTrace initializes the chain of a new app and replays the blocks and txs of the trace
+// against it, returning the blocks of which the app hash differs from the recorded one.
+// Since a difference is carried over to the following blocks, the first mismatch localizes
+// the nondeterminism.
+func ReplayTrace(app *baseapp.BaseApp, trace Trace) ([]AppHashMismatch, error) {
+	var initChainReq abci.InitChainRequest
+	if err := proto.Unmarshal(trace.InitChain, &initChainReq); err != nil {
+		return nil, fmt.Errorf("failed to decode init chain request: %w", err)
+	}
+	if _, err := app.InitChain(&initChainReq); err != nil {
+		return nil, err
+	}
+
+	storeKeys, ok := app.CommitMultiStore().(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil, errors.New("the commit multi-store doesn't expose its store keys")
+	}
+
+	var mismatches []AppHashMismatch
+	for _, block := range trace.Blocks {
+		var finalizeBlockReq abci.FinalizeBlockRequest
+		if err := proto.Unmarshal(block.FinalizeBlock, &finalizeBlockReq); err != nil {
+			return mismatches, fmt.Errorf("failed to decode finalize block request at height %d: %w", block.Height, err)
+		}
+		if _, err := app.FinalizeBlock(&finalizeBlockReq); err != nil {
+			return mismatches, fmt.Errorf("block finalization failed at height %d: %w", block.Height, err)
+		}
+
+		ms := app.NewContext(false).MultiStore()
+		for _, change := range block.Changes {
+			if change.Tx != nil {
+				// the txs which failed in the simulation are recorded as well, so their errors
+				// are expected and only reflected in the app hash
+				_, _, _ = app.SimDeliverTxBytes(change.Tx)
+				continue
+			}
+
+			key, ok := storeKeys.StoreKeysByName()[change.Store]
+			if !ok {
+				return mismatches, fmt.Errorf("unknown store %s at height %d", change.Store, block.Height)
+			}
+			if change.Delete {
+				ms.GetKVStore(key).Delete(change.Key)
+			} else {
+				ms.GetKVStore(key).Set(change.Key, change.Value)
+			}
+		}
+
+		app.SimWriteState()
+		if _, err := app.Commit(); err != nil {
+			return mismatches, fmt.Errorf("commit failed at height %d: %w", block.Height, err)
+		}
+
+		if appHash := app.LastCommitID().Hash; !bytes.Equal(appHash, block.AppHash) {
+			mismatches = append(mismatches, AppHashMismatch{
+				Height:   block.Height,
+				Expected: block.AppHash,
+				Actual:   appHash,
+			})
+		}
+	}
+
+	return mismatches, nil
+}
+
+// traceRecorder records the trace of a simulation run. A nil recorder records nothing.
+type traceRecorder struct {
+	trace Trace
+}
+
+func newTraceRecorder(seed int64) *traceRecorder {
+	return &traceRecorder{trace: Trace{Seed: seed}}
+}
+
+func (r *traceRecorder) recordInitChain(req *abci.InitChainRequest) {
+	if r == nil {
+		return
+	}
+	r.trace.InitChain = mustMarshalProto(req)
+}
+
+func (r *traceRecorder) recordBlock(req *abci.FinalizeBlockRequest) {
+	if r == nil {
+		return
+	}
+	r.trace.Blocks = append(r.trace.Blocks, TraceBlock{
+		Height:        req.Height,
+		FinalizeBlock: mustMarshalProto(req),
+	})
+}
+
+func (r *traceRecorder) recordChange(change TraceChange) {
+	if r == nil || len(r.trace.Blocks) == 0 {
+		return
+	}
+	block := &r.trace.Blocks[len(r.trace.Blocks)-1]
+	block.Changes = append(block.Changes, change)
+}
+
+func (r *traceRecorder) recordAppHash(appHash []byte) {
+	if r == nil || len(r.trace.Blocks) == 0 {
+		return
+	}
+	r.trace.Blocks[len(r.trace.Blocks)-1].AppHash = appHash
+}
+
+// traceRecordingApp is the app entrypoint given to the operations when recording a trace,
+// which records the txs they deliver.
+type traceRecordingApp struct {
+	app      *baseapp.BaseApp
+	recorder *traceRecorder
+}
+
+var _ simulation.AppEntrypoint = traceRecordingApp{}
+
+func (a traceRecordingApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
+	bz, err := txEncoder(tx)
+	if err != nil {
+		// let the app report the encoding error
+		return a.app.SimDeliver(txEncoder, tx)
+	}
+
+	a.recorder.recordChange(TraceChange{Tx: bz})
+	return a.app.SimDeliverTxBytes(bz)
+}
+
+// traceRecordingMultiStore is the multi-store of the context given to the operations when
+// recording a trace, which records the writes they make directly to the stores. The writes
+// made through a branch of the multi-store are not recorded.
+type traceRecordingMultiStore struct {
+	storetypes.MultiStore
+	recorder *traceRecorder
+}
+
+func (ms traceRecordingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return traceRecordingKVStore{
+		KVStore:  ms.MultiStore.GetKVStore(key),
+		name:     key.Name(),
+		recorder: ms.recorder,
+	}
+}
+
+// traceRecordingKVStore records the writes made to a store by the operations.
+type traceRecordingKVStore struct {
+	storetypes.KVStore
+	name     string
+	recorder *traceRecorder
+}
+
+func (s traceRecordingKVStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.recorder.recordChange(TraceChange{Store: s.name, Key: key, Value: value})
+}
+
+func (s traceRecordingKVStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.recorder.recordChange(TraceChange{Store: s.name, Key: key, Delete: true})
+}
+
+func mustMarshalProto(msg proto.Message) []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal proto message: %w", err))
+	}
+	return bz
+}
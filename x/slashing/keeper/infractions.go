@@ -25,6 +25,9 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params types.Params, addr cryptotypes.Address, power int64, signed comet.BlockIDFlag) error {
 	height := k.HeaderService.HeaderInfo(ctx).Height
 
+	// the downtime parameters of the validator's class
+	classParams := params.ValidatorClassParams(power)
+
 	// fetch the validator public key
 	consAddr := sdk.ConsAddress(addr)
 
@@ -52,6 +55,33 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		return err
 	}
 
+	if signInfo.StartHeight > height {
+		return fmt.Errorf("invalid state, the validator %v has start height %d , which is greater than the current height %d (as parsed from the header)",
+			signInfo.Address, signInfo.StartHeight, height)
+	}
+
+	// When the validator changes class, its missed blocks are tracked with the window of its
+	// new class. The missed blocks of the last blocks covered by both windows are carried over,
+	// and the start height is kept, so that changing class can't clear the downtime record of
+	// an absent validator.
+	modifiedSignInfo := false
+	if signInfo.SignedBlocksWindow != classParams.SignedBlocksWindow {
+		trackedWindow := trackedSignedBlocksWindow(params, signInfo)
+		if trackedWindow != classParams.SignedBlocksWindow {
+			missedBlocks, err := k.remapMissedBlockBitmap(ctx, consAddr, signInfo.StartHeight, height, trackedWindow, classParams.SignedBlocksWindow)
+			if err != nil {
+				return err
+			}
+			signInfo.IndexOffset = 0 //nolint: staticcheck // the deprecated index offset is reset with the bitmap
+			signInfo.MissedBlocksCounter = missedBlocks
+		}
+
+		signInfo.SignedBlocksWindow = classParams.SignedBlocksWindow
+		modifiedSignInfo = true
+	}
+
+	// use the downtime parameters of the validator's class
+	params = classParams
 	signedBlocksWindow := params.SignedBlocksWindow
 
 	// Compute the relative index, so we count the blocks the validator *should*
@@ -66,10 +96,6 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 	// A genesis validator will start at index 0, whereas a non-genesis validator's startHeight will be the block
 	// they bonded on, but the first block they vote on will be one later. (And thus their first vote is at index 1)
 	index := (height - signInfo.StartHeight) % signedBlocksWindow
-	if signInfo.StartHeight > height {
-		return fmt.Errorf("invalid state, the validator %v has start height %d , which is greater than the current height %d (as parsed from the header)",
-			signInfo.Address, signInfo.StartHeight, height)
-	}
 
 	// determine if the validator signed the previous block
 	previous, err := k.GetMissedBlockBitmapValue(ctx, consAddr, index)
@@ -77,7 +103,6 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		return fmt.Errorf("failed to get the validator's bitmap value: %w", err)
 	}
 
-	modifiedSignInfo := false
 	missed := signed == comet.BlockIDFlagAbsent
 	switch {
 	case !previous && missed:
@@ -149,11 +174,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
-			if err != nil {
-				return err
-			}
-
+			slashFractionDowntime := params.SlashFractionDowntime
 			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
 			if err != nil {
 				return err
@@ -174,11 +195,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
-			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-			if err != nil {
-				return err
-			}
-			signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(downtimeJailDur)
+			signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(params.DowntimeJailDuration)
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
@@ -118,6 +118,80 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid validator class",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					ValidatorClasses: []slashingtypes.ValidatorClass{
+						slashingtypes.NewValidatorClass("small", 100, 1000, minSignedPerWindow, time.Duration(10), invalidVal),
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "validator class small: downtime slash fraction cannot be negative",
+		},
+		{
+			name: "set unordered validator classes",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					ValidatorClasses: []slashingtypes.ValidatorClass{
+						slashingtypes.NewValidatorClass("medium", 1000, 1000, minSignedPerWindow, time.Duration(10), slashFractionDowntime),
+						slashingtypes.NewValidatorClass("small", 100, 1000, minSignedPerWindow, time.Duration(10), slashFractionDowntime),
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "validator classes must be ordered by increasing max power",
+		},
+		{
+			name: "set duplicate validator classes",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					ValidatorClasses: []slashingtypes.ValidatorClass{
+						slashingtypes.NewValidatorClass("small", 100, 1000, minSignedPerWindow, time.Duration(10), slashFractionDowntime),
+						slashingtypes.NewValidatorClass("small", 1000, 1000, minSignedPerWindow, time.Duration(10), slashFractionDowntime),
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "duplicate validator class",
+		},
+		{
+			name: "set valid params with validator classes",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					ValidatorClasses: []slashingtypes.ValidatorClass{
+						slashingtypes.NewValidatorClass("small", 100, 2000, minSignedPerWindow, time.Duration(10), sdkmath.LegacyZeroDec()),
+						slashingtypes.NewValidatorClass("medium", 1000, 1000, minSignedPerWindow, time.Duration(10), slashFractionDowntime),
+					},
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
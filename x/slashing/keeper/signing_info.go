@@ -221,15 +221,26 @@ func (k Keeper) IterateMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddre
 	})
 }
 
-// GetValidatorMissedBlocks returns array of missed blocks for given validator.
+// GetValidatorMissedBlocks returns array of missed blocks for given validator,
+// in the signed blocks window its missed blocks are tracked with.
 func (k Keeper) GetValidatorMissedBlocks(ctx context.Context, addr sdk.ConsAddress) ([]types.MissedBlock, error) {
-	signedBlocksWindow, err := k.SignedBlocksWindow(ctx)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	signedBlocksWindow := trackedSignedBlocksWindow(params, signInfo)
+
 	missedBlocks := make([]types.MissedBlock, 0, signedBlocksWindow)
 	err = k.IterateMissedBlockBitmap(ctx, addr, func(index int64, missed bool) (stop bool) {
+		if index >= signedBlocksWindow {
+			return true
+		}
+
 		if missed {
 			missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
 		}
@@ -240,6 +251,64 @@ func (k Keeper) GetValidatorMissedBlocks(ctx context.Context, addr sdk.ConsAddre
 	return missedBlocks, err
 }
 
+// trackedSignedBlocksWindow returns the signed blocks window the missed blocks of
+// a validator are tracked with, i.e. the window of its validator class when it
+// last signed, or the signed blocks window param if it has not signed yet.
+func trackedSignedBlocksWindow(params types.Params, signInfo types.ValidatorSigningInfo) int64 {
+	if signInfo.SignedBlocksWindow != 0 {
+		return signInfo.SignedBlocksWindow
+	}
+
+	return params.SignedBlocksWindow
+}
+
+// remapMissedBlockBitmap moves the missed blocks of a validator from the bitmap of
+// the oldWindow signed blocks window to the one of newWindow. The blocks of the
+// last min(oldWindow, newWindow) heights before height are kept, the older ones
+// are dropped. It returns the number of missed blocks kept.
+func (k Keeper) remapMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddress, startHeight, height, oldWindow, newWindow int64) (int64, error) {
+	prevAddr, err := k.getPreviousConsKey(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+
+	// the chunks are read by key, as the chunks without missed blocks may not exist
+	missedIndexes := make(map[int64]struct{})
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](prevAddr.Bytes())
+	err = k.ValidatorMissedBlockBitmap.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], value []byte) (bool, error) {
+		bs := bitset.New(uint(types.MissedBlockBitmapChunkSize))
+		if err := bs.UnmarshalBinary(value); err != nil {
+			return true, errorsmod.Wrapf(err, "failed to decode bitmap chunk; index: %v", key)
+		}
+
+		for i, ok := bs.NextSet(0); ok; i, ok = bs.NextSet(i + 1) {
+			missedIndexes[int64(key.K2())*types.MissedBlockBitmapChunkSize+int64(i)] = struct{}{}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.DeleteMissedBlockBitmap(ctx, addr); err != nil {
+		return 0, err
+	}
+
+	var missedBlocks int64
+	for h := max(startHeight, height-min(oldWindow, newWindow)); h < height; h++ {
+		if _, ok := missedIndexes[(h-startHeight)%oldWindow]; !ok {
+			continue
+		}
+
+		if err := k.SetMissedBlockBitmapValue(ctx, addr, (h-startHeight)%newWindow, true); err != nil {
+			return 0, err
+		}
+		missedBlocks++
+	}
+
+	return missedBlocks, nil
+}
+
 // performConsensusPubKeyUpdate updates cons address to its pub key relation
 // Updates signing info, missed blocks (removes old one, and sets new one)
 func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/math"
@@ -33,6 +35,21 @@ func NewParams(
 	}
 }
 
+// NewValidatorClass creates a new ValidatorClass object
+func NewValidatorClass(
+	name string, maxPower, signedBlocksWindow int64, minSignedPerWindow math.LegacyDec,
+	downtimeJailDuration time.Duration, slashFractionDowntime math.LegacyDec,
+) ValidatorClass {
+	return ValidatorClass{
+		Name:                  name,
+		MaxPower:              maxPower,
+		SignedBlocksWindow:    signedBlocksWindow,
+		MinSignedPerWindow:    minSignedPerWindow,
+		DowntimeJailDuration:  downtimeJailDuration,
+		SlashFractionDowntime: slashFractionDowntime,
+	}
+}
+
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
 	return NewParams(
@@ -61,6 +78,51 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateValidatorClasses(p.ValidatorClasses); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates the downtime parameters of a validator class
+func (c ValidatorClass) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("validator class name cannot be blank")
+	}
+	if c.MaxPower <= 0 {
+		return fmt.Errorf("validator class %s max power must be positive: %d", c.Name, c.MaxPower)
+	}
+	if err := validateSignedBlocksWindow(c.SignedBlocksWindow); err != nil {
+		return fmt.Errorf("validator class %s: %w", c.Name, err)
+	}
+	if err := validateMinSignedPerWindow(c.MinSignedPerWindow); err != nil {
+		return fmt.Errorf("validator class %s: %w", c.Name, err)
+	}
+	if err := validateDowntimeJailDuration(c.DowntimeJailDuration); err != nil {
+		return fmt.Errorf("validator class %s: %w", c.Name, err)
+	}
+	if err := validateSlashFractionDowntime(c.SlashFractionDowntime); err != nil {
+		return fmt.Errorf("validator class %s: %w", c.Name, err)
+	}
+	return nil
+}
+
+func validateValidatorClasses(classes []ValidatorClass) error {
+	names := make(map[string]struct{}, len(classes))
+	for i, class := range classes {
+		if err := class.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[class.Name]; ok {
+			return fmt.Errorf("duplicate validator class: %s", class.Name)
+		}
+		names[class.Name] = struct{}{}
+
+		if i > 0 && class.MaxPower <= classes[i-1].MaxPower {
+			return fmt.Errorf("validator classes must be ordered by increasing max power: %s", class.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -156,3 +218,21 @@ func (p *Params) MinSignedPerWindowInt() int64 {
 	//       less than 1.
 	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
 }
+
+// ValidatorClassParams returns the params applying to a validator with the
+// given consensus power, i.e. the downtime parameters of the first validator
+// class whose max power is greater than or equal to the power. The params are
+// returned unchanged if the validator is in no class.
+func (p Params) ValidatorClassParams(power int64) Params {
+	for _, class := range p.ValidatorClasses {
+		if power <= class.MaxPower {
+			p.SignedBlocksWindow = class.SignedBlocksWindow
+			p.MinSignedPerWindow = class.MinSignedPerWindow
+			p.DowntimeJailDuration = class.DowntimeJailDuration
+			p.SlashFractionDowntime = class.SlashFractionDowntime
+			return p
+		}
+	}
+
+	return p
+}
@@ -50,6 +50,10 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// The signed blocks window the missed blocks of the validator are tracked with,
+	// i.e. the one of its validator class. The missed blocks are carried over when it changes.
+	// Zero means the signed_blocks_window param.
+	SignedBlocksWindow int64 `protobuf:"varint,7,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +132,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,6 +146,11 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// validator_classes defines the downtime parameters of validator classes,
+	// ordered by increasing max_power. A validator uses the parameters of the
+	// first class whose max_power is greater than or equal to its consensus power,
+	// or the parameters above if there is none.
+	ValidatorClasses []ValidatorClass `protobuf:"bytes,6,rep,name=validator_classes,json=validatorClasses,proto3" json:"validator_classes"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +200,92 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetValidatorClasses() []ValidatorClass {
+	if m != nil {
+		return m.ValidatorClasses
+	}
+	return nil
+}
+
+// ValidatorClass defines the downtime parameters applied to the validators
+// whose consensus power is in the class's power bracket.
+type ValidatorClass struct {
+	// name is a human readable identifier of the class.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_power is the inclusive upper bound of the consensus power of the
+	// validators in the class.
+	MaxPower              int64                       `protobuf:"varint,2,opt,name=max_power,json=maxPower,proto3" json:"max_power,omitempty"`
+	SignedBlocksWindow    int64                       `protobuf:"varint,3,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	MinSignedPerWindow    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	DowntimeJailDuration  time.Duration               `protobuf:"bytes,5,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	SlashFractionDowntime cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+}
+
+func (m *ValidatorClass) Reset()         { *m = ValidatorClass{} }
+func (m *ValidatorClass) String() string { return proto.CompactTextString(m) }
+func (*ValidatorClass) ProtoMessage()    {}
+func (*ValidatorClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *ValidatorClass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorClass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorClass.Merge(m, src)
+}
+func (m *ValidatorClass) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorClass proto.InternalMessageInfo
+
+func (m *ValidatorClass) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ValidatorClass) GetMaxPower() int64 {
+	if m != nil {
+		return m.MaxPower
+	}
+	return 0
+}
+
+func (m *ValidatorClass) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *ValidatorClass) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*ValidatorClass)(nil), "cosmos.slashing.v1beta1.ValidatorClass")
 }
 
 func init() {
@@ -194,47 +293,55 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4b, 0x4f, 0xf3, 0x46,
+	0x14, 0x8d, 0xc9, 0x03, 0x98, 0xa4, 0x55, 0x99, 0x26, 0xc5, 0x84, 0xe2, 0x04, 0xa4, 0xb6, 0x11,
+	0x52, 0x6c, 0x48, 0xa5, 0x2e, 0x60, 0xd5, 0x10, 0xf5, 0x25, 0xa4, 0xa2, 0xd0, 0x87, 0xd4, 0x45,
+	0xad, 0x89, 0x3d, 0x71, 0xa6, 0xd8, 0x33, 0x91, 0x67, 0xf2, 0x60, 0xdd, 0x5d, 0x17, 0x15, 0xcb,
+	0x2e, 0xbb, 0x64, 0xc9, 0x82, 0xbf, 0x50, 0x89, 0x25, 0x62, 0x55, 0xb1, 0xa0, 0x55, 0x58, 0xd0,
+	0x9f, 0x51, 0x79, 0xc6, 0x0e, 0xef, 0x4a, 0x15, 0xdf, 0xf7, 0x6d, 0x2c, 0xfb, 0xde, 0x73, 0xce,
+	0x9d, 0x7b, 0xef, 0x19, 0x83, 0x0f, 0x1d, 0xc6, 0x03, 0xc6, 0x2d, 0xee, 0x23, 0xde, 0x23, 0xd4,
+	0xb3, 0x86, 0x9b, 0x1d, 0x2c, 0xd0, 0xe6, 0x34, 0x60, 0xf6, 0x43, 0x26, 0x18, 0x5c, 0x54, 0x38,
+	0x73, 0x1a, 0x8e, 0x71, 0xe5, 0xa2, 0xc7, 0x3c, 0x26, 0x31, 0x56, 0xf4, 0xa6, 0xe0, 0x65, 0xc3,
+	0x63, 0xcc, 0xf3, 0xb1, 0x25, 0xbf, 0x3a, 0x83, 0xae, 0xe5, 0x0e, 0x42, 0x24, 0x08, 0xa3, 0x71,
+	0xbe, 0xf2, 0x30, 0x2f, 0x48, 0x80, 0xb9, 0x40, 0x41, 0x3f, 0x06, 0x2c, 0xa9, 0x7a, 0xb6, 0x52,
+	0x8e, 0x8b, 0xab, 0xd4, 0x02, 0x0a, 0x08, 0x65, 0x96, 0x7c, 0xaa, 0xd0, 0xda, 0xaf, 0x69, 0x50,
+	0xfc, 0x0e, 0xf9, 0xc4, 0x45, 0x82, 0x85, 0xfb, 0xc4, 0xa3, 0x84, 0x7a, 0x5f, 0xd2, 0x2e, 0x83,
+	0xdb, 0x60, 0x16, 0xb9, 0x6e, 0x88, 0x39, 0xd7, 0xb5, 0xaa, 0x56, 0x9b, 0x6f, 0xae, 0x5e, 0x9c,
+	0xd6, 0x57, 0x62, 0xb9, 0x1d, 0x46, 0x39, 0xa6, 0x7c, 0xc0, 0x3f, 0x55, 0x90, 0x7d, 0x11, 0x12,
+	0xea, 0xb5, 0x13, 0x06, 0x5c, 0x05, 0x05, 0x2e, 0x50, 0x28, 0xec, 0x1e, 0x26, 0x5e, 0x4f, 0xe8,
+	0x33, 0x55, 0xad, 0x96, 0x6e, 0xe7, 0x65, 0xec, 0x0b, 0x19, 0x82, 0x1f, 0x80, 0x02, 0xa1, 0x2e,
+	0x1e, 0xdb, 0xac, 0xdb, 0xe5, 0x58, 0xe8, 0xe9, 0x08, 0xd2, 0x9c, 0xd1, 0xb5, 0x76, 0x5e, 0xc6,
+	0xbf, 0x96, 0x61, 0xb8, 0x0b, 0x0a, 0x3f, 0x21, 0xe2, 0x63, 0xd7, 0x1e, 0x50, 0x41, 0x7c, 0x3d,
+	0x53, 0xd5, 0x6a, 0xf9, 0x46, 0xd9, 0x54, 0x53, 0x30, 0x93, 0x29, 0x98, 0xdf, 0x24, 0x53, 0x68,
+	0xbe, 0x75, 0x76, 0x55, 0x49, 0x1d, 0xfd, 0x55, 0xd1, 0x8e, 0x6f, 0x4e, 0xd6, 0xb5, 0x76, 0x5e,
+	0xd1, 0xbf, 0x8d, 0xd8, 0xd0, 0x00, 0x40, 0xb0, 0xa0, 0xc3, 0x05, 0xa3, 0xd8, 0xd5, 0xb3, 0x55,
+	0xad, 0x36, 0xd7, 0xbe, 0x13, 0x81, 0x0d, 0x50, 0x0a, 0x08, 0xe7, 0xd8, 0xb5, 0x3b, 0x3e, 0x73,
+	0x0e, 0xb8, 0xed, 0xb0, 0x01, 0x15, 0x38, 0xd4, 0x73, 0xb2, 0x81, 0x77, 0x55, 0xb2, 0x29, 0x73,
+	0x3b, 0x2a, 0x05, 0x3f, 0x07, 0x45, 0x4e, 0x3c, 0x7a, 0xcb, 0x19, 0x11, 0xea, 0xb2, 0x91, 0x3e,
+	0x2b, 0x1b, 0x2a, 0x5d, 0x9e, 0xd6, 0x17, 0xc6, 0x53, 0x4f, 0x54, 0x87, 0x1b, 0x66, 0xc3, 0xdc,
+	0x68, 0x43, 0x45, 0x51, 0x4a, 0xdf, 0x4b, 0xc2, 0x56, 0xe6, 0x9f, 0xdf, 0x2b, 0xda, 0xda, 0xcf,
+	0x59, 0x90, 0xdb, 0x43, 0x21, 0x0a, 0x38, 0xdc, 0x78, 0x46, 0x59, 0x93, 0x87, 0x79, 0x42, 0x02,
+	0x92, 0xe8, 0xfc, 0xd4, 0x8e, 0x59, 0x7d, 0x1c, 0x26, 0x94, 0x68, 0x01, 0x85, 0xe6, 0x27, 0xd1,
+	0x68, 0x2e, 0xaf, 0x2a, 0xcb, 0x6a, 0x8d, 0xdc, 0x3d, 0x30, 0x09, 0xb3, 0x02, 0x24, 0x7a, 0xe6,
+	0x2e, 0xf6, 0x90, 0x73, 0xd8, 0xc2, 0xce, 0xc5, 0x69, 0x1d, 0xc4, 0x5b, 0x6e, 0x61, 0x47, 0xcd,
+	0x10, 0x06, 0x84, 0xee, 0x4b, 0xcd, 0x3d, 0x1c, 0xc6, 0xa5, 0x7e, 0x04, 0xef, 0xb9, 0x6c, 0x44,
+	0x23, 0xf7, 0xd9, 0xd1, 0x88, 0xed, 0xc4, 0xa7, 0x72, 0x93, 0xf9, 0xc6, 0xd2, 0xa3, 0x15, 0xb5,
+	0x62, 0x80, 0xda, 0xd0, 0x6f, 0xd3, 0x0d, 0x15, 0x13, 0x9d, 0xaf, 0x10, 0xf1, 0x13, 0x10, 0xe4,
+	0xa0, 0x2c, 0x87, 0x66, 0x77, 0x43, 0xe4, 0x44, 0x11, 0xdb, 0x65, 0x83, 0x8e, 0x8f, 0x65, 0x73,
+	0x7a, 0xe6, 0x45, 0xfd, 0x2c, 0x4a, 0xe5, 0xcf, 0x62, 0xe1, 0x96, 0xd4, 0x8d, 0xfa, 0x83, 0x14,
+	0x2c, 0x3e, 0x2a, 0xaa, 0xce, 0xa6, 0x67, 0x5f, 0x54, 0xb1, 0xf4, 0xa0, 0xa2, 0x12, 0x85, 0x02,
+	0x2c, 0x0c, 0x93, 0xcb, 0x67, 0x3b, 0x3e, 0xe2, 0x1c, 0x73, 0x3d, 0x57, 0x4d, 0xd7, 0xf2, 0x8d,
+	0x8f, 0xcc, 0x67, 0xfe, 0x1b, 0xe6, 0xf4, 0xba, 0xee, 0x44, 0x84, 0xa6, 0x21, 0x8f, 0xf4, 0x94,
+	0xcb, 0x54, 0xe9, 0x77, 0x86, 0xf7, 0xf0, 0x98, 0x6f, 0xad, 0xfe, 0x72, 0x73, 0xb2, 0xfe, 0xbe,
+	0x92, 0xaf, 0x73, 0xf7, 0xc0, 0xba, 0x65, 0x5a, 0xca, 0x7a, 0x6b, 0x7f, 0xa4, 0xc1, 0xdb, 0xf7,
+	0xeb, 0x40, 0x08, 0x32, 0x14, 0x05, 0x58, 0xfd, 0x0d, 0xda, 0xf2, 0x1d, 0x2e, 0x83, 0xf9, 0x00,
+	0x8d, 0xed, 0x3e, 0x1b, 0xe1, 0x30, 0xbe, 0xe4, 0x73, 0x01, 0x1a, 0xef, 0x45, 0xdf, 0xcf, 0xda,
+	0x37, 0xfd, 0xff, 0xed, 0x9b, 0x79, 0x83, 0xf6, 0xcd, 0xbe, 0x12, 0xfb, 0xfe, 0x87, 0x93, 0x72,
+	0xaf, 0xc1, 0x49, 0x5b, 0xa5, 0x8b, 0xa7, 0x1c, 0xd0, 0xdc, 0x3e, 0x9e, 0x18, 0xda, 0xd9, 0xc4,
+	0xd0, 0xce, 0x27, 0x86, 0xf6, 0xf7, 0xc4, 0xd0, 0x8e, 0xae, 0x8d, 0xd4, 0xf9, 0xb5, 0x91, 0xfa,
+	0xf3, 0xda, 0x48, 0xfd, 0xb0, 0x72, 0xaf, 0xf6, 0x1d, 0x17, 0x88, 0xc3, 0x3e, 0xe6, 0x9d, 0x9c,
+	0xec, 0xfd, 0xe3, 0x7f, 0x07, 0x00, 0xc8, 0x20, 0x13, 0x8f, 0xea, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +381,9 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +420,53 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.ValidatorClasses) != len(that1.ValidatorClasses) {
+		return false
+	}
+	for i := range this.ValidatorClasses {
+		if !this.ValidatorClasses[i].Equal(&that1.ValidatorClasses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorClass) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorClass)
+	if !ok {
+		that2, ok := that.(ValidatorClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.MaxPower != that1.MaxPower {
+		return false
+	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,6 +489,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -395,6 +557,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorClasses) > 0 {
+		for iNdEx := len(m.ValidatorClasses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorClasses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -441,6 +617,74 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorClass) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPower != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -476,6 +720,9 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
 	return n
 }
 
@@ -496,6 +743,37 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.ValidatorClasses) > 0 {
+		for _, e := range m.ValidatorClasses {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.MaxPower != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxPower))
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -676,6 +954,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -877,6 +1174,259 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorClasses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorClasses = append(m.ValidatorClasses, ValidatorClass{})
+			if err := m.ValidatorClasses[len(m.ValidatorClasses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPower", wireType)
+			}
+			m.MaxPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -137,6 +137,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "ValidatorsBelowMinSelfDelegation",
+					Use:       "validators-below-min-self-delegation",
+					Short:     "Query the bonded validators self-delegating less than the chain minimum",
+					Long:      "Query the bonded validators self-delegating less than the min_self_delegation parameter, along with the time at which they will be jailed.",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -13,5 +13,10 @@ import (
 func (k *Keeper) EndBlocker(ctx context.Context) ([]appmodule.ValidatorUpdate, error) {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
+
+	if err := k.jailSelfDelegationShortfalls(ctx); err != nil {
+		return nil, err
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -782,6 +782,101 @@ func (k Keeper) Delegate(
 		return newShares, err
 	}
 
+	if bytes.Equal(delAddr, valbz) {
+		if err := k.updateSelfDelegationShortfall(ctx, valbz); err != nil {
+			return newShares, err
+		}
+	}
+
+	return newShares, nil
+}
+
+// MultiDelegate delegates the given amounts of the delegator to the given
+// validators. The tokens of all the delegations are transferred from the
+// delegator account at once, then moved at once to the bonded pool for the
+// bonded validators, and the delegations are updated, along with their hooks,
+// in a single pass over the validators.
+func (k Keeper) MultiDelegate(
+	ctx context.Context, delAddr sdk.AccAddress, validators []types.Validator, amounts []math.Int,
+) (newShares []math.LegacyDec, err error) {
+	if len(validators) != len(amounts) {
+		return nil, fmt.Errorf("%d amounts for %d validators", len(amounts), len(validators))
+	}
+
+	totalAmt, bondedAmt := math.ZeroInt(), math.ZeroInt()
+	for i, validator := range validators {
+		// see Delegate
+		if validator.InvalidExRate() {
+			return nil, types.ErrDelegatorShareExRateInvalid
+		}
+
+		totalAmt = totalAmt.Add(amounts[i])
+		if validator.IsBonded() {
+			bondedAmt = bondedAmt.Add(amounts[i])
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, totalAmt))
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.NotBondedPoolName, coins); err != nil {
+		return nil, err
+	}
+
+	if bondedAmt.IsPositive() {
+		if err := k.notBondedTokensToBonded(ctx, bondedAmt); err != nil {
+			return nil, err
+		}
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	newShares = make([]math.LegacyDec, len(validators))
+	for i, validator := range validators {
+		valbz, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, sdk.ValAddress(valbz)))
+		switch {
+		case err == nil:
+			err = k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valbz)
+		case errors.Is(err, collections.ErrNotFound):
+			delegation = types.NewDelegation(delAddrStr, validator.GetOperator(), math.LegacyZeroDec())
+			err = k.Hooks().BeforeDelegationCreated(ctx, delAddr, valbz)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		_, newShares[i], err = k.AddValidatorTokensAndShares(ctx, validator, amounts[i])
+		if err != nil {
+			return nil, err
+		}
+
+		delegation.Shares = delegation.Shares.Add(newShares[i])
+		if err = k.SetDelegation(ctx, delegation); err != nil {
+			return nil, err
+		}
+
+		if err := k.Hooks().AfterDelegationModified(ctx, delAddr, valbz); err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(delAddr, valbz) {
+			if err := k.updateSelfDelegationShortfall(ctx, valbz); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return newShares, nil
 }
 
@@ -876,6 +971,12 @@ func (k Keeper) Unbond(
 		}
 	}
 
+	if isValidatorOperator {
+		if err := k.updateSelfDelegationShortfall(ctx, valbz); err != nil {
+			return amount, err
+		}
+	}
+
 	return amount, nil
 }
 
@@ -189,6 +189,48 @@ func (s *KeeperTestSuite) TestDelegation() {
 	require.Equal(0, len(resBonds))
 }
 
+func (s *KeeperTestSuite) TestMultiDelegate() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, valAddrs := createValAddrs(3)
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	// a bonded and an unbonded validator
+	bondedVal := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	bondedVal, _ = bondedVal.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	bondedVal = stakingkeeper.TestingUpdateValidator(keeper, ctx, bondedVal, true)
+	require.True(bondedVal.IsBonded())
+
+	unbondedVal := testutil.NewValidator(s.T(), valAddrs[1], PKs[1])
+	require.NoError(keeper.SetValidator(ctx, unbondedVal))
+
+	// the funds of all the delegations are transferred at once, then the ones
+	// of the bonded validators are moved at once to the bonded pool
+	bondDenom, err := keeper.BondDenom(ctx)
+	require.NoError(err)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addrDels[2], stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30))).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10))).Times(1)
+
+	newShares, err := keeper.MultiDelegate(ctx, addrDels[2], []stakingtypes.Validator{bondedVal, unbondedVal}, []math.Int{math.NewInt(10), math.NewInt(20)})
+	require.NoError(err)
+	require.Equal([]math.LegacyDec{math.LegacyNewDec(10), math.LegacyNewDec(20)}, newShares)
+
+	for i, expTokens := range []math.Int{bondedVal.Tokens.AddRaw(10), math.NewInt(20)} {
+		delegation, err := keeper.Delegations.Get(ctx, collections.Join(addrDels[2], valAddrs[i]))
+		require.NoError(err)
+		require.Equal(newShares[i], delegation.Shares)
+
+		validator, err := keeper.GetValidator(ctx, valAddrs[i])
+		require.NoError(err)
+		require.Equal(expTokens, validator.Tokens)
+	}
+
+	_, err = keeper.MultiDelegate(ctx, addrDels[2], []stakingtypes.Validator{bondedVal}, nil)
+	require.ErrorContains(err, "0 amounts for 1 validators")
+}
+
 func (s *KeeperTestSuite) TestDelegationsByValIndex() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
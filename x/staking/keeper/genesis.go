@@ -195,6 +195,17 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, shortfall := range data.SelfDelegationShortfalls {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(shortfall.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.SelfDelegationShortfalls.Set(ctx, valAddr, shortfall.Since); err != nil {
+			return nil, err
+		}
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -331,17 +342,35 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	selfDelegationShortfalls := []types.SelfDelegationShortfall{}
+	err = k.SelfDelegationShortfalls.Walk(ctx, nil, func(valAddr sdk.ValAddress, since time.Time) (stop bool, err error) {
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		selfDelegationShortfalls = append(selfDelegationShortfalls, types.SelfDelegationShortfall{
+			ValidatorAddress: valAddrStr,
+			Since:            since,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
-		RotationIndexRecords: rotationIndex,
-		RotationHistory:      conspubKeyRotationHistory,
-		RotationQueue:        rotationQueue,
+		Params:                   params,
+		LastTotalPower:           totalPower,
+		LastValidatorPowers:      lastValidatorPowers,
+		Validators:               allValidators,
+		Delegations:              allDelegations,
+		UnbondingDelegations:     unbondingDelegations,
+		Redelegations:            redelegations,
+		Exported:                 true,
+		RotationIndexRecords:     rotationIndex,
+		RotationHistory:          conspubKeyRotationHistory,
+		RotationQueue:            rotationQueue,
+		SelfDelegationShortfalls: selfDelegationShortfalls,
 	}, nil
 }
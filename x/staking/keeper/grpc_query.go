@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -506,6 +507,44 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ValidatorsBelowMinSelfDelegation queries the bonded validators self-delegating less than the minimum
+func (k Querier) ValidatorsBelowMinSelfDelegation(ctx context.Context, req *types.QueryValidatorsBelowMinSelfDelegationRequest) (*types.QueryValidatorsBelowMinSelfDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, pageRes, err := query.CollectionPaginate(ctx, k.SelfDelegationShortfalls, req.Pagination,
+		func(valAddr sdk.ValAddress, since time.Time) (types.ValidatorBelowMinSelfDelegation, error) {
+			validator, err := k.GetValidator(ctx, valAddr)
+			if err != nil {
+				return types.ValidatorBelowMinSelfDelegation{}, err
+			}
+
+			selfDelegation, err := k.getSelfDelegation(ctx, validator)
+			if err != nil {
+				return types.ValidatorBelowMinSelfDelegation{}, err
+			}
+
+			return types.ValidatorBelowMinSelfDelegation{
+				ValidatorAddress: validator.OperatorAddress,
+				SelfDelegation:   selfDelegation,
+				Since:            since,
+				JailTime:         since.Add(params.MinSelfDelegationGracePeriod),
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorsBelowMinSelfDelegationResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
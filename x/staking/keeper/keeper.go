@@ -111,6 +111,8 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// SelfDelegationShortfalls key: valAddr | value: time since which the validator self-delegates less than the minimum
+	SelfDelegationShortfalls collections.Map[sdk.ValAddress, time.Time]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -286,6 +288,14 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 107 | valAddr
+		SelfDelegationShortfalls: collections.NewMap(
+			sb, types.SelfDelegationShortfallsKey,
+			"self_delegation_shortfalls",
+			sdk.ValAddressKey,
+			collcodec.KeyToValueCodec(sdk.TimeKey),
+		),
 	}
 
 	schema, err := sb.Build()
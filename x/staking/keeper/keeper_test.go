@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -85,7 +86,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		Params: simtestutil.DefaultConsensusParams,
 	}, nil).AnyTimes()
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
-	consensustypes.RegisterQueryServer(queryHelper, ck)
+	consensustypes.RegisterQueryServer(queryHelper, &consensusQueryServer{keeper: ck})
 
 	bankKeeper := stakingtestutil.NewMockBankKeeper(ctrl)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(queryHelper.GRPCQueryRouter), runtime.EnvWithMsgRouterService(s.baseApp.MsgServiceRouter()))
@@ -287,7 +288,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"d541c142f09fbaef4a9ce031b44496f956ca67bbf8c83e625732e92ccdb0d784",
 	)
 	s.Require().NoError(err)
 
@@ -302,7 +303,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"d541c142f09fbaef4a9ce031b44496f956ca67bbf8c83e625732e92ccdb0d784",
 	)
 	s.Require().NoError(err)
 }
@@ -320,7 +321,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"2312a259663a29d7d2e6a02a828fac09b4049ce0e9e4b2ab0d3d621a63ea7609",
 	)
 	s.Require().NoError(err)
 
@@ -333,7 +334,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"2312a259663a29d7d2e6a02a828fac09b4049ce0e9e4b2ab0d3d621a63ea7609",
 	)
 
 	s.Require().NoError(err)
@@ -352,7 +353,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1", // this hash obtained when ran this test in main branch
+		"5f6d8f2a86a16b8b0ca4bd060ec55bf5bd1fbb24e0882a2ca49d64f3214c8e58", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -365,7 +366,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1",
+		"5f6d8f2a86a16b8b0ca4bd060ec55bf5bd1fbb24e0882a2ca49d64f3214c8e58",
 	)
 
 	s.Require().NoError(err)
@@ -396,7 +397,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"a569c699e52c5fe58eeaa0ab9b9be5cbfd3d30dabe227c166b6584dd4c276525",
 	)
 	s.Require().NoError(err)
 
@@ -420,7 +421,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"a569c699e52c5fe58eeaa0ab9b9be5cbfd3d30dabe227c166b6584dd4c276525",
 	)
 	s.Require().NoError(err)
 }
@@ -437,7 +438,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"adb220d9aff992f65fbdfd6bd7e4b52a5bad7e2ac413a5b3a6cc4772e688affa",
 	)
 	s.Require().NoError(err)
 
@@ -450,7 +451,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"adb220d9aff992f65fbdfd6bd7e4b52a5bad7e2ac413a5b3a6cc4772e688affa",
 	)
 	s.Require().NoError(err)
 }
@@ -484,7 +485,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"d73d6eced9e2bf8d596a2db07a97b9f0060f139e8a27294ae28cd2ee19c20a7f",
 	)
 	s.Require().NoError(err)
 
@@ -510,7 +511,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"d73d6eced9e2bf8d596a2db07a97b9f0060f139e8a27294ae28cd2ee19c20a7f",
 	)
 	s.Require().NoError(err)
 }
@@ -533,7 +534,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"d5eeb2b0f49cd0f965fdc601d6530e9a21ef780c591f423b8d67236654811bf6",
 	)
 	s.Require().NoError(err)
 
@@ -548,7 +549,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"d5eeb2b0f49cd0f965fdc601d6530e9a21ef780c591f423b8d67236654811bf6",
 	)
 	s.Require().NoError(err)
 }
@@ -576,7 +577,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"e7575e4327819edac50b362caa42249fd7dfafcd1e21d5867e82cfc7db2789aa",
 	)
 	s.Require().NoError(err)
 
@@ -598,7 +599,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"e7575e4327819edac50b362caa42249fd7dfafcd1e21d5867e82cfc7db2789aa",
 	)
 	s.Require().NoError(err)
 }
@@ -606,3 +607,14 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+// consensusQueryServer serves the consensus params queries of the keeper with
+// the mock consensus keeper.
+type consensusQueryServer struct {
+	consensustypes.UnimplementedQueryServer
+	keeper *stakingtestutil.MockConsensusKeeper
+}
+
+func (s *consensusQueryServer) Params(ctx context.Context, req *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error) {
+	return s.keeper.Params(ctx, req)
+}
@@ -106,6 +106,15 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		)
 	}
 
+	minSelfDelegation, err := k.MinSelfDelegation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Value.Amount.LT(minSelfDelegation) {
+		return nil, errorsmod.Wrapf(types.ErrSelfDelegationBelowMinimum, "cannot self delegate less than the chain minimum of %s", minSelfDelegation)
+	}
+
 	if _, err := msg.Description.EnsureLength(); err != nil {
 		return nil, err
 	}
@@ -320,6 +329,120 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 	return &types.MsgDelegateResponse{}, nil
 }
 
+// MultiDelegate defines a method for splitting a delegation of coins from a delegator across multiple validators.
+// The delegated coins are transferred from the delegator account once per destination pool.
+func (k msgServer) MultiDelegate(ctx context.Context, msg *types.MsgMultiDelegate) (*types.MsgMultiDelegateResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid delegation amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	if len(msg.Delegations) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidDelegationWeights, "no delegations")
+	}
+
+	validators := make([]types.Validator, len(msg.Delegations))
+	seen := make(map[string]bool, len(msg.Delegations))
+	totalWeight := math.LegacyZeroDec()
+	for i, delegation := range msg.Delegations {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+
+		if seen[delegation.ValidatorAddress] {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDelegationWeights, "duplicate validator %s", delegation.ValidatorAddress)
+		}
+		seen[delegation.ValidatorAddress] = true
+
+		if delegation.Weight.IsNil() || !delegation.Weight.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDelegationWeights, "weight of validator %s must be positive", delegation.ValidatorAddress)
+		}
+		totalWeight = totalWeight.Add(delegation.Weight)
+
+		validators[i], err = k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !totalWeight.Equal(math.LegacyOneDec()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDelegationWeights, "weights must add up to 1, got %s", totalWeight)
+	}
+
+	amounts := splitDelegationAmount(msg.Amount.Amount, msg.Delegations)
+
+	for i := range validators {
+		if !amounts[i].IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrTinyDelegationAmount, "validator %s", msg.Delegations[i].ValidatorAddress)
+		}
+	}
+
+	newShares, err := k.Keeper.MultiDelegate(ctx, delegatorAddress, validators, amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	delegated := make([]sdk.Coin, len(validators))
+	for i, validator := range validators {
+		delegated[i] = sdk.NewCoin(bondDenom, amounts[i])
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeDelegate,
+			event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			event.NewAttribute(sdk.AttributeKeyAmount, delegated[i].String()),
+			event.NewAttribute(types.AttributeKeyNewShares, newShares[i].String()),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "multi_delegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	return &types.MsgMultiDelegateResponse{Amounts: delegated}, nil
+}
+
+// splitDelegationAmount splits amount according to the weights of the delegations.
+// The remainder of the truncated amounts goes to the first delegation.
+func splitDelegationAmount(amount math.Int, delegations []types.WeightedDelegation) []math.Int {
+	amounts := make([]math.Int, len(delegations))
+	remainder := amount
+	for i, delegation := range delegations {
+		amounts[i] = delegation.Weight.MulInt(amount).TruncateInt()
+		remainder = remainder.Sub(amounts[i])
+	}
+	amounts[0] = amounts[0].Add(remainder)
+
+	return amounts
+}
+
 // BeginRedelegate defines a method for performing a redelegation of coins from a source validator to a destination validator of given delegator
 func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedelegate) (*types.MsgBeginRedelegateResponse, error) {
 	valSrcAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorSrcAddress)
@@ -641,6 +764,25 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		}
 	}
 
+	// when min self delegation is updated, we need to update the validators self-delegating less than it
+	if previousParams.MinSelfDelegation.IsNil() || !previousParams.MinSelfDelegation.Equal(msg.Params.MinSelfDelegation) {
+		vals, err := k.GetAllValidators(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, val := range vals {
+			valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
+			if err != nil {
+				return nil, err
+			}
+
+			if err := k.updateSelfDelegationShortfall(ctx, valAddr); err != nil {
+				return nil, fmt.Errorf("failed to update self-delegation shortfall after MinSelfDelegation param change: %w", err)
+			}
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
@@ -627,6 +627,148 @@ func (s *KeeperTestSuite) TestMsgDelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgMultiDelegate() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	addr2 := sdk.AccAddress(PKS[1].Address())
+	valAddr2 := sdk.ValAddress(addr2)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addr2, types.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	for _, valAddr := range []sdk.ValAddress{ValAddr, valAddr2} {
+		msg, err := types.NewMsgCreateValidator(s.valAddressToString(valAddr), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+		require.NoError(err)
+
+		_, err = msgServer.CreateValidator(ctx, msg)
+		require.NoError(err)
+	}
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	testCases := []struct {
+		name       string
+		input      *types.MsgMultiDelegate
+		expErr     bool
+		expErrMsg  string
+		expAmounts []sdk.Coin
+	}{
+		{
+			name: "invalid delegator",
+			input: types.NewMsgMultiDelegate("invalid", amount, []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyOneDec()),
+			}),
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name: "zero amount",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt()), []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyOneDec()),
+			}),
+			expErr:    true,
+			expErrMsg: "invalid delegation amount",
+		},
+		{
+			name: "invalid BondDenom",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), sdk.NewCoin("test", math.NewInt(100)), []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyOneDec()),
+			}),
+			expErr:    true,
+			expErrMsg: "invalid coin denomination",
+		},
+		{
+			name:      "no delegations",
+			input:     types.NewMsgMultiDelegate(s.addressToString(Addr), amount, nil),
+			expErr:    true,
+			expErrMsg: "no delegations",
+		},
+		{
+			name: "invalid validator",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), amount, []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.addressToString([]byte("invalid")), math.LegacyOneDec()),
+			}),
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name: "validator does not exist",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), amount, []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString([]byte("val")), math.LegacyOneDec()),
+			}),
+			expErr:    true,
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name: "duplicate validator",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), amount, []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyNewDecWithPrec(5, 1)),
+			}),
+			expErr:    true,
+			expErrMsg: "duplicate validator",
+		},
+		{
+			name: "zero weight",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), amount, []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyOneDec()),
+				types.NewWeightedDelegation(s.valAddressToString(valAddr2), math.LegacyZeroDec()),
+			}),
+			expErr:    true,
+			expErrMsg: "must be positive",
+		},
+		{
+			name: "weights not adding up to 1",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), amount, []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWeightedDelegation(s.valAddressToString(valAddr2), math.LegacyNewDecWithPrec(4, 1)),
+			}),
+			expErr:    true,
+			expErrMsg: "weights must add up to 1",
+		},
+		{
+			name: "amount truncated to zero",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1)), []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWeightedDelegation(s.valAddressToString(valAddr2), math.LegacyNewDecWithPrec(5, 1)),
+			}),
+			expErr:    true,
+			expErrMsg: "too few tokens to delegate",
+		},
+		{
+			name: "valid msg, remainder goes to the first delegation",
+			input: types.NewMsgMultiDelegate(s.addressToString(Addr), amount, []types.WeightedDelegation{
+				types.NewWeightedDelegation(s.valAddressToString(ValAddr), math.LegacyNewDecWithPrec(333, 3)),
+				types.NewWeightedDelegation(s.valAddressToString(valAddr2), math.LegacyNewDecWithPrec(667, 3)),
+			}),
+			expErr: false,
+			expAmounts: []sdk.Coin{
+				sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(34)),
+				sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(66)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.MultiDelegate(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tc.expAmounts, res.Amounts)
+
+			delegation, err := keeper.Delegations.Get(ctx, collections.Join(Addr, valAddr2))
+			require.NoError(err)
+			require.Equal(math.LegacyNewDec(66), delegation.Shares)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgBeginRedelegate() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
@@ -54,3 +54,23 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
 	return params.MinCommissionRate, err
 }
+
+// MinSelfDelegation - Chain-wide minimum validator self delegation
+func (k Keeper) MinSelfDelegation(ctx context.Context) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if params.MinSelfDelegation.IsNil() {
+		return math.ZeroInt(), nil
+	}
+
+	return params.MinSelfDelegation, nil
+}
+
+// MinSelfDelegationGracePeriod - Time a validator may self-delegate less than the chain-wide minimum before being jailed
+func (k Keeper) MinSelfDelegationGracePeriod(ctx context.Context) (time.Duration, error) {
+	params, err := k.Params.Get(ctx)
+	return params.MinSelfDelegationGracePeriod, err
+}
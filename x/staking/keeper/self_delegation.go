@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// getSelfDelegation returns the amount of tokens self-delegated by a validator.
+func (k Keeper) getSelfDelegation(ctx context.Context, validator types.Validator) (math.Int, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(valAddr), sdk.ValAddress(valAddr)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	} else if err != nil {
+		return math.Int{}, err
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt(), nil
+}
+
+// updateSelfDelegationShortfall records the validator if it self-delegates less
+// than the min_self_delegation param, and stops tracking it otherwise. It must be
+// called whenever the self-delegation of the validator or the param changes.
+func (k Keeper) updateSelfDelegationShortfall(ctx context.Context, valAddr sdk.ValAddress) error {
+	minSelfDelegation, err := k.MinSelfDelegation(ctx)
+	if err != nil {
+		return err
+	}
+
+	if minSelfDelegation.IsZero() {
+		return k.SelfDelegationShortfalls.Remove(ctx, valAddr)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		return k.SelfDelegationShortfalls.Remove(ctx, valAddr)
+	} else if err != nil {
+		return err
+	}
+
+	if validator.Jailed {
+		return k.SelfDelegationShortfalls.Remove(ctx, valAddr)
+	}
+
+	selfDelegation, err := k.getSelfDelegation(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	if selfDelegation.GTE(minSelfDelegation) {
+		return k.SelfDelegationShortfalls.Remove(ctx, valAddr)
+	}
+
+	has, err := k.SelfDelegationShortfalls.Has(ctx, valAddr)
+	if err != nil || has {
+		return err
+	}
+
+	gracePeriod, err := k.MinSelfDelegationGracePeriod(ctx)
+	if err != nil {
+		return err
+	}
+
+	since := k.HeaderService.HeaderInfo(ctx).Time
+	if err := k.SelfDelegationShortfalls.Set(ctx, valAddr, since); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSelfDelegationShortfall,
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeySelfDelegation, selfDelegation.String()),
+		event.NewAttribute(types.AttributeKeyJailTime, since.Add(gracePeriod).Format(time.RFC3339)),
+	)
+}
+
+// jailSelfDelegationShortfalls jails the recorded validators that have been
+// self-delegating less than the min_self_delegation param for longer than the
+// min_self_delegation_grace_period param.
+func (k Keeper) jailSelfDelegationShortfalls(ctx context.Context) error {
+	gracePeriod, err := k.MinSelfDelegationGracePeriod(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+	var expired []sdk.ValAddress
+	if err := k.SelfDelegationShortfalls.Walk(ctx, nil, func(valAddr sdk.ValAddress, since time.Time) (bool, error) {
+		if !blockTime.Before(since.Add(gracePeriod)) {
+			expired = append(expired, valAddr)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	minSelfDelegation, err := k.MinSelfDelegation(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, valAddr := range expired {
+		if err := k.SelfDelegationShortfalls.Remove(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		// the validator may have been jailed by an infraction in the meantime
+		if validator.Jailed {
+			continue
+		}
+
+		selfDelegation, err := k.getSelfDelegation(ctx, validator)
+		if err != nil {
+			return err
+		}
+
+		if selfDelegation.GTE(minSelfDelegation) {
+			continue
+		}
+
+		if err := k.jailValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		k.Logger.Info("validator jailed for self-delegating less than the minimum", "validator", validator.GetOperator(), "self_delegation", selfDelegation, "min_self_delegation", minSelfDelegation)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeMinSelfDelegationJail,
+			event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			event.NewAttribute(types.AttributeKeySelfDelegation, selfDelegation.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
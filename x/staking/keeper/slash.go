@@ -7,6 +7,7 @@ import (
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/types"
 
@@ -180,6 +181,10 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		return math.NewInt(0), err
 	}
 
+	if err := k.updateSelfDelegationShortfall(ctx, operatorAddress); err != nil {
+		return math.NewInt(0), err
+	}
+
 	switch validator.GetStatus() {
 	case sdk.Bonded:
 		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
@@ -227,6 +232,20 @@ func (k Keeper) Unjail(ctx context.Context, consAddr sdk.ConsAddress) error {
 	if err != nil {
 		return fmt.Errorf("validator with consensus-Address %s not found", consAddr)
 	}
+
+	// a validator self-delegating less than the chain minimum would be jailed again
+	minSelfDelegation, err := k.MinSelfDelegation(ctx)
+	if err != nil {
+		return err
+	}
+	selfDelegation, err := k.getSelfDelegation(ctx, validator)
+	if err != nil {
+		return err
+	}
+	if selfDelegation.LT(minSelfDelegation) {
+		return errorsmod.Wrapf(types.ErrSelfDelegationBelowMinimum, "cannot unjail validator self-delegating %s, less than the chain minimum of %s", selfDelegation, minSelfDelegation)
+	}
+
 	if err := k.unjailValidator(ctx, validator); err != nil {
 		return err
 	}
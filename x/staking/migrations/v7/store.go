@@ -0,0 +1,35 @@
+package v7
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7.
+// It sets the minimum self delegation params to their default values.
+func MigrateStore(ctx context.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.MinSelfDelegation = types.DefaultMinSelfDelegation
+	params.MinSelfDelegationGracePeriod = types.DefaultMinSelfDelegationGracePeriod
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,37 @@
+package v7_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	v7 "cosmossdk.io/x/staking/migrations/v7"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	storeKey := storetypes.NewKVStoreKey("staking")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// non-default minimum self delegation params are reset by the migration
+	params := stakingtypes.DefaultParams()
+	params.MinSelfDelegation = stakingtypes.DefaultMinSelfDelegation.AddRaw(1)
+	params.MinSelfDelegationGracePeriod = time.Hour
+	store.Set(v7.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v7.MigrateStore(ctx, store, cdc))
+
+	var migrated stakingtypes.Params
+	cdc.MustUnmarshal(store.Get(v7.ParamsKey), &migrated)
+	require.Equal(t, stakingtypes.DefaultMinSelfDelegation, migrated.MinSelfDelegation)
+	require.Equal(t, stakingtypes.DefaultMinSelfDelegationGracePeriod, migrated.MinSelfDelegationGracePeriod)
+	require.Equal(t, params.UnbondingTime, migrated.UnbondingTime)
+}
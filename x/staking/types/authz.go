@@ -14,10 +14,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// TODO: Revisit this once we have proper gas fee framework.
-// Tracking issues https://github.com/cosmos/cosmos-sdk/issues/9054, https://github.com/cosmos/cosmos-sdk/discussions/9072
-const gasCostPerIteration = uint64(10)
-
 // NewStakeAuthorization creates a new StakeAuthorization object.
 func NewStakeAuthorization(allowed, denied []sdk.ValAddress, authzType AuthorizationType, amount *sdk.Coin, valAddressCodec address.Codec) (*StakeAuthorization, error) {
 	allowedValidators, deniedValidators, err := validateAllowAndDenyValidators(allowed, denied, valAddressCodec)
@@ -109,7 +105,7 @@ func (a StakeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Acce
 	isValidatorExists := false
 	allowedList := a.GetAllowList().GetAddress()
 	for _, validator := range allowedList {
-		if err := authzEnv.GasService.GasMeter(ctx).Consume(gasCostPerIteration, "stake authorization"); err != nil {
+		if err := authzEnv.GasService.GasMeter(ctx).Consume(authz.GasCostPerIteration, "stake authorization"); err != nil {
 			return authz.AcceptResponse{}, err
 		}
 
@@ -121,7 +117,7 @@ func (a StakeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Acce
 
 	denyList := a.GetDenyList().GetAddress()
 	for _, validator := range denyList {
-		if err := authzEnv.GasService.GasMeter(ctx).Consume(gasCostPerIteration, "stake authorization"); err != nil {
+		if err := authzEnv.GasService.GasMeter(ctx).Consume(authz.GasCostPerIteration, "stake authorization"); err != nil {
 			return authz.AcceptResponse{}, err
 		}
 
@@ -50,4 +50,8 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// multi delegation errors
+	ErrInvalidDelegationWeights = errors.Register(ModuleName, 48, "invalid delegation weights")
+	ErrTinyDelegationAmount     = errors.Register(ModuleName, 49, "too few tokens to delegate (truncates to zero tokens)")
 )
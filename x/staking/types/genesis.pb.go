@@ -53,6 +53,9 @@ type GenesisState struct {
 	RotationIndexRecords []RotationIndexRecord       `protobuf:"bytes,9,rep,name=rotation_index_records,json=rotationIndexRecords,proto3" json:"rotation_index_records"`
 	RotationHistory      []ConsPubKeyRotationHistory `protobuf:"bytes,10,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 	RotationQueue        []RotationQueueRecord       `protobuf:"bytes,11,rep,name=rotation_queue,json=rotationQueue,proto3" json:"rotation_queue"`
+	// self_delegation_shortfalls defines the bonded validators self-delegating less
+	// than the min_self_delegation param at genesis.
+	SelfDelegationShortfalls []SelfDelegationShortfall `protobuf:"bytes,12,rep,name=self_delegation_shortfalls,json=selfDelegationShortfalls,proto3" json:"self_delegation_shortfalls"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,6 +161,13 @@ func (m *GenesisState) GetRotationQueue() []RotationQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetSelfDelegationShortfalls() []SelfDelegationShortfall {
+	if m != nil {
+		return m.SelfDelegationShortfalls
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -289,11 +299,68 @@ func (m *RotationQueueRecord) GetTime() *time.Time {
 	return nil
 }
 
+// SelfDelegationShortfall records since when a bonded validator self-delegates
+// less than the min_self_delegation param.
+type SelfDelegationShortfall struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// since is the block time at which the shortfall was first recorded.
+	Since time.Time `protobuf:"bytes,2,opt,name=since,proto3,stdtime" json:"since"`
+}
+
+func (m *SelfDelegationShortfall) Reset()         { *m = SelfDelegationShortfall{} }
+func (m *SelfDelegationShortfall) String() string { return proto.CompactTextString(m) }
+func (*SelfDelegationShortfall) ProtoMessage()    {}
+func (*SelfDelegationShortfall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{4}
+}
+func (m *SelfDelegationShortfall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelfDelegationShortfall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelfDelegationShortfall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SelfDelegationShortfall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelfDelegationShortfall.Merge(m, src)
+}
+func (m *SelfDelegationShortfall) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelfDelegationShortfall) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelfDelegationShortfall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelfDelegationShortfall proto.InternalMessageInfo
+
+func (m *SelfDelegationShortfall) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SelfDelegationShortfall) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
 	proto.RegisterType((*RotationIndexRecord)(nil), "cosmos.staking.v1beta1.RotationIndexRecord")
 	proto.RegisterType((*RotationQueueRecord)(nil), "cosmos.staking.v1beta1.RotationQueueRecord")
+	proto.RegisterType((*SelfDelegationShortfall)(nil), "cosmos.staking.v1beta1.SelfDelegationShortfall")
 }
 
 func init() {
@@ -301,52 +368,59 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xfc, 0x29, 0xed, 0x14, 0xf8, 0xf1, 0x1b, 0x0a, 0x59, 0x1b, 0xd3, 0xd6, 0x86,
-	0x43, 0x83, 0x61, 0xd7, 0xa2, 0xf1, 0xe0, 0x8d, 0x6a, 0xa2, 0x04, 0x22, 0xb8, 0x80, 0x07, 0x12,
-	0xb3, 0x99, 0xb2, 0xc3, 0xb2, 0x61, 0xbb, 0x53, 0x67, 0xa6, 0x95, 0xbe, 0x03, 0x8f, 0xdc, 0xbd,
-	0x70, 0xf4, 0xe8, 0x81, 0x17, 0xc1, 0xc5, 0x84, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x41, 0x5f, 0x86,
-	0xd9, 0x99, 0xdd, 0x65, 0x9a, 0xb2, 0x44, 0x2f, 0x4d, 0xa7, 0xcf, 0xf7, 0xfb, 0xf9, 0x3e, 0xcf,
-	0x76, 0xe7, 0x01, 0x0b, 0x7b, 0x84, 0xb5, 0x09, 0x33, 0x19, 0x47, 0x87, 0x5e, 0xe0, 0x9a, 0xbd,
-	0x46, 0x0b, 0x73, 0xd4, 0x30, 0x5d, 0x1c, 0x60, 0xe6, 0x31, 0xa3, 0x43, 0x09, 0x27, 0x70, 0x5e,
-	0xaa, 0x8c, 0x48, 0x65, 0x44, 0xaa, 0x52, 0xd1, 0x25, 0x2e, 0x11, 0x12, 0x33, 0xfc, 0x26, 0xd5,
-	0xa5, 0x34, 0x66, 0xec, 0x96, 0xaa, 0x7b, 0x52, 0x65, 0x4b, 0x7b, 0x14, 0x20, 0x4b, 0xff, 0xa3,
-	0xb6, 0x17, 0x10, 0x53, 0x7c, 0x46, 0x3f, 0x55, 0x5c, 0x42, 0x5c, 0x1f, 0x9b, 0xe2, 0xd4, 0xea,
-	0xee, 0x9b, 0xdc, 0x6b, 0x63, 0xc6, 0x51, 0xbb, 0x23, 0x05, 0xb5, 0xaf, 0x13, 0x60, 0xf2, 0xa5,
-	0x6c, 0x7a, 0x8b, 0x23, 0x8e, 0xe1, 0x0a, 0xc8, 0x76, 0x10, 0x45, 0x6d, 0xa6, 0x6b, 0x55, 0xad,
-	0x5e, 0x58, 0x2e, 0x1b, 0xb7, 0x0f, 0x61, 0x6c, 0x0a, 0x55, 0x33, 0x7f, 0x76, 0x59, 0xc9, 0x7c,
-	0xfe, 0xf5, 0x65, 0x51, 0xb3, 0x22, 0x23, 0xdc, 0x05, 0x33, 0x3e, 0x62, 0xdc, 0xe6, 0x84, 0x23,
-	0xdf, 0xee, 0x90, 0x0f, 0x98, 0xea, 0x23, 0x55, 0xad, 0x3e, 0xd9, 0x7c, 0x14, 0x8a, 0xbf, 0x5f,
-	0x56, 0xe6, 0x24, 0x93, 0x39, 0x87, 0x86, 0x47, 0xcc, 0x36, 0xe2, 0x07, 0xc6, 0x6a, 0xc0, 0x2f,
-	0x4e, 0x97, 0x40, 0x14, 0xb6, 0x1a, 0x70, 0xc9, 0x9c, 0x0e, 0x49, 0xdb, 0x21, 0x68, 0x33, 0xe4,
-	0x40, 0x0f, 0xcc, 0x09, 0x76, 0x0f, 0xf9, 0x9e, 0x83, 0x38, 0xa1, 0x92, 0xcf, 0xf4, 0xd1, 0xea,
-	0x68, 0xbd, 0xb0, 0xbc, 0x98, 0xd6, 0xed, 0x3a, 0x62, 0xfc, 0x6d, 0xec, 0x11, 0x28, 0xb5, 0xf3,
-	0x59, 0x7f, 0xa8, 0xcc, 0xe0, 0x3a, 0x00, 0x49, 0x0a, 0xd3, 0xc7, 0x04, 0xff, 0x41, 0x1a, 0x3f,
-	0x31, 0xab, 0x58, 0xc5, 0x0f, 0x37, 0x40, 0xc1, 0xc1, 0x3e, 0x76, 0x11, 0xf7, 0x48, 0xc0, 0xf4,
-	0x71, 0x81, 0xab, 0xa5, 0xe1, 0x5e, 0x24, 0x52, 0x95, 0xa7, 0x12, 0xe0, 0x21, 0x98, 0xeb, 0x06,
-	0x2d, 0x12, 0x38, 0x5e, 0xe0, 0xda, 0x2a, 0x3a, 0x2b, 0xd0, 0x0f, 0xd3, 0xd0, 0x3b, 0xb1, 0xe9,
-	0xf6, 0x8c, 0x62, 0x77, 0xb8, 0xce, 0xe0, 0x0e, 0x98, 0xa2, 0x58, 0x0d, 0x99, 0x10, 0x21, 0x0b,
-	0x69, 0x21, 0x96, 0x22, 0x56, 0xe9, 0x83, 0x14, 0x58, 0x02, 0x39, 0x7c, 0xd4, 0x21, 0x94, 0x63,
-	0x47, 0xcf, 0x55, 0xb5, 0x7a, 0xce, 0x4a, 0xce, 0xd0, 0x07, 0xf3, 0x94, 0x70, 0x21, 0xb4, 0xbd,
-	0xc0, 0xc1, 0x47, 0x36, 0xc5, 0x7b, 0x84, 0x3a, 0x4c, 0xcf, 0xdf, 0x3d, 0xa0, 0x15, 0xb9, 0x56,
-	0x43, 0x93, 0x25, 0x3c, 0x03, 0x03, 0xd2, 0xe1, 0x3a, 0x83, 0x2e, 0x98, 0x49, 0xd2, 0x0e, 0x3c,
-	0xc6, 0x09, 0xed, 0xeb, 0x40, 0xe4, 0x34, 0xd2, 0x72, 0x9e, 0x93, 0x80, 0x6d, 0x76, 0x5b, 0x6b,
-	0xb8, 0x1f, 0x27, 0xbe, 0x92, 0x46, 0x35, 0xed, 0x3f, 0x3a, 0x58, 0x83, 0xef, 0xc0, 0x74, 0x12,
-	0xf4, 0xbe, 0x8b, 0xbb, 0x58, 0x2f, 0xfc, 0xdd, 0x38, 0x6f, 0x42, 0xf1, 0xf0, 0x38, 0x53, 0x54,
-	0xad, 0xd7, 0x0e, 0x00, 0x1c, 0x7e, 0xd5, 0xe1, 0x32, 0x98, 0x40, 0x8e, 0x43, 0x31, 0x93, 0xb7,
-	0x3a, 0xdf, 0xd4, 0x2f, 0x4e, 0x97, 0x8a, 0x51, 0xe0, 0x8a, 0xac, 0x6c, 0x71, 0xea, 0x05, 0xae,
-	0x15, 0x0b, 0x61, 0x11, 0x8c, 0xdf, 0x5c, 0xdd, 0x51, 0x4b, 0x1e, 0x9e, 0xe5, 0x3e, 0x9e, 0x54,
-	0x32, 0xbf, 0x4f, 0x2a, 0x99, 0x1a, 0x01, 0xb3, 0xb7, 0x3c, 0x69, 0xa8, 0x0f, 0x46, 0x4d, 0xde,
-	0x00, 0x9f, 0x80, 0xb1, 0x70, 0xfb, 0xe8, 0x59, 0xb1, 0x57, 0x4a, 0x86, 0x5c, 0x4d, 0x46, 0xbc,
-	0x9a, 0x8c, 0xed, 0x78, 0x35, 0x35, 0xc7, 0x8e, 0x7f, 0x54, 0x34, 0x4b, 0xa8, 0x95, 0xc0, 0x4f,
-	0xda, 0x4d, 0xa2, 0xf2, 0x30, 0xe0, 0x6b, 0x90, 0xef, 0x21, 0xdf, 0x0e, 0x63, 0xe2, 0xa5, 0xd5,
-	0xb8, 0xe3, 0x9a, 0x86, 0xe3, 0xb2, 0x8d, 0x7d, 0x41, 0xc2, 0x4e, 0xf8, 0x27, 0xae, 0xe1, 0x3e,
-	0xb3, 0x72, 0xbd, 0xa8, 0x94, 0xf4, 0x39, 0xf2, 0x2f, 0x7d, 0x36, 0x9f, 0x9e, 0x5d, 0x95, 0xb5,
-	0xf3, 0xab, 0xb2, 0xf6, 0xf3, 0xaa, 0xac, 0x1d, 0x5f, 0x97, 0x33, 0xe7, 0xd7, 0xe5, 0xcc, 0xb7,
-	0xeb, 0x72, 0x66, 0xf7, 0xfe, 0xc0, 0xb2, 0x3b, 0x4a, 0xf6, 0x3b, 0xef, 0x77, 0x30, 0x6b, 0x65,
-	0x05, 0xf7, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7b, 0xa0, 0x65, 0x52, 0x06, 0x00,
-	0x00,
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x6d, 0x9b, 0x4d, 0x26, 0xe9, 0x92, 0x9d, 0x4d, 0x17, 0x13, 0xb1, 0x49, 0x36,
+	0xda, 0x43, 0xb4, 0x28, 0x76, 0x13, 0x10, 0x87, 0xbd, 0xa0, 0x06, 0x24, 0xa8, 0x5a, 0xb5, 0xc5,
+	0x69, 0x39, 0x54, 0x42, 0xd6, 0xa4, 0x9e, 0x38, 0x56, 0x1d, 0x4f, 0x98, 0x99, 0x84, 0xe6, 0x1b,
+	0x70, 0xe0, 0xd0, 0x3b, 0x97, 0x1e, 0x39, 0x72, 0xc8, 0x07, 0xe0, 0xd8, 0x63, 0x95, 0x13, 0x42,
+	0xa8, 0xa0, 0xf6, 0x00, 0x1f, 0x03, 0x79, 0xc6, 0x76, 0x1c, 0x12, 0x57, 0xe5, 0x52, 0xd5, 0x7e,
+	0xff, 0xff, 0xef, 0xff, 0x46, 0x6f, 0xfc, 0x02, 0xde, 0x9c, 0x11, 0x36, 0x20, 0x4c, 0x67, 0x1c,
+	0x9d, 0x3b, 0x9e, 0xad, 0x8f, 0x9b, 0x5d, 0xcc, 0x51, 0x53, 0xb7, 0xb1, 0x87, 0x99, 0xc3, 0xb4,
+	0x21, 0x25, 0x9c, 0xc0, 0x97, 0x52, 0xa5, 0x05, 0x2a, 0x2d, 0x50, 0x95, 0x8a, 0x36, 0xb1, 0x89,
+	0x90, 0xe8, 0xfe, 0x7f, 0x52, 0x5d, 0x4a, 0x62, 0x86, 0x6e, 0xa9, 0xfa, 0x40, 0xaa, 0x4c, 0x69,
+	0x0f, 0x02, 0x64, 0xe9, 0x39, 0x1a, 0x38, 0x1e, 0xd1, 0xc5, 0xdf, 0xe0, 0x55, 0xc5, 0x26, 0xc4,
+	0x76, 0xb1, 0x2e, 0x9e, 0xba, 0xa3, 0x9e, 0xce, 0x9d, 0x01, 0x66, 0x1c, 0x0d, 0x86, 0x52, 0x50,
+	0xfb, 0x23, 0x03, 0xf2, 0x5f, 0xca, 0xa6, 0x3b, 0x1c, 0x71, 0x0c, 0x77, 0x40, 0x7a, 0x88, 0x28,
+	0x1a, 0x30, 0x55, 0xa9, 0x2a, 0xf5, 0x5c, 0xab, 0xac, 0xad, 0x3e, 0x84, 0x76, 0x24, 0x54, 0xed,
+	0xec, 0xf5, 0x6d, 0x25, 0xf5, 0xf3, 0xdf, 0xbf, 0xbc, 0x55, 0x8c, 0xc0, 0x08, 0x4f, 0x41, 0xc1,
+	0x45, 0x8c, 0x9b, 0x9c, 0x70, 0xe4, 0x9a, 0x43, 0xf2, 0x3d, 0xa6, 0xea, 0x93, 0xaa, 0x52, 0xcf,
+	0xb7, 0xb7, 0x7d, 0xf1, 0xef, 0xb7, 0x95, 0x2d, 0xc9, 0x64, 0xd6, 0xb9, 0xe6, 0x10, 0x7d, 0x80,
+	0x78, 0x5f, 0xdb, 0xf5, 0xf8, 0x6c, 0xda, 0x00, 0x41, 0xd8, 0xae, 0xc7, 0x25, 0xf3, 0x99, 0x4f,
+	0x3a, 0xf6, 0x41, 0x47, 0x3e, 0x07, 0x3a, 0x60, 0x4b, 0xb0, 0xc7, 0xc8, 0x75, 0x2c, 0xc4, 0x09,
+	0x95, 0x7c, 0xa6, 0xae, 0x55, 0xd7, 0xea, 0xb9, 0xd6, 0xdb, 0xa4, 0x6e, 0xf7, 0x11, 0xe3, 0xdf,
+	0x84, 0x1e, 0x81, 0x8a, 0x77, 0xfe, 0xc2, 0x5d, 0x2a, 0x33, 0xb8, 0x0f, 0x40, 0x94, 0xc2, 0xd4,
+	0x75, 0xc1, 0x7f, 0x9d, 0xc4, 0x8f, 0xcc, 0x71, 0x6c, 0xcc, 0x0f, 0x0f, 0x41, 0xce, 0xc2, 0x2e,
+	0xb6, 0x11, 0x77, 0x88, 0xc7, 0xd4, 0x0d, 0x81, 0xab, 0x25, 0xe1, 0xbe, 0x88, 0xa4, 0x71, 0x5e,
+	0x9c, 0x00, 0xcf, 0xc1, 0xd6, 0xc8, 0xeb, 0x12, 0xcf, 0x72, 0x3c, 0xdb, 0x8c, 0xa3, 0xd3, 0x02,
+	0xfd, 0x51, 0x12, 0xfa, 0x24, 0x34, 0xad, 0xce, 0x28, 0x8e, 0x96, 0xeb, 0x0c, 0x9e, 0x80, 0x4d,
+	0x8a, 0xe3, 0x21, 0x4f, 0x45, 0xc8, 0x9b, 0xa4, 0x10, 0x03, 0x5b, 0x2b, 0xe9, 0x8b, 0x14, 0x58,
+	0x02, 0x19, 0x7c, 0x31, 0x24, 0x94, 0x63, 0x4b, 0xcd, 0x54, 0x95, 0x7a, 0xc6, 0x88, 0x9e, 0xa1,
+	0x0b, 0x5e, 0x52, 0xc2, 0x85, 0xd0, 0x74, 0x3c, 0x0b, 0x5f, 0x98, 0x14, 0x9f, 0x11, 0x6a, 0x31,
+	0x35, 0xfb, 0xf0, 0x01, 0x8d, 0xc0, 0xb5, 0xeb, 0x9b, 0x0c, 0xe1, 0x59, 0x38, 0x20, 0x5d, 0xae,
+	0x33, 0x68, 0x83, 0x42, 0x94, 0xd6, 0x77, 0x18, 0x27, 0x74, 0xa2, 0x02, 0x91, 0xd3, 0x4c, 0xca,
+	0xf9, 0x9c, 0x78, 0xec, 0x68, 0xd4, 0xdd, 0xc3, 0x93, 0x30, 0xf1, 0x2b, 0x69, 0x8c, 0xa7, 0xbd,
+	0x47, 0x17, 0x6b, 0xf0, 0x5b, 0xf0, 0x2c, 0x0a, 0xfa, 0x6e, 0x84, 0x47, 0x58, 0xcd, 0x3d, 0xee,
+	0x38, 0x5f, 0xfb, 0xe2, 0xe5, 0xe3, 0x6c, 0xd2, 0x78, 0x1d, 0xfe, 0xa8, 0x80, 0x12, 0xc3, 0x6e,
+	0x2f, 0x76, 0x23, 0x4c, 0xd6, 0x27, 0x94, 0xf7, 0x90, 0xeb, 0x32, 0x35, 0x2f, 0xb2, 0xf4, 0xa4,
+	0xac, 0x0e, 0x76, 0x7b, 0xf3, 0xb1, 0x77, 0x42, 0x5f, 0xfb, 0x95, 0xf8, 0x6e, 0xa7, 0x8d, 0xc2,
+	0x45, 0xb8, 0x8d, 0xaa, 0xe3, 0x6d, 0xad, 0xa5, 0x6d, 0xcb, 0x1e, 0x54, 0xb6, 0xda, 0xc7, 0x6a,
+	0x7d, 0x00, 0x97, 0xbf, 0x3c, 0xd8, 0x02, 0x4f, 0x91, 0x65, 0x51, 0xcc, 0xe4, 0x92, 0xc9, 0xb6,
+	0xd5, 0xd9, 0xb4, 0x51, 0x0c, 0x7a, 0xda, 0x91, 0x95, 0x0e, 0xa7, 0x8e, 0x67, 0x1b, 0xa1, 0x10,
+	0x16, 0xc1, 0xc6, 0x7c, 0x93, 0xac, 0x19, 0xf2, 0xe1, 0x5d, 0xe6, 0x87, 0xab, 0x4a, 0xea, 0x9f,
+	0xab, 0x4a, 0xaa, 0x46, 0xc0, 0x8b, 0x15, 0x83, 0x87, 0xea, 0x62, 0x54, 0x7e, 0x0e, 0xfc, 0x04,
+	0xac, 0xfb, 0xcb, 0x50, 0x4d, 0x8b, 0x35, 0x57, 0xd2, 0xe4, 0xa6, 0xd4, 0xc2, 0x4d, 0xa9, 0x1d,
+	0x87, 0x9b, 0xb2, 0xbd, 0x7e, 0xf9, 0x67, 0x45, 0x31, 0x84, 0x3a, 0x16, 0xf8, 0x93, 0x32, 0x4f,
+	0x8c, 0xcd, 0x06, 0x1e, 0x80, 0xec, 0x18, 0xb9, 0xa6, 0x1f, 0x13, 0xee, 0xd0, 0xe6, 0x03, 0x5b,
+	0xc3, 0x3f, 0x2e, 0x3b, 0xec, 0x09, 0x12, 0xb6, 0xfc, 0x3b, 0xb5, 0x87, 0x27, 0xcc, 0xc8, 0x8c,
+	0x83, 0x52, 0xd4, 0xe7, 0x93, 0xff, 0xd3, 0x67, 0xed, 0x57, 0x05, 0xbc, 0x9f, 0x30, 0x4d, 0x78,
+	0x00, 0x9e, 0xcf, 0xd7, 0xe7, 0xe2, 0x20, 0x5e, 0xcf, 0xa6, 0x8d, 0x57, 0x41, 0xb3, 0xd1, 0xd0,
+	0x16, 0x27, 0x52, 0x18, 0xff, 0xe7, 0x3d, 0xfc, 0x0c, 0x6c, 0x30, 0xc7, 0x3b, 0x7b, 0x4c, 0x8b,
+	0x9b, 0xfe, 0x45, 0xf2, 0xdb, 0x94, 0x17, 0x47, 0xfa, 0xde, 0x15, 0x67, 0x2b, 0xae, 0x56, 0xfb,
+	0xd3, 0xeb, 0xbb, 0xb2, 0x72, 0x73, 0x57, 0x56, 0xfe, 0xba, 0x2b, 0x2b, 0x97, 0xf7, 0xe5, 0xd4,
+	0xcd, 0x7d, 0x39, 0xf5, 0xdb, 0x7d, 0x39, 0x75, 0xfa, 0xe1, 0xc2, 0xcf, 0x47, 0x64, 0xd4, 0xf9,
+	0x64, 0x88, 0x59, 0x37, 0x2d, 0x72, 0x3f, 0xfe, 0x77, 0x00, 0x11, 0x2c, 0xb7, 0x3e, 0xa4, 0x07,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +443,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SelfDelegationShortfalls) > 0 {
+		for iNdEx := len(m.SelfDelegationShortfalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelfDelegationShortfalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RotationQueue) > 0 {
 		for iNdEx := len(m.RotationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -634,6 +722,44 @@ func (m *RotationQueueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SelfDelegationShortfall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelfDelegationShortfall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelfDelegationShortfall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -706,6 +832,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SelfDelegationShortfalls) > 0 {
+		for _, e := range m.SelfDelegationShortfalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -759,6 +891,21 @@ func (m *RotationQueueRecord) Size() (n int) {
 	return n
 }
 
+func (m *SelfDelegationShortfall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1152,6 +1299,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegationShortfalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfDelegationShortfalls = append(m.SelfDelegationShortfalls, SelfDelegationShortfall{})
+			if err := m.SelfDelegationShortfalls[len(m.SelfDelegationShortfalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1516,6 +1697,121 @@ func (m *RotationQueueRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SelfDelegationShortfall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelfDelegationShortfall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelfDelegationShortfall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
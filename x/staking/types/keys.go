@@ -67,6 +67,8 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	SelfDelegationShortfallsKey = collections.NewPrefix(107) // prefix for the bonded validators self-delegating less than the minimum
 )
 
 // Reserved kvstore keys
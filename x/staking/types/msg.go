@@ -19,6 +19,7 @@ var (
 	_ gogoprotoany.UnpackInterfacesMessage = (*MsgCreateValidator)(nil)
 	_ coretransaction.Msg                  = &MsgEditValidator{}
 	_ coretransaction.Msg                  = &MsgDelegate{}
+	_ coretransaction.Msg                  = &MsgMultiDelegate{}
 	_ coretransaction.Msg                  = &MsgUndelegate{}
 	_ coretransaction.Msg                  = &MsgBeginRedelegate{}
 	_ coretransaction.Msg                  = &MsgCancelUnbondingDelegation{}
@@ -115,6 +116,23 @@ func NewMsgDelegate(delAddr, valAddr string, amount sdk.Coin) *MsgDelegate {
 	}
 }
 
+// NewMsgMultiDelegate creates a new MsgMultiDelegate instance.
+func NewMsgMultiDelegate(delAddr string, amount sdk.Coin, delegations []WeightedDelegation) *MsgMultiDelegate {
+	return &MsgMultiDelegate{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+		Delegations:      delegations,
+	}
+}
+
+// NewWeightedDelegation creates a new WeightedDelegation instance.
+func NewWeightedDelegation(valAddr string, weight math.LegacyDec) WeightedDelegation {
+	return WeightedDelegation{
+		ValidatorAddress: valAddr,
+		Weight:           weight,
+	}
+}
+
 // NewMsgBeginRedelegate creates a new MsgBeginRedelegate instance.
 func NewMsgBeginRedelegate(
 	delAddr, valSrcAddr, valDstAddr string, amount sdk.Coin,
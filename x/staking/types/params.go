@@ -24,6 +24,10 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint32 = 7
+
+	// DefaultMinSelfDelegationGracePeriod reflects one week as the default
+	// duration a bonded validator can self-delegate less than the minimum.
+	DefaultMinSelfDelegationGracePeriod time.Duration = time.Hour * 24 * 7
 )
 
 var (
@@ -32,6 +36,9 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultMinSelfDelegation is set to 0, which disables the chain-wide minimum self delegation
+	DefaultMinSelfDelegation = math.ZeroInt()
 )
 
 // NewParams creates a new Params instance
@@ -39,15 +46,18 @@ func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
 	keyRotationFee sdk.Coin,
+	minSelfDelegation math.Int, minSelfDelegationGracePeriod time.Duration,
 ) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: 0,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
-		KeyRotationFee:    keyRotationFee,
+		UnbondingTime:                unbondingTime,
+		MaxValidators:                maxValidators,
+		MaxEntries:                   maxEntries,
+		HistoricalEntries:            0,
+		BondDenom:                    bondDenom,
+		MinCommissionRate:            minCommissionRate,
+		KeyRotationFee:               keyRotationFee,
+		MinSelfDelegation:            minSelfDelegation,
+		MinSelfDelegationGracePeriod: minSelfDelegationGracePeriod,
 	}
 }
 
@@ -60,6 +70,8 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultKeyRotationFee,
+		DefaultMinSelfDelegation,
+		DefaultMinSelfDelegationGracePeriod,
 	)
 }
 
@@ -113,6 +125,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinSelfDelegation(p.MinSelfDelegation); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfDelegationGracePeriod(p.MinSelfDelegationGracePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -228,3 +248,32 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateMinSelfDelegation(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum self delegation cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum self delegation cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegationGracePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("minimum self delegation grace period must not be negative: %d", v)
+	}
+
+	return nil
+}
@@ -46,4 +46,21 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// reset params to default
+	params = types.DefaultParams()
+
+	// validate min self delegation
+	params.MinSelfDelegation = math.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	params.MinSelfDelegation = math.Int{}
+	require.Error(t, params.Validate())
+
+	params.MinSelfDelegation = math.NewInt(1000)
+	params.MinSelfDelegationGracePeriod = -1
+	require.Error(t, params.Validate())
+
+	params.MinSelfDelegationGracePeriod = 0
+	require.NoError(t, params.Validate())
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -12,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1452,6 +1457,184 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryValidatorsBelowMinSelfDelegationRequest is request type for the
+// Query/ValidatorsBelowMinSelfDelegation RPC method.
+type QueryValidatorsBelowMinSelfDelegationRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) Reset() {
+	*m = QueryValidatorsBelowMinSelfDelegationRequest{}
+}
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorsBelowMinSelfDelegationRequest) ProtoMessage() {}
+func (*QueryValidatorsBelowMinSelfDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationRequest.Merge(m, src)
+}
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ValidatorBelowMinSelfDelegation describes a bonded validator self-delegating
+// less than the min_self_delegation param.
+type ValidatorBelowMinSelfDelegation struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// self_delegation is the amount of tokens currently self-delegated by the validator.
+	SelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=self_delegation,json=selfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"self_delegation"`
+	// since is the block time at which the shortfall was first recorded.
+	Since time.Time `protobuf:"bytes,3,opt,name=since,proto3,stdtime" json:"since"`
+	// jail_time is the time after which the validator is jailed if it still
+	// self-delegates less than the min_self_delegation param.
+	JailTime time.Time `protobuf:"bytes,4,opt,name=jail_time,json=jailTime,proto3,stdtime" json:"jail_time"`
+}
+
+func (m *ValidatorBelowMinSelfDelegation) Reset()         { *m = ValidatorBelowMinSelfDelegation{} }
+func (m *ValidatorBelowMinSelfDelegation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBelowMinSelfDelegation) ProtoMessage()    {}
+func (*ValidatorBelowMinSelfDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *ValidatorBelowMinSelfDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBelowMinSelfDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBelowMinSelfDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBelowMinSelfDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBelowMinSelfDelegation.Merge(m, src)
+}
+func (m *ValidatorBelowMinSelfDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBelowMinSelfDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBelowMinSelfDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBelowMinSelfDelegation proto.InternalMessageInfo
+
+func (m *ValidatorBelowMinSelfDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorBelowMinSelfDelegation) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorBelowMinSelfDelegation) GetJailTime() time.Time {
+	if m != nil {
+		return m.JailTime
+	}
+	return time.Time{}
+}
+
+// QueryValidatorsBelowMinSelfDelegationResponse is response type for the
+// Query/ValidatorsBelowMinSelfDelegation RPC method.
+type QueryValidatorsBelowMinSelfDelegationResponse struct {
+	Validators []ValidatorBelowMinSelfDelegation `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) Reset() {
+	*m = QueryValidatorsBelowMinSelfDelegationResponse{}
+}
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorsBelowMinSelfDelegationResponse) ProtoMessage() {}
+func (*QueryValidatorsBelowMinSelfDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{31}
+}
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationResponse.Merge(m, src)
+}
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsBelowMinSelfDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) GetValidators() []ValidatorBelowMinSelfDelegation {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*ValidatorInfo)(nil), "cosmos.staking.v1beta1.ValidatorInfo")
@@ -1482,6 +1665,9 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorsBelowMinSelfDelegationRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsBelowMinSelfDelegationRequest")
+	proto.RegisterType((*ValidatorBelowMinSelfDelegation)(nil), "cosmos.staking.v1beta1.ValidatorBelowMinSelfDelegation")
+	proto.RegisterType((*QueryValidatorsBelowMinSelfDelegationResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsBelowMinSelfDelegationResponse")
 }
 
 func init() {
@@ -1489,99 +1675,114 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x6b, 0x1c, 0x55,
-	0x14, 0xcf, 0xdd, 0xc4, 0x60, 0x4e, 0x69, 0x49, 0xef, 0x6e, 0xe3, 0x76, 0x9a, 0x6e, 0xb6, 0x43,
-	0xad, 0x69, 0x6a, 0x66, 0xda, 0x54, 0xdb, 0x58, 0xa1, 0xed, 0xc6, 0xa2, 0xad, 0x2d, 0x35, 0x5d,
-	0x31, 0x8a, 0x1f, 0x84, 0x49, 0x76, 0x3a, 0x19, 0x9a, 0xcc, 0x6c, 0xe7, 0x4e, 0x42, 0x4b, 0x29,
-	0x82, 0x0f, 0x52, 0x5f, 0x44, 0xf0, 0x5d, 0xfa, 0x28, 0xa2, 0x20, 0x98, 0x0a, 0x22, 0xf6, 0x51,
-	0xfa, 0x20, 0x52, 0x2a, 0x15, 0xf5, 0xa1, 0x4a, 0x23, 0xe8, 0x8b, 0xff, 0x81, 0x88, 0xcc, 0xcc,
-	0x99, 0xaf, 0xcc, 0xe7, 0x6e, 0x76, 0x21, 0x7d, 0x09, 0xd9, 0x3b, 0xf7, 0x9c, 0xf3, 0xfb, 0xfd,
-	0xce, 0x39, 0x77, 0xce, 0xdd, 0x05, 0x7e, 0x5e, 0x67, 0x4b, 0x3a, 0x13, 0x99, 0x29, 0x5d, 0x52,
-	0x35, 0x45, 0x5c, 0x39, 0x34, 0x27, 0x9b, 0xd2, 0x21, 0xf1, 0xf2, 0xb2, 0x6c, 0x5c, 0x15, 0x9a,
-	0x86, 0x6e, 0xea, 0x74, 0xc8, 0xd9, 0x23, 0xe0, 0x1e, 0x01, 0xf7, 0x70, 0x63, 0x68, 0x3b, 0x27,
-	0x31, 0xd9, 0x31, 0xf0, 0xcc, 0x9b, 0x92, 0xa2, 0x6a, 0x92, 0xa9, 0xea, 0x9a, 0xe3, 0x83, 0x2b,
-	0x29, 0xba, 0xa2, 0xdb, 0xff, 0x8a, 0xd6, 0x7f, 0xb8, 0x3a, 0xac, 0xe8, 0xba, 0xb2, 0x28, 0x8b,
-	0x52, 0x53, 0x15, 0x25, 0x4d, 0xd3, 0x4d, 0xdb, 0x84, 0xe1, 0xd3, 0xbd, 0x09, 0xd8, 0x5c, 0x1c,
-	0xce, 0xae, 0x9d, 0xce, 0xae, 0x59, 0xc7, 0x39, 0x42, 0x75, 0x1e, 0xed, 0x42, 0x07, 0x2e, 0xb6,
-	0x20, 0x2b, 0x6e, 0xbb, 0xb4, 0xa4, 0x6a, 0xba, 0x68, 0xff, 0x75, 0x96, 0xf8, 0x2b, 0x30, 0x74,
-	0xc1, 0xda, 0x31, 0x23, 0x2d, 0xaa, 0x0d, 0xc9, 0xd4, 0x0d, 0x56, 0x97, 0x2f, 0x2f, 0xcb, 0xcc,
-	0xa4, 0x43, 0xd0, 0xcf, 0x4c, 0xc9, 0x5c, 0x66, 0x65, 0x52, 0x25, 0xa3, 0x03, 0x75, 0xfc, 0x44,
-	0x5f, 0x04, 0xf0, 0xa9, 0x96, 0x0b, 0x55, 0x32, 0xba, 0x65, 0x62, 0x9f, 0x80, 0x20, 0x2c, 0x5d,
-	0x04, 0x27, 0x24, 0x42, 0x17, 0xa6, 0x25, 0x45, 0x46, 0x9f, 0xf5, 0x80, 0x25, 0xbf, 0x00, 0x5b,
-	0xbd, 0xa0, 0x67, 0xb4, 0x8b, 0x3a, 0xad, 0xc1, 0xf6, 0x79, 0x5d, 0x63, 0xb2, 0xc6, 0x96, 0xd9,
-	0xac, 0xd4, 0x68, 0x18, 0x32, 0xc3, 0xd8, 0x53, 0xa5, 0xdf, 0x56, 0xc7, 0x07, 0xaf, 0xb8, 0x2a,
-	0x54, 0x57, 0x0e, 0x0a, 0x13, 0xc2, 0xc1, 0xfa, 0xa0, 0xb7, 0xbd, 0xe6, 0xec, 0x3e, 0x56, 0xba,
-	0x17, 0xb3, 0x8f, 0xff, 0xa0, 0x00, 0x4f, 0x44, 0x48, 0xb2, 0xa6, 0x65, 0x4c, 0xcf, 0x01, 0xac,
-	0x78, 0xab, 0x65, 0x52, 0xed, 0x1d, 0xdd, 0x32, 0xb1, 0x47, 0x88, 0xcf, 0xbe, 0xe0, 0xd9, 0x4f,
-	0x0d, 0xdc, 0x79, 0x30, 0xd2, 0xf3, 0xe9, 0x5f, 0x5f, 0x8e, 0x91, 0x7a, 0xc0, 0x9e, 0xbe, 0x0e,
-	0xdb, 0xbc, 0x4f, 0xb3, 0xaa, 0x76, 0x51, 0x2f, 0x17, 0x6c, 0x8f, 0x4f, 0x66, 0x7a, 0xb4, 0x14,
-	0x08, 0x7a, 0xdd, 0xba, 0x12, 0xd2, 0xe6, 0xa5, 0x90, 0xe8, 0xbd, 0xb6, 0xe8, 0x4f, 0x65, 0x8a,
-	0xee, 0x70, 0x0c, 0xa9, 0x2e, 0xc1, 0x8e, 0xb0, 0x14, 0x6e, 0xba, 0x4f, 0x07, 0xa1, 0x5b, 0xea,
-	0xa3, 0xf4, 0x7b, 0xee, 0xad, 0x8e, 0xef, 0xc6, 0x40, 0x9e, 0x11, 0xea, 0xfd, 0xaa, 0x69, 0xa8,
-	0x9a, 0x12, 0xc0, 0x6a, 0xad, 0xf3, 0x8d, 0xf5, 0x25, 0xe5, 0x89, 0xfd, 0x32, 0x0c, 0x78, 0x5b,
-	0x6d, 0xf7, 0xad, 0x6a, 0xed, 0x9b, 0xf3, 0xab, 0x04, 0xaa, 0xe1, 0x30, 0xa7, 0xe4, 0x45, 0x59,
-	0x71, 0xba, 0xa9, 0xe3, 0xa4, 0x3a, 0x56, 0xf5, 0xff, 0x10, 0xd8, 0x93, 0x02, 0x1b, 0x85, 0x7a,
-	0x17, 0x4a, 0x0d, 0x6f, 0x79, 0xd6, 0xc0, 0x65, 0xb7, 0x3e, 0xc7, 0x92, 0x34, 0xf3, 0x5d, 0xb9,
-	0x9e, 0xa6, 0xaa, 0x96, 0x78, 0x9f, 0xfd, 0x3e, 0x52, 0x8c, 0x3e, 0x63, 0x8e, 0xa6, 0xc5, 0x46,
-	0xf4, 0xc9, 0xba, 0x7a, 0x2b, 0xb4, 0x5f, 0x6f, 0xdf, 0x11, 0xd8, 0x1f, 0xe6, 0xfb, 0x9a, 0x36,
-	0xa7, 0x6b, 0x0d, 0x55, 0x53, 0x1e, 0x89, 0x7c, 0x3d, 0x20, 0x30, 0x96, 0x07, 0x3f, 0x26, 0x4e,
-	0x81, 0xe2, 0xb2, 0xfb, 0x3c, 0x92, 0xb7, 0x03, 0x49, 0x79, 0x8b, 0x71, 0x19, 0xac, 0x7a, 0xea,
-	0xb9, 0xec, 0x42, 0x82, 0xbe, 0x20, 0xd8, 0xae, 0xc1, 0x02, 0x71, 0xb2, 0x71, 0x02, 0xb6, 0x61,
-	0x6d, 0x84, 0xb3, 0x51, 0xbe, 0xb7, 0x3a, 0x5e, 0xc2, 0x50, 0xeb, 0x92, 0xe0, 0xed, 0xb7, 0x93,
-	0x10, 0x4d, 0x67, 0xa1, 0xbd, 0x74, 0x1e, 0x7b, 0xfc, 0xc6, 0xcd, 0x91, 0x9e, 0xbf, 0x6f, 0x8e,
-	0xf4, 0xf0, 0x2b, 0x78, 0x96, 0x47, 0xeb, 0x99, 0xbe, 0x05, 0xc5, 0x98, 0xae, 0xc1, 0x83, 0xa6,
-	0x85, 0xa6, 0xa9, 0xd3, 0x68, 0x4b, 0xf0, 0x5f, 0x13, 0x18, 0xb1, 0x03, 0xc7, 0x24, 0x6b, 0x53,
-	0x0b, 0x66, 0xe0, 0x39, 0x19, 0x8b, 0x1b, 0x95, 0x3b, 0x0f, 0xfd, 0x4e, 0x8d, 0xa1, 0x58, 0xed,
-	0x56, 0x2a, 0x7a, 0xe1, 0x6f, 0xb9, 0x87, 0xf3, 0x29, 0x97, 0x5e, 0x4c, 0xb3, 0x6f, 0x58, 0xad,
-	0x0e, 0xf5, 0x78, 0x40, 0xab, 0x9f, 0xdd, 0xd3, 0x39, 0x1e, 0x37, 0xaa, 0xb5, 0xd0, 0xb1, 0xd3,
-	0x39, 0x20, 0x5d, 0x77, 0x8f, 0xe1, 0xdb, 0xee, 0x31, 0xec, 0x11, 0x4b, 0x3b, 0x86, 0x37, 0x61,
-	0x66, 0xbc, 0x73, 0x38, 0x83, 0xc0, 0x23, 0x7b, 0x0e, 0xdf, 0x2e, 0xc0, 0x4e, 0x9b, 0x60, 0x5d,
-	0x6e, 0x74, 0x25, 0x23, 0x94, 0x19, 0xf3, 0xb3, 0xb1, 0xa7, 0x4b, 0xb2, 0x93, 0x41, 0x66, 0xcc,
-	0xcf, 0xac, 0x7b, 0xaf, 0xd2, 0x06, 0x33, 0xd7, 0xfb, 0xe9, 0xcd, 0xf2, 0xd3, 0x60, 0xe6, 0x4c,
-	0xca, 0xfb, 0xb9, 0xaf, 0x03, 0x15, 0x72, 0x9f, 0x00, 0x17, 0x27, 0x20, 0x56, 0x84, 0x06, 0x43,
-	0x86, 0x9c, 0xd2, 0xb6, 0x4f, 0x27, 0x15, 0x45, 0xd0, 0x5d, 0x5c, 0xe3, 0xee, 0x30, 0xe4, 0xae,
-	0xb6, 0xee, 0xaa, 0xfb, 0xe2, 0xf1, 0x2a, 0x3f, 0x7a, 0x57, 0xdb, 0x84, 0x0d, 0xfb, 0x4d, 0xe4,
-	0x15, 0xd0, 0xf5, 0xdb, 0x57, 0xc7, 0x24, 0xbf, 0x45, 0xa0, 0x92, 0x80, 0x7d, 0x53, 0xbf, 0xea,
-	0x97, 0x12, 0x2b, 0xa5, 0x2b, 0x57, 0xb0, 0x49, 0x6c, 0xb8, 0xd3, 0x2a, 0x33, 0x75, 0x43, 0x9d,
-	0x97, 0x16, 0xad, 0xbb, 0x6a, 0xe0, 0xfb, 0x83, 0x05, 0x59, 0x55, 0x16, 0x4c, 0x3b, 0x4c, 0x6f,
-	0x1d, 0x3f, 0x1d, 0x2b, 0x94, 0x09, 0x2f, 0xc1, 0xae, 0x58, 0x4b, 0x04, 0x79, 0x1c, 0xfa, 0x16,
-	0x54, 0x66, 0x22, 0xbe, 0x7d, 0x49, 0xf8, 0xc2, 0xd6, 0x53, 0x85, 0x32, 0xa9, 0xdb, 0x76, 0x76,
-	0x08, 0x0a, 0x83, 0x76, 0x88, 0x69, 0x5d, 0x5f, 0x44, 0x48, 0xfc, 0x34, 0x6c, 0x0f, 0xac, 0x61,
-	0xb0, 0xe7, 0xa1, 0xaf, 0xa9, 0xeb, 0x8b, 0x18, 0x6c, 0x38, 0x29, 0x98, 0x65, 0x13, 0xd4, 0xc1,
-	0x36, 0xe2, 0x4b, 0x40, 0x1d, 0x8f, 0x92, 0x21, 0x2d, 0xb9, 0xed, 0xc8, 0xbf, 0x01, 0xc5, 0xd0,
-	0x2a, 0x46, 0xaa, 0x41, 0x7f, 0xd3, 0x5e, 0xc1, 0x58, 0x95, 0xc4, 0x58, 0xf6, 0xae, 0xd0, 0x60,
-	0xe5, 0x18, 0x4e, 0x7c, 0x35, 0x04, 0x8f, 0xd9, 0xae, 0xe9, 0x27, 0x04, 0xc0, 0xef, 0x28, 0x2a,
-	0x24, 0xf9, 0x8a, 0xff, 0x76, 0x87, 0x13, 0x73, 0xef, 0xc7, 0xf9, 0x57, 0xbc, 0x61, 0x01, 0x79,
-	0xef, 0xa7, 0x3f, 0x3f, 0x2e, 0xec, 0xa5, 0xbc, 0x98, 0xf0, 0x3d, 0x55, 0xa0, 0x1b, 0x3f, 0x27,
-	0x30, 0xe0, 0xf9, 0xa1, 0xe3, 0xf9, 0xe2, 0xb9, 0xf0, 0x84, 0xbc, 0xdb, 0x11, 0xdd, 0x49, 0x1f,
-	0xdd, 0xb3, 0xf4, 0x70, 0x36, 0x3a, 0xf1, 0x5a, 0xb8, 0xf9, 0xae, 0xd3, 0x5f, 0x09, 0x94, 0xe2,
-	0xee, 0xe4, 0x74, 0x32, 0x1f, 0x94, 0xe8, 0x18, 0xc5, 0x3d, 0xd7, 0x86, 0x25, 0xf2, 0x39, 0xe7,
-	0xf3, 0xa9, 0xd1, 0x13, 0x6d, 0xf0, 0x11, 0x03, 0xef, 0x40, 0xfa, 0x1f, 0x81, 0xdd, 0xa9, 0xf7,
-	0x57, 0x5a, 0xcb, 0x07, 0x35, 0x65, 0x68, 0xe4, 0xa6, 0x36, 0xe2, 0x02, 0x69, 0xcf, 0xf8, 0xb4,
-	0xcf, 0xd2, 0x33, 0xed, 0xd0, 0xf6, 0xa7, 0xbe, 0xa0, 0x00, 0x3f, 0x10, 0x00, 0x3f, 0x5e, 0x46,
-	0xb3, 0x44, 0xee, 0x75, 0x19, 0xcd, 0x12, 0x9d, 0xeb, 0xf9, 0x77, 0x7c, 0x1e, 0x75, 0x3a, 0xbd,
-	0xc1, 0xf4, 0x89, 0xd7, 0xc2, 0x6f, 0x9a, 0xeb, 0xf4, 0x5f, 0x02, 0xc5, 0x18, 0x1d, 0xe9, 0xd1,
-	0x54, 0x9c, 0xc9, 0x17, 0x57, 0x6e, 0xb2, 0x75, 0x43, 0x64, 0x6a, 0xf8, 0x4c, 0x15, 0x2a, 0x77,
-	0x9a, 0x69, 0x6c, 0x3a, 0xe9, 0x8f, 0x04, 0x4a, 0x71, 0x17, 0xb4, 0x8c, 0x56, 0x4d, 0xb9, 0x8b,
-	0x66, 0xb4, 0x6a, 0xda, 0x6d, 0x90, 0xaf, 0xf9, 0x0a, 0x1c, 0xa1, 0xcf, 0x24, 0x29, 0x90, 0x9a,
-	0x4f, 0xab, 0x3f, 0x53, 0xef, 0x35, 0x19, 0xfd, 0x99, 0xe7, 0x52, 0x97, 0xd1, 0x9f, 0xb9, 0xae,
-	0x55, 0x39, 0xfb, 0xd3, 0xa3, 0x97, 0x33, 0xa1, 0x8c, 0x7e, 0x4f, 0x60, 0x6b, 0x68, 0x6c, 0xa7,
-	0x87, 0x52, 0xd1, 0xc6, 0xdd, 0x91, 0xb8, 0x89, 0x56, 0x4c, 0x90, 0xd0, 0x79, 0x9f, 0xd0, 0x0b,
-	0xb4, 0xd6, 0x0e, 0x21, 0x23, 0x04, 0xfb, 0x3e, 0x81, 0x62, 0xcc, 0xc0, 0x9b, 0xd1, 0x99, 0xc9,
-	0x93, 0x3d, 0x37, 0xd9, 0xba, 0x21, 0x52, 0x3b, 0xeb, 0x53, 0x3b, 0x49, 0x8f, 0xb7, 0x43, 0x2d,
-	0xf0, 0x32, 0x5f, 0x23, 0x40, 0xa3, 0xc1, 0xe8, 0x91, 0x16, 0xd1, 0xb9, 0xac, 0x8e, 0xb6, 0x6c,
-	0x87, 0xa4, 0xde, 0xf6, 0x49, 0x5d, 0xa0, 0xaf, 0x6c, 0x8c, 0x54, 0x74, 0x06, 0xf8, 0x96, 0xc0,
-	0xb6, 0xf0, 0x50, 0x49, 0xd3, 0x8b, 0x2a, 0x76, 0xf2, 0xe5, 0x0e, 0xb7, 0x64, 0x13, 0x9d, 0x60,
-	0x26, 0xe8, 0xc1, 0x24, 0x66, 0x0b, 0x9e, 0xb1, 0xfd, 0xf3, 0x92, 0x78, 0xcd, 0x19, 0xaa, 0xaf,
-	0xdf, 0x28, 0x10, 0xfa, 0x3e, 0x81, 0x3e, 0x6b, 0x4a, 0xa5, 0xa3, 0xa9, 0xf1, 0x03, 0x03, 0x31,
-	0xb7, 0x3f, 0xc7, 0x4e, 0xc4, 0xb7, 0xdf, 0xc7, 0x57, 0xa1, 0xc3, 0x49, 0xf8, 0xac, 0xa1, 0x98,
-	0x7e, 0x48, 0xa0, 0xdf, 0x19, 0x61, 0xe9, 0x58, 0x7a, 0x80, 0xe0, 0xd4, 0xcc, 0x1d, 0xc8, 0xb5,
-	0x17, 0xe1, 0x1c, 0xf0, 0xe1, 0x54, 0x69, 0x25, 0x11, 0x8e, 0x33, 0x48, 0x1f, 0xb9, 0xf3, 0xb0,
-	0x42, 0xee, 0x3e, 0xac, 0x90, 0x3f, 0x1e, 0x56, 0xc8, 0x47, 0x6b, 0x95, 0x9e, 0xbb, 0x6b, 0x95,
-	0x9e, 0x5f, 0xd6, 0x2a, 0x3d, 0x6f, 0x0e, 0x3b, 0x86, 0xac, 0x71, 0x49, 0x50, 0x75, 0xd1, 0xfb,
-	0xe5, 0x50, 0x34, 0xaf, 0x36, 0x65, 0x36, 0xd7, 0x6f, 0xff, 0x46, 0x7a, 0xf8, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x02, 0x10, 0x70, 0xd8, 0x32, 0x1e, 0x00, 0x00,
+	// 1702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5d, 0x6c, 0x14, 0x55,
+	0x14, 0xee, 0xdd, 0x96, 0x86, 0x1e, 0xd2, 0xda, 0xde, 0x2e, 0x65, 0x19, 0xca, 0xee, 0x32, 0x41,
+	0x2d, 0x85, 0xce, 0x94, 0xa2, 0x50, 0xd1, 0x00, 0x5d, 0x11, 0xa8, 0x20, 0x96, 0x45, 0xeb, 0x7f,
+	0x36, 0xd3, 0xee, 0x74, 0x3b, 0xb2, 0x3b, 0xb3, 0xcc, 0x9d, 0x56, 0x08, 0x21, 0x26, 0x3e, 0x18,
+	0x4c, 0x0c, 0x21, 0xf1, 0xdd, 0xf0, 0x68, 0x8c, 0x26, 0x3e, 0x14, 0x13, 0x63, 0xe4, 0xc9, 0x18,
+	0x62, 0x8c, 0x21, 0x35, 0x18, 0xe5, 0x01, 0x0c, 0x35, 0xd1, 0xc4, 0xf8, 0xe2, 0xb3, 0x31, 0x66,
+	0x66, 0xee, 0xfc, 0xed, 0xfc, 0xec, 0xec, 0xb6, 0x9b, 0x94, 0x17, 0xd2, 0xbd, 0x73, 0xfe, 0xbe,
+	0xef, 0x9c, 0x73, 0x67, 0xce, 0x01, 0xd8, 0x59, 0x85, 0x54, 0x14, 0xc2, 0x13, 0x4d, 0x38, 0x27,
+	0xc9, 0x25, 0x7e, 0x71, 0xef, 0x8c, 0xa8, 0x09, 0x7b, 0xf9, 0xf3, 0x0b, 0xa2, 0x7a, 0x91, 0xab,
+	0xaa, 0x8a, 0xa6, 0xe0, 0x01, 0x53, 0x86, 0xa3, 0x32, 0x1c, 0x95, 0x61, 0x86, 0xa9, 0xee, 0x8c,
+	0x40, 0x44, 0x53, 0xc1, 0x56, 0xaf, 0x0a, 0x25, 0x49, 0x16, 0x34, 0x49, 0x91, 0x4d, 0x1b, 0x4c,
+	0xb2, 0xa4, 0x94, 0x14, 0xe3, 0x4f, 0x5e, 0xff, 0x8b, 0x9e, 0x0e, 0x96, 0x14, 0xa5, 0x54, 0x16,
+	0x79, 0xa1, 0x2a, 0xf1, 0x82, 0x2c, 0x2b, 0x9a, 0xa1, 0x42, 0xe8, 0xd3, 0x0c, 0x7d, 0x6a, 0xfc,
+	0x9a, 0x59, 0x98, 0xe3, 0x35, 0xa9, 0x22, 0x12, 0x4d, 0xa8, 0x54, 0xa9, 0xc0, 0xce, 0x90, 0xe0,
+	0xad, 0x40, 0x4d, 0xa9, 0xad, 0xa6, 0x54, 0xc1, 0xf4, 0x4e, 0xb1, 0x98, 0x8f, 0xb6, 0x51, 0x03,
+	0x56, 0xf0, 0x6e, 0xd8, 0x4c, 0x9f, 0x50, 0x91, 0x64, 0x85, 0x37, 0xfe, 0x35, 0x8f, 0xd8, 0x0b,
+	0x30, 0x70, 0x46, 0x97, 0x98, 0x16, 0xca, 0x52, 0x51, 0xd0, 0x14, 0x95, 0xe4, 0xc5, 0xf3, 0x0b,
+	0x22, 0xd1, 0xf0, 0x00, 0x74, 0x12, 0x4d, 0xd0, 0x16, 0x48, 0x0a, 0x65, 0xd1, 0x50, 0x57, 0x9e,
+	0xfe, 0xc2, 0xc7, 0x00, 0x1c, 0x2e, 0x52, 0x89, 0x2c, 0x1a, 0xda, 0x34, 0xf6, 0x18, 0x47, 0x83,
+	0xd0, 0x89, 0xe3, 0x4c, 0x97, 0x34, 0x74, 0x6e, 0x4a, 0x28, 0x89, 0xd4, 0x66, 0xde, 0xa5, 0xc9,
+	0xce, 0x43, 0xb7, 0xed, 0x74, 0x52, 0x9e, 0x53, 0xf0, 0x04, 0xf4, 0xcd, 0x2a, 0x32, 0x11, 0x65,
+	0xb2, 0x40, 0x0a, 0x42, 0xb1, 0xa8, 0x8a, 0x84, 0xfa, 0xce, 0x25, 0xef, 0x2e, 0x8d, 0xf4, 0x5e,
+	0xb0, 0x58, 0xc8, 0x2e, 0x8e, 0x72, 0x63, 0xdc, 0x68, 0xbe, 0xd7, 0x16, 0x9f, 0x30, 0xa5, 0x0f,
+	0x26, 0x97, 0x03, 0xe4, 0xd8, 0x0f, 0x12, 0xb0, 0xc5, 0x07, 0x92, 0x54, 0x75, 0x65, 0x7c, 0x0a,
+	0x60, 0xd1, 0x3e, 0x4d, 0xa1, 0x6c, 0xfb, 0xd0, 0xa6, 0xb1, 0x1d, 0x5c, 0x70, 0x79, 0x70, 0xb6,
+	0x7e, 0xae, 0xeb, 0xd6, 0xbd, 0x4c, 0xdb, 0x27, 0x7f, 0x7c, 0x31, 0x8c, 0xf2, 0x2e, 0x7d, 0xfc,
+	0x0a, 0xf4, 0xd8, 0xbf, 0x0a, 0x92, 0x3c, 0xa7, 0xa4, 0x12, 0x86, 0xc5, 0x47, 0xeb, 0x5a, 0xd4,
+	0x19, 0x70, 0x5b, 0xed, 0x5e, 0xf4, 0x70, 0x73, 0xdc, 0x43, 0x7a, 0xbb, 0x41, 0xfa, 0xe3, 0x75,
+	0x49, 0x37, 0x31, 0x7a, 0x58, 0x17, 0x60, 0xb3, 0x97, 0x0a, 0x2b, 0xdd, 0x27, 0xdc, 0xa1, 0xeb,
+	0xec, 0x53, 0xea, 0x77, 0x2c, 0x2f, 0x8d, 0x6c, 0xa7, 0x8e, 0x6c, 0x25, 0xca, 0xf7, 0x59, 0x4d,
+	0x95, 0xe4, 0x92, 0x2b, 0x56, 0xfd, 0x9c, 0x2d, 0xd6, 0x96, 0x94, 0x4d, 0xf6, 0xf3, 0xd0, 0x65,
+	0x8b, 0x1a, 0xe6, 0x1b, 0xe5, 0xda, 0x51, 0x67, 0x97, 0x10, 0x64, 0xbd, 0x6e, 0x8e, 0x8a, 0x65,
+	0xb1, 0x64, 0xb6, 0xdb, 0x9a, 0x83, 0x5a, 0xb3, 0xaa, 0xff, 0x1b, 0xc1, 0x8e, 0x88, 0xb0, 0x29,
+	0x51, 0xef, 0x42, 0xb2, 0x68, 0x1f, 0x17, 0x54, 0x7a, 0x6c, 0xd5, 0xe7, 0x70, 0x18, 0x67, 0x8e,
+	0x29, 0xcb, 0x52, 0x2e, 0xab, 0x93, 0xf7, 0xe9, 0xfd, 0x4c, 0xbf, 0xff, 0x19, 0x31, 0x39, 0xed,
+	0x2f, 0xfa, 0x9f, 0xe0, 0xe3, 0x01, 0x70, 0x9b, 0xaa, 0xb7, 0x6f, 0x10, 0xec, 0xf2, 0xe2, 0x7d,
+	0x59, 0x9e, 0x51, 0xe4, 0xa2, 0x24, 0x97, 0x1e, 0x8a, 0x7c, 0xdd, 0x43, 0x30, 0x1c, 0x27, 0x7e,
+	0x9a, 0xb8, 0x12, 0xf4, 0x2f, 0x58, 0xcf, 0x7d, 0x79, 0xdb, 0x1d, 0x96, 0xb7, 0x00, 0x93, 0xee,
+	0xaa, 0xc7, 0xb6, 0xc9, 0x16, 0x24, 0xe8, 0x73, 0x44, 0xdb, 0xd5, 0x5d, 0x20, 0x66, 0x36, 0x0e,
+	0x43, 0x0f, 0xad, 0x0d, 0x6f, 0x36, 0x52, 0xcb, 0x4b, 0x23, 0x49, 0xea, 0xaa, 0x26, 0x09, 0xb6,
+	0xbc, 0x91, 0x04, 0x7f, 0x3a, 0x13, 0xcd, 0xa5, 0xf3, 0xe0, 0xc6, 0x2b, 0xd7, 0x33, 0x6d, 0x7f,
+	0x5e, 0xcf, 0xb4, 0xb1, 0x8b, 0xb0, 0xc5, 0x17, 0x2e, 0x25, 0xff, 0x0d, 0xe8, 0x0f, 0xe8, 0x1a,
+	0x7a, 0xd1, 0x34, 0xd0, 0x34, 0x79, 0xec, 0x6f, 0x09, 0xf6, 0x4b, 0x04, 0x19, 0xc3, 0x71, 0x40,
+	0xb2, 0xd6, 0x35, 0x61, 0x2a, 0x64, 0xc3, 0xe3, 0xa6, 0xcc, 0x9d, 0x86, 0x4e, 0xb3, 0xc6, 0x28,
+	0x59, 0xcd, 0x56, 0x2a, 0xb5, 0xc2, 0xde, 0xb0, 0x2e, 0xe7, 0xa3, 0x16, 0xbc, 0x80, 0x66, 0x5f,
+	0x35, 0x5b, 0x6b, 0xd4, 0xe3, 0x2e, 0xae, 0x7e, 0xb6, 0x6e, 0xe7, 0xe0, 0xb8, 0x29, 0x5b, 0xf3,
+	0x6b, 0x76, 0x3b, 0xbb, 0xa8, 0x6b, 0xed, 0x35, 0x7c, 0xd3, 0xba, 0x86, 0x6d, 0x60, 0x51, 0xd7,
+	0xf0, 0x3a, 0xcc, 0x8c, 0x7d, 0x0f, 0xd7, 0x01, 0xf0, 0xd0, 0xde, 0xc3, 0x37, 0x13, 0xb0, 0xd5,
+	0x00, 0x98, 0x17, 0x8b, 0x2d, 0xc9, 0x08, 0x26, 0xea, 0x6c, 0x21, 0xf0, 0x76, 0x09, 0x37, 0xd2,
+	0x4b, 0xd4, 0xd9, 0xe9, 0x9a, 0xf7, 0x2a, 0x2e, 0x12, 0xad, 0xd6, 0x4e, 0x7b, 0x3d, 0x3b, 0x45,
+	0xa2, 0x4d, 0x47, 0xbc, 0x9f, 0x3b, 0xd6, 0xa0, 0x42, 0xee, 0x20, 0x60, 0x82, 0x08, 0xa4, 0x15,
+	0x21, 0xc3, 0x80, 0x2a, 0x46, 0xb4, 0xed, 0x9e, 0xb0, 0xa2, 0x70, 0x9b, 0x0b, 0x6a, 0xdc, 0xcd,
+	0xaa, 0xd8, 0xd2, 0xd6, 0x5d, 0xb2, 0x5e, 0x3c, 0x76, 0xe5, 0xfb, 0x67, 0xb5, 0x75, 0xd8, 0xb0,
+	0x5f, 0xf9, 0x5e, 0x01, 0x2d, 0x9f, 0xbe, 0xd6, 0x8c, 0xf2, 0x1b, 0x08, 0xd2, 0x21, 0xb1, 0xaf,
+	0xeb, 0x57, 0x7d, 0x25, 0xb4, 0x52, 0x5a, 0x32, 0x82, 0x8d, 0xd3, 0x86, 0x3b, 0x21, 0x11, 0x4d,
+	0x51, 0xa5, 0x59, 0xa1, 0xac, 0xcf, 0xaa, 0xae, 0xfd, 0xc1, 0xbc, 0x28, 0x95, 0xe6, 0x35, 0xc3,
+	0x4d, 0x7b, 0x9e, 0xfe, 0x3a, 0x98, 0x48, 0x21, 0x56, 0x80, 0x6d, 0x81, 0x9a, 0x34, 0xc8, 0x43,
+	0xd0, 0x31, 0x2f, 0x11, 0x2d, 0x85, 0xbc, 0x75, 0x58, 0x1b, 0x9f, 0x57, 0x3b, 0x97, 0x48, 0xa1,
+	0xbc, 0xa1, 0x67, 0xb8, 0xc0, 0xd0, 0x6b, 0xb8, 0x98, 0x52, 0x94, 0x32, 0x0d, 0x89, 0x9d, 0x82,
+	0x3e, 0xd7, 0x19, 0x75, 0xf6, 0x34, 0x74, 0x54, 0x15, 0xa5, 0x4c, 0x9d, 0x0d, 0x86, 0x39, 0xd3,
+	0x75, 0xdc, 0x3c, 0x18, 0x4a, 0x6c, 0x12, 0xb0, 0x69, 0x51, 0x50, 0x85, 0x8a, 0xd5, 0x8e, 0xec,
+	0xab, 0xd0, 0xef, 0x39, 0xa5, 0x9e, 0x26, 0xa0, 0xb3, 0x6a, 0x9c, 0x50, 0x5f, 0xe9, 0x50, 0x5f,
+	0x86, 0x94, 0xe7, 0xc3, 0xca, 0x54, 0x64, 0x3f, 0x44, 0xb0, 0xa7, 0x66, 0x95, 0x91, 0x13, 0xcb,
+	0xca, 0x3b, 0x2f, 0x48, 0xf2, 0x59, 0xb1, 0x3c, 0xe7, 0xff, 0x24, 0xf5, 0x36, 0x36, 0x6a, 0xba,
+	0xb1, 0x83, 0x37, 0x2b, 0x7f, 0x25, 0x20, 0xe3, 0x54, 0x49, 0x60, 0x20, 0xf8, 0x34, 0xf4, 0x79,
+	0x0b, 0xdd, 0x59, 0xeb, 0xc4, 0xa8, 0xf5, 0xde, 0xc5, 0x9a, 0x73, 0xfc, 0x1a, 0x3c, 0x42, 0xc4,
+	0xf2, 0x5c, 0xc1, 0xb9, 0x74, 0x69, 0xe7, 0x8c, 0xea, 0x74, 0xdd, 0xbd, 0x97, 0xd9, 0x6c, 0x5a,
+	0x24, 0xc5, 0x73, 0x9c, 0xa4, 0xf0, 0x15, 0x41, 0x9b, 0xe7, 0x26, 0x65, 0x6d, 0x79, 0x69, 0x04,
+	0xa8, 0xab, 0x49, 0x59, 0x33, 0x59, 0xed, 0x21, 0xde, 0x50, 0x0f, 0xc3, 0x06, 0x22, 0xc9, 0xb3,
+	0x22, 0x5d, 0xb0, 0x30, 0x9c, 0xb9, 0xae, 0xe3, 0xac, 0x75, 0x1d, 0xf7, 0x92, 0xb5, 0xae, 0xcb,
+	0x75, 0xeb, 0xce, 0xae, 0xdd, 0xcf, 0x20, 0xd3, 0x92, 0xa9, 0x87, 0x8f, 0x41, 0xd7, 0xdb, 0x82,
+	0x54, 0x2e, 0x68, 0x52, 0x45, 0x4c, 0x75, 0x34, 0x6a, 0x64, 0xa3, 0xae, 0xab, 0x3f, 0x0d, 0x61,
+	0xfb, 0x1f, 0x04, 0x23, 0x31, 0x93, 0x4f, 0x2b, 0x6e, 0x26, 0xe0, 0x7e, 0x3d, 0x50, 0xbf, 0xdd,
+	0x03, 0x8d, 0xb6, 0xfa, 0xd6, 0x0d, 0x06, 0x3d, 0xf6, 0x6d, 0x0a, 0x36, 0x18, 0xa0, 0xf1, 0xc7,
+	0x08, 0xc0, 0x41, 0x8e, 0xb9, 0x30, 0x1c, 0xc1, 0xfb, 0x4c, 0x86, 0x8f, 0x2d, 0x4f, 0x27, 0x3e,
+	0xfe, 0x8a, 0x8e, 0xf5, 0xbd, 0x9f, 0x7e, 0xff, 0x28, 0xb1, 0x13, 0xb3, 0x7c, 0xc8, 0x66, 0xd6,
+	0xc5, 0xc4, 0x67, 0x08, 0xba, 0x6c, 0x3b, 0x78, 0x24, 0x9e, 0x3f, 0x2b, 0x3c, 0x2e, 0xae, 0x38,
+	0x8d, 0xee, 0x88, 0x13, 0xdd, 0x93, 0x78, 0x5f, 0xfd, 0xe8, 0xf8, 0x4b, 0xde, 0x2e, 0xbc, 0x8c,
+	0x7f, 0x45, 0x90, 0x0c, 0xda, 0x42, 0xe1, 0xf1, 0x78, 0xa1, 0xf8, 0x07, 0x07, 0xe6, 0xa9, 0x26,
+	0x34, 0x29, 0x9e, 0x53, 0x0e, 0x9e, 0x09, 0x7c, 0xb8, 0x09, 0x3c, 0xbc, 0xeb, 0xab, 0x0f, 0xff,
+	0x87, 0x60, 0x7b, 0xe4, 0xc6, 0x06, 0x4f, 0xc4, 0x0b, 0x35, 0x62, 0x4c, 0x62, 0x72, 0xab, 0x31,
+	0x41, 0x61, 0x4f, 0x3b, 0xb0, 0x4f, 0xe2, 0xc9, 0x66, 0x60, 0x3b, 0x73, 0x8e, 0x9b, 0x80, 0x1f,
+	0x10, 0x80, 0xeb, 0x66, 0x8b, 0xae, 0x2e, 0xdf, 0x6b, 0x83, 0xe1, 0x63, 0xcb, 0x53, 0x1c, 0x6f,
+	0x39, 0x38, 0xf2, 0x78, 0x6a, 0x95, 0xe9, 0xe3, 0x2f, 0x79, 0xbf, 0xad, 0x2e, 0xe3, 0x7f, 0x11,
+	0xf4, 0x07, 0xf0, 0x88, 0x0f, 0x44, 0xc6, 0x19, 0xbe, 0xaa, 0x61, 0xc6, 0x1b, 0x57, 0xa4, 0x48,
+	0x55, 0x07, 0x69, 0x09, 0x8b, 0x6b, 0x8d, 0x34, 0x30, 0x9d, 0xf8, 0x47, 0x04, 0xc9, 0xa0, 0x95,
+	0x44, 0x9d, 0x56, 0x8d, 0xd8, 0xbe, 0xd4, 0x69, 0xd5, 0xa8, 0xfd, 0x07, 0x3b, 0xe1, 0x30, 0xb0,
+	0x1f, 0x3f, 0x11, 0xc6, 0x40, 0x64, 0x3e, 0xf5, 0xfe, 0x8c, 0x9c, 0xe4, 0xeb, 0xf4, 0x67, 0x9c,
+	0x35, 0x46, 0x9d, 0xfe, 0x8c, 0xb5, 0x48, 0x88, 0xd9, 0x9f, 0x36, 0xbc, 0x98, 0x09, 0x25, 0xf8,
+	0x3b, 0x04, 0xdd, 0x9e, 0x41, 0x15, 0xef, 0x8d, 0x8c, 0x36, 0x68, 0x2b, 0xc0, 0x8c, 0x35, 0xa2,
+	0x42, 0x01, 0x9d, 0x76, 0x00, 0x3d, 0x8b, 0x27, 0x9a, 0x01, 0xa4, 0x7a, 0xc2, 0xbe, 0x83, 0xa0,
+	0x3f, 0x60, 0xc4, 0xab, 0xd3, 0x99, 0xe1, 0xb3, 0x2c, 0x33, 0xde, 0xb8, 0x22, 0x85, 0x76, 0xd2,
+	0x81, 0x76, 0x04, 0x1f, 0x6a, 0x06, 0x9a, 0xeb, 0x65, 0xbe, 0x82, 0x00, 0xfb, 0x9d, 0xe1, 0xfd,
+	0x0d, 0x46, 0x67, 0xa1, 0x3a, 0xd0, 0xb0, 0x1e, 0x05, 0xf5, 0xa6, 0x03, 0xea, 0x0c, 0x7e, 0x71,
+	0x75, 0xa0, 0xfc, 0xdf, 0x00, 0x5f, 0x23, 0xe8, 0xf1, 0x8e, 0x51, 0x38, 0xba, 0xa8, 0x02, 0x67,
+	0x3d, 0x66, 0x5f, 0x43, 0x3a, 0xfe, 0x2f, 0x98, 0x31, 0x3c, 0x1a, 0x86, 0x6c, 0xde, 0x56, 0x36,
+	0xfe, 0x43, 0x95, 0xbf, 0x64, 0x8e, 0x91, 0x97, 0xaf, 0x24, 0x10, 0x7e, 0x1f, 0x41, 0x87, 0x3e,
+	0x97, 0xe1, 0xa1, 0x48, 0xff, 0xae, 0x11, 0x90, 0xd9, 0x15, 0x43, 0x92, 0xc6, 0xb7, 0xcb, 0x89,
+	0x2f, 0x8d, 0x07, 0xc3, 0xe2, 0xd3, 0xc7, 0x40, 0x7c, 0x15, 0x41, 0xa7, 0x39, 0xb4, 0xe1, 0xe1,
+	0x68, 0x07, 0xee, 0x39, 0x91, 0xd9, 0x1d, 0x4b, 0x96, 0x86, 0xb3, 0xdb, 0x09, 0x27, 0x8b, 0xd3,
+	0xa1, 0xe1, 0x98, 0x51, 0x5c, 0x4d, 0x40, 0xb6, 0xde, 0x94, 0x80, 0x8f, 0xc6, 0xfc, 0x22, 0x8e,
+	0x9c, 0x30, 0x99, 0xe7, 0x56, 0x69, 0xc5, 0xfa, 0x80, 0xf8, 0x3e, 0xe0, 0xeb, 0xdf, 0x81, 0x7c,
+	0x08, 0x3f, 0x53, 0xff, 0x55, 0x5b, 0x98, 0xd1, 0x7d, 0x14, 0x2a, 0x92, 0x5c, 0xa8, 0x19, 0x11,
+	0x73, 0xfb, 0x6f, 0x3d, 0x48, 0xa3, 0xdb, 0x0f, 0xd2, 0xe8, 0xb7, 0x07, 0x69, 0x74, 0x6d, 0x25,
+	0xdd, 0x76, 0x7b, 0x25, 0xdd, 0xf6, 0xcb, 0x4a, 0xba, 0xed, 0xf5, 0x41, 0xcf, 0xb8, 0x68, 0x47,
+	0xc0, 0x6b, 0x17, 0xab, 0x22, 0x99, 0xe9, 0x34, 0xc6, 0xb6, 0x7d, 0xff, 0x0f, 0x00, 0xa4, 0x66,
+	0xb5, 0x40, 0x56, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1649,6 +1850,10 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorsBelowMinSelfDelegation queries the bonded validators self-delegating
+	// less than the min_self_delegation param, along with the time at which they
+	// will be jailed.
+	ValidatorsBelowMinSelfDelegation(ctx context.Context, in *QueryValidatorsBelowMinSelfDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorsBelowMinSelfDelegationResponse, error)
 }
 
 type queryClient struct {
@@ -1786,6 +1991,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorsBelowMinSelfDelegation(ctx context.Context, in *QueryValidatorsBelowMinSelfDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorsBelowMinSelfDelegationResponse, error) {
+	out := new(QueryValidatorsBelowMinSelfDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorsBelowMinSelfDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1841,6 +2055,10 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorsBelowMinSelfDelegation queries the bonded validators self-delegating
+	// less than the min_self_delegation param, along with the time at which they
+	// will be jailed.
+	ValidatorsBelowMinSelfDelegation(context.Context, *QueryValidatorsBelowMinSelfDelegationRequest) (*QueryValidatorsBelowMinSelfDelegationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1889,6 +2107,9 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorsBelowMinSelfDelegation(ctx context.Context, req *QueryValidatorsBelowMinSelfDelegationRequest) (*QueryValidatorsBelowMinSelfDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsBelowMinSelfDelegation not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2146,6 +2367,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorsBelowMinSelfDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsBelowMinSelfDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsBelowMinSelfDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorsBelowMinSelfDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsBelowMinSelfDelegation(ctx, req.(*QueryValidatorsBelowMinSelfDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2206,6 +2445,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorsBelowMinSelfDelegation",
+			Handler:    _Query_ValidatorsBelowMinSelfDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3356,6 +3599,146 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBelowMinSelfDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBelowMinSelfDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBelowMinSelfDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintQuery(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintQuery(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SelfDelegation.Size()
+		i -= size
+		if _, err := m.SelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3821,16 +4204,67 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *ValidatorBelowMinSelfDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SelfDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -6853,6 +7287,394 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorsBelowMinSelfDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsBelowMinSelfDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsBelowMinSelfDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBelowMinSelfDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBelowMinSelfDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBelowMinSelfDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsBelowMinSelfDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsBelowMinSelfDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsBelowMinSelfDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorBelowMinSelfDelegation{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
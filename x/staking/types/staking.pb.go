@@ -935,6 +935,12 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// min_self_delegation is the chain-wide minimum amount of tokens that a bonded
+	// validator must self-delegate. Zero disables the requirement.
+	MinSelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_delegation"`
+	// min_self_delegation_grace_period is the duration during which a bonded validator
+	// can self-delegate less than min_self_delegation before being jailed.
+	MinSelfDelegationGracePeriod time.Duration `protobuf:"bytes,9,opt,name=min_self_delegation_grace_period,json=minSelfDelegationGracePeriod,proto3,stdduration" json:"min_self_delegation_grace_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1013,6 +1019,13 @@ func (m *Params) GetKeyRotationFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetMinSelfDelegationGracePeriod() time.Duration {
+	if m != nil {
+		return m.MinSelfDelegationGracePeriod
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1384,137 +1397,140 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xd6, 0x92, 0x0c, 0x25, 0xfd, 0x94, 0x44, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x92, 0x19, 0xb7,
-	0x91, 0xdd, 0x9a, 0x8a, 0xdc, 0xc2, 0x05, 0x84, 0x20, 0x85, 0x29, 0xd2, 0x31, 0xf3, 0x90, 0xd4,
-	0xa5, 0xa4, 0x3e, 0xd0, 0x66, 0x31, 0xdc, 0x1d, 0x52, 0x5b, 0x91, 0xb3, 0xec, 0xce, 0x50, 0x36,
-	0xef, 0x3d, 0x04, 0x2e, 0x0a, 0xe4, 0x54, 0x04, 0x28, 0x8c, 0x1a, 0xe8, 0x25, 0xbd, 0xe5, 0x60,
-	0xf4, 0xde, 0x5b, 0x5a, 0xa0, 0x80, 0xe1, 0x53, 0x11, 0xa0, 0x6e, 0x61, 0x1f, 0x12, 0x34, 0x97,
-	0xa2, 0xa7, 0x1e, 0x8b, 0x79, 0xec, 0x83, 0xa2, 0x68, 0x59, 0x72, 0x50, 0x04, 0xed, 0x45, 0xe0,
-	0xcc, 0xfc, 0xff, 0xb7, 0xf3, 0x7f, 0xf3, 0x3f, 0x66, 0x7e, 0xc1, 0x25, 0xdb, 0x63, 0x1d, 0x8f,
-	0x2d, 0x33, 0x8e, 0xf7, 0x5c, 0xda, 0x5a, 0xde, 0x5f, 0x69, 0x10, 0x8e, 0x57, 0x82, 0x71, 0xa9,
-	0xeb, 0x7b, 0xdc, 0x43, 0x67, 0x95, 0x54, 0x29, 0x98, 0xd5, 0x52, 0x85, 0xd3, 0x2d, 0xaf, 0xe5,
-	0x49, 0x91, 0x65, 0xf1, 0x4b, 0x49, 0x17, 0xe6, 0x5a, 0x9e, 0xd7, 0x6a, 0x93, 0x65, 0x39, 0x6a,
-	0xf4, 0x9a, 0xcb, 0x98, 0xf6, 0xf5, 0xd2, 0xfc, 0xc1, 0x25, 0xa7, 0xe7, 0x63, 0xee, 0x7a, 0x54,
-	0xaf, 0x2f, 0x1c, 0x5c, 0xe7, 0x6e, 0x87, 0x30, 0x8e, 0x3b, 0xdd, 0x00, 0x5b, 0xed, 0xc4, 0x52,
-	0x1f, 0xd5, 0xdb, 0xd2, 0xd8, 0xda, 0x94, 0x06, 0x66, 0x24, 0xb4, 0xc3, 0xf6, 0xdc, 0x00, 0x7b,
-	0x16, 0x77, 0x5c, 0xea, 0x2d, 0xcb, 0xbf, 0x7a, 0xea, 0x82, 0xed, 0x75, 0x08, 0x6f, 0x34, 0xf9,
-	0x32, 0xef, 0x77, 0x09, 0x5b, 0xde, 0x5f, 0x51, 0x3f, 0xf4, 0xf2, 0xcb, 0xe1, 0x32, 0x6e, 0xd8,
-	0xee, 0x81, 0xd5, 0xe2, 0x87, 0x06, 0xcc, 0xdc, 0x72, 0x19, 0xf7, 0x7c, 0xd7, 0xc6, 0xed, 0x1a,
-	0x6d, 0x7a, 0xe8, 0x75, 0x48, 0xef, 0x12, 0xec, 0x10, 0x3f, 0x6f, 0x2c, 0x1a, 0x4b, 0x99, 0x6b,
-	0x73, 0xa5, 0x00, 0xa1, 0xa4, 0x34, 0xf7, 0x57, 0x4a, 0xb7, 0xa4, 0x40, 0x79, 0xf2, 0x93, 0xc7,
-	0x0b, 0x63, 0x1f, 0x7d, 0xf6, 0xf1, 0x15, 0xc3, 0xd4, 0x3a, 0xa8, 0x02, 0xe9, 0x7d, 0xdc, 0x66,
-	0x84, 0xe7, 0x13, 0x8b, 0xc9, 0xa5, 0xcc, 0xb5, 0x8b, 0xa5, 0xc3, 0x69, 0x2f, 0xed, 0xe0, 0xb6,
-	0xeb, 0x60, 0xee, 0x0d, 0xa2, 0x28, 0xdd, 0xd5, 0x44, 0xde, 0x28, 0xfe, 0x2a, 0x01, 0xd9, 0x35,
-	0xaf, 0xd3, 0x71, 0x19, 0x73, 0x3d, 0x6a, 0x62, 0x4e, 0x18, 0x7a, 0x0b, 0x52, 0x3e, 0xe6, 0x44,
-	0xee, 0x6c, 0xb2, 0x7c, 0x5d, 0x28, 0x7e, 0xfa, 0x78, 0xe1, 0xbc, 0xfa, 0x04, 0x73, 0xf6, 0x4a,
-	0xae, 0xb7, 0xdc, 0xc1, 0x7c, 0xb7, 0xf4, 0x0e, 0x69, 0x61, 0xbb, 0x5f, 0x21, 0xf6, 0xa3, 0x07,
-	0x57, 0x41, 0xef, 0xa0, 0x42, 0x6c, 0xf5, 0x15, 0x89, 0x81, 0xbe, 0x07, 0x13, 0x1d, 0x7c, 0xc7,
-	0x92, 0x78, 0x89, 0x17, 0xc2, 0x1b, 0xef, 0xe0, 0x3b, 0x62, 0x7f, 0xe8, 0x3d, 0xc8, 0x0a, 0x48,
-	0x7b, 0x17, 0xd3, 0x16, 0x51, 0xc8, 0xc9, 0x17, 0x42, 0x9e, 0xee, 0xe0, 0x3b, 0x6b, 0x12, 0x4d,
-	0xe0, 0xaf, 0xa6, 0x3e, 0xbf, 0xbf, 0x60, 0x14, 0xff, 0x60, 0x00, 0x44, 0xc4, 0x20, 0x0c, 0x39,
-	0x3b, 0x1c, 0xc9, 0x8f, 0x32, 0x7d, 0x72, 0xaf, 0x8e, 0xe2, 0xfe, 0x00, 0xad, 0xe5, 0x69, 0xb1,
-	0xbd, 0x87, 0x8f, 0x17, 0x0c, 0xf5, 0xd5, 0xac, 0x3d, 0x44, 0x7b, 0xa6, 0xd7, 0x75, 0x30, 0x27,
-	0x96, 0x70, 0x65, 0xc9, 0x56, 0xe6, 0x5a, 0xa1, 0xa4, 0xfc, 0xbc, 0x14, 0xf8, 0x79, 0x69, 0x2b,
-	0xf0, 0x73, 0x05, 0xf8, 0xc1, 0xdf, 0x02, 0x40, 0x50, 0xda, 0x62, 0x5d, 0xdb, 0xf0, 0x91, 0x01,
-	0x99, 0x0a, 0x61, 0xb6, 0xef, 0x76, 0x45, 0xe4, 0xa0, 0x3c, 0x8c, 0x77, 0x3c, 0xea, 0xee, 0x69,
-	0xaf, 0x9b, 0x34, 0x83, 0x21, 0x2a, 0xc0, 0x84, 0xeb, 0x10, 0xca, 0x5d, 0xde, 0x57, 0xc7, 0x64,
-	0x86, 0x63, 0xa1, 0x75, 0x9b, 0x34, 0x98, 0x1b, 0xf0, 0x6c, 0x06, 0x43, 0x74, 0x19, 0x72, 0x8c,
-	0xd8, 0x3d, 0xdf, 0xe5, 0x7d, 0xcb, 0xf6, 0x28, 0xc7, 0x36, 0xcf, 0xa7, 0xa4, 0x48, 0x36, 0x98,
-	0x5f, 0x53, 0xd3, 0x02, 0xc4, 0x21, 0x1c, 0xbb, 0x6d, 0x96, 0x7f, 0x49, 0x81, 0xe8, 0xa1, 0xde,
-	0xea, 0xbd, 0x71, 0x98, 0x0c, 0x9d, 0x15, 0xad, 0x41, 0xce, 0xeb, 0x12, 0x5f, 0xfc, 0xb6, 0xb0,
-	0xe3, 0xf8, 0x84, 0x31, 0xed, 0x8d, 0xf9, 0x47, 0x0f, 0xae, 0x9e, 0xd6, 0x84, 0xdf, 0x50, 0x2b,
-	0x75, 0xee, 0xbb, 0xb4, 0x65, 0x66, 0x03, 0x0d, 0x3d, 0x8d, 0x7e, 0x28, 0x8e, 0x8c, 0x32, 0x42,
-	0x59, 0x8f, 0x59, 0xdd, 0x5e, 0x63, 0x8f, 0xf4, 0x35, 0xa9, 0xa7, 0x87, 0x48, 0xbd, 0x41, 0xfb,
-	0xe5, 0xfc, 0x9f, 0x22, 0x68, 0xdb, 0xef, 0x77, 0xb9, 0x57, 0xda, 0xec, 0x35, 0xde, 0x26, 0x7d,
-	0x71, 0x54, 0x1a, 0x67, 0x53, 0xc2, 0xa0, 0xb3, 0x90, 0xfe, 0x29, 0x76, 0xdb, 0xc4, 0x91, 0x8c,
-	0x4c, 0x98, 0x7a, 0x84, 0x56, 0x21, 0xcd, 0x38, 0xe6, 0x3d, 0x26, 0x69, 0x98, 0xb9, 0x56, 0x1c,
-	0xe5, 0x1b, 0x65, 0x8f, 0x3a, 0x75, 0x29, 0x69, 0x6a, 0x0d, 0xb4, 0x06, 0x69, 0xee, 0xed, 0x11,
-	0xaa, 0x09, 0x2a, 0x7f, 0x43, 0x7b, 0xf3, 0x99, 0x61, 0x6f, 0xae, 0x51, 0x1e, 0xf3, 0xe3, 0x1a,
-	0xe5, 0xa6, 0x56, 0x45, 0x3f, 0x86, 0x9c, 0x43, 0xda, 0xa4, 0x25, 0x99, 0x63, 0xbb, 0xd8, 0x27,
-	0x2c, 0x9f, 0x96, 0x70, 0x2b, 0xc7, 0x0e, 0x0e, 0x33, 0x1b, 0x42, 0xd5, 0x25, 0x12, 0xda, 0x84,
-	0x8c, 0x13, 0xb9, 0x53, 0x7e, 0x5c, 0x92, 0xf9, 0xca, 0x28, 0x1b, 0x63, 0x9e, 0x17, 0xcf, 0x3e,
-	0x71, 0x08, 0xe1, 0x41, 0x3d, 0xda, 0xf0, 0xa8, 0xe3, 0xd2, 0x96, 0xb5, 0x4b, 0xdc, 0xd6, 0x2e,
-	0xcf, 0x4f, 0x2c, 0x1a, 0x4b, 0x49, 0x33, 0x1b, 0xce, 0xdf, 0x92, 0xd3, 0x68, 0x13, 0x66, 0x22,
-	0x51, 0x19, 0x21, 0x93, 0xc7, 0x8d, 0x90, 0xe9, 0x10, 0x40, 0x88, 0xa0, 0x77, 0x01, 0xa2, 0x18,
-	0xcc, 0x83, 0x44, 0x2b, 0x1e, 0x1d, 0xcd, 0x71, 0x63, 0x62, 0x00, 0x88, 0xc2, 0xa9, 0x8e, 0x4b,
-	0x2d, 0x46, 0xda, 0x4d, 0x4b, 0x33, 0x27, 0x70, 0x33, 0x92, 0xfe, 0x37, 0x8e, 0x71, 0x9a, 0x9f,
-	0x3e, 0xb8, 0x9a, 0x55, 0xa3, 0xab, 0xcc, 0xd9, 0x5b, 0x7c, 0xad, 0xf4, 0xed, 0xef, 0x98, 0xb3,
-	0x1d, 0x97, 0xd6, 0x49, 0xbb, 0x59, 0x09, 0x81, 0xd1, 0xeb, 0x70, 0x3e, 0x22, 0xc4, 0xa3, 0xd6,
-	0xae, 0xd7, 0x76, 0x2c, 0x9f, 0x34, 0x2d, 0xdb, 0xeb, 0x51, 0x9e, 0x9f, 0x92, 0x34, 0x9e, 0x0b,
-	0x45, 0x36, 0xe8, 0x2d, 0xaf, 0xed, 0x98, 0xa4, 0xb9, 0x26, 0x96, 0xd1, 0x2b, 0x10, 0xb1, 0x61,
-	0xb9, 0x0e, 0xcb, 0x4f, 0x2f, 0x26, 0x97, 0x52, 0xe6, 0x54, 0x38, 0x59, 0x73, 0xd8, 0xea, 0xc4,
-	0xfb, 0xf7, 0x17, 0xc6, 0x3e, 0xbf, 0xbf, 0x30, 0x56, 0xbc, 0x09, 0x53, 0x3b, 0xb8, 0xad, 0x43,
-	0x8b, 0x30, 0x74, 0x1d, 0x26, 0x71, 0x30, 0xc8, 0x1b, 0x8b, 0xc9, 0x67, 0x86, 0x66, 0x24, 0x5a,
-	0xfc, 0x9d, 0x01, 0xe9, 0xca, 0xce, 0x26, 0x76, 0x7d, 0x54, 0x85, 0xd9, 0xc8, 0x57, 0x9f, 0x37,
-	0xca, 0x23, 0xf7, 0x0e, 0xc2, 0x7c, 0x1d, 0x66, 0xf7, 0x83, 0xc4, 0x11, 0xc2, 0xa8, 0x52, 0x73,
-	0xf1, 0xd1, 0x83, 0xab, 0x17, 0x34, 0x4c, 0x98, 0x5c, 0x0e, 0xe0, 0xed, 0x1f, 0x98, 0x8f, 0xd9,
-	0xfc, 0x16, 0x8c, 0xab, 0xad, 0x32, 0xf4, 0x5d, 0x78, 0xa9, 0x2b, 0x7e, 0x48, 0x53, 0x33, 0xd7,
-	0xe6, 0x47, 0xfa, 0xbc, 0x94, 0x8f, 0x7b, 0x88, 0xd2, 0x2b, 0xfe, 0x22, 0x01, 0x50, 0xd9, 0xd9,
-	0xd9, 0xf2, 0xdd, 0x6e, 0x9b, 0xf0, 0x2f, 0xcb, 0xf6, 0x6d, 0x38, 0x13, 0xd9, 0xce, 0x7c, 0xfb,
-	0xf8, 0xf6, 0x9f, 0x0a, 0xf5, 0xeb, 0xbe, 0x7d, 0x28, 0xac, 0xc3, 0x78, 0x08, 0x9b, 0x3c, 0x3e,
-	0x6c, 0x85, 0xf1, 0x61, 0x66, 0x7f, 0x00, 0x99, 0x88, 0x0c, 0x86, 0x6a, 0x30, 0xc1, 0xf5, 0x6f,
-	0x4d, 0x70, 0x71, 0x34, 0xc1, 0x81, 0x5a, 0x9c, 0xe4, 0x50, 0xbd, 0xf8, 0x6f, 0x03, 0x20, 0x16,
-	0x23, 0x5f, 0x4d, 0x1f, 0x43, 0x35, 0x48, 0xeb, 0xe4, 0x9c, 0x3c, 0x69, 0x72, 0xd6, 0x00, 0x31,
-	0x52, 0x7f, 0x99, 0x80, 0x53, 0xdb, 0x41, 0xf4, 0x7e, 0xf5, 0x39, 0xd8, 0x86, 0x71, 0x42, 0xb9,
-	0xef, 0x4a, 0x12, 0xc4, 0x99, 0xbf, 0x36, 0xea, 0xcc, 0x0f, 0x31, 0xaa, 0x4a, 0xb9, 0xdf, 0x8f,
-	0x7b, 0x40, 0x80, 0x15, 0xe3, 0xe3, 0xd7, 0x49, 0xc8, 0x8f, 0x52, 0x45, 0xaf, 0x42, 0xd6, 0xf6,
-	0x89, 0x9c, 0x08, 0xea, 0x8e, 0x21, 0x13, 0xe6, 0x4c, 0x30, 0xad, 0xcb, 0x8e, 0x09, 0xe2, 0xa2,
-	0x26, 0x9c, 0x4b, 0x88, 0x9e, 0xec, 0x66, 0x36, 0x13, 0x21, 0xc8, 0xc2, 0xb3, 0x05, 0x59, 0x97,
-	0xba, 0xdc, 0xc5, 0x6d, 0xab, 0x81, 0xdb, 0x98, 0xda, 0xc1, 0x0d, 0xf6, 0x58, 0x35, 0x7f, 0x46,
-	0x63, 0x94, 0x15, 0x04, 0xaa, 0xc2, 0x78, 0x80, 0x96, 0x3a, 0x3e, 0x5a, 0xa0, 0x8b, 0x2e, 0xc2,
-	0x54, 0xbc, 0x30, 0xc8, 0xdb, 0x48, 0xca, 0xcc, 0xc4, 0xea, 0xc2, 0x51, 0x95, 0x27, 0xfd, 0xcc,
-	0xca, 0xa3, 0x2f, 0x7c, 0xbf, 0x49, 0xc2, 0xac, 0x49, 0x9c, 0xff, 0xfd, 0x63, 0xd9, 0x04, 0x50,
-	0xa1, 0x2a, 0x32, 0xa9, 0x3e, 0x99, 0x13, 0xc4, 0xfb, 0xa4, 0x02, 0xa9, 0x30, 0xfe, 0xdf, 0x3a,
-	0xa1, 0xbf, 0x26, 0x60, 0x2a, 0x7e, 0x42, 0xff, 0x97, 0x45, 0x0b, 0xad, 0x47, 0x69, 0x2a, 0x25,
-	0xd3, 0xd4, 0xe5, 0x51, 0x69, 0x6a, 0xc8, 0x9b, 0x8f, 0xc8, 0x4f, 0x5f, 0x24, 0x21, 0xbd, 0x89,
-	0x7d, 0xdc, 0x61, 0x68, 0x63, 0xe8, 0x6e, 0x1b, 0x74, 0x05, 0x0e, 0x3a, 0x73, 0x45, 0x77, 0x41,
-	0x94, 0x2f, 0x7f, 0x38, 0xea, 0x6a, 0xfb, 0x35, 0x98, 0x11, 0x6f, 0xe4, 0xd0, 0x20, 0x45, 0xee,
-	0xb4, 0x7c, 0xea, 0x86, 0xd6, 0x33, 0xb4, 0x00, 0x19, 0x21, 0x16, 0xe5, 0x61, 0x21, 0x03, 0x1d,
-	0x7c, 0xa7, 0xaa, 0x66, 0xd0, 0x0a, 0xa0, 0xdd, 0xb0, 0x71, 0x61, 0x45, 0x44, 0x18, 0x4b, 0xd3,
-	0xe5, 0x44, 0xde, 0x30, 0x67, 0xa3, 0xd5, 0x40, 0xe5, 0x02, 0x80, 0xd8, 0x89, 0xe5, 0x10, 0xea,
-	0x75, 0xf4, 0x63, 0x6f, 0x52, 0xcc, 0x54, 0xc4, 0x04, 0xfa, 0xb9, 0xa1, 0xae, 0xc9, 0x07, 0x5e,
-	0xd3, 0xfa, 0x95, 0xb2, 0xf5, 0x1c, 0x81, 0xf1, 0xaf, 0xc7, 0x0b, 0x85, 0x3e, 0xee, 0xb4, 0x57,
-	0x8b, 0x87, 0xe0, 0x14, 0x0f, 0x7b, 0xe0, 0x8b, 0xcb, 0xf3, 0xe0, 0x6b, 0x1c, 0xd5, 0x20, 0xb7,
-	0x47, 0xfa, 0x96, 0xef, 0x71, 0x95, 0x6c, 0x9a, 0x84, 0xe8, 0xf7, 0xcc, 0x5c, 0x70, 0xbe, 0x0d,
-	0xcc, 0x48, 0xec, 0xfa, 0xef, 0xd2, 0x72, 0x4a, 0xec, 0xce, 0x9c, 0xd9, 0x23, 0x7d, 0x53, 0xeb,
-	0xdd, 0x24, 0x64, 0xf5, 0x92, 0x88, 0x96, 0xbb, 0x9f, 0x7d, 0x7c, 0xe5, 0x7c, 0x74, 0x69, 0x5f,
-	0xbe, 0x13, 0xf6, 0xc9, 0xd4, 0x11, 0x8b, 0x8b, 0x2f, 0x8a, 0x8a, 0x90, 0x49, 0x58, 0x57, 0xbc,
-	0x29, 0xc5, 0x1b, 0x24, 0xf6, 0x56, 0x30, 0x9e, 0xfd, 0x06, 0x89, 0xf4, 0x07, 0xde, 0x20, 0xb1,
-	0x10, 0x7d, 0x23, 0xaa, 0x01, 0x89, 0xa3, 0xac, 0x89, 0x7b, 0xa7, 0x56, 0x92, 0x91, 0x3f, 0x56,
-	0xfc, 0xb3, 0x01, 0x73, 0x43, 0xde, 0x1c, 0x6e, 0xd9, 0x06, 0xe4, 0xc7, 0x16, 0xa5, 0x57, 0xf4,
-	0xf5, 0xd6, 0x4f, 0x16, 0x1c, 0xb3, 0xfe, 0x50, 0x21, 0xf8, 0x72, 0x8a, 0x99, 0xce, 0x64, 0x7f,
-	0x34, 0xe0, 0x74, 0x7c, 0x03, 0xa1, 0x29, 0x75, 0x98, 0x8a, 0x7f, 0x5a, 0x1b, 0x71, 0xe9, 0x79,
-	0x8c, 0x88, 0xef, 0x7f, 0x00, 0x04, 0xed, 0x44, 0x19, 0x43, 0x75, 0xe7, 0x56, 0x9e, 0x9b, 0x94,
-	0x60, 0x63, 0x87, 0x66, 0x0e, 0x75, 0x36, 0x5f, 0x18, 0x90, 0xda, 0xf4, 0xbc, 0x36, 0xfa, 0x19,
-	0xcc, 0x52, 0x8f, 0x5b, 0x22, 0xb2, 0x88, 0x63, 0xe9, 0xd6, 0x81, 0xca, 0xc6, 0xd5, 0x67, 0x72,
-	0xf5, 0x8f, 0xc7, 0x0b, 0xc3, 0x9a, 0x83, 0x04, 0xea, 0x0e, 0x15, 0xf5, 0x78, 0x59, 0x0a, 0x6d,
-	0xa9, 0xee, 0x42, 0x13, 0xa6, 0x07, 0x3f, 0xa7, 0x32, 0xf6, 0x8d, 0xa3, 0x3e, 0x37, 0x7d, 0xe4,
-	0xa7, 0xa6, 0x1a, 0xb1, 0xef, 0xac, 0x4e, 0x88, 0x53, 0xfb, 0xa7, 0x38, 0xb9, 0xf7, 0x20, 0x17,
-	0xa6, 0xab, 0x6d, 0xd9, 0xde, 0x62, 0xe8, 0x26, 0x8c, 0xab, 0x4e, 0x57, 0xf0, 0x58, 0xb8, 0x18,
-	0xf5, 0x4e, 0x71, 0xc3, 0x76, 0x4b, 0xfb, 0xb1, 0xbe, 0xa7, 0x52, 0x1a, 0xe0, 0x53, 0x2b, 0xcb,
-	0xf6, 0xe7, 0xc3, 0x04, 0xcc, 0xad, 0x79, 0x94, 0xe9, 0x46, 0x8f, 0x8e, 0x6a, 0xd5, 0xab, 0xed,
-	0xa3, 0xcb, 0x23, 0xda, 0x50, 0x53, 0xc3, 0xcd, 0xa6, 0x1d, 0xc8, 0x8a, 0x12, 0x6b, 0x7b, 0xf4,
-	0x05, 0x7b, 0x4d, 0xd3, 0x5e, 0xdb, 0xd1, 0x3b, 0xda, 0x23, 0x7d, 0x81, 0x4b, 0xc9, 0xed, 0x01,
-	0xdc, 0xe4, 0xc9, 0x70, 0x29, 0xb9, 0x1d, 0xc3, 0x3d, 0x0b, 0x69, 0x7d, 0xbf, 0x4a, 0xc9, 0xdb,
-	0x83, 0x1e, 0xa1, 0xeb, 0x90, 0x14, 0xa9, 0xf0, 0xa5, 0x63, 0x24, 0x0f, 0xa1, 0x10, 0x2b, 0x6b,
-	0x75, 0x98, 0xd3, 0x9d, 0x02, 0xb6, 0xd1, 0x94, 0x8c, 0x12, 0x69, 0xd0, 0xdb, 0xa4, 0x7f, 0x48,
-	0xdb, 0x60, 0xea, 0xb9, 0xda, 0x06, 0x57, 0x7e, 0x6f, 0x00, 0x44, 0x3d, 0x33, 0xf4, 0x4d, 0x38,
-	0x57, 0xde, 0x58, 0xaf, 0x58, 0xf5, 0xad, 0x1b, 0x5b, 0xdb, 0x75, 0x6b, 0x7b, 0xbd, 0xbe, 0x59,
-	0x5d, 0xab, 0xdd, 0xac, 0x55, 0x2b, 0xb9, 0xb1, 0x42, 0xf6, 0xee, 0xbd, 0xc5, 0xcc, 0x36, 0x65,
-	0x5d, 0x62, 0xbb, 0x4d, 0x97, 0x38, 0xe8, 0xeb, 0x70, 0x7a, 0x50, 0x5a, 0x8c, 0xaa, 0x95, 0x9c,
-	0x51, 0x98, 0xba, 0x7b, 0x6f, 0x71, 0x42, 0xbd, 0x11, 0x88, 0x83, 0x96, 0xe0, 0xcc, 0xb0, 0x5c,
-	0x6d, 0xfd, 0xcd, 0x5c, 0xa2, 0x30, 0x7d, 0xf7, 0xde, 0xe2, 0x64, 0xf8, 0x98, 0x40, 0x45, 0x40,
-	0x71, 0x49, 0x8d, 0x97, 0x2c, 0xc0, 0xdd, 0x7b, 0x8b, 0x69, 0x15, 0x32, 0x85, 0xd4, 0xfb, 0xbf,
-	0x9d, 0x1f, 0xbb, 0xf2, 0x13, 0x80, 0x1a, 0x6d, 0xfa, 0xd8, 0x96, 0xa9, 0xa1, 0x00, 0x67, 0x6b,
-	0xeb, 0x37, 0xcd, 0x1b, 0x6b, 0x5b, 0xb5, 0x8d, 0xf5, 0xc1, 0x6d, 0x1f, 0x58, 0xab, 0x6c, 0x6c,
-	0x97, 0xdf, 0xa9, 0x5a, 0xf5, 0xda, 0x9b, 0xeb, 0x39, 0x03, 0x9d, 0x83, 0x53, 0x03, 0x6b, 0xdf,
-	0x5f, 0xdf, 0xaa, 0xbd, 0x5b, 0xcd, 0x25, 0xca, 0xd7, 0x3f, 0x79, 0x32, 0x6f, 0x3c, 0x7c, 0x32,
-	0x6f, 0xfc, 0xfd, 0xc9, 0xbc, 0xf1, 0xc1, 0xd3, 0xf9, 0xb1, 0x87, 0x4f, 0xe7, 0xc7, 0xfe, 0xf2,
-	0x74, 0x7e, 0xec, 0x47, 0x2f, 0x0f, 0x04, 0x63, 0x54, 0x8e, 0xe4, 0x7f, 0x17, 0x1a, 0x69, 0xe9,
-	0x35, 0xdf, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xd1, 0x83, 0x1f, 0xd5, 0x19, 0x00,
-	0x00,
+	// 2123 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x34, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0xfe, 0x5b, 0xd3, 0xb6, 0x44, 0x33, 0x6e,
+	0xa3, 0xb8, 0x35, 0x69, 0xa9, 0x85, 0x0b, 0x08, 0x41, 0x0a, 0x53, 0x94, 0x6d, 0xe6, 0x47, 0x52,
+	0x97, 0x92, 0xfa, 0x83, 0x36, 0x8b, 0xe1, 0xee, 0x90, 0xda, 0x8a, 0x9c, 0x65, 0x77, 0x46, 0xb2,
+	0x79, 0xcf, 0x21, 0x70, 0x51, 0x20, 0xa7, 0x22, 0x40, 0x61, 0xd4, 0x40, 0x2f, 0xe9, 0x2d, 0x07,
+	0xa3, 0xf7, 0xde, 0xd2, 0x02, 0x05, 0x0c, 0x9f, 0x8a, 0x00, 0x75, 0x0b, 0xfb, 0x10, 0xa3, 0xbd,
+	0x14, 0x3d, 0xf5, 0x58, 0xcc, 0xec, 0xec, 0x0f, 0x49, 0xd1, 0xb2, 0xe4, 0xa0, 0x08, 0xda, 0x0b,
+	0xb1, 0x33, 0xf3, 0xde, 0x37, 0xf3, 0xbe, 0x79, 0xef, 0xcd, 0xcc, 0x23, 0x5c, 0xb6, 0x5c, 0xd6,
+	0x71, 0x59, 0x99, 0x71, 0xbc, 0xeb, 0xd0, 0x56, 0x79, 0x7f, 0xb1, 0x41, 0x38, 0x5e, 0x0c, 0xda,
+	0xa5, 0xae, 0xe7, 0x72, 0x17, 0x9d, 0xf1, 0xa5, 0x4a, 0x41, 0xaf, 0x92, 0xca, 0x9f, 0x6a, 0xb9,
+	0x2d, 0x57, 0x8a, 0x94, 0xc5, 0x97, 0x2f, 0x9d, 0x3f, 0xd7, 0x72, 0xdd, 0x56, 0x9b, 0x94, 0x65,
+	0xab, 0xb1, 0xd7, 0x2c, 0x63, 0xda, 0x53, 0x43, 0x73, 0x83, 0x43, 0xf6, 0x9e, 0x87, 0xb9, 0xe3,
+	0x52, 0x35, 0x3e, 0x3f, 0x38, 0xce, 0x9d, 0x0e, 0x61, 0x1c, 0x77, 0xba, 0x01, 0xb6, 0xbf, 0x12,
+	0xd3, 0x9f, 0x54, 0x2d, 0x4b, 0x61, 0x2b, 0x53, 0x1a, 0x98, 0x91, 0xd0, 0x0e, 0xcb, 0x75, 0x02,
+	0xec, 0x59, 0xdc, 0x71, 0xa8, 0x5b, 0x96, 0xbf, 0xaa, 0xeb, 0xa2, 0xe5, 0x76, 0x08, 0x6f, 0x34,
+	0x79, 0x99, 0xf7, 0xba, 0x84, 0x95, 0xf7, 0x17, 0xfd, 0x0f, 0x35, 0x7c, 0x21, 0x1c, 0xc6, 0x0d,
+	0xcb, 0x19, 0x18, 0x2d, 0x7e, 0xac, 0xc1, 0xcc, 0x6d, 0x87, 0x71, 0xd7, 0x73, 0x2c, 0xdc, 0xae,
+	0xd1, 0xa6, 0x8b, 0xde, 0x84, 0xf4, 0x0e, 0xc1, 0x36, 0xf1, 0x74, 0xad, 0xa0, 0x2d, 0x64, 0x96,
+	0xce, 0x95, 0x02, 0x84, 0x92, 0xaf, 0xb9, 0xbf, 0x58, 0xba, 0x2d, 0x05, 0x2a, 0x93, 0x9f, 0x3d,
+	0x99, 0x1f, 0xfb, 0xe4, 0x8b, 0x4f, 0xaf, 0x68, 0x86, 0xd2, 0x41, 0x55, 0x48, 0xef, 0xe3, 0x36,
+	0x23, 0x5c, 0x4f, 0x14, 0x92, 0x0b, 0x99, 0xa5, 0x4b, 0xa5, 0x83, 0x69, 0x2f, 0x6d, 0xe3, 0xb6,
+	0x63, 0x63, 0xee, 0xf6, 0xa3, 0xf8, 0xba, 0xcb, 0x09, 0x5d, 0x2b, 0xfe, 0x32, 0x01, 0xd9, 0x15,
+	0xb7, 0xd3, 0x71, 0x18, 0x73, 0x5c, 0x6a, 0x60, 0x4e, 0x18, 0x7a, 0x1b, 0x52, 0x1e, 0xe6, 0x44,
+	0xae, 0x6c, 0xb2, 0x72, 0x5d, 0x28, 0x7e, 0xfe, 0x64, 0xfe, 0xbc, 0x3f, 0x05, 0xb3, 0x77, 0x4b,
+	0x8e, 0x5b, 0xee, 0x60, 0xbe, 0x53, 0x7a, 0x97, 0xb4, 0xb0, 0xd5, 0xab, 0x12, 0xeb, 0xf1, 0xc3,
+	0xab, 0xa0, 0x56, 0x50, 0x25, 0x96, 0x3f, 0x8b, 0xc4, 0x40, 0xdf, 0x83, 0x89, 0x0e, 0xbe, 0x6b,
+	0x4a, 0xbc, 0xc4, 0x2b, 0xe1, 0x8d, 0x77, 0xf0, 0x5d, 0xb1, 0x3e, 0xf4, 0x3e, 0x64, 0x05, 0xa4,
+	0xb5, 0x83, 0x69, 0x8b, 0xf8, 0xc8, 0xc9, 0x57, 0x42, 0x9e, 0xee, 0xe0, 0xbb, 0x2b, 0x12, 0x4d,
+	0xe0, 0x2f, 0xa7, 0x9e, 0x3f, 0x98, 0xd7, 0x8a, 0xbf, 0xd7, 0x00, 0x22, 0x62, 0x10, 0x86, 0x9c,
+	0x15, 0xb6, 0xe4, 0xa4, 0x4c, 0xed, 0xdc, 0xeb, 0xa3, 0xb8, 0x1f, 0xa0, 0xb5, 0x32, 0x2d, 0x96,
+	0xf7, 0xe8, 0xc9, 0xbc, 0xe6, 0xcf, 0x9a, 0xb5, 0x86, 0x68, 0xcf, 0xec, 0x75, 0x6d, 0xcc, 0x89,
+	0x29, 0x5c, 0x59, 0xb2, 0x95, 0x59, 0xca, 0x97, 0x7c, 0x3f, 0x2f, 0x05, 0x7e, 0x5e, 0xda, 0x0c,
+	0xfc, 0xdc, 0x07, 0xfc, 0xe8, 0xaf, 0x01, 0x20, 0xf8, 0xda, 0x62, 0x5c, 0xd9, 0xf0, 0x89, 0x06,
+	0x99, 0x2a, 0x61, 0x96, 0xe7, 0x74, 0x45, 0xe4, 0x20, 0x1d, 0xc6, 0x3b, 0x2e, 0x75, 0x76, 0x95,
+	0xd7, 0x4d, 0x1a, 0x41, 0x13, 0xe5, 0x61, 0xc2, 0xb1, 0x09, 0xe5, 0x0e, 0xef, 0xf9, 0xdb, 0x64,
+	0x84, 0x6d, 0xa1, 0x75, 0x87, 0x34, 0x98, 0x13, 0xf0, 0x6c, 0x04, 0x4d, 0xf4, 0x06, 0xe4, 0x18,
+	0xb1, 0xf6, 0x3c, 0x87, 0xf7, 0x4c, 0xcb, 0xa5, 0x1c, 0x5b, 0x5c, 0x4f, 0x49, 0x91, 0x6c, 0xd0,
+	0xbf, 0xe2, 0x77, 0x0b, 0x10, 0x9b, 0x70, 0xec, 0xb4, 0x99, 0x7e, 0xc2, 0x07, 0x51, 0x4d, 0xb5,
+	0xd4, 0xfb, 0xe3, 0x30, 0x19, 0x3a, 0x2b, 0x5a, 0x81, 0x9c, 0xdb, 0x25, 0x9e, 0xf8, 0x36, 0xb1,
+	0x6d, 0x7b, 0x84, 0x31, 0xe5, 0x8d, 0xfa, 0xe3, 0x87, 0x57, 0x4f, 0x29, 0xc2, 0x6f, 0xf8, 0x23,
+	0x75, 0xee, 0x39, 0xb4, 0x65, 0x64, 0x03, 0x0d, 0xd5, 0x8d, 0x7e, 0x28, 0xb6, 0x8c, 0x32, 0x42,
+	0xd9, 0x1e, 0x33, 0xbb, 0x7b, 0x8d, 0x5d, 0xd2, 0x53, 0xa4, 0x9e, 0x1a, 0x22, 0xf5, 0x06, 0xed,
+	0x55, 0xf4, 0x3f, 0x46, 0xd0, 0x96, 0xd7, 0xeb, 0x72, 0xb7, 0xb4, 0xb1, 0xd7, 0x78, 0x87, 0xf4,
+	0x8c, 0x6c, 0x88, 0xb3, 0x21, 0x61, 0xd0, 0x19, 0x48, 0xff, 0x14, 0x3b, 0x6d, 0x62, 0x4b, 0x46,
+	0x26, 0x0c, 0xd5, 0x42, 0xcb, 0x90, 0x66, 0x1c, 0xf3, 0x3d, 0x26, 0x69, 0x98, 0x59, 0x2a, 0x8e,
+	0xf2, 0x8d, 0x8a, 0x4b, 0xed, 0xba, 0x94, 0x34, 0x94, 0x06, 0x5a, 0x81, 0x34, 0x77, 0x77, 0x09,
+	0x55, 0x04, 0x55, 0xbe, 0xa1, 0xbc, 0xf9, 0xf4, 0xb0, 0x37, 0xd7, 0x28, 0x8f, 0xf9, 0x71, 0x8d,
+	0x72, 0x43, 0xa9, 0xa2, 0x1f, 0x43, 0xce, 0x26, 0x6d, 0xd2, 0x92, 0xcc, 0xb1, 0x1d, 0xec, 0x11,
+	0xa6, 0xa7, 0x25, 0xdc, 0xe2, 0x91, 0x83, 0xc3, 0xc8, 0x86, 0x50, 0x75, 0x89, 0x84, 0x36, 0x20,
+	0x63, 0x47, 0xee, 0xa4, 0x8f, 0x4b, 0x32, 0x5f, 0x1b, 0x65, 0x63, 0xcc, 0xf3, 0xe2, 0xd9, 0x27,
+	0x0e, 0x21, 0x3c, 0x68, 0x8f, 0x36, 0x5c, 0x6a, 0x3b, 0xb4, 0x65, 0xee, 0x10, 0xa7, 0xb5, 0xc3,
+	0xf5, 0x89, 0x82, 0xb6, 0x90, 0x34, 0xb2, 0x61, 0xff, 0x6d, 0xd9, 0x8d, 0x36, 0x60, 0x26, 0x12,
+	0x95, 0x11, 0x32, 0x79, 0xd4, 0x08, 0x99, 0x0e, 0x01, 0x84, 0x08, 0x7a, 0x0f, 0x20, 0x8a, 0x41,
+	0x1d, 0x24, 0x5a, 0xf1, 0xf0, 0x68, 0x8e, 0x1b, 0x13, 0x03, 0x40, 0x14, 0x4e, 0x76, 0x1c, 0x6a,
+	0x32, 0xd2, 0x6e, 0x9a, 0x8a, 0x39, 0x81, 0x9b, 0x91, 0xf4, 0xbf, 0x75, 0x84, 0xdd, 0xfc, 0xfc,
+	0xe1, 0xd5, 0xac, 0xdf, 0xba, 0xca, 0xec, 0xdd, 0xc2, 0xb5, 0xd2, 0xb7, 0xbf, 0x63, 0xcc, 0x76,
+	0x1c, 0x5a, 0x27, 0xed, 0x66, 0x35, 0x04, 0x46, 0x6f, 0xc2, 0xf9, 0x88, 0x10, 0x97, 0x9a, 0x3b,
+	0x6e, 0xdb, 0x36, 0x3d, 0xd2, 0x34, 0x2d, 0x77, 0x8f, 0x72, 0x7d, 0x4a, 0xd2, 0x78, 0x36, 0x14,
+	0x59, 0xa7, 0xb7, 0xdd, 0xb6, 0x6d, 0x90, 0xe6, 0x8a, 0x18, 0x46, 0xaf, 0x41, 0xc4, 0x86, 0xe9,
+	0xd8, 0x4c, 0x9f, 0x2e, 0x24, 0x17, 0x52, 0xc6, 0x54, 0xd8, 0x59, 0xb3, 0xd9, 0xf2, 0xc4, 0x87,
+	0x0f, 0xe6, 0xc7, 0x9e, 0x3f, 0x98, 0x1f, 0x2b, 0xde, 0x84, 0xa9, 0x6d, 0xdc, 0x56, 0xa1, 0x45,
+	0x18, 0xba, 0x0e, 0x93, 0x38, 0x68, 0xe8, 0x5a, 0x21, 0xf9, 0xc2, 0xd0, 0x8c, 0x44, 0x8b, 0xbf,
+	0xd5, 0x20, 0x5d, 0xdd, 0xde, 0xc0, 0x8e, 0x87, 0x56, 0x61, 0x36, 0xf2, 0xd5, 0x97, 0x8d, 0xf2,
+	0xc8, 0xbd, 0x83, 0x30, 0x5f, 0x83, 0xd9, 0xfd, 0x20, 0x71, 0x84, 0x30, 0xfe, 0x51, 0x73, 0xe9,
+	0xf1, 0xc3, 0xab, 0x17, 0x15, 0x4c, 0x98, 0x5c, 0x06, 0xf0, 0xf6, 0x07, 0xfa, 0x63, 0x36, 0xbf,
+	0x0d, 0xe3, 0xfe, 0x52, 0x19, 0xfa, 0x2e, 0x9c, 0xe8, 0x8a, 0x0f, 0x69, 0x6a, 0x66, 0x69, 0x6e,
+	0xa4, 0xcf, 0x4b, 0xf9, 0xb8, 0x87, 0xf8, 0x7a, 0xc5, 0x9f, 0x27, 0x00, 0xaa, 0xdb, 0xdb, 0x9b,
+	0x9e, 0xd3, 0x6d, 0x13, 0xfe, 0x65, 0xd9, 0xbe, 0x05, 0xa7, 0x23, 0xdb, 0x99, 0x67, 0x1d, 0xdd,
+	0xfe, 0x93, 0xa1, 0x7e, 0xdd, 0xb3, 0x0e, 0x84, 0xb5, 0x19, 0x0f, 0x61, 0x93, 0x47, 0x87, 0xad,
+	0x32, 0x3e, 0xcc, 0xec, 0x0f, 0x20, 0x13, 0x91, 0xc1, 0x50, 0x0d, 0x26, 0xb8, 0xfa, 0x56, 0x04,
+	0x17, 0x47, 0x13, 0x1c, 0xa8, 0xc5, 0x49, 0x0e, 0xd5, 0x8b, 0xff, 0xd6, 0x00, 0x62, 0x31, 0xf2,
+	0xd5, 0xf4, 0x31, 0x54, 0x83, 0xb4, 0x4a, 0xce, 0xc9, 0xe3, 0x26, 0x67, 0x05, 0x10, 0x23, 0xf5,
+	0x17, 0x09, 0x38, 0xb9, 0x15, 0x44, 0xef, 0x57, 0x9f, 0x83, 0x2d, 0x18, 0x27, 0x94, 0x7b, 0x8e,
+	0x24, 0x41, 0xec, 0xf9, 0xb5, 0x51, 0x7b, 0x7e, 0x80, 0x51, 0xab, 0x94, 0x7b, 0xbd, 0xb8, 0x07,
+	0x04, 0x58, 0x31, 0x3e, 0x7e, 0x95, 0x04, 0x7d, 0x94, 0x2a, 0x7a, 0x1d, 0xb2, 0x96, 0x47, 0x64,
+	0x47, 0x70, 0xee, 0x68, 0x32, 0x61, 0xce, 0x04, 0xdd, 0xea, 0xd8, 0x31, 0x40, 0x5c, 0xd4, 0x84,
+	0x73, 0x09, 0xd1, 0xe3, 0xdd, 0xcc, 0x66, 0x22, 0x04, 0x79, 0xf0, 0x6c, 0x42, 0xd6, 0xa1, 0x0e,
+	0x77, 0x70, 0xdb, 0x6c, 0xe0, 0x36, 0xa6, 0x56, 0x70, 0x83, 0x3d, 0xd2, 0x99, 0x3f, 0xa3, 0x30,
+	0x2a, 0x3e, 0x04, 0x5a, 0x85, 0xf1, 0x00, 0x2d, 0x75, 0x74, 0xb4, 0x40, 0x17, 0x5d, 0x82, 0xa9,
+	0xf8, 0xc1, 0x20, 0x6f, 0x23, 0x29, 0x23, 0x13, 0x3b, 0x17, 0x0e, 0x3b, 0x79, 0xd2, 0x2f, 0x3c,
+	0x79, 0xd4, 0x85, 0xef, 0xd7, 0x49, 0x98, 0x35, 0x88, 0xfd, 0xbf, 0xbf, 0x2d, 0x1b, 0x00, 0x7e,
+	0xa8, 0x8a, 0x4c, 0xaa, 0xa7, 0x8e, 0x1b, 0xef, 0x93, 0x3e, 0x48, 0x95, 0xf1, 0xff, 0xd6, 0x0e,
+	0xfd, 0x25, 0x01, 0x53, 0xf1, 0x1d, 0xfa, 0xbf, 0x3c, 0xb4, 0xd0, 0x5a, 0x94, 0xa6, 0x52, 0x32,
+	0x4d, 0xbd, 0x31, 0x2a, 0x4d, 0x0d, 0x79, 0xf3, 0x21, 0xf9, 0xe9, 0xf9, 0x09, 0x48, 0x6f, 0x60,
+	0x0f, 0x77, 0x18, 0x5a, 0x1f, 0xba, 0xdb, 0x06, 0x55, 0x81, 0x41, 0x67, 0xae, 0xaa, 0x2a, 0x88,
+	0xef, 0xcb, 0x1f, 0x8f, 0xba, 0xda, 0x7e, 0x0d, 0x66, 0xc4, 0x1b, 0x39, 0x34, 0xc8, 0x27, 0x77,
+	0x5a, 0x3e, 0x75, 0x43, 0xeb, 0x19, 0x9a, 0x87, 0x8c, 0x10, 0x8b, 0xf2, 0xb0, 0x90, 0x81, 0x0e,
+	0xbe, 0xbb, 0xea, 0xf7, 0xa0, 0x45, 0x40, 0x3b, 0x61, 0xe1, 0xc2, 0x8c, 0x88, 0xd0, 0x16, 0xa6,
+	0x2b, 0x09, 0x5d, 0x33, 0x66, 0xa3, 0xd1, 0x40, 0xe5, 0x22, 0x80, 0x58, 0x89, 0x69, 0x13, 0xea,
+	0x76, 0xd4, 0x63, 0x6f, 0x52, 0xf4, 0x54, 0x45, 0x07, 0xfa, 0x40, 0xf3, 0xaf, 0xc9, 0x03, 0xaf,
+	0x69, 0xf5, 0x4a, 0xd9, 0x7c, 0x89, 0xc0, 0xf8, 0xd7, 0x93, 0xf9, 0x7c, 0x0f, 0x77, 0xda, 0xcb,
+	0xc5, 0x03, 0x70, 0x8a, 0x07, 0x3d, 0xf0, 0xc5, 0xe5, 0xb9, 0xff, 0x35, 0x8e, 0x6a, 0x90, 0xdb,
+	0x25, 0x3d, 0xd3, 0x73, 0xb9, 0x9f, 0x6c, 0x9a, 0x84, 0xe8, 0xe3, 0x61, 0x25, 0x46, 0xaa, 0x8b,
+	0xea, 0x50, 0xec, 0xfa, 0xef, 0xd0, 0x4a, 0x4a, 0xac, 0xce, 0x98, 0xd9, 0x25, 0x3d, 0x43, 0xe9,
+	0xdd, 0x24, 0x04, 0xf1, 0x83, 0xef, 0xfd, 0x13, 0xd2, 0xa0, 0xea, 0xd1, 0xee, 0xfd, 0xb9, 0xbb,
+	0x41, 0x3d, 0xad, 0xb0, 0x7f, 0xad, 0xb4, 0x54, 0xba, 0x16, 0x19, 0x30, 0x70, 0xfb, 0xff, 0x40,
+	0x83, 0xc2, 0x01, 0xd3, 0x9a, 0x2d, 0x0f, 0x5b, 0xc4, 0xec, 0x12, 0xcf, 0x71, 0x6d, 0x7d, 0xf2,
+	0x30, 0x2f, 0xba, 0x14, 0x78, 0xd1, 0xe8, 0xb9, 0x2f, 0x0c, 0xcd, 0x7d, 0x4b, 0x4c, 0xb1, 0x21,
+	0x67, 0x58, 0xbe, 0x2c, 0x52, 0xc5, 0xbd, 0x2f, 0x3e, 0xbd, 0x72, 0x3e, 0x7a, 0xb1, 0x94, 0x43,
+	0xa0, 0xb2, 0xef, 0xdf, 0xe2, 0xd6, 0x8f, 0x22, 0x7d, 0x83, 0xb0, 0xae, 0x4b, 0x99, 0x7c, 0x80,
+	0xc5, 0x08, 0xd3, 0x5e, 0xfc, 0x00, 0x8b, 0xf4, 0xfb, 0x1e, 0x60, 0xb1, 0xfc, 0xf4, 0x56, 0x74,
+	0x00, 0x26, 0x0e, 0xdb, 0xca, 0x78, 0x68, 0x2a, 0x25, 0x99, 0xf6, 0xc6, 0x8a, 0x7f, 0xd2, 0xe0,
+	0xdc, 0x50, 0x28, 0x87, 0x4b, 0xb6, 0x00, 0x79, 0xb1, 0x41, 0x19, 0x12, 0x3d, 0xb5, 0xf4, 0xe3,
+	0x65, 0x86, 0x59, 0x6f, 0x70, 0xf4, 0x4b, 0x3a, 0xc9, 0x55, 0x1a, 0xff, 0x83, 0x06, 0xa7, 0xe2,
+	0x0b, 0x08, 0x4d, 0xa9, 0xc3, 0x54, 0x7c, 0x6a, 0x65, 0xc4, 0xe5, 0x97, 0x31, 0x22, 0xbe, 0xfe,
+	0x3e, 0x10, 0xb4, 0x1d, 0xa5, 0x4b, 0xbf, 0x34, 0xb9, 0xf8, 0xd2, 0xa4, 0x04, 0x0b, 0x3b, 0x30,
+	0x6d, 0xfa, 0x7b, 0xf3, 0x0f, 0x0d, 0x52, 0x1b, 0xae, 0xdb, 0x46, 0x3f, 0x83, 0x59, 0xea, 0x72,
+	0x53, 0xa4, 0x15, 0x62, 0x9b, 0xaa, 0x6e, 0xe2, 0x1f, 0x45, 0xab, 0x2f, 0xe4, 0xea, 0xef, 0x4f,
+	0xe6, 0x87, 0x35, 0xfb, 0x09, 0x54, 0xe5, 0x39, 0xea, 0xf2, 0x8a, 0x14, 0xda, 0x94, 0x32, 0xa8,
+	0x09, 0xd3, 0xfd, 0xd3, 0xf9, 0xc7, 0xd5, 0x8d, 0xc3, 0xa6, 0x9b, 0x3e, 0x74, 0xaa, 0xa9, 0x46,
+	0x6c, 0x9e, 0xe5, 0x09, 0xb1, 0x6b, 0xff, 0x14, 0x3b, 0xf7, 0x3e, 0xe4, 0xc2, 0x5c, 0xbd, 0x25,
+	0x6b, 0x7b, 0x0c, 0xdd, 0x84, 0x71, 0xbf, 0xcc, 0x17, 0xbc, 0x94, 0x2e, 0x45, 0x85, 0x63, 0x51,
+	0x7a, 0x16, 0x75, 0xe3, 0x01, 0xa5, 0x3e, 0x3e, 0x95, 0xb2, 0xac, 0xfd, 0x3e, 0x4a, 0xc0, 0xb9,
+	0x15, 0x97, 0x32, 0x55, 0xe5, 0x52, 0x29, 0xcd, 0x2f, 0x54, 0xf7, 0x44, 0x69, 0xe6, 0xc0, 0x1a,
+	0xdc, 0xd4, 0x70, 0xa5, 0x6d, 0x1b, 0xb2, 0xe2, 0x7e, 0x61, 0xb9, 0xf4, 0x15, 0x0b, 0x6d, 0xd3,
+	0x6e, 0xdb, 0x56, 0x2b, 0x12, 0x65, 0xb6, 0x6d, 0xc8, 0x52, 0x72, 0xa7, 0x0f, 0x37, 0x79, 0x3c,
+	0x5c, 0x4a, 0xee, 0xc4, 0x70, 0xcf, 0x88, 0xe2, 0xbb, 0xbc, 0x5c, 0xa6, 0xe4, 0xd5, 0x49, 0xb5,
+	0xd0, 0x75, 0x48, 0x8a, 0x73, 0xe0, 0xc4, 0x11, 0x92, 0x87, 0x50, 0x88, 0x9d, 0xe9, 0x75, 0x38,
+	0xa7, 0xca, 0x24, 0x6c, 0xbd, 0x29, 0x19, 0x25, 0xd2, 0xa0, 0x77, 0x48, 0xef, 0x80, 0x9a, 0xc9,
+	0xd4, 0x4b, 0xd5, 0x4c, 0xae, 0xfc, 0x4e, 0x03, 0x88, 0x0a, 0x86, 0xe8, 0x9b, 0x70, 0xb6, 0xb2,
+	0xbe, 0x56, 0x35, 0xeb, 0x9b, 0x37, 0x36, 0xb7, 0xea, 0xe6, 0xd6, 0x5a, 0x7d, 0x63, 0x75, 0xa5,
+	0x76, 0xb3, 0xb6, 0x5a, 0xcd, 0x8d, 0xe5, 0xb3, 0xf7, 0xee, 0x17, 0x32, 0x5b, 0x94, 0x75, 0x89,
+	0xe5, 0x34, 0x1d, 0x62, 0xa3, 0xaf, 0xc3, 0xa9, 0x7e, 0x69, 0xd1, 0x5a, 0xad, 0xe6, 0xb4, 0xfc,
+	0xd4, 0xbd, 0xfb, 0x85, 0x09, 0xff, 0x81, 0x44, 0x6c, 0xb4, 0x00, 0xa7, 0x87, 0xe5, 0x6a, 0x6b,
+	0xb7, 0x72, 0x89, 0xfc, 0xf4, 0xbd, 0xfb, 0x85, 0xc9, 0xf0, 0x25, 0x85, 0x8a, 0x80, 0xe2, 0x92,
+	0x0a, 0x2f, 0x99, 0x87, 0x7b, 0xf7, 0x0b, 0x69, 0x3f, 0x64, 0xf2, 0xa9, 0x0f, 0x7f, 0x33, 0x37,
+	0x76, 0xe5, 0x27, 0x00, 0x35, 0xda, 0xf4, 0xb0, 0x25, 0x53, 0x43, 0x1e, 0xce, 0xd4, 0xd6, 0x6e,
+	0x1a, 0x37, 0x56, 0x36, 0x6b, 0xeb, 0x6b, 0xfd, 0xcb, 0x1e, 0x18, 0xab, 0xae, 0x6f, 0x55, 0xde,
+	0x5d, 0x35, 0xeb, 0xb5, 0x5b, 0x6b, 0x39, 0x0d, 0x9d, 0x85, 0x93, 0x7d, 0x63, 0xdf, 0x5f, 0xdb,
+	0xac, 0xbd, 0xb7, 0x9a, 0x4b, 0x54, 0xae, 0x7f, 0xf6, 0x74, 0x4e, 0x7b, 0xf4, 0x74, 0x4e, 0xfb,
+	0xdb, 0xd3, 0x39, 0xed, 0xa3, 0x67, 0x73, 0x63, 0x8f, 0x9e, 0xcd, 0x8d, 0xfd, 0xf9, 0xd9, 0xdc,
+	0xd8, 0x8f, 0x2e, 0xf4, 0x05, 0x63, 0x74, 0x1c, 0xc9, 0xbf, 0x56, 0x1a, 0x69, 0xe9, 0x35, 0xdf,
+	0xfa, 0xcf, 0x00, 0xe8, 0x3c, 0x03, 0xbc, 0xd2, 0x1a, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1523,733 +1539,812 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11605 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x23, 0x57,
-		0x76, 0x1f, 0x3c, 0x0d, 0x80, 0x20, 0x70, 0x00, 0x10, 0xcd, 0x4b, 0xce, 0x0c, 0x87, 0x23, 0x0d,
-		0x39, 0x18, 0x49, 0x33, 0x1a, 0x49, 0x1c, 0xcd, 0x48, 0x1a, 0x49, 0xd0, 0xcb, 0x00, 0x09, 0x72,
-		0x30, 0xe2, 0x6b, 0x1b, 0x20, 0x57, 0x92, 0x1f, 0xed, 0x66, 0xe3, 0x92, 0x6c, 0x0d, 0xd0, 0xdd,
-		0xdb, 0xdd, 0x98, 0x21, 0xf5, 0x7d, 0xf5, 0xd5, 0xfa, 0xf3, 0xe3, 0xb3, 0xe5, 0xc7, 0xb7, 0x8e,
-		0x1d, 0x7b, 0xbd, 0xbb, 0xb3, 0x5e, 0xdb, 0xb1, 0x77, 0xd7, 0x4e, 0x1c, 0x3b, 0xbb, 0x7e, 0xc5,
-		0x95, 0x87, 0x53, 0x89, 0x63, 0x3b, 0x15, 0x67, 0xe3, 0xaa, 0x24, 0x2e, 0x57, 0x59, 0xb1, 0x77,
-		0x5d, 0xe5, 0x8d, 0x77, 0xed, 0xd8, 0xce, 0xae, 0xcb, 0x95, 0xad, 0xa4, 0x52, 0xf7, 0xd5, 0x0f,
-		0xa0, 0x41, 0x80, 0x23, 0x69, 0xed, 0xd8, 0xf9, 0x67, 0x86, 0x7d, 0xef, 0x39, 0xbf, 0xbe, 0xf7,
-		0xdc, 0x73, 0xcf, 0x39, 0xf7, 0xdc, 0xdb, 0x17, 0xf0, 0xfb, 0x55, 0x98, 0xdf, 0xb3, 0xac, 0xbd,
-		0x36, 0xbe, 0x62, 0x3b, 0x96, 0x67, 0xed, 0x74, 0x77, 0xaf, 0xb4, 0xb0, 0xab, 0x3b, 0x86, 0xed,
-		0x59, 0xce, 0x02, 0x2d, 0x43, 0x45, 0x46, 0xb1, 0x20, 0x28, 0x4a, 0x6b, 0x30, 0xb9, 0x6c, 0xb4,
-		0xf1, 0x92, 0x4f, 0xd8, 0xc0, 0x1e, 0x7a, 0x06, 0x52, 0xbb, 0x46, 0x1b, 0xcf, 0x48, 0xf3, 0xc9,
-		0x4b, 0xb9, 0x6b, 0x0f, 0x2c, 0xf4, 0x30, 0x2d, 0x44, 0x39, 0x36, 0x49, 0xb1, 0x42, 0x39, 0x4a,
-		0xff, 0x33, 0x05, 0x53, 0x31, 0xb5, 0x08, 0x41, 0xca, 0xd4, 0x3a, 0x04, 0x51, 0xba, 0x94, 0x55,
-		0xe8, 0xdf, 0x68, 0x06, 0xc6, 0x6d, 0x4d, 0xbf, 0xa5, 0xed, 0xe1, 0x99, 0x04, 0x2d, 0x16, 0x8f,
-		0xe8, 0x1c, 0x40, 0x0b, 0xdb, 0xd8, 0x6c, 0x61, 0x53, 0x3f, 0x9c, 0x49, 0xce, 0x27, 0x2f, 0x65,
-		0x95, 0x50, 0x09, 0x7a, 0x04, 0x26, 0xed, 0xee, 0x4e, 0xdb, 0xd0, 0xd5, 0x10, 0x19, 0xcc, 0x27,
-		0x2f, 0x8d, 0x29, 0x32, 0xab, 0x58, 0x0a, 0x88, 0x2f, 0x42, 0xf1, 0x0e, 0xd6, 0x6e, 0x85, 0x49,
-		0x73, 0x94, 0x74, 0x82, 0x14, 0x87, 0x08, 0x17, 0x21, 0xdf, 0xc1, 0xae, 0xab, 0xed, 0x61, 0xd5,
-		0x3b, 0xb4, 0xf1, 0x4c, 0x8a, 0xf6, 0x7e, 0xbe, 0xaf, 0xf7, 0xbd, 0x3d, 0xcf, 0x71, 0xae, 0xe6,
-		0xa1, 0x8d, 0x51, 0x05, 0xb2, 0xd8, 0xec, 0x76, 0x18, 0xc2, 0xd8, 0x00, 0xf9, 0xd5, 0xcc, 0x6e,
-		0xa7, 0x17, 0x25, 0x43, 0xd8, 0x38, 0xc4, 0xb8, 0x8b, 0x9d, 0xdb, 0x86, 0x8e, 0x67, 0xd2, 0x14,
-		0xe0, 0x62, 0x1f, 0x40, 0x83, 0xd5, 0xf7, 0x62, 0x08, 0x3e, 0xb4, 0x08, 0x59, 0x7c, 0xe0, 0x61,
-		0xd3, 0x35, 0x2c, 0x73, 0x66, 0x9c, 0x82, 0x3c, 0x18, 0x33, 0x8a, 0xb8, 0xdd, 0xea, 0x85, 0x08,
-		0xf8, 0xd0, 0x75, 0x18, 0xb7, 0x6c, 0xcf, 0xb0, 0x4c, 0x77, 0x26, 0x33, 0x2f, 0x5d, 0xca, 0x5d,
-		0xbb, 0x2f, 0x56, 0x11, 0x36, 0x18, 0x8d, 0x22, 0x88, 0x51, 0x1d, 0x64, 0xd7, 0xea, 0x3a, 0x3a,
-		0x56, 0x75, 0xab, 0x85, 0x55, 0xc3, 0xdc, 0xb5, 0x66, 0xb2, 0x14, 0x60, 0xae, 0xbf, 0x23, 0x94,
-		0x70, 0xd1, 0x6a, 0xe1, 0xba, 0xb9, 0x6b, 0x29, 0x13, 0x6e, 0xe4, 0x19, 0x9d, 0x82, 0xb4, 0x7b,
-		0x68, 0x7a, 0xda, 0xc1, 0x4c, 0x9e, 0x6a, 0x08, 0x7f, 0x22, 0xaa, 0x83, 0x5b, 0x06, 0x79, 0xdd,
-		0x4c, 0x81, 0xa9, 0x0e, 0x7f, 0x2c, 0xfd, 0x52, 0x1a, 0x8a, 0xa3, 0x28, 0xdf, 0x73, 0x30, 0xb6,
-		0x4b, 0xfa, 0x3f, 0x93, 0x38, 0x8e, 0x74, 0x18, 0x4f, 0x54, 0xbc, 0xe9, 0x7b, 0x14, 0x6f, 0x05,
-		0x72, 0x26, 0x76, 0x3d, 0xdc, 0x62, 0xba, 0x92, 0x1c, 0x51, 0xdb, 0x80, 0x31, 0xf5, 0x2b, 0x5b,
-		0xea, 0x9e, 0x94, 0xed, 0x15, 0x28, 0xfa, 0x4d, 0x52, 0x1d, 0xcd, 0xdc, 0x13, 0x5a, 0x7b, 0x65,
-		0x58, 0x4b, 0x16, 0x6a, 0x82, 0x4f, 0x21, 0x6c, 0xca, 0x04, 0x8e, 0x3c, 0xa3, 0x25, 0x00, 0xcb,
-		0xc4, 0xd6, 0xae, 0xda, 0xc2, 0x7a, 0x7b, 0x26, 0x33, 0x40, 0x4a, 0x1b, 0x84, 0xa4, 0x4f, 0x4a,
-		0x16, 0x2b, 0xd5, 0xdb, 0xe8, 0xd9, 0x40, 0x09, 0xc7, 0x07, 0xe8, 0xd0, 0x1a, 0x9b, 0x7e, 0x7d,
-		0x7a, 0xb8, 0x05, 0x13, 0x0e, 0x26, 0x33, 0x02, 0xb7, 0x78, 0xcf, 0xb2, 0xb4, 0x11, 0x0b, 0x43,
-		0x7b, 0xa6, 0x70, 0x36, 0xd6, 0xb1, 0x82, 0x13, 0x7e, 0x44, 0x17, 0xc0, 0x2f, 0x50, 0xa9, 0x5a,
-		0x01, 0xb5, 0x4f, 0x79, 0x51, 0xb8, 0xae, 0x75, 0xf0, 0xec, 0x1b, 0x30, 0x11, 0x15, 0x0f, 0x9a,
-		0x86, 0x31, 0xd7, 0xd3, 0x1c, 0x8f, 0x6a, 0xe1, 0x98, 0xc2, 0x1e, 0x90, 0x0c, 0x49, 0x6c, 0xb6,
-		0xa8, 0xfd, 0x1b, 0x53, 0xc8, 0x9f, 0xe8, 0x6b, 0x82, 0x0e, 0x27, 0x69, 0x87, 0x1f, 0xea, 0x1f,
-		0xd1, 0x08, 0x72, 0x6f, 0xbf, 0x67, 0x9f, 0x86, 0x42, 0xa4, 0x03, 0xa3, 0xbe, 0xba, 0xf4, 0x7f,
-		0xc3, 0xc9, 0x58, 0x68, 0xf4, 0x0a, 0x4c, 0x77, 0x4d, 0xc3, 0xf4, 0xb0, 0x63, 0x3b, 0x98, 0x68,
-		0x2c, 0x7b, 0xd5, 0xcc, 0x1f, 0x8e, 0x0f, 0xd0, 0xb9, 0xad, 0x30, 0x35, 0x43, 0x51, 0xa6, 0xba,
-		0xfd, 0x85, 0x97, 0xb3, 0x99, 0xcf, 0x8f, 0xcb, 0xef, 0x7f, 0xff, 0xfb, 0xdf, 0x9f, 0x28, 0xfd,
-		0x4a, 0x1a, 0xa6, 0xe3, 0xe6, 0x4c, 0xec, 0xf4, 0x3d, 0x05, 0x69, 0xb3, 0xdb, 0xd9, 0xc1, 0x0e,
-		0x15, 0xd2, 0x98, 0xc2, 0x9f, 0x50, 0x05, 0xc6, 0xda, 0xda, 0x0e, 0x6e, 0xcf, 0xa4, 0xe6, 0xa5,
-		0x4b, 0x13, 0xd7, 0x1e, 0x19, 0x69, 0x56, 0x2e, 0xac, 0x12, 0x16, 0x85, 0x71, 0xa2, 0x17, 0x21,
-		0xc5, 0x8d, 0x37, 0x41, 0xb8, 0x3c, 0x1a, 0x02, 0x99, 0x4b, 0x0a, 0xe5, 0x43, 0x67, 0x21, 0x4b,
-		0xfe, 0x67, 0xba, 0x91, 0xa6, 0x6d, 0xce, 0x90, 0x02, 0xa2, 0x17, 0x68, 0x16, 0x32, 0x74, 0x9a,
-		0xb4, 0xb0, 0x70, 0x7a, 0xfe, 0x33, 0x51, 0xac, 0x16, 0xde, 0xd5, 0xba, 0x6d, 0x4f, 0xbd, 0xad,
-		0xb5, 0xbb, 0x98, 0x2a, 0x7c, 0x56, 0xc9, 0xf3, 0xc2, 0x6d, 0x52, 0x86, 0xe6, 0x20, 0xc7, 0x66,
-		0x95, 0x61, 0xb6, 0xf0, 0x01, 0xb5, 0xab, 0x63, 0x0a, 0x9b, 0x68, 0x75, 0x52, 0x42, 0x5e, 0xff,
-		0xba, 0x6b, 0x99, 0x42, 0x35, 0xe9, 0x2b, 0x48, 0x01, 0x7d, 0xfd, 0xd3, 0xbd, 0x26, 0xfd, 0xfe,
-		0xf8, 0xee, 0xf5, 0xcd, 0xa5, 0x8b, 0x50, 0xa4, 0x14, 0x4f, 0xf0, 0xa1, 0xd7, 0xda, 0x33, 0x93,
-		0xf3, 0xd2, 0xa5, 0x8c, 0x32, 0xc1, 0x8a, 0x37, 0x78, 0x69, 0xe9, 0xe7, 0x13, 0x90, 0xa2, 0x86,
-		0xa5, 0x08, 0xb9, 0xe6, 0xab, 0x9b, 0x35, 0x75, 0x69, 0x63, 0xab, 0xba, 0x5a, 0x93, 0x25, 0x34,
-		0x01, 0x40, 0x0b, 0x96, 0x57, 0x37, 0x2a, 0x4d, 0x39, 0xe1, 0x3f, 0xd7, 0xd7, 0x9b, 0xd7, 0x9f,
-		0x94, 0x93, 0x3e, 0xc3, 0x16, 0x2b, 0x48, 0x85, 0x09, 0x9e, 0xb8, 0x26, 0x8f, 0x21, 0x19, 0xf2,
-		0x0c, 0xa0, 0xfe, 0x4a, 0x6d, 0xe9, 0xfa, 0x93, 0x72, 0x3a, 0x5a, 0xf2, 0xc4, 0x35, 0x79, 0x1c,
-		0x15, 0x20, 0x4b, 0x4b, 0xaa, 0x1b, 0x1b, 0xab, 0x72, 0xc6, 0xc7, 0x6c, 0x34, 0x95, 0xfa, 0xfa,
-		0x8a, 0x9c, 0xf5, 0x31, 0x57, 0x94, 0x8d, 0xad, 0x4d, 0x19, 0x7c, 0x84, 0xb5, 0x5a, 0xa3, 0x51,
-		0x59, 0xa9, 0xc9, 0x39, 0x9f, 0xa2, 0xfa, 0x6a, 0xb3, 0xd6, 0x90, 0xf3, 0x91, 0x66, 0x3d, 0x71,
-		0x4d, 0x2e, 0xf8, 0xaf, 0xa8, 0xad, 0x6f, 0xad, 0xc9, 0x13, 0x68, 0x12, 0x0a, 0xec, 0x15, 0xa2,
-		0x11, 0xc5, 0x9e, 0xa2, 0xeb, 0x4f, 0xca, 0x72, 0xd0, 0x10, 0x86, 0x32, 0x19, 0x29, 0xb8, 0xfe,
-		0xa4, 0x8c, 0x4a, 0x8b, 0x30, 0x46, 0xd5, 0x10, 0x21, 0x98, 0x58, 0xad, 0x54, 0x6b, 0xab, 0xea,
-		0xc6, 0x66, 0xb3, 0xbe, 0xb1, 0x5e, 0x59, 0x95, 0xa5, 0xa0, 0x4c, 0xa9, 0xbd, 0x67, 0xab, 0xae,
-		0xd4, 0x96, 0xe4, 0x44, 0xb8, 0x6c, 0xb3, 0x56, 0x69, 0xd6, 0x96, 0xe4, 0x64, 0x49, 0x87, 0xe9,
-		0x38, 0x83, 0x1a, 0x3b, 0x85, 0x42, 0xba, 0x90, 0x18, 0xa0, 0x0b, 0x14, 0xab, 0x57, 0x17, 0x4a,
-		0x9f, 0x4b, 0xc0, 0x54, 0x8c, 0x53, 0x89, 0x7d, 0xc9, 0x4b, 0x30, 0xc6, 0x74, 0x99, 0xb9, 0xd9,
-		0x87, 0x63, 0xbd, 0x13, 0xd5, 0xec, 0x3e, 0x57, 0x4b, 0xf9, 0xc2, 0x41, 0x48, 0x72, 0x40, 0x10,
-		0x42, 0x20, 0xfa, 0x14, 0xf6, 0xeb, 0xfb, 0x8c, 0x3f, 0xf3, 0x8f, 0xd7, 0x47, 0xf1, 0x8f, 0xb4,
-		0xec, 0x78, 0x4e, 0x60, 0x2c, 0xc6, 0x09, 0x3c, 0x07, 0x93, 0x7d, 0x40, 0x23, 0x1b, 0xe3, 0x6f,
-		0x96, 0x60, 0x66, 0x90, 0x70, 0x86, 0x98, 0xc4, 0x44, 0xc4, 0x24, 0x3e, 0xd7, 0x2b, 0xc1, 0xf3,
-		0x83, 0x07, 0xa1, 0x6f, 0xac, 0x3f, 0x2e, 0xc1, 0xa9, 0xf8, 0x60, 0x33, 0xb6, 0x0d, 0x2f, 0x42,
-		0xba, 0x83, 0xbd, 0x7d, 0x4b, 0x84, 0x55, 0x0f, 0xc5, 0x38, 0x6b, 0x52, 0xdd, 0x3b, 0xd8, 0x9c,
-		0x2b, 0xec, 0xed, 0x93, 0x83, 0x22, 0x46, 0xd6, 0x9a, 0xbe, 0x96, 0x7e, 0x47, 0x02, 0x4e, 0xc6,
-		0x82, 0xc7, 0x36, 0xf4, 0x7e, 0x00, 0xc3, 0xb4, 0xbb, 0x1e, 0x0b, 0x9d, 0x98, 0x25, 0xce, 0xd2,
-		0x12, 0x6a, 0xbc, 0x88, 0x95, 0xed, 0x7a, 0x7e, 0x7d, 0x92, 0xd6, 0x03, 0x2b, 0xa2, 0x04, 0xcf,
-		0x04, 0x0d, 0x4d, 0xd1, 0x86, 0x9e, 0x1b, 0xd0, 0xd3, 0x3e, 0xc5, 0x7c, 0x1c, 0x64, 0xbd, 0x6d,
-		0x60, 0xd3, 0x53, 0x5d, 0xcf, 0xc1, 0x5a, 0xc7, 0x30, 0xf7, 0xa8, 0xab, 0xc9, 0x94, 0xc7, 0x76,
-		0xb5, 0xb6, 0x8b, 0x95, 0x22, 0xab, 0x6e, 0x88, 0x5a, 0xc2, 0x41, 0x15, 0xc8, 0x09, 0x71, 0xa4,
-		0x23, 0x1c, 0xac, 0xda, 0xe7, 0x28, 0x7d, 0x6f, 0x16, 0x72, 0xa1, 0xd0, 0x1c, 0x9d, 0x87, 0xfc,
-		0xeb, 0xda, 0x6d, 0x4d, 0x15, 0xcb, 0x2d, 0x26, 0x89, 0x1c, 0x29, 0xdb, 0xe4, 0x4b, 0xae, 0xc7,
-		0x61, 0x9a, 0x92, 0x58, 0x5d, 0x0f, 0x3b, 0xaa, 0xde, 0xd6, 0x5c, 0x97, 0x0a, 0x2d, 0x43, 0x49,
-		0x11, 0xa9, 0xdb, 0x20, 0x55, 0x8b, 0xa2, 0x06, 0x3d, 0x05, 0x53, 0x94, 0xa3, 0xd3, 0x6d, 0x7b,
-		0x86, 0xdd, 0xc6, 0x2a, 0x59, 0x00, 0xba, 0xd4, 0xe5, 0xf8, 0x2d, 0x9b, 0x24, 0x14, 0x6b, 0x9c,
-		0x80, 0xb4, 0xc8, 0x45, 0x4b, 0x70, 0x3f, 0x65, 0xdb, 0xc3, 0x26, 0x76, 0x34, 0x0f, 0xab, 0xf8,
-		0x7d, 0x5d, 0xad, 0xed, 0xaa, 0x9a, 0xd9, 0x52, 0xf7, 0x35, 0x77, 0x7f, 0x66, 0x9a, 0x00, 0x54,
-		0x13, 0x33, 0x92, 0x72, 0x86, 0x10, 0xae, 0x70, 0xba, 0x1a, 0x25, 0xab, 0x98, 0xad, 0x1b, 0x9a,
-		0xbb, 0x8f, 0xca, 0x70, 0x8a, 0xa2, 0xb8, 0x9e, 0x63, 0x98, 0x7b, 0xaa, 0xbe, 0x8f, 0xf5, 0x5b,
-		0x6a, 0xd7, 0xdb, 0x7d, 0x66, 0xe6, 0x6c, 0xf8, 0xfd, 0xb4, 0x85, 0x0d, 0x4a, 0xb3, 0x48, 0x48,
-		0xb6, 0xbc, 0xdd, 0x67, 0x50, 0x03, 0xf2, 0x64, 0x30, 0x3a, 0xc6, 0x1b, 0x58, 0xdd, 0xb5, 0x1c,
-		0xea, 0x43, 0x27, 0x62, 0x4c, 0x53, 0x48, 0x82, 0x0b, 0x1b, 0x9c, 0x61, 0xcd, 0x6a, 0xe1, 0xf2,
-		0x58, 0x63, 0xb3, 0x56, 0x5b, 0x52, 0x72, 0x02, 0x65, 0xd9, 0x72, 0x88, 0x42, 0xed, 0x59, 0xbe,
-		0x80, 0x73, 0x4c, 0xa1, 0xf6, 0x2c, 0x21, 0xde, 0xa7, 0x60, 0x4a, 0xd7, 0x59, 0x9f, 0x0d, 0x5d,
-		0xe5, 0xcb, 0x34, 0x77, 0x46, 0x8e, 0x08, 0x4b, 0xd7, 0x57, 0x18, 0x01, 0xd7, 0x71, 0x17, 0x3d,
-		0x0b, 0x27, 0x03, 0x61, 0x85, 0x19, 0x27, 0xfb, 0x7a, 0xd9, 0xcb, 0xfa, 0x14, 0x4c, 0xd9, 0x87,
-		0xfd, 0x8c, 0x28, 0xf2, 0x46, 0xfb, 0xb0, 0x97, 0xed, 0x69, 0x98, 0xb6, 0xf7, 0xed, 0x7e, 0xbe,
-		0xcb, 0x61, 0x3e, 0x64, 0xef, 0xdb, 0xbd, 0x8c, 0x0f, 0xd2, 0x35, 0xbb, 0x83, 0x75, 0xcd, 0xc3,
-		0xad, 0x99, 0xd3, 0x61, 0xf2, 0x50, 0x05, 0x5a, 0x00, 0x59, 0xd7, 0x55, 0x6c, 0x6a, 0x3b, 0x6d,
-		0xac, 0x6a, 0x0e, 0x36, 0x35, 0x77, 0x66, 0x8e, 0x12, 0xa7, 0x3c, 0xa7, 0x8b, 0x95, 0x09, 0x5d,
-		0xaf, 0xd1, 0xca, 0x0a, 0xad, 0x43, 0x97, 0x61, 0xd2, 0xda, 0x79, 0x5d, 0x67, 0x1a, 0xa9, 0xda,
-		0x0e, 0xde, 0x35, 0x0e, 0x66, 0x1e, 0xa0, 0xe2, 0x2d, 0x92, 0x0a, 0xaa, 0x8f, 0x9b, 0xb4, 0x18,
-		0x3d, 0x0c, 0xb2, 0xee, 0xee, 0x6b, 0x8e, 0x4d, 0x4d, 0xb2, 0x6b, 0x6b, 0x3a, 0x9e, 0x79, 0x90,
-		0x91, 0xb2, 0xf2, 0x75, 0x51, 0x4c, 0x66, 0x84, 0x7b, 0xc7, 0xd8, 0xf5, 0x04, 0xe2, 0x45, 0x36,
-		0x23, 0x68, 0x19, 0x47, 0xbb, 0x04, 0x32, 0x91, 0x44, 0xe4, 0xc5, 0x97, 0x28, 0xd9, 0x84, 0xbd,
-		0x6f, 0x87, 0xdf, 0x7b, 0x01, 0x0a, 0x84, 0x32, 0x78, 0xe9, 0xc3, 0x2c, 0x70, 0xb3, 0xf7, 0x43,
-		0x6f, 0x7c, 0x12, 0x4e, 0x11, 0xa2, 0x0e, 0xf6, 0xb4, 0x96, 0xe6, 0x69, 0x21, 0xea, 0x47, 0x29,
-		0x35, 0x11, 0xfb, 0x1a, 0xaf, 0x8c, 0xb4, 0xd3, 0xe9, 0xee, 0x1c, 0xfa, 0x8a, 0xf5, 0x18, 0x6b,
-		0x27, 0x29, 0x13, 0xaa, 0xf5, 0xae, 0x05, 0xe7, 0xa5, 0x32, 0xe4, 0xc3, 0x7a, 0x8f, 0xb2, 0xc0,
-		0x34, 0x5f, 0x96, 0x48, 0x10, 0xb4, 0xb8, 0xb1, 0x44, 0xc2, 0x97, 0xd7, 0x6a, 0x72, 0x82, 0x84,
-		0x51, 0xab, 0xf5, 0x66, 0x4d, 0x55, 0xb6, 0xd6, 0x9b, 0xf5, 0xb5, 0x9a, 0x9c, 0x0c, 0x05, 0xf6,
-		0x37, 0x53, 0x99, 0x87, 0xe4, 0x8b, 0xa5, 0x5f, 0x4e, 0xc2, 0x44, 0x74, 0xa5, 0x86, 0x9e, 0x87,
-		0xd3, 0x22, 0xe1, 0xe2, 0x62, 0x4f, 0xbd, 0x63, 0x38, 0x74, 0x42, 0x76, 0x34, 0xe6, 0x1c, 0x7d,
-		0xfd, 0x99, 0xe6, 0x54, 0x0d, 0xec, 0xbd, 0xd7, 0x70, 0xc8, 0x74, 0xeb, 0x68, 0x1e, 0x5a, 0x85,
-		0x39, 0xd3, 0x52, 0x5d, 0x4f, 0x33, 0x5b, 0x9a, 0xd3, 0x52, 0x83, 0x54, 0x97, 0xaa, 0xe9, 0x3a,
-		0x76, 0x5d, 0x8b, 0x39, 0x42, 0x1f, 0xe5, 0x3e, 0xd3, 0x6a, 0x70, 0xe2, 0xc0, 0x43, 0x54, 0x38,
-		0x69, 0x8f, 0xfa, 0x26, 0x07, 0xa9, 0xef, 0x59, 0xc8, 0x76, 0x34, 0x5b, 0xc5, 0xa6, 0xe7, 0x1c,
-		0xd2, 0xf8, 0x3c, 0xa3, 0x64, 0x3a, 0x9a, 0x5d, 0x23, 0xcf, 0x68, 0x1b, 0x1e, 0x0a, 0x48, 0xd5,
-		0x36, 0xde, 0xd3, 0xf4, 0x43, 0x95, 0x06, 0xe3, 0x34, 0x6d, 0xa0, 0xea, 0x96, 0xb9, 0xdb, 0x36,
-		0x74, 0xcf, 0xa5, 0xf6, 0x81, 0xd9, 0xb8, 0x52, 0xc0, 0xb1, 0x4a, 0x19, 0x6e, 0xba, 0x96, 0x49,
-		0x63, 0xf0, 0x45, 0x41, 0xfd, 0x55, 0x59, 0x7e, 0xdd, 0x4c, 0x65, 0x52, 0xf2, 0xd8, 0xcd, 0x54,
-		0x66, 0x4c, 0x4e, 0xdf, 0x4c, 0x65, 0xd2, 0xf2, 0xf8, 0xcd, 0x54, 0x26, 0x23, 0x67, 0x6f, 0xa6,
-		0x32, 0x59, 0x19, 0x4a, 0xbf, 0x90, 0x81, 0x7c, 0x78, 0x65, 0x40, 0x16, 0x5a, 0x3a, 0xf5, 0x8d,
-		0x12, 0xb5, 0x9e, 0x17, 0x8e, 0x5c, 0x47, 0x2c, 0x2c, 0x12, 0xa7, 0x59, 0x4e, 0xb3, 0x30, 0x5c,
-		0x61, 0x9c, 0x24, 0x60, 0x21, 0x6a, 0x8d, 0x59, 0xd8, 0x93, 0x51, 0xf8, 0x13, 0x5a, 0x81, 0xf4,
-		0xeb, 0x2e, 0xc5, 0x4e, 0x53, 0xec, 0x07, 0x8e, 0xc6, 0xbe, 0xd9, 0xa0, 0xe0, 0xd9, 0x9b, 0x0d,
-		0x75, 0x7d, 0x43, 0x59, 0xab, 0xac, 0x2a, 0x9c, 0x1d, 0x9d, 0x81, 0x54, 0x5b, 0x7b, 0xe3, 0x30,
-		0xea, 0x5e, 0x69, 0x11, 0x5a, 0x80, 0x62, 0xd7, 0xbc, 0x8d, 0x1d, 0x63, 0xd7, 0x20, 0x43, 0x45,
-		0xa8, 0x8a, 0x61, 0xaa, 0x89, 0xa0, 0x76, 0x95, 0xd0, 0x8f, 0xa8, 0x1e, 0x67, 0x20, 0x75, 0x07,
-		0x6b, 0xb7, 0xa2, 0x4e, 0x90, 0x16, 0xa1, 0x4b, 0x90, 0x6f, 0xe1, 0x9d, 0xee, 0x9e, 0xea, 0xe0,
-		0x96, 0xa6, 0x7b, 0x51, 0xd3, 0x9f, 0xa3, 0x55, 0x0a, 0xad, 0x41, 0x2f, 0x43, 0x96, 0x8c, 0x91,
-		0x49, 0xc7, 0x78, 0x92, 0x8a, 0xe0, 0xb1, 0xa3, 0x45, 0xc0, 0x87, 0x58, 0x30, 0x29, 0x01, 0x3f,
-		0x5a, 0x86, 0xb4, 0xa7, 0x39, 0x7b, 0xd8, 0xa3, 0x96, 0x7f, 0x22, 0x26, 0xf9, 0x11, 0x83, 0xd4,
-		0xa4, 0x1c, 0x74, 0x4d, 0xcb, 0xb9, 0xdf, 0x45, 0x2b, 0x73, 0x05, 0xc6, 0xa8, 0x7a, 0x20, 0x00,
-		0xae, 0x20, 0xf2, 0x09, 0x94, 0x81, 0xd4, 0xe2, 0x86, 0x42, 0x2c, 0x8d, 0x0c, 0x79, 0x56, 0xaa,
-		0x6e, 0xd6, 0x6b, 0x8b, 0x35, 0x39, 0x51, 0x7a, 0x0a, 0xd2, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x47,
-		0x5d, 0x3e, 0xc1, 0x1f, 0x39, 0x86, 0x24, 0x6a, 0xb7, 0xd6, 0xaa, 0x35, 0x45, 0x4e, 0x94, 0xb6,
-		0xa0, 0xd8, 0x23, 0x27, 0x74, 0x12, 0x26, 0x95, 0x5a, 0xb3, 0xb6, 0x4e, 0xd6, 0x59, 0xea, 0xd6,
-		0xfa, 0xcb, 0xeb, 0x1b, 0xef, 0x5d, 0x97, 0x4f, 0x44, 0x8b, 0x85, 0x49, 0x93, 0xd0, 0x34, 0xc8,
-		0x41, 0x71, 0x63, 0x63, 0x4b, 0xa1, 0xad, 0xf9, 0xae, 0x04, 0xc8, 0xbd, 0x52, 0x43, 0xa7, 0x61,
-		0xaa, 0x59, 0x51, 0x56, 0x6a, 0x4d, 0x95, 0xad, 0x1d, 0x7d, 0xe8, 0x69, 0x90, 0xc3, 0x15, 0xcb,
-		0x75, 0xba, 0x34, 0x9e, 0x83, 0xb3, 0xe1, 0xd2, 0xda, 0x2b, 0xcd, 0xda, 0x7a, 0x83, 0xbe, 0xbc,
-		0xb2, 0xbe, 0x42, 0xec, 0x6b, 0x0f, 0x9e, 0x58, 0xad, 0x26, 0x49, 0x53, 0xa3, 0x78, 0xb5, 0xd5,
-		0x25, 0x39, 0xd5, 0x5b, 0xbc, 0xb1, 0x5e, 0xdb, 0x58, 0x96, 0xc7, 0x7a, 0xdf, 0x4e, 0x57, 0xb0,
-		0x69, 0x34, 0x0b, 0xa7, 0x7a, 0x4b, 0xd5, 0xda, 0x7a, 0x53, 0x79, 0x55, 0x1e, 0xef, 0x7d, 0x71,
-		0xa3, 0xa6, 0x6c, 0xd7, 0x17, 0x6b, 0x72, 0x06, 0x9d, 0x02, 0x14, 0x6d, 0x51, 0xf3, 0xc6, 0xc6,
-		0x92, 0x9c, 0xed, 0xb3, 0x28, 0x25, 0x17, 0xf2, 0xe1, 0x65, 0xe4, 0x57, 0x27, 0x97, 0xf4, 0xc1,
-		0x04, 0xe4, 0x42, 0xcb, 0x42, 0x12, 0xcf, 0x6b, 0xed, 0xb6, 0x75, 0x47, 0xd5, 0xda, 0x86, 0xe6,
-		0x72, 0x7b, 0x03, 0xb4, 0xa8, 0x42, 0x4a, 0x46, 0x9d, 0xdf, 0xa3, 0x5b, 0xf8, 0xf4, 0x5f, 0x47,
-		0x0b, 0x3f, 0x26, 0xa7, 0x4b, 0x1f, 0x95, 0x40, 0xee, 0x5d, 0xef, 0xf5, 0x74, 0x5f, 0x1a, 0xd4,
-		0xfd, 0xaf, 0xca, 0xd8, 0x7d, 0x44, 0x82, 0x89, 0xe8, 0x22, 0xaf, 0xa7, 0x79, 0xe7, 0xff, 0x4a,
-		0x9b, 0xf7, 0x7b, 0x09, 0x28, 0x44, 0x96, 0x76, 0xa3, 0xb6, 0xee, 0x7d, 0x30, 0x69, 0xb4, 0x70,
-		0xc7, 0xb6, 0x3c, 0x6c, 0xea, 0x87, 0x6a, 0x1b, 0xdf, 0xc6, 0xed, 0x99, 0x12, 0x35, 0xca, 0x57,
-		0x8e, 0x5e, 0x3c, 0x2e, 0xd4, 0x03, 0xbe, 0x55, 0xc2, 0x56, 0x9e, 0xaa, 0x2f, 0xd5, 0xd6, 0x36,
-		0x37, 0x9a, 0xb5, 0xf5, 0xc5, 0x57, 0x85, 0x75, 0x51, 0x64, 0xa3, 0x87, 0xec, 0x5d, 0x34, 0xda,
-		0x9b, 0x20, 0xf7, 0x36, 0x8a, 0xd8, 0x8a, 0x98, 0x66, 0xc9, 0x27, 0xd0, 0x14, 0x14, 0xd7, 0x37,
-		0xd4, 0x46, 0x7d, 0xa9, 0xa6, 0xd6, 0x96, 0x97, 0x6b, 0x8b, 0xcd, 0x06, 0x4b, 0x07, 0xfa, 0xd4,
-		0x4d, 0x39, 0x11, 0x16, 0xf1, 0x87, 0x92, 0x30, 0x15, 0xd3, 0x12, 0x54, 0xe1, 0x0b, 0x79, 0x96,
-		0x5b, 0x78, 0x6c, 0x94, 0xd6, 0x2f, 0x90, 0x50, 0x7a, 0x53, 0x73, 0x3c, 0xbe, 0xee, 0x7f, 0x18,
-		0x88, 0x94, 0x4c, 0x8f, 0x78, 0x76, 0x87, 0xa7, 0x59, 0xd9, 0xea, 0xbe, 0x18, 0x94, 0xb3, 0x4c,
-		0xeb, 0xa3, 0x80, 0x6c, 0xcb, 0x35, 0x3c, 0xe3, 0x36, 0x56, 0x0d, 0x53, 0xe4, 0x64, 0xc9, 0x6a,
-		0x3f, 0xa5, 0xc8, 0xa2, 0xa6, 0x6e, 0x7a, 0x3e, 0xb5, 0x89, 0xf7, 0xb4, 0x1e, 0x6a, 0x12, 0x79,
-		0x24, 0x15, 0x59, 0xd4, 0xf8, 0xd4, 0xe7, 0x21, 0xdf, 0xb2, 0xba, 0x64, 0x09, 0xc4, 0xe8, 0x88,
-		0xb5, 0x90, 0x94, 0x1c, 0x2b, 0xf3, 0x49, 0xf8, 0xe2, 0x36, 0x48, 0x06, 0xe7, 0x95, 0x1c, 0x2b,
-		0x63, 0x24, 0x17, 0xa1, 0xa8, 0xed, 0xed, 0x39, 0x04, 0x5c, 0x00, 0xb1, 0xe5, 0xfa, 0x84, 0x5f,
-		0x4c, 0x09, 0x67, 0x6f, 0x42, 0x46, 0xc8, 0x81, 0x44, 0xb0, 0x44, 0x12, 0xaa, 0xcd, 0x72, 0x50,
-		0x89, 0x4b, 0x59, 0x25, 0x63, 0x8a, 0xca, 0xf3, 0x90, 0x37, 0x5c, 0x35, 0xd8, 0xdb, 0x4a, 0xcc,
-		0x27, 0x2e, 0x65, 0x94, 0x9c, 0xe1, 0xfa, 0xfb, 0x02, 0xa5, 0x8f, 0x27, 0x60, 0x22, 0xba, 0x6b,
-		0x87, 0x96, 0x20, 0xd3, 0xb6, 0x74, 0x8d, 0xaa, 0x16, 0xdb, 0x32, 0xbe, 0x34, 0x64, 0xa3, 0x6f,
-		0x61, 0x95, 0xd3, 0x2b, 0x3e, 0xe7, 0xec, 0x6f, 0x4a, 0x90, 0x11, 0xc5, 0xe8, 0x14, 0xa4, 0x6c,
-		0xcd, 0xdb, 0xa7, 0x70, 0x63, 0xd5, 0x84, 0x2c, 0x29, 0xf4, 0x99, 0x94, 0xbb, 0xb6, 0x66, 0x52,
-		0x15, 0xe0, 0xe5, 0xe4, 0x99, 0x8c, 0x6b, 0x1b, 0x6b, 0x2d, 0x9a, 0x0b, 0xb0, 0x3a, 0x1d, 0x6c,
-		0x7a, 0xae, 0x18, 0x57, 0x5e, 0xbe, 0xc8, 0x8b, 0xd1, 0x23, 0x30, 0xe9, 0x39, 0x9a, 0xd1, 0x8e,
-		0xd0, 0xa6, 0x28, 0xad, 0x2c, 0x2a, 0x7c, 0xe2, 0x32, 0x9c, 0x11, 0xb8, 0x2d, 0xec, 0x69, 0xfa,
-		0x3e, 0x6e, 0x05, 0x4c, 0x69, 0x9a, 0xf3, 0x3b, 0xcd, 0x09, 0x96, 0x78, 0xbd, 0xe0, 0x2d, 0x7d,
-		0x26, 0x01, 0x93, 0x22, 0x7b, 0xd1, 0xf2, 0x85, 0xb5, 0x06, 0xa0, 0x99, 0xa6, 0xe5, 0x85, 0xc5,
-		0xd5, 0xaf, 0xca, 0x7d, 0x7c, 0x0b, 0x15, 0x9f, 0x49, 0x09, 0x01, 0xcc, 0x7e, 0x41, 0x02, 0x08,
-		0xaa, 0x06, 0xca, 0x6d, 0x0e, 0x72, 0x7c, 0x4f, 0x96, 0x6e, 0xec, 0xb3, 0x84, 0x17, 0xb0, 0xa2,
-		0x65, 0xa3, 0x4d, 0xd3, 0x92, 0x3b, 0x78, 0xcf, 0x30, 0xf9, 0x7e, 0x0a, 0x7b, 0x10, 0x69, 0xc9,
-		0x54, 0xb0, 0x3d, 0xa5, 0x40, 0xc6, 0xc5, 0x1d, 0xcd, 0xf4, 0x0c, 0x9d, 0xef, 0x90, 0x5c, 0x3f,
-		0x56, 0xe3, 0x17, 0x1a, 0x9c, 0x5b, 0xf1, 0x71, 0x4a, 0x97, 0x20, 0x23, 0x4a, 0x49, 0xe0, 0xb7,
-		0xbe, 0xb1, 0x5e, 0x93, 0x4f, 0xa0, 0x71, 0x48, 0x36, 0x6a, 0x4d, 0x59, 0x22, 0xcb, 0xce, 0xca,
-		0x6a, 0xbd, 0xd2, 0x90, 0x13, 0xd5, 0xff, 0x07, 0xa6, 0x74, 0xab, 0xd3, 0xfb, 0xc2, 0xaa, 0xdc,
-		0x93, 0xf2, 0x73, 0x6f, 0x48, 0xaf, 0x3d, 0xc6, 0x89, 0xf6, 0xac, 0xb6, 0x66, 0xee, 0x2d, 0x58,
-		0xce, 0x5e, 0x70, 0x2c, 0x82, 0xac, 0x0e, 0xdc, 0xd0, 0xe1, 0x08, 0x7b, 0xe7, 0x2f, 0x25, 0xe9,
-		0x47, 0x13, 0xc9, 0x95, 0xcd, 0xea, 0x4f, 0x26, 0x66, 0x57, 0x18, 0xe3, 0xa6, 0xe8, 0x8e, 0x82,
-		0x77, 0xdb, 0x58, 0x27, 0x8d, 0x87, 0x3f, 0x7e, 0x04, 0xa6, 0xf7, 0xac, 0x3d, 0x8b, 0x22, 0x5d,
-		0x21, 0x7f, 0xf1, 0x73, 0x15, 0x59, 0xbf, 0x74, 0x76, 0xe8, 0x21, 0x8c, 0xf2, 0x3a, 0x4c, 0x71,
-		0x62, 0x95, 0x6e, 0xdf, 0xb2, 0xe4, 0x02, 0x3a, 0x32, 0xb3, 0x3d, 0xf3, 0xb3, 0x7f, 0x40, 0xa3,
-		0x12, 0x65, 0x92, 0xb3, 0x92, 0x3a, 0x96, 0x7f, 0x28, 0x2b, 0x70, 0x32, 0x82, 0xc7, 0x6c, 0x04,
-		0x76, 0x86, 0x20, 0xfe, 0x2b, 0x8e, 0x38, 0x15, 0x42, 0x6c, 0x70, 0xd6, 0xf2, 0x22, 0x14, 0x8e,
-		0x83, 0xf5, 0xab, 0x1c, 0x2b, 0x8f, 0xc3, 0x20, 0x2b, 0x50, 0xa4, 0x20, 0x7a, 0xd7, 0xf5, 0xac,
-		0x0e, 0x35, 0xc0, 0x47, 0xc3, 0xfc, 0xeb, 0x3f, 0x60, 0x93, 0x76, 0x82, 0xb0, 0x2d, 0xfa, 0x5c,
-		0xe5, 0x32, 0xd0, 0x1d, 0xeb, 0x16, 0xd6, 0xdb, 0x43, 0x10, 0x7e, 0x8d, 0x37, 0xc4, 0xa7, 0x2f,
-		0x6f, 0xc3, 0x34, 0xf9, 0x9b, 0xda, 0xc7, 0x70, 0x4b, 0x86, 0xa7, 0xc1, 0x67, 0xfe, 0xfd, 0x37,
-		0x33, 0xbb, 0x30, 0xe5, 0x03, 0x84, 0xda, 0x14, 0x1a, 0xc5, 0x3d, 0xec, 0x79, 0xd8, 0x71, 0x55,
-		0xad, 0x1d, 0xd7, 0xbc, 0x50, 0x1e, 0x71, 0xe6, 0x87, 0xbe, 0x18, 0x1d, 0xc5, 0x15, 0xc6, 0x59,
-		0x69, 0xb7, 0xcb, 0x5b, 0x70, 0x3a, 0x46, 0x2b, 0x46, 0xc0, 0xfc, 0x10, 0xc7, 0x9c, 0xee, 0xd3,
-		0x0c, 0x02, 0xbb, 0x09, 0xa2, 0xdc, 0x1f, 0xcb, 0x11, 0x30, 0x3f, 0xcc, 0x31, 0x11, 0xe7, 0x15,
-		0x43, 0x4a, 0x10, 0x6f, 0xc2, 0xe4, 0x6d, 0xec, 0xec, 0x58, 0x2e, 0xcf, 0xdd, 0x8e, 0x00, 0xf7,
-		0x11, 0x0e, 0x57, 0xe4, 0x8c, 0x34, 0x99, 0x4b, 0xb0, 0x9e, 0x85, 0xcc, 0xae, 0xa6, 0xe3, 0x11,
-		0x20, 0xee, 0x72, 0x88, 0x71, 0x42, 0x4f, 0x58, 0x2b, 0x90, 0xdf, 0xb3, 0xb8, 0x8b, 0x1c, 0xce,
-		0xfe, 0x51, 0xce, 0x9e, 0x13, 0x3c, 0x1c, 0xc2, 0xb6, 0xec, 0x6e, 0x9b, 0xf8, 0xcf, 0xe1, 0x10,
-		0x3f, 0x2c, 0x20, 0x04, 0x0f, 0x87, 0x38, 0x86, 0x58, 0x3f, 0x26, 0x20, 0xdc, 0x90, 0x3c, 0x5f,
-		0x82, 0x9c, 0x65, 0xb6, 0x0f, 0x2d, 0x73, 0x94, 0x46, 0xfc, 0x08, 0x47, 0x00, 0xce, 0x42, 0x00,
-		0x9e, 0x83, 0xec, 0xa8, 0x03, 0xf1, 0xe3, 0x5f, 0x14, 0xd3, 0x43, 0x8c, 0xc0, 0x0a, 0x14, 0x85,
-		0x81, 0x32, 0x2c, 0x73, 0x04, 0x88, 0x9f, 0xe0, 0x10, 0x13, 0x21, 0x36, 0xde, 0x0d, 0x0f, 0xbb,
-		0xde, 0x1e, 0x1e, 0x05, 0xe4, 0xe3, 0xa2, 0x1b, 0x9c, 0x85, 0x8b, 0x72, 0x07, 0x9b, 0xfa, 0xfe,
-		0x68, 0x08, 0x9f, 0x10, 0xa2, 0x14, 0x3c, 0x04, 0x62, 0x11, 0x0a, 0x1d, 0xcd, 0x71, 0xf7, 0xb5,
-		0xf6, 0x48, 0xc3, 0xf1, 0x49, 0x8e, 0x91, 0xf7, 0x99, 0xb8, 0x44, 0xba, 0xe6, 0x71, 0x60, 0x7e,
-		0x52, 0x48, 0x24, 0xc4, 0xc6, 0xa7, 0x9e, 0xeb, 0xd1, 0x44, 0xf7, 0x71, 0xd0, 0x7e, 0x4a, 0x4c,
-		0x3d, 0xc6, 0xbb, 0x16, 0x46, 0x7c, 0x0e, 0xb2, 0xae, 0xf1, 0xc6, 0x48, 0x30, 0x7f, 0x5f, 0x8c,
-		0x34, 0x65, 0x20, 0xcc, 0xaf, 0xc2, 0x99, 0x58, 0x37, 0x31, 0x02, 0xd8, 0x3f, 0xe0, 0x60, 0xa7,
-		0x62, 0x5c, 0x05, 0x37, 0x09, 0xc7, 0x85, 0xfc, 0x69, 0x61, 0x12, 0x70, 0x0f, 0xd6, 0x26, 0x59,
-		0xb4, 0xb8, 0xda, 0xee, 0xf1, 0xa4, 0xf6, 0x0f, 0x85, 0xd4, 0x18, 0x6f, 0x44, 0x6a, 0x4d, 0x38,
-		0xc5, 0x11, 0x8f, 0x37, 0xae, 0x3f, 0x23, 0x0c, 0x2b, 0xe3, 0xde, 0x8a, 0x8e, 0xee, 0xd7, 0xc2,
-		0xac, 0x2f, 0x4e, 0x11, 0x1d, 0xbb, 0x6a, 0x47, 0xb3, 0x47, 0x40, 0xfe, 0x59, 0x8e, 0x2c, 0x2c,
-		0xbe, 0x1f, 0x5e, 0xbb, 0x6b, 0x9a, 0x4d, 0xc0, 0x5f, 0x81, 0x19, 0x01, 0xde, 0x35, 0x1d, 0xac,
-		0x5b, 0x7b, 0xa6, 0xf1, 0x06, 0x6e, 0x8d, 0x00, 0xfd, 0x8f, 0x7a, 0x86, 0x6a, 0x2b, 0xc4, 0x4e,
-		0x90, 0xeb, 0x20, 0xfb, 0xb1, 0x8a, 0x6a, 0x74, 0x6c, 0xcb, 0xf1, 0x86, 0x20, 0x7e, 0x4a, 0x8c,
-		0x94, 0xcf, 0x57, 0xa7, 0x6c, 0xe5, 0x1a, 0xb0, 0xd3, 0x1f, 0xa3, 0xaa, 0xe4, 0xa7, 0x39, 0x50,
-		0x21, 0xe0, 0xe2, 0x86, 0x43, 0xb7, 0x3a, 0xb6, 0xe6, 0x8c, 0x62, 0xff, 0x7e, 0x4e, 0x18, 0x0e,
-		0xce, 0xc2, 0x0d, 0x07, 0x89, 0xe8, 0x88, 0xb7, 0x1f, 0x01, 0xe1, 0xe7, 0x85, 0xe1, 0x10, 0x3c,
-		0x1c, 0x42, 0x04, 0x0c, 0x23, 0x40, 0xfc, 0x82, 0x80, 0x10, 0x3c, 0x04, 0xe2, 0x3d, 0x81, 0xa3,
-		0x75, 0xf0, 0x9e, 0xe1, 0x7a, 0x0e, 0x0b, 0xc9, 0x8f, 0x86, 0xfa, 0xc5, 0x2f, 0x46, 0x83, 0x30,
-		0x25, 0xc4, 0x4a, 0x2c, 0x11, 0xdf, 0xfa, 0xa0, 0x4b, 0xb6, 0xe1, 0x0d, 0xfb, 0x25, 0x61, 0x89,
-		0x42, 0x6c, 0xa4, 0x6d, 0xa1, 0x08, 0x91, 0x88, 0x5d, 0x27, 0x0b, 0x95, 0x11, 0xe0, 0xfe, 0x71,
-		0x4f, 0xe3, 0x1a, 0x82, 0x97, 0x60, 0x86, 0xe2, 0x9f, 0xae, 0x79, 0x0b, 0x1f, 0x8e, 0xa4, 0x9d,
-		0xbf, 0xdc, 0x13, 0xff, 0x6c, 0x31, 0x4e, 0x66, 0x43, 0x8a, 0x3d, 0xf1, 0x14, 0x1a, 0x76, 0xd6,
-		0x6f, 0xe6, 0x9b, 0xbe, 0xc4, 0xfb, 0x1b, 0x0d, 0xa7, 0xca, 0xab, 0x44, 0xc9, 0xa3, 0x41, 0xcf,
-		0x70, 0xb0, 0x6f, 0xfe, 0x92, 0xaf, 0xe7, 0x91, 0x98, 0xa7, 0xbc, 0x0c, 0x85, 0x48, 0xc0, 0x33,
-		0x1c, 0xea, 0x5b, 0x38, 0x54, 0x3e, 0x1c, 0xef, 0x94, 0x9f, 0x82, 0x14, 0x09, 0x5e, 0x86, 0xb3,
-		0x7f, 0x2b, 0x67, 0xa7, 0xe4, 0xe5, 0x17, 0x20, 0x23, 0x82, 0x96, 0xe1, 0xac, 0xdf, 0xc6, 0x59,
-		0x7d, 0x16, 0xc2, 0x2e, 0x02, 0x96, 0xe1, 0xec, 0xff, 0x9f, 0x60, 0x17, 0x2c, 0x84, 0x7d, 0x74,
-		0x11, 0xfe, 0xf3, 0xef, 0x4c, 0x71, 0xa7, 0x23, 0x64, 0xf7, 0x1c, 0x8c, 0xf3, 0x48, 0x65, 0x38,
-		0xf7, 0x77, 0xf0, 0x97, 0x0b, 0x8e, 0xf2, 0xd3, 0x30, 0x36, 0xa2, 0xc0, 0xbf, 0x9b, 0xb3, 0x32,
-		0xfa, 0xf2, 0x22, 0xe4, 0x42, 0xd1, 0xc9, 0x70, 0xf6, 0xef, 0xe1, 0xec, 0x61, 0x2e, 0xd2, 0x74,
-		0x1e, 0x9d, 0x0c, 0x07, 0xf8, 0xff, 0x45, 0xd3, 0x39, 0x07, 0x11, 0x9b, 0x08, 0x4c, 0x86, 0x73,
-		0x7f, 0x40, 0x48, 0x5d, 0xb0, 0x94, 0x5f, 0x82, 0xac, 0xef, 0x6c, 0x86, 0xf3, 0x7f, 0x2f, 0xe7,
-		0x0f, 0x78, 0x88, 0x04, 0x42, 0xce, 0x6e, 0x38, 0xc4, 0xdf, 0x11, 0x12, 0x08, 0x71, 0x91, 0x69,
-		0xd4, 0x1b, 0xc0, 0x0c, 0x47, 0xfa, 0x3e, 0x31, 0x8d, 0x7a, 0xe2, 0x17, 0x32, 0x9a, 0xd4, 0xe6,
-		0x0f, 0x87, 0xf8, 0x7e, 0x31, 0x9a, 0x94, 0x9e, 0x34, 0xa3, 0x37, 0x22, 0x18, 0x8e, 0xf1, 0x83,
-		0xa2, 0x19, 0x3d, 0x01, 0x41, 0x79, 0x13, 0x50, 0x7f, 0x34, 0x30, 0x1c, 0xef, 0x83, 0x1c, 0x6f,
-		0xb2, 0x2f, 0x18, 0x28, 0xbf, 0x17, 0x4e, 0xc5, 0x47, 0x02, 0xc3, 0x51, 0x7f, 0xe8, 0x4b, 0x3d,
-		0x6b, 0xb7, 0x70, 0x20, 0x50, 0x6e, 0x06, 0x2e, 0x25, 0x1c, 0x05, 0x0c, 0x87, 0xfd, 0xd0, 0x97,
-		0xa2, 0x86, 0x3b, 0x1c, 0x04, 0x94, 0x2b, 0x00, 0x81, 0x03, 0x1e, 0x8e, 0xf5, 0x11, 0x8e, 0x15,
-		0x62, 0x22, 0x53, 0x83, 0xfb, 0xdf, 0xe1, 0xfc, 0x77, 0xc5, 0xd4, 0xe0, 0x1c, 0x64, 0x6a, 0x08,
-		0xd7, 0x3b, 0x9c, 0xfb, 0xa3, 0x62, 0x6a, 0x08, 0x16, 0xa2, 0xd9, 0x21, 0xef, 0x36, 0x1c, 0xe1,
-		0x47, 0x84, 0x66, 0x87, 0xb8, 0xca, 0xeb, 0x30, 0xd9, 0xe7, 0x10, 0x87, 0x43, 0xfd, 0x28, 0x87,
-		0x92, 0x7b, 0xfd, 0x61, 0xd8, 0x79, 0x71, 0x67, 0x38, 0x1c, 0xed, 0xc7, 0x7a, 0x9c, 0x17, 0xf7,
-		0x85, 0xe5, 0xe7, 0x20, 0x63, 0x76, 0xdb, 0x6d, 0x32, 0x79, 0xd0, 0xd1, 0xe7, 0x73, 0x67, 0xfe,
-		0xcb, 0x57, 0xb8, 0x74, 0x04, 0x43, 0xf9, 0x29, 0x18, 0xc3, 0x9d, 0x1d, 0xdc, 0x1a, 0xc6, 0xf9,
-		0x47, 0x5f, 0x11, 0x06, 0x93, 0x50, 0x97, 0x5f, 0x02, 0x60, 0xa9, 0x11, 0xba, 0x71, 0x3e, 0x84,
-		0xf7, 0x0b, 0x5f, 0xe1, 0x07, 0xe2, 0x02, 0x96, 0x00, 0x80, 0x1d, 0xaf, 0x3b, 0x1a, 0xe0, 0x8b,
-		0x51, 0x00, 0x3a, 0x22, 0xcf, 0xc2, 0xf8, 0xeb, 0xae, 0x65, 0x7a, 0xda, 0xde, 0x30, 0xee, 0x3f,
-		0xe6, 0xdc, 0x82, 0x9e, 0x08, 0xac, 0x63, 0x39, 0xd8, 0xd3, 0xf6, 0xdc, 0x61, 0xbc, 0x7f, 0xc2,
-		0x79, 0x7d, 0x06, 0xc2, 0xac, 0x6b, 0xae, 0x37, 0x4a, 0xbf, 0xff, 0xab, 0x60, 0x16, 0x0c, 0xa4,
-		0xd1, 0xe4, 0xef, 0x5b, 0xf8, 0x70, 0x18, 0xef, 0x9f, 0x8a, 0x46, 0x73, 0xfa, 0xf2, 0x0b, 0x90,
-		0x25, 0x7f, 0xb2, 0x53, 0xae, 0x43, 0x98, 0xff, 0x8c, 0x33, 0x07, 0x1c, 0xe4, 0xcd, 0xae, 0xd7,
-		0xf2, 0x8c, 0xe1, 0xc2, 0xfe, 0x73, 0x3e, 0xd2, 0x82, 0xbe, 0x5c, 0x81, 0x9c, 0xeb, 0xb5, 0x5a,
-		0x5d, 0x1e, 0x9f, 0x0e, 0x61, 0xff, 0x6f, 0x5f, 0xf1, 0x53, 0x16, 0x3e, 0x0f, 0x19, 0xed, 0x3b,
-		0xb7, 0x3c, 0xdb, 0xa2, 0xfb, 0x2d, 0xc3, 0x10, 0xbe, 0xc4, 0x11, 0x42, 0x2c, 0xe5, 0x45, 0xc8,
-		0x93, 0xbe, 0x38, 0xd8, 0xc6, 0x74, 0x73, 0x6c, 0x08, 0xc4, 0x97, 0xb9, 0x00, 0x22, 0x4c, 0xd5,
-		0x6f, 0xfc, 0xb5, 0xcf, 0x9e, 0x93, 0x3e, 0xf3, 0xd9, 0x73, 0xd2, 0xef, 0x7d, 0xf6, 0x9c, 0xf4,
-		0x81, 0xcf, 0x9d, 0x3b, 0xf1, 0x99, 0xcf, 0x9d, 0x3b, 0xf1, 0xdb, 0x9f, 0x3b, 0x77, 0x22, 0x3e,
-		0x4b, 0x0c, 0x2b, 0xd6, 0x8a, 0xc5, 0xf2, 0xc3, 0xaf, 0x3d, 0xb8, 0x67, 0x78, 0xfb, 0xdd, 0x9d,
-		0x05, 0xdd, 0xea, 0x5c, 0xd1, 0x2d, 0xb7, 0x63, 0xb9, 0x57, 0xa2, 0x79, 0x5d, 0xfa, 0x17, 0xfc,
-		0x0f, 0x89, 0xac, 0x99, 0xa3, 0xe9, 0x5c, 0xcd, 0x3c, 0x1c, 0xf4, 0x31, 0xdd, 0x75, 0x48, 0x56,
-		0xcc, 0x43, 0x74, 0x86, 0x19, 0x38, 0xb5, 0xeb, 0xb4, 0xf9, 0x51, 0xcb, 0x71, 0xf2, 0xbc, 0xe5,
-		0xb4, 0xd1, 0x74, 0x70, 0x1e, 0x5a, 0xba, 0x94, 0xe7, 0x87, 0x9c, 0xab, 0xdf, 0x23, 0x1d, 0xaf,
-		0x27, 0x99, 0x8a, 0x79, 0x48, 0x3b, 0xb2, 0x29, 0xbd, 0xf6, 0xe8, 0xd0, 0x3c, 0xf7, 0x2d, 0xd3,
-		0xba, 0x63, 0x92, 0x66, 0xdb, 0x3b, 0x22, 0xc7, 0x7d, 0xae, 0x37, 0xc7, 0xfd, 0x5e, 0xdc, 0x6e,
-		0xbf, 0x4c, 0xe8, 0x9a, 0x84, 0x65, 0x27, 0xcd, 0x4e, 0xf5, 0xc3, 0xf7, 0x25, 0xe0, 0x5c, 0x5f,
-		0x3a, 0x9b, 0x2b, 0xc1, 0x20, 0x21, 0x94, 0x21, 0xb3, 0x24, 0x74, 0x6b, 0x06, 0xc6, 0x5d, 0xac,
-		0x5b, 0x66, 0xcb, 0xa5, 0x82, 0x48, 0x2a, 0xe2, 0x91, 0x08, 0xc2, 0xd4, 0x4c, 0xcb, 0xe5, 0x87,
-		0x95, 0xd9, 0x43, 0xf5, 0xc3, 0xc7, 0x14, 0x44, 0x41, 0xbc, 0x49, 0x48, 0xe3, 0xea, 0x88, 0xd2,
-		0x10, 0x9d, 0x88, 0x64, 0xfe, 0x47, 0x95, 0xca, 0x0f, 0x26, 0x60, 0xae, 0x57, 0x2a, 0x64, 0x66,
-		0xb9, 0x9e, 0xd6, 0xb1, 0x07, 0x89, 0xe5, 0x39, 0xc8, 0x36, 0x05, 0xcd, 0xb1, 0xe5, 0x72, 0xf7,
-		0x98, 0x72, 0x99, 0xf0, 0x5f, 0x25, 0x04, 0x73, 0x6d, 0x44, 0xc1, 0xf8, 0xfd, 0xb8, 0x27, 0xc9,
-		0x7c, 0x38, 0x0b, 0x67, 0xd8, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x0f, 0x5c, 0x26, 0xf9, 0x70, 0xd5,
-		0xf0, 0x7d, 0x92, 0xd2, 0xcb, 0x30, 0x55, 0x27, 0xd6, 0x82, 0xac, 0x82, 0x82, 0x1d, 0x9e, 0xd8,
-		0xf3, 0xdc, 0xf3, 0x91, 0x80, 0x9f, 0xef, 0x6f, 0x85, 0x8b, 0x4a, 0xdf, 0x24, 0x81, 0xdc, 0xd0,
-		0xb5, 0xb6, 0xe6, 0xbc, 0x5d, 0x28, 0xf4, 0x34, 0x00, 0x3b, 0xee, 0xe1, 0x7f, 0xb8, 0x37, 0x71,
-		0x6d, 0x66, 0x21, 0xdc, 0xb9, 0x05, 0xf6, 0x26, 0x7a, 0x82, 0x2a, 0x4b, 0x69, 0xc9, 0x9f, 0x97,
-		0x5f, 0x01, 0x08, 0x2a, 0xd0, 0x59, 0x38, 0xdd, 0x58, 0xac, 0xac, 0x56, 0x14, 0x71, 0x48, 0xa8,
-		0xb1, 0x59, 0x5b, 0xac, 0x2f, 0xd7, 0x6b, 0x4b, 0xf2, 0x09, 0x74, 0x0a, 0x50, 0xb8, 0xd2, 0x3f,
-		0xd4, 0x74, 0x12, 0x26, 0xc3, 0xe5, 0xec, 0x2b, 0x95, 0x44, 0xf9, 0x06, 0x14, 0xd9, 0x11, 0x7a,
-		0x55, 0x6b, 0xb5, 0x70, 0x4b, 0x35, 0x4c, 0x34, 0xe4, 0x44, 0xfa, 0xcc, 0xaf, 0xff, 0xc7, 0x31,
-		0xda, 0xb5, 0x02, 0x63, 0xac, 0x10, 0xbe, 0xba, 0x49, 0x62, 0x4e, 0xa3, 0x63, 0xb7, 0x31, 0xdd,
-		0xc3, 0x54, 0x0d, 0x21, 0xff, 0xe1, 0xe1, 0x0c, 0xc1, 0x4b, 0x5e, 0xca, 0x2a, 0x53, 0x01, 0xbb,
-		0x3f, 0x7a, 0xe5, 0x97, 0x41, 0x16, 0x47, 0x3d, 0xfd, 0x06, 0x0e, 0x45, 0xfc, 0x0d, 0xde, 0x42,
-		0x91, 0xcd, 0x10, 0x4d, 0x5c, 0x85, 0x49, 0x4d, 0xd7, 0xb1, 0x1d, 0x69, 0xdf, 0x10, 0x0f, 0x22,
-		0x7a, 0x2b, 0x73, 0xce, 0xa0, 0x69, 0x4f, 0x43, 0xda, 0xa5, 0x83, 0x32, 0x0c, 0x42, 0x34, 0x87,
-		0x93, 0x97, 0x6b, 0x30, 0xc1, 0xd4, 0xc0, 0xef, 0xd1, 0x10, 0x80, 0x7f, 0xc3, 0x01, 0xf2, 0x94,
-		0x4d, 0xf4, 0xc6, 0x84, 0x49, 0x12, 0xd7, 0x6a, 0x0e, 0x0e, 0xf5, 0xe6, 0xe8, 0x2c, 0xca, 0x3f,
-		0xf9, 0xd4, 0xe3, 0x74, 0xdf, 0xf8, 0x7c, 0x54, 0xe9, 0x62, 0x26, 0x8b, 0x22, 0x73, 0xec, 0xa0,
-		0xbf, 0x18, 0x26, 0xc4, 0xfb, 0x78, 0xbf, 0x8f, 0x7e, 0xd9, 0x3f, 0xe5, 0x2f, 0x3b, 0x17, 0xa7,
-		0xe1, 0xa1, 0x37, 0x15, 0x38, 0x2a, 0xab, 0x28, 0x57, 0xa1, 0xb0, 0x6b, 0xb4, 0x43, 0xc3, 0x7d,
-		0xf4, 0x5b, 0xfe, 0xd9, 0xa7, 0x1e, 0x67, 0x13, 0x8d, 0x30, 0x71, 0xd1, 0x54, 0x6b, 0x83, 0xac,
-		0xde, 0x6b, 0x8f, 0xf4, 0xfb, 0x6f, 0xf6, 0xdf, 0x63, 0x14, 0xfd, 0xb9, 0x70, 0x53, 0x03, 0xeb,
-		0x94, 0x82, 0x49, 0xad, 0x63, 0x98, 0xd6, 0x15, 0xfa, 0x2f, 0xb7, 0x4a, 0x63, 0xf4, 0x61, 0x84,
-		0x6d, 0xdb, 0xeb, 0xcc, 0x58, 0x0c, 0xd7, 0xdb, 0x3f, 0xfb, 0xae, 0x9f, 0x18, 0x0b, 0x0c, 0x4a,
-		0x79, 0x2d, 0xd0, 0x7d, 0x6c, 0xea, 0x56, 0x6b, 0xa4, 0x3c, 0xce, 0x9f, 0x0b, 0x0c, 0x91, 0x01,
-		0xac, 0x71, 0xd6, 0xf2, 0xf3, 0x90, 0xf1, 0x61, 0x86, 0xc5, 0x6e, 0x02, 0xc4, 0xe7, 0x20, 0x91,
-		0x1b, 0x53, 0xda, 0x51, 0xe2, 0xf4, 0x2f, 0x09, 0x7e, 0x66, 0xc3, 0xd6, 0x49, 0x6f, 0x56, 0x60,
-		0xa2, 0x65, 0x99, 0x9e, 0x6a, 0x75, 0x0c, 0x0f, 0x77, 0x6c, 0x6f, 0x68, 0xe4, 0xfb, 0x65, 0x06,
-		0x92, 0x51, 0x0a, 0x84, 0x6f, 0x43, 0xb0, 0x91, 0x96, 0xb0, 0x2f, 0x19, 0x47, 0x69, 0xc9, 0x5f,
-		0xf8, 0x2d, 0xa1, 0x3c, 0xa4, 0x25, 0xf7, 0xa4, 0x1d, 0x6e, 0xeb, 0x16, 0x77, 0x77, 0xde, 0x01,
-		0xd3, 0x02, 0x5f, 0x3b, 0x3e, 0x9e, 0x84, 0x73, 0x9c, 0x78, 0x47, 0x73, 0xf1, 0x95, 0xdb, 0x57,
-		0x77, 0xb0, 0xa7, 0x5d, 0xbd, 0xa2, 0x5b, 0x86, 0x88, 0x75, 0xa6, 0xb8, 0x3b, 0x23, 0xf5, 0x0b,
-		0xbc, 0x7e, 0x36, 0xf6, 0x40, 0xc0, 0xec, 0x60, 0x37, 0x38, 0xdb, 0xaf, 0x83, 0xa5, 0x36, 0xa4,
-		0x16, 0x2d, 0xc3, 0x24, 0xde, 0xbf, 0x85, 0x4d, 0xab, 0xc3, 0x1d, 0x12, 0x7b, 0x40, 0x37, 0x20,
-		0xad, 0x75, 0xac, 0xae, 0xe9, 0x31, 0x67, 0x54, 0x7d, 0xfc, 0xd7, 0xde, 0x9a, 0x3b, 0xf1, 0x3b,
-		0x6f, 0xcd, 0x9d, 0x64, 0xb0, 0x6e, 0xeb, 0xd6, 0x82, 0x61, 0x5d, 0xe9, 0x68, 0xde, 0x3e, 0x31,
-		0x01, 0xbf, 0xf5, 0xe9, 0xc7, 0x80, 0xbf, 0xaf, 0x6e, 0x7a, 0x9f, 0xf8, 0xc3, 0x9f, 0xb9, 0x2c,
-		0x29, 0x9c, 0xbf, 0x9c, 0xfa, 0xfc, 0xc7, 0xe6, 0xa4, 0x92, 0x0d, 0xe3, 0x4b, 0x58, 0x3f, 0xe2,
-		0x85, 0xf5, 0x9e, 0x17, 0x5e, 0xe5, 0x2f, 0x3c, 0xdb, 0xff, 0x42, 0x76, 0xa4, 0x71, 0x09, 0xeb,
-		0xa1, 0xd7, 0x2e, 0x61, 0x3d, 0xfa, 0xc6, 0xea, 0xd2, 0x6f, 0xff, 0xfe, 0xb9, 0x13, 0xef, 0xff,
-		0xec, 0xb9, 0x13, 0x03, 0x87, 0xac, 0x34, 0x7c, 0xc8, 0xfc, 0x91, 0xfa, 0x64, 0x8a, 0x8c, 0x54,
-		0x07, 0x7b, 0x3b, 0xbb, 0xde, 0x15, 0xdd, 0x39, 0xb4, 0x3d, 0xeb, 0xca, 0xed, 0xab, 0x64, 0xe6,
-		0x5a, 0xbb, 0x7c, 0xa4, 0x90, 0xa8, 0x5f, 0x60, 0xf5, 0x0b, 0xb7, 0x07, 0x0c, 0x54, 0x69, 0x17,
-		0xc6, 0x36, 0x09, 0x23, 0x11, 0x85, 0x67, 0x79, 0x5a, 0x9b, 0x47, 0x64, 0xec, 0x81, 0x94, 0xb2,
-		0x2f, 0x6d, 0x13, 0xac, 0xd4, 0x10, 0x1f, 0xd9, 0xb6, 0xb1, 0xb6, 0xcb, 0x3e, 0x58, 0x4a, 0xd2,
-		0x50, 0x3e, 0x43, 0x0a, 0xe8, 0xb7, 0x49, 0xd3, 0x30, 0xa6, 0x75, 0xd9, 0xa1, 0xa2, 0x24, 0x89,
-		0xf1, 0xe9, 0x43, 0x69, 0x15, 0xc6, 0xf9, 0xd9, 0x02, 0x24, 0x43, 0xf2, 0x16, 0x3e, 0xa4, 0xef,
-		0xc9, 0x2b, 0xe4, 0x4f, 0x74, 0x05, 0xc6, 0x68, 0xeb, 0xf9, 0x97, 0x98, 0x67, 0x16, 0xfa, 0x9b,
-		0xbf, 0x40, 0x5b, 0xa9, 0x30, 0xba, 0xd2, 0x4d, 0xc8, 0x2c, 0x59, 0x44, 0x81, 0xa2, 0x70, 0x59,
-		0x06, 0x47, 0x1b, 0x6d, 0x77, 0xf9, 0xf0, 0x29, 0xec, 0x01, 0x9d, 0x82, 0x34, 0xfb, 0x82, 0x8d,
-		0x9f, 0x8c, 0xe2, 0x4f, 0xa5, 0x45, 0x18, 0xa7, 0xd8, 0x1b, 0x36, 0x89, 0x87, 0xfc, 0x43, 0xfd,
-		0x59, 0xfe, 0x3d, 0x33, 0x87, 0x4f, 0x04, 0xad, 0x45, 0x90, 0x6a, 0x69, 0x9e, 0xc6, 0x3b, 0x4e,
-		0xff, 0x2e, 0xbd, 0x04, 0x19, 0x0e, 0xe2, 0xa2, 0x27, 0x20, 0x69, 0xd9, 0x2e, 0x3f, 0xdb, 0x74,
-		0x76, 0x60, 0x5f, 0x36, 0xec, 0x6a, 0x8a, 0x28, 0x96, 0x42, 0xa8, 0xab, 0x6b, 0x03, 0x55, 0xe3,
-		0x89, 0x88, 0x6a, 0x88, 0x61, 0x17, 0x7f, 0x68, 0xb6, 0x71, 0xa5, 0x5f, 0x19, 0x7c, 0x5d, 0xf9,
-		0xef, 0x12, 0xdc, 0x1f, 0xa3, 0x2b, 0xb7, 0xf0, 0xa1, 0x7b, 0x6c, 0x55, 0x79, 0x05, 0xb2, 0x9b,
-		0xf4, 0x76, 0x91, 0x97, 0xf1, 0x21, 0x9a, 0x85, 0x71, 0xdc, 0xba, 0xf6, 0xd4, 0x53, 0x57, 0x9f,
-		0x65, 0x03, 0x79, 0xe3, 0x84, 0x22, 0x0a, 0xd0, 0x39, 0xc8, 0xba, 0x58, 0xb7, 0xaf, 0x3d, 0x75,
-		0xfd, 0xd6, 0x55, 0x26, 0xb8, 0x1b, 0x27, 0x94, 0xa0, 0xa8, 0x9c, 0x21, 0x93, 0xe2, 0xf3, 0x3f,
-		0x32, 0x27, 0x55, 0xc7, 0x20, 0xe9, 0x76, 0x3b, 0xef, 0x56, 0xe7, 0xff, 0x22, 0x0d, 0xe7, 0xfd,
-		0x6a, 0x66, 0xf6, 0x6e, 0x5f, 0xbd, 0x72, 0x5b, 0x6b, 0x1b, 0x2d, 0x2d, 0xb8, 0x13, 0x66, 0xd2,
-		0x17, 0x00, 0x25, 0x19, 0xd8, 0xff, 0xd9, 0xa3, 0x05, 0x59, 0xfa, 0xb4, 0x04, 0xf9, 0x6d, 0x81,
-		0xdd, 0xc0, 0x1e, 0x7a, 0x1e, 0xc0, 0x7f, 0x97, 0x50, 0x87, 0xfb, 0x16, 0xfa, 0xde, 0xb6, 0xe0,
-		0x33, 0x29, 0x21, 0x7a, 0xf4, 0x0c, 0x64, 0x6c, 0xc7, 0xb2, 0x2d, 0x97, 0x7f, 0xd1, 0x3a, 0x8c,
-		0xd7, 0xa7, 0x46, 0x8f, 0x02, 0xa2, 0x93, 0x57, 0xbd, 0x6d, 0x79, 0x86, 0xb9, 0xa7, 0xda, 0xd6,
-		0x1d, 0x7e, 0x51, 0x40, 0x52, 0x91, 0x69, 0xcd, 0x36, 0xad, 0xd8, 0x24, 0xe5, 0xa5, 0x4f, 0x49,
-		0x90, 0xf5, 0x51, 0xc8, 0xca, 0x4c, 0x6b, 0xb5, 0x1c, 0xec, 0xba, 0x7c, 0x7e, 0x8a, 0x47, 0xf4,
-		0x3c, 0x8c, 0xdb, 0xdd, 0x1d, 0x55, 0xcc, 0x85, 0xdc, 0xb5, 0xfb, 0x63, 0x35, 0x5b, 0x28, 0x08,
-		0xd7, 0xed, 0xb4, 0xdd, 0xdd, 0x21, 0xea, 0x72, 0x1e, 0xf2, 0x31, 0xad, 0xc9, 0xdd, 0x0e, 0x1a,
-		0x42, 0x6f, 0xb5, 0xe1, 0x5d, 0x50, 0x6d, 0xc7, 0xb0, 0x1c, 0xc3, 0x3b, 0xa4, 0x47, 0xef, 0x92,
-		0x8a, 0x2c, 0x2a, 0x36, 0x79, 0x79, 0xa9, 0x0d, 0xc5, 0x06, 0x0d, 0xb4, 0x83, 0xa6, 0x5f, 0x0f,
-		0x1a, 0x28, 0x8d, 0xd0, 0xc0, 0x81, 0x4d, 0x4b, 0xf4, 0x35, 0xed, 0xf2, 0x7f, 0x92, 0x20, 0x57,
-		0x6d, 0x5b, 0xfa, 0xad, 0xfa, 0xd2, 0x72, 0x5b, 0xdb, 0x43, 0x57, 0xe1, 0x64, 0x75, 0x75, 0x63,
-		0xf1, 0x65, 0xb5, 0xbe, 0xa4, 0x2e, 0xaf, 0x56, 0x56, 0x82, 0xc3, 0xbe, 0xb3, 0xa7, 0xde, 0xbc,
-		0x3b, 0x8f, 0x42, 0xb4, 0x5b, 0x26, 0x5d, 0x58, 0xa2, 0x2b, 0x30, 0x1d, 0x65, 0xa9, 0x54, 0x1b,
-		0xb5, 0xf5, 0xa6, 0x2c, 0xcd, 0x9e, 0x7c, 0xf3, 0xee, 0xfc, 0x64, 0x88, 0xa3, 0xb2, 0xe3, 0x62,
-		0xd3, 0xeb, 0x67, 0x58, 0xdc, 0x58, 0x5b, 0xab, 0x37, 0xe5, 0x44, 0x1f, 0xc3, 0xa2, 0xd5, 0xe9,
-		0x18, 0x1e, 0x7a, 0x18, 0x26, 0xa3, 0x0c, 0xeb, 0xf5, 0x55, 0x39, 0x39, 0x8b, 0xde, 0xbc, 0x3b,
-		0x3f, 0x11, 0xa2, 0x5e, 0x37, 0xda, 0xb3, 0x99, 0x6f, 0xff, 0xb1, 0x73, 0x27, 0x3e, 0xf1, 0xf7,
-		0xce, 0x49, 0xd5, 0xd5, 0x81, 0x33, 0xef, 0xda, 0xe8, 0x33, 0x4f, 0x4c, 0x2d, 0x7f, 0xe2, 0x7d,
-		0x34, 0x01, 0x73, 0x7e, 0xed, 0x6d, 0xec, 0xb8, 0x86, 0x65, 0x92, 0xd9, 0xc2, 0xd4, 0xd6, 0x0f,
-		0x26, 0xf8, 0xe0, 0x70, 0x82, 0xc1, 0x86, 0xe7, 0x05, 0x48, 0x56, 0x6c, 0x1b, 0xcd, 0xd2, 0x19,
-		0xe1, 0x59, 0xba, 0xc5, 0x9c, 0x54, 0x4a, 0xf1, 0x9f, 0x49, 0x9d, 0x6b, 0xed, 0x7a, 0x77, 0x34,
-		0xc7, 0xbf, 0x58, 0x42, 0x3c, 0x97, 0x9e, 0x85, 0xec, 0xa2, 0x65, 0xba, 0xd8, 0x74, 0xbb, 0x34,
-		0xc1, 0xb0, 0x43, 0x84, 0xc1, 0x11, 0xd8, 0x03, 0x31, 0xf2, 0x9a, 0x6d, 0x53, 0xce, 0x94, 0x42,
-		0xfe, 0xe4, 0x8e, 0x7b, 0x7d, 0xa0, 0x78, 0x9e, 0x1c, 0x5d, 0x3c, 0x81, 0x00, 0x7c, 0x01, 0x7d,
-		0xff, 0xfd, 0x21, 0xb3, 0xec, 0x5b, 0xa6, 0xb0, 0x78, 0x62, 0xac, 0xd2, 0x10, 0xa7, 0x3f, 0x3b,
-		0xdc, 0xd6, 0xcd, 0x0e, 0x1b, 0x95, 0x01, 0x96, 0x6f, 0x58, 0xba, 0xa7, 0xf4, 0x2c, 0x14, 0x36,
-		0x35, 0xc7, 0x6b, 0x60, 0xef, 0x06, 0xd6, 0x5a, 0xd8, 0x89, 0x46, 0x13, 0x05, 0x11, 0x4d, 0x20,
-		0x48, 0xd1, 0x90, 0x81, 0x39, 0x53, 0xfa, 0x77, 0xc9, 0x80, 0x14, 0x3d, 0x7b, 0xed, 0x47, 0x1a,
-		0x9c, 0x83, 0x45, 0x1a, 0x64, 0xb8, 0x0e, 0x3d, 0xec, 0x8a, 0x84, 0x21, 0x7d, 0x40, 0x4f, 0x89,
-		0x78, 0x21, 0x39, 0x24, 0x5e, 0xe0, 0x56, 0x88, 0x47, 0x0d, 0x1d, 0x18, 0xe7, 0x13, 0xc1, 0x6f,
-		0x89, 0x14, 0xb4, 0x04, 0xad, 0x43, 0xd1, 0xd6, 0x1c, 0x8f, 0x7e, 0x8c, 0xb9, 0x4f, 0xbb, 0xc1,
-		0x2d, 0xdd, 0x7c, 0x8c, 0xe1, 0x8d, 0x74, 0x97, 0xbf, 0xa6, 0x60, 0x87, 0x0b, 0x4b, 0x9f, 0x4f,
-		0x41, 0x9a, 0x8b, 0xe3, 0x45, 0x18, 0xe7, 0x02, 0xe7, 0xb6, 0xe9, 0xdc, 0x42, 0x8c, 0xfa, 0x2f,
-		0xf8, 0x6a, 0xca, 0x01, 0x05, 0x13, 0x7a, 0x08, 0x32, 0xfa, 0xbe, 0x66, 0x98, 0xaa, 0xd1, 0xe2,
-		0x31, 0x69, 0xee, 0xb3, 0x6f, 0xcd, 0x8d, 0x2f, 0x92, 0xb2, 0xfa, 0x92, 0x32, 0x4e, 0x2b, 0xeb,
-		0x2d, 0x12, 0xe3, 0xec, 0x63, 0x63, 0x6f, 0xdf, 0xe3, 0x06, 0x96, 0x3f, 0xa1, 0x67, 0x20, 0x45,
-		0x86, 0x8c, 0x7f, 0xac, 0x3f, 0xdb, 0xb7, 0xd8, 0xf0, 0xf3, 0x65, 0xd5, 0x0c, 0x79, 0xf1, 0x07,
-		0xfe, 0xf3, 0x9c, 0xa4, 0x50, 0x0e, 0xb4, 0x04, 0x85, 0xb6, 0xe6, 0x7a, 0x2a, 0x9d, 0x27, 0xe4,
-		0xf5, 0x63, 0x1c, 0xa2, 0x5f, 0x24, 0x5c, 0xb6, 0xbc, 0xed, 0x39, 0xc2, 0xc6, 0x8a, 0x5a, 0xe8,
-		0x12, 0xc8, 0x14, 0x45, 0xa7, 0xa6, 0x8a, 0xc5, 0x8d, 0x69, 0x2a, 0xfa, 0x09, 0x52, 0xce, 0x2c,
-		0x18, 0x8d, 0x1e, 0xcf, 0x42, 0x96, 0x7e, 0x1f, 0x4c, 0x49, 0xd8, 0xa1, 0xff, 0x0c, 0x29, 0xa0,
-		0x95, 0x17, 0xa1, 0x18, 0x78, 0x48, 0x46, 0x92, 0x61, 0x28, 0x41, 0x31, 0x25, 0x7c, 0x1c, 0xa6,
-		0x4d, 0x7c, 0x40, 0x3f, 0x43, 0x88, 0x50, 0x67, 0x29, 0x35, 0x22, 0x75, 0xdb, 0x51, 0x8e, 0x07,
-		0x61, 0x42, 0x17, 0xd2, 0x67, 0xb4, 0x40, 0x69, 0x0b, 0x7e, 0x29, 0x25, 0x3b, 0x03, 0x19, 0xcd,
-		0xb6, 0x19, 0x41, 0x8e, 0x3b, 0x48, 0xdb, 0xa6, 0x55, 0x97, 0x61, 0x92, 0xf6, 0xd1, 0xc1, 0x6e,
-		0xb7, 0xed, 0x71, 0x90, 0x3c, 0xa5, 0x29, 0x92, 0x0a, 0x85, 0x95, 0x53, 0xda, 0x0b, 0x50, 0xc0,
-		0xb7, 0x8d, 0x16, 0x36, 0x75, 0xcc, 0xe8, 0x0a, 0x94, 0x2e, 0x2f, 0x0a, 0x29, 0xd1, 0xc3, 0xe0,
-		0xfb, 0x3d, 0x55, 0x38, 0xe5, 0x09, 0x86, 0x27, 0xca, 0x2b, 0xac, 0xb8, 0x34, 0x03, 0xa9, 0x25,
-		0xcd, 0xd3, 0x88, 0x1d, 0xf3, 0x0e, 0x58, 0xac, 0x91, 0x57, 0xc8, 0x9f, 0xa5, 0x5f, 0x4a, 0x42,
-		0x6a, 0xdb, 0xf2, 0x30, 0x7a, 0x32, 0x14, 0xdb, 0x4e, 0xc4, 0xaa, 0x74, 0xc3, 0xd8, 0x33, 0x71,
-		0x6b, 0xcd, 0xdd, 0x0b, 0xdd, 0xe6, 0x13, 0x28, 0x54, 0x22, 0xa2, 0x50, 0xd3, 0x30, 0xe6, 0x58,
-		0x5d, 0xb3, 0x25, 0xce, 0xcb, 0xd3, 0x07, 0xb4, 0x0c, 0x19, 0x5f, 0x4f, 0x52, 0x43, 0xf5, 0xa4,
-		0x48, 0xf4, 0x84, 0xa8, 0x31, 0x2f, 0x50, 0xc6, 0x77, 0xb8, 0xba, 0x54, 0x21, 0xeb, 0x5b, 0x18,
-		0x5f, 0xe1, 0x46, 0xd1, 0xd9, 0x80, 0x8d, 0x84, 0x13, 0xfe, 0xe8, 0xfb, 0xe2, 0x63, 0x3a, 0x27,
-		0xfb, 0x15, 0x5c, 0x7e, 0x11, 0xc5, 0xe2, 0x57, 0x0b, 0x8d, 0xd3, 0x8e, 0x05, 0x8a, 0xc5, 0xae,
-		0x17, 0xba, 0x0f, 0xb2, 0xae, 0xb1, 0x67, 0x6a, 0x5e, 0xd7, 0xc1, 0x5c, 0xf7, 0x82, 0x02, 0x52,
-		0x1b, 0x7c, 0x3c, 0xc2, 0x74, 0x2d, 0x74, 0xe3, 0xd9, 0x15, 0x98, 0x0a, 0xee, 0x1a, 0x0b, 0x50,
-		0x98, 0x9e, 0x21, 0xbf, 0xaa, 0x21, 0x6a, 0x4a, 0xff, 0x42, 0x82, 0x34, 0x77, 0xee, 0xc1, 0x38,
-		0x48, 0xf1, 0xe3, 0x90, 0x18, 0x34, 0x0e, 0xc9, 0xb7, 0x35, 0x0e, 0xe0, 0xb7, 0xd3, 0xe5, 0x37,
-		0xc8, 0xc4, 0x45, 0xa1, 0xac, 0x91, 0x0d, 0x63, 0x8f, 0xcf, 0xfd, 0x10, 0x57, 0xe9, 0x2d, 0x89,
-		0xb8, 0x5f, 0x5e, 0x8f, 0xaa, 0x50, 0x10, 0x2d, 0x53, 0x77, 0xdb, 0xda, 0x1e, 0x57, 0xc7, 0x73,
-		0x83, 0x9b, 0x47, 0x62, 0x16, 0x25, 0xc7, 0x5b, 0x44, 0xa3, 0xaf, 0xd8, 0x91, 0x4d, 0x0c, 0x18,
-		0xd9, 0x88, 0x2a, 0x25, 0xef, 0x4d, 0x95, 0x22, 0x83, 0x9e, 0xea, 0x19, 0xf4, 0xd2, 0xe7, 0x24,
-		0x7e, 0xd9, 0x59, 0x8b, 0x7d, 0xfc, 0xf2, 0x57, 0x36, 0x5a, 0x5f, 0xcb, 0xf5, 0xab, 0x85, 0x5b,
-		0x6a, 0xdf, 0xb0, 0x3d, 0x10, 0x03, 0x19, 0x6d, 0x75, 0x30, 0x7c, 0x48, 0xc0, 0x34, 0x82, 0x61,
-		0xfc, 0xb9, 0x04, 0x4c, 0xf6, 0xd1, 0xff, 0x0d, 0x1c, 0xce, 0xe8, 0x1c, 0x1e, 0x1b, 0x71, 0x0e,
-		0xa7, 0x07, 0xce, 0xe1, 0x9f, 0x4b, 0xd0, 0xcc, 0x80, 0x6d, 0xb9, 0x5a, 0xfb, 0xab, 0x62, 0x83,
-		0xcf, 0x42, 0xd6, 0xb6, 0xda, 0x2a, 0xab, 0x61, 0x5f, 0x2e, 0x65, 0x6c, 0xab, 0xad, 0xf4, 0xa9,
-		0xda, 0xd8, 0x3b, 0x65, 0xa0, 0xd3, 0xef, 0xc0, 0x30, 0x8c, 0xf7, 0xce, 0x2a, 0x0f, 0xf2, 0x4c,
-		0x16, 0x3c, 0x82, 0xba, 0x4a, 0x84, 0x40, 0x63, 0x32, 0xa9, 0x37, 0xe6, 0xf3, 0xdb, 0xcd, 0x48,
-		0x15, 0x4e, 0x48, 0x58, 0x58, 0xbc, 0xd1, 0x9f, 0x56, 0xea, 0xb1, 0x5c, 0x0a, 0x27, 0x2c, 0x7d,
-		0x50, 0x02, 0x58, 0x25, 0xc2, 0xa5, 0x3d, 0x26, 0xc1, 0x8f, 0x4b, 0x1b, 0xa1, 0x46, 0xde, 0x3d,
-		0x37, 0x70, 0xe0, 0x78, 0x0b, 0xf2, 0x6e, 0xb8, 0xe9, 0x4b, 0x50, 0x08, 0x14, 0xdc, 0xc5, 0xa2,
-		0x39, 0x73, 0x47, 0x2d, 0xe7, 0x1b, 0xd8, 0x53, 0xf2, 0xb7, 0x43, 0x4f, 0xa5, 0x7f, 0x29, 0x41,
-		0x96, 0xb6, 0x6a, 0x0d, 0x7b, 0x5a, 0x64, 0x20, 0xa5, 0xb7, 0x31, 0x90, 0xf7, 0x03, 0x30, 0x1c,
-		0xd7, 0x78, 0x03, 0x73, 0xfd, 0xca, 0xd2, 0x92, 0x86, 0xf1, 0x06, 0x46, 0x4f, 0xfb, 0x52, 0x4f,
-		0x0e, 0x91, 0xba, 0x58, 0xef, 0x73, 0xd9, 0x9f, 0x86, 0x71, 0x7a, 0x33, 0xe7, 0x81, 0xcb, 0x97,
-		0xf0, 0x69, 0xb3, 0xdb, 0x69, 0x1e, 0xb8, 0xa5, 0x5b, 0x30, 0xde, 0x3c, 0x60, 0x19, 0xc7, 0xb3,
-		0x90, 0x75, 0x2c, 0x8b, 0x47, 0x83, 0x2c, 0x10, 0xcf, 0x90, 0x02, 0x1a, 0xfc, 0x88, 0x24, 0x5b,
-		0x22, 0x48, 0xb2, 0x05, 0x69, 0xc2, 0xe4, 0x68, 0x69, 0x42, 0xb2, 0x6e, 0x2f, 0x44, 0x66, 0x14,
-		0x7a, 0x14, 0x4e, 0x37, 0xea, 0x2b, 0xeb, 0xb5, 0x25, 0x75, 0xad, 0xb1, 0xd2, 0x73, 0x3b, 0xc1,
-		0x6c, 0xf1, 0xcd, 0xbb, 0xf3, 0x39, 0xbe, 0x60, 0x1f, 0x44, 0xbd, 0xa9, 0xd4, 0xb6, 0x37, 0x9a,
-		0x35, 0x59, 0x62, 0xd4, 0x9b, 0x0e, 0xbe, 0x6d, 0x79, 0xec, 0xee, 0xdb, 0xc7, 0xe1, 0x4c, 0x0c,
-		0xb5, 0xbf, 0x6c, 0x9f, 0x7c, 0xf3, 0xee, 0x7c, 0x61, 0xd3, 0xc1, 0x4c, 0xd5, 0x28, 0xc7, 0x02,
-		0xcc, 0xf4, 0x73, 0x6c, 0x6c, 0x6e, 0x34, 0x2a, 0xab, 0xf2, 0xfc, 0xac, 0xfc, 0xe6, 0xdd, 0xf9,
-		0xbc, 0xb0, 0x1d, 0x84, 0xfe, 0xdd, 0x5f, 0xb7, 0xa7, 0xfa, 0xcf, 0x3b, 0xdc, 0x71, 0x34, 0xdb,
-		0xc6, 0x8e, 0x3b, 0x68, 0x63, 0xff, 0x02, 0xe4, 0x96, 0x42, 0xdf, 0xed, 0xfa, 0x27, 0x3c, 0x24,
-		0xfa, 0x4d, 0x2f, 0x7b, 0x28, 0x95, 0x00, 0x96, 0xdb, 0x96, 0xe6, 0xc5, 0xd0, 0x24, 0x42, 0x34,
-		0x75, 0xd3, 0xbb, 0xfe, 0x64, 0x0c, 0x4d, 0x52, 0xd0, 0x5c, 0x80, 0xdc, 0xd6, 0x20, 0xa2, 0x54,
-		0x14, 0xe8, 0x89, 0x6b, 0x31, 0x34, 0x63, 0x3d, 0x40, 0xb1, 0x44, 0x05, 0x41, 0x74, 0x1e, 0xb2,
-		0x55, 0xcb, 0x6a, 0xc7, 0x90, 0x64, 0x42, 0x38, 0x8d, 0xd0, 0x27, 0xc9, 0x11, 0xa2, 0x6c, 0xa8,
-		0x41, 0x55, 0xb2, 0x6e, 0x8d, 0xa1, 0xf1, 0xcf, 0xc0, 0x1c, 0xfb, 0xe8, 0xc7, 0x7b, 0xf9, 0xb8,
-		0x1c, 0xf7, 0xe8, 0x87, 0x18, 0xcf, 0x7b, 0x3b, 0xfa, 0x91, 0x0f, 0x6d, 0x3d, 0xf8, 0x59, 0x06,
-		0x5b, 0x73, 0xb4, 0x8e, 0x7b, 0xdc, 0x74, 0xea, 0x90, 0x93, 0x35, 0xb3, 0x43, 0x34, 0x91, 0xac,
-		0x6c, 0x8a, 0xfe, 0x82, 0x79, 0x93, 0x36, 0x01, 0x3d, 0x19, 0xce, 0xee, 0xe4, 0x06, 0xc7, 0x21,
-		0x8c, 0x5c, 0x64, 0x7f, 0x5e, 0x80, 0x8c, 0x58, 0x78, 0x71, 0xdb, 0x7c, 0x3e, 0x2e, 0x5a, 0xe2,
-		0x24, 0x9c, 0xd7, 0x67, 0x41, 0x5f, 0x03, 0x59, 0xdf, 0x52, 0x73, 0xd3, 0x54, 0x3a, 0xca, 0xb6,
-		0x73, 0x80, 0x80, 0x09, 0x95, 0x83, 0xf4, 0x40, 0x6a, 0x60, 0xc6, 0x61, 0x9b, 0x51, 0x70, 0x6e,
-		0x3f, 0x35, 0xf0, 0x14, 0xa4, 0xb4, 0x1d, 0xdd, 0xe0, 0xee, 0xfc, 0xfe, 0x18, 0xc6, 0x4a, 0x75,
-		0xb1, 0xce, 0xb8, 0xe8, 0x85, 0x1c, 0x94, 0x9c, 0x34, 0xda, 0x3d, 0x34, 0xf5, 0x7d, 0xc7, 0x32,
-		0x0f, 0xb9, 0x07, 0x8f, 0x6b, 0x74, 0x43, 0xd0, 0x88, 0x46, 0xfb, 0x4c, 0xa4, 0xd1, 0xbb, 0x38,
-		0xf0, 0xde, 0xf1, 0x8d, 0x5e, 0x66, 0x14, 0xa2, 0xd1, 0x9c, 0xa1, 0x54, 0xe7, 0xf9, 0x54, 0x3e,
-		0x6c, 0xf4, 0x5a, 0xa9, 0x03, 0x95, 0x65, 0x7a, 0xd8, 0x84, 0xcf, 0x74, 0xb4, 0x03, 0x3a, 0x69,
-		0x88, 0x2b, 0x21, 0x95, 0x7b, 0xfc, 0xe2, 0x92, 0xa4, 0x92, 0xee, 0x68, 0x07, 0x2b, 0x9a, 0x7b,
-		0x33, 0x95, 0x49, 0xca, 0xa9, 0xd2, 0x27, 0x49, 0xf8, 0x1d, 0x19, 0x1a, 0xf4, 0x08, 0x20, 0xc2,
-		0xa1, 0xed, 0x61, 0x95, 0x38, 0x21, 0x3a, 0xc8, 0x02, 0xb7, 0xd8, 0xd1, 0x0e, 0x2a, 0x7b, 0x78,
-		0xbd, 0xdb, 0xa1, 0x0d, 0x70, 0xd1, 0x1a, 0xc8, 0x82, 0x58, 0x28, 0xa0, 0x1f, 0x2f, 0xf4, 0x5d,
-		0x94, 0xcc, 0x09, 0x58, 0x40, 0xf3, 0x41, 0x12, 0xd0, 0x4c, 0x30, 0x3c, 0xff, 0xc8, 0x57, 0xa4,
-		0x2b, 0xc9, 0x68, 0x57, 0x4a, 0x2f, 0x41, 0xb1, 0x47, 0x0b, 0x50, 0x09, 0x0a, 0x3c, 0x6b, 0x4d,
-		0x8f, 0xd3, 0xb0, 0xb5, 0x7b, 0x56, 0xc9, 0xb1, 0xe4, 0x34, 0x9d, 0x7d, 0xe5, 0xcc, 0x2f, 0x7e,
-		0x6c, 0x4e, 0xa2, 0x5b, 0x97, 0x8f, 0x40, 0x21, 0xa2, 0x06, 0x22, 0x71, 0x29, 0x05, 0x89, 0xcb,
-		0x80, 0xf8, 0x35, 0xc8, 0x13, 0x57, 0x8a, 0x5b, 0x9c, 0xf6, 0x21, 0x28, 0x32, 0x5f, 0xdf, 0x2b,
-		0x6b, 0x16, 0xc3, 0xaf, 0x09, 0x81, 0x97, 0x44, 0x50, 0x1f, 0x15, 0x7b, 0x4e, 0x50, 0xad, 0x68,
-		0x6e, 0xe9, 0x07, 0x24, 0x28, 0xf6, 0xe8, 0x06, 0x7a, 0x01, 0xb2, 0xb6, 0x83, 0x75, 0x23, 0x94,
-		0xe6, 0x3a, 0x42, 0x84, 0x29, 0x2a, 0xbe, 0x80, 0x83, 0x84, 0x49, 0xe2, 0x9c, 0x40, 0x0b, 0xb7,
-		0xb5, 0xc3, 0xe1, 0xa3, 0xc0, 0x20, 0xc4, 0xad, 0xf5, 0x4b, 0x84, 0xa9, 0xf4, 0xab, 0x12, 0x14,
-		0x22, 0x4a, 0x87, 0x5a, 0x70, 0x3f, 0x71, 0xd1, 0xe1, 0xb3, 0xe9, 0xfc, 0xfe, 0xbd, 0xd0, 0x1a,
-		0x2d, 0x77, 0xed, 0x6c, 0xdf, 0x7b, 0x02, 0x47, 0x43, 0x83, 0x1b, 0x49, 0x99, 0x25, 0x38, 0xc1,
-		0x11, 0x75, 0x76, 0x51, 0xdf, 0x0d, 0x16, 0x8c, 0x6f, 0x00, 0xb2, 0x77, 0xbc, 0x5e, 0xe8, 0xc4,
-		0xa8, 0xd0, 0x32, 0x61, 0x0e, 0x03, 0x96, 0x1a, 0x00, 0xc1, 0xc4, 0x45, 0x95, 0x51, 0x3a, 0x91,
-		0x3c, 0xaa, 0x85, 0xe5, 0xc4, 0x8c, 0x54, 0xdd, 0xfc, 0xc4, 0x67, 0xcf, 0x49, 0xef, 0x4a, 0xe8,
-		0xf0, 0xbb, 0x0d, 0xb8, 0x2f, 0x20, 0xdd, 0xd1, 0x8d, 0xde, 0x84, 0xb6, 0xec, 0x1b, 0x07, 0x52,
-		0x4b, 0xdc, 0xc2, 0xd1, 0xfb, 0x69, 0x43, 0xd3, 0xdd, 0x43, 0x1c, 0xd1, 0x28, 0xe9, 0xf0, 0x7b,
-		0xcc, 0x76, 0xff, 0xbb, 0x2c, 0x8c, 0x2b, 0xf8, 0x7d, 0x5d, 0xec, 0x7a, 0xe8, 0x09, 0x48, 0x61,
-		0x7d, 0xdf, 0xea, 0xdf, 0x72, 0xe2, 0xbd, 0x5c, 0xa8, 0xe9, 0xfb, 0x16, 0x27, 0xbe, 0x71, 0x42,
-		0xa1, 0xc4, 0xe8, 0x3a, 0x8c, 0xed, 0xb6, 0xbb, 0x3c, 0x11, 0x1e, 0x71, 0x53, 0x82, 0x6b, 0x99,
-		0x54, 0x07, 0x6c, 0x8c, 0x9c, 0xbc, 0x8c, 0xfe, 0x9c, 0x40, 0x72, 0xd0, 0xcb, 0xe8, 0xaf, 0x08,
-		0x04, 0x2f, 0x23, 0xc4, 0x68, 0x11, 0xc0, 0x30, 0x0d, 0x4f, 0xa5, 0x39, 0x62, 0xee, 0x26, 0x4a,
-		0x71, 0xac, 0x86, 0x47, 0xf3, 0xc9, 0x01, 0x7f, 0xd6, 0x10, 0x65, 0xa4, 0xc5, 0xef, 0xeb, 0x62,
-		0x47, 0xb8, 0x8a, 0x98, 0x16, 0xbf, 0x87, 0x54, 0x87, 0x5a, 0x4c, 0xc9, 0x89, 0x6b, 0x65, 0x97,
-		0x93, 0x7a, 0x07, 0xfc, 0xca, 0xed, 0xf9, 0x7e, 0x56, 0x7a, 0x37, 0x69, 0xf3, 0x20, 0x60, 0x1e,
-		0xd7, 0x59, 0x09, 0x7a, 0xd6, 0x5f, 0xc2, 0xe5, 0x7a, 0xd7, 0x4c, 0x3e, 0x33, 0x5b, 0xc1, 0xf9,
-		0xbc, 0x9c, 0x01, 0x6d, 0xc0, 0x44, 0xdb, 0x70, 0x3d, 0xd5, 0x35, 0x35, 0xdb, 0xdd, 0xb7, 0x3c,
-		0x97, 0xe6, 0x62, 0x73, 0xd7, 0x1e, 0xea, 0x87, 0x58, 0x35, 0x5c, 0xaf, 0x21, 0xc8, 0x02, 0xa4,
-		0x42, 0x3b, 0x5c, 0x4e, 0x00, 0xad, 0xdd, 0x5d, 0xec, 0xf8, 0x88, 0x34, 0x69, 0x1b, 0x0b, 0xb8,
-		0x41, 0xe8, 0x04, 0x67, 0x08, 0xd0, 0x0a, 0x97, 0xa3, 0xaf, 0x83, 0xa9, 0xb6, 0xa5, 0xb5, 0x7c,
-		0x3c, 0x55, 0xdf, 0xef, 0x9a, 0xb7, 0x68, 0x8a, 0x37, 0x77, 0xed, 0x72, 0x4c, 0x33, 0x2d, 0xad,
-		0x25, 0x98, 0x17, 0x09, 0x69, 0x80, 0x3c, 0xd9, 0xee, 0xad, 0x43, 0x2a, 0x4c, 0x6b, 0xb6, 0xdd,
-		0x3e, 0xec, 0x85, 0x2f, 0x52, 0xf8, 0x47, 0xfa, 0xe1, 0x2b, 0x84, 0x7a, 0x00, 0x3e, 0xd2, 0xfa,
-		0x2a, 0xd1, 0x16, 0xc8, 0xb6, 0x83, 0xe9, 0x77, 0xab, 0x36, 0x5f, 0xa4, 0xd0, 0x5b, 0xfd, 0x72,
-		0xd7, 0x2e, 0xf5, 0x83, 0x6f, 0x32, 0x4a, 0xb1, 0x9a, 0x09, 0x90, 0x8b, 0x76, 0xb4, 0x86, 0xc1,
-		0x5a, 0x3a, 0xa6, 0xb7, 0x8e, 0x72, 0xd8, 0xc9, 0xc1, 0xb0, 0x94, 0x32, 0x16, 0x36, 0x52, 0x83,
-		0x96, 0x21, 0xc7, 0xb2, 0x5a, 0x2a, 0x31, 0x91, 0xf4, 0x36, 0xc0, 0xdc, 0xb5, 0x0b, 0x31, 0xd3,
-		0x95, 0x12, 0x6d, 0x5b, 0x1e, 0x0e, 0xc0, 0x00, 0xfb, 0x85, 0x68, 0x07, 0x4e, 0xd2, 0x9b, 0x11,
-		0x0f, 0xd5, 0xa8, 0x3d, 0x9e, 0x99, 0xa2, 0x88, 0x8f, 0xf6, 0x23, 0x6e, 0x53, 0xf2, 0xed, 0xb0,
-		0x61, 0x0e, 0xa0, 0xa7, 0x6e, 0xf7, 0xd7, 0x12, 0x4d, 0xdb, 0x35, 0x4c, 0xad, 0x6d, 0xbc, 0x81,
-		0x59, 0xf0, 0x42, 0x2f, 0x05, 0x8e, 0xd5, 0xb4, 0x65, 0x4e, 0x47, 0x83, 0x99, 0x90, 0xa6, 0xed,
-		0x86, 0xcb, 0xab, 0xe3, 0x7c, 0xc9, 0xe1, 0xdf, 0x72, 0x39, 0x2e, 0x67, 0xd8, 0xcd, 0x96, 0x37,
-		0x53, 0x19, 0x90, 0x73, 0xa5, 0x8b, 0x90, 0x0b, 0xd9, 0x29, 0x34, 0x03, 0xe3, 0xdc, 0xa9, 0x8a,
-		0x03, 0xfc, 0xfc, 0xb1, 0x34, 0x01, 0xf9, 0xb0, 0x69, 0x2a, 0x7d, 0x40, 0x82, 0x5c, 0xc8, 0xe8,
-		0x10, 0xce, 0xf0, 0x46, 0x57, 0x36, 0x88, 0x53, 0x2f, 0x88, 0xa8, 0x42, 0xd4, 0xb3, 0xcd, 0xd6,
-		0x3c, 0x2d, 0xe4, 0x41, 0x0d, 0x9a, 0x83, 0x9c, 0x7d, 0xcd, 0xf6, 0x49, 0x92, 0x94, 0x04, 0xec,
-		0x6b, 0xb6, 0x20, 0x38, 0x0f, 0x79, 0xd2, 0x75, 0x35, 0x1c, 0x2e, 0x67, 0x95, 0x1c, 0x29, 0xe3,
-		0x24, 0xa5, 0xdf, 0x4c, 0x80, 0xdc, 0x6b, 0xcc, 0xfc, 0x0d, 0x30, 0xe9, 0xd8, 0x1b, 0x60, 0x67,
-		0x7a, 0xb7, 0xde, 0x82, 0xdd, 0xb6, 0x35, 0x90, 0x83, 0x3d, 0x23, 0xe6, 0x7b, 0x8e, 0x88, 0xff,
-		0x7b, 0xd6, 0x2a, 0x4a, 0x51, 0xef, 0x59, 0xbc, 0xac, 0x44, 0xce, 0x8b, 0xa4, 0xfc, 0x23, 0xae,
-		0xbd, 0xfa, 0x24, 0x68, 0xb6, 0xec, 0x96, 0xe6, 0x61, 0x91, 0x72, 0x0f, 0x1d, 0x1d, 0x79, 0x08,
-		0x8a, 0x9a, 0x6d, 0xab, 0xae, 0xa7, 0x79, 0x98, 0x07, 0x7a, 0x2c, 0x91, 0x59, 0xd0, 0x6c, 0xbb,
-		0x41, 0x4a, 0x59, 0xa0, 0xf7, 0x20, 0x4c, 0x10, 0x0b, 0x6f, 0x68, 0x6d, 0x11, 0x45, 0xa4, 0x59,
-		0x3c, 0xc8, 0x4b, 0x79, 0x24, 0xd2, 0x82, 0x7c, 0xd8, 0xb8, 0xfb, 0xa9, 0x19, 0x29, 0x94, 0x9a,
-		0x41, 0xfc, 0xe2, 0x25, 0x26, 0x21, 0x71, 0x59, 0x55, 0xfc, 0x66, 0xe4, 0x34, 0x4d, 0xe3, 0xdc,
-		0x66, 0xb9, 0xd7, 0x8c, 0xc2, 0x1e, 0x4a, 0xaf, 0xc2, 0x44, 0xd4, 0x0f, 0xa0, 0x09, 0x48, 0x78,
-		0x07, 0xfc, 0x2d, 0x09, 0xef, 0x00, 0x5d, 0xe5, 0xd9, 0xd3, 0x24, 0xcd, 0x9e, 0xde, 0x3f, 0xd0,
-		0x8f, 0x04, 0xa9, 0xd3, 0x9b, 0xa9, 0x4c, 0x42, 0x4e, 0x96, 0x8a, 0x50, 0x88, 0x78, 0x89, 0xd2,
-		0x29, 0x98, 0x8e, 0xb3, 0xf9, 0x25, 0x03, 0xa6, 0xe3, 0x4c, 0x37, 0xba, 0x0e, 0x19, 0xdf, 0xe8,
-		0xf7, 0x65, 0xdb, 0xc4, 0xdb, 0x7d, 0x26, 0x9f, 0x36, 0xb2, 0x5b, 0x98, 0x88, 0xec, 0x16, 0x96,
-		0xbe, 0x11, 0x66, 0x06, 0xd9, 0xf3, 0x9e, 0xed, 0x83, 0x94, 0x2f, 0xb8, 0x53, 0x90, 0xe6, 0xf7,
-		0x03, 0x27, 0x68, 0x9a, 0x82, 0x3f, 0x11, 0x81, 0x32, 0xdb, 0x9e, 0x64, 0xd9, 0x0b, 0xfa, 0x50,
-		0x52, 0xe1, 0xcc, 0x40, 0x93, 0x3e, 0x78, 0xb7, 0x9d, 0x01, 0xf1, 0xdd, 0x76, 0xfa, 0x40, 0x7f,
-		0x85, 0x08, 0x9b, 0x22, 0x09, 0x98, 0x55, 0xf8, 0x53, 0xe9, 0x43, 0x49, 0x38, 0x15, 0x6f, 0xd7,
-		0xd1, 0x3c, 0xe4, 0xc9, 0xe2, 0xc1, 0x8b, 0xae, 0x33, 0xa0, 0xa3, 0x1d, 0x34, 0xf9, 0x22, 0x83,
-		0xef, 0x54, 0x26, 0xfc, 0x9d, 0x4a, 0xb4, 0x0d, 0x93, 0x6d, 0x4b, 0xd7, 0xda, 0x6a, 0x68, 0xa7,
-		0x98, 0x4f, 0xa7, 0x07, 0x06, 0xd9, 0x69, 0xb1, 0x17, 0x41, 0x4c, 0x10, 0x9f, 0x08, 0x45, 0x0a,
-		0xb2, 0xea, 0xef, 0x2a, 0xa3, 0x1a, 0xe4, 0x3a, 0x86, 0xbb, 0x83, 0xf7, 0xb5, 0xdb, 0x86, 0xe5,
-		0xf0, 0x79, 0x15, 0xa3, 0x3d, 0x6b, 0x01, 0x91, 0xd8, 0xc2, 0x0e, 0xf1, 0x85, 0x06, 0x65, 0x2c,
-		0x76, 0x6b, 0x3d, 0x7d, 0x6c, 0xcb, 0x32, 0x68, 0x93, 0x7a, 0x7c, 0xe0, 0x26, 0x75, 0xdc, 0x8e,
-		0x70, 0x26, 0x7e, 0x47, 0xf8, 0x4d, 0x3a, 0x38, 0x71, 0xde, 0xb1, 0x7f, 0x93, 0x18, 0x35, 0x61,
-		0x9a, 0xf3, 0xb7, 0x22, 0xd2, 0xef, 0x3b, 0x77, 0x16, 0x0d, 0xba, 0x42, 0x52, 0x47, 0x82, 0x7f,
-		0xb0, 0xe0, 0x93, 0xf7, 0x28, 0x78, 0x71, 0x54, 0x23, 0x15, 0x3a, 0xaa, 0xf1, 0xbf, 0xd9, 0x60,
-		0x7c, 0x6b, 0x52, 0x6c, 0x9e, 0x85, 0x02, 0x8b, 0xd8, 0x33, 0x28, 0x83, 0xf6, 0x7a, 0x44, 0xc7,
-		0x92, 0xc7, 0xee, 0x18, 0x1f, 0xed, 0xd4, 0xf0, 0xd1, 0x1e, 0x7b, 0x27, 0x47, 0x3b, 0x7d, 0x8f,
-		0xa3, 0xfd, 0xae, 0x8e, 0xc3, 0x47, 0x24, 0x98, 0x1d, 0x1c, 0x8e, 0xc5, 0x0e, 0xc8, 0xb1, 0x76,
-		0x27, 0x07, 0x79, 0xbc, 0x07, 0x61, 0xa2, 0x27, 0x5a, 0x64, 0xca, 0x5c, 0x88, 0x2c, 0xd7, 0x4b,
-		0xdf, 0x96, 0x84, 0xe9, 0xb8, 0x80, 0x2e, 0x66, 0xc6, 0x2a, 0x30, 0xd5, 0xc2, 0xba, 0xd1, 0xba,
-		0xe7, 0x09, 0x3b, 0xc9, 0xd9, 0xff, 0xcf, 0x7c, 0x8d, 0xd1, 0x93, 0x1f, 0x07, 0xc8, 0x28, 0xd8,
-		0xb5, 0x49, 0x80, 0xc6, 0x7e, 0xed, 0x4e, 0xc7, 0xb6, 0x17, 0xa4, 0xb5, 0x62, 0xd7, 0x0d, 0x9c,
-		0x44, 0xf0, 0x91, 0xf5, 0xb3, 0xcf, 0x87, 0x9e, 0xe4, 0x69, 0x82, 0x81, 0x0b, 0x7e, 0x16, 0x7e,
-		0xfb, 0xac, 0x2c, 0x4f, 0xf0, 0xb4, 0xc8, 0x13, 0x24, 0x07, 0xad, 0x7e, 0x79, 0x30, 0xee, 0xf3,
-		0xf1, 0x44, 0xc1, 0x93, 0x3c, 0x51, 0x90, 0x1a, 0xf4, 0x3a, 0x16, 0xb3, 0x07, 0xaf, 0x33, 0xd8,
-		0x45, 0xa6, 0xe1, 0x4c, 0x41, 0x7a, 0x50, 0x57, 0x43, 0xc1, 0x75, 0xd0, 0xd5, 0x20, 0x55, 0xf0,
-		0xb4, 0x48, 0x15, 0x8c, 0x0f, 0x6a, 0x34, 0x8f, 0x26, 0x83, 0x46, 0xb3, 0x5c, 0xc1, 0x8b, 0xa1,
-		0x5c, 0x41, 0xb6, 0x37, 0x0d, 0xdf, 0x97, 0x2b, 0xf0, 0xb9, 0xfd, 0x64, 0x41, 0xd9, 0x4f, 0x16,
-		0xe4, 0x07, 0x66, 0x1a, 0x78, 0x18, 0xe8, 0x33, 0x8b, 0x6c, 0xc1, 0x66, 0x5f, 0xb6, 0x80, 0x2d,
-		0xee, 0x2f, 0x0e, 0xcd, 0x16, 0xf8, 0x50, 0x3d, 0xe9, 0x82, 0xcd, 0xbe, 0x74, 0xc1, 0xc4, 0x20,
-		0xc4, 0x9e, 0x98, 0x33, 0x40, 0x8c, 0xe6, 0x0b, 0xbe, 0x3e, 0x3e, 0x5f, 0x30, 0x70, 0x41, 0x1f,
-		0x13, 0x5f, 0xfa, 0xd0, 0x31, 0x09, 0x83, 0x6f, 0x1c, 0x90, 0x30, 0x90, 0x07, 0x2d, 0x6c, 0xe3,
-		0xa2, 0x4b, 0xff, 0x05, 0x71, 0x19, 0x83, 0xed, 0x98, 0x8c, 0x01, 0x5b, 0xda, 0x3f, 0x3c, 0x42,
-		0xc6, 0xc0, 0x87, 0xee, 0x4b, 0x19, 0x6c, 0xc7, 0xa4, 0x0c, 0xd0, 0x60, 0xdc, 0x9e, 0xa0, 0x28,
-		0x8c, 0x1b, 0xcd, 0x19, 0xac, 0x44, 0x73, 0x06, 0x53, 0x47, 0xc7, 0xa2, 0xcc, 0xb5, 0xfb, 0x68,
-		0xe1, 0xa4, 0x81, 0x3e, 0x28, 0x69, 0xc0, 0xd6, 0xf5, 0x8f, 0x8d, 0x98, 0x34, 0xf0, 0xb1, 0x63,
-		0xb3, 0x06, 0x9b, 0x7d, 0x59, 0x83, 0x93, 0x83, 0x14, 0xae, 0xc7, 0xc9, 0x04, 0x0a, 0x37, 0x30,
-		0x6d, 0xc0, 0x7e, 0x16, 0x83, 0xfd, 0x20, 0x06, 0xc8, 0xb9, 0x9b, 0xa9, 0x4c, 0x4e, 0xce, 0x97,
-		0x1e, 0x26, 0x61, 0x4d, 0x8f, 0xdd, 0x23, 0x8b, 0x08, 0xec, 0x38, 0x96, 0x23, 0xf6, 0x40, 0xe9,
-		0x43, 0xe9, 0x12, 0xe4, 0xc3, 0x26, 0xee, 0x88, 0x14, 0x43, 0x11, 0x0a, 0x11, 0xab, 0x56, 0xfa,
-		0x45, 0x09, 0xf2, 0x61, 0x7b, 0x15, 0x59, 0x80, 0x66, 0xf9, 0x02, 0x34, 0x94, 0x78, 0x48, 0x44,
-		0x13, 0x0f, 0x73, 0x90, 0x23, 0x8b, 0xb0, 0x9e, 0x9c, 0x82, 0x66, 0xfb, 0x39, 0x05, 0x71, 0x70,
-		0x93, 0xa5, 0x27, 0xb8, 0x9f, 0x62, 0xa7, 0x16, 0x8a, 0xfe, 0x21, 0x56, 0x9e, 0xe6, 0x7f, 0x0c,
-		0xa6, 0x42, 0xb4, 0xfe, 0xe2, 0x8e, 0x2d, 0xaf, 0x65, 0x9f, 0xba, 0xc2, 0x57, 0x79, 0xbf, 0x2a,
-		0xc1, 0x64, 0x9f, 0xb9, 0x8c, 0xcd, 0x1b, 0x48, 0xef, 0x54, 0xde, 0x20, 0x71, 0xef, 0x79, 0x83,
-		0xf0, 0x72, 0x35, 0x19, 0x5d, 0xae, 0xfe, 0xa5, 0x04, 0x85, 0x88, 0xd9, 0x26, 0x83, 0xa0, 0x5b,
-		0x2d, 0xb1, 0x63, 0x4e, 0xff, 0x26, 0x71, 0x4a, 0xdb, 0xda, 0xe3, 0xcb, 0x44, 0xf2, 0x27, 0xa1,
-		0xf2, 0x1d, 0x51, 0x96, 0xbb, 0x19, 0x7f, 0xed, 0x39, 0x16, 0xfe, 0xa6, 0x8c, 0x7f, 0x67, 0x95,
-		0x0e, 0xbe, 0xb3, 0xf2, 0x37, 0xca, 0xc7, 0x43, 0x1b, 0xe5, 0xe8, 0x59, 0xc8, 0xd2, 0x5d, 0x00,
-		0xd5, 0xb2, 0x83, 0x1f, 0xe6, 0x1d, 0xfc, 0x8d, 0x95, 0x4b, 0x3f, 0x12, 0x60, 0x1f, 0x66, 0x05,
-		0x51, 0x48, 0x36, 0x12, 0x85, 0xdc, 0x07, 0x59, 0xd2, 0x7c, 0xf6, 0x73, 0x44, 0xc0, 0xaf, 0x1a,
-		0x11, 0x05, 0xa5, 0x9f, 0x4a, 0x40, 0xb1, 0xc7, 0xeb, 0xc4, 0x76, 0x3e, 0xee, 0xc4, 0xca, 0x68,
-		0x02, 0x39, 0x07, 0xb0, 0xa7, 0xb9, 0xea, 0x1d, 0xcd, 0xf4, 0x70, 0x8b, 0x4b, 0x25, 0x54, 0x82,
-		0x66, 0x21, 0x43, 0x9e, 0xba, 0x2e, 0x6e, 0xf1, 0x0c, 0x8d, 0xff, 0x8c, 0xea, 0x90, 0xc6, 0xb7,
-		0xe9, 0x75, 0xdc, 0xec, 0x52, 0xfb, 0xd3, 0x31, 0xe6, 0x89, 0xd4, 0x57, 0x67, 0xc8, 0x70, 0xff,
-		0xd1, 0x5b, 0x73, 0x32, 0x23, 0x7f, 0xd4, 0xff, 0x80, 0x55, 0xe1, 0x00, 0x51, 0x31, 0x64, 0x7a,
-		0xc4, 0x40, 0xd3, 0x85, 0x79, 0xb1, 0xf6, 0x27, 0x42, 0x65, 0x5f, 0xe2, 0x28, 0x85, 0x0e, 0xee,
-		0xd8, 0x96, 0xd5, 0x56, 0xd9, 0x3c, 0xaf, 0xc0, 0x44, 0xd4, 0xc9, 0xb2, 0xdf, 0x0a, 0xf4, 0x34,
-		0xc3, 0x54, 0x23, 0xb1, 0x71, 0x9e, 0x15, 0xb2, 0x79, 0x75, 0x33, 0x95, 0x91, 0xe4, 0x04, 0x4f,
-		0xd7, 0xbc, 0x07, 0x4e, 0xc6, 0xfa, 0x58, 0xf4, 0x0c, 0x64, 0x03, 0xff, 0xcc, 0xbe, 0xa7, 0x3a,
-		0x2a, 0x0f, 0x13, 0x10, 0x97, 0xb6, 0xe1, 0x64, 0xac, 0x93, 0x45, 0x2f, 0x40, 0x9a, 0x9d, 0xd7,
-		0xe6, 0x67, 0xf2, 0x1e, 0x1c, 0xee, 0x9d, 0xbb, 0x6d, 0x4f, 0xe1, 0x4c, 0xa5, 0xab, 0x70, 0x66,
-		0xa0, 0x97, 0x0d, 0xb2, 0x29, 0x52, 0x28, 0x9b, 0x52, 0xfa, 0x69, 0x09, 0x66, 0x07, 0x7b, 0x4e,
-		0x54, 0xed, 0x69, 0xd0, 0xe5, 0x11, 0xfd, 0x6e, 0xa8, 0x55, 0x64, 0xb9, 0xe1, 0xe0, 0x5d, 0xec,
-		0xe9, 0xfb, 0xcc, 0x85, 0x33, 0xa3, 0x50, 0x50, 0x0a, 0xbc, 0x94, 0xf2, 0xb8, 0x8c, 0xec, 0x75,
-		0xac, 0x7b, 0x2a, 0x1b, 0x54, 0x97, 0xff, 0xd4, 0x78, 0x81, 0x95, 0x36, 0x58, 0x61, 0xe9, 0x11,
-		0x38, 0x3d, 0xc0, 0x17, 0xc7, 0x1c, 0x37, 0x7f, 0x8d, 0x10, 0xc7, 0x3a, 0x58, 0xf4, 0x12, 0xa4,
-		0x5d, 0x4f, 0xf3, 0xba, 0x2e, 0xef, 0xd9, 0xc5, 0xa1, 0xbe, 0xb9, 0x41, 0xc9, 0x15, 0xce, 0x56,
-		0x7a, 0x0e, 0x50, 0xbf, 0xa7, 0x8d, 0x59, 0x5b, 0x49, 0x71, 0x6b, 0xab, 0x1d, 0x38, 0x7b, 0x84,
-		0x4f, 0x45, 0x8b, 0x3d, 0x8d, 0x7b, 0x64, 0x24, 0x97, 0xdc, 0xd3, 0xc0, 0x3f, 0x49, 0xc0, 0xc9,
-		0x58, 0xd7, 0x1a, 0x9a, 0xa5, 0xd2, 0xdb, 0x9d, 0xa5, 0x2f, 0x00, 0x78, 0x07, 0xe2, 0x23, 0x03,
-		0x6e, 0xed, 0xe3, 0xd6, 0x13, 0x07, 0x58, 0xa7, 0x06, 0x8b, 0x28, 0x46, 0xd6, 0xe3, 0x7f, 0x91,
-		0xc5, 0x7f, 0x68, 0x3d, 0xdb, 0xa5, 0x9e, 0xc0, 0xe5, 0x4b, 0xbd, 0x91, 0x7d, 0x46, 0xb0, 0xf0,
-		0x65, 0xc5, 0x2e, 0x7a, 0x0d, 0x4e, 0xf7, 0x78, 0x34, 0x1f, 0x3b, 0x35, 0xb2, 0x63, 0x3b, 0x19,
-		0x75, 0x6c, 0x02, 0x3b, 0xec, 0x95, 0xc6, 0xa2, 0x5e, 0xe9, 0x35, 0x80, 0x60, 0x61, 0x1b, 0x9c,
-		0x87, 0x95, 0xc2, 0xe7, 0x61, 0xaf, 0xc3, 0x18, 0xd1, 0x04, 0x21, 0xaa, 0x18, 0x83, 0x41, 0x86,
-		0x34, 0xb4, 0x32, 0x66, 0xe4, 0xa5, 0xd7, 0x85, 0xb6, 0x85, 0x73, 0x8c, 0x03, 0xde, 0xf1, 0x62,
-		0xf4, 0x1d, 0xa5, 0xc1, 0xe9, 0xca, 0xf8, 0x77, 0xfd, 0x5f, 0x30, 0x46, 0x87, 0x3f, 0xf6, 0x03,
-		0xe4, 0x6f, 0x00, 0xd0, 0x3c, 0xcf, 0x31, 0x76, 0xba, 0xc1, 0x1b, 0xe6, 0x07, 0xe8, 0x4f, 0x45,
-		0x10, 0x56, 0xef, 0xe3, 0x8a, 0x34, 0x1d, 0xf0, 0x86, 0x94, 0x29, 0x84, 0x58, 0x5a, 0x87, 0x89,
-		0x28, 0x6f, 0xfc, 0x17, 0xd5, 0xc1, 0xbd, 0x4d, 0xe2, 0x5c, 0x5b, 0xe0, 0xc8, 0xf9, 0x5d, 0x6a,
-		0xf4, 0xa1, 0xf4, 0x4d, 0x09, 0xc8, 0x87, 0xb5, 0xef, 0x6f, 0xa1, 0xb3, 0x2c, 0x7d, 0x9b, 0x04,
-		0x19, 0xbf, 0xff, 0x47, 0x7c, 0x0d, 0x10, 0x7c, 0x5b, 0xef, 0xe7, 0xe0, 0xd9, 0xae, 0x47, 0xd2,
-		0xdf, 0xf5, 0x78, 0xde, 0x77, 0x08, 0x03, 0x17, 0xf3, 0x61, 0x69, 0x8b, 0x73, 0xb8, 0xdc, 0x41,
-		0x3d, 0x37, 0xda, 0xc7, 0xbd, 0xd3, 0x30, 0x16, 0xfe, 0x2e, 0x97, 0x3d, 0x94, 0x70, 0xe8, 0xb8,
-		0x12, 0x9b, 0x8d, 0xe1, 0xaf, 0x80, 0xa5, 0xe3, 0x7f, 0x05, 0xec, 0xbf, 0x26, 0x11, 0x7e, 0xcd,
-		0xdf, 0x95, 0x20, 0x23, 0xe6, 0x05, 0x7a, 0x29, 0x7c, 0x98, 0x4e, 0x9c, 0xcc, 0x19, 0x6c, 0x97,
-		0xf8, 0x0b, 0x42, 0x67, 0xe9, 0xfa, 0x3e, 0x49, 0x48, 0x1e, 0xfb, 0x93, 0x04, 0x1e, 0x87, 0x7c,
-		0x59, 0x02, 0xb9, 0x77, 0xde, 0xbe, 0xfd, 0xf6, 0xf5, 0xfb, 0xab, 0x64, 0x8c, 0xbf, 0x1a, 0xf4,
-		0xa1, 0x41, 0x6a, 0xd0, 0x87, 0x06, 0xfd, 0xfd, 0x1e, 0xbb, 0xd7, 0x7e, 0x7f, 0x6b, 0x02, 0x72,
-		0xa1, 0x1c, 0x1f, 0x7a, 0x2a, 0xf2, 0xd5, 0xc2, 0xf9, 0x23, 0x13, 0x82, 0xa1, 0xcf, 0x16, 0x22,
-		0x92, 0x4a, 0xdc, 0x83, 0xa4, 0xde, 0xf9, 0x8f, 0x19, 0xe3, 0xbf, 0x8c, 0x1f, 0x1b, 0xf0, 0x65,
-		0xfc, 0xff, 0x2b, 0x41, 0xc6, 0x4f, 0xbe, 0x1c, 0x77, 0x4f, 0xee, 0x14, 0xa4, 0x79, 0xec, 0xc5,
-		0x36, 0xe5, 0xf8, 0x53, 0x6c, 0x76, 0x74, 0x16, 0x32, 0xe2, 0x77, 0x51, 0xb9, 0x87, 0xf3, 0x9f,
-		0x2f, 0xef, 0x40, 0x2e, 0xb4, 0xad, 0x89, 0xce, 0xc0, 0xc9, 0xc5, 0x1b, 0xb5, 0xc5, 0x97, 0xd5,
-		0xe6, 0x2b, 0xbd, 0xbf, 0xad, 0xd7, 0x57, 0xa5, 0xd4, 0xe8, 0xb3, 0x2c, 0xa1, 0xd3, 0x30, 0x15,
-		0xad, 0x62, 0x15, 0x89, 0xd9, 0xd4, 0xb7, 0xff, 0xd8, 0xb9, 0x13, 0x97, 0xbf, 0x2c, 0xc1, 0x54,
-		0x4c, 0x94, 0x8b, 0xce, 0xc3, 0xfd, 0x1b, 0xcb, 0xcb, 0x35, 0x45, 0x6d, 0xac, 0x57, 0x36, 0x1b,
-		0x37, 0x36, 0x9a, 0xaa, 0x52, 0x6b, 0x6c, 0xad, 0x36, 0x43, 0x2f, 0x9d, 0x87, 0xfb, 0xe2, 0x49,
-		0x2a, 0x8b, 0x8b, 0xb5, 0xcd, 0x26, 0xfb, 0x71, 0xbf, 0x01, 0x14, 0xd5, 0x0d, 0xa5, 0x29, 0x27,
-		0x06, 0x43, 0x28, 0xb5, 0x9b, 0xb5, 0xc5, 0xa6, 0x9c, 0x44, 0x17, 0xe1, 0xc2, 0x51, 0x14, 0xea,
-		0xf2, 0x86, 0xb2, 0x56, 0x69, 0xca, 0xa9, 0xa1, 0x84, 0x8d, 0xda, 0xfa, 0x52, 0x4d, 0x91, 0xc7,
-		0x78, 0xbf, 0x3f, 0x96, 0x80, 0x99, 0x41, 0xc1, 0x34, 0xc1, 0xaa, 0x6c, 0x6e, 0xae, 0xbe, 0x1a,
-		0x60, 0x2d, 0xde, 0xd8, 0x5a, 0x7f, 0xb9, 0x5f, 0x04, 0x0f, 0x41, 0xe9, 0x28, 0x42, 0x5f, 0x10,
-		0x0f, 0xc2, 0xf9, 0x23, 0xe9, 0xb8, 0x38, 0x86, 0x90, 0x29, 0xb5, 0xa6, 0xf2, 0xaa, 0x9c, 0x44,
-		0x0b, 0x70, 0x79, 0x28, 0x99, 0x5f, 0x27, 0xa7, 0xd0, 0x15, 0x78, 0xe4, 0x68, 0x7a, 0x26, 0x20,
-		0xc1, 0x20, 0x44, 0xf4, 0xa6, 0x04, 0x27, 0x63, 0xa3, 0x72, 0x74, 0x01, 0xe6, 0x36, 0x95, 0x8d,
-		0xc5, 0x5a, 0xa3, 0xe1, 0x7f, 0xb3, 0xa0, 0x36, 0x9a, 0x95, 0xe6, 0x56, 0x23, 0x24, 0x9b, 0x12,
-		0x9c, 0x1b, 0x44, 0xe4, 0xcb, 0xe5, 0x08, 0x1a, 0xae, 0x01, 0x42, 0x4f, 0xef, 0x4a, 0x70, 0x66,
-		0x60, 0x14, 0x8e, 0x2e, 0xc1, 0x03, 0xdb, 0x35, 0xa5, 0xbe, 0xfc, 0xaa, 0xba, 0xbd, 0xd1, 0x0c,
-		0xff, 0x8a, 0x64, 0x5f, 0xab, 0x2e, 0xc2, 0x85, 0x23, 0x29, 0xfd, 0xa6, 0x0d, 0x23, 0xec, 0x69,
-		0xdf, 0xb7, 0x48, 0x50, 0xec, 0xb1, 0x85, 0xe8, 0x3e, 0x98, 0x59, 0xab, 0x37, 0xaa, 0xb5, 0x1b,
-		0x95, 0xed, 0xfa, 0x86, 0xd2, 0x3b, 0x67, 0x2f, 0xc0, 0x5c, 0x5f, 0xed, 0xd2, 0xd6, 0xe6, 0x6a,
-		0x7d, 0xb1, 0xd2, 0xac, 0xa9, 0xec, 0x43, 0x13, 0xd2, 0xb1, 0x3e, 0xa2, 0xd5, 0xfa, 0xca, 0x8d,
-		0xa6, 0xba, 0xb8, 0x5a, 0xaf, 0xad, 0x37, 0xd5, 0x4a, 0xb3, 0x59, 0x09, 0xa6, 0x73, 0xf5, 0xe5,
-		0x81, 0x07, 0x3c, 0xaf, 0x8e, 0x7e, 0xc0, 0x93, 0x1f, 0xe1, 0xf4, 0xcf, 0x77, 0xfe, 0x87, 0x27,
-		0xe0, 0x01, 0x7e, 0x31, 0x91, 0xeb, 0x69, 0xb7, 0x0c, 0x73, 0xcf, 0xbf, 0x21, 0x8a, 0x3f, 0xf3,
-		0x73, 0x9e, 0xa7, 0xf8, 0x2d, 0x48, 0xa2, 0x74, 0xc8, 0x3d, 0x51, 0x03, 0xaf, 0x17, 0x1d, 0xfa,
-		0x7d, 0xc0, 0xb0, 0x63, 0x9a, 0x47, 0xdd, 0x41, 0x35, 0xe4, 0xa6, 0xab, 0x98, 0x3b, 0xaa, 0x66,
-		0x8f, 0xbe, 0xaf, 0x61, 0xf6, 0xc8, 0xc3, 0xaf, 0xa5, 0x0f, 0x4a, 0x30, 0x71, 0xc3, 0x70, 0x3d,
-		0xcb, 0x31, 0x74, 0xad, 0x4d, 0x03, 0x89, 0xe7, 0x47, 0xfe, 0xa0, 0xad, 0x9a, 0x25, 0x6e, 0x8c,
-		0xdf, 0x64, 0xb5, 0x2f, 0xbe, 0x29, 0x4b, 0xdf, 0xd6, 0xda, 0xec, 0x63, 0xb2, 0xf0, 0x55, 0x78,
-		0xbd, 0x62, 0x0f, 0xf9, 0xd7, 0x30, 0x0a, 0xe3, 0x2d, 0x27, 0x66, 0xa4, 0xd2, 0x0f, 0x24, 0xa0,
-		0x48, 0x17, 0x38, 0x2e, 0x5d, 0x10, 0xd3, 0x25, 0xd7, 0x4d, 0x48, 0x39, 0x9a, 0xc7, 0x97, 0x21,
-		0xd5, 0xeb, 0xc7, 0xbe, 0xfe, 0x8a, 0xbd, 0x85, 0x62, 0xa0, 0xf7, 0x40, 0xa6, 0xa3, 0x1d, 0xa8,
-		0x14, 0x2f, 0xf1, 0xb6, 0xf0, 0xc6, 0x3b, 0xda, 0x01, 0x69, 0x1f, 0xfa, 0x06, 0x28, 0x12, 0x48,
-		0x7d, 0x5f, 0x33, 0xf7, 0x30, 0x43, 0x4e, 0xbe, 0x2d, 0xe4, 0x42, 0x47, 0x3b, 0x58, 0xa4, 0x68,
-		0x04, 0x9f, 0x5f, 0x13, 0xf6, 0x2b, 0x12, 0x5f, 0x5d, 0x52, 0xc1, 0x20, 0x0d, 0x64, 0xdd, 0x7f,
-		0xa2, 0x2f, 0x15, 0x49, 0xdb, 0x8b, 0x83, 0x64, 0xdf, 0x23, 0xd6, 0x6a, 0x81, 0x34, 0xef, 0x33,
-		0x6f, 0xcd, 0x49, 0xec, 0xad, 0x45, 0xbd, 0x4f, 0xec, 0x39, 0xb6, 0x6a, 0x56, 0x69, 0x7c, 0x93,
-		0x18, 0x1a, 0xdf, 0x14, 0x44, 0x7c, 0xc3, 0x00, 0x81, 0x71, 0x93, 0x7a, 0xde, 0x87, 0x4f, 0x48,
-		0x90, 0x5b, 0x0a, 0x5d, 0xdd, 0x39, 0x03, 0xe3, 0x1d, 0xcb, 0x34, 0x6e, 0x61, 0xc7, 0xcf, 0xba,
-		0xb3, 0x47, 0x12, 0x83, 0xb0, 0x5f, 0x80, 0xf4, 0x0e, 0xc5, 0x05, 0x2a, 0xe2, 0x99, 0x70, 0xdd,
-		0xc1, 0x3b, 0xae, 0x21, 0xe4, 0xac, 0x88, 0x47, 0xf4, 0x30, 0xc8, 0x2e, 0xd6, 0xbb, 0x8e, 0xe1,
-		0x1d, 0xaa, 0xba, 0x65, 0x7a, 0x9a, 0xee, 0xf1, 0xc5, 0x5a, 0x51, 0x94, 0x2f, 0xb2, 0x62, 0x02,
-		0xd2, 0xc2, 0x9e, 0x66, 0xb4, 0xd9, 0x61, 0xb4, 0xac, 0x22, 0x1e, 0x79, 0x53, 0xef, 0x8e, 0x87,
-		0x97, 0x2a, 0x8b, 0x20, 0x5b, 0x36, 0x76, 0x22, 0xbb, 0xee, 0x4c, 0x1b, 0x67, 0x7e, 0xeb, 0xd3,
-		0x8f, 0x4d, 0x73, 0x81, 0xf3, 0xfd, 0x5a, 0xf6, 0x05, 0x96, 0x52, 0x14, 0x1c, 0x62, 0x3b, 0xfe,
-		0xd5, 0x48, 0x9e, 0xbd, 0xbb, 0x13, 0xdc, 0x5d, 0x34, 0xdd, 0x27, 0xd4, 0x8a, 0x79, 0x58, 0x9d,
-		0xf9, 0x8d, 0x00, 0x9a, 0x2f, 0x66, 0x36, 0xe9, 0xc2, 0x25, 0x9c, 0x73, 0xa7, 0x30, 0x24, 0xbc,
-		0x7b, 0x5d, 0x33, 0xda, 0xe2, 0xc7, 0x72, 0x15, 0xfe, 0x84, 0xca, 0x7e, 0x1e, 0x29, 0x45, 0xa3,
-		0xe5, 0xd2, 0x20, 0xdd, 0xa8, 0x5a, 0x66, 0x2b, 0x9a, 0x3e, 0x42, 0x8b, 0x90, 0xf6, 0xac, 0x5b,
-		0xd8, 0xe4, 0x02, 0xaa, 0x3e, 0x72, 0x8c, 0x7b, 0xee, 0x14, 0xce, 0x8a, 0xbe, 0x0e, 0xe4, 0x16,
-		0x6e, 0xe3, 0x3d, 0xf6, 0xb1, 0xe9, 0xbe, 0xe6, 0x60, 0x76, 0xeb, 0xc1, 0x3d, 0xdd, 0x62, 0x57,
-		0xf4, 0xa1, 0x1a, 0x14, 0x09, 0x6d, 0x46, 0x2f, 0x87, 0x1d, 0xf7, 0xb7, 0x88, 0x63, 0xfb, 0x18,
-		0xd2, 0xbc, 0xb0, 0xf5, 0x89, 0x5c, 0x26, 0xfb, 0x30, 0xc8, 0x5d, 0x73, 0xc7, 0x32, 0xe9, 0x6f,
-		0x4c, 0xf2, 0x08, 0x3b, 0xc3, 0xf6, 0x5e, 0xfc, 0x72, 0xbe, 0xf7, 0xb2, 0x09, 0x13, 0x01, 0x29,
-		0x9d, 0x21, 0xd9, 0xe3, 0xce, 0x90, 0x82, 0x0f, 0x40, 0x48, 0xd0, 0x1a, 0x40, 0x30, 0x07, 0x69,
-		0xe6, 0x3f, 0x37, 0x78, 0xc4, 0x82, 0xd9, 0x1c, 0xee, 0x4c, 0x08, 0x00, 0x99, 0x30, 0xd5, 0x31,
-		0x4c, 0xd5, 0xc5, 0xed, 0x5d, 0x95, 0x4b, 0x8e, 0xe0, 0xe6, 0xa8, 0xf8, 0x5f, 0x3c, 0xc6, 0x68,
-		0xfe, 0xce, 0xa7, 0x1f, 0x2b, 0x06, 0xd7, 0xff, 0xcd, 0x3f, 0xbe, 0xf0, 0xe4, 0xd3, 0xca, 0x64,
-		0xc7, 0x30, 0x1b, 0xb8, 0xbd, 0xbb, 0xe4, 0x03, 0xa3, 0xe7, 0xe1, 0x6c, 0x20, 0x10, 0xcb, 0x54,
-		0xf7, 0xad, 0x76, 0x4b, 0x75, 0xf0, 0xae, 0xaa, 0xd3, 0xcb, 0x0b, 0xf3, 0x54, 0x8c, 0xa7, 0x7d,
-		0x92, 0x0d, 0xf3, 0x86, 0xd5, 0x6e, 0x29, 0x78, 0x77, 0x91, 0x54, 0xa3, 0x0b, 0x10, 0x48, 0x43,
-		0x35, 0x5a, 0xee, 0x4c, 0x61, 0x3e, 0x79, 0x29, 0xa5, 0xe4, 0xfd, 0xc2, 0x7a, 0xcb, 0x2d, 0x67,
-		0xbe, 0xfd, 0x63, 0x73, 0x27, 0x3e, 0xff, 0xb1, 0xb9, 0x13, 0xa5, 0x65, 0x7a, 0xbb, 0x19, 0x9f,
-		0x5a, 0xd8, 0x45, 0xd7, 0x21, 0xab, 0x89, 0x07, 0xf6, 0xd1, 0xd2, 0x11, 0x53, 0x33, 0x20, 0x2d,
-		0x7d, 0x52, 0x82, 0xf4, 0xd2, 0xf6, 0xa6, 0x66, 0x38, 0xa8, 0x06, 0x93, 0x81, 0xae, 0x8e, 0x3a,
-		0xcb, 0x03, 0xf5, 0x16, 0xd3, 0x7c, 0x7d, 0xd0, 0x11, 0x9d, 0x6c, 0xf5, 0xfc, 0x6f, 0x7d, 0xfa,
-		0xb1, 0xfb, 0x39, 0xcc, 0x76, 0xcf, 0x69, 0x1d, 0x81, 0xd7, 0x7b, 0x8a, 0x27, 0xd4, 0xe7, 0x9b,
-		0x30, 0xce, 0x9a, 0xea, 0xa2, 0x97, 0x60, 0xcc, 0x26, 0x7f, 0xf0, 0x04, 0xee, 0xb9, 0x81, 0x3a,
-		0x4f, 0xe9, 0xc3, 0x1a, 0xc2, 0xf8, 0x4a, 0xdf, 0x99, 0x00, 0x58, 0xda, 0xde, 0x6e, 0x3a, 0x86,
-		0xdd, 0xc6, 0xde, 0x3b, 0xd5, 0xf7, 0x2d, 0x38, 0x19, 0xfa, 0xb6, 0xdc, 0xd1, 0x8f, 0xdf, 0xff,
-		0xa9, 0xe0, 0x2b, 0x73, 0x47, 0x8f, 0x85, 0x6d, 0xb9, 0x9e, 0x0f, 0x9b, 0x3c, 0x3e, 0xec, 0x92,
-		0xeb, 0xf5, 0x4b, 0xf6, 0x15, 0xc8, 0x05, 0xc2, 0x70, 0x51, 0x1d, 0x32, 0x1e, 0xff, 0x9b, 0x0b,
-		0xb8, 0x34, 0x58, 0xc0, 0x82, 0x2d, 0x2c, 0x64, 0x9f, 0xbd, 0xf4, 0x97, 0x12, 0x40, 0x68, 0x8e,
-		0xfc, 0xf5, 0xd4, 0x31, 0x54, 0x87, 0x34, 0x37, 0xce, 0xc9, 0x7b, 0xbe, 0x62, 0x94, 0x01, 0x84,
-		0x84, 0xfa, 0xdd, 0x09, 0x98, 0xda, 0x12, 0xb3, 0xf7, 0xaf, 0xbf, 0x0c, 0xb6, 0x60, 0x1c, 0x9b,
-		0x9e, 0x63, 0xf8, 0x1b, 0x10, 0x8f, 0x0f, 0x1a, 0xf3, 0x98, 0x4e, 0xd5, 0x4c, 0xcf, 0x39, 0x0c,
-		0x6b, 0x80, 0xc0, 0x0a, 0xc9, 0xe3, 0xc3, 0x49, 0x98, 0x19, 0xc4, 0x8a, 0x2e, 0x42, 0x51, 0x77,
-		0x30, 0x2d, 0x88, 0x7e, 0x43, 0x37, 0x21, 0x8a, 0xb9, 0xdb, 0x51, 0x80, 0x04, 0x6a, 0x44, 0xb9,
-		0x08, 0xe9, 0xbd, 0x45, 0x66, 0x13, 0x01, 0x02, 0x75, 0x3c, 0x4d, 0x28, 0x8a, 0x93, 0xf7, 0x3b,
-		0x5a, 0x5b, 0x33, 0x75, 0x11, 0xc1, 0x1e, 0xcb, 0xe7, 0x8b, 0xd3, 0xfb, 0x55, 0x06, 0x81, 0x6a,
-		0x30, 0x2e, 0xd0, 0x52, 0xc7, 0x47, 0x13, 0xbc, 0xe8, 0x3c, 0xe4, 0xc3, 0x8e, 0x81, 0x46, 0x23,
-		0x29, 0x25, 0x17, 0xf2, 0x0b, 0xc3, 0x3c, 0x4f, 0xfa, 0x48, 0xcf, 0xc3, 0x03, 0xbe, 0x1f, 0x4e,
-		0xc2, 0xa4, 0x82, 0x5b, 0x7f, 0xf3, 0x87, 0x65, 0x13, 0x80, 0x4d, 0x55, 0x62, 0x49, 0xf9, 0xc8,
-		0xdc, 0xc3, 0x7c, 0xcf, 0x32, 0x90, 0x25, 0xd7, 0xfb, 0x6a, 0x8d, 0xd0, 0xef, 0x26, 0x20, 0x1f,
-		0x1e, 0xa1, 0xbf, 0x95, 0x4e, 0x0b, 0xad, 0x07, 0x66, 0x8a, 0x7d, 0x3b, 0xf0, 0xf0, 0x20, 0x33,
-		0xd5, 0xa7, 0xcd, 0x43, 0xec, 0xd3, 0x17, 0x92, 0x90, 0xe6, 0x67, 0x78, 0x36, 0xfa, 0x62, 0xdb,
-		0xa1, 0x1f, 0x50, 0x17, 0xc4, 0x37, 0xe8, 0xb1, 0xa1, 0xed, 0x83, 0x30, 0x41, 0xd6, 0xc8, 0x91,
-		0x83, 0x41, 0xd2, 0xa5, 0x02, 0x5d, 0xea, 0x06, 0x07, 0x63, 0xd1, 0x1c, 0xe4, 0x08, 0x59, 0x60,
-		0x87, 0x09, 0x0d, 0x74, 0xb4, 0x83, 0x1a, 0x2b, 0x41, 0x57, 0x01, 0xed, 0xfb, 0x89, 0x0b, 0x35,
-		0x10, 0x84, 0x74, 0xa9, 0x40, 0x6f, 0x13, 0x98, 0x0c, 0x6a, 0x05, 0xcb, 0xfd, 0x00, 0xa4, 0x25,
-		0x2a, 0xbb, 0x59, 0x9b, 0xdf, 0x5b, 0x4e, 0x4a, 0x96, 0xe8, 0xed, 0xda, 0xdf, 0x22, 0xb1, 0x30,
-		0xb9, 0x67, 0x35, 0xcd, 0x57, 0x29, 0xcd, 0x11, 0x26, 0xc6, 0x9f, 0xbf, 0x35, 0x37, 0x7b, 0xa8,
-		0x75, 0xda, 0xe5, 0x52, 0x0c, 0x4e, 0x29, 0x6e, 0x81, 0x4f, 0x82, 0xe7, 0xe8, 0x6a, 0x1c, 0xd5,
-		0x41, 0xbe, 0x85, 0x0f, 0x55, 0x87, 0xff, 0x30, 0xbb, 0xba, 0x8b, 0xc5, 0x3d, 0x06, 0x67, 0x16,
-		0x62, 0xee, 0x39, 0x5f, 0x58, 0xb4, 0x0c, 0x93, 0xef, 0x51, 0x4c, 0xdc, 0xc2, 0x87, 0x0a, 0xe7,
-		0x5b, 0xc6, 0xb8, 0xfc, 0x00, 0x99, 0x2d, 0x6f, 0xfe, 0xe1, 0xcf, 0x5c, 0x3e, 0x1b, 0xba, 0xb3,
-		0xfb, 0xc0, 0xcf, 0x93, 0xb1, 0x21, 0x26, 0x81, 0x2f, 0x0a, 0x9c, 0x50, 0xe8, 0x30, 0x18, 0x84,
-		0xd6, 0x0a, 0xd2, 0xd1, 0x6b, 0x90, 0x80, 0x3f, 0xb2, 0x06, 0x09, 0x4d, 0xd1, 0x17, 0x03, 0x1f,
-		0x90, 0x18, 0xd6, 0x9b, 0xb0, 0x76, 0x72, 0x26, 0x3a, 0xf3, 0x4f, 0x94, 0xfe, 0xad, 0x04, 0x67,
-		0xfa, 0xb4, 0xd9, 0x6f, 0xb2, 0x0e, 0xc8, 0x09, 0x55, 0x52, 0xad, 0x10, 0xfb, 0x81, 0xf7, 0x36,
-		0x39, 0x26, 0x9d, 0x3e, 0x47, 0xf0, 0xce, 0x38, 0x33, 0x6e, 0xc9, 0x7e, 0x5d, 0x82, 0xe9, 0x70,
-		0x03, 0xfc, 0xae, 0x34, 0x20, 0x1f, 0x7e, 0x35, 0xef, 0xc4, 0x03, 0xa3, 0x74, 0x22, 0xdc, 0xfe,
-		0x08, 0x08, 0xda, 0x0e, 0x2c, 0x06, 0xcb, 0xce, 0x5d, 0x1d, 0x59, 0x28, 0xa2, 0x61, 0xb1, 0x96,
-		0x83, 0x8d, 0xcd, 0x17, 0x24, 0x48, 0x6d, 0x5a, 0x56, 0x1b, 0xbd, 0x0f, 0x26, 0x4d, 0xcb, 0x53,
-		0xc9, 0xcc, 0xc2, 0x2d, 0x95, 0xa7, 0x0e, 0x98, 0x35, 0xae, 0x1d, 0x29, 0xab, 0x3f, 0x7a, 0x6b,
-		0xae, 0x9f, 0x33, 0xee, 0xde, 0xfc, 0xa2, 0x69, 0x79, 0x55, 0x4a, 0xd4, 0x64, 0xd9, 0x85, 0x5d,
-		0x28, 0x44, 0x5f, 0xc7, 0x2c, 0x76, 0x65, 0xd8, 0xeb, 0x0a, 0x43, 0x5f, 0x95, 0xdf, 0x09, 0xbd,
-		0x87, 0xdd, 0x10, 0xfe, 0xa7, 0x64, 0xe4, 0xbe, 0x01, 0xe4, 0xed, 0xde, 0xd3, 0x26, 0xcb, 0x30,
-		0x2e, 0x4e, 0x97, 0x48, 0xa3, 0x9e, 0x5c, 0x09, 0xcb, 0x93, 0x33, 0xd3, 0xf4, 0xe7, 0x67, 0x12,
-		0x70, 0x66, 0xd1, 0x32, 0x5d, 0x9e, 0xe8, 0xe1, 0xb3, 0x9a, 0xe5, 0x6a, 0x0f, 0xd1, 0xc3, 0x03,
-		0xd2, 0x50, 0xf9, 0xfe, 0x64, 0xd3, 0x36, 0x14, 0x89, 0x8b, 0xd5, 0x2d, 0xf3, 0x6d, 0xe6, 0x9a,
-		0x0a, 0x56, 0xbb, 0xc5, 0x5b, 0x74, 0x0b, 0x1f, 0x12, 0x5c, 0x13, 0xdf, 0x89, 0xe0, 0x26, 0xef,
-		0x0d, 0xd7, 0xc4, 0x77, 0x42, 0xb8, 0xc1, 0x86, 0x66, 0x2a, 0xb2, 0xa1, 0x79, 0x1d, 0x92, 0xc4,
-		0x14, 0x8e, 0x1d, 0xc3, 0x78, 0x10, 0x86, 0x90, 0x5b, 0x6b, 0xc0, 0x19, 0x9e, 0x29, 0x70, 0x37,
-		0x76, 0xa9, 0x44, 0x31, 0xed, 0xd0, 0xcb, 0xf8, 0x30, 0x26, 0x6d, 0x90, 0x1f, 0x29, 0x6d, 0x70,
-		0xf9, 0xe7, 0x25, 0x80, 0x20, 0x67, 0x86, 0x1e, 0x85, 0xd3, 0xd5, 0x8d, 0xf5, 0xa5, 0x60, 0x6f,
-		0x27, 0xf4, 0xe3, 0x41, 0xe2, 0x1e, 0x2f, 0xd7, 0xc6, 0xba, 0xb1, 0x6b, 0xe0, 0x16, 0x7a, 0x08,
-		0xa6, 0xa3, 0xd4, 0xe4, 0xa9, 0xb6, 0x24, 0x4b, 0xb3, 0xf9, 0x37, 0xef, 0xce, 0x67, 0xd8, 0x1a,
-		0x01, 0xb7, 0xd0, 0x25, 0x38, 0xd9, 0x4f, 0x57, 0x5f, 0x5f, 0x91, 0x13, 0xb3, 0x85, 0x37, 0xef,
-		0xce, 0x67, 0xfd, 0xc5, 0x04, 0x2a, 0x01, 0x0a, 0x53, 0x72, 0xbc, 0xe4, 0x2c, 0xbc, 0x79, 0x77,
-		0x3e, 0xcd, 0xa6, 0x0c, 0xdf, 0x14, 0xfa, 0x7a, 0x80, 0xba, 0xb9, 0xeb, 0x68, 0x3a, 0x35, 0x0d,
-		0xb3, 0x70, 0xaa, 0xbe, 0xbe, 0xac, 0x54, 0x16, 0x9b, 0xf5, 0x8d, 0xf5, 0x9e, 0xdf, 0x3c, 0x8a,
-		0xd6, 0x2d, 0x6d, 0x6c, 0x55, 0x57, 0x6b, 0x6a, 0xa3, 0xbe, 0xb2, 0xce, 0x76, 0x70, 0x23, 0x75,
-		0xef, 0x5d, 0x6f, 0xd6, 0xd7, 0x6a, 0x72, 0xa2, 0x7a, 0x7d, 0xe0, 0x66, 0xcf, 0x7d, 0x91, 0xc9,
-		0x18, 0xb8, 0xa3, 0xc8, 0x8f, 0x49, 0xfc, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xcf, 0xe7,
-		0x8d, 0x37, 0xa5, 0x00, 0x00,
+		// 12876 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x90, 0x23, 0xd7,
+		0x79, 0xd8, 0x36, 0x6e, 0x7c, 0xb8, 0x7a, 0xde, 0xcc, 0xee, 0x62, 0x67, 0xc9, 0xdd, 0x59, 0x2c,
+		0x8f, 0xe5, 0x52, 0x9c, 0xe5, 0x2e, 0xc9, 0x25, 0x77, 0x78, 0x19, 0x18, 0x60, 0x67, 0xb1, 0x9c,
+		0x4b, 0x0d, 0xcc, 0xf0, 0xf0, 0xd1, 0xea, 0x01, 0x7a, 0x66, 0xc0, 0x05, 0xba, 0x21, 0x74, 0x63,
+		0x76, 0x47, 0xa9, 0x72, 0xc9, 0xb6, 0xec, 0xc8, 0x94, 0xe5, 0xc8, 0xb1, 0x63, 0xd3, 0x96, 0x57,
+		0x96, 0xed, 0xd8, 0x92, 0xcf, 0xf8, 0x90, 0x1d, 0xdb, 0xa9, 0x1c, 0x4e, 0x25, 0x8e, 0xed, 0x24,
+		0x8e, 0xac, 0xaa, 0x24, 0x2e, 0x57, 0x85, 0x49, 0x24, 0x97, 0x49, 0xcb, 0xf2, 0xa5, 0x50, 0x89,
+		0xaa, 0x54, 0x29, 0xa5, 0xbe, 0x77, 0xf4, 0x01, 0x34, 0x06, 0x98, 0x25, 0xe5, 0x30, 0x76, 0xfe,
+		0xcc, 0xe0, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xef, 0x7b, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0xef, 0x68,
+		0xf8, 0xe2, 0x7b, 0x60, 0x6e, 0xc7, 0x34, 0x77, 0xda, 0xfa, 0x85, 0x6e, 0xcf, 0xb4, 0xcd, 0xad,
+		0xfe, 0xf6, 0x85, 0xa6, 0x6e, 0x35, 0x7a, 0xad, 0xae, 0x6d, 0xf6, 0xe6, 0x69, 0x1e, 0xc9, 0x31,
+		0x88, 0x79, 0x01, 0x51, 0x58, 0x81, 0xa9, 0xab, 0xad, 0xb6, 0x5e, 0x76, 0x00, 0x6b, 0xba, 0x4d,
+		0x9e, 0x80, 0xc8, 0x76, 0xab, 0xad, 0xe7, 0xa5, 0xb9, 0xf0, 0xb9, 0xd4, 0xa5, 0x7b, 0xe6, 0x07,
+		0x90, 0xe6, 0xfd, 0x18, 0xeb, 0x98, 0xad, 0x50, 0x8c, 0xc2, 0xab, 0x51, 0x98, 0x0e, 0x28, 0x25,
+		0x04, 0x22, 0x86, 0xd6, 0x41, 0x8a, 0xd2, 0xb9, 0xa4, 0x42, 0x7f, 0x93, 0x3c, 0xc4, 0xbb, 0x5a,
+		0xe3, 0x86, 0xb6, 0xa3, 0xe7, 0x43, 0x34, 0x5b, 0x24, 0xc9, 0x29, 0x80, 0xa6, 0xde, 0xd5, 0x8d,
+		0xa6, 0x6e, 0x34, 0xf6, 0xf3, 0xe1, 0xb9, 0xf0, 0xb9, 0xa4, 0xe2, 0xc9, 0x21, 0x0f, 0xc2, 0x54,
+		0xb7, 0xbf, 0xd5, 0x6e, 0x35, 0x54, 0x0f, 0x18, 0xcc, 0x85, 0xcf, 0x45, 0x15, 0x99, 0x15, 0x94,
+		0x5d, 0xe0, 0xfb, 0x21, 0x77, 0x53, 0xd7, 0x6e, 0x78, 0x41, 0x53, 0x14, 0x34, 0x8b, 0xd9, 0x1e,
+		0xc0, 0x45, 0x48, 0x77, 0x74, 0xcb, 0xd2, 0x76, 0x74, 0xd5, 0xde, 0xef, 0xea, 0xf9, 0x08, 0xed,
+		0xfd, 0xdc, 0x50, 0xef, 0x07, 0x7b, 0x9e, 0xe2, 0x58, 0xf5, 0xfd, 0xae, 0x4e, 0x8a, 0x90, 0xd4,
+		0x8d, 0x7e, 0x87, 0x51, 0x88, 0x8e, 0xe0, 0x5f, 0xc5, 0xe8, 0x77, 0x06, 0xa9, 0x24, 0x10, 0x8d,
+		0x93, 0x88, 0x5b, 0x7a, 0x6f, 0xaf, 0xd5, 0xd0, 0xf3, 0x31, 0x4a, 0xe0, 0xfe, 0x21, 0x02, 0x35,
+		0x56, 0x3e, 0x48, 0x43, 0xe0, 0x91, 0x45, 0x48, 0xea, 0xb7, 0x6c, 0xdd, 0xb0, 0x5a, 0xa6, 0x91,
+		0x8f, 0x53, 0x22, 0xf7, 0x06, 0x8c, 0xa2, 0xde, 0x6e, 0x0e, 0x92, 0x70, 0xf1, 0xc8, 0x65, 0x88,
+		0x9b, 0x5d, 0xbb, 0x65, 0x1a, 0x56, 0x3e, 0x31, 0x27, 0x9d, 0x4b, 0x5d, 0xba, 0x2b, 0x50, 0x10,
+		0xd6, 0x18, 0x8c, 0x22, 0x80, 0x49, 0x15, 0x64, 0xcb, 0xec, 0xf7, 0x1a, 0xba, 0xda, 0x30, 0x9b,
+		0xba, 0xda, 0x32, 0xb6, 0xcd, 0x7c, 0x92, 0x12, 0x38, 0x3d, 0xdc, 0x11, 0x0a, 0xb8, 0x68, 0x36,
+		0xf5, 0xaa, 0xb1, 0x6d, 0x2a, 0x59, 0xcb, 0x97, 0x26, 0xc7, 0x20, 0x66, 0xed, 0x1b, 0xb6, 0x76,
+		0x2b, 0x9f, 0xa6, 0x12, 0xc2, 0x53, 0xe4, 0x12, 0xc4, 0xf5, 0x66, 0x0b, 0xab, 0xcb, 0x67, 0xe7,
+		0xa4, 0x73, 0xd9, 0x4b, 0xf9, 0x61, 0x1e, 0xb3, 0x72, 0x45, 0x00, 0x16, 0x7e, 0x3d, 0x06, 0xb9,
+		0x49, 0xc4, 0xf2, 0x49, 0x88, 0x6e, 0x23, 0x67, 0xf2, 0xa1, 0xc3, 0xf0, 0x8d, 0xe1, 0xf8, 0x19,
+		0x1f, 0xbb, 0x43, 0xc6, 0x17, 0x21, 0x65, 0xe8, 0x96, 0xad, 0x37, 0x99, 0x14, 0x85, 0x27, 0x94,
+		0x43, 0x60, 0x48, 0xc3, 0x62, 0x18, 0xb9, 0x23, 0x31, 0x7c, 0x01, 0x72, 0x4e, 0x93, 0xd4, 0x9e,
+		0x66, 0xec, 0x08, 0x79, 0xbe, 0x30, 0xae, 0x25, 0xf3, 0x15, 0x81, 0xa7, 0x20, 0x9a, 0x92, 0xd5,
+		0x7d, 0x69, 0x52, 0x06, 0x30, 0x0d, 0xdd, 0xdc, 0x56, 0x9b, 0x7a, 0xa3, 0x9d, 0x4f, 0x8c, 0xe0,
+		0xd2, 0x1a, 0x82, 0x0c, 0x71, 0xc9, 0x64, 0xb9, 0x8d, 0x36, 0xb9, 0xe2, 0x8a, 0x67, 0x7c, 0x84,
+		0x74, 0xad, 0xb0, 0x89, 0x39, 0x24, 0xa1, 0x1b, 0x90, 0xed, 0xe9, 0x38, 0x57, 0xf4, 0x26, 0xef,
+		0x59, 0x92, 0x36, 0x62, 0x7e, 0x6c, 0xcf, 0x14, 0x8e, 0xc6, 0x3a, 0x96, 0xe9, 0x79, 0x93, 0xe4,
+		0x2c, 0x38, 0x19, 0x2a, 0x15, 0x2b, 0xa0, 0x9a, 0x2b, 0x2d, 0x32, 0x57, 0xb5, 0x8e, 0x3e, 0xfb,
+		0x3e, 0xc8, 0xfa, 0xd9, 0x43, 0x66, 0x20, 0x6a, 0xd9, 0x5a, 0xcf, 0xa6, 0x52, 0x18, 0x55, 0x58,
+		0x82, 0xc8, 0x10, 0xd6, 0x8d, 0x26, 0xd5, 0x8c, 0x51, 0x05, 0x7f, 0x92, 0xaf, 0x73, 0x3b, 0x1c,
+		0xa6, 0x1d, 0xbe, 0x6f, 0x78, 0x44, 0x7d, 0x94, 0x07, 0xfb, 0x3d, 0xfb, 0x38, 0x64, 0x7c, 0x1d,
+		0x98, 0xb4, 0xea, 0xc2, 0xbf, 0x8b, 0xc0, 0xd1, 0x40, 0xda, 0xe4, 0x05, 0x98, 0xe9, 0x1b, 0x2d,
+		0xc3, 0xd6, 0x7b, 0xdd, 0x9e, 0x8e, 0x22, 0xcb, 0xea, 0xca, 0xbf, 0x1e, 0x1f, 0x21, 0x74, 0x1b,
+		0x5e, 0x68, 0x46, 0x45, 0x99, 0xee, 0x0f, 0x67, 0x92, 0x17, 0x21, 0x85, 0xf2, 0xa1, 0xf5, 0x34,
+		0x4a, 0x90, 0xcd, 0xc6, 0x4b, 0x93, 0x75, 0x79, 0xbe, 0xec, 0x62, 0x96, 0xc2, 0x1f, 0x94, 0x42,
+		0x8a, 0x97, 0x16, 0x79, 0x1c, 0x12, 0xdb, 0xba, 0x66, 0xf7, 0x7b, 0xba, 0x95, 0xbf, 0x44, 0x59,
+		0x79, 0x72, 0x78, 0x92, 0x32, 0x80, 0x9a, 0x6e, 0x2b, 0x0e, 0x30, 0xe9, 0x40, 0x7a, 0x4f, 0xef,
+		0xb5, 0xb6, 0x5b, 0x0d, 0xd6, 0xa8, 0x30, 0x55, 0x3e, 0x4f, 0x4c, 0xd8, 0xa8, 0x4d, 0x0f, 0x6a,
+		0xcd, 0xd6, 0x6c, 0x7d, 0x01, 0x36, 0x56, 0x37, 0x2b, 0x4a, 0xf5, 0x6a, 0xb5, 0x52, 0x66, 0xcd,
+		0xf4, 0x91, 0x9f, 0xfd, 0x3e, 0x09, 0x52, 0x9e, 0x9e, 0xa0, 0x3a, 0x34, 0xfa, 0x9d, 0x2d, 0xbd,
+		0xc7, 0xc7, 0x8b, 0xa7, 0xc8, 0x49, 0x48, 0x6e, 0xf7, 0xdb, 0x6d, 0x26, 0x74, 0xcc, 0x96, 0x26,
+		0x30, 0x03, 0x05, 0x0e, 0x75, 0x1c, 0x57, 0x23, 0x54, 0xc7, 0xe1, 0x6f, 0x32, 0x0b, 0x09, 0x21,
+		0x94, 0xf9, 0xe8, 0x9c, 0x74, 0x2e, 0xa1, 0x38, 0x69, 0x56, 0xd6, 0xd5, 0x35, 0x5b, 0x6f, 0xe6,
+		0x63, 0xa2, 0x8c, 0xa5, 0xaf, 0x47, 0x12, 0x11, 0x39, 0x5a, 0x78, 0x14, 0xa6, 0x86, 0xba, 0x42,
+		0x72, 0x90, 0x2a, 0x57, 0x16, 0x97, 0x8b, 0x4a, 0xb1, 0x5e, 0x5d, 0x5b, 0x95, 0x8f, 0x90, 0x2c,
+		0x78, 0x7a, 0x27, 0x4b, 0xe7, 0x93, 0x89, 0x37, 0xe2, 0xf2, 0xfb, 0xdf, 0xff, 0xfe, 0xf7, 0x87,
+		0x0a, 0xbf, 0x19, 0x83, 0x99, 0x20, 0x25, 0x18, 0xa8, 0x8f, 0xdd, 0x4e, 0x87, 0x7d, 0x9d, 0x2e,
+		0x42, 0xb4, 0xad, 0x6d, 0xe9, 0xed, 0x7c, 0x84, 0x0e, 0xc2, 0x83, 0x13, 0xa9, 0xd9, 0xf9, 0x65,
+		0x44, 0x51, 0x18, 0x26, 0x79, 0x86, 0xb3, 0x26, 0x4a, 0x29, 0x9c, 0x9f, 0x8c, 0x02, 0x2a, 0x47,
+		0xce, 0xc6, 0x93, 0x90, 0xc4, 0xff, 0x8c, 0xef, 0x31, 0xc6, 0x77, 0xcc, 0xa0, 0x7c, 0x9f, 0x85,
+		0x04, 0xd5, 0x7b, 0x4d, 0xdd, 0x19, 0x13, 0x91, 0x46, 0x4d, 0xd1, 0xd4, 0xb7, 0xb5, 0x7e, 0xdb,
+		0x56, 0xf7, 0xb4, 0x76, 0x5f, 0xa7, 0x1a, 0x2c, 0xa9, 0xa4, 0x79, 0xe6, 0x26, 0xe6, 0x91, 0xd3,
+		0x90, 0x62, 0x6a, 0xb2, 0x65, 0x34, 0xf5, 0x5b, 0xd4, 0x84, 0x46, 0x15, 0xa6, 0x39, 0xab, 0x98,
+		0x83, 0xd5, 0xbf, 0x6c, 0x99, 0x86, 0xd0, 0x35, 0xb4, 0x0a, 0xcc, 0xa0, 0xd5, 0x3f, 0x3e, 0x68,
+		0xbd, 0xef, 0x0e, 0xee, 0xde, 0x90, 0x72, 0xbc, 0x1f, 0x72, 0x14, 0xe2, 0x11, 0x3e, 0x95, 0xb5,
+		0x76, 0x7e, 0x8a, 0x8a, 0x41, 0x96, 0x65, 0xaf, 0xf1, 0xdc, 0xc2, 0xaf, 0x86, 0x20, 0x42, 0x2d,
+		0x45, 0x0e, 0x52, 0xf5, 0x17, 0xd7, 0x2b, 0x6a, 0x79, 0x6d, 0xa3, 0xb4, 0x5c, 0x91, 0x25, 0x1c,
+		0x7a, 0x9a, 0x71, 0x75, 0x79, 0xad, 0x58, 0x97, 0x43, 0x4e, 0xba, 0xba, 0x5a, 0xbf, 0xfc, 0xa8,
+		0x1c, 0x76, 0x10, 0x36, 0x58, 0x46, 0xc4, 0x0b, 0xf0, 0xc8, 0x25, 0x39, 0x4a, 0x64, 0x48, 0x33,
+		0x02, 0xd5, 0x17, 0x2a, 0xe5, 0xcb, 0x8f, 0xca, 0x31, 0x7f, 0xce, 0x23, 0x97, 0xe4, 0x38, 0xc9,
+		0x40, 0x92, 0xe6, 0x94, 0xd6, 0xd6, 0x96, 0xe5, 0x84, 0x43, 0xb3, 0x56, 0x57, 0xaa, 0xab, 0x4b,
+		0x72, 0xd2, 0xa1, 0xb9, 0xa4, 0xac, 0x6d, 0xac, 0xcb, 0xe0, 0x50, 0x58, 0xa9, 0xd4, 0x6a, 0xc5,
+		0xa5, 0x8a, 0x9c, 0x72, 0x20, 0x4a, 0x2f, 0xd6, 0x2b, 0x35, 0x39, 0xed, 0x6b, 0xd6, 0x23, 0x97,
+		0xe4, 0x8c, 0x53, 0x45, 0x65, 0x75, 0x63, 0x45, 0xce, 0x92, 0x29, 0xc8, 0xb0, 0x2a, 0x44, 0x23,
+		0x72, 0x03, 0x59, 0x97, 0x1f, 0x95, 0x65, 0xb7, 0x21, 0x8c, 0xca, 0x94, 0x2f, 0xe3, 0xf2, 0xa3,
+		0x32, 0x29, 0x2c, 0x42, 0x94, 0x8a, 0x21, 0x21, 0x90, 0x5d, 0x2e, 0x96, 0x2a, 0xcb, 0xea, 0xda,
+		0x3a, 0x4e, 0x9a, 0xe2, 0xb2, 0x2c, 0xb9, 0x79, 0x4a, 0x65, 0xbd, 0x52, 0xac, 0x57, 0xca, 0x72,
+		0xd8, 0x9b, 0xf7, 0xee, 0x8d, 0xaa, 0x52, 0x29, 0xcb, 0xa1, 0x42, 0x03, 0x66, 0x82, 0x2c, 0x64,
+		0xe0, 0x14, 0xf2, 0xc8, 0x42, 0x68, 0x84, 0x2c, 0x50, 0x5a, 0x83, 0xb2, 0x50, 0xf8, 0x5c, 0x08,
+		0xa6, 0x03, 0xbc, 0x84, 0xc0, 0x4a, 0x9e, 0x85, 0x28, 0x93, 0x65, 0xa6, 0xa9, 0x1f, 0x08, 0x74,
+		0x37, 0xa8, 0x64, 0x0f, 0xf9, 0x4e, 0x14, 0xcf, 0xeb, 0x6f, 0x86, 0x47, 0xf8, 0x9b, 0x48, 0x62,
+		0x48, 0x60, 0xbf, 0x71, 0xc8, 0x9a, 0x33, 0x87, 0xe7, 0xf2, 0x24, 0x0e, 0x0f, 0xcd, 0x3b, 0x9c,
+		0x55, 0x8f, 0x06, 0x58, 0xf5, 0x27, 0x61, 0x6a, 0x88, 0xd0, 0xc4, 0xd6, 0xf5, 0xdb, 0x24, 0xc8,
+		0x8f, 0x62, 0xce, 0x18, 0x95, 0x18, 0xf2, 0xa9, 0xc4, 0x27, 0x07, 0x39, 0x78, 0x66, 0xf4, 0x20,
+		0x0c, 0x8d, 0xf5, 0x27, 0x24, 0x38, 0x16, 0xbc, 0xae, 0x08, 0x6c, 0xc3, 0x33, 0x10, 0xeb, 0xe8,
+		0xf6, 0xae, 0x29, 0xfc, 0xe4, 0xfb, 0x02, 0xbc, 0x2f, 0x2c, 0x1e, 0x1c, 0x6c, 0x8e, 0x45, 0xae,
+		0x0c, 0xb6, 0xf5, 0xf4, 0xa8, 0x55, 0xce, 0x50, 0x4b, 0xbf, 0x33, 0x04, 0x47, 0x03, 0x89, 0x07,
+		0x36, 0xf4, 0x6e, 0x80, 0x96, 0xd1, 0xed, 0xdb, 0xcc, 0x17, 0x66, 0x9a, 0x38, 0x49, 0x73, 0xa8,
+		0xf2, 0x42, 0x2d, 0xdb, 0xb7, 0x9d, 0x72, 0x66, 0x25, 0x81, 0x65, 0x51, 0x80, 0x27, 0xdc, 0x86,
+		0x46, 0x68, 0x43, 0x4f, 0x8d, 0xe8, 0xe9, 0x90, 0x60, 0x3e, 0x0c, 0x72, 0xa3, 0xdd, 0xd2, 0x0d,
+		0x5b, 0xb5, 0xec, 0x9e, 0xae, 0x75, 0x5a, 0xc6, 0x0e, 0xb3, 0xb6, 0x0b, 0xd1, 0x6d, 0xad, 0x6d,
+		0xe9, 0x4a, 0x8e, 0x15, 0xd7, 0x44, 0x29, 0x62, 0x50, 0x01, 0xea, 0x79, 0x30, 0x62, 0x3e, 0x0c,
+		0x56, 0xec, 0x60, 0x14, 0x7e, 0x2e, 0x09, 0x29, 0xcf, 0x2a, 0x8c, 0x9c, 0x81, 0xf4, 0xcb, 0xda,
+		0x9e, 0xa6, 0x8a, 0x95, 0x35, 0xe3, 0x44, 0x0a, 0xf3, 0xd6, 0x59, 0x16, 0x79, 0x18, 0x66, 0x28,
+		0x88, 0xd9, 0xb7, 0xf5, 0x9e, 0xda, 0x68, 0x6b, 0x96, 0x45, 0x99, 0x96, 0xa0, 0xa0, 0x04, 0xcb,
+		0xd6, 0xb0, 0x68, 0x51, 0x94, 0x90, 0xc7, 0x60, 0x9a, 0x62, 0x74, 0xfa, 0x6d, 0xbb, 0xd5, 0x6d,
+		0xeb, 0x2a, 0xae, 0xf5, 0xad, 0x3c, 0x78, 0x5b, 0x36, 0x85, 0x10, 0x2b, 0x1c, 0x00, 0x5b, 0x64,
+		0x91, 0x32, 0xdc, 0x4d, 0xd1, 0x76, 0x74, 0x43, 0xef, 0x69, 0xb6, 0xae, 0xea, 0xef, 0xed, 0x6b,
+		0x6d, 0x4b, 0xd5, 0x8c, 0xa6, 0xba, 0xab, 0x59, 0xbb, 0xf9, 0x19, 0x24, 0x50, 0x0a, 0xe5, 0x25,
+		0xe5, 0x04, 0x02, 0x2e, 0x71, 0xb8, 0x0a, 0x05, 0x2b, 0x1a, 0xcd, 0x6b, 0x9a, 0xb5, 0x4b, 0x16,
+		0xe0, 0x18, 0xa5, 0x62, 0xd9, 0xbd, 0x96, 0xb1, 0xa3, 0x36, 0x76, 0xf5, 0xc6, 0x0d, 0xb5, 0x6f,
+		0x6f, 0x3f, 0x91, 0x3f, 0xe9, 0xad, 0x9f, 0xb6, 0xb0, 0x46, 0x61, 0x16, 0x11, 0x64, 0xc3, 0xde,
+		0x7e, 0x82, 0xd4, 0x20, 0x8d, 0x83, 0xd1, 0x69, 0xbd, 0x4f, 0x57, 0xb7, 0xcd, 0x1e, 0xb5, 0xa1,
+		0xd9, 0x00, 0xd5, 0xe4, 0xe1, 0xe0, 0xfc, 0x1a, 0x47, 0x58, 0x31, 0x9b, 0xfa, 0x42, 0xb4, 0xb6,
+		0x5e, 0xa9, 0x94, 0x95, 0x94, 0xa0, 0x72, 0xd5, 0xec, 0xa1, 0x40, 0xed, 0x98, 0x0e, 0x83, 0x53,
+		0x4c, 0xa0, 0x76, 0x4c, 0xc1, 0xde, 0xc7, 0x60, 0xba, 0xd1, 0x60, 0x7d, 0x6e, 0x35, 0x54, 0xbe,
+		0x22, 0xb7, 0xf2, 0xb2, 0x8f, 0x59, 0x8d, 0xc6, 0x12, 0x03, 0xe0, 0x32, 0x6e, 0x91, 0x2b, 0x70,
+		0xd4, 0x65, 0x96, 0x17, 0x71, 0x6a, 0xa8, 0x97, 0x83, 0xa8, 0x8f, 0xc1, 0x74, 0x77, 0x7f, 0x18,
+		0x91, 0xf8, 0x6a, 0xec, 0xee, 0x0f, 0xa2, 0xdd, 0x4b, 0xa3, 0x2c, 0x3d, 0xbd, 0x41, 0x5d, 0xbd,
+		0xe3, 0x5e, 0x68, 0x4f, 0x01, 0x99, 0x07, 0xb9, 0xd1, 0x50, 0x75, 0x43, 0xdb, 0x6a, 0xeb, 0xaa,
+		0xd6, 0xd3, 0x0d, 0xcd, 0xca, 0x9f, 0xa6, 0xc0, 0x11, 0xbb, 0xd7, 0xd7, 0x95, 0x6c, 0xa3, 0x51,
+		0xa1, 0x85, 0x45, 0x5a, 0x46, 0xce, 0xc3, 0x94, 0xb9, 0xf5, 0x72, 0x83, 0x09, 0x96, 0xda, 0xed,
+		0xe9, 0xdb, 0xad, 0x5b, 0xf9, 0x7b, 0x28, 0x97, 0x72, 0x58, 0x40, 0xc5, 0x6a, 0x9d, 0x66, 0x93,
+		0x07, 0x40, 0x6e, 0x58, 0xbb, 0x5a, 0xaf, 0x4b, 0x35, 0xab, 0xd5, 0xd5, 0x1a, 0x7a, 0xfe, 0x5e,
+		0x06, 0xca, 0xf2, 0x57, 0x45, 0x36, 0x0a, 0xb6, 0x75, 0xb3, 0xb5, 0x6d, 0x0b, 0x8a, 0xf7, 0x33,
+		0xc1, 0xa6, 0x79, 0x9c, 0xda, 0x39, 0x90, 0xbb, 0xbb, 0x5d, 0x7f, 0xc5, 0xe7, 0x28, 0x58, 0xb6,
+		0xbb, 0xdb, 0xf5, 0xd6, 0x7b, 0x16, 0x32, 0xdd, 0x5d, 0x6f, 0xa5, 0x0f, 0x30, 0xff, 0xab, 0xbb,
+		0xeb, 0xa9, 0xf1, 0x51, 0x38, 0x86, 0x40, 0x1d, 0xdd, 0xd6, 0x9a, 0x9a, 0xad, 0x79, 0xa0, 0xdf,
+		0x45, 0xa1, 0x67, 0xba, 0xbb, 0xdd, 0x15, 0x5e, 0xe8, 0x6b, 0x67, 0xaf, 0xbf, 0xb5, 0xef, 0xc8,
+		0xc7, 0x43, 0xac, 0x9d, 0x98, 0x27, 0x24, 0xe4, 0x8e, 0x97, 0x1f, 0x5f, 0xb3, 0xc5, 0x56, 0x61,
+		0x01, 0xd2, 0x5e, 0xb9, 0x27, 0x49, 0x60, 0x92, 0x2f, 0x4b, 0xe8, 0x04, 0x2d, 0xae, 0x95, 0xd1,
+		0x7d, 0x79, 0xa9, 0x22, 0x87, 0xd0, 0x8d, 0x5a, 0xae, 0xd6, 0x2b, 0xaa, 0xb2, 0xb1, 0x5a, 0xaf,
+		0xae, 0x54, 0xe4, 0xb0, 0xc7, 0xb1, 0xbf, 0x1e, 0x49, 0x9c, 0x97, 0x1f, 0xbc, 0x1e, 0x49, 0xdc,
+		0x27, 0xdf, 0x4f, 0xd9, 0x33, 0x24, 0x94, 0x85, 0x37, 0xc3, 0x90, 0xf5, 0x2f, 0xcb, 0xc9, 0x53,
+		0x70, 0x5c, 0xc4, 0xdd, 0x2c, 0xdd, 0x56, 0x6f, 0xb6, 0x7a, 0x74, 0xb2, 0x76, 0x34, 0x66, 0x38,
+		0x1d, 0xa1, 0x9c, 0xe1, 0x50, 0x35, 0xdd, 0x7e, 0xbe, 0xd5, 0xc3, 0xa9, 0xd8, 0xd1, 0x6c, 0xb2,
+		0x0c, 0xa7, 0x0d, 0x53, 0xb5, 0x6c, 0xcd, 0x68, 0x6a, 0xbd, 0xa6, 0xea, 0x46, 0x3c, 0x55, 0xad,
+		0xd1, 0xd0, 0x2d, 0xcb, 0x64, 0x46, 0xd2, 0xa1, 0x72, 0x97, 0x61, 0xd6, 0x38, 0xb0, 0x6b, 0x3d,
+		0x8a, 0x1c, 0x74, 0x60, 0x4e, 0x84, 0x47, 0xcd, 0x89, 0x93, 0x90, 0xec, 0x68, 0x5d, 0x55, 0x37,
+		0xec, 0xde, 0x3e, 0xf5, 0xdd, 0x13, 0x4a, 0xa2, 0xa3, 0x75, 0x2b, 0x98, 0x26, 0x9b, 0x70, 0x9f,
+		0x0b, 0xaa, 0xb6, 0xf5, 0x1d, 0xad, 0xb1, 0xaf, 0x52, 0x47, 0x9d, 0xc6, 0x88, 0xd4, 0x86, 0x69,
+		0x6c, 0xb7, 0x5b, 0x0d, 0xdb, 0xca, 0xa7, 0x1c, 0xfd, 0x57, 0x70, 0x31, 0x96, 0x29, 0xc2, 0x75,
+		0xcb, 0x34, 0xa8, 0x7f, 0xbe, 0x28, 0xa0, 0x7d, 0x62, 0x93, 0x7e, 0x47, 0x88, 0x8d, 0x7f, 0xe8,
+		0x23, 0x72, 0xf4, 0x7a, 0x24, 0x11, 0x95, 0x63, 0xd7, 0x23, 0x89, 0x98, 0x1c, 0xbf, 0x1e, 0x49,
+		0x24, 0xe4, 0xe4, 0xf5, 0x48, 0x22, 0x29, 0x43, 0xe1, 0x76, 0x06, 0xd2, 0xde, 0xe5, 0x06, 0xae,
+		0xde, 0x1a, 0xd4, 0xe0, 0x4a, 0x54, 0x25, 0x9f, 0x3d, 0x70, 0x71, 0x32, 0xbf, 0x88, 0x96, 0x78,
+		0x21, 0xc6, 0x7c, 0x7b, 0x85, 0x61, 0xa2, 0x17, 0x84, 0x93, 0x4c, 0x67, 0xbe, 0x54, 0x42, 0xe1,
+		0x29, 0xb2, 0x04, 0xb1, 0x97, 0x2d, 0x4a, 0x3b, 0x46, 0x69, 0xdf, 0x73, 0x30, 0xed, 0xeb, 0x35,
+		0x4a, 0x3c, 0x79, 0xbd, 0xa6, 0xae, 0xae, 0x29, 0x2b, 0xc5, 0x65, 0x85, 0xa3, 0x93, 0x13, 0x10,
+		0x69, 0x6b, 0xef, 0xdb, 0xf7, 0xdb, 0x6c, 0x9a, 0x45, 0xe6, 0x21, 0xd7, 0x37, 0xd8, 0x5a, 0x1d,
+		0xc7, 0x18, 0xa1, 0x72, 0x5e, 0xa8, 0xac, 0x5b, 0xba, 0x8c, 0xf0, 0x13, 0xca, 0xd5, 0x09, 0x88,
+		0x60, 0x50, 0xda, 0x6f, 0x59, 0x69, 0x16, 0x39, 0x07, 0xe9, 0xa6, 0xbe, 0xd5, 0xdf, 0x51, 0x7b,
+		0x7a, 0x53, 0x6b, 0xd8, 0x7e, 0x7b, 0x92, 0xa2, 0x45, 0x0a, 0x2d, 0x21, 0xcf, 0x41, 0x12, 0xc7,
+		0xc8, 0xa0, 0x63, 0x3c, 0x45, 0x59, 0xf0, 0xd0, 0xc1, 0x2c, 0xe0, 0x43, 0x2c, 0x90, 0x14, 0x17,
+		0x9f, 0x5c, 0x83, 0xb8, 0xad, 0xf5, 0x76, 0x74, 0xdb, 0xca, 0x4f, 0xcf, 0x85, 0xcf, 0x65, 0x2f,
+		0xcd, 0x4f, 0x42, 0xaa, 0x4e, 0x51, 0xe8, 0x4a, 0x59, 0xa0, 0x93, 0xe7, 0x41, 0xe6, 0xa1, 0x58,
+		0x95, 0x2f, 0x73, 0xad, 0xfc, 0x0c, 0x15, 0xc0, 0x77, 0x1d, 0x4c, 0x92, 0x47, 0x72, 0xcb, 0x0c,
+		0x49, 0xc9, 0xe9, 0xbe, 0xb4, 0x7f, 0x5e, 0x1c, 0x3d, 0xcc, 0xbc, 0xd8, 0x80, 0x1c, 0xff, 0xad,
+		0x5a, 0xfd, 0x6e, 0xd7, 0xec, 0xd9, 0xf9, 0x63, 0x73, 0xd2, 0xf8, 0x06, 0x09, 0x62, 0x0c, 0x47,
+		0xc9, 0x6e, 0xfb, 0xd2, 0x5f, 0xbb, 0xe9, 0x36, 0xfb, 0x12, 0x64, 0xfd, 0xcc, 0xf0, 0x06, 0xc2,
+		0xc3, 0x13, 0x06, 0xc2, 0x71, 0x59, 0x22, 0x16, 0x6a, 0x68, 0x9a, 0x58, 0x62, 0xf6, 0xfb, 0x43,
+		0x90, 0xf5, 0x77, 0x8c, 0x2c, 0x01, 0x11, 0x23, 0xd6, 0x32, 0xec, 0x9e, 0xd9, 0xec, 0x37, 0xf4,
+		0x66, 0x5e, 0x1a, 0x53, 0xcf, 0x14, 0xc7, 0xa9, 0x3a, 0x28, 0x5e, 0x42, 0x9e, 0x59, 0x10, 0x9a,
+		0x90, 0x50, 0xd9, 0x9d, 0x1f, 0x17, 0x60, 0x5a, 0x10, 0x40, 0x62, 0x37, 0xb5, 0x9e, 0x81, 0x2e,
+		0x32, 0x73, 0xda, 0x89, 0xa7, 0xe8, 0x79, 0x56, 0x42, 0x8a, 0x20, 0xc4, 0x45, 0xed, 0xe9, 0x1d,
+		0x13, 0xe3, 0x5d, 0x91, 0x31, 0xd5, 0x66, 0x39, 0x82, 0xc2, 0xe0, 0x0b, 0x17, 0x20, 0x4a, 0xd5,
+		0x0f, 0x01, 0xe0, 0x0a, 0x48, 0x3e, 0x42, 0x12, 0x10, 0x59, 0x5c, 0x53, 0xd0, 0x3c, 0xca, 0x90,
+		0x66, 0xb9, 0xea, 0x7a, 0xb5, 0xb2, 0x58, 0x91, 0x43, 0x85, 0xc7, 0x20, 0xc6, 0x74, 0x0a, 0x9a,
+		0x4e, 0x47, 0xab, 0xc8, 0x47, 0x78, 0x92, 0xd3, 0x90, 0x44, 0xe9, 0xc6, 0x4a, 0xa9, 0xa2, 0xc8,
+		0xa1, 0xc2, 0x06, 0xe4, 0x06, 0xe6, 0x21, 0x39, 0x0a, 0x53, 0x4a, 0xa5, 0x5e, 0x59, 0xc5, 0xe0,
+		0x80, 0xba, 0xb1, 0xfa, 0xdc, 0xea, 0xda, 0xf3, 0x18, 0x59, 0xf3, 0x65, 0x0b, 0x3b, 0x2c, 0x91,
+		0x19, 0x90, 0xdd, 0xec, 0xda, 0xda, 0x86, 0x42, 0x5b, 0xf3, 0x5d, 0x21, 0x90, 0x07, 0x27, 0x25,
+		0x39, 0x0e, 0xd3, 0xf5, 0xa2, 0xb2, 0x54, 0xa9, 0xab, 0x2c, 0xe0, 0xe1, 0x90, 0x9e, 0x01, 0xd9,
+		0x5b, 0x70, 0xb5, 0x4a, 0xe3, 0x39, 0xa7, 0xe1, 0xa4, 0x37, 0xb7, 0xf2, 0x42, 0xbd, 0xb2, 0x5a,
+		0xa3, 0x95, 0x17, 0x57, 0x97, 0xd0, 0x29, 0x18, 0xa0, 0x27, 0x42, 0x2c, 0x61, 0x6c, 0xaa, 0x9f,
+		0x5e, 0x65, 0xb9, 0x2c, 0x47, 0x06, 0xb3, 0xd7, 0x56, 0x2b, 0x6b, 0x57, 0xe5, 0xe8, 0x60, 0xed,
+		0x34, 0xec, 0x12, 0x23, 0xb3, 0x70, 0x6c, 0x30, 0x57, 0xad, 0xac, 0xd6, 0x95, 0x17, 0xe5, 0xf8,
+		0x60, 0xc5, 0xb5, 0x8a, 0xb2, 0x59, 0x5d, 0xac, 0xc8, 0x09, 0x72, 0x0c, 0x88, 0xbf, 0x45, 0xf5,
+		0x6b, 0x6b, 0x65, 0x39, 0x19, 0x64, 0xb1, 0x88, 0x3c, 0x5d, 0xf8, 0x59, 0x09, 0xd2, 0xde, 0x10,
+		0x88, 0x4f, 0xa9, 0x48, 0xef, 0x34, 0x63, 0x5b, 0xf8, 0xfd, 0x10, 0xa4, 0x3c, 0xb1, 0x10, 0x5c,
+		0xc4, 0x6a, 0xed, 0xb6, 0x79, 0x53, 0xd5, 0xda, 0x2d, 0xcd, 0xe2, 0xf6, 0x10, 0x68, 0x56, 0x11,
+		0x73, 0x26, 0xb5, 0x3f, 0x93, 0xbb, 0x2e, 0xb1, 0x3b, 0x76, 0x5d, 0xe2, 0xef, 0x40, 0xd7, 0x25,
+		0x2a, 0xc7, 0x0a, 0x7f, 0x10, 0x02, 0x79, 0x30, 0x3a, 0x32, 0xc0, 0x37, 0x69, 0x14, 0xdf, 0xbc,
+		0xfd, 0x0b, 0x1d, 0xa6, 0x7f, 0x83, 0x56, 0x3d, 0x3c, 0xd2, 0xaa, 0x07, 0x18, 0xab, 0xc8, 0x3b,
+		0xd9, 0x58, 0x79, 0xc5, 0xf5, 0x3f, 0x4a, 0x90, 0xf5, 0x07, 0x73, 0x7c, 0x1c, 0x2b, 0x1c, 0x86,
+		0x63, 0xfe, 0x11, 0x39, 0x33, 0x6a, 0x44, 0xfe, 0x5a, 0xfa, 0xf5, 0x83, 0x61, 0xc8, 0xf8, 0x62,
+		0x3f, 0x93, 0xb6, 0xee, 0xbd, 0x30, 0xd5, 0x6a, 0xea, 0x9d, 0xae, 0x69, 0xe3, 0xc9, 0x03, 0xb5,
+		0xad, 0xef, 0xe9, 0x6d, 0xca, 0x86, 0x6c, 0xc0, 0xee, 0xaa, 0xaf, 0x86, 0xf9, 0xaa, 0x8b, 0xb7,
+		0x8c, 0x68, 0x0b, 0xd3, 0xd5, 0x72, 0x65, 0x65, 0x7d, 0xad, 0x5e, 0x59, 0x5d, 0x7c, 0x51, 0x68,
+		0x72, 0x45, 0x6e, 0x0d, 0x80, 0xf9, 0x18, 0x7e, 0xf6, 0x9d, 0xb1, 0xe8, 0x5c, 0x07, 0x79, 0xb0,
+		0x37, 0xa8, 0xd0, 0x03, 0xfa, 0x23, 0x1f, 0x21, 0xd3, 0x90, 0x5b, 0x5d, 0x53, 0x6b, 0xd5, 0x72,
+		0x45, 0xad, 0x5c, 0xbd, 0x5a, 0x59, 0xac, 0xd7, 0xd8, 0x46, 0x83, 0x03, 0x5d, 0x97, 0x43, 0xde,
+		0xb1, 0xf9, 0xa1, 0x30, 0x4c, 0x07, 0xb4, 0x84, 0x14, 0x79, 0x88, 0x90, 0x45, 0x2d, 0x1f, 0x9a,
+		0xa4, 0xf5, 0xf3, 0xb8, 0xba, 0x5f, 0xd7, 0x7a, 0x36, 0x8f, 0x28, 0x3e, 0x00, 0xc8, 0x5e, 0xc3,
+		0x46, 0xf7, 0xbe, 0xc7, 0x37, 0x70, 0x98, 0x0b, 0x92, 0x73, 0xf3, 0xd9, 0x1e, 0xce, 0xbb, 0x80,
+		0x74, 0x4d, 0xab, 0x65, 0xb7, 0xf6, 0xf0, 0x20, 0x84, 0xd8, 0xed, 0xc1, 0x89, 0x1b, 0x51, 0x64,
+		0x51, 0x52, 0x35, 0x6c, 0x07, 0xda, 0xd0, 0x77, 0xb4, 0x01, 0x68, 0x5c, 0x7e, 0x84, 0x15, 0x59,
+		0x94, 0x38, 0xd0, 0x67, 0x20, 0xdd, 0x34, 0xfb, 0x18, 0x95, 0x61, 0x70, 0xa8, 0x92, 0x25, 0x25,
+		0xc5, 0xf2, 0x1c, 0x10, 0x1e, 0x36, 0x73, 0xb7, 0x99, 0xd2, 0x4a, 0x8a, 0xe5, 0x31, 0x90, 0xfb,
+		0x21, 0xa7, 0xed, 0xec, 0xf4, 0x90, 0xb8, 0x20, 0xc4, 0x02, 0x81, 0x59, 0x27, 0x9b, 0x02, 0xce,
+		0x5e, 0x87, 0x84, 0xe0, 0x03, 0xae, 0x7f, 0x91, 0x13, 0x6a, 0x97, 0x45, 0xb7, 0x43, 0xb8, 0xf3,
+		0x64, 0x88, 0xc2, 0x33, 0x90, 0x6e, 0x59, 0xaa, 0x7b, 0x0c, 0x22, 0x34, 0x17, 0x3a, 0x97, 0x50,
+		0x52, 0x2d, 0xcb, 0xd9, 0x15, 0x2d, 0xfc, 0x5c, 0x0a, 0xc0, 0x15, 0x36, 0xf2, 0x3d, 0x12, 0x64,
+		0x99, 0x81, 0xe9, 0xf6, 0x74, 0x4b, 0x37, 0x1a, 0x62, 0x59, 0xf8, 0xc0, 0x01, 0x22, 0xca, 0xd4,
+		0xdc, 0x3a, 0x47, 0x28, 0x3d, 0xfb, 0x41, 0x49, 0x7a, 0x55, 0x8a, 0xbc, 0x2a, 0x49, 0x3f, 0x26,
+		0x65, 0x48, 0xa2, 0xf2, 0xc2, 0xfa, 0x72, 0x75, 0xb1, 0x5a, 0xcf, 0xff, 0x71, 0x9c, 0xa6, 0xab,
+		0x2b, 0x3c, 0xfd, 0x7a, 0xdc, 0x5f, 0xfe, 0x46, 0xfc, 0x97, 0xa4, 0x70, 0xe2, 0x8d, 0xb8, 0x92,
+		0xd9, 0xf6, 0xd2, 0x23, 0x6d, 0xef, 0x09, 0x8a, 0xd0, 0xa8, 0x85, 0xa4, 0xdb, 0x9a, 0x0a, 0x3f,
+		0x37, 0x51, 0x7a, 0x80, 0x36, 0x24, 0x46, 0x1b, 0x92, 0x22, 0xb1, 0xc5, 0xe5, 0xb5, 0x5a, 0xa5,
+		0x4c, 0x9b, 0x91, 0x24, 0x91, 0xb5, 0xf5, 0xca, 0x6a, 0xfe, 0x75, 0x51, 0xa5, 0x7b, 0xd8, 0xe2,
+		0x55, 0x09, 0x8e, 0x8b, 0x5d, 0x56, 0x6e, 0x6b, 0x75, 0xa3, 0x61, 0x36, 0x85, 0x77, 0x9b, 0xbd,
+		0x74, 0xf1, 0xa0, 0xca, 0x15, 0x8e, 0x4a, 0x59, 0x52, 0xe1, 0x88, 0xa5, 0x87, 0x86, 0x58, 0x52,
+		0x5c, 0x2d, 0xf3, 0xb6, 0xa4, 0x48, 0x6c, 0xbd, 0xb8, 0xf8, 0x5c, 0xa5, 0xec, 0xb6, 0xe6, 0x68,
+		0x2f, 0x88, 0x0a, 0xf9, 0x66, 0xc8, 0x61, 0xb4, 0x15, 0x65, 0xa3, 0xd5, 0x64, 0xdb, 0xde, 0x91,
+		0x51, 0xfb, 0xa5, 0x6e, 0x8b, 0x30, 0xfc, 0xba, 0xe9, 0x60, 0x94, 0x1e, 0xf0, 0x34, 0x25, 0x49,
+		0x22, 0xab, 0x6b, 0xab, 0x15, 0xd1, 0x0c, 0xba, 0x45, 0xfc, 0xa2, 0xdb, 0x8c, 0x6c, 0xdf, 0x87,
+		0x4a, 0xbe, 0x19, 0x64, 0x11, 0x1e, 0x72, 0x58, 0x12, 0x1d, 0xb5, 0xe5, 0xeb, 0x36, 0x80, 0x07,
+		0x99, 0x1c, 0x66, 0xdc, 0xe7, 0x69, 0xc1, 0x0c, 0xc9, 0x2d, 0x57, 0x56, 0x97, 0xea, 0xd7, 0xd4,
+		0x75, 0xa5, 0x42, 0x77, 0xee, 0xf2, 0x7f, 0x2c, 0xaa, 0xcf, 0x75, 0xfc, 0x88, 0xe4, 0x5b, 0x25,
+		0x48, 0x31, 0x17, 0x88, 0xc5, 0xa4, 0x58, 0x50, 0xe1, 0xbe, 0x83, 0xea, 0xa6, 0x1e, 0x10, 0x85,
+		0x2e, 0x5d, 0xa1, 0xd5, 0x86, 0x85, 0x40, 0x1c, 0x27, 0x64, 0xb9, 0xb2, 0x54, 0x5c, 0x7c, 0x51,
+		0x2d, 0x55, 0x6a, 0x75, 0xd4, 0x64, 0x6b, 0x0a, 0x93, 0x51, 0x20, 0xd1, 0xe2, 0xf2, 0xf2, 0xda,
+		0xf3, 0x2e, 0x23, 0xe0, 0x65, 0x87, 0x4c, 0xe1, 0x1b, 0x20, 0xe3, 0x13, 0x77, 0x74, 0x8a, 0xa9,
+		0x33, 0x8d, 0x3d, 0xa8, 0x55, 0x56, 0x17, 0xbd, 0x4e, 0x7c, 0x1a, 0x1c, 0xf1, 0x96, 0x25, 0x4c,
+		0x09, 0xe1, 0x97, 0x43, 0xa8, 0x46, 0x79, 0x03, 0x9c, 0xbd, 0xc4, 0x70, 0xe1, 0x71, 0x48, 0x08,
+		0xf1, 0x45, 0xd7, 0x9c, 0x7a, 0xd8, 0x03, 0x0b, 0x83, 0x04, 0x50, 0xd9, 0x95, 0x25, 0x5c, 0x06,
+		0x31, 0x99, 0x96, 0x43, 0x85, 0x4d, 0x38, 0x1a, 0x28, 0x7a, 0xe4, 0x2c, 0x9c, 0x16, 0xfb, 0x97,
+		0xcc, 0xe9, 0x57, 0x2b, 0xab, 0x8b, 0x6b, 0x65, 0x5c, 0x26, 0xb9, 0x34, 0x01, 0xb8, 0x0c, 0xb2,
+		0x56, 0x0a, 0xf9, 0x94, 0x43, 0x85, 0x2a, 0x64, 0xfd, 0x02, 0x44, 0x4e, 0xc2, 0xf1, 0x8d, 0xfa,
+		0xd5, 0x27, 0xd4, 0xcd, 0xe2, 0x72, 0xb5, 0x5c, 0x1c, 0x58, 0x10, 0x01, 0x70, 0x29, 0x92, 0x43,
+		0xd8, 0x50, 0x94, 0x2e, 0x39, 0x5c, 0x88, 0x24, 0x24, 0x59, 0x2a, 0xd4, 0x20, 0x37, 0x20, 0x0a,
+		0xe4, 0x2e, 0xc8, 0xf3, 0x15, 0x4a, 0x50, 0xab, 0xa6, 0x61, 0x50, 0x38, 0xd8, 0x5a, 0xad, 0x5c,
+		0x59, 0xae, 0xae, 0x54, 0xeb, 0xb4, 0x7d, 0xd7, 0x00, 0xdc, 0x31, 0x46, 0x9b, 0x75, 0xbd, 0xb6,
+		0xb6, 0xaa, 0x5e, 0xc5, 0x85, 0x5e, 0xdd, 0x43, 0x2a, 0x09, 0x6c, 0x4c, 0x65, 0x09, 0xd7, 0x23,
+		0xc3, 0x03, 0x2f, 0x87, 0xce, 0x7f, 0x48, 0x42, 0x93, 0xf5, 0xa1, 0xd5, 0xd9, 0x6f, 0x95, 0xc8,
+		0xdd, 0x89, 0x37, 0xe2, 0x24, 0x3e, 0xdf, 0xdd, 0x9a, 0x6f, 0x74, 0xbb, 0xb3, 0x39, 0xfc, 0xb1,
+		0xd8, 0xed, 0x5e, 0x15, 0x86, 0xf8, 0x74, 0xe2, 0x4f, 0xe2, 0x24, 0x81, 0xb9, 0xb8, 0x09, 0x30,
+		0x2b, 0xe3, 0xaf, 0xeb, 0xda, 0x9e, 0xe6, 0x00, 0x9c, 0x4c, 0x7c, 0x3e, 0x4e, 0x62, 0x98, 0xbd,
+		0x63, 0xce, 0x66, 0xf1, 0xff, 0x92, 0xe9, 0x14, 0x9e, 0x4d, 0x7c, 0xc7, 0x2a, 0x01, 0xcc, 0xa4,
+		0x12, 0x7b, 0x71, 0x96, 0xe0, 0x6f, 0xba, 0x6d, 0x76, 0x51, 0x00, 0x9d, 0x8f, 0x25, 0x3e, 0xb4,
+		0x2a, 0x7f, 0x64, 0xf5, 0x7c, 0x2c, 0xf1, 0x91, 0x55, 0xf9, 0x7b, 0x56, 0xaf, 0xc7, 0x12, 0xaf,
+		0xc7, 0xe5, 0x37, 0xe2, 0x85, 0xbf, 0x08, 0x03, 0x71, 0xe5, 0xdb, 0x89, 0xbc, 0xbc, 0x00, 0x09,
+		0x27, 0x94, 0xc3, 0xce, 0x8a, 0x3e, 0x75, 0xc0, 0xb4, 0x10, 0x68, 0x9e, 0xac, 0x81, 0xd0, 0x8e,
+		0x43, 0x0d, 0xd7, 0xed, 0x9d, 0x96, 0xd1, 0xea, 0xf4, 0x3b, 0xaa, 0x88, 0x6f, 0x8c, 0x5d, 0xb7,
+		0x73, 0x04, 0x9e, 0xa6, 0x24, 0xb4, 0x5b, 0x3e, 0x12, 0xd1, 0xb1, 0x24, 0x18, 0x02, 0x4f, 0xcf,
+		0x7e, 0x59, 0x82, 0xfc, 0xa8, 0xc6, 0xde, 0x51, 0xe8, 0x65, 0x15, 0x66, 0xcc, 0x3d, 0xbd, 0xd7,
+		0x6b, 0x35, 0xe9, 0x6e, 0x8a, 0xe3, 0x90, 0x45, 0xc6, 0x3b, 0x64, 0xd3, 0x1e, 0x44, 0x67, 0x50,
+		0x4b, 0x68, 0x37, 0x6f, 0xa1, 0xc9, 0x10, 0x94, 0xa2, 0xe3, 0x29, 0x65, 0x28, 0x8a, 0xa0, 0x71,
+		0x1d, 0xa7, 0x09, 0xae, 0x81, 0x42, 0x72, 0xd8, 0xf5, 0xfa, 0x0a, 0x9f, 0x08, 0x41, 0xd6, 0x7f,
+		0x38, 0x93, 0x94, 0x21, 0xd1, 0x36, 0xf9, 0xc1, 0x27, 0x36, 0xda, 0xe7, 0xc6, 0x9c, 0xe7, 0x9c,
+		0x5f, 0xe6, 0xf0, 0x8a, 0x83, 0x39, 0xfb, 0x7b, 0x12, 0x24, 0x44, 0x36, 0x39, 0x06, 0x91, 0xae,
+		0x66, 0xef, 0x52, 0x72, 0xd1, 0x52, 0x48, 0x96, 0x14, 0x9a, 0xc6, 0x7c, 0xab, 0xab, 0xb1, 0x43,
+		0x5f, 0x3c, 0x1f, 0xd3, 0xe8, 0x79, 0xb5, 0x75, 0xad, 0x49, 0xf7, 0x01, 0xcd, 0x4e, 0x47, 0x37,
+		0x6c, 0x4b, 0x78, 0x5e, 0x3c, 0x7f, 0x91, 0x67, 0xe3, 0x19, 0x61, 0xbb, 0xa7, 0xb5, 0xda, 0x3e,
+		0xd8, 0x08, 0x85, 0x95, 0x45, 0x81, 0x03, 0xbc, 0x00, 0x27, 0x04, 0xdd, 0xa6, 0x6e, 0x6b, 0x8d,
+		0x5d, 0xbd, 0xe9, 0x22, 0xc5, 0xe8, 0x7e, 0xff, 0x71, 0x0e, 0x50, 0xe6, 0xe5, 0x02, 0xb7, 0xf0,
+		0xe9, 0x10, 0x4c, 0x89, 0x9d, 0xcb, 0xa6, 0xc3, 0xac, 0x15, 0x00, 0xcd, 0x30, 0x4c, 0xdb, 0xcb,
+		0xae, 0x61, 0x67, 0x73, 0x08, 0x6f, 0xbe, 0xe8, 0x20, 0x29, 0x1e, 0x02, 0xb3, 0x7f, 0x2a, 0x01,
+		0xb8, 0x45, 0x23, 0xf9, 0x76, 0x1a, 0x52, 0xfc, 0xe8, 0x2d, 0x3d, 0xbf, 0xcd, 0x02, 0x7c, 0xc0,
+		0xb2, 0x70, 0x8f, 0x13, 0x63, 0x7f, 0x5b, 0xfa, 0x4e, 0xcb, 0xe0, 0x67, 0xa9, 0x58, 0x42, 0x1c,
+		0x49, 0x88, 0xb8, 0x67, 0x0d, 0x15, 0x48, 0x58, 0x7a, 0x47, 0x33, 0xec, 0x56, 0x83, 0xcf, 0x9a,
+		0xcb, 0x87, 0x6a, 0xfc, 0x7c, 0x8d, 0x63, 0x2b, 0x0e, 0x9d, 0xc2, 0x39, 0x48, 0x88, 0x5c, 0x47,
+		0x4b, 0x1f, 0x21, 0x71, 0x08, 0xd7, 0x2a, 0x68, 0xa7, 0xa8, 0xb2, 0xac, 0x16, 0x6b, 0x72, 0xe8,
+		0xfc, 0x27, 0x42, 0x10, 0x17, 0xd3, 0x78, 0x1a, 0x72, 0x95, 0x72, 0x75, 0x40, 0xe1, 0x4f, 0x43,
+		0x56, 0x64, 0x32, 0xad, 0x2a, 0x7f, 0x20, 0xee, 0xcd, 0x5c, 0x57, 0xd6, 0xea, 0x6b, 0x97, 0xe4,
+		0x3f, 0x1e, 0xce, 0x7c, 0x44, 0x7e, 0x3d, 0x4e, 0xa6, 0x20, 0x2d, 0x32, 0x2f, 0x3d, 0x7c, 0xe9,
+		0x11, 0xf9, 0x8d, 0xc1, 0xac, 0x47, 0xe5, 0x3f, 0xa1, 0xb1, 0x25, 0x91, 0x75, 0x51, 0xad, 0xa3,
+		0xd6, 0x5e, 0x5b, 0x5d, 0x7e, 0x51, 0x96, 0xbc, 0x05, 0x97, 0x3c, 0x05, 0x21, 0x72, 0x37, 0x1c,
+		0x17, 0x05, 0x57, 0xae, 0x5c, 0xb9, 0xf2, 0xb8, 0xa7, 0xf0, 0xf6, 0x87, 0x63, 0x83, 0xc5, 0x4f,
+		0x78, 0x8a, 0x3f, 0x36, 0x5c, 0x7c, 0xc5, 0x53, 0xfc, 0x23, 0x1f, 0x8e, 0x91, 0x69, 0x48, 0x89,
+		0xe2, 0x95, 0xe2, 0x0b, 0xf2, 0x57, 0xbf, 0xfa, 0xd5, 0xaf, 0xc6, 0x4b, 0xdf, 0x0c, 0xd3, 0x0d,
+		0xb3, 0x33, 0x38, 0x34, 0x25, 0x79, 0xe0, 0x60, 0x84, 0x75, 0x4d, 0x7a, 0xe9, 0x21, 0x0e, 0xb4,
+		0x63, 0xb6, 0x35, 0x63, 0x67, 0xde, 0xec, 0xed, 0xb8, 0xf7, 0x04, 0xd0, 0xc9, 0xb5, 0x3c, 0xb7,
+		0x05, 0xba, 0x5b, 0x5f, 0x96, 0xa4, 0x1f, 0x0b, 0x85, 0x97, 0xd6, 0x4b, 0x3f, 0x1d, 0x9a, 0x5d,
+		0x62, 0x88, 0xeb, 0x62, 0xe0, 0x15, 0x7d, 0xbb, 0xad, 0x37, 0x70, 0x74, 0xe0, 0xcf, 0x1e, 0x84,
+		0x99, 0x1d, 0x73, 0xc7, 0xa4, 0x94, 0x2e, 0xe0, 0x2f, 0xd6, 0x08, 0x92, 0x74, 0x72, 0x67, 0xc7,
+		0xde, 0x4a, 0x58, 0x58, 0x85, 0x69, 0x0e, 0xac, 0x52, 0x9f, 0x9b, 0xed, 0xdd, 0x92, 0x03, 0xcf,
+		0xff, 0xe4, 0x7f, 0xf1, 0x8f, 0x68, 0xb0, 0x44, 0x99, 0xe2, 0xa8, 0x58, 0xc6, 0xb6, 0x77, 0x17,
+		0x14, 0x38, 0xea, 0xa3, 0xc7, 0xd6, 0x3b, 0x7a, 0x6f, 0x0c, 0xc5, 0x7f, 0xcd, 0x29, 0x4e, 0x7b,
+		0x28, 0xd6, 0x38, 0xea, 0xc2, 0x22, 0x64, 0x0e, 0x43, 0xeb, 0xb7, 0x38, 0xad, 0xb4, 0xee, 0x25,
+		0xb2, 0x04, 0x39, 0x4a, 0xa4, 0xd1, 0xb7, 0x6c, 0xb3, 0x43, 0x17, 0x93, 0x07, 0x93, 0xf9, 0x37,
+		0x7f, 0xc4, 0xd4, 0x5b, 0x16, 0xd1, 0x16, 0x1d, 0xac, 0x85, 0x05, 0xa0, 0x6b, 0x07, 0x3c, 0xd4,
+		0x3a, 0x86, 0xc2, 0x6f, 0xf3, 0x86, 0x38, 0xf0, 0x0b, 0x9b, 0x30, 0x83, 0xbf, 0xe9, 0x5a, 0xcf,
+		0xdb, 0x92, 0xf1, 0x87, 0x85, 0xf2, 0xbf, 0xff, 0x6d, 0x4c, 0x83, 0x4e, 0x3b, 0x04, 0x3c, 0x6d,
+		0xf2, 0x8c, 0xe2, 0x8e, 0x6e, 0xdb, 0x7a, 0xcf, 0x52, 0xb5, 0x76, 0x50, 0xf3, 0x3c, 0xa7, 0x2d,
+		0xf2, 0x3f, 0xf8, 0x05, 0xff, 0x28, 0x2e, 0x31, 0xcc, 0x62, 0xbb, 0xbd, 0xb0, 0x01, 0xc7, 0x03,
+		0xa4, 0x62, 0x02, 0x9a, 0x3f, 0xc4, 0x69, 0xce, 0x0c, 0x49, 0x06, 0x92, 0x5d, 0x07, 0x91, 0xef,
+		0x8c, 0xe5, 0x04, 0x34, 0x3f, 0xca, 0x69, 0x12, 0x8e, 0x2b, 0x86, 0x14, 0x29, 0x5e, 0x87, 0xa9,
+		0x3d, 0xbd, 0xb7, 0x65, 0x5a, 0xfc, 0x84, 0xcb, 0x04, 0xe4, 0x7e, 0x98, 0x93, 0xcb, 0x71, 0x44,
+		0x7a, 0xe4, 0x05, 0x69, 0x5d, 0x81, 0xc4, 0xb6, 0xd6, 0xd0, 0x27, 0x20, 0x71, 0x9b, 0x93, 0x88,
+		0x23, 0x3c, 0xa2, 0x16, 0x21, 0xbd, 0x63, 0xf2, 0xe5, 0xfe, 0x78, 0xf4, 0x8f, 0x71, 0xf4, 0x94,
+		0xc0, 0xe1, 0x24, 0xba, 0x66, 0xb7, 0xdf, 0xc6, 0x58, 0xc0, 0x78, 0x12, 0x3f, 0x22, 0x48, 0x08,
+		0x1c, 0x4e, 0xe2, 0x10, 0x6c, 0xfd, 0xb8, 0x20, 0x61, 0x79, 0xf8, 0xf9, 0x2c, 0x1e, 0x7c, 0x6d,
+		0xef, 0x9b, 0xc6, 0x24, 0x8d, 0xf8, 0x51, 0x4e, 0x01, 0x38, 0x0a, 0x12, 0x78, 0x12, 0x92, 0x93,
+		0x0e, 0xc4, 0x4f, 0x7c, 0x41, 0x4c, 0x0f, 0x31, 0x02, 0x4b, 0x90, 0x13, 0x0a, 0x0a, 0xb7, 0x8d,
+		0xc6, 0x93, 0xf8, 0x49, 0x4e, 0x22, 0xeb, 0x41, 0xe3, 0xdd, 0xb0, 0x75, 0xcb, 0xde, 0xd1, 0x27,
+		0x21, 0xf2, 0x09, 0xd1, 0x0d, 0x8e, 0xc2, 0x59, 0xb9, 0xa5, 0x1b, 0x8d, 0xdd, 0xc9, 0x28, 0x7c,
+		0x52, 0xb0, 0x52, 0xe0, 0x20, 0x89, 0x45, 0xc8, 0x74, 0xb4, 0x9e, 0xb5, 0xab, 0xb5, 0x27, 0x1a,
+		0x8e, 0x9f, 0xe2, 0x34, 0xd2, 0x0e, 0x12, 0xe7, 0x48, 0xdf, 0x38, 0x0c, 0x99, 0x9f, 0x16, 0x1c,
+		0xe9, 0x1b, 0x3e, 0x42, 0xeb, 0x30, 0x63, 0xd9, 0xd4, 0xf3, 0x3d, 0x0c, 0xb5, 0x9f, 0x11, 0x53,
+		0x8f, 0xe1, 0xae, 0x78, 0x29, 0x3e, 0x09, 0x49, 0xab, 0xf5, 0xbe, 0x89, 0xc8, 0xfc, 0xac, 0x18,
+		0x69, 0x8a, 0x80, 0xc8, 0x2f, 0xc2, 0x89, 0x40, 0x33, 0x31, 0x01, 0xb1, 0x9f, 0xe3, 0xc4, 0x8e,
+		0x05, 0x98, 0x0a, 0xae, 0x12, 0x0e, 0x4b, 0xf2, 0xe7, 0x85, 0x4a, 0xd0, 0x07, 0x68, 0xad, 0x63,
+		0x00, 0xd6, 0xd2, 0xb6, 0x0f, 0xc7, 0xb5, 0x7f, 0x24, 0xb8, 0xc6, 0x70, 0x7d, 0x5c, 0xab, 0xc3,
+		0x31, 0x4e, 0xf1, 0x70, 0xe3, 0xfa, 0x0b, 0x42, 0xb1, 0x32, 0xec, 0x0d, 0xff, 0xe8, 0x7e, 0x3d,
+		0xcc, 0x3a, 0xec, 0x14, 0x91, 0x3e, 0x4b, 0xc5, 0x73, 0x32, 0xe3, 0x29, 0xff, 0x22, 0xa7, 0x2c,
+		0x34, 0xbe, 0x13, 0x2a, 0xb4, 0x56, 0xb4, 0x2e, 0x12, 0x7f, 0x01, 0xf2, 0x82, 0x78, 0xdf, 0xe8,
+		0xe9, 0x0d, 0x73, 0xc7, 0x68, 0xbd, 0x4f, 0x6f, 0x4e, 0x40, 0xfa, 0x97, 0x06, 0x86, 0x6a, 0xc3,
+		0x83, 0x8e, 0x94, 0xab, 0x20, 0x3b, 0xbe, 0x8a, 0xda, 0xea, 0xd0, 0x5d, 0x91, 0x83, 0x29, 0xfe,
+		0xb2, 0x18, 0x29, 0x07, 0xaf, 0x4a, 0xd1, 0x16, 0x2a, 0xc0, 0xce, 0xc8, 0x4f, 0x2a, 0x92, 0x9f,
+		0xe2, 0x84, 0x32, 0x2e, 0x16, 0x57, 0x1c, 0x0d, 0xb3, 0xd3, 0xd5, 0x7a, 0x93, 0xe8, 0xbf, 0x5f,
+		0x11, 0x8a, 0x83, 0xa3, 0x70, 0xc5, 0x81, 0x1e, 0x1d, 0x5a, 0xfb, 0x09, 0x28, 0xfc, 0xaa, 0x50,
+		0x1c, 0x02, 0x87, 0x93, 0x10, 0x0e, 0xc3, 0x04, 0x24, 0xfe, 0xb1, 0x20, 0x21, 0x70, 0x90, 0xc4,
+		0xbb, 0x5d, 0x43, 0xdb, 0xd3, 0x77, 0x5a, 0x96, 0xcd, 0x6f, 0xb1, 0x1c, 0x4c, 0xea, 0xd7, 0xbe,
+		0xe0, 0x77, 0xc2, 0x14, 0x0f, 0x2a, 0x6a, 0x22, 0x1e, 0x9e, 0xa3, 0xe1, 0xe7, 0xf1, 0x0d, 0xfb,
+		0x75, 0xa1, 0x89, 0x3c, 0x68, 0xd8, 0x36, 0x8f, 0x87, 0x88, 0x6c, 0x6f, 0xe0, 0x92, 0x6e, 0x02,
+		0x72, 0xbf, 0x31, 0xd0, 0xb8, 0x9a, 0xc0, 0x45, 0x9a, 0x1e, 0xff, 0xa7, 0x6f, 0xdc, 0xd0, 0xf7,
+		0x27, 0x92, 0xce, 0x7f, 0x32, 0xe0, 0xff, 0x6c, 0x30, 0x4c, 0xa6, 0x43, 0x72, 0x03, 0xfe, 0x14,
+		0x19, 0x77, 0xc5, 0x2d, 0xff, 0x2d, 0x6f, 0xf2, 0xfe, 0xfa, 0xdd, 0xa9, 0x85, 0x65, 0x90, 0x79,
+		0x8e, 0xeb, 0xc0, 0x8e, 0x25, 0xf6, 0x6d, 0x6f, 0x3a, 0x72, 0xee, 0xf3, 0x79, 0x16, 0xae, 0x42,
+		0xc6, 0xe7, 0xf0, 0x8c, 0x27, 0xf5, 0x01, 0x4e, 0x2a, 0xed, 0xf5, 0x77, 0x16, 0x1e, 0x83, 0x08,
+		0x3a, 0x2f, 0xe3, 0xd1, 0xbf, 0x9d, 0xa3, 0x53, 0xf0, 0x85, 0xa7, 0x21, 0x21, 0x9c, 0x96, 0xf1,
+		0xa8, 0xdf, 0xc1, 0x51, 0x1d, 0x14, 0x44, 0x17, 0x0e, 0xcb, 0x78, 0xf4, 0xbf, 0x2b, 0xd0, 0x05,
+		0x0a, 0xa2, 0x4f, 0xce, 0xc2, 0x7f, 0xf1, 0xa1, 0x08, 0x43, 0x17, 0x28, 0x0b, 0x78, 0x46, 0x9f,
+		0x79, 0x2a, 0xe3, 0xb1, 0xbf, 0x93, 0x57, 0x2e, 0x30, 0x16, 0x1e, 0x87, 0xe8, 0x84, 0x0c, 0xff,
+		0x30, 0x47, 0x65, 0xf0, 0x0b, 0x8b, 0x90, 0xf2, 0x78, 0x27, 0xe3, 0xd1, 0xbf, 0x9b, 0xa3, 0x7b,
+		0xb1, 0xb0, 0xe9, 0xdc, 0x3b, 0x19, 0x4f, 0xe0, 0xef, 0x89, 0xa6, 0x73, 0x0c, 0x64, 0x9b, 0x70,
+		0x4c, 0xc6, 0x63, 0x7f, 0x44, 0x70, 0x5d, 0xa0, 0x2c, 0x3c, 0x0b, 0x49, 0xc7, 0xd8, 0x8c, 0xc7,
+		0xff, 0x1e, 0x8e, 0xef, 0xe2, 0x20, 0x07, 0xfa, 0xc6, 0x21, 0x48, 0xfc, 0x7d, 0xc1, 0x01, 0x0f,
+		0x16, 0x4e, 0xa3, 0x41, 0x07, 0x66, 0x3c, 0xa5, 0xef, 0x15, 0xd3, 0x68, 0xc0, 0x7f, 0xc1, 0xd1,
+		0xa4, 0x3a, 0x7f, 0x3c, 0x89, 0xef, 0x13, 0xa3, 0x49, 0xe1, 0xb1, 0x19, 0x83, 0x1e, 0xc1, 0x78,
+		0x1a, 0x3f, 0x20, 0x9a, 0x31, 0xe0, 0x10, 0x2c, 0xac, 0x03, 0x19, 0xf6, 0x06, 0xc6, 0xd3, 0x7b,
+		0x95, 0xd3, 0x9b, 0x1a, 0x72, 0x06, 0x16, 0x9e, 0x87, 0x63, 0xc1, 0x9e, 0xc0, 0x78, 0xaa, 0x3f,
+		0xf8, 0xe6, 0xc0, 0xda, 0xcd, 0xeb, 0x08, 0x2c, 0xd4, 0x61, 0x26, 0xc8, 0x0b, 0x18, 0x4f, 0xf6,
+		0x87, 0xde, 0xf4, 0x2b, 0x6e, 0xaf, 0x13, 0xb0, 0x50, 0x04, 0x70, 0x0d, 0xf0, 0x78, 0x5a, 0x3f,
+		0xcc, 0x69, 0x79, 0x90, 0x70, 0x6a, 0x70, 0xfb, 0x3b, 0x1e, 0xff, 0xb6, 0x98, 0x1a, 0x1c, 0x03,
+		0xa7, 0x86, 0x30, 0xbd, 0xe3, 0xb1, 0x3f, 0x26, 0xa6, 0x86, 0x40, 0x41, 0xc9, 0xf6, 0x58, 0xb7,
+		0xf1, 0x14, 0x7e, 0x54, 0x48, 0xb6, 0x07, 0x6b, 0x61, 0x15, 0xa6, 0x86, 0x0c, 0xe2, 0x78, 0x52,
+		0x3f, 0xc6, 0x49, 0xc9, 0x83, 0xf6, 0xd0, 0x6b, 0xbc, 0xb8, 0x31, 0x1c, 0x4f, 0xed, 0xc7, 0x07,
+		0x8c, 0x17, 0xb7, 0x85, 0x0b, 0x4f, 0x42, 0xc2, 0xe8, 0xb7, 0xdb, 0x38, 0x79, 0xc8, 0xc1, 0xb7,
+		0x18, 0xf3, 0x7f, 0xf2, 0x15, 0xce, 0x1d, 0x81, 0xb0, 0xf0, 0x18, 0x44, 0xf5, 0xce, 0x96, 0xde,
+		0x1c, 0x87, 0xf9, 0xf9, 0xaf, 0x08, 0x85, 0x89, 0xd0, 0x0b, 0xcf, 0x02, 0xb0, 0xd0, 0x08, 0x3d,
+		0x09, 0x3c, 0x06, 0xf7, 0x4f, 0xbf, 0xc2, 0xaf, 0x0d, 0xb9, 0x28, 0x2e, 0x01, 0x76, 0x09, 0xe9,
+		0x60, 0x02, 0x5f, 0xf0, 0x13, 0xa0, 0x23, 0x72, 0x05, 0xe2, 0xb8, 0x01, 0x68, 0x6b, 0x3b, 0xe3,
+		0xb0, 0xff, 0x8c, 0x63, 0x0b, 0x78, 0x64, 0x58, 0xc7, 0xec, 0xe9, 0xb6, 0xb6, 0x63, 0x8d, 0xc3,
+		0xfd, 0x73, 0x8e, 0xeb, 0x20, 0x20, 0x72, 0x43, 0xb3, 0xec, 0x49, 0xfa, 0xfd, 0x17, 0x02, 0x59,
+		0x20, 0x60, 0xa3, 0xf1, 0xf7, 0x0d, 0x7d, 0x7f, 0x1c, 0xee, 0x5f, 0x8a, 0x46, 0x73, 0xf8, 0x85,
+		0xa7, 0x21, 0x89, 0x3f, 0xd9, 0x5d, 0xc0, 0x31, 0xc8, 0x7f, 0xc5, 0x91, 0x5d, 0x0c, 0xac, 0xd9,
+		0xb2, 0x9b, 0x76, 0x6b, 0x3c, 0xb3, 0xbf, 0xc8, 0x47, 0x5a, 0xc0, 0x2f, 0x14, 0x21, 0x65, 0xd9,
+		0xcd, 0x66, 0x9f, 0xfb, 0xa7, 0x63, 0xd0, 0xff, 0xc7, 0x57, 0x9c, 0x90, 0x85, 0x83, 0x83, 0xa3,
+		0x7d, 0xf3, 0x86, 0xdd, 0x35, 0xe9, 0xd9, 0x91, 0x71, 0x14, 0xde, 0xe4, 0x14, 0x3c, 0x28, 0x0b,
+		0x8b, 0x90, 0xc6, 0xbe, 0x88, 0x2d, 0xf8, 0x71, 0x24, 0xbe, 0xc4, 0x19, 0xe0, 0x43, 0x2a, 0xbd,
+		0xe7, 0xb7, 0x3f, 0x7b, 0x4a, 0xfa, 0xf4, 0x67, 0x4f, 0x49, 0xff, 0xed, 0xb3, 0xa7, 0xa4, 0x8f,
+		0x7c, 0xee, 0xd4, 0x91, 0x4f, 0x7f, 0xee, 0xd4, 0x91, 0x3f, 0xf8, 0xdc, 0xa9, 0x23, 0xc1, 0x51,
+		0x62, 0x58, 0x32, 0x97, 0x4c, 0x16, 0x1f, 0x7e, 0xe9, 0xde, 0x9d, 0x96, 0xbd, 0xdb, 0xdf, 0x9a,
+		0x6f, 0x98, 0x9d, 0x0b, 0x0d, 0xd3, 0xea, 0x98, 0xd6, 0x05, 0x7f, 0x5c, 0x97, 0xfe, 0x82, 0xff,
+		0x2d, 0xc1, 0x09, 0x46, 0xc6, 0x0d, 0xe7, 0x6a, 0xc6, 0xfe, 0xa8, 0xd7, 0x65, 0x2e, 0x43, 0xb8,
+		0x68, 0xec, 0x93, 0x13, 0x4c, 0xc1, 0xa9, 0xfd, 0x5e, 0x9b, 0x5f, 0x48, 0x8b, 0x63, 0x7a, 0xa3,
+		0xd7, 0xf6, 0x1f, 0x46, 0x4e, 0xf3, 0xc3, 0xc8, 0xa5, 0xef, 0x96, 0x0e, 0xd7, 0x93, 0x44, 0xd1,
+		0xd8, 0xa7, 0x1d, 0x59, 0x97, 0x5e, 0x7a, 0xd7, 0xd8, 0x38, 0xf7, 0x0d, 0xc3, 0xbc, 0x69, 0x60,
+		0xb3, 0xbb, 0x5b, 0x22, 0xc6, 0x7d, 0x6a, 0x30, 0xc6, 0xfd, 0xbc, 0xde, 0x6e, 0x3f, 0x87, 0x70,
+		0xb8, 0x29, 0x6e, 0x6d, 0xc5, 0xd8, 0xdd, 0x67, 0xf8, 0xde, 0x10, 0x9c, 0x1a, 0x0a, 0x67, 0x73,
+		0x21, 0x18, 0xc5, 0x84, 0x05, 0x48, 0x94, 0x85, 0x6c, 0xe5, 0xf1, 0x6d, 0x97, 0x86, 0x69, 0x34,
+		0xd9, 0xa9, 0xd2, 0xb0, 0x22, 0x92, 0xc8, 0x08, 0x43, 0x33, 0x4c, 0x8b, 0x5f, 0xe9, 0x64, 0x89,
+		0xd2, 0x47, 0x0f, 0xc9, 0x88, 0x8c, 0xa8, 0x49, 0x70, 0xe3, 0xe2, 0x84, 0xdc, 0x10, 0x9d, 0xf0,
+		0x45, 0xfe, 0x27, 0xe5, 0xca, 0x0f, 0x84, 0xe0, 0xf4, 0x20, 0x57, 0x70, 0x66, 0x59, 0xb6, 0xd6,
+		0xe9, 0x8e, 0x62, 0xcb, 0x93, 0x90, 0xac, 0x0b, 0x98, 0x43, 0xf3, 0xe5, 0xf6, 0x21, 0xf9, 0x92,
+		0x75, 0xaa, 0x12, 0x8c, 0xb9, 0x34, 0x21, 0x63, 0x9c, 0x7e, 0xdc, 0x11, 0x67, 0x3e, 0x9a, 0x84,
+		0x13, 0x6c, 0x3a, 0xa9, 0x6c, 0x2a, 0xb1, 0x04, 0xe7, 0x49, 0xda, 0x5b, 0x34, 0x7e, 0x9f, 0xa4,
+		0xf0, 0x1c, 0x4c, 0x57, 0x51, 0x5b, 0xe0, 0x2a, 0xc8, 0xdd, 0xe1, 0x09, 0xbc, 0xf5, 0x3a, 0xe7,
+		0x73, 0xf8, 0xf9, 0x4e, 0xa0, 0x37, 0xab, 0xf0, 0x2d, 0x12, 0xc8, 0xb5, 0x86, 0xd6, 0xd6, 0x7a,
+		0x6f, 0x95, 0x14, 0x79, 0x1c, 0x80, 0x9d, 0x59, 0x72, 0xde, 0xab, 0xc1, 0xdd, 0x70, 0x6f, 0xe7,
+		0xe6, 0x59, 0x4d, 0xf4, 0x46, 0x48, 0x92, 0xc2, 0xe2, 0xcf, 0xf3, 0x2f, 0x00, 0xb8, 0x05, 0x78,
+		0xc6, 0xa3, 0xb6, 0x58, 0x5c, 0x2e, 0x2a, 0xe2, 0xf0, 0x49, 0x6d, 0xbd, 0xb2, 0xc8, 0x9e, 0x8f,
+		0x38, 0x82, 0x07, 0x28, 0xbc, 0x85, 0xce, 0x29, 0xfa, 0xa3, 0x30, 0xe5, 0xcd, 0x67, 0x77, 0xf9,
+		0x43, 0x0b, 0xd7, 0x30, 0x52, 0x80, 0xe7, 0x26, 0x55, 0xad, 0xd9, 0xd4, 0x9b, 0x6a, 0xcb, 0x20,
+		0x63, 0xee, 0xed, 0xe6, 0x7f, 0xe7, 0x3f, 0x45, 0x69, 0xd7, 0x32, 0x0c, 0xb1, 0x88, 0x78, 0x55,
+		0x03, 0x7d, 0xce, 0x56, 0xa7, 0xdb, 0xd6, 0xe9, 0x6e, 0xaf, 0xda, 0x12, 0xfc, 0x1f, 0xef, 0xce,
+		0x20, 0x3d, 0xdc, 0x39, 0x9e, 0x76, 0xd1, 0x9d, 0xd1, 0x5b, 0x78, 0xce, 0x3d, 0xd5, 0xe4, 0x34,
+		0x70, 0x2c, 0xc5, 0xdf, 0xe5, 0x2d, 0x14, 0xd1, 0x0c, 0xd1, 0xc4, 0x65, 0x98, 0xc2, 0xcb, 0x6e,
+		0x5d, 0x5f, 0xfb, 0xc6, 0x58, 0x10, 0xd1, 0x5b, 0x99, 0x63, 0xba, 0x4d, 0x7b, 0x1c, 0x62, 0x16,
+		0x1d, 0x94, 0x71, 0x24, 0x44, 0x73, 0x38, 0x38, 0x86, 0xbf, 0x98, 0x18, 0x38, 0x3d, 0x1a, 0x43,
+		0xe0, 0xdf, 0x72, 0x02, 0x69, 0x8a, 0x26, 0x7a, 0x63, 0xc0, 0x14, 0x7b, 0xac, 0x45, 0xf7, 0xf4,
+		0xe6, 0xe0, 0x28, 0xca, 0x3f, 0xfd, 0xe5, 0x87, 0xe9, 0x0e, 0xfb, 0x19, 0xbf, 0xd0, 0x05, 0x4c,
+		0x16, 0x45, 0xe6, 0xb4, 0xdd, 0xfe, 0xea, 0x90, 0x15, 0xf5, 0xf1, 0x7e, 0x1f, 0x5c, 0xd9, 0x3f,
+		0xe3, 0x95, 0x9d, 0x0a, 0x92, 0x70, 0x4f, 0x4d, 0x19, 0x4e, 0x95, 0x15, 0x2c, 0x94, 0x20, 0x83,
+		0x9b, 0xf2, 0x2e, 0x73, 0x0e, 0xae, 0xe5, 0x9f, 0xff, 0xf2, 0xc3, 0x6c, 0xa2, 0x21, 0x12, 0x67,
+		0x4d, 0xa9, 0x32, 0x4a, 0xeb, 0xbd, 0xf4, 0xe0, 0xb0, 0xfd, 0x66, 0xff, 0x1e, 0xa2, 0xd4, 0x9f,
+		0xf4, 0x36, 0xd5, 0xd5, 0x4e, 0x11, 0x98, 0xc2, 0x6b, 0xe4, 0xe6, 0x05, 0xfa, 0x97, 0x6b, 0xa5,
+		0x28, 0x4d, 0x4c, 0xb0, 0x6d, 0x7b, 0x99, 0x29, 0x8b, 0xf1, 0x72, 0xfb, 0x57, 0xdf, 0xf5, 0x93,
+		0x51, 0x57, 0xa1, 0x2c, 0xac, 0x0c, 0x9f, 0xe8, 0x1b, 0x4f, 0xe3, 0x8b, 0x82, 0xc6, 0xe0, 0x01,
+		0xbd, 0x85, 0xa7, 0x70, 0x2f, 0x94, 0x93, 0x19, 0xe7, 0xbb, 0x09, 0x22, 0x0e, 0x06, 0x7a, 0x6e,
+		0x4c, 0x68, 0x27, 0xf1, 0xd3, 0xdf, 0x14, 0xf8, 0x4c, 0x87, 0xe1, 0x79, 0xd8, 0x85, 0x25, 0xc8,
+		0x36, 0x4d, 0xc3, 0x56, 0xcd, 0x4e, 0xcb, 0xd6, 0x3b, 0x5d, 0x7b, 0xac, 0xe7, 0xfb, 0x25, 0x46,
+		0x24, 0xa1, 0x64, 0x10, 0x6f, 0x4d, 0xa0, 0x61, 0x4b, 0xd8, 0x7b, 0x2f, 0x93, 0xb4, 0xe4, 0x7f,
+		0x3a, 0x2d, 0xa1, 0x38, 0xd8, 0x92, 0x3b, 0x92, 0x0e, 0xab, 0x79, 0x83, 0x9b, 0x3b, 0xfb, 0x16,
+		0x93, 0x02, 0x47, 0x3a, 0x3e, 0x11, 0x86, 0x53, 0x1c, 0x78, 0x4b, 0xb3, 0xf4, 0x0b, 0x7b, 0x17,
+		0xb7, 0x74, 0x5b, 0xbb, 0x78, 0xa1, 0x61, 0xb6, 0x84, 0xaf, 0x33, 0xcd, 0xca, 0xe7, 0xb1, 0x7c,
+		0x9e, 0x97, 0xcf, 0x06, 0x1e, 0x08, 0x98, 0x1d, 0x6d, 0x06, 0x67, 0x87, 0x65, 0xb0, 0xd0, 0x86,
+		0xc8, 0xa2, 0xd9, 0xa2, 0x77, 0xd5, 0x9a, 0xba, 0x61, 0x76, 0xb8, 0x41, 0x62, 0x09, 0x72, 0x0d,
+		0x62, 0x5a, 0xc7, 0xec, 0x1b, 0x36, 0x33, 0x46, 0xa5, 0x87, 0x7f, 0xfb, 0xb5, 0xd3, 0x47, 0xfe,
+		0xf0, 0xb5, 0xd3, 0x47, 0x19, 0x59, 0xab, 0x79, 0x63, 0xbe, 0x65, 0x5e, 0xe8, 0x68, 0xf6, 0x2e,
+		0xaa, 0x80, 0xcf, 0x7c, 0xea, 0x21, 0xe0, 0xf5, 0x55, 0x0d, 0xfb, 0x93, 0xaf, 0xff, 0xc2, 0x79,
+		0x49, 0xe1, 0xf8, 0x0b, 0x91, 0x37, 0x3e, 0x7e, 0x5a, 0x2a, 0x74, 0x21, 0x5e, 0xd6, 0x1b, 0x07,
+		0x54, 0x58, 0x1d, 0xa8, 0xf0, 0x22, 0xaf, 0xf0, 0xe4, 0x70, 0x85, 0xec, 0x0e, 0x4c, 0x59, 0x6f,
+		0x78, 0xaa, 0x2d, 0xeb, 0x0d, 0x7f, 0x8d, 0xa5, 0xf2, 0x1f, 0xfc, 0xf7, 0x53, 0x47, 0xde, 0xff,
+		0xd9, 0x53, 0x47, 0x46, 0x0e, 0x59, 0x61, 0xfc, 0x90, 0x39, 0x23, 0xf5, 0x53, 0x11, 0x1c, 0xa9,
+		0x8e, 0x6e, 0x6f, 0x6d, 0xdb, 0x17, 0x1a, 0xbd, 0xfd, 0xae, 0x6d, 0x5e, 0xd8, 0xbb, 0x88, 0x33,
+		0xd7, 0xdc, 0xe6, 0x23, 0x45, 0x44, 0xf9, 0x3c, 0x2b, 0x9f, 0xdf, 0x1b, 0x31, 0x50, 0x85, 0x6d,
+		0x88, 0xae, 0x23, 0x22, 0xb2, 0xc2, 0x36, 0x6d, 0xad, 0xcd, 0x3d, 0x32, 0x96, 0xc0, 0x5c, 0xf6,
+		0x1e, 0x51, 0x88, 0xe5, 0xb6, 0xc4, 0x53, 0x44, 0x6d, 0x5d, 0xdb, 0x66, 0xcf, 0x3a, 0x84, 0xa9,
+		0x2b, 0x9f, 0xc0, 0x0c, 0xfa, 0x82, 0xc3, 0x0c, 0x44, 0xb5, 0x3e, 0x3b, 0x7e, 0x15, 0x46, 0x1f,
+		0x9f, 0x26, 0x0a, 0xcb, 0x10, 0xe7, 0x67, 0x0b, 0xf0, 0xfc, 0xd1, 0x0d, 0x7d, 0x9f, 0xd6, 0x93,
+		0x56, 0xf0, 0x27, 0xb9, 0x00, 0x51, 0xda, 0x7a, 0x7e, 0x9b, 0xe6, 0xc4, 0xfc, 0x70, 0xf3, 0xe7,
+		0x69, 0x2b, 0x15, 0x06, 0x57, 0xb8, 0x0e, 0x89, 0xb2, 0x89, 0x02, 0xe4, 0x27, 0x97, 0x64, 0xe4,
+		0x68, 0xa3, 0xbb, 0x7d, 0x5b, 0x5c, 0x79, 0xa4, 0x09, 0xbc, 0x40, 0xcc, 0xde, 0xf9, 0xe0, 0x67,
+		0xc8, 0x78, 0xaa, 0xb0, 0x08, 0x71, 0x4a, 0x7b, 0xad, 0xeb, 0x3c, 0x9e, 0x25, 0x79, 0x1e, 0xcf,
+		0xe2, 0xe4, 0x43, 0x6e, 0x6b, 0x09, 0x44, 0x9a, 0x9a, 0xad, 0xf1, 0x8e, 0xd3, 0xdf, 0x85, 0x67,
+		0x21, 0xc1, 0x89, 0x58, 0xe4, 0x11, 0x08, 0x9b, 0x5d, 0x71, 0x44, 0xf2, 0xe4, 0xc8, 0xbe, 0xac,
+		0x75, 0x4b, 0x11, 0x14, 0x2c, 0x05, 0xa1, 0x4b, 0x2b, 0x23, 0x45, 0xe3, 0x11, 0x9f, 0x68, 0x88,
+		0x61, 0x17, 0x3f, 0xb4, 0x6e, 0xeb, 0xc2, 0xb0, 0x30, 0x38, 0xb2, 0xf2, 0x6a, 0x08, 0xee, 0x0e,
+		0x90, 0x95, 0x1b, 0xfa, 0xbe, 0x75, 0x68, 0x51, 0xd9, 0x83, 0xe4, 0x3a, 0x7d, 0x6e, 0xf3, 0x39,
+		0x7d, 0x9f, 0xcc, 0xe2, 0x79, 0xc8, 0x4b, 0x8f, 0x3d, 0x76, 0xf1, 0x0a, 0x1b, 0xc8, 0x6b, 0x47,
+		0x14, 0x91, 0x41, 0x4e, 0x41, 0xd2, 0xd2, 0x1b, 0xdd, 0x4b, 0x8f, 0x5d, 0xbe, 0x71, 0x91, 0x31,
+		0xee, 0xda, 0x11, 0xc5, 0xcd, 0x22, 0x77, 0x41, 0x62, 0xab, 0x6d, 0x5d, 0xbc, 0xf4, 0xc8, 0x13,
+		0x17, 0x19, 0x13, 0xaf, 0x1d, 0x51, 0x9c, 0x9c, 0x85, 0x04, 0x4e, 0x99, 0x37, 0x7e, 0xf4, 0xb4,
+		0x54, 0x8a, 0x42, 0xd8, 0xea, 0x77, 0xbe, 0x56, 0xac, 0xf9, 0x8d, 0x38, 0x9c, 0x71, 0x8a, 0x99,
+		0x52, 0xdc, 0xbb, 0x78, 0x81, 0x9f, 0x7b, 0x77, 0x9e, 0x50, 0x9d, 0x72, 0xd8, 0x43, 0x41, 0x90,
+		0x3b, 0x07, 0x33, 0x74, 0x04, 0xf3, 0x3e, 0x25, 0x41, 0x7a, 0x53, 0xd0, 0xc6, 0x5b, 0x10, 0x4f,
+		0x01, 0x38, 0x75, 0x09, 0x61, 0xb9, 0x6b, 0x7e, 0xa8, 0xb6, 0x79, 0x07, 0x49, 0xf1, 0xc0, 0x93,
+		0x27, 0x20, 0xd1, 0xed, 0x99, 0x5d, 0xd3, 0xe2, 0xaf, 0x02, 0x8d, 0xc3, 0x75, 0xa0, 0xf1, 0xd6,
+		0x09, 0x9d, 0xda, 0xea, 0x9e, 0x69, 0xe3, 0xd9, 0x91, 0xae, 0x79, 0x93, 0x3f, 0xb6, 0x16, 0x56,
+		0x64, 0x5a, 0xb2, 0x49, 0x0b, 0xd6, 0x31, 0xbf, 0xf0, 0xbf, 0x24, 0x48, 0x3a, 0x54, 0x70, 0xdd,
+		0xa6, 0x35, 0x9b, 0x3d, 0xdd, 0xb2, 0xf8, 0xec, 0x15, 0x49, 0xb2, 0x00, 0xf1, 0x6e, 0x7f, 0x4b,
+		0x15, 0x33, 0x05, 0xdf, 0x9c, 0x0a, 0x92, 0x7b, 0x21, 0x3e, 0xf4, 0x2a, 0x61, 0xac, 0xdb, 0xdf,
+		0x42, 0x51, 0x3a, 0x03, 0xe9, 0x80, 0xb6, 0xa4, 0xf6, 0xdc, 0x66, 0xd0, 0x27, 0x60, 0x79, 0x07,
+		0xd4, 0x6e, 0xaf, 0x65, 0xf6, 0x5a, 0xf6, 0x3e, 0x3d, 0xc0, 0x18, 0x56, 0x64, 0x51, 0xb0, 0xce,
+		0xf3, 0x49, 0x01, 0x32, 0xbc, 0x2d, 0xea, 0xd6, 0xbe, 0xcd, 0x4f, 0xc9, 0xa6, 0x95, 0x14, 0xab,
+		0xae, 0x84, 0x59, 0x64, 0x0e, 0xd2, 0x02, 0xc6, 0x79, 0x3b, 0x20, 0xa9, 0x00, 0x03, 0xc1, 0xe5,
+		0x49, 0xa1, 0x0d, 0xb9, 0x1a, 0x75, 0xe5, 0xdd, 0xee, 0x5f, 0x76, 0x3b, 0x29, 0x4d, 0xd0, 0xc9,
+		0x91, 0x1d, 0x0c, 0x0d, 0x75, 0xf0, 0xfc, 0x7f, 0x96, 0x20, 0x55, 0x6a, 0x9b, 0x8d, 0x1b, 0xd5,
+		0xf2, 0xd5, 0xb6, 0xb6, 0x43, 0x2e, 0xc2, 0xd1, 0xd2, 0xf2, 0xda, 0xe2, 0x73, 0x6a, 0xb5, 0xac,
+		0x5e, 0x5d, 0x2e, 0x7a, 0x4e, 0xac, 0xcf, 0x1e, 0x7b, 0xe5, 0xf6, 0x1c, 0xf1, 0xc0, 0x6e, 0x18,
+		0x74, 0xe9, 0x4a, 0x2e, 0xc0, 0x8c, 0x1f, 0xa5, 0x58, 0xaa, 0xe1, 0x3d, 0x29, 0x69, 0xf6, 0xe8,
+		0x2b, 0xb7, 0xe7, 0xa6, 0x3c, 0x18, 0xc5, 0x2d, 0x4b, 0x37, 0xec, 0x61, 0x84, 0xc5, 0xb5, 0x95,
+		0x15, 0xbc, 0x36, 0x30, 0x84, 0x80, 0xa7, 0x5f, 0x5b, 0x36, 0x79, 0x00, 0xa6, 0xfc, 0x08, 0xab,
+		0xd5, 0x65, 0x39, 0x3c, 0x4b, 0x5e, 0xb9, 0x3d, 0x97, 0xf5, 0x40, 0xaf, 0xb6, 0xda, 0xb3, 0x89,
+		0x0f, 0xfe, 0xf8, 0xa9, 0x23, 0x9f, 0xfc, 0x87, 0xa7, 0xa4, 0xd2, 0xf2, 0xc8, 0xd9, 0x7b, 0x69,
+		0xf2, 0xd9, 0x2b, 0xa6, 0xa7, 0x33, 0x79, 0x3f, 0x16, 0x82, 0xd3, 0x4e, 0xe9, 0x9e, 0xde, 0xc3,
+		0x3d, 0x03, 0x9c, 0x87, 0x4c, 0xf4, 0x1d, 0x77, 0x85, 0x0f, 0x0e, 0x07, 0x18, 0xad, 0xda, 0x9e,
+		0x86, 0x70, 0xb1, 0xdb, 0xc5, 0x47, 0xfc, 0x68, 0xba, 0x61, 0x32, 0x33, 0x18, 0x51, 0x9c, 0x34,
+		0x96, 0x59, 0xe6, 0xb6, 0x7d, 0x53, 0xeb, 0x39, 0x0f, 0xfc, 0x89, 0x74, 0xe1, 0x0a, 0x24, 0x17,
+		0x4d, 0xc3, 0xd2, 0x0d, 0xab, 0x4f, 0x43, 0x18, 0x5b, 0xc8, 0x0c, 0x4e, 0x81, 0x25, 0xd0, 0x8c,
+		0x68, 0xdd, 0x2e, 0xc5, 0x8c, 0x28, 0xf8, 0x93, 0xbb, 0x06, 0xab, 0x23, 0xd9, 0xf3, 0xe8, 0xe4,
+		0xec, 0x71, 0x19, 0xe0, 0x30, 0xe8, 0xfb, 0xee, 0xf6, 0x28, 0x7e, 0x47, 0xbb, 0x79, 0xd9, 0x13,
+		0xa0, 0xd9, 0xc6, 0xb8, 0x15, 0xb3, 0xe3, 0xf5, 0xe5, 0xec, 0xb8, 0x51, 0x19, 0xe1, 0x2f, 0x8e,
+		0x0b, 0x28, 0x15, 0xae, 0x40, 0x06, 0xaf, 0x9b, 0xd5, 0x74, 0xfb, 0x9a, 0xae, 0x35, 0xf5, 0x9e,
+		0xdf, 0x5f, 0xc9, 0x08, 0x7f, 0x85, 0x40, 0x84, 0x3a, 0x25, 0xcc, 0x5c, 0xd3, 0xdf, 0x85, 0x16,
+		0x44, 0x10, 0xd5, 0xf5, 0x65, 0x38, 0x06, 0x4d, 0xd0, 0xe1, 0xa2, 0x5a, 0x82, 0xa1, 0xb0, 0x04,
+		0x79, 0x4c, 0x78, 0x24, 0xe1, 0x31, 0x1e, 0x09, 0xb7, 0xe1, 0xdc, 0x2f, 0xe9, 0x40, 0x9c, 0x4f,
+		0x04, 0xa7, 0x25, 0x92, 0xdb, 0x12, 0xb2, 0x0a, 0xb9, 0xae, 0xd6, 0xb3, 0xe9, 0xc3, 0x37, 0xbb,
+		0xb4, 0x1b, 0x5c, 0x5b, 0xce, 0x05, 0x28, 0x6f, 0x5f, 0x77, 0x79, 0x35, 0x99, 0xae, 0x37, 0xb3,
+		0xf0, 0x46, 0x04, 0x62, 0x9c, 0x1d, 0xcf, 0x40, 0x9c, 0x33, 0x9c, 0xeb, 0xa6, 0x53, 0xf3, 0x01,
+		0xe2, 0x3f, 0xef, 0x88, 0x29, 0x27, 0x28, 0x90, 0xc8, 0x7d, 0x90, 0x68, 0xec, 0x6a, 0x2d, 0x43,
+		0x6d, 0x35, 0xb9, 0xd7, 0x9b, 0xfa, 0xec, 0x6b, 0xa7, 0xe3, 0x8b, 0x98, 0x57, 0x2d, 0x2b, 0x71,
+		0x5a, 0x58, 0x6d, 0xa2, 0x17, 0xb5, 0xab, 0xb7, 0x76, 0x76, 0x6d, 0xae, 0xa6, 0x79, 0x0a, 0x1f,
+		0x11, 0xc7, 0x21, 0xe3, 0x77, 0x1b, 0x66, 0x87, 0x96, 0x33, 0x4e, 0x44, 0xae, 0x94, 0xc0, 0x8a,
+		0x3f, 0xf2, 0x5f, 0x4f, 0x4b, 0x0a, 0xc5, 0x20, 0x65, 0xc8, 0xb4, 0x35, 0xcb, 0x56, 0xe9, 0x3c,
+		0xc1, 0xea, 0xa3, 0x9c, 0xc4, 0x30, 0x4b, 0x38, 0x6f, 0x79, 0xdb, 0x53, 0x88, 0xc6, 0xb2, 0x9a,
+		0xf8, 0x1a, 0x14, 0xa5, 0xd2, 0xa0, 0xaa, 0x8a, 0x79, 0xa6, 0x31, 0xca, 0xfa, 0x2c, 0xe6, 0x33,
+		0x0d, 0x46, 0xfd, 0xd3, 0x93, 0x90, 0xa4, 0x0f, 0x3c, 0x51, 0x10, 0x76, 0x45, 0x32, 0x81, 0x19,
+		0xb4, 0xf0, 0x7e, 0xc8, 0xb9, 0x56, 0x96, 0x81, 0x24, 0x18, 0x15, 0x37, 0x9b, 0x02, 0x3e, 0x0c,
+		0x33, 0x86, 0x7e, 0xcb, 0x56, 0x07, 0xa1, 0x93, 0x14, 0x9a, 0x60, 0xd9, 0xa6, 0x1f, 0xe3, 0x5e,
+		0xc8, 0x36, 0x04, 0xf7, 0x19, 0x2c, 0x50, 0xd8, 0x8c, 0x93, 0x4b, 0xc1, 0x4e, 0x40, 0x42, 0xeb,
+		0x76, 0x19, 0x40, 0x8a, 0x1b, 0xd9, 0x6e, 0x97, 0x16, 0x9d, 0x87, 0x29, 0xda, 0xc7, 0x9e, 0x6e,
+		0xe1, 0xb5, 0x19, 0x06, 0x93, 0xa6, 0x30, 0x39, 0x2c, 0x50, 0x58, 0x3e, 0x85, 0x3d, 0x0b, 0x19,
+		0x7d, 0x0f, 0xef, 0xa7, 0x36, 0x74, 0x06, 0x97, 0xa1, 0x70, 0x69, 0x91, 0x49, 0x81, 0x1e, 0x00,
+		0xc7, 0x7a, 0xaa, 0xc2, 0xb0, 0x67, 0x19, 0x3d, 0x91, 0x5f, 0x64, 0xd9, 0x85, 0x3c, 0x44, 0xca,
+		0x9a, 0xad, 0xa1, 0x1e, 0xb3, 0x6f, 0x31, 0x7f, 0x25, 0xad, 0xe0, 0xcf, 0xc2, 0xaf, 0x87, 0x21,
+		0xb2, 0x69, 0xda, 0xf8, 0x82, 0x56, 0xc4, 0xf3, 0xc6, 0x4f, 0x90, 0x48, 0xd7, 0x5a, 0x3b, 0x86,
+		0xde, 0x5c, 0xb1, 0x76, 0x3c, 0xaf, 0xaa, 0xba, 0x02, 0x15, 0xf2, 0x09, 0xd4, 0x0c, 0x44, 0x7b,
+		0x66, 0xdf, 0x68, 0x8a, 0xbb, 0x0b, 0x34, 0x41, 0xae, 0x42, 0xc2, 0x91, 0x93, 0xc8, 0x58, 0x39,
+		0xc9, 0xa1, 0x9c, 0xa0, 0x18, 0xf3, 0x0c, 0x25, 0xbe, 0xc5, 0xc5, 0xa5, 0x04, 0x49, 0x47, 0xc3,
+		0xe4, 0xa3, 0x87, 0x90, 0x59, 0x17, 0x0d, 0x9d, 0x12, 0x67, 0xf4, 0x1d, 0xf6, 0x31, 0x99, 0x93,
+		0x9d, 0x02, 0xce, 0x3f, 0x9f, 0x60, 0xf1, 0x27, 0x5e, 0xe3, 0xb4, 0x63, 0xae, 0x60, 0xb1, 0x67,
+		0x5e, 0xef, 0xc2, 0x33, 0x8e, 0x3b, 0x06, 0xbd, 0x98, 0xc3, 0x65, 0xcf, 0xcd, 0xc0, 0x52, 0xf7,
+		0xaa, 0x2d, 0x93, 0x35, 0x37, 0x03, 0x1f, 0x4c, 0x71, 0x12, 0xaa, 0x4b, 0x85, 0xc9, 0x19, 0x71,
+		0x8a, 0x6a, 0xa2, 0xa4, 0xf0, 0x2f, 0x25, 0x88, 0x71, 0xe3, 0xee, 0x8e, 0x83, 0x14, 0x3c, 0x0e,
+		0xa1, 0x51, 0xe3, 0x10, 0x7e, 0x4b, 0xe3, 0x00, 0x4e, 0x3b, 0x2d, 0xfe, 0x92, 0x67, 0x90, 0x27,
+		0xcb, 0x1a, 0x59, 0x6b, 0xed, 0xf0, 0xb9, 0xef, 0xc1, 0x2a, 0xbc, 0x26, 0x41, 0xd2, 0x29, 0x27,
+		0x25, 0xc8, 0x88, 0x96, 0xa9, 0xdb, 0x6d, 0x6d, 0x87, 0x8b, 0xe3, 0xa9, 0xd1, 0xcd, 0x43, 0x9f,
+		0x45, 0x49, 0xf1, 0x16, 0x61, 0x22, 0x78, 0x64, 0x43, 0x23, 0x46, 0xd6, 0x27, 0x4a, 0xe1, 0x3b,
+		0x13, 0x25, 0xdf, 0xa0, 0x47, 0x06, 0x06, 0xbd, 0xf0, 0x39, 0x89, 0xbf, 0x22, 0xde, 0xd4, 0x9b,
+		0xff, 0x57, 0x47, 0xeb, 0xeb, 0xb9, 0x7c, 0x61, 0x48, 0x73, 0x68, 0xd8, 0xee, 0x09, 0x20, 0xe9,
+		0x6f, 0xb5, 0x3b, 0x7c, 0x44, 0x90, 0xa9, 0xb9, 0xc3, 0xf8, 0x2b, 0x21, 0x98, 0x1a, 0x82, 0xff,
+		0x1b, 0x38, 0x9c, 0xfe, 0x39, 0x1c, 0x9d, 0x70, 0x0e, 0xc7, 0x46, 0xce, 0xe1, 0x5f, 0x09, 0xd1,
+		0xd8, 0x43, 0xd7, 0xb4, 0xb4, 0xf6, 0x5f, 0x8b, 0x0e, 0x3e, 0x09, 0xc9, 0xae, 0xd9, 0x56, 0x59,
+		0x09, 0xbb, 0x45, 0x96, 0xe8, 0x9a, 0x6d, 0x65, 0x48, 0xd4, 0xa2, 0x6f, 0x97, 0x82, 0x8e, 0xbd,
+		0x0d, 0xc3, 0x10, 0x1f, 0x9c, 0x55, 0x36, 0xa4, 0x19, 0x2f, 0xb8, 0x07, 0x75, 0x11, 0x99, 0x80,
+		0xbf, 0xf2, 0xd2, 0xa0, 0xcf, 0xe7, 0xb4, 0x9b, 0x81, 0x2a, 0xb1, 0x5d, 0x07, 0x85, 0xf9, 0x1b,
+		0xf9, 0xd0, 0x48, 0x14, 0x26, 0xca, 0x0a, 0x07, 0x2c, 0xbc, 0x2a, 0x01, 0x2c, 0x23, 0x73, 0x69,
+		0x8f, 0xd1, 0xf9, 0xb1, 0x68, 0x23, 0x54, 0x5f, 0xdd, 0xa7, 0x47, 0x0e, 0x1c, 0x6f, 0x41, 0xda,
+		0xf2, 0x36, 0xbd, 0x0c, 0x19, 0x57, 0xc0, 0x2d, 0x5d, 0x34, 0xe7, 0xf4, 0x41, 0x21, 0x01, 0xbc,
+		0x1b, 0x9a, 0xde, 0xf3, 0xa4, 0x0a, 0xff, 0x4a, 0x82, 0x24, 0x6d, 0x15, 0x3e, 0x73, 0xe9, 0x1b,
+		0x48, 0xe9, 0x2d, 0x0c, 0xe4, 0xdd, 0x00, 0x8c, 0x0e, 0x1e, 0x34, 0xe2, 0xf2, 0x95, 0xa4, 0x39,
+		0x78, 0x7c, 0x88, 0x3c, 0xee, 0x70, 0x3d, 0x3c, 0x86, 0xeb, 0x5c, 0x75, 0x08, 0xde, 0x1f, 0x87,
+		0x38, 0x7d, 0xb0, 0xe1, 0x96, 0xc5, 0x03, 0x01, 0xf8, 0x2c, 0x72, 0xfd, 0x96, 0x55, 0xb8, 0x01,
+		0xf1, 0xfa, 0x2d, 0x16, 0xd3, 0x3c, 0x09, 0xc9, 0x9e, 0x69, 0x72, 0x6f, 0x90, 0x39, 0xe2, 0x09,
+		0xcc, 0xa0, 0xce, 0x8f, 0x08, 0xe3, 0x85, 0xdc, 0x30, 0x9e, 0x1b, 0x88, 0x0c, 0x4f, 0x16, 0x88,
+		0xc4, 0x75, 0x7b, 0xc6, 0x37, 0xa3, 0xc8, 0xbb, 0xe0, 0x78, 0xad, 0xba, 0xb4, 0x5a, 0x29, 0xab,
+		0x2b, 0xb5, 0xa5, 0x81, 0x7b, 0xf5, 0xb3, 0xb9, 0x57, 0x6e, 0xcf, 0xa5, 0xf8, 0x82, 0x7d, 0x14,
+		0xf4, 0xba, 0x52, 0xd9, 0x5c, 0xab, 0x57, 0x64, 0x89, 0x41, 0xaf, 0xf7, 0xf4, 0x3d, 0xd3, 0x66,
+		0x9f, 0x9b, 0x79, 0x18, 0x4e, 0x04, 0x40, 0x3b, 0xcb, 0xf6, 0xa9, 0x57, 0x6e, 0xcf, 0x65, 0xd6,
+		0x7b, 0x3a, 0x13, 0x35, 0x8a, 0x31, 0x0f, 0xf9, 0x61, 0x8c, 0xb5, 0xf5, 0xb5, 0x5a, 0x71, 0x59,
+		0x9e, 0x9b, 0x95, 0x5f, 0xb9, 0x3d, 0x97, 0x16, 0xba, 0x03, 0xe1, 0xbf, 0xf6, 0xeb, 0xf6, 0xc8,
+		0xf0, 0x89, 0x8a, 0x9b, 0x3d, 0xad, 0xdb, 0xd5, 0x7b, 0xd6, 0xa8, 0xa3, 0x03, 0x67, 0x21, 0x55,
+		0xf6, 0xbc, 0x72, 0xe2, 0x9c, 0x21, 0x91, 0xe8, 0x0b, 0x28, 0x2c, 0x51, 0x28, 0x00, 0x5c, 0x6d,
+		0x9b, 0x9a, 0x1d, 0x00, 0x13, 0xf2, 0xc0, 0x54, 0x0d, 0xfb, 0xf2, 0xa3, 0x01, 0x30, 0x61, 0x01,
+		0x73, 0x16, 0x52, 0x1b, 0xa3, 0x80, 0x22, 0x7e, 0x42, 0x8f, 0x5c, 0x0a, 0x80, 0x89, 0x0e, 0x10,
+		0x0a, 0x04, 0xca, 0x08, 0xa0, 0x33, 0x90, 0x2c, 0x99, 0x66, 0x3b, 0x00, 0x24, 0xe1, 0xa1, 0x53,
+		0xf3, 0x3c, 0xe0, 0xe2, 0x03, 0x4a, 0x7a, 0x1a, 0x44, 0xe3, 0x5a, 0x01, 0x30, 0xce, 0x29, 0x9b,
+		0x43, 0x1f, 0x2e, 0x79, 0x9e, 0x8f, 0xcb, 0x61, 0x0f, 0x97, 0x88, 0xf1, 0xbc, 0xb3, 0xc3, 0x25,
+		0x69, 0xcf, 0xe6, 0x86, 0x13, 0x65, 0xe8, 0x6a, 0x3d, 0xad, 0x73, 0x40, 0xe0, 0x22, 0x38, 0xa8,
+		0x30, 0xe6, 0xec, 0xce, 0xec, 0x18, 0x49, 0xc4, 0x95, 0x4d, 0xce, 0x59, 0x30, 0xaf, 0xd3, 0x26,
+		0x90, 0x47, 0xbd, 0xd1, 0x9d, 0xd4, 0x68, 0x3f, 0x84, 0x81, 0x8b, 0xe8, 0xcf, 0xd3, 0x90, 0x10,
+		0x0b, 0x2f, 0xae, 0x9b, 0xcf, 0x04, 0x79, 0x4b, 0x1c, 0x84, 0xe3, 0x3a, 0x28, 0xe4, 0xeb, 0x20,
+		0xe9, 0x68, 0x6a, 0xae, 0x9a, 0x0a, 0x07, 0xe9, 0x76, 0x4e, 0xc0, 0x45, 0xc2, 0xf8, 0xac, 0x08,
+		0x0f, 0x44, 0x46, 0x46, 0x1c, 0x36, 0x19, 0x04, 0xc7, 0x16, 0x08, 0xe4, 0x31, 0x88, 0x68, 0x5b,
+		0x8d, 0x56, 0x3e, 0x3a, 0x18, 0xf3, 0x74, 0x10, 0x8b, 0xa5, 0xc5, 0x2a, 0xc3, 0xa2, 0x81, 0x5d,
+		0x0a, 0x8e, 0x8d, 0xb6, 0xf6, 0x8d, 0xc6, 0x6e, 0xcf, 0x34, 0xf6, 0xf3, 0xb1, 0x91, 0x8d, 0xae,
+		0x09, 0x18, 0xd1, 0x68, 0x07, 0x09, 0x1b, 0xbd, 0xad, 0xbb, 0xd6, 0x3b, 0xb8, 0xd1, 0xfc, 0x65,
+		0x03, 0xd1, 0x68, 0x8e, 0x50, 0xa8, 0xf2, 0x78, 0x2a, 0x1f, 0x36, 0xfa, 0x84, 0xef, 0x2d, 0x1e,
+		0x0f, 0x66, 0x13, 0x3e, 0xd1, 0xd1, 0x6e, 0xb1, 0x60, 0xf0, 0x71, 0x88, 0x63, 0xe1, 0x0e, 0x7f,
+		0x4b, 0x2f, 0xac, 0xc4, 0x3a, 0xda, 0xad, 0x25, 0x0d, 0x1f, 0x4b, 0x08, 0xcb, 0x91, 0xc2, 0x4f,
+		0xa1, 0xfb, 0xed, 0x1b, 0x1a, 0xf2, 0x20, 0x10, 0xc4, 0xc0, 0x3d, 0x6d, 0x34, 0x42, 0x74, 0x90,
+		0x05, 0x5d, 0x7c, 0x87, 0xa2, 0xb8, 0xa3, 0xaf, 0xf6, 0x3b, 0xb4, 0x01, 0x16, 0xc1, 0x0d, 0x70,
+		0x0e, 0x2c, 0x04, 0xd0, 0xf1, 0x17, 0x86, 0xbe, 0x40, 0xc4, 0x01, 0x98, 0x43, 0xf3, 0x2a, 0x3a,
+		0x34, 0x59, 0x46, 0x4f, 0x94, 0xf8, 0xbb, 0x12, 0xf6, 0x77, 0xa5, 0xf0, 0x2c, 0xe4, 0x06, 0xa4,
+		0xc0, 0x1b, 0x0e, 0xa7, 0x4c, 0xa3, 0x6b, 0xf7, 0xa4, 0x08, 0x87, 0xd3, 0xd9, 0xb7, 0x90, 0xf8,
+		0x35, 0xdc, 0x73, 0xc1, 0xcd, 0xd1, 0x07, 0x21, 0xe3, 0x13, 0x03, 0x11, 0xb8, 0x94, 0xdc, 0xc0,
+		0xa5, 0x0b, 0xfc, 0x12, 0xa4, 0xd1, 0x94, 0xea, 0x4d, 0x0e, 0x7b, 0x1f, 0xe4, 0x98, 0xad, 0x1f,
+		0xe4, 0x35, 0xf3, 0xe1, 0x57, 0x04, 0xc3, 0x0b, 0x90, 0x71, 0xe1, 0x5c, 0xb6, 0xa7, 0x04, 0xd4,
+		0x92, 0x66, 0x15, 0xbe, 0x5f, 0x82, 0xdc, 0x80, 0x6c, 0x90, 0xa7, 0x21, 0xd9, 0xed, 0xe9, 0x8d,
+		0x96, 0x27, 0xcc, 0x75, 0x00, 0x0b, 0x23, 0x94, 0x7d, 0x2e, 0x06, 0xba, 0x49, 0xe2, 0x24, 0x42,
+		0x53, 0x6f, 0x6b, 0xfb, 0xf9, 0xd0, 0x64, 0x24, 0xc4, 0x87, 0xe2, 0xca, 0x88, 0x54, 0xf8, 0x2d,
+		0x09, 0x32, 0x3e, 0xa1, 0x23, 0x4d, 0xb8, 0x1b, 0x4d, 0xb4, 0xf7, 0xf4, 0x3b, 0x7f, 0x40, 0xdd,
+		0xb3, 0x46, 0x0b, 0x7a, 0xa6, 0xc3, 0x35, 0x34, 0xd4, 0xb9, 0x91, 0x94, 0x59, 0xa4, 0xe3, 0x1e,
+		0x82, 0x67, 0x2f, 0xad, 0x5f, 0x63, 0xce, 0xf8, 0x1a, 0x90, 0xee, 0x96, 0x3d, 0x48, 0x3a, 0x34,
+		0x29, 0x69, 0x19, 0x91, 0xbd, 0x04, 0x0b, 0x35, 0x00, 0x77, 0xe2, 0x92, 0xe2, 0x24, 0x9d, 0x08,
+		0x1f, 0xd4, 0xc2, 0x85, 0x50, 0x5e, 0x2a, 0xad, 0x7f, 0xf2, 0xb3, 0xa7, 0xa4, 0xaf, 0x89, 0xeb,
+		0xf0, 0xe5, 0x1a, 0xdc, 0xe5, 0x82, 0x6e, 0x35, 0x5a, 0x83, 0x01, 0x6d, 0x59, 0x94, 0xce, 0x63,
+		0xe9, 0x24, 0xf1, 0xec, 0x31, 0x96, 0x66, 0x92, 0x78, 0xf7, 0x1d, 0x86, 0xb3, 0xff, 0x43, 0x12,
+		0xe2, 0x8a, 0xfe, 0xde, 0xbe, 0x6e, 0xd9, 0xe4, 0x11, 0x88, 0xe8, 0x8d, 0x5d, 0x73, 0x78, 0x4f,
+		0x89, 0x77, 0x63, 0xbe, 0xd2, 0xd8, 0x35, 0x39, 0xf0, 0xb5, 0x23, 0x0a, 0x05, 0x26, 0x97, 0x21,
+		0xba, 0xdd, 0xee, 0xf3, 0x48, 0xb7, 0xcf, 0x0e, 0x09, 0xac, 0xab, 0x58, 0xec, 0xa2, 0x31, 0x70,
+		0xac, 0x8c, 0x7e, 0xa2, 0x2f, 0x3c, 0xaa, 0x32, 0xfa, 0x65, 0x3e, 0xb7, 0x32, 0x04, 0x26, 0x8b,
+		0x00, 0x2d, 0xa3, 0x65, 0xab, 0x34, 0x08, 0x9c, 0x8f, 0x0e, 0xea, 0x72, 0x17, 0xb5, 0x65, 0xd3,
+		0x80, 0xb1, 0x8b, 0x9f, 0x6c, 0x89, 0x3c, 0x6c, 0xf1, 0x7b, 0xfb, 0x7a, 0x4f, 0xd8, 0x82, 0x80,
+		0x16, 0xbf, 0x1b, 0x8b, 0x3d, 0x2d, 0xa6, 0xe0, 0x68, 0x3b, 0xd9, 0x57, 0x20, 0xec, 0x5b, 0xf9,
+		0xc4, 0xa0, 0x19, 0x10, 0xa8, 0xf4, 0x23, 0x10, 0xf5, 0x5b, 0x2e, 0x72, 0xbc, 0xc1, 0x72, 0xc8,
+		0x15, 0x67, 0x8d, 0x96, 0x1a, 0x5c, 0x14, 0x39, 0xc8, 0xb4, 0xdc, 0xc5, 0xe5, 0x08, 0x64, 0x0d,
+		0xb2, 0xed, 0x96, 0x65, 0xab, 0x96, 0xa1, 0x75, 0xad, 0x5d, 0xd3, 0x16, 0x0f, 0xb1, 0xdf, 0x37,
+		0x4c, 0x62, 0xb9, 0x65, 0xd9, 0x35, 0x01, 0xe6, 0x52, 0xca, 0xb4, 0xbd, 0xf9, 0x48, 0xd0, 0xdc,
+		0xde, 0xd6, 0x7b, 0x0e, 0xc5, 0x7c, 0x66, 0x14, 0xc1, 0x35, 0x84, 0x13, 0x98, 0x1e, 0x82, 0xa6,
+		0x37, 0x9f, 0x7c, 0x03, 0x4c, 0xb7, 0x4d, 0xad, 0xe9, 0xd0, 0x53, 0x1b, 0xbb, 0x7d, 0xe3, 0x06,
+		0x8d, 0xe1, 0xa6, 0x2e, 0x9d, 0x0f, 0x68, 0xa6, 0xa9, 0x35, 0x05, 0xf2, 0x22, 0x82, 0xba, 0x94,
+		0xa7, 0xda, 0x83, 0x65, 0x44, 0x85, 0x19, 0xad, 0xdb, 0x6d, 0xef, 0x0f, 0x92, 0xcf, 0x51, 0xf2,
+		0x0f, 0x0e, 0x93, 0x2f, 0x22, 0xf4, 0x08, 0xfa, 0x44, 0x1b, 0x2a, 0x24, 0x1b, 0x18, 0x7f, 0xd6,
+		0xe9, 0xd5, 0xd7, 0x2e, 0x5f, 0x85, 0xd0, 0x97, 0xce, 0xf1, 0xad, 0xa1, 0x21, 0xe2, 0xeb, 0x0c,
+		0x52, 0x2c, 0x57, 0x5c, 0xca, 0xb9, 0xae, 0xbf, 0x84, 0x91, 0x35, 0x1b, 0x3a, 0xfd, 0x2e, 0x04,
+		0x27, 0x3b, 0x35, 0x9a, 0x2c, 0x85, 0x0c, 0x24, 0xeb, 0x2b, 0x21, 0x57, 0x21, 0xc5, 0xc2, 0x56,
+		0xb8, 0x75, 0xae, 0xd3, 0x0f, 0x6e, 0xa4, 0x2e, 0x9d, 0x0d, 0x98, 0xae, 0x14, 0x08, 0x43, 0xe2,
+		0x2e, 0x31, 0xd0, 0x9d, 0x4c, 0xb2, 0x05, 0x47, 0xe9, 0x6b, 0xf1, 0xfb, 0xaa, 0x5f, 0xe1, 0xe6,
+		0xa7, 0xf9, 0x0b, 0xaf, 0x43, 0x14, 0xe9, 0xf7, 0xd7, 0xf6, 0x37, 0xbd, 0x9a, 0xd7, 0x25, 0x3d,
+		0xbd, 0x37, 0x5c, 0x8a, 0x92, 0xb6, 0xdd, 0x32, 0xb4, 0x36, 0x7e, 0x0a, 0x85, 0xf9, 0xab, 0x33,
+		0xa3, 0x24, 0xed, 0x2a, 0x87, 0xa3, 0xde, 0x8a, 0x47, 0xd2, 0xb6, 0xbd, 0xf9, 0xa5, 0x38, 0x5f,
+		0x53, 0x38, 0xef, 0x28, 0xc7, 0xe5, 0x04, 0x7b, 0xed, 0xff, 0x7a, 0x24, 0x01, 0x72, 0xaa, 0x70,
+		0x3f, 0xa4, 0x3c, 0x7a, 0x0a, 0x8f, 0x0a, 0x70, 0xab, 0x29, 0xee, 0x00, 0xf0, 0x64, 0x21, 0x0b,
+		0x69, 0xaf, 0x6a, 0x2a, 0x7c, 0x44, 0x82, 0x94, 0x47, 0xe9, 0x20, 0xa6, 0x77, 0x27, 0x2b, 0xe9,
+		0x3a, 0xa2, 0x67, 0x85, 0xdb, 0x20, 0xca, 0xd9, 0x6e, 0x6a, 0x9a, 0x66, 0x72, 0xaf, 0x05, 0x1f,
+		0x45, 0xea, 0x5e, 0xea, 0x3a, 0x20, 0x61, 0x0a, 0x02, 0xdd, 0x4b, 0x5d, 0x01, 0x70, 0x06, 0xd2,
+		0xd8, 0x75, 0xd5, 0xeb, 0x0f, 0x27, 0x95, 0x14, 0xe6, 0x71, 0x90, 0xc2, 0xef, 0x85, 0x40, 0x1e,
+		0x54, 0x66, 0xce, 0x0e, 0x97, 0x74, 0xe8, 0x1d, 0xae, 0x13, 0x83, 0x7b, 0x6b, 0xee, 0x76, 0xda,
+		0x0a, 0xc8, 0xee, 0xa6, 0x10, 0xb3, 0x3d, 0x07, 0x38, 0xf8, 0x03, 0x8b, 0x11, 0x25, 0xd7, 0xf0,
+		0x67, 0x90, 0x25, 0xdf, 0xa1, 0x92, 0x88, 0x73, 0x4a, 0x76, 0x50, 0x9e, 0x04, 0xcc, 0x46, 0xb7,
+		0xa9, 0xd9, 0xba, 0x88, 0xa9, 0xbb, 0xa8, 0xe8, 0xc9, 0xe1, 0x2e, 0x94, 0x65, 0x6b, 0xb6, 0xee,
+		0x3b, 0x45, 0x91, 0xd1, 0xba, 0x5d, 0xfa, 0x09, 0x40, 0xe6, 0xc9, 0xdd, 0x0b, 0x59, 0xd4, 0xf0,
+		0x2d, 0xad, 0x2d, 0xdc, 0x84, 0x18, 0x73, 0xf8, 0x78, 0x2e, 0x77, 0x35, 0x9a, 0x90, 0xf6, 0x2a,
+		0x77, 0x27, 0xf6, 0x22, 0x79, 0x62, 0x2f, 0x84, 0xbf, 0x72, 0xc5, 0x38, 0x24, 0x5e, 0x06, 0x0b,
+		0xde, 0x6d, 0x9c, 0xa1, 0x71, 0x9a, 0x3d, 0x16, 0x5c, 0x4d, 0x28, 0x2c, 0x51, 0x78, 0x11, 0xb2,
+		0x7e, 0x3b, 0x40, 0xb2, 0x10, 0xb2, 0x6f, 0xf1, 0x5a, 0x42, 0xf6, 0x2d, 0x72, 0xd1, 0xf3, 0x75,
+		0xc4, 0x6c, 0x90, 0xf5, 0xe3, 0xf8, 0x6e, 0x6c, 0x94, 0x3d, 0x95, 0x56, 0xc8, 0x41, 0xc6, 0x67,
+		0x25, 0x0a, 0xc7, 0x60, 0x26, 0x48, 0xe7, 0x17, 0x5a, 0x30, 0x13, 0xa4, 0xba, 0xc9, 0x65, 0x48,
+		0x38, 0x4a, 0x7f, 0x28, 0x9c, 0x26, 0x6a, 0x77, 0x90, 0x1c, 0x58, 0xdf, 0x76, 0x60, 0xc8, 0xb7,
+		0x1d, 0x58, 0x78, 0x0f, 0xe4, 0x47, 0xe9, 0xf3, 0x81, 0xfd, 0x81, 0x88, 0xc3, 0xb8, 0x63, 0x10,
+		0xe3, 0x0f, 0x5b, 0x86, 0x68, 0x1c, 0x82, 0xa7, 0x90, 0xa1, 0x4c, 0xb7, 0x87, 0x69, 0x36, 0x4b,
+		0x14, 0x54, 0x38, 0x31, 0x52, 0xa5, 0x8f, 0xde, 0x4e, 0x67, 0x84, 0xf8, 0x76, 0x3a, 0x4d, 0x60,
+		0xb5, 0x16, 0x06, 0xf6, 0x7b, 0xe2, 0xec, 0x1d, 0x4b, 0xe1, 0xb3, 0xbd, 0xc7, 0x82, 0xf5, 0x3a,
+		0x9e, 0xd0, 0xc1, 0xd5, 0x81, 0xed, 0x5f, 0x48, 0x40, 0x47, 0xbb, 0x55, 0xe7, 0xab, 0x08, 0xbe,
+		0x15, 0x19, 0x72, 0xb6, 0x22, 0xc9, 0x26, 0x4c, 0xb5, 0xcd, 0x86, 0xd6, 0x56, 0x3d, 0x5b, 0xc1,
+		0x7c, 0x3a, 0xdd, 0x33, 0x4a, 0x4f, 0x8b, 0xcd, 0x06, 0x54, 0x41, 0x7c, 0x22, 0xe4, 0x28, 0x91,
+		0x65, 0x67, 0xdb, 0x98, 0x54, 0x20, 0xd5, 0x69, 0x59, 0x5b, 0xfa, 0xae, 0xb6, 0xd7, 0x32, 0x7b,
+		0x7c, 0x5e, 0x05, 0x48, 0xcf, 0x8a, 0x0b, 0x24, 0xf6, 0xa8, 0x3d, 0x78, 0x9e, 0x41, 0x89, 0x06,
+		0xee, 0x9d, 0xc7, 0x0e, 0xad, 0x59, 0x46, 0xed, 0x42, 0xc7, 0x47, 0xee, 0x42, 0x07, 0x6d, 0xf9,
+		0x26, 0x82, 0xb7, 0x7c, 0x5f, 0xa1, 0x83, 0x13, 0x64, 0x1d, 0x87, 0x77, 0x81, 0x49, 0x1d, 0x66,
+		0x38, 0x7e, 0xd3, 0xc7, 0xfd, 0xa1, 0xc3, 0x69, 0x7e, 0xa7, 0xcb, 0xc3, 0x75, 0x22, 0xf0, 0x47,
+		0x33, 0x3e, 0x7c, 0x87, 0x8c, 0x17, 0x67, 0x31, 0x22, 0x9e, 0xb3, 0x18, 0xff, 0x8f, 0x0d, 0xc6,
+		0xb7, 0x87, 0xc5, 0xee, 0x98, 0xc7, 0xb1, 0x08, 0x3c, 0x64, 0x32, 0x6a, 0x33, 0x47, 0x74, 0x2c,
+		0x7c, 0xe8, 0x8e, 0xf1, 0xd1, 0x8e, 0x8c, 0x1f, 0xed, 0xe8, 0xdb, 0x39, 0xda, 0xb1, 0x3b, 0x1c,
+		0xed, 0xaf, 0xe9, 0x38, 0xfc, 0xb0, 0x04, 0xb3, 0xa3, 0xdd, 0xb1, 0xc0, 0x01, 0x39, 0xd4, 0xf6,
+		0xe3, 0x28, 0x8b, 0x77, 0x2f, 0x64, 0x07, 0xbc, 0x45, 0x26, 0xcc, 0x19, 0xdf, 0x7a, 0xbc, 0xf0,
+		0x4b, 0x61, 0xfc, 0xd6, 0xee, 0xb0, 0x43, 0x17, 0x30, 0x63, 0x15, 0xfc, 0xba, 0x4a, 0xa3, 0xd5,
+		0xbc, 0xe3, 0x09, 0x3b, 0xc5, 0xd1, 0xff, 0xff, 0x7c, 0x1d, 0x96, 0x13, 0x3c, 0xab, 0x83, 0x81,
+		0x4c, 0x3c, 0xf4, 0x69, 0x9b, 0xc2, 0x37, 0x4a, 0xb2, 0x00, 0x21, 0x2f, 0xa8, 0x9b, 0xdc, 0x3b,
+		0xfa, 0x09, 0x80, 0x84, 0xa2, 0x5b, 0x5d, 0x74, 0xe6, 0xd8, 0x37, 0xe5, 0x1b, 0x7a, 0xd7, 0x76,
+		0x63, 0x5c, 0x81, 0x6b, 0x0c, 0x0e, 0x22, 0xf0, 0x70, 0xad, 0xed, 0xe0, 0xe1, 0x36, 0x30, 0x0d,
+		0x29, 0x8c, 0x0c, 0x0e, 0x30, 0x57, 0xdd, 0x41, 0xa5, 0xd0, 0xe4, 0x71, 0x11, 0x53, 0x08, 0x8f,
+		0x5a, 0x29, 0x73, 0xc7, 0xdd, 0xc1, 0x63, 0xf0, 0x58, 0x1d, 0x0d, 0x2a, 0x44, 0x46, 0x55, 0xc7,
+		0xfc, 0x7b, 0xb7, 0xba, 0x16, 0x7b, 0x61, 0xd6, 0x1b, 0x55, 0x88, 0x8d, 0xea, 0xaa, 0xc7, 0x11,
+		0x77, 0xbb, 0xea, 0x86, 0x15, 0x1e, 0x17, 0x61, 0x85, 0xf8, 0xa8, 0x46, 0x73, 0xcf, 0xd3, 0x6d,
+		0x34, 0x85, 0x27, 0xcf, 0x78, 0xe2, 0x0a, 0xc9, 0xc1, 0x98, 0xfc, 0x50, 0x5c, 0xc1, 0xc1, 0x76,
+		0x02, 0x0b, 0x0b, 0x4e, 0x60, 0x21, 0x3d, 0x32, 0x2a, 0xc1, 0x5d, 0x46, 0x07, 0x99, 0x63, 0x90,
+		0xf5, 0xa1, 0xc8, 0x02, 0x0b, 0x04, 0xdc, 0x3f, 0x36, 0xb2, 0xe0, 0x90, 0x1a, 0x08, 0x2d, 0xac,
+		0x0f, 0x85, 0x16, 0xb2, 0xa3, 0x28, 0x0e, 0xf8, 0xa7, 0x2e, 0x45, 0x7f, 0x6c, 0xe1, 0x1b, 0x83,
+		0x63, 0x0b, 0x23, 0x17, 0xff, 0x01, 0xbe, 0xa8, 0x43, 0x3a, 0x20, 0xb8, 0xf0, 0x9e, 0x11, 0xc1,
+		0x05, 0x79, 0xd4, 0x22, 0x38, 0xc8, 0x13, 0x75, 0x2a, 0x08, 0x8a, 0x2e, 0x6c, 0x06, 0x44, 0x17,
+		0x58, 0x18, 0xe0, 0x81, 0x09, 0xa2, 0x0b, 0x0e, 0xe9, 0xa1, 0xf0, 0xc2, 0x66, 0x40, 0x78, 0x81,
+		0x8c, 0xa6, 0x3b, 0xe0, 0x40, 0x79, 0xe9, 0xfa, 0x8a, 0xc8, 0x92, 0x3f, 0xbe, 0x30, 0x7d, 0xb0,
+		0xdf, 0xca, 0xdc, 0x00, 0x87, 0x9a, 0x37, 0xc0, 0xd0, 0x18, 0x15, 0x60, 0x60, 0x31, 0x80, 0x87,
+		0x26, 0x0c, 0x30, 0x38, 0xb4, 0x03, 0x23, 0x0c, 0xeb, 0x43, 0x11, 0x86, 0xa3, 0xa3, 0x04, 0x6e,
+		0xc0, 0x20, 0xb9, 0x02, 0x37, 0x32, 0xc4, 0xc0, 0x3e, 0x2b, 0xc8, 0x3e, 0x28, 0x08, 0x72, 0xea,
+		0x7a, 0x24, 0x91, 0x92, 0xd3, 0x85, 0x07, 0xd0, 0x05, 0x1a, 0xd0, 0x7b, 0xb8, 0xe0, 0xd0, 0x7b,
+		0x3d, 0xb3, 0x27, 0x36, 0x44, 0x69, 0xa2, 0x70, 0x0e, 0xd2, 0x5e, 0x15, 0x77, 0x40, 0x38, 0x22,
+		0x07, 0x19, 0x9f, 0x56, 0x2b, 0xfc, 0x9a, 0x04, 0x69, 0xaf, 0xbe, 0xf2, 0x2d, 0x56, 0x93, 0x7c,
+		0xb1, 0xea, 0x09, 0x52, 0x84, 0xfc, 0x41, 0x0a, 0xfc, 0x38, 0x53, 0x77, 0x28, 0xfe, 0xa0, 0x75,
+		0x9d, 0xf8, 0x83, 0x38, 0xc5, 0xc9, 0x42, 0x19, 0xdc, 0x32, 0xb0, 0x23, 0x0c, 0x39, 0xe7, 0x44,
+		0x2b, 0x8f, 0xf9, 0x3f, 0x04, 0xd3, 0x1e, 0x58, 0x67, 0x21, 0xc8, 0x96, 0xe2, 0xb2, 0x03, 0x5d,
+		0xe4, 0x2b, 0xc2, 0xdf, 0x92, 0x60, 0x6a, 0x48, 0x5d, 0x06, 0xc6, 0x18, 0xa4, 0xb7, 0x2b, 0xc6,
+		0x10, 0xba, 0xf3, 0x18, 0x83, 0x77, 0x69, 0x1b, 0xf6, 0x2f, 0x6d, 0xbf, 0x2c, 0x41, 0xc6, 0xa7,
+		0xb6, 0x71, 0x10, 0x1a, 0x66, 0x53, 0x6c, 0x9f, 0xd3, 0xdf, 0xe8, 0xd3, 0xb4, 0x4d, 0xf1, 0x3d,
+		0x38, 0xfc, 0x89, 0x50, 0x8e, 0x21, 0x4a, 0x72, 0x33, 0xe3, 0xac, 0x53, 0xa3, 0xde, 0x2b, 0x6c,
+		0xfc, 0x5a, 0x57, 0xcc, 0xbd, 0xd6, 0xe5, 0xec, 0x9a, 0xc7, 0x3d, 0xbb, 0xe6, 0xe4, 0x0a, 0x6e,
+		0x39, 0x99, 0xe6, 0xb6, 0x6a, 0x76, 0x99, 0xad, 0xf7, 0xf9, 0x46, 0x43, 0x57, 0xba, 0x2c, 0x7a,
+		0x63, 0x80, 0xfe, 0xf2, 0x78, 0x2c, 0x49, 0x9f, 0xc7, 0x72, 0x17, 0x24, 0xb1, 0xf9, 0xec, 0xe3,
+		0xb2, 0xc0, 0x5f, 0x36, 0x11, 0x19, 0x85, 0x9f, 0x09, 0x41, 0x6e, 0xc0, 0xea, 0x04, 0x76, 0x3e,
+		0xe8, 0xf8, 0xca, 0x64, 0x0c, 0x39, 0x05, 0xb0, 0xa3, 0x59, 0xea, 0x4d, 0xcd, 0xb0, 0xf5, 0x26,
+		0xe7, 0x8a, 0x27, 0x07, 0x6f, 0x3a, 0x60, 0xaa, 0x6f, 0xe9, 0x4d, 0x1e, 0xcd, 0x71, 0xd2, 0x78,
+		0x35, 0x52, 0xdf, 0xa3, 0xef, 0xa4, 0xb3, 0xef, 0x01, 0x1d, 0x0f, 0x50, 0x4f, 0x58, 0x5e, 0xca,
+		0xe3, 0x70, 0x7f, 0xfe, 0xb5, 0xd3, 0x32, 0x03, 0x7f, 0x97, 0x73, 0x5f, 0x56, 0xe1, 0x04, 0xfc,
+		0x6c, 0x48, 0x0c, 0xb0, 0x81, 0x86, 0x16, 0xd3, 0x22, 0x4e, 0x80, 0x4c, 0x65, 0x97, 0x7b, 0xf0,
+		0xba, 0x7e, 0xa7, 0x6b, 0x9a, 0x6d, 0x95, 0xcd, 0xf3, 0x22, 0x64, 0xfd, 0x46, 0x96, 0x7d, 0xc0,
+		0xdd, 0xc6, 0x68, 0x9b, 0xcf, 0x8f, 0x4e, 0xb3, 0x4c, 0x36, 0xaf, 0xbc, 0xaf, 0xe0, 0x17, 0xde,
+		0x0d, 0x47, 0x03, 0x6d, 0x2c, 0x79, 0x02, 0x92, 0xae, 0x7d, 0x66, 0x17, 0xb4, 0x0e, 0x8a, 0xd9,
+		0xb8, 0xc0, 0xf8, 0xa1, 0x8c, 0x40, 0x23, 0x4b, 0x9e, 0x86, 0x18, 0x3b, 0xbc, 0xcd, 0x0f, 0xe8,
+		0xdd, 0x3b, 0xde, 0x3a, 0xe3, 0x97, 0x12, 0x38, 0x52, 0xe1, 0x22, 0x9c, 0x18, 0x69, 0x65, 0xdd,
+		0xc8, 0x8b, 0xe4, 0x89, 0xbc, 0x14, 0x7e, 0x5e, 0x82, 0xd9, 0xd1, 0x96, 0x93, 0x94, 0x06, 0x1a,
+		0x74, 0x7e, 0x42, 0xbb, 0xeb, 0x69, 0x15, 0x2e, 0x4d, 0x7a, 0xfa, 0xb6, 0x6e, 0x37, 0x76, 0x99,
+		0x09, 0x67, 0x4a, 0x21, 0xa3, 0x64, 0x78, 0x2e, 0xc5, 0xb1, 0x18, 0xd8, 0xcb, 0x7a, 0xc3, 0x56,
+		0xd9, 0xa0, 0x5a, 0x74, 0x79, 0x90, 0x54, 0x32, 0x2c, 0xb7, 0xc6, 0x32, 0x0b, 0x0f, 0xc2, 0xf1,
+		0x11, 0xb6, 0x38, 0xe0, 0xec, 0xf9, 0x4b, 0x08, 0x1c, 0x68, 0x60, 0xc9, 0xb3, 0x10, 0xb3, 0x6c,
+		0xcd, 0xee, 0x5b, 0xbc, 0x67, 0xf7, 0x8f, 0xb5, 0xcd, 0x35, 0x0a, 0xae, 0x70, 0xb4, 0xc2, 0x93,
+		0x40, 0x86, 0x2d, 0x6d, 0xc0, 0x3a, 0x4c, 0x0a, 0x5a, 0x87, 0x6d, 0xc1, 0xc9, 0x03, 0x6c, 0x2a,
+		0x59, 0x1c, 0x68, 0xdc, 0x83, 0x13, 0x99, 0xe4, 0x81, 0x06, 0xfe, 0x79, 0x08, 0x8e, 0x06, 0x9a,
+		0x56, 0xcf, 0x2c, 0x95, 0xde, 0xea, 0x2c, 0x7d, 0x1a, 0xc0, 0xbe, 0x25, 0x6e, 0x1c, 0x70, 0x6d,
+		0x1f, 0xb4, 0x9e, 0xb8, 0xa5, 0x37, 0xea, 0xb7, 0xb8, 0x60, 0x24, 0x6d, 0xfe, 0x0b, 0x03, 0x05,
+		0x9e, 0xb5, 0x6f, 0x9f, 0x5a, 0x02, 0x2b, 0x1f, 0x3e, 0x9c, 0xcd, 0x90, 0xf7, 0xfc, 0xd9, 0x16,
+		0x79, 0x09, 0x8e, 0x0f, 0x58, 0x34, 0x87, 0x76, 0x64, 0x62, 0xc3, 0x76, 0xd4, 0x6f, 0xd8, 0x04,
+		0x6d, 0xaf, 0x55, 0x8a, 0xfa, 0xad, 0xd2, 0x4b, 0x00, 0xee, 0x22, 0xd8, 0x3d, 0x1c, 0x2b, 0x79,
+		0x0f, 0xc7, 0x5e, 0x86, 0x28, 0x4a, 0x82, 0x60, 0x55, 0x80, 0xc2, 0xc0, 0x21, 0xf5, 0xac, 0xa2,
+		0x19, 0x78, 0xe1, 0x65, 0x20, 0xc3, 0xf1, 0xc8, 0x11, 0x75, 0x3c, 0xe3, 0xaf, 0xa3, 0x30, 0x3a,
+		0xb4, 0x19, 0x5c, 0xd7, 0xdf, 0x81, 0x28, 0x1d, 0xfe, 0xc0, 0xfb, 0xce, 0xdf, 0x04, 0xa0, 0xd9,
+		0x76, 0xaf, 0xb5, 0xd5, 0x77, 0x6b, 0x98, 0x1b, 0x21, 0x3f, 0x45, 0x01, 0x58, 0xba, 0x8b, 0x0b,
+		0xd2, 0x8c, 0x8b, 0xeb, 0x11, 0x26, 0x0f, 0xc5, 0xc2, 0x2a, 0x64, 0xfd, 0xb8, 0xc1, 0x17, 0xb8,
+		0x87, 0xbf, 0x59, 0xeb, 0x1a, 0x72, 0xfe, 0x74, 0x1b, 0x4d, 0x14, 0xbe, 0x25, 0x04, 0x69, 0xaf,
+		0xf4, 0xfd, 0x2d, 0x34, 0x96, 0x85, 0xef, 0x90, 0x20, 0xe1, 0xf4, 0xff, 0x80, 0xab, 0x01, 0xee,
+		0x55, 0x7e, 0x27, 0x5e, 0xcf, 0x76, 0x48, 0xc2, 0xce, 0x0e, 0xc9, 0x53, 0x8e, 0x41, 0x18, 0xb9,
+		0x98, 0xf7, 0x72, 0x5b, 0x1c, 0xca, 0xe5, 0x06, 0xea, 0xc9, 0xc9, 0x6e, 0x0b, 0xe3, 0xf6, 0x8d,
+		0xe7, 0xaa, 0x2f, 0x4b, 0x14, 0x2c, 0xcf, 0xd9, 0x25, 0x36, 0x1b, 0x5d, 0xc0, 0x90, 0x07, 0x70,
+		0xf8, 0x82, 0x6f, 0x78, 0xfc, 0x05, 0xdf, 0xc8, 0xe0, 0x05, 0x5f, 0xe6, 0x03, 0x14, 0xfe, 0x81,
+		0x04, 0x09, 0x31, 0x4b, 0xc8, 0xb3, 0xde, 0x73, 0x76, 0xe2, 0xd0, 0xce, 0x68, 0x2d, 0xc5, 0x3b,
+		0xef, 0xe2, 0x0c, 0xdf, 0x56, 0x08, 0x1f, 0xfa, 0xb6, 0x02, 0xf7, 0x4a, 0xbe, 0x24, 0x81, 0x3c,
+		0x38, 0x8b, 0xdf, 0x7a, 0xfb, 0x86, 0xad, 0x57, 0x38, 0xc0, 0x7a, 0x8d, 0xba, 0x83, 0x10, 0x19,
+		0x75, 0x07, 0x61, 0xb8, 0xdf, 0xd1, 0x3b, 0xed, 0xf7, 0xb7, 0x87, 0x20, 0xe5, 0x89, 0x0e, 0xe2,
+		0xe1, 0x43, 0xcf, 0x85, 0x86, 0x33, 0x07, 0x86, 0x12, 0x3d, 0x37, 0x1a, 0x7c, 0x9c, 0x0a, 0xdd,
+		0x01, 0xa7, 0xde, 0xfe, 0x7b, 0x8e, 0xc1, 0x17, 0xef, 0xa3, 0x23, 0x2e, 0xde, 0x7f, 0xab, 0x04,
+		0x09, 0x27, 0x14, 0x73, 0xd8, 0xdd, 0xbc, 0x63, 0x10, 0xe3, 0x9e, 0x18, 0xdb, 0xce, 0xe3, 0xa9,
+		0xc0, 0xb8, 0xea, 0x2c, 0x24, 0x3a, 0xba, 0xad, 0x51, 0xf5, 0xc8, 0xec, 0x9d, 0x93, 0x3e, 0xbf,
+		0x05, 0x29, 0xcf, 0x86, 0x28, 0x39, 0x01, 0x47, 0x17, 0xaf, 0x55, 0x16, 0x9f, 0x53, 0xeb, 0x2f,
+		0x0c, 0x7e, 0x30, 0x6e, 0xa8, 0x48, 0xa9, 0xd0, 0x34, 0xfb, 0x7e, 0x8e, 0xbf, 0x88, 0x15, 0x84,
+		0x66, 0x23, 0x78, 0xd6, 0xfc, 0xfc, 0x97, 0x24, 0x98, 0x0e, 0xf0, 0x79, 0xc9, 0x19, 0xb8, 0x7b,
+		0xed, 0xea, 0xd5, 0x8a, 0xa2, 0xd6, 0x56, 0x8b, 0xeb, 0xb5, 0x6b, 0x6b, 0x75, 0x55, 0xa9, 0xd4,
+		0x36, 0x96, 0xbd, 0x1f, 0x5c, 0x9b, 0x83, 0xbb, 0x82, 0x41, 0x8a, 0x8b, 0x8b, 0x95, 0xf5, 0x3a,
+		0xfb, 0x94, 0xf5, 0x08, 0x88, 0x12, 0xfd, 0x20, 0xdb, 0x68, 0x12, 0x4a, 0xe5, 0x7a, 0x65, 0xb1,
+		0x2e, 0x87, 0xc9, 0xfd, 0x70, 0xf6, 0x20, 0x08, 0xfe, 0x19, 0x38, 0x39, 0x32, 0x16, 0xb0, 0x56,
+		0x59, 0x2d, 0x57, 0x14, 0x39, 0xca, 0xfb, 0xfd, 0xf1, 0x10, 0xe4, 0x47, 0xb9, 0xd6, 0x48, 0xab,
+		0xb8, 0xbe, 0xbe, 0xfc, 0xa2, 0x4b, 0x6b, 0xf1, 0xda, 0xc6, 0xea, 0x73, 0xc3, 0x2c, 0xb8, 0x0f,
+		0x0a, 0x07, 0x01, 0x3a, 0x8c, 0xb8, 0x17, 0xce, 0x1c, 0x08, 0xc7, 0xd9, 0x31, 0x06, 0x4c, 0xa9,
+		0xe0, 0x77, 0xb8, 0xc3, 0x64, 0x1e, 0xce, 0x8f, 0x05, 0x73, 0xca, 0xe4, 0x08, 0xb9, 0x00, 0x0f,
+		0x1e, 0x0c, 0xcf, 0x18, 0x24, 0x10, 0x04, 0x8b, 0x5e, 0x91, 0xe0, 0x68, 0xa0, 0x8f, 0x8e, 0x1f,
+		0x1c, 0x5c, 0x57, 0xd6, 0x16, 0x2b, 0xb5, 0x9a, 0x73, 0x9d, 0x41, 0xad, 0xd5, 0x8b, 0xf5, 0x8d,
+		0x9a, 0x87, 0x37, 0x05, 0x38, 0x35, 0x0a, 0xc8, 0xe1, 0xcb, 0x01, 0x30, 0x5c, 0x02, 0x84, 0x9c,
+		0xde, 0x96, 0xe0, 0xc4, 0x48, 0x9f, 0x9c, 0x9c, 0x83, 0x7b, 0xd8, 0x37, 0x09, 0x55, 0xbc, 0xbb,
+		0xe1, 0xf9, 0x66, 0xfa, 0x50, 0xab, 0xee, 0x87, 0xb3, 0x07, 0x42, 0x3a, 0x4d, 0x1b, 0x07, 0x38,
+		0xd0, 0xbe, 0x0f, 0x48, 0x90, 0x1b, 0xd0, 0x85, 0xf4, 0xd3, 0x87, 0xd5, 0x5a, 0xa9, 0x72, 0xad,
+		0xb8, 0x59, 0x5d, 0x53, 0x06, 0xe7, 0xec, 0x59, 0x38, 0x3d, 0x54, 0x5a, 0xde, 0xc0, 0x6f, 0x47,
+		0x16, 0xeb, 0x15, 0x95, 0xdd, 0x41, 0xc1, 0x8e, 0x0d, 0x01, 0x2d, 0x57, 0x97, 0xae, 0xd5, 0xd5,
+		0xc5, 0xe5, 0x6a, 0x65, 0xb5, 0xae, 0x16, 0xeb, 0xf5, 0xa2, 0x3b, 0x9d, 0x4b, 0xcf, 0x8d, 0x3c,
+		0xfb, 0x79, 0x71, 0xf2, 0xb3, 0x9f, 0xfc, 0x74, 0xa7, 0x73, 0xf4, 0xf3, 0x33, 0x8f, 0xc1, 0x3d,
+		0xfc, 0x55, 0x24, 0xcb, 0xd6, 0x6e, 0xb4, 0x8c, 0x1d, 0xe7, 0x79, 0x2a, 0x9e, 0xe6, 0x47, 0x40,
+		0x8f, 0x31, 0xa8, 0x79, 0x91, 0x3b, 0xe6, 0x91, 0xaa, 0x91, 0x6f, 0x9b, 0x8e, 0xbd, 0x3a, 0x30,
+		0xee, 0x80, 0xe7, 0x41, 0x0f, 0x60, 0x8d, 0x79, 0x66, 0x2b, 0xe0, 0x81, 0xac, 0xd9, 0x83, 0x9f,
+		0x72, 0x98, 0x3d, 0xf0, 0x5c, 0x2c, 0x5e, 0x39, 0xcb, 0x5e, 0x6b, 0x59, 0xb6, 0xd9, 0x6b, 0x35,
+		0xb4, 0x36, 0x75, 0x24, 0x9e, 0x9a, 0xf8, 0xae, 0x5b, 0x29, 0x89, 0x66, 0x8c, 0x3f, 0xa3, 0xb5,
+		0x2b, 0xae, 0x9b, 0xc5, 0xf6, 0xb4, 0x36, 0xbb, 0x67, 0xe6, 0x7d, 0x87, 0x6f, 0x90, 0xed, 0x1e,
+		0xfb, 0xea, 0xa5, 0xc2, 0x70, 0xf1, 0xb4, 0x70, 0xe1, 0xfb, 0x31, 0xd8, 0x85, 0xcb, 0x1d, 0x8b,
+		0x2e, 0x8f, 0xe9, 0x02, 0xec, 0x3a, 0x44, 0x7a, 0x9a, 0xcd, 0x17, 0x25, 0xa5, 0xcb, 0x87, 0x7e,
+		0x7b, 0x8b, 0xd5, 0x42, 0x69, 0x90, 0x77, 0x03, 0x1e, 0x8d, 0x57, 0x29, 0xbd, 0xd0, 0x5b, 0xa2,
+		0x87, 0x37, 0x04, 0xb0, 0x7d, 0xe4, 0x9b, 0xe8, 0x87, 0x26, 0x71, 0xd3, 0xca, 0xd8, 0xd1, 0x19,
+		0xe5, 0xf0, 0x5b, 0xa2, 0x9c, 0xe9, 0x68, 0xb7, 0x16, 0x29, 0x35, 0xa4, 0xcf, 0xdf, 0x28, 0xfb,
+		0x4d, 0x09, 0xc0, 0x65, 0x0c, 0xd1, 0x30, 0x84, 0x2b, 0x52, 0xb4, 0x52, 0x11, 0xc2, 0xbd, 0x7f,
+		0x14, 0xef, 0x07, 0xd8, 0x5a, 0xca, 0x60, 0xf3, 0x3e, 0xfd, 0xda, 0x69, 0x89, 0xd5, 0x9a, 0x6b,
+		0x0c, 0xb1, 0x3d, 0xc5, 0xd6, 0xd0, 0x2a, 0xf5, 0x6f, 0x42, 0x63, 0xfd, 0x9b, 0x8c, 0xf0, 0x6f,
+		0x18, 0x41, 0x60, 0xd8, 0x58, 0xce, 0xfb, 0xf0, 0x49, 0x09, 0x52, 0x65, 0xcf, 0xbb, 0xa1, 0x18,
+		0x83, 0x37, 0x8d, 0xd6, 0x0d, 0xbd, 0xe7, 0xc4, 0xe0, 0x59, 0x12, 0x7d, 0x10, 0xf6, 0x29, 0x6d,
+		0x7b, 0x5f, 0xbc, 0xad, 0x22, 0xd2, 0x88, 0x75, 0x53, 0xdf, 0xb2, 0x5a, 0x82, 0xcf, 0x8a, 0x48,
+		0xe2, 0x6e, 0xac, 0xa5, 0x37, 0xfa, 0x18, 0x16, 0x54, 0x1b, 0xa6, 0x61, 0xe3, 0x17, 0xf5, 0x99,
+		0x9b, 0x9f, 0x13, 0xf9, 0x8b, 0x2c, 0x1b, 0x89, 0x34, 0x75, 0x5b, 0x6b, 0xb5, 0xd9, 0x31, 0xb6,
+		0xa4, 0x22, 0x92, 0xbc, 0xa9, 0xb7, 0xe3, 0xde, 0x85, 0xcb, 0x22, 0xc8, 0x66, 0x57, 0xef, 0xf9,
+		0xf6, 0xeb, 0x99, 0x34, 0xe6, 0x3f, 0xf3, 0xa9, 0x87, 0x66, 0x38, 0xc3, 0xf9, 0x4e, 0x2f, 0xbb,
+		0x9c, 0xa5, 0xe4, 0x04, 0x06, 0xcf, 0x26, 0x2f, 0xfa, 0xa2, 0xee, 0xfd, 0x2d, 0xf7, 0x69, 0xa4,
+		0x99, 0x21, 0xa6, 0x16, 0x8d, 0xfd, 0x52, 0xfe, 0x77, 0x5d, 0xd2, 0x3c, 0xac, 0xbc, 0x4e, 0x17,
+		0x2b, 0xde, 0x08, 0x3c, 0x25, 0x83, 0xee, 0xdd, 0xcb, 0x5a, 0xab, 0xad, 0x37, 0xf9, 0x4a, 0x98,
+		0xa7, 0x70, 0x47, 0x92, 0x47, 0x95, 0xd8, 0xd7, 0x53, 0x0b, 0xa3, 0x64, 0xa3, 0x64, 0x1a, 0x4d,
+		0x7f, 0x30, 0x09, 0x23, 0x52, 0xb6, 0x79, 0x43, 0x37, 0x38, 0x83, 0x4a, 0x0f, 0x1e, 0xe2, 0x91,
+		0x3d, 0x85, 0xa3, 0x92, 0x6f, 0x00, 0xb9, 0xa9, 0xb7, 0xf5, 0x1d, 0xca, 0x39, 0x6b, 0x57, 0xc3,
+		0xcb, 0xe1, 0xb1, 0x3b, 0x7d, 0x42, 0x2f, 0xe7, 0x90, 0xaa, 0x51, 0x4a, 0x64, 0xdd, 0xff, 0x32,
+		0x6d, 0xdc, 0xd9, 0x30, 0x0e, 0xec, 0xa3, 0x47, 0xf2, 0xbc, 0xda, 0xc7, 0x4b, 0x02, 0x25, 0xa8,
+		0x6f, 0x6c, 0x99, 0x06, 0xfd, 0x14, 0x28, 0xf7, 0xb0, 0x13, 0x6c, 0x27, 0xc6, 0xc9, 0xe7, 0x3b,
+		0x31, 0xeb, 0x90, 0x75, 0x41, 0xe9, 0x0c, 0x49, 0x1e, 0x76, 0x86, 0x64, 0x1c, 0x02, 0x08, 0x82,
+		0xdf, 0x0c, 0x75, 0xe7, 0x20, 0xdd, 0x07, 0x48, 0x5d, 0x2a, 0x8c, 0x9f, 0xcd, 0xde, 0xce, 0x78,
+		0x08, 0x10, 0x03, 0xa6, 0x3b, 0x2d, 0x43, 0xb5, 0xf4, 0xf6, 0xb6, 0xca, 0x39, 0x87, 0x74, 0x53,
+		0x94, 0xfd, 0xcf, 0x1c, 0x62, 0x34, 0xff, 0xf0, 0x53, 0x0f, 0xe5, 0xdc, 0xb7, 0x07, 0xe7, 0x1e,
+		0x9e, 0x7f, 0xf4, 0x71, 0x65, 0xaa, 0xd3, 0x32, 0x6a, 0x7a, 0x7b, 0xbb, 0xec, 0x10, 0x26, 0x4f,
+		0xc1, 0x49, 0x97, 0x21, 0xa6, 0xa1, 0xee, 0x9a, 0xed, 0xa6, 0xda, 0xd3, 0xb7, 0xd5, 0x06, 0x7d,
+		0x39, 0x31, 0x4d, 0xd9, 0x78, 0xdc, 0x01, 0x59, 0x33, 0xae, 0x99, 0xed, 0xa6, 0xa2, 0x6f, 0x2f,
+		0x62, 0x31, 0x46, 0xe9, 0x5d, 0xec, 0x56, 0x13, 0xf7, 0xbf, 0xc3, 0x78, 0x94, 0xd7, 0xc9, 0xac,
+		0x36, 0xad, 0x85, 0xc4, 0x07, 0x3f, 0x7e, 0xfa, 0xc8, 0x1b, 0x1f, 0x3f, 0x7d, 0xa4, 0x70, 0x95,
+		0x3e, 0x9e, 0xc6, 0xa7, 0x96, 0x6e, 0x91, 0xcb, 0x90, 0xd4, 0x44, 0x82, 0xdd, 0x67, 0x3a, 0x60,
+		0x6a, 0xba, 0xa0, 0x78, 0x95, 0x2b, 0x56, 0xde, 0x5c, 0xd7, 0x5a, 0x3d, 0x52, 0xc1, 0x77, 0x67,
+		0x85, 0xac, 0x4e, 0x3a, 0xcb, 0x5d, 0xf1, 0x16, 0xd3, 0x7c, 0x75, 0xd4, 0xe1, 0x9e, 0x64, 0xe9,
+		0xcc, 0x67, 0x3e, 0xf5, 0xd0, 0xdd, 0x9c, 0xcc, 0xe6, 0xc0, 0x39, 0x1f, 0x41, 0x6f, 0xf0, 0xfc,
+		0x8f, 0xa7, 0xcf, 0xd7, 0x21, 0xce, 0x9a, 0x6a, 0x91, 0x67, 0x21, 0xda, 0xc5, 0x1f, 0x3c, 0x9c,
+		0x7b, 0x6a, 0xa4, 0xcc, 0x53, 0x78, 0xaf, 0x84, 0x30, 0xbc, 0xc2, 0x87, 0x42, 0x00, 0xe5, 0xcd,
+		0xcd, 0x7a, 0xaf, 0xd5, 0x6d, 0xeb, 0xf6, 0xdb, 0xd5, 0xf7, 0x0d, 0x38, 0xea, 0xf6, 0xdd, 0xea,
+		0x35, 0x0e, 0xdf, 0xff, 0x69, 0xf7, 0x02, 0x7a, 0xaf, 0x11, 0x48, 0xb6, 0x69, 0xd9, 0x0e, 0xd9,
+		0xf0, 0xe1, 0xc9, 0x96, 0x2d, 0x7b, 0x98, 0xb3, 0x2f, 0x40, 0xca, 0x65, 0x86, 0x45, 0xaa, 0x90,
+		0xb0, 0xf9, 0x6f, 0xce, 0xe0, 0xc2, 0x68, 0x06, 0x0b, 0x34, 0x2f, 0x93, 0x1d, 0x74, 0xdc, 0xb7,
+		0x04, 0xcf, 0x1c, 0x79, 0x67, 0xca, 0x18, 0xc6, 0x25, 0xb9, 0x72, 0x0e, 0xdf, 0xf1, 0xfb, 0xa6,
+		0x8c, 0x80, 0x87, 0xa9, 0x1f, 0x0e, 0xc1, 0xf4, 0x86, 0x98, 0xbd, 0xef, 0x7c, 0x1e, 0x6c, 0x40,
+		0x5c, 0x37, 0xec, 0x5e, 0xcb, 0xd9, 0x8e, 0x78, 0x78, 0xd4, 0x98, 0x07, 0x74, 0xaa, 0x62, 0xd8,
+		0xbd, 0x7d, 0xaf, 0x04, 0x08, 0x5a, 0x1e, 0x7e, 0x7c, 0x34, 0x0c, 0xf9, 0x51, 0xa8, 0xf8, 0x1a,
+		0x50, 0xa3, 0xa7, 0xd3, 0x0c, 0xff, 0xf5, 0xba, 0xac, 0xc8, 0xe6, 0x66, 0x47, 0x01, 0x74, 0xd4,
+		0x50, 0xb8, 0x10, 0xf4, 0xce, 0x3c, 0xb3, 0xac, 0x4b, 0x81, 0x1a, 0x9e, 0x3a, 0xe4, 0xc4, 0x99,
+		0xfd, 0x2d, 0xad, 0xad, 0xe1, 0x9d, 0xe4, 0xf0, 0xe1, 0x6d, 0xbe, 0x38, 0xf7, 0x5f, 0x62, 0x24,
+		0x48, 0x05, 0xe2, 0x82, 0x5a, 0xe4, 0xf0, 0xd4, 0x04, 0x2e, 0xde, 0xce, 0xf0, 0x1a, 0x06, 0xea,
+		0x8d, 0x44, 0x94, 0x94, 0x93, 0x57, 0x6d, 0x8e, 0xb3, 0x3c, 0xb1, 0x03, 0x2d, 0x0f, 0x77, 0xf8,
+		0x7e, 0x24, 0x0c, 0x53, 0x8a, 0xde, 0xfc, 0x9b, 0x3f, 0x2c, 0xeb, 0x00, 0x6c, 0xaa, 0xa2, 0x26,
+		0xcd, 0x47, 0xee, 0x74, 0xbe, 0x27, 0x19, 0x91, 0xb2, 0x65, 0xff, 0x75, 0x8d, 0xd0, 0x7f, 0x09,
+		0x41, 0xda, 0x3b, 0x42, 0x7f, 0x2b, 0x8d, 0x16, 0x59, 0x75, 0xd5, 0x14, 0xbb, 0x75, 0xf0, 0xc0,
+		0x28, 0x35, 0x35, 0x24, 0xcd, 0x63, 0xf4, 0xd3, 0x1b, 0x51, 0x88, 0xf1, 0x13, 0x3d, 0x6b, 0x43,
+		0xbe, 0xed, 0xd8, 0xbb, 0xd5, 0x19, 0x71, 0x3d, 0x3d, 0xd0, 0xb5, 0xbd, 0x17, 0xf0, 0xd2, 0xba,
+		0xea, 0x3b, 0x26, 0x84, 0x71, 0x68, 0x5c, 0xea, 0x3a, 0xbd, 0xb7, 0xf0, 0xa8, 0x14, 0x82, 0xb9,
+		0x7a, 0x18, 0x61, 0xf0, 0x86, 0x47, 0x85, 0xe5, 0x90, 0x8b, 0x40, 0x76, 0x9d, 0xc0, 0x85, 0xea,
+		0x32, 0x42, 0x3a, 0x97, 0xa1, 0x0f, 0x0d, 0x4c, 0xb9, 0xa5, 0x02, 0x05, 0x9f, 0x9b, 0x31, 0x8d,
+		0xa6, 0xca, 0x9e, 0xf5, 0xe6, 0x8f, 0xa6, 0x63, 0x4e, 0x19, 0x33, 0xc8, 0x07, 0x24, 0xe6, 0x26,
+		0x0f, 0xac, 0xa6, 0xf9, 0x2a, 0xa5, 0x3e, 0xc1, 0xc4, 0xf8, 0xe2, 0x6b, 0xa7, 0x67, 0xf7, 0xb5,
+		0x4e, 0x7b, 0xa1, 0x10, 0x40, 0xa7, 0x10, 0xb4, 0xc0, 0x47, 0xe7, 0xd9, 0xbf, 0x1a, 0x27, 0x55,
+		0x90, 0x71, 0x67, 0xaa, 0xc7, 0xbf, 0x9f, 0xaf, 0x6e, 0xeb, 0xe2, 0x89, 0x83, 0x13, 0xf3, 0x01,
+		0x8f, 0xac, 0xcf, 0xe3, 0x63, 0xe5, 0x7c, 0x8f, 0x22, 0x7b, 0x43, 0xdf, 0x57, 0x38, 0xde, 0x55,
+		0x5d, 0x27, 0x76, 0xb0, 0xdf, 0x4f, 0xf7, 0x08, 0x4b, 0xe5, 0xc3, 0xf9, 0xfd, 0xf2, 0x2d, 0x11,
+		0x4f, 0x9b, 0xdb, 0x7b, 0x78, 0xfe, 0xd2, 0xfc, 0xc3, 0x6e, 0x07, 0x06, 0xbc, 0xff, 0x0f, 0x48,
+		0x30, 0x17, 0x50, 0xad, 0xba, 0xd3, 0xc3, 0xcf, 0x59, 0x77, 0xf5, 0x5e, 0xcb, 0x6c, 0xe6, 0x93,
+		0xe3, 0xa4, 0xe8, 0x8c, 0x90, 0xa2, 0xd1, 0x75, 0xdf, 0x35, 0x54, 0xf7, 0x12, 0x56, 0xb1, 0x4e,
+		0x6b, 0x58, 0xb8, 0x07, 0x55, 0xc5, 0x2b, 0xaf, 0xff, 0xc2, 0xf9, 0x93, 0x9e, 0xd7, 0xd2, 0x1d,
+		0x42, 0x17, 0x98, 0x7c, 0xa3, 0xd7, 0x4f, 0x5c, 0x7c, 0xcf, 0xb9, 0x38, 0xf0, 0x30, 0x4c, 0x3a,
+		0x78, 0x01, 0xe6, 0xe2, 0xfb, 0x16, 0x60, 0x1e, 0xfd, 0xf4, 0x8c, 0x6b, 0x00, 0x43, 0xe3, 0x86,
+		0xd2, 0x3b, 0x35, 0x39, 0x12, 0x55, 0x7b, 0x47, 0x0a, 0xff, 0x5e, 0x82, 0x13, 0x43, 0x53, 0xd9,
+		0x69, 0x72, 0x03, 0x48, 0xcf, 0x53, 0x48, 0xa7, 0x84, 0x78, 0x8c, 0xf8, 0xce, 0x34, 0xc3, 0x54,
+		0x6f, 0xb0, 0xf4, 0x6d, 0xb2, 0xe4, 0x5c, 0x8d, 0xff, 0x8e, 0x04, 0x33, 0xde, 0x06, 0x38, 0x5d,
+		0xa9, 0x41, 0xda, 0x5b, 0x75, 0x5e, 0x72, 0x8e, 0xbb, 0x8e, 0xed, 0x84, 0xb7, 0xfd, 0x3e, 0x22,
+		0x64, 0xd3, 0x55, 0x97, 0x2c, 0x34, 0x79, 0x71, 0x62, 0xa6, 0x88, 0x86, 0x05, 0xaa, 0x4d, 0x36,
+		0x36, 0x7f, 0x2a, 0x41, 0x64, 0xdd, 0x34, 0xdb, 0xe4, 0xbd, 0x30, 0x65, 0x98, 0xb6, 0x8a, 0x6a,
+		0x45, 0x6f, 0xaa, 0x3c, 0x6e, 0xc2, 0x4c, 0x51, 0xe5, 0x40, 0x5e, 0x7d, 0xfe, 0xb5, 0xd3, 0xc3,
+		0x98, 0x41, 0x5f, 0x2c, 0xc8, 0x19, 0xa6, 0x5d, 0xa2, 0x40, 0x75, 0x0a, 0x43, 0xb6, 0x21, 0xe3,
+		0xaf, 0x8e, 0x99, 0xab, 0xe2, 0xb8, 0xea, 0x32, 0x63, 0xab, 0x4a, 0x6f, 0x79, 0xea, 0x61, 0xaf,
+		0xaf, 0xff, 0x25, 0x8e, 0xdc, 0x37, 0x81, 0xbc, 0x39, 0x78, 0xf0, 0xe6, 0x2a, 0xc4, 0xc5, 0x41,
+		0x1b, 0x69, 0xd2, 0x43, 0x3c, 0x5e, 0x7e, 0x72, 0x64, 0x1a, 0xfb, 0xfd, 0x74, 0x08, 0x4e, 0xe0,
+		0x99, 0x1c, 0x1e, 0xe5, 0xe2, 0x2a, 0x8d, 0x05, 0xaa, 0xf7, 0x31, 0x34, 0x13, 0x18, 0x83, 0x4b,
+		0x0f, 0x47, 0xda, 0x36, 0x21, 0x87, 0xfe, 0x05, 0x46, 0xc9, 0xde, 0x5a, 0xa0, 0x2d, 0x63, 0xb6,
+		0x9b, 0xbc, 0x45, 0x18, 0x66, 0xdb, 0x84, 0x9c, 0xa1, 0xdf, 0xf4, 0xd1, 0x0d, 0xdf, 0x19, 0x5d,
+		0x43, 0xbf, 0xe9, 0xa1, 0xeb, 0xee, 0xe6, 0x46, 0x7c, 0xbb, 0xb9, 0x97, 0x21, 0x8c, 0x76, 0x20,
+		0x7a, 0x08, 0xe5, 0x81, 0x08, 0x1e, 0x9b, 0x5e, 0x83, 0x13, 0x3c, 0x4c, 0x62, 0xad, 0x6d, 0x53,
+		0x8e, 0xea, 0xb4, 0x43, 0xcf, 0xe9, 0xfb, 0x01, 0x31, 0x93, 0xf4, 0x44, 0x31, 0x93, 0xf3, 0xbf,
+		0x2a, 0x01, 0xb8, 0x01, 0x43, 0x7c, 0xb1, 0xac, 0xb4, 0xb6, 0x5a, 0x76, 0x37, 0xb6, 0x3c, 0x9f,
+		0x6d, 0x12, 0xef, 0x9b, 0x59, 0x5d, 0xbd, 0xd1, 0xda, 0x6e, 0xe9, 0x4d, 0x72, 0x1f, 0xcc, 0xf8,
+		0xa1, 0x31, 0x55, 0x29, 0xcb, 0xd2, 0x6c, 0xfa, 0x95, 0xdb, 0x73, 0x09, 0xb6, 0x40, 0xd2, 0xf1,
+		0xf9, 0xde, 0xa3, 0xc3, 0x70, 0xf8, 0xc9, 0xa7, 0xd0, 0x6c, 0xe6, 0x95, 0xdb, 0x73, 0x49, 0x67,
+		0x25, 0x45, 0x0a, 0x40, 0xbc, 0x90, 0x9c, 0x5e, 0x78, 0x16, 0x5e, 0xb9, 0x3d, 0x17, 0x63, 0x53,
+		0x86, 0xef, 0x88, 0x7d, 0x23, 0xbe, 0xd2, 0xb5, 0xdd, 0xd3, 0x1a, 0x54, 0x35, 0xcc, 0xc2, 0xb1,
+		0xea, 0xea, 0x55, 0xa5, 0xb8, 0x58, 0xc7, 0x3d, 0x34, 0x5f, 0xb3, 0x07, 0xca, 0xca, 0x6b, 0x1b,
+		0xa5, 0xe5, 0x8a, 0x8a, 0xef, 0xa6, 0xb1, 0xed, 0x6b, 0x5f, 0xd9, 0xf3, 0xab, 0xf5, 0xea, 0x4a,
+		0x45, 0x0e, 0x95, 0x2e, 0x8f, 0xdc, 0xe9, 0xba, 0xcb, 0x37, 0x19, 0x5d, 0x73, 0xe4, 0xfb, 0x8c,
+		0xc7, 0xff, 0x19, 0x00, 0xed, 0x21, 0x3f, 0xad, 0xc2, 0xb5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2476,6 +2571,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.MinSelfDelegation.Equal(that1.MinSelfDelegation) {
+		return false
+	}
+	if this.MinSelfDelegationGracePeriod != that1.MinSelfDelegationGracePeriod {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3369,6 +3470,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinSelfDelegationGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinSelfDelegationGracePeriod):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3411,12 +3530,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err13 != nil {
-		return 0, err13
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n13
-	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4094,6 +4213,10 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinSelfDelegationGracePeriod)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -6902,6 +7025,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinSelfDelegationGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -282,6 +282,142 @@ func (m *MsgDelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateResponse proto.InternalMessageInfo
 
+// MsgMultiDelegate defines a SDK message for splitting a delegation of coins
+// from a delegator across multiple validators according to weights.
+type MsgMultiDelegate struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// amount is the total amount of coins to delegate.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// delegations defines the validators to delegate to and the weight of each
+	// of them. The weights must add up to 1.
+	Delegations []WeightedDelegation `protobuf:"bytes,3,rep,name=delegations,proto3" json:"delegations"`
+}
+
+func (m *MsgMultiDelegate) Reset()         { *m = MsgMultiDelegate{} }
+func (m *MsgMultiDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiDelegate) ProtoMessage()    {}
+func (*MsgMultiDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{6}
+}
+func (m *MsgMultiDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiDelegate.Merge(m, src)
+}
+func (m *MsgMultiDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiDelegate proto.InternalMessageInfo
+
+// WeightedDelegation defines the share of a multi delegation going to a validator.
+type WeightedDelegation struct {
+	ValidatorAddress string                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Weight           cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *WeightedDelegation) Reset()         { *m = WeightedDelegation{} }
+func (m *WeightedDelegation) String() string { return proto.CompactTextString(m) }
+func (*WeightedDelegation) ProtoMessage()    {}
+func (*WeightedDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{7}
+}
+func (m *WeightedDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedDelegation.Merge(m, src)
+}
+func (m *WeightedDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedDelegation proto.InternalMessageInfo
+
+func (m *WeightedDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// MsgMultiDelegateResponse defines the Msg/MultiDelegate response type.
+type MsgMultiDelegateResponse struct {
+	// amounts are the coins delegated to each validator, in the order of the delegations.
+	Amounts []types.Coin `protobuf:"bytes,1,rep,name=amounts,proto3" json:"amounts"`
+}
+
+func (m *MsgMultiDelegateResponse) Reset()         { *m = MsgMultiDelegateResponse{} }
+func (m *MsgMultiDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiDelegateResponse) ProtoMessage()    {}
+func (*MsgMultiDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{8}
+}
+func (m *MsgMultiDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiDelegateResponse.Merge(m, src)
+}
+func (m *MsgMultiDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiDelegateResponse proto.InternalMessageInfo
+
+func (m *MsgMultiDelegateResponse) GetAmounts() []types.Coin {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
 // MsgBeginRedelegate defines a SDK message for performing a redelegation
 // of coins from a delegator and source validator to a destination validator.
 type MsgBeginRedelegate struct {
@@ -295,7 +431,7 @@ func (m *MsgBeginRedelegate) Reset()         { *m = MsgBeginRedelegate{} }
 func (m *MsgBeginRedelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBeginRedelegate) ProtoMessage()    {}
 func (*MsgBeginRedelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{6}
+	return fileDescriptor_0926ef28816b35ab, []int{9}
 }
 func (m *MsgBeginRedelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +469,7 @@ func (m *MsgBeginRedelegateResponse) Reset()         { *m = MsgBeginRedelegateRe
 func (m *MsgBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBeginRedelegateResponse) ProtoMessage()    {}
 func (*MsgBeginRedelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{7}
+	return fileDescriptor_0926ef28816b35ab, []int{10}
 }
 func (m *MsgBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +517,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{8}
+	return fileDescriptor_0926ef28816b35ab, []int{11}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +557,7 @@ func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
 func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegateResponse) ProtoMessage()    {}
 func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{9}
+	return fileDescriptor_0926ef28816b35ab, []int{12}
 }
 func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +614,7 @@ func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbonding
 func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{10}
+	return fileDescriptor_0926ef28816b35ab, []int{13}
 }
 func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +651,7 @@ func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelU
 func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
 func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{11}
+	return fileDescriptor_0926ef28816b35ab, []int{14}
 }
 func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +694,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{12}
+	return fileDescriptor_0926ef28816b35ab, []int{15}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +746,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{13}
+	return fileDescriptor_0926ef28816b35ab, []int{16}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +785,7 @@ func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
 func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
 func (*MsgRotateConsPubKey) ProtoMessage()    {}
 func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{14}
+	return fileDescriptor_0926ef28816b35ab, []int{17}
 }
 func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +823,7 @@ func (m *MsgRotateConsPubKeyResponse) Reset()         { *m = MsgRotateConsPubKey
 func (m *MsgRotateConsPubKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRotateConsPubKeyResponse) ProtoMessage()    {}
 func (*MsgRotateConsPubKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{15}
+	return fileDescriptor_0926ef28816b35ab, []int{18}
 }
 func (m *MsgRotateConsPubKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,6 +859,9 @@ func init() {
 	proto.RegisterType((*MsgEditValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgEditValidatorResponse")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.v1beta1.MsgDelegate")
 	proto.RegisterType((*MsgDelegateResponse)(nil), "cosmos.staking.v1beta1.MsgDelegateResponse")
+	proto.RegisterType((*MsgMultiDelegate)(nil), "cosmos.staking.v1beta1.MsgMultiDelegate")
+	proto.RegisterType((*WeightedDelegation)(nil), "cosmos.staking.v1beta1.WeightedDelegation")
+	proto.RegisterType((*MsgMultiDelegateResponse)(nil), "cosmos.staking.v1beta1.MsgMultiDelegateResponse")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegate")
 	proto.RegisterType((*MsgBeginRedelegateResponse)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.v1beta1.MsgUndelegate")
@@ -738,87 +877,96 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0x09, 0x29, 0x43, 0xc1, 0xb0, 0x40, 0x62, 0x16, 0x6a, 0xd3, 0x0d, 0x15, 0x94,
-	0xca, 0x6b, 0x20, 0x01, 0x54, 0x27, 0xaa, 0x82, 0x21, 0x6d, 0xd3, 0x96, 0x16, 0x2d, 0x21, 0x95,
-	0xaa, 0xb6, 0xee, 0x78, 0x77, 0x58, 0x56, 0x78, 0x77, 0x9c, 0x9d, 0x31, 0x89, 0x0f, 0x95, 0xaa,
-	0x9e, 0x9a, 0x9e, 0x72, 0xaf, 0x2a, 0xa5, 0x52, 0x2b, 0xf5, 0xc8, 0x81, 0x63, 0x7f, 0x40, 0x94,
-	0x53, 0x44, 0x2f, 0x51, 0x0e, 0xb4, 0x82, 0x03, 0xfd, 0x0f, 0xb9, 0x54, 0xbb, 0x3b, 0x5e, 0x7b,
-	0xd7, 0xde, 0xc5, 0xd0, 0xe6, 0x92, 0x4b, 0x62, 0xde, 0x7c, 0xf3, 0xcd, 0xcc, 0xf7, 0xbe, 0x79,
-	0xf3, 0x16, 0x64, 0x14, 0x4c, 0x0c, 0x4c, 0x72, 0x84, 0xc2, 0x6d, 0xdd, 0xd4, 0x72, 0x3b, 0xb3,
-	0x25, 0x44, 0xe1, 0x6c, 0x8e, 0xde, 0x97, 0x2a, 0x16, 0xa6, 0x98, 0xbf, 0xe8, 0x02, 0x24, 0x06,
-	0x90, 0x18, 0x40, 0x18, 0xd1, 0x30, 0xd6, 0xca, 0x28, 0xe7, 0xa0, 0x4a, 0xd5, 0xcd, 0x1c, 0x34,
-	0x6b, 0xee, 0x14, 0x21, 0x13, 0x1c, 0xa2, 0xba, 0x81, 0x08, 0x85, 0x46, 0x85, 0x01, 0x86, 0x34,
-	0xac, 0x61, 0xe7, 0x67, 0xce, 0xfe, 0xc5, 0xa2, 0x23, 0xee, 0x4a, 0x45, 0x77, 0x80, 0x2d, 0xeb,
-	0x0e, 0xa5, 0xd9, 0x2e, 0x4b, 0x90, 0x20, 0x6f, 0x8b, 0x0a, 0xd6, 0x4d, 0x36, 0x3e, 0x11, 0x72,
-	0x8a, 0xfa, 0xa6, 0x5d, 0xd4, 0x25, 0x86, 0x32, 0x88, 0x8d, 0xb0, 0xff, 0x63, 0x03, 0x03, 0xd0,
-	0xd0, 0x4d, 0x9c, 0x73, 0xfe, 0x75, 0x43, 0xe2, 0x8b, 0x73, 0x80, 0x5f, 0x25, 0xda, 0xb2, 0x85,
-	0x20, 0x45, 0x77, 0x60, 0x59, 0x57, 0x21, 0xc5, 0x16, 0xbf, 0x06, 0x7a, 0x54, 0x44, 0x14, 0x4b,
-	0xaf, 0x50, 0x1d, 0x9b, 0x29, 0x6e, 0x9c, 0x9b, 0xea, 0x99, 0xbb, 0x2c, 0xb5, 0xd7, 0x48, 0x5a,
-	0x69, 0x40, 0x0b, 0xdd, 0x8f, 0x0f, 0x32, 0xb1, 0xdf, 0x8f, 0x77, 0xa7, 0x39, 0xb9, 0x99, 0x82,
-	0x97, 0x01, 0x50, 0xb0, 0x61, 0xe8, 0x84, 0xd8, 0x84, 0x71, 0x87, 0x70, 0x32, 0x8c, 0x70, 0xd9,
-	0x43, 0xca, 0x90, 0x22, 0xd2, 0x4c, 0xda, 0xc4, 0xc2, 0x7f, 0x03, 0x06, 0x0d, 0xdd, 0x2c, 0x12,
-	0x54, 0xde, 0x2c, 0xaa, 0xa8, 0x8c, 0x34, 0xe8, 0xec, 0x36, 0x31, 0xce, 0x4d, 0x75, 0x17, 0x66,
-	0xec, 0x39, 0xcf, 0x0f, 0x32, 0xc3, 0xee, 0x1a, 0x44, 0xdd, 0x96, 0x74, 0x9c, 0x33, 0x20, 0xdd,
-	0x92, 0x6e, 0x99, 0x74, 0x7f, 0x2f, 0x0b, 0xd8, 0xe2, 0xb7, 0x4c, 0xea, 0x52, 0x0f, 0x18, 0xba,
-	0xb9, 0x8e, 0xca, 0x9b, 0x2b, 0x1e, 0x15, 0xff, 0x01, 0x18, 0x60, 0xc4, 0xd8, 0x2a, 0x42, 0x55,
-	0xb5, 0x10, 0x21, 0xa9, 0x73, 0x0e, 0xbf, 0xb0, 0xbf, 0x97, 0x1d, 0x62, 0x14, 0x4b, 0xee, 0xc8,
-	0x3a, 0xb5, 0x74, 0x53, 0x4b, 0x71, 0x72, 0xbf, 0x37, 0x89, 0x8d, 0xf0, 0x9f, 0x82, 0x81, 0x9d,
-	0xba, 0xba, 0x1e, 0xd1, 0x79, 0x87, 0xe8, 0xcd, 0xfd, 0xbd, 0xec, 0x1b, 0x8c, 0xc8, 0xcb, 0x80,
-	0x8f, 0x51, 0xee, 0xdf, 0x09, 0xc4, 0xf9, 0xf7, 0x41, 0x57, 0xa5, 0x5a, 0xda, 0x46, 0xb5, 0x54,
-	0x97, 0x23, 0xe5, 0x90, 0xe4, 0x9a, 0x51, 0xaa, 0x9b, 0x51, 0x5a, 0x32, 0x6b, 0x85, 0xd4, 0x93,
-	0xc6, 0x1e, 0x15, 0xab, 0x56, 0xa1, 0x58, 0x5a, 0xab, 0x96, 0x3e, 0x46, 0x35, 0x99, 0xcd, 0xe6,
-	0xf3, 0xe0, 0xfc, 0x0e, 0x2c, 0x57, 0x51, 0xea, 0x82, 0x43, 0x33, 0x52, 0xcf, 0x88, 0xed, 0xc0,
-	0xa6, 0x74, 0xe8, 0xbe, 0xc4, 0xba, 0x53, 0xf2, 0x37, 0x7e, 0x78, 0x94, 0x89, 0xfd, 0xf3, 0x28,
-	0x13, 0xfb, 0xfe, 0x78, 0x77, 0xba, 0xf5, 0x78, 0x3f, 0x1e, 0xef, 0x4e, 0xb3, 0x73, 0x65, 0x89,
-	0xba, 0x9d, 0x6b, 0xb5, 0x99, 0x38, 0x06, 0x84, 0xd6, 0xa8, 0x8c, 0x48, 0x05, 0x9b, 0x04, 0x89,
-	0xbf, 0x25, 0x40, 0xff, 0x2a, 0xd1, 0x6e, 0xaa, 0x3a, 0x7d, 0x99, 0xce, 0x6c, 0x9b, 0x9a, 0xf8,
-	0xd9, 0x53, 0x73, 0x07, 0x24, 0x1b, 0x1e, 0x2d, 0x5a, 0x90, 0x22, 0xe6, 0xc8, 0xec, 0xf3, 0x83,
-	0xcc, 0x68, 0xab, 0x1b, 0x3f, 0x41, 0x1a, 0x54, 0x6a, 0x2b, 0x48, 0x69, 0xf2, 0xe4, 0x0a, 0x52,
-	0xe4, 0x3e, 0xc5, 0x77, 0x0b, 0xf8, 0xcf, 0xdb, 0xbb, 0xdd, 0x75, 0xe3, 0x64, 0x87, 0x4e, 0x6f,
-	0x63, 0xf2, 0xfc, 0x7b, 0x27, 0xe7, 0x71, 0xd4, 0x9f, 0x47, 0x5f, 0x4a, 0x44, 0x01, 0xa4, 0x82,
-	0x31, 0x2f, 0x87, 0x3f, 0xc7, 0x41, 0xcf, 0x2a, 0xd1, 0xd8, 0x6a, 0x88, 0xbf, 0xd9, 0xee, 0x42,
-	0x71, 0xce, 0x11, 0x52, 0x61, 0x17, 0xaa, 0xd3, 0xeb, 0xf4, 0x1f, 0x72, 0x76, 0x1d, 0x74, 0x41,
-	0x03, 0x57, 0x4d, 0xea, 0xa4, 0xaa, 0xd3, 0x7b, 0xc0, 0xe6, 0xe4, 0xdf, 0xf5, 0x09, 0xd8, 0x72,
-	0x3e, 0x5b, 0xc0, 0x8b, 0x7e, 0x01, 0xeb, 0x7a, 0x88, 0xc3, 0x60, 0xb0, 0xe9, 0x4f, 0x4f, 0xb6,
-	0x07, 0x09, 0xa7, 0x2c, 0x17, 0x90, 0xa6, 0x9b, 0x32, 0x52, 0xff, 0x67, 0xf5, 0x36, 0xc0, 0x70,
-	0x43, 0x3d, 0x62, 0x29, 0xa7, 0x57, 0x70, 0xd0, 0x9b, 0xbf, 0x6e, 0x29, 0x6d, 0x69, 0x55, 0x42,
-	0x3d, 0xda, 0xc4, 0xe9, 0x69, 0x57, 0x08, 0x6d, 0xcd, 0xcd, 0xb9, 0x33, 0xe4, 0xe6, 0xc6, 0xc9,
-	0xb9, 0x09, 0x14, 0xa9, 0x80, 0xe8, 0x62, 0xc5, 0x29, 0x52, 0x81, 0x68, 0x3d, 0x53, 0xbc, 0xec,
-	0xdc, 0xf6, 0x4a, 0x19, 0xd9, 0x57, 0xa9, 0x68, 0x77, 0x00, 0xac, 0x26, 0x09, 0x2d, 0x15, 0xf9,
-	0x76, 0xbd, 0x3d, 0x28, 0xf4, 0xda, 0xfb, 0x7c, 0xf8, 0x57, 0x86, 0x73, 0xf7, 0xda, 0xd7, 0x60,
-	0xb0, 0x31, 0xe2, 0x2f, 0x71, 0xd0, 0xbb, 0x4a, 0xb4, 0x0d, 0x53, 0x7d, 0xa5, 0xaf, 0xcd, 0xb5,
-	0x93, 0x53, 0x93, 0xf2, 0xa7, 0xa6, 0xa1, 0x88, 0xf8, 0x07, 0x07, 0x86, 0x7d, 0x91, 0x97, 0x99,
-	0x11, 0xfe, 0x33, 0xef, 0xa0, 0xf1, 0x93, 0x0e, 0x3a, 0xe6, 0xf4, 0x1d, 0x7b, 0xd9, 0x64, 0x63,
-	0xeb, 0xe3, 0x33, 0xd2, 0xfc, 0x8c, 0xef, 0xec, 0xe2, 0x8b, 0x38, 0x18, 0xb3, 0x9f, 0x3e, 0x68,
-	0x2a, 0xa8, 0xbc, 0x61, 0x96, 0xb0, 0xa9, 0xea, 0xa6, 0xd6, 0xd4, 0x79, 0xbc, 0x8a, 0x19, 0xe7,
-	0x27, 0x41, 0x52, 0xb1, 0x1f, 0x7b, 0x3b, 0x31, 0x5b, 0x48, 0xd7, 0xb6, 0xdc, 0x3b, 0x9d, 0x90,
-	0xfb, 0xea, 0xe1, 0x0f, 0x9d, 0x68, 0xfe, 0xab, 0xba, 0x35, 0xf6, 0x83, 0x42, 0x5e, 0x5d, 0x08,
-	0x77, 0xcb, 0x64, 0xa0, 0xdb, 0x08, 0x13, 0x57, 0xbc, 0x06, 0x26, 0xa2, 0xc6, 0xeb, 0x56, 0xca,
-	0x0f, 0xb6, 0x59, 0x5e, 0x7c, 0xc6, 0x81, 0xa4, 0xed, 0xbc, 0x8a, 0x0a, 0x29, 0x5a, 0x83, 0x16,
-	0x34, 0x08, 0xbf, 0x00, 0xba, 0x61, 0x95, 0x6e, 0x61, 0x4b, 0xa7, 0xb5, 0x13, 0xb3, 0xd4, 0x80,
-	0xf2, 0x4b, 0xa0, 0xab, 0xe2, 0x30, 0x30, 0x5f, 0xa5, 0xc3, 0x1a, 0x19, 0x77, 0x1d, 0x9f, 0xa6,
-	0xee, 0xc4, 0xfc, 0x47, 0xad, 0x7b, 0x5c, 0xb4, 0x25, 0x6a, 0xac, 0x62, 0x4b, 0x33, 0xd1, 0x24,
-	0xcd, 0x7d, 0xef, 0xfb, 0x21, 0x70, 0x0c, 0x51, 0x02, 0x97, 0x02, 0xa1, 0x28, 0x29, 0x16, 0xc5,
-	0x9f, 0xe2, 0xce, 0xf3, 0x25, 0x63, 0x0a, 0x29, 0x5a, 0xc6, 0x26, 0x71, 0xbb, 0xcb, 0xf6, 0xae,
-	0xe3, 0xce, 0xee, 0xba, 0xaf, 0x01, 0x30, 0xd1, 0xbd, 0x22, 0xeb, 0x78, 0xe3, 0x11, 0x1d, 0xef,
-	0xdb, 0x61, 0x1d, 0xef, 0xfe, 0x5e, 0xb6, 0x97, 0xc5, 0x59, 0x0b, 0xdc, 0x6d, 0xa2, 0x7b, 0x6b,
-	0x0e, 0x63, 0xfe, 0x76, 0xa8, 0xdd, 0xe6, 0x67, 0xc3, 0x9b, 0xa2, 0xb4, 0xdf, 0x6e, 0x41, 0x15,
-	0xc4, 0x39, 0x30, 0xda, 0x26, 0x1c, 0xa1, 0xe8, 0xfc, 0xec, 0xdc, 0x9f, 0x17, 0x40, 0x62, 0x95,
-	0x68, 0xfc, 0x5d, 0x90, 0x0c, 0x7e, 0x93, 0x4d, 0x87, 0x79, 0xa3, 0xb5, 0x85, 0x16, 0xe6, 0x3a,
-	0xc7, 0x7a, 0x75, 0x73, 0x1b, 0xf4, 0xfa, 0x5b, 0xed, 0xa9, 0x08, 0x12, 0x1f, 0x52, 0x98, 0xe9,
-	0x14, 0xe9, 0x2d, 0xf6, 0x25, 0x78, 0xcd, 0xeb, 0x09, 0x2f, 0x47, 0xcc, 0xae, 0x83, 0x84, 0x77,
-	0x3a, 0x00, 0x79, 0xec, 0x77, 0x41, 0x32, 0xd8, 0x3a, 0x45, 0xa9, 0x17, 0xc0, 0x46, 0xaa, 0x17,
-	0xd6, 0x07, 0x94, 0x00, 0x68, 0x7a, 0xaf, 0xdf, 0x8a, 0x60, 0x68, 0xc0, 0x84, 0x6c, 0x47, 0x30,
-	0x6f, 0x8d, 0x5f, 0x39, 0x30, 0x12, 0xfe, 0x62, 0x5c, 0x8d, 0xca, 0x79, 0xd8, 0x2c, 0xe1, 0xfa,
-	0x59, 0x66, 0x79, 0x7d, 0xea, 0xe0, 0x93, 0xd6, 0x02, 0xc9, 0x7f, 0x0b, 0x5e, 0xf7, 0x15, 0xc7,
-	0xc9, 0xa8, 0x53, 0x36, 0x01, 0x85, 0x5c, 0x87, 0xc0, 0xa8, 0xe5, 0x17, 0xf9, 0x07, 0x1c, 0xe8,
-	0x6f, 0xa9, 0x48, 0x51, 0xf6, 0x09, 0x82, 0x85, 0x2b, 0xa7, 0x00, 0x47, 0xec, 0x65, 0x7e, 0x56,
-	0x38, 0xff, 0x9d, 0x5d, 0xab, 0x0b, 0x0b, 0x8f, 0x0f, 0xd3, 0xdc, 0xd3, 0xc3, 0x34, 0xf7, 0xf7,
-	0x61, 0x9a, 0x7b, 0x78, 0x94, 0x8e, 0x3d, 0x3d, 0x4a, 0xc7, 0x9e, 0x1d, 0xa5, 0x63, 0x5f, 0x8c,
-	0xf9, 0xbe, 0xd9, 0x1a, 0x95, 0x99, 0xd6, 0x2a, 0x88, 0x94, 0xba, 0x9c, 0xda, 0x76, 0xe5, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xcb, 0xe0, 0xf9, 0xbe, 0x12, 0x00, 0x00,
+	// 1419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0xd4, 0x46,
+	0x18, 0x8d, 0x77, 0x21, 0x90, 0x49, 0x43, 0x12, 0x27, 0x01, 0xc7, 0xa4, 0xbb, 0xa9, 0xa1, 0x4a,
+	0x9a, 0x6a, 0xbd, 0x9b, 0x85, 0x04, 0x75, 0x41, 0x08, 0x42, 0x68, 0x4b, 0xdb, 0xa5, 0x91, 0xf9,
+	0x25, 0x55, 0x6d, 0xb7, 0x5e, 0x7b, 0x70, 0xac, 0xac, 0x7f, 0xd4, 0x33, 0x1b, 0xd8, 0x43, 0xa5,
+	0xb6, 0xa7, 0xd2, 0x13, 0xf7, 0xaa, 0x12, 0x95, 0x5a, 0xa9, 0x47, 0x0e, 0x7b, 0xec, 0xb1, 0x07,
+	0x94, 0x13, 0xca, 0xa5, 0x88, 0x03, 0xad, 0xe0, 0x40, 0xff, 0x07, 0x2e, 0x95, 0xed, 0xb1, 0xbd,
+	0xb6, 0xd7, 0x8e, 0x93, 0xc2, 0x85, 0x4b, 0xe2, 0xcc, 0xbc, 0x79, 0x33, 0xdf, 0xfb, 0xde, 0xcc,
+	0x7c, 0x13, 0x50, 0x94, 0x0c, 0xa4, 0x19, 0xa8, 0x8c, 0xb0, 0xb8, 0xa1, 0xea, 0x4a, 0x79, 0x73,
+	0xb1, 0x09, 0xb1, 0xb8, 0x58, 0xc6, 0xb7, 0x79, 0xd3, 0x32, 0xb0, 0x41, 0x1f, 0x76, 0x01, 0x3c,
+	0x01, 0xf0, 0x04, 0xc0, 0x4e, 0x2b, 0x86, 0xa1, 0xb4, 0x60, 0xd9, 0x41, 0x35, 0xdb, 0x37, 0xcb,
+	0xa2, 0xde, 0x71, 0x87, 0xb0, 0xc5, 0x68, 0x17, 0x56, 0x35, 0x88, 0xb0, 0xa8, 0x99, 0x04, 0x30,
+	0xa9, 0x18, 0x8a, 0xe1, 0x7c, 0x96, 0xed, 0x2f, 0xd2, 0x3a, 0xed, 0xce, 0xd4, 0x70, 0x3b, 0xc8,
+	0xb4, 0x6e, 0x57, 0x81, 0xac, 0xb2, 0x29, 0x22, 0xe8, 0x2f, 0x51, 0x32, 0x54, 0x9d, 0xf4, 0x1f,
+	0x4f, 0x88, 0xc2, 0x5b, 0xb4, 0x8b, 0x3a, 0x42, 0x50, 0x1a, 0xb2, 0x11, 0xf6, 0x2f, 0xd2, 0x31,
+	0x2e, 0x6a, 0xaa, 0x6e, 0x94, 0x9d, 0x9f, 0x6e, 0x13, 0xf7, 0x62, 0x1f, 0xa0, 0xeb, 0x48, 0xb9,
+	0x60, 0x41, 0x11, 0xc3, 0xeb, 0x62, 0x4b, 0x95, 0x45, 0x6c, 0x58, 0xf4, 0x1a, 0x18, 0x96, 0x21,
+	0x92, 0x2c, 0xd5, 0xc4, 0xaa, 0xa1, 0x33, 0xd4, 0x2c, 0x35, 0x3f, 0x5c, 0x3d, 0xc6, 0xf7, 0xd7,
+	0x88, 0x5f, 0x0d, 0xa0, 0x2b, 0x43, 0x0f, 0x9e, 0x14, 0x07, 0x7e, 0x7f, 0x7e, 0x7f, 0x81, 0x12,
+	0x7a, 0x29, 0x68, 0x01, 0x00, 0xc9, 0xd0, 0x34, 0x15, 0x21, 0x9b, 0x30, 0xe7, 0x10, 0xce, 0x25,
+	0x11, 0x5e, 0xf0, 0x91, 0x82, 0x88, 0x21, 0xea, 0x25, 0xed, 0x61, 0xa1, 0xbf, 0x02, 0x13, 0x9a,
+	0xaa, 0x37, 0x10, 0x6c, 0xdd, 0x6c, 0xc8, 0xb0, 0x05, 0x15, 0xd1, 0x59, 0x6d, 0x7e, 0x96, 0x9a,
+	0x1f, 0x5a, 0xa9, 0xd8, 0x63, 0x1e, 0x3f, 0x29, 0x4e, 0xb9, 0x73, 0x20, 0x79, 0x83, 0x57, 0x8d,
+	0xb2, 0x26, 0xe2, 0x75, 0xfe, 0x92, 0x8e, 0xb7, 0xbb, 0x25, 0x40, 0x26, 0xbf, 0xa4, 0x63, 0x97,
+	0x7a, 0x5c, 0x53, 0xf5, 0x2b, 0xb0, 0x75, 0x73, 0xd5, 0xa7, 0xa2, 0x3f, 0x00, 0xe3, 0x84, 0xd8,
+	0xb0, 0x1a, 0xa2, 0x2c, 0x5b, 0x10, 0x21, 0x66, 0x9f, 0xc3, 0xcf, 0x6e, 0x77, 0x4b, 0x93, 0x84,
+	0xe2, 0xbc, 0xdb, 0x73, 0x05, 0x5b, 0xaa, 0xae, 0x30, 0x94, 0x30, 0xe6, 0x0f, 0x22, 0x3d, 0xf4,
+	0x65, 0x30, 0xbe, 0xe9, 0xa9, 0xeb, 0x13, 0xed, 0x77, 0x88, 0xde, 0xda, 0xee, 0x96, 0xde, 0x24,
+	0x44, 0x7e, 0x06, 0x42, 0x8c, 0xc2, 0xd8, 0x66, 0xa4, 0x9d, 0x7e, 0x1f, 0x0c, 0x9a, 0xed, 0xe6,
+	0x06, 0xec, 0x30, 0x83, 0x8e, 0x94, 0x93, 0xbc, 0x6b, 0x46, 0xde, 0x33, 0x23, 0x7f, 0x5e, 0xef,
+	0xac, 0x30, 0x5b, 0xc1, 0x1a, 0x25, 0xab, 0x63, 0x62, 0x83, 0x5f, 0x6b, 0x37, 0x3f, 0x86, 0x1d,
+	0x81, 0x8c, 0xa6, 0x6b, 0x60, 0xff, 0xa6, 0xd8, 0x6a, 0x43, 0xe6, 0x80, 0x43, 0x33, 0xed, 0x65,
+	0xc4, 0x76, 0x60, 0x4f, 0x3a, 0xd4, 0x50, 0x62, 0xdd, 0x21, 0xb5, 0x73, 0x3f, 0xdc, 0x2b, 0x0e,
+	0xfc, 0x7b, 0xaf, 0x38, 0xf0, 0xfd, 0xf3, 0xfb, 0x0b, 0xf1, 0xf0, 0x7e, 0x7c, 0x7e, 0x7f, 0x81,
+	0xc4, 0x55, 0x42, 0xf2, 0x46, 0x39, 0x6e, 0x33, 0x6e, 0x06, 0xb0, 0xf1, 0x56, 0x01, 0x22, 0xd3,
+	0xd0, 0x11, 0xe4, 0x7e, 0xcb, 0x83, 0xb1, 0x3a, 0x52, 0x2e, 0xca, 0x2a, 0x7e, 0x95, 0xce, 0xec,
+	0x9b, 0x9a, 0xdc, 0xde, 0x53, 0x73, 0x1d, 0x8c, 0x06, 0x1e, 0x6d, 0x58, 0x22, 0x86, 0xc4, 0x91,
+	0xa5, 0xc7, 0x4f, 0x8a, 0x47, 0xe3, 0x6e, 0xfc, 0x04, 0x2a, 0xa2, 0xd4, 0x59, 0x85, 0x52, 0x8f,
+	0x27, 0x57, 0xa1, 0x24, 0x1c, 0x92, 0x42, 0xbb, 0x80, 0xbe, 0xd1, 0xdf, 0xed, 0xae, 0x1b, 0xe7,
+	0x32, 0x3a, 0xbd, 0x8f, 0xc9, 0x6b, 0x67, 0x77, 0xce, 0xe3, 0xd1, 0x70, 0x1e, 0x43, 0x29, 0xe1,
+	0x58, 0xc0, 0x44, 0xdb, 0xfc, 0x1c, 0xfe, 0x9c, 0x03, 0xc3, 0x75, 0xa4, 0x90, 0xd9, 0x20, 0x7d,
+	0xb1, 0xdf, 0x86, 0xa2, 0x9c, 0x10, 0x98, 0xa4, 0x0d, 0x95, 0x75, 0x3b, 0xfd, 0x8f, 0x9c, 0x9d,
+	0x01, 0x83, 0xa2, 0x66, 0xb4, 0x75, 0xcc, 0xe4, 0x77, 0xb1, 0x0f, 0xc8, 0x98, 0xda, 0x7b, 0x21,
+	0x01, 0x63, 0xf1, 0xd9, 0x02, 0x1e, 0x0e, 0x0b, 0xe8, 0xe9, 0xc1, 0x4d, 0x81, 0x89, 0x9e, 0x3f,
+	0x7d, 0xd9, 0xfe, 0xcc, 0x39, 0xd6, 0xaf, 0xb7, 0x5b, 0x58, 0x7d, 0xd9, 0xda, 0x05, 0xb1, 0xe6,
+	0x76, 0x1f, 0x2b, 0x7d, 0x03, 0x0c, 0x13, 0x46, 0xd5, 0xd0, 0x11, 0x93, 0x9f, 0xcd, 0xcf, 0x0f,
+	0x57, 0x17, 0x92, 0xf6, 0xdf, 0x0d, 0xa8, 0x2a, 0xeb, 0x18, 0xca, 0x81, 0xdb, 0x22, 0xdb, 0xd0,
+	0x67, 0xaa, 0x09, 0x9e, 0x88, 0xdb, 0xdd, 0xd2, 0xd8, 0x6d, 0xef, 0x4a, 0x9b, 0xdd, 0xac, 0xf0,
+	0x55, 0xbe, 0x92, 0x2c, 0x6c, 0xc4, 0x99, 0x21, 0xc5, 0xb8, 0x2d, 0x0a, 0xd0, 0xf1, 0x25, 0xf4,
+	0x77, 0x0f, 0xb5, 0x77, 0xf7, 0x5c, 0x06, 0x83, 0xb7, 0x9c, 0x59, 0x88, 0x05, 0x97, 0xc9, 0xd5,
+	0x93, 0x7d, 0xb3, 0x13, 0x8d, 0x5d, 0x96, 0xda, 0x64, 0x3f, 0x09, 0x38, 0x13, 0x30, 0xd1, 0x00,
+	0x3d, 0xbf, 0xd0, 0x67, 0xc1, 0x01, 0x37, 0x3f, 0x76, 0x1c, 0xf9, 0xcc, 0x49, 0xf5, 0x06, 0x25,
+	0xcc, 0x78, 0x27, 0xef, 0x14, 0x07, 0x2b, 0x50, 0x51, 0x75, 0x01, 0xca, 0x2f, 0xd9, 0x87, 0xd7,
+	0xc0, 0x54, 0x90, 0x05, 0x64, 0x49, 0xbb, 0xdf, 0xc7, 0x13, 0xfe, 0xf8, 0x2b, 0x96, 0xd4, 0x97,
+	0x56, 0x46, 0xd8, 0xa7, 0xcd, 0xef, 0x9e, 0x76, 0x15, 0xe1, 0xf8, 0xae, 0xd9, 0xb7, 0x87, 0x13,
+	0xe2, 0xdc, 0xce, 0x27, 0x44, 0xe4, 0xaa, 0x8c, 0x88, 0xce, 0x99, 0x80, 0x8d, 0xb7, 0xfa, 0xf9,
+	0x17, 0x9c, 0x3b, 0xc7, 0x6c, 0x41, 0xdb, 0xdf, 0x0d, 0xbb, 0x0e, 0x25, 0x37, 0x23, 0x1b, 0xab,
+	0x0b, 0xae, 0x7a, 0x45, 0xea, 0xca, 0x88, 0xbd, 0xce, 0xbb, 0x7f, 0x17, 0x29, 0x77, 0xad, 0x87,
+	0x02, 0x06, 0x1b, 0xc3, 0xfd, 0x92, 0x03, 0x23, 0x75, 0xa4, 0x5c, 0xd3, 0xe5, 0xd7, 0xfa, 0xf0,
+	0x3e, 0xbd, 0x73, 0x6a, 0x98, 0x70, 0x6a, 0x02, 0x45, 0xb8, 0x3f, 0x28, 0x30, 0x15, 0x6a, 0x79,
+	0x95, 0x19, 0xa1, 0x3f, 0xcd, 0x7e, 0x72, 0xcf, 0x38, 0x47, 0x50, 0xb7, 0x34, 0x1a, 0x2c, 0x7d,
+	0xb6, 0xc2, 0x2f, 0x55, 0x42, 0xb1, 0x73, 0x2f, 0x72, 0x60, 0xc6, 0x2e, 0xc0, 0x44, 0x5d, 0x82,
+	0xad, 0x6b, 0x7a, 0xd3, 0xd0, 0x65, 0x55, 0x57, 0x7a, 0x4e, 0xca, 0xd7, 0x31, 0xe3, 0xf4, 0x1c,
+	0x18, 0x95, 0x2c, 0xe8, 0x04, 0xd8, 0x58, 0x77, 0xcf, 0x6d, 0x7b, 0x4f, 0xe7, 0x85, 0x43, 0x5e,
+	0xf3, 0x87, 0xee, 0x39, 0xfc, 0x45, 0xcf, 0x95, 0x14, 0x16, 0xf2, 0xe4, 0x72, 0xb2, 0x5b, 0xe6,
+	0x22, 0x35, 0x6f, 0x92, 0xb8, 0xdc, 0x69, 0x70, 0x3c, 0xad, 0xdf, 0xb3, 0x52, 0x6d, 0xa2, 0xcf,
+	0xf4, 0xdc, 0x23, 0x0a, 0x8c, 0xda, 0xce, 0x33, 0x65, 0x11, 0xc3, 0x35, 0xd1, 0x12, 0x35, 0x44,
+	0x2f, 0x83, 0x21, 0xb1, 0x8d, 0xd7, 0x0d, 0x4b, 0xc5, 0x9d, 0x1d, 0xb3, 0x14, 0x40, 0xe9, 0xf3,
+	0x60, 0xd0, 0x74, 0x18, 0x88, 0xaf, 0x0a, 0x49, 0xd7, 0xb9, 0x3b, 0x4f, 0x48, 0x53, 0x77, 0x60,
+	0xed, 0xa3, 0xf8, 0x1a, 0x4f, 0xd9, 0x12, 0x05, 0xb3, 0xd8, 0xd2, 0x1c, 0xef, 0x91, 0xc6, 0xbf,
+	0x6d, 0xca, 0x91, 0x30, 0x38, 0x1e, 0x1c, 0x89, 0x34, 0xa5, 0x49, 0x71, 0x8a, 0xfb, 0x29, 0xe7,
+	0x14, 0x51, 0x82, 0x81, 0x45, 0x0c, 0x2f, 0x18, 0x3a, 0x72, 0xdf, 0x38, 0x2f, 0xfd, 0x9a, 0xff,
+	0x12, 0x00, 0x1d, 0xde, 0x6a, 0x90, 0x77, 0x57, 0x2e, 0xe5, 0xdd, 0xf5, 0x4e, 0xd2, 0xbb, 0x6b,
+	0xbb, 0x5b, 0x1a, 0x21, 0xed, 0x6e, 0x83, 0x30, 0xa4, 0xc3, 0x5b, 0x6b, 0x0e, 0x63, 0xed, 0x6a,
+	0xa2, 0xdd, 0x96, 0x16, 0x93, 0x4b, 0xf3, 0x42, 0xd8, 0x6e, 0x51, 0x15, 0xb8, 0x2a, 0x38, 0xda,
+	0xa7, 0x39, 0x45, 0xd1, 0xa5, 0xc5, 0xea, 0x5f, 0x07, 0x41, 0xbe, 0x8e, 0x14, 0xfa, 0x6b, 0x30,
+	0x1a, 0xfd, 0xcf, 0x40, 0x62, 0xa9, 0x17, 0x7f, 0xc8, 0xb1, 0xd5, 0xec, 0x58, 0xff, 0xdc, 0xdc,
+	0x00, 0x23, 0xe1, 0x07, 0xdf, 0x7c, 0x0a, 0x49, 0x08, 0xc9, 0x56, 0xb2, 0x22, 0xfd, 0xc9, 0x3e,
+	0x07, 0x07, 0xfd, 0xea, 0xfa, 0x58, 0xca, 0x68, 0x0f, 0xc4, 0xbe, 0x9b, 0x01, 0xe4, 0xb3, 0x7f,
+	0x47, 0x81, 0x91, 0x70, 0x05, 0x9f, 0x16, 0x4b, 0x08, 0xc9, 0x56, 0xb2, 0x22, 0xfd, 0x27, 0xc3,
+	0xe4, 0x56, 0x9f, 0x12, 0xce, 0xce, 0x60, 0xb4, 0x7c, 0x4b, 0xcb, 0x60, 0x04, 0xcb, 0x56, 0xb3,
+	0x63, 0xfd, 0xb0, 0x9b, 0x00, 0xf4, 0xd4, 0x0c, 0x6f, 0xa7, 0x30, 0x04, 0x30, 0xb6, 0x94, 0x09,
+	0xe6, 0xcf, 0xf1, 0x2b, 0x05, 0xa6, 0x93, 0x6f, 0xad, 0x93, 0x69, 0xbe, 0x4b, 0x1a, 0xc5, 0x9e,
+	0xd9, 0xcb, 0x28, 0x5f, 0xfe, 0x89, 0xad, 0xf8, 0x21, 0x4d, 0x7f, 0x03, 0xde, 0x08, 0x1d, 0xd0,
+	0x73, 0x69, 0x51, 0xf6, 0x00, 0xd9, 0x72, 0x46, 0x60, 0xda, 0xf4, 0xa7, 0xe8, 0x3b, 0x14, 0x18,
+	0x8b, 0x9d, 0x8a, 0x69, 0x16, 0x8e, 0x82, 0xd9, 0x13, 0xbb, 0x00, 0xa7, 0xac, 0x65, 0x69, 0x91,
+	0xdd, 0xff, 0xad, 0x7d, 0x5f, 0xac, 0x2c, 0x3f, 0x78, 0x5a, 0xa0, 0x1e, 0x3e, 0x2d, 0x50, 0xff,
+	0x3c, 0x2d, 0x50, 0x77, 0x9f, 0x15, 0x06, 0x1e, 0x3e, 0x2b, 0x0c, 0x3c, 0x7a, 0x56, 0x18, 0xf8,
+	0x6c, 0x26, 0xf4, 0x58, 0x0a, 0x6e, 0x07, 0xdc, 0x31, 0x21, 0x6a, 0x0e, 0x3a, 0xe7, 0xeb, 0x89,
+	0xff, 0x06, 0x00, 0xe7, 0x1f, 0x7e, 0xe4, 0xc8, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -840,6 +988,9 @@ type MsgClient interface {
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
+	// MultiDelegate defines a method for splitting a delegation of coins
+	// from a delegator across multiple validators according to weights.
+	MultiDelegate(ctx context.Context, in *MsgMultiDelegate, opts ...grpc.CallOption) (*MsgMultiDelegateResponse, error)
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error)
@@ -892,6 +1043,15 @@ func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) MultiDelegate(ctx context.Context, in *MsgMultiDelegate, opts ...grpc.CallOption) (*MsgMultiDelegateResponse, error) {
+	out := new(MsgMultiDelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/MultiDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error) {
 	out := new(MsgBeginRedelegateResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/BeginRedelegate", in, out, opts...)
@@ -946,6 +1106,9 @@ type MsgServer interface {
 	// Delegate defines a method for performing a delegation of coins
 	// from a delegator to a validator.
 	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
+	// MultiDelegate defines a method for splitting a delegation of coins
+	// from a delegator across multiple validators according to weights.
+	MultiDelegate(context.Context, *MsgMultiDelegate) (*MsgMultiDelegateResponse, error)
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error)
@@ -976,6 +1139,9 @@ func (*UnimplementedMsgServer) EditValidator(ctx context.Context, req *MsgEditVa
 func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (*MsgDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
 }
+func (*UnimplementedMsgServer) MultiDelegate(ctx context.Context, req *MsgMultiDelegate) (*MsgMultiDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiDelegate not implemented")
+}
 func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegate not implemented")
 }
@@ -1050,6 +1216,24 @@ func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MultiDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/MultiDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiDelegate(ctx, req.(*MsgMultiDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_BeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBeginRedelegate)
 	if err := dec(in); err != nil {
@@ -1156,6 +1340,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delegate",
 			Handler:    _Msg_Delegate_Handler,
 		},
+		{
+			MethodName: "MultiDelegate",
+			Handler:    _Msg_MultiDelegate_Handler,
+		},
 		{
 			MethodName: "BeginRedelegate",
 			Handler:    _Msg_BeginRedelegate_Handler,
@@ -1450,6 +1638,137 @@ func (m *MsgDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMultiDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgBeginRedelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1524,12 +1843,12 @@ func (m *MsgBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1612,12 +1931,12 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1935,6 +2254,57 @@ func (m *MsgDelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMultiDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMultiDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgBeginRedelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2855,6 +3225,355 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMultiDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, WeightedDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,56 @@
+package decode_test
+
+import (
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/internal/benchtx"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// BenchmarkDecode benchmarks the decoding of the benchmark txs. Compare two
+// runs with benchstat, see the benchmark target of the x/tx Makefile.
+func BenchmarkDecode(b *testing.B) {
+	for _, msg := range []proto.Message{&bankv1beta1.MsgSend{}, &authzv1beta1.MsgExec{}} {
+		name := string(msg.ProtoReflect().Descriptor().FullName())
+		if gogoproto.MessageType(name) == nil {
+			gogoproto.RegisterType(msg.(gogoproto.Message), name)
+		}
+	}
+
+	signingCtx, err := signing.NewContext(signing.Options{
+		AddressCodec:          dummyAddressCodec{},
+		ValidatorAddressCodec: dummyAddressCodec{},
+	})
+	require.NoError(b, err)
+	decoder, err := decode.NewDecoder(decode.Options{
+		SigningContext: signingCtx,
+		ProtoCodec:     mockCodec{},
+	})
+	require.NoError(b, err)
+
+	txs, err := benchtx.Txs()
+	require.NoError(b, err)
+
+	for _, tx := range txs {
+		txBytes, err := tx.Bytes()
+		require.NoError(b, err)
+
+		b.Run("tx="+tx.Name, func(b *testing.B) {
+			b.SetBytes(int64(len(txBytes)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := decoder.Decode(txBytes); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package decode
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
 
@@ -10,11 +11,13 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"cosmossdk.io/api/cosmos/crypto/secp256k1"
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/internal/benchtx"
 	"cosmossdk.io/x/tx/signing"
 )
 
@@ -128,6 +131,82 @@ func FuzzDecode(f *testing.F) {
 	})
 }
 
+// FuzzDecodeRoundTrip checks that the txs the decoder accepts are decoded
+// identically from their re-encoded bytes, and that re-encoding them is stable.
+func FuzzDecodeRoundTrip(f *testing.F) {
+	if testing.Short() {
+		f.Skip("Skipping in -short mode")
+	}
+
+	// 1. Add some seeds, including the benchmark txs whose messages can be
+	// fully decoded.
+	generateAndAddSeedsFromTx(f)
+	txs, err := benchtx.Txs()
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, tx := range txs {
+		txBytes, err := tx.Bytes()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(txBytes)
+	}
+
+	// 2. Now fuzz it.
+	for _, msg := range []proto.Message{&bankv1beta1.MsgSend{}, &authzv1beta1.MsgExec{}} {
+		name := string(msg.ProtoReflect().Descriptor().FullName())
+		if gogoproto.MessageType(name) == nil {
+			gogoproto.RegisterType(msg.(gogoproto.Message), name)
+		}
+	}
+	cdc := new(asHexCodec)
+	signingCtx, err := signing.NewContext(signing.Options{
+		AddressCodec:          cdc,
+		ValidatorAddressCodec: cdc,
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+	dec, err := NewDecoder(Options{
+		SigningContext: signingCtx,
+		ProtoCodec:     mockCodec{},
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, in []byte) {
+		txr, err := dec.Decode(in)
+		if err != nil {
+			return
+		}
+
+		encoded := txr.Bytes()
+		txr2, err := dec.Decode(encoded)
+		if err != nil {
+			t.Fatalf("re-encoded tx cannot be decoded: %v", err)
+		}
+		if !proto.Equal(txr.Tx, txr2.Tx) {
+			t.Fatalf("tx changed after re-encoding:\n%v\n%v", txr.Tx, txr2.Tx)
+		}
+		if txr.TxBodyHasUnknownNonCriticals != txr2.TxBodyHasUnknownNonCriticals {
+			t.Fatal("unknown non-critical fields changed after re-encoding")
+		}
+		if len(txr.Signers) != len(txr2.Signers) {
+			t.Fatalf("signers changed after re-encoding: %d != %d", len(txr.Signers), len(txr2.Signers))
+		}
+		for i := range txr.Signers {
+			if !bytes.Equal(txr.Signers[i], txr2.Signers[i]) {
+				t.Fatalf("signer %d changed after re-encoding", i)
+			}
+		}
+		if !bytes.Equal(encoded, txr2.Bytes()) {
+			t.Fatal("re-encoding is not stable")
+		}
+	})
+}
+
 func mustMarshal(f *testing.F, m proto.Message) []byte {
 	f.Helper()
 	blob, err := proto.Marshal(m)
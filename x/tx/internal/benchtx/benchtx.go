@@ -0,0 +1,233 @@
+// Package benchtx provides the representative txs of the x/tx benchmarks, so
+// that the decoding and sign mode benchmarks measure the same tx shapes.
+package benchtx
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/api/cosmos/crypto/secp256k1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+)
+
+const (
+	// ChainID is the chain ID the txs are signed for.
+	ChainID = "bench-chain"
+
+	// LargeTxMsgs is the number of messages of the large tx.
+	LargeTxMsgs = 100
+	// AnyNestingDepth is the number of authz MsgExec wrapping the message of
+	// the deeply nested tx.
+	AnyNestingDepth = 20
+	// ManySigners is the number of signers of the multi signer tx.
+	ManySigners = 16
+)
+
+// Tx is a named benchmark tx.
+type Tx struct {
+	Name string
+	Tx   *txv1beta1.Tx
+}
+
+// Txs returns the benchmark txs:
+//   - simple: a single bank send, the most common tx
+//   - large: many multi coin bank sends with a long memo
+//   - deep_any_nesting: a bank send wrapped in nested authz MsgExec
+//   - many_signers: bank sends from many signers, each with its signer info
+//
+// The addresses are hex encoded, to be decoded by a hex address codec.
+func Txs() ([]Tx, error) {
+	builders := []struct {
+		name string
+		fn   func() (*txv1beta1.Tx, error)
+	}{
+		{"simple", simpleTx},
+		{"large", largeTx},
+		{"deep_any_nesting", deepAnyNestingTx},
+		{"many_signers", manySignersTx},
+	}
+
+	txs := make([]Tx, 0, len(builders))
+	for _, b := range builders {
+		tx, err := b.fn()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", b.name, err)
+		}
+		txs = append(txs, Tx{Name: b.name, Tx: tx})
+	}
+	return txs, nil
+}
+
+// Bytes returns the ADR-027 compliant TxRaw bytes of the tx, as received by
+// the tx decoder.
+func (t Tx) Bytes() ([]byte, error) {
+	bodyBz, authInfoBz, err := t.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(&txv1beta1.TxRaw{
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+		Signatures:    t.Tx.Signatures,
+	})
+}
+
+// SigningArguments returns the arguments of the sign mode handlers to get the
+// sign bytes of the first signer of the tx.
+func (t Tx) SigningArguments() (signing.SignerData, signing.TxData, error) {
+	bodyBz, authInfoBz, err := t.marshal()
+	if err != nil {
+		return signing.SignerData{}, signing.TxData{}, err
+	}
+
+	signerInfo := t.Tx.AuthInfo.SignerInfos[0]
+	signerData := signing.SignerData{
+		Address:       Address(0),
+		ChainID:       ChainID,
+		AccountNumber: 1,
+		Sequence:      signerInfo.Sequence,
+		PubKey:        signerInfo.PublicKey,
+	}
+	txData := signing.TxData{
+		Body:          t.Tx.Body,
+		AuthInfo:      t.Tx.AuthInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}
+	return signerData, txData, nil
+}
+
+func (t Tx) marshal() (bodyBz, authInfoBz []byte, err error) {
+	marshalOpts := proto.MarshalOptions{Deterministic: true}
+	bodyBz, err = marshalOpts.Marshal(t.Tx.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	authInfoBz, err = marshalOpts.Marshal(t.Tx.AuthInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bodyBz, authInfoBz, nil
+}
+
+// Address returns the hex encoded address of the i-th signer. The fee payer is
+// the address of index -1, it differs from the signers so that they can all
+// use SIGN_MODE_DIRECT_AUX.
+func Address(i int) string {
+	bz := make([]byte, 20)
+	bz[0], bz[19] = 0xa0, byte(i)
+	if i < 0 {
+		bz[0] = 0xfe
+	}
+	return hex.EncodeToString(bz)
+}
+
+func simpleTx() (*txv1beta1.Tx, error) {
+	msg, err := anyutil.New(bankSend(0, 1))
+	if err != nil {
+		return nil, err
+	}
+	return newTx([]*anypb.Any{msg}, "", 1)
+}
+
+func largeTx() (*txv1beta1.Tx, error) {
+	msgs := make([]*anypb.Any, LargeTxMsgs)
+	for i := range msgs {
+		var err error
+		msgs[i], err = anyutil.New(bankSend(0, 10))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newTx(msgs, strings.Repeat("memo", 64), 1)
+}
+
+func deepAnyNestingTx() (*txv1beta1.Tx, error) {
+	msg, err := anyutil.New(bankSend(0, 1))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < AnyNestingDepth; i++ {
+		msg, err = anyutil.New(&authzv1beta1.MsgExec{
+			Grantee: Address(0),
+			Msgs:    []*anypb.Any{msg},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newTx([]*anypb.Any{msg}, "", 1)
+}
+
+func manySignersTx() (*txv1beta1.Tx, error) {
+	msgs := make([]*anypb.Any, ManySigners)
+	for i := range msgs {
+		var err error
+		msgs[i], err = anyutil.New(bankSend(i, 1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newTx(msgs, "", ManySigners)
+}
+
+func bankSend(signer, coins int) *bankv1beta1.MsgSend {
+	amount := make([]*basev1beta1.Coin, coins)
+	for i := range amount {
+		amount[i] = &basev1beta1.Coin{Denom: fmt.Sprintf("denom%d", i), Amount: "1000000"}
+	}
+	return &bankv1beta1.MsgSend{
+		FromAddress: Address(signer),
+		ToAddress:   Address(signer + 1),
+		Amount:      amount,
+	}
+}
+
+func newTx(msgs []*anypb.Any, memo string, signers int) (*txv1beta1.Tx, error) {
+	signerInfos := make([]*txv1beta1.SignerInfo, signers)
+	signatures := make([][]byte, signers)
+	for i := range signerInfos {
+		pk := make([]byte, 33)
+		pk[0], pk[32] = 0x02, byte(i)
+		pkAny, err := anyutil.New(&secp256k1.PubKey{Key: pk})
+		if err != nil {
+			return nil, err
+		}
+		signerInfos[i] = &txv1beta1.SignerInfo{
+			PublicKey: pkAny,
+			ModeInfo: &txv1beta1.ModeInfo{
+				Sum: &txv1beta1.ModeInfo_Single_{
+					Single: &txv1beta1.ModeInfo_Single{Mode: signingv1beta1.SignMode_SIGN_MODE_DIRECT},
+				},
+			},
+			Sequence: uint64(i),
+		}
+		signatures[i] = make([]byte, 64)
+	}
+
+	return &txv1beta1.Tx{
+		Body: &txv1beta1.TxBody{
+			Messages: msgs,
+			Memo:     memo,
+		},
+		AuthInfo: &txv1beta1.AuthInfo{
+			SignerInfos: signerInfos,
+			Fee: &txv1beta1.Fee{
+				Amount:   []*basev1beta1.Coin{{Denom: "stake", Amount: "5000"}},
+				GasLimit: 200000,
+				Payer:    Address(-1),
+			},
+		},
+		Signatures: signatures,
+	}, nil
+}
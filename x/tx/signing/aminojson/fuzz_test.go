@@ -1,8 +1,10 @@
 package aminojson
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"slices"
 	"testing"
 
 	fuzz "github.com/google/gofuzz"
@@ -10,6 +12,7 @@ import (
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/testutil"
 )
 
@@ -19,6 +22,100 @@ func FuzzSignModeGetSignBytes(f *testing.F) {
 	}
 
 	// 1. Create seeds.
+	addSignModeSeeds(f)
+
+	ctx := context.Background()
+	handler := NewSignModeHandler(SignModeHandlerOptions{})
+
+	// 2. Now run the fuzzers.
+	f.Fuzz(func(t *testing.T, in []byte) {
+		opts := new(testutil.HandlerArgumentOptions)
+		if err := json.Unmarshal(in, opts); err != nil {
+			return
+		}
+
+		signerData, txData, err := testutil.MakeHandlerArguments(*opts)
+		if err != nil {
+			return
+		}
+		_, _ = handler.GetSignBytes(ctx, signerData, txData)
+	})
+}
+
+// FuzzSignDocRoundTrip checks that the sign docs produced from the fuzzed
+// handler arguments are canonical JSON: decoding and re-encoding them, with
+// their keys sorted, yields the same bytes.
+func FuzzSignDocRoundTrip(f *testing.F) {
+	if testing.Short() {
+		f.Skip("not running in -short mode")
+	}
+
+	// 1. Create seeds.
+	addSignModeSeeds(f)
+
+	ctx := context.Background()
+	handler := NewSignModeHandler(SignModeHandlerOptions{})
+
+	// 2. Now run the fuzzers.
+	f.Fuzz(func(t *testing.T, in []byte) {
+		signerData, txData, ok := decodeHandlerArguments(in)
+		if !ok {
+			return
+		}
+		signDoc, err := handler.GetSignBytes(ctx, signerData, txData)
+		if err != nil {
+			return
+		}
+
+		again, err := handler.GetSignBytes(ctx, signerData, txData)
+		if err != nil {
+			t.Fatalf("sign bytes cannot be computed again: %v", err)
+		}
+		if !bytes.Equal(signDoc, again) {
+			t.Fatalf("sign bytes are not deterministic:\n%s\n%s", signDoc, again)
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(signDoc))
+		dec.UseNumber()
+		var decoded any
+		if err := dec.Decode(&decoded); err != nil {
+			t.Fatalf("sign doc is not valid JSON: %v\n%s", err, signDoc)
+		}
+		reencoded, err := json.Marshal(decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(signDoc, reencoded) {
+			t.Fatalf("sign doc is not canonical JSON:\n%s\n%s", signDoc, reencoded)
+		}
+	})
+}
+
+// decodeHandlerArguments decodes the JSON encoded handler arguments of a bank
+// send and makes the sign mode handler arguments from them.
+func decodeHandlerArguments(in []byte) (signing.SignerData, signing.TxData, bool) {
+	msg := &bankv1beta1.MsgSend{}
+	opts := &testutil.HandlerArgumentOptions{Msg: msg}
+	if err := json.Unmarshal(in, opts); err != nil {
+		return signing.SignerData{}, signing.TxData{}, false
+	}
+	// null coins cannot be encoded in the tx bytes
+	if slices.Contains(msg.Amount, nil) || (opts.Fee != nil && slices.Contains(opts.Fee.Amount, nil)) {
+		return signing.SignerData{}, signing.TxData{}, false
+	}
+
+	signerData, txData, err := testutil.MakeHandlerArguments(*opts)
+	if err != nil {
+		return signing.SignerData{}, signing.TxData{}, false
+	}
+	return signerData, txData, true
+}
+
+// addSignModeSeeds adds the JSON encoded handler arguments of a bank send,
+// mutated by gofuzz, to the seed corpus.
+func addSignModeSeeds(f *testing.F) {
+	f.Helper()
+
 	fee := &txv1beta1.Fee{
 		Amount: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
 	}
@@ -55,21 +152,4 @@ func FuzzSignModeGetSignBytes(f *testing.F) {
 		gf.Fuzz(seed.Fee)
 		gf.Fuzz(&seed.SignerAddress)
 	}
-
-	ctx := context.Background()
-	handler := NewSignModeHandler(SignModeHandlerOptions{})
-
-	// 2. Now run the fuzzers.
-	f.Fuzz(func(t *testing.T, in []byte) {
-		opts := new(testutil.HandlerArgumentOptions)
-		if err := json.Unmarshal(in, opts); err != nil {
-			return
-		}
-
-		signerData, txData, err := testutil.MakeHandlerArguments(*opts)
-		if err != nil {
-			return
-		}
-		_, _ = handler.GetSignBytes(ctx, signerData, txData)
-	})
 }
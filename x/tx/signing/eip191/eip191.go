@@ -0,0 +1,119 @@
+// Package eip191 implements a SIGN_MODE_EIP_191 signing.SignModeHandler, producing
+// EIP-191 personal message sign bytes over a plain text rendering of the
+// SIGN_MODE_TEXTUAL screens of a transaction, so that transactions can be signed
+// with Ethereum wallets which support personal_sign but not typed data.
+//
+// Ref: https://eips.ethereum.org/EIPS/eip-191
+package eip191
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+// MessagePrefix is the prefix of the EIP-191 version 0x45 (personal message) sign bytes,
+// which is followed by the decimal length of the message and the message.
+const MessagePrefix = "\x19Ethereum Signed Message:\n"
+
+var _ signing.SignModeHandler = SignModeHandler{}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	// Textual is the SIGN_MODE_TEXTUAL handler rendering the screens of the transactions.
+	Textual *textual.SignModeHandler
+}
+
+// SignModeHandler is the SIGN_MODE_EIP_191 implementation of signing.SignModeHandler.
+// It is not registered by default, but can be added to a TxConfig with CustomSignModes.
+//
+// Ethereum wallets sign the Keccak-256 hash of the sign bytes, so the signatures
+// must be verified by public keys which hash the sign bytes with Keccak-256, e.g.
+// eth_secp256k1 keys.
+type SignModeHandler struct {
+	textual *textual.SignModeHandler
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) (*SignModeHandler, error) {
+	if options.Textual == nil {
+		return nil, errors.New("textual sign mode handler must be non-nil")
+	}
+	return &SignModeHandler{textual: options.Textual}, nil
+}
+
+// Mode implements signing.SignModeHandler.Mode.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_EIP_191 //nolint:staticcheck // the sign mode is deprecated in the proto but is the one of EIP-191
+}
+
+// GetSignBytes implements signing.SignModeHandler.GetSignBytes. It returns the
+// message returned by GetMessage, prefixed as an EIP-191 personal message.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	msg, err := h.GetMessage(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(MessagePrefix + strconv.Itoa(len(msg)) + msg), nil
+}
+
+// GetMessage returns the message the wallets are asked to sign with
+// personal_sign, which prefix it themselves. It is the plain text rendering of
+// the SIGN_MODE_TEXTUAL screens of the transaction, see FormatScreens.
+func (h SignModeHandler) GetMessage(ctx context.Context, signerData signing.SignerData, txData signing.TxData) (string, error) {
+	screens, err := h.textual.GetScreens(ctx, signerData, txData)
+	if err != nil {
+		return "", err
+	}
+	return FormatScreens(screens), nil
+}
+
+// FormatScreens renders the screens as plain text, one line per screen:
+//
+//	[*][> ...]Title: Content
+//
+// Expert screens are prefixed with "*" and every level of indentation with "> ".
+// Screens without a title are rendered as ": Content". Backslashes and control
+// characters, as well as ":", ">" and "*" in titles, are escaped so that
+// distinct screens never render to the same text.
+func FormatScreens(screens []textual.Screen) string {
+	var sb strings.Builder
+	for i, screen := range screens {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		if screen.Expert {
+			sb.WriteByte('*')
+		}
+		sb.WriteString(strings.Repeat("> ", screen.Indent))
+		sb.WriteString(escape(screen.Title, ":>*"))
+		sb.WriteString(": ")
+		sb.WriteString(escape(screen.Content, ""))
+	}
+	return sb.String()
+}
+
+// escape escapes backslashes, control characters and the special characters in s.
+func escape(s, special string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case unicode.IsControl(r) || strings.ContainsRune(special, r):
+			sb.WriteString(fmt.Sprintf(`\u%04x`, r))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,107 @@
+package eip191_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing/eip191"
+	"cosmossdk.io/x/tx/signing/testutil"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+func emptyCoinMetadataQuerier(context.Context, string) (*bankv1beta1.Metadata, error) {
+	return nil, nil
+}
+
+func TestEIP191SignMode(t *testing.T) {
+	textualHandler, err := textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: emptyCoinMetadataQuerier})
+	require.NoError(t, err)
+	handler, err := eip191.NewSignModeHandler(eip191.SignModeHandlerOptions{Textual: textualHandler})
+	require.NoError(t, err)
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_EIP_191, handler.Mode()) //nolint:staticcheck // testing the deprecated sign mode
+
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Memo:    "line 1\nline 2",
+		Msg: &bankv1beta1.MsgSend{
+			FromAddress: "foo",
+			ToAddress:   "bar",
+			Amount:      []*basev1beta1.Coin{{Denom: "demon", Amount: "100"}},
+		},
+		AccNum:        1,
+		AccSeq:        2,
+		SignerAddress: "signerAddress",
+		Fee: &txv1beta1.Fee{
+			Amount: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
+		},
+	})
+	require.NoError(t, err)
+
+	msg, err := handler.GetMessage(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Contains(t, msg, "Chain id: test-chain\n")
+	require.Contains(t, msg, "Memo: line 1\\nline 2\n")
+	require.Contains(t, msg, "> From address: foo\n")
+
+	// the message renders the same screens as SIGN_MODE_TEXTUAL
+	screens, err := textualHandler.GetScreens(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(msg, "\n"), len(screens))
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, "\x19Ethereum Signed Message:\n"+strconv.Itoa(len(msg))+msg, string(signBytes))
+
+	// the sign bytes change with the signer data
+	signerData.Sequence++
+	otherSignBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+
+	_, err = eip191.NewSignModeHandler(eip191.SignModeHandlerOptions{})
+	require.Error(t, err)
+}
+
+func TestFormatScreens(t *testing.T) {
+	for name, tc := range map[string]struct {
+		screens  []textual.Screen
+		expected string
+	}{
+		"title and content": {
+			screens:  []textual.Screen{{Title: "Chain id", Content: "test-chain"}},
+			expected: "Chain id: test-chain",
+		},
+		"indent and expert": {
+			screens: []textual.Screen{
+				{Content: "Message (1/1)"},
+				{Title: "Amount", Content: "100 demon", Indent: 2},
+				{Title: "Hash", Content: "AB", Expert: true, Indent: 1},
+			},
+			expected: ": Message (1/1)\n> > Amount: 100 demon\n*> Hash: AB",
+		},
+		"escaping": {
+			screens:  []textual.Screen{{Title: "a: b>*", Content: "c\\d\ne\tf: g"}},
+			expected: `a\u003a b\u003e\u002a: c\\d\ne\u0009f: g`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, eip191.FormatScreens(tc.screens))
+		})
+	}
+
+	// screens which could be confused if they were not escaped render differently
+	require.NotEqual(t,
+		eip191.FormatScreens([]textual.Screen{{Title: "a", Content: "b\nc: d"}}),
+		eip191.FormatScreens([]textual.Screen{{Title: "a", Content: "b"}, {Title: "c", Content: "d"}}))
+	require.NotEqual(t,
+		eip191.FormatScreens([]textual.Screen{{Title: "a: b", Content: "c"}}),
+		eip191.FormatScreens([]textual.Screen{{Title: "a", Content: "b: c"}}))
+}
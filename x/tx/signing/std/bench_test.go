@@ -0,0 +1,37 @@
+package std_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/tx/internal/benchtx"
+)
+
+// BenchmarkGetSignBytes benchmarks the sign bytes of the benchmark txs in every
+// standard sign mode. Compare two runs with benchstat, see the benchmark target
+// of the x/tx Makefile.
+func BenchmarkGetSignBytes(b *testing.B) {
+	handlerMap := newHandlerMap(b)
+	txs, err := benchtx.Txs()
+	require.NoError(b, err)
+
+	ctx := context.Background()
+	for _, tx := range txs {
+		signerData, txData, err := tx.SigningArguments()
+		require.NoError(b, err)
+
+		for _, mode := range handlerMap.SupportedModes() {
+			b.Run("tx="+tx.Name+"/mode="+mode.String(), func(b *testing.B) {
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := handlerMap.GetSignBytes(ctx, mode, signerData, txData); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
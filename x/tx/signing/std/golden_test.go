@@ -0,0 +1,172 @@
+package std_test
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gotest.tools/v3/golden"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	govv1 "cosmossdk.io/api/cosmos/gov/v1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+	"cosmossdk.io/x/tx/signing/direct"
+	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/std"
+	"cosmossdk.io/x/tx/signing/testutil"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+const (
+	signerAddr = "cosmos1ulav3hsenupswqfkw2y3sup5kgtqwnvqa8eyhs"
+	recipient  = "cosmos1ejrf4cur2wy6kfurg9f2jppp2h3afe5h6pkh5t"
+	validator  = "cosmosvaloper1ulav3hsenupswqfkw2y3sup5kgtqwnvqkzvvvf"
+	// feePayer pays the fees of the corpus txs, SIGN_MODE_DIRECT_AUX cannot be used by the fee payer
+	feePayer = recipient
+)
+
+var signBytesCorpus = []testutil.SignBytesCase{
+	{
+		Name: "bank_send",
+		Options: testutil.HandlerArgumentOptions{
+			ChainID: "test-chain",
+			Msg: &bankv1beta1.MsgSend{
+				FromAddress: signerAddr,
+				ToAddress:   recipient,
+				Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "1000"}},
+			},
+			AccNum: 1,
+			AccSeq: 2,
+			Fee: &txv1beta1.Fee{
+				Amount:   []*basev1beta1.Coin{{Denom: "stake", Amount: "150"}},
+				GasLimit: 200000,
+				Payer:    feePayer,
+			},
+			SignerAddress: signerAddr,
+		},
+	},
+	{
+		Name: "staking_delegate_with_memo",
+		Options: testutil.HandlerArgumentOptions{
+			ChainID: "test-chain",
+			Memo:    "delegating my stake",
+			Msg: &stakingv1beta1.MsgDelegate{
+				DelegatorAddress: signerAddr,
+				ValidatorAddress: validator,
+				Amount:           &basev1beta1.Coin{Denom: "stake", Amount: "5000000"},
+			},
+			AccNum: 42,
+			AccSeq: 7,
+			Fee: &txv1beta1.Fee{
+				Amount:   []*basev1beta1.Coin{{Denom: "stake", Amount: "2500"}},
+				GasLimit: 250000,
+				Payer:    feePayer,
+			},
+			SignerAddress: signerAddr,
+		},
+	},
+	{
+		Name: "gov_vote_no_fee_amount",
+		Options: testutil.HandlerArgumentOptions{
+			ChainID: "test-chain",
+			Msg: &govv1.MsgVote{
+				ProposalId: 3,
+				Voter:      signerAddr,
+				Option:     govv1.VoteOption_VOTE_OPTION_YES,
+				Metadata:   "ipfs://vote",
+			},
+			Fee: &txv1beta1.Fee{
+				GasLimit: 100000,
+				Payer:    feePayer,
+			},
+			SignerAddress: signerAddr,
+		},
+	},
+}
+
+// dummyAddressCodec is only used to build the signers context, the fee payer of
+// the corpus txs is always set.
+type dummyAddressCodec struct{}
+
+func (dummyAddressCodec) StringToBytes(text string) ([]byte, error) {
+	return hex.DecodeString(text)
+}
+
+func (dummyAddressCodec) BytesToString(bz []byte) (string, error) {
+	return hex.EncodeToString(bz), nil
+}
+
+func emptyCoinMetadataQuerier(context.Context, string) (*bankv1beta1.Metadata, error) {
+	return nil, nil
+}
+
+// newHandlerMap returns the handler map of all the standard sign modes.
+func newHandlerMap(t require.TestingT) *signing.HandlerMap {
+	signersCtx, err := signing.NewContext(signing.Options{
+		AddressCodec:          dummyAddressCodec{},
+		ValidatorAddressCodec: dummyAddressCodec{},
+	})
+	require.NoError(t, err)
+	handlerMap, err := std.SignModeOptions{
+		Textual:   textual.SignModeOptions{CoinMetadataQuerier: emptyCoinMetadataQuerier},
+		DirectAux: directaux.SignModeHandlerOptions{SignersContext: signersCtx},
+		AminoJSON: aminojson.SignModeHandlerOptions{
+			FileResolver: protoregistry.GlobalFiles,
+			TypeResolver: protoregistry.GlobalTypes,
+		},
+	}.HandlerMap()
+	require.NoError(t, err)
+	return handlerMap
+}
+
+// TestGoldenSignBytes checks that the sign bytes of the corpus are unchanged in
+// every standard sign mode. Run `go test . -update` to regenerate the golden
+// file after an intended change of the sign bytes.
+func TestGoldenSignBytes(t *testing.T) {
+	handlerMap := newHandlerMap(t)
+	require.Len(t, handlerMap.SupportedModes(), 4)
+
+	ctx := context.Background()
+	goldenFile := filepath.Join("testdata", "sign_bytes.json")
+	if golden.FlagUpdate() {
+		bz, err := testutil.GenerateGoldenSignBytes(ctx, handlerMap, signBytesCorpus)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(goldenFile, bz, 0o600))
+	}
+
+	bz, err := os.ReadFile(goldenFile)
+	require.NoError(t, err)
+	require.NoError(t, testutil.VerifyGoldenSignBytes(ctx, handlerMap, signBytesCorpus, bz))
+}
+
+func TestVerifyGoldenSignBytes(t *testing.T) {
+	handlerMap := signing.NewHandlerMap(aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{}))
+	ctx := context.Background()
+
+	bz, err := testutil.GenerateGoldenSignBytes(ctx, handlerMap, signBytesCorpus[:1])
+	require.NoError(t, err)
+	require.NoError(t, testutil.VerifyGoldenSignBytes(ctx, handlerMap, signBytesCorpus[:1], bz))
+
+	// a change of the tx changes its sign bytes
+	changed := signBytesCorpus[0]
+	changed.Options.Memo = "changed"
+	err = testutil.VerifyGoldenSignBytes(ctx, handlerMap, []testutil.SignBytesCase{changed}, bz)
+	require.ErrorContains(t, err, `tx "bank_send": SIGN_MODE_LEGACY_AMINO_JSON sign bytes changed`)
+
+	// txs missing from either the corpus or the golden file are reported
+	err = testutil.VerifyGoldenSignBytes(ctx, handlerMap, signBytesCorpus[1:2], bz)
+	require.ErrorContains(t, err, `tx "bank_send" of the golden file is missing from the corpus`)
+	require.ErrorContains(t, err, `tx "staking_delegate_with_memo" of the corpus is missing from the golden file`)
+
+	// a sign mode dropped from the handler map is reported
+	err = testutil.VerifyGoldenSignBytes(ctx, signing.NewHandlerMap(direct.SignModeHandler{}), signBytesCorpus[:1], bz)
+	require.ErrorContains(t, err, `tx "bank_send": SIGN_MODE_LEGACY_AMINO_JSON is no longer supported`)
+}
@@ -0,0 +1,145 @@
+package testutil
+
+import (
+	"cmp"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// SignBytesCase is a named tx of a sign bytes corpus.
+type SignBytesCase struct {
+	Name    string
+	Options HandlerArgumentOptions
+}
+
+// GoldenSignBytes are the hex encoded sign bytes of a tx of a sign bytes
+// corpus, keyed by the name of their sign mode.
+type GoldenSignBytes struct {
+	Name      string            `json:"name"`
+	SignBytes map[string]string `json:"sign_bytes"`
+}
+
+// GenerateGoldenSignBytes computes the sign bytes of every tx of the corpus in
+// every sign mode supported by the handler map, and returns them as the JSON
+// contents of a golden file.
+func GenerateGoldenSignBytes(ctx context.Context, handlerMap *signing.HandlerMap, corpus []SignBytesCase) ([]byte, error) {
+	golden, err := generateGoldenSignBytes(ctx, handlerMap, corpus)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.MarshalIndent(golden, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(bz, '\n'), nil
+}
+
+// VerifyGoldenSignBytes checks that the sign bytes of every tx of the corpus
+// are unchanged from the golden file contents generated by
+// GenerateGoldenSignBytes. Wallets sign the sign bytes computed on their side,
+// so any change to them breaks the signatures of existing wallets. All the
+// txs and sign modes whose sign bytes differ are reported in the returned
+// error.
+func VerifyGoldenSignBytes(ctx context.Context, handlerMap *signing.HandlerMap, corpus []SignBytesCase, goldenBz []byte) error {
+	var expected []GoldenSignBytes
+	if err := json.Unmarshal(goldenBz, &expected); err != nil {
+		return fmt.Errorf("invalid golden file: %w", err)
+	}
+
+	actual, err := generateGoldenSignBytes(ctx, handlerMap, corpus)
+	if err != nil {
+		return err
+	}
+
+	actualByName := make(map[string]GoldenSignBytes, len(actual))
+	for _, tx := range actual {
+		actualByName[tx.Name] = tx
+	}
+
+	var errs []error
+	for _, exp := range expected {
+		act, ok := actualByName[exp.Name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("tx %q of the golden file is missing from the corpus", exp.Name))
+			continue
+		}
+		delete(actualByName, exp.Name)
+
+		for _, mode := range sortedSignModes(exp.SignBytes) {
+			actBz, ok := act.SignBytes[mode]
+			switch {
+			case !ok:
+				errs = append(errs, fmt.Errorf("tx %q: %s is no longer supported", exp.Name, mode))
+			case actBz != exp.SignBytes[mode]:
+				errs = append(errs, fmt.Errorf("tx %q: %s sign bytes changed\nexpected: %s\ngot:      %s", exp.Name, mode, exp.SignBytes[mode], actBz))
+			}
+		}
+		for _, mode := range sortedSignModes(act.SignBytes) {
+			if _, ok := exp.SignBytes[mode]; !ok {
+				errs = append(errs, fmt.Errorf("tx %q: %s sign bytes are missing from the golden file", exp.Name, mode))
+			}
+		}
+	}
+	// report the new txs of the corpus in corpus order
+	for _, tx := range actual {
+		if _, ok := actualByName[tx.Name]; ok {
+			errs = append(errs, fmt.Errorf("tx %q of the corpus is missing from the golden file", tx.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func generateGoldenSignBytes(ctx context.Context, handlerMap *signing.HandlerMap, corpus []SignBytesCase) ([]GoldenSignBytes, error) {
+	golden := make([]GoldenSignBytes, 0, len(corpus))
+	names := make(map[string]struct{}, len(corpus))
+	for _, tc := range corpus {
+		if _, ok := names[tc.Name]; ok {
+			return nil, fmt.Errorf("duplicate tx name %q in corpus", tc.Name)
+		}
+		names[tc.Name] = struct{}{}
+
+		signerData, txData, err := MakeHandlerArguments(tc.Options)
+		if err != nil {
+			return nil, fmt.Errorf("tx %q: %w", tc.Name, err)
+		}
+
+		signBytes := make(map[string]string, len(handlerMap.SupportedModes()))
+		for _, mode := range handlerMap.SupportedModes() {
+			bz, err := handlerMap.GetSignBytes(ctx, mode, signerData, txData)
+			if err != nil {
+				return nil, fmt.Errorf("tx %q: %s: %w", tc.Name, mode, err)
+			}
+			signBytes[mode.String()] = hex.EncodeToString(bz)
+		}
+
+		golden = append(golden, GoldenSignBytes{Name: tc.Name, SignBytes: signBytes})
+	}
+
+	return golden, nil
+}
+
+// sortedSignModes returns the sign mode names of sign bytes in the order of
+// the sign mode enum, so that errors are reported deterministically.
+func sortedSignModes(signBytes map[string]string) []string {
+	modes := make([]string, 0, len(signBytes))
+	for name := range signBytes {
+		modes = append(modes, name)
+	}
+	slices.SortFunc(modes, func(a, b string) int {
+		if c := cmp.Compare(signingv1beta1.SignMode_value[a], signingv1beta1.SignMode_value[b]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	return modes
+}
@@ -194,6 +194,24 @@ func (r *SignModeHandler) DefineMessageRenderer(name protoreflect.FullName, vr V
 // GetSignBytes returns the transaction sign bytes which is the CBOR representation
 // of a list of screens created from the TX data.
 func (r *SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	screens, err := r.GetScreens(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = encode(screens, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GetScreens returns the list of screens created from the TX data, which are
+// encoded as the sign bytes. It is useful to render the transaction in other
+// formats, e.g. as plain text.
+func (r *SignModeHandler) GetScreens(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]Screen, error) {
 	data := &textualpb.TextualData{
 		BodyBytes:     txData.BodyBytes,
 		AuthInfoBytes: txData.AuthInfoBytes,
@@ -206,18 +224,7 @@ func (r *SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.S
 		},
 	}
 
-	screens, err := NewTxValueRenderer(r).Format(ctx, protoreflect.ValueOf(data.ProtoReflect()))
-	if err != nil {
-		return nil, err
-	}
-
-	var buf bytes.Buffer
-	err = encode(screens, &buf)
-	if err != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
+	return NewTxValueRenderer(r).Format(ctx, protoreflect.ValueOf(data.ProtoReflect()))
 }
 
 func (r *SignModeHandler) Mode() signingv1beta1.SignMode {
@@ -40,6 +40,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "DryRunParamChanges",
+					Use:       "dry-run-param-changes",
+					Short:     "Dry run the param changes of the upgrade plan",
+					Long:      "Executes the param changes of the currently scheduled upgrade plan without persisting them, and reports whether each of them would succeed",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
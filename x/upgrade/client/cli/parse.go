@@ -1,9 +1,16 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+
 	"github.com/spf13/pflag"
 
 	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func parsePlan(fs *pflag.FlagSet, name string) (types.Plan, error) {
@@ -19,3 +26,26 @@ func parsePlan(fs *pflag.FlagSet, name string) (types.Plan, error) {
 
 	return types.Plan{Name: name, Height: height, Info: info}, nil
 }
+
+// parseParamChanges reads the param changes of a plan from a JSON file holding
+// an array of messages, each with its @type.
+func parseParamChanges(cdc codec.Codec, path string) ([]sdk.Msg, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(bz, &raws); err != nil {
+		return nil, fmt.Errorf("param changes must be a JSON array of messages: %w", err)
+	}
+
+	msgs := make([]sdk.Msg, len(raws))
+	for i, raw := range raws {
+		if err := cdc.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("invalid param change %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
@@ -1,12 +1,17 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/upgrade/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParsePlan(t *testing.T) {
@@ -32,3 +37,22 @@ func TestParsePlan(t *testing.T) {
 	require.Equal(t, p.Height, proposal.Plan.Height)
 	require.Equal(t, p.Info, proposal.Plan.Info)
 }
+
+func TestParseParamChanges(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	types.RegisterInterfaces(cdc.InterfaceRegistry())
+
+	path := filepath.Join(t.TempDir(), "param-changes.json")
+	err := os.WriteFile(path, []byte(`[{"@type": "/cosmos.upgrade.v1beta1.MsgCancelUpgrade", "authority": "cosmos1"}]`), 0o600)
+	require.NoError(t, err)
+
+	msgs, err := parseParamChanges(cdc, path)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{&types.MsgCancelUpgrade{Authority: "cosmos1"}}, msgs)
+
+	err = os.WriteFile(path, []byte(`{"@type": "/cosmos.upgrade.v1beta1.MsgCancelUpgrade"}`), 0o600)
+	require.NoError(t, err)
+
+	_, err = parseParamChanges(cdc, path)
+	require.ErrorContains(t, err, "JSON array of messages")
+}
@@ -25,6 +25,7 @@ const (
 	FlagNoChecksumRequired = "no-checksum-required"
 	FlagDaemonName         = "daemon-name"
 	FlagAuthority          = "authority"
+	FlagParamChanges       = "param-changes"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -50,7 +51,8 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 		Short: "Submit a software upgrade proposal",
 		Long: "Submit a software upgrade along with an initial deposit.\n" +
 			"Please specify a unique name and height for the upgrade to take effect.\n" +
-			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor",
+			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor\n" +
+			"Module param changes to apply with the upgrade can be given with --param-changes, as a JSON file holding an array of messages such as MsgUpdateParams.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -68,6 +70,22 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 				return err
 			}
 
+			paramChangesPath, err := cmd.Flags().GetString(FlagParamChanges)
+			if err != nil {
+				return err
+			}
+
+			if paramChangesPath != "" {
+				changes, err := parseParamChanges(clientCtx.Codec, paramChangesPath)
+				if err != nil {
+					return err
+				}
+
+				if err := p.SetParamChanges(changes); err != nil {
+					return err
+				}
+			}
+
 			noValidate, err := cmd.Flags().GetBool(FlagNoValidate)
 			if err != nil {
 				return err
@@ -124,6 +142,7 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().Bool(FlagNoChecksumRequired, false, "Skip requirement of checksums for binaries in the upgrade info")
 	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded (for upgrade-info validation). Default is the DAEMON_NAME env var if set, or else this executable")
 	cmd.Flags().String(FlagAuthority, "", "The address of the upgrade module authority (defaults to gov)")
+	cmd.Flags().String(FlagParamChanges, "", "Path to a JSON file with an array of param change messages to apply with the upgrade, signed by the upgrade authority")
 
 	// add common proposal flags
 	flags.AddTxFlagsToCmd(cmd)
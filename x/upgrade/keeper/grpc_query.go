@@ -77,3 +77,18 @@ func (k Keeper) ModuleVersions(ctx context.Context, req *types.QueryModuleVersio
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// DryRunParamChanges implements the Query/DryRunParamChanges gRPC method
+func (k Keeper) DryRunParamChanges(ctx context.Context, req *types.QueryDryRunParamChangesRequest) (*types.QueryDryRunParamChangesResponse, error) {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.SimulateParamChanges(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDryRunParamChangesResponse{Name: plan.Name, Results: results}, nil
+}
@@ -239,6 +239,19 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(suite.encodedAuthority, res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestDryRunParamChanges() {
+	_, err := suite.queryClient.DryRunParamChanges(context.Background(), &types.QueryDryRunParamChangesRequest{})
+	suite.Require().ErrorContains(err, types.ErrNoUpgradePlanFound.Error())
+
+	err = suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, types.Plan{Name: "test-plan", Height: 5})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.DryRunParamChanges(context.Background(), &types.QueryDryRunParamChangesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal("test-plan", res.Name)
+	suite.Require().Empty(res.Results)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
@@ -32,7 +33,7 @@ type Keeper struct {
 
 	homePath           string                          // root directory of app config
 	skipUpgradeHeights map[int64]bool                  // map of heights to skip for an upgrade
-	cdc                codec.BinaryCodec               // App-wide binary codec
+	cdc                codec.Codec                     // App-wide codec
 	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
 	versionModifier    app.VersionModifier             // implements setting the protocol version field on BaseApp
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
@@ -43,13 +44,13 @@ type Keeper struct {
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
 // skipUpgradeHeights - map of heights to skip an upgrade
 // storeKey - a store key with which to access upgrade's store
-// cdc - the app-wide binary codec
+// cdc - the app-wide codec, also used to get the signers of the plan param changes
 // homePath - root directory of the application's config
 // vs - the interface implemented by baseapp which allows setting baseapp's protocol version field
 func NewKeeper(
 	env appmodule.Environment,
 	skipUpgradeHeights map[int64]bool,
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	homePath string,
 	vs app.VersionModifier,
 	authority string,
@@ -210,6 +211,10 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	if err := k.validateParamChanges(ctx, plan); err != nil {
+		return err
+	}
+
 	store := k.KVStoreService.OpenKVStore(ctx)
 
 	// clear any old IBC state stored by previous plan
@@ -423,7 +428,17 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
-	updatedVM, err := handler(ctx, plan, vm)
+	// The upgrade handler and the param changes of the plan are applied in a
+	// branch, so that none of their state changes are written if one of them fails.
+	var updatedVM appmodule.VersionMap
+	err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		updatedVM, err = handler(ctx, plan, vm)
+		if err != nil {
+			return err
+		}
+
+		return k.applyParamChanges(ctx, plan)
+	})
 	if err != nil {
 		return err
 	}
@@ -460,6 +475,90 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 	return k.setDone(ctx, plan.Name)
 }
 
+// validateParamChanges checks that every param change of the plan can be routed
+// and has the upgrade authority as its only signer.
+func (k Keeper) validateParamChanges(ctx context.Context, plan types.Plan) error {
+	changes, err := plan.GetParamChanges()
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	for i, msg := range changes {
+		signers, _, err := k.cdc.GetMsgSigners(msg)
+		if err != nil {
+			return err
+		}
+		if len(signers) != 1 {
+			return errorsmod.Wrapf(types.ErrInvalidSigner, "param change %d must have exactly one signer", i)
+		}
+
+		signer, err := k.cdc.InterfaceRegistry().SigningContext().AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return err
+		}
+		if signer != k.authority {
+			return errorsmod.Wrapf(types.ErrInvalidSigner, "param change %d: expected %s got %s", i, k.authority, signer)
+		}
+
+		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "param change %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// applyParamChanges executes the param changes of the plan in order.
+func (k Keeper) applyParamChanges(ctx context.Context, plan types.Plan) error {
+	changes, err := plan.GetParamChanges()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range changes {
+		if _, err := k.MsgRouterService.InvokeUntyped(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "param change %d (%s) failed", i, sdk.MsgTypeURL(msg))
+		}
+
+		k.Logger.Info("applied upgrade param change", "name", plan.Name, "msg", sdk.MsgTypeURL(msg))
+	}
+
+	return nil
+}
+
+// SimulateParamChanges executes the param changes of the plan without persisting
+// them. Each change is executed on top of the previous successful ones, and its
+// outcome is reported in order. The upgrade handler of the plan is not run.
+func (k Keeper) SimulateParamChanges(ctx context.Context, plan types.Plan) ([]*types.ParamChangeResult, error) {
+	changes, err := plan.GetParamChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*types.ParamChangeResult, len(changes))
+	errDiscard := errors.New("dry run")
+	err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		for i, msg := range changes {
+			results[i] = &types.ParamChangeResult{TypeUrl: sdk.MsgTypeURL(msg), Success: true}
+			if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+				_, err := k.MsgRouterService.InvokeUntyped(ctx, msg)
+				return err
+			}); err != nil {
+				results[i].Success = false
+				results[i].Error = err.Error()
+			}
+		}
+
+		// always discard the state changes of the dry run
+		return errDiscard
+	})
+	if !errors.Is(err, errDiscard) {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
 func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
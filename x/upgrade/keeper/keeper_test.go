@@ -2,15 +2,19 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmttypes "github.com/cometbft/cometbft/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/router"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -49,6 +53,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	env.MsgRouterService = paramChangeRouter{key: key}
 	s.key = key
 	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Height: 10})
@@ -327,6 +332,46 @@ func (s *KeeperTestSuite) TestMigrations() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestParamChanges() {
+	paramChange := &types.MsgCancelUpgrade{Authority: s.encodedAuthority}
+	failingParamChange := &types.MsgSoftwareUpgrade{Authority: s.encodedAuthority}
+
+	plan := types.Plan{Name: "params", Height: 123450000}
+	s.Require().NoError(plan.SetParamChanges([]sdk.Msg{&types.MsgCancelUpgrade{Authority: s.encodedAddrs[0]}}))
+	err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan)
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	s.Require().NoError(plan.SetParamChanges([]sdk.Msg{paramChange, failingParamChange}))
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	storedPlan, err := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+	results, err := s.upgradeKeeper.SimulateParamChanges(s.ctx, storedPlan)
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.Require().True(results[0].Success)
+	s.Require().Equal(sdk.MsgTypeURL(paramChange), results[0].TypeUrl)
+	s.Require().False(results[1].Success)
+	s.Require().Contains(results[1].Error, "invalid params")
+	s.Require().False(s.ctx.KVStore(s.key).Has(paramChangeKey))
+
+	s.upgradeKeeper.SetUpgradeHandler("params", func(ctx context.Context, _ types.Plan, vm appmodule.VersionMap) (appmodule.VersionMap, error) {
+		sdk.UnwrapSDKContext(ctx).KVStore(s.key).Set([]byte("handler"), []byte{1})
+		return vm, nil
+	})
+
+	// a failing param change rolls back the upgrade handler and the other changes
+	err = s.upgradeKeeper.ApplyUpgrade(s.ctx, storedPlan)
+	s.Require().ErrorContains(err, "param change 1")
+	s.Require().False(s.ctx.KVStore(s.key).Has([]byte("handler")))
+	s.Require().False(s.ctx.KVStore(s.key).Has(paramChangeKey))
+
+	s.Require().NoError(plan.SetParamChanges([]sdk.Msg{paramChange}))
+	s.Require().NoError(s.upgradeKeeper.ApplyUpgrade(s.ctx, plan))
+	s.Require().True(s.ctx.KVStore(s.key).Has([]byte("handler")))
+	s.Require().True(s.ctx.KVStore(s.key).Has(paramChangeKey))
+}
+
 func (s *KeeperTestSuite) TestLastCompletedUpgrade() {
 	keeper := s.upgradeKeeper
 	require := s.Require()
@@ -416,6 +461,34 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
+var paramChangeKey = []byte("param_change")
+
+// paramChangeRouter executes MsgCancelUpgrade as a param change writing to the
+// upgrade store, and fails to execute MsgSoftwareUpgrade.
+type paramChangeRouter struct {
+	router.Service
+
+	key *storetypes.KVStoreKey
+}
+
+func (paramChangeRouter) CanInvoke(_ context.Context, typeURL string) error {
+	switch typeURL {
+	case sdk.MsgTypeURL(&types.MsgCancelUpgrade{}), sdk.MsgTypeURL(&types.MsgSoftwareUpgrade{}):
+		return nil
+	default:
+		return fmt.Errorf("unknown message %s", typeURL)
+	}
+}
+
+func (r paramChangeRouter) InvokeUntyped(ctx context.Context, msg gogoproto.Message) (gogoproto.Message, error) {
+	if _, ok := msg.(*types.MsgSoftwareUpgrade); ok {
+		return nil, errors.New("invalid params")
+	}
+
+	sdk.UnwrapSDKContext(ctx).KVStore(r.key).Set(paramChangeKey, []byte{1})
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
 type paramStore struct {
 	params cmtproto.ConsensusParams
 }
@@ -0,0 +1,12 @@
+package types
+
+import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = MsgSoftwareUpgrade{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSoftwareUpgrade) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return m.Plan.UnpackInterfaces(unpacker)
+}
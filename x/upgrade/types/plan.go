@@ -3,9 +3,13 @@ package types
 import (
 	"fmt"
 
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // UpgradeInfoFileName file to store upgrade information
@@ -26,9 +30,42 @@ func (p Plan) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
 
+	changes, err := p.GetParamChanges()
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for i, msg := range changes {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "param change %d: %s", i, err)
+			}
+		}
+	}
+
 	return nil
 }
 
+// GetParamChanges returns the param change messages of the Plan.
+func (p Plan) GetParamChanges() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(p.ParamChanges, "param change")
+}
+
+// SetParamChanges sets the param change messages of the Plan.
+func (p *Plan) SetParamChanges(msgs []sdk.Msg) error {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	p.ParamChanges = anys
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Plan) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, p.ParamChanges)
+}
+
 // ShouldExecute returns true if the Plan is ready to execute given the current block height
 func (p Plan) ShouldExecute(blockHeight int64) bool {
 	return p.Height > 0 && p.Height <= blockHeight
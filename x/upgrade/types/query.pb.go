@@ -484,6 +484,164 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryDryRunParamChangesRequest is the request type for the
+// Query/DryRunParamChanges RPC method.
+type QueryDryRunParamChangesRequest struct {
+}
+
+func (m *QueryDryRunParamChangesRequest) Reset()         { *m = QueryDryRunParamChangesRequest{} }
+func (m *QueryDryRunParamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDryRunParamChangesRequest) ProtoMessage()    {}
+func (*QueryDryRunParamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryDryRunParamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDryRunParamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDryRunParamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDryRunParamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDryRunParamChangesRequest.Merge(m, src)
+}
+func (m *QueryDryRunParamChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDryRunParamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDryRunParamChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDryRunParamChangesRequest proto.InternalMessageInfo
+
+// QueryDryRunParamChangesResponse is the response type for the
+// Query/DryRunParamChanges RPC method.
+type QueryDryRunParamChangesResponse struct {
+	// name is the name of the current upgrade plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// results contains the outcome of each param change of the plan, in order.
+	Results []*ParamChangeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *QueryDryRunParamChangesResponse) Reset()         { *m = QueryDryRunParamChangesResponse{} }
+func (m *QueryDryRunParamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDryRunParamChangesResponse) ProtoMessage()    {}
+func (*QueryDryRunParamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryDryRunParamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDryRunParamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDryRunParamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDryRunParamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDryRunParamChangesResponse.Merge(m, src)
+}
+func (m *QueryDryRunParamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDryRunParamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDryRunParamChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDryRunParamChangesResponse proto.InternalMessageInfo
+
+func (m *QueryDryRunParamChangesResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryDryRunParamChangesResponse) GetResults() []*ParamChangeResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ParamChangeResult is the outcome of the dry run of a single param change.
+type ParamChangeResult struct {
+	// type_url is the type URL of the param change message.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// success is true if the param change was executed successfully.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the execution error of the param change, if any.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ParamChangeResult) Reset()         { *m = ParamChangeResult{} }
+func (m *ParamChangeResult) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeResult) ProtoMessage()    {}
+func (*ParamChangeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *ParamChangeResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeResult.Merge(m, src)
+}
+func (m *ParamChangeResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeResult proto.InternalMessageInfo
+
+func (m *ParamChangeResult) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *ParamChangeResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ParamChangeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -495,6 +653,9 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryDryRunParamChangesRequest)(nil), "cosmos.upgrade.v1beta1.QueryDryRunParamChangesRequest")
+	proto.RegisterType((*QueryDryRunParamChangesResponse)(nil), "cosmos.upgrade.v1beta1.QueryDryRunParamChangesResponse")
+	proto.RegisterType((*ParamChangeResult)(nil), "cosmos.upgrade.v1beta1.ParamChangeResult")
 }
 
 func init() {
@@ -502,50 +663,59 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
-	0x1c, 0x65, 0x0a, 0x5f, 0xf8, 0xf2, 0xa9, 0x01, 0x33, 0xc4, 0xba, 0xac, 0xa4, 0xd6, 0x01, 0x15,
-	0x23, 0xdd, 0x85, 0xd6, 0x70, 0x40, 0x63, 0x14, 0x0e, 0x8a, 0x11, 0xa2, 0x35, 0x7a, 0xf0, 0xd2,
-	0x0c, 0xec, 0xa4, 0x34, 0xb4, 0xbb, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x3c, 0x70, 0xf2, 0x68, 0xe2,
-	0xcd, 0x83, 0x37, 0x13, 0xff, 0x00, 0xaf, 0xde, 0x8d, 0x27, 0xa2, 0x17, 0x63, 0x3c, 0x18, 0xf0,
-	0x0f, 0x31, 0x3b, 0x33, 0x25, 0xfd, 0xb1, 0xbb, 0xa0, 0xb7, 0xce, 0xce, 0x7b, 0xef, 0xf3, 0xde,
-	0xce, 0xbc, 0x2d, 0x90, 0x75, 0x8f, 0x37, 0x3d, 0x6e, 0x87, 0x7e, 0x2d, 0xa0, 0x0e, 0xb3, 0xb7,
-	0xe7, 0xd6, 0x98, 0xa0, 0x73, 0xf6, 0x56, 0xc8, 0x82, 0x1d, 0xcb, 0x0f, 0x3c, 0xe1, 0xe1, 0x9c,
-	0xc2, 0x58, 0x1a, 0x63, 0x69, 0x8c, 0x39, 0x51, 0xf3, 0xbc, 0x5a, 0x83, 0xd9, 0xd4, 0xaf, 0xdb,
-	0xd4, 0x75, 0x3d, 0x41, 0x45, 0xdd, 0x73, 0xb9, 0x62, 0x99, 0x53, 0x09, 0xca, 0x2d, 0x15, 0x85,
-	0x1a, 0x57, 0xa8, 0xaa, 0x5c, 0xd9, 0x7a, 0x90, 0x5c, 0x90, 0x71, 0x38, 0xff, 0x38, 0x72, 0xb1,
-	0x14, 0x06, 0x01, 0x73, 0xc5, 0xa3, 0x06, 0x75, 0x2b, 0x6c, 0x2b, 0x64, 0x5c, 0x90, 0x87, 0x60,
-	0xf4, 0x6e, 0x71, 0xdf, 0x73, 0x39, 0xc3, 0xb3, 0x30, 0xe0, 0x37, 0xa8, 0x6b, 0xa0, 0x02, 0x9a,
-	0xce, 0x96, 0x26, 0xac, 0x78, 0xf3, 0x96, 0xe4, 0x48, 0x24, 0x29, 0xea, 0x41, 0x77, 0x7d, 0xbf,
-	0x51, 0x67, 0x4e, 0xdb, 0x20, 0x8c, 0x61, 0xc0, 0xa5, 0x4d, 0x26, 0xc5, 0x86, 0x2b, 0xf2, 0x37,
-	0x29, 0xe9, 0xe1, 0x1d, 0x70, 0x3d, 0x3c, 0x07, 0x83, 0x1b, 0xac, 0x5e, 0xdb, 0x10, 0x92, 0xd1,
-	0x5f, 0xd1, 0x2b, 0xb2, 0x0c, 0x44, 0x72, 0x9e, 0x2a, 0x17, 0xce, 0x52, 0x84, 0x76, 0x79, 0xc8,
-	0x9f, 0x08, 0x2a, 0x58, 0x6b, 0xda, 0x45, 0xc8, 0x36, 0x28, 0x17, 0xd5, 0x0e, 0x09, 0x88, 0x1e,
-	0xdd, 0x97, 0x4f, 0x16, 0x32, 0x06, 0x22, 0x2f, 0x61, 0x32, 0x55, 0x4a, 0x3b, 0x59, 0x01, 0x43,
-	0x47, 0x76, 0xaa, 0xeb, 0x2d, 0x48, 0x95, 0x47, 0x18, 0x23, 0x53, 0x40, 0xd3, 0x67, 0x16, 0xc7,
-	0x7e, 0x7c, 0x2c, 0x8e, 0xaa, 0xb7, 0x53, 0xe4, 0xce, 0x66, 0x61, 0xd6, 0xba, 0x51, 0xae, 0xe4,
-	0xc2, 0x58, 0xd9, 0x68, 0xf2, 0x83, 0x81, 0xff, 0xd1, 0xd9, 0x0c, 0xa9, 0x80, 0x29, 0xe7, 0xaf,
-	0x78, 0x4e, 0xd8, 0x60, 0xcf, 0x58, 0xc0, 0xa3, 0x43, 0x6f, 0x8b, 0xd0, 0x94, 0x1b, 0xd5, 0xb6,
-	0xf7, 0x06, 0xea, 0xd1, 0x2a, 0x6d, 0xb2, 0x85, 0xb1, 0xaf, 0xbd, 0x53, 0xc9, 0x3e, 0x82, 0x0b,
-	0xb1, 0xa2, 0x3a, 0xcc, 0x2a, 0x8c, 0x6a, 0xd5, 0x6d, 0xbd, 0x65, 0xa0, 0x42, 0xff, 0x74, 0xb6,
-	0x74, 0x39, 0xe9, 0x78, 0x3b, 0x84, 0x2a, 0x23, 0xcd, 0x0e, 0xdd, 0x78, 0x13, 0x33, 0x70, 0x4e,
-	0x9d, 0x6b, 0x28, 0x36, 0xbc, 0xa0, 0x2e, 0x76, 0x74, 0xa6, 0x38, 0xf4, 0x3c, 0xb9, 0x07, 0xb9,
-	0x6e, 0xb4, 0x36, 0x6b, 0xc0, 0x10, 0x75, 0x9c, 0x80, 0x71, 0xae, 0xe3, 0xb7, 0x96, 0xb1, 0x42,
-	0xa5, 0xb7, 0x43, 0xf0, 0x9f, 0x54, 0xc2, 0xef, 0x10, 0x64, 0xdb, 0x6e, 0x34, 0xb6, 0x93, 0xc2,
-	0x25, 0xd4, 0xc2, 0x9c, 0x3d, 0x3d, 0x41, 0x79, 0x25, 0x33, 0xfb, 0xdf, 0x7e, 0xbf, 0xc9, 0x5c,
-	0xc1, 0x53, 0x76, 0x42, 0x5b, 0xd7, 0x15, 0xa9, 0x1a, 0x15, 0x05, 0xbf, 0x47, 0x90, 0x6d, 0xbb,
-	0xf5, 0x27, 0x18, 0xec, 0xad, 0xd3, 0x09, 0x06, 0x63, 0x0a, 0x45, 0xca, 0xd2, 0x60, 0x11, 0x5f,
-	0x4f, 0x32, 0x48, 0x15, 0x49, 0x1a, 0xb4, 0x77, 0xa3, 0x4b, 0xb7, 0x87, 0x7f, 0x22, 0xc8, 0xc5,
-	0xd7, 0x03, 0x2f, 0xa4, 0x3a, 0x48, 0xad, 0xa7, 0x79, 0xf3, 0x9f, 0xb8, 0x3a, 0xc8, 0xb2, 0x0c,
-	0x72, 0x07, 0xdf, 0xb6, 0xd3, 0xbf, 0x8b, 0x3d, 0x6d, 0xb5, 0x77, 0xdb, 0xbe, 0x09, 0x7b, 0xaf,
-	0x32, 0x08, 0x7f, 0x42, 0x30, 0xd2, 0x59, 0x14, 0x5c, 0x4a, 0xb5, 0x16, 0x5b, 0x55, 0xb3, 0xfc,
-	0x57, 0x1c, 0x1d, 0x63, 0xf1, 0x4b, 0x6f, 0x73, 0x64, 0xb2, 0x6b, 0xf8, 0x6a, 0x52, 0xb2, 0xae,
-	0xea, 0xe2, 0x0f, 0x08, 0x86, 0x8f, 0x6b, 0x83, 0x8b, 0xe9, 0x77, 0xa2, 0xab, 0x8c, 0xa6, 0x75,
-	0x5a, 0xb8, 0x36, 0x7c, 0xab, 0xd7, 0xf0, 0xbc, 0x34, 0x3c, 0x89, 0x2f, 0x25, 0xde, 0xa9, 0x96,
-	0xca, 0xe2, 0xfc, 0xe7, 0xc3, 0x3c, 0x3a, 0x38, 0xcc, 0xa3, 0x5f, 0x87, 0x79, 0xf4, 0xfa, 0x28,
-	0xdf, 0x77, 0x70, 0x94, 0xef, 0xfb, 0x7e, 0x94, 0xef, 0x7b, 0x3e, 0xa1, 0xb8, 0xdc, 0xd9, 0xb4,
-	0xea, 0x9e, 0xfd, 0xe2, 0x58, 0x43, 0xec, 0xf8, 0x8c, 0xaf, 0x0d, 0xca, 0xbf, 0xb0, 0xf2, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x0d, 0x4f, 0xe3, 0x5f, 0x07, 0x00, 0x00,
+	// 831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0x03, 0x45,
+	0x14, 0x66, 0xca, 0xaf, 0xf2, 0x6a, 0x40, 0x07, 0xad, 0xcb, 0x4a, 0x4a, 0x5d, 0x50, 0x21, 0xd2,
+	0xdd, 0xd2, 0x4a, 0x4d, 0xd0, 0x18, 0xa5, 0x26, 0x82, 0x11, 0x82, 0x6b, 0xf0, 0xe0, 0x65, 0x33,
+	0x74, 0x27, 0x6d, 0xc3, 0x76, 0x77, 0x99, 0xd9, 0x25, 0x36, 0x04, 0x0f, 0x9c, 0xbc, 0x69, 0xe2,
+	0xdd, 0x9b, 0x89, 0x7f, 0x80, 0x57, 0xef, 0x86, 0x8b, 0x44, 0x2f, 0xc6, 0x78, 0x30, 0xe0, 0xd1,
+	0x3f, 0xc2, 0xec, 0xec, 0x14, 0x5b, 0x76, 0xb7, 0x82, 0xb7, 0xbe, 0xdd, 0xf7, 0x7d, 0xef, 0xfb,
+	0xde, 0x4c, 0xbf, 0x16, 0xb4, 0x96, 0xc7, 0x7b, 0x1e, 0x37, 0x42, 0xbf, 0xcd, 0x88, 0x4d, 0x8d,
+	0xf3, 0xad, 0x13, 0x1a, 0x90, 0x2d, 0xe3, 0x2c, 0xa4, 0xac, 0xaf, 0xfb, 0xcc, 0x0b, 0x3c, 0x5c,
+	0x8c, 0x7b, 0x74, 0xd9, 0xa3, 0xcb, 0x1e, 0x75, 0xb9, 0xed, 0x79, 0x6d, 0x87, 0x1a, 0xc4, 0xef,
+	0x1a, 0xc4, 0x75, 0xbd, 0x80, 0x04, 0x5d, 0xcf, 0xe5, 0x31, 0x4a, 0x5d, 0xcb, 0x60, 0x1e, 0xb0,
+	0xc4, 0x5d, 0x4b, 0x71, 0x97, 0x25, 0x2a, 0x43, 0x0e, 0x12, 0x85, 0xb6, 0x04, 0x2f, 0x7e, 0x1c,
+	0xa9, 0x68, 0x86, 0x8c, 0x51, 0x37, 0x38, 0x72, 0x88, 0x6b, 0xd2, 0xb3, 0x90, 0xf2, 0x40, 0xfb,
+	0x08, 0x94, 0xe4, 0x2b, 0xee, 0x7b, 0x2e, 0xa7, 0xb8, 0x0a, 0x53, 0xbe, 0x43, 0x5c, 0x05, 0x95,
+	0xd1, 0x7a, 0xa1, 0xb6, 0xac, 0xa7, 0x8b, 0xd7, 0x05, 0x46, 0x74, 0x6a, 0x15, 0x39, 0xe8, 0x3d,
+	0xdf, 0x77, 0xba, 0xd4, 0x1e, 0x1a, 0x84, 0x31, 0x4c, 0xb9, 0xa4, 0x47, 0x05, 0xd9, 0x9c, 0x29,
+	0x3e, 0x6b, 0x35, 0x50, 0x92, 0xed, 0x72, 0x78, 0x11, 0x66, 0x3a, 0xb4, 0xdb, 0xee, 0x04, 0x02,
+	0x31, 0x69, 0xca, 0x4a, 0xdb, 0x07, 0x4d, 0x60, 0x8e, 0x63, 0x15, 0x76, 0x33, 0xea, 0x76, 0x79,
+	0xc8, 0x3f, 0x09, 0x48, 0x40, 0x07, 0xd3, 0x56, 0xa0, 0xe0, 0x10, 0x1e, 0x58, 0x23, 0x14, 0x10,
+	0x3d, 0xda, 0x13, 0x4f, 0x76, 0x72, 0x0a, 0xd2, 0xbe, 0x80, 0xd5, 0xb1, 0x54, 0x52, 0xc9, 0x01,
+	0x28, 0xd2, 0xb2, 0x6d, 0xb5, 0x06, 0x2d, 0x16, 0x8f, 0x7a, 0x94, 0x5c, 0x19, 0xad, 0x3f, 0xb3,
+	0xbb, 0xf8, 0xfb, 0x0f, 0x95, 0x85, 0x78, 0x3b, 0x15, 0x6e, 0x9f, 0x96, 0xab, 0xfa, 0x1b, 0x75,
+	0xb3, 0x18, 0xa6, 0xd2, 0x46, 0x93, 0x3f, 0x9c, 0xca, 0xa3, 0x67, 0x73, 0x9a, 0x09, 0xaa, 0x98,
+	0x7f, 0xe0, 0xd9, 0xa1, 0x43, 0x3f, 0xa5, 0x8c, 0x47, 0x87, 0x3e, 0x64, 0xa1, 0x27, 0x5e, 0x58,
+	0x43, 0x7b, 0x83, 0xf8, 0xd1, 0x21, 0xe9, 0xd1, 0x9d, 0xc5, 0x5f, 0x92, 0x53, 0xb5, 0x2b, 0x04,
+	0x2f, 0xa5, 0x92, 0x4a, 0x33, 0x87, 0xb0, 0x20, 0x59, 0xcf, 0xe5, 0x2b, 0x05, 0x95, 0x27, 0xd7,
+	0x0b, 0xb5, 0x57, 0xb2, 0x8e, 0x77, 0x84, 0xc8, 0x9c, 0xef, 0x8d, 0xf0, 0xa6, 0x8b, 0xd8, 0x84,
+	0x17, 0xe2, 0x73, 0x0d, 0x83, 0x8e, 0xc7, 0xba, 0x41, 0x5f, 0x7a, 0x4a, 0xeb, 0x6e, 0x68, 0x1f,
+	0x40, 0xf1, 0x61, 0xb7, 0x14, 0xab, 0xc0, 0x2c, 0xb1, 0x6d, 0x46, 0x39, 0x97, 0xf6, 0x07, 0x65,
+	0x3a, 0xd1, 0x36, 0x94, 0x04, 0xd1, 0xfb, 0xac, 0x6f, 0x86, 0xee, 0x11, 0x61, 0xa4, 0xd7, 0xec,
+	0x10, 0xb7, 0x4d, 0x79, 0xf6, 0xfc, 0xed, 0x9a, 0xf6, 0x15, 0x82, 0x95, 0x4c, 0x9c, 0x54, 0x92,
+	0x72, 0x7b, 0x71, 0x13, 0x66, 0x19, 0xe5, 0xa1, 0x13, 0x70, 0x25, 0x27, 0x56, 0xb8, 0x91, 0xf9,
+	0x0d, 0xf9, 0x97, 0xd2, 0x14, 0x08, 0x73, 0x80, 0x4c, 0x57, 0xc4, 0xe1, 0xb9, 0x04, 0x04, 0x2f,
+	0x41, 0x3e, 0xe8, 0xfb, 0xd4, 0x0a, 0x99, 0x33, 0xd8, 0x46, 0x54, 0x1f, 0x33, 0x27, 0xda, 0x13,
+	0x0f, 0x5b, 0xad, 0x68, 0x4f, 0xd1, 0x85, 0xcc, 0x9b, 0x83, 0x12, 0x3f, 0x0f, 0xd3, 0x94, 0x31,
+	0x8f, 0x29, 0x93, 0x02, 0x11, 0x17, 0xa9, 0x43, 0x6b, 0x7f, 0xe7, 0x61, 0x5a, 0xac, 0x01, 0x7f,
+	0x8b, 0xa0, 0x30, 0x94, 0x07, 0xd8, 0xc8, 0xf2, 0x95, 0x11, 0x2a, 0x6a, 0xf5, 0xf1, 0x80, 0x78,
+	0xbf, 0xda, 0xe6, 0xd5, 0xaf, 0x7f, 0x7d, 0x93, 0x7b, 0x15, 0xaf, 0x19, 0x19, 0x59, 0xd7, 0x8a,
+	0x41, 0x56, 0x14, 0x33, 0xf8, 0x3b, 0x04, 0x85, 0xa1, 0xcc, 0xf8, 0x0f, 0x81, 0xc9, 0x30, 0x52,
+	0xab, 0x8f, 0x07, 0x48, 0x81, 0x75, 0x21, 0xb0, 0x82, 0x5f, 0xcf, 0x12, 0x48, 0x62, 0x90, 0x10,
+	0x68, 0x5c, 0x44, 0x17, 0xe4, 0x12, 0xff, 0x81, 0xa0, 0x98, 0x1e, 0x2e, 0x78, 0x67, 0xac, 0x82,
+	0xb1, 0xe1, 0xa6, 0xbe, 0xf5, 0xbf, 0xb0, 0xd2, 0xc8, 0xbe, 0x30, 0xf2, 0x2e, 0x7e, 0xc7, 0x18,
+	0xff, 0xab, 0x92, 0xc8, 0x3a, 0xe3, 0x62, 0x28, 0x51, 0x2f, 0xbf, 0xcc, 0x21, 0xfc, 0x23, 0x82,
+	0xf9, 0xd1, 0x98, 0xc1, 0xb5, 0xb1, 0xd2, 0x52, 0x83, 0x4e, 0xad, 0x3f, 0x09, 0x23, 0x6d, 0xec,
+	0x5e, 0x27, 0x73, 0x47, 0x38, 0xdb, 0xc0, 0xaf, 0x65, 0x39, 0x7b, 0x10, 0x7c, 0xf8, 0x7b, 0x04,
+	0x73, 0xf7, 0xa1, 0x83, 0x2b, 0xe3, 0xef, 0xc4, 0x83, 0x28, 0x53, 0xf5, 0xc7, 0xb6, 0x4b, 0xc1,
+	0x6f, 0x27, 0x05, 0x37, 0x84, 0xe0, 0x55, 0xfc, 0x72, 0xe6, 0x9d, 0xba, 0x17, 0xf7, 0x33, 0x02,
+	0x9c, 0x8c, 0x27, 0xdc, 0x18, 0x2b, 0x22, 0x33, 0x07, 0xd5, 0x37, 0x9f, 0x8c, 0x93, 0x2e, 0xf6,
+	0xae, 0x93, 0xc9, 0x21, 0x5c, 0x18, 0xb8, 0x92, 0xe5, 0xc2, 0x66, 0x7d, 0x8b, 0x85, 0xae, 0xe5,
+	0x47, 0x84, 0x56, 0x2b, 0x66, 0xdc, 0x6d, 0xfc, 0x74, 0x5b, 0x42, 0x37, 0xb7, 0x25, 0xf4, 0xe7,
+	0x6d, 0x09, 0x7d, 0x7d, 0x57, 0x9a, 0xb8, 0xb9, 0x2b, 0x4d, 0xfc, 0x76, 0x57, 0x9a, 0xf8, 0x6c,
+	0x39, 0xe6, 0xe1, 0xf6, 0xa9, 0xde, 0xf5, 0x8c, 0xcf, 0xef, 0xf9, 0xa2, 0xb4, 0xe3, 0x27, 0x33,
+	0xe2, 0x2f, 0x4d, 0xfd, 0x9f, 0x01, 0x00, 0xc8, 0x4e, 0x11, 0x47, 0x6f, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -575,6 +745,9 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// DryRunParamChanges executes the param changes of the current upgrade plan
+	// without persisting them and reports the outcome of each change.
+	DryRunParamChanges(ctx context.Context, in *QueryDryRunParamChangesRequest, opts ...grpc.CallOption) (*QueryDryRunParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -631,6 +804,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) DryRunParamChanges(ctx context.Context, in *QueryDryRunParamChangesRequest, opts ...grpc.CallOption) (*QueryDryRunParamChangesResponse, error) {
+	out := new(QueryDryRunParamChangesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/DryRunParamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -648,6 +830,9 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// DryRunParamChanges executes the param changes of the current upgrade plan
+	// without persisting them and reports the outcome of each change.
+	DryRunParamChanges(context.Context, *QueryDryRunParamChangesRequest) (*QueryDryRunParamChangesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -669,6 +854,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) DryRunParamChanges(ctx context.Context, req *QueryDryRunParamChangesRequest) (*QueryDryRunParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunParamChanges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -764,6 +952,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DryRunParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDryRunParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DryRunParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/DryRunParamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DryRunParamChanges(ctx, req.(*QueryDryRunParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -788,6 +994,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "DryRunParamChanges",
+			Handler:    _Query_DryRunParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1087,6 +1297,120 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDryRunParamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDryRunParamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDryRunParamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDryRunParamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDryRunParamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDryRunParamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangeResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1220,6 +1544,54 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDryRunParamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDryRunParamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamChangeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1964,6 +2336,306 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDryRunParamChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDryRunParamChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDryRunParamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDryRunParamChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDryRunParamChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDryRunParamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &ParamChangeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChangeResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangeResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
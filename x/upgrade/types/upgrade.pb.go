@@ -53,6 +53,11 @@ type Plan struct {
 	// moved to the IBC module in the sub module 02-client.
 	// If this field is not empty, an error will be thrown.
 	UpgradedClientState *any.Any `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"` // Deprecated: Do not use.
+	// param_changes are the module parameter updates applied as part of the
+	// upgrade, after the upgrade handler has run. They are messages such as
+	// MsgUpdateParams whose only signer must be the upgrade authority. If the
+	// upgrade handler or any of the param changes fail, none of them are applied.
+	ParamChanges []*any.Any `protobuf:"bytes,6,rep,name=param_changes,json=paramChanges,proto3" json:"param_changes,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -235,41 +240,43 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xcf, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0xa8, 0x38, 0x96, 0xc5, 0x10,
-	0x55, 0x8a, 0x4d, 0x5b, 0x26, 0x33, 0x20, 0x92, 0x11, 0x90, 0x8a, 0x0b, 0x1d, 0x58, 0xa2, 0x4b,
-	0x7c, 0x71, 0xad, 0xda, 0x77, 0x96, 0xef, 0x12, 0xc8, 0x2b, 0x30, 0xf5, 0x11, 0x18, 0x11, 0x53,
-	0x87, 0x3e, 0x44, 0xc4, 0x54, 0x31, 0x21, 0x21, 0xf1, 0x27, 0x19, 0xca, 0xc6, 0x2b, 0xa0, 0xbb,
-	0xb3, 0xab, 0x08, 0x0a, 0x62, 0x60, 0x89, 0xbe, 0xef, 0xbb, 0xef, 0x97, 0xdf, 0x9f, 0x3b, 0xc3,
-	0x3b, 0x03, 0xc6, 0x53, 0xc6, 0xbd, 0x51, 0x16, 0xe5, 0x38, 0x24, 0xde, 0x78, 0xa7, 0x4f, 0x04,
-	0xde, 0x29, 0x7b, 0x37, 0xcb, 0x99, 0x60, 0xe6, 0xba, 0xde, 0x72, 0xcb, 0x69, 0xb1, 0xd5, 0xd8,
-	0x8c, 0x18, 0x8b, 0x12, 0xe2, 0xa9, 0xad, 0xfe, 0x68, 0xe8, 0x61, 0x3a, 0xd1, 0x90, 0x46, 0x3d,
-	0x62, 0x11, 0x53, 0xa5, 0x27, 0xab, 0x62, 0xda, 0xfc, 0x15, 0x20, 0xe2, 0x94, 0x70, 0x81, 0xd3,
-	0xac, 0x58, 0xd8, 0xd4, 0x4c, 0x3d, 0x8d, 0x2c, 0x68, 0xf5, 0xd1, 0x0d, 0x9c, 0xc6, 0x94, 0x79,
-	0xea, 0x57, 0x8f, 0x9c, 0x1f, 0x00, 0x1a, 0xfb, 0x09, 0xa6, 0xa6, 0x09, 0x0d, 0x8a, 0x53, 0x82,
-	0x80, 0x0d, 0x5a, 0xd5, 0x40, 0xd5, 0xe6, 0x03, 0x68, 0xc8, 0x7f, 0x47, 0x4b, 0x36, 0x68, 0xd5,
-	0x76, 0x1b, 0xae, 0xa6, 0x76, 0x4b, 0x6a, 0xf7, 0x59, 0x49, 0xdd, 0x59, 0x9b, 0x7e, 0x6e, 0x56,
-	0x4e, 0xbe, 0x34, 0xc1, 0xdb, 0x8b, 0xd3, 0x6d, 0x80, 0x40, 0xa0, 0x80, 0xe6, 0x3a, 0x5c, 0x3d,
-	0x22, 0x71, 0x74, 0x24, 0xd0, 0xb2, 0x0d, 0x5a, 0xcb, 0x41, 0xd1, 0x49, 0xb2, 0x98, 0x0e, 0x19,
-	0x32, 0x34, 0x99, 0xac, 0xcd, 0xc7, 0xf0, 0x56, 0x11, 0x4e, 0xd8, 0x1b, 0x24, 0x31, 0xa1, 0xa2,
-	0xc7, 0x05, 0x16, 0x04, 0xad, 0x28, 0xf6, 0xfa, 0x6f, 0xec, 0x0f, 0xe9, 0xa4, 0xb3, 0x84, 0x40,
-	0x70, 0xb3, 0x84, 0x75, 0x15, 0xea, 0x40, 0x82, 0x7c, 0xf4, 0xfd, 0x4d, 0x13, 0xbc, 0xbe, 0x38,
-	0xdd, 0x5e, 0xd3, 0x09, 0xb4, 0x79, 0x78, 0xec, 0x49, 0xa3, 0xce, 0x27, 0x00, 0x37, 0x0e, 0xd8,
-	0x50, 0xbc, 0xc4, 0x39, 0x79, 0xae, 0x91, 0xfb, 0x39, 0xcb, 0x18, 0xc7, 0x89, 0x59, 0x87, 0x2b,
-	0x22, 0x16, 0x49, 0x99, 0x82, 0x6e, 0x4c, 0x1b, 0xd6, 0x42, 0xc2, 0x07, 0x79, 0x9c, 0x89, 0x98,
-	0x51, 0x95, 0x46, 0x35, 0x58, 0x1c, 0x99, 0xf7, 0xa1, 0x91, 0x25, 0x98, 0x2a, 0x97, 0xb5, 0xdd,
-	0x2d, 0xf7, 0xea, 0xcb, 0x76, 0x25, 0x7f, 0xa7, 0x2a, 0xa3, 0x52, 0x31, 0x05, 0x0a, 0xe4, 0x3f,
-	0x92, 0x52, 0xdf, 0x9f, 0xb5, 0x1b, 0x05, 0x2a, 0x62, 0xe3, 0x4b, 0x44, 0x97, 0x51, 0x41, 0xa8,
-	0x90, 0x46, 0x9c, 0x05, 0x23, 0x7f, 0xd0, 0x8f, 0x80, 0xf3, 0x0e, 0xc0, 0xdb, 0x5d, 0x4c, 0x07,
-	0x24, 0xf9, 0xcf, 0x1e, 0xfd, 0xa7, 0xff, 0x26, 0xb3, 0xb5, 0x20, 0xf3, 0xaf, 0x42, 0x10, 0x70,
-	0x0e, 0xe1, 0xf5, 0x27, 0x2c, 0x1c, 0x25, 0xe4, 0x90, 0xe4, 0x5c, 0xe6, 0x78, 0xd5, 0x23, 0x44,
-	0xf0, 0xda, 0x58, 0x1f, 0x2b, 0x55, 0x46, 0x50, 0xb6, 0xfe, 0x86, 0x54, 0xf4, 0xe1, 0xac, 0xbd,
-	0x70, 0xc5, 0xf6, 0x5d, 0xf7, 0xde, 0x5e, 0xc7, 0x9f, 0x7e, 0xb3, 0x2a, 0xd3, 0x99, 0x05, 0xce,
-	0x67, 0x16, 0xf8, 0x3a, 0xb3, 0xc0, 0xc9, 0xdc, 0xaa, 0x9c, 0xcf, 0xad, 0xca, 0xc7, 0xb9, 0x55,
-	0x79, 0xb1, 0xa5, 0xd7, 0x79, 0x78, 0xec, 0xc6, 0xcc, 0x7b, 0x75, 0xf9, 0xe1, 0x8a, 0x49, 0x46,
-	0x78, 0x7f, 0x55, 0xbd, 0xaf, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x6a, 0x37, 0x42,
-	0xd7, 0x03, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0xb5, 0x6e, 0x51, 0x2f, 0x54, 0x15, 0x26, 0x34, 0xd7, 0xa8, 0x38, 0x56, 0xc4, 0x10,
+	0x55, 0x8a, 0x4d, 0x53, 0x58, 0xc2, 0x80, 0x48, 0x46, 0xa8, 0x54, 0x5c, 0xe8, 0xc0, 0x12, 0x5d,
+	0xe2, 0x8b, 0x63, 0xd5, 0xbe, 0xb3, 0x7c, 0x97, 0x40, 0xbe, 0x02, 0x53, 0x3f, 0x02, 0x23, 0x62,
+	0xea, 0x90, 0x0f, 0x11, 0x75, 0xaa, 0x98, 0x50, 0x91, 0xf8, 0x93, 0x0c, 0xe5, 0x63, 0x20, 0xdf,
+	0xd9, 0x51, 0x54, 0x5a, 0xc4, 0xc0, 0x62, 0xbd, 0xf7, 0xee, 0xfd, 0xde, 0xef, 0x77, 0xbf, 0x7b,
+	0x32, 0x7c, 0xd0, 0x65, 0x3c, 0x64, 0xdc, 0x1e, 0x44, 0x5e, 0x8c, 0x5d, 0x62, 0x0f, 0x77, 0x3b,
+	0x44, 0xe0, 0xdd, 0x2c, 0xb7, 0xa2, 0x98, 0x09, 0xa6, 0x6f, 0xaa, 0x2e, 0x2b, 0xab, 0xa6, 0x5d,
+	0xa5, 0x2d, 0x8f, 0x31, 0x2f, 0x20, 0xb6, 0xec, 0xea, 0x0c, 0x7a, 0x36, 0xa6, 0x23, 0x05, 0x29,
+	0x15, 0x3c, 0xe6, 0x31, 0x19, 0xda, 0x49, 0x94, 0x56, 0xcb, 0x57, 0x01, 0xc2, 0x0f, 0x09, 0x17,
+	0x38, 0x8c, 0xd2, 0x86, 0x2d, 0xc5, 0xd4, 0x56, 0xc8, 0x94, 0x56, 0x1d, 0xdd, 0xc1, 0xa1, 0x4f,
+	0x99, 0x2d, 0xbf, 0xaa, 0x54, 0xb9, 0x58, 0x82, 0xda, 0x41, 0x80, 0xa9, 0xae, 0x43, 0x8d, 0xe2,
+	0x90, 0x20, 0x60, 0x82, 0xea, 0x9a, 0x23, 0x63, 0xfd, 0x29, 0xd4, 0x92, 0xe9, 0x68, 0xc9, 0x04,
+	0xd5, 0x7c, 0xbd, 0x64, 0x29, 0x6a, 0x2b, 0xa3, 0xb6, 0x5e, 0x65, 0xd4, 0xcd, 0x8d, 0xc9, 0xb7,
+	0x72, 0xee, 0xe4, 0x7b, 0x19, 0x7c, 0xbc, 0x3c, 0xdd, 0x01, 0x08, 0x38, 0x12, 0xa8, 0x6f, 0xc2,
+	0xd5, 0x3e, 0xf1, 0xbd, 0xbe, 0x40, 0xcb, 0x26, 0xa8, 0x2e, 0x3b, 0x69, 0x96, 0x90, 0xf9, 0xb4,
+	0xc7, 0x90, 0xa6, 0xc8, 0x92, 0x58, 0x7f, 0x01, 0xef, 0xa5, 0xe6, 0xb8, 0xed, 0x6e, 0xe0, 0x13,
+	0x2a, 0xda, 0x5c, 0x60, 0x41, 0xd0, 0x8a, 0x64, 0x2f, 0xfc, 0xc1, 0xfe, 0x8c, 0x8e, 0x9a, 0x4b,
+	0x08, 0x38, 0x77, 0x33, 0x58, 0x4b, 0xa2, 0x0e, 0x13, 0x90, 0xee, 0xc3, 0xf5, 0x08, 0xc7, 0x38,
+	0x6c, 0x77, 0xfb, 0x98, 0x7a, 0x84, 0xa3, 0x55, 0x73, 0xf9, 0xc6, 0x29, 0xd6, 0xd9, 0xb8, 0x56,
+	0x4c, 0x9d, 0xea, 0x60, 0x3e, 0x7f, 0x1d, 0x6b, 0x9f, 0x7b, 0x17, 0xe3, 0xda, 0x86, 0x3a, 0xaa,
+	0x71, 0xf7, 0xd8, 0x7c, 0x68, 0x3d, 0xae, 0x3b, 0xb7, 0xe5, 0xe8, 0x96, 0x9a, 0xdc, 0x40, 0xbf,
+	0x3e, 0x94, 0xc1, 0xfb, 0xcb, 0xd3, 0x9d, 0x85, 0x3e, 0x3b, 0xf1, 0xb4, 0xf2, 0x15, 0xc0, 0xe2,
+	0x21, 0xeb, 0x89, 0xb7, 0x38, 0x26, 0xaf, 0x95, 0xc8, 0x83, 0x98, 0x45, 0x8c, 0xe3, 0x40, 0x2f,
+	0xc0, 0x15, 0xe1, 0x8b, 0x20, 0x33, 0x5c, 0x25, 0xba, 0x09, 0xf3, 0x2e, 0xe1, 0xdd, 0xd8, 0x8f,
+	0x84, 0xcf, 0xa8, 0x34, 0x7e, 0xcd, 0x59, 0x2c, 0xe9, 0x4f, 0xa0, 0x16, 0x05, 0x98, 0x4a, 0x43,
+	0xf3, 0xf5, 0x6d, 0xeb, 0xfa, 0xbd, 0xb2, 0x12, 0xfe, 0xe6, 0x5a, 0xf2, 0x2a, 0xf2, 0x45, 0x1c,
+	0x09, 0x6a, 0x3c, 0x4f, 0xa4, 0x9e, 0x8d, 0x6b, 0xa5, 0x14, 0xe5, 0xb1, 0xe1, 0x1c, 0xd1, 0x62,
+	0x54, 0x10, 0x2a, 0x92, 0x8b, 0x54, 0x16, 0x2e, 0x72, 0x83, 0x7e, 0x04, 0x2a, 0x9f, 0x00, 0xbc,
+	0xdf, 0xc2, 0xb4, 0x4b, 0x82, 0xff, 0x7c, 0xc7, 0xc6, 0xcb, 0x7f, 0x93, 0x59, 0x5d, 0x90, 0xf9,
+	0x57, 0x21, 0x08, 0x54, 0x8e, 0xe0, 0xfa, 0x3e, 0x73, 0x07, 0x01, 0x39, 0x22, 0x31, 0xf7, 0xd9,
+	0xf5, 0xfb, 0x8e, 0xe0, 0xad, 0xa1, 0x3a, 0x96, 0xaa, 0x34, 0x27, 0x4b, 0x1b, 0xc5, 0x44, 0xd1,
+	0xe7, 0xab, 0xab, 0xf0, 0x68, 0xaf, 0xd9, 0x98, 0xfc, 0x34, 0x72, 0x93, 0xa9, 0x01, 0xce, 0xa7,
+	0x06, 0xf8, 0x31, 0x35, 0xc0, 0xc9, 0xcc, 0xc8, 0x9d, 0xcf, 0x8c, 0xdc, 0x97, 0x99, 0x91, 0x7b,
+	0xb3, 0xad, 0xda, 0xb9, 0x7b, 0x6c, 0xf9, 0xcc, 0x7e, 0x37, 0xff, 0x47, 0x88, 0x51, 0x44, 0x78,
+	0x67, 0x55, 0x2e, 0xe1, 0xde, 0xef, 0x01, 0x00, 0xa0, 0x6f, 0x78, 0xaa, 0x42, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -306,6 +313,14 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.UpgradedClientState.Equal(that1.UpgradedClientState) {
 		return false
 	}
+	if len(this.ParamChanges) != len(that1.ParamChanges) {
+		return false
+	}
+	for i := range this.ParamChanges {
+		if !this.ParamChanges[i].Equal(that1.ParamChanges[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -412,6 +427,20 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParamChanges) > 0 {
+		for iNdEx := len(m.ParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.UpgradedClientState != nil {
 		{
 			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
@@ -607,6 +636,12 @@ func (m *Plan) Size() (n int) {
 		l = m.UpgradedClientState.Size()
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	if len(m.ParamChanges) > 0 {
+		for _, e := range m.ParamChanges {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -849,6 +884,40 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamChanges = append(m.ParamChanges, &any.Any{})
+			if err := m.ParamChanges[len(m.ParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])
@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The names of the decorators of the default SDK AnteHandler, registered by NewRegistry.
+const (
+	SetUpContextName        = "setup-context"
+	ExtensionOptionsName    = "extension-options"
+	ValidateBasicName       = "validate-basic"
+	TxTimeoutHeightName     = "tx-timeout-height"
+	ValidateMemoName        = "validate-memo"
+	ConsumeGasForTxSizeName = "consume-gas-for-tx-size"
+	DeductFeeName           = "deduct-fee"
+	ValidateSigCountName    = "validate-sig-count"
+	SigVerificationName     = "sig-verification"
+)
+
+// DefaultAnteDecorators are the names of the decorators of the default SDK
+// AnteHandler, in order.
+var DefaultAnteDecorators = []string{
+	SetUpContextName,
+	ExtensionOptionsName,
+	ValidateBasicName,
+	TxTimeoutHeightName,
+	ValidateMemoName,
+	ConsumeGasForTxSizeName,
+	DeductFeeName,
+	ValidateSigCountName,
+	SigVerificationName,
+}
+
+// Registry holds named ante and post decorators, the components of the
+// validation pipeline of the transactions, which an app enables and orders by
+// name, e.g. from its config. Chains can thus customize the validation of their
+// transactions, registering their own checks along with the SDK decorators,
+// without writing the chain of decorators in Go.
+type Registry struct {
+	ante map[string]sdk.AnteDecorator
+	post map[string]sdk.PostDecorator
+}
+
+// NewRegistry returns a registry of the decorators of the default SDK
+// AnteHandler, see DefaultAnteDecorators, built with options.
+func NewRegistry(options ante.HandlerOptions) (*Registry, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	r := &Registry{
+		ante: map[string]sdk.AnteDecorator{
+			SetUpContextName:        ante.NewSetUpContextDecorator(options.Environment),
+			ExtensionOptionsName:    ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+			ValidateBasicName:       ante.NewValidateBasicDecorator(options.Environment),
+			TxTimeoutHeightName:     ante.NewTxTimeoutHeightDecorator(options.Environment),
+			ValidateMemoName:        ante.NewValidateMemoDecorator(options.AccountKeeper),
+			ConsumeGasForTxSizeName: ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+			DeductFeeName:           ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+			ValidateSigCountName:    ante.NewValidateSigCountDecorator(options.AccountKeeper),
+			SigVerificationName: ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper).
+				WithSignatureCache(options.SignatureCache),
+		},
+		post: map[string]sdk.PostDecorator{},
+	}
+
+	return r, nil
+}
+
+// RegisterAnte registers the ante decorator under name.
+func (r *Registry) RegisterAnte(name string, decorator sdk.AnteDecorator) error {
+	if _, ok := r.ante[name]; ok {
+		return fmt.Errorf("ante decorator %q is already registered", name)
+	}
+
+	r.ante[name] = decorator
+	return nil
+}
+
+// RegisterPost registers the post decorator under name.
+func (r *Registry) RegisterPost(name string, decorator sdk.PostDecorator) error {
+	if _, ok := r.post[name]; ok {
+		return fmt.Errorf("post decorator %q is already registered", name)
+	}
+
+	r.post[name] = decorator
+	return nil
+}
+
+// AnteNames returns the names of the registered ante decorators, sorted.
+func (r *Registry) AnteNames() []string {
+	return sortedNames(r.ante)
+}
+
+// PostNames returns the names of the registered post decorators, sorted.
+func (r *Registry) PostNames() []string {
+	return sortedNames(r.post)
+}
+
+// AnteDecorators returns the ante decorators with the given names, in order,
+// to be chained or set with baseapp.SetAnteDecorators. The decorator setting up
+// the context, if enabled, must be first, as the others depend on it.
+func (r *Registry) AnteDecorators(names []string) ([]sdk.AnteDecorator, error) {
+	for i, name := range names {
+		if name == SetUpContextName && i != 0 {
+			return nil, fmt.Errorf("ante decorator %q must be first", SetUpContextName)
+		}
+	}
+
+	return lookupDecorators(r.ante, "ante", names)
+}
+
+// PostDecorators returns the post decorators with the given names, in order,
+// to be chained or set with baseapp.SetPostDecorators.
+func (r *Registry) PostDecorators(names []string) ([]sdk.PostDecorator, error) {
+	return lookupDecorators(r.post, "post", names)
+}
+
+func lookupDecorators[D any](decorators map[string]D, kind string, names []string) ([]D, error) {
+	enabled := make([]D, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		decorator, ok := decorators[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown %s decorator %q, expected one of %v", kind, name, sortedNames(decorators))
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s decorator %q is enabled twice", kind, name)
+		}
+		seen[name] = true
+		enabled = append(enabled, decorator)
+	}
+
+	return enabled, nil
+}
+
+func sortedNames[D any](decorators map[string]D) []string {
+	names := make([]string, 0, len(decorators))
+	for name := range decorators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,73 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	antetestutil "cosmossdk.io/x/auth/ante/testutil"
+	authtestutil "cosmossdk.io/x/auth/testutil"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/validate"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type namedDecorator string
+
+func (d namedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func (d namedDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate, success)
+}
+
+func TestRegistry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	_, err := validate.NewRegistry(ante.HandlerOptions{})
+	require.ErrorContains(t, err, "account keeper is required")
+
+	registry, err := validate.NewRegistry(ante.HandlerOptions{
+		AccountKeeper:   antetestutil.NewMockAccountKeeper(ctrl),
+		BankKeeper:      authtestutil.NewMockBankKeeper(ctrl),
+		FeegrantKeeper:  antetestutil.NewMockFeegrantKeeper(ctrl),
+		SignModeHandler: &txsigning.HandlerMap{},
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, validate.DefaultAnteDecorators, registry.AnteNames())
+	require.Empty(t, registry.PostNames())
+
+	decorators, err := registry.AnteDecorators(validate.DefaultAnteDecorators)
+	require.NoError(t, err)
+	require.Len(t, decorators, len(validate.DefaultAnteDecorators))
+
+	// custom decorators are registered along with the default ones
+	require.NoError(t, registry.RegisterAnte("custom-check", namedDecorator("custom-check")))
+	require.ErrorContains(t, registry.RegisterAnte(validate.DeductFeeName, namedDecorator("fee")), "already registered")
+	require.NoError(t, registry.RegisterPost("custom-post", namedDecorator("custom-post")))
+	require.ErrorContains(t, registry.RegisterPost("custom-post", namedDecorator("custom-post")), "already registered")
+	require.Equal(t, []string{"custom-post"}, registry.PostNames())
+
+	decorators, err = registry.AnteDecorators([]string{validate.SetUpContextName, "custom-check"})
+	require.NoError(t, err)
+	require.Equal(t, namedDecorator("custom-check"), decorators[1])
+
+	posts, err := registry.PostDecorators([]string{"custom-post"})
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+
+	// the enabled decorators must be registered, once, with the context set up first
+	_, err = registry.AnteDecorators([]string{"unknown"})
+	require.ErrorContains(t, err, `unknown ante decorator "unknown"`)
+	_, err = registry.AnteDecorators([]string{"custom-check", "custom-check"})
+	require.ErrorContains(t, err, "enabled twice")
+	_, err = registry.AnteDecorators([]string{"custom-check", validate.SetUpContextName})
+	require.ErrorContains(t, err, "must be first")
+	_, err = registry.PostDecorators([]string{"unknown"})
+	require.ErrorContains(t, err, `unknown post decorator "unknown"`)
+}